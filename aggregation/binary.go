@@ -23,9 +23,9 @@ import (
 )
 
 // binaryEval evaluates two float array and returns float array
-// 1. capacity not equals, return nil
+// 1. capacity not equals, align both array based on the larger capacity
 // 2. merge two array based on binary operator, return other float array
-// NOTE: make sure both left and right array are not nil and same capacity
+// NOTE: make sure both left and right array are not nil
 func binaryEval(binaryOp stmt.BinaryOP, left, right *collections.FloatArray) *collections.FloatArray {
 	if left == nil || right == nil {
 		return nil
@@ -35,22 +35,41 @@ func binaryEval(binaryOp stmt.BinaryOP, left, right *collections.FloatArray) *co
 	}
 
 	capacity := left.Capacity()
+	if right.Capacity() > capacity {
+		capacity = right.Capacity()
+	}
 	result := collections.NewFloatArray(capacity)
 
 	for i := 0; i < capacity; i++ {
-		leftHasValue := left.HasValue(i)
-		rightHasValue := right.HasValue(i)
+		leftHasValue := hasValue(left, i)
+		rightHasValue := hasValue(right, i)
 		switch {
 		case !leftHasValue && right.IsSingle():
 		case left.IsSingle() && !rightHasValue:
 		case leftHasValue || rightHasValue:
-			result.SetValue(i, eval(binaryOp, left.GetValue(i), right.GetValue(i)))
+			result.SetValue(i, eval(binaryOp, getValue(left, i), getValue(right, i)))
 		}
 	}
 
 	return result
 }
 
+// hasValue checks if array has value with pos, single value array always has value.
+func hasValue(values *collections.FloatArray, pos int) bool {
+	if values.IsSingle() && pos >= values.Capacity() {
+		return values.HasValue(0)
+	}
+	return values.HasValue(pos)
+}
+
+// getValue returns value with pos, single value array returns the same value for all pos.
+func getValue(values *collections.FloatArray, pos int) float64 {
+	if values.IsSingle() && pos >= values.Capacity() {
+		return values.GetValue(0)
+	}
+	return values.GetValue(pos)
+}
+
 // eval evaluates two values and returns another value
 func eval(binaryOp stmt.BinaryOP, left, right float64) float64 {
 	switch binaryOp {
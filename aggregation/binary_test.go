@@ -125,3 +125,28 @@ func TestBinaryEval(t *testing.T) {
 	assert.Equal(t, 0.0, result.GetValue(5))
 	assert.Equal(t, 0.0, result.GetValue(8))
 }
+
+func TestBinaryEval_Align(t *testing.T) {
+	left := collections.NewFloatArray(5)
+	left.SetValue(0, 1)
+	left.SetValue(4, 4)
+	right := collections.NewFloatArray(10)
+	right.SetValue(0, 2)
+	right.SetValue(8, 8)
+	result := binaryEval(stmt.ADD, left, right)
+	assert.Equal(t, 10, result.Capacity())
+	assert.Equal(t, 3, result.Size())
+	assert.Equal(t, 3.0, result.GetValue(0))
+	assert.Equal(t, 4.0, result.GetValue(4))
+	assert.Equal(t, 8.0, result.GetValue(8))
+
+	single := collections.NewFloatArray(2)
+	single.SetSingle(true)
+	single.SetValue(0, 10)
+	single.SetValue(1, 10)
+	result = binaryEval(stmt.MUL, right, single)
+	assert.Equal(t, 10, result.Capacity())
+	assert.Equal(t, 2, result.Size())
+	assert.Equal(t, 20.0, result.GetValue(0))
+	assert.Equal(t, 80.0, result.GetValue(8))
+}
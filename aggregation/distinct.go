@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/hll"
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+// DistinctFieldPrefix represents the prefix of field name which stores the tag value sketches of count distinct.
+const DistinctFieldPrefix = "__distinct_"
+
+// DistinctFieldName returns the field name of count distinct for tag key.
+func DistinctFieldName(tagKey string) string {
+	return DistinctFieldPrefix + tagKey
+}
+
+// IsDistinctField checks if field stores the tag value sketches of count distinct.
+func IsDistinctField(fieldName string) bool {
+	return strings.HasPrefix(fieldName, DistinctFieldPrefix)
+}
+
+// DistinctCount represents the hyperloglog sketches of tag values for each time slot,
+// time slot is the index based on query time range.
+type DistinctCount struct {
+	sketches map[int]*hll.Sketch
+}
+
+// NewDistinctCount creates a DistinctCount instance.
+func NewDistinctCount() *DistinctCount {
+	return &DistinctCount{
+		sketches: make(map[int]*hll.Sketch),
+	}
+}
+
+// Add adds tag value into the sketch of time slot.
+func (d *DistinctCount) Add(slot int, tagValue string) {
+	d.getSketch(slot).InsertString(tagValue)
+}
+
+// Merge merges other distinct count's sketches.
+func (d *DistinctCount) Merge(other *DistinctCount) {
+	for slot, sketch := range other.sketches {
+		d.getSketch(slot).Merge(sketch)
+	}
+}
+
+// Values returns the approximate distinct count of each time slot.
+func (d *DistinctCount) Values(capacity int) *collections.FloatArray {
+	values := collections.NewFloatArray(capacity)
+	for slot, sketch := range d.sketches {
+		if slot >= 0 && slot < capacity {
+			values.SetValue(slot, float64(sketch.Estimate()))
+		}
+	}
+	return values
+}
+
+// MarshalBinary marshals the sketches of each time slot.
+func (d *DistinctCount) MarshalBinary() ([]byte, error) {
+	slots := make([]int, 0, len(d.sketches))
+	for slot := range d.sketches {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+	writer := stream.NewBufferWriter(nil)
+	for _, slot := range slots {
+		data, err := d.sketches[slot].MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		writer.PutVarint32(int32(slot))
+		writer.PutVarint32(int32(len(data)))
+		writer.PutBytes(data)
+	}
+	return writer.Bytes()
+}
+
+// UnmarshalBinary unmarshals the sketches of each time slot, then merges them.
+func (d *DistinctCount) UnmarshalBinary(data []byte) error {
+	reader := stream.NewReader(data)
+	for !reader.Empty() {
+		slot := reader.ReadVarint32()
+		length := reader.ReadVarint32()
+		sketchData := reader.ReadSlice(int(length))
+		if err := reader.Error(); err != nil {
+			return err
+		}
+		sketch := hll.New()
+		if err := sketch.UnmarshalBinary(sketchData); err != nil {
+			return err
+		}
+		d.getSketch(int(slot)).Merge(sketch)
+	}
+	return nil
+}
+
+// getSketch returns the sketch of time slot, if not exist creates it.
+func (d *DistinctCount) getSketch(slot int) *hll.Sketch {
+	sketch, ok := d.sketches[slot]
+	if !ok {
+		sketch = hll.New()
+		d.sketches[slot] = sketch
+	}
+	return sketch
+}
@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistinctFieldName(t *testing.T) {
+	assert.Equal(t, "__distinct_host", DistinctFieldName("host"))
+	assert.True(t, IsDistinctField(DistinctFieldName("host")))
+	assert.False(t, IsDistinctField("host"))
+}
+
+func TestDistinctCount(t *testing.T) {
+	d1 := NewDistinctCount()
+	d1.Add(0, "a")
+	d1.Add(0, "b")
+	d1.Add(0, "a")
+	d1.Add(2, "a")
+	d1.Add(10, "a") // out of capacity
+	d2 := NewDistinctCount()
+	d2.Add(0, "c")
+	d2.Add(1, "c")
+	d1.Merge(d2)
+
+	values := d1.Values(3)
+	assert.Equal(t, 3.0, values.GetValue(0))
+	assert.Equal(t, 1.0, values.GetValue(1))
+	assert.Equal(t, 1.0, values.GetValue(2))
+
+	data, err := d1.MarshalBinary()
+	assert.NoError(t, err)
+	d3 := NewDistinctCount()
+	assert.NoError(t, d3.UnmarshalBinary(data))
+	assert.Equal(t, values, d3.Values(3))
+
+	assert.Error(t, d3.UnmarshalBinary([]byte{1, 100, 1}))
+	assert.Error(t, d3.UnmarshalBinary([]byte{1, 2, 1, 1}))
+}
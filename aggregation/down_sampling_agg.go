@@ -150,3 +150,40 @@ func DownSampling(
 		emitValue(targetSlot, value)
 	}
 }
+
+// DownSamplingBlock merges the materialized field data from source time range => target time range,
+// same as DownSampling, but iterates the decoded values of block directly.
+func DownSamplingBlock(
+	source, target timeutil.SlotRange, ratio uint16, baseSlot int, block *encoding.TSDBlock,
+	emitValue func(targetPos int, value float64),
+) {
+	from := source.Start
+	if target.Start > from {
+		from = target.Start
+	}
+	to := source.End
+	if target.End < to {
+		to = target.End
+	}
+	values, exists := block.Values()
+	blockStart := block.Start()
+	if from < blockStart {
+		from = blockStart
+	}
+	if from > to || len(values) == 0 {
+		return
+	}
+	intervalRatio := int(ratio)
+	startIdx := int(from - blockStart)
+	endIdx := int(to-blockStart) + 1
+	if endIdx > len(values) {
+		endIdx = len(values)
+	}
+	for idx := startIdx; idx < endIdx; idx++ {
+		if !exists[idx] {
+			continue
+		}
+		targetSlot := (baseSlot + int(blockStart) + idx) / intervalRatio
+		emitValue(targetSlot, values[idx])
+	}
+}
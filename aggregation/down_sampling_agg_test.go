@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/pkg/bit"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -96,3 +97,43 @@ func TestDownSampling(t *testing.T) {
 	})
 	assert.Equal(t, 1, found)
 }
+
+func TestDownSamplingBlock(t *testing.T) {
+	encoder := encoding.NewTSDEncoder(5)
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			encoder.AppendTime(bit.One)
+			encoder.AppendValue(math.Float64bits(float64(i)))
+		} else {
+			encoder.AppendTime(bit.Zero)
+		}
+	}
+	data, err := encoder.Bytes()
+	assert.NoError(t, err)
+	decoder := encoding.NewTSDDecoder(data)
+	block := encoding.GetTSDBlock()
+	defer encoding.ReleaseTSDBlock(block)
+	decoder.DecodeBlock(block)
+
+	collect := func(source, target timeutil.SlotRange, ratio uint16) (rs map[int]float64) {
+		rs = make(map[int]float64)
+		DownSamplingBlock(source, target, ratio, 0, block, func(targetPos int, value float64) {
+			rs[targetPos] += value
+		})
+		return
+	}
+	// case 1: out of range
+	assert.Empty(t, collect(timeutil.SlotRange{Start: 0, End: 4}, timeutil.SlotRange{Start: 0, End: 100}, 1))
+	assert.Empty(t, collect(timeutil.SlotRange{Start: 0, End: 100}, timeutil.SlotRange{Start: 50, End: 100}, 1))
+	// case 2: same as slot by slot down sampling
+	for _, ratio := range []uint16{1, 3} {
+		source := timeutil.SlotRange{Start: 0, End: 30}
+		target := timeutil.SlotRange{Start: 7, End: 20}
+		expect := make(map[int]float64)
+		decoder.Reset(data)
+		DownSampling(source, target, ratio, 0, decoder, func(targetPos int, value float64) {
+			expect[targetPos] += value
+		})
+		assert.Equal(t, expect, collect(source, target, ratio))
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/lindb/lindb/aggregation/fields"
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -53,6 +54,7 @@ type expression struct {
 	selectItems []stmt.Expr
 
 	fieldStore map[field.Name]fields.Field
+	sketches   *ddsketch.SlotSketches             // histogram sketch of each time slot
 	resultSet  map[string]*collections.FloatArray // field => series
 }
 
@@ -76,17 +78,17 @@ func (e *expression) Eval(timeSeries series.GroupedIterator) {
 	// prepare Expression context
 	e.prepare(timeSeries)
 
-	if len(e.fieldStore) == 0 {
+	if len(e.fieldStore) == 0 && e.sketches == nil {
 		return
 	}
 
 	for _, selectItem := range e.selectItems {
 		values := e.eval(nil, selectItem)
 		if len(values) != 0 {
-			if item, ok := selectItem.(*stmt.SelectItem); ok && len(item.Alias) > 0 {
-				e.resultSet[item.Alias] = values[0]
+			if item, ok := selectItem.(*stmt.SelectItem); ok {
+				e.resultSet[item.ColumnName()] = values[0]
 			} else {
-				e.resultSet[item.Rewrite()] = values[0]
+				e.resultSet[selectItem.Rewrite()] = values[0]
 			}
 		}
 	}
@@ -106,6 +108,12 @@ func (e *expression) prepare(timeSeries series.GroupedIterator) {
 		fieldSeries := timeSeries.Next()
 		fieldName := fieldSeries.FieldName()
 		fieldType := fieldSeries.FieldType()
+		if fieldType == field.SketchField {
+			if sketches, err := UnmarshalSketches(fieldSeries); err == nil {
+				e.sketches = sketches
+			}
+			continue
+		}
 		f := fields.NewDynamicField(fieldType, e.timeRange.Start, e.interval, e.pointCount)
 		e.fieldStore[fieldName] = f
 		f.SetValue(fieldSeries)
@@ -152,9 +160,7 @@ func (e *expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 }
 
 func (e *expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
-	var (
-		histogramFields = make(map[float64][]*collections.FloatArray)
-	)
+	histogramFields := make(map[float64][]*collections.FloatArray)
 	if len(expr.Params) != 1 {
 		return nil
 	}
@@ -163,25 +169,64 @@ func (e *expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
 		return nil
 	}
 	for fieldName, df := range e.fieldStore {
-		if df.Type() == field.HistogramField {
-			var upperBound float64
-			upperBound, err = metric.UpperBound(fieldName.String())
-			if err != nil {
-				continue
-			}
-			histogramFields[upperBound] = df.GetDefaultValues()
+		if df.Type() != field.HistogramField {
+			continue
+		}
+		var upperBound float64
+		upperBound, err = metric.UpperBound(fieldName.String())
+		if err != nil {
+			continue
 		}
+		histogramFields[upperBound] = df.GetDefaultValues()
 	}
-	if len(histogramFields) == 0 {
-		return nil
+	var array *collections.FloatArray
+	if len(histogramFields) > 0 {
+		array, err = function.QuantileCall(quantileValue, histogramFields)
+		if err != nil {
+			array = nil
+		}
 	}
-	array, err := function.QuantileCall(quantileValue, histogramFields)
-	if err != nil {
+	if e.sketches != nil {
+		// histogram sketch is mergeable across different buckets, prefer using it
+		sketchArray, err := function.SketchQuantileCall(quantileValue, e.sketches, e.pointCount)
+		if err == nil {
+			if array != nil {
+				// fill the time slot which sketch not covers all values of buckets
+				// (histogram sketch not enabled when written)
+				it := array.NewIterator()
+				for it.HasNext() {
+					pos, value := it.Next()
+					if !sketchArray.HasValue(pos) || !e.sketchCovers(pos, histogramFields) {
+						sketchArray.SetValue(pos, value)
+					}
+				}
+			}
+			array = sketchArray
+		}
+	}
+	if array == nil {
 		return nil
 	}
 	return []*collections.FloatArray{array}
 }
 
+// sketchCovers checks if the sketch of time slot covers all values which histogram buckets count.
+func (e *expression) sketchCovers(pos int, histogramFields map[float64][]*collections.FloatArray) bool {
+	sketch, ok := e.sketches.Lookup(pos)
+	if !ok {
+		return false
+	}
+	count := 0.0
+	for _, arrays := range histogramFields {
+		for _, values := range arrays {
+			if values != nil && values.HasValue(pos) {
+				count += values.GetValue(pos)
+			}
+		}
+	}
+	return sketch.Count() >= count*(1-1e-9)
+}
+
 // funcCall calls the function
 func (e *expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 	var params []*collections.FloatArray
@@ -231,5 +276,6 @@ func (e *expression) Reset() {
 	for _, f := range e.fieldStore {
 		f.Reset()
 	}
+	e.sketches = nil
 	e.resultSet = make(map[string]*collections.FloatArray)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -366,3 +367,73 @@ func TestExpression_NotSupport_Expr(t *testing.T) {
 	resultSet = expression.ResultSet()
 	assert.Equal(t, 0, len(resultSet))
 }
+
+func TestExpression_FuncCall_Quantile_Sketch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sketchAgg := newSketchAggregator("HistogramSketch")
+	sketch := ddsketch.NewSketch()
+	for i := 1; i <= 100; i++ {
+		sketch.Add(float64(i), 10)
+	}
+	sketchAgg.AddSketch(5, sketch)
+	data, err := sketchAgg.ResultSet().MarshalBinary()
+	assert.NoError(t, err)
+
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+	q, _ := sql.Parse("select quantile(0.99) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series.NewIterator("HistogramSketch", data)),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	value := resultSet["quantile(0.99)"]
+	assert.NotNil(t, value)
+	assert.Equal(t, 1, value.Size())
+	assert.InEpsilon(t, 99, value.GetValue(5), 0.1)
+
+	expression.Reset()
+	assert.Empty(t, expression.ResultSet())
+
+	// sketch not covers all values of buckets, fallback to buckets
+	cases := []struct {
+		sketchCount float64
+		expect      float64
+	}{
+		{sketchCount: 1, expect: 100},
+		{sketchCount: 100, expect: ddsketch.Value(ddsketch.Index(1000))},
+	}
+	for _, c := range cases {
+		sketchAgg = newSketchAggregator("HistogramSketch")
+		sketch = ddsketch.NewSketch()
+		sketch.Add(1000, c.sketchCount)
+		sketchAgg.AddSketch(40, sketch)
+		data, err = sketchAgg.ResultSet().MarshalBinary()
+		assert.NoError(t, err)
+		expression = NewExpression(timeutil.TimeRange{
+			Start: now,
+			End:   now + timeutil.OneHour*2,
+		}, timeutil.OneMinute, query.SelectItems)
+		gomock.InOrder(
+			timeSeries.EXPECT().HasNext().Return(true),
+			timeSeries.EXPECT().Next().Return(mockTimeSeries(ctrl, familyTime, "__bucket_100", field.HistogramField, field.Sum)),
+			timeSeries.EXPECT().HasNext().Return(true),
+			timeSeries.EXPECT().Next().Return(mockTimeSeries(ctrl, familyTime, "__bucket_+Inf", field.HistogramField, field.Sum)),
+			timeSeries.EXPECT().HasNext().Return(true),
+			timeSeries.EXPECT().Next().Return(series.NewIterator("HistogramSketch", data)),
+			timeSeries.EXPECT().HasNext().Return(false),
+		)
+		expression.Eval(timeSeries)
+		value = expression.ResultSet()["quantile(0.99)"]
+		assert.NotNil(t, value)
+		assert.Equal(t, c.expect, value.GetValue(40))
+	}
+}
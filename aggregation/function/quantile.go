@@ -23,6 +23,7 @@ import (
 	"sort"
 
 	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/ddsketch"
 )
 
 type bucket struct {
@@ -125,3 +126,24 @@ func QuantileCall(q float64, histogramFields map[float64][]*collections.FloatArr
 
 	return targetFloatArray, nil
 }
+
+// SketchQuantileCall calculates quantile value based on the histogram sketch of each time slot,
+// capacity is the number of time slots in query time range.
+func SketchQuantileCall(q float64, sketches *ddsketch.SlotSketches, capacity int) (*collections.FloatArray, error) {
+	if q < 0 || q > 1 {
+		return nil, fmt.Errorf("SketchQuantileCall with illegal value: %f", q)
+	}
+	if sketches == nil || sketches.Len() == 0 {
+		return nil, fmt.Errorf("SketchQuantileCall without sketch")
+	}
+	targetFloatArray := collections.NewFloatArray(capacity)
+	for _, slot := range sketches.Slots() {
+		sketch, _ := sketches.Lookup(slot)
+		if slot < 0 || slot >= capacity || sketch.IsEmpty() {
+			continue
+		}
+		value, _ := sketch.Quantile(q)
+		targetFloatArray.SetValue(slot, value)
+	}
+	return targetFloatArray, nil
+}
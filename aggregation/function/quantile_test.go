@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/ddsketch"
 )
 
 func makeFloatArray(data []float64) []*collections.FloatArray {
@@ -161,3 +162,25 @@ func Test_QuantileCallBadCases(t *testing.T) {
 	_, err = QuantileCall(0.9, fields)
 	assert.Error(t, err)
 }
+
+func Test_SketchQuantileCall(t *testing.T) {
+	_, err := SketchQuantileCall(1.1, nil, 3)
+	assert.Error(t, err)
+	_, err = SketchQuantileCall(0.99, nil, 3)
+	assert.Error(t, err)
+
+	sketches := ddsketch.NewSlotSketches()
+	for i := 1; i <= 100; i++ {
+		// slot 0 has value, slot 1 no value
+		sketches.Get(0).Add(float64(i), 1)
+	}
+	sketches.Get(2).AddZero(10)
+	// out of query time range
+	sketches.Get(5).Add(1, 1)
+	rs, err := SketchQuantileCall(0.99, sketches, 3)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, 99, rs.GetValue(0), ddsketch.RelativeAccuracy*1.1)
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 0.0, rs.GetValue(2))
+	assert.True(t, rs.HasValue(2))
+}
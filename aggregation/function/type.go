@@ -32,6 +32,7 @@ const (
 	Quantile
 	Stddev
 	Rate
+	CountDistinct
 )
 
 // String return the function's name
@@ -57,6 +58,8 @@ func (t FuncType) String() string {
 		return "stddev"
 	case Rate:
 		return "rate"
+	case CountDistinct:
+		return "count_distinct"
 	default:
 		return "unknown"
 	}
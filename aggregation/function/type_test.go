@@ -34,6 +34,7 @@ func TestFuncTypeString(t *testing.T) {
 	assert.Equal(t, "quantile", Quantile.String())
 	assert.Equal(t, "stddev", Stddev.String())
 	assert.Equal(t, "rate", Rate.String())
+	assert.Equal(t, "count_distinct", CountDistinct.String())
 	assert.Equal(t, "unknown", Unknown.String())
 }
 
@@ -37,6 +37,10 @@ type GroupingAggregator interface {
 	Interval() timeutil.Interval
 	// Fields returns all fields.
 	Fields() []field.Name
+	// Size returns the number of groups.
+	Size() int
+	// Contains checks if the group of tags exists.
+	Contains(tags string) bool
 }
 
 // groupingAggregator implements GroupingAggregator interface.
@@ -85,6 +89,11 @@ func (ga *groupingAggregator) Aggregate(it series.GroupedIterator) {
 		if sAgg == nil {
 			continue
 		}
+		if sketchAgg, ok := sAgg.(SketchAggregator); ok {
+			// sketch field has no float value, merges the sketches directly
+			_ = sketchAgg.Merge(seriesIt)
+			continue
+		}
 		// 2. merge the field series data
 		for seriesIt.HasNext() {
 			startTime, fieldIt := seriesIt.Next()
@@ -137,6 +146,17 @@ func (ga *groupingAggregator) Fields() []field.Name {
 	return rs
 }
 
+// Size returns the number of groups.
+func (ga *groupingAggregator) Size() int {
+	return len(ga.aggregates)
+}
+
+// Contains checks if the group of tags exists.
+func (ga *groupingAggregator) Contains(tags string) bool {
+	_, ok := ga.aggregates[tags]
+	return ok
+}
+
 // getAggregator returns the time series aggregator by the tag of time series.
 func (ga *groupingAggregator) getAggregator(tags string) (agg FieldAggregates) {
 	// get series aggregator
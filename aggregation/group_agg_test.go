@@ -105,6 +105,9 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 			rs := agg.ResultSet()
 			assert.NotNil(t, rs)
 			assert.NotNil(t, agg.Fields())
+			assert.Equal(t, 1, agg.Size())
+			assert.True(t, agg.Contains("tags"))
+			assert.False(t, agg.Contains("other"))
 		})
 	}
 
@@ -120,6 +123,7 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 	assert.Nil(t, rs)
 	assert.Equal(t, timeutil.Interval(timeutil.OneSecond), agg.Interval())
 	assert.Empty(t, agg.Fields())
+	assert.Zero(t, agg.Size())
 	assert.Equal(t,
 		timeutil.TimeRange{
 			Start: now,
@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+//go:generate mockgen -source=./having.go -destination=./having_mock.go -package=aggregation
+
+// HavingFilter represents the filter of having clause for grouped result.
+type HavingFilter interface {
+	// Filter returns if the row matches the having condition.
+	Filter(row Row) bool
+	// Dropped returns the num. of rows which not match the having condition.
+	Dropped() int
+}
+
+// havingFilter implements HavingFilter interface.
+type havingFilter struct {
+	condition  stmt.Expr
+	fieldFuncs map[string]function.FuncType // function for field without function call
+
+	dropped int
+}
+
+// NewHavingFilter creates a HavingFilter instance.
+func NewHavingFilter(condition stmt.Expr, fieldFuncs map[string]function.FuncType) HavingFilter {
+	return &havingFilter{
+		condition:  condition,
+		fieldFuncs: fieldFuncs,
+	}
+}
+
+// Filter returns if the row matches the having condition.
+func (f *havingFilter) Filter(row Row) bool {
+	if f.match(row, f.condition) {
+		return true
+	}
+	f.dropped++
+	return false
+}
+
+// Dropped returns the num. of rows which not match the having condition.
+func (f *havingFilter) Dropped() int {
+	return f.dropped
+}
+
+// match evaluates the bool expression(logic/compare) for given row.
+func (f *havingFilter) match(row Row, expr stmt.Expr) bool {
+	switch e := expr.(type) {
+	case *stmt.ParenExpr:
+		return f.match(row, e.Expr)
+	case *stmt.BinaryExpr:
+		switch e.Operator {
+		case stmt.AND:
+			return f.match(row, e.Left) && f.match(row, e.Right)
+		case stmt.OR:
+			return f.match(row, e.Left) || f.match(row, e.Right)
+		default:
+			return compare(e.Operator, f.value(row, e.Left), f.value(row, e.Right))
+		}
+	default:
+		return false
+	}
+}
+
+// value returns the value of field expression for given row.
+func (f *havingFilter) value(row Row, expr stmt.Expr) float64 {
+	switch e := expr.(type) {
+	case *stmt.NumberLiteral:
+		return e.Val
+	case *stmt.ParenExpr:
+		return f.value(row, e.Expr)
+	case *stmt.BinaryExpr:
+		return eval(e.Operator, f.value(row, e.Left), f.value(row, e.Right))
+	case *stmt.FieldExpr:
+		return row.GetValue(e.Name, f.fieldFuncs[e.Name])
+	case *stmt.CallExpr:
+		if len(e.Params) != 1 {
+			return 0
+		}
+		return row.GetValue(e.Params[0].Rewrite(), e.FuncType)
+	default:
+		return 0
+	}
+}
+
+// compare compares two values based on compare operator.
+func compare(op stmt.BinaryOP, left, right float64) bool {
+	switch op {
+	case stmt.EQUAL:
+		return left == right
+	case stmt.NOTEQUAL:
+		return left != right
+	case stmt.LESS:
+		return left < right
+	case stmt.LESSEQUAL:
+		return left <= right
+	case stmt.GREATER:
+		return left > right
+	case stmt.GREATEREQUAL:
+		return left >= right
+	default:
+		return false
+	}
+}
@@ -0,0 +1,121 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestHavingFilter_Filter(t *testing.T) {
+	values := collections.NewFloatArray(3)
+	values.SetValue(0, 2.0)
+	values.SetValue(1, 3.0)
+	values.SetValue(2, 1.0)
+	row := NewOrderByRow(nil, "tags", map[string]*collections.FloatArray{"f": values})
+	fieldFuncs := map[string]function.FuncType{"f": function.Sum}
+
+	cases := []struct {
+		name      string
+		condition stmt.Expr
+		match     bool
+	}{
+		{
+			name:      "field with default function",
+			condition: &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.EQUAL, Right: &stmt.NumberLiteral{Val: 6}},
+			match:     true,
+		},
+		{
+			name: "function call",
+			condition: &stmt.BinaryExpr{
+				Left:     &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+				Operator: stmt.GREATER,
+				Right:    &stmt.NumberLiteral{Val: 3},
+			},
+		},
+		{
+			name: "function call with wrong params",
+			condition: &stmt.BinaryExpr{
+				Left:     &stmt.CallExpr{FuncType: function.Max},
+				Operator: stmt.GREATEREQUAL,
+				Right:    &stmt.NumberLiteral{Val: 0},
+			},
+			match: true,
+		},
+		{
+			name: "math expr",
+			condition: &stmt.BinaryExpr{
+				Left: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+					Left:     &stmt.CallExpr{FuncType: function.Min, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+					Operator: stmt.MUL,
+					Right:    &stmt.NumberLiteral{Val: 10},
+				}},
+				Operator: stmt.LESSEQUAL,
+				Right:    &stmt.NumberLiteral{Val: 10},
+			},
+			match: true,
+		},
+		{
+			name: "logic and",
+			condition: &stmt.BinaryExpr{
+				Left:     &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.LESS, Right: &stmt.NumberLiteral{Val: 6}},
+				Operator: stmt.AND,
+				Right:    &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.NOTEQUAL, Right: &stmt.NumberLiteral{Val: 1}},
+			},
+		},
+		{
+			name: "logic or with paren",
+			condition: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+				Left:     &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.LESS, Right: &stmt.NumberLiteral{Val: 6}},
+				Operator: stmt.OR,
+				Right:    &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.NOTEQUAL, Right: &stmt.NumberLiteral{Val: 1}},
+			}},
+			match: true,
+		},
+		{
+			name:      "unknown operator",
+			condition: &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.UNKNOWN, Right: &stmt.NumberLiteral{Val: 6}},
+		},
+		{
+			name:      "unknown value expr",
+			condition: &stmt.BinaryExpr{Left: &stmt.EqualsExpr{}, Operator: stmt.EQUAL, Right: &stmt.NumberLiteral{Val: 0}},
+			match:     true,
+		},
+		{
+			name:      "not bool expr",
+			condition: &stmt.FieldExpr{Name: "f"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewHavingFilter(tt.condition, fieldFuncs)
+			assert.Equal(t, tt.match, filter.Filter(row))
+			if tt.match {
+				assert.Zero(t, filter.Dropped())
+			} else {
+				assert.Equal(t, 1, filter.Dropped())
+			}
+		})
+	}
+}
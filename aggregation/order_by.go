@@ -19,9 +19,11 @@ package aggregation
 
 import (
 	"math"
+	"strings"
 
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/series/tag"
 )
 
 //go:generate mockgen -source=./order_by.go -destination=./order_by_mock.go -package=aggregation
@@ -34,19 +36,23 @@ type aggResult struct {
 
 // OrderByRow represents row for order by, implements Row interface.
 type OrderByRow struct {
-	tags   string
-	fields map[string]*collections.FloatArray
+	groupByKeys []string
+	tags        string
+	tagValues   []string
+	fields      map[string]*collections.FloatArray
 
 	// cache order by point value
 	points map[string]*aggResult
 }
 
-// NewOrderByRow creates a OrderByRow instance.
-func NewOrderByRow(tags string, fields map[string]*collections.FloatArray) Row {
+// NewOrderByRow creates a OrderByRow instance,
+// group by keys is empty when group by all tag keys(tag values with tag key: key=value).
+func NewOrderByRow(groupByKeys []string, tags string, fields map[string]*collections.FloatArray) Row {
 	return &OrderByRow{
-		tags:   tags,
-		fields: fields,
-		points: make(map[string]*aggResult),
+		groupByKeys: groupByKeys,
+		tags:        tags,
+		fields:      fields,
+		points:      make(map[string]*aggResult),
 	}
 }
 
@@ -139,6 +145,28 @@ func (r *OrderByRow) GetValue(fieldName string, funcType function.FuncType) floa
 	return 0.0
 }
 
+// GetTagValue returns the tag value of group based on given tag key, returns empty string if not found.
+func (r *OrderByRow) GetTagValue(tagKey string) string {
+	if r.tagValues == nil {
+		r.tagValues = tag.SplitTagValues(r.tags)
+	}
+	if len(r.groupByKeys) == 0 {
+		// group by *, tag value with tag key(key=value)
+		for _, tagValue := range r.tagValues {
+			if key, value, ok := strings.Cut(tagValue, "="); ok && key == tagKey {
+				return value
+			}
+		}
+		return ""
+	}
+	for idx, key := range r.groupByKeys {
+		if key == tagKey && idx < len(r.tagValues) {
+			return r.tagValues[idx]
+		}
+	}
+	return ""
+}
+
 // ResultSet returns the resutl set of series(tags/fields).
 func (r *OrderByRow) ResultSet() (tags string, fields map[string]*collections.FloatArray) {
 	return r.tags, r.fields
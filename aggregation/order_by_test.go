@@ -25,6 +25,7 @@ import (
 
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/series/tag"
 )
 
 func TestTopNOrderBy(t *testing.T) {
@@ -45,12 +46,24 @@ func TestTopNOrderBy(t *testing.T) {
 	assert.Equal(t, []float64{1, 3, 10, 20, 20}, rs)
 }
 
+func TestOrderByRow_GetTagValue(t *testing.T) {
+	row := NewOrderByRow([]string{"host", "ip"}, tag.ConcatTagValues([]string{"h1", "1.1.1.1"}), nil)
+	assert.Equal(t, "h1", row.GetTagValue("host"))
+	assert.Equal(t, "1.1.1.1", row.GetTagValue("ip"))
+	assert.Empty(t, row.GetTagValue("zone"))
+	// group by *
+	row = NewOrderByRow(nil, tag.ConcatTagValues([]string{"host=h1", "ip=1.1.1.1"}), nil)
+	assert.Equal(t, "h1", row.GetTagValue("host"))
+	assert.Equal(t, "1.1.1.1", row.GetTagValue("ip"))
+	assert.Empty(t, row.GetTagValue("zone"))
+}
+
 func TestOrderByRow(t *testing.T) {
 	t.Run("no data", func(t *testing.T) {
 		mockFields := map[string]*collections.FloatArray{
 			"f1": collections.NewFloatArray(10),
 		}
-		row := NewOrderByRow("tags", mockFields)
+		row := NewOrderByRow(nil, "tags", mockFields)
 		tags, fields := row.ResultSet()
 		assert.Equal(t, "tags", tags)
 		assert.Equal(t, mockFields, fields)
@@ -62,7 +75,7 @@ func TestOrderByRow(t *testing.T) {
 		mockFields = map[string]*collections.FloatArray{
 			"f1": nil,
 		}
-		row = NewOrderByRow("tags", mockFields)
+		row = NewOrderByRow(nil, "tags", mockFields)
 		assert.Zero(t, row.GetValue("f1", function.Min))
 	})
 
@@ -74,7 +87,7 @@ func TestOrderByRow(t *testing.T) {
 		mockFields := map[string]*collections.FloatArray{
 			"f1": values,
 		}
-		row := NewOrderByRow("tags", mockFields)
+		row := NewOrderByRow(nil, "tags", mockFields)
 		tags, fields := row.ResultSet()
 		assert.Equal(t, "tags", tags)
 		assert.Equal(t, mockFields, fields)
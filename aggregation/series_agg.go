@@ -100,6 +100,9 @@ func NewMergeSeriesAggregator(
 	queryTimeRange timeutil.TimeRange,
 	aggSpec AggregatorSpec,
 ) SeriesAggregator {
+	if aggSpec.GetFieldType() == field.SketchField {
+		return newSketchAggregator(aggSpec.FieldName())
+	}
 	calc := queryInterval.Calculator()
 	startTime := calc.CalcFamilyTime(queryTimeRange.Start)
 
@@ -124,6 +127,9 @@ func NewSeriesAggregator(
 	queryTimeRange timeutil.TimeRange,
 	aggSpec AggregatorSpec,
 ) SeriesAggregator {
+	if aggSpec.GetFieldType() == field.SketchField {
+		return newSketchAggregator(aggSpec.FieldName())
+	}
 	calc := queryInterval.Calculator()
 
 	return &seriesAggregator{
@@ -0,0 +1,177 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lindb/lindb/pkg/ddsketch"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+)
+
+// SketchAggregator represents the series aggregator of histogram sketch field,
+// which merges the sketches of each time slot, time slot is the index based on query time range.
+type SketchAggregator interface {
+	SeriesAggregator
+	// AddSketch merges the sketch into the sketch of time slot.
+	AddSketch(slot int, sketch *ddsketch.Sketch)
+	// Merge merges the sketches of series iterator(sketch field).
+	Merge(it series.Iterator) error
+}
+
+// sketchAggregator implements SketchAggregator interface.
+type sketchAggregator struct {
+	fieldName field.Name
+	sketches  *ddsketch.SlotSketches
+	mutex     sync.Mutex
+}
+
+// newSketchAggregator creates a sketch aggregator.
+func newSketchAggregator(fieldName field.Name) SketchAggregator {
+	return &sketchAggregator{
+		fieldName: fieldName,
+		sketches:  ddsketch.NewSlotSketches(),
+	}
+}
+
+// FieldName returns field name.
+func (a *sketchAggregator) FieldName() field.Name {
+	return a.fieldName
+}
+
+// GetFieldType returns the field type.
+func (a *sketchAggregator) GetFieldType() field.Type {
+	return field.SketchField
+}
+
+// GetAggregator returns nil, sketch field has no float value, sketches are merged by AddSketch/Merge.
+func (a *sketchAggregator) GetAggregator(_ int64) FieldAggregator {
+	return nil
+}
+
+// getAggregator returns nil, sketch field has no float value.
+func (a *sketchAggregator) getAggregator(_ int64) FieldAggregator {
+	return nil
+}
+
+// GetAggregates returns nil, sketch field has no float value.
+func (a *sketchAggregator) GetAggregates() []FieldAggregator {
+	return nil
+}
+
+// AddSketch merges the sketch into the sketch of time slot.
+func (a *sketchAggregator) AddSketch(slot int, sketch *ddsketch.Sketch) {
+	a.mutex.Lock()
+	a.sketches.Merge(slot, sketch)
+	a.mutex.Unlock()
+}
+
+// Merge merges the sketches of series iterator(sketch field).
+func (a *sketchAggregator) Merge(it series.Iterator) error {
+	if it.FieldType() != field.SketchField {
+		return fmt.Errorf("cannot merge field type[%s] into sketch field", it.FieldType())
+	}
+	data, err := it.MarshalBinary()
+	if err != nil || len(data) <= 1 {
+		return err
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	// skip field type
+	return a.sketches.UnmarshalBinary(data[1:])
+}
+
+// ResultSet returns the result set of sketch aggregator.
+func (a *sketchAggregator) ResultSet() series.Iterator {
+	return &sketchIterator{fieldName: a.fieldName, sketches: a.sketches}
+}
+
+// Reset resets the aggregator's context for reusing.
+func (a *sketchAggregator) Reset() {
+	a.sketches.Reset()
+}
+
+// sketchIterator implements series.Iterator interface, iterates the sketches of sketch field.
+type sketchIterator struct {
+	fieldName field.Name
+	sketches  *ddsketch.SlotSketches
+}
+
+// FieldName returns the field name.
+func (it *sketchIterator) FieldName() field.Name {
+	return it.fieldName
+}
+
+// FieldType returns the field type.
+func (it *sketchIterator) FieldType() field.Type {
+	return field.SketchField
+}
+
+// HasNext returns false, sketch field has no float value.
+func (it *sketchIterator) HasNext() bool {
+	return false
+}
+
+// Next returns nil, sketch field has no float value.
+func (it *sketchIterator) Next() (startTime int64, fieldIt series.FieldIterator) {
+	return 0, nil
+}
+
+// MarshalBinary marshals the sketches, format: field type + sketches of time slots,
+// returns empty data if no sketch.
+func (it *sketchIterator) MarshalBinary() ([]byte, error) {
+	if it.sketches.Len() == 0 {
+		return nil, nil
+	}
+	data, err := it.sketches.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{byte(field.SketchField)}, data...), nil
+}
+
+// UnmarshalSketches unmarshals the sketches of series iterator(sketch field).
+func UnmarshalSketches(it series.Iterator) (*ddsketch.SlotSketches, error) {
+	agg := newSketchAggregator(it.FieldName())
+	if err := agg.Merge(it); err != nil {
+		return nil, err
+	}
+	return agg.(*sketchAggregator).sketches, nil
+}
+
+// DownSamplingSketches merges the sketches from source time range => target time range,
+// same as DownSampling, but emits the sketch of time slot.
+func DownSamplingSketches(
+	source, target timeutil.SlotRange, ratio uint16, baseSlot int, sketches *ddsketch.SlotSketches,
+	emitSketch func(targetPos int, sketch *ddsketch.Sketch),
+) {
+	intervalRatio := int(ratio)
+	for _, slot := range sketches.Slots() {
+		if slot < int(source.Start) || slot < int(target.Start) {
+			continue
+		}
+		if slot > int(source.End) || slot > int(target.End) {
+			break
+		}
+		sketch, _ := sketches.Lookup(slot)
+		emitSketch((baseSlot+slot)/intervalRatio, sketch)
+	}
+}
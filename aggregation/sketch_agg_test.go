@@ -0,0 +1,94 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/ddsketch"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+)
+
+func TestSketchAggregator(t *testing.T) {
+	agg := newSketchAggregator("HistogramSketch")
+	assert.Equal(t, field.Name("HistogramSketch"), agg.FieldName())
+	assert.Equal(t, field.SketchField, agg.GetFieldType())
+	assert.Nil(t, agg.GetAggregator(10))
+	assert.Nil(t, agg.(*sketchAggregator).getAggregator(10))
+	assert.Nil(t, agg.GetAggregates())
+
+	// empty result
+	rs := agg.ResultSet()
+	assert.Equal(t, field.SketchField, rs.FieldType())
+	assert.False(t, rs.HasNext())
+	startTime, fIt := rs.Next()
+	assert.Zero(t, startTime)
+	assert.Nil(t, fIt)
+	data, err := rs.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+
+	sketch := ddsketch.NewSketch()
+	sketch.Add(10, 10)
+	agg.AddSketch(1, sketch)
+	agg.AddSketch(3, sketch)
+	data, err = agg.ResultSet().MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(field.SketchField), data[0])
+
+	// merge other series
+	agg2 := newSketchAggregator("HistogramSketch")
+	assert.NoError(t, agg2.Merge(series.NewIterator("HistogramSketch", data)))
+	assert.NoError(t, agg2.Merge(series.NewIterator("HistogramSketch", data)))
+	sketches, err := UnmarshalSketches(agg2.ResultSet())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, sketches.Slots())
+	s, _ := sketches.Lookup(3)
+	assert.Equal(t, 20.0, s.Count())
+
+	// field type not match
+	assert.Error(t, agg2.Merge(series.NewIterator("f", []byte{byte(field.SumField)})))
+	_, err = UnmarshalSketches(series.NewIterator("f", []byte{byte(field.SumField)}))
+	assert.Error(t, err)
+	// invalid data
+	assert.Error(t, agg2.Merge(series.NewIterator("HistogramSketch", []byte{byte(field.SketchField), 1})))
+
+	agg2.Reset()
+	data, err = agg2.ResultSet().MarshalBinary()
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+}
+
+func TestDownSamplingSketches(t *testing.T) {
+	sketches := ddsketch.NewSlotSketches()
+	sketch := ddsketch.NewSketch()
+	sketch.Add(10, 1)
+	for _, slot := range []int{1, 5, 10, 15, 20, 30} {
+		sketches.Merge(slot, sketch)
+	}
+	result := make(map[int]float64)
+	DownSamplingSketches(timeutil.SlotRange{Start: 5, End: 20}, timeutil.SlotRange{Start: 0, End: 15}, 10, 0, sketches,
+		func(targetPos int, sketch *ddsketch.Sketch) {
+			result[targetPos] += sketch.Count()
+		})
+	assert.Equal(t, map[int]float64{0: 1, 1: 2}, result)
+}
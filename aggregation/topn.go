@@ -19,6 +19,8 @@ package aggregation
 
 import (
 	"container/heap"
+	"sort"
+	"strings"
 
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/collections"
@@ -33,12 +35,15 @@ type OrderByItem struct {
 	Name     string
 	FuncType function.FuncType
 	Desc     bool
+	Tag      bool // order by the tag value of group, Name is the tag key
 }
 
 // Row represents the series data for one group.
 type Row interface {
 	// GetValue returns the value based on given field name and function type.
 	GetValue(fieldName string, funcType function.FuncType) float64
+	// GetTagValue returns the tag value of group based on given tag key.
+	GetTagValue(tagKey string) string
 	// ResultSet returns the result set(tags/fields).
 	ResultSet() (tags string, fields map[string]*collections.FloatArray)
 }
@@ -70,21 +75,9 @@ func (h *topNHeap) Swap(i, j int) {
 	h.rows[i], h.rows[j] = h.rows[j], h.rows[i]
 }
 
-// Less compares the value of row based on order by items.
+// Less compares the value of row based on order by items, the row which should be evicted first is less.
 func (h *topNHeap) Less(i, j int) bool {
-	for _, by := range h.orderByItems {
-		ret := h.rows[i].GetValue(by.Name, by.FuncType) - h.rows[j].GetValue(by.Name, by.FuncType)
-		if by.Desc {
-			ret = -ret
-		}
-		if ret > 0 {
-			return true
-		} else if ret < 0 {
-			return false
-		}
-		// if equals goto next order by item
-	}
-	return false
+	return compareRows(h.orderByItems, h.rows[i], h.rows[j]) > 0
 }
 
 // Push pushes row into topN heap.
@@ -112,7 +105,41 @@ func (h *topNHeap) Add(row Row) {
 	}
 }
 
-// ResultSet returns result set of topN.
+// ResultSet returns result set of topN sorted by order by items.
+// NOTICE: heap cannot be used after sorting result set.
 func (h *topNHeap) ResultSet() []Row {
+	sort.Slice(h.rows, func(i, j int) bool {
+		return compareRows(h.orderByItems, h.rows[i], h.rows[j]) < 0
+	})
 	return h.rows
 }
+
+// compareRows compares two rows based on order by items, returns negative if row a is in front of row b.
+// if all order by items are equal, compares tags of group, which keeps the order stable no matter
+// what the arrival order of merged results is.
+func compareRows(orderByItems []*OrderByItem, a, b Row) int {
+	for _, by := range orderByItems {
+		ret := 0
+		if by.Tag {
+			ret = strings.Compare(a.GetTagValue(by.Name), b.GetTagValue(by.Name))
+		} else {
+			v1, v2 := a.GetValue(by.Name, by.FuncType), b.GetValue(by.Name, by.FuncType)
+			switch {
+			case v1 > v2:
+				ret = 1
+			case v1 < v2:
+				ret = -1
+			}
+		}
+		if by.Desc {
+			ret = -ret
+		}
+		if ret != 0 {
+			return ret
+		}
+		// if equals goto next order by item
+	}
+	tags1, _ := a.ResultSet()
+	tags2, _ := b.ResultSet()
+	return strings.Compare(tags1, tags2)
+}
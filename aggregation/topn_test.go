@@ -28,15 +28,24 @@ import (
 )
 
 type row struct {
-	v float64
+	tags string
+	v    float64
 }
 
 func newRow(v float64) Row {
 	return &row{v: v}
 }
 
-func (*row) ResultSet() (tags string, fields map[string]*collections.FloatArray) {
-	return "", nil
+func newTagRow(tags string, v float64) Row {
+	return &row{tags: tags, v: v}
+}
+
+func (r *row) ResultSet() (tags string, fields map[string]*collections.FloatArray) {
+	return r.tags, nil
+}
+
+func (r *row) GetTagValue(_ string) string {
+	return r.tags
 }
 
 func (r *row) GetValue(_ string, _ function.FuncType) float64 {
@@ -72,3 +81,30 @@ func TestTopN(t *testing.T) {
 
 	assert.Nil(t, topNAsc.Pop())
 }
+
+func TestTopN_Order(t *testing.T) {
+	resultSet := func(h *topNHeap) (rs []string) {
+		for _, r := range h.ResultSet() {
+			tags, _ := r.ResultSet()
+			rs = append(rs, tags)
+		}
+		return
+	}
+	// same value, keeps stable order by tags no matter the arrival order
+	h1 := newTopNHeap([]*OrderByItem{{Desc: true}}, 3)
+	h2 := newTopNHeap([]*OrderByItem{{Desc: true}}, 3)
+	rows := []Row{newTagRow("d", 1), newTagRow("b", 2), newTagRow("c", 2), newTagRow("a", 2), newTagRow("e", 3)}
+	for i := range rows {
+		h1.Add(rows[i])
+		h2.Add(rows[len(rows)-1-i])
+	}
+	assert.Equal(t, []string{"e", "a", "b"}, resultSet(h1))
+	assert.Equal(t, []string{"e", "a", "b"}, resultSet(h2))
+
+	// order by tag value desc, then value
+	h := newTopNHeap([]*OrderByItem{{Tag: true, Desc: true}, {}}, 3)
+	for _, r := range []Row{newTagRow("a", 1), newTagRow("c", 2), newTagRow("b", 3), newTagRow("d", 0)} {
+		h.Add(r)
+	}
+	assert.Equal(t, []string{"d", "c", "b"}, resultSet(h))
+}
@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/app/broker/api/exec"
+	"github.com/lindb/lindb/app/broker/api/ingest"
+	httppkg "github.com/lindb/lindb/pkg/http"
+)
+
+// drainCheckInterval represents the interval of checking if all inflight requests completed.
+const drainCheckInterval = 100 * time.Millisecond
+
+// DrainFilter represents the filter which tracks inflight query/write requests,
+// rejects new query/write requests when broker is draining.
+type DrainFilter struct {
+	draining atomic.Bool
+	inflight atomic.Int32
+}
+
+// NewDrainFilter creates a DrainFilter instance.
+func NewDrainFilter() *DrainFilter {
+	return &DrainFilter{}
+}
+
+// Handle returns the middleware which tracks/rejects query/write requests.
+func (f *DrainFilter) Handle() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !isQueryOrWrite(c.FullPath()) {
+			c.Next()
+			return
+		}
+		if f.draining.Load() {
+			httppkg.ServiceUnavailable(c, "broker is draining, reject new request")
+			c.Abort()
+			return
+		}
+		f.inflight.Inc()
+		defer f.inflight.Dec()
+
+		c.Next()
+	}
+}
+
+// Inflight returns the number of inflight query/write requests.
+func (f *DrainFilter) Inflight() int32 {
+	return f.inflight.Load()
+}
+
+// Drain rejects new query/write requests, then waits inflight requests completed,
+// returns false if there are inflight requests after timeout.
+func (f *DrainFilter) Drain(timeout time.Duration) bool {
+	f.draining.Store(true)
+
+	deadline := time.Now().Add(timeout)
+	for f.inflight.Load() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(drainCheckInterval)
+	}
+	return true
+}
+
+// isQueryOrWrite checks if the path of request is query/write path.
+func isQueryOrWrite(path string) bool {
+	return strings.HasSuffix(path, exec.ExecutePath) ||
+		strings.HasSuffix(path, ingest.WritePath) ||
+		strings.HasSuffix(path, ingest.ValidatePath)
+}
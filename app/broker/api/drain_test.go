@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/api/exec"
+	"github.com/lindb/lindb/internal/mock"
+)
+
+func TestDrainFilter(t *testing.T) {
+	f := NewDrainFilter()
+	r := gin.New()
+	r.Use(f.Handle())
+	release := make(chan struct{})
+	r.GET(exec.ExecutePath, func(c *gin.Context) {
+		<-release
+		c.JSON(http.StatusOK, "ok")
+	})
+	r.GET("/state", func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+
+	done := make(chan int)
+	go func() {
+		resp := mock.DoRequest(t, r, http.MethodGet, exec.ExecutePath, "")
+		done <- resp.Code
+	}()
+	assert.Eventually(t, func() bool {
+		return f.Inflight() == 1
+	}, time.Second, time.Millisecond)
+
+	// inflight request not completed
+	assert.False(t, f.Drain(10*time.Millisecond))
+	// reject new query request when draining
+	resp := mock.DoRequest(t, r, http.MethodGet, exec.ExecutePath, "")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	// other request not rejected
+	resp = mock.DoRequest(t, r, http.MethodGet, "/state", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done)
+	assert.True(t, f.Drain(time.Second))
+	assert.Zero(t, f.Inflight())
+}
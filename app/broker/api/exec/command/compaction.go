@@ -0,0 +1,152 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var compactionCli = client.NewCompactionCli()
+
+// CompactionCommand executes the compaction statement, shows compaction backlog/jobs, pauses/resumes background compaction
+// of storage nodes, only executes on storage cluster which database belongs to if database is set.
+func CompactionCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	compactionStmt := stmt.(*stmtpkg.Compaction)
+	nodes, err := getCompactionNodes(deps, compactionStmt.Database)
+	if err != nil {
+		return nil, err
+	}
+	if compactionStmt.Type == stmtpkg.ShowCompactionJobs {
+		return getCompactionJobs(nodes, compactionStmt.Database), nil
+	}
+	result := make(models.CompactionStates, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		node := nodes[idx]
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			var (
+				state *models.CompactionState
+				err   error
+			)
+			switch compactionStmt.Type {
+			case stmtpkg.ShowCompaction:
+				state, err = compactionCli.FetchCompactionState(&node)
+			default:
+				state, err = compactionCli.SetCompaction(&node, compactionStmt.Database, compactionStmt.Paused)
+			}
+			if err != nil {
+				log.Warn("show/set compaction of storage node failure",
+					logger.String("node", node.Indicator()), logger.Error(err))
+				state = &models.CompactionState{ErrMsg: err.Error()}
+			}
+			state.Node = node.Indicator()
+			if compactionStmt.Database != "" {
+				state.Backlogs = filterCompactionBacklogs(state.Backlogs, compactionStmt.Database)
+			}
+			result[i] = state
+		}()
+	}
+	wait.Wait()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Node < result[j].Node
+	})
+	return result, nil
+}
+
+// getCompactionJobs returns the running/queued compact and rollup jobs from storage nodes.
+func getCompactionJobs(nodes []models.StatefulNode, database string) models.CompactionJobs {
+	result := make([]models.CompactionJobs, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		node := nodes[idx]
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			jobs, err := compactionCli.FetchCompactionJobs(&node, database)
+			if err != nil {
+				log.Warn("fetch compaction jobs from storage node failure",
+					logger.String("node", node.Indicator()), logger.Error(err))
+				jobs = models.CompactionJobs{{ErrMsg: err.Error()}}
+			}
+			for _, job := range jobs {
+				job.Node = node.Indicator()
+			}
+			result[i] = jobs
+		}()
+	}
+	wait.Wait()
+	var rs models.CompactionJobs
+	for _, jobs := range result {
+		rs = append(rs, jobs...)
+	}
+	sort.SliceStable(rs, func(i, j int) bool {
+		return rs[i].Node < rs[j].Node
+	})
+	return rs
+}
+
+// getCompactionNodes returns the live nodes of storage cluster which database belongs to,
+// returns the live nodes of all storage clusters if database is empty.
+func getCompactionNodes(deps *depspkg.HTTPDeps, database string) ([]models.StatefulNode, error) {
+	var storages []*models.StorageState
+	if database != "" {
+		databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(database)
+		if !ok {
+			return nil, constants.ErrDatabaseNotFound
+		}
+		storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+		if !ok {
+			return nil, constants.ErrNoStorageCluster
+		}
+		storages = append(storages, storage)
+	} else {
+		storages = deps.StateMgr.GetStorageList()
+	}
+	var nodes []models.StatefulNode
+	for _, storage := range storages {
+		for id := range storage.LiveNodes {
+			nodes = append(nodes, storage.LiveNodes[id])
+		}
+	}
+	return nodes, nil
+}
+
+// filterCompactionBacklogs returns the compaction backlog of given database.
+func filterCompactionBacklogs(backlogs []*models.CompactionBacklog, database string) []*models.CompactionBacklog {
+	var rs []*models.CompactionBacklog
+	for _, backlog := range backlogs {
+		if backlog.Database == database {
+			rs = append(rs, backlog)
+		}
+	}
+	return rs
+}
@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestCompactionCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockCompactionCli(ctrl)
+	compactionCli = cli
+	defer func() {
+		compactionCli = client.NewCompactionCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err := CompactionCommand(context.TODO(), deps, nil, &stmt.Compaction{Type: stmt.ShowCompaction, Database: "db"})
+	assert.Equal(t, constants.ErrDatabaseNotFound, err)
+	assert.Nil(t, rs)
+	// storage not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetStorage("s").Return(nil, false)
+	rs, err = CompactionCommand(context.TODO(), deps, nil, &stmt.Compaction{Type: stmt.ShowCompaction, Database: "db"})
+	assert.Equal(t, constants.ErrNoStorageCluster, err)
+	assert.Nil(t, rs)
+
+	// show compaction of all storages
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{storage})
+	cli.EXPECT().FetchCompactionState(gomock.Any()).DoAndReturn(func(node models.Node) (*models.CompactionState, error) {
+		if node.Indicator() == "1.1.1.1:2892" {
+			return &models.CompactionState{Paused: true}, nil
+		}
+		return nil, fmt.Errorf("err")
+	}).Times(2)
+	rs, err = CompactionCommand(context.TODO(), deps, nil, &stmt.Compaction{Type: stmt.ShowCompaction})
+	assert.NoError(t, err)
+	assert.Equal(t, models.CompactionStates{
+		{Node: "1.1.1.1:2892", Paused: true},
+		{Node: "1.1.1.2:2892", ErrMsg: "err"},
+	}, rs)
+	// pause compaction of database
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+	cli.EXPECT().SetCompaction(gomock.Any(), "db", true).Return(&models.CompactionState{
+		PausedDatabases: []string{"db"},
+		Backlogs: []*models.CompactionBacklog{
+			{Database: "db", Paused: true, Stores: 2},
+			{Database: "db2", Stores: 1},
+		},
+	}, nil).Times(2)
+	rs, err = CompactionCommand(context.TODO(), deps, nil, &stmt.Compaction{Type: stmt.SetCompaction, Database: "db", Paused: true})
+	assert.NoError(t, err)
+	states := rs.(models.CompactionStates)
+	assert.Len(t, states, 2)
+	assert.Equal(t, []*models.CompactionBacklog{{Database: "db", Paused: true, Stores: 2}}, states[0].Backlogs)
+}
+
+func TestCompactionCommand_ShowJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockCompactionCli(ctrl)
+	compactionCli = cli
+	defer func() {
+		compactionCli = client.NewCompactionCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+	cli.EXPECT().FetchCompactionJobs(gomock.Any(), "db").DoAndReturn(func(node models.Node, _ string) (models.CompactionJobs, error) {
+		if node.Indicator() == "1.1.1.1:2892" {
+			return models.CompactionJobs{{Database: "db", Family: "f", Status: "running"}}, nil
+		}
+		return nil, fmt.Errorf("err")
+	}).Times(2)
+	rs, err := CompactionCommand(context.TODO(), deps, nil, &stmt.Compaction{Type: stmt.ShowCompactionJobs, Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, models.CompactionJobs{
+		{Node: "1.1.1.1:2892", Database: "db", Family: "f", Status: "running"},
+		{Node: "1.1.1.2:2892", ErrMsg: "err"},
+	}, rs)
+}
@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// FieldUsageCommand executes the show field usage statement, merges the query-time access counts of metric's fields
+// from live nodes of storage cluster which database belongs to, the least queried fields are listed first.
+func FieldUsageCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	db := strings.TrimSpace(param.Database)
+	if db == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	usageStmt := stmt.(*stmtpkg.FieldUsage)
+	storage, _, err := getDatabaseTopology(deps, db)
+	if err != nil {
+		return nil, err
+	}
+	var since int64
+	if usageStmt.Since > 0 {
+		since = timeutil.Now() - usageStmt.Since
+	}
+	var nodes []models.StatefulNode
+	for id := range storage.LiveNodes {
+		nodes = append(nodes, storage.LiveNodes[id])
+	}
+	result := make([]models.FieldUsages, len(nodes))
+	errs := make([]error, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			result[i], errs[i] = topologyCli.FetchFieldUsage(&node, db, usageStmt.Namespace, usageStmt.MetricName, since)
+			if errs[i] != nil {
+				log.Warn("fetch field usage from storage node failure",
+					logger.String("database", db), logger.String("node", node.Indicator()), logger.Error(errs[i]))
+			}
+		}()
+	}
+	wait.Wait()
+	var rs models.FieldUsages
+	fetched := false
+	for i := range result {
+		if errs[i] != nil {
+			err = errs[i]
+			continue
+		}
+		fetched = true
+		rs = rs.Merge(result[i])
+	}
+	if !fetched && err != nil {
+		return nil, err
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Count != rs[j].Count {
+			return rs[i].Count < rs[j].Count
+		}
+		return rs[i].Field < rs[j].Field
+	})
+	return rs, nil
+}
@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestFieldUsageCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockTopologyCli(ctrl)
+	topologyCli = cli
+	defer func() {
+		topologyCli = client.NewTopologyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+	storage.ShardStates["db"] = map[models.ShardID]models.ShardState{1: {ID: 1}}
+	usageStmt := &stmt.FieldUsage{Namespace: "ns", MetricName: "cpu", Since: 10}
+
+	// database name required
+	rs, err := FieldUsageCommand(context.TODO(), deps, &models.ExecuteParam{}, usageStmt)
+	assert.Equal(t, constants.ErrDatabaseNameRequired, err)
+	assert.Nil(t, rs)
+	// database not found
+	param := &models.ExecuteParam{Database: "db"}
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err = FieldUsageCommand(context.TODO(), deps, param, usageStmt)
+	assert.Equal(t, constants.ErrDatabaseNotFound, err)
+	assert.Nil(t, rs)
+
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true).AnyTimes()
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true).AnyTimes()
+	// fetch from all nodes failure
+	cli.EXPECT().FetchFieldUsage(gomock.Any(), "db", "ns", "cpu", gomock.Any()).Return(nil, fmt.Errorf("err")).Times(2)
+	rs, err = FieldUsageCommand(context.TODO(), deps, param, usageStmt)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// merge field usage of nodes
+	cli.EXPECT().FetchFieldUsage(gomock.Any(), "db", "ns", "cpu", gomock.Any()).Return(models.FieldUsages{
+		{Namespace: "ns", Metric: "cpu", Field: "f1", Count: 2},
+		{Namespace: "ns", Metric: "cpu", Field: "f2"},
+	}, nil)
+	cli.EXPECT().FetchFieldUsage(gomock.Any(), "db", "ns", "cpu", gomock.Any()).Return(nil, fmt.Errorf("err"))
+	rs, err = FieldUsageCommand(context.TODO(), deps, param, usageStmt)
+	assert.NoError(t, err)
+	usages := rs.(models.FieldUsages)
+	assert.Len(t, usages, 2)
+	assert.Equal(t, "f2", usages[0].Field)
+	assert.Equal(t, "f1", usages[1].Field)
+}
@@ -19,19 +19,24 @@ package command
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/BurntSushi/toml"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
 var (
 	tomlDecodeFn = toml.Decode
+	limitsCli    = client.NewLimitsCli()
 )
 
 // LimitCommand executes database limit statement.
@@ -46,6 +51,8 @@ func LimitCommand(ctx context.Context, deps *depspkg.HTTPDeps, param *models.Exe
 		return setLimit(ctx, db, deps, limitStmt)
 	case stmtpkg.ShowLimit:
 		return showLimit(ctx, db, deps)
+	case stmtpkg.ShowLimitStatus:
+		return showLimitStatus(ctx, db, deps)
 	}
 	return nil, nil
 }
@@ -64,6 +71,66 @@ func showLimit(ctx context.Context, db string, deps *depspkg.HTTPDeps) (interfac
 	return &limit, nil
 }
 
+// showLimitStatus returns the state of database's limits applied on broker nodes and storage nodes of database,
+// the node applied latest limits if the revision of applied limits equals the revision of limits config.
+func showLimitStatus(ctx context.Context, db string, deps *depspkg.HTTPDeps) (interface{}, error) {
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(db)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	data, err := deps.Repo.Get(ctx, constants.GetDatabaseLimitPath(db))
+	if err != nil && err != state.ErrNotExist {
+		return nil, err
+	}
+	revision := models.LimitsRevision(data)
+
+	var (
+		nodes []models.Node
+		roles []string
+	)
+	brokers := deps.StateMgr.GetLiveNodes()
+	for idx := range brokers {
+		nodes = append(nodes, &brokers[idx])
+		roles = append(roles, constants.BrokerRole)
+	}
+	if storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage); ok {
+		for id := range storage.LiveNodes {
+			node := storage.LiveNodes[id]
+			nodes = append(nodes, &node)
+			roles = append(roles, constants.StorageRole)
+		}
+	}
+	result := make(models.LimitsStates, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			limitsState, err := limitsCli.FetchLimitsState(node, db)
+			if err != nil {
+				log.Warn("fetch limits state of node failure",
+					logger.String("database", db), logger.String("node", node.Indicator()), logger.Error(err))
+				limitsState = &models.LimitsState{ErrMsg: err.Error()}
+			} else {
+				limitsState.Applied = limitsState.Revision == revision
+			}
+			limitsState.Node = node.Indicator()
+			limitsState.Role = roles[i]
+			result[i] = limitsState
+		}()
+	}
+	wait.Wait()
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Role != result[j].Role {
+			return result[i].Role < result[j].Role
+		}
+		return result[i].Node < result[j].Node
+	})
+	return result, nil
+}
+
 // setLimit set the database's limits.
 func setLimit(ctx context.Context, db string, deps *depspkg.HTTPDeps, stmt *stmtpkg.Limit) (interface{}, error) {
 	data := []byte(stmt.Limit)
@@ -73,6 +140,9 @@ func setLimit(ctx context.Context, db string, deps *depspkg.HTTPDeps, stmt *stmt
 	if err != nil {
 		return nil, err
 	}
+	if err := limits.Validate(); err != nil {
+		return nil, err
+	}
 	if err := deps.Repo.Put(ctx, constants.GetDatabaseLimitPath(db), data); err != nil {
 		return nil, err
 	}
@@ -24,8 +24,12 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/sql/stmt"
@@ -66,6 +70,18 @@ func TestLimit(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:      "invalid sanitize rules",
+			db:        "test",
+			statement: &stmt.Limit{Limit: "[sanitize]\ncase-folding = \"title\"", Type: stmt.SetLimit},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid metrics limit",
+			db:        "test",
+			statement: &stmt.Limit{Limit: "[metrics]\n\"ns|*.cpu\" = 10", Type: stmt.SetLimit},
+			wantErr:   true,
+		},
 		{
 			name:      "save limit failure",
 			db:        "test",
@@ -132,3 +148,60 @@ func TestLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestLimit_ShowLimitStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockLimitsCli(ctrl)
+	limitsCli = cli
+	defer func() {
+		limitsCli = client.NewLimitsCli()
+		ctrl.Finish()
+	}()
+
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{Repo: repo, StateMgr: stateMgr}
+	statement := &stmt.Limit{Type: stmt.ShowLimitStatus}
+	param := &models.ExecuteParam{Database: "db"}
+
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err := LimitCommand(context.TODO(), deps, param, statement)
+	assert.Equal(t, constants.ErrDatabaseNotFound, err)
+	assert.Nil(t, rs)
+
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true).AnyTimes()
+	// get limits failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	rs, err = LimitCommand(context.TODO(), deps, param, statement)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true).AnyTimes()
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "1.1.1.3", HTTPPort: 9000}}).AnyTimes()
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("test"), nil)
+	cli.EXPECT().FetchLimitsState(gomock.Any(), "db").DoAndReturn(func(node models.Node, database string) (*models.LimitsState, error) {
+		switch node.Indicator() {
+		case "1.1.1.1:2892":
+			return &models.LimitsState{Revision: models.LimitsRevision([]byte("test"))}, nil
+		case "1.1.1.2:2892":
+			return &models.LimitsState{}, nil
+		default:
+			return nil, fmt.Errorf("err")
+		}
+	}).Times(3)
+	rs, err = LimitCommand(context.TODO(), deps, param, statement)
+	assert.NoError(t, err)
+	states := rs.(models.LimitsStates)
+	assert.Len(t, states, 3)
+	assert.Equal(t, constants.BrokerRole, states[0].Role)
+	assert.Equal(t, "err", states[0].ErrMsg)
+	assert.False(t, states[0].Applied)
+	assert.Equal(t, "1.1.1.1:2892", states[1].Node)
+	assert.True(t, states[1].Applied)
+	assert.Equal(t, "1.1.1.2:2892", states[2].Node)
+	assert.False(t, states[2].Applied)
+}
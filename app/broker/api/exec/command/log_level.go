@@ -0,0 +1,42 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// LogLevelCommand executes log level statement, changes/shows the log level of logger modules in current node.
+func LogLevelCommand(_ context.Context, _ *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	logLevelStmt := stmt.(*stmtpkg.LogLevel)
+	switch logLevelStmt.Type {
+	case stmtpkg.SetLogLevel:
+		if err := logger.SetModuleLevels(logLevelStmt.Levels); err != nil {
+			return nil, err
+		}
+		return logger.GetModuleLevels(), nil
+	case stmtpkg.ShowLogLevels:
+		return logger.GetModuleLevels(), nil
+	}
+	return nil, nil
+}
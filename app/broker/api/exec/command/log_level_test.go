@@ -0,0 +1,50 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestLogLevel(t *testing.T) {
+	_ = logger.GetLogger("LevelCommand", "Test")
+	defer func() {
+		_ = logger.SetModuleLevel("LevelCommand", logger.DefaultLevel)
+	}()
+
+	rs, err := LogLevelCommand(context.TODO(), nil, nil, &stmt.LogLevel{})
+	assert.NoError(t, err)
+	assert.Nil(t, rs)
+	rs, err = LogLevelCommand(context.TODO(), nil, nil, &stmt.LogLevel{Type: stmt.ShowLogLevels})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rs)
+	rs, err = LogLevelCommand(context.TODO(), nil, nil,
+		&stmt.LogLevel{Type: stmt.SetLogLevel, Levels: map[string]string{"LevelCommand": "debug"}})
+	assert.NoError(t, err)
+	assert.Contains(t, rs, logger.ModuleLevel{Module: "LevelCommand", Level: "debug", Overridden: true})
+	rs, err = LogLevelCommand(context.TODO(), nil, nil,
+		&stmt.LogLevel{Type: stmt.SetLogLevel, Levels: map[string]string{"LevelCommand": "abc"}})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
@@ -0,0 +1,117 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/master"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// PlacementCommand executes the replica placement statement of database.
+func PlacementCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	placementStmt := stmt.(*stmtpkg.Placement)
+	switch placementStmt.Type {
+	case stmtpkg.ShowPlacement:
+		return getPlacementViolations(deps, placementStmt.Database)
+	case stmtpkg.RepairPlacement:
+		return repairPlacement(deps, placementStmt.Database)
+	case stmtpkg.ShowPlacementSuggestions:
+		return getPlacementSuggestions(deps, placementStmt.Database)
+	}
+	return nil, nil
+}
+
+// getPlacementViolations returns the replicas of database's shards which are placed in the same failure domain.
+func getPlacementViolations(deps *depspkg.HTTPDeps, databaseName string) (interface{}, error) {
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(databaseName)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	liveNodes := make([]models.StatefulNode, 0, len(storage.LiveNodes))
+	for id := range storage.LiveNodes {
+		liveNodes = append(liveNodes, storage.LiveNodes[id])
+	}
+	violations := models.CheckPlacement(storage.ShardAssignments[databaseName],
+		models.GetFailureDomains(liveNodes, databaseCfg.GetFailureDomain()))
+	if len(violations) == 0 {
+		return nil, nil
+	}
+	return violations, nil
+}
+
+// repairPlacement moves the replicas which violate failure domain placement by master.
+func repairPlacement(deps *depspkg.HTTPDeps, databaseName string) (interface{}, error) {
+	return executePlacementOnMaster(deps, fmt.Sprintf(`repair placement from "%s"`, databaseName),
+		func(stateMgr master.StateManager) (models.PlacementMoves, error) {
+			return stateMgr.RepairPlacement(databaseName)
+		})
+}
+
+// getPlacementSuggestions returns the suggested replica moves for balancing disk usage of storage nodes by master.
+func getPlacementSuggestions(deps *depspkg.HTTPDeps, databaseName string) (interface{}, error) {
+	return executePlacementOnMaster(deps, fmt.Sprintf(`show placement suggestions from "%s"`, databaseName),
+		func(stateMgr master.StateManager) (models.PlacementMoves, error) {
+			return stateMgr.GetPlacementSuggestions(databaseName, deps.BrokerCfg.BrokerBase.CapacityImbalanceThreshold)
+		})
+}
+
+// executePlacementOnMaster executes the placement statement which needs master's state manager,
+// forwards the statement to master if current node isn't master.
+func executePlacementOnMaster(deps *depspkg.HTTPDeps, sql string,
+	fn func(stateMgr master.StateManager) (models.PlacementMoves, error)) (interface{}, error) {
+	if deps.Master.IsMaster() {
+		// if current node is master, execute directly.
+		moves, err := fn(deps.Master.GetStateManager())
+		if err != nil || len(moves) == 0 {
+			return nil, err
+		}
+		return moves, nil
+	}
+	// if current node is not master, forward to master
+	address := deps.Master.GetMaster().Node.HTTPAddress()
+	var moves models.PlacementMoves
+	resp, err := resty.New().R().
+		SetBody(&models.ExecuteParam{SQL: sql}).
+		SetHeader("Accept", "application/json").
+		SetResult(&moves).
+		Put(address + constants.APIVersion1CliPath + "/exec")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+		return nil, fmt.Errorf("master handle placement statement error: %s", resp.String())
+	}
+	if len(moves) == 0 {
+		return nil, nil
+	}
+	return moves, nil
+}
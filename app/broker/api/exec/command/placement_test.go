@@ -0,0 +1,217 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator"
+	"github.com/lindb/lindb/coordinator/broker"
+	masterpkg "github.com/lindb/lindb/coordinator/master"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestPlacement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	master := coordinator.NewMockMasterController(ctrl)
+	masterStateMgr := masterpkg.NewMockStateManager(ctrl)
+	master.EXPECT().GetStateManager().Return(masterStateMgr).AnyTimes()
+	deps := &depspkg.HTTPDeps{
+		StateMgr:  stateMgr,
+		Master:    master,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{CapacityImbalanceThreshold: 0.2}},
+	}
+	storage := models.NewStorageState("test")
+	storage.NodeOnline(models.StatefulNode{ID: 1,
+		StatelessNode: models.StatelessNode{Labels: map[string]string{models.NodeLabelZone: "a"}}})
+	storage.NodeOnline(models.StatefulNode{ID: 2,
+		StatelessNode: models.StatelessNode{Labels: map[string]string{models.NodeLabelZone: "a"}}})
+	storage.NodeOnline(models.StatefulNode{ID: 3,
+		StatelessNode: models.StatelessNode{Labels: map[string]string{models.NodeLabelZone: "b"}}})
+	storage.ShardAssignments["db"] = &models.ShardAssignment{
+		Name: "db",
+		Shards: map[models.ShardID]*models.Replica{
+			0: {Replicas: []models.NodeID{1, 3}},
+			1: {Replicas: []models.NodeID{1, 2}},
+		},
+	}
+	moves := models.PlacementMoves{{ShardID: 1, From: 2, To: 3}}
+
+	cases := []struct {
+		name      string
+		statement stmt.Statement
+		prepare   func()
+		wantErr   bool
+		wantRS    interface{}
+	}{
+		{
+			name:      "unknown placement statement type",
+			statement: &stmt.Placement{},
+		},
+		{
+			name:      "show placement, database not found",
+			statement: &stmt.Placement{Type: stmt.ShowPlacement, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show placement, storage not found",
+			statement: &stmt.Placement{Type: stmt.ShowPlacement, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "test"}, true)
+				stateMgr.EXPECT().GetStorage("test").Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show placement, no violation",
+			statement: &stmt.Placement{Type: stmt.ShowPlacement, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "test", FailureDomain: "rack"}, true)
+				stateMgr.EXPECT().GetStorage("test").Return(storage, true)
+			},
+		},
+		{
+			name:      "show placement, found violation",
+			statement: &stmt.Placement{Type: stmt.ShowPlacement, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "test"}, true)
+				stateMgr.EXPECT().GetStorage("test").Return(storage, true)
+			},
+			wantRS: models.PlacementViolations{{ShardID: 1, Domain: "a", Replicas: []models.NodeID{1, 2}}},
+		},
+		{
+			name:      "repair placement by master, failure",
+			statement: &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"},
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(true)
+				masterStateMgr.EXPECT().RepairPlacement("db").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "repair placement by master, successfully",
+			statement: &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"},
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(true)
+				masterStateMgr.EXPECT().RepairPlacement("db").Return(moves, nil)
+			},
+			wantRS: moves,
+		},
+		{
+			name:      "repair placement, forward request failure",
+			statement: &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"},
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(false)
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}})
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show placement suggestions by master, no suggestion",
+			statement: &stmt.Placement{Type: stmt.ShowPlacementSuggestions, Database: "db"},
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(true)
+				masterStateMgr.EXPECT().GetPlacementSuggestions("db", 0.2).Return(nil, nil)
+			},
+		},
+		{
+			name:      "show placement suggestions by master, successfully",
+			statement: &stmt.Placement{Type: stmt.ShowPlacementSuggestions, Database: "db"},
+			prepare: func() {
+				master.EXPECT().IsMaster().Return(true)
+				masterStateMgr.EXPECT().GetPlacementSuggestions("db", 0.2).Return(moves, nil)
+			},
+			wantRS: moves,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			rs, err := PlacementCommand(context.TODO(), deps, nil, tt.statement)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantRS != nil {
+				assert.Equal(t, tt.wantRS, rs)
+			} else {
+				assert.Nil(t, rs)
+			}
+		})
+	}
+}
+
+func TestPlacement_Forward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	master := coordinator.NewMockMasterController(ctrl)
+	deps := &depspkg.HTTPDeps{Master: master}
+	moves := models.PlacementMoves{{ShardID: 1, From: 2, To: 3}}
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write(encoding.JSONMarshal(moves))
+		}
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+	master.EXPECT().IsMaster().Return(false).AnyTimes()
+	master.EXPECT().GetMaster().Return(&models.Master{
+		Node: &models.StatelessNode{HostIP: u.Hostname(), HTTPPort: uint16(port)},
+	}).AnyTimes()
+
+	rs, err := PlacementCommand(context.TODO(), deps, nil, &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"})
+	assert.NoError(t, err)
+	assert.Equal(t, moves, rs)
+	// no moves
+	status = http.StatusNotFound
+	rs, err = PlacementCommand(context.TODO(), deps, nil, &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"})
+	assert.NoError(t, err)
+	assert.Nil(t, rs)
+	// master handle failure
+	status = http.StatusInternalServerError
+	rs, err = PlacementCommand(context.TODO(), deps, nil, &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
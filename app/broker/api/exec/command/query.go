@@ -23,6 +23,7 @@ import (
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/query"
+	queryctx "github.com/lindb/lindb/query/context"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
@@ -34,15 +35,26 @@ var (
 // QueryCommand executes metric query.
 func QueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	var groupBySpill *queryctx.GroupBySpillOptions
+	if queryCfg := deps.BrokerCfg.Query; queryCfg.GroupBySpillEnabled {
+		groupBySpill = &queryctx.GroupBySpillOptions{
+			Dir:        queryCfg.GroupBySpillDir,
+			Threshold:  queryCfg.GroupBySpillThreshold,
+			Partitions: queryCfg.GroupBySpillPartitions,
+		}
+	}
 	return metricDataSearchFn(
 		ctx,
 		param,
 		stmt.(*stmtpkg.Query),
 		&query.SearchMgr{
-			Timeout:      deps.BrokerCfg.Query.Timeout.Duration(),
-			CurNode:      *deps.Node,
-			Choose:       deps.StateMgr,
-			TaskMgr:      deps.TaskMgr,
-			TransportMgr: deps.TransportMgr,
+			Timeout:        deps.BrokerCfg.Query.Timeout.Duration(),
+			HedgeDelay:     deps.BrokerCfg.Query.HedgeDelay.Duration(),
+			SplitThreshold: deps.BrokerCfg.Query.SplitThreshold.Duration(),
+			GroupBySpill:   groupBySpill,
+			CurNode:        *deps.Node,
+			Choose:         deps.StateMgr,
+			TaskMgr:        deps.TaskMgr,
+			TransportMgr:   deps.TransportMgr,
 		})
 }
@@ -0,0 +1,93 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var readOnlyCli = client.NewReadOnlyCli()
+
+// ReadOnlyCommand executes the read-only statement, shows/switches the read-only mode of storage nodes.
+func ReadOnlyCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	readOnlyStmt := stmt.(*stmtpkg.ReadOnly)
+	storageName := readOnlyStmt.Storage
+	if readOnlyStmt.Type == stmtpkg.SetDatabaseReadOnly {
+		databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(readOnlyStmt.Database)
+		if !ok {
+			return nil, constants.ErrDatabaseNotFound
+		}
+		storageName = databaseCfg.Storage
+	}
+	storage, ok := deps.StateMgr.GetStorage(storageName)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	var nodes []models.StatefulNode
+	for id := range storage.LiveNodes {
+		if readOnlyStmt.NodeID == 0 || int(id) == readOnlyStmt.NodeID {
+			nodes = append(nodes, storage.LiveNodes[id])
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("%w, storage: %s, node: %d", constants.ErrTargetNodesNotFound, storageName, readOnlyStmt.NodeID)
+	}
+	result := make(models.ReadOnlyStates, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		node := nodes[idx]
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			var (
+				state *models.ReadOnlyState
+				err   error
+			)
+			switch readOnlyStmt.Type {
+			case stmtpkg.ShowReadOnly:
+				state, err = readOnlyCli.FetchReadOnlyState(&node)
+			default:
+				state, err = readOnlyCli.SetReadOnly(&node, readOnlyStmt.Database, readOnlyStmt.ReadOnly)
+			}
+			if err != nil {
+				log.Warn("show/switch read-only mode of storage node failure",
+					logger.String("storage", storageName), logger.String("node", node.Indicator()), logger.Error(err))
+				state = &models.ReadOnlyState{ErrMsg: err.Error()}
+			}
+			state.Node = node.Indicator()
+			result[i] = state
+		}()
+	}
+	wait.Wait()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Node < result[j].Node
+	})
+	return result, nil
+}
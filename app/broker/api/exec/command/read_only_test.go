@@ -0,0 +1,95 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestReadOnlyCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockReadOnlyCli(ctrl)
+	readOnlyCli = cli
+	defer func() {
+		readOnlyCli = client.NewReadOnlyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err := ReadOnlyCommand(context.TODO(), deps, nil,
+		&stmt.ReadOnly{Type: stmt.SetDatabaseReadOnly, Database: "db", ReadOnly: true})
+	assert.Equal(t, constants.ErrDatabaseNotFound, err)
+	assert.Nil(t, rs)
+	// storage not found
+	stateMgr.EXPECT().GetStorage("s").Return(nil, false)
+	rs, err = ReadOnlyCommand(context.TODO(), deps, nil, &stmt.ReadOnly{Type: stmt.ShowReadOnly, Storage: "s"})
+	assert.Equal(t, constants.ErrNoStorageCluster, err)
+	assert.Nil(t, rs)
+
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true).AnyTimes()
+	// node not alive
+	rs, err = ReadOnlyCommand(context.TODO(), deps, nil,
+		&stmt.ReadOnly{Type: stmt.SetStorageReadOnly, Storage: "s", NodeID: 3, ReadOnly: true})
+	assert.ErrorIs(t, err, constants.ErrTargetNodesNotFound)
+	assert.Nil(t, rs)
+	// show read-only state
+	cli.EXPECT().FetchReadOnlyState(gomock.Any()).DoAndReturn(func(node models.Node) (*models.ReadOnlyState, error) {
+		if node.Indicator() == "1.1.1.1:2892" {
+			return &models.ReadOnlyState{ReadOnly: true}, nil
+		}
+		return nil, fmt.Errorf("err")
+	}).Times(2)
+	rs, err = ReadOnlyCommand(context.TODO(), deps, nil, &stmt.ReadOnly{Type: stmt.ShowReadOnly, Storage: "s"})
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReadOnlyStates{
+		{Node: "1.1.1.1:2892", ReadOnly: true},
+		{Node: "1.1.1.2:2892", ErrMsg: "err"},
+	}, rs)
+	// switch read-only mode of one node
+	cli.EXPECT().SetReadOnly(gomock.Any(), "", true).Return(&models.ReadOnlyState{ReadOnly: true}, nil)
+	rs, err = ReadOnlyCommand(context.TODO(), deps, nil,
+		&stmt.ReadOnly{Type: stmt.SetStorageReadOnly, Storage: "s", NodeID: 2, ReadOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReadOnlyStates{{Node: "1.1.1.2:2892", ReadOnly: true}}, rs)
+	// switch read-only mode of database
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	cli.EXPECT().SetReadOnly(gomock.Any(), "db", true).Return(&models.ReadOnlyState{Databases: []string{"db"}}, nil).Times(2)
+	rs, err = ReadOnlyCommand(context.TODO(), deps, nil,
+		&stmt.ReadOnly{Type: stmt.SetDatabaseReadOnly, Database: "db", ReadOnly: true})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+}
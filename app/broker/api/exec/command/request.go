@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"sort"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/internal/client"
@@ -31,12 +32,42 @@ var (
 )
 
 // RequestCommand executes requests/request related statement.
-func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
 	liveNodes := deps.StateMgr.GetLiveNodes()
 	var nodes []models.Node
 	for idx := range liveNodes {
 		nodes = append(nodes, &liveNodes[idx])
 	}
-	rs := requestCli.FetchRequestsByNodes(nodes)
-	return rs, nil
+	requestStmt, ok := stmt.(*stmtpkg.Request)
+	if !ok || requestStmt.RequestID == "" {
+		rs := requestCli.FetchRequestsByNodes(nodes)
+		return rs, nil
+	}
+	return getRequestDetail(deps, requestStmt.RequestID, nodes), nil
+}
+
+// getRequestDetail returns the request detail with live execution state from broker and storage nodes.
+func getRequestDetail(deps *depspkg.HTTPDeps, requestID string, brokerNodes []models.Node) *models.RequestDetail {
+	detail := &models.RequestDetail{}
+	for _, req := range requestCli.FetchRequestsByNodes(brokerNodes) {
+		if req.RequestID == requestID {
+			detail.Request = req
+			break
+		}
+	}
+	nodes := brokerNodes
+	for _, storage := range deps.StateMgr.GetStorageList() {
+		for id := range storage.LiveNodes {
+			node := storage.LiveNodes[id]
+			nodes = append(nodes, &node)
+		}
+	}
+	detail.Nodes = requestCli.FetchRequestStateByNodes(requestID, nodes)
+	if detail.Request == nil && len(detail.Nodes) == 0 {
+		return nil
+	}
+	sort.Slice(detail.Nodes, func(i, j int) bool {
+		return detail.Nodes[i].Node < detail.Nodes[j].Node
+	})
+	return detail
 }
@@ -54,3 +54,45 @@ func TestRequst(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, rs)
 }
+
+func TestRequestDetail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		requestCli = client.NewRequestCli()
+		ctrl.Finish()
+	}()
+
+	cli := client.NewMockRequestCli(ctrl)
+	requestCli = cli
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{
+		StateMgr: stateMgr,
+	}
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "127.0.0.1", HTTPPort: 3000}}).AnyTimes()
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{{
+		LiveNodes: map[models.NodeID]models.StatefulNode{1: {StatelessNode: models.StatelessNode{HostIP: "127.0.0.2", HTTPPort: 2892}}},
+	}}).AnyTimes()
+
+	t.Run("request not found", func(t *testing.T) {
+		cli.EXPECT().FetchRequestsByNodes(gomock.Any()).Return(nil)
+		cli.EXPECT().FetchRequestStateByNodes("id", gomock.Any()).Return(nil)
+		rs, err := RequestCommand(context.TODO(), deps, nil, &stmt.Request{RequestID: "id"})
+		assert.NoError(t, err)
+		assert.Nil(t, rs)
+	})
+	t.Run("get request detail", func(t *testing.T) {
+		cli.EXPECT().FetchRequestsByNodes(gomock.Any()).Return([]*models.Request{{RequestID: "other"}, {RequestID: "id"}})
+		cli.EXPECT().FetchRequestStateByNodes("id", gomock.Any()).DoAndReturn(
+			func(_ string, nodes []models.Node) []*models.RequestNodeState {
+				assert.Len(t, nodes, 2)
+				return []*models.RequestNodeState{{Node: "127.0.0.2:2892"}, {Node: "127.0.0.1:3000"}}
+			})
+		rs, err := RequestCommand(context.TODO(), deps, nil, &stmt.Request{RequestID: "id"})
+		assert.NoError(t, err)
+		detail := rs.(*models.RequestDetail)
+		assert.Equal(t, "id", detail.Request.RequestID)
+		assert.Equal(t, "127.0.0.1:3000", detail.Nodes[0].Node)
+		assert.Equal(t, "127.0.0.2:2892", detail.Nodes[1].Node)
+	})
+}
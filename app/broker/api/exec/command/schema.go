@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
@@ -37,11 +38,18 @@ func SchemaCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.Execut
 	schemaStmt := stmt.(*stmtpkg.Schema)
 	switch schemaStmt.Type {
 	case stmtpkg.DatabaseSchemaType:
-		return listDataBases(ctx, deps)
+		dbs, err := listDataBases(ctx, deps)
+		if err != nil {
+			return nil, err
+		}
+		fillDatabaseStats(deps, dbs.([]*models.Database))
+		return dbs, nil
 	case stmtpkg.CreateDatabaseSchemaType:
 		return saveDataBase(ctx, deps, schemaStmt)
 	case stmtpkg.DropDatabaseSchemaType:
 		return dropDatabase(ctx, deps, schemaStmt)
+	case stmtpkg.AlterDatabaseSchemaType:
+		return alterDatabase(ctx, deps, schemaStmt)
 	case stmtpkg.DatabaseNameSchemaType:
 		dbs, err := listDataBases(ctx, deps)
 		if err != nil {
@@ -72,6 +80,33 @@ func dropDatabase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	return &rs, nil
 }
 
+// alterDatabase modifies the write state of database config, brokers watch the config and reject writes if disabled.
+func alterDatabase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Schema) (interface{}, error) {
+	databaseName := stmt.Value
+	data, err := deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(databaseName))
+	if errors.Is(err, state.ErrNotExist) {
+		return nil, constants.ErrDatabaseNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	database := &models.Database{}
+	if err := encoding.JSONUnmarshal(data, database); err != nil {
+		return nil, err
+	}
+	database.WriteDisabled = stmt.WriteDisabled
+	log.Info("alter database", logger.String("name", databaseName), logger.Any("writeDisabled", stmt.WriteDisabled))
+	if err := deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(databaseName), encoding.JSONMarshal(database)); err != nil {
+		return nil, err
+	}
+	writeState := "on"
+	if stmt.WriteDisabled {
+		writeState = "off"
+	}
+	rs := fmt.Sprintf("Alter database[%s] write %s ok", databaseName, writeState)
+	return &rs, nil
+}
+
 // listDataBases returns database list in cluster.
 func listDataBases(ctx context.Context, deps *depspkg.HTTPDeps) (interface{}, error) {
 	data, err := deps.Repo.List(ctx, constants.DatabaseConfigPath)
@@ -93,6 +128,60 @@ func listDataBases(ctx context.Context, deps *depspkg.HTTPDeps) (interface{}, er
 	return dbs, nil
 }
 
+// fillDatabaseStats fills the runtime statistics of databases, which are aggregated from
+// the memory database/disk usage/wal replica state of live nodes in storage cluster.
+func fillDatabaseStats(deps *depspkg.HTTPDeps, dbs []*models.Database) {
+	var wait sync.WaitGroup
+	for idx := range dbs {
+		db := dbs[idx]
+		storage, ok := deps.StateMgr.GetStorage(db.Storage)
+		if !ok {
+			continue
+		}
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			var (
+				families []models.DataFamilyState
+				usages   []*models.DatabaseDiskUsage
+				replicas []models.FamilyLogReplicaState
+				mutex    sync.Mutex
+				wait0    sync.WaitGroup
+			)
+			for id := range storage.LiveNodes {
+				node := storage.LiveNodes[id]
+				wait0.Add(1)
+				go func() {
+					defer wait0.Done()
+					family, err := topologyCli.FetchMemoryDatabaseState(&node, db.Name)
+					if err != nil {
+						log.Warn("fetch memory database state from storage node failure",
+							logger.String("database", db.Name), logger.String("node", node.Indicator()), logger.Error(err))
+					}
+					usage, err := topologyCli.FetchDiskUsage(&node, db.Name)
+					if err != nil {
+						log.Warn("fetch disk usage from storage node failure",
+							logger.String("database", db.Name), logger.String("node", node.Indicator()), logger.Error(err))
+					}
+					replica, err := topologyCli.FetchReplicaState(&node, db.Name)
+					if err != nil {
+						log.Warn("fetch replica state from storage node failure",
+							logger.String("database", db.Name), logger.String("node", node.Indicator()), logger.Error(err))
+					}
+					mutex.Lock()
+					families = append(families, family...)
+					usages = append(usages, usage...)
+					replicas = append(replicas, replica...)
+					mutex.Unlock()
+				}()
+			}
+			wait0.Wait()
+			db.Stats = models.NewDatabaseStats(families, usages, replicas)
+		}()
+	}
+	wait.Wait()
+}
+
 // saveDataBase creates the database config if there is no database
 // config with the name database.Name, otherwise update the config.
 func saveDataBase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Schema) (interface{}, error) {
@@ -23,8 +23,11 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/option"
@@ -38,8 +41,10 @@ func TestSchema(t *testing.T) {
 
 	opt := &option.DatabaseOption{}
 	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
 	deps := &depspkg.HTTPDeps{
-		Repo: repo,
+		Repo:     repo,
+		StateMgr: stateMgr,
 	}
 	databaseCfg := `{"name":"test","storage":"cluster-test","numOfShard":12,`
 	databaseCfg += `"replicaFactor":3,"option":{"intervals":[{"interval":"10s"}]}}`
@@ -211,6 +216,70 @@ func TestSchema(t *testing.T) {
 					{Key: "db", Value: data},
 					{Key: "err", Value: []byte{1, 2, 4}},
 				}, nil)
+				stateMgr.EXPECT().GetStorage("cluster-test").Return(nil, false)
+			},
+		},
+		{
+			name:      "get all database schemas, list err",
+			statement: &stmt.Schema{Type: stmt.DatabaseSchemaType},
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "alter database, get config failure",
+			statement: &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "test", WriteDisabled: true},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "alter database, database not exist",
+			statement: &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "test", WriteDisabled: true},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "alter database, unmarshal config failure",
+			statement: &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "test", WriteDisabled: true},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte{1, 2, 3}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "alter database, put config failure",
+			statement: &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "test", WriteDisabled: true},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(databaseCfg), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "alter database, disable write",
+			statement: &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "test", WriteDisabled: true},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(databaseCfg), nil)
+				repo.EXPECT().Put(gomock.Any(), "/database/config/test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						database := &models.Database{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, database))
+						assert.True(t, database.WriteDisabled)
+						return nil
+					})
+			},
+		},
+		{
+			name:      "alter database, enable write",
+			statement: &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "test"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(databaseCfg), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -235,3 +304,45 @@ func TestSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_DatabaseStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockTopologyCli(ctrl)
+	topologyCli = cli
+	defer func() {
+		topologyCli = client.NewTopologyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true).AnyTimes()
+	stateMgr.EXPECT().GetStorage("s2").Return(nil, false).AnyTimes()
+
+	cli.EXPECT().FetchMemoryDatabaseState(gomock.Any(), "db").DoAndReturn(
+		func(node models.Node, _ string) ([]models.DataFamilyState, error) {
+			if node.Indicator() == "1.1.1.2:2892" {
+				return nil, fmt.Errorf("err")
+			}
+			return []models.DataFamilyState{{ShardID: 1, FamilyTime: "20221010", WriteRate: 10}}, nil
+		}).Times(2)
+	cli.EXPECT().FetchDiskUsage(gomock.Any(), "db").Return(
+		[]*models.DatabaseDiskUsage{{Database: "db", Meta: 10}}, nil).Times(2)
+	cli.EXPECT().FetchReplicaState(gomock.Any(), "db").DoAndReturn(
+		func(node models.Node, _ string) ([]models.FamilyLogReplicaState, error) {
+			if node.Indicator() == "1.1.1.1:2892" {
+				return nil, fmt.Errorf("err")
+			}
+			return []models.FamilyLogReplicaState{{ShardID: 1}}, nil
+		}).Times(2)
+
+	dbs := []*models.Database{{Name: "db", Storage: "s"}, {Name: "db2", Storage: "s2"}}
+	fillDatabaseStats(deps, dbs)
+	assert.NotNil(t, dbs[0].Stats)
+	assert.Equal(t, float64(10), dbs[0].Stats.WriteRate)
+	assert.Equal(t, int64(20), dbs[0].Stats.DiskUsage)
+	assert.Nil(t, dbs[1].Stats)
+}
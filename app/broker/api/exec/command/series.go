@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var seriesCli = client.NewSeriesCli()
+
+// DeleteSeriesCommand executes the delete series statement, deletes the series from all replicas of database's shards,
+// because each replica generates series id by itself.
+func DeleteSeriesCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+	if strings.TrimSpace(param.Database) == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	storage, database, err := getDatabaseTopology(deps, param.Database)
+	if err != nil {
+		return nil, err
+	}
+	var result models.SeriesDeletions
+	var wait sync.WaitGroup
+	for _, shard := range database.Shards {
+		for _, replica := range shard.Replicas {
+			rs := &models.SeriesDeletion{Node: replica.Node.String(), ShardID: shard.ID}
+			result = append(result, rs)
+			node, ok := storage.LiveNodes[replica.Node]
+			if !ok {
+				rs.ErrMsg = "replica is offline"
+				continue
+			}
+			rs.Node = node.Indicator()
+			shardID := shard.ID
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				deletion, err0 := seriesCli.DeleteSeries(&node, database.Name, shardID, param.SQL)
+				if err0 != nil {
+					log.Warn("delete series from storage node failure",
+						logger.String("database", database.Name), logger.Any("shard", shardID),
+						logger.String("node", node.Indicator()), logger.Error(err0))
+					rs.ErrMsg = err0.Error()
+					return
+				}
+				rs.NumOfSeries = deletion.NumOfSeries
+			}()
+		}
+	}
+	wait.Wait()
+	return result, nil
+}
@@ -0,0 +1,82 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteSeriesCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockSeriesCli(ctrl)
+	seriesCli = cli
+	defer func() {
+		seriesCli = client.NewSeriesCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+	storage.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2, 3}}},
+	}
+	sql := "delete series from cpu where host='x'"
+	statement := &stmt.DeleteSeries{MetricName: "cpu"}
+
+	// database name required
+	rs, err := DeleteSeriesCommand(context.TODO(), deps, &models.ExecuteParam{SQL: sql}, statement)
+	assert.Equal(t, constants.ErrDatabaseNameRequired, err)
+	assert.Nil(t, rs)
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err = DeleteSeriesCommand(context.TODO(), deps, &models.ExecuteParam{SQL: sql, Database: "db"}, statement)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// delete series from replicas
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+	cli.EXPECT().DeleteSeries(gomock.Any(), "db", models.ShardID(1), sql).DoAndReturn(
+		func(node models.Node, _ string, shardID models.ShardID, _ string) (*models.SeriesDeletion, error) {
+			if node.Indicator() == "1.1.1.1:2892" {
+				return &models.SeriesDeletion{ShardID: shardID, NumOfSeries: 10}, nil
+			}
+			return nil, fmt.Errorf("err")
+		}).Times(2)
+	rs, err = DeleteSeriesCommand(context.TODO(), deps, &models.ExecuteParam{SQL: sql, Database: "db"}, statement)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SeriesDeletions{
+		{Node: "1.1.1.1:2892", ShardID: 1, NumOfSeries: 10},
+		{Node: "1.1.1.2:2892", ShardID: 1, ErrMsg: "err"},
+		{Node: "3", ShardID: 1, ErrMsg: "replica is offline"},
+	}, rs)
+}
@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 
@@ -33,7 +34,8 @@ import (
 )
 
 var (
-	metricCli = client.NewMetricCli()
+	metricCli   = client.NewMetricCli()
+	topologyCli = client.NewTopologyCli()
 )
 
 // StateCommand executes the state query.
@@ -57,6 +59,18 @@ func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
 			var state []models.DataFamilyState
 			return &state
 		})
+	case stmtpkg.Shards:
+		return getShardsState(deps, stateStmt)
+	case stmtpkg.Segments:
+		return getSegmentsState(deps, stateStmt)
+	case stmtpkg.DiskUsage:
+		return getDiskUsage(deps, stateStmt)
+	case stmtpkg.FileDetail:
+		return getFileDetail(deps, stateStmt)
+	case stmtpkg.ReplicationChannels:
+		return getReplicationChannels(deps, stateStmt)
+	case stmtpkg.ExpiredMetrics:
+		return getExpiredMetrics(deps, stateStmt)
 	case stmtpkg.BrokerMetric:
 		liveNodes := deps.StateMgr.GetLiveNodes()
 		var nodes []models.Node
@@ -84,6 +98,285 @@ func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
 	}
 }
 
+// getShardsState returns the shards of database with leadership and replica lag.
+func getShardsState(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
+	storage, database, err := getDatabaseTopology(deps, stmt.Database)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		wait  sync.WaitGroup
+		mutex sync.Mutex
+	)
+	for id := range storage.LiveNodes {
+		node := storage.LiveNodes[id]
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			state, err0 := topologyCli.FetchReplicaState(&node, database.Name)
+			if err0 != nil {
+				log.Warn("fetch replica state from storage node failure",
+					logger.String("database", database.Name), logger.String("node", node.Indicator()), logger.Error(err0))
+				return
+			}
+			mutex.Lock()
+			database.FillLag(state)
+			mutex.Unlock()
+		}()
+	}
+	wait.Wait()
+	return models.Shards(database.Shards), nil
+}
+
+// getSegmentsState returns the segments of database's shard from the online replicas.
+func getSegmentsState(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
+	storage, database, shard, err := getShardTopology(deps, stmt.Database, stmt.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	shardID := shard.ID
+	result := make([][]models.SegmentState, len(shard.Replicas))
+	var wait sync.WaitGroup
+	for idx, replica := range shard.Replicas {
+		node, ok := storage.LiveNodes[replica.Node]
+		if !ok {
+			continue
+		}
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			state, err0 := topologyCli.FetchSegmentState(&node, database.Name, shardID)
+			if err0 != nil {
+				log.Warn("fetch segment state from storage node failure",
+					logger.String("database", database.Name), logger.Any("shard", shardID),
+					logger.String("node", node.Indicator()), logger.Error(err0))
+				return
+			}
+			for j := range state {
+				state[j].Node = node.Indicator()
+			}
+			result[i] = state
+		}()
+	}
+	wait.Wait()
+	var rs models.Segments
+	for _, state := range result {
+		rs = append(rs, state...)
+	}
+	return rs, nil
+}
+
+// getFileDetail returns the detail of data family's file from the online replicas of database's shard.
+func getFileDetail(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
+	storage, database, shard, err := getShardTopology(deps, stmt.Database, stmt.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.DataFileDetail, len(shard.Replicas))
+	var wait sync.WaitGroup
+	for idx, replica := range shard.Replicas {
+		node, ok := storage.LiveNodes[replica.Node]
+		if !ok {
+			continue
+		}
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			detail, err0 := topologyCli.FetchFileDetail(&node, database.Name, shard.ID, stmt.FamilyTime, stmt.FileNumber)
+			if err0 != nil {
+				log.Warn("fetch file detail from storage node failure",
+					logger.String("database", database.Name), logger.Any("shard", shard.ID),
+					logger.String("node", node.Indicator()), logger.Error(err0))
+				return
+			}
+			detail.Node = node.Indicator()
+			result[i] = detail
+		}()
+	}
+	wait.Wait()
+	var rs models.DataFileDetails
+	for _, detail := range result {
+		if detail != nil {
+			rs = append(rs, detail)
+		}
+	}
+	return rs, nil
+}
+
+// getDiskUsage returns the disk usage of databases from live nodes of storage clusters,
+// only fetches from the storage cluster which database belongs to if database is set.
+func getDiskUsage(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
+	var storages []*models.StorageState
+	if stmt.Database != "" {
+		storage, _, err := getDatabaseTopology(deps, stmt.Database)
+		if err != nil {
+			return nil, err
+		}
+		storages = append(storages, storage)
+	} else {
+		storages = deps.StateMgr.GetStorageList()
+	}
+	var nodes []models.StatefulNode
+	for _, storage := range storages {
+		for id := range storage.LiveNodes {
+			nodes = append(nodes, storage.LiveNodes[id])
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Indicator() < nodes[j].Indicator()
+	})
+	result := make([][]*models.DatabaseDiskUsage, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			usage, err := topologyCli.FetchDiskUsage(&node, stmt.Database)
+			if err != nil {
+				log.Warn("fetch disk usage from storage node failure",
+					logger.String("node", node.Indicator()), logger.Error(err))
+				return
+			}
+			for _, u := range usage {
+				u.Node = node.Indicator()
+			}
+			result[i] = usage
+		}()
+	}
+	wait.Wait()
+	var rs models.DiskUsages
+	for _, usage := range result {
+		rs = append(rs, usage...)
+	}
+	return rs, nil
+}
+
+// getExpiredMetrics returns the unused metrics found by last expiry check from live nodes of storage cluster
+// which database belongs to, includes the candidates of dry-run policy.
+func getExpiredMetrics(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
+	storage, _, err := getDatabaseTopology(deps, stmt.Database)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []models.StatefulNode
+	for id := range storage.LiveNodes {
+		nodes = append(nodes, storage.LiveNodes[id])
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Indicator() < nodes[j].Indicator()
+	})
+	result := make([]models.ExpiredMetrics, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			metrics, err := topologyCli.FetchExpiredMetrics(&node, stmt.Database)
+			if err != nil {
+				log.Warn("fetch expired metrics from storage node failure",
+					logger.String("node", node.Indicator()), logger.Error(err))
+				return
+			}
+			sort.Slice(metrics, func(m, n int) bool {
+				if metrics[m].Namespace != metrics[n].Namespace {
+					return metrics[m].Namespace < metrics[n].Namespace
+				}
+				return metrics[m].Metric < metrics[n].Metric
+			})
+			for _, m := range metrics {
+				m.Node = node.Indicator()
+			}
+			result[i] = metrics
+		}()
+	}
+	wait.Wait()
+	var rs models.ExpiredMetrics
+	for _, metrics := range result {
+		rs = append(rs, metrics...)
+	}
+	return rs, nil
+}
+
+// getReplicationChannels returns the write channel state of families from live nodes of broker cluster,
+// only returns the channels of database if database is set.
+func getReplicationChannels(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
+	if stmt.Database != "" {
+		if _, ok := deps.StateMgr.GetDatabaseCfg(stmt.Database); !ok {
+			return nil, constants.ErrDatabaseNotFound
+		}
+	}
+	nodes := deps.StateMgr.GetLiveNodes()
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Indicator() < nodes[j].Indicator()
+	})
+	result := make([][]*models.ReplicaChannelState, len(nodes))
+	var wait sync.WaitGroup
+	for idx := range nodes {
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			state, err := topologyCli.FetchReplicaChannelState(&node, stmt.Database)
+			if err != nil {
+				log.Warn("fetch replication channel state from broker node failure",
+					logger.String("node", node.Indicator()), logger.Error(err))
+				return
+			}
+			for _, s := range state {
+				s.Node = node.Indicator()
+			}
+			result[i] = state
+		}()
+	}
+	wait.Wait()
+	var rs models.ReplicaChannelStates
+	for _, state := range result {
+		rs = append(rs, state...)
+	}
+	return rs, nil
+}
+
+// getShardTopology returns the storage state, database topology and shard topology of database's shard.
+func getShardTopology(deps *depspkg.HTTPDeps, databaseName string,
+	shardID int,
+) (*models.StorageState, *models.DatabaseTopology, *models.ShardTopology, error) {
+	storage, database, err := getDatabaseTopology(deps, databaseName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, shard := range database.Shards {
+		if shard.ID == models.ShardID(shardID) {
+			return storage, database, shard, nil
+		}
+	}
+	return nil, nil, nil, constants.ErrShardNotFound
+}
+
+// getDatabaseTopology returns the storage state and shards topology of database.
+func getDatabaseTopology(deps *depspkg.HTTPDeps, databaseName string) (*models.StorageState, *models.DatabaseTopology, error) {
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(databaseName)
+	if !ok {
+		return nil, nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, nil, constants.ErrNoStorageCluster
+	}
+	for _, database := range models.NewStorageTopology(storage).Databases {
+		if database.Name == databaseName {
+			return storage, database, nil
+		}
+	}
+	return nil, nil, constants.ErrDatabaseNotFound
+}
+
 // getStateFromStorage returns the state from storage cluster.
 func getStateFromStorage(deps *depspkg.HTTPDeps, stmt *stmtpkg.State, path string, newStateFn func() interface{}) (interface{}, error) {
 	if storage, ok := deps.StateMgr.GetStorage(stmt.StorageName); ok {
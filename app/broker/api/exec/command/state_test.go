@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -31,6 +32,7 @@ import (
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -235,3 +237,264 @@ func TestState(t *testing.T) {
 		})
 	}
 }
+
+func TestState_Shards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockTopologyCli(ctrl)
+	topologyCli = cli
+	defer func() {
+		topologyCli = client.NewTopologyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2}}},
+	}
+
+	cases := []struct {
+		name      string
+		statement *stmt.State
+		prepare   func()
+		wantErr   bool
+	}{
+		{
+			name:      "database not found",
+			statement: &stmt.State{Type: stmt.Shards, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "storage not found",
+			statement: &stmt.State{Type: stmt.Shards, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "database not found in storage",
+			statement: &stmt.State{Type: stmt.Shards, Database: "db2"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db2").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show shards, fetch replica state failure",
+			statement: &stmt.State{Type: stmt.Shards, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+				cli.EXPECT().FetchReplicaState(gomock.Any(), "db").Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:      "show shards successfully",
+			statement: &stmt.State{Type: stmt.Shards, Database: "db"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+				cli.EXPECT().FetchReplicaState(gomock.Any(), "db").Return([]models.FamilyLogReplicaState{
+					{ShardID: 1, Replicators: []models.ReplicaPeerState{{Replicator: "2", Pending: 10}}},
+				}, nil)
+			},
+		},
+		{
+			name:      "show segments, shard not found",
+			statement: &stmt.State{Type: stmt.Segments, Database: "db", ShardID: 10},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show segments, fetch segment state failure",
+			statement: &stmt.State{Type: stmt.Segments, Database: "db", ShardID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+				cli.EXPECT().FetchSegmentState(gomock.Any(), "db", models.ShardID(1)).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:      "show segments successfully",
+			statement: &stmt.State{Type: stmt.Segments, Database: "db", ShardID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+				cli.EXPECT().FetchSegmentState(gomock.Any(), "db", models.ShardID(1)).
+					Return([]models.SegmentState{{Interval: "10s", Name: "20220101"}}, nil)
+			},
+		},
+		{
+			name:      "show file detail, shard not found",
+			statement: &stmt.State{Type: stmt.FileDetail, Database: "db", ShardID: 10, FamilyTime: 100, FileNumber: 3},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show file detail, fetch file detail failure",
+			statement: &stmt.State{Type: stmt.FileDetail, Database: "db", ShardID: 1, FamilyTime: 100, FileNumber: 3},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+				cli.EXPECT().FetchFileDetail(gomock.Any(), "db", models.ShardID(1), int64(100), int64(3)).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:      "show file detail successfully",
+			statement: &stmt.State{Type: stmt.FileDetail, Database: "db", ShardID: 1, FamilyTime: 100, FileNumber: 3},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+				cli.EXPECT().FetchFileDetail(gomock.Any(), "db", models.ShardID(1), int64(100), int64(3)).
+					Return(models.NewDataFileDetail("000003.sst", 100), nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			rs, err := StateCommand(context.TODO(), deps, nil, tt.statement)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, rs)
+				return
+			}
+			assert.NoError(t, err)
+			switch tt.statement.Type {
+			case stmt.Shards:
+				assert.Len(t, rs, 1)
+			case stmt.Segments:
+				assert.IsType(t, models.Segments{}, rs)
+			case stmt.FileDetail:
+				assert.IsType(t, models.DataFileDetails{}, rs)
+			}
+		})
+	}
+}
+
+func TestState_ReplicationChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockTopologyCli(ctrl)
+	topologyCli = cli
+	defer func() {
+		topologyCli = client.NewTopologyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	liveNodes := []models.StatelessNode{{HostIP: "1.1.1.2", HTTPPort: 9000}, {HostIP: "1.1.1.1", HTTPPort: 9000}}
+
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err := StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.ReplicationChannels, Database: "db"})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// show replication channels of database
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetLiveNodes().Return(liveNodes)
+	cli.EXPECT().FetchReplicaChannelState(gomock.Any(), "db").Return([]*models.ReplicaChannelState{{Database: "db"}}, nil)
+	cli.EXPECT().FetchReplicaChannelState(gomock.Any(), "db").Return(nil, fmt.Errorf("err"))
+	rs, err = StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.ReplicationChannels, Database: "db"})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+	assert.NotEmpty(t, rs.(models.ReplicaChannelStates)[0].Node)
+	// show replication channels of all databases
+	stateMgr.EXPECT().GetLiveNodes().Return(liveNodes)
+	cli.EXPECT().FetchReplicaChannelState(gomock.Any(), "").Return([]*models.ReplicaChannelState{{Database: "db"}}, nil).Times(2)
+	rs, err = StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.ReplicationChannels})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+	assert.Equal(t, "1.1.1.1:9000", rs.(models.ReplicaChannelStates)[0].Node)
+}
+
+func TestState_ExpiredMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockTopologyCli(ctrl)
+	topologyCli = cli
+	defer func() {
+		topologyCli = client.NewTopologyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+	storage.ShardStates["db"] = map[models.ShardID]models.ShardState{1: {ID: 1}}
+
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err := StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.ExpiredMetrics, Database: "db"})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// show expired metrics of database
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+	cli.EXPECT().FetchExpiredMetrics(gomock.Any(), "db").Return(models.ExpiredMetrics{
+		{Namespace: "ns", Metric: "mem"}, {Namespace: "ns", Metric: "cpu"},
+	}, nil)
+	cli.EXPECT().FetchExpiredMetrics(gomock.Any(), "db").Return(nil, fmt.Errorf("err"))
+	rs, err = StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.ExpiredMetrics, Database: "db"})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+	assert.Equal(t, "cpu", rs.(models.ExpiredMetrics)[0].Metric)
+	assert.NotEmpty(t, rs.(models.ExpiredMetrics)[0].Node)
+}
+
+func TestState_DiskUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cli := client.NewMockTopologyCli(ctrl)
+	topologyCli = cli
+	defer func() {
+		topologyCli = client.NewTopologyCli()
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{StateMgr: stateMgr}
+	storage := models.NewStorageState("s")
+	storage.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", HTTPPort: 2892}}
+	storage.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", HTTPPort: 2892}}
+	storage.ShardStates["db"] = map[models.ShardID]models.ShardState{1: {ID: 1}}
+
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	rs, err := StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.DiskUsage, Database: "db"})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// show disk usage of database
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Storage: "s"}, true)
+	stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+	cli.EXPECT().FetchDiskUsage(gomock.Any(), "db").Return([]*models.DatabaseDiskUsage{{Database: "db"}}, nil)
+	cli.EXPECT().FetchDiskUsage(gomock.Any(), "db").Return(nil, fmt.Errorf("err"))
+	rs, err = StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.DiskUsage, Database: "db"})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+	assert.NotEmpty(t, rs.(models.DiskUsages)[0].Node)
+	// show disk usage of all databases
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{storage})
+	cli.EXPECT().FetchDiskUsage(gomock.Any(), "").Return([]*models.DatabaseDiskUsage{{Database: "db"}}, nil).Times(2)
+	rs, err = StateCommand(context.TODO(), deps, nil, &stmt.State{Type: stmt.DiskUsage})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+}
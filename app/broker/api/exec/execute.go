@@ -20,6 +20,8 @@ package exec
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
 
 	"github.com/gin-gonic/gin"
@@ -27,7 +29,9 @@ import (
 	"github.com/lindb/lindb/app/broker/api/exec/command"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
 	sqlpkg "github.com/lindb/lindb/sql"
@@ -36,7 +40,7 @@ import (
 
 // for testing
 var (
-	sqlParseFn = sqlpkg.Parse
+	sqlParseFn = sqlpkg.ParseWithFormat
 )
 
 // statementExecFn represents statement execution funcation define.
@@ -59,6 +63,12 @@ var (
 		stmtpkg.QueryStatement:          command.QueryCommand,
 		stmtpkg.RequestStatement:        command.RequestCommand,
 		stmtpkg.LimitStatement:          command.LimitCommand,
+		stmtpkg.LogLevelStatement:       command.LogLevelCommand,
+		stmtpkg.PlacementStatement:      command.PlacementCommand,
+		stmtpkg.DeleteSeriesStatement:   command.DeleteSeriesCommand,
+		stmtpkg.ReadOnlyStatement:       command.ReadOnlyCommand,
+		stmtpkg.CompactionStatement:     command.CompactionCommand,
+		stmtpkg.FieldUsageStatement:     command.FieldUsageCommand,
 	}
 )
 
@@ -89,29 +99,38 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 // 1. metric data/metadata query statement;
 // 2. cluster metadata/state query statement;
 // 3. database/storage management statement;
+// Multiple statements separated by semicolon are executed sequentially in one request.
+// Output format clause(FORMAT TABLE/VERTICAL/CSV/JSONL) at the end of statement responses formatted text.
 //
 // @Summary execute lin query language
 // @Description Execute lin query language with rate limit, then return different response based on execution statement.
 // @Description 1. metric data/metadata query statement;
 // @Description 2. cluster metadata/state query statement;
 // @Description 3. database/storage management statement;
+// @Description Multiple statements separated by semicolon(use db; select ...) are executed sequentially, stop at the first failure.
+// @Description Output format clause(select ... format csv) or format param responses formatted text(table/vertical/csv/jsonl).
 // @Tags LinQL
 // @Accept json
 // @Param param body models.ExecuteParam ture "param data"
 // @Produce json
 // @Success 200 {object} models.ResultSet
 // @Success 200 {object} models.Metadata
-// @Failure 404 {string} string "not found"
-// @Failure 500 {string} string "can't parse lin query language"
-// @Failure 500 {string} string "internal error"
+// @Success 200 {object} models.BatchResult "multiple statements separated by semicolon"
+// @Failure 400 {object} models.ErrorResponse "can't parse lin query language"
+// @Failure 404 {object} models.ErrorResponse "metadata not found"
+// @Failure 429 {object} models.ErrorResponse "limit exceeded"
+// @Failure 500 {object} models.ErrorResponse "internal error"
+// @Failure 503 {object} models.ErrorResponse "storage unavailable"
+// @Failure 504 {object} models.ErrorResponse "timeout"
 // @Router /exec [get]
 // @Router /exec [put]
 // @Router /exec [post]
 func (e *ExecuteAPI) Execute(c *gin.Context) {
+	_ = httppkg.WithRequestID(c)
 	if err := e.deps.QueryLimiter.Do(func() error {
 		return e.execute(c)
 	}); err != nil {
-		httppkg.Error(c, err)
+		httppkg.ErrorWithCode(c, err)
 	}
 }
 
@@ -123,29 +142,112 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	param := models.ExecuteParam{}
 	err := c.ShouldBind(&param)
 	if err != nil {
-		return err
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	if param.Priority == "" {
+		param.Priority = c.GetHeader(constants.HeaderQueryPriority)
+	}
+	if param.Priority == "" && param.Database == constants.InternalDatabase {
+		// query of monitoring data(LinDB self) is system query if not set priority class
+		param.Priority = concurrent.System.String()
+	}
+	priority, err := concurrent.ParsePriority(param.Priority)
+	if err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
 	}
+	ctx = concurrent.WithPriority(ctx, priority)
 	c.Set(constants.CurrentSQL, &param)
-	stmt, err := sqlParseFn(param.SQL)
+	if statements := sqlpkg.SplitStatements(param.SQL); len(statements) > 1 {
+		return e.executeBatch(ctx, c, &param, statements)
+	}
+	stmt, format, err := sqlParseFn(param.SQL)
+	if err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	if format == "" {
+		format = param.Format
+	}
+	outputFormat, err := models.ParseOutputFormat(format)
+	if err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	result, err := e.executeStatement(ctx, &param, stmt)
 	if err != nil {
 		return err
 	}
-
-	if stmt == nil {
-		return errors.New("can't parse lin query language")
+	if result == nil || reflect.ValueOf(result).IsNil() {
+		httppkg.NotFound(c)
+		return nil
 	}
+	if isPartialResult(result) {
+		// some targets failed, result only includes the others
+		c.Header(constants.HeaderErrorCode, errorpkg.PartialResult.String())
+	}
+	if formatter, ok := result.(models.TableFormatter); ok && format != "" && c.GetHeader("Accept") != "application/json" {
+		// output format is set and client not requires json, response formatted text instead of json
+		_, text := models.FormatTable(formatter, outputFormat)
+		c.Data(http.StatusOK, outputFormat.ContentType(), []byte(text))
+		return nil
+	}
+	httppkg.OK(c, result)
+	return nil
+}
 
-	if commandFn, ok := commands[stmt.StatementType()]; ok {
-		result, err := commandFn(ctx, e.deps, &param, stmt)
+// executeBatch executes multiple statements sequentially in one request,
+// all statements are parsed before execution, and stops at the first failure statement.
+func (e *ExecuteAPI) executeBatch(ctx context.Context, c *gin.Context, param *models.ExecuteParam, statements []string) error {
+	stmts := make([]stmtpkg.Statement, len(statements))
+	for idx, sql := range statements {
+		// results of batch are always json, ignore output format of statement
+		stmt, _, err := sqlParseFn(sql)
 		if err != nil {
-			return err
+			return errorpkg.WithCode(errorpkg.ParseError, fmt.Errorf("statement %d: %w", idx+1, err))
 		}
-		if result == nil || reflect.ValueOf(result).IsNil() {
-			httppkg.NotFound(c)
-		} else {
-			httppkg.OK(c, result)
+		stmts[idx] = stmt
+	}
+	requestID := c.Writer.Header().Get(constants.HeaderRequestID)
+	batch := &models.BatchResult{}
+	partial := false
+	for idx, stmt := range stmts {
+		statementResult := &models.StatementResult{SQL: statements[idx]}
+		batch.Results = append(batch.Results, statementResult)
+		if use, ok := stmt.(*stmtpkg.Use); ok {
+			// switch database for the following statements
+			param.Database = use.Name
+			continue
 		}
-		return nil
+		result, err := e.executeStatement(ctx, param, stmt)
+		if err != nil {
+			statementResult.Error = models.NewErrorResponse(requestID, err)
+			c.Header(constants.HeaderErrorCode, statementResult.Error.Code.String())
+			break
+		}
+		if result != nil && !reflect.ValueOf(result).IsNil() {
+			statementResult.Result = result
+			partial = partial || isPartialResult(result)
+		}
+	}
+	if partial && batch.Failure() == nil {
+		c.Header(constants.HeaderErrorCode, errorpkg.PartialResult.String())
 	}
-	return errors.New("can't parse lin query language")
+	httppkg.OK(c, batch)
+	return nil
+}
+
+// executeStatement executes the statement by the command of statement type.
+func (e *ExecuteAPI) executeStatement(ctx context.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	if stmt == nil {
+		return nil, errorpkg.WithCode(errorpkg.ParseError, errors.New("can't parse lin query language"))
+	}
+	commandFn, ok := commands[stmt.StatementType()]
+	if !ok {
+		return nil, errorpkg.WithCode(errorpkg.ParseError, errors.New("can't parse lin query language"))
+	}
+	return commandFn(ctx, e.deps, param, stmt)
+}
+
+// isPartialResult checks if the result set only includes part of targets.
+func isPartialResult(result interface{}) bool {
+	rs, ok := result.(*models.ResultSet)
+	return ok && len(rs.PartialFailures) > 0
 }
@@ -31,6 +31,7 @@ import (
 
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
@@ -39,6 +40,8 @@ import (
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/sql"
@@ -78,35 +81,41 @@ func TestExecuteAPI_Execute(t *testing.T) {
 	cases := []struct {
 		name    string
 		reqBody string
+		headers []http.Header
 		prepare func()
 		assert  func(resp *httptest.ResponseRecorder)
 	}{
 		{
 			name: "param invalid",
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+				assert.Equal(t, "PARSE_ERROR", resp.Header().Get(constants.HeaderErrorCode))
+				assert.NotEmpty(t, resp.Header().Get(constants.HeaderRequestID))
+				errResp := &models.ErrorResponse{}
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), errResp))
+				assert.Equal(t, errorpkg.ParseError, errResp.Code)
 			},
 		},
 		{
 			name:    "parse sql failure",
 			reqBody: `{"sql":"show a"}`,
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
 			},
 		},
 		{
 			name:    "parse sql failure",
 			reqBody: `{"sql":"abcs"}`,
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
 			},
 		},
 		{
 			name:    "unknown metadata statement type",
 			reqBody: `{"sql":"show master"}`,
 			prepare: func() {
-				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
-					return &stmtpkg.State{}, nil
+				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, format string, err error) {
+					return &stmtpkg.State{}, "", nil
 				}
 			},
 			assert: func(resp *httptest.ResponseRecorder) {
@@ -117,12 +126,12 @@ func TestExecuteAPI_Execute(t *testing.T) {
 			name:    "unknown lin query language statement",
 			reqBody: `{"sql":"show master"}`,
 			prepare: func() {
-				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, err error) {
-					return &stmtpkg.Use{}, nil
+				sqlParseFn = func(sql string) (stmt stmtpkg.Statement, format string, err error) {
+					return &stmtpkg.Use{}, "", nil
 				}
 			},
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
 			},
 		},
 		{
@@ -145,6 +154,36 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "found master, output as table",
+			reqBody: `{"sql":"show master format table"}`,
+			prepare: func() {
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, models.TableFormat.ContentType(), resp.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:    "found master, client requires json",
+			reqBody: `{"sql":"show master format table"}`,
+			headers: []http.Header{{"Content-Type": []string{"application/json"}, "Accept": []string{"application/json"}}},
+			prepare: func() {
+				master.EXPECT().GetMaster().Return(&models.Master{Node: &models.StatelessNode{}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Header().Get("Content-Type"), "application/json")
+			},
+		},
+		{
+			name:    "unknown output format",
+			reqBody: `{"sql":"show master","format":"xml"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
 		{
 			name:    "get database list err",
 			reqBody: `{"sql":"show databases"}`,
@@ -155,18 +194,62 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusInternalServerError, resp.Code)
 			},
 		},
+		{
+			name:    "parse batch statements failure",
+			reqBody: `{"sql":"show master; show a"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+				errResp := &models.ErrorResponse{}
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), errResp))
+				assert.Contains(t, errResp.Message, "statement 2:")
+			},
+		},
+		{
+			name:    "execute batch statements successfully",
+			reqBody: `{"sql":"use db; show master; show master"}`,
+			prepare: func() {
+				master.EXPECT().GetMaster().Return(&models.Master{})
+				master.EXPECT().GetMaster().Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Empty(t, resp.Header().Get(constants.HeaderErrorCode))
+				rs := &models.BatchResult{}
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), rs))
+				assert.Len(t, rs.Results, 3)
+				assert.Equal(t, "use db", rs.Results[0].SQL)
+				assert.NotNil(t, rs.Results[1].Result)
+				assert.Nil(t, rs.Results[2].Result)
+				assert.Nil(t, rs.Failure())
+			},
+		},
+		{
+			name:    "execute batch statements, stop at failure statement",
+			reqBody: `{"sql":"show databases; show master"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "INTERNAL", resp.Header().Get(constants.HeaderErrorCode))
+				rs := &models.BatchResult{}
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), rs))
+				assert.Len(t, rs.Results, 1)
+				assert.Equal(t, "err", rs.Failure().Message)
+			},
+		},
 	}
 
 	for _, tt := range cases {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			defer func() {
-				sqlParseFn = sql.Parse
+				sqlParseFn = sql.ParseWithFormat
 			}()
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, tt.reqBody)
+			resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, tt.reqBody, tt.headers...)
 			if tt.assert != nil {
 				tt.assert(resp)
 			}
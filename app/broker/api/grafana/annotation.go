@@ -0,0 +1,228 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grafana
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
+	httppkg "github.com/lindb/lindb/pkg/http"
+)
+
+var (
+	// AnnotationPath represents the path of grafana json datasource annotation query.
+	AnnotationPath = GrafanaPath + "/annotations"
+)
+
+// maxAnnotations represents the max number of annotations returned by one annotation query.
+const maxAnnotations = 1000
+
+// thresholdPattern matches the threshold clause at the end of annotation query(select ... threshold > 90).
+var thresholdPattern = regexp.MustCompile(`(?is)^(.*\S)\s+threshold\s*(>=|<=|>|<|=)\s*([-+]?[0-9]*\.?[0-9]+(?:[eE][-+]?[0-9]+)?)\s*$`)
+
+// threshold represents the threshold of annotation query, annotation is a time region which value matches threshold.
+type threshold struct {
+	op    string
+	value float64
+}
+
+// match checks if the value matches the threshold.
+func (t *threshold) match(v float64) bool {
+	switch t.op {
+	case ">":
+		return v > t.value
+	case ">=":
+		return v >= t.value
+	case "<":
+		return v < t.value
+	case "<=":
+		return v <= t.value
+	default:
+		return v == t.value
+	}
+}
+
+// String returns the string value of threshold.
+func (t *threshold) String() string {
+	return fmt.Sprintf("%s %s", t.op, strconv.FormatFloat(t.value, 'f', -1, 64))
+}
+
+// parseThreshold parses the threshold clause of annotation query, returns the query without threshold clause.
+func parseThreshold(query string) (string, *threshold) {
+	matches := thresholdPattern.FindStringSubmatch(query)
+	if len(matches) != 4 {
+		return query, nil
+	}
+	value, err := strconv.ParseFloat(matches[3], 64)
+	if err != nil {
+		return query, nil
+	}
+	return matches[1], &threshold{op: matches[2], value: value}
+}
+
+// Annotations executes the annotation query of grafana dashboard.
+// Without threshold clause, each non-zero point of event field(like deploy count) is an annotation,
+// with threshold clause, each time region which value crosses the threshold is an annotation.
+//
+// @Summary annotation query for grafana json datasource
+// @Description Execute annotation query(lin query language with optional threshold clause) for grafana json datasource.
+// @Tags Grafana
+// @Accept json
+// @Param param body models.GrafanaAnnotationRequest ture "annotation request of grafana"
+// @Produce json
+// @Success 200 {object} []models.GrafanaAnnotation
+// @Failure 400 {object} models.ErrorResponse "can't parse lin query language"
+// @Failure 500 {object} models.ErrorResponse "internal error"
+// @Router /grafana/annotations [post]
+func (api *API) Annotations(c *gin.Context) {
+	_ = httppkg.WithRequestID(c)
+	if err := api.deps.QueryLimiter.Do(func() error {
+		return api.annotations(c)
+	}); err != nil {
+		httppkg.ErrorWithCode(c, err)
+	}
+}
+
+// annotations executes the annotation query.
+func (api *API) annotations(c *gin.Context) error {
+	req := &models.GrafanaAnnotationRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	timeRange, err := parseRange(req.Range)
+	if err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	database := databaseOf(c, req.Annotation.Payload)
+	if database == "" {
+		return errorpkg.WithCode(errorpkg.ParseError,
+			fmt.Errorf("database not set for annotation(%s), set db in payload or %s header", req.Annotation.Name, constants.HeaderDatabase))
+	}
+	query, threshold := parseThreshold(req.Annotation.Query)
+
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	rs, err := api.queryResultSet(ctx, database, query, timeRange, autoInterval(timeRange, 0, 0))
+	if err != nil {
+		return err
+	}
+	c.Header("Cache-Control", noCacheControl)
+	httppkg.OK(c, toAnnotations(rs, threshold))
+	return nil
+}
+
+// toAnnotations converts the points of result set to annotations, sorted by time.
+func toAnnotations(rs *models.ResultSet, threshold *threshold) []*models.GrafanaAnnotation {
+	annotations := make([]*models.GrafanaAnnotation, 0)
+	if rs == nil {
+		return annotations
+	}
+	for _, series := range rs.Series {
+		tags := make([]string, 0, len(series.Tags))
+		for key, value := range series.Tags {
+			tags = append(tags, key+"="+value)
+		}
+		sort.Strings(tags)
+		for name, points := range series.Fields {
+			timestamps := make([]int64, 0, len(points))
+			for timestamp := range points {
+				timestamps = append(timestamps, timestamp)
+			}
+			sort.Slice(timestamps, func(i, j int) bool {
+				return timestamps[i] < timestamps[j]
+			})
+			if threshold == nil {
+				annotations = append(annotations, eventAnnotations(name, tags, timestamps, points)...)
+			} else {
+				annotations = append(annotations, thresholdAnnotations(name, tags, timestamps, points, threshold)...)
+			}
+		}
+	}
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotations[i].Time < annotations[j].Time
+	})
+	if len(annotations) > maxAnnotations {
+		annotations = annotations[:maxAnnotations]
+	}
+	return annotations
+}
+
+// eventAnnotations returns the annotation of each non-zero point.
+func eventAnnotations(field string, tags []string, timestamps []int64, points map[int64]float64) []*models.GrafanaAnnotation {
+	var annotations []*models.GrafanaAnnotation
+	for _, timestamp := range timestamps {
+		value := points[timestamp]
+		if value == 0 {
+			continue
+		}
+		annotations = append(annotations, &models.GrafanaAnnotation{
+			Time:  timestamp,
+			Title: field,
+			Text:  strings.TrimSpace(fmt.Sprintf("%s=%s %s", field, strconv.FormatFloat(value, 'f', -1, 64), strings.Join(tags, ","))),
+			Tags:  tags,
+		})
+	}
+	return annotations
+}
+
+// thresholdAnnotations returns the time regions which values match the threshold.
+func thresholdAnnotations(field string, tags []string, timestamps []int64, points map[int64]float64,
+	threshold *threshold,
+) []*models.GrafanaAnnotation {
+	var (
+		annotations []*models.GrafanaAnnotation
+		region      *models.GrafanaAnnotation
+		peak        float64
+	)
+	for _, timestamp := range timestamps {
+		value := points[timestamp]
+		if !threshold.match(value) {
+			if region != nil {
+				// value back to normal, close the time region
+				region.TimeEnd = timestamp
+				region = nil
+			}
+			continue
+		}
+		if region == nil {
+			region = &models.GrafanaAnnotation{
+				Time:  timestamp,
+				Title: fmt.Sprintf("%s %s", field, threshold),
+				Tags:  tags,
+			}
+			peak = value
+			annotations = append(annotations, region)
+		}
+		if upward := strings.HasPrefix(threshold.op, ">"); (upward && value > peak) || (!upward && value < peak) {
+			peak = value
+		}
+		region.TimeEnd = timestamp
+		region.Text = strings.TrimSpace(fmt.Sprintf("%s crossed %s, peak=%s %s",
+			field, threshold, strconv.FormatFloat(peak, 'f', -1, 64), strings.Join(tags, ",")))
+	}
+	return annotations
+}
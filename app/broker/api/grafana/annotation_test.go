@@ -0,0 +1,195 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestAPI_Annotations(t *testing.T) {
+	r := newTestAPI()
+	rangeBody := `"range":{"from":"2022-01-01T00:00:00Z","to":"2022-01-01T01:00:00.000Z"}`
+	resultSet := &models.ResultSet{
+		MetricName: "deploy",
+		Series: []*models.Series{{
+			Tags:   map[string]string{"app": "a"},
+			Fields: map[string]map[int64]float64{"count": {30: 0, 20: 2, 10: 1}},
+		}},
+	}
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set(constants.HeaderDatabase, "db")
+
+	cases := []struct {
+		name    string
+		reqBody string
+		headers []http.Header
+		prepare func()
+		assert  func(resp *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "invalid request",
+			reqBody: `{"annotation":"abc"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "invalid range",
+			reqBody: `{"range":{"from":"abc","to":"2022-01-01T01:00:00Z"}}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "database not set",
+			reqBody: `{` + rangeBody + `,"annotation":{"name":"deploy","query":"select count from deploy"}}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "query failure",
+			reqBody: `{` + rangeBody + `,"annotation":{"name":"deploy","query":"select count from deploy"}}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "event annotations",
+			reqBody: `{` + rangeBody + `,"annotation":{"name":"deploy","query":"select count from deploy","payload":{"db":"db"}}}`,
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+					return resultSet, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, noCacheControl, resp.Header().Get("Cache-Control"))
+				var rs []*models.GrafanaAnnotation
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rs))
+				assert.Equal(t, []*models.GrafanaAnnotation{
+					{Time: 10, Title: "count", Text: "count=1 app=a", Tags: []string{"app=a"}},
+					{Time: 20, Title: "count", Text: "count=2 app=a", Tags: []string{"app=a"}},
+				}, rs)
+			},
+		},
+		{
+			name:    "threshold annotations",
+			reqBody: `{` + rangeBody + `,"annotation":{"name":"deploy","query":"select count from deploy threshold >= 1"}}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+					return resultSet, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var rs []*models.GrafanaAnnotation
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rs))
+				assert.Equal(t, []*models.GrafanaAnnotation{
+					{Time: 10, TimeEnd: 30, Title: "count >= 1", Text: "count crossed >= 1, peak=2 app=a", Tags: []string{"app=a"}},
+				}, rs)
+			},
+		},
+		{
+			name:    "empty result",
+			reqBody: `{` + rangeBody + `,"annotation":{"name":"deploy","query":"select count from deploy"}}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+					return nil, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "[]", resp.Body.String())
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				queryCommandFn = command.QueryCommand
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, AnnotationPath, tt.reqBody, tt.headers...)
+			tt.assert(resp)
+		})
+	}
+}
+
+func TestParseThreshold(t *testing.T) {
+	query, th := parseThreshold("select f from cpu")
+	assert.Equal(t, "select f from cpu", query)
+	assert.Nil(t, th)
+	query, th = parseThreshold("select f from cpu where host='a' THRESHOLD < -1.5")
+	assert.Equal(t, "select f from cpu where host='a'", query)
+	assert.Equal(t, "< -1.5", th.String())
+
+	cases := []struct {
+		op    string
+		value float64
+		match bool
+	}{
+		{op: ">", value: 2, match: true},
+		{op: ">=", value: 1, match: true},
+		{op: "<", value: 1, match: false},
+		{op: "<=", value: 1, match: true},
+		{op: "=", value: 2, match: false},
+	}
+	for _, tt := range cases {
+		assert.Equal(t, tt.match, (&threshold{op: tt.op, value: 1}).match(tt.value), tt.op)
+	}
+}
+
+func TestThresholdAnnotations(t *testing.T) {
+	points := map[int64]float64{10: 5, 20: 1, 30: 0, 40: 3}
+	rs := thresholdAnnotations("f", nil, []int64{10, 20, 30, 40}, points, &threshold{op: "<", value: 2})
+	assert.Len(t, rs, 1)
+	assert.Equal(t, int64(20), rs[0].Time)
+	assert.Equal(t, int64(40), rs[0].TimeEnd)
+	assert.Equal(t, "f crossed < 2, peak=0", rs[0].Text)
+}
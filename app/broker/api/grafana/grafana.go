@@ -0,0 +1,462 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grafana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	sqlParseFn        = sqlpkg.Parse
+	queryCommandFn    = command.QueryCommand
+	metadataCommandFn = command.MetricMetadataCommand
+)
+
+var (
+	// GrafanaPath represents the root path of grafana json datasource, used for testing connection.
+	GrafanaPath = "/grafana"
+	// QueryPath represents the path of grafana json datasource query.
+	QueryPath = GrafanaPath + "/query"
+	// SearchPath represents the path of grafana json datasource metric search.
+	SearchPath = GrafanaPath + "/search"
+	// VariablePath represents the path of grafana json datasource template variable query.
+	VariablePath = GrafanaPath + "/variable"
+)
+
+const (
+	// defaultMaxDataPoints represents the max data points of series if grafana not set.
+	defaultMaxDataPoints = 1000
+	// searchLimit represents the max number of metric names returned by search.
+	searchLimit = 100
+	// tableType represents the table target type of grafana.
+	tableType = "table"
+	// metadataCacheControl represents the cache control header of metadata suggestion(search/variable),
+	// metadata changes rarely, so dashboard can reuse it for a short time.
+	metadataCacheControl = "private, max-age=30"
+	// noCacheControl represents the cache control header of query/annotation which depends on the latest data.
+	noCacheControl = "no-cache"
+)
+
+// autoIntervals represents the candidate intervals of auto interval selection.
+var autoIntervals = []time.Duration{
+	10 * time.Second, 30 * time.Second,
+	time.Minute, 5 * time.Minute, 10 * time.Minute, 30 * time.Minute,
+	time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour,
+}
+
+// API represents the query api compatible with grafana json datasource plugin,
+// translates the targets of grafana into lin query language.
+type API struct {
+	deps   *depspkg.HTTPDeps
+	logger *logger.Logger
+}
+
+// NewAPI creates the grafana json datasource api.
+func NewAPI(deps *depspkg.HTTPDeps) *API {
+	return &API{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "GrafanaAPI"),
+	}
+}
+
+// Register adds grafana json datasource url routes.
+func (api *API) Register(route gin.IRoutes) {
+	route.GET(GrafanaPath, api.Test)
+	route.POST(QueryPath, api.Query)
+	route.POST(SearchPath, api.Search)
+	route.POST(VariablePath, api.Variable)
+	route.POST(AnnotationPath, api.Annotations)
+}
+
+// Test responses ok for testing datasource connection.
+func (api *API) Test(c *gin.Context) {
+	httppkg.OK(c, "ok")
+}
+
+// Query executes the targets of grafana panel, then returns time series or table.
+// Target is lin query language, time range is overwritten by the range of dashboard,
+// group by interval is selected automatically based on max data points if not set.
+//
+// @Summary query for grafana json datasource
+// @Description Execute the targets(lin query language) of grafana json datasource.
+// @Tags Grafana
+// @Accept json
+// @Param param body models.GrafanaQueryRequest ture "query request of grafana"
+// @Produce json
+// @Success 200 {object} []models.GrafanaTimeSeries
+// @Failure 400 {object} models.ErrorResponse "can't parse lin query language"
+// @Failure 500 {object} models.ErrorResponse "internal error"
+// @Router /grafana/query [post]
+func (api *API) Query(c *gin.Context) {
+	_ = httppkg.WithRequestID(c)
+	if err := api.deps.QueryLimiter.Do(func() error {
+		return api.query(c)
+	}); err != nil {
+		httppkg.ErrorWithCode(c, err)
+	}
+}
+
+// query executes the targets of grafana panel.
+func (api *API) query(c *gin.Context) error {
+	req := &models.GrafanaQueryRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	timeRange, err := parseRange(req.Range)
+	if err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	interval := autoInterval(timeRange, req.MaxDataPoints, req.IntervalMs)
+
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	rs := make([]interface{}, 0)
+	for _, target := range req.Targets {
+		if target.Hide || strings.TrimSpace(target.Target) == "" {
+			continue
+		}
+		result, err := api.queryTarget(ctx, c, target, timeRange, interval)
+		if err != nil {
+			return err
+		}
+		rs = append(rs, result...)
+	}
+	c.Header("Cache-Control", noCacheControl)
+	httppkg.OK(c, rs)
+	return nil
+}
+
+// queryTarget executes the lin query language of target, then converts the result set based on target type.
+func (api *API) queryTarget(ctx context.Context, c *gin.Context, target *models.GrafanaTarget,
+	timeRange timeutil.TimeRange, interval int64,
+) ([]interface{}, error) {
+	database := databaseOf(c, target.Payload)
+	if database == "" {
+		return nil, errorpkg.WithCode(errorpkg.ParseError,
+			fmt.Errorf("database not set for target(%s), set db in payload or %s header", target.RefID, constants.HeaderDatabase))
+	}
+	resultSet, err := api.queryResultSet(ctx, database, target.Target, timeRange, interval)
+	if err != nil || resultSet == nil {
+		return nil, err
+	}
+	if target.Type == tableType {
+		return []interface{}{toTable(target.RefID, resultSet)}, nil
+	}
+	return toTimeSeries(target.RefID, resultSet), nil
+}
+
+// queryResultSet executes the select statement with the time range and interval of dashboard,
+// group by interval of statement is kept if set.
+func (api *API) queryResultSet(ctx context.Context, database, sql string,
+	timeRange timeutil.TimeRange, interval int64,
+) (*models.ResultSet, error) {
+	stmt, err := sqlParseFn(sql)
+	if err != nil {
+		return nil, errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	statement, ok := stmt.(*stmtpkg.Query)
+	if !ok {
+		return nil, errorpkg.WithCode(errorpkg.ParseError, errors.New("target must be a select statement"))
+	}
+	// dashboard controls the time range and interval of query
+	statement.TimeRange = timeRange
+	if statement.Interval <= 0 && !statement.AutoGroupByTime {
+		statement.Interval = timeutil.Interval(interval)
+	}
+	param := &models.ExecuteParam{Database: database, SQL: sql}
+	result, err := queryCommandFn(ctx, api.deps, param, statement)
+	if err != nil {
+		return nil, err
+	}
+	resultSet, ok := result.(*models.ResultSet)
+	if !ok {
+		return nil, nil
+	}
+	return resultSet, nil
+}
+
+// Search returns the metric names which start with the target, used for metric suggestion in query editor,
+// if fuzzy of payload is true, returns the metric names which fuzzy match the target, ranked by query popularity.
+//
+// @Summary search metric for grafana json datasource
+// @Description Search metric names by prefix(or fuzzy match if payload.fuzzy=true) for grafana json datasource.
+// @Tags Grafana
+// @Accept json
+// @Param param body models.GrafanaSearchRequest ture "search request of grafana"
+// @Produce json
+// @Success 200 {object} []string
+// @Failure 400 {object} models.ErrorResponse "database not set"
+// @Failure 500 {object} models.ErrorResponse "internal error"
+// @Router /grafana/search [post]
+func (api *API) Search(c *gin.Context) {
+	_ = httppkg.WithRequestID(c)
+	if err := api.deps.QueryLimiter.Do(func() error {
+		return api.search(c)
+	}); err != nil {
+		httppkg.ErrorWithCode(c, err)
+	}
+}
+
+// search returns the metric names which start with(or fuzzy match) the target.
+func (api *API) search(c *gin.Context) error {
+	req := &models.GrafanaSearchRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	database := databaseOf(c, req.Payload)
+	if database == "" {
+		return errorpkg.WithCode(errorpkg.ParseError,
+			fmt.Errorf("database not set, set db in payload or %s header", constants.HeaderDatabase))
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	fuzzy, _ := req.Payload["fuzzy"].(bool)
+	statement := &stmtpkg.MetricMetadata{
+		Type:   stmtpkg.Metric,
+		Prefix: req.Target,
+		Fuzzy:  fuzzy,
+		Limit:  searchLimit,
+	}
+	sql := fmt.Sprintf("show metrics where metric='%s'", req.Target)
+	if fuzzy {
+		sql += " fuzzy"
+	}
+	param := &models.ExecuteParam{Database: database, SQL: sql}
+	result, err := metadataCommandFn(ctx, api.deps, param, statement)
+	if err != nil {
+		return err
+	}
+	metrics := make([]string, 0)
+	if metadata, ok := result.(*models.Metadata); ok && metadata != nil {
+		if values, ok := metadata.Values.([]string); ok {
+			metrics = append(metrics, values...)
+		}
+	}
+	c.Header("Cache-Control", metadataCacheControl)
+	httppkg.OK(c, metrics)
+	return nil
+}
+
+// Variable executes the metadata statement of template variable, returns the values of variable.
+//
+// @Summary variable query for grafana json datasource
+// @Description Execute metadata statement(show tag values ...) of template variable for grafana json datasource.
+// @Tags Grafana
+// @Accept json
+// @Param param body models.GrafanaVariableRequest ture "variable request of grafana"
+// @Produce json
+// @Success 200 {object} []models.GrafanaVariableValue
+// @Failure 400 {object} models.ErrorResponse "can't parse lin query language"
+// @Failure 500 {object} models.ErrorResponse "internal error"
+// @Router /grafana/variable [post]
+func (api *API) Variable(c *gin.Context) {
+	_ = httppkg.WithRequestID(c)
+	if err := api.deps.QueryLimiter.Do(func() error {
+		return api.variable(c)
+	}); err != nil {
+		httppkg.ErrorWithCode(c, err)
+	}
+}
+
+// variable executes the metadata statement of template variable.
+func (api *API) variable(c *gin.Context) error {
+	req := &models.GrafanaVariableRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	target, _ := req.Payload["target"].(string)
+	if strings.TrimSpace(target) == "" {
+		return errorpkg.WithCode(errorpkg.ParseError, errors.New("target of variable query not set"))
+	}
+	database := databaseOf(c, req.Payload)
+	if database == "" {
+		return errorpkg.WithCode(errorpkg.ParseError,
+			fmt.Errorf("database not set, set db in payload or %s header", constants.HeaderDatabase))
+	}
+	stmt, err := sqlParseFn(target)
+	if err != nil {
+		return errorpkg.WithCode(errorpkg.ParseError, err)
+	}
+	statement, ok := stmt.(*stmtpkg.MetricMetadata)
+	if !ok {
+		return errorpkg.WithCode(errorpkg.ParseError, errors.New("target of variable query must be a metadata statement"))
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+
+	param := &models.ExecuteParam{Database: database, SQL: target}
+	result, err := metadataCommandFn(ctx, api.deps, param, statement)
+	if err != nil {
+		return err
+	}
+	c.Header("Cache-Control", metadataCacheControl)
+	httppkg.OK(c, toVariableValues(result))
+	return nil
+}
+
+// toVariableValues converts the values of metadata to the values of template variable.
+func toVariableValues(result interface{}) []*models.GrafanaVariableValue {
+	rs := make([]*models.GrafanaVariableValue, 0)
+	metadata, ok := result.(*models.Metadata)
+	if !ok || metadata == nil {
+		return rs
+	}
+	switch values := metadata.Values.(type) {
+	case []string:
+		for _, value := range values {
+			rs = append(rs, &models.GrafanaVariableValue{Text: value, Value: value})
+		}
+	case []models.Field:
+		for _, field := range values {
+			rs = append(rs, &models.GrafanaVariableValue{Text: field.Name, Value: field.Name})
+		}
+	}
+	return rs
+}
+
+// databaseOf returns the database name from payload of target, if not set returns it from request header.
+func databaseOf(c *gin.Context, payload map[string]interface{}) string {
+	if db, ok := payload["db"].(string); ok && db != "" {
+		return db
+	}
+	return c.GetHeader(constants.HeaderDatabase)
+}
+
+// parseRange parses the time range of grafana(RFC3339 format).
+func parseRange(r models.GrafanaRange) (timeutil.TimeRange, error) {
+	from, err := time.Parse(time.RFC3339Nano, r.From)
+	if err != nil {
+		return timeutil.TimeRange{}, fmt.Errorf("invalid range from: %w", err)
+	}
+	to, err := time.Parse(time.RFC3339Nano, r.To)
+	if err != nil {
+		return timeutil.TimeRange{}, fmt.Errorf("invalid range to: %w", err)
+	}
+	if to.Before(from) {
+		return timeutil.TimeRange{}, errors.New("range from cannot be after range to")
+	}
+	return timeutil.TimeRange{Start: from.UnixMilli(), End: to.UnixMilli()}, nil
+}
+
+// autoInterval selects the smallest candidate interval(ms) which keeps the number of points
+// not larger than max data points, and not smaller than the min interval of grafana.
+func autoInterval(timeRange timeutil.TimeRange, maxDataPoints, minIntervalMs int64) int64 {
+	if maxDataPoints <= 0 {
+		maxDataPoints = defaultMaxDataPoints
+	}
+	expect := (timeRange.End - timeRange.Start + maxDataPoints - 1) / maxDataPoints
+	if expect < minIntervalMs {
+		expect = minIntervalMs
+	}
+	for _, interval := range autoIntervals {
+		if interval.Milliseconds() >= expect {
+			return interval.Milliseconds()
+		}
+	}
+	return autoIntervals[len(autoIntervals)-1].Milliseconds()
+}
+
+// toTimeSeries converts result set to grafana time series, one time series per series and field.
+func toTimeSeries(refID string, rs *models.ResultSet) []interface{} {
+	var result []interface{}
+	for _, series := range rs.Series {
+		tags := seriesTags(series.Tags)
+		fields := make([]string, 0, len(series.Fields))
+		for name := range series.Fields {
+			fields = append(fields, name)
+		}
+		sort.Strings(fields)
+		for _, name := range fields {
+			points := series.Fields[name]
+			timestamps := make([]int64, 0, len(points))
+			for timestamp := range points {
+				timestamps = append(timestamps, timestamp)
+			}
+			sort.Slice(timestamps, func(i, j int) bool {
+				return timestamps[i] < timestamps[j]
+			})
+			dataPoints := make([][2]float64, 0, len(timestamps))
+			for _, timestamp := range timestamps {
+				dataPoints = append(dataPoints, [2]float64{points[timestamp], float64(timestamp)})
+			}
+			result = append(result, &models.GrafanaTimeSeries{
+				Target:     name + tags,
+				RefID:      refID,
+				DataPoints: dataPoints,
+			})
+		}
+	}
+	return result
+}
+
+// seriesTags returns the tags of series as {k1=v1,k2=v2} sorted by tag key.
+func seriesTags(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+tags[key])
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// toTable converts result set to grafana table based on the rows of result set table.
+func toTable(refID string, rs *models.ResultSet) *models.GrafanaTable {
+	table := &models.GrafanaTable{Type: tableType, RefID: refID, Rows: make([][]interface{}, 0)}
+	header, rows := rs.TableRows()
+	for idx, col := range header {
+		columnType := "number"
+		if idx <= len(rs.GroupBy) {
+			// tag values and timestamp
+			columnType = "string"
+		}
+		table.Columns = append(table.Columns, &models.GrafanaColumn{Text: fmt.Sprint(col), Type: columnType})
+	}
+	for _, row := range rows {
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
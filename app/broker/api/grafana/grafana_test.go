@@ -0,0 +1,330 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestAPI() *gin.Engine {
+	api := NewAPI(&depspkg.HTTPDeps{
+		Ctx: context.Background(),
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("grafana", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	api.Register(r)
+	return r
+}
+
+func TestAPI_Test(t *testing.T) {
+	r := newTestAPI()
+	resp := mock.DoRequest(t, r, http.MethodGet, GrafanaPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAPI_Query(t *testing.T) {
+	r := newTestAPI()
+	defer func() {
+		queryCommandFn = command.QueryCommand
+		sqlParseFn = sqlpkg.Parse
+	}()
+	rangeBody := `"range":{"from":"2022-01-01T00:00:00Z","to":"2022-01-01T01:00:00.000Z"},"maxDataPoints":100`
+	resultSet := &models.ResultSet{
+		MetricName: "cpu",
+		GroupBy:    []string{"host"},
+		Fields:     []string{"usage"},
+		Series: []*models.Series{{
+			Tags:   map[string]string{"host": "a"},
+			Fields: map[string]map[int64]float64{"usage": {20: 2, 10: 1}},
+		}},
+	}
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set(constants.HeaderDatabase, "db")
+
+	cases := []struct {
+		name    string
+		reqBody string
+		headers []http.Header
+		prepare func()
+		assert  func(resp *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "invalid request",
+			reqBody: `{"targets":"abc"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "invalid range",
+			reqBody: `{"range":{"from":"abc","to":"2022-01-01T01:00:00Z"}}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "database not set",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","target":"select usage from cpu"}]}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "parse target failure",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","target":"select usage","payload":{"db":"db"}}]}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "target not select statement",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","target":"show databases","payload":{"db":"db"}}]}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+			},
+		},
+		{
+			name:    "query failure",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","target":"select usage from cpu"}]}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name: "query time series",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","target":"select usage from cpu group by host"},` +
+				`{"refId":"B","target":"select usage from cpu","hide":true},{"refId":"C","target":""}]}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+					q := stmt.(*stmtpkg.Query)
+					assert.Equal(t, "db", param.Database)
+					assert.Equal(t, timeutil.Interval(time.Minute.Milliseconds()), q.Interval)
+					assert.Equal(t, time.Hour.Milliseconds(), q.TimeRange.End-q.TimeRange.Start)
+					return resultSet, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var rs []*models.GrafanaTimeSeries
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rs))
+				assert.Equal(t, []*models.GrafanaTimeSeries{{
+					Target:     "usage{host=a}",
+					RefID:      "A",
+					DataPoints: [][2]float64{{1, 10}, {2, 20}},
+				}}, rs)
+			},
+		},
+		{
+			name:    "query table",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","type":"table","target":"select usage from cpu group by host"}]}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+					return resultSet, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				var rs []*models.GrafanaTable
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rs))
+				assert.Len(t, rs, 1)
+				assert.Equal(t, []*models.GrafanaColumn{
+					{Text: "host", Type: "string"}, {Text: "timestamp", Type: "string"}, {Text: "usage", Type: "number"},
+				}, rs[0].Columns)
+				assert.Len(t, rs[0].Rows, 2)
+			},
+		},
+		{
+			name:    "query empty result",
+			reqBody: `{` + rangeBody + `,"targets":[{"refId":"A","target":"select usage from cpu group by time(5m)"}]}`,
+			headers: []http.Header{header},
+			prepare: func() {
+				queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+					_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+					assert.Equal(t, timeutil.Interval(5*time.Minute.Milliseconds()), stmt.(*stmtpkg.Query).Interval)
+					return nil, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "[]", resp.Body.String())
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				queryCommandFn = command.QueryCommand
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, QueryPath, tt.reqBody, tt.headers...)
+			tt.assert(resp)
+		})
+	}
+}
+
+func TestAPI_Search(t *testing.T) {
+	r := newTestAPI()
+	defer func() {
+		metadataCommandFn = command.MetricMetadataCommand
+	}()
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set(constants.HeaderDatabase, "db")
+
+	resp := mock.DoRequest(t, r, http.MethodPost, SearchPath, `{"target":[]}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, SearchPath, `{"target":"cpu"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	metadataCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, SearchPath, `{"target":"cpu"}`, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	metadataCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		assert.Equal(t, "db", param.Database)
+		assert.Equal(t, "cpu", stmt.(*stmtpkg.MetricMetadata).Prefix)
+		return &models.Metadata{Type: "metric", Values: []string{"cpu", "cpu.load"}}, nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, SearchPath, `{"target":"cpu","payload":{"db":"db"}}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `["cpu","cpu.load"]`, resp.Body.String())
+	assert.Equal(t, metadataCacheControl, resp.Header().Get("Cache-Control"))
+
+	metadataCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		assert.True(t, stmt.(*stmtpkg.MetricMetadata).Fuzzy)
+		assert.Equal(t, "show metrics where metric='cpu' fuzzy", param.SQL)
+		return &models.Metadata{Type: "metric", Values: []string{"cpu", "system.cpu"}}, nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, SearchPath, `{"target":"cpu","payload":{"db":"db","fuzzy":true}}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `["cpu","system.cpu"]`, resp.Body.String())
+}
+
+func TestAPI_Variable(t *testing.T) {
+	r := newTestAPI()
+	defer func() {
+		metadataCommandFn = command.MetricMetadataCommand
+	}()
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set(constants.HeaderDatabase, "db")
+
+	resp := mock.DoRequest(t, r, http.MethodPost, VariablePath, `{"payload":[]}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, VariablePath, `{"payload":{}}`, header)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, VariablePath, `{"payload":{"target":"show tag values from cpu with key=host"}}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, VariablePath, `{"payload":{"target":"show tag values"}}`, header)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, VariablePath, `{"payload":{"target":"select f from cpu"}}`, header)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	metadataCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, VariablePath, `{"payload":{"target":"show tag values from cpu with key=host"}}`, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	metadataCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		assert.Equal(t, "db", param.Database)
+		assert.Equal(t, stmtpkg.TagValue, stmt.(*stmtpkg.MetricMetadata).Type)
+		return &models.Metadata{Type: "tagValue", Values: []string{"a", "b"}}, nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, VariablePath,
+		`{"payload":{"db":"db","target":"show tag values from cpu with key=host"}}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `[{"__text":"a","__value":"a"},{"__text":"b","__value":"b"}]`, resp.Body.String())
+	assert.Equal(t, metadataCacheControl, resp.Header().Get("Cache-Control"))
+}
+
+func TestToVariableValues(t *testing.T) {
+	assert.Empty(t, toVariableValues(nil))
+	assert.Empty(t, toVariableValues(&models.Metadata{Values: 1}))
+	assert.Equal(t, []*models.GrafanaVariableValue{{Text: "f", Value: "f"}},
+		toVariableValues(&models.Metadata{Values: []models.Field{{Name: "f"}}}))
+}
+
+func TestAutoInterval(t *testing.T) {
+	oneHour := timeutil.TimeRange{Start: 0, End: time.Hour.Milliseconds()}
+	assert.Equal(t, (10 * time.Second).Milliseconds(), autoInterval(oneHour, 0, 0))
+	assert.Equal(t, time.Minute.Milliseconds(), autoInterval(oneHour, 100, 0))
+	assert.Equal(t, (5 * time.Minute).Milliseconds(), autoInterval(oneHour, 1000, (2*time.Minute).Milliseconds()))
+	oneYear := timeutil.TimeRange{Start: 0, End: 365 * 24 * time.Hour.Milliseconds()}
+	assert.Equal(t, (24 * time.Hour).Milliseconds(), autoInterval(oneYear, 100, 0))
+}
+
+func TestParseRange(t *testing.T) {
+	_, err := parseRange(models.GrafanaRange{From: "2022-01-01T00:00:00Z", To: "abc"})
+	assert.Error(t, err)
+	_, err = parseRange(models.GrafanaRange{From: "2022-01-01T01:00:00Z", To: "2022-01-01T00:00:00Z"})
+	assert.Error(t, err)
+}
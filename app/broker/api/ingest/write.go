@@ -21,6 +21,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-http-utils/headers"
@@ -33,20 +35,27 @@ import (
 	"github.com/lindb/lindb/ingestion/flat"
 	"github.com/lindb/lindb/ingestion/influx"
 	"github.com/lindb/lindb/ingestion/proto"
+	"github.com/lindb/lindb/ingestion/route"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
 )
 
 var (
 	// WritePath represents write http api router path.
 	WritePath = "/write"
+	// ValidatePath represents write validation(dry-run) http api router path.
+	ValidatePath = "/write/validate"
 )
 
 // Write represents write api that processes flat/proto/influx protocol data.
 type Write struct {
-	deps *depspkg.HTTPDeps
+	deps      *depspkg.HTTPDeps
+	router    *route.Router
+	latencies sync.Map // database => *metrics.BrokerWriteLatencyStatistics
 
 	statistics struct {
 		flat   *linmetric.BoundHistogram
@@ -59,7 +68,8 @@ type Write struct {
 func NewWrite(deps *depspkg.HTTPDeps) *Write {
 	ingestStatistics := metrics.NewCommonIngestionStatistics()
 	return &Write{
-		deps: deps,
+		deps:   deps,
+		router: route.NewRouter(deps.BrokerCfg.BrokerBase.Ingestion.Routes),
 		statistics: struct {
 			flat   *linmetric.BoundHistogram
 			proto  *linmetric.BoundHistogram
@@ -76,6 +86,8 @@ func NewWrite(deps *depspkg.HTTPDeps) *Write {
 func (w *Write) Register(route gin.IRoutes) {
 	route.POST(WritePath, w.Write)
 	route.PUT(WritePath, w.Write)
+	route.POST(ValidatePath, w.Validate)
+	route.PUT(ValidatePath, w.Validate)
 }
 
 // Write processes flat/proto/influx protocol data with ingest limit.
@@ -110,13 +122,43 @@ func (w *Write) Write(c *gin.Context) {
 	}
 }
 
+// Validate validates flat/proto protocol data without writing(dry-run).
+//
+// @BasePath /api/v1
+// @Summary validate metric data
+// @Schemes
+// @Description receive metric data, then only validate the data based on content type(flat buffer/proto buffer)
+// @Description and database limits, returns the validation error of each metric, nothing is persisted.
+// @Description support content-type as below:
+// @Description 1. application/flatbuffer
+// @Description 2. application/protobuf
+// @Tags Write
+// @Accept application/flatbuffer
+// @Accept application/protobuf
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param string body string ture "metric data"
+// @Produce json
+// @Success 200 {object} models.WriteValidation
+// @Failure 500 {string} string "internal error"
+// @Router /write/validate [put]
+// @Router /write/validate [post]
+func (w *Write) Validate(c *gin.Context) {
+	var result *models.WriteValidation
+	if err := w.deps.IngestLimiter.Do(func() (err error) {
+		result, err = w.validate(c)
+		return err
+	}); err != nil {
+		http.Error(c, err)
+	} else {
+		http.OK(c, result)
+	}
+}
+
 // parse flat/proto/influx protocol data, then write parsed data to database's write channel.
 func (w *Write) write(c *gin.Context) (err error) {
-	var param struct {
-		Database  string `form:"db" binding:"required"`
-		Namespace string `form:"ns"`
-	}
-	err = c.ShouldBindQuery(&param)
+	start := time.Now()
+	param, enrichedTags, limits, err := w.parseParam(c)
 	if err != nil {
 		return err
 	}
@@ -124,27 +166,7 @@ func (w *Write) write(c *gin.Context) (err error) {
 		w.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
 	defer cancel()
 
-	if param.Namespace == "" {
-		param.Namespace = commonconstants.DefaultNamespace
-	}
-	enrichedTags, err := ingestCommon.ExtractEnrichTags(c.Request)
-	if err != nil {
-		return err
-	}
-
-	limits := w.deps.StateMgr.GetDatabaseLimits(param.Database)
-	for _, tag := range enrichedTags {
-		if limits.EnableTagNameLengthCheck() && len(tag.Key) > limits.MaxTagNameLength {
-			return constants.ErrTagKeyTooLong
-		}
-		if limits.EnableTagValueLengthCheck() && len(tag.Value) > limits.MaxTagValueLength {
-			return constants.ErrTagValueTooLong
-		}
-	}
-	if limits.EnableNamespaceLengthCheck() && len(param.Namespace) > limits.MaxNamespaceLength {
-		return constants.ErrNamespaceTooLong
-	}
-	contentType := strings.ToLower(strings.Trim(c.Request.Header.Get(headers.ContentType), " "))
+	contentType := getContentType(c)
 	var rows *metric.BrokerBatchRows
 	switch {
 	case strings.HasPrefix(contentType, constants.ContentTypeFlat):
@@ -160,8 +182,89 @@ func (w *Write) write(c *gin.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	if err := w.deps.CM.Write(ctx, param.Database, rows); err != nil {
+	w.getLatencyStatistics(param.Database).Decode.UpdateSince(start)
+	// relabel/route rows to target database/namespace before sharding
+	batches, err := w.router.Route(param.Database, rows)
+	if err != nil {
 		return err
 	}
+	// reject the whole request before writing any batch if one of target databases is write disabled
+	for database := range batches {
+		if cfg, ok := w.deps.StateMgr.GetDatabaseCfg(database); ok && cfg.WriteDisabled {
+			return fmt.Errorf("%w, database: %s", constants.ErrDatabaseWriteDisabled, database)
+		}
+	}
+	for database, batch := range batches {
+		if err := w.deps.CM.Write(ctx, database, batch); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// getLatencyStatistics returns the write latency statistics of database.
+func (w *Write) getLatencyStatistics(database string) *metrics.BrokerWriteLatencyStatistics {
+	if statistics, ok := w.latencies.Load(database); ok {
+		return statistics.(*metrics.BrokerWriteLatencyStatistics)
+	}
+	statistics, _ := w.latencies.LoadOrStore(database, metrics.NewBrokerWriteLatencyStatistics(database))
+	return statistics.(*metrics.BrokerWriteLatencyStatistics)
+}
+
+// validate flat/proto protocol data based on database limits, returns the validation error of each metric.
+func (w *Write) validate(c *gin.Context) (*models.WriteValidation, error) {
+	param, enrichedTags, limits, err := w.parseParam(c)
+	if err != nil {
+		return nil, err
+	}
+	contentType := getContentType(c)
+	switch {
+	case strings.HasPrefix(contentType, constants.ContentTypeFlat):
+		return flat.Validate(c.Request, enrichedTags, param.Namespace, limits)
+	case strings.HasPrefix(contentType, constants.ContentTypeProto):
+		return proto.Validate(c.Request, enrichedTags, param.Namespace, limits)
+	default:
+		return nil, fmt.Errorf("not support content type: %s, only support %s/%s", contentType,
+			constants.ContentTypeFlat, constants.ContentTypeProto)
+	}
+}
+
+// writeParam represents the query params of write request.
+type writeParam struct {
+	Database  string `form:"db" binding:"required"`
+	Namespace string `form:"ns"`
+}
+
+// parseParam parses the query params/enriched tags of write request, and checks them with database limits.
+func (w *Write) parseParam(c *gin.Context) (param *writeParam, enrichedTags tag.Tags, limits *models.Limits, err error) {
+	param = &writeParam{}
+	if err = c.ShouldBindQuery(param); err != nil {
+		return nil, nil, nil, err
+	}
+	if param.Namespace == "" {
+		param.Namespace = commonconstants.DefaultNamespace
+	}
+	enrichedTags, err = ingestCommon.ExtractEnrichTags(c.Request)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	limits = w.deps.StateMgr.GetDatabaseLimits(param.Database)
+	for _, enrichedTag := range enrichedTags {
+		if limits.EnableTagNameLengthCheck() && len(enrichedTag.Key) > limits.MaxTagNameLength {
+			return nil, nil, nil, constants.ErrTagKeyTooLong
+		}
+		if limits.EnableTagValueLengthCheck() && len(enrichedTag.Value) > limits.MaxTagValueLength {
+			return nil, nil, nil, constants.ErrTagValueTooLong
+		}
+	}
+	if limits.EnableNamespaceLengthCheck() && len(param.Namespace) > limits.MaxNamespaceLength {
+		return nil, nil, nil, constants.ErrNamespaceTooLong
+	}
+	return param, enrichedTags, limits, nil
+}
+
+// getContentType returns the content type of request.
+func getContentType(c *gin.Context) string {
+	return strings.ToLower(strings.Trim(c.Request.Header.Get(headers.ContentType), " "))
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/replica"
@@ -53,6 +54,7 @@ func TestWrite_Flat(t *testing.T) {
 
 	stateMgr := broker.NewMockStateManager(ctrl)
 	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(models.NewDefaultLimits()).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
 	cm := replica.NewMockChannelManager(ctrl)
 	api := NewWrite(&deps.HTTPDeps{
 		BrokerCfg: &config.Broker{
@@ -112,6 +114,62 @@ func TestWrite_Flat(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, resp.Code)
 }
 
+func TestWrite_Route(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(models.NewDefaultLimits()).AnyTimes()
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+					Routes:        []config.IngestionRoute{{Metric: "k8s.*", TargetDatabase: "k8s"}},
+				},
+			},
+		},
+		StateMgr: stateMgr,
+		CM:       cm,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("route_write_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	converter := metric.NewProtoConverter(models.NewDefaultLimits())
+	var buf bytes.Buffer
+	for _, name := range []string{"cpu", "k8s.pod"} {
+		var brokerRow metric.BrokerRow
+		err := converter.ConvertTo(&protoMetricsV1.Metric{
+			Name:      name,
+			Timestamp: timeutil.Now(),
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+		}, &brokerRow)
+		assert.NoError(t, err)
+		_, _ = brokerRow.WriteTo(&buf)
+	}
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeFlat)
+
+	// write of target database disabled, reject whole request
+	stateMgr.EXPECT().GetDatabaseCfg("k8s").Return(models.Database{WriteDisabled: true}, true)
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
+	resp := mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", buf.String(), header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, resp.Body.String(), constants.ErrDatabaseWriteDisabled.Error())
+
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil)
+	cm.EXPECT().Write(gomock.Any(), "k8s", gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", buf.String(), header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
 func TestWrite_Influx(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -119,6 +177,7 @@ func TestWrite_Influx(t *testing.T) {
 	cm := replica.NewMockChannelManager(ctrl)
 	stateMgr := broker.NewMockStateManager(ctrl)
 	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(models.NewDefaultLimits()).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
 	api := NewWrite(&deps.HTTPDeps{
 		BrokerCfg: &config.Broker{
 			BrokerBase: config.BrokerBase{
@@ -187,6 +246,7 @@ func TestWrite_Proto(t *testing.T) {
 	limits.MaxTagNameLength = 5
 	limits.MaxTagValueLength = 5
 	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(limits).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
 	api := NewWrite(&deps.HTTPDeps{
 		BrokerCfg: &config.Broker{
 			BrokerBase: config.BrokerBase{
@@ -252,3 +312,67 @@ func TestWrite_Proto(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test&ns=ns4&enrich_tag=a=b", string(data), header)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
+
+func TestWrite_Validate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	limits := models.NewDefaultLimits()
+	limits.MaxMetricNameLength = 3
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(limits).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false).AnyTimes()
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{},
+		StateMgr:  stateMgr,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("validate_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	// missing db param
+	resp := mock.DoRequest(t, r, http.MethodPut, ValidatePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// not support content type
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeInflux)
+	resp = mock.DoRequest(t, r, http.MethodPut, ValidatePath+"?db=test", "cpu f1=1", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	metricList := protoMetricsV1.MetricList{Metrics: []*protoMetricsV1.Metric{
+		{Name: "cpu", Timestamp: timeutil.Now(), SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}}},
+		{Name: "memory", Timestamp: timeutil.Now(), SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}}},
+	}}
+	expect := &models.WriteValidation{
+		Total:  2,
+		Valid:  1,
+		Errors: []models.MetricError{{Index: 1, Metric: "memory", Error: constants.ErrMetricNameTooLong.Error()}},
+	}
+	// proto
+	data, _ := metricList.Marshal()
+	header.Set(headers.ContentType, constants.ContentTypeProto)
+	resp = mock.DoRequest(t, r, http.MethodPost, ValidatePath+"?db=test", string(data), header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	result := &models.WriteValidation{}
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), result))
+	assert.Equal(t, expect, result)
+	// flat
+	converter := metric.NewProtoConverter(models.NewDefaultLimits())
+	var buf bytes.Buffer
+	for _, m := range metricList.Metrics {
+		_, err := converter.MarshalProtoMetricV1To(m, &buf)
+		assert.NoError(t, err)
+	}
+	header.Set(headers.ContentType, constants.ContentTypeFlat)
+	resp = mock.DoRequest(t, r, http.MethodPost, ValidatePath+"?db=test", buf.String(), header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	result = &models.WriteValidation{}
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), result))
+	assert.Equal(t, expect, result)
+}
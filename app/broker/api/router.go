@@ -22,9 +22,11 @@ import (
 
 	"github.com/lindb/lindb/app/broker/api/admin"
 	"github.com/lindb/lindb/app/broker/api/exec"
+	"github.com/lindb/lindb/app/broker/api/grafana"
 	"github.com/lindb/lindb/app/broker/api/ingest"
 	"github.com/lindb/lindb/app/broker/api/state"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	storagestate "github.com/lindb/lindb/app/storage/api/state"
 	"github.com/lindb/lindb/constants"
 	apipkg "github.com/lindb/lindb/internal/api"
 	"github.com/lindb/lindb/internal/linmetric"
@@ -36,11 +38,16 @@ type API struct {
 	deps *depspkg.HTTPDeps
 
 	execute            *exec.ExecuteAPI
+	grafana            *grafana.API
 	database           *admin.DatabaseAPI
 	flusher            *admin.DatabaseFlusherAPI
 	storage            *admin.StorageClusterAPI
 	brokerStateMachine *state.BrokerStateMachineAPI
+	topology           *state.TopologyAPI
+	replicaChannel     *state.ReplicaChannelAPI
+	limits             *state.LimitsAPI
 	request            *apipkg.RequestAPI
+	requestState       *storagestate.RequestAPI
 	metricExplore      *apipkg.ExploreAPI
 	log                *apipkg.LoggerAPI
 	config             *apipkg.ConfigAPI
@@ -54,11 +61,16 @@ func NewAPI(deps *depspkg.HTTPDeps) *API {
 	return &API{
 		deps:               deps,
 		execute:            exec.NewExecuteAPI(deps),
+		grafana:            grafana.NewAPI(deps),
 		database:           admin.NewDatabaseAPI(deps),
 		flusher:            admin.NewDatabaseFlusherAPI(deps),
 		storage:            admin.NewStorageClusterAPI(deps),
 		brokerStateMachine: state.NewBrokerStateMachineAPI(deps),
+		topology:           state.NewTopologyAPI(deps),
+		replicaChannel:     state.NewReplicaChannelAPI(deps),
+		limits:             state.NewLimitsAPI(deps),
 		request:            apipkg.NewRequestAPI(),
+		requestState:       storagestate.NewRequestAPI(),
 		metricExplore:      apipkg.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
 		log:                apipkg.NewLoggerAPI(deps.BrokerCfg.Logging.Dir),
 		config:             apipkg.NewConfigAPI(deps.Node, deps.BrokerCfg),
@@ -74,6 +86,8 @@ func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	v1 := router.Group(constants.APIVersion1)
 	// execute lin query language statement
 	api.execute.Register(v1)
+	// query api compatible with grafana json datasource
+	api.grafana.Register(v1)
 
 	api.database.Register(v1)
 	api.flusher.Register(v1)
@@ -81,7 +95,12 @@ func (api *API) RegisterRouter(router *gin.RouterGroup) {
 
 	// state
 	api.brokerStateMachine.Register(v1)
+	api.topology.Register(v1)
+	api.replicaChannel.Register(v1)
+	api.limits.Register(v1)
 	api.request.Register(v1)
+	// request state of broker's pipeline, same as storage node
+	v1.GET(storagestate.RequestPath, api.requestState.GetRequestState)
 
 	// write metric data
 	api.write.Register(v1)
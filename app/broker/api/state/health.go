@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lindb/lindb/coordinator/broker"
+	apipkg "github.com/lindb/lindb/internal/api"
+	"github.com/lindb/lindb/models"
+)
+
+// maxUnhealthyTargets represents the max number of unhealthy shards/connections in health check message.
+const maxUnhealthyTargets = 3
+
+// NewReplicationHealthCheck creates the replication stream health check of broker,
+// replication is unhealthy if any shard is offline(no leader for writing) or connection circuit breaker is open.
+func NewReplicationHealthCheck(stateMgr broker.StateManager) apipkg.HealthCheck {
+	return apipkg.HealthCheck{
+		Name: "replication",
+		Check: func(_ context.Context) error {
+			if stateMgr == nil {
+				return errors.New("state manager not initialized")
+			}
+			var offlineShards, openConnections []string
+			for _, storage := range stateMgr.GetStorageList() {
+				for db, shards := range storage.ShardStates {
+					for id, shard := range shards {
+						if shard.State == models.OfflineShard || shard.Leader == models.NoLeader {
+							offlineShards = append(offlineShards, fmt.Sprintf("%s/%s/%d", storage.Name, db, id))
+						}
+					}
+				}
+			}
+			for _, breaker := range stateMgr.GetCircuitBreakerStates() {
+				if breaker.State == "open" {
+					openConnections = append(openConnections, breaker.Target)
+				}
+			}
+			var msgs []string
+			if len(offlineShards) > 0 {
+				msgs = append(msgs, "offline shards: "+joinUnhealthyTargets(offlineShards))
+			}
+			if len(openConnections) > 0 {
+				msgs = append(msgs, "circuit breaker open: "+joinUnhealthyTargets(openConnections))
+			}
+			if len(msgs) == 0 {
+				return nil
+			}
+			return errors.New(strings.Join(msgs, "; "))
+		},
+	}
+}
+
+// joinUnhealthyTargets joins the sorted unhealthy targets, keeps max targets.
+func joinUnhealthyTargets(targets []string) string {
+	sort.Strings(targets)
+	if len(targets) > maxUnhealthyTargets {
+		targets = append(targets[:maxUnhealthyTargets], "...")
+	}
+	return strings.Join(targets, ", ")
+}
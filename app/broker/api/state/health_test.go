@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+)
+
+func TestReplicationHealthCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	assert.Error(t, NewReplicationHealthCheck(nil).Check(context.TODO()))
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	check := NewReplicationHealthCheck(stateMgr)
+	storage := models.NewStorageState("cluster")
+	storage.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 1},
+	}
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{storage}).AnyTimes()
+
+	// replication ok
+	stateMgr.EXPECT().GetCircuitBreakerStates().Return([]models.CircuitBreakerState{{Target: "1.1.1.1:2891", State: "closed"}})
+	assert.NoError(t, check.Check(context.TODO()))
+
+	// shard offline and circuit breaker open
+	for i := 2; i < 6; i++ {
+		storage.ShardStates["db"][models.ShardID(i)] = models.ShardState{ID: models.ShardID(i), State: models.OfflineShard, Leader: models.NoLeader}
+	}
+	stateMgr.EXPECT().GetCircuitBreakerStates().Return([]models.CircuitBreakerState{{Target: "1.1.1.1:2891", State: "open"}})
+	err := check.Check(context.TODO())
+	assert.Error(t, err)
+	assert.Equal(t, "offline shards: cluster/db/2, cluster/db/3, cluster/db/4, ...; circuit breaker open: 1.1.1.1:2891", err.Error())
+}
@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/pkg/http"
+)
+
+var (
+	LimitsPath = "/state/limits"
+)
+
+// LimitsAPI represents the api of database's limits applied state on broker node.
+type LimitsAPI struct {
+	deps *depspkg.HTTPDeps
+}
+
+// NewLimitsAPI creates database's limits state api instance.
+func NewLimitsAPI(deps *depspkg.HTTPDeps) *LimitsAPI {
+	return &LimitsAPI{
+		deps: deps,
+	}
+}
+
+// Register adds limits state url route.
+func (api *LimitsAPI) Register(route gin.IRoutes) {
+	route.GET(LimitsPath, api.GetLimitsState)
+}
+
+// GetLimitsState returns the state of database's limits applied on current node.
+func (api *LimitsAPI) GetLimitsState(c *gin.Context) {
+	var param struct {
+		Database string `form:"db" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	http.OK(c, api.deps.StateMgr.GetDatabaseLimitsState(param.Database))
+}
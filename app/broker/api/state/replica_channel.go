@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/pkg/http"
+)
+
+var (
+	ReplicaChannelPath = "/state/replica/channel"
+)
+
+// ReplicaChannelAPI represents the write channel state api of broker.
+type ReplicaChannelAPI struct {
+	deps *depspkg.HTTPDeps
+}
+
+// NewReplicaChannelAPI creates the write channel state api instance.
+func NewReplicaChannelAPI(deps *depspkg.HTTPDeps) *ReplicaChannelAPI {
+	return &ReplicaChannelAPI{
+		deps: deps,
+	}
+}
+
+// Register adds write channel state url route.
+func (api *ReplicaChannelAPI) Register(route gin.IRoutes) {
+	route.GET(ReplicaChannelPath, api.GetChannelStates)
+}
+
+// GetChannelStates returns the write state of family channels(pending/retry/last send etc.) in current broker,
+// returns all databases if db param is empty.
+func (api *ReplicaChannelAPI) GetChannelStates(c *gin.Context) {
+	var param struct {
+		Database string `form:"db"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	http.OK(c, api.deps.CM.ChannelStates(param.Database))
+}
@@ -0,0 +1,50 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/replica"
+)
+
+func TestReplicaChannelAPI_GetChannelStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewReplicaChannelAPI(&depspkg.HTTPDeps{CM: cm})
+	r := gin.New()
+	api.Register(r)
+
+	cm.EXPECT().ChannelStates("db").Return(models.ReplicaChannelStates{{Database: "db", ShardID: 1, Pending: 2}})
+	resp := mock.DoRequest(t, r, http.MethodGet, ReplicaChannelPath+"?db=db", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var states models.ReplicaChannelStates
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &states))
+	assert.Equal(t, int64(2), states[0].Pending)
+}
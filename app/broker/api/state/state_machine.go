@@ -137,6 +137,8 @@ func (api *BrokerStateMachineAPI) exploreBroker(c *gin.Context, param *Param) {
 		http.OK(c, nodes)
 	case constants.DatabaseConfig:
 		api.writeDatabaseState(c, api.deps.StateMgr.GetDatabases())
+	case constants.CircuitBreaker:
+		http.OK(c, api.deps.StateMgr.GetCircuitBreakerStates())
 	default:
 		http.NotFound(c)
 	}
@@ -123,6 +123,18 @@ func TestBrokerStateMachineAPI_Explore(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name: "broker state, circuit breaker",
+			req:  `role=2&type=` + constants.CircuitBreaker,
+			prepare: func() {
+				stateMgr.EXPECT().GetCircuitBreakerStates().Return([]models.CircuitBreakerState{
+					{Target: "1.1.1.1:9000", State: "open", Failures: 5},
+				})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{
 			name: "master state, type not match",
 			req:  `role=3&type=` + constants.LiveNode,
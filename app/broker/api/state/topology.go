@@ -0,0 +1,118 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	TopologyPath = "/state/topology"
+)
+
+// TopologyAPI represents broker cluster topology api.
+type TopologyAPI struct {
+	deps   *depspkg.HTTPDeps
+	cli    client.TopologyCli
+	logger *logger.Logger
+}
+
+// NewTopologyAPI creates broker cluster topology api instance.
+func NewTopologyAPI(deps *depspkg.HTTPDeps) *TopologyAPI {
+	return &TopologyAPI{
+		deps:   deps,
+		cli:    client.NewTopologyCli(),
+		logger: logger.GetLogger("Broker", "TopologyAPI"),
+	}
+}
+
+// Register adds topology url route.
+func (api *TopologyAPI) Register(route gin.IRoutes) {
+	route.GET(TopologyPath, api.GetTopology)
+}
+
+// GetTopology returns the topology of broker cluster(brokers->storages->databases->shards->replicas),
+// includes replica lag if lag param is true.
+func (api *TopologyAPI) GetTopology(c *gin.Context) {
+	var param struct {
+		Lag bool `form:"lag"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	topology := &models.BrokerTopology{}
+	liveNodes := api.deps.StateMgr.GetLiveNodes()
+	for idx := range liveNodes {
+		indicator := liveNodes[idx].Indicator()
+		topology.Nodes = append(topology.Nodes, models.NodeTopology{ID: indicator, Address: indicator})
+	}
+	sort.Slice(topology.Nodes, func(i, j int) bool {
+		return topology.Nodes[i].ID < topology.Nodes[j].ID
+	})
+	storages := api.deps.StateMgr.GetStorageList()
+	sort.Slice(storages, func(i, j int) bool {
+		return storages[i].Name < storages[j].Name
+	})
+	for _, storage := range storages {
+		storageTopology := models.NewStorageTopology(storage)
+		if param.Lag {
+			api.fillLag(storage, storageTopology)
+		}
+		topology.Storages = append(topology.Storages, storageTopology)
+	}
+	http.OK(c, topology)
+}
+
+// fillLag fills the replica lag of databases, fetches wal replica state from live nodes of storage cluster.
+func (api *TopologyAPI) fillLag(storage *models.StorageState, topology *models.StorageTopology) {
+	var (
+		wait  sync.WaitGroup
+		mutex sync.Mutex
+	)
+	for _, database := range topology.Databases {
+		for id := range storage.LiveNodes {
+			node := storage.LiveNodes[id]
+			db := database
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				state, err := api.cli.FetchReplicaState(&node, db.Name)
+				if err != nil {
+					api.logger.Warn("fetch replica state from storage node failure",
+						logger.String("storage", storage.Name), logger.String("database", db.Name),
+						logger.String("node", node.Indicator()), logger.Error(err))
+					return
+				}
+				mutex.Lock()
+				db.FillLag(state)
+				mutex.Unlock()
+			}()
+		}
+	}
+	wait.Wait()
+}
@@ -0,0 +1,94 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestTopologyAPI_GetTopology(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	cli := client.NewMockTopologyCli(ctrl)
+	api := NewTopologyAPI(&depspkg.HTTPDeps{StateMgr: stateMgr})
+	api.cli = cli
+	r := gin.New()
+	api.Register(r)
+
+	newStorageState := func() *models.StorageState {
+		state := models.NewStorageState("storage")
+		state.LiveNodes[1] = models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: "1.1.1.1"}}
+		state.LiveNodes[2] = models.StatefulNode{ID: 2, StatelessNode: models.StatelessNode{HostIP: "1.1.1.2"}}
+		state.ShardStates["db"] = map[models.ShardID]models.ShardState{
+			0: {ID: 0, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2}}},
+		}
+		return state
+	}
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "2.2.2.2", GRPCPort: 9000}}).AnyTimes()
+
+	t.Run("param invalid", func(t *testing.T) {
+		resp := mock.DoRequest(t, r, http.MethodGet, TopologyPath+"?lag=abc", "")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("topology without lag", func(t *testing.T) {
+		stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{newStorageState()})
+		resp := mock.DoRequest(t, r, http.MethodGet, TopologyPath, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		topology := &models.BrokerTopology{}
+		assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), topology))
+		assert.Equal(t, "2.2.2.2:9000", topology.Nodes[0].ID)
+		replicas := topology.Storages[0].Databases[0].Shards[0].Replicas
+		assert.Equal(t, models.LeaderRole, replicas[0].Role)
+		assert.True(t, replicas[1].Online)
+	})
+	t.Run("topology with lag", func(t *testing.T) {
+		stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{newStorageState()})
+		cli.EXPECT().FetchReplicaState(gomock.Any(), "db").
+			DoAndReturn(func(node models.Node, _ string) ([]models.FamilyLogReplicaState, error) {
+				if node.Indicator() == "1.1.1.2:0" {
+					return nil, fmt.Errorf("err")
+				}
+				return []models.FamilyLogReplicaState{{
+					ShardID:     0,
+					Replicators: []models.ReplicaPeerState{{Replicator: "2", Pending: 10}},
+				}}, nil
+			}).Times(2)
+		resp := mock.DoRequest(t, r, http.MethodGet, TopologyPath+"?lag=true", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		topology := &models.BrokerTopology{}
+		assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), topology))
+		replicas := topology.Storages[0].Databases[0].Shards[0].Replicas
+		assert.Equal(t, int64(0), replicas[0].Lag)
+		assert.Equal(t, int64(10), replicas[1].Lag)
+	})
+}
@@ -29,12 +29,14 @@ import (
 
 	"github.com/lindb/lindb/app"
 	"github.com/lindb/lindb/app/broker/api"
+	brokerstate "github.com/lindb/lindb/app/broker/api/state"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
+	apipkg "github.com/lindb/lindb/internal/api"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
@@ -104,12 +106,15 @@ type runtime struct {
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            broker.StateManager
 
-	grpcServer rpc.GRPCServer
-	rpcHandler *rpcHandler
-	queryPool  concurrent.Pool
+	grpcServer  rpc.GRPCServer
+	rpcHandler  *rpcHandler
+	queryPool   concurrent.Pool
+	drainFilter *api.DrainFilter
 
 	ctx                 context.Context
 	cancel              context.CancelFunc
+	requestCtx          context.Context    // context of http requests, canceled if drain timeout
+	requestCancel       context.CancelFunc // cancels inflight http requests
 	globalKeyValues     tag.Tags
 	enableSystemMonitor bool
 
@@ -126,12 +131,11 @@ func NewBrokerRuntime(version string, cfg *config.Broker, enableSystemMonitor bo
 		repoFactory: newRepositoryFactory("broker"),
 		ctx:         ctx,
 		cancel:      cancel,
-		queryPool: concurrent.NewPool(
-			"task-pool",
+		queryPool: concurrent.NewPriorityPool(
+			"broker-query",
 			cfg.Query.QueryConcurrency,
 			cfg.Query.IdleTimeout.Duration(),
-			metrics.NewConcurrentStatistics("broker-query", linmetric.BrokerRegistry),
-		),
+			linmetric.BrokerRegistry),
 		enableSystemMonitor: enableSystemMonitor,
 		logger:              logger.GetLogger("Broker", "Runtime"),
 	}
@@ -162,6 +166,7 @@ func (r *runtime) Run() error {
 		HTTPPort:   r.config.BrokerBase.HTTP.Port,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
+		Labels:     app.GetNodeLabels(),
 	}
 
 	r.logger.Info("starting broker", logger.String("host", hostName), logger.String("ip", ip),
@@ -184,14 +189,15 @@ func (r *runtime) Run() error {
 	r.factory = factory{
 		taskClient:    tackClientFct,
 		taskServer:    rpc.NewTaskServerFactory(),
-		connectionMgr: rpc.NewConnectionManager(tackClientFct),
+		connectionMgr: rpc.NewConnectionManager(tackClientFct, linmetric.BrokerRegistry),
 	}
 
 	r.stateMgr = newStateManager(
 		r.ctx,
 		*r.node,
 		r.factory.connectionMgr,
-		r.factory.taskClient)
+		r.factory.taskClient,
+		r.config.Query.FollowerRead)
 
 	r.buildServiceDependency()
 
@@ -282,6 +288,16 @@ func (r *runtime) Stop() {
 	r.logger.Info("stopping broker server...")
 	defer r.cancel()
 
+	if r.state == server.Running {
+		r.drain()
+	}
+	if r.srv.channelManager != nil {
+		// flush pending data of write channels before deregister
+		r.logger.Info("closing write channel manager...")
+		r.srv.channelManager.Close()
+		r.logger.Info("closed write channel successfully")
+	}
+
 	r.Shutdown()
 
 	if r.httpServer != nil {
@@ -326,11 +342,6 @@ func (r *runtime) Stop() {
 	if r.stateMgr != nil {
 		r.stateMgr.Close()
 	}
-	if r.srv.channelManager != nil {
-		r.logger.Info("closing write channel manager...")
-		r.srv.channelManager.Close()
-		r.logger.Info("closed write channel successfully")
-	}
 
 	if r.factory.connectionMgr != nil {
 		if err := r.factory.connectionMgr.Close(); err != nil {
@@ -352,13 +363,34 @@ func (r *runtime) Stop() {
 	r.logger.Info("stopped broker server successfully")
 }
 
+// drain rejects new query/write requests, waits inflight requests completed,
+// cancels inflight requests if drain timeout.
+func (r *runtime) drain() {
+	if r.drainFilter == nil {
+		return
+	}
+	r.state = server.Draining
+	timeout := r.config.BrokerBase.DrainTimeout.Duration()
+	r.logger.Info("draining broker, waiting inflight requests completed...",
+		logger.Int32("inflight", r.drainFilter.Inflight()), logger.String("timeout", timeout.String()))
+	if r.drainFilter.Drain(timeout) {
+		r.logger.Info("drained broker successfully")
+		return
+	}
+	r.logger.Warn("drain broker timeout, cancel inflight requests",
+		logger.Int32("inflight", r.drainFilter.Inflight()))
+	r.requestCancel()
+}
+
 // startHTTPServer starts http server for api rpcHandler
 func (r *runtime) startHTTPServer() {
 	r.logger.Info("starting HTTP server")
 	r.httpServer = newHTTPServer(r.config.BrokerBase.HTTP, true, linmetric.BrokerRegistry)
+	r.requestCtx, r.requestCancel = context.WithCancel(r.ctx)
+	r.drainFilter = api.NewDrainFilter()
 	// TODO login api is not registered
 	httpAPI := api.NewAPI(&deps.HTTPDeps{
-		Ctx:          r.ctx,
+		Ctx:          r.requestCtx,
 		Node:         r.node,
 		BrokerCfg:    r.config,
 		Master:       r.master,
@@ -382,7 +414,15 @@ func (r *runtime) startHTTPServer() {
 		),
 		GlobalKeyValues: r.globalKeyValues,
 	})
-	httpAPI.RegisterRouter(r.httpServer.GetAPIRouter())
+	router := r.httpServer.GetAPIRouter()
+	// track/reject query/write requests for draining
+	router.Use(r.drainFilter.Handle())
+	httpAPI.RegisterRouter(router)
+	healthAPI := apipkg.NewHealthAPI(r.State,
+		apipkg.NewRepoHealthCheck(func() state.Repository { return r.repo }),
+		brokerstate.NewReplicationHealthCheck(r.stateMgr),
+	)
+	healthAPI.Register(router.Group(constants.APIVersion1))
 	go r.runHTTPServer()
 }
 
@@ -418,7 +458,7 @@ func (r *runtime) buildServiceDependency() {
 	s := srv{
 		channelManager:   cm,
 		taskManager:      taskMgr,
-		transportManager: query.NewTransportManager(r.factory.taskClient, r.factory.taskServer, linmetric.BrokerRegistry),
+		transportManager: query.NewTransportManager(r.factory.taskClient, r.factory.taskServer, r.factory.connectionMgr, linmetric.BrokerRegistry),
 	}
 	r.srv = s
 }
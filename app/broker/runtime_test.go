@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/api"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
 	brokerpkg "github.com/lindb/lindb/coordinator/broker"
@@ -38,6 +39,7 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
@@ -318,6 +320,43 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 	}
 }
 
+func TestBrokerRuntime_drain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	channelMgr := replica.NewMockChannelManager(ctrl)
+	ctx, cancel := context.WithCancel(context.TODO())
+	requestCtx, requestCancel := context.WithCancel(ctx)
+	r := &runtime{
+		ctx:           ctx,
+		cancel:        cancel,
+		requestCtx:    requestCtx,
+		requestCancel: requestCancel,
+		state:         server.Running,
+		config: &config.Broker{
+			BrokerBase: config.BrokerBase{DrainTimeout: ltoml.Duration(time.Second)},
+		},
+		srv: srv{
+			channelManager: channelMgr,
+		},
+		logger: logger.GetLogger("Runtime", "Test"),
+	}
+	// drain filter not init
+	r.drain()
+	assert.Equal(t, server.Running, r.State())
+
+	r.drainFilter = api.NewDrainFilter()
+	r.drain()
+	assert.Equal(t, server.Draining, r.State())
+	assert.NoError(t, r.requestCtx.Err())
+
+	// flush write channels when stop
+	r.state = server.Running
+	channelMgr.EXPECT().Close()
+	r.Stop()
+	assert.Equal(t, server.Terminated, r.State())
+}
+
 func TestBrokerRuntime_startGrpcServer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -352,7 +391,7 @@ func TestBrokerRuntime_RunHTTPServer(t *testing.T) {
 func resetNewDepsMock() {
 	newStateManager = func(ctx context.Context, currentNode models.StatelessNode,
 		connectionManager rpc.ConnectionManager,
-		taskClientFactory rpc.TaskClientFactory) brokerpkg.StateManager {
+		taskClientFactory rpc.TaskClientFactory, followerRead bool) brokerpkg.StateManager {
 		return nil
 	}
 	newChannelManager = func(ctx context.Context, fct rpc.ClientStreamFactory,
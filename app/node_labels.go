@@ -0,0 +1,94 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package app
+
+import (
+	"os"
+	"strings"
+
+	"github.com/lindb/lindb/models"
+)
+
+// Defines all environment variables for node labels, kubernetes can inject them by Downward API, like:
+//
+//	env:
+//	  - name: POD_NAME
+//	    valueFrom:
+//	      fieldRef:
+//	        fieldPath: metadata.name
+const (
+	// PodNameEnv represents the pod name(metadata.name).
+	PodNameEnv = "POD_NAME"
+	// PodNamespaceEnv represents the pod namespace(metadata.namespace).
+	PodNamespaceEnv = "POD_NAMESPACE"
+	// K8sNodeNameEnv represents the kubernetes node name which pod scheduled on(spec.nodeName).
+	K8sNodeNameEnv = "NODE_NAME"
+	// ZoneEnv represents the zone which node running in, like topology.kubernetes.io/zone.
+	ZoneEnv = "LINDB_ZONE"
+	// InstanceTypeEnv represents the instance type of node, like node.kubernetes.io/instance-type.
+	InstanceTypeEnv = "LINDB_INSTANCE_TYPE"
+	// NodeLabelsEnv represents the extra labels of node, format: k1=v1,k2=v2.
+	NodeLabelsEnv = "LINDB_NODE_LABELS"
+)
+
+// for testing
+var lookupEnv = os.LookupEnv
+
+// labelEnvs maps environment variable to node label.
+var labelEnvs = []struct {
+	env   string
+	label string
+}{
+	{env: PodNameEnv, label: models.NodeLabelPodName},
+	{env: PodNamespaceEnv, label: models.NodeLabelPodNamespace},
+	{env: K8sNodeNameEnv, label: models.NodeLabelK8sNodeName},
+	{env: ZoneEnv, label: models.NodeLabelZone},
+	{env: InstanceTypeEnv, label: models.NodeLabelInstanceType},
+}
+
+// GetNodeLabels returns the labels of current node from environment variables,
+// return nil if no label found.
+func GetNodeLabels() map[string]string {
+	labels := make(map[string]string)
+	// extra labels first, well-known labels cannot be overridden by them.
+	if value, ok := lookupEnv(NodeLabelsEnv); ok {
+		for _, kv := range strings.Split(value, ",") {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			key := strings.TrimSpace(parts[0])
+			val := strings.TrimSpace(parts[1])
+			if key == "" || val == "" {
+				continue
+			}
+			labels[key] = val
+		}
+	}
+	for _, e := range labelEnvs {
+		if value, ok := lookupEnv(e.env); ok {
+			if value = strings.TrimSpace(value); value != "" {
+				labels[e.label] = value
+			}
+		}
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	return labels
+}
@@ -0,0 +1,53 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestGetNodeLabels(t *testing.T) {
+	defer func() {
+		lookupEnv = os.LookupEnv
+	}()
+	envs := make(map[string]string)
+	lookupEnv = func(key string) (string, bool) {
+		v, ok := envs[key]
+		return v, ok
+	}
+	assert.Nil(t, GetNodeLabels())
+
+	envs[PodNameEnv] = "storage-0"
+	envs[PodNamespaceEnv] = "lindb"
+	envs[K8sNodeNameEnv] = "node-1"
+	envs[ZoneEnv] = " zone-a "
+	envs[InstanceTypeEnv] = ""
+	envs[NodeLabelsEnv] = "rack=r1, zone=zone-b,bad,=v,k="
+	assert.Equal(t, map[string]string{
+		models.NodeLabelPodName:      "storage-0",
+		models.NodeLabelPodNamespace: "lindb",
+		models.NodeLabelK8sNodeName:  "node-1",
+		models.NodeLabelZone:         "zone-a",
+		"rack":                       "r1",
+	}, GetNodeLabels())
+}
@@ -26,7 +26,9 @@ import (
 
 	"github.com/lindb/lindb/app/root/api/command"
 	depspkg "github.com/lindb/lindb/app/root/deps"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	sqlpkg "github.com/lindb/lindb/sql"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -56,6 +58,7 @@ var (
 		stmtpkg.QueryStatement:          command.QueryCommand,
 		stmtpkg.StateStatement:          command.StateCommand,
 		stmtpkg.RequestStatement:        command.RequestCommand,
+		stmtpkg.LogLevelStatement:       command.LogLevelCommand,
 	}
 )
 
@@ -93,17 +96,21 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 // @Produce json
 // @Success 200 {object} models.ResultSet
 // @Success 200 {object} models.Metadata
-// @Failure 404 {string} string "not found"
-// @Failure 500 {string} string "can't parse lin query language"
-// @Failure 500 {string} string "internal error"
+// @Failure 400 {object} models.ErrorResponse "can't parse lin query language"
+// @Failure 404 {object} models.ErrorResponse "metadata not found"
+// @Failure 429 {object} models.ErrorResponse "limit exceeded"
+// @Failure 500 {object} models.ErrorResponse "internal error"
+// @Failure 503 {object} models.ErrorResponse "storage unavailable"
+// @Failure 504 {object} models.ErrorResponse "timeout"
 // @Router /exec [get]
 // @Router /exec [put]
 // @Router /exec [post]
 func (e *ExecuteAPI) Execute(c *gin.Context) {
+	_ = httppkg.WithRequestID(c)
 	if err := e.deps.QueryLimiter.Do(func() error {
 		return e.execute(c)
 	}); err != nil {
-		httppkg.Error(c, err)
+		httppkg.ErrorWithCode(c, err)
 	}
 }
 
@@ -115,11 +122,11 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	param := models.ExecuteParam{}
 	err := c.ShouldBind(&param)
 	if err != nil {
-		return err
+		return errorpkg.WithCode(errorpkg.ParseError, err)
 	}
 	stmt, err := sqlParseFn(param.SQL)
 	if err != nil {
-		return err
+		return errorpkg.WithCode(errorpkg.ParseError, err)
 	}
 
 	if commandFn, ok := commands[stmt.StatementType()]; ok {
@@ -130,9 +137,13 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 		if result == nil || reflect.ValueOf(result).IsNil() {
 			httppkg.NotFound(c)
 		} else {
+			if rs, ok := result.(*models.ResultSet); ok && len(rs.PartialFailures) > 0 {
+				// some targets failed, result only includes the others
+				c.Header(constants.HeaderErrorCode, errorpkg.PartialResult.String())
+			}
 			httppkg.OK(c, result)
 		}
 		return nil
 	}
-	return errors.New("can't parse lin query language")
+	return errorpkg.WithCode(errorpkg.ParseError, errors.New("can't parse lin query language"))
 }
@@ -79,21 +79,21 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		{
 			name: "param invalid",
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
 			},
 		},
 		{
 			name:    "parse sql failure",
 			reqBody: `{"sql":"show a"}`,
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
 			},
 		},
 		{
 			name:    "unknown statement type",
 			reqBody: `{"sql":"use brokers"}`,
 			assert: func(resp *httptest.ResponseRecorder) {
-				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
 			},
 		},
 		{
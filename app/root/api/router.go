@@ -32,6 +32,7 @@ import (
 type API struct {
 	execute          *ExecuteAPI
 	rootStateMachine *state.RootStateMachineAPI
+	topology         *state.TopologyAPI
 	request          *apipkg.RequestAPI
 	metricExplore    *apipkg.ExploreAPI
 	env              *apipkg.EnvAPI
@@ -45,6 +46,7 @@ func NewAPI(deps *depspkg.HTTPDeps) *API {
 	return &API{
 		execute:          NewExecuteAPI(deps),
 		rootStateMachine: state.NewRootStateMachineAPI(deps),
+		topology:         state.NewTopologyAPI(deps),
 		request:          apipkg.NewRequestAPI(),
 		metricExplore:    apipkg.NewExploreAPI(deps.GlobalKeyValues, linmetric.RootRegistry),
 		env:              apipkg.NewEnvAPI(deps.Cfg.Monitor, constants.RootRole),
@@ -62,6 +64,7 @@ func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	// monitoring
 	api.metricExplore.Register(v1)
 	api.rootStateMachine.Register(v1)
+	api.topology.Register(v1)
 	api.config.Register(v1)
 	api.log.Register(v1)
 	api.request.Register(v1)
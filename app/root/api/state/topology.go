@@ -0,0 +1,121 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/root/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	TopologyPath = "/state/topology"
+)
+
+// TopologyAPI represents the topology api of whole cluster.
+type TopologyAPI struct {
+	deps   *depspkg.HTTPDeps
+	cli    client.TopologyCli
+	logger *logger.Logger
+}
+
+// NewTopologyAPI creates the topology api instance.
+func NewTopologyAPI(deps *depspkg.HTTPDeps) *TopologyAPI {
+	return &TopologyAPI{
+		deps:   deps,
+		cli:    client.NewTopologyCli(),
+		logger: logger.GetLogger("Root", "TopologyAPI"),
+	}
+}
+
+// Register adds topology url route.
+func (api *TopologyAPI) Register(route gin.IRoutes) {
+	route.GET(TopologyPath, api.GetTopology)
+}
+
+// GetTopology returns the topology graph of whole cluster(root->brokers->storages->databases->shards->replicas),
+// fetches the topology of each broker cluster from one of its live nodes.
+func (api *TopologyAPI) GetTopology(c *gin.Context) {
+	var param struct {
+		Lag bool `form:"lag"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	topology := &models.Topology{}
+	liveNodes := api.deps.StateMgr.GetLiveNodes()
+	for idx := range liveNodes {
+		indicator := liveNodes[idx].Indicator()
+		topology.Roots = append(topology.Roots, models.NodeTopology{ID: indicator, Address: indicator})
+	}
+	sort.Slice(topology.Roots, func(i, j int) bool {
+		return topology.Roots[i].ID < topology.Roots[j].ID
+	})
+
+	brokerStates := api.deps.StateMgr.GetBrokerStates()
+	sort.Slice(brokerStates, func(i, j int) bool {
+		return brokerStates[i].Name < brokerStates[j].Name
+	})
+	topology.Brokers = make([]*models.BrokerTopology, len(brokerStates))
+	var wait sync.WaitGroup
+	for idx := range brokerStates {
+		i := idx
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			topology.Brokers[i] = api.getBrokerTopology(&brokerStates[i], param.Lag)
+		}()
+	}
+	wait.Wait()
+	http.OK(c, topology)
+}
+
+// getBrokerTopology returns the topology of broker cluster, tries each live node until fetch successfully.
+func (api *TopologyAPI) getBrokerTopology(state *models.BrokerState, lag bool) *models.BrokerTopology {
+	liveNodes := state.GetLiveNodes()
+	sort.Slice(liveNodes, func(i, j int) bool {
+		return liveNodes[i].Indicator() < liveNodes[j].Indicator()
+	})
+	errMsg := constants.ErrNoLiveNode.Error()
+	for idx := range liveNodes {
+		topology, err := api.cli.FetchBrokerTopology(&liveNodes[idx], lag)
+		if err == nil {
+			topology.Name = state.Name
+			return topology
+		}
+		api.logger.Warn("fetch topology from broker node failure",
+			logger.String("broker", state.Name), logger.String("node", liveNodes[idx].Indicator()), logger.Error(err))
+		errMsg = err.Error()
+	}
+	// cannot fetch topology from broker cluster, returns the live nodes of broker cluster
+	topology := &models.BrokerTopology{Name: state.Name, ErrMsg: errMsg}
+	for idx := range liveNodes {
+		indicator := liveNodes[idx].Indicator()
+		topology.Nodes = append(topology.Nodes, models.NodeTopology{ID: indicator, Address: indicator})
+	}
+	return topology
+}
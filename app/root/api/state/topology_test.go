@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/root/deps"
+	"github.com/lindb/lindb/coordinator/root"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestTopologyAPI_GetTopology(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := root.NewMockStateManager(ctrl)
+	cli := client.NewMockTopologyCli(ctrl)
+	api := NewTopologyAPI(&depspkg.HTTPDeps{StateMgr: stateMgr})
+	api.cli = cli
+	r := gin.New()
+	api.Register(r)
+
+	t.Run("param invalid", func(t *testing.T) {
+		resp := mock.DoRequest(t, r, http.MethodGet, TopologyPath+"?lag=abc", "")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("get topology", func(t *testing.T) {
+		stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{HostIP: "1.1.1.1", GRPCPort: 9000}})
+		stateMgr.EXPECT().GetBrokerStates().Return([]models.BrokerState{
+			{Name: "b2", LiveNodes: map[string]models.StatelessNode{
+				"3.3.3.3:9000": {HostIP: "3.3.3.3", GRPCPort: 9000},
+				"3.3.3.4:9000": {HostIP: "3.3.3.4", GRPCPort: 9000},
+			}},
+			{Name: "b1", LiveNodes: map[string]models.StatelessNode{"2.2.2.2:9000": {HostIP: "2.2.2.2", GRPCPort: 9000}}},
+			{Name: "b3"},
+		})
+		cli.EXPECT().FetchBrokerTopology(gomock.Any(), true).
+			DoAndReturn(func(node models.Node, _ bool) (*models.BrokerTopology, error) {
+				if node.Indicator() == "2.2.2.2:9000" {
+					return &models.BrokerTopology{Storages: []*models.StorageTopology{{Name: "storage"}}}, nil
+				}
+				return nil, fmt.Errorf("err")
+			}).Times(3)
+		resp := mock.DoRequest(t, r, http.MethodGet, TopologyPath+"?lag=true", "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		topology := &models.Topology{}
+		assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), topology))
+		assert.Equal(t, []models.NodeTopology{{ID: "1.1.1.1:9000", Address: "1.1.1.1:9000"}}, topology.Roots)
+		assert.Len(t, topology.Brokers, 3)
+		assert.Equal(t, "b1", topology.Brokers[0].Name)
+		assert.Equal(t, "storage", topology.Brokers[0].Storages[0].Name)
+		// all live nodes of broker cluster failure
+		assert.Equal(t, "b2", topology.Brokers[1].Name)
+		assert.Equal(t, "err", topology.Brokers[1].ErrMsg)
+		assert.Len(t, topology.Brokers[1].Nodes, 2)
+		// no live node
+		assert.Equal(t, "no live node for cluster", topology.Brokers[2].ErrMsg)
+	})
+}
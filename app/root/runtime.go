@@ -31,6 +31,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/coordinator/root"
+	apipkg "github.com/lindb/lindb/internal/api"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
@@ -131,6 +132,7 @@ func (r *runtime) Run() error {
 		HTTPPort:   r.config.HTTP.Port,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
+		Labels:     app.GetNodeLabels(),
 	}
 	r.globalKeyValues = tag.Tags{
 		{Key: []byte("node"), Value: []byte(r.node.Indicator())},
@@ -143,7 +145,7 @@ func (r *runtime) Run() error {
 	// build dependencies
 	repoFct := newRepositoryFactory("root")
 	taskClientFct := newTaskClientFactory(r.ctx, r.node, rpc.GetBrokerClientConnFactory())
-	connectionMgr := rpc.NewConnectionManager(taskClientFct)
+	connectionMgr := rpc.NewConnectionManager(taskClientFct, linmetric.RootRegistry)
 	stateMgr := root.NewStateManager(r.ctx, repoFct, connectionMgr)
 	taskMgr := newTaskManager(
 		concurrent.NewPool(
@@ -275,7 +277,7 @@ func (r *runtime) startHTTPServer() {
 		Repo:         r.repo,
 		RepoFactory:  r.deps.repoFct,
 		StateMgr:     r.deps.stateMgr,
-		TransportMgr: query.NewTransportManager(r.deps.taskClientFct, nil, linmetric.RootRegistry), // root node no grpc server
+		TransportMgr: query.NewTransportManager(r.deps.taskClientFct, nil, r.deps.connectionMgr, linmetric.RootRegistry), // root node no grpc server
 		TaskMgr:      r.deps.taskMgr,
 		QueryLimiter: concurrent.NewLimiter(
 			r.ctx,
@@ -285,7 +287,12 @@ func (r *runtime) startHTTPServer() {
 		),
 		GlobalKeyValues: r.globalKeyValues,
 	})
-	httpAPI.RegisterRouter(r.httpServer.GetAPIRouter())
+	router := r.httpServer.GetAPIRouter()
+	httpAPI.RegisterRouter(router)
+	healthAPI := apipkg.NewHealthAPI(r.State,
+		apipkg.NewRepoHealthCheck(func() state.Repository { return r.repo }),
+	)
+	healthAPI.Register(router.Group(constants.APIVersion1))
 	go func() {
 		r.runHTTPServer()
 	}()
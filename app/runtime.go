@@ -30,6 +30,7 @@ import (
 
 var (
 	newNativeProtoPusher = monitoring.NewNativeProtoPusher
+	newRemoteExporter    = monitoring.NewRemoteExporter
 	NewBaseRuntimeFn     = NewBaseRuntime
 )
 
@@ -39,6 +40,7 @@ type BaseRuntime struct {
 	monitor         config.Monitor
 	registry        *linmetric.Registry
 	pusher          monitoring.NativePusher
+	exporter        monitoring.RemoteExporter
 	globalKeyValues tag.Tags
 
 	logger *logger.Logger
@@ -61,6 +63,10 @@ func (r *BaseRuntime) Shutdown() {
 		r.pusher.Stop()
 		r.logger.Info("stopped native metric pusher successfully")
 	}
+	if r.exporter != nil {
+		r.exporter.Stop()
+		r.logger.Info("stopped remote metric exporter successfully")
+	}
 }
 
 // NativePusher pushes metric data into internal database.
@@ -73,6 +79,19 @@ func (r *BaseRuntime) NativePusher() {
 	r.logger.Info("pusher is running",
 		logger.String("interval", r.monitor.ReportInterval.String()))
 
+	if r.monitor.RemoteURL != "" {
+		exporter, err := newRemoteExporter(r.ctx, &r.monitor, r.registry)
+		if err != nil {
+			r.logger.Error("create remote exporter failure, self-metrics won't be exported to remote", logger.Error(err))
+		} else {
+			r.exporter = exporter
+			r.logger.Info("remote exporter is running",
+				logger.String("url", r.monitor.RemoteURL),
+				logger.String("protocol", r.monitor.RemoteProtocol))
+			go r.exporter.Start()
+		}
+	}
+
 	r.pusher = newNativeProtoPusher(
 		r.ctx,
 		r.monitor.URL,
@@ -80,6 +99,7 @@ func (r *BaseRuntime) NativePusher() {
 		r.monitor.PushTimeout.Duration(),
 		r.registry,
 		r.globalKeyValues,
+		r.exporter,
 	)
 	go r.pusher.Start()
 }
@@ -40,6 +40,7 @@ func TestBaseRuntime_NativePusher(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
 		newNativeProtoPusher = monitoring.NewNativeProtoPusher
+		newRemoteExporter = monitoring.NewRemoteExporter
 		ctrl.Finish()
 	}()
 
@@ -49,7 +50,7 @@ func TestBaseRuntime_NativePusher(t *testing.T) {
 
 	pusher := monitoring.NewMockNativePusher(ctrl)
 	newNativeProtoPusher = func(_ context.Context, _ string, _, _ time.Duration,
-		_ *linmetric.Registry, _ tag.Tags) monitoring.NativePusher {
+		_ *linmetric.Registry, _ tag.Tags, _ monitoring.RemoteExporter) monitoring.NativePusher {
 		return pusher
 	}
 	r = NewBaseRuntime(context.TODO(), config.Monitor{ReportInterval: 1000}, linmetric.RootRegistry, tag.Tags{})
@@ -62,4 +63,38 @@ func TestBaseRuntime_NativePusher(t *testing.T) {
 	assert.NotNil(t, r.pusher)
 	<-ch
 	r.Shutdown()
+
+	// create remote exporter failure
+	r = NewBaseRuntime(context.TODO(), config.Monitor{ReportInterval: 1000, RemoteURL: "http://remote", RemoteProtocol: "unknown"},
+		linmetric.RootRegistry, tag.Tags{})
+	ch = make(chan struct{})
+	pusher.EXPECT().Start().Do(func() {
+		close(ch)
+	})
+	r.NativePusher()
+	assert.Nil(t, r.exporter)
+	<-ch
+
+	// start remote exporter
+	exporter := monitoring.NewMockRemoteExporter(ctrl)
+	newRemoteExporter = func(_ context.Context, _ *config.Monitor, _ *linmetric.Registry) (monitoring.RemoteExporter, error) {
+		return exporter, nil
+	}
+	r = NewBaseRuntime(context.TODO(), config.Monitor{ReportInterval: 1000, RemoteURL: "http://remote"},
+		linmetric.RootRegistry, tag.Tags{})
+	ch = make(chan struct{})
+	exporterCh := make(chan struct{})
+	pusher.EXPECT().Start().Do(func() {
+		close(ch)
+	})
+	exporter.EXPECT().Start().Do(func() {
+		close(exporterCh)
+	})
+	pusher.EXPECT().Stop()
+	exporter.EXPECT().Stop()
+	r.NativePusher()
+	assert.NotNil(t, r.exporter)
+	<-ch
+	<-exporterCh
+	r.Shutdown()
 }
@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apipkg "github.com/lindb/lindb/internal/api"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// maxUnhealthyReplicators represents the max number of unhealthy replicators in health check message.
+const maxUnhealthyReplicators = 3
+
+// NewReplicaHealthCheck creates the replication stream health check,
+// replication is unhealthy if any replicator of write ahead log is failure.
+func NewReplicaHealthCheck(engine tsdb.Engine, walMgr replica.WriteAheadLogManager) apipkg.HealthCheck {
+	return apipkg.HealthCheck{
+		Name: "replication",
+		Check: func(_ context.Context) error {
+			var unhealthy []string
+			for name := range engine.GetAllDatabases() {
+				for _, family := range walMgr.GetReplicaState(name) {
+					for _, peer := range family.Replicators {
+						if peer.State == models.ReplicatorFailureState {
+							unhealthy = append(unhealthy, fmt.Sprintf("%s/%d/%s[%s]: %s",
+								name, family.ShardID, family.FamilyTime, peer.Replicator, peer.StateErrMsg))
+						}
+					}
+				}
+			}
+			if len(unhealthy) == 0 {
+				return nil
+			}
+			sort.Strings(unhealthy)
+			if len(unhealthy) > maxUnhealthyReplicators {
+				unhealthy = append(unhealthy[:maxUnhealthyReplicators], "...")
+			}
+			return fmt.Errorf("replicator failure: %s", strings.Join(unhealthy, ", "))
+		},
+	}
+}
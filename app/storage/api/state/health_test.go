@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestReplicaHealthCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	check := NewReplicaHealthCheck(engine, walMgr)
+	engine.EXPECT().GetAllDatabases().Return(map[string]tsdb.Database{"test": nil}).AnyTimes()
+
+	// replication ok
+	walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{
+		{Replicators: []models.ReplicaPeerState{{State: models.ReplicatorReadyState}}},
+	})
+	assert.NoError(t, check.Check(context.TODO()))
+	// replicator failure
+	var peers []models.ReplicaPeerState
+	for i := 0; i < 5; i++ {
+		peers = append(peers, models.ReplicaPeerState{State: models.ReplicatorFailureState, StateErrMsg: "follower node is offline"})
+	}
+	walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{{Replicators: peers}})
+	err := check.Check(context.TODO())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "follower node is offline")
+}
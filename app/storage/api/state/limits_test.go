@@ -0,0 +1,50 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+)
+
+func TestLimitsAPI_GetLimitsState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := storage.NewMockStateManager(ctrl)
+	api := NewLimitsAPI(stateMgr)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: database not set
+	resp := mock.DoRequest(t, r, http.MethodGet, LimitsPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: get limits state
+	stateMgr.EXPECT().GetDatabaseLimitsState("test").Return(&models.LimitsState{Revision: "abc", AppliedAt: 10})
+	resp = mock.DoRequest(t, r, http.MethodGet, LimitsPath+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"revision":"abc","appliedAt":10,"applied":false}`, resp.Body.String())
+}
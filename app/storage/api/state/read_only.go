@@ -0,0 +1,70 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"github.com/gin-gonic/gin"
+
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/replica"
+)
+
+var (
+	ReadOnlyPath = "/state/readonly"
+)
+
+// ReadOnlyAPI represents the read-only mode rest api of storage node and databases for maintenance.
+type ReadOnlyAPI struct {
+	readOnly replica.ReadOnlyController
+	logger   *logger.Logger
+}
+
+// NewReadOnlyAPI creates a read-only mode api instance.
+func NewReadOnlyAPI(readOnly replica.ReadOnlyController) *ReadOnlyAPI {
+	return &ReadOnlyAPI{
+		readOnly: readOnly,
+		logger:   logger.GetLogger("Storage", "ReadOnlyAPI"),
+	}
+}
+
+// Register adds read-only mode url route.
+func (d *ReadOnlyAPI) Register(route gin.IRoutes) {
+	route.GET(ReadOnlyPath, d.GetReadOnlyState)
+	route.PUT(ReadOnlyPath, d.SetReadOnly)
+}
+
+// GetReadOnlyState returns the read-only state of current node and databases.
+func (d *ReadOnlyAPI) GetReadOnlyState(c *gin.Context) {
+	httppkg.OK(c, d.readOnly.GetState())
+}
+
+// SetReadOnly switches the read-only mode of database, switches node level mode if db param not set.
+func (d *ReadOnlyAPI) SetReadOnly(c *gin.Context) {
+	var param struct {
+		DB       string `form:"db"`
+		ReadOnly bool   `form:"readOnly"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	d.readOnly.SetReadOnly(param.DB, param.ReadOnly)
+	d.logger.Info("switch read-only mode", logger.String("database", param.DB), logger.Any("readOnly", param.ReadOnly))
+	httppkg.OK(c, d.readOnly.GetState())
+}
@@ -0,0 +1,53 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/replica"
+)
+
+func TestReadOnlyAPI(t *testing.T) {
+	readOnly := replica.NewReadOnlyController()
+	api := NewReadOnlyAPI(readOnly)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, ReadOnlyPath+"?readOnly=abc", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: switch database read-only
+	resp = mock.DoRequest(t, r, http.MethodPut, ReadOnlyPath+"?db=test&readOnly=true", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, readOnly.IsReadOnly("test"))
+	assert.False(t, readOnly.IsReadOnly("test2"))
+	// case 3: switch node read-only
+	resp = mock.DoRequest(t, r, http.MethodPut, ReadOnlyPath+"?readOnly=true", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, readOnly.IsReadOnly("test2"))
+	// case 4: get state
+	resp = mock.DoRequest(t, r, http.MethodGet, ReadOnlyPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"readOnly":true,"databases":["test"]}`, resp.Body.String())
+}
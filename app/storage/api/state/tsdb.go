@@ -18,26 +18,56 @@
 package state
 
 import (
+	"errors"
+	"path/filepath"
+	"sort"
+
 	"github.com/gin-gonic/gin"
 
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 )
 
 var (
-	MemoryDatabase = "/state/tsdb/memory"
+	MemoryDatabase       = "/state/tsdb/memory"
+	SegmentPath          = "/state/tsdb/segment"
+	DiskUsagePath        = "/state/tsdb/disk"
+	FieldUsagePath       = "/state/tsdb/field/usage"
+	ExpiredMetricsPath   = "/state/tsdb/metric/expired"
+	FamilyFilesPath      = "/state/tsdb/family/files"
+	FamilyFilePath       = "/state/tsdb/family/file"
+	FamilyFileDetailPath = "/state/tsdb/family/file/detail"
+	IndexRebuildPath     = "/state/tsdb/index/rebuild"
+	SeriesDeletePath     = "/state/tsdb/series/delete"
+	CompactionPath       = "/state/tsdb/compaction"
+	CompactionJobsPath   = "/state/tsdb/compaction/jobs"
+)
+
+// for testing
+var (
+	deleteSeriesFn = query.DeleteSeries
 )
 
 // TSDBAPI represents tsdb internal state rest api.
 type TSDBAPI struct {
+	engine tsdb.Engine
 	logger *logger.Logger
 }
 
 // NewTSDBAPI creates a tsdb state api instance.
-func NewTSDBAPI() *TSDBAPI {
+func NewTSDBAPI(engine tsdb.Engine) *TSDBAPI {
 	return &TSDBAPI{
+		engine: engine,
 		logger: logger.GetLogger("Storage", "TSDBAPI"),
 	}
 }
@@ -45,6 +75,19 @@ func NewTSDBAPI() *TSDBAPI {
 // Register adds the route for tsdb state api.
 func (db *TSDBAPI) Register(route gin.IRoutes) {
 	route.GET(MemoryDatabase, db.GetMemoryDatabaseState)
+	route.GET(SegmentPath, db.GetSegmentState)
+	route.GET(DiskUsagePath, db.GetDiskUsage)
+	route.GET(FieldUsagePath, db.GetFieldUsage)
+	route.GET(ExpiredMetricsPath, db.GetExpiredMetrics)
+	route.GET(FamilyFilesPath, db.GetFamilyFiles)
+	route.GET(FamilyFilePath, db.GetFamilyFile)
+	route.GET(FamilyFileDetailPath, db.GetFamilyFileDetail)
+	route.PUT(IndexRebuildPath, db.RebuildIndex)
+	route.GET(IndexRebuildPath, db.GetIndexRebuildState)
+	route.PUT(SeriesDeletePath, db.DeleteSeries)
+	route.GET(CompactionPath, db.GetCompactionState)
+	route.PUT(CompactionPath, db.SetCompaction)
+	route.GET(CompactionJobsPath, db.GetCompactionJobs)
 }
 
 // GetMemoryDatabaseState returns memory database
@@ -65,3 +108,292 @@ func (db *TSDBAPI) GetMemoryDatabaseState(c *gin.Context) {
 	})
 	httppkg.OK(c, rs)
 }
+
+// GetSegmentState returns the segment/family files state of shard.
+func (db *TSDBAPI) GetSegmentState(c *gin.Context) {
+	var param struct {
+		DB      string `form:"db" binding:"required"`
+		ShardID int    `form:"shard"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, ok := db.engine.GetShard(param.DB, models.ShardID(param.ShardID))
+	if !ok {
+		httppkg.Error(c, constants.ErrShardNotFound)
+		return
+	}
+	rs, err := shard.GetSegmentStates()
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
+
+// GetDiskUsage returns the disk usage of databases calculated by last check, filters by database if db param set.
+func (db *TSDBAPI) GetDiskUsage(c *gin.Context) {
+	var param struct {
+		DB string `form:"db"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	var rs []*models.DatabaseDiskUsage
+	for name, database := range db.engine.GetAllDatabases() {
+		if param.DB != "" && param.DB != name {
+			continue
+		}
+		if usage := database.GetDiskUsage(); usage != nil {
+			rs = append(rs, usage)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Database < rs[j].Database
+	})
+	httppkg.OK(c, rs)
+}
+
+// GetFieldUsage returns the query-time access counts of metric's fields since given timestamp,
+// includes the fields which never be queried.
+func (db *TSDBAPI) GetFieldUsage(c *gin.Context) {
+	var param struct {
+		DB        string `form:"db" binding:"required"`
+		Namespace string `form:"ns"`
+		Metric    string `form:"metric" binding:"required"`
+		Since     int64  `form:"since"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	database, ok := db.engine.GetDatabase(param.DB)
+	if !ok {
+		httppkg.Error(c, constants.ErrDatabaseNotFound)
+		return
+	}
+	if param.Namespace == "" {
+		param.Namespace = commonconstants.DefaultNamespace
+	}
+	rs, err := database.GetFieldUsage(param.Namespace, param.Metric, param.Since)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
+
+// GetExpiredMetrics returns the unused metrics found by last expiry check of database,
+// includes the candidates of dry-run policy.
+func (db *TSDBAPI) GetExpiredMetrics(c *gin.Context) {
+	var param struct {
+		DB string `form:"db" binding:"required"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	database, ok := db.engine.GetDatabase(param.DB)
+	if !ok {
+		httppkg.Error(c, constants.ErrDatabaseNotFound)
+		return
+	}
+	httppkg.OK(c, database.GetExpiredMetrics())
+}
+
+// GetFamilyFiles returns the persisted replica sequences and live files of data family,
+// used for repairing the data family of other replica.
+func (db *TSDBAPI) GetFamilyFiles(c *gin.Context) {
+	family, err := db.getDataFamily(c)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, family.GetFamilyFiles())
+}
+
+// GetFamilyFile returns the content of data family's live file.
+func (db *TSDBAPI) GetFamilyFile(c *gin.Context) {
+	family, err := db.getDataFamily(c)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	file := c.Query("file")
+	// only live file of data family can be fetched
+	for _, liveFile := range family.GetFamilyFiles().Files {
+		if liveFile == file {
+			c.File(filepath.Join(family.Family().Path(), file))
+			return
+		}
+	}
+	httppkg.NotFound(c)
+}
+
+// GetFamilyFileDetail returns the detail of data family's live file, used for diagnosing suspicious file.
+func (db *TSDBAPI) GetFamilyFileDetail(c *gin.Context) {
+	var param struct {
+		FileNumber int64 `form:"fileNumber" binding:"required"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	family, err := db.getDataFamily(c)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	detail, err := family.GetFileDetail(table.FileNumber(param.FileNumber))
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, detail)
+}
+
+// RebuildIndex starts the job which rebuilds the index of shard in background,
+// used for recovering the index when index corruption is detected.
+func (db *TSDBAPI) RebuildIndex(c *gin.Context) {
+	shard, err := db.getShard(c)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err = shard.RebuildIndex(); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db.logger.Info("start rebuilding index of shard",
+		logger.String("database", shard.Database().Name()), logger.Any("shardID", shard.ShardID()))
+	httppkg.OK(c, shard.GetIndexRebuildState())
+}
+
+// GetIndexRebuildState returns the progress of the latest index rebuild job of shard.
+func (db *TSDBAPI) GetIndexRebuildState(c *gin.Context) {
+	shard, err := db.getShard(c)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	state := shard.GetIndexRebuildState()
+	if state == nil {
+		httppkg.NotFound(c)
+		return
+	}
+	httppkg.OK(c, state)
+}
+
+// GetCompactionState returns the pause state and pending compaction backlog of databases.
+func (db *TSDBAPI) GetCompactionState(c *gin.Context) {
+	httppkg.OK(c, db.engine.GetCompactionState())
+}
+
+// SetCompaction pauses/resumes background compaction of database, pauses/resumes all databases if db param not set.
+func (db *TSDBAPI) SetCompaction(c *gin.Context) {
+	var param struct {
+		DB     string `form:"db"`
+		Paused bool   `form:"paused"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db.engine.SetCompactionPaused(param.DB, param.Paused)
+	db.logger.Info("pause/resume compaction", logger.String("database", param.DB), logger.Any("paused", param.Paused))
+	httppkg.OK(c, db.engine.GetCompactionState())
+}
+
+// GetCompactionJobs returns the running/queued compact and rollup jobs, filters by database if db param set.
+func (db *TSDBAPI) GetCompactionJobs(c *gin.Context) {
+	var param struct {
+		DB string `form:"db"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, db.engine.GetCompactionJobs(param.DB))
+}
+
+// DeleteSeries deletes the series of shard which match the delete series statement,
+// the deleted series are excluded by query immediately and purged by next compaction/rollup job.
+func (db *TSDBAPI) DeleteSeries(c *gin.Context) {
+	var param struct {
+		SQL string `form:"sql" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, err := db.getShard(c)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	statement, err := sql.Parse(param.SQL)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	deleteStmt, ok := statement.(*stmt.DeleteSeries)
+	if !ok {
+		httppkg.Error(c, errors.New("not delete series statement"))
+		return
+	}
+	numOfSeries, err := deleteSeriesFn(shard, deleteStmt)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db.logger.Info("delete series of shard",
+		logger.String("database", shard.Database().Name()), logger.Any("shardID", shard.ShardID()),
+		logger.String("sql", param.SQL), logger.Any("numOfSeries", numOfSeries))
+	httppkg.OK(c, &models.SeriesDeletion{ShardID: shard.ShardID(), NumOfSeries: numOfSeries})
+}
+
+// getShard returns the shard by database name and shard id.
+func (db *TSDBAPI) getShard(c *gin.Context) (tsdb.Shard, error) {
+	var param struct {
+		DB      string `form:"db" binding:"required"`
+		ShardID int    `form:"shard"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		return nil, err
+	}
+	shard, ok := db.engine.GetShard(param.DB, models.ShardID(param.ShardID))
+	if !ok {
+		return nil, constants.ErrShardNotFound
+	}
+	return shard, nil
+}
+
+// getDataFamily returns the data family of shard by family time.
+func (db *TSDBAPI) getDataFamily(c *gin.Context) (tsdb.DataFamily, error) {
+	var param struct {
+		DB         string `form:"db" binding:"required"`
+		ShardID    int    `form:"shard"`
+		FamilyTime int64  `form:"familyTime" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		return nil, err
+	}
+	shard, ok := db.engine.GetShard(param.DB, models.ShardID(param.ShardID))
+	if !ok {
+		return nil, constants.ErrShardNotFound
+	}
+	timeRange := timeutil.TimeRange{Start: param.FamilyTime, End: param.FamilyTime}
+	for _, family := range shard.GetDataFamilies(shard.CurrentInterval().Type(), timeRange) {
+		if family.FamilyTime() == param.FamilyTime {
+			return family, nil
+		}
+	}
+	return nil, constants.ErrDataFamilyNotFound
+}
@@ -18,7 +18,11 @@
 package state
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -26,7 +30,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 )
 
@@ -46,7 +55,7 @@ func TestTSDBAPI_GetMemoryDatabaseState(t *testing.T) {
 	db.EXPECT().Name().Return("test")
 	tsdb.GetFamilyManager().AddFamily(f)
 
-	api := NewTSDBAPI()
+	api := NewTSDBAPI(nil)
 	r := gin.New()
 	api.Register(r)
 
@@ -57,3 +66,371 @@ func TestTSDBAPI_GetMemoryDatabaseState(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodGet, MemoryDatabase+"?db=test", "")
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
+
+func TestTSDBAPI_GetSegmentState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, SegmentPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, SegmentPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: get segment state failure
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	shard.EXPECT().GetSegmentStates().Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, SegmentPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: get segment state ok
+	shard.EXPECT().GetSegmentStates().Return([]models.SegmentState{{Interval: "10s", Name: "20220101"}}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, SegmentPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestTSDBAPI_GetDiskUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db1 := tsdb.NewMockDatabase(ctrl)
+	db2 := tsdb.NewMockDatabase(ctrl)
+	db3 := tsdb.NewMockDatabase(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	engine.EXPECT().GetAllDatabases().Return(map[string]tsdb.Database{"b": db1, "a": db2, "c": db3}).Times(2)
+	db1.EXPECT().GetDiskUsage().Return(&models.DatabaseDiskUsage{Database: "b"}).Times(2)
+	db2.EXPECT().GetDiskUsage().Return(&models.DatabaseDiskUsage{Database: "a"})
+	db3.EXPECT().GetDiskUsage().Return(nil)
+	resp := mock.DoRequest(t, r, http.MethodGet, DiskUsagePath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[{"database":"a","meta":0},{"database":"b","meta":0}]`, resp.Body.String())
+	// filter by database
+	resp = mock.DoRequest(t, r, http.MethodGet, DiskUsagePath+"?db=b", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[{"database":"b","meta":0}]`, resp.Body.String())
+}
+
+func TestTSDBAPI_GetFieldUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, FieldUsagePath+"?db=test", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, FieldUsagePath+"?db=test&metric=cpu", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: get field usage failure
+	engine.EXPECT().GetDatabase("test").Return(db, true).AnyTimes()
+	db.EXPECT().GetFieldUsage("default-ns", "cpu", int64(0)).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, FieldUsagePath+"?db=test&metric=cpu", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: get field usage successfully
+	db.EXPECT().GetFieldUsage("ns", "cpu", int64(100)).Return(models.FieldUsages{{Field: "f", Count: 1}}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, FieldUsagePath+"?db=test&ns=ns&metric=cpu&since=100", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[{"namespace":"","metric":"","field":"f","type":"","count":1}]`, resp.Body.String())
+}
+
+func TestTSDBAPI_GetExpiredMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, ExpiredMetricsPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, ExpiredMetricsPath+"?db=test", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: get expired metrics
+	engine.EXPECT().GetDatabase("test").Return(db, true)
+	db.EXPECT().GetExpiredMetrics().Return(models.ExpiredMetrics{{Namespace: "ns", Metric: "cpu", LastActive: 10}})
+	resp = mock.DoRequest(t, r, http.MethodGet, ExpiredMetricsPath+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[{"namespace":"ns","metric":"cpu","lastActive":10,"removed":false}]`, resp.Body.String())
+}
+
+func TestTSDBAPI_GetFamilyFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	family := tsdb.NewMockDataFamily(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, FamilyFilesPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFilesPath+"?db=test&shard=1&familyTime=100", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: family not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	shard.EXPECT().CurrentInterval().Return(timeutil.Interval(timeutil.OneSecond * 10)).AnyTimes()
+	shard.EXPECT().GetDataFamilies(gomock.Any(), timeutil.TimeRange{Start: 100, End: 100}).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFilesPath+"?db=test&shard=1&familyTime=100", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: get family files
+	shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).Return([]tsdb.DataFamily{family})
+	family.EXPECT().FamilyTime().Return(int64(100))
+	family.EXPECT().GetFamilyFiles().Return(&models.DataFamilyFiles{
+		Sequences: map[int32]int64{1: 10},
+		Files:     []string{"000001.sst"},
+	})
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFilesPath+"?db=test&shard=1&familyTime=100", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"sequences":{"1":10},"files":["000001.sst"]}`, resp.Body.String())
+}
+
+func TestTSDBAPI_GetFamilyFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "000001.sst"), []byte("data"), 0644))
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	family := tsdb.NewMockDataFamily(ctrl)
+	kvFamily := kv.NewMockFamily(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp := mock.DoRequest(t, r, http.MethodGet, FamilyFilePath+"?db=test&shard=1&familyTime=100&file=000001.sst", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	shard.EXPECT().CurrentInterval().Return(timeutil.Interval(timeutil.OneSecond * 10)).AnyTimes()
+	shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).Return([]tsdb.DataFamily{family}).AnyTimes()
+	family.EXPECT().FamilyTime().Return(int64(100)).AnyTimes()
+	family.EXPECT().GetFamilyFiles().Return(&models.DataFamilyFiles{Files: []string{"000001.sst"}}).AnyTimes()
+	family.EXPECT().Family().Return(kvFamily).AnyTimes()
+	kvFamily.EXPECT().Path().Return(dir).AnyTimes()
+	// case 2: file not live
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFilePath+"?db=test&shard=1&familyTime=100&file=000002.sst", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFilePath+"?db=test&shard=1&familyTime=100&file=../000001.sst", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// case 3: download file
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFilePath+"?db=test&shard=1&familyTime=100&file=000001.sst", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "data", resp.Body.String())
+}
+
+func TestTSDBAPI_GetFamilyFileDetail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	family := tsdb.NewMockDataFamily(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, FamilyFileDetailPath+"?db=test&shard=1&familyTime=100", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFileDetailPath+"?db=test&shard=1&familyTime=100&fileNumber=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	shard.EXPECT().CurrentInterval().Return(timeutil.Interval(timeutil.OneSecond * 10)).AnyTimes()
+	shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).Return([]tsdb.DataFamily{family}).AnyTimes()
+	family.EXPECT().FamilyTime().Return(int64(100)).AnyTimes()
+	// case 3: get file detail failure
+	family.EXPECT().GetFileDetail(table.FileNumber(1)).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFileDetailPath+"?db=test&shard=1&familyTime=100&fileNumber=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: get file detail
+	family.EXPECT().GetFileDetail(table.FileNumber(1)).Return(models.NewDataFileDetail("000001.sst", 100), nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, FamilyFileDetailPath+"?db=test&shard=1&familyTime=100&fileNumber=1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "000001.sst")
+}
+
+func TestTSDBAPI_RebuildIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, IndexRebuildPath+"?shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPut, IndexRebuildPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	db.EXPECT().Name().Return("test").AnyTimes()
+	// case 3: rebuild is running
+	shard.EXPECT().RebuildIndex().Return(tsdb.ErrIndexRebuilding)
+	resp = mock.DoRequest(t, r, http.MethodPut, IndexRebuildPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: start rebuild
+	state := &models.IndexRebuildState{Database: "test", ShardID: 1, Status: models.IndexRebuildRunning}
+	shard.EXPECT().RebuildIndex().Return(nil)
+	shard.EXPECT().GetIndexRebuildState().Return(state)
+	resp = mock.DoRequest(t, r, http.MethodPut, IndexRebuildPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "running")
+}
+
+func TestTSDBAPI_GetIndexRebuildState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp := mock.DoRequest(t, r, http.MethodGet, IndexRebuildPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	// case 2: never rebuild
+	shard.EXPECT().GetIndexRebuildState().Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, IndexRebuildPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// case 3: get rebuild state
+	shard.EXPECT().GetIndexRebuildState().Return(&models.IndexRebuildState{Status: models.IndexRebuildCompleted})
+	resp = mock.DoRequest(t, r, http.MethodGet, IndexRebuildPath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "completed")
+}
+
+func TestTSDBAPI_DeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		deleteSeriesFn = query.DeleteSeries
+		ctrl.Finish()
+	}()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+	deleteSQL := url.QueryEscape("delete series from cpu where host='x'")
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, SeriesDeletePath+"?db=test&shard=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPut, SeriesDeletePath+"?db=test&shard=1&sql="+deleteSQL, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	db.EXPECT().Name().Return("test").AnyTimes()
+	// case 3: parse sql failure
+	resp = mock.DoRequest(t, r, http.MethodPut, SeriesDeletePath+"?db=test&shard=1&sql=delete", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: not delete series statement
+	resp = mock.DoRequest(t, r, http.MethodPut, SeriesDeletePath+"?db=test&shard=1&sql="+url.QueryEscape("show databases"), "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 5: delete series failure
+	deleteSeriesFn = func(_ tsdb.Shard, _ *stmt.DeleteSeries) (uint64, error) {
+		return 0, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, SeriesDeletePath+"?db=test&shard=1&sql="+deleteSQL, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 6: delete series successfully
+	deleteSeriesFn = func(_ tsdb.Shard, deleteStmt *stmt.DeleteSeries) (uint64, error) {
+		assert.Equal(t, "cpu", deleteStmt.MetricName)
+		return 10, nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, SeriesDeletePath+"?db=test&shard=1&sql="+deleteSQL, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"shardId":1,"numOfSeries":10}`, resp.Body.String())
+}
+
+func TestTSDBAPI_Compaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: get compaction state
+	engine.EXPECT().GetCompactionState().Return(&models.CompactionState{Paused: true})
+	resp := mock.DoRequest(t, r, http.MethodGet, CompactionPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"paused":true}`, resp.Body.String())
+	// case 2: params invalid
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactionPath+"?paused=abc", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 3: pause compaction of database
+	engine.EXPECT().SetCompactionPaused("test", true)
+	engine.EXPECT().GetCompactionState().Return(&models.CompactionState{PausedDatabases: []string{"test"}})
+	resp = mock.DoRequest(t, r, http.MethodPut, CompactionPath+"?db=test&paused=true", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"paused":false,"pausedDatabases":["test"]}`, resp.Body.String())
+}
+
+func TestTSDBAPI_GetCompactionJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	engine.EXPECT().GetCompactionJobs("test").Return(models.CompactionJobs{{Database: "test", Store: "test/meta", Family: "f",
+		Type: "merge", Status: "queued", InputFiles: 4}})
+	resp := mock.DoRequest(t, r, http.MethodGet, CompactionJobsPath+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `[{"database":"test","store":"test/meta","family":"f","type":"merge","status":"queued",
+"level":0,"inputFiles":4,"inputBytes":0,"readBytes":0}]`, resp.Body.String())
+}
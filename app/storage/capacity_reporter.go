@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/disk"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// for testing
+var (
+	diskUsageFn = disk.UsageWithContext
+)
+
+// capacityReporter reports the disk capacity of storage node into state repo periodically,
+// master places the replicas of shard based on it.
+type capacityReporter struct {
+	ctx    context.Context
+	repo   state.Repository
+	nodeID models.NodeID
+	dir    string
+
+	logger *logger.Logger
+}
+
+// newCapacityReporter creates a disk capacity reporter of storage node.
+func newCapacityReporter(ctx context.Context, repo state.Repository, nodeID models.NodeID, dir string) *capacityReporter {
+	return &capacityReporter{
+		ctx:    ctx,
+		repo:   repo,
+		nodeID: nodeID,
+		dir:    dir,
+		logger: logger.GetLogger("Storage", "CapacityReporter"),
+	}
+}
+
+// Run reports disk capacity when startup, then reports it with the interval of disk usage check.
+func (r *capacityReporter) Run() {
+	r.report()
+	ticker := time.NewTicker(config.GlobalStorageConfig().DiskUsageCheckInterval.Duration())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.report()
+			// support dynamic modify config
+			ticker.Reset(config.GlobalStorageConfig().DiskUsageCheckInterval.Duration())
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// report reports the disk capacity of the disk which data dir on.
+func (r *capacityReporter) report() {
+	stat, err := diskUsageFn(r.ctx, fileutil.GetExistPath(r.dir))
+	if err != nil {
+		r.logger.Warn("get disk usage stat failure", logger.String("dir", r.dir), logger.Error(err))
+		return
+	}
+	capacity := &models.NodeCapacity{
+		Total:      stat.Total,
+		Used:       stat.Used,
+		Free:       stat.Free,
+		ReportTime: timeutil.Now(),
+	}
+	if err := r.repo.Put(r.ctx, constants.GetNodeCapacityPath(r.nodeID.String()), encoding.JSONMarshal(capacity)); err != nil {
+		r.logger.Warn("report disk capacity failure", logger.Error(err))
+	}
+}
@@ -0,0 +1,93 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestCapacityReporter_report(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		diskUsageFn = disk.UsageWithContext
+		ctrl.Finish()
+	}()
+	repo := state.NewMockRepository(ctrl)
+	reporter := newCapacityReporter(context.TODO(), repo, 1, t.TempDir())
+
+	// case 1: get disk usage err
+	diskUsageFn = func(_ context.Context, _ string) (*disk.UsageStat, error) {
+		return nil, fmt.Errorf("err")
+	}
+	reporter.report()
+
+	diskUsageFn = func(_ context.Context, _ string) (*disk.UsageStat, error) {
+		return &disk.UsageStat{Total: 100, Used: 40, Free: 60}, nil
+	}
+	// case 2: put err
+	repo.EXPECT().Put(gomock.Any(), constants.GetNodeCapacityPath("1"), gomock.Any()).Return(fmt.Errorf("err"))
+	reporter.report()
+	// case 3: ok
+	repo.EXPECT().Put(gomock.Any(), constants.GetNodeCapacityPath("1"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+			capacity := &models.NodeCapacity{}
+			assert.NoError(t, encoding.JSONUnmarshal(data, capacity))
+			assert.Equal(t, uint64(100), capacity.Total)
+			assert.Equal(t, uint64(40), capacity.Used)
+			assert.Equal(t, uint64(60), capacity.Free)
+			return nil
+		})
+	reporter.report()
+}
+
+func TestCapacityReporter_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		diskUsageFn = disk.UsageWithContext
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+	cfg := config.NewDefaultStorageBase()
+	cfg.DiskUsageCheckInterval = ltoml.Duration(time.Millisecond * 10)
+	config.SetGlobalStorageConfig(cfg)
+	diskUsageFn = func(_ context.Context, _ string) (*disk.UsageStat, error) {
+		return &disk.UsageStat{Total: 100, Used: 40, Free: 60}, nil
+	}
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).MinTimes(2)
+	ctx, cancel := context.WithCancel(context.TODO())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+	newCapacityReporter(ctx, repo, 1, t.TempDir()).Run()
+}
@@ -73,6 +73,8 @@ func NewDatabaseLifecycle(
 // Startup startups database's lifecycle, includes background task(ttl etc.)
 func (l *databaseLifecycle) Startup() {
 	l.ttlTask()
+	l.diskUsageTask()
+	l.fieldUsageTask()
 }
 
 // Shutdown shutdowns database's lifecycle.
@@ -113,6 +115,8 @@ func (l *databaseLifecycle) ttlTask() {
 				l.tryDropDatabases()
 				// do data ttl
 				l.engine.TTL()
+				// expire metrics which received no writes and no queries for a long time
+				l.engine.ExpireUnusedMetrics()
 				// do data compaction
 				tsdb.GetFamilyManager().WalkEntry(func(family tsdb.DataFamily) {
 					family.Compact()
@@ -129,6 +133,44 @@ func (l *databaseLifecycle) ttlTask() {
 	}()
 }
 
+// diskUsageTask runs disk usage check task in background goroutine,
+// calculates disk usage of databases and enforces disk quota.
+func (l *databaseLifecycle) diskUsageTask() {
+	go func() {
+		// calculate disk usage when startup
+		l.engine.CheckDiskUsage()
+		ticker := time.NewTicker(config.GlobalStorageConfig().DiskUsageCheckInterval.Duration())
+		for {
+			select {
+			case <-ticker.C:
+				l.engine.CheckDiskUsage()
+				// support dynamic modify config
+				ticker.Reset(config.GlobalStorageConfig().DiskUsageCheckInterval.Duration())
+			case <-l.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// fieldUsageTask runs field usage aggregate task in background goroutine,
+// aggregates the query-time field access counts of databases into daily buckets.
+func (l *databaseLifecycle) fieldUsageTask() {
+	go func() {
+		ticker := time.NewTicker(config.GlobalStorageConfig().FieldUsageAggregateInterval.Duration())
+		for {
+			select {
+			case <-ticker.C:
+				l.engine.AggregateFieldUsage()
+				// support dynamic modify config
+				ticker.Reset(config.GlobalStorageConfig().FieldUsageAggregateInterval.Duration())
+			case <-l.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
 // tryDropDatabases tries drop database's resource(data/write ahead log), keeps active databases.
 func (l *databaseLifecycle) tryDropDatabases() {
 	activeDatabases := make(map[string]struct{})
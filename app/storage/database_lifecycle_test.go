@@ -44,6 +44,8 @@ func TestNewDatabaseLifecycle(t *testing.T) {
 	walMgr.EXPECT().Close().Return(nil)
 	engine := tsdb.NewMockEngine(ctrl)
 	engine.EXPECT().Close().MaxTimes(2)
+	engine.EXPECT().CheckDiskUsage().AnyTimes()
+	engine.EXPECT().AggregateFieldUsage().AnyTimes()
 
 	dbLifecycle := NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
 
@@ -102,6 +104,7 @@ func TestDatabaseLifecycle_ttlTask(t *testing.T) {
 	config.SetGlobalStorageConfig(cfg)
 	repo.EXPECT().WalkEntry(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	engine.EXPECT().TTL().AnyTimes()
+	engine.EXPECT().ExpireUnusedMetrics().AnyTimes()
 	engine.EXPECT().EvictSegment().AnyTimes()
 	dbLifecycle1.ttlTask()
 	<-ch
@@ -162,3 +165,65 @@ func TestDatabaseLifecycle_dropDatabases(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseLifecycle_diskUsageTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+
+	repo := state.NewMockRepository(ctrl)
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	walMgr.EXPECT().Close()
+	walMgr.EXPECT().Stop()
+	engine := tsdb.NewMockEngine(ctrl)
+	engine.EXPECT().Close()
+
+	dbLifecycle := NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
+	ch := make(chan struct{})
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		dbLifecycle.Shutdown()
+		ch <- struct{}{}
+	}()
+
+	dbLifecycle1 := dbLifecycle.(*databaseLifecycle)
+	cfg := config.NewDefaultStorageBase()
+	cfg.DiskUsageCheckInterval = ltoml.Duration(time.Millisecond * 10)
+	config.SetGlobalStorageConfig(cfg)
+	engine.EXPECT().CheckDiskUsage().MinTimes(2)
+	dbLifecycle1.diskUsageTask()
+	<-ch
+}
+
+func TestDatabaseLifecycle_fieldUsageTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+
+	repo := state.NewMockRepository(ctrl)
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	walMgr.EXPECT().Close()
+	walMgr.EXPECT().Stop()
+	engine := tsdb.NewMockEngine(ctrl)
+	engine.EXPECT().Close()
+
+	dbLifecycle := NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
+	ch := make(chan struct{})
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		dbLifecycle.Shutdown()
+		ch <- struct{}{}
+	}()
+
+	dbLifecycle1 := dbLifecycle.(*databaseLifecycle)
+	cfg := config.NewDefaultStorageBase()
+	cfg.FieldUsageAggregateInterval = ltoml.Duration(time.Millisecond * 10)
+	config.SetGlobalStorageConfig(cfg)
+	engine.EXPECT().AggregateFieldUsage().MinTimes(2)
+	dbLifecycle1.fieldUsageTask()
+	<-ch
+}
@@ -19,6 +19,7 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"google.golang.org/grpc/codes"
@@ -27,6 +28,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/logger"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/replica"
@@ -35,7 +37,8 @@ import (
 
 // ReplicaHandler implements replica.ReplicaServiceServer interface for handling replica rpc request.
 type ReplicaHandler struct {
-	walMgr replica.WriteAheadLogManager
+	walMgr   replica.WriteAheadLogManager
+	readOnly replica.ReadOnlyController
 
 	logger *logger.Logger
 }
@@ -43,10 +46,12 @@ type ReplicaHandler struct {
 // NewReplicaHandler creates a replica handler.
 func NewReplicaHandler(
 	walMgr replica.WriteAheadLogManager,
+	readOnly replica.ReadOnlyController,
 ) *ReplicaHandler {
 	return &ReplicaHandler{
-		walMgr: walMgr,
-		logger: logger.GetLogger("Storage", "ReplicaRPC"),
+		walMgr:   walMgr,
+		readOnly: readOnly,
+		logger:   logger.GetLogger("Storage", "ReplicaRPC"),
 	}
 }
 
@@ -86,28 +91,30 @@ func (r *ReplicaHandler) Reset(_ context.Context,
 }
 
 // Replica does replica request, and writes data.
+// Two kinds of replica stream are supported:
+//  1. stream with replica state metadata, only replicates one family channel;
+//  2. stream with database metadata, family channels of the database are multiplexed on it,
+//     each frame carries family state, ack of each family is returned with family state.
 func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaServer) error {
-	replicaState, err := r.getReplicaStateFromCtx(server.Context())
-	if err != nil {
+	ctx := server.Context()
+	var streamPartition replica.Partition
+	replicaState, err := r.getReplicaStateFromCtx(ctx)
+	if err == nil {
+		if err = r.checkReadOnly(replicaState.Database); err != nil {
+			return err
+		}
+		streamPartition, err = r.buildReplica(&replicaState)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	} else if database, err0 := rpc.GetStringFromContext(ctx, constants.RPCMetaKeyDatabase); err0 != nil {
 		r.logger.Error("get replica state err", logger.Error(err))
 		return status.Error(codes.InvalidArgument, err.Error())
+	} else if err = r.checkReadOnly(database); err != nil {
+		return err
 	}
-
-	p, err := r.getOrCreatePartition(
-		replicaState.Database,
-		replicaState.ShardID,
-		replicaState.FamilyTime,
-		replicaState.Leader)
-	if err != nil {
-		r.logger.Error("get or create wal partition err, when do replica", logger.Error(err))
-		return status.Error(codes.Internal, err.Error())
-	}
-	err = p.BuildReplicaForFollower(replicaState.Leader, replicaState.Follower)
-	if err != nil {
-		r.logger.Error("build replica replica err", logger.Error(err))
-		return status.Error(codes.Internal, err.Error())
-	}
-	r.logger.Info("build replica stream channel successful", logger.String("replica", replicaState.String()))
+	// family state => partition for multiplexed stream
+	partitions := make(map[models.ReplicaState]replica.Partition)
 	// handle replica request from stream
 	for {
 		req, err := server.Recv()
@@ -118,16 +125,32 @@ func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaSer
 			r.logger.Error("receive replica request err", logger.Error(err))
 			return status.Error(codes.Internal, err.Error())
 		}
+		database := req.Database
+		if streamPartition != nil {
+			database = replicaState.Database
+		}
+		if err := r.checkReadOnly(database); err != nil {
+			// close stream without writing, leader re-sends the log from ack index after reconnected
+			return err
+		}
 
-		resp := &protoReplicaV1.ReplicaResponse{}
-		r.logger.Debug("receive write ahead log replica log",
-			logger.Any("from", replicaState.Leader), logger.Int64("index", req.ReplicaIndex))
-		// write replica wal log
-		appendedIdx, err := p.ReplicaLog(req.ReplicaIndex, req.Record)
-
-		resp.ReplicaIndex = req.ReplicaIndex
-		resp.AckIndex = appendedIdx
-
+		resp := &protoReplicaV1.ReplicaResponse{
+			Database:     req.Database,
+			Shard:        req.Shard,
+			Leader:       req.Leader,
+			FamilyTime:   req.FamilyTime,
+			ReplicaIndex: req.ReplicaIndex,
+		}
+		p := streamPartition
+		if p == nil {
+			p, err = r.getFamilyPartition(partitions, req)
+		}
+		if err == nil {
+			r.logger.Debug("receive write ahead log replica log",
+				logger.Any("from", req.Leader), logger.Int64("index", req.ReplicaIndex))
+			// write replica wal log
+			resp.AckIndex, err = p.ReplicaLog(req.ReplicaIndex, req.Record)
+		}
 		if err != nil {
 			resp.Err = err.Error()
 		}
@@ -138,6 +161,58 @@ func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaSer
 	}
 }
 
+// checkReadOnly returns the error if database is read-only, replica stream need be rejected.
+func (r *ReplicaHandler) checkReadOnly(database string) error {
+	if r.readOnly.IsReadOnly(database) {
+		return errorpkg.GRPCError(fmt.Errorf("%w, database: %s", constants.ErrStorageReadOnly, database))
+	}
+	return nil
+}
+
+// getFamilyPartition returns the partition of family frame from multiplexed stream,
+// builds replica for follower when receives first frame of the family.
+func (r *ReplicaHandler) getFamilyPartition(
+	partitions map[models.ReplicaState]replica.Partition,
+	req *protoReplicaV1.ReplicaRequest,
+) (replica.Partition, error) {
+	familyState := models.ReplicaState{
+		Database:   req.Database,
+		ShardID:    models.ShardID(req.Shard),
+		Leader:     models.NodeID(req.Leader),
+		Follower:   models.NodeID(req.Follower),
+		FamilyTime: req.FamilyTime,
+	}
+	if p, ok := partitions[familyState]; ok {
+		return p, nil
+	}
+	p, err := r.buildReplica(&familyState)
+	if err != nil {
+		return nil, err
+	}
+	partitions[familyState] = p
+	return p, nil
+}
+
+// buildReplica returns the partition of family, then builds replica for follower.
+func (r *ReplicaHandler) buildReplica(replicaState *models.ReplicaState) (replica.Partition, error) {
+	p, err := r.getOrCreatePartition(
+		replicaState.Database,
+		replicaState.ShardID,
+		replicaState.FamilyTime,
+		replicaState.Leader)
+	if err != nil {
+		r.logger.Error("get or create wal partition err, when do replica", logger.Error(err))
+		return nil, err
+	}
+	err = p.BuildReplicaForFollower(replicaState.Leader, replicaState.Follower)
+	if err != nil {
+		r.logger.Error("build replica replica err", logger.Error(err))
+		return nil, err
+	}
+	r.logger.Info("build replica stream channel successful", logger.String("replica", replicaState.String()))
+	return p, nil
+}
+
 // getReplicaStateFromCtx gets replica relationship metadata from rpc context.
 func (r *ReplicaHandler) getReplicaStateFromCtx(ctx context.Context) (replicatorState models.ReplicaState, err error) {
 	replicaStateData, err := rpc.GetStringFromContext(ctx, constants.RPCMetaReplicaState)
@@ -25,9 +25,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/replica"
 )
@@ -40,7 +43,7 @@ func TestReplicaHandler_Replica(t *testing.T) {
 
 	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
 	replicaServer := protoReplicaV1.NewMockReplicaService_ReplicaServer(ctrl)
-	r := NewReplicaHandler(walMgr)
+	r := NewReplicaHandler(walMgr, replica.NewReadOnlyController())
 
 	// case 5: create partition err
 	ctx := metadata.NewIncomingContext(context.TODO(),
@@ -87,3 +90,97 @@ func TestReplicaHandler_Replica(t *testing.T) {
 	err = r.Replica(replicaServer)
 	assert.NoError(t, err)
 }
+
+func TestReplicaHandler_Replica_ReadOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	replicaServer := protoReplicaV1.NewMockReplicaService_ReplicaServer(ctrl)
+	readOnly := replica.NewReadOnlyController()
+	r := NewReplicaHandler(walMgr, readOnly)
+	readOnly.SetReadOnly("", true)
+
+	// case 1: reject single family stream
+	replicaServer.EXPECT().Context().Return(metadata.NewIncomingContext(context.TODO(),
+		metadata.Pairs(constants.RPCMetaReplicaState, `{"database":"test-db","shardId":1,"leader":2,"follower":3}`)))
+	err := r.Replica(replicaServer)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// case 2: reject multiplexed stream
+	replicaServer.EXPECT().Context().Return(metadata.NewIncomingContext(context.TODO(),
+		metadata.Pairs(constants.RPCMetaKeyDatabase, "test-db")))
+	err = r.Replica(replicaServer)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// case 3: switch to read-only after stream accepted, close stream without writing
+	readOnly.SetReadOnly("", false)
+	replicaServer.EXPECT().Context().Return(metadata.NewIncomingContext(context.TODO(),
+		metadata.Pairs(constants.RPCMetaKeyDatabase, "test-db")))
+	replicaServer.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaRequest, error) {
+		readOnly.SetReadOnly("test-db", true)
+		return &protoReplicaV1.ReplicaRequest{Database: "test-db", Shard: 1, Leader: 2, Follower: 3, FamilyTime: 10}, nil
+	})
+	err = r.Replica(replicaServer)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestReplicaHandler_Replica_Multiplexed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	replicaServer := protoReplicaV1.NewMockReplicaService_ReplicaServer(ctrl)
+	r := NewReplicaHandler(walMgr, replica.NewReadOnlyController())
+
+	// case 1: replica state/database not in metadata
+	replicaServer.EXPECT().Context().Return(context.TODO())
+	err := r.Replica(replicaServer)
+	assert.Error(t, err)
+
+	// case 2: family frames interleaved on database stream
+	ctx := metadata.NewIncomingContext(context.TODO(),
+		metadata.Pairs(constants.RPCMetaKeyDatabase, "test-db"))
+	replicaServer.EXPECT().Context().Return(ctx)
+	wal := replica.NewMockWriteAheadLog(ctrl)
+	walMgr.EXPECT().GetOrCreateLog("test-db").Return(wal).AnyTimes()
+	p := replica.NewMockPartition(ctrl)
+	wal.EXPECT().GetOrCreatePartition(models.ShardID(1), int64(10), models.NodeID(2)).Return(p, nil)
+	wal.EXPECT().GetOrCreatePartition(models.ShardID(2), int64(10), models.NodeID(2)).Return(nil, fmt.Errorf("err"))
+	p.EXPECT().BuildReplicaForFollower(models.NodeID(2), models.NodeID(3)).Return(nil)
+	p.EXPECT().ReplicaLog(int64(5), gomock.Any()).Return(int64(5), nil)
+	p.EXPECT().ReplicaLog(int64(6), gomock.Any()).Return(int64(6), nil)
+	family1 := &protoReplicaV1.ReplicaRequest{Database: "test-db", Shard: 1, Leader: 2, Follower: 3, FamilyTime: 10}
+	family2 := &protoReplicaV1.ReplicaRequest{Database: "test-db", Shard: 2, Leader: 2, Follower: 3, FamilyTime: 10}
+	gomock.InOrder(
+		replicaServer.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaRequest, error) {
+			req := *family1
+			req.ReplicaIndex = 5
+			return &req, nil
+		}),
+		replicaServer.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoReplicaV1.ReplicaResponse) error {
+			assert.Equal(t, int32(1), resp.Shard)
+			assert.Equal(t, int64(10), resp.FamilyTime)
+			assert.Equal(t, int64(5), resp.AckIndex)
+			return nil
+		}),
+		replicaServer.EXPECT().Recv().Return(family2, nil),
+		replicaServer.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoReplicaV1.ReplicaResponse) error {
+			assert.Equal(t, int32(2), resp.Shard)
+			assert.Equal(t, "err", resp.Err)
+			return nil
+		}),
+		replicaServer.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaRequest, error) {
+			req := *family1
+			req.ReplicaIndex = 6
+			return &req, nil
+		}),
+		replicaServer.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoReplicaV1.ReplicaResponse) error {
+			assert.Equal(t, int64(6), resp.AckIndex)
+			return nil
+		}),
+		replicaServer.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	err = r.Replica(replicaServer)
+	assert.NoError(t, err)
+}
@@ -19,14 +19,18 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/logger"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 	"github.com/lindb/lindb/replica"
@@ -35,7 +39,9 @@ import (
 
 // WriteHandler implements protoWriteV1.WriteServiceServer interface for handling write rpc request.
 type WriteHandler struct {
-	walMgr replica.WriteAheadLogManager
+	walMgr   replica.WriteAheadLogManager
+	pool     concurrent.Pool // isolates the writes of different databases, writes execute in rpc stream if nil
+	readOnly replica.ReadOnlyController
 
 	logger *logger.Logger
 }
@@ -43,10 +49,14 @@ type WriteHandler struct {
 // NewWriteHandler creates a write handler.
 func NewWriteHandler(
 	walMgr replica.WriteAheadLogManager,
+	pool concurrent.Pool,
+	readOnly replica.ReadOnlyController,
 ) *WriteHandler {
 	return &WriteHandler{
-		walMgr: walMgr,
-		logger: logger.GetLogger("Storage", "WriteRPC"),
+		walMgr:   walMgr,
+		pool:     pool,
+		readOnly: readOnly,
+		logger:   logger.GetLogger("Storage", "WriteRPC"),
 	}
 }
 
@@ -60,6 +70,10 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 	if len(familyState.Shard.Replica.Replicas) == 0 {
 		return status.Error(codes.InvalidArgument, "replicas cannot be empty")
 	}
+	if r.readOnly.IsReadOnly(familyState.Database) {
+		// reject stream before broker sends any data, broker retries/spools the writes
+		return errorpkg.GRPCError(fmt.Errorf("%w, database: %s", constants.ErrStorageReadOnly, familyState.Database))
+	}
 
 	p, err := r.getOrCreatePartition(
 		familyState.Database,
@@ -68,14 +82,19 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		familyState.Shard.Leader)
 	if err != nil {
 		r.logger.Error("get or create wal partition err, when do write", logger.Error(err))
-		return status.Error(codes.Internal, err.Error())
+		return errorpkg.GRPCError(err)
 	}
 	err = p.BuildReplicaForLeader(familyState.Shard.Leader, familyState.Shard.Replica.Replicas)
 	if err != nil {
 		r.logger.Error("build replica replica err", logger.Error(err))
+		return errorpkg.GRPCError(err)
+	}
+	// confirm stream accepted, broker waits it before sending data, so that no data is sent to rejected stream
+	if err := server.SendHeader(metadata.MD{}); err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
 
+	ctx := concurrent.WithTenant(server.Context(), familyState.Database)
 	// handle write request from stream
 	for {
 		req, err := server.Recv()
@@ -88,11 +107,12 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		}
 
 		resp := &protoWriteV1.WriteResponse{}
-		// write wal log
-		err = p.WriteLog(req.Record)
-
-		if err != nil {
+		if err = r.writeLog(ctx, p, req); err != nil {
 			resp.Err = err.Error()
+		} else if r.readOnly.IsReadOnly(familyState.Database) {
+			// switched to read-only after stream accepted, messages sent before broker receives
+			// this response are still written to avoid losing them, broker stops sending after received it.
+			resp.Err = constants.ErrStorageReadOnly.Error()
 		}
 
 		if err := server.Send(resp); err != nil {
@@ -101,6 +121,31 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 	}
 }
 
+// writeLog writes wal log, waits the write completed if executes in write pool.
+func (r *WriteHandler) writeLog(ctx context.Context, p replica.Partition, req *protoWriteV1.WriteRequest) error {
+	write := func() error {
+		if req.AuditID != 0 {
+			return p.WriteAuditLog(req.AuditID, req.Record, req.Timestamp)
+		}
+		return p.WriteLog(req.Record, req.Timestamp)
+	}
+	if r.pool == nil {
+		return write()
+	}
+	var err error
+	done := make(chan struct{})
+	r.pool.Submit(ctx, concurrent.NewTask(func() {
+		err = write()
+		close(done)
+	}, func(panicErr error) {
+		// panic or canceled before executing
+		err = panicErr
+		close(done)
+	}))
+	<-done
+	return err
+}
+
 // getFamilyInfoFromCtx returns family state metadata from rpc context.
 func (r *WriteHandler) getFamilyInfoFromCtx(ctx context.Context) (familyState models.FamilyState, err error) {
 	familyStateDate, err := rpc.GetStringFromContext(ctx, constants.RPCMetaKeyFamilyState)
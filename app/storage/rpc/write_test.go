@@ -23,12 +23,18 @@ import (
 	"io"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 	"github.com/lindb/lindb/replica"
 )
@@ -42,7 +48,8 @@ func TestWriteHandler_Write(t *testing.T) {
 	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
 	replicaServer := protoWriteV1.NewMockWriteService_WriteServer(ctrl)
 	replicaServer.EXPECT().Context().Return(context.TODO())
-	r := NewWriteHandler(walMgr)
+	readOnly := replica.NewReadOnlyController()
+	r := NewWriteHandler(walMgr, nil, readOnly)
 
 	// case 1: family state not exist
 	err := r.Write(replicaServer)
@@ -79,8 +86,13 @@ func TestWriteHandler_Write(t *testing.T) {
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
 
-	// case 7: recv req err
+	// case 7: send header err
 	p.EXPECT().BuildReplicaForLeader(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	replicaServer.EXPECT().SendHeader(gomock.Any()).Return(fmt.Errorf("err"))
+	err = r.Write(replicaServer)
+	assert.Error(t, err)
+	// case 7: recv req err
+	replicaServer.EXPECT().SendHeader(gomock.Any()).Return(nil).AnyTimes()
 	replicaServer.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
@@ -90,15 +102,53 @@ func TestWriteHandler_Write(t *testing.T) {
 	assert.NoError(t, err)
 	// case 9: write wal err
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(fmt.Errorf("err"))
+	p.EXPECT().WriteLog(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	replicaServer.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
 	// case 10: write wal ok
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	p.EXPECT().WriteLog(gomock.Any(), gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Send(gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
 	err = r.Write(replicaServer)
 	assert.NoError(t, err)
+	// case 11: write audit message
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{AuditID: 10, Record: []byte{1}, Timestamp: 100}, nil)
+	p.EXPECT().WriteAuditLog(int64(10), []byte{1}, int64(100)).Return(nil)
+	replicaServer.EXPECT().Send(gomock.Any()).Return(nil)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	err = r.Write(replicaServer)
+	assert.NoError(t, err)
+	// case 12: write wal in write pool
+	r.pool = concurrent.NewFairPool("test-write",
+		concurrent.NewPool("test-write", 2, time.Second, metrics.NewConcurrentStatistics("test-write", linmetric.StorageRegistry)),
+		2, nil, linmetric.StorageRegistry)
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
+	p.EXPECT().WriteLog(gomock.Any(), gomock.Any()).Return(nil)
+	replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{}).Return(nil)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	err = r.Write(replicaServer)
+	assert.NoError(t, err)
+	// case 13: write pool stopped
+	r.pool.Stop()
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
+	replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{Err: concurrent.ErrPoolStopped.Error()}).Return(nil)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	err = r.Write(replicaServer)
+	assert.NoError(t, err)
+	// case 14: switch to read-only after stream accepted, write message then respond read-only
+	r.pool = nil
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
+	p.EXPECT().WriteLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ []byte, _ int64) error {
+		readOnly.SetReadOnly("test-db", true)
+		return nil
+	})
+	replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{Err: constants.ErrStorageReadOnly.Error()}).Return(nil)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	err = r.Write(replicaServer)
+	assert.NoError(t, err)
+	// case 15: reject stream if database is read-only
+	err = r.Write(replicaServer)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
 }
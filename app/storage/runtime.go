@@ -40,6 +40,7 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -47,16 +48,20 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/queue"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/query/operator"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
 
 // factory represents all factories for storage
@@ -106,6 +111,7 @@ type runtime struct {
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            storage.StateManager
 	walMgr              replica.WriteAheadLogManager
+	readOnly            replica.ReadOnlyController
 	dbLifecycle         DatabaseLifecycle
 
 	node            *models.StatefulNode
@@ -117,6 +123,7 @@ type runtime struct {
 	rpcHandler      *rpcHandler
 	httpServer      httppkg.Server
 	queryPool       concurrent.Pool
+	writePool       concurrent.Pool
 	globalKeyValues tag.Tags
 
 	log *logger.Logger
@@ -125,7 +132,7 @@ type runtime struct {
 // NewStorageRuntime creates storage runtime
 func NewStorageRuntime(version string, myID int, cfg *config.Storage) server.Service {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &runtime{
+	r := &runtime{
 		myID:        myID,
 		state:       server.New,
 		repoFactory: state.NewRepositoryFactory("storage"),
@@ -133,15 +140,64 @@ func NewStorageRuntime(version string, myID int, cfg *config.Storage) server.Ser
 		config:      cfg,
 		ctx:         ctx,
 		cancel:      cancel,
-		queryPool: concurrent.NewPool(
-			"task-pool",
-			cfg.Query.QueryConcurrency,
-			cfg.Query.IdleTimeout.Duration(),
-			metrics.NewConcurrentStatistics("storage-query", linmetric.StorageRegistry)),
 		delayInit:   time.Second,
 		initializer: bootstrap.NewClusterInitializer(cfg.StorageBase.BrokerEndpoint),
 		log:         logger.GetLogger("Storage", "Runtime"),
 	}
+	// query tasks of databases share the priority pool by weighted fair scheduling
+	r.queryPool = concurrent.NewFairPool(
+		"storage-query",
+		concurrent.NewPriorityPool(
+			"storage-query",
+			cfg.Query.QueryConcurrency,
+			cfg.Query.IdleTimeout.Duration(),
+			linmetric.StorageRegistry),
+		cfg.Query.QueryConcurrency,
+		r.readQuota,
+		linmetric.StorageRegistry)
+	if concurrency := cfg.StorageBase.WAL.WriteConcurrency; concurrency > 0 {
+		r.writePool = concurrent.NewFairPool(
+			"storage-write",
+			concurrent.NewPool(
+				"storage-write",
+				concurrency,
+				cfg.Query.IdleTimeout.Duration(),
+				metrics.NewConcurrentStatistics("storage-write", linmetric.StorageRegistry)),
+			concurrency,
+			r.writeQuota,
+			linmetric.StorageRegistry)
+	}
+	return r
+}
+
+// readQuota returns the read quota of database in query pool.
+func (r *runtime) readQuota(database string) concurrent.Quota {
+	opt := r.databaseOption(database)
+	if opt == nil {
+		return concurrent.Quota{}
+	}
+	return concurrent.Quota{Weight: opt.ReadQuota.Weight, MaxConcurrency: opt.ReadQuota.MaxConcurrency}
+}
+
+// writeQuota returns the write quota of database in write pool.
+func (r *runtime) writeQuota(database string) concurrent.Quota {
+	opt := r.databaseOption(database)
+	if opt == nil {
+		return concurrent.Quota{}
+	}
+	return concurrent.Quota{Weight: opt.WriteQuota.Weight, MaxConcurrency: opt.WriteQuota.MaxConcurrency}
+}
+
+// databaseOption returns the option of database, returns nil if database not exist.
+func (r *runtime) databaseOption(database string) *option.DatabaseOption {
+	if r.engine == nil {
+		return nil
+	}
+	db, ok := r.engine.GetDatabase(database)
+	if !ok {
+		return nil
+	}
+	return db.GetOption()
 }
 
 // Config returns the configure of storage.
@@ -173,9 +229,36 @@ func (r *runtime) Run() error {
 	}
 
 	opt := kv.StoreOptions{
-		Dir: config.GlobalStorageConfig().TSDB.Dir,
+		Dir:            config.GlobalStorageConfig().TSDB.Dir,
+		BlockCacheSize: int64(config.GlobalStorageConfig().TSDB.BlockCacheSize),
 	}
 	kv.Options.Store(&opt)
+	// set layout version of new written sst file/metric block
+	if err = table.SetFormatVersion(config.GlobalStorageConfig().TSDB.TableFormatVersion); err != nil {
+		r.state = server.Failed
+		return err
+	}
+	if err = metricsdata.SetFormatVersion(config.GlobalStorageConfig().TSDB.DataFormatVersion); err != nil {
+		r.state = server.Failed
+		return err
+	}
+	// set sync policy of new built sst file
+	table.SetSyncPolicy(fileutil.SyncPolicy(config.GlobalStorageConfig().TSDB.TableSyncPolicy),
+		config.GlobalStorageConfig().TSDB.TableSyncInterval.Duration())
+	// set compaction rate limit and query latency SLO which adjusts compaction throttle level
+	kv.SetCompactThrottle(int64(config.GlobalStorageConfig().TSDB.CompactionRateLimit),
+		config.GlobalStorageConfig().TSDB.CompactionQueryLatencySLO.Duration())
+	// verify option of sst files when opening store, corrupt files are quarantined only if operator enables it
+	kv.SetVerifyOption(config.GlobalStorageConfig().TSDB.VerifyValuesOnOpen,
+		config.GlobalStorageConfig().TSDB.QuarantineCorruptFiles)
+	// big compaction job merges key range partitions concurrently, bounded by flush concurrency
+	kv.SetCompactConcurrency(config.GlobalStorageConfig().TSDB.FlushConcurrency)
+	if resultCacheSize := int64(config.GlobalStorageConfig().TSDB.ResultCacheSize); resultCacheSize > 0 {
+		resultCache := operator.NewResultCache(resultCacheSize)
+		operator.SetResultCache(resultCache)
+		// cached results of sst file are invalidated after file removed
+		kv.SetFileRemovedListener(resultCache.Invalidate)
+	}
 	r.jobScheduler = kv.NewJobScheduler(r.ctx, opt)
 	r.jobScheduler.Startup() // startup kv compact job scheduler
 
@@ -201,6 +284,7 @@ func (r *runtime) Run() error {
 			HTTPPort:   r.config.StorageBase.HTTP.Port,
 			OnlineTime: timeutil.Now(),
 			Version:    config.Version,
+			Labels:     app.GetNodeLabels(),
 		},
 	}
 	r.globalKeyValues = tag.Tags{
@@ -213,6 +297,8 @@ func (r *runtime) Run() error {
 	r.factory = factory{taskServer: rpc.NewTaskServerFactory()}
 	r.stateMgr = storage.NewStateManager(r.ctx, r.node, engine)
 
+	queue.EnableDataPagePreAllocate(r.config.StorageBase.WAL.PreAllocate)
+	queue.SetSyncPolicy(fileutil.SyncPolicy(r.config.StorageBase.WAL.SyncPolicy), r.config.StorageBase.WAL.SyncInterval.Duration())
 	walMgr := newWriteAheadLogManagerFn(
 		r.ctx,
 		r.config.StorageBase.WAL,
@@ -225,6 +311,7 @@ func (r *runtime) Run() error {
 		return err
 	}
 	r.walMgr = walMgr
+	r.readOnly = replica.NewReadOnlyController()
 
 	// start tcp server
 	r.startTCPServer()
@@ -240,6 +327,8 @@ func (r *runtime) Run() error {
 
 	r.dbLifecycle = newDatabaseLifecycleFn(r.ctx, r.repo, r.walMgr, r.engine)
 	r.dbLifecycle.Startup()
+	// report disk capacity for shard placement of master
+	go newCapacityReporter(r.ctx, r.repo, r.node.ID, r.config.StorageBase.TSDB.Dir).Run()
 
 	// Use Leader election mechanism to ensure the uniqueness of stateful node id
 	if err := r.MustRegisterStateFulNode(); err != nil {
@@ -387,6 +476,10 @@ func (r *runtime) Stop() {
 		r.log.Info("stopped GRPC server")
 	}
 
+	if r.writePool != nil {
+		r.writePool.Stop()
+	}
+
 	if r.dbLifecycle != nil {
 		r.dbLifecycle.Shutdown()
 	}
@@ -408,10 +501,14 @@ func (r *runtime) startHTTPServer() {
 	exploreAPI.Register(v1)
 	replicaAPI := stateapi.NewReplicaAPI(r.walMgr)
 	replicaAPI.Register(v1)
-	tsdbStateAPI := stateapi.NewTSDBAPI()
+	readOnlyAPI := stateapi.NewReadOnlyAPI(r.readOnly)
+	readOnlyAPI.Register(v1)
+	tsdbStateAPI := stateapi.NewTSDBAPI(r.engine)
 	tsdbStateAPI.Register(v1)
 	stateMachineAPI := stateapi.NewStorageStateMachineAPI(r.stateMgr)
 	stateMachineAPI.Register(v1)
+	limitsAPI := stateapi.NewLimitsAPI(r.stateMgr)
+	limitsAPI.Register(v1)
 	logAPI := api.NewLoggerAPI(r.config.Logging.Dir)
 	logAPI.Register(v1)
 	configAPI := api.NewConfigAPI(r.node, r.config)
@@ -420,6 +517,14 @@ func (r *runtime) startHTTPServer() {
 	requestAPI.Register(v1)
 	metadataAPI := stateapi.NewMetadataAPI(r.engine)
 	metadataAPI.Register(v1)
+	healthAPI := api.NewHealthAPI(r.State,
+		api.NewRepoHealthCheck(func() state.Repository { return r.repo }),
+		api.NewWritableHealthCheck("wal", r.config.StorageBase.WAL.Dir),
+		api.NewDiskHealthCheck("wal-disk", r.config.StorageBase.WAL.Dir),
+		api.NewDiskHealthCheck("tsdb-disk", r.config.StorageBase.TSDB.Dir),
+		stateapi.NewReplicaHealthCheck(r.engine, r.walMgr),
+	)
+	healthAPI.Register(v1)
 
 	go func() {
 		if err := r.httpServer.Run(); err != http.ErrServerClosed {
@@ -453,8 +558,8 @@ func (r *runtime) bindRPCHandlers() {
 	)
 
 	r.rpcHandler = &rpcHandler{
-		replica: rpchandler.NewReplicaHandler(r.walMgr),
-		write:   rpchandler.NewWriteHandler(r.walMgr),
+		replica: rpchandler.NewReplicaHandler(r.walMgr, r.readOnly),
+		write:   rpchandler.NewWriteHandler(r.walMgr, r.writePool, r.readOnly),
 		task: query.NewTaskHandler(
 			r.config.Query,
 			r.factory.taskServer,
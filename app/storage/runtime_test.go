@@ -32,6 +32,7 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	storagepkg "github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/models"
@@ -39,6 +40,7 @@ import (
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/hostutil"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
@@ -50,7 +52,8 @@ var cfg = config.Storage{
 		Namespace: "/test/2222",
 	},
 	StorageBase: config.StorageBase{
-		WAL: config.WAL{RemoveTaskInterval: ltoml.Duration(time.Minute)},
+		WAL:                    config.WAL{RemoveTaskInterval: ltoml.Duration(time.Minute)},
+		DiskUsageCheckInterval: ltoml.Duration(time.Minute),
 		GRPC: config.GRPC{
 			Port: 7777,
 		},
@@ -354,3 +357,30 @@ func TestStorage_Run_With_Wrong_MyID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, server.Failed, r.State())
 }
+
+func TestStorage_PoolQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg1 := cfg
+	cfg1.StorageBase.WAL.WriteConcurrency = 4
+	r := NewStorageRuntime("test-version", 1, &cfg1).(*runtime)
+	assert.NotNil(t, r.writePool)
+	defer r.writePool.Stop()
+	// case 1: engine not initialized
+	assert.Equal(t, concurrent.Quota{}, r.readQuota("test-db"))
+	// case 2: database not exist
+	engine := tsdb.NewMockEngine(ctrl)
+	r.engine = engine
+	engine.EXPECT().GetDatabase("test-db").Return(nil, false)
+	assert.Equal(t, concurrent.Quota{}, r.writeQuota("test-db"))
+	// case 3: quota of database
+	db := tsdb.NewMockDatabase(ctrl)
+	engine.EXPECT().GetDatabase("test-db").Return(db, true).AnyTimes()
+	db.EXPECT().GetOption().Return(&option.DatabaseOption{
+		ReadQuota:  option.PoolQuotaOption{Weight: 4},
+		WriteQuota: option.PoolQuotaOption{Weight: 2, MaxConcurrency: 1},
+	}).AnyTimes()
+	assert.Equal(t, concurrent.Quota{Weight: 4}, r.readQuota("test-db"))
+	assert.Equal(t, concurrent.Quota{Weight: 2, MaxConcurrency: 1}, r.writeQuota("test-db"))
+}
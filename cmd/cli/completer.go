@@ -0,0 +1,187 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	prompt "github.com/c-bata/go-prompt"
+
+	"github.com/lindb/lindb/models"
+)
+
+var (
+	// metricPattern matches the metric name of query(select ... from cpu/from cpu select ...).
+	metricPattern = regexp.MustCompile(`(?i)\bfrom\s+([^\s,;()]+)`)
+	// clausePattern matches the keywords which end the select field list.
+	clausePattern = regexp.MustCompile(`(?i)\b(from|where|group|order|limit)\b`)
+)
+
+// metadataSuggester suggests database names, metrics, tag keys and field names,
+// which are fetched lazily by metadata statements and cached for the session.
+type metadataSuggester struct {
+	cache map[string][]string // cache key(type/database/metric) => names
+	mutex sync.Mutex
+}
+
+// newMetadataSuggester creates a metadata suggester.
+func newMetadataSuggester() *metadataSuggester {
+	return &metadataSuggester{
+		cache: make(map[string][]string),
+	}
+}
+
+// suggest returns the metadata suggestions based on the context of input text,
+// returns nil if there is no metadata need to complete.
+func (s *metadataSuggester) suggest(text, database string) []prompt.Suggest {
+	words := strings.Fields(strings.ToLower(text))
+	if len(words) == 0 {
+		return nil
+	}
+	prevWord := words[len(words)-1]
+	if !strings.HasSuffix(text, " ") {
+		// completing current word, check previous word
+		if len(words) < 2 {
+			return nil
+		}
+		prevWord = words[len(words)-2]
+	}
+	var names []string
+	switch prevWord {
+	case "use", "on":
+		names = s.databases()
+	case "from", "metric":
+		names = s.metrics(database)
+	case "where", "by", "and", "or":
+		names = s.tagKeys(database, metricOf(text))
+	default:
+		if (prevWord == "select" || strings.HasSuffix(prevWord, ",")) && inSelectClause(text) {
+			names = s.fields(database, metricOf(text))
+		}
+	}
+	suggests := make([]prompt.Suggest, 0, len(names))
+	for _, name := range names {
+		suggests = append(suggests, prompt.Suggest{Text: name})
+	}
+	return suggests
+}
+
+// databases returns the database names.
+func (s *metadataSuggester) databases() []string {
+	return s.load("database", func() []string {
+		rs := models.DatabaseNames{}
+		if err := cli.Execute(models.ExecuteParam{SQL: "show databases"}, &rs); err != nil {
+			return nil
+		}
+		return rs
+	})
+}
+
+// metrics returns the metric names of database.
+func (s *metadataSuggester) metrics(database string) []string {
+	if database == "" {
+		return nil
+	}
+	return s.load("metric/"+database, func() []string {
+		return loadMetadata(database, "show metrics", "")
+	})
+}
+
+// tagKeys returns the tag keys of metric.
+func (s *metadataSuggester) tagKeys(database, metric string) []string {
+	if database == "" || metric == "" {
+		return nil
+	}
+	return s.load("tag/"+database+"/"+metric, func() []string {
+		return loadMetadata(database, fmt.Sprintf("show tag keys from %s", metric), "")
+	})
+}
+
+// fields returns the field names of metric.
+func (s *metadataSuggester) fields(database, metric string) []string {
+	if database == "" || metric == "" {
+		return nil
+	}
+	return s.load("field/"+database+"/"+metric, func() []string {
+		return loadMetadata(database, fmt.Sprintf("show fields from %s", metric), "name")
+	})
+}
+
+// load returns the names from cache, if not exist loads them then caches them(even if load failure).
+func (s *metadataSuggester) load(key string, loadFn func() []string) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if names, ok := s.cache[key]; ok {
+		return names
+	}
+	names := loadFn()
+	s.cache[key] = names
+	return names
+}
+
+// reset clears the cached metadata.
+func (s *metadataSuggester) reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cache = make(map[string][]string)
+}
+
+// loadMetadata executes metadata statement, then returns the names of values,
+// if value is object, returns the value of given key.
+func loadMetadata(database, sql, key string) []string {
+	rs := &models.Metadata{}
+	if err := cli.Execute(models.ExecuteParam{SQL: sql, Database: database}, rs); err != nil {
+		return nil
+	}
+	values, ok := rs.Values.([]interface{})
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, value := range values {
+		if key != "" {
+			if obj, ok := value.(map[string]interface{}); ok {
+				value = obj[key]
+			}
+		}
+		if name, ok := value.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// inSelectClause checks if the end of input text is in the select field list.
+func inSelectClause(text string) bool {
+	idx := strings.LastIndex(strings.ToLower(text), "select")
+	return idx >= 0 && !clausePattern.MatchString(text[idx:])
+}
+
+// metricOf returns the metric name of query.
+func metricOf(text string) string {
+	matches := metricPattern.FindStringSubmatch(text)
+	if len(matches) < 2 {
+		return ""
+	}
+	return matches[1]
+}
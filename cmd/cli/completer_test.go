@@ -0,0 +1,106 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	prompt "github.com/c-bata/go-prompt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+)
+
+func TestMetadataSuggester_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCli := client.NewMockExecuteCli(ctrl)
+	defer func() {
+		cli = nil
+		ctrl.Finish()
+	}()
+	cli = mockCli
+
+	mockCli.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(param models.ExecuteParam, rs interface{}) error {
+			switch param.SQL {
+			case "show databases":
+				*(rs.(*models.DatabaseNames)) = models.DatabaseNames{"db1", "db2"}
+			case "show metrics":
+				rs.(*models.Metadata).Values = []interface{}{"cpu", "memory"}
+			case "show tag keys from cpu":
+				rs.(*models.Metadata).Values = []interface{}{"host", "region"}
+			case "show fields from cpu":
+				rs.(*models.Metadata).Values = []interface{}{
+					map[string]interface{}{"name": "usage", "type": "sum"},
+					map[string]interface{}{"name": "idle", "type": "sum"},
+				}
+			case "show fields from memory":
+				rs.(*models.Metadata).Values = "invalid"
+			default:
+				return fmt.Errorf("err")
+			}
+			return nil
+		}).AnyTimes()
+
+	s := newMetadataSuggester()
+	cases := []struct {
+		text     string
+		db       string
+		suggests []string
+	}{
+		{text: "", suggests: nil},
+		{text: "use", suggests: nil},
+		{text: "use ", suggests: []string{"db1", "db2"}},
+		{text: "use d", suggests: []string{"db1", "db2"}},
+		{text: "select f from ", suggests: nil},
+		{text: "select f from ", db: "db", suggests: []string{"cpu", "memory"}},
+		{text: "select f from cpu where ", db: "db", suggests: []string{"host", "region"}},
+		{text: "select f from cpu\nwhere host='a' and ", db: "db", suggests: []string{"host", "region"}},
+		{text: "select f from cpu group by ", db: "db", suggests: []string{"host", "region"}},
+		{text: "select ", db: "db", suggests: nil},
+		{text: "from cpu select ", db: "db", suggests: []string{"usage", "idle"}},
+		{text: "from cpu select usage, ", db: "db", suggests: []string{"usage", "idle"}},
+		{text: "from cpu select usage ", db: "db", suggests: nil},
+		{text: "from memory select ", db: "db", suggests: nil},
+		{text: "from disk select ", db: "db", suggests: nil},
+		{text: "select f from disk where ", db: "db", suggests: nil},
+	}
+	for _, tt := range cases {
+		var names []string
+		for _, suggest := range s.suggest(tt.text, tt.db) {
+			names = append(names, suggest.Text)
+		}
+		assert.Equal(t, tt.suggests, names, tt.text)
+	}
+
+	s.reset()
+	assert.Empty(t, s.cache)
+}
+
+func TestCompleter_Metadata(t *testing.T) {
+	defer func() {
+		suggester = newMetadataSuggester()
+		inputC.db = ""
+	}()
+	suggester.cache["metric/db"] = []string{"cpu", "memory"}
+	inputC.db = "db"
+	assert.Equal(t, []prompt.Suggest{{Text: "cpu"}}, completer("select f from c"))
+}
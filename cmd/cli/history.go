@@ -0,0 +1,122 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	prompt "github.com/c-bata/go-prompt"
+)
+
+// maxHistorySize represents the max number of statements kept in history file.
+const maxHistorySize = 1000
+
+// history represents the persistent statement history of cli,
+// supports reverse search by the text of input buffer.
+type history struct {
+	path    string
+	entries []string
+
+	searchTerm string // text which is searching
+	searchPos  int    // position of last matched entry
+	lastMatch  string // last matched entry
+}
+
+// newHistory creates a history, loads the statements from history file if exist.
+func newHistory(path string) *history {
+	h := &history{path: path}
+	if path == "" {
+		return h
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return h
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			h.entries = append(h.entries, line)
+		}
+	}
+	if len(h.entries) > maxHistorySize {
+		h.entries = h.entries[len(h.entries)-maxHistorySize:]
+	}
+	return h
+}
+
+// add appends the statement(multi-line statement is joined as one line) to history,
+// ignores the duplicate statement with the latest one.
+func (h *history) add(statement string) {
+	statement = strings.Join(strings.Fields(statement), " ")
+	if statement == "" || (len(h.entries) > 0 && h.entries[len(h.entries)-1] == statement) {
+		return
+	}
+	h.entries = append(h.entries, statement)
+	if h.path == "" {
+		return
+	}
+	if len(h.entries) > maxHistorySize {
+		// truncate history file if too many statements
+		h.entries = h.entries[len(h.entries)-maxHistorySize:]
+		_ = os.WriteFile(h.path, []byte(strings.Join(h.entries, "\n")+"\n"), 0600)
+		return
+	}
+	f, err := os.OpenFile(h.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return
+	}
+	_, _ = f.WriteString(statement + "\n")
+	_ = f.Close()
+}
+
+// search returns the latest statement which contains the term before the position of last match,
+// restarts the search if the term changed.
+func (h *history) search(term string) (string, bool) {
+	if term != h.lastMatch || h.lastMatch == "" {
+		h.searchTerm = term
+		h.searchPos = len(h.entries)
+	}
+	for i := h.searchPos - 1; i >= 0; i-- {
+		if strings.Contains(h.entries[i], h.searchTerm) {
+			h.searchPos = i
+			h.lastMatch = h.entries[i]
+			return h.lastMatch, true
+		}
+	}
+	return "", false
+}
+
+// reverseSearch replaces the text of input buffer with the matched statement(bind with ctrl+r),
+// press ctrl+r again to search older statement.
+func (h *history) reverseSearch(buf *prompt.Buffer) {
+	statement, ok := h.search(buf.Text())
+	if !ok {
+		return
+	}
+	doc := buf.Document()
+	buf.DeleteBeforeCursor(utf8.RuneCountInString(doc.TextBeforeCursor()))
+	buf.Delete(utf8.RuneCountInString(doc.TextAfterCursor()))
+	buf.InsertText(statement, false, true)
+}
@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	prompt "github.com/c-bata/go-prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory_Persistent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".lin_history")
+	h := newHistory(path)
+	assert.Empty(t, h.entries)
+	h.add("use db")
+	h.add("select f\nfrom cpu")
+	h.add("select f from cpu")
+	h.add("  ")
+	assert.Equal(t, []string{"use db", "select f from cpu"}, h.entries)
+
+	h = newHistory(path)
+	assert.Equal(t, []string{"use db", "select f from cpu"}, h.entries)
+
+	for i := 0; i < maxHistorySize; i++ {
+		h.add("show metrics " + strconv.Itoa(i))
+	}
+	assert.Len(t, h.entries, maxHistorySize)
+	h = newHistory(path)
+	assert.Len(t, h.entries, maxHistorySize)
+	assert.Equal(t, "show metrics 999", h.entries[maxHistorySize-1])
+
+	// history file is a dir
+	assert.NoError(t, os.Remove(path))
+	assert.NoError(t, os.Mkdir(path, 0755))
+	h = newHistory(path)
+	h.add("show databases")
+	assert.Equal(t, []string{"show databases"}, h.entries)
+}
+
+func TestHistory_Disable(t *testing.T) {
+	h := newHistory("")
+	h.add("show databases")
+	assert.Equal(t, []string{"show databases"}, h.entries)
+}
+
+func TestHistory_ReverseSearch(t *testing.T) {
+	h := newHistory("")
+	h.add("select f from cpu")
+	h.add("show databases")
+	h.add("select f from memory")
+
+	buf := prompt.NewBuffer()
+	buf.InsertText("select", false, true)
+	h.reverseSearch(buf)
+	assert.Equal(t, "select f from memory", buf.Text())
+	// search older statement
+	h.reverseSearch(buf)
+	assert.Equal(t, "select f from cpu", buf.Text())
+	// not found, keep input
+	h.reverseSearch(buf)
+	assert.Equal(t, "select f from cpu", buf.Text())
+
+	buf = prompt.NewBuffer()
+	buf.InsertText("abc", false, true)
+	h.reverseSearch(buf)
+	assert.Equal(t, "abc", buf.Text())
+	buf = prompt.NewBuffer()
+	buf.InsertText("show", false, true)
+	h.reverseSearch(buf)
+	assert.Equal(t, "show databases", buf.Text())
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -52,11 +53,13 @@ const (
 )
 
 type inputCtx struct {
-	db string
+	db     string
+	format string
 }
 
 var (
-	endpoint string
+	endpoint    string
+	historyPath string
 	// tokens represents suggest token.
 	tokens = []prompt.Suggest{
 		{Text: "show"},
@@ -84,16 +87,21 @@ var (
 		{Text: "key"},
 		{Text: "values"},
 		{Text: "and"},
+		{Text: "format"},
 	}
 	spacesPattern = regexp.MustCompile(`\s+`)
 	inputC        = &inputCtx{}
 	query         = ""
 	live          = true
 	cli           client.ExecuteCli
+	suggester     = newMetadataSuggester()
+	hist          = newHistory("")
 )
 
 func init() {
 	flag.StringVar(&endpoint, "endpoint", "http://localhost:9000", "Broker HTTP Endpoint")
+	flag.StringVar(&inputC.format, "format", string(models.TableFormat), "Output format of result(table/vertical/csv/jsonl)")
+	flag.StringVar(&historyPath, "history", defaultHistoryPath(), "History file of statements, disable persistent history if empty")
 }
 
 // printErr prints error message.
@@ -101,9 +109,24 @@ func printErr(err error) {
 	fmt.Println(color.RedString("ERROR:%s", err))
 }
 
+// defaultHistoryPath returns the default history file under user home dir.
+func defaultHistoryPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".lin_history")
+}
+
 // executor executes command.
 func executor(in string) {
 	in = strings.TrimSpace(in)
+	if in == `\c` {
+		// cancel the multi-line statement which is editing
+		query = ""
+		live = true
+		return
+	}
 	if strings.HasSuffix(in, ";") {
 		query += in
 		live = true
@@ -117,22 +140,44 @@ func executor(in string) {
 		if query == "" {
 			return
 		}
+		hist.add(query)
 		blocks := strings.Split(spacesPattern.ReplaceAllString(query, " "), " ")
 		switch blocks[0] {
 		case "exit":
 			fmt.Println("Good Bye :)")
 			exit(0)
 			return
+		case "format":
+			// switch output format of session(format csv)
+			format, err := models.ParseOutputFormat(strings.Join(blocks[1:], ""))
+			if err != nil {
+				printErr(err)
+				return
+			}
+			inputC.format = string(format)
+			fmt.Printf("Output format changed(current:%s)\n", inputC.format)
+			return
 		default:
-			stmt, err := sql.Parse(query)
+			// output format clause of statement overwrites the format of session
+			stmt, format, err := sql.ParseWithFormat(query)
+			if format == "" {
+				format = inputC.format
+			}
 			if err != nil {
 				printErr(err)
+				var syntaxErr *sql.SyntaxError
+				if errors.As(err, &syntaxErr) {
+					// point out the position of syntax error
+					fmt.Println(syntaxErr.Pointer(query))
+				}
 				return
 			}
 			var result interface{}
 			switch s := stmt.(type) {
 			case *stmtpkg.Use:
 				inputC.db = s.Name
+				// refresh metadata suggestions of database
+				suggester.reset()
 				fmt.Printf("Database changed(current:%s)\n", inputC.db)
 				return
 			case *stmtpkg.Storage:
@@ -146,6 +191,52 @@ func executor(in string) {
 					result = &models.Master{}
 				case stmtpkg.BrokerAlive:
 					result = &models.StatelessNodes{}
+				case stmtpkg.Shards:
+					result = &models.Shards{}
+				case stmtpkg.Segments:
+					result = &models.Segments{}
+				case stmtpkg.DiskUsage:
+					result = &models.DiskUsages{}
+				case stmtpkg.FileDetail:
+					result = &models.DataFileDetails{}
+				case stmtpkg.ReplicationChannels:
+					result = &models.ReplicaChannelStates{}
+				case stmtpkg.ExpiredMetrics:
+					result = &models.ExpiredMetrics{}
+				case stmtpkg.MemoryDatabase:
+					result = &models.MemoryDatabaseStates{}
+				}
+			case *stmtpkg.Placement:
+				switch s.Type {
+				case stmtpkg.ShowPlacement:
+					result = &models.PlacementViolations{}
+				case stmtpkg.RepairPlacement, stmtpkg.ShowPlacementSuggestions:
+					result = &models.PlacementMoves{}
+				}
+			case *stmtpkg.DeleteSeries:
+				if strings.TrimSpace(inputC.db) == "" {
+					printErr(errors.New("please select database(use ...)"))
+					return
+				}
+				result = &models.SeriesDeletions{}
+			case *stmtpkg.ReadOnly:
+				result = &models.ReadOnlyStates{}
+			case *stmtpkg.Compaction:
+				switch s.Type {
+				case stmtpkg.ShowCompactionJobs:
+					result = &models.CompactionJobs{}
+				default:
+					result = &models.CompactionStates{}
+				}
+			case *stmtpkg.FieldUsage:
+				if strings.TrimSpace(inputC.db) == "" {
+					printErr(errors.New("please select database(use ...)"))
+					return
+				}
+				result = &models.FieldUsages{}
+			case *stmtpkg.Limit:
+				if s.Type == stmtpkg.ShowLimitStatus {
+					result = &models.LimitsStates{}
 				}
 			case *stmtpkg.Schema:
 				switch s.Type {
@@ -167,7 +258,7 @@ func executor(in string) {
 					return
 				}
 			}
-			rs, err := cli.ExecuteAsResult(models.ExecuteParam{SQL: query, Database: inputC.db}, result)
+			rs, err := cli.ExecuteAsResult(models.ExecuteParam{SQL: query, Database: inputC.db, Format: format}, result)
 			if err != nil {
 				printErr(err)
 				return
@@ -178,7 +269,8 @@ func executor(in string) {
 		return
 	}
 
-	query += strings.TrimSuffix(in, "\r\n") + " "
+	// keep line break of multi-line statement, so that the position of syntax error is correct
+	query += strings.TrimSuffix(in, "\r\n") + "\n"
 	live = false
 }
 
@@ -189,7 +281,9 @@ func completer(bc string) []prompt.Suggest {
 	}
 	args := strings.Split(spacesPattern.ReplaceAllString(bc, " "), " ")
 	cmdName := args[len(args)-1]
-	return prompt.FilterHasPrefix(tokens, cmdName, true)
+	// suggest metadata based on the context of multi-line statement
+	suggests := append(suggester.suggest(query+bc, inputC.db), tokens...)
+	return prompt.FilterHasPrefix(suggests, cmdName, true)
 }
 
 func main() {
@@ -208,6 +302,7 @@ func main() {
 
 	apiEndpoint := endpoint + constants.APIVersion1CliPath
 	cli = newExecuteCli(apiEndpoint)
+	hist = newHistory(historyPath)
 
 	// first retry connect and get master state
 	master := &models.Master{}
@@ -237,6 +332,11 @@ func main() {
 			return prefix + "- > ", true
 		}),
 		prompt.OptionTitle("LinDB Client"),
+		prompt.OptionHistory(hist.entries),
+		prompt.OptionAddKeyBind(prompt.KeyBind{
+			Key: prompt.ControlR,
+			Fn:  hist.reverseSearch,
+		}),
 
 		prompt.OptionPrefixTextColor(prompt.DarkGreen),
 		prompt.OptionInputTextColor(prompt.DarkBlue),
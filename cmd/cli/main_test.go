@@ -201,6 +201,33 @@ func Test_executor(t *testing.T) {
 			name: "parse query sql failure",
 			in:   "select f;",
 		},
+		{
+			name: "switch output format",
+			in:   "format csv;",
+			prepare: func() {
+				assert.Equal(t, "table", inputC.format)
+			},
+		},
+		{
+			name: "unknown output format",
+			in:   "format xml;",
+		},
+		{
+			name: "select query with output format",
+			in:   "select f from cpu format vertical;",
+			prepare: func() {
+				inputC.db = "test"
+				mockCli.EXPECT().ExecuteAsResult(models.ExecuteParam{SQL: "select f from cpu format vertical", Database: "test", Format: "vertical"}, gomock.Any())
+			},
+		},
+		{
+			name: "cancel multi-line statement",
+			in:   `\c`,
+			prepare: func() {
+				executor("select f")
+				assert.Equal(t, "select f\n", query)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -0,0 +1,87 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/lindb/lindb/internal/bench"
+)
+
+var (
+	benchCfg       = bench.NewDefaultConfig()
+	benchFields    = benchCfg.Fields.String()
+	benchQueryFile = ""
+)
+
+// newBenchCmd returns a new bench-cmd
+func newBenchCmd() *cobra.Command {
+	benchCmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Generate synthetic workload against target cluster for benchmarking",
+		Long: "Generate synthetic write/query workload against target cluster, then report latency percentiles, " +
+			"used for capacity planning and regression testing.",
+		RunE: runBench,
+	}
+	flags := benchCmd.Flags()
+	flags.StringVar(&benchCfg.Endpoint, "endpoint", benchCfg.Endpoint, "broker http endpoint")
+	flags.StringVar(&benchCfg.Database, "db", benchCfg.Database, "target database")
+	flags.StringVar(&benchCfg.Namespace, "ns", benchCfg.Namespace, "namespace of generated metrics")
+	flags.DurationVar(&benchCfg.Duration, "duration", benchCfg.Duration, "duration of benchmark")
+	flags.IntVar(&benchCfg.Concurrency, "concurrency", benchCfg.Concurrency, "num. of concurrent writers/queriers")
+	flags.IntVar(&benchCfg.MetricCount, "metrics", benchCfg.MetricCount, "num. of metric names")
+	flags.IntVar(&benchCfg.SeriesCount, "series", benchCfg.SeriesCount, "num. of active series")
+	flags.Float64Var(&benchCfg.Churn, "churn", benchCfg.Churn,
+		"ratio of active series replaced by new series each churn interval, range: [0, 1]")
+	flags.DurationVar(&benchCfg.ChurnInterval, "churn-interval", benchCfg.ChurnInterval, "interval of series churn")
+	flags.StringVar(&benchFields, "fields", benchFields,
+		"field mix of each metric, format: sum:1,last:1,first:0,min:0,max:0,histogram:0")
+	flags.IntVar(&benchCfg.WriteRate, "write-rate", benchCfg.WriteRate, "num. of metrics written per second, 0 means no write")
+	flags.IntVar(&benchCfg.BatchSize, "batch-size", benchCfg.BatchSize, "num. of metrics per write request")
+	flags.StringArrayVar(&benchCfg.Queries, "query", nil, "query executed in round-robin, can be repeated")
+	flags.StringVar(&benchQueryFile, "query-file", "",
+		"file of representative dashboard queries for replaying, one query per line")
+	flags.Float64Var(&benchCfg.QueryRate, "query-rate", benchCfg.QueryRate, "num. of queries executed per second, 0 means no query")
+	return benchCmd
+}
+
+// runBench runs the synthetic workload, then prints the report.
+func runBench(_ *cobra.Command, _ []string) error {
+	fields, err := bench.ParseFieldMix(benchFields)
+	if err != nil {
+		return err
+	}
+	benchCfg.Fields = fields
+	if benchQueryFile != "" {
+		queries, err0 := bench.LoadQueries(benchQueryFile)
+		if err0 != nil {
+			return err0
+		}
+		benchCfg.Queries = append(benchCfg.Queries, queries...)
+	}
+	runner, err := bench.NewRunner(benchCfg)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("benchmark %s(database: %s) for %s...\n", benchCfg.Endpoint, benchCfg.Database, benchCfg.Duration)
+	_, report := runner.Run(newCtxWithSignals()).ToTable()
+	fmt.Println(report)
+	return nil
+}
@@ -19,6 +19,9 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/lindb/lindb/pkg/ltoml"
@@ -70,8 +73,51 @@ read-timeout = "%s"`,
 }
 
 type Ingestion struct {
-	MaxConcurrency int            `env:"CONCURRENCY" toml:"max-concurrency"`
-	IngestTimeout  ltoml.Duration `env:"TIMEOUT" toml:"ingest-timeout"`
+	MaxConcurrency int              `env:"CONCURRENCY" toml:"max-concurrency"`
+	IngestTimeout  ltoml.Duration   `env:"TIMEOUT" toml:"ingest-timeout"`
+	Routes         []IngestionRoute `toml:"routes"`
+}
+
+// IngestionRoute represents the relabel/routing rule of ingestion,
+// redirects the matched metrics to target database/namespace before sharding.
+type IngestionRoute struct {
+	// glob pattern of metric name(support * and ?), empty matches all metrics.
+	Metric string `toml:"metric"`
+	// glob pattern of namespace, empty matches all namespaces.
+	Namespace string `toml:"namespace"`
+	// glob patterns of tag values, metric matches if all tags matched.
+	Tags map[string]string `toml:"tags"`
+	// database which the matched metrics are written into, empty means keeping the database of request.
+	TargetDatabase string `toml:"target-database"`
+	// namespace which the matched metrics are relabeled to, empty means keeping the origin namespace.
+	TargetNamespace string `toml:"target-namespace"`
+}
+
+// TOML returns ingestion route's configuration string as toml format.
+func (r *IngestionRoute) TOML() string {
+	var tags strings.Builder
+	if len(r.Tags) > 0 {
+		keys := make([]string, 0, len(r.Tags))
+		for key := range r.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		tags.WriteString("\n[broker.ingestion.routes.tags]")
+		for _, key := range keys {
+			tags.WriteString(fmt.Sprintf("\n%q = %q", key, r.Tags[key]))
+		}
+	}
+	return fmt.Sprintf(`
+[[broker.ingestion.routes]]
+metric = %q
+namespace = %q
+target-database = %q
+target-namespace = %q%s`,
+		r.Metric,
+		r.Namespace,
+		r.TargetDatabase,
+		r.TargetNamespace,
+		tags.String())
 }
 
 func (i *Ingestion) TOML() string {
@@ -85,11 +131,37 @@ max-concurrency = %d
 ## maximum duration before timeout for server ingesting metrics
 ## Default: %s
 ## Env: LINDB_BROKER_INGESTION_TIMEOUT
-ingest-timeout = "%s"`,
+ingest-timeout = "%s"
+%s`,
 		i.MaxConcurrency,
 		i.MaxConcurrency,
 		i.IngestTimeout.Duration().String(),
-		i.IngestTimeout.Duration().String())
+		i.IngestTimeout.Duration().String(),
+		i.routesTOML())
+}
+
+// routesTOML returns the relabel/routing rules as toml format, an example is commented out if no rule configured.
+func (i *Ingestion) routesTOML() string {
+	if len(i.Routes) == 0 {
+		return `
+## Relabel/routing rules which redirect the matched metrics to target database/namespace,
+## rules are matched by order, the first matched rule is applied.
+## [[broker.ingestion.routes]]
+## metric = "k8s.*"
+## namespace = ""
+## target-database = "k8s"
+## target-namespace = ""
+## [broker.ingestion.routes.tags]
+## cluster = "prod-*"`
+	}
+	var sb strings.Builder
+	sb.WriteString(`
+## Relabel/routing rules which redirect the matched metrics to target database/namespace,
+## rules are matched by order, the first matched rule is applied.`)
+	for idx := range i.Routes {
+		sb.WriteString(i.Routes[idx].TOML())
+	}
+	return sb.String()
 }
 
 // User represents user model
@@ -109,9 +181,12 @@ password = "%s"`,
 
 // Write represents config for write replication in broker.
 type Write struct {
-	BatchTimeout   ltoml.Duration `env:"BATCH_TIMEOUT" toml:"batch-timeout"`
-	BatchBlockSize ltoml.Size     `env:"BLOCK_SIZE" toml:"batch-block-size"`
-	GCTaskInterval ltoml.Duration `env:"GC_INTERVAL" toml:"gc-task-interval"`
+	BatchTimeout     ltoml.Duration `env:"BATCH_TIMEOUT" toml:"batch-timeout"`
+	BatchBlockSize   ltoml.Size     `env:"BLOCK_SIZE" toml:"batch-block-size"`
+	GCTaskInterval   ltoml.Duration `env:"GC_INTERVAL" toml:"gc-task-interval"`
+	SpoolDir         string         `env:"SPOOL_DIR" toml:"spool-dir"`
+	SpoolSize        ltoml.Size     `env:"SPOOL_SIZE" toml:"spool-size"`
+	AuditSampleRatio float64        `env:"AUDIT_SAMPLE_RATIO" toml:"audit-sample-ratio"`
 }
 
 func (rc *Write) TOML() string {
@@ -128,23 +203,45 @@ batch-block-size = "%s"
 ## interval for how often expired write write family garbage collect task execute
 ## Default: %s
 ## Env: LINDB_BROKER_WRITE_GC_INTERVAL
-gc-task-interval = "%s"`,
+gc-task-interval = "%s"
+## Directory for spooling write data of shard when storage is unreachable
+## Default: %s
+## Env: LINDB_BROKER_WRITE_SPOOL_DIR
+spool-dir = "%s"
+## Max disk size of spooled write data for each shard, backpressure write request when spool is full,
+## spooling is disabled if 0.
+## Default: %s
+## Env: LINDB_BROKER_WRITE_SPOOL_SIZE
+spool-size = "%s"
+## Ratio of write message sampled for end-to-end audit, storage verifies the sampled message
+## applied to write ahead log/memory database, reports loss/duplication as metrics, audit is disabled if 0.
+## Default: %g
+## Env: LINDB_BROKER_WRITE_AUDIT_SAMPLE_RATIO
+audit-sample-ratio = %g`,
 		rc.BatchTimeout.String(),
 		rc.BatchTimeout.String(),
 		rc.BatchBlockSize.String(),
 		rc.BatchBlockSize.String(),
 		rc.GCTaskInterval.String(),
 		rc.GCTaskInterval.String(),
+		rc.SpoolDir,
+		rc.SpoolDir,
+		rc.SpoolSize.String(),
+		rc.SpoolSize.String(),
+		rc.AuditSampleRatio,
+		rc.AuditSampleRatio,
 	)
 }
 
 // BrokerBase represents a broker configuration
 type BrokerBase struct {
-	SlowSQL   ltoml.Duration `env:"SLOW_SQL" toml:"slow-sql"`
-	HTTP      HTTP           `envPrefix:"HTTP_" toml:"http"`
-	Ingestion Ingestion      `envPrefix:"INGESTION_" toml:"ingestion"`
-	Write     Write          `envPrefix:"WRITE_" toml:"write"`
-	GRPC      GRPC           `envPrefix:"GRPC_" toml:"grpc"`
+	SlowSQL                    ltoml.Duration `env:"SLOW_SQL" toml:"slow-sql"`
+	CapacityImbalanceThreshold float64        `env:"CAPACITY_IMBALANCE_THRESHOLD" toml:"capacity-imbalance-threshold"`
+	DrainTimeout               ltoml.Duration `env:"DRAIN_TIMEOUT" toml:"drain-timeout"`
+	HTTP                       HTTP           `envPrefix:"HTTP_" toml:"http"`
+	Ingestion                  Ingestion      `envPrefix:"INGESTION_" toml:"ingestion"`
+	Write                      Write          `envPrefix:"WRITE_" toml:"write"`
+	GRPC                       GRPC           `envPrefix:"GRPC_" toml:"grpc"`
 }
 
 // TOML returns broker's base configuration string as toml format.
@@ -158,6 +255,18 @@ func (bb *BrokerBase) TOML() string {
 ## Env: LINDB_BROKER_SLOW_SQL
 slow-sql = "%s"
 
+## Threshold of disk used ratio difference between storage nodes,
+## suggests replica re-placement if exceeds it.
+## Default: %.2f
+## Env: LINDB_BROKER_CAPACITY_IMBALANCE_THRESHOLD
+capacity-imbalance-threshold = %.2f
+
+## Max duration of waiting inflight query/write requests completed when broker shutdown,
+## inflight requests will be canceled after timeout.
+## Default: %s
+## Env: LINDB_BROKER_DRAIN_TIMEOUT
+drain-timeout = "%s"
+
 ## Controls how HTTP Server are configured.
 [broker.http]%s
 
@@ -171,6 +280,10 @@ slow-sql = "%s"
 [broker.grpc]%s`,
 		bb.SlowSQL.String(),
 		bb.SlowSQL.String(),
+		bb.CapacityImbalanceThreshold,
+		bb.CapacityImbalanceThreshold,
+		bb.DrainTimeout.String(),
+		bb.DrainTimeout.String(),
 		bb.HTTP.TOML(),
 		bb.Ingestion.TOML(),
 		bb.Write.TOML(),
@@ -180,7 +293,9 @@ slow-sql = "%s"
 
 func NewDefaultBrokerBase() *BrokerBase {
 	return &BrokerBase{
-		SlowSQL: ltoml.Duration(time.Second * 30),
+		SlowSQL:                    ltoml.Duration(time.Second * 30),
+		CapacityImbalanceThreshold: 0.2,
+		DrainTimeout:               ltoml.Duration(time.Second * 30),
 		HTTP: HTTP{
 			Port:         9000,
 			IdleTimeout:  ltoml.Duration(time.Minute * 2),
@@ -195,6 +310,8 @@ func NewDefaultBrokerBase() *BrokerBase {
 			BatchTimeout:   ltoml.Duration(time.Second * 2),
 			BatchBlockSize: ltoml.Size(256 * 1024),
 			GCTaskInterval: ltoml.Duration(time.Minute),
+			SpoolDir:       filepath.Join(defaultParentDir, "broker", "spool"),
+			SpoolSize:      ltoml.Size(1024 * 1024 * 1024),
 		},
 		GRPC: GRPC{
 			Port:                 9001,
@@ -255,6 +372,12 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 		return err
 	}
 	defaultBrokerCfg := NewDefaultBrokerBase()
+	if brokerBaseCfg.CapacityImbalanceThreshold <= 0 || brokerBaseCfg.CapacityImbalanceThreshold >= 1 {
+		brokerBaseCfg.CapacityImbalanceThreshold = defaultBrokerCfg.CapacityImbalanceThreshold
+	}
+	if brokerBaseCfg.DrainTimeout <= 0 {
+		brokerBaseCfg.DrainTimeout = defaultBrokerCfg.DrainTimeout
+	}
 	// http check
 	if brokerBaseCfg.HTTP.Port <= 0 {
 		return fmt.Errorf("http port cannot be empty")
@@ -276,6 +399,12 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.Ingestion.MaxConcurrency <= 0 {
 		brokerBaseCfg.Ingestion.MaxConcurrency = defaultBrokerCfg.Ingestion.MaxConcurrency
 	}
+	for idx := range brokerBaseCfg.Ingestion.Routes {
+		route := &brokerBaseCfg.Ingestion.Routes[idx]
+		if route.TargetDatabase == "" && route.TargetNamespace == "" {
+			return fmt.Errorf("ingestion route[%d] must have target database or target namespace", idx)
+		}
+	}
 	// write check
 	if brokerBaseCfg.Write.BatchTimeout <= 0 {
 		brokerBaseCfg.Write.BatchTimeout = defaultBrokerCfg.Write.BatchTimeout
@@ -286,6 +415,9 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.Write.GCTaskInterval <= 0 {
 		brokerBaseCfg.Write.GCTaskInterval = defaultBrokerCfg.Write.GCTaskInterval
 	}
+	if brokerBaseCfg.Write.SpoolDir == "" {
+		brokerBaseCfg.Write.SpoolDir = defaultBrokerCfg.Write.SpoolDir
+	}
 
 	return nil
 }
@@ -38,6 +38,24 @@ func TestBroker_TOML(t *testing.T) {
 	assert.NotEmpty(t, (&User{}).TOML())
 }
 
+func TestIngestion_Routes_TOML(t *testing.T) {
+	cfg := NewDefaultBrokerBase()
+	cfg.Ingestion.Routes = []IngestionRoute{
+		{Metric: "k8s.*", TargetDatabase: "k8s"},
+		{
+			Metric:          "system.*",
+			Namespace:       "default-ns",
+			Tags:            map[string]string{"cluster": "prod-*", "region": "sh"},
+			TargetNamespace: "system",
+		},
+	}
+	brokerCfg := &Broker{}
+	_, err := toml.Decode(cfg.TOML(), brokerCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, cfg.Ingestion, brokerCfg.BrokerBase.Ingestion)
+	assert.Equal(t, cfg.TOML(), brokerCfg.BrokerBase.TOML())
+}
+
 func TestDumpExampleCfg(t *testing.T) {
 	assert.NoError(t, ltoml.WriteConfig("root.toml.example", NewDefaultRootTOML()))
 	assert.NoError(t, ltoml.WriteConfig("broker.toml.example", NewDefaultBrokerTOML()))
@@ -48,37 +66,46 @@ func TestDumpExampleCfg(t *testing.T) {
 func TestBroker_Env(t *testing.T) {
 	cfg := Broker{}
 	opts := env.Options{Environment: map[string]string{
-		"LINDB_COORDINATOR_NAMESPACE":              "ns",
-		"LINDB_COORDINATOR_ENDPOINTS":              "endpoint1,endpoint2",
-		"LINDB_COORDINATOR_LEASE_TTL":              "60s",
-		"LINDB_COORDINATOR_TIMEOUT":                "60s",
-		"LINDB_COORDINATOR_DIAL_TIMEOUT":           "60s",
-		"LINDB_COORDINATOR_USERNAME":               "LinDB",
-		"LINDB_COORDINATOR_PASSWORD":               "pwd",
-		"LINDB_QUERY_CONCURRENCY":                  "100",
-		"LINDB_QUERY_IDLE_TIMEOUT":                 "100s",
-		"LINDB_QUERY_TIMEOUT":                      "120s",
-		"LINDB_BROKER_SLOW_SQL":                    "120s",
-		"LINDB_BROKER_HTTP_PORT":                   "3000",
-		"LINDB_BROKER_HTTP_IDLE_TIMEOUT":           "120s",
-		"LINDB_BROKER_HTTP_WRITE_TIMEOUT":          "120s",
-		"LINDB_BROKER_HTTP_READ_TIMEOUT":           "2m",
-		"LINDB_BROKER_INGESTION_CONCURRENCY":       "100",
-		"LINDB_BROKER_INGESTION_TIMEOUT":           "2m",
-		"LINDB_BROKER_WRITE_BATCH_TIMEOUT":         "2m",
-		"LINDB_BROKER_WRITE_BLOCK_SIZE":            "1Mib",
-		"LINDB_BROKER_WRITE_GC_INTERVAL":           "2m",
-		"LINDB_BROKER_GRPC_PORT":                   "2899",
-		"LINDB_BROKER_GRPC_MAX_CONCURRENT_STREAMS": "10000",
-		"LINDB_BROKER_GRPC_CONNECT_TIMEOUT":        "2m",
-		"LINDB_MONITOR_PUSH_TIMEOUT":               "2m",
-		"LINDB_MONITOR_REPORT_INTERVAL":            "2m",
-		"LINDB_MONITOR_URL":                        "monitor_url",
-		"LINDB_LOGGING_DIR":                        "log_dir",
-		"LINDB_LOGGING_LEVEL":                      "fatal",
-		"LINDB_LOGGING_MAX_SIZE":                   "1Mib",
-		"LINDB_LOGGING_MAX_BACKUPS":                "10",
-		"LINDB_LOGGING_MAX_AGE":                    "20",
+		"LINDB_COORDINATOR_NAMESPACE":               "ns",
+		"LINDB_COORDINATOR_ENDPOINTS":               "endpoint1,endpoint2",
+		"LINDB_COORDINATOR_LEASE_TTL":               "60s",
+		"LINDB_COORDINATOR_TIMEOUT":                 "60s",
+		"LINDB_COORDINATOR_DIAL_TIMEOUT":            "60s",
+		"LINDB_COORDINATOR_USERNAME":                "LinDB",
+		"LINDB_COORDINATOR_PASSWORD":                "pwd",
+		"LINDB_QUERY_CONCURRENCY":                   "100",
+		"LINDB_QUERY_IDLE_TIMEOUT":                  "100s",
+		"LINDB_QUERY_TIMEOUT":                       "120s",
+		"LINDB_BROKER_CAPACITY_IMBALANCE_THRESHOLD": "0.3",
+		"LINDB_BROKER_SLOW_SQL":                     "120s",
+		"LINDB_BROKER_DRAIN_TIMEOUT":                "1m",
+		"LINDB_BROKER_HTTP_PORT":                    "3000",
+		"LINDB_BROKER_HTTP_IDLE_TIMEOUT":            "120s",
+		"LINDB_BROKER_HTTP_WRITE_TIMEOUT":           "120s",
+		"LINDB_BROKER_HTTP_READ_TIMEOUT":            "2m",
+		"LINDB_BROKER_INGESTION_CONCURRENCY":        "100",
+		"LINDB_BROKER_INGESTION_TIMEOUT":            "2m",
+		"LINDB_BROKER_WRITE_BATCH_TIMEOUT":          "2m",
+		"LINDB_BROKER_WRITE_BLOCK_SIZE":             "1Mib",
+		"LINDB_BROKER_WRITE_GC_INTERVAL":            "2m",
+		"LINDB_BROKER_WRITE_SPOOL_DIR":              "spool_dir",
+		"LINDB_BROKER_WRITE_SPOOL_SIZE":             "2Mib",
+		"LINDB_BROKER_WRITE_AUDIT_SAMPLE_RATIO":     "0.01",
+		"LINDB_BROKER_GRPC_PORT":                    "2899",
+		"LINDB_BROKER_GRPC_MAX_CONCURRENT_STREAMS":  "10000",
+		"LINDB_BROKER_GRPC_CONNECT_TIMEOUT":         "2m",
+		"LINDB_MONITOR_PUSH_TIMEOUT":                "2m",
+		"LINDB_MONITOR_REPORT_INTERVAL":             "2m",
+		"LINDB_MONITOR_URL":                         "monitor_url",
+		"LINDB_MONITOR_REMOTE_URL":                  "remote_url",
+		"LINDB_MONITOR_REMOTE_PROTOCOL":             "prometheus",
+		"LINDB_MONITOR_REMOTE_BUFFER_SIZE":          "10",
+		"LINDB_MONITOR_REMOTE_RETRY_INTERVAL":       "2m",
+		"LINDB_LOGGING_DIR":                         "log_dir",
+		"LINDB_LOGGING_LEVEL":                       "fatal",
+		"LINDB_LOGGING_MAX_SIZE":                    "1Mib",
+		"LINDB_LOGGING_MAX_BACKUPS":                 "10",
+		"LINDB_LOGGING_MAX_AGE":                     "20",
 	}}
 	err := env.Parse(&cfg, opts)
 	assert.NoError(t, err)
@@ -94,6 +121,8 @@ func TestBroker_Env(t *testing.T) {
 	assert.Equal(t, ltoml.Duration(time.Second*100), cfg.Query.IdleTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Query.Timeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.SlowSQL)
+	assert.Equal(t, 0.3, cfg.BrokerBase.CapacityImbalanceThreshold)
+	assert.Equal(t, ltoml.Duration(time.Minute), cfg.BrokerBase.DrainTimeout)
 	assert.Equal(t, uint16(3000), cfg.BrokerBase.HTTP.Port)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.HTTP.WriteTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.HTTP.ReadTimeout)
@@ -103,12 +132,19 @@ func TestBroker_Env(t *testing.T) {
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.Write.BatchTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.Write.GCTaskInterval)
 	assert.Equal(t, ltoml.Size(1024*1024), cfg.BrokerBase.Write.BatchBlockSize)
+	assert.Equal(t, "spool_dir", cfg.BrokerBase.Write.SpoolDir)
+	assert.Equal(t, ltoml.Size(2*1024*1024), cfg.BrokerBase.Write.SpoolSize)
+	assert.Equal(t, 0.01, cfg.BrokerBase.Write.AuditSampleRatio)
 	assert.Equal(t, uint16(2899), cfg.BrokerBase.GRPC.Port)
 	assert.Equal(t, 10000, cfg.BrokerBase.GRPC.MaxConcurrentStreams)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.GRPC.ConnectTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.PushTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.ReportInterval)
 	assert.Equal(t, "monitor_url", cfg.Monitor.URL)
+	assert.Equal(t, "remote_url", cfg.Monitor.RemoteURL)
+	assert.Equal(t, PrometheusRemoteProtocol, cfg.Monitor.RemoteProtocol)
+	assert.Equal(t, 10, cfg.Monitor.RemoteBufferSize)
+	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.RemoteRetryInterval)
 	assert.Equal(t, "log_dir", cfg.Logging.Dir)
 	assert.Equal(t, "fatal", cfg.Logging.Level)
 	assert.Equal(t, ltoml.Size(1024*1024), cfg.Logging.MaxSize)
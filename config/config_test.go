@@ -59,7 +59,10 @@ func Test_NewConfig(t *testing.T) {
 	assert.Nil(t, ltoml.WriteConfig(storageCfgPath, NewDefaultStorageTOML()))
 	assert.Nil(t, LoadAndSetStorageConfig(storageCfgPath, "storage.toml", &storageCfg))
 	assert.Nil(t, ltoml.DecodeToml(storageCfgPath, &storageCfg))
-	assert.Equal(t, storageCfg.StorageBase, *NewDefaultStorageBase())
+	// default toml config writes fixed flush concurrency
+	defaultStorageBase := *NewDefaultStorageBase()
+	defaultStorageBase.TSDB.FlushConcurrency = defaultTOMLFlushConcurrency
+	assert.Equal(t, storageCfg.StorageBase, defaultStorageBase)
 	assert.Equal(t, storageCfg.Logging, *NewDefaultLogging())
 	assert.Equal(t, storageCfg.Monitor, *NewDefaultMonitor())
 
@@ -77,7 +80,7 @@ func Test_NewConfig(t *testing.T) {
 	assert.Nil(t, LoadAndSetStandAloneConfig(standaloneCfgPath, "standalone.toml", &standaloneCfg))
 	assert.Nil(t, ltoml.DecodeToml(standaloneCfgPath, &standaloneCfg))
 	assert.Equal(t, standaloneCfg.BrokerBase, *NewDefaultBrokerBase())
-	assert.Equal(t, standaloneCfg.StorageBase, *NewDefaultStorageBase())
+	assert.Equal(t, standaloneCfg.StorageBase, defaultStorageBase)
 	assert.Equal(t, standaloneCfg.Logging, *NewDefaultLogging())
 	assert.Equal(t, standaloneCfg.Monitor, *NewDefaultMonitor())
 }
@@ -111,6 +114,18 @@ func Test_checkBrokerBaseCfg(t *testing.T) {
 	assert.NotZero(t, brokerCfg3.HTTP.IdleTimeout)
 	assert.NotZero(t, brokerCfg3.HTTP.WriteTimeout)
 	assert.NotZero(t, brokerCfg3.Ingestion.IngestTimeout)
+	assert.Equal(t, 0.2, brokerCfg3.CapacityImbalanceThreshold)
+	assert.Equal(t, NewDefaultBrokerBase().DrainTimeout, brokerCfg3.DrainTimeout)
+
+	// ingestion route without target failure
+	brokerCfg4 := &BrokerBase{
+		GRPC:      GRPC{Port: 2379},
+		HTTP:      HTTP{Port: 9000},
+		Ingestion: Ingestion{Routes: []IngestionRoute{{Metric: "k8s.*"}}},
+	}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg4))
+	brokerCfg4.Ingestion.Routes[0].TargetDatabase = "k8s"
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg4))
 }
 
 func Test_checkStorageBaseCfg(t *testing.T) {
@@ -144,6 +159,28 @@ func Test_checkStorageBaseCfg(t *testing.T) {
 	assert.NotZero(t, storageCfg4.TSDB.MaxMemUsageBeforeFlush)
 	assert.NotZero(t, storageCfg4.TSDB.TargetMemUsageAfterFlush)
 	assert.NotZero(t, storageCfg4.TSDB.FlushConcurrency)
+	assert.Equal(t, "per-rollover", storageCfg4.TSDB.TableSyncPolicy)
+	assert.NotZero(t, storageCfg4.TSDB.TableSyncInterval)
+	assert.Zero(t, storageCfg4.TSDB.CompactionRateLimit)
+	assert.Zero(t, storageCfg4.TSDB.CompactionQueryLatencySLO)
+	assert.Equal(t, "per-rollover", storageCfg4.WAL.SyncPolicy)
+	assert.NotZero(t, storageCfg4.WAL.SyncInterval)
+
+	// wal sync policy invalid
+	storageCfg5 := &StorageBase{
+		GRPC: GRPC{Port: 2379},
+		TSDB: TSDB{Dir: "/tmp/lindb"},
+		WAL:  WAL{SyncPolicy: "always"},
+	}
+	assert.Error(t, checkStorageBaseCfg(storageCfg5))
+	// table sync policy invalid
+	storageCfg6 := &StorageBase{
+		GRPC: GRPC{Port: 2379},
+		TSDB: TSDB{Dir: "/tmp/lindb", TableSyncPolicy: "always"},
+		WAL:  WAL{SyncPolicy: "per-write"},
+	}
+	assert.Error(t, checkStorageBaseCfg(storageCfg6))
+	assert.Equal(t, "per-write", storageCfg6.WAL.SyncPolicy)
 }
 
 func Test_checkCoordinatorCfg(t *testing.T) {
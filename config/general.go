@@ -172,6 +172,14 @@ type Query struct {
 	QueryConcurrency int            `env:"CONCURRENCY" toml:"query-concurrency"`
 	IdleTimeout      ltoml.Duration `env:"IDLE_TIMEOUT" toml:"idle-timeout"`
 	Timeout          ltoml.Duration `env:"TIMEOUT" toml:"timeout"`
+	HedgeDelay       ltoml.Duration `env:"HEDGE_DELAY" toml:"hedge-delay"`
+	SplitThreshold   ltoml.Duration `env:"SPLIT_THRESHOLD" toml:"split-threshold"`
+	FollowerRead     bool           `env:"FOLLOWER_READ" toml:"follower-read"`
+
+	GroupBySpillEnabled    bool   `env:"GROUP_BY_SPILL_ENABLED" toml:"group-by-spill-enabled"`
+	GroupBySpillThreshold  int    `env:"GROUP_BY_SPILL_THRESHOLD" toml:"group-by-spill-threshold"`
+	GroupBySpillPartitions int    `env:"GROUP_BY_SPILL_PARTITIONS" toml:"group-by-spill-partitions"`
+	GroupBySpillDir        string `env:"GROUP_BY_SPILL_DIR" toml:"group-by-spill-dir"`
 }
 
 func (q *Query) TOML() string {
@@ -187,13 +195,61 @@ idle-timeout = "%s"
 ## Maximum timeout threshold for query.
 ## Default: %s
 ## Env: LINDB_QUERY_TIMEOUT
-timeout = "%s"`,
+timeout = "%s"
+## Issue hedged leaf request to other replica if leaf node has no response in this duration,
+## first response wins, 0 means disable hedged request.
+## Hedged request is sent to follower replica only if follower-read enabled,
+## follower replica may lag behind leader, so the result may miss the latest data.
+## Default: %s
+## Env: LINDB_QUERY_HEDGE_DELAY
+hedge-delay = "%s"
+## Split query into parallel sub-queries by segment(day/month) if time range of query is longer than this duration,
+## results of sub-queries are merged by broker, 0 means disable query splitting.
+## Default: %s
+## Env: LINDB_QUERY_SPLIT_THRESHOLD
+split-threshold = "%s"
+## Query from follower replica if circuit breaker of leader replica is open,
+## follower replica may lag behind leader, so the result may miss the latest data.
+## Default: %v
+## Env: LINDB_QUERY_FOLLOWER_READ
+follower-read = %v
+## Spill the groups into temporary files when the number of groups exceeds the threshold for group by query,
+## which bounds the memory of broker aggregation, then merges the spilled groups partition by partition.
+## Default: %v
+## Env: LINDB_QUERY_GROUP_BY_SPILL_ENABLED
+group-by-spill-enabled = %v
+## Max number of groups kept in memory when merging the result of group by query.
+## Default: %d
+## Env: LINDB_QUERY_GROUP_BY_SPILL_THRESHOLD
+group-by-spill-threshold = %d
+## Number of spill partitions(temporary files), groups are partitioned by tags.
+## Default: %d
+## Env: LINDB_QUERY_GROUP_BY_SPILL_PARTITIONS
+group-by-spill-partitions = %d
+## Directory of spill files, uses the default directory for temporary files if empty.
+## Default: %s
+## Env: LINDB_QUERY_GROUP_BY_SPILL_DIR
+group-by-spill-dir = "%s"`,
 		q.QueryConcurrency,
 		q.QueryConcurrency,
 		q.IdleTimeout,
 		q.IdleTimeout,
 		q.Timeout,
 		q.Timeout,
+		q.HedgeDelay,
+		q.HedgeDelay,
+		q.SplitThreshold,
+		q.SplitThreshold,
+		q.FollowerRead,
+		q.FollowerRead,
+		q.GroupBySpillEnabled,
+		q.GroupBySpillEnabled,
+		q.GroupBySpillThreshold,
+		q.GroupBySpillThreshold,
+		q.GroupBySpillPartitions,
+		q.GroupBySpillPartitions,
+		q.GroupBySpillDir,
+		q.GroupBySpillDir,
 	)
 }
 
@@ -202,6 +258,9 @@ func NewDefaultQuery() *Query {
 		QueryConcurrency: 1024,
 		IdleTimeout:      ltoml.Duration(5 * time.Second),
 		Timeout:          ltoml.Duration(5 * time.Second),
+
+		GroupBySpillThreshold:  100000,
+		GroupBySpillPartitions: 16,
 	}
 }
 
@@ -248,4 +307,10 @@ func checkQueryCfg(queryCfg *Query) {
 	if queryCfg.IdleTimeout <= 0 {
 		queryCfg.IdleTimeout = defaultQuery.IdleTimeout
 	}
+	if queryCfg.GroupBySpillThreshold <= 0 {
+		queryCfg.GroupBySpillThreshold = defaultQuery.GroupBySpillThreshold
+	}
+	if queryCfg.GroupBySpillPartitions <= 0 {
+		queryCfg.GroupBySpillPartitions = defaultQuery.GroupBySpillPartitions
+	}
 }
@@ -30,11 +30,22 @@ var (
 	defaultPusherURL = fmt.Sprintf("http://127.0.0.1:9000%s/write?db=_internal", constants.APIVersion1CliPath)
 )
 
+const (
+	// NativeRemoteProtocol represents LinDB native ingestion protocol of remote exporter.
+	NativeRemoteProtocol = "native"
+	// PrometheusRemoteProtocol represents Prometheus remote write protocol of remote exporter.
+	PrometheusRemoteProtocol = "prometheus"
+)
+
 // Monitor represents a configuration for the internal monitor
 type Monitor struct {
-	PushTimeout    ltoml.Duration `env:"PUSH_TIMEOUT" toml:"push-timeout"`
-	ReportInterval ltoml.Duration `env:"REPORT_INTERVAL" toml:"report-interval"`
-	URL            string         `env:"URL" toml:"url"`
+	PushTimeout         ltoml.Duration `env:"PUSH_TIMEOUT" toml:"push-timeout"`
+	ReportInterval      ltoml.Duration `env:"REPORT_INTERVAL" toml:"report-interval"`
+	URL                 string         `env:"URL" toml:"url"`
+	RemoteURL           string         `env:"REMOTE_URL" toml:"remote-url"`
+	RemoteProtocol      string         `env:"REMOTE_PROTOCOL" toml:"remote-protocol"`
+	RemoteBufferSize    int            `env:"REMOTE_BUFFER_SIZE" toml:"remote-buffer-size"`
+	RemoteRetryInterval ltoml.Duration `env:"REMOTE_RETRY_INTERVAL" toml:"remote-retry-interval"`
 }
 
 // TOML returns Monitor's toml config
@@ -54,21 +65,51 @@ report-interval = "%s"
 ## URL is the target of broker native ingestion url
 ## Default: %s
 ## Env: LINDB_MONITOR_URL
-url = "%s"`,
+url = "%s"
+## RemoteURL is the target of remote exporter which ships self-metrics to another cluster,
+## such as remote LinDB native ingestion url or Prometheus remote write url,
+## remote exporter won't start when url is empty
+## Default: %s
+## Env: LINDB_MONITOR_REMOTE_URL
+remote-url = "%s"
+## protocol of remote exporter, native(LinDB native ingestion) or prometheus(Prometheus remote write)
+## Default: %s
+## Env: LINDB_MONITOR_REMOTE_PROTOCOL
+remote-protocol = "%s"
+## max number of pending metric batches buffered when remote target unavailable,
+## the oldest batch will be dropped when buffer is full
+## Default: %d
+## Env: LINDB_MONITOR_REMOTE_BUFFER_SIZE
+remote-buffer-size = %d
+## time period to retry pushing the buffered metric batches after failure
+## Default: %s
+## Env: LINDB_MONITOR_REMOTE_RETRY_INTERVAL
+remote-retry-interval = "%s"`,
 		m.PushTimeout.String(),
 		m.PushTimeout.String(),
 		m.ReportInterval.String(),
 		m.ReportInterval.String(),
 		m.URL,
 		m.URL,
+		m.RemoteURL,
+		m.RemoteURL,
+		m.RemoteProtocol,
+		m.RemoteProtocol,
+		m.RemoteBufferSize,
+		m.RemoteBufferSize,
+		m.RemoteRetryInterval.String(),
+		m.RemoteRetryInterval.String(),
 	)
 }
 
 // NewDefaultMonitor returns a new default monitor config
 func NewDefaultMonitor() *Monitor {
 	return &Monitor{
-		PushTimeout:    ltoml.Duration(3 * time.Second),
-		ReportInterval: ltoml.Duration(10 * time.Second),
-		URL:            defaultPusherURL,
+		PushTimeout:         ltoml.Duration(3 * time.Second),
+		ReportInterval:      ltoml.Duration(10 * time.Second),
+		URL:                 defaultPusherURL,
+		RemoteProtocol:      NativeRemoteProtocol,
+		RemoteBufferSize:    60,
+		RemoteRetryInterval: ltoml.Duration(5 * time.Second),
 	}
 }
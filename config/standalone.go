@@ -89,7 +89,7 @@ func NewDefaultStandaloneTOML() string {
 		NewDefaultCoordinator().TOML(),
 		NewDefaultQuery().TOML(),
 		NewDefaultBrokerBase().TOML(),
-		NewDefaultStorageBase().TOML(),
+		newDefaultStorageBaseTOML(),
 		NewDefaultLogging().TOML(),
 		NewDefaultMonitor().TOML(),
 	)
@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/ltoml"
 )
 
@@ -36,8 +37,28 @@ type TSDB struct {
 	MaxMemUsageBeforeFlush   float64        `env:"MAX_MEM_USAGE_BEFORE_FLUSH" toml:"max-mem-usage-before-flush"`
 	TargetMemUsageAfterFlush float64        `env:"TARGET_MEM_USAGE_AFTER_FLUSH" toml:"target-mem-usage-after-flush"`
 	FlushConcurrency         int            `env:"FLUSH_CONCURRENCY" toml:"flush-concurrency"`
+	FamilyFlushConcurrency   int            `env:"FAMILY_FLUSH_CONCURRENCY" toml:"family-flush-concurrency"`
+	MaxStagedRows            int            `env:"MAX_STAGED_ROWS" toml:"max-staged-rows"`
 	SeriesSequenceCache      uint32         `env:"SERIES_SEQ_CACHE" toml:"series-sequence-cache"`
 	MetaSequenceCache        uint32         `env:"META_SEQ_CACHE" toml:"meta-sequence-cache"`
+	NamespaceSequenceCache   uint32         `env:"NS_SEQ_CACHE" toml:"namespace-sequence-cache"`
+	MetricSequenceCache      uint32         `env:"METRIC_SEQ_CACHE" toml:"metric-sequence-cache"`
+	TagKeySequenceCache      uint32         `env:"TAG_KEY_SEQ_CACHE" toml:"tag-key-sequence-cache"`
+	BlockCacheSize           ltoml.Size     `env:"BLOCK_CACHE_SIZE" toml:"block-cache-size"`
+	TagValueCacheSize        int            `env:"TAG_VALUE_CACHE_SIZE" toml:"tag-value-cache-size"`
+	ResultCacheSize          ltoml.Size     `env:"RESULT_CACHE_SIZE" toml:"result-cache-size"`
+	TableFormatVersion       uint8          `env:"TABLE_FORMAT_VERSION" toml:"table-format-version"`
+	DataFormatVersion        uint8          `env:"DATA_FORMAT_VERSION" toml:"data-format-version"`
+	TableSyncPolicy          string         `env:"TABLE_SYNC_POLICY" toml:"table-sync-policy"`
+	TableSyncInterval        ltoml.Duration `env:"TABLE_SYNC_INTERVAL" toml:"table-sync-interval"`
+	// global compaction rate limit(bytes/sec) shared by all kv stores, 0 means unlimited
+	CompactionRateLimit ltoml.Size `env:"COMPACTION_RATE_LIMIT" toml:"compaction-rate-limit"`
+	// query latency SLO, compaction rate limit is lowered when it is breached, 0 disables dynamic adjustment
+	CompactionQueryLatencySLO ltoml.Duration `env:"COMPACTION_QUERY_LATENCY_SLO" toml:"compaction-query-latency-slo"`
+	// verify crc32 of each value of sst file when opening store, reads whole file
+	VerifyValuesOnOpen bool `env:"VERIFY_VALUES_ON_OPEN" toml:"verify-values-on-open"`
+	// quarantine the corrupt sst files found when opening store, otherwise only report them
+	QuarantineCorruptFiles bool `env:"QUARANTINE_CORRUPT_FILES" toml:"quarantine-corrupt-files"`
 }
 
 func (t *TSDB) TOML() string {
@@ -70,10 +91,87 @@ max-mem-usage-before-flush = %.2f
 ## Default: %.2f
 ## Env: LINDB_STORAGE_TSDB_TARGET_MEM_USAGE_AFTER_FLUSH
 target-mem-usage-after-flush = %.2f
-## concurrency of goroutines for flushing.
+## concurrency of goroutines for flushing,
+## also bounds the key range partitions merged concurrently by one big compaction job.
 ## Default: %d
 ## Env: LINDB_STORAGE_TSDB_FLUSH_CONCURRENCY 
-flush-concurrency = %d`,
+flush-concurrency = %d
+## concurrency of flushing families(different family time) within a shard,
+## bounded by flush-concurrency, families are flushed after shard index flushed.
+## Default: %d
+## Env: LINDB_STORAGE_TSDB_FAMILY_FLUSH_CONCURRENCY
+family-flush-concurrency = %d
+## Max number of rows staged in each shard, whose metadata(metric/series/field ids) need generating,
+## staged rows are written asynchronously after metadata generated,
+## so that writing of existing series is not blocked by new series.
+## Rows are written synchronously if staging buffer is full, 0 disables staging.
+## Default: %d
+## Env: LINDB_STORAGE_TSDB_MAX_STAGED_ROWS
+max-staged-rows = %d
+## Size of off-heap block cache shared by all kv stores, caches hot value blocks of sst files,
+## hot blocks are kept even if page cache of mmaped sst file is evicted.
+## 0 disables block cache.
+## Default: %s
+## Env: LINDB_STORAGE_TSDB_BLOCK_CACHE_SIZE
+block-cache-size = "%s"
+## Max number of tag values cached for each database,
+## caches the tag values of grouping query collected from kv store.
+## 0 disables tag value cache.
+## Default: %d
+## Env: LINDB_STORAGE_TSDB_TAG_VALUE_CACHE_SIZE
+tag-value-cache-size = %d
+## Size of partial aggregate result cache shared by all leaf queries,
+## caches the down sampling result of flushed families for same query(dashboard refresh),
+## invalidated when sst file removed(compaction/rollup).
+## 0 disables result cache.
+## Default: %s
+## Env: LINDB_STORAGE_TSDB_RESULT_CACHE_SIZE
+result-cache-size = "%s"
+## Layout version of new written sst file(kv table), old versions are always readable.
+## Upgrade it only after all storage nodes support the new version,
+## because the sst file maybe fetched by other replica.
+## Default: %d
+## Env: LINDB_STORAGE_TSDB_TABLE_FORMAT_VERSION
+table-format-version = %d
+## Layout version of new written metric data block, old versions are always readable.
+## Upgrade it only after all storage nodes support the new version.
+## Default: %d
+## Env: LINDB_STORAGE_TSDB_DATA_FORMAT_VERSION
+data-format-version = %d
+## Policy of syncing sst file(kv table) when building it, sst file is always synced before committing into version.
+## per-write: sync after each key/value pair written, slowest flush/compaction.
+## per-interval: sync at most once per table-sync-interval, limits dirty pages of building file.
+## per-rollover: sync only when file finished, fastest flush/compaction.
+## Default: %s
+## Env: LINDB_STORAGE_TSDB_TABLE_SYNC_POLICY
+table-sync-policy = "%s"
+## Interval of syncing sst file for per-interval policy.
+## Default: %s
+## Env: LINDB_STORAGE_TSDB_TABLE_SYNC_INTERVAL
+table-sync-interval = "%s"
+## Global rate limit(bytes/sec) of compaction reading shared by all kv stores,
+## limits the disk bandwidth used by compaction, so that compaction doesn't compete with query.
+## 0 means unlimited.
+## Default: %s
+## Env: LINDB_STORAGE_TSDB_COMPACTION_RATE_LIMIT
+compaction-rate-limit = "%s"
+## Latency SLO of leaf query, compaction rate limits(global and per-store) are lowered
+## when the SLO is breached frequently, and restored gradually after query latency recovered.
+## 0 disables dynamic adjustment.
+## Default: %s
+## Env: LINDB_STORAGE_TSDB_COMPACTION_QUERY_LATENCY_SLO
+compaction-query-latency-slo = "%s"
+## Footer/magic-number/index block of sst files are verified when opening store,
+## enable it to also verify crc32 of each value, which reads whole files and slows down startup.
+## Default: %v
+## Env: LINDB_STORAGE_TSDB_VERIFY_VALUES_ON_OPEN
+verify-values-on-open = %v
+## Corrupt sst files found when opening store are only reported by default,
+## enable it then restart to move them into quarantine dir and remove them from store,
+## the data of these files is lost if it cannot be repaired from other replica.
+## Default: %v
+## Env: LINDB_STORAGE_TSDB_QUARANTINE_CORRUPT_FILES
+quarantine-corrupt-files = %v`,
 		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
 		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
 		t.MaxMemDBSize.String(),
@@ -86,6 +184,32 @@ flush-concurrency = %d`,
 		t.TargetMemUsageAfterFlush,
 		t.FlushConcurrency,
 		t.FlushConcurrency,
+		t.FamilyFlushConcurrency,
+		t.FamilyFlushConcurrency,
+		t.MaxStagedRows,
+		t.MaxStagedRows,
+		t.BlockCacheSize.String(),
+		t.BlockCacheSize.String(),
+		t.TagValueCacheSize,
+		t.TagValueCacheSize,
+		t.ResultCacheSize.String(),
+		t.ResultCacheSize.String(),
+		t.TableFormatVersion,
+		t.TableFormatVersion,
+		t.DataFormatVersion,
+		t.DataFormatVersion,
+		t.TableSyncPolicy,
+		t.TableSyncPolicy,
+		t.TableSyncInterval.String(),
+		t.TableSyncInterval.String(),
+		t.CompactionRateLimit.String(),
+		t.CompactionRateLimit.String(),
+		t.CompactionQueryLatencySLO.String(),
+		t.CompactionQueryLatencySLO.String(),
+		t.VerifyValuesOnOpen,
+		t.VerifyValuesOnOpen,
+		t.QuarantineCorruptFiles,
+		t.QuarantineCorruptFiles,
 	)
 }
 
@@ -94,10 +218,14 @@ type StorageBase struct {
 	// Broker http endpoint, auto register current storage cluster.
 	BrokerEndpoint  string         `env:"BROKER_ENDPOINT" toml:"broker-endpoint"`
 	TTLTaskInterval ltoml.Duration `env:"TTL_TASK_INTERVAL" toml:"ttl-task-interval"`
-	HTTP            HTTP           `envPrefix:"HTTP_" toml:"http"`
-	GRPC            GRPC           `envPrefix:"GRPC_" toml:"grpc"`
-	TSDB            TSDB           `envPrefix:"TSDB_" toml:"tsdb"`
-	WAL             WAL            `envPrefix:"WAL_" toml:"wal"`
+	// interval for how often do disk usage check job(calculate disk usage and enforce disk quota)
+	DiskUsageCheckInterval ltoml.Duration `env:"DISK_USAGE_CHECK_INTERVAL" toml:"disk-usage-check-interval"`
+	// interval for how often do field usage aggregate job(aggregate query-time field access counts into daily buckets)
+	FieldUsageAggregateInterval ltoml.Duration `env:"FIELD_USAGE_AGGREGATE_INTERVAL" toml:"field-usage-aggregate-interval"`
+	HTTP                        HTTP           `envPrefix:"HTTP_" toml:"http"`
+	GRPC                        GRPC           `envPrefix:"GRPC_" toml:"grpc"`
+	TSDB                        TSDB           `envPrefix:"TSDB_" toml:"tsdb"`
+	WAL                         WAL            `envPrefix:"WAL_" toml:"wal"`
 }
 
 // TOML returns StorageBase's toml config string
@@ -109,6 +237,14 @@ func (s *StorageBase) TOML() string {
 ## Default: %s
 ## Env: LINDB_STORAGE_TTL_TASK_INTERVAL 
 ttl-task-interval = "%s"
+## interval for how often do disk usage check job(calculate disk usage and enforce disk quota of database)
+## Default: %s
+## Env: LINDB_STORAGE_DISK_USAGE_CHECK_INTERVAL
+disk-usage-check-interval = "%s"
+## interval for how often do field usage aggregate job(aggregate query-time field access counts into daily buckets)
+## Default: %s
+## Env: LINDB_STORAGE_FIELD_USAGE_AGGREGATE_INTERVAL
+field-usage-aggregate-interval = "%s"
 ## Broker http endpoint which storage self register address
 ## Default: %s
 ## Env: LINDB_STORAGE_BROKER_ENDPOINT
@@ -127,6 +263,10 @@ broker-endpoint = "%s"
 [storage.tsdb]%s`,
 		s.TTLTaskInterval,
 		s.TTLTaskInterval,
+		s.DiskUsageCheckInterval,
+		s.DiskUsageCheckInterval,
+		s.FieldUsageAggregateInterval,
+		s.FieldUsageAggregateInterval,
 		s.BrokerEndpoint,
 		s.BrokerEndpoint,
 		s.HTTP.TOML(),
@@ -141,6 +281,10 @@ type WAL struct {
 	Dir                string         `env:"DIR" toml:"dir"`
 	DataSizeLimit      ltoml.Size     `env:"DATA_SIZE_LIMIT" toml:"data-size-limit"`
 	RemoveTaskInterval ltoml.Duration `env:"REMOVE_TASK_INTERVAL" toml:"remove-task-interval"`
+	PreAllocate        bool           `env:"PRE_ALLOCATE" toml:"pre-allocate"`
+	WriteConcurrency   int            `env:"WRITE_CONCURRENCY" toml:"write-concurrency"`
+	SyncPolicy         string         `env:"SYNC_POLICY" toml:"sync-policy"`
+	SyncInterval       ltoml.Duration `env:"SYNC_INTERVAL" toml:"sync-interval"`
 }
 
 func (rc *WAL) GetDataSizeLimit() int64 {
@@ -167,13 +311,43 @@ data-size-limit = "%s"
 ## interval for how often remove expired write ahead log
 ## Default: %s
 ## Env: LINDB_STORAGE_WAL_REMOVE_TASK_INTERVAL
-remove-task-interval = "%s"`,
+remove-task-interval = "%s"
+## whether pre-allocate(fallocate and warm mmap) next page file before current one fills,
+## avoids write stalls at page rollover, but takes one more page file of disk space for each log.
+## Default: %v
+## Env: LINDB_STORAGE_WAL_PRE_ALLOCATE
+pre-allocate = %v
+## Number of write ahead log writes allowed to execute concurrently, which are dispatched to databases
+## by weighted fair scheduling based on write quota of database, 0 means writes execute without isolation.
+## Default: %d
+## Env: LINDB_STORAGE_WAL_WRITE_CONCURRENCY
+write-concurrency = %d
+## Policy of syncing page files of write ahead log after writing, trade-off between durability and write throughput.
+## per-write: sync after each write, no acknowledged write lost when machine crashes, lowest write throughput.
+## per-interval: sync at most once per sync-interval, loses writes in the last interval when machine crashes.
+## per-rollover: sync only when page file is full, relies on os flushing dirty pages, highest write throughput.
+## Process crash never loses writes with any policy, because page files are memory mapped.
+## Default: %s
+## Env: LINDB_STORAGE_WAL_SYNC_POLICY
+sync-policy = "%s"
+## Interval of syncing page files for per-interval policy.
+## Default: %s
+## Env: LINDB_STORAGE_WAL_SYNC_INTERVAL
+sync-interval = "%s"`,
 		strings.ReplaceAll(rc.Dir, "\\", "\\\\"),
 		strings.ReplaceAll(rc.Dir, "\\", "\\\\"),
 		rc.DataSizeLimit.String(),
 		rc.DataSizeLimit.String(),
 		rc.RemoveTaskInterval.String(),
 		rc.RemoveTaskInterval.String(),
+		rc.PreAllocate,
+		rc.PreAllocate,
+		rc.WriteConcurrency,
+		rc.WriteConcurrency,
+		rc.SyncPolicy,
+		rc.SyncPolicy,
+		rc.SyncInterval.String(),
+		rc.SyncInterval.String(),
 	)
 }
 
@@ -207,8 +381,10 @@ func (s *Storage) TOML() string {
 // NewDefaultStorageBase returns a new default StorageBase struct
 func NewDefaultStorageBase() *StorageBase {
 	return &StorageBase{
-		TTLTaskInterval: ltoml.Duration(time.Hour * 24),
-		BrokerEndpoint:  "http://localhost:9000",
+		TTLTaskInterval:             ltoml.Duration(time.Hour * 24),
+		DiskUsageCheckInterval:      ltoml.Duration(time.Minute),
+		FieldUsageAggregateInterval: ltoml.Duration(time.Minute * 5),
+		BrokerEndpoint:              "http://localhost:9000",
 		HTTP: HTTP{
 			Port:         2892,
 			IdleTimeout:  ltoml.Duration(time.Minute * 2),
@@ -224,6 +400,8 @@ func NewDefaultStorageBase() *StorageBase {
 			Dir:                filepath.Join(defaultParentDir, "storage", "wal"),
 			DataSizeLimit:      ltoml.Size(128 * 1024 * 1024),
 			RemoveTaskInterval: ltoml.Duration(time.Minute),
+			SyncPolicy:         string(fileutil.SyncPerRollover),
+			SyncInterval:       ltoml.Duration(time.Second),
 		},
 		TSDB: TSDB{
 			Dir:                      filepath.Join(defaultParentDir, "storage", "data"),
@@ -232,12 +410,30 @@ func NewDefaultStorageBase() *StorageBase {
 			MaxMemUsageBeforeFlush:   0.75,
 			TargetMemUsageAfterFlush: 0.6,
 			FlushConcurrency:         int(math.Ceil(float64(runtime.GOMAXPROCS(-1)) / 2)),
+			FamilyFlushConcurrency:   2,
+			MaxStagedRows:            100000,
 			SeriesSequenceCache:      1000,
 			MetaSequenceCache:        100,
+			TagValueCacheSize:        100000,
+			ResultCacheSize:          ltoml.Size(64 * 1024 * 1024),
+			TableSyncPolicy:          string(fileutil.SyncPerRollover),
+			TableSyncInterval:        ltoml.Duration(time.Second),
 		},
 	}
 }
 
+// defaultTOMLFlushConcurrency is the flush concurrency written into default toml config,
+// default value of runtime depends on the cpu cores of machine.
+const defaultTOMLFlushConcurrency = 5
+
+// newDefaultStorageBaseTOML creates storage base's default toml config with fixed flush concurrency,
+// so that the generated config(e.g. example files) does not depend on the machine.
+func newDefaultStorageBaseTOML() string {
+	storageBase := NewDefaultStorageBase()
+	storageBase.TSDB.FlushConcurrency = defaultTOMLFlushConcurrency
+	return storageBase.TOML()
+}
+
 // NewDefaultStorageTOML creates storage's default toml config
 func NewDefaultStorageTOML() string {
 	return fmt.Sprintf(`## Coordinator related configuration.
@@ -250,7 +446,7 @@ func NewDefaultStorageTOML() string {
 %s`,
 		NewDefaultCoordinator().TOML(),
 		NewDefaultQuery().TOML(),
-		NewDefaultStorageBase().TOML(),
+		newDefaultStorageBaseTOML(),
 		NewDefaultMonitor().TOML(),
 		NewDefaultLogging().TOML(),
 	)
@@ -276,12 +472,40 @@ func checkTSDBCfg(tsdbCfg *TSDB) error {
 	if tsdbCfg.FlushConcurrency <= 0 {
 		tsdbCfg.FlushConcurrency = defaultStorageCfg.TSDB.FlushConcurrency
 	}
+	if tsdbCfg.FamilyFlushConcurrency <= 0 {
+		tsdbCfg.FamilyFlushConcurrency = defaultStorageCfg.TSDB.FamilyFlushConcurrency
+	}
 	if tsdbCfg.SeriesSequenceCache <= 0 {
 		tsdbCfg.SeriesSequenceCache = defaultStorageCfg.TSDB.SeriesSequenceCache
 	}
 	if tsdbCfg.MetaSequenceCache <= 0 {
 		tsdbCfg.MetaSequenceCache = defaultStorageCfg.TSDB.MetaSequenceCache
 	}
+	policy, err := fileutil.ParseSyncPolicy(tsdbCfg.TableSyncPolicy)
+	if err != nil {
+		return fmt.Errorf("tsdb table sync policy invalid: %w", err)
+	}
+	tsdbCfg.TableSyncPolicy = string(policy)
+	if tsdbCfg.TableSyncInterval <= 0 {
+		tsdbCfg.TableSyncInterval = defaultStorageCfg.TSDB.TableSyncInterval
+	}
+	if tsdbCfg.CompactionQueryLatencySLO < 0 {
+		tsdbCfg.CompactionQueryLatencySLO = 0
+	}
+	return nil
+}
+
+// checkWALCfg checks write ahead log config.
+func checkWALCfg(walCfg *WAL) error {
+	defaultStorageCfg := NewDefaultStorageBase()
+	policy, err := fileutil.ParseSyncPolicy(walCfg.SyncPolicy)
+	if err != nil {
+		return fmt.Errorf("wal sync policy invalid: %w", err)
+	}
+	walCfg.SyncPolicy = string(policy)
+	if walCfg.SyncInterval <= 0 {
+		walCfg.SyncInterval = defaultStorageCfg.WAL.SyncInterval
+	}
 	return nil
 }
 
@@ -294,5 +518,14 @@ func checkStorageBaseCfg(storageBaseCfg *StorageBase) error {
 	if storageBaseCfg.TTLTaskInterval <= 0 {
 		storageBaseCfg.TTLTaskInterval = defaultStorageCfg.TTLTaskInterval
 	}
+	if storageBaseCfg.DiskUsageCheckInterval <= 0 {
+		storageBaseCfg.DiskUsageCheckInterval = defaultStorageCfg.DiskUsageCheckInterval
+	}
+	if storageBaseCfg.FieldUsageAggregateInterval <= 0 {
+		storageBaseCfg.FieldUsageAggregateInterval = defaultStorageCfg.FieldUsageAggregateInterval
+	}
+	if err := checkWALCfg(&storageBaseCfg.WAL); err != nil {
+		return err
+	}
 	return checkTSDBCfg(&storageBaseCfg.TSDB)
 }
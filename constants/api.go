@@ -30,4 +30,12 @@ const (
 	ContentTypeProto = "application/protobuf"
 	// ContentTypeInflux represents influx content type.
 	ContentTypeInflux = "application/influx"
+	// HeaderQueryPriority represents the header of query priority class(interactive/background/system).
+	HeaderQueryPriority = "X-LinDB-Query-Priority"
+	// HeaderRequestID represents the header of request id, which is generated if client not set.
+	HeaderRequestID = "X-LinDB-Request-ID"
+	// HeaderErrorCode represents the header of stable error code(failure or partial result).
+	HeaderErrorCode = "X-LinDB-Error-Code"
+	// HeaderDatabase represents the header of database name for the request without database param(grafana datasource).
+	HeaderDatabase = "X-LinDB-Database"
 )
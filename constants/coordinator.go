@@ -41,12 +41,15 @@ const (
 	ShardAssignment = "ShardAssignment"
 	Master          = "Master"
 	StorageConfig   = "StorageConfig"
+	CircuitBreaker  = "CircuitBreaker"
 )
 
 // defines common constants will be used in broker and storage.
 const (
 	// LiveNodesPath represents live nodes prefix path for node register.
 	LiveNodesPath = "/live/nodes"
+	// NodeCapacityPath represents disk capacity prefix path for storage node report.
+	NodeCapacityPath = "/capacity/nodes"
 )
 
 // defines broker level constants will be used in broker.
@@ -102,3 +105,8 @@ func GetDatabaseAssignPath(name string) string {
 func GetLiveNodePath(node string) string {
 	return fmt.Sprintf("%s/%s", LiveNodesPath, node)
 }
+
+// GetNodeCapacityPath returns disk capacity report path of storage node.
+func GetNodeCapacityPath(node string) string {
+	return fmt.Sprintf("%s/%s", NodeCapacityPath, node)
+}
@@ -37,6 +37,7 @@ func TestGetDatabaseLimitPath(t *testing.T) {
 
 func TestGetNodePath(t *testing.T) {
 	assert.Equal(t, LiveNodesPath+"/name", GetLiveNodePath("name"))
+	assert.Equal(t, NodeCapacityPath+"/1", GetNodeCapacityPath("1"))
 }
 
 func TestGetStorageClusterConfigPath(t *testing.T) {
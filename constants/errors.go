@@ -43,6 +43,7 @@ var (
 	ErrFieldNotFound        = fmt.Errorf("field %w", ErrNotFound)
 	ErrSeriesIDNotFound     = fmt.Errorf("seriesID %w", ErrNotFound)
 	ErrDataFamilyNotFound   = fmt.Errorf("data family %w", ErrNotFound)
+	ErrDataFileNotFound     = fmt.Errorf("data file %w", ErrNotFound)
 	ErrUnknownNodeChoose    = errors.New("unknown node choose")
 
 	// ErrDataFileCorruption represents data in tsdb's file is corrupted
@@ -55,6 +56,8 @@ var (
 	ErrNoLiveReplica = errors.New("no live replica for shard")
 	// ErrNoLiveNode represents no live node for current cluster.
 	ErrNoLiveNode = errors.New("no live node for cluster")
+	// ErrStorageReadOnly represents storage node/database is read-only for maintenance, rejects writes.
+	ErrStorageReadOnly = errors.New("storage is read-only")
 	// ErrNameEmpty represents name is empty.
 	ErrNameEmpty = errors.New("name cannot be empty")
 	// ErrNoStorageCluster represents storage cluster not exist.
@@ -70,6 +73,12 @@ var (
 
 	ErrDatabaseNotExist       = errors.New("database not exist")
 	ErrNoAvailableStorageNode = errors.New("no available storage node for server")
+	// ErrDiskQuotaExceeded represents the disk usage of database exceeds disk quota.
+	ErrDiskQuotaExceeded = errors.New("disk quota of database exceeded")
+	// ErrDatabaseWriteDisabled represents the write of database is disabled(ALTER DATABASE x SET WRITE = OFF).
+	ErrDatabaseWriteDisabled = errors.New("write of database is disabled")
+	// ErrMetricActive represents the metric is written or queried again when dropping it as unused metric.
+	ErrMetricActive = errors.New("metric is active")
 
 	// ErrTooManySeries represents the series be limited.
 	ErrTooManySeries     = errors.New("too manay series")
@@ -88,4 +97,8 @@ var (
 	ErrTooManyFields = errors.New("too many fields")
 	// ErrTooManySeriesFound is the error returned max series limit of data query.
 	ErrTooManySeriesFound = errors.New("found too many series")
+	// ErrTooManyGroupsForGroupByAll is the error returned when the groups of group by * exceed the safety limit.
+	ErrTooManyGroupsForGroupByAll = errors.New("too many groups for group by *, please filter series or group by specific tag keys")
+	// ErrTooManyMetricsMatched is the error returned when the metrics matched by metric name pattern exceed the limit.
+	ErrTooManyMetricsMatched = errors.New("too many metrics matched by metric name pattern")
 )
@@ -20,3 +20,6 @@ package constants
 const LF = "\n"
 
 var LBBytes = []byte(LF)
+
+// InternalDatabase represents the database which stores the monitoring data of LinDB self.
+const InternalDatabase = "_internal"
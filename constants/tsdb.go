@@ -20,6 +20,14 @@ package constants
 const (
 	// MaxSuggestions represents the max number of suggestions count
 	MaxSuggestions = 100
+	// MaxFuzzySuggestionCandidates represents the max number of candidates scanned by fuzzy suggestion(substring/subsequence match).
+	MaxFuzzySuggestionCandidates = 10000
+	// MaxGroupByAllSeries represents the max number of series(groups) of shard when grouping by all tag keys(group by *).
+	MaxGroupByAllSeries = 10000
+	// MaxMatchedMetrics represents the max number of metrics matched by metric name pattern of query(from 'cpu.*').
+	MaxMatchedMetrics = 20
+	// MetricNameTagKey represents the synthetic tag key of metric name for query with metric name pattern.
+	MetricNameTagKey = "__metric__"
 
 	// MetricMaxAheadDuration controls the global max write ahead duration.
 	// If current timestamp is 2021-08-19 23:00:00, metric after 2021-08-20 23:00:00 will be dropped.
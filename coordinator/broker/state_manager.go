@@ -34,6 +34,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
 )
 
@@ -58,12 +59,21 @@ type StateManager interface {
 	// and chooses the leader replica if the shard has multi-replica.
 	// returns storage node => shard id list
 	GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error)
+	// GetHedgeReplicas returns other available replicas of shards for hedged request, excludes spec nodes,
+	// returns false if any shard has no available replica.
+	// follower replicas(may lag behind leader) are candidates only if follower read enabled.
+	// returns storage node => shard id list
+	GetHedgeReplicas(databaseName string, shardIDs []models.ShardID, excludes map[string]struct{}) (map[string][]models.ShardID, bool)
 	// GetStorage returns storage state by name.
 	GetStorage(name string) (*models.StorageState, bool)
 	// GetStorageList returns all storage state list.
 	GetStorageList() (rs []*models.StorageState)
 	// GetDatabaseLimits returns the database's limits.
 	GetDatabaseLimits(name string) *models.Limits
+	// GetDatabaseLimitsState returns the state of database's limits applied on current node.
+	GetDatabaseLimitsState(name string) *models.LimitsState
+	// GetCircuitBreakerStates returns the circuit breaker state of storage nodes' connections.
+	GetCircuitBreakerStates() []models.CircuitBreakerState
 
 	WatchShardStateChangeEvent(fn func(databaseCfg models.Database,
 		shards map[models.ShardID]models.ShardState,
@@ -88,9 +98,12 @@ type stateManager struct {
 	)
 	// connection manager
 	connectionManager rpc.ConnectionManager
+	// if query from follower replica when leader replica is broken
+	followerRead bool
 	//FIXME: remove it???
 	taskClientFactory rpc.TaskClientFactory
 	databaseLimits    sync.Map
+	limitsStates      sync.Map // database => *models.LimitsState
 
 	events chan *discovery.Event
 	mutex  sync.RWMutex
@@ -105,6 +118,7 @@ func NewStateManager(
 	currentNode models.StatelessNode,
 	connectionManager rpc.ConnectionManager,
 	taskClientFactory rpc.TaskClientFactory,
+	followerRead bool,
 ) StateManager {
 	c, cancel := context.WithCancel(ctx)
 	mgr := &stateManager{
@@ -113,6 +127,7 @@ func NewStateManager(
 		currentNode:       currentNode,
 		connectionManager: connectionManager,
 		taskClientFactory: taskClientFactory,
+		followerRead:      followerRead,
 		storages:          make(map[string]*models.StorageState),
 		databases:         make(map[string]models.Database),
 		nodes:             make(map[string]models.StatelessNode),
@@ -244,6 +259,10 @@ func (m *stateManager) onDatabaseLimitsChange(key string, data []byte) error {
 		return err
 	}
 	m.databaseLimits.Store(name, limits)
+	m.limitsStates.Store(name, &models.LimitsState{
+		Revision:  models.LimitsRevision(data),
+		AppliedAt: timeutil.Now(),
+	})
 	return nil
 }
 
@@ -449,6 +468,17 @@ func (m *stateManager) GetStorageList() (rs []*models.StorageState) {
 	return
 }
 
+// GetDatabaseLimitsState returns the state of database's limits applied on current node.
+func (m *stateManager) GetDatabaseLimitsState(name string) *models.LimitsState {
+	val, ok := m.limitsStates.Load(name)
+	if !ok {
+		// default limits
+		return &models.LimitsState{}
+	}
+	state := *(val.(*models.LimitsState))
+	return &state
+}
+
 // GetDatabaseLimits returns the database's limits.
 func (m *stateManager) GetDatabaseLimits(name string) *models.Limits {
 	val, ok := m.databaseLimits.Load(name)
@@ -458,6 +488,14 @@ func (m *stateManager) GetDatabaseLimits(name string) *models.Limits {
 	return val.(*models.Limits)
 }
 
+// GetCircuitBreakerStates returns the circuit breaker state of storage nodes' connections.
+func (m *stateManager) GetCircuitBreakerStates() []models.CircuitBreakerState {
+	if m.connectionManager == nil {
+		return nil
+	}
+	return m.connectionManager.GetCircuitBreakerStates()
+}
+
 // GetQueryableReplicas returns the queryable replicas, else return detail error msg.::x
 // returns storage node => shard id list
 func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error) {
@@ -497,8 +535,7 @@ func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]m
 	result := make(map[string][]models.ShardID)
 	for shardID, shardState := range shards {
 		if shardState.State == models.OnlineShard {
-			node := liveNodes[shardState.Leader]
-			nodeID := node.Indicator()
+			nodeID := m.chooseReplica(liveNodes, shardState)
 			result[nodeID] = append(result[nodeID], shardID)
 		} else {
 			m.logger.Warn("shard is not online ignore it, maybe query data will be lost",
@@ -510,6 +547,95 @@ func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]m
 	return result, nil
 }
 
+// GetHedgeReplicas returns other available replicas of shards for hedged request, excludes spec nodes,
+// returns false if any shard has no available replica.
+// follower replicas(may lag behind leader) are candidates only if follower read enabled,
+// because hedged duplicate may win, so that the result may miss the latest data.
+// returns storage node => shard id list
+func (m *stateManager) GetHedgeReplicas(databaseName string,
+	shardIDs []models.ShardID, excludes map[string]struct{},
+) (map[string][]models.ShardID, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	database, ok := m.databases[databaseName]
+	if !ok {
+		return nil, false
+	}
+	storageState, ok := m.storages[database.Storage]
+	if !ok {
+		return nil, false
+	}
+	liveNodes := storageState.LiveNodes
+	shards := storageState.ShardStates[databaseName]
+	result := make(map[string][]models.ShardID)
+	for _, shardID := range shardIDs {
+		shardState, ok := shards[shardID]
+		if !ok || shardState.State != models.OnlineShard {
+			return nil, false
+		}
+		var candidates []string
+		for _, replicaID := range shardState.Replica.Replicas {
+			if !m.followerRead && replicaID != shardState.Leader {
+				// no in-sync tracking of follower replica, only hedge to leader replica
+				continue
+			}
+			node, ok := liveNodes[replicaID]
+			if !ok {
+				continue
+			}
+			nodeID := node.Indicator()
+			if _, excluded := excludes[nodeID]; excluded {
+				continue
+			}
+			if m.connectionManager != nil && !m.connectionManager.IsAvailable(nodeID) {
+				continue
+			}
+			if _, chosen := result[nodeID]; chosen {
+				// prefer the replica which already chosen, reduce the number of hedged requests
+				candidates = []string{nodeID}
+				break
+			}
+			candidates = append(candidates, nodeID)
+		}
+		if len(candidates) == 0 {
+			return nil, false
+		}
+		result[candidates[0]] = append(result[candidates[0]], shardID)
+	}
+	return result, true
+}
+
+// chooseReplica chooses the leader replica of shard for query,
+// if follower read enabled and the circuit breaker of leader is open, routes around it, chooses other available replica.
+// NOTE: follower replica may lag behind leader(no in-sync tracking), so the result may miss the latest data.
+func (m *stateManager) chooseReplica(liveNodes map[models.NodeID]models.StatefulNode, shardState models.ShardState) string {
+	leader := liveNodes[shardState.Leader]
+	leaderID := leader.Indicator()
+	if !m.followerRead || m.connectionManager == nil || m.connectionManager.IsAvailable(leaderID) {
+		return leaderID
+	}
+	for _, replicaID := range shardState.Replica.Replicas {
+		if replicaID == shardState.Leader {
+			continue
+		}
+		node, ok := liveNodes[replicaID]
+		if !ok {
+			continue
+		}
+		nodeID := node.Indicator()
+		if m.connectionManager.IsAvailable(nodeID) {
+			m.logger.Warn("leader replica is broken, query from other replica",
+				logger.Any("shard", shardState.ID),
+				logger.String("leader", leaderID),
+				logger.String("replica", nodeID))
+			return nodeID
+		}
+	}
+	// no available replica, try leader
+	return leaderID
+}
+
 // buildShardAssign builds the data write channel and related shard state.
 func (m *stateManager) notifyShardStateChange(storageState *models.StorageState) {
 	liveNodes := storageState.LiveNodes
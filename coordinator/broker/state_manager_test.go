@@ -34,7 +34,7 @@ import (
 )
 
 func TestStateManager_Close(t *testing.T) {
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil, false)
 	mgr.Close()
 }
 
@@ -43,7 +43,7 @@ func TestStateManager_Handle_Event_Panic(t *testing.T) {
 	defer ctrl.Finish()
 
 	connectionMgr := rpc.NewMockConnectionManager(ctrl)
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil, false)
 	mgr1 := mgr.(*stateManager)
 	mgr1.mutex.Lock()
 	mgr1.nodes["1.1.1.1:9000"] = models.StatelessNode{}
@@ -61,7 +61,7 @@ func TestStateManager_Handle_Event_Panic(t *testing.T) {
 }
 
 func TestStateManager_DatabaseConfig(t *testing.T) {
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil, false)
 	// case 1: unmarshal database config err
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.DatabaseConfigChanged,
@@ -108,7 +108,7 @@ func TestStateManager_Node(t *testing.T) {
 	defer ctrl.Finish()
 
 	cm := rpc.NewMockConnectionManager(ctrl)
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{HostIP: "3.3.3.3"}, cm, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{HostIP: "3.3.3.3"}, cm, nil, false)
 	// case 1: unmarshal node info err
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.NodeStartup,
@@ -151,7 +151,7 @@ func TestStateManager_Storage(t *testing.T) {
 	defer ctrl.Finish()
 
 	connectionMgr := rpc.NewMockConnectionManager(ctrl)
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil, false)
 
 	// case 1: unmarshal storage state err
 	mgr.EmitEvent(&discovery.Event{
@@ -228,7 +228,7 @@ func TestStateManager_ShardState(t *testing.T) {
 	defer ctrl.Finish()
 
 	connectionMgr := rpc.NewMockConnectionManager(ctrl)
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil, true)
 	c := 0
 	mgr.WatchShardStateChangeEvent(func(_ models.Database,
 		_ map[models.ShardID]models.ShardState,
@@ -307,6 +307,7 @@ func TestStateManager_ShardState(t *testing.T) {
 	assert.Equal(t, err, constants.ErrShardNotFound)
 	assert.Empty(t, replicas)
 
+	connectionMgr.EXPECT().IsAvailable(gomock.Any()).Return(true)
 	replicas, err = mgr.GetQueryableReplicas("db")
 	assert.NoError(t, err)
 	assert.Len(t, replicas, 1)
@@ -314,6 +315,103 @@ func TestStateManager_ShardState(t *testing.T) {
 	assert.True(t, c > 0)
 }
 
+func TestStateManager_chooseReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	connectionMgr := rpc.NewMockConnectionManager(ctrl)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil, true)
+	mgr1 := mgr.(*stateManager)
+	liveNodes := map[models.NodeID]models.StatefulNode{
+		1: {StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}},
+		2: {StatelessNode: models.StatelessNode{HostIP: "2.2.2.2", GRPCPort: 9000}},
+		3: {StatelessNode: models.StatelessNode{HostIP: "3.3.3.3", GRPCPort: 9000}},
+	}
+	shardState := models.ShardState{
+		ID:      1,
+		State:   models.OnlineShard,
+		Leader:  1,
+		Replica: models.Replica{Replicas: []models.NodeID{1, 4, 2, 3}},
+	}
+	// leader available
+	connectionMgr.EXPECT().IsAvailable("1.1.1.1:9000").Return(true)
+	assert.Equal(t, "1.1.1.1:9000", mgr1.chooseReplica(liveNodes, shardState))
+	// leader broken, route to other replica
+	connectionMgr.EXPECT().IsAvailable("1.1.1.1:9000").Return(false)
+	connectionMgr.EXPECT().IsAvailable("2.2.2.2:9000").Return(false)
+	connectionMgr.EXPECT().IsAvailable("3.3.3.3:9000").Return(true)
+	assert.Equal(t, "3.3.3.3:9000", mgr1.chooseReplica(liveNodes, shardState))
+	// all replicas broken, choose leader
+	connectionMgr.EXPECT().IsAvailable(gomock.Any()).Return(false).Times(3)
+	assert.Equal(t, "1.1.1.1:9000", mgr1.chooseReplica(liveNodes, shardState))
+	// follower read disabled, always choose leader
+	mgr1.followerRead = false
+	assert.Equal(t, "1.1.1.1:9000", mgr1.chooseReplica(liveNodes, shardState))
+	mgr1.followerRead = true
+	// no connection manager
+	mgr1.connectionManager = nil
+	assert.Equal(t, "1.1.1.1:9000", mgr1.chooseReplica(liveNodes, shardState))
+}
+
+func TestStateManager_GetHedgeReplicas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	connectionMgr := rpc.NewMockConnectionManager(ctrl)
+	connectionMgr.EXPECT().IsAvailable("3.3.3.3:9000").Return(false).AnyTimes()
+	connectionMgr.EXPECT().IsAvailable(gomock.Any()).Return(true).AnyTimes()
+	mgr := &stateManager{
+		connectionManager: connectionMgr,
+		followerRead:      true,
+		databases: map[string]models.Database{
+			"test": {Storage: "test"},
+		},
+		storages: map[string]*models.StorageState{
+			"test": {
+				LiveNodes: map[models.NodeID]models.StatefulNode{
+					1: {StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}},
+					2: {StatelessNode: models.StatelessNode{HostIP: "2.2.2.2", GRPCPort: 9000}},
+					3: {StatelessNode: models.StatelessNode{HostIP: "3.3.3.3", GRPCPort: 9000}},
+				},
+				ShardStates: map[string]map[models.ShardID]models.ShardState{
+					"test": {
+						1: {ID: 1, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2}}},
+						2: {ID: 2, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 4, 2}}},
+						3: {ID: 3, State: models.OnlineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 3}}},
+						4: {ID: 4, State: models.OfflineShard, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2}}},
+					},
+				},
+			},
+		},
+	}
+	excludes := map[string]struct{}{"1.1.1.1:9000": {}}
+	// database/storage not found
+	_, ok := mgr.GetHedgeReplicas("test_1", []models.ShardID{1}, excludes)
+	assert.False(t, ok)
+	mgr.databases["test_2"] = models.Database{Storage: "test_2"}
+	_, ok = mgr.GetHedgeReplicas("test_2", []models.ShardID{1}, excludes)
+	assert.False(t, ok)
+	// other replicas of shards
+	replicas, ok := mgr.GetHedgeReplicas("test", []models.ShardID{1, 2}, excludes)
+	assert.True(t, ok)
+	assert.Equal(t, map[string][]models.ShardID{"2.2.2.2:9000": {1, 2}}, replicas)
+	// shard not found/offline
+	_, ok = mgr.GetHedgeReplicas("test", []models.ShardID{1, 5}, excludes)
+	assert.False(t, ok)
+	_, ok = mgr.GetHedgeReplicas("test", []models.ShardID{4}, excludes)
+	assert.False(t, ok)
+	// replica is broken
+	_, ok = mgr.GetHedgeReplicas("test", []models.ShardID{3}, excludes)
+	assert.False(t, ok)
+	// follower read disabled, only hedge to leader replica
+	mgr.followerRead = false
+	_, ok = mgr.GetHedgeReplicas("test", []models.ShardID{1}, excludes)
+	assert.False(t, ok)
+	replicas, ok = mgr.GetHedgeReplicas("test", []models.ShardID{1}, map[string]struct{}{"2.2.2.2:9000": {}})
+	assert.True(t, ok)
+	assert.Equal(t, map[string][]models.ShardID{"1.1.1.1:9000": {1}}, replicas)
+}
+
 func TestStateManager_GetLiveNodes(t *testing.T) {
 	s := &stateManager{
 		nodes: make(map[string]models.StatelessNode),
@@ -399,7 +497,7 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil, false)
 
 	// case 1: decode limit failure
 	mgr.EmitEvent(&discovery.Event{
@@ -418,4 +516,8 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 	assert.Equal(t, limit2, mgr.GetDatabaseLimits("db2"))
 	assert.Equal(t, defaultDatabaseLimits, mgr.GetDatabaseLimits("test"))
+	state := mgr.GetDatabaseLimitsState("db2")
+	assert.Equal(t, models.LimitsRevision([]byte(limit2.TOML())), state.Revision)
+	assert.NotZero(t, state.AppliedAt)
+	assert.Equal(t, &models.LimitsState{}, mgr.GetDatabaseLimitsState("test"))
 }
@@ -19,7 +19,9 @@ package master
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 
 	"github.com/lindb/lindb/models"
 )
@@ -45,7 +47,14 @@ import (
 // s8		s9		s5		s6		s7		(2st replica)
 // s3		s4		s0		s1		s2		(3st replica)
 // s7		s8		s9		s5		s6		(3st replica)
-func ShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database,
+//
+// If failure domains(zone/rack) of storage nodes are given, the remaining replicas of each shard prefer the nodes
+// in the domains which don't have replica of this shard yet, following the order of increasing shift.
+// If failure domain is required by database, replicas of a shard never land in the same domain.
+//
+// If free disk spaces of all storage nodes are given, replicas are assigned weighted by free disk space instead.
+func ShardAssignment(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	freeSpaces map[models.NodeID]uint64, cfg *models.Database,
 	fixedStartIndex int, startShardID models.ShardID) (*models.ShardAssignment, error) {
 	numOfShard := cfg.NumOfShard
 	replicaFactor := cfg.ReplicaFactor
@@ -60,14 +69,20 @@ func ShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database,
 			fmt.Errorf("shard assign error for databaes[%s], bacause replica factor > num. of storage nodes",
 				cfg.Name)
 	}
+	storageNodeIDs, err := selectFailureDomainNodes(storageNodeIDs, domains, cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	shardAssignment := models.NewShardAssignment(cfg.Name)
-	assignReplicasToStorageNodes(storageNodeIDs, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
+	assignReplicas(storageNodeIDs, domains, freeSpaces, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
 
 	return shardAssignment, nil
 }
 
-func ModifyShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database, shardAssignment *models.ShardAssignment,
+// ModifyShardAssignment assigns replica list for the new shards of database.
+func ModifyShardAssignment(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	freeSpaces map[models.NodeID]uint64, cfg *models.Database, shardAssignment *models.ShardAssignment,
 	fixedStartIndex int, startShardID models.ShardID) error {
 	numOfShard := cfg.NumOfShard - len(shardAssignment.Shards)
 	replicaFactor := cfg.ReplicaFactor
@@ -81,15 +96,86 @@ func ModifyShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database,
 		return fmt.Errorf("shard assign error for databaes[%s], bacause replica factor > num. of storage nodes",
 			cfg.Name)
 	}
+	storageNodeIDs, err := selectFailureDomainNodes(storageNodeIDs, domains, cfg)
+	if err != nil {
+		return err
+	}
 
-	assignReplicasToStorageNodes(storageNodeIDs, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
+	assignReplicas(storageNodeIDs, domains, freeSpaces, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
 
 	return nil
 }
 
+// assignReplicas assigns replica list for each shard weighted by free disk space if free disk spaces
+// of all storage nodes are given, else assigns by round-robin.
+func assignReplicas(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	freeSpaces map[models.NodeID]uint64,
+	numOfShard, replicaFactor, fixedStartIndex int, startShardID models.ShardID,
+	shardAssignment *models.ShardAssignment) {
+	for _, nodeID := range storageNodeIDs {
+		if _, ok := freeSpaces[nodeID]; !ok {
+			assignReplicasToStorageNodes(storageNodeIDs, domains,
+				numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
+			return
+		}
+	}
+	assignReplicasByCapacity(storageNodeIDs, domains, freeSpaces, numOfShard, replicaFactor, startShardID, shardAssignment)
+}
+
+// assignReplicasByCapacity assigns replica list for each shard weighted by free disk space of storage nodes,
+// picks the node with the least score((num. of assigned replicas + 1) / free disk space) for each replica,
+// the nodes in the failure domains which don't have replica of this shard yet are preferred.
+func assignReplicasByCapacity(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	freeSpaces map[models.NodeID]uint64, numOfShard, replicaFactor int, startShardID models.ShardID,
+	shardAssignment *models.ShardAssignment) {
+	nodeIDs := make([]models.NodeID, len(storageNodeIDs))
+	copy(nodeIDs, storageNodeIDs)
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+	score := func(nodeID models.NodeID, assigned int) float64 {
+		free := freeSpaces[nodeID]
+		if free == 0 {
+			return math.Inf(1)
+		}
+		return float64(assigned+1) / float64(free)
+	}
+
+	assigned := make(map[models.NodeID]int)
+	currentShardID := models.ShardID(0)
+	if startShardID >= 0 {
+		currentShardID = startShardID
+	}
+	for i := 0; i < numOfShard; i++ {
+		replicas := &models.Replica{}
+		usedDomains := make(map[string]struct{})
+		for j := 0; j < replicaFactor; j++ {
+			target := models.NodeID(-1)
+			targetPreferred := false
+			for _, nodeID := range nodeIDs {
+				if replicas.Contain(nodeID) {
+					continue
+				}
+				_, used := usedDomains[domains[nodeID]]
+				preferred := len(domains) == 0 || !used
+				if target < 0 || (preferred && !targetPreferred) ||
+					(preferred == targetPreferred && score(nodeID, assigned[nodeID]) < score(target, assigned[target])) {
+					target = nodeID
+					targetPreferred = preferred
+				}
+			}
+			replicas.Replicas = append(replicas.Replicas, target)
+			usedDomains[domains[target]] = struct{}{}
+			assigned[target]++
+			shardAssignment.AddReplica(currentShardID, target)
+		}
+
+		// do next shard assign
+		currentShardID++
+	}
+}
+
 // assignReplicasToStorageNodes assigns replica list for storage storageCluster
 // which database's each shard based on selected node list in storageCluster.
-func assignReplicasToStorageNodes(storageNodeIDs []models.NodeID,
+func assignReplicasToStorageNodes(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
 	numOfShard, replicaFactor, fixedStartIndex int, startShardID models.ShardID,
 	shardAssignment *models.ShardAssignment) {
 	numOfNode := len(storageNodeIDs)
@@ -118,9 +204,16 @@ func assignReplicasToStorageNodes(storageNodeIDs []models.NodeID,
 		shardAssignment.AddReplica(currentShardID, leader)
 
 		// assign other replica
-		for j := 0; j < replicaFactor-1; j++ {
-			idx := replicaIndex(firstReplicaIndex, nextReplicaShift, j, numOfNode)
-			shardAssignment.AddReplica(currentShardID, storageNodeIDs[idx])
+		if len(domains) == 0 {
+			for j := 0; j < replicaFactor-1; j++ {
+				idx := replicaIndex(firstReplicaIndex, nextReplicaShift, j, numOfNode)
+				shardAssignment.AddReplica(currentShardID, storageNodeIDs[idx])
+			}
+		} else {
+			for _, idx := range domainAwareReplicaIndexes(storageNodeIDs, domains,
+				firstReplicaIndex, nextReplicaShift, replicaFactor) {
+				shardAssignment.AddReplica(currentShardID, storageNodeIDs[idx])
+			}
 		}
 
 		// do next shard assign
@@ -133,3 +226,224 @@ func replicaIndex(firstReplicaIndex, secondReplicaShift, replicaIndex, numOfNode
 	shift := 1 + (secondReplicaShift+replicaIndex)%(numOfNode-1)
 	return (firstReplicaIndex + shift) % numOfNode
 }
+
+// domainAwareReplicaIndexes returns the indexes of other replicas(except first replica),
+// picks the nodes in unused failure domains first, then fills the remaining replicas by the order of shift.
+func domainAwareReplicaIndexes(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	firstReplicaIndex, secondReplicaShift, replicaFactor int) []int {
+	numOfNode := len(storageNodeIDs)
+	usedDomains := map[string]struct{}{domains[storageNodeIDs[firstReplicaIndex]]: {}}
+	var (
+		selected []int
+		skipped  []int
+	)
+	// candidates(all other nodes) in the order of shift
+	for j := 0; j < numOfNode-1 && len(selected) < replicaFactor-1; j++ {
+		idx := replicaIndex(firstReplicaIndex, secondReplicaShift, j, numOfNode)
+		domain := domains[storageNodeIDs[idx]]
+		if _, ok := usedDomains[domain]; ok {
+			skipped = append(skipped, idx)
+			continue
+		}
+		usedDomains[domain] = struct{}{}
+		selected = append(selected, idx)
+	}
+	// not enough failure domains, fill the remaining replicas with skipped nodes
+	for _, idx := range skipped {
+		if len(selected) >= replicaFactor-1 {
+			break
+		}
+		selected = append(selected, idx)
+	}
+	return selected
+}
+
+// selectFailureDomainNodes returns the storage nodes which can hold replicas of database,
+// if failure domain is required by database, only the nodes with failure domain label can be used,
+// and num. of failure domains must be >= replica factor.
+func selectFailureDomainNodes(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	cfg *models.Database) ([]models.NodeID, error) {
+	if cfg.FailureDomain == "" {
+		return storageNodeIDs, nil
+	}
+	var nodeIDs []models.NodeID
+	distinctDomains := make(map[string]struct{})
+	for _, nodeID := range storageNodeIDs {
+		if domain, ok := domains[nodeID]; ok {
+			nodeIDs = append(nodeIDs, nodeID)
+			distinctDomains[domain] = struct{}{}
+		}
+	}
+	if cfg.ReplicaFactor > len(distinctDomains) {
+		return nil,
+			fmt.Errorf("shard assign error for databaes[%s], bacause replica factor > num. of failure domains(%s)",
+				cfg.Name, cfg.FailureDomain)
+	}
+	return nodeIDs, nil
+}
+
+// repairShardAssignment moves the replicas which are in the same failure domain with other replica of shard
+// to the least loaded node in unused failure domains, returns the replica moves.
+// Replicas can't be moved if there is no available failure domain.
+func repairShardAssignment(storageNodeIDs []models.NodeID, domains map[models.NodeID]string,
+	shardAssignment *models.ShardAssignment) (moves models.PlacementMoves) {
+	// num. of replicas on each node
+	loads := make(map[models.NodeID]int)
+	shardIDs := make([]models.ShardID, 0, len(shardAssignment.Shards))
+	for shardID, replica := range shardAssignment.Shards {
+		shardIDs = append(shardIDs, shardID)
+		for _, nodeID := range replica.Replicas {
+			loads[nodeID]++
+		}
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	nodeIDs := make([]models.NodeID, len(storageNodeIDs))
+	copy(nodeIDs, storageNodeIDs)
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	for _, shardID := range shardIDs {
+		replica := shardAssignment.Shards[shardID]
+		// failure domains of all current replicas can't be used as target
+		usedDomains := make(map[string]struct{})
+		for _, nodeID := range replica.Replicas {
+			if domain, ok := domains[nodeID]; ok {
+				usedDomains[domain] = struct{}{}
+			}
+		}
+		seenDomains := make(map[string]struct{})
+		for idx, nodeID := range replica.Replicas {
+			domain, ok := domains[nodeID]
+			if !ok {
+				continue
+			}
+			if _, seen := seenDomains[domain]; !seen {
+				seenDomains[domain] = struct{}{}
+				continue
+			}
+			// pick the least loaded node in unused failure domain
+			target := models.NodeID(-1)
+			for _, candidate := range nodeIDs {
+				candidateDomain, ok0 := domains[candidate]
+				if !ok0 || replica.Contain(candidate) {
+					continue
+				}
+				if _, used := usedDomains[candidateDomain]; used {
+					continue
+				}
+				if target < 0 || loads[candidate] < loads[target] {
+					target = candidate
+				}
+			}
+			if target < 0 {
+				continue
+			}
+			replica.Replicas[idx] = target
+			usedDomains[domains[target]] = struct{}{}
+			seenDomains[domains[target]] = struct{}{}
+			loads[nodeID]--
+			loads[target]++
+			moves = append(moves, models.PlacementMove{ShardID: shardID, From: nodeID, To: target})
+		}
+	}
+	return moves
+}
+
+// capacityBalanceMoves returns the suggested replica moves for balancing disk usage of storage nodes,
+// moves the replica of shard from the most used node to the least used node until the difference of
+// used ratio between them doesn't exceed threshold, the disk space of replica is estimated by
+// the average of replicas on the node. Shard assignment isn't modified.
+func capacityBalanceMoves(capacities map[models.NodeID]models.NodeCapacity, domains map[models.NodeID]string,
+	shardAssignment *models.ShardAssignment, threshold float64) (moves models.PlacementMoves) {
+	used := make(map[models.NodeID]float64)
+	var nodeIDs []models.NodeID
+	for nodeID, capacity := range capacities {
+		if capacity.Total > 0 {
+			nodeIDs = append(nodeIDs, nodeID)
+			used[nodeID] = float64(capacity.Used)
+		}
+	}
+	if len(nodeIDs) < 2 {
+		return nil
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+	ratio := func(nodeID models.NodeID) float64 {
+		return used[nodeID] / float64(capacities[nodeID].Total)
+	}
+	// copy replica list, because shard assignment cannot be modified
+	shardIDs := make([]models.ShardID, 0, len(shardAssignment.Shards))
+	replicas := make(map[models.ShardID][]models.NodeID)
+	for shardID, replica := range shardAssignment.Shards {
+		shardIDs = append(shardIDs, shardID)
+		replicas[shardID] = append([]models.NodeID{}, replica.Replicas...)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	moved := make(map[models.ShardID]struct{})
+
+	for len(moved) < len(shardIDs) {
+		maxNode, minNode := nodeIDs[0], nodeIDs[0]
+		for _, nodeID := range nodeIDs {
+			if ratio(nodeID) > ratio(maxNode) {
+				maxNode = nodeID
+			}
+			if ratio(nodeID) < ratio(minNode) {
+				minNode = nodeID
+			}
+		}
+		diff := ratio(maxNode) - ratio(minNode)
+		if diff <= threshold {
+			return moves
+		}
+		// find movable replica on the most used node
+		numOfReplicas := 0
+		candidate := models.ShardID(-1)
+		for _, shardID := range shardIDs {
+			if !(models.Replica{Replicas: replicas[shardID]}).Contain(maxNode) {
+				continue
+			}
+			numOfReplicas++
+			if _, ok := moved[shardID]; ok || candidate >= 0 {
+				continue
+			}
+			if isMovable(replicas[shardID], domains, maxNode, minNode) {
+				candidate = shardID
+			}
+		}
+		if candidate < 0 {
+			return moves
+		}
+		size := used[maxNode] / float64(numOfReplicas)
+		newDiff := math.Abs((used[maxNode]-size)/float64(capacities[maxNode].Total) -
+			(used[minNode]+size)/float64(capacities[minNode].Total))
+		if newDiff >= diff {
+			return moves
+		}
+		used[maxNode] -= size
+		used[minNode] += size
+		for idx, nodeID := range replicas[candidate] {
+			if nodeID == maxNode {
+				replicas[candidate][idx] = minNode
+			}
+		}
+		moved[candidate] = struct{}{}
+		moves = append(moves, models.PlacementMove{ShardID: candidate, From: maxNode, To: minNode})
+	}
+	return moves
+}
+
+// isMovable checks if the replica of shard can be moved from source node to target node,
+// target node cannot hold the replica of shard and cannot be in the failure domain of other replicas.
+func isMovable(replicas []models.NodeID, domains map[models.NodeID]string, from, to models.NodeID) bool {
+	targetDomain, hasDomain := domains[to]
+	for _, nodeID := range replicas {
+		if nodeID == to {
+			return false
+		}
+		if nodeID == from || !hasDomain {
+			continue
+		}
+		if domain, ok := domains[nodeID]; ok && domain == targetDomain {
+			return false
+		}
+	}
+	return true
+}
@@ -28,7 +28,7 @@ import (
 func TestShardAssign(t *testing.T) {
 	storageNodeIDs := []models.NodeID{0, 1, 2, 3, 4}
 
-	_, err1 := ShardAssignment(storageNodeIDs,
+	_, err1 := ShardAssignment(storageNodeIDs, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    0,
@@ -36,7 +36,7 @@ func TestShardAssign(t *testing.T) {
 		}, -1, -1)
 	assert.NotNil(t, err1)
 
-	_, err1 = ShardAssignment(storageNodeIDs,
+	_, err1 = ShardAssignment(storageNodeIDs, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    3,
@@ -44,7 +44,7 @@ func TestShardAssign(t *testing.T) {
 		}, -1, -1)
 	assert.NotNil(t, err1)
 
-	_, err2 := ShardAssignment(storageNodeIDs,
+	_, err2 := ShardAssignment(storageNodeIDs, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    10,
@@ -52,7 +52,7 @@ func TestShardAssign(t *testing.T) {
 		}, -1, -1)
 	assert.NotNil(t, err2)
 
-	shardAssignment, _ := ShardAssignment(storageNodeIDs,
+	shardAssignment, _ := ShardAssignment(storageNodeIDs, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    10,
@@ -61,6 +61,171 @@ func TestShardAssign(t *testing.T) {
 	checkShardAssignResult(shardAssignment, t)
 }
 
+func TestShardAssign_ZoneAware(t *testing.T) {
+	storageNodeIDs := []models.NodeID{0, 1, 2, 3, 4, 5}
+	zones := map[models.NodeID]string{0: "a", 1: "a", 2: "b", 3: "b", 4: "c", 5: "c"}
+	for i := 0; i < 6; i++ {
+		shardAssignment, err := ShardAssignment(storageNodeIDs, zones, nil,
+			&models.Database{
+				Name:          "test",
+				NumOfShard:    12,
+				ReplicaFactor: 3,
+			}, i, -1)
+		assert.NoError(t, err)
+		for _, replica := range shardAssignment.Shards {
+			assert.Len(t, replica.Replicas, 3)
+			replicaZones := make(map[string]struct{})
+			for _, nodeID := range replica.Replicas {
+				replicaZones[zones[nodeID]] = struct{}{}
+			}
+			// each replica in different zone
+			assert.Len(t, replicaZones, 3)
+		}
+	}
+	// replica factor > num. of zones
+	shardAssignment, err := ShardAssignment(storageNodeIDs, map[models.NodeID]string{0: "a", 1: "a", 2: "b"}, nil,
+		&models.Database{
+			Name:          "test",
+			NumOfShard:    6,
+			ReplicaFactor: 4,
+		}, -1, -1)
+	assert.NoError(t, err)
+	for _, replica := range shardAssignment.Shards {
+		nodes := make(map[models.NodeID]struct{})
+		for _, nodeID := range replica.Replicas {
+			nodes[nodeID] = struct{}{}
+		}
+		assert.Len(t, nodes, 4)
+	}
+}
+
+func TestShardAssign_FailureDomainRequired(t *testing.T) {
+	storageNodeIDs := []models.NodeID{0, 1, 2, 3, 4}
+	racks := map[models.NodeID]string{0: "r1", 1: "r1", 2: "r2", 3: "r2"}
+	cfg := &models.Database{
+		Name:          "test",
+		NumOfShard:    8,
+		ReplicaFactor: 3,
+		FailureDomain: models.NodeLabelRack,
+	}
+	// num. of failure domains < replica factor
+	_, err := ShardAssignment(storageNodeIDs, racks, nil, cfg, -1, -1)
+	assert.Error(t, err)
+	err = ModifyShardAssignment(storageNodeIDs, racks, nil, cfg, models.NewShardAssignment("test"), -1, 0)
+	assert.Error(t, err)
+
+	racks[4] = "r3"
+	racks[5] = "r3"
+	shardAssignment, err := ShardAssignment(storageNodeIDs, racks, nil, cfg, -1, -1)
+	assert.NoError(t, err)
+	assert.Empty(t, models.CheckPlacement(shardAssignment, racks))
+
+	// node without failure domain label can't hold replica
+	delete(racks, 4)
+	racks[6] = "r3"
+	shardAssignment, err = ShardAssignment([]models.NodeID{0, 1, 2, 3, 4, 6}, racks, nil, cfg, -1, -1)
+	assert.NoError(t, err)
+	assert.Empty(t, models.CheckPlacement(shardAssignment, racks))
+	for _, replica := range shardAssignment.Shards {
+		assert.False(t, replica.Contain(4))
+	}
+}
+
+func TestRepairShardAssignment(t *testing.T) {
+	zones := map[models.NodeID]string{1: "a", 2: "a", 3: "b", 4: "b", 5: "c"}
+	shardAssignment := &models.ShardAssignment{
+		Name: "test",
+		Shards: map[models.ShardID]*models.Replica{
+			0: {Replicas: []models.NodeID{1, 2, 3}},
+			1: {Replicas: []models.NodeID{3, 4, 1}},
+			2: {Replicas: []models.NodeID{1, 3, 5}},
+			3: {Replicas: []models.NodeID{6, 7}}, // unknown failure domain
+		},
+	}
+	assert.Len(t, models.CheckPlacement(shardAssignment, zones), 2)
+	moves := repairShardAssignment([]models.NodeID{1, 2, 3, 4, 5}, zones, shardAssignment)
+	assert.Equal(t, models.PlacementMoves{{ShardID: 0, From: 2, To: 5}, {ShardID: 1, From: 4, To: 5}}, moves)
+	assert.Empty(t, models.CheckPlacement(shardAssignment, zones))
+	assert.Equal(t, []models.NodeID{1, 5, 3}, shardAssignment.Shards[0].Replicas)
+	assert.Equal(t, []models.NodeID{3, 5, 1}, shardAssignment.Shards[1].Replicas)
+	// no moves
+	assert.Empty(t, repairShardAssignment([]models.NodeID{1, 2, 3, 4, 5}, zones, shardAssignment))
+
+	// no available failure domain
+	shardAssignment = &models.ShardAssignment{
+		Name:   "test",
+		Shards: map[models.ShardID]*models.Replica{0: {Replicas: []models.NodeID{1, 2}}},
+	}
+	assert.Empty(t, repairShardAssignment([]models.NodeID{1, 2}, zones, shardAssignment))
+	assert.Equal(t, []models.NodeID{1, 2}, shardAssignment.Shards[0].Replicas)
+}
+
+func TestShardAssign_Capacity(t *testing.T) {
+	storageNodeIDs := []models.NodeID{1, 2, 3}
+	cfg := &models.Database{Name: "test", NumOfShard: 4, ReplicaFactor: 1}
+	shardAssignment, err := ShardAssignment(storageNodeIDs, nil,
+		map[models.NodeID]uint64{1: 200, 2: 100, 3: 100}, cfg, -1, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.NodeID{1}, shardAssignment.Shards[0].Replicas)
+	assert.Equal(t, []models.NodeID{1}, shardAssignment.Shards[1].Replicas)
+	assert.Equal(t, []models.NodeID{2}, shardAssignment.Shards[2].Replicas)
+	assert.Equal(t, []models.NodeID{3}, shardAssignment.Shards[3].Replicas)
+
+	// node without free disk space
+	shardAssignment, err = ShardAssignment(storageNodeIDs, nil,
+		map[models.NodeID]uint64{1: 0, 2: 100, 3: 100}, cfg, -1, -1)
+	assert.NoError(t, err)
+	for _, replica := range shardAssignment.Shards {
+		assert.False(t, replica.Contain(1))
+	}
+
+	// prefer the failure domain which doesn't have replica of shard
+	cfg = &models.Database{Name: "test", NumOfShard: 2, ReplicaFactor: 2}
+	shardAssignment, err = ShardAssignment(storageNodeIDs, map[models.NodeID]string{1: "a", 2: "a", 3: "b"},
+		map[models.NodeID]uint64{1: 300, 2: 100, 3: 100}, cfg, -1, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.NodeID{1, 3}, shardAssignment.Shards[0].Replicas)
+	assert.Equal(t, []models.NodeID{1, 3}, shardAssignment.Shards[1].Replicas)
+
+	// free disk space of some node not found, assign by round-robin
+	cfg = &models.Database{Name: "test", NumOfShard: 10, ReplicaFactor: 3}
+	shardAssignment, err = ShardAssignment([]models.NodeID{0, 1, 2, 3, 4}, nil,
+		map[models.NodeID]uint64{1: 300}, cfg, -1, -1)
+	assert.NoError(t, err)
+	checkShardAssignResult(shardAssignment, t)
+}
+
+func TestCapacityBalanceMoves(t *testing.T) {
+	shardAssignment := models.NewShardAssignment("test")
+	shardAssignment.AddReplica(0, 1)
+	shardAssignment.AddReplica(0, 2)
+	shardAssignment.AddReplica(1, 1)
+	shardAssignment.AddReplica(1, 2)
+	// not enough nodes
+	assert.Empty(t, capacityBalanceMoves(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Used: 80}, 2: {Used: 10},
+	}, nil, shardAssignment, 0.2))
+	// balanced
+	assert.Empty(t, capacityBalanceMoves(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Used: 50}, 2: {Total: 100, Used: 50}, 3: {Total: 100, Used: 40},
+	}, nil, shardAssignment, 0.2))
+	// move replica from most used node to least used node
+	assert.Equal(t, models.PlacementMoves{
+		{ShardID: 0, From: 1, To: 3},
+		{ShardID: 1, From: 2, To: 4},
+	}, capacityBalanceMoves(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Used: 80}, 2: {Total: 100, Used: 80}, 3: {Total: 100}, 4: {Total: 100},
+	}, nil, shardAssignment, 0.2))
+	// cannot move replica into the failure domain of other replica
+	assert.Empty(t, capacityBalanceMoves(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Used: 80}, 2: {Total: 100, Used: 80}, 3: {Total: 100},
+	}, map[models.NodeID]string{1: "a", 2: "b", 3: "b"}, shardAssignment, 0.2))
+	// move cannot reduce imbalance
+	assert.Empty(t, capacityBalanceMoves(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Used: 100}, 2: {Total: 100, Used: 50}, 3: {Total: 100, Used: 10},
+	}, nil, &models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{0: {Replicas: []models.NodeID{1}}}}, 0.2))
+}
+
 func checkShardAssignResult(shardAssignment *models.ShardAssignment, t *testing.T) {
 	assert.Equal(t, 10, len(shardAssignment.Shards))
 	var nodes = make(map[models.NodeID]map[models.ShardID]models.ShardID)
@@ -81,7 +246,7 @@ func checkShardAssignResult(shardAssignment *models.ShardAssignment, t *testing.
 }
 
 func TestModifyShardAssignment(t *testing.T) {
-	err := ModifyShardAssignment([]models.NodeID{0, 1, 2, 3, 4},
+	err := ModifyShardAssignment([]models.NodeID{0, 1, 2, 3, 4}, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    0,
@@ -89,7 +254,7 @@ func TestModifyShardAssignment(t *testing.T) {
 		}, models.NewShardAssignment("test"), -1, models.ShardID(1))
 	assert.Error(t, err)
 
-	err = ModifyShardAssignment([]models.NodeID{0},
+	err = ModifyShardAssignment([]models.NodeID{0}, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    1,
@@ -97,7 +262,7 @@ func TestModifyShardAssignment(t *testing.T) {
 		}, models.NewShardAssignment("test"), -1, models.ShardID(1))
 	assert.Error(t, err)
 
-	err = ModifyShardAssignment([]models.NodeID{0},
+	err = ModifyShardAssignment([]models.NodeID{0}, nil, nil,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    1,
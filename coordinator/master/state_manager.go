@@ -60,6 +60,12 @@ type StateManager interface {
 	GetShardAssignments() []models.ShardAssignment
 	// GetStorageStates returns current storage state list.
 	GetStorageStates() []*models.StorageState
+	// RepairPlacement moves the replicas of database's shards which violate failure domain placement,
+	// returns the replica moves.
+	RepairPlacement(databaseName string) (models.PlacementMoves, error)
+	// GetPlacementSuggestions returns the suggested replica moves for balancing disk usage of storage nodes,
+	// if the difference of used ratio between storage nodes exceeds threshold.
+	GetPlacementSuggestions(databaseName string, threshold float64) (models.PlacementMoves, error)
 }
 
 // stateManager implements StateManager.
@@ -591,8 +597,9 @@ func (m *stateManager) createShardAssignment(
 		nodes[node.ID] = &node
 	}
 
-	// generate shard assignment based on node ids and config
-	shardAssign, err := ShardAssignment(nodeIDs, cfg, fixedStartIndex, startShardID)
+	// generate shard assignment based on node ids/failure domains/free disk spaces and config
+	domains := models.GetFailureDomains(liveNodes, cfg.GetFailureDomain())
+	shardAssign, err := ShardAssignment(nodeIDs, domains, m.getFreeSpaces(cluster), cfg, fixedStartIndex, startShardID)
 	if err != nil {
 		return nil, err
 	}
@@ -640,7 +647,9 @@ func (m *stateManager) modifyShardAssignment(
 
 		// generate shard assignment based on node ids and config
 		// TODO check start shard id
-		err = ModifyShardAssignment(nodeIDs, cfg, shardAssign, -1, models.ShardID(len(shardAssign.Shards)))
+		domains := models.GetFailureDomains(liveNodes, cfg.GetFailureDomain())
+		err = ModifyShardAssignment(nodeIDs, domains, m.getFreeSpaces(cluster),
+			cfg, shardAssign, -1, models.ShardID(len(shardAssign.Shards)))
 		if err != nil {
 			return err
 		}
@@ -662,6 +671,107 @@ func (m *stateManager) modifyShardAssignment(
 	return nil
 }
 
+// RepairPlacement moves the replicas of database's shards which violate failure domain placement,
+// returns the replica moves.
+// NOTICE: new replica only receives the data written after moving, data of old replica isn't removed.
+func (m *stateManager) RepairPlacement(databaseName string) (models.PlacementMoves, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cfg, ok := m.databases[databaseName]
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	cluster, ok := m.storages[cfg.Storage]
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	shardAssign, err := m.GetShardAssign(databaseName)
+	if err != nil {
+		return nil, err
+	}
+	liveNodes, err := cluster.GetLiveNodes()
+	if err != nil {
+		return nil, err
+	}
+	nodeIDs := make([]models.NodeID, len(liveNodes))
+	for idx := range liveNodes {
+		nodeIDs[idx] = liveNodes[idx].ID
+	}
+	moves := repairShardAssignment(nodeIDs, models.GetFailureDomains(liveNodes, cfg.GetFailureDomain()), shardAssign)
+	if len(moves) == 0 {
+		return nil, nil
+	}
+	m.logger.Info("repair placement of shard assign",
+		logger.String("database", databaseName),
+		logger.Any("moves", moves))
+
+	data := encoding.JSONMarshal(shardAssign)
+	if err := m.masterRepo.Put(m.ctx, constants.GetDatabaseAssignPath(databaseName), data); err != nil {
+		return nil, err
+	}
+	// save shard assignment into related storage repo, storage node will create the new replicas.
+	if err := cluster.SaveDatabaseAssignment(shardAssign, cfg.Option); err != nil {
+		return nil, err
+	}
+	return moves, nil
+}
+
+// GetPlacementSuggestions returns the suggested replica moves for balancing disk usage of storage nodes,
+// if the difference of used ratio between storage nodes exceeds threshold.
+// NOTICE: only returns suggestions, shard assignment isn't modified.
+func (m *stateManager) GetPlacementSuggestions(databaseName string, threshold float64) (models.PlacementMoves, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	cfg, ok := m.databases[databaseName]
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	cluster, ok := m.storages[cfg.Storage]
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	shardAssign, err := m.GetShardAssign(databaseName)
+	if err != nil {
+		return nil, err
+	}
+	liveNodes, err := cluster.GetLiveNodes()
+	if err != nil {
+		return nil, err
+	}
+	capacities, err := cluster.GetNodeCapacities()
+	if err != nil {
+		return nil, err
+	}
+	// only balance between live nodes
+	liveCapacities := make(map[models.NodeID]models.NodeCapacity)
+	for idx := range liveNodes {
+		if capacity, ok := capacities[liveNodes[idx].ID]; ok {
+			liveCapacities[liveNodes[idx].ID] = capacity
+		}
+	}
+	return capacityBalanceMoves(liveCapacities, models.GetFailureDomains(liveNodes, cfg.GetFailureDomain()),
+		shardAssign, threshold), nil
+}
+
+// getFreeSpaces returns free disk space of storage nodes, returns nil if fail.
+func (m *stateManager) getFreeSpaces(cluster StorageCluster) map[models.NodeID]uint64 {
+	capacities, err := cluster.GetNodeCapacities()
+	if err != nil {
+		m.logger.Warn("get capacity of storage nodes failure, assign shard without capacity", logger.Error(err))
+		return nil
+	}
+	if len(capacities) == 0 {
+		return nil
+	}
+	freeSpaces := make(map[models.NodeID]uint64)
+	for nodeID, capacity := range capacities {
+		freeSpaces[nodeID] = capacity.Free
+	}
+	return freeSpaces
+}
+
 // GetShardAssign returns shard assignment by database name, return not exist err if it's not exist.
 func (m *stateManager) GetShardAssign(databaseName string) (*models.ShardAssignment, error) {
 	data, err := m.masterRepo.Get(m.ctx, constants.GetDatabaseAssignPath(databaseName))
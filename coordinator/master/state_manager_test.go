@@ -368,6 +368,7 @@ func TestStateManager_createShardAssign(t *testing.T) {
 	storage.EXPECT().Close().AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
+	storage.EXPECT().GetNodeCapacities().Return(nil, fmt.Errorf("err")).AnyTimes()
 	// case 1: get live nodes err
 	storage.EXPECT().GetLiveNodes().Return(nil, fmt.Errorf("err"))
 	shardAssign, err := mgr1.createShardAssignment(storage, &models.Database{Name: "test"}, -1, -1)
@@ -417,6 +418,9 @@ func TestStateManager_modifyShardAssign(t *testing.T) {
 	storage.EXPECT().Close().AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
+	storage.EXPECT().GetNodeCapacities().Return(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Free: 100}, 2: {Total: 100, Free: 50}, 3: {Total: 100, Free: 10},
+	}, nil).AnyTimes()
 	// case 1: no impl
 	assert.Panics(t, func() {
 		_ = mgr1.modifyShardAssignment(storage,
@@ -641,3 +645,122 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 	})
 	time.Sleep(100 * time.Millisecond)
 }
+
+func TestStateManager_RepairPlacement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		ctrl.Finish()
+	}()
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	assignData := encoding.JSONMarshal(&models.ShardAssignment{
+		Name:   "test",
+		Shards: map[models.ShardID]*models.Replica{0: {Replicas: []models.NodeID{1, 2}}},
+	})
+	liveNodes := []models.StatefulNode{
+		{ID: 1, StatelessNode: models.StatelessNode{Labels: map[string]string{models.NodeLabelZone: "a"}}},
+		{ID: 2, StatelessNode: models.StatelessNode{Labels: map[string]string{models.NodeLabelZone: "a"}}},
+		{ID: 3, StatelessNode: models.StatelessNode{Labels: map[string]string{models.NodeLabelZone: "b"}}},
+	}
+	// case 1: database not found
+	moves, err := mgr.RepairPlacement("test")
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 2: storage not found
+	mgr1.databases["test"] = &models.Database{Name: "test", Storage: "test"}
+	moves, err = mgr.RepairPlacement("test")
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	mgr1.storages["test"] = storage
+	// case 3: get shard assign err
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	moves, err = mgr.RepairPlacement("test")
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 4: get live nodes err
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(assignData, nil).AnyTimes()
+	storage.EXPECT().GetLiveNodes().Return(nil, fmt.Errorf("err"))
+	moves, err = mgr.RepairPlacement("test")
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 5: no moves
+	storage.EXPECT().GetLiveNodes().Return(liveNodes[:2], nil)
+	moves, err = mgr.RepairPlacement("test")
+	assert.NoError(t, err)
+	assert.Nil(t, moves)
+	storage.EXPECT().GetLiveNodes().Return(liveNodes, nil).AnyTimes()
+	// case 6: save shard assign err
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	moves, err = mgr.RepairPlacement("test")
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	moves, err = mgr.RepairPlacement("test")
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 7: ok
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil)
+	moves, err = mgr.RepairPlacement("test")
+	assert.NoError(t, err)
+	assert.Equal(t, models.PlacementMoves{{ShardID: 0, From: 2, To: 3}}, moves)
+	mgr.Close()
+}
+
+func TestStateManager_GetPlacementSuggestions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		ctrl.Finish()
+	}()
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	assignData := encoding.JSONMarshal(&models.ShardAssignment{
+		Name: "test",
+		Shards: map[models.ShardID]*models.Replica{
+			0: {Replicas: []models.NodeID{1, 2}},
+			1: {Replicas: []models.NodeID{1, 2}},
+		},
+	})
+	liveNodes := []models.StatefulNode{{ID: 1}, {ID: 2}, {ID: 3}}
+	// case 1: database not found
+	moves, err := mgr.GetPlacementSuggestions("test", 0.2)
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 2: storage not found
+	mgr1.databases["test"] = &models.Database{Name: "test", Storage: "test"}
+	moves, err = mgr.GetPlacementSuggestions("test", 0.2)
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	mgr1.storages["test"] = storage
+	// case 3: get shard assign err
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	moves, err = mgr.GetPlacementSuggestions("test", 0.2)
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 4: get live nodes err
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(assignData, nil).AnyTimes()
+	storage.EXPECT().GetLiveNodes().Return(nil, fmt.Errorf("err"))
+	moves, err = mgr.GetPlacementSuggestions("test", 0.2)
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	storage.EXPECT().GetLiveNodes().Return(liveNodes, nil).AnyTimes()
+	// case 5: get capacities err
+	storage.EXPECT().GetNodeCapacities().Return(nil, fmt.Errorf("err"))
+	moves, err = mgr.GetPlacementSuggestions("test", 0.2)
+	assert.Error(t, err)
+	assert.Nil(t, moves)
+	// case 6: ok
+	storage.EXPECT().GetNodeCapacities().Return(map[models.NodeID]models.NodeCapacity{
+		1: {Total: 100, Used: 80}, 2: {Total: 100, Used: 80}, 3: {Total: 100}, 4: {Total: 100},
+	}, nil)
+	moves, err = mgr.GetPlacementSuggestions("test", 0.2)
+	assert.NoError(t, err)
+	assert.Equal(t, models.PlacementMoves{{ShardID: 0, From: 1, To: 3}}, moves)
+	mgr.Close()
+}
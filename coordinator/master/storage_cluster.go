@@ -20,6 +20,7 @@ package master
 import (
 	"context"
 	"encoding/json"
+	"path"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
@@ -46,6 +47,8 @@ type StorageCluster interface {
 	GetState() *models.StorageState
 	// GetLiveNodes returns the current live nodes of storage cluster.
 	GetLiveNodes() ([]models.StatefulNode, error)
+	// GetNodeCapacities returns the disk capacity of storage nodes which reported by storage node.
+	GetNodeCapacities() (map[models.NodeID]models.NodeCapacity, error)
 	// FlushDatabase submits the coordinator task for flushing memory database by name
 	FlushDatabase(databaseName string) error
 	// SaveDatabaseAssignment saves database assignment in storage state repo.
@@ -141,6 +144,24 @@ func (c *storageCluster) GetLiveNodes() (rs []models.StatefulNode, err error) {
 	return rs, nil
 }
 
+// GetNodeCapacities returns the disk capacity of storage nodes which reported by storage node.
+func (c *storageCluster) GetNodeCapacities() (map[models.NodeID]models.NodeCapacity, error) {
+	kvs, err := c.storageRepo.List(c.ctx, constants.NodeCapacityPath)
+	if err != nil {
+		return nil, err
+	}
+	rs := make(map[models.NodeID]models.NodeCapacity)
+	for _, kv := range kvs {
+		capacity := models.NodeCapacity{}
+		if err := json.Unmarshal(kv.Value, &capacity); err != nil {
+			return nil, err
+		}
+		_, node := path.Split(kv.Key)
+		rs[models.ParseNodeID(node)] = capacity
+	}
+	return rs, nil
+}
+
 // GetRepo returns current storage cluster's state repo
 func (c *storageCluster) GetRepo() state.Repository {
 	return c.storageRepo
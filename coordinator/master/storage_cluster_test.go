@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -176,6 +177,35 @@ func TestStorageCluster_listLiveNodes(t *testing.T) {
 	}
 }
 
+func TestStorageCluster_GetNodeCapacities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		ctrl.Finish()
+	}()
+	repo := state.NewMockRepository(ctrl)
+	sc := &storageCluster{
+		storageRepo: repo,
+	}
+	// case 1: list err
+	repo.EXPECT().List(gomock.Any(), constants.NodeCapacityPath).Return(nil, fmt.Errorf("err"))
+	rs, err := sc.GetNodeCapacities()
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// case 2: unmarshal err
+	repo.EXPECT().List(gomock.Any(), constants.NodeCapacityPath).Return([]state.KeyValue{{Value: []byte{1, 2, 3}}}, nil)
+	rs, err = sc.GetNodeCapacities()
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// case 3: ok
+	capacity := models.NodeCapacity{Total: 100, Used: 10, Free: 90}
+	repo.EXPECT().List(gomock.Any(), constants.NodeCapacityPath).Return([]state.KeyValue{
+		{Key: constants.GetNodeCapacityPath("12"), Value: encoding.JSONMarshal(&capacity)},
+	}, nil)
+	rs, err = sc.GetNodeCapacities()
+	assert.NoError(t, err)
+	assert.Equal(t, map[models.NodeID]models.NodeCapacity{12: capacity}, rs)
+}
+
 func TestStorageCluster_close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -19,10 +19,12 @@ package root
 
 import (
 	"context"
+	"math/rand"
 	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -77,6 +79,11 @@ type stateManager struct {
 		repoFactory statepkg.RepositoryFactory) (cluster BrokerCluster, err error)
 	// connection manager
 	connectionManager rpc.ConnectionManager
+	// database => replica group => sticky broker cluster
+	stickyRoutes map[string]map[string]string
+	// random source of weighted routing, global source is reseeded when building physical plan
+	random       *rand.Rand
+	routingMutex sync.Mutex
 
 	mutex sync.RWMutex
 
@@ -99,6 +106,8 @@ func NewStateManager(
 		databases:          make(map[string]*models.LogicDatabase),
 		events:             make(chan *discovery.Event, 10),
 		nodes:              make(map[string]models.StatelessNode),
+		stickyRoutes:       make(map[string]map[string]string),
+		random:             rand.New(rand.NewSource(time.Now().UnixNano())),
 		running:            atomic.NewBool(true),
 		connectionManager:  connectionManager,
 		statistics:         metrics.NewStateManagerStatistics(linmetric.RootRegistry),
@@ -131,18 +140,132 @@ func (s *stateManager) Choose(database string, numOfNodes int) ([]*models.Physic
 		return defalutValue
 	}
 
-	routers := databaseCfg.Routers
-	for _, router := range routers {
-		broker, ok := s.brokers[router.Broker]
+	// group routers by replica group, routers in same group are replicas(hold same data), choose one of them,
+	// router without replica group is a group by itself.
+	var groups [][]models.Router
+	groupIdx := make(map[string]int)
+	for _, router := range databaseCfg.Routers {
+		if router.ReplicaGroup == "" {
+			groups = append(groups, []models.Router{router})
+			continue
+		}
+		idx, ok := groupIdx[router.ReplicaGroup]
 		if !ok {
-			s.logger.Warn("broker cluster is offline, will ingore this cluster", logger.String("broker", router.Broker))
+			idx = len(groups)
+			groupIdx[router.ReplicaGroup] = idx
+			groups = append(groups, nil)
+		}
+		groups[idx] = append(groups[idx], router)
+	}
+	// multi routers(routing values) may point to same database of broker cluster, query it only once
+	targets := make(map[string]struct{})
+	for _, replicas := range groups {
+		router, liveNodes, available := s.route(databaseCfg, replicas)
+		if !available {
+			s.logger.Warn("no available broker cluster for router, will ignore this router",
+				logger.String("database", database), logger.String("replicaGroup", replicas[0].ReplicaGroup),
+				logger.String("broker", replicas[0].Broker))
 			continue
 		}
-		rs = append(rs, flow.BuildPhysicalPlan(getDatabase(router.Database, database), broker.GetState().GetLiveNodes(), numOfNodes))
+		targetDatabase := getDatabase(router.Database, database)
+		target := router.Broker + "/" + targetDatabase
+		if _, exist := targets[target]; exist {
+			continue
+		}
+		targets[target] = struct{}{}
+		physicalPlan := flow.BuildPhysicalPlan(targetDatabase, liveNodes, numOfNodes)
+		physicalPlan.Cluster = router.Broker
+		rs = append(rs, physicalPlan)
 	}
 	return rs, nil
 }
 
+// route chooses an available broker cluster from the routers of replica group,
+// the broker cluster which is drained(weight 0), offline or has no live nodes is unavailable.
+func (s *stateManager) route(databaseCfg *models.LogicDatabase,
+	routers []models.Router,
+) (router models.Router, liveNodes []models.StatelessNode, available bool) {
+	var (
+		candidates     []models.Router
+		candidateNodes [][]models.StatelessNode
+	)
+	for _, r := range routers {
+		if r.GetWeight() == 0 {
+			s.logger.Info("broker cluster is drained, will ingore this cluster", logger.String("broker", r.Broker))
+			continue
+		}
+		broker, ok := s.brokers[r.Broker]
+		if !ok {
+			s.logger.Warn("broker cluster is offline, will ingore this cluster", logger.String("broker", r.Broker))
+			continue
+		}
+		nodes := broker.GetState().GetLiveNodes()
+		if len(nodes) == 0 {
+			s.logger.Warn("broker cluster has no live nodes, will ingore this cluster", logger.String("broker", r.Broker))
+			continue
+		}
+		candidates = append(candidates, r)
+		candidateNodes = append(candidateNodes, nodes)
+	}
+	if len(candidates) == 0 {
+		return models.Router{}, nil, false
+	}
+	idx := 0
+	switch {
+	case routers[0].ReplicaGroup == "":
+		// router without replica group, no other replica to choose
+	case databaseCfg.Sticky:
+		idx = s.stickyRoute(databaseCfg.Name, routers[0].ReplicaGroup, candidates)
+	case len(candidates) > 1:
+		idx = s.weightedRoute(candidates)
+	}
+	return candidates[idx], candidateNodes[idx], true
+}
+
+// stickyRoute returns the index of sticky router if it is available,
+// else fails over to the first available router in routers order.
+func (s *stateManager) stickyRoute(database, replicaGroup string, candidates []models.Router) int {
+	s.routingMutex.Lock()
+	defer s.routingMutex.Unlock()
+
+	routes, ok := s.stickyRoutes[database]
+	if !ok {
+		routes = make(map[string]string)
+		s.stickyRoutes[database] = routes
+	}
+	sticky, ok := routes[replicaGroup]
+	if ok {
+		for idx := range candidates {
+			if candidates[idx].Broker == sticky {
+				return idx
+			}
+		}
+		s.logger.Warn("sticky broker cluster is unavailable, fail over to next broker cluster",
+			logger.String("database", database), logger.String("replicaGroup", replicaGroup),
+			logger.String("from", sticky), logger.String("to", candidates[0].Broker))
+	}
+	routes[replicaGroup] = candidates[0].Broker
+	return 0
+}
+
+// weightedRoute returns the index of router chosen randomly by routing weight.
+func (s *stateManager) weightedRoute(candidates []models.Router) int {
+	total := 0
+	for idx := range candidates {
+		total += candidates[idx].GetWeight()
+	}
+	s.routingMutex.Lock()
+	n := s.random.Intn(total)
+	s.routingMutex.Unlock()
+	for idx := range candidates {
+		n -= candidates[idx].GetWeight()
+		if n < 0 {
+			return idx
+		}
+	}
+	return len(candidates) - 1
+}
+
 // EmitEvent emits discovery event when state changed.
 func (s *stateManager) EmitEvent(event *discovery.Event) {
 	s.events <- event
@@ -251,6 +374,7 @@ func (s *stateManager) onDatabaseCfgChange(key string, data []byte) error {
 		return err
 	}
 	s.databases[cfg.Name] = cfg
+	s.resetStickyRoutes(cfg.Name)
 	return nil
 }
 
@@ -260,6 +384,14 @@ func (s *stateManager) onDatabaseCfgDelete(key string) {
 		logger.String("key", key))
 	name := strings.TrimPrefix(key, constants.GetDatabaseConfigPath(""))
 	delete(s.databases, name)
+	s.resetStickyRoutes(name)
+}
+
+// resetStickyRoutes resets the sticky routes of database after database config changed.
+func (s *stateManager) resetStickyRoutes(database string) {
+	s.routingMutex.Lock()
+	delete(s.stickyRoutes, database)
+	s.routingMutex.Unlock()
 }
 
 // onNodeStartup triggers when root node online.
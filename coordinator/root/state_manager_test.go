@@ -20,6 +20,7 @@ package root
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -301,12 +302,126 @@ func TestStateManager_Choose(t *testing.T) {
 	broker := NewMockBrokerCluster(ctrl)
 	mgr.brokers["broker"] = broker
 	mgr.mutex.Unlock()
+	// broker cluster has no live nodes
 	broker.EXPECT().GetState().Return(&models.BrokerState{}).Times(2)
 	plan, err = mgr.Choose("test", 1)
 	assert.NoError(t, err)
+	assert.Len(t, plan, 0)
+
+	liveState := &models.BrokerState{LiveNodes: map[string]models.StatelessNode{"1.1.1.1:9000": {HostIP: "1.1.1.1"}}}
+	broker.EXPECT().GetState().Return(liveState).Times(2)
+	plan, err = mgr.Choose("test", 1)
+	assert.NoError(t, err)
+	// routers point to same database of broker cluster
+	assert.Len(t, plan, 1)
+	assert.Equal(t, "broker", plan[0].Cluster)
+	assert.Equal(t, "test", plan[0].Database)
+
+	mgr.mutex.Lock()
+	mgr.databases["test"] = &models.LogicDatabase{
+		Routers: []models.Router{
+			{Broker: "broker", Key: "region", Values: []string{"a"}},
+			{Broker: "broker", Key: "region", Values: []string{"b"}, Database: "test2"},
+		},
+	}
+	mgr.mutex.Unlock()
+	broker.EXPECT().GetState().Return(liveState).Times(2)
+	plan, err = mgr.Choose("test", 1)
+	assert.NoError(t, err)
 	assert.Len(t, plan, 2)
 }
 
+func TestStateManager_Choose_Routing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	liveState := &models.BrokerState{LiveNodes: map[string]models.StatelessNode{"1.1.1.1:9000": {HostIP: "1.1.1.1"}}}
+	brokerA := NewMockBrokerCluster(ctrl)
+	brokerB := NewMockBrokerCluster(ctrl)
+	mgr := &stateManager{
+		logger:       logger.GetLogger("Test", "StateManager"),
+		databases:    make(map[string]*models.LogicDatabase),
+		brokers:      map[string]BrokerCluster{"a": brokerA, "b": brokerB},
+		stickyRoutes: make(map[string]map[string]string),
+		random:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	weight := 3
+	drain := 0
+	routers := []models.Router{
+		{Broker: "a", Key: "region", Values: []string{"x", "y"}, ReplicaGroup: "xy"},
+		{Broker: "b", Key: "region", Values: []string{"y", "x"}, ReplicaGroup: "xy", Weight: &weight},
+	}
+	choose := func() string {
+		plan, err := mgr.Choose("test", 1)
+		assert.NoError(t, err)
+		assert.Len(t, plan, 1)
+		return plan[0].Cluster
+	}
+
+	t.Run("weighted routing", func(t *testing.T) {
+		mgr.databases["test"] = &models.LogicDatabase{Name: "test", Routers: routers}
+		brokerA.EXPECT().GetState().Return(liveState).AnyTimes()
+		brokerB.EXPECT().GetState().Return(liveState).AnyTimes()
+		clusters := make(map[string]int)
+		for i := 0; i < 200; i++ {
+			clusters[choose()]++
+		}
+		assert.Len(t, clusters, 2)
+		assert.Greater(t, clusters["b"], clusters["a"])
+	})
+	t.Run("drain cluster", func(t *testing.T) {
+		mgr.databases["test"] = &models.LogicDatabase{Name: "test", Routers: []models.Router{
+			routers[0],
+			{Broker: "b", Key: "region", Values: []string{"y", "x"}, ReplicaGroup: "xy", Weight: &drain},
+		}}
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, "a", choose())
+		}
+	})
+	t.Run("routers without replica group", func(t *testing.T) {
+		mgr.databases["test"] = &models.LogicDatabase{Name: "test", Routers: []models.Router{
+			{Broker: "a", Key: "region", Values: []string{"x"}},
+			{Broker: "b", Key: "region", Values: []string{"x"}},
+		}}
+		plan, err := mgr.Choose("test", 1)
+		assert.NoError(t, err)
+		assert.Len(t, plan, 2)
+	})
+	t.Run("sticky routing with failover", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		brokerA := NewMockBrokerCluster(ctrl)
+		brokerB := NewMockBrokerCluster(ctrl)
+		mgr.brokers = map[string]BrokerCluster{"a": brokerA, "b": brokerB}
+		mgr.databases["test"] = &models.LogicDatabase{Name: "test", Routers: routers, Sticky: true}
+		brokerB.EXPECT().GetState().Return(liveState).AnyTimes()
+
+		brokerA.EXPECT().GetState().Return(liveState).Times(2)
+		assert.Equal(t, "a", choose())
+		assert.Equal(t, "a", choose())
+		// fail over
+		brokerA.EXPECT().GetState().Return(&models.BrokerState{})
+		assert.Equal(t, "b", choose())
+		// keep sticky route after cluster recovered
+		brokerA.EXPECT().GetState().Return(liveState)
+		assert.Equal(t, "b", choose())
+		// reset sticky route after database config changed
+		mgr.resetStickyRoutes("test")
+		brokerA.EXPECT().GetState().Return(liveState)
+		assert.Equal(t, "a", choose())
+	})
+	t.Run("no available cluster", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		brokerA := NewMockBrokerCluster(ctrl)
+		mgr.brokers = map[string]BrokerCluster{"a": brokerA}
+		brokerA.EXPECT().GetState().Return(&models.BrokerState{})
+		plan, err := mgr.Choose("test", 1)
+		assert.NoError(t, err)
+		assert.Empty(t, plan)
+	})
+}
+
 func TestStateManager_Node(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -34,6 +34,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/tsdb"
 )
@@ -57,6 +58,8 @@ type StateManager interface {
 	GetLiveNodes() []models.StatefulNode
 	// GetDatabaseAssignments returns the current database assignments.
 	GetDatabaseAssignments() []*models.DatabaseAssignment
+	// GetDatabaseLimitsState returns the state of database's limits applied on current node.
+	GetDatabaseLimitsState(name string) *models.LimitsState
 }
 
 // stateManager implements StateManager.
@@ -69,6 +72,7 @@ type stateManager struct {
 	nodes               map[models.NodeID]models.StatefulNode // storage live nodes
 	watches             map[models.NodeID][]func(state models.NodeStateType)
 	databaseAssignments map[string]*models.DatabaseAssignment
+	limitsStates        sync.Map // database => *models.LimitsState
 
 	events chan *discovery.Event
 
@@ -176,6 +180,10 @@ func (m *stateManager) onDatabaseLimitsChange(key string, data []byte) error {
 		return err
 	}
 	m.engine.SetDatabaseLimits(name, limits)
+	m.limitsStates.Store(name, &models.LimitsState{
+		Revision:  models.LimitsRevision(data),
+		AppliedAt: timeutil.Now(),
+	})
 	return nil
 }
 
@@ -308,6 +316,17 @@ func (m *stateManager) GetLiveNodes() (rs []models.StatefulNode) {
 	return
 }
 
+// GetDatabaseLimitsState returns the state of database's limits applied on current node.
+func (m *stateManager) GetDatabaseLimitsState(name string) *models.LimitsState {
+	val, ok := m.limitsStates.Load(name)
+	if !ok {
+		// default limits
+		return &models.LimitsState{}
+	}
+	state := *(val.(*models.LimitsState))
+	return &state
+}
+
 // GetDatabaseAssignments returns the current database assignments.
 func (m *stateManager) GetDatabaseAssignments() (rs []*models.DatabaseAssignment) {
 	m.mutex.RLock()
@@ -206,4 +206,8 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 		Value: []byte(models.NewDefaultLimits().TOML()),
 	})
 	time.Sleep(100 * time.Millisecond)
+	state := mgr.GetDatabaseLimitsState("db2")
+	assert.Equal(t, models.LimitsRevision([]byte(models.NewDefaultLimits().TOML())), state.Revision)
+	assert.NotZero(t, state.AppliedAt)
+	assert.Equal(t, &models.LimitsState{}, mgr.GetDatabaseLimitsState("test"))
 }
@@ -30,6 +30,7 @@ import (
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
@@ -39,12 +40,19 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 )
 
+// cancelCheckInterval represents the number of series loaded between two cancellation checks.
+const cancelCheckInterval = 64
+
 // TaskContext represents task execute context.
 type TaskContext struct {
 	Ctx    context.Context
 	Cancel context.CancelFunc
 
 	Start time.Time
+
+	// credits of partial result streams(receiver => credits), scoped to the task
+	credits map[string]*ResultCredits
+	mutex   sync.Mutex
 }
 
 // NewTaskContextWithTimeout creates a task context with timeout.
@@ -57,11 +65,47 @@ func NewTaskContextWithTimeout(ctx context.Context, timeout time.Duration) *Task
 	}
 }
 
+// ResultCredits returns the credits of partial result stream which sends to receiver,
+// creates the credits with window size if not exist.
+func (ctx *TaskContext) ResultCredits(receiver string, window int) *ResultCredits {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	if ctx.credits == nil {
+		ctx.credits = make(map[string]*ResultCredits)
+	}
+	credits, ok := ctx.credits[receiver]
+	if !ok {
+		credits = newResultCredits(window)
+		ctx.credits[receiver] = credits
+	}
+	return credits
+}
+
+// GrantResultCredits grants credits to the partial result stream which sends to receiver,
+// ignores if stream not exist.
+func (ctx *TaskContext) GrantResultCredits(receiver string, credits int32) {
+	ctx.mutex.Lock()
+	c, ok := ctx.credits[receiver]
+	ctx.mutex.Unlock()
+	if ok {
+		c.grant(credits)
+	}
+}
+
 // Release releases context's resource after query.
 func (ctx *TaskContext) Release() {
 	ctx.Cancel()
 }
 
+// Err returns the error if task context is canceled(timeout), else returns nil.
+func (ctx *TaskContext) Err() error {
+	if ctx == nil || ctx.Ctx == nil {
+		return nil
+	}
+	return ctx.Ctx.Err()
+}
+
 // StorageExecuteContext represents storage level query execute context.
 type StorageExecuteContext struct {
 	TaskCtx       *TaskContext
@@ -90,10 +134,43 @@ type StorageExecuteContext struct {
 	GroupByTagKeyIDs []tag.KeyID
 	// for group by query store tag value ids for each group tag key
 	GroupingTagValueIDs []*roaring.Bitmap
+	// tag keys of count distinct function, collects tag value ids of them for each group under storage node
+	DistinctTagKeys   []string
+	DistinctTagKeyIDs []tag.KeyID
+
+	queryShape     string
+	queryShapeOnce sync.Once
+
+	tagMetaSnapshot      TagMetadataSnapshot // tag metadata snapshot acquired once for query, closed when releasing
+	tagMetaSnapshotMutex sync.Mutex          // not share mutex of collecting, releases context when collect failure
 
 	mutex sync.Mutex
 }
 
+// QueryShape returns the normalized query(without time range), be used as the key of leaf query result cache,
+// so that the panel refreshes with same query but sliding time range can share the result of same family.
+func (ctx *StorageExecuteContext) QueryShape() string {
+	ctx.queryShapeOnce.Do(func() {
+		q := *ctx.Query
+		q.TimeRange = timeutil.TimeRange{}
+		q.Explain = false
+		ctx.queryShape = string(encoding.JSONMarshal(&q))
+	})
+	return ctx.queryShape
+}
+
+// TagMetadataSnapshot returns the tag metadata snapshot of query, acquires it by given function for first call,
+// so that all tag value reads of the query(tag filter lookup/tag value collect) see the same version of tag metadata.
+func (ctx *StorageExecuteContext) TagMetadataSnapshot(acquire func() TagMetadataSnapshot) TagMetadataSnapshot {
+	ctx.tagMetaSnapshotMutex.Lock()
+	defer ctx.tagMetaSnapshotMutex.Unlock()
+
+	if ctx.tagMetaSnapshot == nil {
+		ctx.tagMetaSnapshot = acquire()
+	}
+	return ctx.tagMetaSnapshot
+}
+
 // CollectTagValues collects tag value with lock.
 func (ctx *StorageExecuteContext) CollectTagValues(fn func()) {
 	ctx.mutex.Lock()
@@ -156,7 +233,16 @@ func (ctx *StorageExecuteContext) Release() {
 			shardCtx.Release()
 		}
 	}
-	ctx.TaskCtx.Release()
+	ctx.tagMetaSnapshotMutex.Lock()
+	tagMetaSnapshot := ctx.tagMetaSnapshot
+	ctx.tagMetaSnapshot = nil
+	ctx.tagMetaSnapshotMutex.Unlock()
+	if tagMetaSnapshot != nil {
+		_ = tagMetaSnapshot.Close()
+	}
+	if ctx.TaskCtx != nil {
+		ctx.TaskCtx.Release()
+	}
 }
 
 // TagFilterResult represents the tag filter result, include tag key id and tag value ids.
@@ -224,6 +310,9 @@ type ShardExecuteContext struct {
 
 	GroupingContext         GroupingContext // after get grouping context if it has grouping query
 	SeriesIDsAfterFiltering *roaring.Bitmap // after data filter
+
+	indexSnapshot IndexSnapshot // index snapshot acquired once for query, closed when releasing
+	mutex         sync.Mutex
 }
 
 // NewShardExecuteContext creates a shard execute context.
@@ -243,11 +332,31 @@ func (ctx *ShardExecuteContext) IsSeriesIDsEmpty() bool {
 	return ctx.SeriesIDsAfterFiltering.IsEmpty()
 }
 
+// IndexSnapshot returns the index snapshot of shard query, acquires it by given function for first call,
+// so that all index reads of the query(filtering/grouping) see the same version of index.
+func (ctx *ShardExecuteContext) IndexSnapshot(acquire func() IndexSnapshot) IndexSnapshot {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	if ctx.indexSnapshot == nil {
+		ctx.indexSnapshot = acquire()
+	}
+	return ctx.indexSnapshot
+}
+
 // Release releases shard context's resource after query.
 func (ctx *ShardExecuteContext) Release() {
 	if ctx.TimeSegmentContext != nil {
 		ctx.TimeSegmentContext.Release()
 	}
+	ctx.mutex.Lock()
+	indexSnapshot := ctx.indexSnapshot
+	ctx.indexSnapshot = nil
+	ctx.mutex.Unlock()
+
+	if indexSnapshot != nil {
+		_ = indexSnapshot.Close()
+	}
 }
 
 // GroupingSeriesAgg represents grouping series aggregator.
@@ -255,6 +364,35 @@ type GroupingSeriesAgg struct {
 	Key         string
 	Aggregator  aggregation.SeriesAggregator // for single field query
 	Aggregators aggregation.FieldAggregates  // for multi fields query
+	Distincts   []DistinctTagValueIDs        // for count distinct query, tag value ids of each distinct tag key
+
+	mutex sync.Mutex
+}
+
+// mergeDistincts merges the tag value ids of distinct tag keys, which collected by data load task.
+func (agg *GroupingSeriesAgg) mergeDistincts(distincts []DistinctTagValueIDs) {
+	agg.mutex.Lock()
+	defer agg.mutex.Unlock()
+
+	if agg.Distincts == nil {
+		agg.Distincts = distincts
+		return
+	}
+	for idx, distinct := range distincts {
+		agg.Distincts[idx].Merge(distinct)
+	}
+}
+
+// reduceDistincts reduces the tag value ids of distinct tag keys.
+func (agg *GroupingSeriesAgg) reduceDistincts(reduceFn func(key string, distincts []DistinctTagValueIDs)) {
+	agg.mutex.Lock()
+	distincts := agg.Distincts
+	agg.Distincts = nil
+	agg.mutex.Unlock()
+
+	if len(distincts) > 0 {
+		reduceFn(agg.Key, distincts)
+	}
 }
 
 // reduce aggregator's result set.
@@ -288,8 +426,13 @@ type DataLoadContext struct {
 	GroupingSeriesAgg        []*GroupingSeriesAgg
 	groupingSeriesAggRefIdx  uint16
 
+	// tag value ids of count distinct tag keys, tag key index => series index => tag value id(0 if tag not exist)
+	DistinctTagValueIDs [][]uint32
+
 	Decoder      *encoding.TSDDecoder
 	DownSampling func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter)
+	// LoadSketch merges the histogram sketches of time slots into the aggregator of sketch field.
+	LoadSketch func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, sketches *ddsketch.SlotSketches)
 
 	PendingDataLoadTasks *atomic.Int32
 }
@@ -364,19 +507,21 @@ func (ctx *DataLoadContext) HasGroupingData() bool {
 
 // GetSeriesAggregator gets series aggregator with low series id and field index.
 func (ctx *DataLoadContext) GetSeriesAggregator(lowSeriesIdx uint16, fieldIdx int) (result aggregation.SeriesAggregator) {
-	var groupingSeriesAgg *GroupingSeriesAgg
-	if ctx.IsGrouping {
-		groupingSeriesIdx := ctx.GroupingSeriesAggRefs[lowSeriesIdx]
-		groupingSeriesAgg = ctx.GroupingSeriesAgg[groupingSeriesIdx]
-	} else {
-		groupingSeriesAgg = ctx.WithoutGroupingSeriesAgg
-	}
+	groupingSeriesAgg := ctx.getGroupingSeriesAgg(lowSeriesIdx)
 	if ctx.IsMultiField {
 		return groupingSeriesAgg.Aggregators[fieldIdx]
 	}
 	return groupingSeriesAgg.Aggregator
 }
 
+// getGroupingSeriesAgg returns the grouping series aggregator which low series id belongs to.
+func (ctx *DataLoadContext) getGroupingSeriesAgg(lowSeriesIdx uint16) *GroupingSeriesAgg {
+	if ctx.IsGrouping {
+		return ctx.GroupingSeriesAgg[ctx.GroupingSeriesAggRefs[lowSeriesIdx]]
+	}
+	return ctx.WithoutGroupingSeriesAgg
+}
+
 // Grouping prepares context for grouping query.
 func (ctx *DataLoadContext) Grouping() {
 	min := ctx.LowSeriesIDsContainer.Minimum()
@@ -404,6 +549,7 @@ func (ctx *DataLoadContext) IterateLowSeriesIDs(lowSeriesIDsFromStorage roaring.
 	lowSeriesIDs := ctx.LowSeriesIDs
 	it := lowSeriesIDsFromStorage.PeekableIterator()
 	seriesIdxFromStorage := 0
+	loaded := 0
 	for it.HasNext() {
 		seriesID := it.Next()
 		if seriesID > max {
@@ -415,6 +561,11 @@ func (ctx *DataLoadContext) IterateLowSeriesIDs(lowSeriesIDsFromStorage roaring.
 		}
 		seriesIdxFromQuery := seriesID - min
 		if lowSeriesIDs[seriesIdxFromQuery] == seriesID {
+			// check cancellation at block boundary, stop loading if query timeout
+			if loaded%cancelCheckInterval == 0 && ctx.Err() != nil {
+				return
+			}
+			loaded++
 			// match low series invoke callback
 			fn(seriesIdxFromQuery, seriesIdxFromStorage)
 		}
@@ -422,6 +573,14 @@ func (ctx *DataLoadContext) IterateLowSeriesIDs(lowSeriesIDsFromStorage roaring.
 	}
 }
 
+// Err returns the error if query task is canceled(timeout), else returns nil.
+func (ctx *DataLoadContext) Err() error {
+	if ctx.ShardExecuteCtx == nil || ctx.ShardExecuteCtx.StorageExecuteCtx == nil {
+		return nil
+	}
+	return ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx.Err()
+}
+
 // Reduce reduces down sampling result.
 func (ctx *DataLoadContext) Reduce(reduceFn func(it series.GroupedIterator)) {
 	if ctx.IsGrouping {
@@ -433,6 +592,17 @@ func (ctx *DataLoadContext) Reduce(reduceFn func(it series.GroupedIterator)) {
 	}
 }
 
+// ReduceDistinct reduces the tag value ids of count distinct tag keys for each group.
+func (ctx *DataLoadContext) ReduceDistinct(reduceFn func(key string, distincts []DistinctTagValueIDs)) {
+	if ctx.IsGrouping {
+		for _, groupAgg := range ctx.GroupingSeriesAgg {
+			groupAgg.reduceDistincts(reduceFn)
+		}
+	} else {
+		ctx.WithoutGroupingSeriesAgg.reduceDistincts(reduceFn)
+	}
+}
+
 // TimeSegmentContexts represents the time segment slice in query time range.
 type TimeSegmentContexts []*TimeSegmentResultSet
 
@@ -79,6 +79,22 @@ func TestStorageExecuteContext_CalcSlotRange(t *testing.T) {
 	}, slotRange)
 }
 
+func TestStorageExecuteContext_QueryShape(t *testing.T) {
+	ctx1 := &StorageExecuteContext{
+		Query: &stmt.Query{MetricName: "cpu", TimeRange: timeutil.TimeRange{Start: 10, End: 20}, Explain: true},
+	}
+	ctx2 := &StorageExecuteContext{
+		Query: &stmt.Query{MetricName: "cpu", TimeRange: timeutil.TimeRange{Start: 30, End: 40}},
+	}
+	ctx3 := &StorageExecuteContext{
+		Query: &stmt.Query{MetricName: "cpu", Limit: 10},
+	}
+	assert.Equal(t, ctx1.QueryShape(), ctx2.QueryShape())
+	assert.NotEqual(t, ctx1.QueryShape(), ctx3.QueryShape())
+	// time range of query not changed
+	assert.Equal(t, int64(10), ctx1.Query.TimeRange.Start)
+}
+
 func TestStorageExecuteContext_HasGroupingTagValueIDs(t *testing.T) {
 	ctx := &StorageExecuteContext{
 		GroupingTagValueIDs: make([]*roaring.Bitmap, 2),
@@ -107,6 +123,30 @@ func TestStorageExecuteContext_Release(t *testing.T) {
 	ctx.Release()
 	ctx.ShardContexts[0] = &ShardExecuteContext{}
 	ctx.Release()
+	// task context not set
+	ctx = &StorageExecuteContext{}
+	ctx.Release()
+}
+
+func TestStorageExecuteContext_TagMetadataSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &StorageExecuteContext{}
+	snapshot := NewMockTagMetadataSnapshot(ctrl)
+	acquired := 0
+	acquire := func() TagMetadataSnapshot {
+		acquired++
+		return snapshot
+	}
+	// snapshot only acquired once for query
+	assert.Equal(t, snapshot, ctx.TagMetadataSnapshot(acquire))
+	assert.Equal(t, snapshot, ctx.TagMetadataSnapshot(acquire))
+	assert.Equal(t, 1, acquired)
+	snapshot.EXPECT().Close().Return(nil)
+	ctx.Release()
+	// snapshot only closed once
+	ctx.Release()
 }
 
 func TestTimeSegmentContext_AddFilterResultSet(t *testing.T) {
@@ -151,6 +191,27 @@ func TestShardExecuteContext(t *testing.T) {
 	ctx.Release()
 }
 
+func TestShardExecuteContext_IndexSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := NewShardExecuteContext(&StorageExecuteContext{})
+	snapshot := NewMockIndexSnapshot(ctrl)
+	acquired := 0
+	acquire := func() IndexSnapshot {
+		acquired++
+		return snapshot
+	}
+	// snapshot only acquired once for query
+	assert.Equal(t, snapshot, ctx.IndexSnapshot(acquire))
+	assert.Equal(t, snapshot, ctx.IndexSnapshot(acquire))
+	assert.Equal(t, 1, acquired)
+	snapshot.EXPECT().Close().Return(nil)
+	ctx.Release()
+	// snapshot only closed once
+	ctx.Release()
+}
+
 func TestGroupingSeriesAgg_reduce(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -290,6 +351,29 @@ func TestDataLoadContext_IterateLowSeriesIDs(t *testing.T) {
 	assert.Equal(t, querySeriesIDs, findSeriesIDs)
 }
 
+func TestDataLoadContext_IterateLowSeriesIDs_Canceled(t *testing.T) {
+	taskCtx := NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	taskCtx.Release()
+	querySeriesIDs := roaring.BitmapOf(5, 11, 13)
+	ctx := &DataLoadContext{
+		LowSeriesIDsContainer: querySeriesIDs.GetContainer(0),
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				TaskCtx: taskCtx,
+				Query:   &stmt.Query{},
+			},
+		},
+	}
+	ctx.Grouping()
+	assert.Equal(t, context.Canceled, ctx.Err())
+	found := 0
+	ctx.IterateLowSeriesIDs(querySeriesIDs.GetContainer(0), func(_ uint16, _ int) {
+		found++
+	})
+	assert.Zero(t, found)
+	assert.Nil(t, (&DataLoadContext{}).Err())
+}
+
 func TestDataLoadContext_GetSeriesAggregator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -340,3 +424,19 @@ func TestTimeSegmentContexts(t *testing.T) {
 	}
 	sort.Sort(segments)
 }
+
+func TestTaskContext_ResultCredits(t *testing.T) {
+	taskCtx := NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	defer taskCtx.Release()
+	// stream not exist, ignore it
+	taskCtx.GrantResultCredits("r", 1)
+	credits := taskCtx.ResultCredits("r", 1)
+	assert.Equal(t, credits, taskCtx.ResultCredits("r", 1))
+	assert.NoError(t, credits.Acquire(context.TODO()))
+	// exceeded credits
+	taskCtx.GrantResultCredits("r", 2)
+	assert.NoError(t, credits.Acquire(context.TODO()))
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	assert.Error(t, credits.Acquire(ctx))
+}
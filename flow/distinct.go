@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"github.com/lindb/roaring"
+)
+
+// DistinctTagValueIDs represents the tag value ids of count distinct tag key for each time slot,
+// time slot is the index based on query time range.
+type DistinctTagValueIDs map[int]*roaring.Bitmap
+
+// Add adds the tag value id into the tag value ids of time slot.
+func (d DistinctTagValueIDs) Add(slot int, tagValueID uint32) {
+	tagValueIDs, ok := d[slot]
+	if !ok {
+		tagValueIDs = roaring.New()
+		d[slot] = tagValueIDs
+	}
+	tagValueIDs.Add(tagValueID)
+}
+
+// Merge merges other's tag value ids of each time slot.
+func (d DistinctTagValueIDs) Merge(other DistinctTagValueIDs) {
+	for slot, tagValueIDs := range other {
+		if exist, ok := d[slot]; ok {
+			exist.Or(tagValueIDs)
+		} else {
+			d[slot] = tagValueIDs
+		}
+	}
+}
+
+// DistinctCollector collects the tag value ids of count distinct tag keys for the series which has data in time slot,
+// it is used by one data load task(not thread safe), collected tag value ids are merged into grouping aggregator when flush.
+type DistinctCollector struct {
+	ctx       *DataLoadContext
+	distincts map[*GroupingSeriesAgg][]DistinctTagValueIDs
+}
+
+// NewDistinctCollector creates a DistinctCollector instance, returns nil if not count distinct query.
+func NewDistinctCollector(ctx *DataLoadContext) *DistinctCollector {
+	if len(ctx.DistinctTagValueIDs) == 0 {
+		return nil
+	}
+	return &DistinctCollector{
+		ctx:       ctx,
+		distincts: make(map[*GroupingSeriesAgg][]DistinctTagValueIDs),
+	}
+}
+
+// Collect collects the tag value ids of low series which has data in time slot.
+func (c *DistinctCollector) Collect(lowSeriesIdx uint16, slot int) {
+	groupingSeriesAgg := c.ctx.getGroupingSeriesAgg(lowSeriesIdx)
+	distincts, ok := c.distincts[groupingSeriesAgg]
+	if !ok {
+		distincts = make([]DistinctTagValueIDs, len(c.ctx.DistinctTagValueIDs))
+		for idx := range distincts {
+			distincts[idx] = make(DistinctTagValueIDs)
+		}
+		c.distincts[groupingSeriesAgg] = distincts
+	}
+	for idx, tagValueIDs := range c.ctx.DistinctTagValueIDs {
+		if tagValueID := tagValueIDs[lowSeriesIdx]; tagValueID > 0 {
+			distincts[idx].Add(slot, tagValueID)
+		}
+	}
+}
+
+// Flush merges the collected tag value ids into grouping aggregator.
+func (c *DistinctCollector) Flush() {
+	for groupingSeriesAgg, distincts := range c.distincts {
+		groupingSeriesAgg.mergeDistincts(distincts)
+	}
+	c.distincts = make(map[*GroupingSeriesAgg][]DistinctTagValueIDs)
+}
@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDistinctTagValueIDs(t *testing.T) {
+	d1 := make(DistinctTagValueIDs)
+	d1.Add(0, 1)
+	d1.Add(0, 2)
+	d1.Add(1, 1)
+	d2 := make(DistinctTagValueIDs)
+	d2.Add(1, 3)
+	d2.Add(2, 3)
+	d1.Merge(d2)
+	assert.Equal(t, DistinctTagValueIDs{
+		0: roaring.BitmapOf(1, 2),
+		1: roaring.BitmapOf(1, 3),
+		2: roaring.BitmapOf(3),
+	}, d1)
+}
+
+func TestDistinctCollector(t *testing.T) {
+	newDataLoadCtx := func(isGrouping bool) *DataLoadContext {
+		ctx := &DataLoadContext{
+			IsGrouping: isGrouping,
+			ShardExecuteCtx: &ShardExecuteContext{
+				StorageExecuteCtx: &StorageExecuteContext{
+					DownSamplingSpecs:   aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+					GroupingTagValueIDs: make([]*roaring.Bitmap, 1),
+					Query:               &stmt.Query{},
+				},
+			},
+		}
+		return ctx
+	}
+	assert.Nil(t, NewDistinctCollector(newDataLoadCtx(false)))
+
+	// without grouping
+	ctx := newDataLoadCtx(false)
+	ctx.PrepareAggregatorWithoutGrouping()
+	ctx.DistinctTagValueIDs = [][]uint32{{1, 0, 2}}
+	collector := NewDistinctCollector(ctx)
+	collector.Collect(0, 0)
+	collector.Collect(1, 0) // without tag value
+	collector.Collect(2, 1)
+	collector.Flush()
+	collector.Collect(0, 1)
+	collector.Flush()
+	var distincts []DistinctTagValueIDs
+	ctx.ReduceDistinct(func(key string, rs []DistinctTagValueIDs) {
+		assert.Empty(t, key)
+		distincts = rs
+	})
+	assert.Equal(t, []DistinctTagValueIDs{{0: roaring.BitmapOf(1), 1: roaring.BitmapOf(1, 2)}}, distincts)
+	// reset after reduce
+	ctx.ReduceDistinct(func(_ string, _ []DistinctTagValueIDs) {
+		assert.Fail(t, "reduce empty distinct")
+	})
+
+	// grouping
+	ctx = newDataLoadCtx(true)
+	ctx.NewSeriesAggregator(string([]byte{1, 0, 0, 0}))
+	ctx.NewSeriesAggregator(string([]byte{2, 0, 0, 0}))
+	ctx.GroupingSeriesAggRefs = []uint16{0, 1, 0}
+	ctx.DistinctTagValueIDs = [][]uint32{{1, 2, 3}}
+	collector = NewDistinctCollector(ctx)
+	collector.Collect(0, 0)
+	collector.Collect(1, 0)
+	collector.Collect(2, 0)
+	collector.Flush()
+	rs := make(map[string][]DistinctTagValueIDs)
+	ctx.ReduceDistinct(func(key string, distincts []DistinctTagValueIDs) {
+		rs[key] = distincts
+	})
+	assert.Equal(t, map[string][]DistinctTagValueIDs{
+		string([]byte{1, 0, 0, 0}): {{0: roaring.BitmapOf(1, 3)}},
+		string([]byte{2, 0, 0, 0}): {{0: roaring.BitmapOf(2)}},
+	}, rs)
+}
@@ -48,6 +48,13 @@ type FilterResultSet interface {
 	Close()
 }
 
+// CacheableResultSet represents the filter result set which reads immutable data(flushed sst file),
+// the down sampling result loaded from it can be cached until the file removed(compaction/rollup).
+type CacheableResultSet interface {
+	// File returns the path of sst file which result set reads from.
+	File() string
+}
+
 // DataLoader represents the loader which load metric data from storage.
 type DataLoader interface {
 	// Load loads the metric data by given low series id.
@@ -33,6 +33,8 @@ type GroupingContext interface {
 	// BuildGroup builds the grouped series ids by the high key of series id
 	// and the container includes low keys of series id
 	BuildGroup(ctx *DataLoadContext)
+	// BuildDistinct builds the tag value ids of count distinct tag keys for each low series id
+	BuildDistinct(ctx *DataLoadContext)
 	// ScanTagValueIDs scans grouping context by high key/container of series ids,
 	// then returns grouped tag value ids for each tag key
 	ScanTagValueIDs(highKey uint16, container roaring.Container) []*roaring.Bitmap
@@ -114,6 +116,22 @@ func (g *groupingContext) BuildGroup(ctx *DataLoadContext) {
 	}
 }
 
+// BuildDistinct builds the tag value ids of count distinct tag keys for each low series id,
+// series not grouped by distinct tag keys, so that the tag values are counted without enumerating each series.
+func (g *groupingContext) BuildDistinct(ctx *DataLoadContext) {
+	tagKeys := ctx.ShardExecuteCtx.StorageExecuteCtx.DistinctTagKeyIDs
+	if len(tagKeys) == 0 {
+		return
+	}
+	ctx.DistinctTagValueIDs = make([][]uint32, len(tagKeys))
+	for idx := range tagKeys {
+		ctx.DistinctTagValueIDs[idx] = make([]uint32, len(ctx.LowSeriesIDs))
+	}
+	g.scanTags(ctx, tagKeys, func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32) {
+		ctx.DistinctTagValueIDs[tagKeyIDIdx][seriesIdxFromQuery] = tagValueID
+	})
+}
+
 // buildGroupForMultiTags builds grouping for multi-tags.
 func (g *groupingContext) buildGroupForMultiTags(ctx *DataLoadContext) {
 	tagSize := len(g.tagKeys)
@@ -165,9 +183,16 @@ func (g *groupingContext) buildGroupForSingleTag(ctx *DataLoadContext) {
 // scanGroupingTags scans grouping tags(series ids=>tag value ids)
 func (g *groupingContext) scanGroupingTags(ctx *DataLoadContext,
 	fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32),
+) {
+	g.scanTags(ctx, g.tagKeys, fn)
+}
+
+// scanTags scans tags(series ids=>tag value ids) of given tag keys
+func (g *groupingContext) scanTags(ctx *DataLoadContext, tagKeys []tag.KeyID,
+	fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32),
 ) {
 	seriesIDHighKey := ctx.SeriesIDHighKey
-	for tagKeyIdx, tagKey := range g.tagKeys {
+	for tagKeyIdx, tagKey := range tagKeys {
 		scanners := g.scanners[tagKey]
 		for _, scanner := range scanners {
 			lowSeriesIDs, tagValueIDs := scanner.GetSeriesAndTagValue(seriesIDHighKey)
@@ -145,3 +145,30 @@ func TestGroupingContext_ScanTagValueIDs(t *testing.T) {
 	result = ctx.ScanTagValueIDs(1, roaring.BitmapOf(1, 2, 6, 10).GetContainerAtIndex(0))
 	assert.Equal(t, roaring.New(), result[0])
 }
+
+func TestGroupingContext_BuildDistinct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scanner := NewMockGroupingScanner(ctrl)
+	ctx := NewGroupContext(nil, map[tag.KeyID][]GroupingScanner{2: {scanner}})
+	querySeriesIDs := roaring.BitmapOf(1, 2, 6, 10)
+	dataLoadCtx := &DataLoadContext{
+		SeriesIDHighKey:       1,
+		LowSeriesIDsContainer: querySeriesIDs.GetContainerAtIndex(0),
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{},
+		},
+	}
+	dataLoadCtx.Grouping()
+	// no distinct tag keys
+	ctx.BuildDistinct(dataLoadCtx)
+	assert.Nil(t, dataLoadCtx.DistinctTagValueIDs)
+
+	dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.DistinctTagKeyIDs = []tag.KeyID{2}
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(1)).
+		Return(roaring.BitmapOf(1, 2, 3, 10).GetContainerAtIndex(0), []uint32{10, 20, 30, 10})
+	ctx.BuildDistinct(dataLoadCtx)
+	// series id 6 without distinct tag key
+	assert.Equal(t, [][]uint32{{10, 20, 0, 0, 0, 0, 0, 0, 0, 10}}, dataLoadCtx.DistinctTagValueIDs)
+}
@@ -0,0 +1,61 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"context"
+)
+
+// ResultCredits represents the credits of partial result stream(flow control),
+// a credit need be acquired before sending partial result, receiver grants credit after partial result consumed.
+type ResultCredits struct {
+	credits chan struct{}
+}
+
+// newResultCredits creates the credits with window size.
+func newResultCredits(window int) *ResultCredits {
+	c := &ResultCredits{
+		credits: make(chan struct{}, window),
+	}
+	for i := 0; i < window; i++ {
+		c.credits <- struct{}{}
+	}
+	return c
+}
+
+// Acquire acquires a credit for sending partial result, blocks until credit granted or ctx done.
+func (c *ResultCredits) Acquire(ctx context.Context) error {
+	select {
+	case <-c.credits:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// grant returns the credits of consumed partial results.
+func (c *ResultCredits) grant(credits int32) {
+	for i := int32(0); i < credits; i++ {
+		select {
+		case c.credits <- struct{}{}:
+		default:
+			// ignore exceeded credits
+			return
+		}
+	}
+}
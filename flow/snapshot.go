@@ -0,0 +1,61 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"io"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+//go:generate mockgen -source=./snapshot.go -destination=./snapshot_mock.go -package=flow
+
+// IndexSnapshot represents a versioned consistent view of shard's inverted/forward index,
+// acquired once for shard query, all index reads(filtering/grouping) of the query go through it,
+// closed when releasing shard execute context.
+type IndexSnapshot interface {
+	io.Closer
+	// Version returns the version of index when snapshot created.
+	Version() int64
+	// GetGroupingContext returns the context of group by
+	GetGroupingContext(ctx *ShardExecuteContext) error
+	// GetSeriesIDsByTagValueIDs gets series ids by tag value ids for spec tag key of metric,
+	// result is picked from bitmap pool, caller can return it to the pool after using.
+	GetSeriesIDsByTagValueIDs(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap) (*roaring.Bitmap, error)
+	// GetSeriesIDsForTag gets series ids for spec tag key of metric,
+	// result is picked from bitmap pool, caller can return it to the pool after using.
+	GetSeriesIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error)
+}
+
+// TagMetadataSnapshot represents a versioned consistent view of database's tag metadata(tag value trie),
+// acquired once for query, all tag value reads of the query go through it,
+// closed when releasing storage execute context.
+type TagMetadataSnapshot interface {
+	io.Closer
+	// Version returns the version of tag metadata when snapshot created.
+	Version() int64
+	// FindTagValueDsByExpr finds tag value ids by tag filter expr for spec tag key
+	FindTagValueDsByExpr(tagKeyID tag.KeyID, expr stmt.TagFilter) (*roaring.Bitmap, error)
+	// GetTagValueIDsForTag get tag value ids for spec tag key of metric
+	GetTagValueIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error)
+	// CollectTagValues collects the tag values by tag value ids
+	CollectTagValues(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error
+}
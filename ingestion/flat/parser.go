@@ -52,7 +52,7 @@ func Parse(req *http.Request, enrichedTags tag.Tags, namespace string, limits *m
 	bufioReader, releaseBufioReaderFunc := ingestCommon.NewBufioReader(reader)
 	defer releaseBufioReaderFunc(bufioReader)
 
-	batch, err := parseFlatMetric(reader, enrichedTags, namespace, limits)
+	batch, err := parseFlatMetric(bufioReader, enrichedTags, namespace, limits)
 	if err != nil {
 		flatIngestionStatistics.CorruptedData.Incr()
 		return nil, err
@@ -108,6 +108,59 @@ func parseFlatMetric(
 		flatIngestionStatistics.GT10MiBCounter.Incr()
 	}
 	flatIngestionStatistics.ReadBytes.Add(float64(decoder.ReadLen()))
+	flatIngestionStatistics.ZeroCopied.Add(float64(decoder.ZeroCopied()))
 
 	return batch, nil
 }
+
+// Validate validates flat metric data without writing, returns the validation error of each metric.
+func Validate(req *http.Request, enrichedTags tag.Tags, namespace string, limits *models.Limits) (*models.WriteValidation, error) {
+	var reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := ingestCommon.GetGzipReader(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("ingestion corrupted gzip data: %w", err)
+		}
+		defer ingestCommon.PutGzipReader(gzipReader)
+		reader = gzipReader
+	}
+	bufioReader, releaseBufioReaderFunc := ingestCommon.NewBufioReader(reader)
+	defer releaseBufioReaderFunc(bufioReader)
+
+	return validateFlatMetric(bufioReader, enrichedTags, namespace, limits)
+}
+
+func validateFlatMetric(
+	reader io.Reader,
+	enrichedTags tag.Tags,
+	namespace string,
+	limits *models.Limits,
+) (
+	result *models.WriteValidation, err error,
+) {
+	decoder, releaseFunc := metric.NewBrokerRowFlatDecoder(
+		reader,
+		strutil.String2ByteSlice(namespace),
+		enrichedTags,
+		limits,
+	)
+	defer releaseFunc(decoder)
+
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = fmt.Errorf("bad flat metrics binary")
+		}
+	}()
+	result = &models.WriteValidation{}
+	// reuse one row, because decoded row is dropped after validating
+	var row metric.BrokerRow
+	for decoder.HasNext() {
+		if err0 := decoder.DecodeTo(&row); err0 != nil {
+			result.AddError(string(decoder.MetricName()), err0)
+		} else {
+			result.AddValid()
+		}
+	}
+	return result, nil
+}
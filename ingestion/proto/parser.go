@@ -94,3 +94,48 @@ func parseProtoMetric(
 	}
 	return batch, nil
 }
+
+// Validate validates proto metric data without writing, returns the validation error of each metric.
+func Validate(req *http.Request, enrichedTags tag.Tags, namespace string, limits *models.Limits) (*models.WriteValidation, error) {
+	var reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := ingestCommon.GetGzipReader(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("ingestion corrupted gzip data: %w", err)
+		}
+		defer ingestCommon.PutGzipReader(gzipReader)
+		reader = gzipReader
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return validateProtoMetric(data, enrichedTags, namespace, limits)
+}
+
+func validateProtoMetric(
+	data []byte,
+	enrichedTags tag.Tags,
+	namespace string,
+	limits *models.Limits,
+) (*models.WriteValidation, error) {
+	converter, releaseFunc := metric.NewBrokerRowProtoConverter(strutil.String2ByteSlice(namespace), enrichedTags, limits)
+	defer releaseFunc(converter)
+
+	var ms protoMetricsV1.MetricList
+	if err := ms.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	result := &models.WriteValidation{}
+	// reuse one row, because converted row is dropped after validating
+	var row metric.BrokerRow
+	for _, m := range ms.Metrics {
+		if err := converter.ConvertTo(m, &row); err != nil {
+			result.AddError(m.GetName(), err)
+		} else {
+			result.AddValid()
+		}
+	}
+	return result, nil
+}
@@ -30,6 +30,7 @@ import (
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 )
 
@@ -103,3 +104,35 @@ func Test_parseProtoMetric(t *testing.T) {
 	assert.Equal(t, "ns", string(m.Namespace()))
 	assert.Equal(t, 0, m.KeyValuesLength())
 }
+
+func Test_Validate(t *testing.T) {
+	metricList := &protoMetricsV1.MetricList{Metrics: []*protoMetricsV1.Metric{
+		testMetricList.Metrics[0],
+		{Name: "b", Timestamp: 0},
+		{Name: "", Timestamp: 0},
+	}}
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPut, "", bytes.NewReader(makeGzipData(metricList)))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	result, err := Validate(req, nil, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.Total)
+	assert.Equal(t, 1, result.Valid)
+	assert.Len(t, result.Errors, 2)
+	assert.Equal(t, models.MetricError{Index: 1, Metric: "b", Error: metric.ErrMetricPBEmptyField.Error()}, result.Errors[0])
+	assert.Equal(t, 2, result.Errors[1].Index)
+
+	// bad gzip data
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPut, "", strings.NewReader("bad-data"))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	result, err = Validate(req, nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	// bad proto data
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPut, "", strings.NewReader("bad-data"))
+	assert.NoError(t, err)
+	result, err = Validate(req, nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
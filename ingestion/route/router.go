@@ -0,0 +1,174 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// Router relabels/routes the ingested metrics to target database/namespace based on routing rules,
+// rules are matched by order, the first matched rule is applied.
+type Router struct {
+	rules []*rule
+
+	statistics *metrics.RouteStatistics
+}
+
+// rule represents the compiled ingestion route.
+type rule struct {
+	metric          *regexp.Regexp
+	namespace       *regexp.Regexp
+	tags            map[string]*regexp.Regexp
+	targetDatabase  string
+	targetNamespace []byte
+}
+
+// NewRouter creates the ingestion router by routing rules.
+func NewRouter(routes []config.IngestionRoute) *Router {
+	r := &Router{
+		statistics: metrics.NewRouteStatistics(),
+	}
+	for idx := range routes {
+		route := routes[idx]
+		ru := &rule{
+			metric:          compileGlob(route.Metric),
+			namespace:       compileGlob(route.Namespace),
+			targetDatabase:  route.TargetDatabase,
+			targetNamespace: []byte(route.TargetNamespace),
+		}
+		if len(route.Tags) > 0 {
+			ru.tags = make(map[string]*regexp.Regexp, len(route.Tags))
+			for tagKey, tagValue := range route.Tags {
+				ru.tags[tagKey] = compileGlob(tagValue)
+			}
+		}
+		r.rules = append(r.rules, ru)
+	}
+	return r
+}
+
+// Route relabels the namespace of matched rows in place, then groups rows by target database,
+// returns the batches of each database(rows of request's database are included).
+// NOTE: rows are validated by the limits of request's database.
+func (r *Router) Route(database string, rows *metric.BrokerBatchRows) (map[string]*metric.BrokerBatchRows, error) {
+	if len(r.rules) == 0 || rows == nil || rows.Len() == 0 {
+		return map[string]*metric.BrokerBatchRows{database: rows}, nil
+	}
+	var (
+		builder   *commonseries.RowBuilder
+		targets   = make([]string, rows.Len())
+		splitting = false
+	)
+	brokerRows := rows.Rows()
+	for idx := range brokerRows {
+		row := &brokerRows[idx]
+		targets[idx] = database
+		m := row.Metric()
+		ru := r.match(&m)
+		if ru == nil {
+			continue
+		}
+		if len(ru.targetNamespace) > 0 && string(m.Namespace()) != string(ru.targetNamespace) {
+			if builder == nil {
+				builder = commonseries.CreateRowBuilder()
+			}
+			if err := row.RewriteNamespace(builder, ru.targetNamespace); err != nil {
+				r.statistics.RouteFails.Incr()
+				return nil, err
+			}
+			r.statistics.Relabeled.WithTagValues(string(ru.targetNamespace)).Incr()
+		}
+		if ru.targetDatabase != "" && ru.targetDatabase != database {
+			targets[idx] = ru.targetDatabase
+			splitting = true
+			r.statistics.Routed.WithTagValues(ru.targetDatabase).Incr()
+		}
+	}
+	if !splitting {
+		// fast path, all rows are written into request's database
+		return map[string]*metric.BrokerBatchRows{database: rows}, nil
+	}
+	batches := make(map[string]*metric.BrokerBatchRows)
+	for idx := range brokerRows {
+		batch, ok := batches[targets[idx]]
+		if !ok {
+			batch = metric.NewBrokerBatchRows()
+			batches[targets[idx]] = batch
+		}
+		batch.AppendRow(&brokerRows[idx])
+	}
+	return batches, nil
+}
+
+// match returns the first rule which matches the metric, returns nil if not matched.
+func (r *Router) match(m *flatMetricsV1.Metric) *rule {
+	for _, ru := range r.rules {
+		if ru.match(m) {
+			return ru
+		}
+	}
+	return nil
+}
+
+// match checks if metric name/namespace/tags are all matched.
+func (ru *rule) match(m *flatMetricsV1.Metric) bool {
+	if ru.metric != nil && !ru.metric.Match(m.Name()) {
+		return false
+	}
+	if ru.namespace != nil && !ru.namespace.Match(m.Namespace()) {
+		return false
+	}
+	if len(ru.tags) == 0 {
+		return true
+	}
+	matched := 0
+	var kv flatMetricsV1.KeyValue
+	for idx := 0; idx < m.KeyValuesLength(); idx++ {
+		if !m.KeyValues(&kv, idx) {
+			continue
+		}
+		pattern, ok := ru.tags[string(kv.Key())]
+		if !ok {
+			continue
+		}
+		if !pattern.Match(kv.Value()) {
+			return false
+		}
+		matched++
+	}
+	return matched == len(ru.tags)
+}
+
+// compileGlob compiles glob pattern(support * and ?) to anchored regexp, returns nil if pattern is empty(match all).
+func compileGlob(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return regexp.MustCompile("^" + expr + "$")
+}
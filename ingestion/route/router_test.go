@@ -0,0 +1,145 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package route
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/series/metric"
+)
+
+func buildRows(t *testing.T, names ...string) *metric.BrokerBatchRows {
+	rows := metric.NewBrokerBatchRows()
+	for idx, name := range names {
+		idx := idx
+		name := name
+		assert.NoError(t, rows.TryAppend(func(row *metric.BrokerRow) error {
+			builder := commonseries.CreateRowBuilder()
+			builder.AddMetricName([]byte(name))
+			builder.AddNameSpace([]byte("ns"))
+			_ = builder.AddTag([]byte("cluster"), []byte(fmt.Sprintf("prod-%d", idx)))
+			_ = builder.AddSimpleField([]byte("f1"), flatMetricsV1.SimpleFieldTypeLast, 1)
+			builder.AddTimestamp(1000)
+			data, err := builder.Build()
+			if err != nil {
+				return err
+			}
+			row.FromBlock(data)
+			return nil
+		}))
+	}
+	return rows
+}
+
+func metricNames(rows *metric.BrokerBatchRows) (names []string) {
+	for _, row := range rows.Rows() {
+		m := row.Metric()
+		names = append(names, string(m.Namespace())+":"+string(m.Name()))
+	}
+	return
+}
+
+func TestRouter_Route(t *testing.T) {
+	cases := []struct {
+		name    string
+		routes  []config.IngestionRoute
+		metrics []string
+		expect  map[string][]string
+	}{
+		{
+			name:    "no rules",
+			metrics: []string{"k8s.pod", "cpu"},
+			expect:  map[string][]string{"db": {"ns:k8s.pod", "ns:cpu"}},
+		},
+		{
+			name:    "route by metric",
+			routes:  []config.IngestionRoute{{Metric: "k8s.*", TargetDatabase: "k8s"}},
+			metrics: []string{"k8s.pod", "cpu", "k8s.node"},
+			expect: map[string][]string{
+				"db":  {"ns:cpu"},
+				"k8s": {"ns:k8s.pod", "ns:k8s.node"},
+			},
+		},
+		{
+			name:    "route to same database",
+			routes:  []config.IngestionRoute{{Metric: "k8s.*", TargetDatabase: "db"}},
+			metrics: []string{"k8s.pod", "cpu"},
+			expect:  map[string][]string{"db": {"ns:k8s.pod", "ns:cpu"}},
+		},
+		{
+			name:    "relabel namespace",
+			routes:  []config.IngestionRoute{{Metric: "cp?", Namespace: "n*", TargetNamespace: "system"}},
+			metrics: []string{"k8s.pod", "cpu"},
+			expect:  map[string][]string{"db": {"ns:k8s.pod", "system:cpu"}},
+		},
+		{
+			name:    "namespace not matched",
+			routes:  []config.IngestionRoute{{Namespace: "other", TargetNamespace: "system"}},
+			metrics: []string{"cpu"},
+			expect:  map[string][]string{"db": {"ns:cpu"}},
+		},
+		{
+			name: "route by tags, first matched rule applied",
+			routes: []config.IngestionRoute{
+				{Tags: map[string]string{"cluster": "prod-1"}, TargetDatabase: "prod1", TargetNamespace: "k8s"},
+				{Tags: map[string]string{"cluster": "prod-*"}, TargetDatabase: "prod"},
+				{Tags: map[string]string{"host": "*"}, TargetDatabase: "host"},
+			},
+			metrics: []string{"cpu", "memory"},
+			expect: map[string][]string{
+				"prod":  {"ns:cpu"},
+				"prod1": {"k8s:memory"},
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRouter(tt.routes)
+			batches, err := r.Route("db", buildRows(t, tt.metrics...))
+			assert.NoError(t, err)
+			assert.Len(t, batches, len(tt.expect))
+			for database, names := range tt.expect {
+				assert.Equal(t, names, metricNames(batches[database]), database)
+			}
+		})
+	}
+}
+
+func TestRouter_Route_Empty(t *testing.T) {
+	r := NewRouter([]config.IngestionRoute{{Metric: "*", TargetDatabase: "k8s"}})
+	batches, err := r.Route("db", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*metric.BrokerBatchRows{"db": nil}, batches)
+}
+
+func Test_compileGlob(t *testing.T) {
+	assert.Nil(t, compileGlob(""))
+	assert.True(t, compileGlob("k8s.*").MatchString("k8s.pod"))
+	assert.False(t, compileGlob("k8s.*").MatchString("k8sxpod"))
+	assert.False(t, compileGlob("k8s.*").MatchString("a.k8s.pod"))
+	assert.True(t, compileGlob("cp?").MatchString("cpu"))
+	assert.False(t, compileGlob("cp?").MatchString("cpus"))
+}
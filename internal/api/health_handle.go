@@ -0,0 +1,236 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shirou/gopsutil/v3/disk"
+
+	"github.com/lindb/lindb/internal/server"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+// for testing
+var (
+	diskUsageFn  = disk.UsageWithContext
+	createTempFn = os.CreateTemp
+)
+
+var (
+	HealthzPath = "/healthz"
+	ReadyzPath  = "/readyz"
+)
+
+const (
+	// healthCheckTimeout represents the timeout of all health checks in one probe.
+	healthCheckTimeout = 3 * time.Second
+	// maxDiskUsedPercent represents the max used percent of disk, keeps headroom for flushing and compaction.
+	maxDiskUsedPercent = 95.0
+	// healthCheckKey represents the key for checking state repository connectivity.
+	healthCheckKey = "/health-check"
+)
+
+// HealthCheck represents the dependency check of node's component.
+type HealthCheck struct {
+	// Name represents the component name.
+	Name string
+	// Liveness represents if the check is included in liveness probe,
+	// the other checks(such as etcd, replication) only affect readiness probe.
+	Liveness bool
+	// Check returns error if the component is unhealthy.
+	Check func(ctx context.Context) error
+}
+
+// HealthAPI represents liveness/readiness probe rest api, returns the status of all components.
+type HealthAPI struct {
+	state  func() server.State
+	checks []HealthCheck
+	logger *logger.Logger
+}
+
+// NewHealthAPI creates liveness/readiness probe api instance.
+func NewHealthAPI(stateFn func() server.State, checks ...HealthCheck) *HealthAPI {
+	return &HealthAPI{
+		state:  stateFn,
+		checks: checks,
+		logger: logger.GetLogger("Monitoring", "HealthAPI"),
+	}
+}
+
+// Register adds health probe url route.
+func (api *HealthAPI) Register(route gin.IRoutes) {
+	route.GET(HealthzPath, api.Healthz)
+	route.GET(ReadyzPath, api.Readyz)
+}
+
+// Healthz returns the liveness of node, checks server state and local resources(disk/wal).
+
+// @Summary liveness probe
+// @Description return the liveness of node with the status of local components.
+// @Tags State
+// @Produce json
+// @Success 200 {object} models.HealthStatus
+// @Failure 503 {object} models.HealthStatus
+// @Router /healthz [get]
+func (api *HealthAPI) Healthz(c *gin.Context) {
+	api.probe(c, true)
+}
+
+// Readyz returns the readiness of node, checks all components including etcd and replication.
+
+// @Summary readiness probe
+// @Description return the readiness of node with the status of all components.
+// @Tags State
+// @Produce json
+// @Success 200 {object} models.HealthStatus
+// @Failure 503 {object} models.HealthStatus
+// @Router /readyz [get]
+func (api *HealthAPI) Readyz(c *gin.Context) {
+	api.probe(c, false)
+}
+
+// probe runs health checks concurrently, responses 503 if any component is unhealthy.
+func (api *HealthAPI) probe(c *gin.Context, liveness bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthCheckTimeout)
+	defer cancel()
+
+	checks := []HealthCheck{{Name: "server", Liveness: true, Check: func(_ context.Context) error {
+		return checkServerState(api.state(), liveness)
+	}}}
+	for _, check := range api.checks {
+		if !liveness || check.Liveness {
+			checks = append(checks, check)
+		}
+	}
+	components := make([]models.ComponentHealth, len(checks))
+	var wait sync.WaitGroup
+	wait.Add(len(checks))
+	for idx := range checks {
+		go func(idx int) {
+			defer wait.Done()
+			components[idx] = runHealthCheck(ctx, checks[idx])
+		}(idx)
+	}
+	wait.Wait()
+
+	status := &models.HealthStatus{Status: models.HealthStatusUp, Components: components}
+	for _, component := range components {
+		if component.Status != models.HealthStatusUp {
+			status.Status = models.HealthStatusDown
+			api.logger.Warn("component is unhealthy",
+				logger.String("component", component.Name),
+				logger.String("message", component.Message))
+		}
+	}
+	if status.Status == models.HealthStatusUp {
+		httppkg.OK(c, status)
+		return
+	}
+	httppkg.ServiceUnavailable(c, status)
+}
+
+// runHealthCheck runs health check, returns the status of component.
+func runHealthCheck(ctx context.Context, check HealthCheck) models.ComponentHealth {
+	start := time.Now()
+	component := models.ComponentHealth{Name: check.Name, Status: models.HealthStatusUp}
+	if err := check.Check(ctx); err != nil {
+		component.Status = models.HealthStatusDown
+		component.Message = err.Error()
+	}
+	component.Elapsed = time.Since(start).String()
+	return component
+}
+
+// checkServerState checks server state, node is alive if not failed/terminated, ready if running.
+func checkServerState(state server.State, liveness bool) error {
+	switch {
+	case state == server.Running:
+		return nil
+	case liveness && (state == server.New || state == server.Draining):
+		return nil
+	default:
+		return fmt.Errorf("server state is %s", state)
+	}
+}
+
+// NewRepoHealthCheck creates the connectivity check of state repository(etcd).
+func NewRepoHealthCheck(repo func() state.Repository) HealthCheck {
+	return HealthCheck{
+		Name: "etcd",
+		Check: func(ctx context.Context) error {
+			r := repo()
+			if r == nil {
+				return errors.New("state repository not initialized")
+			}
+			if _, err := r.Get(ctx, healthCheckKey); err != nil && !errors.Is(err, state.ErrNotExist) {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// NewDiskHealthCheck creates the disk space headroom check of given path.
+func NewDiskHealthCheck(name, path string) HealthCheck {
+	return HealthCheck{
+		Name:     name,
+		Liveness: true,
+		Check: func(ctx context.Context) error {
+			stat, err := diskUsageFn(ctx, path)
+			if err != nil {
+				return err
+			}
+			if stat.UsedPercent > maxDiskUsedPercent {
+				return fmt.Errorf("disk usage of %s is %.2f%%, exceeds %.2f%%", path, stat.UsedPercent, maxDiskUsedPercent)
+			}
+			return nil
+		},
+	}
+}
+
+// NewWritableHealthCheck creates the writability check of given dir, writes and syncs a temp file.
+func NewWritableHealthCheck(name, dir string) HealthCheck {
+	return HealthCheck{
+		Name:     name,
+		Liveness: true,
+		Check: func(_ context.Context) error {
+			f, err := createTempFn(dir, ".health-check-*")
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = f.Close()
+				_ = os.Remove(f.Name())
+			}()
+			if _, err = f.Write([]byte("health check")); err != nil {
+				return err
+			}
+			return f.Sync()
+		},
+	}
+}
@@ -0,0 +1,137 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/internal/server"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestHealthAPI_Probe(t *testing.T) {
+	serverState := server.New
+	readyErr := fmt.Errorf("not ready")
+	api := NewHealthAPI(func() server.State { return serverState },
+		HealthCheck{Name: "local", Liveness: true, Check: func(_ context.Context) error { return nil }},
+		HealthCheck{Name: "remote", Check: func(_ context.Context) error { return readyErr }},
+	)
+	r := gin.New()
+	api.Register(r)
+
+	cases := []struct {
+		name       string
+		path       string
+		state      server.State
+		readyErr   error
+		code       int
+		components int
+	}{
+		{name: "starting node is alive", path: HealthzPath, state: server.New, code: http.StatusOK, components: 2},
+		{name: "starting node not ready", path: ReadyzPath, state: server.New, code: http.StatusServiceUnavailable, components: 3},
+		{name: "draining node is alive", path: HealthzPath, state: server.Draining, code: http.StatusOK, components: 2},
+		{name: "draining node not ready", path: ReadyzPath, state: server.Draining, code: http.StatusServiceUnavailable, components: 3},
+		{name: "failed node not alive", path: HealthzPath, state: server.Failed, code: http.StatusServiceUnavailable, components: 2},
+		{name: "dependency unhealthy", path: ReadyzPath, state: server.Running, readyErr: fmt.Errorf("err"),
+			code: http.StatusServiceUnavailable, components: 3},
+		{name: "node ready", path: ReadyzPath, state: server.Running, code: http.StatusOK, components: 3},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			serverState = tt.state
+			readyErr = tt.readyErr
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			status := &models.HealthStatus{}
+			assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), status))
+			assert.Len(t, status.Components, tt.components)
+			assert.Equal(t, "server", status.Components[0].Name)
+		})
+	}
+}
+
+func TestHealthCheck_Repo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var repo state.Repository
+	check := NewRepoHealthCheck(func() state.Repository { return repo })
+	assert.Error(t, check.Check(context.TODO()))
+	assert.False(t, check.Liveness)
+
+	mockRepo := state.NewMockRepository(ctrl)
+	repo = mockRepo
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+	assert.NoError(t, check.Check(context.TODO()))
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, check.Check(context.TODO()))
+}
+
+func TestHealthCheck_Disk(t *testing.T) {
+	defer func() {
+		diskUsageFn = disk.UsageWithContext
+	}()
+	check := NewDiskHealthCheck("disk", ".")
+	assert.NoError(t, check.Check(context.TODO()))
+
+	diskUsageFn = func(_ context.Context, _ string) (*disk.UsageStat, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, check.Check(context.TODO()))
+	diskUsageFn = func(_ context.Context, _ string) (*disk.UsageStat, error) {
+		return &disk.UsageStat{UsedPercent: 99}, nil
+	}
+	assert.Error(t, check.Check(context.TODO()))
+}
+
+func TestHealthCheck_Writable(t *testing.T) {
+	defer func() {
+		createTempFn = os.CreateTemp
+	}()
+	dir := t.TempDir()
+	check := NewWritableHealthCheck("wal", dir)
+	assert.NoError(t, check.Check(context.TODO()))
+	files, _ := os.ReadDir(dir)
+	assert.Empty(t, files)
+
+	assert.Error(t, NewWritableHealthCheck("wal", filepath.Join(dir, "not-exist")).Check(context.TODO()))
+
+	createTempFn = func(dir, pattern string) (*os.File, error) {
+		f, err := os.CreateTemp(dir, pattern)
+		if err != nil {
+			return nil, err
+		}
+		_ = f.Close()
+		return f, nil
+	}
+	assert.Error(t, check.Check(context.TODO()))
+}
@@ -47,8 +47,9 @@ type FileInfo struct {
 }
 
 var (
-	LogListPath = "/log/list"
-	LogViewPath = "/log/view"
+	LogListPath  = "/log/list"
+	LogViewPath  = "/log/view"
+	LogLevelPath = "/log/level"
 )
 
 // LoggerAPI represents view log file rest api.
@@ -69,6 +70,47 @@ func NewLoggerAPI(logDir string) *LoggerAPI {
 func (d *LoggerAPI) Register(route gin.IRoutes) {
 	route.GET(LogListPath, d.List)
 	route.GET(LogViewPath, d.View)
+	route.GET(LogLevelPath, d.GetLevels)
+	route.PUT(LogLevelPath, d.SetLevels)
+}
+
+// GetLevels returns the running log level of all logger modules.
+
+// @Summary list log levels
+// @Description return the running log level of all logger modules.
+// @Tags State
+// @Accept json
+// @Produce json
+// @Success 200 {object} []logger.ModuleLevel
+// @Failure 500 {string} string "internal error"
+// @Router /log/level [get]
+func (d *LoggerAPI) GetLevels(c *gin.Context) {
+	httppkg.OK(c, logger.GetModuleLevels())
+}
+
+// SetLevels changes the log level of logger modules at runtime.
+
+// @Summary set log levels
+// @Description change the log level of logger modules at runtime, module '*' changes all loggers, level 'default' resets the module.
+// @Tags State
+// @Accept json
+// @Produce json
+// @Param param body map[string]string ture "module => level"
+// @Success 200 {object} []logger.ModuleLevel
+// @Failure 500 {string} string "internal error"
+// @Router /log/level [put]
+func (d *LoggerAPI) SetLevels(c *gin.Context) {
+	var levels map[string]string
+	if err := c.ShouldBindJSON(&levels); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := logger.SetModuleLevels(levels); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	d.logger.Info("change log levels", logger.Any("levels", levels))
+	httppkg.OK(c, logger.GetModuleLevels())
 }
 
 // List returns all log files in log dir.
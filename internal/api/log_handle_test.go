@@ -31,6 +31,7 @@ import (
 
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
 )
 
 type mockDirEntry struct{}
@@ -128,3 +129,27 @@ func TestLoggerAPI_View(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodGet, LogViewPath+"?file=../client/base.go", "")
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
+
+func TestLoggerAPI_Level(t *testing.T) {
+	_ = logger.GetLogger("LevelAPI", "Test")
+	defer func() {
+		_ = logger.SetModuleLevel("LevelAPI", logger.DefaultLevel)
+	}()
+
+	api := NewLoggerAPI(".")
+	r := gin.New()
+	api.Register(r)
+	resp := mock.DoRequest(t, r, http.MethodGet, LogLevelPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// bad request
+	resp = mock.DoRequest(t, r, http.MethodPut, LogLevelPath, "abc")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// unknown module
+	resp = mock.DoRequest(t, r, http.MethodPut, LogLevelPath, `{"not-exist":"debug"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// ok
+	resp = mock.DoRequest(t, r, http.MethodPut, LogLevelPath, `{"LevelAPI":"debug"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, logger.GetModuleLevels(), logger.ModuleLevel{Module: "LevelAPI", Level: "debug", Overridden: true})
+}
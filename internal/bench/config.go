@@ -0,0 +1,180 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldMix represents the number of fields by field type of each generated metric.
+type FieldMix struct {
+	Sum       int
+	Last      int
+	First     int
+	Min       int
+	Max       int
+	Histogram bool
+}
+
+// Total returns the number of simple fields.
+func (f FieldMix) Total() int {
+	return f.Sum + f.Last + f.First + f.Min + f.Max
+}
+
+// String returns the string value of field mix.
+func (f FieldMix) String() string {
+	return fmt.Sprintf("sum:%d,last:%d,first:%d,min:%d,max:%d,histogram:%v",
+		f.Sum, f.Last, f.First, f.Min, f.Max, f.Histogram)
+}
+
+// ParseFieldMix parses field mix from string, format: sum:2,last:1,histogram:1.
+func ParseFieldMix(value string) (mix FieldMix, err error) {
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		kv := strings.SplitN(item, ":", 2)
+		if len(kv) != 2 {
+			return mix, fmt.Errorf("bad field mix: %s, format: type:count", item)
+		}
+		count, parseErr := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if parseErr != nil || count < 0 {
+			return mix, fmt.Errorf("bad field count: %s", item)
+		}
+		switch strings.ToLower(strings.TrimSpace(kv[0])) {
+		case "sum":
+			mix.Sum = count
+		case "last":
+			mix.Last = count
+		case "first":
+			mix.First = count
+		case "min":
+			mix.Min = count
+		case "max":
+			mix.Max = count
+		case "histogram":
+			mix.Histogram = count > 0
+		default:
+			return mix, fmt.Errorf("not support field type: %s, only support sum/last/first/min/max/histogram", kv[0])
+		}
+	}
+	if mix.Total() == 0 && !mix.Histogram {
+		return mix, fmt.Errorf("field mix cannot be empty")
+	}
+	return mix, nil
+}
+
+// Config represents the configuration of simulated workload.
+type Config struct {
+	Endpoint    string        // broker http endpoint, like http://localhost:9000
+	Database    string        // target database
+	Namespace   string        // namespace of generated metrics
+	Duration    time.Duration // duration of benchmark
+	Concurrency int           // num. of concurrent writers/queriers
+
+	MetricCount   int           // num. of metric names
+	SeriesCount   int           // num. of active series
+	Churn         float64       // ratio of active series replaced by new series each churn interval
+	ChurnInterval time.Duration // interval of series churn
+	Fields        FieldMix      // fields of each metric
+	WriteRate     int           // num. of metrics written per second, 0 means no write
+	BatchSize     int           // num. of metrics per write request
+
+	Queries   []string // queries executed in round-robin, like representative dashboard queries
+	QueryRate float64  // num. of queries executed per second, 0 means no query
+}
+
+// NewDefaultConfig returns a default configuration of simulated workload.
+func NewDefaultConfig() *Config {
+	return &Config{
+		Endpoint:      "http://localhost:9000",
+		Database:      "_internal",
+		Namespace:     "bench",
+		Duration:      time.Minute,
+		Concurrency:   4,
+		MetricCount:   10,
+		SeriesCount:   10000,
+		ChurnInterval: time.Minute,
+		Fields:        FieldMix{Sum: 1, Last: 1},
+		WriteRate:     10000,
+		BatchSize:     1000,
+	}
+}
+
+// Validate checks if configuration is valid.
+func (cfg *Config) Validate() error {
+	switch {
+	case cfg.Endpoint == "":
+		return fmt.Errorf("endpoint cannot be empty")
+	case cfg.Database == "":
+		return fmt.Errorf("database cannot be empty")
+	case cfg.Duration <= 0:
+		return fmt.Errorf("duration must be positive")
+	case cfg.Concurrency <= 0:
+		return fmt.Errorf("concurrency must be positive")
+	case cfg.WriteRate < 0 || cfg.QueryRate < 0:
+		return fmt.Errorf("write/query rate cannot be negative")
+	case cfg.WriteRate == 0 && cfg.QueryRate == 0:
+		return fmt.Errorf("write rate and query rate cannot be both zero")
+	case cfg.QueryRate > 0 && len(cfg.Queries) == 0:
+		return fmt.Errorf("queries cannot be empty if query rate is positive")
+	case cfg.Churn < 0 || cfg.Churn > 1:
+		return fmt.Errorf("churn must be in [0, 1]")
+	}
+	if cfg.WriteRate > 0 {
+		switch {
+		case cfg.MetricCount <= 0 || cfg.SeriesCount <= 0:
+			return fmt.Errorf("metric count and series count must be positive")
+		case cfg.BatchSize <= 0:
+			return fmt.Errorf("batch size must be positive")
+		case cfg.Churn > 0 && cfg.ChurnInterval <= 0:
+			return fmt.Errorf("churn interval must be positive")
+		}
+	}
+	return nil
+}
+
+// LoadQueries loads the queries from file for replaying, one query per line, skips empty line and comment(#).
+func LoadQueries(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	var queries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		queries = append(queries, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
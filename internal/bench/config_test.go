@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFieldMix(t *testing.T) {
+	mix, err := ParseFieldMix("sum:2, last:1,first:1,min:1,max:1,histogram:1,")
+	assert.NoError(t, err)
+	assert.Equal(t, FieldMix{Sum: 2, Last: 1, First: 1, Min: 1, Max: 1, Histogram: true}, mix)
+	assert.Equal(t, 6, mix.Total())
+	assert.Equal(t, "sum:2,last:1,first:1,min:1,max:1,histogram:true", mix.String())
+
+	for _, value := range []string{"", "sum", "sum:a", "sum:-1", "gauge:1", "sum:0,histogram:0"} {
+		_, err = ParseFieldMix(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, NewDefaultConfig().Validate())
+	cases := []func(cfg *Config){
+		func(cfg *Config) { cfg.Endpoint = "" },
+		func(cfg *Config) { cfg.Database = "" },
+		func(cfg *Config) { cfg.Duration = 0 },
+		func(cfg *Config) { cfg.Concurrency = 0 },
+		func(cfg *Config) { cfg.WriteRate = -1 },
+		func(cfg *Config) { cfg.WriteRate = 0 },
+		func(cfg *Config) { cfg.QueryRate = 1 },
+		func(cfg *Config) { cfg.Churn = 2 },
+		func(cfg *Config) { cfg.SeriesCount = 0 },
+		func(cfg *Config) { cfg.BatchSize = 0 },
+		func(cfg *Config) {
+			cfg.Churn = 0.1
+			cfg.ChurnInterval = 0
+		},
+	}
+	for _, fn := range cases {
+		cfg := NewDefaultConfig()
+		fn(cfg)
+		assert.Error(t, cfg.Validate())
+	}
+	// query only
+	cfg := NewDefaultConfig()
+	cfg.WriteRate = 0
+	cfg.SeriesCount = 0
+	cfg.QueryRate = 1
+	cfg.Queries = []string{"select f from cpu"}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadQueries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queries.sql")
+	assert.NoError(t, os.WriteFile(path, []byte("# dashboard\nselect f from cpu\n\n  select f from memory  \n"), 0600))
+	queries, err := LoadQueries(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"select f from cpu", "select f from memory"}, queries)
+
+	queries, err = LoadQueries(filepath.Join(t.TempDir(), "not_exist.sql"))
+	assert.Error(t, err)
+	assert.Nil(t, queries)
+}
@@ -0,0 +1,121 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// histogramBounds represents the explicit bounds of generated histogram.
+var histogramBounds = []float64{10, 50, 100, 500, 1000, math.Inf(1)}
+
+// generator generates synthetic metrics of active series.
+// Series is identified by sequence(offset + index of active series),
+// churn moves offset forward, so the oldest series are replaced by new series.
+type generator struct {
+	cfg        *Config
+	fieldNames []string
+	fieldTypes []protoMetricsV1.SimpleFieldType
+
+	offset int // sequence of the first active series
+	cursor int // index of next active series to generate
+	mutex  sync.Mutex
+}
+
+// newGenerator creates a synthetic metric generator.
+func newGenerator(cfg *Config) *generator {
+	g := &generator{cfg: cfg}
+	addFields := func(prefix string, count int, fieldType protoMetricsV1.SimpleFieldType) {
+		for i := 0; i < count; i++ {
+			g.fieldNames = append(g.fieldNames, prefix+"_"+strconv.Itoa(i))
+			g.fieldTypes = append(g.fieldTypes, fieldType)
+		}
+	}
+	addFields("sum", cfg.Fields.Sum, protoMetricsV1.SimpleFieldType_DELTA_SUM)
+	addFields("last", cfg.Fields.Last, protoMetricsV1.SimpleFieldType_LAST)
+	addFields("first", cfg.Fields.First, protoMetricsV1.SimpleFieldType_FIRST)
+	addFields("min", cfg.Fields.Min, protoMetricsV1.SimpleFieldType_Min)
+	addFields("max", cfg.Fields.Max, protoMetricsV1.SimpleFieldType_Max)
+	return g
+}
+
+// Next generates the metrics of next n active series.
+func (g *generator) Next(n int) *protoMetricsV1.MetricList {
+	g.mutex.Lock()
+	start, offset := g.cursor, g.offset
+	g.cursor = (g.cursor + n) % g.cfg.SeriesCount
+	g.mutex.Unlock()
+
+	now := timeutil.Now()
+	metrics := make([]*protoMetricsV1.Metric, n)
+	for i := 0; i < n; i++ {
+		metrics[i] = g.newMetric(offset+(start+i)%g.cfg.SeriesCount, now)
+	}
+	return &protoMetricsV1.MetricList{Metrics: metrics}
+}
+
+// Churn replaces the oldest active series with new series based on churn ratio.
+func (g *generator) Churn() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.offset += int(float64(g.cfg.SeriesCount) * g.cfg.Churn)
+}
+
+// newMetric creates a metric of series with random field values.
+func (g *generator) newMetric(seq int, timestamp int64) *protoMetricsV1.Metric {
+	m := &protoMetricsV1.Metric{
+		Namespace: g.cfg.Namespace,
+		Name:      "bench_metric_" + strconv.Itoa(seq%g.cfg.MetricCount),
+		Timestamp: timestamp,
+		Tags: []*protoMetricsV1.KeyValue{
+			{Key: "host", Value: "host-" + strconv.Itoa(seq)},
+			{Key: "group", Value: "group-" + strconv.Itoa(seq%100)},
+		},
+	}
+	for i := range g.fieldNames {
+		m.SimpleFields = append(m.SimpleFields, &protoMetricsV1.SimpleField{
+			Name:  g.fieldNames[i],
+			Type:  g.fieldTypes[i],
+			Value: rand.Float64() * 100,
+		})
+	}
+	if g.cfg.Fields.Histogram {
+		values := make([]float64, len(histogramBounds))
+		count := 0.0
+		for i := range values {
+			values[i] = float64(rand.Intn(10))
+			count += values[i]
+		}
+		m.CompoundField = &protoMetricsV1.CompoundField{
+			Min:            1,
+			Max:            histogramBounds[len(histogramBounds)-2],
+			Sum:            count * histogramBounds[1],
+			Count:          count,
+			ExplicitBounds: histogramBounds,
+			Values:         values,
+		}
+	}
+	return m
+}
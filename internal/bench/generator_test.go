@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/metric"
+)
+
+func TestGenerator(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.MetricCount = 2
+	cfg.SeriesCount = 4
+	cfg.Churn = 0.5
+	cfg.Fields = FieldMix{Sum: 1, Max: 2, Histogram: true}
+	g := newGenerator(cfg)
+
+	hosts := func(n int) (rs []string) {
+		for _, m := range g.Next(n).Metrics {
+			rs = append(rs, m.Tags[0].Value)
+		}
+		return
+	}
+	assert.Equal(t, []string{"host-0", "host-1", "host-2"}, hosts(3))
+	assert.Equal(t, []string{"host-3", "host-0"}, hosts(2))
+	g.Churn()
+	assert.Equal(t, []string{"host-3", "host-4", "host-5", "host-2"}, hosts(4))
+
+	// generated metric is valid
+	converter := metric.NewProtoConverter(models.NewDefaultLimits())
+	var row metric.BrokerRow
+	m := g.Next(1).Metrics[0]
+	assert.Equal(t, "bench_metric_1", m.Name)
+	assert.Len(t, m.SimpleFields, 3)
+	assert.NotNil(t, m.CompoundField)
+	assert.NoError(t, converter.ConvertTo(m, &row))
+}
@@ -0,0 +1,117 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+// Stats represents the statistics of an operation(write/query) in benchmark.
+type Stats struct {
+	Name       string
+	Count      int     // num. of successful operations
+	Errors     int     // num. of failed operations
+	Items      int     // num. of metrics written/queries executed successfully
+	Throughput float64 // items per second
+	P50        time.Duration
+	P90        time.Duration
+	P99        time.Duration
+	Max        time.Duration
+}
+
+// Report represents the statistics list of benchmark.
+type Report []Stats
+
+// ToTable returns the statistics list as table.
+func (r Report) ToTable() (rows int, tableStr string) {
+	if len(r) == 0 {
+		return 0, ""
+	}
+	writer := models.NewTableFormatter()
+	writer.AppendHeader(table.Row{"Operation", "Count", "Errors", "Throughput(/s)", "P50", "P90", "P99", "Max"})
+	for i := range r {
+		s := r[i]
+		writer.AppendRow(table.Row{
+			s.Name, s.Count, s.Errors, fmt.Sprintf("%.2f", s.Throughput),
+			ltoml.Duration(s.P50), ltoml.Duration(s.P90), ltoml.Duration(s.P99), ltoml.Duration(s.Max),
+		})
+	}
+	return len(r), writer.Render()
+}
+
+// recorder records the latency of operations.
+type recorder struct {
+	name      string
+	latencies []time.Duration
+	errors    int
+	items     int
+	mutex     sync.Mutex
+}
+
+// newRecorder creates a latency recorder.
+func newRecorder(name string) *recorder {
+	return &recorder{name: name}
+}
+
+// Record records the latency of operation, latency of failed operation is ignored.
+func (r *recorder) Record(latency time.Duration, items int, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err != nil {
+		r.errors++
+		return
+	}
+	r.latencies = append(r.latencies, latency)
+	r.items += items
+}
+
+// Stats returns the statistics of recorded operations.
+func (r *recorder) Stats(elapsed time.Duration) Stats {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	stats := Stats{Name: r.name, Count: len(r.latencies), Errors: r.errors, Items: r.items}
+	if elapsed > 0 {
+		stats.Throughput = float64(r.items) / elapsed.Seconds()
+	}
+	if len(r.latencies) == 0 {
+		return stats
+	}
+	sort.Slice(r.latencies, func(i, j int) bool { return r.latencies[i] < r.latencies[j] })
+	percentile := func(p float64) time.Duration {
+		idx := int(float64(len(r.latencies))*p+0.5) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return r.latencies[idx]
+	}
+	stats.P50 = percentile(0.5)
+	stats.P90 = percentile(0.9)
+	stats.P99 = percentile(0.99)
+	stats.Max = r.latencies[len(r.latencies)-1]
+	return stats
+}
@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_Stats(t *testing.T) {
+	r := newRecorder("write")
+	stats := r.Stats(0)
+	assert.Equal(t, Stats{Name: "write"}, stats)
+
+	for i := 100; i > 0; i-- {
+		r.Record(time.Duration(i)*time.Millisecond, 10, nil)
+	}
+	r.Record(time.Second, 10, fmt.Errorf("err"))
+	stats = r.Stats(time.Second)
+	assert.Equal(t, Stats{
+		Name:       "write",
+		Count:      100,
+		Errors:     1,
+		Items:      1000,
+		Throughput: 1000,
+		P50:        50 * time.Millisecond,
+		P90:        90 * time.Millisecond,
+		P99:        99 * time.Millisecond,
+		Max:        100 * time.Millisecond,
+	}, stats)
+}
+
+func TestReport_ToTable(t *testing.T) {
+	rows, str := Report{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	rows, str = Report{{Name: "write", Count: 1}, {Name: "query"}}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.Contains(t, str, "write")
+	assert.Contains(t, str, "query")
+}
@@ -0,0 +1,198 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+)
+
+// requestTimeout represents the timeout of write/query request.
+const requestTimeout = 30 * time.Second
+
+// Runner runs the simulated workload against target cluster, then reports latency percentiles.
+type Runner struct {
+	cfg       *Config
+	generator *generator
+	client    *http.Client
+	queryCli  client.ExecuteCli
+	writeURL  string
+
+	queryIdx atomic.Int64
+
+	writeRecorder *recorder
+	queryRecorder *recorder
+}
+
+// NewRunner creates a workload runner, returns err if configuration is invalid.
+func NewRunner(cfg *Config) (*Runner, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	params := make(url.Values)
+	params.Set("db", cfg.Database)
+	params.Set("ns", cfg.Namespace)
+	return &Runner{
+		cfg:           cfg,
+		generator:     newGenerator(cfg),
+		client:        &http.Client{Timeout: requestTimeout},
+		queryCli:      client.NewExecuteCli(cfg.Endpoint + constants.APIVersion1CliPath),
+		writeURL:      cfg.Endpoint + constants.APIVersion1CliPath + "/write?" + params.Encode(),
+		writeRecorder: newRecorder("write"),
+		queryRecorder: newRecorder("query"),
+	}, nil
+}
+
+// Run runs the workload until duration elapsed or context done, returns the statistics of write/query.
+func (r *Runner) Run(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Duration)
+	defer cancel()
+
+	start := time.Now()
+	var wait sync.WaitGroup
+	if r.cfg.WriteRate > 0 {
+		interval := time.Duration(float64(time.Second) * float64(r.cfg.BatchSize) / float64(r.cfg.WriteRate))
+		r.dispatch(ctx, &wait, interval, r.write)
+		if r.cfg.Churn > 0 {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				r.churn(ctx)
+			}()
+		}
+	}
+	if r.cfg.QueryRate > 0 {
+		r.dispatch(ctx, &wait, time.Duration(float64(time.Second)/r.cfg.QueryRate), r.query)
+	}
+	wait.Wait()
+
+	elapsed := time.Since(start)
+	var report Report
+	if r.cfg.WriteRate > 0 {
+		report = append(report, r.writeRecorder.Stats(elapsed))
+	}
+	if r.cfg.QueryRate > 0 {
+		report = append(report, r.queryRecorder.Stats(elapsed))
+	}
+	return report
+}
+
+// dispatch triggers operation in interval by concurrent workers until context done.
+func (r *Runner) dispatch(ctx context.Context, wait *sync.WaitGroup, interval time.Duration, op func()) {
+	if interval <= 0 {
+		interval = time.Microsecond
+	}
+	tokens := make(chan struct{}, r.cfg.Concurrency)
+	wait.Add(1 + r.cfg.Concurrency)
+	go func() {
+		defer func() {
+			close(tokens)
+			wait.Done()
+		}()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				select {
+				case tokens <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	for i := 0; i < r.cfg.Concurrency; i++ {
+		go func() {
+			defer wait.Done()
+			for range tokens {
+				op()
+			}
+		}()
+	}
+}
+
+// churn replaces active series in churn interval until context done.
+func (r *Runner) churn(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.ChurnInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.generator.Churn()
+		}
+	}
+}
+
+// write writes a batch of generated metrics as protobuf.
+func (r *Runner) write() {
+	metrics := r.generator.Next(r.cfg.BatchSize)
+	data, err := metrics.Marshal()
+	if err != nil {
+		r.writeRecorder.Record(0, 0, err)
+		return
+	}
+	start := time.Now()
+	err = r.doWrite(data)
+	r.writeRecorder.Record(time.Since(start), len(metrics.Metrics), err)
+}
+
+// doWrite sends write request.
+func (r *Runner) doWrite(data []byte) error {
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPut, r.writeURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", constants.ContentTypeProto)
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("write failure, status: %d, body: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// query executes next query in round-robin.
+func (r *Runner) query() {
+	sql := r.cfg.Queries[int(r.queryIdx.Inc()-1)%len(r.cfg.Queries)]
+	start := time.Now()
+	err := r.queryCli.Execute(models.ExecuteParam{Database: r.cfg.Database, SQL: sql}, &models.ResultSet{})
+	r.queryRecorder.Record(time.Since(start), 1, err)
+}
@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bench
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunner_Run(t *testing.T) {
+	writeStatus := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/write"):
+			w.WriteHeader(writeStatus)
+		case strings.HasSuffix(r.URL.Path, "/exec"):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"metricName":"cpu"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := NewDefaultConfig()
+	cfg.Endpoint = server.URL
+	cfg.Duration = 200 * time.Millisecond
+	cfg.Concurrency = 2
+	cfg.SeriesCount = 100
+	cfg.BatchSize = 10
+	cfg.WriteRate = 1000
+	cfg.Churn = 0.1
+	cfg.ChurnInterval = 10 * time.Millisecond
+	cfg.QueryRate = 100
+	cfg.Queries = []string{"select f from cpu", "select f from memory"}
+	r, err := NewRunner(cfg)
+	assert.NoError(t, err)
+	report := r.Run(context.TODO())
+	assert.Len(t, report, 2)
+	assert.Equal(t, "write", report[0].Name)
+	assert.NotZero(t, report[0].Count)
+	assert.Zero(t, report[0].Errors)
+	assert.Equal(t, report[0].Count*10, report[0].Items)
+	assert.Equal(t, "query", report[1].Name)
+	assert.NotZero(t, report[1].Count)
+
+	// write failure
+	writeStatus = http.StatusInternalServerError
+	cfg.QueryRate = 0
+	r, err = NewRunner(cfg)
+	assert.NoError(t, err)
+	report = r.Run(context.TODO())
+	assert.Len(t, report, 1)
+	assert.Zero(t, report[0].Count)
+	assert.NotZero(t, report[0].Errors)
+
+	// invalid config
+	cfg.Database = ""
+	r, err = NewRunner(cfg)
+	assert.Error(t, err)
+	assert.Nil(t, r)
+}
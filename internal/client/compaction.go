@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./compaction.go -destination=./compaction_mock.go -package=client
+
+// CompactionCli represents background compaction management client of storage node.
+type CompactionCli interface {
+	// SetCompaction pauses/resumes compaction of database on storage node, pauses/resumes all databases if database is empty.
+	SetCompaction(node models.Node, database string, paused bool) (*models.CompactionState, error)
+	// FetchCompactionState fetches the compaction pause state and pending compaction backlog of storage node.
+	FetchCompactionState(node models.Node) (*models.CompactionState, error)
+	// FetchCompactionJobs fetches the running/queued compact and rollup jobs of storage node, filters by database if set.
+	FetchCompactionJobs(node models.Node, database string) (models.CompactionJobs, error)
+}
+
+// compactionCli implements CompactionCli interface.
+type compactionCli struct{}
+
+// NewCompactionCli creates a CompactionCli instance.
+func NewCompactionCli() CompactionCli {
+	return &compactionCli{}
+}
+
+// SetCompaction pauses/resumes compaction of database on storage node, pauses/resumes all databases if database is empty.
+func (cli *compactionCli) SetCompaction(node models.Node, database string, paused bool) (*models.CompactionState, error) {
+	rs := &models.CompactionState{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(map[string]string{
+			"db":     database,
+			"paused": strconv.FormatBool(paused),
+		}).
+		SetHeader("Accept", "application/json").
+		SetResult(rs).
+		Put(address + constants.APIVersion1CliPath + "/state/tsdb/compaction")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("set compaction of %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
+
+// FetchCompactionState fetches the compaction pause state and pending compaction backlog of storage node.
+func (cli *compactionCli) FetchCompactionState(node models.Node) (*models.CompactionState, error) {
+	rs := &models.CompactionState{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetHeader("Accept", "application/json").
+		SetResult(rs).
+		Get(address + constants.APIVersion1CliPath + "/state/tsdb/compaction")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("fetch compaction state of %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
+
+// FetchCompactionJobs fetches the running/queued compact and rollup jobs of storage node, filters by database if set.
+func (cli *compactionCli) FetchCompactionJobs(node models.Node, database string) (models.CompactionJobs, error) {
+	var rs models.CompactionJobs
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(map[string]string{"db": database}).
+		SetHeader("Accept", "application/json").
+		SetResult(&rs).
+		Get(address + constants.APIVersion1CliPath + "/state/tsdb/compaction/jobs")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("fetch compaction jobs of %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
@@ -0,0 +1,105 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestCompactionCli_SetCompaction(t *testing.T) {
+	cli := NewCompactionCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/state/tsdb/compaction", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "true", r.URL.Query().Get("paused"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"paused":false,"pausedDatabases":["db"]}`))
+	})
+	rs, err := cli.SetCompaction(node, "db", true)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.CompactionState{PausedDatabases: []string{"db"}}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.SetCompaction(node, "db", true)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.SetCompaction(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "", true)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func TestCompactionCli_FetchCompactionState(t *testing.T) {
+	cli := NewCompactionCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/state/tsdb/compaction", r.URL.Path)
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"paused":true,"backlogs":[{"database":"db","stores":2,"level0Files":5}]}`))
+	})
+	rs, err := cli.FetchCompactionState(node)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.CompactionState{
+		Paused:   true,
+		Backlogs: []*models.CompactionBacklog{{Database: "db", Stores: 2, Level0Files: 5}},
+	}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.FetchCompactionState(node)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.FetchCompactionState(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func TestCompactionCli_FetchCompactionJobs(t *testing.T) {
+	cli := NewCompactionCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/state/tsdb/compaction/jobs", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"database":"db","family":"f","status":"running","inputBytes":100,"readBytes":10}]`))
+	})
+	rs, err := cli.FetchCompactionJobs(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, models.CompactionJobs{{Database: "db", Family: "f", Status: "running", InputBytes: 100, ReadBytes: 10}}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.FetchCompactionJobs(node, "")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.FetchCompactionJobs(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
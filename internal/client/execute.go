@@ -36,7 +36,7 @@ import (
 type ExecuteCli interface {
 	// Execute executes lin query language, then returns execute result.
 	Execute(param models.ExecuteParam, rs interface{}) error
-	// ExecuteAsResult executes lin query language, then returns terminal result.
+	// ExecuteAsResult executes lin query language, then returns terminal result in output format of param.
 	ExecuteAsResult(param models.ExecuteParam, rs interface{}) (string, error)
 }
 
@@ -75,13 +75,24 @@ func (cli *executeCli) Execute(param models.ExecuteParam, rs interface{}) error
 		}
 		return nil
 	}
+	// returns the error with stable error code if server responses structured error envelope
+	errResp := &models.ErrorResponse{}
+	if err := encoding.JSONUnmarshal(resp.Body(), errResp); err == nil && errResp.Message != "" {
+		return errResp.Err()
+	}
 	return errors.New(string(resp.Body()))
 }
 
-// ExecuteAsResult executes lin query language, then returns terminal result.
+// ExecuteAsResult executes lin query language, then returns terminal result in output format of param.
 func (cli *executeCli) ExecuteAsResult(param models.ExecuteParam, rs interface{}) (string, error) {
+	format, err := models.ParseOutputFormat(param.Format)
+	if err != nil {
+		return "", err
+	}
+	// result is formatted by client, server responses json
+	param.Format = ""
 	n := time.Now()
-	err := cli.Execute(param, rs)
+	err = cli.Execute(param, rs)
 	cost := time.Since(n)
 	if err != nil {
 		return "", err
@@ -89,7 +100,11 @@ func (cli *executeCli) ExecuteAsResult(param models.ExecuteParam, rs interface{}
 	result := ""
 	rows := 0
 	if formatter, ok := rs.(models.TableFormatter); ok {
-		rows, result = formatter.ToTable()
+		rows, result = models.FormatTable(formatter, format)
+	}
+	if format == models.CSVFormat || format == models.JSONLFormat {
+		// keep result clean, so that it can be piped into other tools
+		return result, nil
 	}
 	if rows == 0 {
 		return fmt.Sprintf("Query OK, 0 rows affected (%s)", ltoml.Duration(cost)), nil
@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -91,6 +92,19 @@ func TestExecuteCli_Execute(t *testing.T) {
 	}
 }
 
+func TestExecuteCli_Execute_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusGatewayTimeout)
+		_, _ = rw.Write([]byte(`{"code":"TIMEOUT","message":"exceed timeout","retryable":true,"requestId":"1"}`))
+	}))
+	defer server.Close()
+
+	cli := NewExecuteCli(server.URL)
+	err := cli.Execute(models.ExecuteParam{SQL: "show master"}, nil)
+	assert.EqualError(t, err, "exceed timeout")
+	assert.Equal(t, errorpkg.Timeout, errorpkg.CodeOf(err))
+}
+
 func TestExecuteCli_ExecuteAsResult(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -152,6 +166,45 @@ func TestExecuteCli_ExecuteAsResult(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "unknown output format",
+			param:   models.ExecuteParam{Format: "xml"},
+			wantErr: true,
+		},
+		{
+			name:  "format as csv",
+			param: models.ExecuteParam{Format: "csv"},
+			rs:    &models.ResultSet{},
+			prepare: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusOK)
+				_, _ = rw.Write(encoding.JSONMarshal(&models.ResultSet{
+					GroupBy: []string{"host"},
+					Fields:  []string{"f"},
+					Series: []*models.Series{{
+						Tags:   map[string]string{"host": "a"},
+						Fields: map[string]map[int64]float64{"f": {0: 1}},
+					}},
+				}))
+			},
+			assert: func(rs string) {
+				assert.True(t, strings.HasPrefix(rs, "host,timestamp,f\n"))
+				assert.False(t, strings.Contains(rs, "rows in sets"))
+			},
+			wantErr: false,
+		},
+		{
+			name:  "format as vertical",
+			param: models.ExecuteParam{Format: "vertical"},
+			rs:    &models.Master{},
+			prepare: func(rw http.ResponseWriter) {
+				rw.WriteHeader(http.StatusOK)
+				_, _ = rw.Write(encoding.JSONMarshal(&models.Master{ElectTime: timeutil.Now(), Node: &models.StatelessNode{}}))
+			},
+			assert: func(rs string) {
+				assert.True(t, strings.Contains(rs, "1 row"))
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range cases {
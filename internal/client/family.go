@@ -0,0 +1,95 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./family.go -destination=./family_mock.go -package=client
+
+// FamilyCli represents data family files fetch client, used for repairing data family from other replica.
+type FamilyCli interface {
+	// FetchFamilyFiles fetches the persisted replica sequences and live files of data family from storage node.
+	FetchFamilyFiles(node models.Node, database string, shardID models.ShardID, familyTime int64) (*models.DataFamilyFiles, error)
+	// DownloadFamilyFile downloads the file of data family from storage node into target path.
+	DownloadFamilyFile(node models.Node, database string, shardID models.ShardID, familyTime int64, file, target string) error
+}
+
+// familyCli implements FamilyCli interface.
+type familyCli struct{}
+
+// NewFamilyCli creates a FamilyCli instance.
+func NewFamilyCli() FamilyCli {
+	return &familyCli{}
+}
+
+// FetchFamilyFiles fetches the persisted replica sequences and live files of data family from storage node.
+func (cli *familyCli) FetchFamilyFiles(node models.Node, database string,
+	shardID models.ShardID, familyTime int64,
+) (*models.DataFamilyFiles, error) {
+	files := &models.DataFamilyFiles{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(cli.params(database, shardID, familyTime)).
+		SetHeader("Accept", "application/json").
+		SetResult(files).
+		Get(address + constants.APIVersion1CliPath + "/state/tsdb/family/files")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("fetch family files from %s failure, status: %d", address, resp.StatusCode())
+	}
+	return files, nil
+}
+
+// DownloadFamilyFile downloads the file of data family from storage node into target path.
+func (cli *familyCli) DownloadFamilyFile(node models.Node, database string,
+	shardID models.ShardID, familyTime int64, file, target string,
+) error {
+	params := cli.params(database, shardID, familyTime)
+	params["file"] = file
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(params).
+		SetOutput(target).
+		Get(address + constants.APIVersion1CliPath + "/state/tsdb/family/file")
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("download family file[%s] from %s failure, status: %d", file, address, resp.StatusCode())
+	}
+	return nil
+}
+
+// params returns the query params of data family.
+func (cli *familyCli) params(database string, shardID models.ShardID, familyTime int64) map[string]string {
+	return map[string]string{
+		"db":         database,
+		"shard":      shardID.String(),
+		"familyTime": strconv.FormatInt(familyTime, 10),
+	}
+}
@@ -0,0 +1,80 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestFamilyCli_FetchFamilyFiles(t *testing.T) {
+	cli := NewFamilyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/family/files", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "2", r.URL.Query().Get("shard"))
+		assert.Equal(t, "100", r.URL.Query().Get("familyTime"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"sequences":{"1":10},"files":["000001.sst"]}`))
+	})
+	files, err := cli.FetchFamilyFiles(node, "db", 2, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int32]int64{1: 10}, files.Sequences)
+	assert.Equal(t, []string{"000001.sst"}, files.Files)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	files, err = cli.FetchFamilyFiles(node, "db", 2, 100)
+	assert.Error(t, err)
+	assert.Nil(t, files)
+	// connect failure
+	files, err = cli.FetchFamilyFiles(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "db", 2, 100)
+	assert.Error(t, err)
+	assert.Nil(t, files)
+}
+
+func TestFamilyCli_DownloadFamilyFile(t *testing.T) {
+	cli := NewFamilyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/family/file", r.URL.Path)
+		assert.Equal(t, "000001.sst", r.URL.Query().Get("file"))
+		_, _ = w.Write([]byte("data"))
+	})
+	target := filepath.Join(t.TempDir(), "000001.sst")
+	err := cli.DownloadFamilyFile(node, "db", 2, 100, "000001.sst", target)
+	assert.NoError(t, err)
+	data, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	err = cli.DownloadFamilyFile(node, "db", 2, 100, "000001.sst", target)
+	assert.Error(t, err)
+	// connect failure
+	err = cli.DownloadFamilyFile(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "db", 2, 100, "000001.sst", target)
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./limits.go -destination=./limits_mock.go -package=client
+
+// LimitsCli represents the client which fetches the database's limits state of node.
+type LimitsCli interface {
+	// FetchLimitsState fetches the state of database's limits applied on node.
+	FetchLimitsState(node models.Node, database string) (*models.LimitsState, error)
+}
+
+// limitsCli implements LimitsCli interface.
+type limitsCli struct{}
+
+// NewLimitsCli creates a LimitsCli instance.
+func NewLimitsCli() LimitsCli {
+	return &limitsCli{}
+}
+
+// FetchLimitsState fetches the state of database's limits applied on node.
+func (cli *limitsCli) FetchLimitsState(node models.Node, database string) (*models.LimitsState, error) {
+	rs := &models.LimitsState{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(map[string]string{
+			"db": database,
+		}).
+		SetHeader("Accept", "application/json").
+		SetResult(rs).
+		Get(address + constants.APIVersion1CliPath + "/state/limits")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("fetch limits state of %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
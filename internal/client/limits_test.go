@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestLimitsCli_FetchLimitsState(t *testing.T) {
+	cli := NewLimitsCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/state/limits", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"revision":"abc","appliedAt":10}`))
+	})
+	rs, err := cli.FetchLimitsState(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, &models.LimitsState{Revision: "abc", AppliedAt: 10}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.FetchLimitsState(node, "db")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.FetchLimitsState(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "db")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./read_only.go -destination=./read_only_mock.go -package=client
+
+// ReadOnlyCli represents read-only mode management client of storage node.
+type ReadOnlyCli interface {
+	// SetReadOnly switches the read-only mode of database on storage node, switches node level mode if database is empty.
+	SetReadOnly(node models.Node, database string, readOnly bool) (*models.ReadOnlyState, error)
+	// FetchReadOnlyState fetches the read-only state of storage node.
+	FetchReadOnlyState(node models.Node) (*models.ReadOnlyState, error)
+}
+
+// readOnlyCli implements ReadOnlyCli interface.
+type readOnlyCli struct{}
+
+// NewReadOnlyCli creates a ReadOnlyCli instance.
+func NewReadOnlyCli() ReadOnlyCli {
+	return &readOnlyCli{}
+}
+
+// SetReadOnly switches the read-only mode of database on storage node, switches node level mode if database is empty.
+func (cli *readOnlyCli) SetReadOnly(node models.Node, database string, readOnly bool) (*models.ReadOnlyState, error) {
+	rs := &models.ReadOnlyState{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(map[string]string{
+			"db":       database,
+			"readOnly": strconv.FormatBool(readOnly),
+		}).
+		SetHeader("Accept", "application/json").
+		SetResult(rs).
+		Put(address + constants.APIVersion1CliPath + "/state/readonly")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("set read-only mode of %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
+
+// FetchReadOnlyState fetches the read-only state of storage node.
+func (cli *readOnlyCli) FetchReadOnlyState(node models.Node) (*models.ReadOnlyState, error) {
+	rs := &models.ReadOnlyState{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetHeader("Accept", "application/json").
+		SetResult(rs).
+		Get(address + constants.APIVersion1CliPath + "/state/readonly")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("fetch read-only state of %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
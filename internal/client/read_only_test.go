@@ -0,0 +1,77 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestReadOnlyCli_SetReadOnly(t *testing.T) {
+	cli := NewReadOnlyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/state/readonly", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "true", r.URL.Query().Get("readOnly"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"readOnly":false,"databases":["db"]}`))
+	})
+	rs, err := cli.SetReadOnly(node, "db", true)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ReadOnlyState{Databases: []string{"db"}}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.SetReadOnly(node, "db", true)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.SetReadOnly(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "", true)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func TestReadOnlyCli_FetchReadOnlyState(t *testing.T) {
+	cli := NewReadOnlyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/state/readonly", r.URL.Path)
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"readOnly":true}`))
+	})
+	rs, err := cli.FetchReadOnlyState(node)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ReadOnlyState{ReadOnly: true}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.FetchReadOnlyState(node)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.FetchReadOnlyState(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
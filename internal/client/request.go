@@ -34,16 +34,23 @@ import (
 type RequestCli interface {
 	// FetchRequestsByNodes fetches the pending requests by target nodes.
 	FetchRequestsByNodes(nodes []models.Node) []*models.Request
+	// FetchRequestStateByNodes fetches the execution state of request by target nodes,
+	// only returns the state of nodes which are executing the request.
+	FetchRequestStateByNodes(requestID string, nodes []models.Node) []*models.RequestNodeState
 }
 
 // requestCli implements RequestCli interface.
 type requestCli struct {
+	Base
 	logger *logger.Logger
 }
 
 // NewReqeustCli creates a request fetch client instance.
 func NewRequestCli() RequestCli {
 	return &requestCli{
+		Base: Base{
+			cli: resty.New(),
+		},
 		logger: logger.GetLogger("Client", "Request"),
 	}
 }
@@ -64,7 +71,7 @@ func (cli *requestCli) FetchRequestsByNodes(nodes []models.Node) []*models.Reque
 			node := nodes[i]
 			address := node.HTTPAddress()
 			var stats []*models.Request
-			_, err := resty.New().R().
+			_, err := cli.cli.R().
 				SetHeader("Accept", "application/json").
 				SetResult(&stats).
 				Get(address + constants.APIVersion1CliPath + "/state/requests")
@@ -91,3 +98,51 @@ func (cli *requestCli) FetchRequestsByNodes(nodes []models.Node) []*models.Reque
 	})
 	return rs
 }
+
+// FetchRequestStateByNodes fetches the execution state of request by target nodes,
+// only returns the state of nodes which are executing the request.
+func (cli *requestCli) FetchRequestStateByNodes(requestID string, nodes []models.Node) []*models.RequestNodeState {
+	size := len(nodes)
+	if size == 0 {
+		return nil
+	}
+	result := make([]*models.RequestNodeState, size)
+	var wait sync.WaitGroup
+	wait.Add(size)
+	for idx := range nodes {
+		i := idx
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			address := node.HTTPAddress()
+			var stages []*models.StageStats
+			resp, err := cli.cli.R().
+				SetHeader("Accept", "application/json").
+				SetQueryParam("requestId", requestID).
+				SetResult(&stages).
+				Get(address + constants.APIVersion1CliPath + "/state/request")
+			if err != nil {
+				cli.logger.Error("get request state from alive node", logger.String("url", address),
+					logger.String("requestID", requestID), logger.Error(err))
+				return
+			}
+			if !resp.IsSuccess() {
+				// request not executing on this node
+				return
+			}
+			result[i] = &models.RequestNodeState{
+				Node:   node.Indicator(),
+				Stages: stages,
+			}
+		}()
+	}
+	wait.Wait()
+
+	var rs []*models.RequestNodeState
+	for _, state := range result {
+		if state != nil {
+			rs = append(rs, state)
+		}
+	}
+	return rs
+}
@@ -80,3 +80,31 @@ func TestRequestCli_FetchRequestsByNodes(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestCli_FetchRequestStateByNodes(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("content-type", "application/json")
+		if r.URL.Query().Get("requestId") != "req-id" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"identifier":"ShardScan","state":"Executing","operator":"DataLoad"}]`))
+	}))
+	defer svr.Close()
+	u, err := url.Parse(svr.URL)
+	assert.NoError(t, err)
+	p, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+	node := &models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(p)}
+
+	cli := NewRequestCli()
+	assert.Nil(t, cli.FetchRequestStateByNodes("req-id", nil))
+	// request not found/node unavailable
+	assert.Empty(t, cli.FetchRequestStateByNodes("other-id", []models.Node{node}))
+	assert.Empty(t, cli.FetchRequestStateByNodes("req-id", []models.Node{&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 3000}}))
+
+	rs := cli.FetchRequestStateByNodes("req-id", []models.Node{node})
+	assert.Len(t, rs, 1)
+	assert.Equal(t, node.Indicator(), rs[0].Node)
+	assert.Equal(t, "DataLoad", rs[0].Stages[0].Operator)
+}
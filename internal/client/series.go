@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./series.go -destination=./series_mock.go -package=client
+
+// SeriesCli represents series management client of storage node.
+type SeriesCli interface {
+	// DeleteSeries deletes the series of database's shard which match the delete series statement from storage node.
+	DeleteSeries(node models.Node, database string, shardID models.ShardID, sql string) (*models.SeriesDeletion, error)
+}
+
+// seriesCli implements SeriesCli interface.
+type seriesCli struct{}
+
+// NewSeriesCli creates a SeriesCli instance.
+func NewSeriesCli() SeriesCli {
+	return &seriesCli{}
+}
+
+// DeleteSeries deletes the series of database's shard which match the delete series statement from storage node.
+func (cli *seriesCli) DeleteSeries(node models.Node, database string,
+	shardID models.ShardID, sql string,
+) (*models.SeriesDeletion, error) {
+	rs := &models.SeriesDeletion{}
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(map[string]string{
+			"db":    database,
+			"shard": shardID.String(),
+			"sql":   sql,
+		}).
+		SetHeader("Accept", "application/json").
+		SetResult(rs).
+		Put(address + constants.APIVersion1CliPath + "/state/tsdb/series/delete")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("delete series from %s failure, status: %d, error: %s", address, resp.StatusCode(), resp.String())
+	}
+	return rs, nil
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestSeriesCli_DeleteSeries(t *testing.T) {
+	cli := NewSeriesCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/state/tsdb/series/delete", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "2", r.URL.Query().Get("shard"))
+		assert.Equal(t, "delete series from cpu where host='x'", r.URL.Query().Get("sql"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"shardId":2,"numOfSeries":10}`))
+	})
+	rs, err := cli.DeleteSeries(node, "db", 2, "delete series from cpu where host='x'")
+	assert.NoError(t, err)
+	assert.Equal(t, &models.SeriesDeletion{ShardID: 2, NumOfSeries: 10}, rs)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	rs, err = cli.DeleteSeries(node, "db", 2, "delete series from cpu where host='x'")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// connect failure
+	rs, err = cli.DeleteSeries(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, "db", 2, "sql")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
@@ -0,0 +1,177 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./topology.go -destination=./topology_mock.go -package=client
+
+// TopologyCli represents cluster topology fetch client.
+type TopologyCli interface {
+	// FetchBrokerTopology fetches the topology of broker cluster from broker node.
+	FetchBrokerTopology(node models.Node, lag bool) (*models.BrokerTopology, error)
+	// FetchReplicaState fetches the wal replica state of database from storage node.
+	FetchReplicaState(node models.Node, database string) ([]models.FamilyLogReplicaState, error)
+	// FetchSegmentState fetches the segment state of database's shard from storage node.
+	FetchSegmentState(node models.Node, database string, shardID models.ShardID) ([]models.SegmentState, error)
+	// FetchMemoryDatabaseState fetches the state of database's data families(memory database) from storage node.
+	FetchMemoryDatabaseState(node models.Node, database string) ([]models.DataFamilyState, error)
+	// FetchDiskUsage fetches the disk usage of databases from storage node, fetches all databases if database is empty.
+	FetchDiskUsage(node models.Node, database string) ([]*models.DatabaseDiskUsage, error)
+	// FetchFieldUsage fetches the query-time access counts of metric's fields since given timestamp from storage node.
+	FetchFieldUsage(node models.Node, database, namespace, metricName string, since int64) (models.FieldUsages, error)
+	// FetchExpiredMetrics fetches the unused metrics found by last expiry check of database from storage node.
+	FetchExpiredMetrics(node models.Node, database string) (models.ExpiredMetrics, error)
+	// FetchReplicaChannelState fetches the write channel state of database from broker node,
+	// fetches all databases if database is empty.
+	FetchReplicaChannelState(node models.Node, database string) ([]*models.ReplicaChannelState, error)
+	// FetchFileDetail fetches the detail of data family's file from storage node.
+	FetchFileDetail(node models.Node, database string, shardID models.ShardID, familyTime, fileNumber int64) (*models.DataFileDetail, error)
+}
+
+// topologyCli implements TopologyCli interface.
+type topologyCli struct{}
+
+// NewTopologyCli creates a TopologyCli instance.
+func NewTopologyCli() TopologyCli {
+	return &topologyCli{}
+}
+
+// FetchBrokerTopology fetches the topology of broker cluster from broker node.
+func (cli *topologyCli) FetchBrokerTopology(node models.Node, lag bool) (*models.BrokerTopology, error) {
+	topology := &models.BrokerTopology{}
+	if err := cli.get(node, "/state/topology", map[string]string{"lag": strconv.FormatBool(lag)}, topology); err != nil {
+		return nil, err
+	}
+	return topology, nil
+}
+
+// FetchReplicaState fetches the wal replica state of database from storage node.
+func (cli *topologyCli) FetchReplicaState(node models.Node, database string) ([]models.FamilyLogReplicaState, error) {
+	var state []models.FamilyLogReplicaState
+	if err := cli.get(node, "/state/replica", map[string]string{"db": database}, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// FetchSegmentState fetches the segment state of database's shard from storage node.
+func (cli *topologyCli) FetchSegmentState(node models.Node, database string, shardID models.ShardID) ([]models.SegmentState, error) {
+	var state []models.SegmentState
+	params := map[string]string{"db": database, "shard": shardID.String()}
+	if err := cli.get(node, "/state/tsdb/segment", params, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// FetchMemoryDatabaseState fetches the state of database's data families(memory database) from storage node.
+func (cli *topologyCli) FetchMemoryDatabaseState(node models.Node, database string) ([]models.DataFamilyState, error) {
+	var state []models.DataFamilyState
+	if err := cli.get(node, "/state/tsdb/memory", map[string]string{"db": database}, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// FetchDiskUsage fetches the disk usage of databases from storage node, fetches all databases if database is empty.
+func (cli *topologyCli) FetchDiskUsage(node models.Node, database string) ([]*models.DatabaseDiskUsage, error) {
+	var usage []*models.DatabaseDiskUsage
+	if err := cli.get(node, "/state/tsdb/disk", map[string]string{"db": database}, &usage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// FetchFieldUsage fetches the query-time access counts of metric's fields since given timestamp from storage node.
+func (cli *topologyCli) FetchFieldUsage(node models.Node, database, namespace, metricName string,
+	since int64,
+) (models.FieldUsages, error) {
+	var usage models.FieldUsages
+	params := map[string]string{
+		"db":     database,
+		"ns":     namespace,
+		"metric": metricName,
+		"since":  strconv.FormatInt(since, 10),
+	}
+	if err := cli.get(node, "/state/tsdb/field/usage", params, &usage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// FetchExpiredMetrics fetches the unused metrics found by last expiry check of database from storage node.
+func (cli *topologyCli) FetchExpiredMetrics(node models.Node, database string) (models.ExpiredMetrics, error) {
+	var metrics models.ExpiredMetrics
+	if err := cli.get(node, "/state/tsdb/metric/expired", map[string]string{"db": database}, &metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// FetchReplicaChannelState fetches the write channel state of database from broker node,
+// fetches all databases if database is empty.
+func (cli *topologyCli) FetchReplicaChannelState(node models.Node, database string) ([]*models.ReplicaChannelState, error) {
+	var state []*models.ReplicaChannelState
+	if err := cli.get(node, "/state/replica/channel", map[string]string{"db": database}, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// FetchFileDetail fetches the detail of data family's file from storage node.
+func (cli *topologyCli) FetchFileDetail(node models.Node, database string, shardID models.ShardID,
+	familyTime, fileNumber int64,
+) (*models.DataFileDetail, error) {
+	detail := &models.DataFileDetail{}
+	params := map[string]string{
+		"db":         database,
+		"shard":      shardID.String(),
+		"familyTime": strconv.FormatInt(familyTime, 10),
+		"fileNumber": strconv.FormatInt(fileNumber, 10),
+	}
+	if err := cli.get(node, "/state/tsdb/family/file/detail", params, detail); err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// get fetches the state from target node.
+func (cli *topologyCli) get(node models.Node, path string, params map[string]string, result interface{}) error {
+	address := node.HTTPAddress()
+	resp, err := resty.New().R().
+		SetQueryParams(params).
+		SetHeader("Accept", "application/json").
+		SetResult(result).
+		Get(address + constants.APIVersion1CliPath + path)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("fetch state from %s failure, status: %d", address, resp.StatusCode())
+	}
+	return nil
+}
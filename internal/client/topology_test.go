@@ -0,0 +1,237 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func newTopologyTestNode(t *testing.T, handler http.HandlerFunc) models.Node {
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+	u, err := url.Parse(svr.URL)
+	assert.NoError(t, err)
+	p, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+	return &models.StatelessNode{HostIP: u.Hostname(), HTTPPort: uint16(p)}
+}
+
+func TestTopologyCli_FetchBrokerTopology(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/topology", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("lag"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"nodes":[{"id":"1.1.1.1:9000"}],"storages":[{"name":"s"}]}`))
+	})
+	topology, err := cli.FetchBrokerTopology(node, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.1.1:9000", topology.Nodes[0].ID)
+	assert.Equal(t, "s", topology.Storages[0].Name)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	topology, err = cli.FetchBrokerTopology(node, false)
+	assert.Error(t, err)
+	assert.Nil(t, topology)
+	// connect failure
+	topology, err = cli.FetchBrokerTopology(&models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: 1}, false)
+	assert.Error(t, err)
+	assert.Nil(t, topology)
+}
+
+func TestTopologyCli_FetchReplicaState(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/replica", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"shardId":1,"replicators":[{"replicator":"2","pending":10}]}]`))
+	})
+	state, err := cli.FetchReplicaState(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, models.ShardID(1), state[0].ShardID)
+	assert.Equal(t, int64(10), state[0].Replicators[0].Pending)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	state, err = cli.FetchReplicaState(node, "db")
+	assert.Error(t, err)
+	assert.Nil(t, state)
+}
+
+func TestTopologyCli_FetchSegmentState(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/segment", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "2", r.URL.Query().Get("shard"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"interval":"10s","name":"20220101","families":[{"family":"10","numOfFiles":2}]}]`))
+	})
+	state, err := cli.FetchSegmentState(node, "db", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "20220101", state[0].Name)
+	assert.Equal(t, 2, state[0].Families[0].NumOfFiles)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	state, err = cli.FetchSegmentState(node, "db", 2)
+	assert.Error(t, err)
+	assert.Nil(t, state)
+}
+
+func TestTopologyCli_FetchMemoryDatabaseState(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/memory", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"shardId":1,"numOfSeries":10,"writeRate":2.5}]`))
+	})
+	state, err := cli.FetchMemoryDatabaseState(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, state[0].NumOfSeries)
+	assert.Equal(t, 2.5, state[0].WriteRate)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	state, err = cli.FetchMemoryDatabaseState(node, "db")
+	assert.Error(t, err)
+	assert.Nil(t, state)
+}
+
+func TestTopologyCli_FetchDiskUsage(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/disk", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"database":"db","meta":10,"shards":[{"shardId":1,"data":100}]}]`))
+	})
+	usage, err := cli.FetchDiskUsage(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(110), usage[0].Total())
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	usage, err = cli.FetchDiskUsage(node, "db")
+	assert.Error(t, err)
+	assert.Nil(t, usage)
+}
+
+func TestTopologyCli_FetchFieldUsage(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/field/usage", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "ns", r.URL.Query().Get("ns"))
+		assert.Equal(t, "cpu", r.URL.Query().Get("metric"))
+		assert.Equal(t, "100", r.URL.Query().Get("since"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"namespace":"ns","metric":"cpu","field":"f","type":"sum","count":10}]`))
+	})
+	usage, err := cli.FetchFieldUsage(node, "db", "ns", "cpu", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), usage[0].Count)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	usage, err = cli.FetchFieldUsage(node, "db", "ns", "cpu", 100)
+	assert.Error(t, err)
+	assert.Nil(t, usage)
+}
+
+func TestTopologyCli_FetchExpiredMetrics(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/metric/expired", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"namespace":"ns","metric":"cpu","lastActive":10,"removed":true}]`))
+	})
+	metrics, err := cli.FetchExpiredMetrics(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu", metrics[0].Metric)
+	assert.True(t, metrics[0].Removed)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	metrics, err = cli.FetchExpiredMetrics(node, "db")
+	assert.Error(t, err)
+	assert.Nil(t, metrics)
+}
+
+func TestTopologyCli_FetchReplicaChannelState(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/replica/channel", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`[{"database":"db","shardId":1,"pending":2,"pendingBytes":100}]`))
+	})
+	state, err := cli.FetchReplicaChannelState(node, "db")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), state[0].PendingBytes)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	state, err = cli.FetchReplicaChannelState(node, "db")
+	assert.Error(t, err)
+	assert.Nil(t, state)
+}
+
+func TestTopologyCli_FetchFileDetail(t *testing.T) {
+	cli := NewTopologyCli()
+	node := newTopologyTestNode(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/state/tsdb/family/file/detail", r.URL.Path)
+		assert.Equal(t, "db", r.URL.Query().Get("db"))
+		assert.Equal(t, "1", r.URL.Query().Get("shard"))
+		assert.Equal(t, "100", r.URL.Query().Get("familyTime"))
+		assert.Equal(t, "3", r.URL.Query().Get("fileNumber"))
+		w.Header().Add("content-type", "application/json")
+		_, _ = w.Write([]byte(`{"file":"000003.sst","size":100,"numOfSeries":10}`))
+	})
+	detail, err := cli.FetchFileDetail(node, "db", 1, 100, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "000003.sst", detail.File)
+	assert.Equal(t, uint64(10), detail.NumOfSeries)
+
+	node = newTopologyTestNode(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	detail, err = cli.FetchFileDetail(node, "db", 1, 100, 3)
+	assert.Error(t, err)
+	assert.Nil(t, detail)
+}
@@ -0,0 +1,351 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+)
+
+// tenantIdleTimeout is the time after which idle tenant(no pending/running tasks) is evicted with its metrics,
+// tenant which submits task again is treated as new idle tenant.
+const tenantIdleTimeout = 5 * time.Minute
+
+// Quota represents the quota of tenant(database) in weighted fair pool.
+type Quota struct {
+	// Weight represents the share of running slots when tenants compete, default 1.
+	Weight int
+	// MaxConcurrency represents the max running tasks of tenant, 0 means unlimited.
+	MaxConcurrency int
+}
+
+// QuotaFunc returns the quota of tenant, which is resolved when tenant submits task.
+type QuotaFunc func(tenant string) Quota
+
+// tenantKey represents the context key of tenant.
+type tenantKey struct{}
+
+// WithTenant returns a copy of parent context with the tenant(database) which task belongs to.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant from context, returns empty if not set.
+func TenantFromContext(ctx context.Context) string {
+	if tenant, ok := ctx.Value(tenantKey{}).(string); ok {
+		return tenant
+	}
+	return ""
+}
+
+// detachedContext keeps the values of parent context, but is never canceled,
+// so that dispatched task always reaches worker which releases the running slot.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (deadline time.Time, ok bool) { return }
+
+func (detachedContext) Done() <-chan struct{} { return nil }
+
+func (detachedContext) Err() error { return nil }
+
+// fairTask represents the task waiting in tenant queue.
+type fairTask struct {
+	ctx         context.Context
+	task        *Task
+	enqueueTime time.Time
+}
+
+// tenantQueue represents the pending tasks and scheduling state of tenant.
+type tenantQueue struct {
+	name    string
+	quota   Quota
+	pass    float64 // virtual time of tenant, advances 1/weight when dispatching a task
+	running int
+	tasks   []*fairTask
+
+	activeTime time.Time // last time of submitting or completing task
+
+	statistics *metrics.FairPoolStatistics
+}
+
+// setQuota sets the quota of tenant, weight is 1 if not set.
+func (q *tenantQueue) setQuota(quota Quota) {
+	if quota.Weight <= 0 {
+		quota.Weight = 1
+	}
+	if quota.MaxConcurrency < 0 {
+		quota.MaxConcurrency = 0
+	}
+	q.quota = quota
+	q.statistics.Weight.Update(float64(quota.Weight))
+	q.statistics.MaxConcurrency.Update(float64(quota.MaxConcurrency))
+}
+
+// throttled returns if the running tasks of tenant reach the max concurrency.
+func (q *tenantQueue) throttled() bool {
+	return q.quota.MaxConcurrency > 0 && q.running >= q.quota.MaxConcurrency
+}
+
+// pop pops the task from the head of queue.
+func (q *tenantQueue) pop() *fairTask {
+	task := q.tasks[0]
+	q.tasks[0] = nil
+	q.tasks = q.tasks[1:]
+	q.statistics.Pending.Decr()
+	return task
+}
+
+// fairPool represents the pool which isolates tenants(databases) sharing the underlying pool,
+// the running slots are dispatched to tenants by weighted fair scheduling(stride scheduling),
+// so that one noisy tenant cannot starve others.
+type fairPool struct {
+	name       string
+	pool       Pool
+	maxRunning int
+	quotaFn    QuotaFunc
+	registry   *linmetric.Registry
+
+	tenants      map[string]*tenantQueue
+	idleTimeout  time.Duration // idle tenant is evicted after it
+	lastEviction time.Time     // last time of checking idle tenants
+	running      int
+	virtualTime  float64 // pass of the latest dispatched tenant
+	stopped      atomic.Bool
+	mutex        sync.Mutex
+}
+
+// NewFairPool returns a new weighted fair pool on top of the underlying pool,
+// maxRunning parameter specifies the maximum number of tasks which are dispatched to the underlying pool,
+// tasks without tenant in context are submitted to the underlying pool directly.
+func NewFairPool(name string, pool Pool, maxRunning int, quotaFn QuotaFunc, registry *linmetric.Registry) Pool {
+	if maxRunning < 1 {
+		maxRunning = 1
+	}
+	return &fairPool{
+		name:        name,
+		pool:        pool,
+		maxRunning:  maxRunning,
+		quotaFn:     quotaFn,
+		registry:    registry,
+		tenants:     make(map[string]*tenantQueue),
+		idleTimeout: tenantIdleTimeout,
+	}
+}
+
+// Submit enqueues a callable task into the queue of tenant which gets from ctx,
+// then dispatches tasks to the underlying pool if there are free running slots.
+func (p *fairPool) Submit(ctx context.Context, task *Task) {
+	if task.handle == nil {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tenant := TenantFromContext(ctx)
+	if tenant == "" {
+		p.pool.Submit(ctx, task)
+		return
+	}
+	var quota Quota
+	if p.quotaFn != nil {
+		quota = p.quotaFn(tenant)
+	}
+
+	p.mutex.Lock()
+	if p.Stopped() {
+		p.mutex.Unlock()
+		rejectTask(task)
+		return
+	}
+	now := time.Now()
+	p.evictIdleTenants(now)
+	q, ok := p.tenants[tenant]
+	if !ok {
+		q = &tenantQueue{
+			name:       tenant,
+			statistics: metrics.NewFairPoolStatistics(p.name, tenant, p.registry),
+		}
+		p.tenants[tenant] = q
+	}
+	q.setQuota(quota)
+	if len(q.tasks) == 0 && q.running == 0 && q.pass < p.virtualTime {
+		// idle tenant cannot save up credits for burst
+		q.pass = p.virtualTime
+	}
+	q.activeTime = now
+	q.tasks = append(q.tasks, &fairTask{ctx: ctx, task: task, enqueueTime: now})
+	q.statistics.Submitted.Incr()
+	q.statistics.Pending.Incr()
+	p.mutex.Unlock()
+
+	p.dispatch()
+}
+
+// dispatch dispatches pending tasks to the underlying pool until no free running slots.
+func (p *fairPool) dispatch() {
+	for {
+		q, ft, canceled := p.next()
+		if ft == nil {
+			return
+		}
+		if canceled != nil {
+			if ft.task.panicHandle != nil {
+				ft.task.panicHandle(canceled)
+			}
+			continue
+		}
+		task := ft.task
+		ctx := ft.ctx
+		// running slot is released once, either after executing or when rejected by the underlying pool
+		var released atomic.Bool
+		p.pool.Submit(detachedContext{Context: ctx}, &Task{
+			handle: func(_ context.Context) {
+				defer func() {
+					if released.CAS(false, true) {
+						p.release(q)
+					}
+				}()
+				if err := ctx.Err(); err != nil {
+					// task canceled(timeout) when waiting in the underlying pool
+					q.statistics.Rejected.Incr()
+					if task.panicHandle != nil {
+						task.panicHandle(err)
+					}
+					return
+				}
+				task.handle(ctx)
+			},
+			panicHandle: func(err error) {
+				if released.CAS(false, true) {
+					// task rejected(underlying pool stopped) without executing
+					q.statistics.Rejected.Incr()
+					p.release(q)
+				}
+				if task.panicHandle != nil {
+					task.panicHandle(err)
+				}
+			},
+			ctx:        ctx,
+			createTime: ft.enqueueTime,
+		})
+	}
+}
+
+// next picks the task of tenant which has the smallest virtual time and isn't throttled,
+// returns the error if the task is canceled when waiting in tenant queue, the running slot isn't taken for it.
+func (p *fairPool) next() (*tenantQueue, *fairTask, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.running >= p.maxRunning {
+		return nil, nil, nil
+	}
+	var selected *tenantQueue
+	for _, q := range p.tenants {
+		if len(q.tasks) == 0 {
+			continue
+		}
+		if q.throttled() {
+			q.statistics.Throttled.Incr()
+			continue
+		}
+		if selected == nil || q.pass < selected.pass {
+			selected = q
+		}
+	}
+	if selected == nil {
+		return nil, nil, nil
+	}
+	ft := selected.pop()
+	if err := ft.ctx.Err(); err != nil {
+		selected.statistics.Rejected.Incr()
+		return selected, ft, err
+	}
+	p.running++
+	selected.running++
+	selected.pass += 1 / float64(selected.quota.Weight)
+	p.virtualTime = selected.pass
+	selected.statistics.Running.Incr()
+	selected.statistics.Dispatched.Incr()
+	selected.statistics.WaitingTime.UpdateDuration(time.Since(ft.enqueueTime))
+	return selected, ft, nil
+}
+
+// release releases the running slot of tenant after task completed, then dispatches pending tasks.
+func (p *fairPool) release(q *tenantQueue) {
+	p.mutex.Lock()
+	p.running--
+	q.running--
+	q.activeTime = time.Now()
+	q.statistics.Running.Decr()
+	p.mutex.Unlock()
+
+	if !p.Stopped() {
+		p.dispatch()
+	}
+}
+
+// evictIdleTenants removes the tenants which are idle longer than idle timeout with their metrics,
+// checks once per idle timeout, must be called under lock.
+func (p *fairPool) evictIdleTenants(now time.Time) {
+	if now.Sub(p.lastEviction) < p.idleTimeout {
+		return
+	}
+	p.lastEviction = now
+	for name, q := range p.tenants {
+		if len(q.tasks) == 0 && q.running == 0 && now.Sub(q.activeTime) >= p.idleTimeout {
+			delete(p.tenants, name)
+			metrics.DeleteFairPoolStatistics(p.name, name, p.registry)
+		}
+	}
+}
+
+// Stopped returns true if this pool has been stopped.
+func (p *fairPool) Stopped() bool {
+	return p.stopped.Load()
+}
+
+// Stop rejects all pending tasks of tenants, then stops the underlying pool.
+func (p *fairPool) Stop() {
+	p.mutex.Lock()
+	if p.stopped.Swap(true) {
+		p.mutex.Unlock()
+		return
+	}
+	var pending []*fairTask
+	for _, q := range p.tenants {
+		for len(q.tasks) > 0 {
+			pending = append(pending, q.pop())
+			q.statistics.Rejected.Incr()
+		}
+	}
+	p.mutex.Unlock()
+
+	for _, ft := range pending {
+		rejectTask(ft.task)
+	}
+	p.pool.Stop()
+}
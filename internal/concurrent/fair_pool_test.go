@@ -0,0 +1,303 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+)
+
+func newTestFairPool(name string, maxRunning int, quotas map[string]Quota) Pool {
+	pool := NewPool(name, maxRunning, time.Second, metrics.NewConcurrentStatistics(name, linmetric.BrokerRegistry))
+	return NewFairPool(name, pool, maxRunning,
+		func(tenant string) Quota {
+			return quotas[tenant]
+		}, linmetric.BrokerRegistry)
+}
+
+func TestTenantFromContext(t *testing.T) {
+	assert.Empty(t, TenantFromContext(context.TODO()))
+	assert.Equal(t, "db", TenantFromContext(WithTenant(context.TODO(), "db")))
+
+	ctx, cancel := context.WithCancel(WithTenant(context.TODO(), "db"))
+	cancel()
+	detached := detachedContext{Context: ctx}
+	assert.NoError(t, detached.Err())
+	assert.Nil(t, detached.Done())
+	_, ok := detached.Deadline()
+	assert.False(t, ok)
+	assert.Equal(t, "db", TenantFromContext(detached))
+}
+
+func TestFairPool_Submit(t *testing.T) {
+	pool := NewFairPool("test-fair", NewPriorityPool("test-fair", 4, time.Second, linmetric.BrokerRegistry),
+		4, nil, linmetric.BrokerRegistry)
+	var wait sync.WaitGroup
+	for _, tenant := range []string{"", "db1", "db2"} {
+		wait.Add(1)
+		pool.Submit(WithTenant(context.TODO(), tenant), NewTask(func() {
+			wait.Done()
+		}, nil))
+	}
+	// nil handle/ctx
+	pool.Submit(context.TODO(), NewTask(nil, nil))
+	wait.Add(1)
+	pool.Submit(nil, NewTask(func() { //nolint:staticcheck
+		wait.Done()
+	}, nil))
+	wait.Wait()
+	pool.Stop()
+	pool.Stop()
+	assert.True(t, pool.Stopped())
+	// reject task after stopped
+	var rejected error
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {
+		panic("err")
+	}, func(err error) {
+		rejected = err
+	}))
+	assert.Equal(t, ErrPoolStopped, rejected)
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {
+		panic("err")
+	}, nil))
+}
+
+func TestFairPool_weight(t *testing.T) {
+	pool := newTestFairPool("test-fair-weight", 1, map[string]Quota{"db1": {Weight: 3}})
+	defer pool.Stop()
+
+	// block the only running slot, then queue tasks of tenants
+	block := make(chan struct{})
+	pool.Submit(WithTenant(context.TODO(), "db3"), NewTask(func() {
+		<-block
+	}, nil))
+	var (
+		wait  sync.WaitGroup
+		mutex sync.Mutex
+		order []string
+	)
+	for i := 0; i < 8; i++ {
+		for _, tenant := range []string{"db1", "db2"} {
+			tenant := tenant
+			wait.Add(1)
+			pool.Submit(WithTenant(context.TODO(), tenant), NewTask(func() {
+				mutex.Lock()
+				order = append(order, tenant)
+				mutex.Unlock()
+				wait.Done()
+			}, nil))
+		}
+	}
+	close(block)
+	wait.Wait()
+	// db1 gets 3 times running slots of db2 when competing
+	count := 0
+	for _, tenant := range order[:8] {
+		if tenant == "db1" {
+			count++
+		}
+	}
+	assert.Equal(t, 6, count)
+	assert.Len(t, order, 16)
+}
+
+func TestFairPool_MaxConcurrency(t *testing.T) {
+	pool := newTestFairPool("test-fair-max-concurrency", 4, map[string]Quota{"db1": {MaxConcurrency: 1}})
+	defer pool.Stop()
+
+	block := make(chan struct{})
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {
+		<-block
+	}, nil))
+	executed := make(chan string, 2)
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {
+		executed <- "db1"
+	}, nil))
+	pool.Submit(WithTenant(context.TODO(), "db2"), NewTask(func() {
+		executed <- "db2"
+	}, nil))
+	// db1 is throttled, but db2 can use free running slots
+	assert.Equal(t, "db2", <-executed)
+	select {
+	case <-executed:
+		t.Fatal("db1 should be throttled by max concurrency")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(block)
+	assert.Equal(t, "db1", <-executed)
+	p := pool.(*fairPool)
+	assert.True(t, p.tenants["db1"].statistics.Throttled.Get() > 0)
+
+	// invalid quota
+	q := &tenantQueue{statistics: p.tenants["db1"].statistics}
+	q.setQuota(Quota{Weight: -1, MaxConcurrency: -1})
+	assert.Equal(t, Quota{Weight: 1}, q.quota)
+	assert.Equal(t, 1, NewFairPool("test-fair-invalid", pool, 0, nil, linmetric.BrokerRegistry).(*fairPool).maxRunning)
+}
+
+func TestFairPool_canceled(t *testing.T) {
+	pool := newTestFairPool("test-fair-canceled", 1, nil)
+	p := pool.(*fairPool)
+
+	block := make(chan struct{})
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {
+		<-block
+	}, nil))
+	errCh := make(chan error, 2)
+	// case 1: canceled when waiting in tenant queue
+	ctx, cancel := context.WithCancel(WithTenant(context.TODO(), "db1"))
+	pool.Submit(ctx, NewTask(func() {
+		panic("err")
+	}, func(err error) {
+		errCh <- err
+	}))
+	pool.Submit(ctx, NewTask(func() {
+		panic("err")
+	}, nil))
+	cancel()
+	// case 2: panic releases the running slot
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {
+		panic("err")
+	}, func(err error) {
+		errCh <- err
+	}))
+	close(block)
+	assert.Equal(t, context.Canceled, <-errCh)
+	assert.Error(t, <-errCh)
+	p.mutex.Lock()
+	assert.Equal(t, 0, p.running)
+	// case 3: reject pending tasks when pool stopped
+	p.running = p.maxRunning
+	p.mutex.Unlock()
+	pool.Submit(WithTenant(context.TODO(), "db2"), NewTask(func() {
+		panic("err")
+	}, func(err error) {
+		errCh <- err
+	}))
+	pool.Submit(WithTenant(context.TODO(), "db2"), NewTask(func() {
+		panic("err")
+	}, nil))
+	pool.Stop()
+	assert.Equal(t, ErrPoolStopped, <-errCh)
+}
+
+// mockPool holds the submitted tasks without executing.
+type mockPool struct {
+	Pool
+	tasks []*Task
+}
+
+func (p *mockPool) Submit(ctx context.Context, task *Task) {
+	task.ctx = ctx
+	p.tasks = append(p.tasks, task)
+}
+
+func TestFairPool_canceledInPool(t *testing.T) {
+	underlying := &mockPool{}
+	pool := NewFairPool("test-fair-canceled-in-pool", underlying, 1, nil, linmetric.BrokerRegistry)
+	p := pool.(*fairPool)
+
+	ctx, cancel := context.WithCancel(WithTenant(context.TODO(), "db1"))
+	var canceled error
+	pool.Submit(ctx, NewTask(func() {
+		panic("err")
+	}, func(err error) {
+		canceled = err
+	}))
+	assert.Len(t, underlying.tasks, 1)
+	cancel()
+	// dispatched task is never canceled by the underlying pool
+	assert.NoError(t, underlying.tasks[0].ctx.Err())
+	underlying.tasks[0].Exec()
+	assert.Equal(t, context.Canceled, canceled)
+	assert.Equal(t, 0, p.running)
+	assert.Equal(t, 0, p.tenants["db1"].running)
+}
+
+func TestFairPool_rejectedByPool(t *testing.T) {
+	underlying := NewPool("test-fair-rejected", 1, time.Second,
+		metrics.NewConcurrentStatistics("test-fair-rejected", linmetric.BrokerRegistry))
+	underlying.Stop()
+	pool := NewFairPool("test-fair-rejected", underlying, 1, nil, linmetric.BrokerRegistry)
+	defer pool.Stop()
+	p := pool.(*fairPool)
+
+	var rejected []error
+	for _, tenant := range []string{"", "db1", "db1", "db2"} {
+		pool.Submit(WithTenant(context.TODO(), tenant), NewTask(func() {
+			panic("err")
+		}, func(err error) {
+			rejected = append(rejected, err)
+		}))
+	}
+	// running slots released by rejected tasks, all tasks are rejected
+	assert.Equal(t, []error{ErrPoolStopped, ErrPoolStopped, ErrPoolStopped, ErrPoolStopped}, rejected)
+	assert.Equal(t, 0, p.running)
+	assert.Equal(t, 0, p.tenants["db1"].running)
+	assert.Empty(t, p.tenants["db1"].tasks)
+}
+
+func TestFairPool_evictIdleTenants(t *testing.T) {
+	underlying := &mockPool{}
+	name := "test-fair-evict"
+	pool := NewFairPool(name, underlying, 2, nil, linmetric.BrokerRegistry)
+	p := pool.(*fairPool)
+	tenantMetrics := func(tenant string) map[string][]*models.StateMetric {
+		return linmetric.BrokerRegistry.FindMetricList([]string{"lindb.concurrent.fair_pool"},
+			map[string]string{"pool_name": name, "db": tenant})
+	}
+
+	for _, tenant := range []string{"db1", "db2"} {
+		pool.Submit(WithTenant(context.TODO(), tenant), NewTask(func() {}, nil))
+	}
+	assert.Len(t, underlying.tasks, 2)
+	underlying.tasks[0].Exec()
+	assert.NotEmpty(t, tenantMetrics("db1"))
+	// tenants become idle a while ago
+	p.mutex.Lock()
+	p.tenants["db1"].activeTime = time.Now().Add(-time.Hour)
+	p.tenants["db2"].activeTime = time.Now().Add(-time.Hour)
+	p.mutex.Unlock()
+	// checked once per idle timeout
+	pool.Submit(WithTenant(context.TODO(), "db3"), NewTask(func() {}, nil))
+	assert.Len(t, p.tenants, 3)
+
+	p.mutex.Lock()
+	p.lastEviction = time.Now().Add(-time.Hour)
+	p.mutex.Unlock()
+	pool.Submit(WithTenant(context.TODO(), "db3"), NewTask(func() {}, nil))
+	// idle tenant evicted with metrics, tenant with running task is kept
+	assert.Len(t, p.tenants, 2)
+	assert.NotContains(t, p.tenants, "db1")
+	assert.Contains(t, p.tenants, "db2")
+	assert.Empty(t, tenantMetrics("db1"))
+	assert.NotEmpty(t, tenantMetrics("db2"))
+
+	// evicted tenant submits task again
+	pool.Submit(WithTenant(context.TODO(), "db1"), NewTask(func() {}, nil))
+	assert.Contains(t, p.tenants, "db1")
+	assert.NotEmpty(t, tenantMetrics("db1"))
+}
@@ -24,9 +24,10 @@ import (
 	"time"
 
 	"github.com/lindb/lindb/metrics"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 )
 
-var ErrConcurrencyLimiterTimeout = errors.New("reaches the max concurrency for writing")
+var ErrConcurrencyLimiterTimeout = errorpkg.WithCode(errorpkg.LimitExceeded, errors.New("reaches the max concurrency for writing"))
 
 type Limiter struct {
 	ctx     context.Context
@@ -19,6 +19,7 @@ package concurrent
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -32,47 +33,65 @@ import (
 //go:generate mockgen -source=./pool.go -destination=./pool_mock.go -package=concurrent
 
 const (
-	// size of the queue that workers register their availability to the dispatcher.
-	readyWorkerQueueSize = 32
-	// size of the tasks queue
+	// size of the global tasks queue for each worker
 	tasksCapacity = 8
-	// sleeps in this interval when there are no available workers
-	sleepInterval = time.Millisecond * 5
+	// max number of tasks which worker takes from global queue into local queue at once
+	localBatchSize = 4
 )
 
+// ErrPoolStopped represents the task is rejected because pool has been stopped.
+var ErrPoolStopped = errors.New("pool is stopped")
+
 // Task represents a task function to be executed by a worker(goroutine).
 type Task struct {
-	// handle executes task function.
-	handle func()
-	// panicHandle executes callback if task happens panic.
+	// handle executes task function, ctx is the context which task submitted with.
+	handle func(ctx context.Context)
+	// panicHandle executes callback if task happens panic or task is canceled before executing.
 	panicHandle func(err error)
+	// ctx is the context which task submitted with, task can observe cancellation by it.
+	ctx context.Context
 
 	createTime time.Time
 }
 
-// NewTask creates a task.
+// NewTask creates a task which doesn't care about the context.
 func NewTask(handle func(), panicHandle func(err error)) *Task {
+	if handle == nil {
+		return NewContextTask(nil, panicHandle)
+	}
+	return NewContextTask(func(_ context.Context) {
+		handle()
+	}, panicHandle)
+}
+
+// NewContextTask creates a task with context-aware handle,
+// the handle should check ctx.Err() at block boundaries for cooperative cancellation.
+func NewContextTask(handle func(ctx context.Context), panicHandle func(err error)) *Task {
 	return &Task{
 		handle:      handle,
 		panicHandle: panicHandle,
+		ctx:         context.Background(),
 		createTime:  time.Now(),
 	}
 }
 
+// Exec executes the task function with the context which task submitted with.
 func (t *Task) Exec() {
-	t.handle()
+	t.handle(t.ctx)
 }
 
 // Pool represents the goroutine pool that executes submitted tasks.
 type Pool interface {
 	// Submit enqueues a callable task for a worker to execute.
 	//
-	// Each submitted task is immediately given to a ready worker.
-	// If there are no available workers, the dispatcher starts a new worker,
+	// Each submitted task is put into the global queue, idle workers take tasks from it.
+	// If there are no idle workers, a new worker is started,
 	// until the maximum number of workers are added.
 	//
-	// After the maximum number of workers are running, and no workers are ready,
+	// After the maximum number of workers are running, and the global queue is full,
 	// execute function will be blocked.
+	//
+	// If the pool has been stopped, the task is rejected, panic handle of task is called with ErrPoolStopped.
 	Submit(ctx context.Context, task *Task)
 	// Stopped returns true if this pool has been stopped.
 	Stopped() bool
@@ -81,17 +100,24 @@ type Pool interface {
 	Stop()
 }
 
-// workerPool is a pool for goroutines.
+// workerPool is a work-stealing pool for goroutines,
+// each worker has a local queue, takes a batch of tasks from the global queue(injector) into local queue,
+// steals tasks from the local queues of other workers when both local queue and global queue are empty,
+// idle workers are woken up to steal when tasks are taken into local queue.
 type workerPool struct {
-	name                string
-	maxWorkers          int
-	tasks               chan *Task    // tasks channel
-	readyWorkers        chan *worker  // available worker
-	idleTimeout         time.Duration // idle goroutine recycle time
-	onDispatcherStopped chan struct{} // signal that dispatcher is stopped
-	stopped             atomic.Bool   // mark if the pool is closed or not
-	ctx                 context.Context
-	cancel              context.CancelFunc
+	name        string
+	maxWorkers  int
+	injector    chan *Task    // global tasks queue
+	stealable   chan struct{} // notifies idle workers that tasks are taken into local queue
+	idleTimeout time.Duration // idle goroutine recycle time
+	stopped     atomic.Bool   // mark if the pool is closed or not
+	ctx         context.Context
+	cancel      context.CancelFunc
+
+	workers     []*worker    // alive workers, for stealing
+	idleWorkers atomic.Int32 // decreased under mutex when idle worker exits
+	workersWait sync.WaitGroup
+	mutex       sync.RWMutex
 
 	statistics *metrics.ConcurrentStatistics
 
@@ -109,116 +135,152 @@ func NewPool(name string, maxWorkers int, idleTimeout time.Duration, statistics
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	pool := &workerPool{
-		name:                name,
-		maxWorkers:          maxWorkers,
-		tasks:               make(chan *Task, tasksCapacity),
-		readyWorkers:        make(chan *worker, readyWorkerQueueSize),
-		idleTimeout:         idleTimeout,
-		onDispatcherStopped: make(chan struct{}),
-		stopped:             *atomic.NewBool(false),
-		ctx:                 ctx,
-		cancel:              cancel,
-		statistics:          statistics,
-		logger:              logger.GetLogger("Pool", name),
-	}
-	go pool.dispatch()
+		name:        name,
+		maxWorkers:  maxWorkers,
+		injector:    make(chan *Task, tasksCapacity*maxWorkers),
+		stealable:   make(chan struct{}, maxWorkers),
+		idleTimeout: idleTimeout,
+		ctx:         ctx,
+		cancel:      cancel,
+		statistics:  statistics,
+		logger:      logger.GetLogger("Pool", name),
+	}
 	return pool
 }
 
 func (p *workerPool) Submit(ctx context.Context, task *Task) {
-	if task.handle == nil || p.Stopped() {
+	if task.handle == nil {
 		return
 	}
-	select {
-	case <-ctx.Done():
+	if p.Stopped() {
 		p.statistics.TasksRejected.Incr()
+		rejectTask(task)
 		return
-	case p.tasks <- task:
 	}
-}
-
-// mustGetWorker makes sure that a ready worker is return
-func (p *workerPool) mustGetWorker() *worker {
-	var worker *worker
-	for {
+	if ctx != nil {
+		task.ctx = ctx
+	}
+	// idle worker cannot exit when holding read lock, so it will take the task if enqueued
+	p.mutex.RLock()
+	if p.idleWorkers.Load() > 0 {
 		select {
-		// got a worker
-		case worker = <-p.readyWorkers:
-			return worker
+		case p.injector <- task:
+			p.mutex.RUnlock()
+			return
 		default:
-			if int(p.statistics.WorkersAlive.Get()) >= p.maxWorkers {
-				// no available workers
-				time.Sleep(sleepInterval)
-				continue
-			}
-			w := newWorker(p)
-			return w
 		}
 	}
+	p.mutex.RUnlock()
+
+	// no idle workers(or global queue is full), try to start a new worker
+	p.tryStartWorker()
+	select {
+	case <-task.ctx.Done():
+		p.statistics.TasksRejected.Incr()
+		return
+	case p.injector <- task:
+	}
+	// all workers maybe exit with idle timeout before task enqueued
+	p.ensureWorker()
 }
 
-func (p *workerPool) dispatch() {
-	defer func() {
-		p.onDispatcherStopped <- struct{}{}
-	}()
+// rejectTask notifies the submitter that task is rejected by stopped pool.
+func rejectTask(task *Task) {
+	if task.panicHandle != nil {
+		task.panicHandle(ErrPoolStopped)
+	}
+}
 
-	idleTimeoutTimer := time.NewTimer(p.idleTimeout)
-	defer idleTimeoutTimer.Stop()
-	var (
-		worker *worker
-		task   *Task
-	)
+// tryStartWorker starts a new worker if the number of workers not reaches the max workers.
+func (p *workerPool) tryStartWorker() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
 
-	for {
-		idleTimeoutTimer.Reset(p.idleTimeout)
-		select {
-		case <-p.ctx.Done():
-			return
-		case task = <-p.tasks:
-			worker = p.mustGetWorker()
-			worker.execute(task)
-		case <-idleTimeoutTimer.C:
-			p.idle()
+	if len(p.workers) >= p.maxWorkers || p.Stopped() {
+		return
+	}
+	p.startWorker()
+}
+
+// ensureWorker starts a new worker if there is no alive worker.
+func (p *workerPool) ensureWorker() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.workers) > 0 || p.Stopped() {
+		return
+	}
+	p.startWorker()
+}
+
+// startWorker starts a new worker, must hold the lock.
+func (p *workerPool) startWorker() {
+	w := newWorker(p)
+	p.workers = append(p.workers, w)
+	p.workersWait.Add(1)
+	go w.process()
+}
+
+// tryExitIdle removes the idle worker if global queue is empty, returns true if worker can exit,
+// checks global queue, decreases idle workers and removes worker under lock, so no task is enqueued without worker.
+func (p *workerPool) tryExitIdle(w *worker) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.injector) > 0 {
+		return false
+	}
+	p.idleWorkers.Dec()
+	p.removeWorkerLocked(w)
+	return true
+}
+
+// removeWorker removes the exited worker.
+func (p *workerPool) removeWorker(w *worker) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.removeWorkerLocked(w)
+}
+
+// removeWorkerLocked removes the exited worker, must hold the lock.
+func (p *workerPool) removeWorkerLocked(w *worker) {
+	for idx, worker := range p.workers {
+		if worker == w {
+			p.workers = append(p.workers[:idx], p.workers[idx+1:]...)
+			break
 		}
 	}
+	p.statistics.WorkersKilled.Incr()
+	p.statistics.WorkersAlive.Decr()
 }
 
-func (p *workerPool) idle() {
-	// timed out waiting, kill a ready worker
-	if p.statistics.WorkersAlive.Get() > 0 {
-		select {
-		case worker := <-p.readyWorkers:
-			worker.stop(func() {})
-		case <-p.ctx.Done():
-			// pool is stopped
-		default:
-			// workers are busy now
+// steal steals half tasks from the local queue of other worker.
+func (p *workerPool) steal(thief *worker) *Task {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	for _, victim := range p.workers {
+		if victim == thief {
+			continue
+		}
+		if tasks := victim.local.stealHalf(); len(tasks) > 0 {
+			thief.local.push(tasks[1:]...)
+			return tasks[0]
 		}
 	}
+	return nil
 }
 
 func (p *workerPool) Stopped() bool {
 	return p.stopped.Load()
 }
 
-// stopWorkers stops all workers
-func (p *workerPool) stopWorkers() {
-	var wg sync.WaitGroup
-	for p.statistics.WorkersAlive.Get() > 0 {
-		wg.Add(1)
-		worker := <-p.readyWorkers
-		worker.stop(func() {
-			wg.Done()
-		})
-	}
-	wg.Wait()
-}
-
-// consumedRemainingTasks consumes all buffered tasks in the channel
+// consumedRemainingTasks consumes all buffered tasks in the global queue
 func (p *workerPool) consumedRemainingTasks() {
 	for {
 		select {
-		case task := <-p.tasks:
+		case task := <-p.injector:
 			p.execTask(task)
 		default:
 			return
@@ -241,71 +303,194 @@ func (p *workerPool) execTask(task *Task) {
 		}
 	}()
 	p.statistics.TasksWaitingTime.UpdateDuration(time.Since(task.createTime))
+	if err := task.ctx.Err(); err != nil {
+		// task canceled(timeout) when waiting in queue, skip it
+		p.statistics.TasksCanceled.Incr()
+		if task.panicHandle != nil {
+			task.panicHandle(err)
+		}
+		return
+	}
 	task.Exec()
 	p.statistics.TasksExecutingTime.UpdateDuration(time.Since(task.createTime))
 
 	p.statistics.TasksConsumed.Incr()
 }
 
-// Stop tells the dispatcher to exit with pending tasks done.
+// Stop tells the workers to exit with pending tasks done.
 func (p *workerPool) Stop() {
 	if p.stopped.Swap(true) {
 		return
 	}
-	// close dispatcher
+	// make sure no more workers started
+	p.mutex.Lock()
+	p.mutex.Unlock() //nolint:staticcheck
+	// notify all workers exit after all queues consumed
 	p.cancel()
-	// wait dispatcher's exit
-	<-p.onDispatcherStopped
-	// close all workers
-	p.stopWorkers()
+	// wait all workers' exit
+	p.workersWait.Wait()
 	// consume remaining tasks
 	p.consumedRemainingTasks()
 }
 
+// localQueue represents the local tasks queue of worker.
+type localQueue struct {
+	tasks []*Task
+	mutex sync.Mutex
+}
+
+// push pushes tasks into the tail of queue.
+func (q *localQueue) push(tasks ...*Task) {
+	if len(tasks) == 0 {
+		return
+	}
+	q.mutex.Lock()
+	q.tasks = append(q.tasks, tasks...)
+	q.mutex.Unlock()
+}
+
+// pop pops a task from the head of queue, returns nil if empty.
+func (q *localQueue) pop() *Task {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.tasks) == 0 {
+		return nil
+	}
+	task := q.tasks[0]
+	q.tasks[0] = nil
+	q.tasks = q.tasks[1:]
+	return task
+}
+
+// stealHalf steals half tasks from the tail of queue.
+func (q *localQueue) stealHalf() []*Task {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	n := len(q.tasks)
+	if n == 0 {
+		return nil
+	}
+	half := n - n/2
+	stolen := make([]*Task, half)
+	copy(stolen, q.tasks[n-half:])
+	for i := n - half; i < n; i++ {
+		q.tasks[i] = nil
+	}
+	q.tasks = q.tasks[:n-half]
+	return stolen
+}
+
 // worker represents the worker that executes the task
 type worker struct {
-	pool   *workerPool
-	tasks  chan *Task
-	stopCh chan struct{}
+	pool  *workerPool
+	local localQueue
 }
 
-// newWorker creates the worker that executes tasks given by the dispatcher
-// When a new worker starts, it registers itself on the createdWorkers channel.
+// newWorker creates the worker that executes tasks from local queue/global queue/other workers.
 func newWorker(pool *workerPool) *worker {
 	w := &worker{
-		pool:   pool,
-		tasks:  make(chan *Task),
-		stopCh: make(chan struct{}),
+		pool: pool,
 	}
 	w.pool.statistics.WorkersAlive.Incr()
 	w.pool.statistics.WorkersCreated.Incr()
-	go w.process()
 	return w
 }
 
-// execute submits the task to queue
-func (w *worker) execute(task *Task) {
-	w.tasks <- task
+// next returns the next task, order: local queue => global queue => steal from other workers,
+// blocks until task got, returns nil if worker exited(idle timeout or pool stopped).
+func (w *worker) next(idleTimer *time.Timer) *Task {
+	if task := w.local.pop(); task != nil {
+		return task
+	}
+	select {
+	case task := <-w.pool.injector:
+		w.takeBatch()
+		return task
+	default:
+	}
+	if task := w.pool.steal(w); task != nil {
+		return task
+	}
+	// no tasks, waiting
+	w.pool.idleWorkers.Inc()
+	for {
+		idleTimer.Reset(w.pool.idleTimeout)
+		select {
+		case task := <-w.pool.injector:
+			stopTimer(idleTimer)
+			w.pool.idleWorkers.Dec()
+			w.takeBatch()
+			return task
+		case <-w.pool.stealable:
+			stopTimer(idleTimer)
+			if task := w.pool.steal(w); task != nil {
+				w.pool.idleWorkers.Dec()
+				return task
+			}
+		case <-idleTimer.C:
+			if w.pool.tryExitIdle(w) {
+				return nil
+			}
+		case <-w.pool.ctx.Done():
+			stopTimer(idleTimer)
+			w.pool.idleWorkers.Dec()
+			w.pool.removeWorker(w)
+			return nil
+		}
+	}
+}
+
+// takeBatch takes a batch of tasks from global queue into local queue,
+// wakes up idle workers to steal them, avoids tasks blocked behind a slow task.
+func (w *worker) takeBatch() {
+	var batch [localBatchSize]*Task
+	n := 0
+	for n < localBatchSize {
+		select {
+		case task := <-w.pool.injector:
+			batch[n] = task
+			n++
+			continue
+		default:
+		}
+		break
+	}
+	if n == 0 {
+		return
+	}
+	w.local.push(batch[:n]...)
+	for i := 0; i < n; i++ {
+		select {
+		case w.pool.stealable <- struct{}{}:
+		default:
+			return
+		}
+	}
 }
 
-func (w *worker) stop(callable func()) {
-	defer callable()
-	w.stopCh <- struct{}{}
-	w.pool.statistics.WorkersKilled.Incr()
-	w.pool.statistics.WorkersAlive.Decr()
+// stopTimer stops the timer and drains its channel.
+func stopTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
 }
 
-// process task from queue
+// process executes tasks until idle timeout or pool stopped.
 func (w *worker) process() {
-	var task *Task
+	defer w.pool.workersWait.Done()
+
+	idleTimer := time.NewTimer(w.pool.idleTimeout)
+	idleTimer.Stop()
 	for {
-		select {
-		case <-w.stopCh:
-			return
-		case task = <-w.tasks:
-			w.pool.execTask(task)
-			// register worker-self to readyWorkers again
-			w.pool.readyWorkers <- w
+		task := w.next(idleTimer)
+		if task == nil {
+			break
 		}
+		w.pool.execTask(task)
 	}
 }
@@ -55,6 +55,13 @@ func Test_Pool_Submit(t *testing.T) {
 	go do(100)
 	<-finished
 	assert.Equal(t, int32(100), c.Load())
+	var rejected error
+	pool.Submit(context.TODO(), NewTask(func() {
+		c.Inc()
+	}, func(err error) {
+		rejected = err
+	}))
+	assert.Equal(t, ErrPoolStopped, rejected)
 }
 
 func TestPool_Submit_PanicTask(t *testing.T) {
@@ -91,22 +98,186 @@ func TestPool_Submit_Task_Timeout(t *testing.T) {
 }
 
 func TestPool_idle(t *testing.T) {
-	p := NewPool("test", 0, time.Millisecond*100, statistics)
+	p := NewPool("test", 2, time.Millisecond*100, statistics)
+	defer p.Stop()
+	p1 := p.(*workerPool)
 	// no worker
-	time.Sleep(time.Second)
+	time.Sleep(200 * time.Millisecond)
+	assert.Empty(t, p1.workers)
+
+	var wait sync.WaitGroup
+	wait.Add(1)
+	p.Submit(context.TODO(), NewTask(func() {
+		wait.Done()
+	}, nil))
+	wait.Wait()
+	// worker exits after idle timeout
+	time.Sleep(500 * time.Millisecond)
+	p1.mutex.RLock()
+	assert.Empty(t, p1.workers)
+	p1.mutex.RUnlock()
+	// restart worker when submit task again
+	wait.Add(1)
+	p.Submit(context.TODO(), NewTask(func() {
+		wait.Done()
+	}, nil))
+	wait.Wait()
+}
+
+func TestPool_steal(t *testing.T) {
+	p := NewPool("test", 2, time.Second, statistics)
+	p1 := p.(*workerPool)
+	victim := &worker{pool: p1}
+	thief := &worker{pool: p1}
+	p1.workers = []*worker{victim, thief}
+	assert.Nil(t, p1.steal(thief))
+
+	var c atomic.Int32
+	for i := 0; i < 5; i++ {
+		victim.local.push(NewTask(func() {
+			c.Inc()
+		}, nil))
+	}
+	// steal half tasks
+	task := p1.steal(thief)
+	assert.NotNil(t, task)
+	assert.Len(t, victim.local.tasks, 2)
+	assert.Len(t, thief.local.tasks, 2)
+	task.Exec()
+	for _, w := range []*worker{victim, thief} {
+		for task := w.local.pop(); task != nil; task = w.local.pop() {
+			task.Exec()
+		}
+	}
+	assert.Equal(t, int32(5), c.Load())
+	p1.workers = nil
+	p.Stop()
+}
+
+func TestPool_Stop_RemainingTasks(t *testing.T) {
+	p := NewPool("test", 1, time.Second, statistics)
+	var c atomic.Int32
+	block := make(chan struct{})
+	p.Submit(context.TODO(), NewTask(func() {
+		<-block
+	}, nil))
+	for i := 0; i < 5; i++ {
+		p.Submit(context.TODO(), NewTask(func() {
+			c.Inc()
+		}, nil))
+	}
+	time.AfterFunc(50*time.Millisecond, func() {
+		close(block)
+	})
+	p.Stop()
+	assert.Equal(t, int32(5), c.Load())
+}
+
+func TestPool_Submit_ContextTask(t *testing.T) {
+	p := NewPool("test", 1, time.Second, statistics)
+	defer p.Stop()
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	var wait sync.WaitGroup
+	wait.Add(1)
+	p.Submit(ctx, NewContextTask(func(taskCtx context.Context) {
+		assert.Equal(t, ctx, taskCtx)
+		wait.Done()
+	}, nil))
+	wait.Wait()
+}
+
+func TestPool_Submit_CanceledTask(t *testing.T) {
+	p := NewPool("test", 1, time.Second, statistics)
+	defer p.Stop()
+	block := make(chan struct{})
+	p.Submit(context.TODO(), NewTask(func() {
+		<-block
+	}, nil))
+	ctx, cancel := context.WithCancel(context.TODO())
+	errCh := make(chan error, 1)
+	p.Submit(ctx, NewTask(func() {
+		panic("task canceled, cannot be executed")
+	}, func(err error) {
+		errCh <- err
+	}))
+	// cancel task when waiting in queue
+	cancel()
+	close(block)
+	assert.Equal(t, context.Canceled, <-errCh)
+}
+
+func BenchmarkPool_Submit(b *testing.B) {
+	pool := NewPool("bench", 16, time.Second, statistics)
+	defer pool.Stop()
+	var wait sync.WaitGroup
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			wait.Add(1)
+			pool.Submit(context.TODO(), NewTask(func() {
+				wait.Done()
+			}, nil))
+		}
+	})
+	wait.Wait()
+}
+
+func BenchmarkPool_Latency(b *testing.B) {
+	pool := NewPool("bench", 16, time.Second, statistics)
+	defer pool.Stop()
+	done := make(chan struct{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pool.Submit(context.TODO(), NewTask(func() {
+			done <- struct{}{}
+		}, nil))
+		<-done
+	}
+}
+
+func TestPool_steal_when_idle(t *testing.T) {
+	p := NewPool("test", 2, time.Second, statistics)
+	defer p.Stop()
+	p1 := p.(*workerPool)
+	var wait sync.WaitGroup
+	wait.Add(1)
+	// idle worker
+	p.Submit(context.TODO(), NewTask(func() {
+		wait.Done()
+	}, nil))
+	wait.Wait()
+	busy := &worker{pool: p1}
+	p1.mutex.Lock()
+	p1.workers = append(p1.workers, busy)
+	p1.mutex.Unlock()
+	// tasks taken into local queue of busy worker, idle worker steals them
+	wait.Add(2)
+	for i := 0; i < 2; i++ {
+		busy.local.push(NewTask(func() {
+			wait.Done()
+		}, nil))
+		p1.stealable <- struct{}{}
+	}
+	wait.Wait()
+	p1.removeWorker(busy)
+}
 
+func TestPool_tryExitIdle(t *testing.T) {
+	p := NewPool("test", 1, time.Second, statistics)
 	p1 := p.(*workerPool)
-	p1.statistics.WorkersAlive.Incr()
-	p1.readyWorkers <- newWorker(p1)
-	ch := make(chan struct{})
-	go func() {
-		p1.idle()
-		time.Sleep(10 * time.Millisecond)
-		p1.cancel()
-		time.Sleep(10 * time.Millisecond)
-		p1.idle()
-		ch <- struct{}{}
-	}()
-	p1.idle()
-	<-ch
+	w := &worker{pool: p1}
+	p1.workers = []*worker{w}
+	p1.idleWorkers.Inc()
+	// task enqueued, cannot exit
+	p1.injector <- NewTask(func() {}, nil)
+	assert.False(t, p1.tryExitIdle(w))
+	assert.Len(t, p1.workers, 1)
+	<-p1.injector
+	assert.True(t, p1.tryExitIdle(w))
+	assert.Empty(t, p1.workers)
+	assert.Zero(t, p1.idleWorkers.Load())
+	p.Stop()
 }
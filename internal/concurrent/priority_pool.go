@@ -0,0 +1,204 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+)
+
+// Priority represents the priority class of task.
+type Priority int32
+
+const (
+	// Interactive represents the task of user query(default).
+	Interactive Priority = iota
+	// Background represents the task of batch/report query, yields to interactive tasks.
+	Background
+	// System represents the task of internal query(monitoring, cluster management).
+	System
+
+	numOfPriorities = 3
+)
+
+const (
+	// starvationThreshold represents the yield time which the background task is treated as starved.
+	starvationThreshold = time.Second
+	// defaultYieldInterval represents the interval which the background task yields to interactive tasks.
+	defaultYieldInterval = 5 * time.Millisecond
+)
+
+var priorityNames = [numOfPriorities]string{"interactive", "background", "system"}
+
+// String returns the name of priority class.
+func (p Priority) String() string {
+	if p < 0 || p >= numOfPriorities {
+		return "unknown"
+	}
+	return priorityNames[p]
+}
+
+// ParsePriority parses the priority class by name, returns interactive if name is empty.
+func ParsePriority(name string) (Priority, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return Interactive, nil
+	}
+	for idx, priorityName := range priorityNames {
+		if priorityName == name {
+			return Priority(idx), nil
+		}
+	}
+	return Interactive, fmt.Errorf("unknown priority class: %s", name)
+}
+
+// priorityKey represents the context key of priority class.
+type priorityKey struct{}
+
+// yielderKey represents the context key of the pool which background task yields to.
+type yielderKey struct{}
+
+// WithPriority returns a copy of parent context with the priority class.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority class from context, returns interactive if not set.
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok && priority >= 0 && priority < numOfPriorities {
+		return priority
+	}
+	return Interactive
+}
+
+// Yield is the preemption point of background task, long-running task calls it between units of work,
+// it blocks until interactive queue not backs up, returns ctx err if ctx done while yielding.
+// It returns immediately if task is not a background task of priority pool.
+func Yield(ctx context.Context) error {
+	p, ok := ctx.Value(yielderKey{}).(*priorityPool)
+	if !ok {
+		return nil
+	}
+	if !p.yield(ctx) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// priorityPool represents the pool which executes tasks with separate queues for each priority class,
+// background tasks yield to interactive tasks when interactive queue backs up,
+// both before executing and at each preemption point(Yield) while executing.
+type priorityPool struct {
+	pools   [numOfPriorities]Pool
+	backlog [numOfPriorities]atomic.Int32 // number of submitters blocked by full queue
+
+	yieldInterval time.Duration
+	statistics    *metrics.PriorityPoolStatistics
+}
+
+// NewPriorityPool returns a new priority pool, which has a worker pool for each priority class,
+// interactive pool has maxWorkers, background pool has half of maxWorkers, system pool has a quarter of maxWorkers.
+func NewPriorityPool(name string, maxWorkers int, idleTimeout time.Duration, registry *linmetric.Registry) Pool {
+	p := &priorityPool{
+		yieldInterval: defaultYieldInterval,
+		statistics:    metrics.NewPriorityPoolStatistics(name, registry),
+	}
+	workers := [numOfPriorities]int{maxWorkers, maxWorkers / 2, maxWorkers / 4}
+	for idx := range p.pools {
+		poolName := name + "-" + Priority(idx).String()
+		p.pools[idx] = NewPool(poolName, workers[idx], idleTimeout,
+			metrics.NewConcurrentStatistics(poolName, registry))
+	}
+	return p
+}
+
+// Submit enqueues a callable task into the queue of priority class which gets from ctx.
+func (p *priorityPool) Submit(ctx context.Context, task *Task) {
+	if task.handle == nil {
+		return
+	}
+	if p.Stopped() {
+		rejectTask(task)
+		return
+	}
+	priority := PriorityFromContext(ctx)
+	if priority == Background {
+		handle := task.handle
+		task.handle = func(ctx context.Context) {
+			// task can yield to interactive tasks at preemption point while executing
+			ctx = context.WithValue(ctx, yielderKey{}, p)
+			// yield to interactive tasks before executing
+			if p.yield(ctx) {
+				handle(ctx)
+				return
+			}
+			if task.panicHandle != nil {
+				task.panicHandle(ctx.Err())
+			}
+		}
+	}
+	p.backlog[priority].Inc()
+	p.pools[priority].Submit(ctx, task)
+	p.backlog[priority].Dec()
+}
+
+// yield waits until interactive queue not backs up, returns false if ctx done.
+func (p *priorityPool) yield(ctx context.Context) bool {
+	if p.backlog[Interactive].Load() == 0 {
+		return true
+	}
+	p.statistics.Preempted.Incr()
+	start := time.Now()
+	defer func() {
+		waiting := time.Since(start)
+		p.statistics.PreemptedWaiting.UpdateDuration(waiting)
+		if waiting >= starvationThreshold {
+			p.statistics.Starved.Incr()
+		}
+	}()
+	timer := time.NewTimer(p.yieldInterval)
+	defer timer.Stop()
+	for p.backlog[Interactive].Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			timer.Reset(p.yieldInterval)
+		}
+	}
+	return true
+}
+
+// Stopped returns true if this pool has been stopped.
+func (p *priorityPool) Stopped() bool {
+	return p.pools[Interactive].Stopped()
+}
+
+// Stop stops the pools of all priority classes.
+func (p *priorityPool) Stop() {
+	for _, pool := range p.pools {
+		pool.Stop()
+	}
+}
@@ -0,0 +1,144 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+func TestParsePriority(t *testing.T) {
+	for _, priority := range []Priority{Interactive, Background, System} {
+		p, err := ParsePriority(priority.String())
+		assert.NoError(t, err)
+		assert.Equal(t, priority, p)
+	}
+	p, err := ParsePriority(" Background ")
+	assert.NoError(t, err)
+	assert.Equal(t, Background, p)
+	p, err = ParsePriority("")
+	assert.NoError(t, err)
+	assert.Equal(t, Interactive, p)
+	_, err = ParsePriority("unknown")
+	assert.Error(t, err)
+	assert.Equal(t, "unknown", Priority(10).String())
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	assert.Equal(t, Interactive, PriorityFromContext(context.TODO()))
+	assert.Equal(t, System, PriorityFromContext(WithPriority(context.TODO(), System)))
+	assert.Equal(t, Interactive, PriorityFromContext(WithPriority(context.TODO(), Priority(10))))
+}
+
+func TestPriorityPool_Submit(t *testing.T) {
+	pool := NewPriorityPool("test-priority", 4, time.Second, linmetric.BrokerRegistry)
+	var wait sync.WaitGroup
+	for _, priority := range []Priority{Interactive, Background, System} {
+		wait.Add(1)
+		pool.Submit(WithPriority(context.TODO(), priority), NewTask(func() {
+			wait.Done()
+		}, nil))
+	}
+	wait.Wait()
+	pool.Stop()
+	assert.True(t, pool.Stopped())
+	// reject task after stopped
+	pool.Submit(context.TODO(), NewTask(func() {
+		panic("err")
+	}, nil))
+	var rejected error
+	pool.Submit(context.TODO(), NewTask(func() {
+		panic("err")
+	}, func(err error) {
+		rejected = err
+	}))
+	assert.Equal(t, ErrPoolStopped, rejected)
+}
+
+func TestPriorityPool_yield(t *testing.T) {
+	pool := NewPriorityPool("test-priority-yield", 4, time.Second, linmetric.BrokerRegistry)
+	defer pool.Stop()
+	p := pool.(*priorityPool)
+	p.yieldInterval = time.Millisecond
+
+	// case 1: interactive queue not backs up
+	assert.True(t, p.yield(context.TODO()))
+	// case 2: yield until interactive queue drained
+	p.backlog[Interactive].Inc()
+	executed := make(chan struct{})
+	pool.Submit(WithPriority(context.TODO(), Background), NewTask(func() {
+		close(executed)
+	}, nil))
+	select {
+	case <-executed:
+		t.Fatal("background task should yield to interactive task")
+	case <-time.After(50 * time.Millisecond):
+	}
+	p.backlog[Interactive].Dec()
+	<-executed
+	assert.Equal(t, float64(1), p.statistics.Preempted.Get())
+	// case 3: ctx done when yield
+	p.backlog[Interactive].Inc()
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, p.yield(ctx))
+	p.backlog[Interactive].Dec()
+}
+
+func TestPriorityPool_Yield(t *testing.T) {
+	pool := NewPriorityPool("test-priority-preempt", 4, time.Second, linmetric.BrokerRegistry)
+	defer pool.Stop()
+	p := pool.(*priorityPool)
+	p.yieldInterval = time.Millisecond
+
+	// not background task, no preemption point
+	assert.NoError(t, Yield(context.TODO()))
+
+	started := make(chan struct{})
+	preempt := make(chan struct{})
+	resumed := make(chan error)
+	pool.Submit(WithPriority(context.TODO(), Background), NewContextTask(func(ctx context.Context) {
+		close(started)
+		<-preempt
+		resumed <- Yield(ctx)
+	}, nil))
+	<-started
+	// interactive queue backs up, background task is preempted at preemption point
+	p.backlog[Interactive].Inc()
+	close(preempt)
+	select {
+	case <-resumed:
+		t.Fatal("background task should yield to interactive task while executing")
+	case <-time.After(50 * time.Millisecond):
+	}
+	p.backlog[Interactive].Dec()
+	assert.NoError(t, <-resumed)
+
+	// ctx done while yielding
+	p.backlog[Interactive].Inc()
+	defer p.backlog[Interactive].Dec()
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, Yield(context.WithValue(ctx, yielderKey{}, p)))
+}
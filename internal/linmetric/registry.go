@@ -19,6 +19,7 @@ package linmetric
 
 import (
 	"io"
+	"strings"
 	"sync"
 
 	commonseries "github.com/lindb/common/series"
@@ -97,6 +98,22 @@ func (r *Registry) FindMetricList(names []string, includeTags map[string]string)
 	return result
 }
 
+// DeleteScope deletes the series of metric(includes child scopes) which tags contain given tags,
+// deleted series are not gathered any more.
+func (r *Registry) DeleteScope(metricName string, tagList ...string) {
+	tags := tagList2Tags(tagList...).Map()
+	prefix := metricName + "."
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for seriesID, s := range r.series {
+		if (s.metricName == metricName || strings.HasPrefix(s.metricName, prefix)) && isMapSubset(s.tags.Map(), tags) {
+			delete(r.series, seriesID)
+		}
+	}
+}
+
 // register registers a named metric
 func (r *Registry) register(seriesID uint64, series *taggedSeries) *taggedSeries {
 	r.mu.Lock()
@@ -37,3 +37,19 @@ func TestRegistry_FindMetricList(t *testing.T) {
 	rs = r.FindMetricList([]string{"test-1"}, map[string]string{"a": "a-1"})
 	assert.Len(t, rs["test-1"], 1)
 }
+
+func TestRegistry_DeleteScope(t *testing.T) {
+	r := &Registry{
+		series: make(map[uint64]*taggedSeries),
+	}
+	scope := r.NewScope("test", "a", "a-1", "b", "b")
+	scope.NewCounter("f")
+	scope.Scope("child").NewCounter("f")
+	r.NewScope("test", "a", "a-2", "b", "b").NewCounter("f")
+	r.NewScope("test-2", "a", "a-1", "b", "b").NewCounter("f")
+
+	r.DeleteScope("test", "a", "a-1")
+	assert.Len(t, r.FindMetricList([]string{"test"}, nil)["test"], 1)
+	assert.Empty(t, r.FindMetricList([]string{"test.child"}, nil))
+	assert.Len(t, r.FindMetricList([]string{"test-2"}, nil)["test-2"], 1)
+}
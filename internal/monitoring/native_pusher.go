@@ -53,6 +53,7 @@ type nativeProtoPusher struct {
 	endpoint        string // HTTP endpoint
 	globalKeyValues tag.Tags
 	gather          linmetric.Gather
+	exporter        RemoteExporter
 	client          *http.Client
 	buffer          *bytes.Buffer
 	gzipWriter      *gzip.Writer
@@ -64,7 +65,8 @@ type nativeProtoPusher struct {
 	}
 }
 
-// NewNativeProtoPusher creates a new native pusher
+// NewNativeProtoPusher creates a new native pusher,
+// also ships the gathered metric data to remote target if exporter isn't nil.
 func NewNativeProtoPusher(
 	ctx context.Context,
 	endpoint string,
@@ -72,6 +74,7 @@ func NewNativeProtoPusher(
 	pushTimeout time.Duration,
 	r *linmetric.Registry,
 	globalKeyValues tag.Tags,
+	exporter RemoteExporter,
 ) NativePusher {
 	c, cancel := context.WithCancel(ctx)
 	pusher := &nativeProtoPusher{
@@ -84,8 +87,9 @@ func NewNativeProtoPusher(
 			linmetric.WithReadRuntimeOption(newRuntimeObserver(r)),
 			linmetric.WithGlobalKeyValueOption(globalKeyValues),
 		),
-		client: &http.Client{Timeout: pushTimeout},
-		buffer: &bytes.Buffer{},
+		exporter: exporter,
+		client:   &http.Client{Timeout: pushTimeout},
+		buffer:   &bytes.Buffer{},
 	}
 
 	monitorScope := r.NewScope("lindb.monitor")
@@ -122,6 +126,10 @@ func (np *nativeProtoPusher) Stop() {
 
 func (np *nativeProtoPusher) gatherAndMarshal() {
 	data, count := np.gather.Gather()
+	if np.exporter != nil {
+		// gather resets the delta value, so shares the gathered data with remote exporter
+		np.exporter.Export(data)
+	}
 
 	np.gzipWriter.Reset(np.buffer)
 	_, _ = np.gzipWriter.Write(data)
@@ -22,6 +22,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"github.com/lindb/lindb/internal/linmetric"
 )
 
@@ -33,6 +35,7 @@ func Test_NativeProtoPusher(t *testing.T) {
 		time.Millisecond,
 		linmetric.BrokerRegistry,
 		nil,
+		nil,
 	)
 	go pusher.Start()
 	time.Sleep(time.Second)
@@ -40,3 +43,21 @@ func Test_NativeProtoPusher(t *testing.T) {
 
 	pusher.(*nativeProtoPusher).push(nil)
 }
+
+func Test_NativeProtoPusher_Exporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exporter := NewMockRemoteExporter(ctrl)
+	exporter.EXPECT().Export(gomock.Any()).AnyTimes()
+	pusher := NewNativeProtoPusher(
+		context.Background(),
+		"http://localhost:12345",
+		time.Millisecond*100,
+		time.Millisecond,
+		linmetric.BrokerRegistry,
+		nil,
+		exporter,
+	)
+	pusher.(*nativeProtoPusher).gatherAndMarshal()
+}
@@ -0,0 +1,204 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/gzip"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// remoteEncoder encodes the gathered native flat metric data into the payload of remote protocol.
+type remoteEncoder interface {
+	// Encode encodes flat metric data into payload.
+	Encode(data []byte) ([]byte, error)
+	// Headers returns the http headers of payload.
+	Headers() map[string]string
+}
+
+// newRemoteEncoder creates the encoder of remote protocol.
+func newRemoteEncoder(protocol string) (remoteEncoder, error) {
+	switch strings.ToLower(protocol) {
+	case "", config.NativeRemoteProtocol:
+		return &nativeEncoder{}, nil
+	case config.PrometheusRemoteProtocol:
+		return &prometheusEncoder{rows: metric.NewStorageBatchRows()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported remote protocol: %s", protocol)
+	}
+}
+
+// nativeEncoder encodes flat metric data with gzip for LinDB native ingestion.
+type nativeEncoder struct{}
+
+// Encode compresses flat metric data with gzip.
+func (e *nativeEncoder) Encode(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Headers returns the headers of LinDB native ingestion.
+func (e *nativeEncoder) Headers() map[string]string {
+	return map[string]string{
+		"Content-Encoding": "gzip",
+		"Content-Type":     constants.ContentTypeFlat,
+	}
+}
+
+// prometheusLabel represents the label of Prometheus time series.
+type prometheusLabel struct {
+	name, value string
+}
+
+// prometheusEncoder encodes flat metric data into Prometheus remote write request(protobuf + snappy).
+// Field of metric is exported as time series named <metric>_<field>, the value of delta field
+// is the delta of report interval; histogram is exported as <metric>_bucket/_sum/_count.
+type prometheusEncoder struct {
+	rows      *metric.StorageBatchRows
+	labels    []prometheusLabel
+	sorted    []prometheusLabel
+	series    []byte
+	request   []byte
+	timestamp int64
+}
+
+// Encode converts flat metric data into Prometheus remote write request.
+func (e *prometheusEncoder) Encode(data []byte) ([]byte, error) {
+	e.request = e.request[:0]
+	e.rows.UnmarshalRows(data)
+	rows := e.rows.Rows()
+	for idx := range rows {
+		row := &rows[idx]
+		name := sanitizePrometheusName(string(row.Name()))
+		e.timestamp = row.Timestamp()
+		e.labels = e.labels[:0]
+		if ns := string(row.NameSpace()); ns != "" && ns != commonconstants.DefaultNamespace {
+			e.labels = append(e.labels, prometheusLabel{name: "namespace", value: ns})
+		}
+		kvItr := row.NewKeyValueIterator()
+		for kvItr.HasNext() {
+			e.labels = append(e.labels, prometheusLabel{
+				name:  sanitizePrometheusName(string(kvItr.NextKey())),
+				value: string(kvItr.NextValue()),
+			})
+		}
+		tagsLen := len(e.labels)
+
+		fieldItr := row.NewSimpleFieldIterator()
+		for fieldItr.HasNext() {
+			e.appendSeries(name+"_"+sanitizePrometheusName(string(fieldItr.NextRawName())), fieldItr.NextValue())
+		}
+		if compoundItr, ok := row.NewCompoundFieldIterator(); ok {
+			// bucket of prometheus histogram is cumulative
+			cumulative := 0.0
+			for compoundItr.HasNextBucket() {
+				cumulative += compoundItr.NextValue()
+				e.labels = append(e.labels[:tagsLen], prometheusLabel{
+					name:  "le",
+					value: formatPrometheusBound(compoundItr.NextExplicitBound()),
+				})
+				e.appendSeries(name+"_bucket", cumulative)
+			}
+			e.labels = e.labels[:tagsLen]
+			e.appendSeries(name+"_sum", compoundItr.Sum())
+			e.appendSeries(name+"_count", compoundItr.Count())
+		}
+	}
+	return snappy.Encode(nil, e.request), nil
+}
+
+// Headers returns the headers of Prometheus remote write.
+func (e *prometheusEncoder) Headers() map[string]string {
+	return map[string]string{
+		"Content-Encoding":                  "snappy",
+		"Content-Type":                      "application/x-protobuf",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+	}
+}
+
+// appendSeries appends a time series with current labels into write request.
+//
+//	WriteRequest { repeated TimeSeries timeseries = 1; }
+//	TimeSeries   { repeated Label labels = 1; repeated Sample samples = 2; }
+//	Label        { string name = 1; string value = 2; }
+//	Sample       { double value = 1; int64 timestamp = 2; }
+func (e *prometheusEncoder) appendSeries(name string, value float64) {
+	e.sorted = append(append(e.sorted[:0], e.labels...), prometheusLabel{name: "__name__", value: name})
+	// labels of time series must be sorted by name
+	sort.SliceStable(e.sorted, func(i, j int) bool {
+		return e.sorted[i].name < e.sorted[j].name
+	})
+	e.series = e.series[:0]
+	for _, label := range e.sorted {
+		e.series = protowire.AppendTag(e.series, 1, protowire.BytesType)
+		e.series = protowire.AppendVarint(e.series,
+			uint64(protowire.SizeTag(1)+protowire.SizeBytes(len(label.name))+protowire.SizeTag(2)+protowire.SizeBytes(len(label.value))))
+		e.series = protowire.AppendTag(e.series, 1, protowire.BytesType)
+		e.series = protowire.AppendString(e.series, label.name)
+		e.series = protowire.AppendTag(e.series, 2, protowire.BytesType)
+		e.series = protowire.AppendString(e.series, label.value)
+	}
+	e.series = protowire.AppendTag(e.series, 2, protowire.BytesType)
+	e.series = protowire.AppendVarint(e.series,
+		uint64(protowire.SizeTag(1)+protowire.SizeFixed64()+protowire.SizeTag(2)+protowire.SizeVarint(uint64(e.timestamp))))
+	e.series = protowire.AppendTag(e.series, 1, protowire.Fixed64Type)
+	e.series = protowire.AppendFixed64(e.series, math.Float64bits(value))
+	e.series = protowire.AppendTag(e.series, 2, protowire.VarintType)
+	e.series = protowire.AppendVarint(e.series, uint64(e.timestamp))
+
+	e.request = protowire.AppendTag(e.request, 1, protowire.BytesType)
+	e.request = protowire.AppendBytes(e.request, e.series)
+}
+
+// sanitizePrometheusName replaces the invalid characters of Prometheus metric/label name with '_'.
+func sanitizePrometheusName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// formatPrometheusBound formats the upper bound of histogram bucket.
+func formatPrometheusBound(bound float64) string {
+	if math.IsInf(bound, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(bound, 'f', -1, 64)
+}
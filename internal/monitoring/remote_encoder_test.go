@@ -0,0 +1,117 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+func TestNewRemoteEncoder(t *testing.T) {
+	encoder, err := newRemoteEncoder("")
+	assert.NoError(t, err)
+	assert.IsType(t, &nativeEncoder{}, encoder)
+	encoder, err = newRemoteEncoder("Prometheus")
+	assert.NoError(t, err)
+	assert.IsType(t, &prometheusEncoder{}, encoder)
+	encoder, err = newRemoteEncoder("graphite")
+	assert.Error(t, err)
+	assert.Nil(t, encoder)
+}
+
+func TestNativeEncoder_Encode(t *testing.T) {
+	encoder, _ := newRemoteEncoder(config.NativeRemoteProtocol)
+	payload, err := encoder.Encode([]byte("metric data"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, payload)
+	assert.Equal(t, "gzip", encoder.Headers()["Content-Encoding"])
+}
+
+func TestPrometheusEncoder_Encode(t *testing.T) {
+	scope := linmetric.RootRegistry.NewScope("lindb.test.remote_encoder", "node", "1.1.1.1:9000")
+	scope.NewCounter("write.count").Add(10)
+	scope.Scope("latency").NewHistogram().UpdateDuration(time.Millisecond)
+	data, _ := linmetric.RootRegistry.NewGather().Gather()
+
+	encoder, _ := newRemoteEncoder(config.PrometheusRemoteProtocol)
+	payload, err := encoder.Encode(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "snappy", encoder.Headers()["Content-Encoding"])
+	request, err := snappy.Decode(nil, payload)
+	assert.NoError(t, err)
+
+	series := decodePrometheusRequest(t, request)
+	assert.Equal(t, map[string]string{"__name__": "lindb_test_remote_encoder_write_count", "node": "1.1.1.1:9000"},
+		series["lindb_test_remote_encoder_write_count"])
+	assert.Contains(t, series, "lindb_test_remote_encoder_latency_bucket")
+	assert.Contains(t, series, "lindb_test_remote_encoder_latency_sum")
+	assert.Contains(t, series, "lindb_test_remote_encoder_latency_count")
+}
+
+func TestPrometheusName(t *testing.T) {
+	assert.Equal(t, "lindb_broker_write_count", sanitizePrometheusName("lindb.broker.write-count"))
+	assert.Equal(t, "+Inf", formatPrometheusBound(math.Inf(1)))
+	assert.Equal(t, "0.5", formatPrometheusBound(0.5))
+}
+
+// decodePrometheusRequest decodes the labels of time series in remote write request, key: metric name.
+func decodePrometheusRequest(t *testing.T, request []byte) map[string]map[string]string {
+	rs := make(map[string]map[string]string)
+	consumeMessage(t, request, func(_ protowire.Number, ts []byte) {
+		labels := make(map[string]string)
+		consumeMessage(t, ts, func(num protowire.Number, label []byte) {
+			if num != 1 {
+				return
+			}
+			var kv []string
+			consumeMessage(t, label, func(_ protowire.Number, v []byte) {
+				kv = append(kv, string(v))
+			})
+			labels[kv[0]] = kv[1]
+		})
+		rs[labels["__name__"]] = labels
+	})
+	return rs
+}
+
+// consumeMessage consumes the length-delimited fields of protobuf message.
+func consumeMessage(t *testing.T, msg []byte, fn func(num protowire.Number, value []byte)) {
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		assert.True(t, n > 0)
+		msg = msg[n:]
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, msg)
+			assert.True(t, n > 0)
+			msg = msg[n:]
+			continue
+		}
+		value, n := protowire.ConsumeBytes(msg)
+		assert.True(t, n > 0)
+		fn(num, value)
+		msg = msg[n:]
+	}
+}
@@ -0,0 +1,233 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+//go:generate mockgen -source ./remote_exporter.go -destination=./remote_exporter_mock.go -package=monitoring
+
+var remoteExportLogger = logger.GetLogger("Monitoring", "RemoteExporter")
+
+// RemoteExporter ships the self-metrics gathered by native pusher to remote target,
+// such as remote LinDB cluster or Prometheus remote write endpoint.
+type RemoteExporter interface {
+	// Start starts exporting the buffered metric data.
+	Start()
+	// Export encodes metric data and puts it into buffer, drops the oldest one if buffer is full.
+	Export(data []byte)
+	// Stop stops exporting.
+	Stop()
+}
+
+// remoteBatch represents the encoded payload of metric data waiting for exporting.
+type remoteBatch struct {
+	seq     uint64
+	payload []byte
+}
+
+// remoteExporter buffers the encoded metric data, pushes them via http in order,
+// retries the pending batches in period if remote target unavailable.
+type remoteExporter struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	endpoint      string
+	retryInterval time.Duration
+	bufferSize    int
+	encoder       remoteEncoder
+	client        *http.Client
+	notify        chan struct{}
+
+	seq     uint64
+	batches []remoteBatch
+	mutex   sync.Mutex
+
+	statistics struct {
+		exportBytes    *linmetric.BoundCounter
+		exportBatches  *linmetric.BoundCounter
+		exportFailures *linmetric.BoundCounter
+		dropBatches    *linmetric.BoundCounter
+		pendingBatches *linmetric.BoundGauge
+	}
+}
+
+// NewRemoteExporter creates a remote exporter based on monitor config.
+func NewRemoteExporter(ctx context.Context, cfg *config.Monitor, r *linmetric.Registry) (RemoteExporter, error) {
+	encoder, err := newRemoteEncoder(cfg.RemoteProtocol)
+	if err != nil {
+		return nil, err
+	}
+	bufferSize := cfg.RemoteBufferSize
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	c, cancel := context.WithCancel(ctx)
+	exporter := &remoteExporter{
+		ctx:           c,
+		cancel:        cancel,
+		endpoint:      cfg.RemoteURL,
+		retryInterval: cfg.RemoteRetryInterval.Duration(),
+		bufferSize:    bufferSize,
+		encoder:       encoder,
+		client:        &http.Client{Timeout: cfg.PushTimeout.Duration()},
+		notify:        make(chan struct{}, 1),
+	}
+	scope := r.NewScope("lindb.monitor").Scope("remote_exporter")
+	exporter.statistics.exportBytes = scope.NewCounter("export_bytes")
+	exporter.statistics.exportBatches = scope.NewCounter("export_batches")
+	exporter.statistics.exportFailures = scope.NewCounter("export_failures")
+	exporter.statistics.dropBatches = scope.NewCounter("drop_batches")
+	exporter.statistics.pendingBatches = scope.NewGauge("pending_batches")
+	return exporter, nil
+}
+
+// Start starts exporting the buffered metric data, waits retry interval after failure.
+func (e *remoteExporter) Start() {
+	remoteExportLogger.Info("remote exporter starting...", logger.String("endpoint", e.endpoint))
+	for {
+		select {
+		case <-e.notify:
+		case <-e.ctx.Done():
+			remoteExportLogger.Info("remote exporter stopped")
+			return
+		}
+		if e.flush() {
+			continue
+		}
+		select {
+		case <-time.After(e.retryInterval):
+			e.signal()
+		case <-e.ctx.Done():
+			remoteExportLogger.Info("remote exporter stopped")
+			return
+		}
+	}
+}
+
+// Export encodes metric data and puts it into buffer, drops the oldest one if buffer is full.
+func (e *remoteExporter) Export(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	payload, err := e.encoder.Encode(data)
+	if err != nil {
+		e.statistics.dropBatches.Incr()
+		remoteExportLogger.Error("encode metric data failure", logger.Error(err))
+		return
+	}
+	e.mutex.Lock()
+	if len(e.batches) >= e.bufferSize {
+		e.batches = e.batches[1:]
+		e.statistics.dropBatches.Incr()
+	}
+	e.seq++
+	e.batches = append(e.batches, remoteBatch{seq: e.seq, payload: payload})
+	e.statistics.pendingBatches.Update(float64(len(e.batches)))
+	e.mutex.Unlock()
+
+	e.signal()
+}
+
+// Stop stops exporting.
+func (e *remoteExporter) Stop() {
+	e.cancel()
+}
+
+// signal notifies exporting goroutine to flush the buffered batches.
+func (e *remoteExporter) signal() {
+	select {
+	case e.notify <- struct{}{}:
+	default:
+	}
+}
+
+// flush pushes the buffered batches in order, returns false if remote target unavailable.
+func (e *remoteExporter) flush() bool {
+	for {
+		e.mutex.Lock()
+		if len(e.batches) == 0 {
+			e.mutex.Unlock()
+			return true
+		}
+		batch := e.batches[0]
+		e.mutex.Unlock()
+
+		retry, err := e.push(batch.payload)
+		if err != nil {
+			e.statistics.exportFailures.Incr()
+			remoteExportLogger.Warn("export metric data to remote failure",
+				logger.String("endpoint", e.endpoint), logger.Error(err))
+			if retry {
+				return false
+			}
+			e.statistics.dropBatches.Incr()
+		} else {
+			e.statistics.exportBatches.Incr()
+			e.statistics.exportBytes.Add(float64(len(batch.payload)))
+		}
+		e.mutex.Lock()
+		// batch maybe dropped when buffer full during pushing
+		if len(e.batches) > 0 && e.batches[0].seq == batch.seq {
+			e.batches = e.batches[1:]
+		}
+		e.statistics.pendingBatches.Update(float64(len(e.batches)))
+		e.mutex.Unlock()
+	}
+}
+
+// push sends the payload to remote target, returns if the payload can be retried when failure.
+func (e *remoteExporter) push(payload []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(e.ctx, http.MethodPost, e.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	for key, value := range e.encoder.Headers() {
+		req.Header.Set(key, value)
+	}
+	resp, err := e.client.Do(req)
+	defer func() {
+		// need close resp body by defer, maybe resp is not nil when throw some err
+		if resp != nil && resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+	}()
+	if err != nil {
+		return true, err
+	}
+	switch {
+	case resp.StatusCode/100 == 2:
+		return false, nil
+	case resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests:
+		return true, fmt.Errorf("remote target unavailable, status code: %d", resp.StatusCode)
+	default:
+		// bad request, drop it
+		return false, fmt.Errorf("remote target rejected, status code: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,128 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+func TestNewRemoteExporter(t *testing.T) {
+	exporter, err := NewRemoteExporter(context.TODO(), &config.Monitor{RemoteProtocol: "graphite"}, linmetric.RootRegistry)
+	assert.Error(t, err)
+	assert.Nil(t, exporter)
+	exporter, err = NewRemoteExporter(context.TODO(), &config.Monitor{}, linmetric.RootRegistry)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, exporter.(*remoteExporter).bufferSize)
+}
+
+func TestRemoteExporter_Export(t *testing.T) {
+	var status atomic.Value
+	status.Store(http.StatusServiceUnavailable)
+	received := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+		code := status.Load().(int)
+		w.WriteHeader(code)
+		if code == http.StatusOK {
+			received <- struct{}{}
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.NewDefaultMonitor()
+	cfg.RemoteURL = server.URL
+	cfg.RemoteBufferSize = 2
+	cfg.RemoteRetryInterval = ltoml.Duration(10 * time.Millisecond)
+	exporter, err := NewRemoteExporter(context.TODO(), cfg, linmetric.RootRegistry)
+	assert.NoError(t, err)
+	e := exporter.(*remoteExporter)
+
+	// empty data
+	e.Export(nil)
+	assert.Empty(t, e.batches)
+	// remote unavailable, keep the latest batches
+	for i := 0; i < 3; i++ {
+		e.Export([]byte(fmt.Sprintf("data-%d", i)))
+	}
+	assert.Len(t, e.batches, 2)
+	assert.Equal(t, uint64(2), e.batches[0].seq)
+	assert.False(t, e.flush())
+	assert.Len(t, e.batches, 2)
+
+	// retry after remote recovered
+	go exporter.Start()
+	status.Store(http.StatusOK)
+	<-received
+	<-received
+	assert.Eventually(t, func() bool {
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		return len(e.batches) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	// bad request, drop batch
+	status.Store(http.StatusBadRequest)
+	e.Export([]byte("bad data"))
+	assert.Eventually(t, func() bool {
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		return len(e.batches) == 0
+	}, time.Second, 10*time.Millisecond)
+	exporter.Stop()
+}
+
+func TestRemoteExporter_Push(t *testing.T) {
+	cfg := config.NewDefaultMonitor()
+	cfg.RemoteURL = "http://127.0.0.1:1"
+	exporter, err := NewRemoteExporter(context.TODO(), cfg, linmetric.RootRegistry)
+	assert.NoError(t, err)
+	e := exporter.(*remoteExporter)
+	retry, err := e.push([]byte("data"))
+	assert.Error(t, err)
+	assert.True(t, retry)
+
+	e.endpoint = "://bad-url"
+	retry, err = e.push([]byte("data"))
+	assert.Error(t, err)
+	assert.False(t, retry)
+
+	// stop when waiting retry
+	e.endpoint = "http://127.0.0.1:1"
+	e.retryInterval = time.Hour
+	e.Export([]byte("data"))
+	ch := make(chan struct{})
+	go func() {
+		e.Start()
+		close(ch)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	exporter.Stop()
+	<-ch
+}
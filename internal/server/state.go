@@ -25,8 +25,28 @@ const (
 	New State = iota
 	// Running is running
 	Running
+	// Draining is stopping, rejects new requests and waits inflight requests completed
+	Draining
 	// Failed has encountered a problem
 	Failed
 	// Terminated is stopped
 	Terminated
 )
+
+// String returns the string value of server state.
+func (s State) String() string {
+	switch s {
+	case New:
+		return "New"
+	case Running:
+		return "Running"
+	case Draining:
+		return "Draining"
+	case Failed:
+		return "Failed"
+	case Terminated:
+		return "Terminated"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,32 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import "github.com/lindb/lindb/kv/table"
+
+var checkers = make(map[MergerType]table.ValueChecker)
+
+// RegisterChecker registers value checker for the family using given merger,
+// the checker is used to verify the integrity of values when opening store.
+// NOTICE: must register before create family
+func RegisterChecker(name MergerType, checker table.ValueChecker) {
+	if _, ok := checkers[name]; ok {
+		panic("checker already register")
+	}
+	checkers[name] = checker
+}
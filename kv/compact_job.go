@@ -41,7 +41,8 @@ type compactJob struct {
 	family    Family
 	state     *compactionState
 	newMerger NewMerger
-	rollup    Rollup // if rollup isn't nil, need do rollup job
+	rollup    Rollup    // if rollup isn't nil, need do rollup job
+	keyRange  *keyRange // if key range isn't nil, only merges the keys in range(partition of compaction job)
 
 	compactType string
 }
@@ -64,8 +65,11 @@ func newCompactJob(family Family, state *compactionState, rollup Rollup) Compact
 // Run runs compact job
 func (c *compactJob) Run() error {
 	startTime := time.Now()
+	c.state.startTime = startTime.UnixMilli()
+	runningCompactJobs.add(c)
 	metrics.CompactStatistics.Compacting.WithTagValues(c.compactType).Incr()
 	defer func() {
+		runningCompactJobs.remove(c)
 		metrics.CompactStatistics.Compacting.WithTagValues(c.compactType).Decr()
 		metrics.CompactStatistics.Duration.WithTagValues(c.compactType).UpdateSince(startTime)
 	}()
@@ -121,8 +125,13 @@ func (c *compactJob) mergeCompaction() (err error) {
 		)
 	}()
 
-	// do merge logic
-	if err := c.doMerge(); err != nil {
+	// do merge logic, big compaction job merges the key range partitions concurrently
+	if ranges := c.splitKeyRanges(); len(ranges) > 1 {
+		err = c.doParallelMerge(ranges)
+	} else {
+		err = c.doMerge()
+	}
+	if err != nil {
 		return err
 	}
 	// if merge success install compaction results into manifest
@@ -140,16 +149,57 @@ func (c *compactJob) doMerge() error {
 	if err != nil {
 		return err
 	}
+	params := make(map[string]interface{})
 	if c.rollup != nil {
-		merger.Init(map[string]interface{}{RollupContext: c.rollup})
+		params[RollupContext] = c.rollup
+		if filter := c.family.getRollupFilter(); filter != nil {
+			params[RollupFilterContext] = filter
+		}
+	}
+	if tombstones := c.family.getTombstones(); tombstones != nil {
+		params[TombstonesContext] = tombstones
+	}
+	if len(params) > 0 {
+		merger.Init(params)
+	}
+
+	if streamMerger, ok := merger.(StreamMerger); ok {
+		err = c.doStreamMerge(streamMerger, it)
+	} else {
+		err = c.doBatchMerge(merger, it)
+	}
+	if err != nil {
+		return err
+	}
+	// if it has store builder opened, need close it
+	if c.state.builder != nil {
+		if err := c.finishCompactionOutputFile(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doStreamMerge merges the values of same key incrementally, values are read from input iterator on demand.
+func (c *compactJob) doStreamMerge(merger StreamMerger, it table.Iterator) error {
+	values := newKeyValueIterator(it, c.readValue)
+	for values.moveNextKey() {
+		if err := merger.MergeStream(values.key, values); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+// doBatchMerge accumulates all values of same key, then merges them.
+func (c *compactJob) doBatchMerge(merger Merger, it table.Iterator) error {
 	var needMerge [][]byte
 	var previousKey uint32
 	start := true
 	for it.HasNext() {
 		key := it.Key()
 		value := it.Value()
+		c.readValue(value)
 		switch {
 		case start || key == previousKey:
 			// if start or same keys, append to need merge slice
@@ -178,15 +228,16 @@ func (c *compactJob) doMerge() error {
 			return err
 		}
 	}
-	// if it has store builder opened, need close it
-	if c.state.builder != nil {
-		if err := c.finishCompactionOutputFile(); err != nil {
-			return err
-		}
-	}
 	return nil
 }
 
+// readValue records the reading progress of compaction job, and throttles compaction reading,
+// avoid competing with query for disk bandwidth.
+func (c *compactJob) readValue(value []byte) {
+	c.state.addReadBytes(int64(len(value)))
+	globalCompactThrottle.wait(c.state.limiter, len(value))
+}
+
 // installCompactionResults installs compactions results.
 // 1. mark input files is deletion which compaction job picked.
 // 2. add output files to up level.
@@ -207,9 +258,32 @@ func (c *compactJob) installCompactionResults() {
 	c.family.commitEditLog(c.state.compaction.GetEditLog())
 }
 
-// makeInputIterator makes a merged iterator by compaction pick input files
+// makeInputIterator makes a merged iterator by compaction pick input files,
+// only iterates the keys in key range if it's the partition of compaction job,
+// the iterator of input file seeks to the start of key range directly.
 func (c *compactJob) makeInputIterator() (table.Iterator, error) {
-	var its []table.Iterator
+	readers, err := c.openInputReaders()
+	if err != nil {
+		return nil, err
+	}
+	its := make([]table.Iterator, 0, len(readers))
+	for _, reader := range readers {
+		if c.keyRange != nil {
+			its = append(its, reader.CompactionIterator(c.keyRange.start, c.state.advice))
+		} else {
+			its = append(its, reader.CompactionIterator(0, c.state.advice))
+		}
+	}
+	it := table.NewMergedIterator(its)
+	if c.keyRange != nil {
+		return newKeyRangeIterator(it, *c.keyRange), nil
+	}
+	return it, nil
+}
+
+// openInputReaders opens the readers of compaction pick input files
+func (c *compactJob) openInputReaders() ([]table.Reader, error) {
+	var readers []table.Reader
 	for which := 0; which < 2; which++ {
 		files := c.state.compaction.GetInputs()[which]
 		if len(files) > 0 {
@@ -218,11 +292,11 @@ func (c *compactJob) makeInputIterator() (table.Iterator, error) {
 				if err != nil {
 					return nil, err
 				}
-				its = append(its, reader.Iterator())
+				readers = append(readers, reader)
 			}
 		}
 	}
-	return table.NewMergedIterator(its), nil
+	return readers, nil
 }
 
 // openCompactionOutputFile opens a new compaction store build, and adds the file number into pending output
@@ -261,20 +335,27 @@ func (c *compactJob) finishCompactionOutputFile() (err error) {
 
 // cleanupCompaction cleanups the compaction context, such as remove pending output files etc.
 func (c *compactJob) cleanupCompaction() {
-	if c.state.builder != nil {
-		currentFileNumber := c.state.builder.FileNumber()
-		if err := c.state.builder.Abandon(); err != nil {
-			kvLogger.Warn("abandon store build error when do compact job",
-				logger.String("family", c.family.familyInfo()), logger.String("type", c.compactType),
-				logger.Int64("file", currentFileNumber.Int64()))
-		}
-		c.family.removePendingOutput(currentFileNumber)
-	}
+	c.abandonCompactionOutputFile()
 	for _, output := range c.state.outputs {
 		c.family.removePendingOutput(output.GetFileNumber())
 	}
 }
 
+// abandonCompactionOutputFile abandons current store builder which isn't finished.
+func (c *compactJob) abandonCompactionOutputFile() {
+	if c.state.builder == nil {
+		return
+	}
+	currentFileNumber := c.state.builder.FileNumber()
+	if err := c.state.builder.Abandon(); err != nil {
+		kvLogger.Warn("abandon store build error when do compact job",
+			logger.String("family", c.family.familyInfo()), logger.String("type", c.compactType),
+			logger.Int64("file", currentFileNumber.Int64()))
+	}
+	c.family.removePendingOutput(currentFileNumber)
+	c.state.builder = nil
+}
+
 // newCompactFlusher creates a new flusher for compacting
 // there are 2 strategies for flushing: streaming flush and buffer flush
 func (c *compactJob) newCompactFlusher() Flusher {
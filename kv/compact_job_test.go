@@ -27,6 +27,7 @@ import (
 
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/fileutil"
 )
 
 type mockAppendMerger struct {
@@ -102,10 +103,10 @@ func TestCompactJob_merge_compact_merge_fail(t *testing.T) {
 	snapshot := version.NewMockSnapshot(ctrl)
 	reader := table.NewMockReader(ctrl)
 	gomock.InOrder(
-		reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value1"),
 		})),
-		reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{})),
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{})),
 	)
 	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil).MaxTimes(2)
 	merge := NewMockMerger(ctrl)
@@ -124,10 +125,10 @@ func TestCompactJob_merge_compact_merge_fail(t *testing.T) {
 	assert.Error(t, err)
 
 	gomock.InOrder(
-		reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value1"),
 		})),
-		reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{2: []byte("value2")})),
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{2: []byte("value2")})),
 	)
 	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil).MaxTimes(2)
 	merge.EXPECT().Merge(uint32(1), gomock.Any()).Return(fmt.Errorf("err"))
@@ -145,6 +146,66 @@ func TestCompactJob_merge_compact_merge_fail(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCompactJob_merge_with_tombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader := table.NewMockReader(ctrl)
+	gomock.InOrder(
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{1: []byte("value1")})),
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{})),
+	)
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil).Times(2)
+	tombstones := NewMockTombstones(ctrl)
+	merge := NewMockMerger(ctrl)
+	family := NewMockFamily(ctrl)
+	family.EXPECT().getNewMerger().Return(func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().getTombstones().Return(tombstones)
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	merge.EXPECT().Init(map[string]interface{}{TombstonesContext: tombstones})
+	merge.EXPECT().Merge(uint32(1), gomock.Any()).Return(fmt.Errorf("err"))
+	f1 := version.NewFileMeta(1, 1, 10, 100)
+	f2 := version.NewFileMeta(2, 1, 10, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, []*version.FileMeta{f2})
+	state := newCompactionState(1000, snapshot, compaction)
+	err := newCompactJob(family, state, nil).Run()
+	assert.Error(t, err)
+}
+
+func TestCompactJob_merge_with_rollup_filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader := table.NewMockReader(ctrl)
+	gomock.InOrder(
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{1: []byte("value1")})),
+		reader.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{})),
+	)
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil).Times(2)
+	rollup := NewMockRollup(ctrl)
+	rollupFilter := NewMockRollupFilter(ctrl)
+	merge := NewMockMerger(ctrl)
+	family := NewMockFamily(ctrl)
+	family.EXPECT().getNewMerger().Return(func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().getTombstones().Return(nil)
+	family.EXPECT().getRollupFilter().Return(rollupFilter)
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	merge.EXPECT().Init(map[string]interface{}{RollupContext: rollup, RollupFilterContext: rollupFilter})
+	merge.EXPECT().Merge(uint32(1), gomock.Any()).Return(fmt.Errorf("err"))
+	f1 := version.NewFileMeta(1, 1, 10, 100)
+	f2 := version.NewFileMeta(2, 1, 10, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, []*version.FileMeta{f2})
+	state := newCompactionState(1000, snapshot, compaction)
+	err := newCompactJob(family, state, rollup).Run()
+	assert.Error(t, err)
+}
+
 func TestCompactJob_merge_doMerge_fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -156,10 +217,10 @@ func TestCompactJob_merge_doMerge_fail(t *testing.T) {
 
 	// test new store build fail
 	gomock.InOrder(
-		reader1.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader1.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value1"),
 		})),
-		reader2.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader2.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			2: []byte("value2"),
 		})),
 	)
@@ -183,6 +244,39 @@ func TestCompactJob_merge_doMerge_fail(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCompactJob_compaction_advice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader1 := table.NewMockReader(ctrl)
+	reader2 := table.NewMockReader(ctrl)
+	merge := NewMockMerger(ctrl)
+
+	// compaction advice applied by input iterators, instead of query advice of shared readers
+	reader1.EXPECT().CompactionIterator(uint32(0), fileutil.AdviceSequential).Return(generateIterator(ctrl, map[uint32][]byte{
+		1: []byte("value1"),
+	}))
+	reader2.EXPECT().CompactionIterator(uint32(0), fileutil.AdviceSequential).Return(generateIterator(ctrl, map[uint32][]byte{
+		2: []byte("value2"),
+	}))
+	merge.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(reader1, nil)
+	snapshot.EXPECT().GetReader(table.FileNumber(4)).Return(reader2, nil)
+	family := generateMockFamily(ctrl, func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+
+	f1 := version.NewFileMeta(1, 1, 10, 100)
+	f4 := version.NewFileMeta(4, 30, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, []*version.FileMeta{f4})
+	state := newCompactionState(10000, snapshot, compaction)
+	state.advice = fileutil.AdviceSequential
+	err := newCompactJob(family, state, nil).Run()
+	assert.NoError(t, err)
+}
+
 func TestCompactJob_output_fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -194,10 +288,10 @@ func TestCompactJob_output_fail(t *testing.T) {
 
 	// test store build is empty
 	gomock.InOrder(
-		reader1.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader1.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value1"),
 		})),
-		reader2.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader2.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value2"),
 		})),
 	)
@@ -232,10 +326,10 @@ func TestCompactJob_output_fail(t *testing.T) {
 
 	// test finish output fail
 	gomock.InOrder(
-		reader1.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader1.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value1"),
 		})),
-		reader2.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		reader2.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 			1: []byte("value2"),
 		})),
 	)
@@ -303,21 +397,21 @@ func TestCompactJob_merge_rollup(t *testing.T) {
 	reader2 := table.NewMockReader(ctrl)
 	reader3 := table.NewMockReader(ctrl)
 	reader4 := table.NewMockReader(ctrl)
-	reader1.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+	reader1.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 		1:  []byte("value1"),
 		3:  []byte("value3"),
 		10: []byte("value10"),
 	}))
-	reader2.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+	reader2.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 		10: []byte("value10"),
 		30: []byte("value30"),
 		40: []byte("value40"),
 	}))
-	reader3.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+	reader3.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 		1:  []byte("value1"),
 		10: []byte("value10"),
 	}))
-	reader4.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+	reader4.EXPECT().CompactionIterator(uint32(0), gomock.Any()).Return(generateIterator(ctrl, map[uint32][]byte{
 		10:  []byte("value10"),
 		30:  []byte("value30"),
 		100: []byte("value100"),
@@ -370,9 +464,12 @@ func TestCompactJob_merge_rollup(t *testing.T) {
 	assert.Equal(t, version.CreateNewFile(0, newFile), logs[0])
 }
 
+// newMockInputReader creates the mock reader of input file, input file is opened for releasing page cache.
 func generateMockFamily(ctrl *gomock.Controller, merger NewMerger) *MockFamily {
 	family := NewMockFamily(ctrl)
 	family.EXPECT().getNewMerger().Return(merger).AnyTimes()
+	family.EXPECT().getTombstones().Return(nil).AnyTimes()
+	family.EXPECT().getRollupFilter().Return(nil).AnyTimes()
 	family.EXPECT().Name().Return("test-family").AnyTimes()
 	family.EXPECT().commitEditLog(gomock.Any()).Return(true).AnyTimes()
 	return family
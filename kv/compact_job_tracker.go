@@ -0,0 +1,130 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	// CompactJobRunning represents the compact/rollup job is running.
+	CompactJobRunning = "running"
+	// CompactJobQueued represents the compact/rollup job is waiting for scheduling.
+	CompactJobQueued = "queued"
+)
+
+// CompactJobStatus represents the status of running/queued compact or rollup job of family.
+type CompactJobStatus struct {
+	Store      string
+	Family     string
+	Type       string // merge/rollup
+	Status     string // running/queued
+	Level      int    // level of input files
+	InputFiles int
+	InputBytes int64 // total bytes of input files, 0 if queued
+	ReadBytes  int64 // bytes of input values read by merging
+	StartTime  int64 // start time of running job, 0 if queued
+}
+
+// compactJobTracker tracks the running compact/rollup jobs for showing compaction status.
+type compactJobTracker struct {
+	jobs  map[*compactJob]struct{}
+	mutex sync.RWMutex
+}
+
+// runningCompactJobs tracks the running compact/rollup jobs of all stores.
+var runningCompactJobs = &compactJobTracker{jobs: make(map[*compactJob]struct{})}
+
+// add adds running compact job.
+func (t *compactJobTracker) add(job *compactJob) {
+	t.mutex.Lock()
+	t.jobs[job] = struct{}{}
+	t.mutex.Unlock()
+}
+
+// remove removes compact job after completed.
+func (t *compactJobTracker) remove(job *compactJob) {
+	t.mutex.Lock()
+	delete(t.jobs, job)
+	t.mutex.Unlock()
+}
+
+// list returns the status of running compact jobs.
+func (t *compactJobTracker) list() (rs []CompactJobStatus) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	for job := range t.jobs {
+		state := job.state
+		rs = append(rs, CompactJobStatus{
+			Store:      job.family.getStore().Name(),
+			Family:     job.family.Name(),
+			Type:       job.compactType,
+			Status:     CompactJobRunning,
+			Level:      state.compaction.GetLevel(),
+			InputFiles: state.inputFiles(),
+			InputBytes: state.inputBytes,
+			ReadBytes:  state.readBytes.Load(),
+			StartTime:  state.startTime,
+		})
+	}
+	return rs
+}
+
+// GetCompactJobs returns the running compact/rollup jobs, and the queued jobs of families
+// whose level0/rollup files reach threshold but job not started(waiting for scheduling or compaction paused).
+func GetCompactJobs() []CompactJobStatus {
+	rs := runningCompactJobs.list()
+	for _, store := range GetStoreManager().GetStores() {
+		for _, name := range store.ListFamilyNames() {
+			family := store.GetFamily(name)
+			if family == nil {
+				continue
+			}
+			if level0Files, pending, compacting := family.compactionBacklog(); pending && !compacting {
+				rs = append(rs, CompactJobStatus{
+					Store:      store.Name(),
+					Family:     name,
+					Type:       "merge",
+					Status:     CompactJobQueued,
+					InputFiles: level0Files,
+				})
+			}
+			if files, pending, rolluping := family.rollupBacklog(); pending && !rolluping {
+				rs = append(rs, CompactJobStatus{
+					Store:      store.Name(),
+					Family:     name,
+					Type:       "rollup",
+					Status:     CompactJobQueued,
+					InputFiles: files,
+				})
+			}
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Store != rs[j].Store {
+			return rs[i].Store < rs[j].Store
+		}
+		if rs[i].Family != rs[j].Family {
+			return rs[i].Family < rs[j].Family
+		}
+		return rs[i].Status > rs[j].Status // running first
+	})
+	return rs
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/version"
+)
+
+func TestGetCompactJobs(t *testing.T) {
+	defer func() {
+		SetCompactionPaused("db", false)
+		InitStoreManager(nil)
+	}()
+	kv, err := newStore("db/jobs", filepath.Join(t.TempDir(), "db", "jobs"), DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	InitStoreManager(&storeManager{stores: map[string]Store{"db/jobs": kv}})
+
+	f, err := kv.CreateFamily("f", FamilyOption{CompactThreshold: 2, Merger: mergerStr, MaxFileSize: 1024 * 1024})
+	assert.NoError(t, err)
+	assert.Empty(t, GetCompactJobs())
+	for i := 0; i < 2; i++ {
+		flusher := f.NewFlusher()
+		assert.NoError(t, flusher.Add(1, []byte("test")))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+	}
+	// compaction paused, job is queued
+	SetCompactionPaused("db", true)
+	queued := CompactJobStatus{Store: "db/jobs", Family: "f", Type: "merge", Status: CompactJobQueued, InputFiles: 2}
+	assert.Equal(t, []CompactJobStatus{queued}, GetCompactJobs())
+
+	// running job
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{version.NewFileMeta(1, 1, 10, 100)}, nil)
+	state := newCompactionState(1024, nil, compaction)
+	state.startTime = 10
+	state.readBytes.Store(50)
+	job := newCompactJob(f, state, nil).(*compactJob)
+	runningCompactJobs.add(job)
+	assert.Equal(t, []CompactJobStatus{{
+		Store: "db/jobs", Family: "f", Type: "merge", Status: CompactJobRunning,
+		InputFiles: 1, InputBytes: 100, ReadBytes: 50, StartTime: 10,
+	}, queued}, GetCompactJobs())
+	runningCompactJobs.remove(job)
+	assert.Equal(t, []CompactJobStatus{queued}, GetCompactJobs())
+}
@@ -0,0 +1,222 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	// compactConcurrency represents the max number of key range partitions merged concurrently by one compaction job.
+	compactConcurrency = atomic.NewInt32(1)
+	// partitionLimiter bounds the number of partitions merged concurrently across all compaction jobs.
+	partitionLimiter = newPartitionSemaphore(1)
+)
+
+// SetCompactConcurrency sets the max number of key range partitions merged concurrently by one compaction job
+// and across all compaction jobs, big compaction job is split into partitions by key range,
+// each partition writes separate output files.
+func SetCompactConcurrency(concurrency int) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	compactConcurrency.Store(int32(concurrency))
+	partitionLimiter.setLimit(concurrency)
+}
+
+// partitionSemaphore represents a counting semaphore whose limit can be changed at runtime.
+type partitionSemaphore struct {
+	limit   int
+	running int
+	mutex   sync.Mutex
+	cond    *sync.Cond
+}
+
+// newPartitionSemaphore creates a partition semaphore with limit.
+func newPartitionSemaphore(limit int) *partitionSemaphore {
+	s := &partitionSemaphore{limit: limit}
+	s.cond = sync.NewCond(&s.mutex)
+	return s
+}
+
+// acquire blocks until the number of running partitions less than limit.
+func (s *partitionSemaphore) acquire() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for s.running >= s.limit {
+		s.cond.Wait()
+	}
+	s.running++
+}
+
+// release releases the token acquired before, wakes up a waiting partition.
+func (s *partitionSemaphore) release() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.running--
+	s.cond.Signal()
+}
+
+// setLimit changes the limit, wakes up all waiting partitions if limit increased.
+func (s *partitionSemaphore) setLimit(limit int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.limit = limit
+	s.cond.Broadcast()
+}
+
+// keyRange represents the key range[start, end] of compaction partition.
+type keyRange struct {
+	start, end uint32
+}
+
+// splitKeyRanges splits the key range of input files into partitions evenly,
+// the number of partitions is bounded by compaction concurrency and the size of input files,
+// returns nil if compaction job isn't big enough or does rollup job.
+func (c *compactJob) splitKeyRanges() []keyRange {
+	concurrency := int64(compactConcurrency.Load())
+	if c.rollup != nil || concurrency <= 1 || c.state.maxFileSize == 0 || c.state.compaction == nil {
+		return nil
+	}
+	// each partition writes one output file at least
+	partitions := c.state.inputBytes / int64(c.state.maxFileSize)
+	if partitions > concurrency {
+		partitions = concurrency
+	}
+	minKey, maxKey := c.state.inputKeyRange()
+	if span := int64(maxKey) - int64(minKey) + 1; partitions > span {
+		partitions = span
+	}
+	if partitions <= 1 {
+		return nil
+	}
+	step := (int64(maxKey) - int64(minKey) + 1) / partitions
+	ranges := make([]keyRange, 0, partitions)
+	start := int64(minKey)
+	for i := int64(0); i < partitions; i++ {
+		end := start + step - 1
+		if i == partitions-1 {
+			end = int64(maxKey)
+		}
+		ranges = append(ranges, keyRange{start: uint32(start), end: uint32(end)})
+		start = end + 1
+	}
+	return ranges
+}
+
+// doParallelMerge merges the key range partitions concurrently, each partition writes separate output files,
+// output files of all partitions are added into compaction state by key range order.
+func (c *compactJob) doParallelMerge(ranges []keyRange) error {
+	kvLogger.Info("merge compaction by key range partitions",
+		logger.String("family", c.family.familyInfo()), logger.String("type", c.compactType),
+		logger.Int("partitions", len(ranges)))
+	partitions := make([]*compactJob, len(ranges))
+	errs := make([]error, len(ranges))
+	var wait sync.WaitGroup
+	for idx := range ranges {
+		partitions[idx] = &compactJob{
+			family:      c.family,
+			state:       c.state.newPartitionState(),
+			newMerger:   c.newMerger,
+			rollup:      c.rollup,
+			keyRange:    &ranges[idx],
+			compactType: c.compactType,
+		}
+		// bound partitions of all compaction jobs, avoid too many goroutines merging concurrently
+		partitionLimiter.acquire()
+		wait.Add(1)
+		go func(idx int) {
+			defer func() {
+				partitionLimiter.release()
+				wait.Done()
+			}()
+			errs[idx] = partitions[idx].doMerge()
+		}(idx)
+	}
+	wait.Wait()
+
+	for _, partition := range partitions {
+		// abandon unfinished output file if partition failure,
+		// finished output files are removed from pending outputs after compaction job completed.
+		partition.abandonCompactionOutputFile()
+		for _, output := range partition.state.outputs {
+			c.state.addOutputFile(output)
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyRangeIterator iterates the keys in key range of merged input iterator.
+type keyRangeIterator struct {
+	it       table.Iterator
+	keyRange keyRange
+
+	key   uint32
+	value []byte
+	done  bool
+}
+
+// newKeyRangeIterator creates a iterator which only iterates the keys in key range.
+func newKeyRangeIterator(it table.Iterator, keyRange keyRange) table.Iterator {
+	return &keyRangeIterator{
+		it:       it,
+		keyRange: keyRange,
+	}
+}
+
+// HasNext returns if the iteration has more element in key range.
+func (it *keyRangeIterator) HasNext() bool {
+	for !it.done && it.it.HasNext() {
+		key := it.it.Key()
+		if key < it.keyRange.start {
+			continue
+		}
+		if key > it.keyRange.end {
+			// keys are sorted, no more key in range
+			it.done = true
+			break
+		}
+		it.key = key
+		it.value = it.it.Value()
+		return true
+	}
+	return false
+}
+
+// Key returns the key of the current key/value pair.
+func (it *keyRangeIterator) Key() uint32 {
+	return it.key
+}
+
+// Value returns the value of the current key/value pair.
+func (it *keyRangeIterator) Value() []byte {
+	return it.value
+}
@@ -0,0 +1,174 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestSetCompactConcurrency(t *testing.T) {
+	defer SetCompactConcurrency(1)
+
+	SetCompactConcurrency(4)
+	assert.Equal(t, int32(4), compactConcurrency.Load())
+	assert.Equal(t, 4, partitionLimiter.limit)
+	SetCompactConcurrency(-1)
+	assert.Equal(t, int32(1), compactConcurrency.Load())
+	assert.Equal(t, 1, partitionLimiter.limit)
+}
+
+func TestPartitionSemaphore(t *testing.T) {
+	s := newPartitionSemaphore(1)
+	s.acquire()
+	acquired := make(chan struct{})
+	go func() {
+		s.acquire()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquire should be blocked")
+	case <-time.After(10 * time.Millisecond):
+	}
+	// wake up by increasing limit
+	s.setLimit(2)
+	<-acquired
+	s.release()
+	s.release()
+	assert.Zero(t, s.running)
+}
+
+func TestCompactJob_splitKeyRanges(t *testing.T) {
+	defer SetCompactConcurrency(1)
+
+	f1 := version.NewFileMeta(1, 1, 10, 100)
+	f2 := version.NewFileMeta(2, 20, 50, 100)
+	f3 := version.NewFileMeta(3, 5, 30, 100)
+	f4 := version.NewFileMeta(4, 30, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1, f2}, []*version.FileMeta{f3, f4})
+	newJob := func(maxFileSize uint32, rollup Rollup) *compactJob {
+		state := newCompactionState(maxFileSize, nil, compaction)
+		return &compactJob{state: state, rollup: rollup}
+	}
+	// case 1: concurrency is 1
+	assert.Nil(t, newJob(100, nil).splitKeyRanges())
+	SetCompactConcurrency(3)
+	// case 2: rollup job
+	assert.Nil(t, newJob(100, &rollup{}).splitKeyRanges())
+	// case 3: input files are small
+	assert.Nil(t, newJob(400, nil).splitKeyRanges())
+	// case 4: bounded by input files size
+	assert.Equal(t, []keyRange{{start: 1, end: 50}, {start: 51, end: 100}}, newJob(200, nil).splitKeyRanges())
+	// case 5: bounded by concurrency
+	assert.Equal(t, []keyRange{{start: 1, end: 33}, {start: 34, end: 66}, {start: 67, end: 100}},
+		newJob(100, nil).splitKeyRanges())
+	// case 6: bounded by key span
+	compaction = version.NewCompaction(1, 0, []*version.FileMeta{version.NewFileMeta(1, 10, 11, 1000)}, nil)
+	assert.Equal(t, []keyRange{{start: 10, end: 10}, {start: 11, end: 11}}, newJob(100, nil).splitKeyRanges())
+}
+
+func TestKeyRangeIterator(t *testing.T) {
+	pairs := []kvPair{
+		{key: 1, value: []byte{1}},
+		{key: 5, value: []byte{5}},
+		{key: 5, value: []byte{6}},
+		{key: 10, value: []byte{10}},
+		{key: 20, value: []byte{20}},
+	}
+	it := newKeyRangeIterator(&sliceIterator{pairs: pairs, idx: -1}, keyRange{start: 5, end: 10})
+	var keys []uint32
+	var values []byte
+	for it.HasNext() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value()...)
+	}
+	assert.Equal(t, []uint32{5, 5, 10}, keys)
+	assert.Equal(t, []byte{5, 6, 10}, values)
+	assert.False(t, it.HasNext())
+}
+
+func TestCompactJob_merge_parallel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		SetCompactConcurrency(1)
+		ctrl.Finish()
+	}()
+	SetCompactConcurrency(2)
+
+	dir := t.TempDir()
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader1 := table.NewMockReader(ctrl)
+	reader2 := table.NewMockReader(ctrl)
+	// iterators of input files seek to the start of key range
+	iteratorFrom := func(pairs []kvPair) func(start uint32, _ fileutil.Advice) table.Iterator {
+		return func(start uint32, _ fileutil.Advice) table.Iterator {
+			it := &sliceIterator{idx: -1}
+			for _, pair := range pairs {
+				if pair.key >= start {
+					it.pairs = append(it.pairs, pair)
+				}
+			}
+			return it
+		}
+	}
+	pairs1 := []kvPair{{key: 1, value: []byte("value1")}, {key: 60, value: []byte("value60")}}
+	pairs2 := []kvPair{{key: 1, value: []byte("value1")}, {key: 100, value: []byte("value100")}}
+	reader1.EXPECT().CompactionIterator(uint32(1), gomock.Any()).DoAndReturn(iteratorFrom(pairs1))
+	reader1.EXPECT().CompactionIterator(uint32(51), gomock.Any()).DoAndReturn(iteratorFrom(pairs1))
+	reader2.EXPECT().CompactionIterator(uint32(1), gomock.Any()).DoAndReturn(iteratorFrom(pairs2))
+	reader2.EXPECT().CompactionIterator(uint32(51), gomock.Any()).DoAndReturn(iteratorFrom(pairs2))
+	snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(reader1, nil).AnyTimes()
+	snapshot.EXPECT().GetReader(table.FileNumber(2)).Return(reader2, nil).AnyTimes()
+	family := generateMockFamily(ctrl, newMockAppendMerger)
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	family.EXPECT().removePendingOutput(gomock.Any()).AnyTimes()
+	fileNumber := atomic.NewInt64(10)
+	family.EXPECT().newTableBuilder().DoAndReturn(func() (table.Builder, error) {
+		n := fileNumber.Inc()
+		return table.NewStoreBuilder(table.FileNumber(n), filepath.Join(dir, fmt.Sprintf("%d.sst", n)))
+	}).Times(2)
+
+	f1 := version.NewFileMeta(1, 1, 60, 100)
+	f2 := version.NewFileMeta(2, 1, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, []*version.FileMeta{f2})
+	state := newCompactionState(100, snapshot, compaction)
+	err := newCompactJob(family, state, nil).Run()
+	assert.NoError(t, err)
+	// output files are sorted by key range of partitions
+	assert.Len(t, state.outputs, 2)
+	assert.Equal(t, uint32(1), state.outputs[0].GetMinKey())
+	assert.Equal(t, uint32(1), state.outputs[0].GetMaxKey())
+	assert.Equal(t, uint32(60), state.outputs[1].GetMinKey())
+	assert.Equal(t, uint32(100), state.outputs[1].GetMaxKey())
+	assert.Equal(t, int64(len("value1value1value60value100")), state.readBytes.Load())
+	logs := state.compaction.GetEditLog().GetLogs()
+	assert.Len(t, logs, 4)
+	assert.Equal(t, version.CreateNewFile(1, state.outputs[0]), logs[2])
+	assert.Equal(t, version.CreateNewFile(1, state.outputs[1]), logs[3])
+}
@@ -0,0 +1,250 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"golang.org/x/time/rate"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+const (
+	// throttleAdjustInterval represents the interval of adjusting throttle level based on query latency.
+	throttleAdjustInterval = 10 * timeutil.OneSecond
+	// throttleBreachRatio represents the ratio of SLO breached queries, which lowers the throttle level if exceeded.
+	throttleBreachRatio = 0.1
+	// minThrottleLevel represents the min throttle level, compaction never stops completely.
+	minThrottleLevel = 0.1
+	// throttleLevelStep represents the step of restoring throttle level after query latency recovered.
+	throttleLevelStep = 0.1
+	// minThrottleBurst represents the min burst bytes of rate limiter.
+	minThrottleBurst = 64 * 1024
+)
+
+// globalCompactThrottle throttles the compaction of all kv stores.
+var globalCompactThrottle = newCompactThrottle()
+
+// SetCompactThrottle sets the global compaction rate limit(bytes/sec, 0 means unlimited),
+// and the query latency SLO which drives the dynamic adjustment of throttle level(0 disables adjustment).
+func SetCompactThrottle(rateLimit int64, latencySLO time.Duration) {
+	globalCompactThrottle.setLimit(rateLimit, latencySLO)
+}
+
+// ObserveQueryLatency records the latency of query,
+// compaction is throttled harder if the query latency SLO is breached frequently.
+func ObserveQueryLatency(latency time.Duration) {
+	globalCompactThrottle.observe(latency, timeutil.Now())
+}
+
+// compactThrottle limits the disk bandwidth(bytes/sec) used by compaction, so that compaction doesn't compete with query.
+// It includes a global limiter shared by all stores and a limiter of each store,
+// the configured rates are scaled by throttle level which is adjusted based on query latency SLO breaches.
+type compactThrottle struct {
+	global     *rate.Limiter // nil if unlimited
+	globalRate int64
+	stores     map[string]*storeLimiter // store path => limiter of store
+	level      float64
+
+	latencySLO atomic.Duration
+	queries    atomic.Int64
+	breaches   atomic.Int64
+	lastAdjust atomic.Int64
+
+	mutex sync.RWMutex
+}
+
+// storeLimiter represents the compaction rate limiter of store.
+type storeLimiter struct {
+	limiter *rate.Limiter
+	rate    int64
+}
+
+// newCompactThrottle creates a compaction throttle without limit.
+func newCompactThrottle() *compactThrottle {
+	t := &compactThrottle{
+		stores: make(map[string]*storeLimiter),
+		level:  1,
+	}
+	t.lastAdjust.Store(timeutil.Now())
+	return t
+}
+
+// setLimit sets the global rate limit and query latency SLO.
+func (t *compactThrottle) setLimit(rateLimit int64, latencySLO time.Duration) {
+	t.latencySLO.Store(latencySLO)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if latencySLO <= 0 {
+		// dynamic adjustment disabled, restore throttle level
+		t.level = 1
+	}
+	t.globalRate = rateLimit
+	t.global = nil
+	if rateLimit > 0 {
+		t.global = rate.NewLimiter(t.scale(rateLimit), burst(rateLimit))
+	}
+	t.applyLevel()
+}
+
+// storeLimiter returns the compaction rate limiter of store, creates it if not exist, return nil if unlimited.
+func (t *compactThrottle) storeLimiter(path string, rateLimit int64) *rate.Limiter {
+	if rateLimit <= 0 {
+		return nil
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if l, ok := t.stores[path]; ok {
+		return l.limiter
+	}
+	l := &storeLimiter{
+		limiter: rate.NewLimiter(t.scale(rateLimit), burst(rateLimit)),
+		rate:    rateLimit,
+	}
+	t.stores[path] = l
+	return l.limiter
+}
+
+// removeStore removes the compaction rate limiter of store after store closed.
+func (t *compactThrottle) removeStore(path string) {
+	t.mutex.Lock()
+	delete(t.stores, path)
+	t.mutex.Unlock()
+}
+
+// observe records the latency of query, then tries to adjust throttle level.
+func (t *compactThrottle) observe(latency time.Duration, now int64) {
+	slo := t.latencySLO.Load()
+	if slo <= 0 {
+		return
+	}
+	t.queries.Inc()
+	if latency > slo {
+		t.breaches.Inc()
+		metrics.CompactThrottleStatistics.SLOBreaches.Incr()
+	}
+	t.tryAdjust(now)
+}
+
+// tryAdjust adjusts throttle level at most once per adjust interval,
+// halves the level if too many queries breach the latency SLO, else restores the level step by step.
+func (t *compactThrottle) tryAdjust(now int64) {
+	last := t.lastAdjust.Load()
+	if now-last < throttleAdjustInterval || !t.lastAdjust.CAS(last, now) {
+		return
+	}
+	if t.latencySLO.Load() <= 0 {
+		return
+	}
+	queries := t.queries.Swap(0)
+	breaches := t.breaches.Swap(0)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	level := t.level
+	if queries > 0 && float64(breaches)/float64(queries) > throttleBreachRatio {
+		level /= 2
+		if level < minThrottleLevel {
+			level = minThrottleLevel
+		}
+	} else {
+		level += throttleLevelStep
+		if level > 1 {
+			level = 1
+		}
+	}
+	if level != t.level {
+		t.level = level
+		t.applyLevel()
+	}
+}
+
+// applyLevel applies current throttle level to all rate limiters, need hold the lock.
+func (t *compactThrottle) applyLevel() {
+	globalLimit := float64(0)
+	if t.global != nil {
+		limit := t.scale(t.globalRate)
+		t.global.SetLimit(limit)
+		globalLimit = float64(limit)
+	}
+	for _, l := range t.stores {
+		l.limiter.SetLimit(t.scale(l.rate))
+	}
+	metrics.CompactThrottleStatistics.Level.Update(t.level)
+	metrics.CompactThrottleStatistics.RateLimit.Update(globalLimit)
+}
+
+// scale returns the rate limit scaled by throttle level, need hold the lock.
+func (t *compactThrottle) scale(rateLimit int64) rate.Limit {
+	return rate.Limit(float64(rateLimit) * t.level)
+}
+
+// getLevel returns current throttle level.
+func (t *compactThrottle) getLevel() float64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.level
+}
+
+// wait blocks until n bytes can be read by compaction under global and store limits.
+func (t *compactThrottle) wait(store *rate.Limiter, n int) {
+	t.tryAdjust(timeutil.Now())
+
+	t.mutex.RLock()
+	global := t.global
+	t.mutex.RUnlock()
+
+	waitN(global, n)
+	waitN(store, n)
+}
+
+// waitN blocks until limiter allows n bytes, n may be split by burst of limiter.
+func waitN(limiter *rate.Limiter, n int) {
+	if limiter == nil {
+		return
+	}
+	b := limiter.Burst()
+	for n > 0 {
+		c := n
+		if c > b {
+			c = b
+		}
+		r := limiter.ReserveN(time.Now(), c)
+		if delay := r.Delay(); r.OK() && delay > 0 {
+			metrics.CompactThrottleStatistics.Throttled.Incr()
+			metrics.CompactThrottleStatistics.ThrottledBytes.Add(float64(c))
+			time.Sleep(delay)
+		}
+		n -= c
+	}
+}
+
+// burst returns the burst bytes of rate limiter, allows reading 1 second's data at once.
+func burst(rateLimit int64) int {
+	if rateLimit < minThrottleBurst {
+		return minThrottleBurst
+	}
+	return int(rateLimit)
+}
@@ -0,0 +1,107 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestCompactThrottle_Limit(t *testing.T) {
+	throttle := newCompactThrottle()
+	// unlimited
+	throttle.wait(nil, 1024*1024)
+	assert.Nil(t, throttle.storeLimiter("/tmp/store", 0))
+
+	throttle.setLimit(1024*1024, 0)
+	assert.NotNil(t, throttle.global)
+	assert.Equal(t, 1024*1024, throttle.global.Burst())
+	limiter := throttle.storeLimiter("/tmp/store", 1024)
+	assert.NotNil(t, limiter)
+	assert.Equal(t, minThrottleBurst, limiter.Burst())
+	// same store returns same limiter
+	assert.Equal(t, limiter, throttle.storeLimiter("/tmp/store", 1024))
+	throttle.removeStore("/tmp/store")
+	assert.Empty(t, throttle.stores)
+
+	// disable global limit
+	throttle.setLimit(0, 0)
+	assert.Nil(t, throttle.global)
+}
+
+func TestCompactThrottle_Wait(t *testing.T) {
+	throttle := newCompactThrottle()
+	throttle.setLimit(minThrottleBurst*20, 0)
+	limiter := throttle.storeLimiter("/tmp/store", minThrottleBurst*20)
+	// consume burst
+	throttle.wait(limiter, minThrottleBurst*20)
+	startTime := time.Now()
+	throttle.wait(limiter, minThrottleBurst)
+	assert.True(t, time.Since(startTime) > 10*time.Millisecond)
+}
+
+func TestCompactThrottle_Adjust(t *testing.T) {
+	throttle := newCompactThrottle()
+	throttle.setLimit(1000, time.Second)
+	limiter := throttle.storeLimiter("/tmp/store", 1000)
+	now := timeutil.Now() + throttleAdjustInterval
+
+	// latency SLO breached
+	throttle.observe(2*time.Second, now)
+	assert.Equal(t, 0.5, throttle.getLevel())
+	assert.Equal(t, 500.0, float64(throttle.global.Limit()))
+	assert.Equal(t, 500.0, float64(limiter.Limit()))
+	// adjust at most once per interval
+	throttle.observe(2*time.Second, now+1)
+	assert.Equal(t, 0.5, throttle.getLevel())
+	for i := 0; i < 10; i++ {
+		now += throttleAdjustInterval
+		throttle.observe(2*time.Second, now)
+	}
+	assert.Equal(t, minThrottleLevel, throttle.getLevel())
+
+	// latency recovered
+	now += throttleAdjustInterval
+	throttle.observe(time.Millisecond, now)
+	assert.InDelta(t, minThrottleLevel+throttleLevelStep, throttle.getLevel(), 0.0001)
+	// no query, restore gradually
+	for i := 0; i < 10; i++ {
+		now += throttleAdjustInterval
+		throttle.tryAdjust(now)
+	}
+	assert.Equal(t, 1.0, throttle.getLevel())
+	assert.Equal(t, 1000.0, float64(limiter.Limit()))
+
+	// dynamic adjustment disabled
+	throttle.observe(2*time.Second, now+throttleAdjustInterval)
+	throttle.setLimit(1000, 0)
+	throttle.observe(2*time.Second, now+2*throttleAdjustInterval)
+	assert.Equal(t, 1.0, throttle.getLevel())
+}
+
+func TestCompactThrottle_Global(t *testing.T) {
+	defer SetCompactThrottle(0, 0)
+
+	SetCompactThrottle(1024, time.Second)
+	ObserveQueryLatency(time.Millisecond)
+	assert.Equal(t, int64(1024), globalCompactThrottle.globalRate)
+}
@@ -18,8 +18,12 @@
 package kv
 
 import (
+	"go.uber.org/atomic"
+	"golang.org/x/time/rate"
+
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/fileutil"
 )
 
 // compactionState represents the state of compaction job
@@ -29,15 +33,81 @@ type compactionState struct {
 	compaction  *version.Compaction
 	snapshot    version.Snapshot
 	maxFileSize uint32
+	advice      fileutil.Advice  // access pattern advice of compaction reading
+	limiter     *rate.Limiter    // compaction rate limiter of store, nil if unlimited
+	parent      *compactionState // state of whole compaction job if it's the state of key range partition
+
+	startTime  int64        // start time of compaction job
+	inputBytes int64        // total bytes of input files
+	readBytes  atomic.Int64 // bytes of input values read by merging, for tracking progress
 }
 
 // newCompactionState creates a compaction state
 func newCompactionState(maxFileSize uint32, snapshot version.Snapshot, compaction *version.Compaction) *compactionState {
-	return &compactionState{
+	state := &compactionState{
 		maxFileSize: maxFileSize,
 		snapshot:    snapshot,
 		compaction:  compaction,
 	}
+	if compaction != nil {
+		for _, files := range compaction.GetInputs() {
+			for _, file := range files {
+				state.inputBytes += int64(file.GetFileSize())
+			}
+		}
+	}
+	return state
+}
+
+// newPartitionState creates the state of key range partition, which shares the input files with whole compaction job,
+// but writes separate output files.
+func (c *compactionState) newPartitionState() *compactionState {
+	return &compactionState{
+		maxFileSize: c.maxFileSize,
+		snapshot:    c.snapshot,
+		compaction:  c.compaction,
+		advice:      c.advice,
+		limiter:     c.limiter,
+		parent:      c,
+		startTime:   c.startTime,
+	}
+}
+
+// addReadBytes adds the bytes of input values read by merging, read bytes of partition are added into whole job.
+func (c *compactionState) addReadBytes(n int64) {
+	if c.parent != nil {
+		c.parent.addReadBytes(n)
+		return
+	}
+	c.readBytes.Add(n)
+}
+
+// inputKeyRange returns the min/max key of input files.
+func (c *compactionState) inputKeyRange() (minKey, maxKey uint32) {
+	first := true
+	for _, files := range c.compaction.GetInputs() {
+		for _, file := range files {
+			if first || file.GetMinKey() < minKey {
+				minKey = file.GetMinKey()
+			}
+			if first || file.GetMaxKey() > maxKey {
+				maxKey = file.GetMaxKey()
+			}
+			first = false
+		}
+	}
+	return minKey, maxKey
+}
+
+// inputFiles returns the number of input files.
+func (c *compactionState) inputFiles() (n int) {
+	if c.compaction == nil {
+		return 0
+	}
+	for _, files := range c.compaction.GetInputs() {
+		n += len(files)
+	}
+	return n
 }
 
 // addOutputFile adds a new output file
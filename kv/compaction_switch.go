@@ -0,0 +1,108 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// compactionSwitch controls pausing/resuming background compaction(include rollup) of stores,
+// operators can pause compaction during incident response or bulk loads.
+// NOTE: running compaction jobs are not interrupted, pause state is kept in memory, resets after restarted.
+type compactionSwitch struct {
+	all      atomic.Bool
+	prefixes sync.Map // first element of store name(database for tsdb) => struct{}
+}
+
+// globalCompactionSwitch controls background compaction of all stores.
+var globalCompactionSwitch = &compactionSwitch{}
+
+// SetCompactionPaused pauses/resumes background compaction of stores whose name starts with given prefix element
+// (database for tsdb), pauses/resumes compaction of all stores if prefix is empty.
+func SetCompactionPaused(prefix string, paused bool) {
+	switch {
+	case prefix == "":
+		globalCompactionSwitch.all.Store(paused)
+	case paused:
+		globalCompactionSwitch.prefixes.Store(prefix, struct{}{})
+	default:
+		globalCompactionSwitch.prefixes.Delete(prefix)
+	}
+}
+
+// IsCompactionPaused returns if background compaction of store(given name or name of sub path) is paused.
+func IsCompactionPaused(name string) bool {
+	if globalCompactionSwitch.all.Load() {
+		return true
+	}
+	prefix := filepath.ToSlash(name)
+	if idx := strings.IndexByte(prefix, '/'); idx >= 0 {
+		prefix = prefix[:idx]
+	}
+	_, ok := globalCompactionSwitch.prefixes.Load(prefix)
+	return ok
+}
+
+// GetPausedCompaction returns if compaction of all stores is paused, and the paused store name prefixes.
+func GetPausedCompaction() (all bool, prefixes []string) {
+	globalCompactionSwitch.prefixes.Range(func(key, _ interface{}) bool {
+		prefixes = append(prefixes, key.(string))
+		return true
+	})
+	sort.Strings(prefixes)
+	return globalCompactionSwitch.all.Load(), prefixes
+}
+
+// CompactionBacklog represents the pending compaction backlog of store.
+type CompactionBacklog struct {
+	Store           string // store name
+	Level0Files     int    // number of level0 files waiting for compaction
+	PendingFamilies int    // number of families whose level0 files reach compact threshold
+	Compacting      int    // number of families doing compaction
+}
+
+// GetCompactionBacklogs returns the pending compaction backlog of all stores.
+func GetCompactionBacklogs() (rs []CompactionBacklog) {
+	for _, store := range GetStoreManager().GetStores() {
+		backlog := CompactionBacklog{Store: store.Name()}
+		for _, name := range store.ListFamilyNames() {
+			family := store.GetFamily(name)
+			if family == nil {
+				continue
+			}
+			level0Files, pending, compacting := family.compactionBacklog()
+			backlog.Level0Files += level0Files
+			if pending {
+				backlog.PendingFamilies++
+			}
+			if compacting {
+				backlog.Compacting++
+			}
+		}
+		rs = append(rs, backlog)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Store < rs[j].Store
+	})
+	return rs
+}
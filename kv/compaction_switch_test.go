@@ -0,0 +1,89 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactionSwitch(t *testing.T) {
+	defer func() {
+		SetCompactionPaused("", false)
+		SetCompactionPaused("db", false)
+	}()
+	assert.False(t, IsCompactionPaused("db/shard/1"))
+
+	SetCompactionPaused("db", true)
+	assert.True(t, IsCompactionPaused("db"))
+	assert.True(t, IsCompactionPaused("db/shard/1"))
+	assert.False(t, IsCompactionPaused("db2/shard/1"))
+	all, prefixes := GetPausedCompaction()
+	assert.False(t, all)
+	assert.Equal(t, []string{"db"}, prefixes)
+
+	SetCompactionPaused("", true)
+	assert.True(t, IsCompactionPaused("db2/shard/1"))
+	all, _ = GetPausedCompaction()
+	assert.True(t, all)
+
+	SetCompactionPaused("", false)
+	SetCompactionPaused("db", false)
+	assert.False(t, IsCompactionPaused("db/shard/1"))
+	all, prefixes = GetPausedCompaction()
+	assert.False(t, all)
+	assert.Empty(t, prefixes)
+}
+
+func TestStore_compact_paused(t *testing.T) {
+	defer func() {
+		SetCompactionPaused("db", false)
+		InitStoreManager(nil)
+	}()
+	kv, err := newStore("db/paused", filepath.Join(t.TempDir(), "db", "paused"), DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	InitStoreManager(&storeManager{stores: map[string]Store{"db/paused": kv}})
+
+	f, err := kv.CreateFamily("f", FamilyOption{CompactThreshold: 2, Merger: mergerStr, MaxFileSize: 1024 * 1024})
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		flusher := f.NewFlusher()
+		assert.NoError(t, flusher.Add(1, []byte("test")))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+	}
+	// compaction paused, level0 files are pending
+	SetCompactionPaused("db", true)
+	kv.compact()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, []CompactionBacklog{{Store: "db/paused", Level0Files: 2, PendingFamilies: 1}}, GetCompactionBacklogs())
+
+	// compaction resumed
+	SetCompactionPaused("db", false)
+	kv.compact()
+	assert.Eventually(t, func() bool {
+		backlogs := GetCompactionBacklogs()
+		return backlogs[0].Level0Files == 0 && backlogs[0].Compacting == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -22,12 +22,20 @@ import "github.com/lindb/lindb/pkg/logger"
 const (
 	dummy                   = ""
 	RollupContext           = "RollupContext"
+	TombstonesContext       = "TombstonesContext"
+	RollupFilterContext     = "RollupFilterContext"
 	defaultMaxFileSize      = uint32(256 * 1024 * 1024)
 	defaultCompactThreshold = 4
 	defaultRollupThreshold  = 3
+	// file which size < maxFileSize/smallFileSizeRatio is small file for small files compaction
+	smallFileSizeRatio = 8
+	// checkpoints version set if number of edit logs appended into current manifest file exceeds it
+	defaultManifestCheckpointLogs = 10000
 )
 
 var (
 	defaultCompactCheckInterval = 60
 	kvLogger                    = logger.GetLogger("KV", "Store")
+	// smallFilesCompactLimiter limits only one low priority small files compaction runs in all stores
+	smallFilesCompactLimiter = make(chan struct{}, 1)
 )
@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"go.uber.org/atomic"
+	"golang.org/x/time/rate"
 
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
@@ -45,12 +46,24 @@ type Family interface {
 	ID() version.FamilyID
 	// Name return family's name.
 	Name() string
+	// Path returns the dir path of family.
+	Path() string
 	// NewFlusher creates flusher for saving data to family.
 	NewFlusher() Flusher
 	// GetSnapshot returns current version's snapshot.
 	GetSnapshot() version.Snapshot
 	// Compact compacts all files of level0.
 	Compact()
+	// QuarantineFile removes the corrupt file from family version, then moves it into quarantine dir.
+	QuarantineFile(fileNumber table.FileNumber) error
+	// ReplaceFiles replaces the files of current version with the files fetched into given dir.
+	ReplaceFiles(fetch func(dir string) ([]string, error)) error
+	// SetTombstones sets the deleted entries provider, the data of deleted entries are purged when compacting.
+	SetTombstones(tombstones Tombstones)
+	// SetRollupFilter sets the rollup filter, the data of excluded entries are not rolled up into target family.
+	SetRollupFilter(filter RollupFilter)
+	// SetVersionListener sets the listener which is notified after new version of family installed.
+	SetVersionListener(listener VersionListener)
 
 	getStore() Store
 	// familyInfo return family info
@@ -64,10 +77,18 @@ type Family interface {
 	newTableBuilder() (table.Builder, error)
 	// needCompact returns level0 files if it needs to do compact job.
 	needCompact() bool
+	// compactionBacklog returns the number of level0 files, if level0 files reach compact threshold and if compacting.
+	compactionBacklog() (level0Files int, pending, compacting bool)
+	// rollupBacklog returns the number of files waiting for rollup, if they reach rollup threshold and if rolluping.
+	rollupBacklog() (files int, pending, rolluping bool)
 	// compact does compaction job.
 	compact()
 	// getNewMerger returns new merger function, merger need implement Merger interface
 	getNewMerger() NewMerger
+	// getTombstones returns the deleted entries provider, return nil if not set.
+	getTombstones() Tombstones
+	// getRollupFilter returns the rollup filter, return nil if not set.
+	getRollupFilter() RollupFilter
 	// addPendingOutput add a file which current writing file number
 	addPendingOutput(fileNumber table.FileNumber)
 	// removePendingOutput removes pending output file after compact or flush
@@ -95,6 +116,9 @@ type family struct {
 	merger        NewMerger
 	familyVersion version.FamilyVersion
 	maxFileSize   uint32
+	// compaction rate limiter shared by all families of store, nil if unlimited
+	compactLimiter *rate.Limiter
+	compactAdvice  fileutil.Advice // access pattern advice of compaction reading
 
 	pendingOutputs    sync.Map // keep all pending output files, includes flush/compact/rollup.
 	newCompactJobFunc func(family Family, state *compactionState, rollup Rollup) CompactJob
@@ -104,13 +128,20 @@ type family struct {
 	compacting     atomic.Bool
 
 	condition sync.WaitGroup // compact/rollup job if it's doing
+
+	tombstones      Tombstones
+	rollupFilter    RollupFilter
+	versionListener VersionListener
+	mutex           sync.RWMutex
 }
 
 // newFamily creates new family or open existed family.
 func newFamily(store Store, option FamilyOption) (Family, error) {
 	name := option.Name
 
-	familyPath := filepath.Join(store.Path(), name)
+	storePath := store.Path()
+	storeOption := store.Option()
+	familyPath := filepath.Join(storePath, name)
 
 	if !fileutil.Exist(familyPath) {
 		if err := mkDirFunc(familyPath); err != nil {
@@ -133,6 +164,8 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 		option:            option,
 		merger:            merger,
 		maxFileSize:       maxFileSize,
+		compactLimiter:    globalCompactThrottle.storeLimiter(storePath, int64(storeOption.CompactRateLimit)),
+		compactAdvice:     fileutil.ParseAdvice(storeOption.CompactAdvice),
 		newCompactJobFunc: newCompactJobFunc,
 		familyVersion:     store.createFamilyVersion(name, version.FamilyID(option.ID)),
 		lastRollupTime:    atomic.NewInt64(timeutil.Now()),
@@ -152,6 +185,11 @@ func (f *family) Name() string {
 	return f.name
 }
 
+// Path returns the dir path of family.
+func (f *family) Path() string {
+	return f.familyPath
+}
+
 func (f *family) getStore() Store {
 	return f.store
 }
@@ -194,6 +232,9 @@ func (f *family) commitEditLog(editLog version.EditLog) bool {
 		kvLogger.Error("commit edit log error:", logger.String("family", f.familyInfo()), logger.Error(err))
 		return false
 	}
+	if listener := f.getVersionListener(); listener != nil {
+		listener.OnVersionChanged()
+	}
 	return true
 }
 
@@ -222,10 +263,7 @@ func (f *family) needCompact() bool {
 
 	snapshot := f.GetSnapshot()
 	defer snapshot.Close()
-	threshold := f.option.CompactThreshold
-	if threshold <= 0 {
-		threshold = defaultCompactThreshold
-	}
+	threshold := f.compactThreshold()
 
 	numberOfFiles := snapshot.GetCurrent().NumberOfFilesInLevel(0)
 	if numberOfFiles > 0 && numberOfFiles >= threshold {
@@ -233,7 +271,32 @@ func (f *family) needCompact() bool {
 			logger.Any("numOfFiles", numberOfFiles), logger.Any("threshold", f.option.CompactThreshold))
 		return true
 	}
-	return false
+	return f.needCompactSmallFiles(snapshot.GetCurrent())
+}
+
+// compactionBacklog returns the number of level0 files, if level0 files reach compact threshold and if compacting.
+func (f *family) compactionBacklog() (level0Files int, pending, compacting bool) {
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+
+	level0Files = snapshot.GetCurrent().NumberOfFilesInLevel(0)
+	return level0Files, level0Files > 0 && level0Files >= f.compactThreshold(), f.compacting.Load()
+}
+
+// compactThreshold returns the level0 compact threshold of family.
+func (f *family) compactThreshold() int {
+	if f.option.CompactThreshold <= 0 {
+		return defaultCompactThreshold
+	}
+	return f.option.CompactThreshold
+}
+
+// needCompactSmallFiles checks if it needs to merge small files of level1.
+func (f *family) needCompactSmallFiles(current version.Version) bool {
+	if f.option.SmallFileCompactThreshold <= 0 {
+		return false
+	}
+	return current.PickSmallFilesCompaction(f.option.SmallFileCompactThreshold, f.maxFileSize/smallFileSizeRatio) != nil
 }
 
 // compact does compact job if it hasn't compact job running.
@@ -264,11 +327,25 @@ func (f *family) backgroundCompactionJob() error {
 	}()
 
 	compaction := snapshot.GetCurrent().PickL0Compaction(f.option.CompactThreshold)
+	if compaction == nil && f.option.SmallFileCompactThreshold > 0 {
+		// small files compaction is low priority, skip if other family is doing it.
+		select {
+		case smallFilesCompactLimiter <- struct{}{}:
+			defer func() {
+				<-smallFilesCompactLimiter
+			}()
+			compaction = snapshot.GetCurrent().PickSmallFilesCompaction(f.option.SmallFileCompactThreshold,
+				f.maxFileSize/smallFileSizeRatio)
+		default:
+		}
+	}
 	if compaction == nil {
 		// no compaction job need to do
 		return nil
 	}
 	compactionState := newCompactionState(f.maxFileSize, snapshot, compaction)
+	compactionState.advice = f.compactAdvice
+	compactionState.limiter = f.compactLimiter
 	compactJob := f.newCompactJobFunc(f, compactionState, nil)
 	if err := compactJob.Run(); err != nil {
 		return err
@@ -288,9 +365,11 @@ func (f *family) removePendingOutput(fileNumber table.FileNumber) {
 
 // deleteSST deletes the temp sst file, if flush or compact fail
 func (f *family) deleteSST(fileNumber table.FileNumber) error {
-	if err := removeDirFunc(filepath.Join(f.familyPath, version.Table(fileNumber))); err != nil {
+	path := filepath.Join(f.familyPath, version.Table(fileNumber))
+	if err := removeDirFunc(path); err != nil {
 		return err
 	}
+	notifyFileRemoved(path)
 	return nil
 }
 
@@ -304,6 +383,54 @@ func (f *family) getNewMerger() NewMerger {
 	return f.merger
 }
 
+// SetTombstones sets the deleted entries provider, the data of deleted entries are purged when compacting.
+func (f *family) SetTombstones(tombstones Tombstones) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.tombstones = tombstones
+}
+
+// getTombstones returns the deleted entries provider, return nil if not set.
+func (f *family) getTombstones() Tombstones {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.tombstones
+}
+
+// SetRollupFilter sets the rollup filter, the data of excluded entries are not rolled up into target family.
+func (f *family) SetRollupFilter(filter RollupFilter) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.rollupFilter = filter
+}
+
+// getRollupFilter returns the rollup filter, return nil if not set.
+func (f *family) getRollupFilter() RollupFilter {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.rollupFilter
+}
+
+// SetVersionListener sets the listener which is notified after new version of family installed.
+func (f *family) SetVersionListener(listener VersionListener) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.versionListener = listener
+}
+
+// getVersionListener returns the version listener, return nil if not set.
+func (f *family) getVersionListener() VersionListener {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.versionListener
+}
+
 // deleteObsoleteFiles deletes obsolete files
 func (f *family) deleteObsoleteFiles() {
 	sstFiles, err := listDirFunc(f.familyPath)
@@ -0,0 +1,151 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// for testing
+var (
+	readKeyRangeFunc = table.ReadKeyRange
+	statFunc         = os.Stat
+)
+
+// ErrFamilyBusy represents family is doing compaction/rollup job, cannot replace files.
+var ErrFamilyBusy = errors.New("family is doing compaction or rollup job")
+
+// QuarantineFile removes the corrupt file from family version, then moves it into quarantine dir,
+// so that family can continue serving from the remaining files.
+func (f *family) QuarantineFile(fileNumber table.FileNumber) error {
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	editLog := version.NewEditLog(f.ID())
+	for level := 0; level < f.store.Option().Levels; level++ {
+		if _, ok := current.GetFile(level, fileNumber); ok {
+			editLog.Add(version.NewDeleteFile(int32(level), fileNumber))
+		}
+	}
+	if editLog.IsEmpty() {
+		// file not in current version, maybe quarantined already
+		return nil
+	}
+	fileName := version.Table(fileNumber)
+	if err := quarantineFile(f.store.Path(), f.name, fileName); err != nil {
+		metrics.TableVerifyStatistics.QuarantineFailures.Incr()
+		return fmt.Errorf("quarantine file[%s] of family[%s] error:%s", fileName, f.familyInfo(), err)
+	}
+	metrics.TableVerifyStatistics.Quarantined.Incr()
+	if !f.commitEditLog(editLog) {
+		return fmt.Errorf("remove corrupt file[%s] of family[%s] from version failure", fileName, f.familyInfo())
+	}
+	kvLogger.Warn("move corrupt file into quarantine dir",
+		logger.String("family", f.familyInfo()), logger.String("file", fileName))
+	return nil
+}
+
+// ReplaceFiles replaces the files of current version with the files fetched into given dir,
+// used for repairing family data from other replica, the files written after fetching are kept.
+func (f *family) ReplaceFiles(fetch func(dir string) ([]string, error)) error {
+	// replaced files maybe are the input files of compaction/rollup job, exclude them.
+	if !f.compacting.CAS(false, true) {
+		return ErrFamilyBusy
+	}
+	defer f.compacting.Store(false)
+	if !f.rolluping.CAS(false, true) {
+		return ErrFamilyBusy
+	}
+	defer f.rolluping.Store(false)
+
+	// files of current version need to be replaced
+	editLog := version.NewEditLog(f.ID())
+	snapshot := f.GetSnapshot()
+	current := snapshot.GetCurrent()
+	oldFiles := len(current.GetAllFiles())
+	for level := 0; level < f.store.Option().Levels; level++ {
+		for _, file := range current.GetFiles(level) {
+			editLog.Add(version.NewDeleteFile(int32(level), file.GetFileNumber()))
+		}
+	}
+	snapshot.Close()
+
+	dir := filepath.Join(f.store.Path(), RepairDir, f.name)
+	if err := removeDirFunc(dir); err != nil {
+		return err
+	}
+	if err := mkDirFunc(dir); err != nil {
+		return err
+	}
+	defer func() {
+		if err := removeDirFunc(dir); err != nil {
+			kvLogger.Warn("remove repair dir failure", logger.String("family", f.familyInfo()), logger.Error(err))
+		}
+	}()
+	files, err := fetch(dir)
+	if err != nil {
+		return err
+	}
+	checker := checkers[MergerType(f.option.Merger)]
+	var fileNumbers []table.FileNumber
+	defer func() {
+		for _, fileNumber := range fileNumbers {
+			f.removePendingOutput(fileNumber)
+		}
+	}()
+	for _, file := range files {
+		if err = verifyFileFunc(file, checker); err != nil {
+			return err
+		}
+		minKey, maxKey, err0 := readKeyRangeFunc(file)
+		if err0 != nil {
+			return err0
+		}
+		stat, err0 := statFunc(file)
+		if err0 != nil {
+			return err0
+		}
+		fileNumber := f.store.nextFileNumber()
+		f.addPendingOutput(fileNumber)
+		fileNumbers = append(fileNumbers, fileNumber)
+
+		if err = renameFunc(file, filepath.Join(f.familyPath, version.Table(fileNumber))); err != nil {
+			return err
+		}
+		// files from other replica maybe overlap, add them into level 0, then compact them.
+		editLog.Add(version.CreateNewFile(0, version.NewFileMeta(fileNumber, minKey, maxKey, uint32(stat.Size()))))
+	}
+	if !f.commitEditLog(editLog) {
+		return fmt.Errorf("replace files of family[%s] failure", f.familyInfo())
+	}
+	kvLogger.Info("replace family files successfully",
+		logger.String("family", f.familyInfo()),
+		logger.Int("oldFiles", oldFiles), logger.Int("newFiles", len(files)))
+	// delete replaced files which not used by other snapshot
+	f.deleteObsoleteFiles()
+	return nil
+}
@@ -0,0 +1,215 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func newRepairTestFamily(t *testing.T, path string) (Store, Family) {
+	kv, err := newStore("test_kv", path, DefaultStoreOption())
+	assert.NoError(t, err)
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: mergerStr})
+	assert.NoError(t, err)
+	return kv, f
+}
+
+func flushRepairTestFile(t *testing.T, f Family, key uint32) {
+	flusher := f.NewFlusher()
+	assert.NoError(t, flusher.Add(key, []byte("test")))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+}
+
+func TestFamily_QuarantineFile(t *testing.T) {
+	path := t.TempDir()
+	defer func() {
+		renameFunc = os.Rename
+	}()
+	kv, f := newRepairTestFamily(t, path)
+	defer func() {
+		_ = kv.close()
+	}()
+	flushRepairTestFile(t, f, 1)
+	flushRepairTestFile(t, f, 2)
+	snapshot := f.GetSnapshot()
+	files := snapshot.GetCurrent().GetAllFiles()
+	snapshot.Close()
+	assert.Len(t, files, 2)
+	fileNumber := files[0].GetFileNumber()
+	fileName := filepath.Join(f.Path(), fmt.Sprintf("%06d.sst", fileNumber))
+	assert.True(t, fileutil.Exist(fileName))
+
+	// case 1: quarantine failure
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, f.QuarantineFile(fileNumber))
+	renameFunc = os.Rename
+	// case 2: quarantine file
+	assert.NoError(t, f.QuarantineFile(fileNumber))
+	snapshot = f.GetSnapshot()
+	assert.Len(t, snapshot.GetCurrent().GetAllFiles(), 1)
+	snapshot.Close()
+	assert.False(t, fileutil.Exist(fileName))
+	assert.True(t, fileutil.Exist(filepath.Join(path, QuarantineDir, "f", fmt.Sprintf("%06d.sst", fileNumber))))
+	// case 3: file not in version
+	assert.NoError(t, f.QuarantineFile(fileNumber))
+}
+
+func TestFamily_ReplaceFiles(t *testing.T) {
+	path := t.TempDir()
+	defer func() {
+		verifyFileFunc = table.VerifyFile
+		readKeyRangeFunc = table.ReadKeyRange
+		statFunc = os.Stat
+		renameFunc = os.Rename
+	}()
+	// build files of other replica
+	leaderKV, leader := newRepairTestFamily(t, filepath.Join(path, "leader"))
+	flushRepairTestFile(t, leader, 10)
+	flushRepairTestFile(t, leader, 20)
+	leaderFiles, err := fileutil.ListDir(leader.Path())
+	assert.NoError(t, err)
+	assert.Len(t, leaderFiles, 2)
+	assert.NoError(t, leaderKV.close())
+
+	kv, f := newRepairTestFamily(t, filepath.Join(path, "follower"))
+	defer func() {
+		_ = kv.close()
+	}()
+	flushRepairTestFile(t, f, 1)
+	fetch := func(dir string) ([]string, error) {
+		var files []string
+		for _, file := range leaderFiles {
+			data, err0 := os.ReadFile(filepath.Join(leader.Path(), file))
+			if err0 != nil {
+				return nil, err0
+			}
+			target := filepath.Join(dir, file)
+			if err0 = os.WriteFile(target, data, 0644); err0 != nil {
+				return nil, err0
+			}
+			files = append(files, target)
+		}
+		return files, nil
+	}
+	assertFiles := func(keys ...uint32) {
+		snapshot := f.GetSnapshot()
+		defer snapshot.Close()
+		assert.Len(t, snapshot.GetCurrent().GetAllFiles(), len(keys))
+		for _, key := range keys {
+			readers, err0 := snapshot.FindReaders(key)
+			assert.NoError(t, err0)
+			assert.Len(t, readers, 1)
+		}
+	}
+
+	cases := []struct {
+		name    string
+		prepare func()
+		fetch   func(dir string) ([]string, error)
+	}{
+		{
+			name:  "fetch failure",
+			fetch: func(dir string) ([]string, error) { return nil, fmt.Errorf("err") },
+		},
+		{
+			name: "verify file failure",
+			prepare: func() {
+				verifyFileFunc = func(path string, checker table.ValueChecker) error {
+					return fmt.Errorf("err")
+				}
+			},
+		},
+		{
+			name: "read key range failure",
+			prepare: func() {
+				readKeyRangeFunc = func(path string) (uint32, uint32, error) {
+					return 0, 0, fmt.Errorf("err")
+				}
+			},
+		},
+		{
+			name: "stat file failure",
+			prepare: func() {
+				statFunc = func(name string) (os.FileInfo, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+		},
+		{
+			name: "rename file failure",
+			prepare: func() {
+				renameFunc = func(oldpath, newpath string) error {
+					return fmt.Errorf("err")
+				}
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				verifyFileFunc = table.VerifyFile
+				readKeyRangeFunc = table.ReadKeyRange
+				statFunc = os.Stat
+				renameFunc = os.Rename
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			fn := fetch
+			if tt.fetch != nil {
+				fn = tt.fetch
+			}
+			assert.Error(t, f.ReplaceFiles(fn))
+			// keep current files if replace failure
+			assertFiles(1)
+			assert.False(t, fileutil.Exist(filepath.Join(kv.Path(), RepairDir, "f")))
+		})
+	}
+
+	t.Run("family is busy", func(t *testing.T) {
+		f1 := f.(*family)
+		f1.compacting.Store(true)
+		assert.Equal(t, ErrFamilyBusy, f.ReplaceFiles(fetch))
+		f1.compacting.Store(false)
+		f1.rolluping.Store(true)
+		assert.Equal(t, ErrFamilyBusy, f.ReplaceFiles(fetch))
+		f1.rolluping.Store(false)
+	})
+	t.Run("replace files successfully", func(t *testing.T) {
+		assert.NoError(t, f.ReplaceFiles(fetch))
+		assertFiles(10, 20)
+		snapshot := f.GetSnapshot()
+		readers, err0 := snapshot.FindReaders(1)
+		assert.NoError(t, err0)
+		assert.Empty(t, readers)
+		snapshot.Close()
+		assert.False(t, fileutil.Exist(filepath.Join(kv.Path(), RepairDir, "f")))
+	})
+}
@@ -75,6 +75,23 @@ func (r *rollup) BaseSlot() uint16 {
 	return r.CalcSlot(r.sourceFTime)
 }
 
+// rollupBacklog returns the number of files waiting for rollup, if they reach rollup threshold and if rolluping.
+func (f *family) rollupBacklog() (files int, pending, rolluping bool) {
+	if len(f.store.Option().Rollup) == 0 {
+		return 0, false, false
+	}
+	files = len(f.familyVersion.GetLiveRollupFiles())
+	return files, files > 0 && files >= f.rollupThreshold(), f.rolluping.Load()
+}
+
+// rollupThreshold returns the rollup threshold of family.
+func (f *family) rollupThreshold() int {
+	if f.option.RollupThreshold <= 0 {
+		return defaultRollupThreshold
+	}
+	return f.option.RollupThreshold
+}
+
 // needRollup checks if it needs rollup source family data.
 func (f *family) needRollup() bool {
 	if f.rolluping.Load() {
@@ -93,10 +110,7 @@ func (f *family) needRollup() bool {
 		// no files need to rollup
 		return false
 	}
-	threshold := f.option.RollupThreshold
-	if threshold <= 0 {
-		threshold = defaultRollupThreshold
-	}
+	threshold := f.rollupThreshold()
 	kvLogger.Info("check file threshold if need to rollup level0 files", logger.String("family", f.familyInfo()),
 		logger.Any("numOfFiles", rollupFilesLen), logger.Any("threshold", threshold))
 	if rollupFilesLen >= threshold {
@@ -288,6 +302,8 @@ func (f *family) doRollupWork(sourceFamily Family, rollup Rollup, sourceFiles []
 	compaction.AddReferenceFiles(logs)
 
 	compactionState := newCompactionState(f.maxFileSize, snapshot, compaction)
+	compactionState.advice = f.compactAdvice
+	compactionState.limiter = f.compactLimiter
 	compactJob := newCompactJobFunc(f, compactionState, rollup)
 	if err := compactJob.Run(); err != nil {
 		return err
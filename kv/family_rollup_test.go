@@ -363,7 +363,7 @@ func TestFamily_doRollupWork(t *testing.T) {
 					sourceFamily.EXPECT().familyInfo().Return("familyInfo").MaxTimes(2),
 					sourceFamily.EXPECT().GetSnapshot().Return(snapshot),
 					snapshot.EXPECT().GetCurrent().Return(v),
-					v.EXPECT().GetFile(0, table.FileNumber(20)).Return(nil, true),
+					v.EXPECT().GetFile(0, table.FileNumber(20)).Return(version.NewFileMeta(20, 1, 10, 1024), true),
 					compactJob.EXPECT().Run().Return(nil),
 					snapshot.EXPECT().Close(),
 				)
@@ -387,7 +387,7 @@ func TestFamily_doRollupWork(t *testing.T) {
 					sourceFamily.EXPECT().familyInfo().Return("familyInfo").MaxTimes(2),
 					sourceFamily.EXPECT().GetSnapshot().Return(snapshot),
 					snapshot.EXPECT().GetCurrent().Return(v),
-					v.EXPECT().GetFile(0, table.FileNumber(20)).Return(nil, true),
+					v.EXPECT().GetFile(0, table.FileNumber(20)).Return(version.NewFileMeta(20, 1, 10, 1024), true),
 					compactJob.EXPECT().Run().Return(fmt.Errorf("err")),
 					snapshot.EXPECT().Close(),
 				)
@@ -429,6 +429,7 @@ func mockFamily(t *testing.T, ctrl *gomock.Controller) (*family, *MockStore) {
 	path := filepath.Join(t.TempDir(), "need_rollup")
 	store := NewMockStore(ctrl)
 	store.EXPECT().Path().Return(path)
+	store.EXPECT().Option().Return(StoreOption{})
 	fv := version.NewMockFamilyVersion(ctrl)
 	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(fv)
 	f, err := newFamily(store, FamilyOption{Merger: "mockMerger"})
@@ -74,6 +74,14 @@ func TestFamily_New(t *testing.T) {
 
 	assert.NotNil(t, f.getFamilyVersion())
 	assert.NotNil(t, f.getNewMerger())
+	assert.Nil(t, f.getTombstones())
+	tombstones := NewMockTombstones(ctrl)
+	f.SetTombstones(tombstones)
+	assert.Equal(t, tombstones, f.getTombstones())
+	assert.Nil(t, f.getRollupFilter())
+	rollupFilter := NewMockRollupFilter(ctrl)
+	f.SetRollupFilter(rollupFilter)
+	assert.Equal(t, rollupFilter, f.getRollupFilter())
 }
 
 func TestFamily_Data_Write_Read(t *testing.T) {
@@ -130,6 +138,12 @@ func TestFamily_commitEditLog(t *testing.T) {
 	// case 4: commit edit log success
 	store.EXPECT().commitFamilyEditLog(gomock.Any(), gomock.Any()).Return(nil)
 	assert.True(t, f.commitEditLog(editLog))
+	// case 5: notify version listener after commit successfully
+	listener := NewMockVersionListener(ctrl)
+	f.SetVersionListener(listener)
+	store.EXPECT().commitFamilyEditLog(gomock.Any(), gomock.Any()).Return(nil)
+	listener.EXPECT().OnVersionChanged()
+	assert.True(t, f.commitEditLog(editLog))
 }
 
 func TestFamily_needCompact(t *testing.T) {
@@ -234,6 +248,55 @@ func TestFamily_compact_background(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFamily_compact_smallFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := NewMockStore(ctrl)
+	store.EXPECT().Option().Return(DefaultStoreOption()).AnyTimes()
+	store.EXPECT().Path().Return(t.TempDir())
+	fv := version.NewMockFamilyVersion(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	fv.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	fv.EXPECT().GetAllActiveFiles().Return(nil).AnyTimes()
+	fv.EXPECT().GetLiveRollupFiles().Return(nil).AnyTimes()
+	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(fv)
+	f, err := newFamily(store, FamilyOption{Merger: "mockMerger", Name: "compact_small_files", SmallFileCompactThreshold: 2})
+	assert.NoError(t, err)
+	f2 := f.(*family)
+	compactJob := NewMockCompactJob(ctrl)
+	f2.newCompactJobFunc = func(family Family, state *compactionState, rollup Rollup) CompactJob {
+		assert.Nil(t, rollup)
+		return compactJob
+	}
+	smallFileSize := defaultMaxFileSize / smallFileSizeRatio
+	// case 1: no small files
+	v.EXPECT().NumberOfFilesInLevel(0).Return(0)
+	v.EXPECT().PickSmallFilesCompaction(2, smallFileSize).Return(nil)
+	assert.False(t, f.needCompact())
+	// case 2: need compact small files
+	v.EXPECT().NumberOfFilesInLevel(0).Return(0)
+	v.EXPECT().PickSmallFilesCompaction(2, smallFileSize).Return(version.NewCompaction(1, 0, nil, nil))
+	assert.True(t, f.needCompact())
+	// case 3: level0 compaction first
+	v.EXPECT().PickL0Compaction(gomock.Any()).Return(version.NewCompaction(1, 0, nil, nil))
+	compactJob.EXPECT().Run().Return(nil)
+	assert.NoError(t, f2.backgroundCompactionJob())
+	// case 4: compact small files
+	v.EXPECT().PickL0Compaction(gomock.Any()).Return(nil)
+	v.EXPECT().PickSmallFilesCompaction(2, smallFileSize).Return(version.NewCompaction(1, 0, nil, nil))
+	compactJob.EXPECT().Run().Return(nil)
+	assert.NoError(t, f2.backgroundCompactionJob())
+	// case 5: other family is compacting small files
+	smallFilesCompactLimiter <- struct{}{}
+	v.EXPECT().PickL0Compaction(gomock.Any()).Return(nil)
+	assert.NoError(t, f2.backgroundCompactionJob())
+	<-smallFilesCompactLimiter
+}
+
 func TestFamily_deleteObsoleteFiles(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -222,3 +222,57 @@ func (nw *nopStreamWriter) Size() uint32 { return nw.size }
 func (nw *nopStreamWriter) Commit() error { return nil }
 
 func (nw *nopStreamWriter) Release() {}
+
+// fileFlusher implements Flusher, writes k/v pairs into a standalone store file,
+// used for building the files which replace the files of family.
+type fileFlusher struct {
+	fileName string
+	builder  table.Builder
+}
+
+// NewFileFlusher creates a flusher which writes k/v pairs into given file,
+// the file is created when first k/v pair written.
+func NewFileFlusher(fileName string) Flusher {
+	return &fileFlusher{fileName: fileName}
+}
+
+func (ff *fileFlusher) checkBuilder() error {
+	if ff.builder == nil {
+		builder, err := table.NewStoreBuilder(0, ff.fileName)
+		if err != nil {
+			return err
+		}
+		ff.builder = builder
+	}
+	return nil
+}
+
+// StreamWriter creates a stream writer for flushing in stream.
+func (ff *fileFlusher) StreamWriter() (table.StreamWriter, error) {
+	if err := ff.checkBuilder(); err != nil {
+		return nil, err
+	}
+	return ff.builder.StreamWriter(), nil
+}
+
+// Add puts k/v pair.
+func (ff *fileFlusher) Add(key uint32, value []byte) error {
+	if err := ff.checkBuilder(); err != nil {
+		return err
+	}
+	return ff.builder.Add(key, value)
+}
+
+// Sequence does nothing, standalone file has no replica sequence.
+func (ff *fileFlusher) Sequence(_ int32, _ int64) {}
+
+// Commit closes the file if any k/v pair written.
+func (ff *fileFlusher) Commit() error {
+	if ff.builder == nil {
+		return nil
+	}
+	return ff.builder.Close()
+}
+
+// Release does nothing.
+func (ff *fileFlusher) Release() {}
@@ -19,6 +19,8 @@ package kv
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -234,3 +236,33 @@ func Test_NopFlusher(t *testing.T) {
 	nopSW := &nopStreamWriter{}
 	nopSW.Release()
 }
+
+func TestFileFlusher(t *testing.T) {
+	dir := t.TempDir()
+	// case 1: no data written, file not created
+	ff := NewFileFlusher(filepath.Join(dir, "000001.sst"))
+	ff.Sequence(1, 10)
+	assert.NoError(t, ff.Commit())
+	ff.Release()
+	_, err := os.Stat(filepath.Join(dir, "000001.sst"))
+	assert.True(t, os.IsNotExist(err))
+	// case 2: create file failure
+	ff = NewFileFlusher(filepath.Join(dir, "not_exist", "000001.sst"))
+	assert.Error(t, ff.Add(1, []byte{1}))
+	_, err = ff.StreamWriter()
+	assert.Error(t, err)
+	// case 3: write k/v pairs into file
+	fileName := filepath.Join(dir, "000002.sst")
+	ff = NewFileFlusher(fileName)
+	assert.NoError(t, ff.Add(1, []byte{1, 2, 3}))
+	writer, err := ff.StreamWriter()
+	assert.NoError(t, err)
+	writer.Prepare(10)
+	_, _ = writer.Write([]byte{4, 5, 6})
+	assert.NoError(t, writer.Commit())
+	assert.NoError(t, ff.Commit())
+	minKey, maxKey, err := table.ReadKeyRange(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), minKey)
+	assert.Equal(t, uint32(10), maxKey)
+}
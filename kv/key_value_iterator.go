@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import "github.com/lindb/lindb/kv/table"
+
+// keyValueIterator implements ValueIterator, iterates the values of same key from merged input iterator,
+// values are read on demand, the remaining values of current key are skipped when moving to next key.
+type keyValueIterator struct {
+	it     table.Iterator
+	onRead func(value []byte)
+
+	key   uint32 // current key
+	value []byte // current value
+
+	started   bool
+	fetched   bool   // if it has value fetched from input iterator, but not consumed
+	nextKey   uint32 // key of fetched value
+	nextValue []byte // fetched value
+}
+
+// newKeyValueIterator creates a values iterator based on merged input iterator.
+func newKeyValueIterator(it table.Iterator, onRead func(value []byte)) *keyValueIterator {
+	return &keyValueIterator{
+		it:     it,
+		onRead: onRead,
+	}
+}
+
+// moveNextKey moves to next key, returns false if no more key.
+func (i *keyValueIterator) moveNextKey() bool {
+	for i.fetch() {
+		if !i.started || i.nextKey != i.key {
+			i.started = true
+			i.key = i.nextKey
+			i.value = nil
+			return true
+		}
+		// skip remaining value of current key
+		i.fetched = false
+	}
+	return false
+}
+
+// HasNext returns if it has next value under current key.
+func (i *keyValueIterator) HasNext() bool {
+	if !i.started || !i.fetch() || i.nextKey != i.key {
+		return false
+	}
+	i.value = i.nextValue
+	i.fetched = false
+	return true
+}
+
+// Value returns current value.
+func (i *keyValueIterator) Value() []byte {
+	return i.value
+}
+
+// fetch fetches next value from input iterator if no pending value, returns false if no more value.
+func (i *keyValueIterator) fetch() bool {
+	if i.fetched {
+		return true
+	}
+	if !i.it.HasNext() {
+		return false
+	}
+	i.nextKey = i.it.Key()
+	i.nextValue = i.it.Value()
+	i.fetched = true
+	if i.onRead != nil {
+		i.onRead(i.nextValue)
+	}
+	return true
+}
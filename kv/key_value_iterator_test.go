@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type kvPair struct {
+	key   uint32
+	value []byte
+}
+
+// sliceIterator implements table.Iterator for testing.
+type sliceIterator struct {
+	pairs []kvPair
+	idx   int
+}
+
+func (it *sliceIterator) HasNext() bool {
+	it.idx++
+	return it.idx < len(it.pairs)
+}
+
+func (it *sliceIterator) Key() uint32 {
+	return it.pairs[it.idx].key
+}
+
+func (it *sliceIterator) Value() []byte {
+	return it.pairs[it.idx].value
+}
+
+func TestKeyValueIterator(t *testing.T) {
+	pairs := []kvPair{
+		{key: 1, value: []byte{1}},
+		{key: 1, value: []byte{2}},
+		{key: 2, value: []byte{3}},
+		{key: 3, value: []byte{4}},
+		{key: 3, value: []byte{5}},
+		{key: 3, value: []byte{6}},
+		{key: 4, value: []byte{7}},
+	}
+	readBytes := 0
+	it := newKeyValueIterator(&sliceIterator{pairs: pairs, idx: -1}, func(value []byte) {
+		readBytes += len(value)
+	})
+	// not started
+	assert.False(t, it.HasNext())
+	// consume all values of key 1
+	assert.True(t, it.moveNextKey())
+	assert.Equal(t, uint32(1), it.key)
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []byte{1}, it.Value())
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []byte{2}, it.Value())
+	assert.False(t, it.HasNext())
+	assert.False(t, it.HasNext())
+	// key 2 not consumed
+	assert.True(t, it.moveNextKey())
+	assert.Equal(t, uint32(2), it.key)
+	// consume part of values of key 3, remaining values are skipped
+	assert.True(t, it.moveNextKey())
+	assert.Equal(t, uint32(3), it.key)
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []byte{4}, it.Value())
+	assert.True(t, it.moveNextKey())
+	assert.Equal(t, uint32(4), it.key)
+	assert.True(t, it.HasNext())
+	assert.Equal(t, []byte{7}, it.Value())
+	assert.False(t, it.HasNext())
+	assert.False(t, it.moveNextKey())
+	assert.Equal(t, len(pairs), readBytes)
+}
@@ -17,6 +17,8 @@
 
 package kv
 
+import "github.com/lindb/roaring"
+
 //go:generate mockgen -source ./merger.go -destination=./merger_mock.go -package kv
 
 // MergerType represents the merger type
@@ -45,3 +47,43 @@ type Merger interface {
 	// return err if failure
 	Merge(key uint32, values [][]byte) error
 }
+
+// StreamMerger represents the merger which consumes the values of same key incrementally,
+// compaction job prefers streaming mode if merger implements it, so that huge key(hot series etc.)
+// doesn't require holding all input values in memory simultaneously.
+type StreamMerger interface {
+	Merger
+	// MergeStream merges values for same key which read from iterator one by one,
+	// merged data will be written into Flusher directly
+	// return err if failure
+	MergeStream(key uint32, values ValueIterator) error
+}
+
+// ValueIterator represents the iterator of values under same key.
+type ValueIterator interface {
+	// HasNext returns if it has next value under current key.
+	HasNext() bool
+	// Value returns current value, value is backed by input file, keeps valid until compaction job completed.
+	Value() []byte
+}
+
+// Tombstones represents the deleted entries under key, merger purges the data of deleted entries
+// when doing compaction job(compact/rollup etc.).
+type Tombstones interface {
+	// GetTombstones returns the ids of deleted entries under key, returns nil if no entry deleted.
+	GetTombstones(key uint32) *roaring.Bitmap
+}
+
+// VersionListener represents the listener of family version, it is notified after new version installed
+// by flush/compact/rollup job etc.
+type VersionListener interface {
+	// OnVersionChanged is invoked after new version of family installed.
+	OnVersionChanged()
+}
+
+// RollupFilter represents the filter of rollup job, merger skips rolling up the data of excluded keys,
+// the data of excluded keys is only kept in source family.
+type RollupFilter interface {
+	// SkipRollup returns true if the data under key is excluded from rollup.
+	SkipRollup(key uint32) bool
+}
@@ -20,6 +20,7 @@ package kv
 import (
 	"time"
 
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -32,6 +33,9 @@ type FamilyOption struct {
 	RollupThreshold  int    `toml:"rollupThreshold"`  // level 0 rollup threshold
 	Merger           string `toml:"merger"`           // merger which need implement Merger interface
 	MaxFileSize      uint32 `toml:"maxFileSize"`      // max file size
+	// level 1 small files compact threshold, 0 disables small files compaction.
+	// small files compaction is low priority, only runs when level 0 compaction not needed.
+	SmallFileCompactThreshold int `toml:"smallFileCompactThreshold"`
 }
 
 // StoreOption defines config item for store level
@@ -39,6 +43,19 @@ type StoreOption struct {
 	Levels int            `toml:"levels"` // num. of levels
 	TTL    ltoml.Duration `toml:"ttl"`
 
+	// access pattern advice(normal/random/sequential/willneed/dontneed) of mapped sst file
+	ReadAdvice string `toml:"readAdvice"` // advice for query reading, applies on whole file
+	// advice for compaction reading, applies on the range ahead of reading,
+	// the pages of consumed range are released after reading.
+	CompactAdvice string `toml:"compactAdvice"`
+
+	// rate limit(bytes/sec) of compaction reading shared by all families of store, 0 means unlimited.
+	CompactRateLimit ltoml.Size `toml:"compactRateLimit"`
+
+	// checkpoints version set into new manifest file if number of edit logs appended into current manifest exceeds it,
+	// 0 uses default value, negative disables checkpoint.
+	ManifestCheckpointLogs int `toml:"manifestCheckpointLogs"`
+
 	Source timeutil.Interval   `toml:"source"` // optional(source interval)
 	Rollup []timeutil.Interval `toml:"rollup"` // optional(target interval)
 }
@@ -46,8 +63,10 @@ type StoreOption struct {
 // DefaultStoreOption builds default store option
 func DefaultStoreOption() StoreOption {
 	return StoreOption{
-		Levels: 2,
-		TTL:    ltoml.Duration(time.Hour),
+		Levels:        2,
+		TTL:           ltoml.Duration(time.Hour),
+		ReadAdvice:    fileutil.AdviceNormal.String(),
+		CompactAdvice: fileutil.AdviceSequential.String(),
 	}
 }
 
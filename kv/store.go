@@ -46,10 +46,28 @@ var (
 	listDirFunc       = fileutil.ListDir
 	mkDirFunc         = fileutil.MkDirIfNotExist
 	removeFunc        = os.Remove
+	renameFunc        = os.Rename
+	verifyFileFunc    = table.VerifyFile
 	newFileLockFunc   = lockers.NewFileLock
 	newStoreFunc      = newStore
 )
 
+// fileRemovedListener is notified with the path of removed sst file(or closed store), nil disables it.
+var fileRemovedListener func(path string)
+
+// SetFileRemovedListener sets the listener which is notified with the path of sst file after it removed,
+// or the path of store after it closed, e.g. invalidates the data cached by sst file outside kv store.
+func SetFileRemovedListener(listener func(path string)) {
+	fileRemovedListener = listener
+}
+
+// notifyFileRemoved notifies the listener that the file(or all files under the path) cannot be read any more.
+func notifyFileRemoved(path string) {
+	if fileRemovedListener != nil {
+		fileRemovedListener(path)
+	}
+}
+
 // Store is kv store, supporting column family, but is different from other LSM implementation.
 // Current implementation doesn't contain memory table write logic.
 type Store interface {
@@ -91,6 +109,8 @@ type store struct {
 	// file-lock restricts access to store by allowing only one instance
 	lock     lockers.FileLock
 	versions version.StoreVersionSet
+	// checkpointing indicates version set is being checkpointed
+	checkpointing atomic.Bool
 	// each family instance need to be assigned a unique family id
 	familySeq atomic.Int32
 	families  map[string]Family // family name => family
@@ -161,7 +181,7 @@ func newStore(name, path string, option StoreOption) (s Store, err error) {
 	}()
 
 	// build store reader cache
-	store1.cache = table.NewCache(path, option.TTL.Duration())
+	store1.cache = table.NewCache(path, option.TTL.Duration(), fileutil.ParseAdvice(option.ReadAdvice))
 	// init version set
 	store1.versions = newVersionSetFunc(path, store1.cache, store1.option.Levels)
 
@@ -191,6 +211,10 @@ func newStore(name, path string, option StoreOption) (s Store, err error) {
 	if err != nil {
 		return nil, fmt.Errorf("recover store version set error:%s", err)
 	}
+	// verify the integrity of live files, report(or quarantine if enabled) corrupt files instead of failing the whole store
+	if err = store1.verifyFamilyFiles(); err != nil {
+		return nil, fmt.Errorf("verify files of store[%s] error:%s", path, err)
+	}
 
 	return store1, nil
 }
@@ -282,6 +306,7 @@ func (s *store) close() error {
 	for _, f := range families {
 		f.close()
 	}
+	notifyFileRemoved(s.path)
 
 	if err := s.cache.Close(); err != nil {
 		kvLogger.Error("close store cache error", logger.String("store", s.path), logger.Error(err))
@@ -290,6 +315,7 @@ func (s *store) close() error {
 		kvLogger.Error("destroy store version set error",
 			logger.String("store", s.path), logger.Error(err))
 	}
+	globalCompactThrottle.removeStore(s.path)
 	s.cancel()
 	return s.lock.Unlock()
 }
@@ -312,7 +338,13 @@ func (s *store) nextFileNumber() table.FileNumber {
 
 // commitFamilyEditLog persists edit logs to manifest file, then apply new version to family version
 func (s *store) commitFamilyEditLog(name string, editLog version.EditLog) error {
-	return s.versions.CommitFamilyEditLog(name, editLog)
+	if err := s.versions.CommitFamilyEditLog(name, editLog); err != nil {
+		return err
+	}
+	// checkpoint version set if manifest file grows too large, reduces edit logs replayed when recovering,
+	// checks after each edit log committed, so that it's independent of compaction.
+	s.checkpointManifest()
+	return nil
 }
 
 // dumpStoreInfo persists store info to OPTIONS file
@@ -337,12 +369,15 @@ func (s *store) compact() {
 	}
 	s.rwMutex.RUnlock()
 
-	for _, family := range families {
-		if family.needCompact() {
-			family.compact()
-		}
-		if family.needRollup() {
-			family.rollup()
+	// skip compaction if paused by operator, pending level0 files are compacted after resumed
+	if !IsCompactionPaused(s.name) {
+		for _, family := range families {
+			if family.needCompact() {
+				family.compact()
+			}
+			if family.needRollup() {
+				family.rollup()
+			}
 		}
 	}
 
@@ -350,6 +385,30 @@ func (s *store) compact() {
 	s.cache.Cleanup()
 }
 
+// checkpointManifest checkpoints version set into new manifest file if too many edit logs appended into
+// current manifest file, then deletes the obsolete manifest files.
+// Skips if other goroutine is checkpointing, because obsolete files are determined by current manifest file.
+func (s *store) checkpointManifest() {
+	threshold := s.option.ManifestCheckpointLogs
+	if threshold == 0 {
+		threshold = defaultManifestCheckpointLogs
+	}
+	if threshold < 0 || s.versions.EditLogsSinceCheckpoint() < int64(threshold) {
+		return
+	}
+	if !s.checkpointing.CAS(false, true) {
+		return
+	}
+	defer s.checkpointing.Store(false)
+
+	if err := s.versions.Checkpoint(); err != nil {
+		kvLogger.Error("checkpoint version set fail",
+			logger.String("store", s.path), logger.String("kv", s.name), logger.Error(err))
+		return
+	}
+	s.deleteObsoleteFiles()
+}
+
 // deleteFamilyObsoleteFiles deletes the all families obsolete files when init kv store
 func (s *store) deleteFamilyObsoleteFiles() {
 	for _, family := range s.families {
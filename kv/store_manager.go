@@ -23,6 +23,7 @@ import (
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/pkg/logger"
 )
 
@@ -31,6 +32,7 @@ import (
 type StoreOptions struct {
 	Dir                  string // store root path
 	CompactCheckInterval int    // compact/rollup job check interval(number of seconds)
+	BlockCacheSize       int64  // size of off-heap block cache shared by all stores, 0 disables block cache
 }
 
 var (
@@ -83,6 +85,9 @@ type storeManager struct {
 
 // newStoreManager creates a StoreManager instance.
 func newStoreManager(options StoreOptions) StoreManager {
+	if options.BlockCacheSize > 0 {
+		table.SetBlockCache(table.NewBlockCache(options.BlockCacheSize))
+	}
 	return &storeManager{
 		stores:  make(map[string]Store),
 		options: options,
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -232,6 +233,70 @@ func TestStore_deleteObsoleteFiles(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStore_checkpointManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	path := filepath.Join(t.TempDir(), "test_data")
+	option := DefaultStoreOption()
+	option.ManifestCheckpointLogs = 2
+	kv, err := newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	s := kv.(*store)
+	f, err := kv.CreateFamily("f", FamilyOption{CompactThreshold: 10, Merger: mergerStr, MaxFileSize: 1024 * 1024})
+	assert.NoError(t, err)
+	flush := func() {
+		flusher := f.NewFlusher()
+		assert.NoError(t, flusher.Add(1, []byte("test")))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+	}
+	manifests := func() (names []string) {
+		files, err := fileutil.ListDir(path)
+		assert.NoError(t, err)
+		for _, file := range files {
+			if strings.HasPrefix(file, version.ManifestPrefix) {
+				names = append(names, file)
+			}
+		}
+		return names
+	}
+	manifestFileNumber := s.versions.ManifestFileNumber()
+	// case 1: edit logs not exceed threshold
+	flush()
+	assert.Equal(t, manifestFileNumber, s.versions.ManifestFileNumber())
+	// case 2: checkpoint after edit log committed, then delete old manifest file
+	flush()
+	assert.NotEqual(t, manifestFileNumber, s.versions.ManifestFileNumber())
+	assert.Equal(t, []string{version.ManifestFileName(s.versions.ManifestFileNumber())}, manifests())
+	assert.Equal(t, int64(0), s.versions.EditLogsSinceCheckpoint())
+	// case 3: checkpoint disabled
+	s.option.ManifestCheckpointLogs = -1
+	flush()
+	flush()
+	assert.Equal(t, int64(2), s.versions.EditLogsSinceCheckpoint())
+	// case 4: checkpoint fail
+	s.option.ManifestCheckpointLogs = 1
+	vs := version.NewMockStoreVersionSet(ctrl)
+	versions := s.versions
+	s.versions = vs
+	vs.EXPECT().EditLogsSinceCheckpoint().Return(int64(10))
+	vs.EXPECT().Checkpoint().Return(fmt.Errorf("err"))
+	s.checkpointManifest()
+	// case 5: other goroutine is checkpointing
+	vs.EXPECT().EditLogsSinceCheckpoint().Return(int64(10))
+	s.checkpointing.Store(true)
+	s.checkpointManifest()
+	s.checkpointing.Store(false)
+	// case 6: commit edit log fail
+	vs.EXPECT().CommitFamilyEditLog(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, s.commitFamilyEditLog("f", nil))
+	s.versions = versions
+}
+
 func TestStore_Compact(t *testing.T) {
 	path := "compact_test"
 	option := DefaultStoreOption()
@@ -0,0 +1,154 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+const (
+	// QuarantineDir is the dir name under store path which keeps the corrupt files.
+	QuarantineDir = "quarantine"
+	// RepairDir is the dir name under store path which keeps the files fetched from other replica temporarily.
+	RepairDir = "repair"
+)
+
+var (
+	// verifyValuesOnOpen represents if verifying crc32 of each value when opening store(read whole file).
+	verifyValuesOnOpen atomic.Bool
+	// quarantineOnOpen represents if quarantining the corrupt files found when opening store.
+	quarantineOnOpen atomic.Bool
+)
+
+// SetVerifyOption sets the options of verifying files when opening store.
+// By default, only footer/magic-number/index block of file are verified, and corrupt files are only reported,
+// verifyValues enables checking crc32 of each value, which reads the whole file;
+// quarantine enables moving corrupt files into quarantine dir(explicit operator action).
+func SetVerifyOption(verifyValues, quarantine bool) {
+	verifyValuesOnOpen.Store(verifyValues)
+	quarantineOnOpen.Store(quarantine)
+}
+
+// verifyFamilyFiles verifies the integrity of live files of all families when opening store,
+// corrupt files are reported, if quarantine enabled by operator,
+// moves corrupt files into quarantine dir and removes them from family version,
+// so that store can continue serving from the remaining files.
+func (s *store) verifyFamilyFiles() error {
+	for familyName, family := range s.families {
+		if err := s.verifyFamily(familyName, family); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyFamily verifies the live files of family, then quarantines the corrupt files.
+func (s *store) verifyFamily(familyName string, family Family) error {
+	var checker table.ValueChecker
+	if verifyValuesOnOpen.Load() {
+		checker = checkers[MergerType(s.storeInfo.Families[familyName].Merger)]
+	}
+	quarantine := quarantineOnOpen.Load()
+	familyPath := filepath.Join(s.path, familyName)
+	startTime := time.Now()
+
+	snapshot := family.GetSnapshot()
+	current := snapshot.GetCurrent()
+	editLog := version.NewEditLog(family.ID())
+	total := 0
+	var corruptFiles []string
+	for level := 0; level < s.option.Levels; level++ {
+		for _, file := range current.GetFiles(level) {
+			total++
+			fileName := version.Table(file.GetFileNumber())
+			err := verifyFileFunc(filepath.Join(familyPath, fileName), checker)
+			if err == nil {
+				metrics.TableVerifyStatistics.Verified.Incr()
+				continue
+			}
+			metrics.TableVerifyStatistics.Corrupted.Incr()
+			kvLogger.Error("found corrupt file",
+				logger.String("store", s.path), logger.String("family", familyName),
+				logger.String("file", fileName), logger.Int32("level", int32(level)),
+				logger.Any("fileSize", file.GetFileSize()), logger.Any("quarantine", quarantine), logger.Error(err))
+			corruptFiles = append(corruptFiles, fileName)
+			if !quarantine {
+				// keep corrupt file until operator enables quarantine explicitly
+				continue
+			}
+			if err = quarantineFile(s.path, familyName, fileName); err != nil {
+				metrics.TableVerifyStatistics.QuarantineFailures.Incr()
+				snapshot.Close()
+				return fmt.Errorf("quarantine file[%s] of family[%s] error:%s", fileName, familyName, err)
+			}
+			metrics.TableVerifyStatistics.Quarantined.Incr()
+			s.evictFamilyFile(file.GetFileNumber())
+			editLog.Add(version.NewDeleteFile(int32(level), file.GetFileNumber()))
+		}
+	}
+	snapshot.Close()
+
+	if len(corruptFiles) == 0 {
+		kvLogger.Info("verify family files successfully",
+			logger.String("store", s.path), logger.String("family", familyName),
+			logger.Int("files", total), logger.String("cost", time.Since(startTime).String()))
+		return nil
+	}
+	if !quarantine {
+		kvLogger.Warn("verify family files completed, found corrupt files, "+
+			"enable quarantine-corrupt-files then restart to quarantine them",
+			logger.String("store", s.path), logger.String("family", familyName),
+			logger.Int("files", total), logger.Any("corruptFiles", corruptFiles),
+			logger.String("cost", time.Since(startTime).String()))
+		return nil
+	}
+	// remove corrupt files from family version
+	if !family.commitEditLog(editLog) {
+		return fmt.Errorf("remove corrupt files of family[%s] from version failure", familyName)
+	}
+	kvLogger.Warn("verify family files completed, corrupt files are quarantined",
+		logger.String("store", s.path), logger.String("family", familyName),
+		logger.Int("files", total), logger.Any("corruptFiles", corruptFiles),
+		logger.String("quarantineDir", filepath.Join(s.path, QuarantineDir, familyName)),
+		logger.String("cost", time.Since(startTime).String()))
+	return nil
+}
+
+// quarantineFile moves the corrupt file of family into quarantine dir.
+func quarantineFile(storePath, familyName, fileName string) error {
+	source := filepath.Join(storePath, familyName, fileName)
+	if !fileutil.Exist(source) {
+		// file is missing, nothing to move
+		return nil
+	}
+	dir := filepath.Join(storePath, QuarantineDir, familyName)
+	if err := mkDirFunc(dir); err != nil {
+		return err
+	}
+	return renameFunc(source, filepath.Join(dir, fileName))
+}
@@ -0,0 +1,130 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestRegisterChecker(t *testing.T) {
+	assert.Panics(t, func() {
+		RegisterChecker("test", nil)
+		RegisterChecker("test", nil)
+	})
+}
+
+func TestStore_verifyFamilyFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "verify_test")
+	option := DefaultStoreOption()
+	defer func() {
+		renameFunc = os.Rename
+		verifyFileFunc = table.VerifyFile
+		SetVerifyOption(false, false)
+	}()
+	writeFile := func(key uint32) {
+		kv, err := newStore("test_kv", path, option)
+		assert.NoError(t, err)
+		f, err := kv.CreateFamily("f", FamilyOption{Merger: mergerStr})
+		assert.NoError(t, err)
+		flusher := f.NewFlusher()
+		assert.NoError(t, flusher.Add(key, []byte("test")))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+		assert.NoError(t, kv.close())
+	}
+	writeFile(1)
+	writeFile(2)
+	familyPath := filepath.Join(path, "f")
+	files, err := fileutil.ListDir(familyPath)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	// corrupt magic number of first file
+	corruptFile := filepath.Join(familyPath, files[0])
+	data, err := os.ReadFile(corruptFile)
+	assert.NoError(t, err)
+	data[len(data)-1]++
+	assert.NoError(t, os.WriteFile(corruptFile, data, 0644))
+
+	// case 0: only verify footer/index by default, corrupt file is reported and kept
+	verifyFileFunc = func(path string, checker table.ValueChecker) error {
+		assert.Nil(t, checker)
+		return table.VerifyFile(path, checker)
+	}
+	kv, err := newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	snapshot := kv.GetFamily("f").GetSnapshot()
+	assert.Len(t, snapshot.GetCurrent().GetAllFiles(), 2)
+	snapshot.Close()
+	assert.True(t, fileutil.Exist(corruptFile))
+	assert.NoError(t, kv.close())
+	verifyFileFunc = table.VerifyFile
+
+	// quarantine enabled by operator
+	SetVerifyOption(true, true)
+	// case 1: quarantine file failure
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	kv, err = newStore("test_kv", path, option)
+	assert.Error(t, err)
+	assert.Nil(t, kv)
+	renameFunc = os.Rename
+	// case 2: quarantine corrupt file, continue serving from remaining files
+	kv, err = newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	snapshot = kv.GetFamily("f").GetSnapshot()
+	assert.Len(t, snapshot.GetCurrent().GetAllFiles(), 1)
+	readers, err := snapshot.FindReaders(2)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	snapshot.Close()
+	assert.True(t, fileutil.Exist(filepath.Join(path, QuarantineDir, "f", files[0])))
+	assert.False(t, fileutil.Exist(corruptFile))
+	assert.NoError(t, kv.close())
+	// case 3: all files corrupt
+	verifyFileFunc = func(path string, checker table.ValueChecker) error {
+		return fmt.Errorf("err")
+	}
+	kv, err = newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	snapshot = kv.GetFamily("f").GetSnapshot()
+	assert.Empty(t, snapshot.GetCurrent().GetAllFiles())
+	snapshot.Close()
+	assert.NoError(t, kv.close())
+	// case 4: file is missing
+	verifyFileFunc = table.VerifyFile
+	writeFile(3)
+	files, err = fileutil.ListDir(familyPath)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.NoError(t, os.Remove(filepath.Join(familyPath, files[0])))
+	kv, err = newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	snapshot = kv.GetFamily("f").GetSnapshot()
+	assert.Empty(t, snapshot.GetCurrent().GetAllFiles())
+	snapshot.Close()
+	assert.NoError(t, kv.close())
+}
@@ -0,0 +1,397 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"container/list"
+	"os"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+//go:generate mockgen -source ./block_cache.go -destination=./block_cache_mock.go -package table
+
+// for testing
+var (
+	allocBlockFn = fileutil.MapAnonymous
+	freeBlockFn  = fileutil.UnmapAnonymous
+)
+
+// pageSize is the allocation unit of off-heap memory.
+var pageSize = os.Getpagesize()
+
+const (
+	// maxBlockRatio limits the max size of block which can be cached(shard capacity/maxBlockRatio).
+	maxBlockRatio = 16
+	// avgBlockSize is the estimated average block size, be used for sizing frequency sketch.
+	avgBlockSize = 4 * 1024
+	// maxBlockCacheShards is the max number of shards of block cache, must be power of 2.
+	maxBlockCacheShards = 16
+	// minShardCapacity is the min capacity of each shard, small cache uses less shards.
+	minShardCapacity = 4 * 1024 * 1024
+	// min/max counters of each row of frequency sketch.
+	minSketchWidth = 1024
+	maxSketchWidth = 1 << 22
+	// sketchDepth is the number of rows of frequency sketch.
+	sketchDepth = 4
+	// maxFrequency is the max frequency of counter.
+	maxFrequency = 15
+)
+
+// blockCache is the shared block cache for all store readers, nil if disabled.
+var blockCache BlockCache
+
+// SetBlockCache sets the shared block cache for all store readers, nil disables block cache.
+func SetBlockCache(cache BlockCache) {
+	blockCache = cache
+}
+
+// BlockKey represents the key of value block in block cache.
+// File number is unique only in store, so the family directory is a part of key.
+type BlockKey struct {
+	Family uint64     // hash of family directory
+	File   FileNumber // file number of sst file
+	Offset int        // offset of value block in file
+}
+
+// fileKey represents the sst file of block.
+type fileKey struct {
+	family uint64
+	file   FileNumber
+}
+
+// Block represents the view of cached block in off-heap memory,
+// off-heap memory is released after block removed from cache and all views released.
+type Block interface {
+	// Bytes returns the block in off-heap memory, which cannot be referenced after view released.
+	Bytes() []byte
+	// Release releases the view of block.
+	Release()
+}
+
+// BlockCache caches the hot value blocks of sst files keyed by (file, offset), shared across stores/families,
+// admits new block based on frequency of access(TinyLFU).
+// Value block is stored uncompressed in sst file, cached block is copied into off-heap memory(anonymous mapping),
+// so that hot blocks are kept even if page cache of sst file is evicted, and gc doesn't scan the cached blocks,
+// cache hit returns the ref-counted view of off-heap memory without allocation.
+type BlockCache interface {
+	// Get returns the view of cached block without copying, records the access frequency of block,
+	// caller must release the view after used.
+	Get(key BlockKey) (Block, bool)
+	// Add tries to add the block into cache, the block is copied into off-heap memory if admitted.
+	Add(key BlockKey, block []byte)
+	// Purge removes all blocks of file from cache, invoked when file reader closed.
+	Purge(family uint64, file FileNumber)
+	// Size returns the total bytes of cached blocks.
+	Size() int64
+}
+
+// shardedBlockCache implements BlockCache interface, splits blocks into shards by hash of key,
+// so that concurrent readers don't contend on one lock.
+type shardedBlockCache struct {
+	shards []*blockCacheShard
+	mask   uint64
+}
+
+// NewBlockCache creates a block cache with capacity(bytes).
+func NewBlockCache(capacity int64) BlockCache {
+	numOfShards := maxBlockCacheShards
+	for numOfShards > 1 && capacity/int64(numOfShards) < minShardCapacity {
+		numOfShards >>= 1
+	}
+	c := &shardedBlockCache{
+		shards: make([]*blockCacheShard, numOfShards),
+		mask:   uint64(numOfShards - 1),
+	}
+	for i := range c.shards {
+		c.shards[i] = newBlockCacheShard(capacity / int64(numOfShards))
+	}
+	return c
+}
+
+// Get returns the view of cached block without copying, records the access frequency of block,
+// caller must release the view after used.
+func (c *shardedBlockCache) Get(key BlockKey) (Block, bool) {
+	h := hashBlockKey(key)
+	return c.shard(h).get(key, h)
+}
+
+// Add tries to add the block into cache, the block is copied into off-heap memory if admitted.
+func (c *shardedBlockCache) Add(key BlockKey, block []byte) {
+	h := hashBlockKey(key)
+	c.shard(h).add(key, h, block)
+}
+
+// Purge removes all blocks of file from cache, invoked when file reader closed.
+func (c *shardedBlockCache) Purge(family uint64, file FileNumber) {
+	for _, s := range c.shards {
+		s.purge(fileKey{family: family, file: file})
+	}
+}
+
+// Size returns the total bytes of cached blocks.
+func (c *shardedBlockCache) Size() (size int64) {
+	for _, s := range c.shards {
+		size += s.getSize()
+	}
+	return size
+}
+
+// shard returns the shard of block based on hash of key.
+func (c *shardedBlockCache) shard(h uint64) *blockCacheShard {
+	return c.shards[(h>>48)&c.mask]
+}
+
+// blockEntry represents the cached block, implements Block interface.
+type blockEntry struct {
+	key   BlockKey
+	data  []byte       // off-heap memory of block, size is aligned with page size
+	block []byte       // block in off-heap memory
+	refs  atomic.Int32 // 1 held by cache, +1 for each view
+}
+
+// newBlockEntry creates a cached block, the reference is held by cache.
+func newBlockEntry(key BlockKey, data, block []byte) *blockEntry {
+	entry := &blockEntry{key: key, data: data, block: block}
+	entry.refs.Store(1)
+	return entry
+}
+
+// Bytes returns the block in off-heap memory, which cannot be referenced after view released.
+func (e *blockEntry) Bytes() []byte {
+	return e.block
+}
+
+// Release releases the reference of block, frees the off-heap memory after the last reference released.
+func (e *blockEntry) Release() {
+	if e.refs.Dec() > 0 {
+		return
+	}
+	if err := freeBlockFn(e.data); err != nil {
+		metrics.BlockCacheStatistics.FreeFailures.Incr()
+	}
+}
+
+// blockCacheShard is a shard of block cache, uses lru for eviction and TinyLFU for admission.
+type blockCacheShard struct {
+	capacity  int64
+	size      int64
+	items     map[BlockKey]*list.Element
+	files     map[fileKey]map[int]struct{}
+	evictList *list.List
+	sketch    *frequencySketch
+
+	mutex sync.Mutex
+}
+
+// newBlockCacheShard creates a shard of block cache with capacity(bytes).
+func newBlockCacheShard(capacity int64) *blockCacheShard {
+	return &blockCacheShard{
+		capacity:  capacity,
+		items:     make(map[BlockKey]*list.Element),
+		files:     make(map[fileKey]map[int]struct{}),
+		evictList: list.New(),
+		sketch:    newFrequencySketch(capacity / avgBlockSize),
+	}
+}
+
+// get returns the view of cached block, records the access frequency of block.
+func (c *blockCacheShard) get(key BlockKey, h uint64) (Block, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.sketch.increment(h)
+	if ent, ok := c.items[key]; ok {
+		c.evictList.MoveToFront(ent)
+		metrics.BlockCacheStatistics.Hit.Incr()
+		// retain block under lock, off-heap memory isn't released after evicted until view released
+		entry := ent.Value.(*blockEntry)
+		entry.refs.Inc()
+		return entry, true
+	}
+	metrics.BlockCacheStatistics.Miss.Incr()
+	return nil, false
+}
+
+// add tries to add the block into cache, the block is copied into off-heap memory if admitted.
+func (c *blockCacheShard) add(key BlockKey, h uint64, block []byte) {
+	if len(block) == 0 {
+		return
+	}
+	// off-heap memory is allocated by page
+	blockSize := int64((len(block) + pageSize - 1) / pageSize * pageSize)
+	if blockSize > c.capacity/maxBlockRatio {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.items[key]; ok {
+		return
+	}
+	if c.size+blockSize > c.capacity {
+		// admit new block only if it is accessed more frequently than the victim
+		victim := c.evictList.Back()
+		if victim != nil && c.sketch.frequency(h) <= c.sketch.frequency(hashBlockKey(victim.Value.(*blockEntry).key)) {
+			metrics.BlockCacheStatistics.Reject.Incr()
+			return
+		}
+		for c.size+blockSize > c.capacity {
+			c.removeElement(c.evictList.Back())
+			metrics.BlockCacheStatistics.Evict.Incr()
+		}
+	}
+	data, err := allocBlockFn(int(blockSize))
+	if err != nil {
+		metrics.BlockCacheStatistics.AllocFailures.Incr()
+		return
+	}
+	n := copy(data, block)
+	c.items[key] = c.evictList.PushFront(newBlockEntry(key, data, data[:n]))
+	fk := fileKey{family: key.Family, file: key.File}
+	blocks, ok := c.files[fk]
+	if !ok {
+		blocks = make(map[int]struct{})
+		c.files[fk] = blocks
+	}
+	blocks[key.Offset] = struct{}{}
+	c.size += blockSize
+	metrics.BlockCacheStatistics.Admit.Incr()
+	metrics.BlockCacheStatistics.Size.Add(float64(blockSize))
+}
+
+// purge removes all blocks of file from shard.
+func (c *blockCacheShard) purge(fk fileKey) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for offset := range c.files[fk] {
+		if ent, ok := c.items[BlockKey{Family: fk.family, File: fk.file, Offset: offset}]; ok {
+			c.removeElement(ent)
+		}
+	}
+}
+
+// getSize returns the total bytes of cached blocks in shard.
+func (c *blockCacheShard) getSize() int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.size
+}
+
+// removeElement removes the entry from shard, releases the reference held by cache.
+func (c *blockCacheShard) removeElement(ent *list.Element) {
+	entry := c.evictList.Remove(ent).(*blockEntry)
+	delete(c.items, entry.key)
+	fk := fileKey{family: entry.key.Family, file: entry.key.File}
+	if blocks, ok := c.files[fk]; ok {
+		delete(blocks, entry.key.Offset)
+		if len(blocks) == 0 {
+			delete(c.files, fk)
+		}
+	}
+	blockSize := int64(len(entry.data))
+	entry.Release()
+	c.size -= blockSize
+	metrics.BlockCacheStatistics.Size.Sub(float64(blockSize))
+}
+
+// frequencySketch is a count-min sketch with 4-bit(max 15) counters, estimates access frequency of blocks,
+// all counters are halved after sampling period, so that the old popular blocks can be evicted.
+type frequencySketch struct {
+	table      [sketchDepth][]uint8
+	mask       uint64
+	additions  int
+	sampleSize int
+}
+
+// newFrequencySketch creates a frequency sketch based on the estimated number of entries.
+func newFrequencySketch(entries int64) *frequencySketch {
+	width := minSketchWidth
+	for int64(width) < entries && width < maxSketchWidth {
+		width <<= 1
+	}
+	s := &frequencySketch{
+		mask:       uint64(width - 1),
+		sampleSize: 10 * width,
+	}
+	for i := range s.table {
+		s.table[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// increment increments the frequency of key hash.
+func (s *frequencySketch) increment(h uint64) {
+	added := false
+	for i := range s.table {
+		idx := s.index(h, i)
+		if s.table[i][idx] < maxFrequency {
+			s.table[i][idx]++
+			added = true
+		}
+	}
+	if added {
+		s.additions++
+		if s.additions >= s.sampleSize {
+			s.reset()
+		}
+	}
+}
+
+// frequency returns the estimated frequency of key hash.
+func (s *frequencySketch) frequency(h uint64) uint8 {
+	freq := uint8(maxFrequency)
+	for i := range s.table {
+		if v := s.table[i][s.index(h, i)]; v < freq {
+			freq = v
+		}
+	}
+	return freq
+}
+
+// reset halves all counters for aging.
+func (s *frequencySketch) reset() {
+	for i := range s.table {
+		for j := range s.table[i] {
+			s.table[i][j] >>= 1
+		}
+	}
+	s.additions /= 2
+}
+
+// index returns the counter index of row.
+func (s *frequencySketch) index(h uint64, row int) uint64 {
+	h += uint64(row) * (h>>32 | 1) * 0x9e3779b97f4a7c15
+	h ^= h >> 29
+	return h & s.mask
+}
+
+// hashBlockKey returns the hash of block key.
+func hashBlockKey(key BlockKey) uint64 {
+	h := key.Family ^ uint64(key.File)*0x9e3779b97f4a7c15 ^ uint64(key.Offset)*0xc2b2ae3d27d4eb4f
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
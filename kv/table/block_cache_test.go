@@ -0,0 +1,184 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestBlockCache_GetAdd(t *testing.T) {
+	cache := NewBlockCache(int64(16 * pageSize))
+	k1 := BlockKey{Family: 1, File: 1, Offset: 1}
+	_, ok := cache.Get(k1)
+	assert.False(t, ok)
+
+	// empty block/too large block
+	cache.Add(k1, nil)
+	cache.Add(k1, make([]byte, pageSize+1))
+	_, ok = cache.Get(k1)
+	assert.False(t, ok)
+
+	block := []byte{1, 2, 3}
+	cache.Add(k1, block)
+	cache.Add(k1, block)
+	v, ok := cache.Get(k1)
+	assert.True(t, ok)
+	assert.Equal(t, block, v.Bytes())
+	v.Release()
+	// copy block into cache
+	block[0] = 10
+	v, _ = cache.Get(k1)
+	assert.Equal(t, []byte{1, 2, 3}, v.Bytes())
+	v.Release()
+	// off-heap memory allocated by page
+	assert.Equal(t, int64(pageSize), cache.Size())
+
+	cache.Purge(1, 1)
+	_, ok = cache.Get(k1)
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), cache.Size())
+	cache.Purge(1, 100)
+}
+
+func TestBlockCache_View(t *testing.T) {
+	defer func() {
+		freeBlockFn = fileutil.UnmapAnonymous
+	}()
+	freed := 0
+	freeBlockFn = func(data []byte) error {
+		freed++
+		return fileutil.UnmapAnonymous(data)
+	}
+	cache := NewBlockCache(int64(16 * pageSize))
+	k1 := BlockKey{Family: 1, File: 1, Offset: 1}
+	cache.Add(k1, []byte{1, 2, 3})
+	v1, ok := cache.Get(k1)
+	assert.True(t, ok)
+	v2, ok := cache.Get(k1)
+	assert.True(t, ok)
+	// view references off-heap memory of cache
+	assert.Equal(t, &v1.Bytes()[0], &v2.Bytes()[0])
+	// off-heap memory is kept after block removed until all views released
+	cache.Purge(1, 1)
+	assert.Equal(t, int64(0), cache.Size())
+	assert.Equal(t, []byte{1, 2, 3}, v1.Bytes())
+	v1.Release()
+	assert.Equal(t, 0, freed)
+	assert.Equal(t, []byte{1, 2, 3}, v2.Bytes())
+	v2.Release()
+	assert.Equal(t, 1, freed)
+}
+
+func TestBlockCache_OffHeap(t *testing.T) {
+	defer func() {
+		allocBlockFn = fileutil.MapAnonymous
+		freeBlockFn = fileutil.UnmapAnonymous
+	}()
+	cache := NewBlockCache(int64(16 * pageSize))
+	k1 := BlockKey{Family: 1, File: 1, Offset: 1}
+	// alloc off-heap memory failure
+	allocBlockFn = func(_ int) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	cache.Add(k1, []byte{1, 2, 3})
+	_, ok := cache.Get(k1)
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), cache.Size())
+	// free off-heap memory failure
+	allocBlockFn = fileutil.MapAnonymous
+	freed := 0
+	freeBlockFn = func(data []byte) error {
+		freed++
+		assert.Len(t, data, pageSize)
+		_ = fileutil.UnmapAnonymous(data)
+		return fmt.Errorf("err")
+	}
+	cache.Add(k1, []byte{1, 2, 3})
+	cache.Purge(1, 1)
+	assert.Equal(t, 1, freed)
+	assert.Equal(t, int64(0), cache.Size())
+}
+
+func TestBlockCache_Admission(t *testing.T) {
+	cache := NewBlockCache(int64(16 * pageSize))
+	// fill the cache
+	for i := 0; i < 16; i++ {
+		key := BlockKey{Family: 1, File: 1, Offset: i}
+		cache.Get(key)
+		cache.Get(key)
+		cache.Add(key, make([]byte, 100))
+	}
+	assert.Equal(t, int64(16*pageSize), cache.Size())
+	// cold block rejected
+	cold := BlockKey{Family: 1, File: 2, Offset: 1}
+	cache.Get(cold)
+	cache.Add(cold, make([]byte, 100))
+	_, ok := cache.Get(cold)
+	assert.False(t, ok)
+	// hot block admitted, evict lru block
+	hot := BlockKey{Family: 1, File: 2, Offset: 2}
+	for i := 0; i < 5; i++ {
+		cache.Get(hot)
+	}
+	cache.Add(hot, make([]byte, 100))
+	_, ok = cache.Get(hot)
+	assert.True(t, ok)
+	_, ok = cache.Get(BlockKey{Family: 1, File: 1, Offset: 0})
+	assert.False(t, ok)
+	assert.Equal(t, int64(16*pageSize), cache.Size())
+}
+
+func TestBlockCache_Shards(t *testing.T) {
+	assert.Len(t, NewBlockCache(1024).(*shardedBlockCache).shards, 1)
+	cache := NewBlockCache(maxBlockCacheShards * minShardCapacity)
+	assert.Len(t, cache.(*shardedBlockCache).shards, maxBlockCacheShards)
+	for i := 0; i < 100; i++ {
+		cache.Add(BlockKey{Family: 1, File: 1, Offset: i * 10}, make([]byte, 10))
+		cache.Add(BlockKey{Family: 2, File: 1, Offset: i * 10}, make([]byte, 10))
+	}
+	assert.Equal(t, int64(200*pageSize), cache.Size())
+	// same file number in other family
+	cache.Purge(1, 1)
+	assert.Equal(t, int64(100*pageSize), cache.Size())
+	_, ok := cache.Get(BlockKey{Family: 2, File: 1, Offset: 10})
+	assert.True(t, ok)
+}
+
+func TestFrequencySketch(t *testing.T) {
+	s := newFrequencySketch(0)
+	key := hashBlockKey(BlockKey{Family: 1, File: 1, Offset: 1})
+	for i := 0; i < 20; i++ {
+		s.increment(key)
+	}
+	assert.Equal(t, uint8(maxFrequency), s.frequency(key))
+	s.reset()
+	assert.Equal(t, uint8(maxFrequency/2), s.frequency(key))
+	// reset after sample period
+	s = newFrequencySketch(0)
+	for i := 0; i < s.sampleSize; i++ {
+		s.increment(hashBlockKey(BlockKey{Family: 1, File: FileNumber(i), Offset: i}))
+	}
+	assert.True(t, s.additions < s.sampleSize)
+	assert.Len(t, s.table[0], minSketchWidth)
+	assert.Len(t, newFrequencySketch(1 << 30).table[0], maxSketchWidth)
+}
@@ -18,17 +18,19 @@
 package table
 
 import (
-	"encoding/binary"
 	"fmt"
 	"hash"
 	"hash/crc32"
 	"io"
+	"time"
 
 	"github.com/lindb/roaring"
+	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/bufioutil"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 )
 
@@ -39,6 +41,19 @@ var (
 	newBufioWriterFunc = bufioutil.NewBufioStreamWriter
 )
 
+// sync policy/interval for the store file built after, file is synced before closing by default.
+var (
+	syncPolicy   = atomic.NewString(string(fileutil.SyncPerRollover))
+	syncInterval = atomic.NewDuration(0)
+)
+
+// SetSyncPolicy sets the policy of syncing store file when writing key/value pairs for the store file built after,
+// interval is used for per-interval policy, store file is always synced before closing(committing into version).
+func SetSyncPolicy(policy fileutil.SyncPolicy, interval time.Duration) {
+	syncPolicy.Store(string(policy))
+	syncInterval.Store(interval)
+}
+
 // FileNumber represents sst file number
 type FileNumber int64
 
@@ -103,6 +118,10 @@ type storeBuilder struct {
 	minKey uint32
 	maxKey uint32
 
+	version byte // layout version of store file
+
+	syncer *fileutil.Syncer // decides if syncing file after writing key/value pair
+
 	first bool
 }
 
@@ -119,6 +138,8 @@ func NewStoreBuilder(fileNumber FileNumber, fileName string) (Builder, error) {
 		writer:     writer,
 		first:      true,
 		offset:     encoding.NewFixedOffsetEncoder(true),
+		version:    FormatVersion(),
+		syncer:     fileutil.NewSyncer(fileutil.SyncPolicy(syncPolicy.Load()), syncInterval.Load()),
 	}, nil
 }
 
@@ -154,6 +175,17 @@ func (b *storeBuilder) afterWrite(key uint32, offset int) {
 	b.first = false
 }
 
+// syncIfNeeded syncs the written key/value pairs based on sync policy.
+func (b *storeBuilder) syncIfNeeded() error {
+	if !b.syncer.NeedSync() {
+		return nil
+	}
+	if err := b.writer.Sync(); err != nil {
+		return fmt.Errorf("sync store file error:%s", err)
+	}
+	return nil
+}
+
 // Add adds key/value pair into store file, if write failure return error
 func (b *storeBuilder) Add(key uint32, value []byte) error {
 	if !b.ensureIncreasingKey(key) {
@@ -168,7 +200,7 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 	metrics.TableWriteStatistics.AddKeys.Incr()
 	metrics.TableWriteStatistics.WriteBytes.Add(float64(len(value)))
 	b.afterWrite(key, int(offset))
-	return nil
+	return b.syncIfNeeded()
 }
 
 // MinKey returns min key in store
@@ -222,16 +254,13 @@ func (b *storeBuilder) Close() (err error) {
 		return err
 	}
 
-	// for file footer for offsets/keys index, length=1+4+4+8
-	var buf [17]byte
-	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
-	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
-	buf[8] = version0
-	binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
-	if _, err = b.writer.Write(buf[:]); err != nil {
+	// for file footer for offsets/keys index
+	footer := footerEncoders[b.version](int(posOfOffset), int(posOfKeys), indexChecksum(offset, keys))
+	if _, err = b.writer.Write(footer); err != nil {
 		return err
 	}
-	return nil
+	// sync file before committing into version, avoid version referring to incomplete file after crash
+	return b.writer.Sync()
 }
 
 func (b *storeBuilder) StreamWriter() StreamWriter {
@@ -291,5 +320,5 @@ func (sw *streamWriter) Commit() error {
 	sw.builder.afterWrite(sw.key, int(sw.offset))
 	// preventing committing twice
 	sw.badKey = true
-	return nil
+	return sw.builder.syncIfNeeded()
 }
@@ -24,6 +24,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -135,10 +136,17 @@ func TestStoreBuilder_Build_Err(t *testing.T) {
 	// case 7: write close err
 	writer.EXPECT().Write(gomock.Any()).Return(10, nil).MaxTimes(2) // write offset/keys
 	writer.EXPECT().Write(gomock.Any()).Return(0, nil)              // write footer
+	writer.EXPECT().Sync().Return(nil)
 	writer.EXPECT().Close().Return(fmt.Errorf("err"))
 	err = builder.Close()
 	assert.Error(t, err)
-	// case 8: new builder err
+	// case 8: sync file err before closing
+	writer.EXPECT().Write(gomock.Any()).Return(10, nil).Times(3) // write offset/keys/footer
+	writer.EXPECT().Sync().Return(fmt.Errorf("err"))
+	writer.EXPECT().Close().Return(nil)
+	err = builder.Close()
+	assert.Error(t, err)
+	// case 9: new builder err
 	newBufioWriterFunc = func(fileName string) (bufioutil.BufioWriter, error) {
 		return nil, fmt.Errorf("err")
 	}
@@ -147,6 +155,60 @@ func TestStoreBuilder_Build_Err(t *testing.T) {
 	assert.Nil(t, builder)
 }
 
+func TestStoreBuilder_SyncPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newBufioWriterFunc = bufioutil.NewBufioStreamWriter
+		SetSyncPolicy(fileutil.SyncPerRollover, 0)
+		ctrl.Finish()
+	}()
+	writer := bufioutil.NewMockBufioWriter(ctrl)
+	newBufioWriterFunc = func(fileName string) (bufioutil.BufioWriter, error) {
+		return writer, nil
+	}
+	writer.EXPECT().Size().Return(int64(10)).AnyTimes()
+	writer.EXPECT().Write(gomock.Any()).Return(10, nil).AnyTimes()
+
+	// per rollover: no sync when writing
+	builder, err := NewStoreBuilder(10, "000010.sst")
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte{1, 2, 3}))
+	// per write: sync after each key/value pair written
+	SetSyncPolicy(fileutil.SyncPerWrite, 0)
+	builder, err = NewStoreBuilder(10, "000010.sst")
+	assert.NoError(t, err)
+	writer.EXPECT().Sync().Return(nil)
+	assert.NoError(t, builder.Add(1, []byte{1, 2, 3}))
+	writer.EXPECT().Sync().Return(fmt.Errorf("err"))
+	assert.Error(t, builder.Add(2, []byte{1, 2, 3}))
+	sw := builder.StreamWriter()
+	sw.Prepare(3)
+	_, _ = sw.Write([]byte{1, 2, 3})
+	writer.EXPECT().Sync().Return(nil)
+	assert.NoError(t, sw.Commit())
+	// per interval: sync at most once per interval
+	SetSyncPolicy(fileutil.SyncPerInterval, time.Hour)
+	builder, err = NewStoreBuilder(10, "000010.sst")
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte{1, 2, 3}))
+	assert.NoError(t, builder.Add(2, []byte{1, 2, 3}))
+}
+
+func TestStoreBuilder_SyncPerWrite_Crash(t *testing.T) {
+	defer SetSyncPolicy(fileutil.SyncPerRollover, 0)
+	SetSyncPolicy(fileutil.SyncPerWrite, 0)
+
+	fileName := filepath.Join(t.TempDir(), "000010.sst")
+	builder, err := NewStoreBuilder(10, fileName)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte("test")))
+	// written key/value pair persisted before closing(crash before committing into version)
+	data, err := os.ReadFile(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), data)
+	assert.NoError(t, builder.Abandon())
+}
+
 func TestStoreBuilder_Abandon(t *testing.T) {
 	_ = fileutil.MkDirIfNotExist(testKVPath)
 	defer func() {
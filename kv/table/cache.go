@@ -26,6 +26,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -53,20 +54,22 @@ type Cache interface {
 
 // Cache caches table readers based on lru cache.
 type storeCache struct {
-	ttl       time.Duration
-	storePath string
-	families  map[string]map[string]struct{} // family name => files
-	cache     *LRUCache
-	mutex     sync.Mutex
+	ttl        time.Duration
+	readAdvice fileutil.Advice // access pattern advice of new reader for query
+	storePath  string
+	families   map[string]map[string]struct{} // family name => files
+	cache      *LRUCache
+	mutex      sync.Mutex
 }
 
-// NewCache creates cache for store readers.
-func NewCache(storePath string, ttl time.Duration) Cache {
+// NewCache creates cache for store readers, readAdvice applies on new reader's mapped file content.
+func NewCache(storePath string, ttl time.Duration, readAdvice fileutil.Advice) Cache {
 	return &storeCache{
-		ttl:       ttl,
-		storePath: storePath,
-		families:  make(map[string]map[string]struct{}),
-		cache:     NewLRUCache(),
+		ttl:        ttl,
+		readAdvice: readAdvice,
+		storePath:  storePath,
+		families:   make(map[string]map[string]struct{}),
+		cache:      NewLRUCache(),
 	}
 }
 
@@ -113,6 +116,9 @@ func (c *storeCache) GetReader(family, fileName string) (Reader, error) {
 	if err != nil {
 		return nil, err
 	}
+	if c.readAdvice != fileutil.AdviceNormal {
+		newReader.Advise(c.readAdvice)
+	}
 	entry := &cacheEntry{
 		key:      fileName,
 		reader:   newReader,
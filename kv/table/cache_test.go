@@ -24,6 +24,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
 )
 
 func TestMapCache_GetReader(t *testing.T) {
@@ -32,7 +34,7 @@ func TestMapCache_GetReader(t *testing.T) {
 		newMMapStoreReaderFunc = newMMapStoreReader
 		ctrl.Finish()
 	}()
-	cache := NewCache(t.TempDir(), time.Hour)
+	cache := NewCache(t.TempDir(), time.Hour, fileutil.AdviceNormal)
 	// case 1: get reader err
 	newMMapStoreReaderFunc = func(path, fileName string) (r Reader, err error) {
 		return nil, fmt.Errorf("err")
@@ -75,6 +77,25 @@ func TestMapCache_GetReader(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStoreCache_ReadAdvice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newMMapStoreReaderFunc = newMMapStoreReader
+		ctrl.Finish()
+	}()
+	cache := NewCache(t.TempDir(), time.Hour, fileutil.AdviceRandom)
+	mockReader := NewMockReader(ctrl)
+	newMMapStoreReaderFunc = func(path, fileName string) (reader Reader, err error) {
+		return mockReader, nil
+	}
+	mockReader.EXPECT().Advise(fileutil.AdviceRandom)
+	r, err := cache.GetReader("f", "100000.sst")
+	assert.NoError(t, err)
+	assert.Equal(t, mockReader, r)
+	mockReader.EXPECT().Close().Return(nil)
+	assert.NoError(t, cache.Close())
+}
+
 func TestStoreCache_Cleanup(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -82,7 +103,7 @@ func TestStoreCache_Cleanup(t *testing.T) {
 		ctrl.Finish()
 	}()
 
-	cache := NewCache(t.TempDir(), time.Millisecond)
+	cache := NewCache(t.TempDir(), time.Millisecond, fileutil.AdviceNormal)
 	mockReader := NewMockReader(ctrl)
 	newMMapStoreReaderFunc = func(path, fileName string) (reader Reader, err error) {
 		return mockReader, nil
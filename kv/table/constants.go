@@ -25,19 +25,34 @@ import (
 
 var (
 	ErrEmptyKeys = errors.New("empty keys under store builder")
+	// ErrUnsupportedFormatVersion represents the layout version of sst file cannot be read/written by current node.
+	ErrUnsupportedFormatVersion = errors.New("unsupported sst file format version")
 )
 
 const (
 	// magic-number in the footer of sst file
 	magicNumberOffsetFile uint64 = 0x69632d656d656c65
-	// current file layout version
-	version0 = 0
 
+	// FormatVersion0 represents the initial file layout.
+	FormatVersion0 byte = 0
+	// FormatVersion1 adds crc32 checksum of index block(offsets+keys) into footer.
+	FormatVersion1 byte = 1
+	// DefaultFormatVersion is the default layout version for writing, which can be read by all nodes.
+	DefaultFormatVersion = FormatVersion0
+	// LatestFormatVersion is the latest layout version which current node supports.
+	LatestFormatVersion = FormatVersion1
+
+	// version(1)+magicNumber(8) are always at the end of file for all layout versions,
+	// so that reader can find the footer decoder by version.
+	footerTailSize = 1 + // version(1)
+		8 // magicNumber(8)
 	sstFileFooterSize = 4 + // posOfOffset(4)
 		4 + // posOfKeys(4)
-		1 + // version(1)
-		8 // magicNumber(8)
-	magicNumberAtFooter = 9
+		footerTailSize
+	sstFileFooterSizeV1 = 4 + // posOfOffset(4)
+		4 + // posOfKeys(4)
+		4 + // crc32 checksum of index block(4)
+		footerTailSize
 )
 
 var tableLogger = logger.GetLogger("KV", "Table")
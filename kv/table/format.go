@@ -0,0 +1,129 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"go.uber.org/atomic"
+)
+
+// formatVersion is the layout version for writing new sst file.
+var formatVersion = atomic.NewUint32(uint32(DefaultFormatVersion))
+
+// footer represents the decoded footer of sst file.
+type footer struct {
+	posOfOffset int
+	posOfKeys   int
+	footerStart int
+
+	indexChecksum    uint32
+	hasIndexChecksum bool
+}
+
+// footerEncoder encodes the footer of sst file.
+type footerEncoder func(posOfOffset, posOfKeys int, indexChecksum uint32) []byte
+
+// footerDecoder decodes the footer of sst file.
+type footerDecoder func(data []byte) (footer, error)
+
+// footerEncoders/footerDecoders are keyed by layout version, new layout version must register both of them,
+// reader supports all registered versions, so that files written by other nodes(replica repair) or
+// old versions can be read during rolling upgrade.
+var (
+	footerEncoders = map[byte]footerEncoder{
+		FormatVersion0: encodeFooterV0,
+		FormatVersion1: encodeFooterV1,
+	}
+	footerDecoders = map[byte]footerDecoder{
+		FormatVersion0: decodeFooterV0,
+		FormatVersion1: decodeFooterV1,
+	}
+)
+
+// SetFormatVersion sets the layout version for writing new sst file, returns err if version not supported.
+// NOTICE: upgrade the layout version only after all nodes support it, because the sst file maybe
+// fetched by other replica.
+func SetFormatVersion(version byte) error {
+	if _, ok := footerEncoders[version]; !ok {
+		return fmt.Errorf("%w: %d, latest version: %d", ErrUnsupportedFormatVersion, version, LatestFormatVersion)
+	}
+	formatVersion.Store(uint32(version))
+	return nil
+}
+
+// FormatVersion returns the layout version for writing new sst file.
+func FormatVersion() byte {
+	return byte(formatVersion.Load())
+}
+
+// encodeFooterV0 encodes footer, layout: posOfOffset(4)+posOfKeys(4)+version(1)+magicNumber(8).
+func encodeFooterV0(posOfOffset, posOfKeys int, _ uint32) []byte {
+	var buf [sstFileFooterSize]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
+	buf[8] = FormatVersion0
+	binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
+	return buf[:]
+}
+
+// decodeFooterV0 decodes footer of version 0.
+func decodeFooterV0(data []byte) (footer, error) {
+	if len(data) < sstFileFooterSize {
+		return footer{}, fmt.Errorf("length of footer(version:%d) is too short", FormatVersion0)
+	}
+	footerStart := len(data) - sstFileFooterSize
+	return footer{
+		posOfOffset: int(binary.LittleEndian.Uint32(data[footerStart : footerStart+4])),
+		posOfKeys:   int(binary.LittleEndian.Uint32(data[footerStart+4 : footerStart+8])),
+		footerStart: footerStart,
+	}, nil
+}
+
+// encodeFooterV1 encodes footer, layout: posOfOffset(4)+posOfKeys(4)+indexChecksum(4)+version(1)+magicNumber(8).
+func encodeFooterV1(posOfOffset, posOfKeys int, indexChecksum uint32) []byte {
+	var buf [sstFileFooterSizeV1]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
+	binary.LittleEndian.PutUint32(buf[8:12], indexChecksum)
+	buf[12] = FormatVersion1
+	binary.LittleEndian.PutUint64(buf[13:], magicNumberOffsetFile)
+	return buf[:]
+}
+
+// decodeFooterV1 decodes footer of version 1.
+func decodeFooterV1(data []byte) (footer, error) {
+	if len(data) < sstFileFooterSizeV1 {
+		return footer{}, fmt.Errorf("length of footer(version:%d) is too short", FormatVersion1)
+	}
+	footerStart := len(data) - sstFileFooterSizeV1
+	return footer{
+		posOfOffset:      int(binary.LittleEndian.Uint32(data[footerStart : footerStart+4])),
+		posOfKeys:        int(binary.LittleEndian.Uint32(data[footerStart+4 : footerStart+8])),
+		indexChecksum:    binary.LittleEndian.Uint32(data[footerStart+8 : footerStart+12]),
+		hasIndexChecksum: true,
+		footerStart:      footerStart,
+	}, nil
+}
+
+// indexChecksum returns the crc32 checksum of index block(offsets+keys).
+func indexChecksum(offsets, keys []byte) uint32 {
+	return crc32.Update(crc32.ChecksumIEEE(offsets), crc32.IEEETable, keys)
+}
@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestSetFormatVersion(t *testing.T) {
+	defer func() {
+		_ = SetFormatVersion(DefaultFormatVersion)
+	}()
+	assert.Equal(t, DefaultFormatVersion, FormatVersion())
+	assert.NoError(t, SetFormatVersion(LatestFormatVersion))
+	assert.Equal(t, LatestFormatVersion, FormatVersion())
+	err := SetFormatVersion(LatestFormatVersion + 1)
+	assert.ErrorIs(t, err, ErrUnsupportedFormatVersion)
+	assert.Equal(t, LatestFormatVersion, FormatVersion())
+}
+
+func TestStoreBuilder_FormatVersion(t *testing.T) {
+	defer func() {
+		_ = SetFormatVersion(DefaultFormatVersion)
+		_ = os.RemoveAll(testKVPath)
+	}()
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	build := func(version byte) string {
+		assert.NoError(t, SetFormatVersion(version))
+		path := filepath.Join(testKVPath, "000010.sst")
+		builder, err := NewStoreBuilder(10, path)
+		assert.NoError(t, err)
+		assert.NoError(t, builder.Add(1, []byte("test")))
+		assert.NoError(t, builder.Add(10, []byte("test10")))
+		assert.NoError(t, builder.Close())
+		return path
+	}
+	for _, version := range []byte{FormatVersion0, FormatVersion1} {
+		path := build(version)
+		r, err := newMMapStoreReader(path, "000010.sst")
+		assert.NoError(t, err)
+		assert.Equal(t, version, r.Version())
+		value, err := r.Get(10)
+		assert.NoError(t, err)
+		assert.Equal(t, "test10", string(value))
+		assert.NoError(t, r.Close())
+	}
+
+	path := build(FormatVersion1)
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	// case 1: index block corrupt
+	corrupt := append([]byte{}, data...)
+	corrupt[len(corrupt)-sstFileFooterSizeV1-1]++
+	assert.NoError(t, os.WriteFile(path, corrupt, 0644))
+	r, err := newMMapStoreReader(path, "000010.sst")
+	assert.Error(t, err)
+	assert.Nil(t, r)
+	// case 2: unsupported version
+	corrupt = append([]byte{}, data...)
+	corrupt[len(corrupt)-footerTailSize] = LatestFormatVersion + 1
+	assert.NoError(t, os.WriteFile(path, corrupt, 0644))
+	r, err = newMMapStoreReader(path, "000010.sst")
+	assert.ErrorIs(t, err, ErrUnsupportedFormatVersion)
+	assert.Nil(t, r)
+	// case 3: footer too short
+	assert.NoError(t, os.WriteFile(path, data[len(data)-sstFileFooterSize:], 0644))
+	r, err = newMMapStoreReader(path, "000010.sst")
+	assert.Error(t, err)
+	assert.Nil(t, r)
+}
@@ -22,8 +22,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/metrics"
@@ -37,25 +43,66 @@ import (
 // for testing
 var (
 	ErrKeyNotExist           = errors.New("key not exist in kv table")
+	ErrCorruptFile           = errors.New("corrupt kv table file")
 	openFileFn               = os.Open
 	mapFunc                  = fileutil.Map
 	unmapFunc                = fileutil.Unmap
 	unmarshalFixedOffsetFunc = unmarshalFixedOffset
 	uint64Func               = binary.LittleEndian.Uint64
 	intsAreSortedFunc        = sort.IntsAreSorted
+	batchReadFunc            = fileutil.BatchRead
+	madviseFunc              = fileutil.Madvise
+	fadviseFunc              = fileutil.FadviseRange
 )
 
+const (
+	// minPrefetchBlocks is the min number of blocks need to prefetch, few blocks read by page fault directly.
+	minPrefetchBlocks = 8
+	// maxPrefetchBytes is the max bytes of blocks for one prefetch.
+	maxPrefetchBytes = 16 * 1024 * 1024
+	// prefetchBufferSize is the size of buffer for batch read, blocks are read into buffer chunk by chunk,
+	// larger block isn't prefetched, which is loaded by read ahead of page fault.
+	prefetchBufferSize = 256 * 1024
+	// compactionAdviseSize is the size of file range which compaction advice applied on ahead of reading,
+	// the consumed range is released chunk by chunk with the same size.
+	compactionAdviseSize = 4 * 1024 * 1024
+)
+
+// prefetchBufferPool pools the buffers for batch read, the content of buffer is discarded.
+var prefetchBufferPool = sync.Pool{New: func() interface{} {
+	buf := make([]byte, prefetchBufferSize)
+	return &buf
+}}
+
 // Reader represents reader which reads k/v pair from store file.
 type Reader interface {
 	// Path returns the file path.
 	Path() string
 	// FileName returns the file name of reader.
 	FileName() string
+	// Version returns the layout version of file.
+	Version() byte
 	// Get returns value for giving key,
 	// if key not exist, return nil, ErrKeyNotExist.
 	Get(key uint32) ([]byte, error)
+	// GetCached returns value for giving key like Get, reads value from block cache if enabled,
+	// cached is the view of cached block which value references, must be released by caller after value used,
+	// cached is nil if value isn't read from block cache.
+	GetCached(key uint32) (value []byte, cached Block, err error)
 	// Iterator iterates over a store's key/value pairs in key order.
 	Iterator() Iterator
+	// IteratorFrom iterates over a store's key/value pairs which key >= start in key order.
+	IteratorFrom(start uint32) Iterator
+	// CompactionIterator iterates over a store's key/value pairs which key >= start in key order for compaction,
+	// applies access pattern advice on the file range ahead of reading, releases the pages of consumed range.
+	CompactionIterator(start uint32, advice fileutil.Advice) Iterator
+	// Prefetch loads the blocks(sub slice of value returned by Get) from file by batch read,
+	// makes page cache warm before accessing the blocks, avoids page faults one by one.
+	Prefetch(blocks [][]byte)
+	// Advise applies access pattern advice on mapped file content for query reading.
+	Advise(advice fileutil.Advice)
+	// Verify verifies the value of key by checker, each key is verified only once in reader's lifecycle.
+	Verify(key uint32, value []byte, checker ValueChecker) error
 	// Close closes reader, release related resources.
 	Close() error
 }
@@ -69,6 +116,13 @@ type storeMMapReader struct {
 	entriesBlock []byte                       // mmaped file content without footer
 	keys         *roaring.Bitmap              // bitmap of keys
 	offsets      *encoding.FixedOffsetDecoder // offset of values
+	blockFamily  uint64                       // hash of family directory, family of block key
+	fileNumber   FileNumber                   // file number of sst-file, file of block key
+	blockCache   BlockCache                   // shared block cache, nil if disabled
+	version      byte                         // layout version of sst-file
+
+	verifiedKeys *roaring.Bitmap // keys which value verified
+	verifyLock   sync.Mutex
 }
 
 // newMMapStoreReader creates mmap store file reader.
@@ -99,19 +153,22 @@ func newMMapStoreReader(path, fileName string) (r Reader, err error) {
 	}
 	metrics.TableReadStatistics.MMaps.Incr()
 
-	if len(data) < sstFileFooterSize {
+	if len(data) < footerTailSize {
 		err = fmt.Errorf("length of sstfile:%s length is too short", path)
 		return
 	}
 	reader := &storeMMapReader{
-		path:      path,
-		fileName:  fileName,
-		f:         f,
-		fullBlock: data,
-		keys:      roaring.New(),
+		path:         path,
+		fileName:     fileName,
+		f:            f,
+		fullBlock:    data,
+		keys:         roaring.New(),
+		blockCache:   blockCache,
+		verifiedKeys: roaring.New(),
 	}
 
-	if err := reader.initialize(); err != nil {
+	reader.blockFamily, reader.fileNumber = blockFileOf(path, fileName)
+	if err = reader.initialize(); err != nil {
 		return nil, err
 	}
 
@@ -120,27 +177,43 @@ func newMMapStoreReader(path, fileName string) (r Reader, err error) {
 
 // initialize store reader, reads index block(keys,offset etc.), then caches it.
 func (r *storeMMapReader) initialize() error {
-	// decode footer
-	footerStart := len(r.fullBlock) - sstFileFooterSize
+	// version and magic-number are at the end of file for all layout versions
+	tailStart := len(r.fullBlock) - footerTailSize
 	// validate magic-number
-	if uint64Func(r.fullBlock[footerStart+magicNumberAtFooter:]) != magicNumberOffsetFile {
+	if uint64Func(r.fullBlock[tailStart+1:]) != magicNumberOffsetFile {
 		return fmt.Errorf("verify magic-number of sstfile:%s failure", r.path)
 	}
-	posOfOffset := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart : footerStart+4]))
-	posOfKeys := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart+4 : footerStart+8]))
+	r.version = r.fullBlock[tailStart]
+	decoder, ok := footerDecoders[r.version]
+	if !ok {
+		return fmt.Errorf("%w: %d, sstfile:%s", ErrUnsupportedFormatVersion, r.version, r.path)
+	}
+	// decode footer
+	ft, err := decoder(r.fullBlock)
+	if err != nil {
+		return fmt.Errorf("decode footer of sstfile:%s error:%s", r.path, err)
+	}
+	posOfOffset, posOfKeys, footerStart := ft.posOfOffset, ft.posOfKeys, ft.footerStart
 	if !intsAreSortedFunc([]int{
 		0, posOfOffset, posOfKeys, footerStart}) {
 		return fmt.Errorf("bad footer data, posOfOffsets: %d posOfKeys: %d,"+
 			" footerStart: %d", posOfOffset, posOfKeys, footerStart)
 	}
+	if ft.hasIndexChecksum {
+		actual := indexChecksum(r.fullBlock[posOfOffset:posOfKeys], r.fullBlock[posOfKeys:footerStart])
+		if actual != ft.indexChecksum {
+			return fmt.Errorf("verify index checksum of sstfile:%s failure, expect:%d, actual:%d",
+				r.path, ft.indexChecksum, actual)
+		}
+	}
 	// decode offsets
 	offsetsBlock := r.fullBlock[posOfOffset:posOfKeys]
 	r.offsets = encoding.NewFixedOffsetDecoder()
-	if err := unmarshalFixedOffsetFunc(r.offsets, offsetsBlock); err != nil {
+	if err = unmarshalFixedOffsetFunc(r.offsets, offsetsBlock); err != nil {
 		return fmt.Errorf("unmarshal fixed-offsets decoder with error: %s", err)
 	}
 	// decode keys
-	if err := encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:]); err != nil {
+	if err = encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:footerStart]); err != nil {
 		return fmt.Errorf("unmarshal keys data from file[%s] error:%s", r.path, err)
 	}
 	// validate keys and offsets
@@ -152,6 +225,16 @@ func (r *storeMMapReader) initialize() error {
 	return nil
 }
 
+// blockFileOf returns the family/file number of block key based on file path,
+// uses hash of path as family if file name isn't a sst-file name.
+func blockFileOf(path, fileName string) (family uint64, fileNumber FileNumber) {
+	n, err := strconv.ParseInt(strings.TrimSuffix(fileName, ".sst"), 10, 64)
+	if err != nil {
+		return xxhash.Sum64String(path), 0
+	}
+	return xxhash.Sum64String(filepath.Dir(path)), FileNumber(n)
+}
+
 func unmarshalFixedOffset(decoder *encoding.FixedOffsetDecoder, data []byte) error {
 	_, err := decoder.Unmarshal(data)
 	return err
@@ -167,14 +250,43 @@ func (r *storeMMapReader) FileName() string {
 	return r.fileName
 }
 
+// Version returns the layout version of file.
+func (r *storeMMapReader) Version() byte {
+	return r.version
+}
+
 // Get return value for key, if not exist return nil, false.
 func (r *storeMMapReader) Get(key uint32) ([]byte, error) {
 	if !r.keys.Contains(key) {
 		return nil, ErrKeyNotExist
 	}
 	// bitmap data's index from 1, so idx= get index - 1
-	idx := r.keys.Rank(key)
-	return r.getBlock(int(idx) - 1)
+	idx := int(r.keys.Rank(key)) - 1
+	return r.getBlock(idx)
+}
+
+// GetCached returns value for giving key like Get, reads value from block cache if enabled,
+// cached is the view of cached block which value references, must be released by caller after value used.
+func (r *storeMMapReader) GetCached(key uint32) (value []byte, cached Block, err error) {
+	if !r.keys.Contains(key) {
+		return nil, nil, ErrKeyNotExist
+	}
+	// bitmap data's index from 1, so idx= get index - 1
+	idx := int(r.keys.Rank(key)) - 1
+	if r.blockCache == nil {
+		value, err = r.getBlock(idx)
+		return value, nil, err
+	}
+	offset, _ := r.offsets.Get(idx)
+	blockKey := BlockKey{Family: r.blockFamily, File: r.fileNumber, Offset: offset}
+	if cached, ok := r.blockCache.Get(blockKey); ok {
+		return cached.Bytes(), cached, nil
+	}
+	value, err = r.getBlock(idx)
+	if err == nil {
+		r.blockCache.Add(blockKey, value)
+	}
+	return value, nil, err
 }
 
 func (r *storeMMapReader) getBlock(idx int) ([]byte, error) {
@@ -190,7 +302,103 @@ func (r *storeMMapReader) getBlock(idx int) ([]byte, error) {
 
 // Iterator iterates over a store's key/value pairs in key order.
 func (r *storeMMapReader) Iterator() Iterator {
-	return newMMapIterator(r)
+	return newMMapIterator(r, 0)
+}
+
+// IteratorFrom iterates over a store's key/value pairs which key >= start in key order.
+func (r *storeMMapReader) IteratorFrom(start uint32) Iterator {
+	return newMMapIterator(r, start)
+}
+
+// CompactionIterator iterates over a store's key/value pairs which key >= start in key order for compaction,
+// applies access pattern advice on the file range ahead of reading, releases the pages of consumed range.
+func (r *storeMMapReader) CompactionIterator(start uint32, advice fileutil.Advice) Iterator {
+	it := newMMapIterator(r, start)
+	it.compaction = true
+	it.advice = advice
+	if offset, ok := r.offsets.Get(it.idx); ok && it.idx > 0 {
+		// page shared with previous range maybe not consumed, release from next page
+		it.released = pageAlignUp(offset)
+	}
+	it.advised = it.released
+	it.adviseAhead()
+	return it
+}
+
+// Prefetch loads the blocks(sub slice of value returned by Get) from file by batch read,
+// makes page cache warm before accessing the blocks, avoids page faults one by one.
+func (r *storeMMapReader) Prefetch(blocks [][]byte) {
+	if len(blocks) < minPrefetchBlocks || len(r.fullBlock) == 0 {
+		return
+	}
+	base := uintptr(unsafe.Pointer(&r.fullBlock[0]))
+	size := uintptr(len(r.fullBlock))
+	ranges := make([]fileutil.ReadRange, 0, len(blocks))
+	total := 0
+	for _, block := range blocks {
+		if len(block) == 0 || len(block) > prefetchBufferSize {
+			continue
+		}
+		ptr := uintptr(unsafe.Pointer(&block[0]))
+		if ptr < base || ptr+uintptr(len(block)) > base+size {
+			// block not belong to current file
+			continue
+		}
+		ranges = append(ranges, fileutil.ReadRange{Offset: int64(ptr - base), Length: len(block)})
+		total += len(block)
+	}
+	if len(ranges) < minPrefetchBlocks || total > maxPrefetchBytes {
+		return
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Offset < ranges[j].Offset
+	})
+	buf := prefetchBufferPool.Get().(*[]byte)
+	defer prefetchBufferPool.Put(buf)
+
+	read := 0
+	for len(ranges) > 0 {
+		n, err := batchReadFunc(r.f, ranges, *buf)
+		read += n
+		if err != nil {
+			metrics.TableReadStatistics.PrefetchFails.Incr()
+			tableLogger.Warn("prefetch blocks failure", logger.String("path", r.path), logger.Error(err))
+			return
+		}
+		if n == 0 {
+			break
+		}
+		// skip the ranges read into buffer
+		for len(ranges) > 0 && n >= ranges[0].Length {
+			n -= ranges[0].Length
+			ranges = ranges[1:]
+		}
+	}
+	metrics.TableReadStatistics.Prefetches.Incr()
+	metrics.TableReadStatistics.PrefetchBytes.Add(float64(read))
+}
+
+// Advise applies access pattern advice on mapped file content for query reading.
+func (r *storeMMapReader) Advise(advice fileutil.Advice) {
+	r.adviseRange(0, len(r.fullBlock), advice)
+}
+
+// adviseRange applies access pattern advice on mapped file content of range[from, to).
+func (r *storeMMapReader) adviseRange(from, to int, advice fileutil.Advice) {
+	if err := madviseFunc(r.fullBlock[from:to], advice); err != nil {
+		tableLogger.Warn("apply madvise on store file failure",
+			logger.String("path", r.path), logger.String("advice", advice.String()), logger.Error(err))
+	}
+}
+
+// releaseRange releases the pages of file range[from, to), unmaps the pages from memory mapping(madvise dontneed),
+// then drops the page cache(fadvise dontneed), the pages are read from file again if accessed later.
+func (r *storeMMapReader) releaseRange(from, to int) {
+	r.adviseRange(from, to, fileutil.AdviceDontNeed)
+	if err := fadviseFunc(r.f, int64(from), int64(to-from), fileutil.AdviceDontNeed); err != nil {
+		tableLogger.Warn("apply fadvise on store file failure",
+			logger.String("path", r.path), logger.Error(err))
+	}
 }
 
 // Close store reader, release resource
@@ -199,6 +407,9 @@ func (r *storeMMapReader) Close() error {
 		_ = r.f.Close()
 	}()
 	r.entriesBlock = nil
+	if r.blockCache != nil {
+		r.blockCache.Purge(r.blockFamily, r.fileNumber)
+	}
 	err := unmapFunc(r.f, r.fullBlock)
 	if err != nil {
 		metrics.TableReadStatistics.UnMMapFailures.Incr()
@@ -211,23 +422,43 @@ func (r *storeMMapReader) Close() error {
 // storeMMapIterator iterates k/v pair using mmap store reader
 type storeMMapIterator struct {
 	reader *storeMMapReader
-	keyIt  roaring.IntIterable
+	keyIt  roaring.IntPeekable
 
 	idx int
+
+	// for compaction reading
+	compaction bool            // if advises file range ahead of reading and releases consumed range
+	advice     fileutil.Advice // access pattern advice of compaction reading
+	released   int             // offset of file which pages before it are released(page aligned)
+	advised    int             // offset of file which advice applied before it
 }
 
-// newMMapIterator creates store iterator using mmap store reader
-func newMMapIterator(reader *storeMMapReader) Iterator {
-	return &storeMMapIterator{
+// newMMapIterator creates store iterator using mmap store reader, skips the keys less than start.
+func newMMapIterator(reader *storeMMapReader, start uint32) *storeMMapIterator {
+	it := &storeMMapIterator{
 		reader: reader,
 		keyIt:  reader.keys.Iterator(),
 	}
+	if start > 0 {
+		it.keyIt.AdvanceIfNeeded(start)
+		// index of value block = the number of keys less than start
+		it.idx = int(reader.keys.Rank(start - 1))
+	}
+	return it
 }
 
 // HasNext returns if the iteration has more element.
 // It returns false if the iterator is exhausted.
 func (it *storeMMapIterator) HasNext() bool {
-	return it.keyIt.HasNext()
+	if it.keyIt.HasNext() {
+		return true
+	}
+	if it.compaction && it.released < len(it.reader.fullBlock) {
+		// release the rest of file after reading all values
+		it.reader.releaseRange(it.released, len(it.reader.fullBlock))
+		it.released = len(it.reader.fullBlock)
+	}
+	return false
 }
 
 // Key returns the key of the current key/value pair
@@ -240,5 +471,46 @@ func (it *storeMMapIterator) Key() uint32 {
 func (it *storeMMapIterator) Value() []byte {
 	block, _ := it.reader.getBlock(it.idx)
 	it.idx++
+	if it.compaction {
+		if offset, ok := it.reader.offsets.Get(it.idx); ok {
+			it.consumed(offset)
+		}
+	}
 	return block
 }
+
+// consumed releases the consumed range chunk by chunk, then applies advice on the range ahead of reading.
+func (it *storeMMapIterator) consumed(offset int) {
+	if offset-it.released < compactionAdviseSize {
+		return
+	}
+	to := pageAlignDown(offset)
+	it.reader.releaseRange(it.released, to)
+	it.released = to
+	it.adviseAhead()
+}
+
+// adviseAhead applies compaction advice on the range ahead of reading, keeps two chunks advised.
+func (it *storeMMapIterator) adviseAhead() {
+	if it.advice == fileutil.AdviceNormal {
+		return
+	}
+	to := it.released + 2*compactionAdviseSize
+	if size := len(it.reader.fullBlock); to > size {
+		to = size
+	}
+	if to > it.advised {
+		it.reader.adviseRange(it.advised, to, it.advice)
+		it.advised = to
+	}
+}
+
+// pageAlignDown returns the page aligned offset which <= offset.
+func pageAlignDown(offset int) int {
+	return offset / pageSize * pageSize
+}
+
+// pageAlignUp returns the page aligned offset which >= offset.
+func pageAlignUp(offset int) int {
+	return (offset + pageSize - 1) / pageSize * pageSize
+}
@@ -166,7 +166,7 @@ func TestReader(t *testing.T) {
 	err = builder.Close()
 	assert.Nil(t, err)
 
-	cache := NewCache(testKVPath, time.Hour)
+	cache := NewCache(testKVPath, time.Hour, fileutil.AdviceNormal)
 
 	reader, err := cache.GetReader("", "000010.sst")
 	assert.NoError(t, err)
@@ -209,7 +209,7 @@ func TestStoreIterator(t *testing.T) {
 	err = builder.Close()
 	assert.Nil(t, err)
 
-	cache := NewCache(testKVPath, time.Hour)
+	cache := NewCache(testKVPath, time.Hour, fileutil.AdviceNormal)
 	reader, err := cache.GetReader("", "000010.sst")
 	assert.NoError(t, err)
 
@@ -227,3 +227,192 @@ func TestStoreIterator(t *testing.T) {
 
 	assert.False(t, it.HasNext())
 }
+
+func TestReader_Prefetch(t *testing.T) {
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	defer func() {
+		batchReadFunc = fileutil.BatchRead
+		_ = os.RemoveAll(testKVPath)
+	}()
+
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000011.sst"))
+	assert.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		_ = builder.Add(uint32(i), []byte(fmt.Sprintf("test-%d", i)))
+	}
+	assert.NoError(t, builder.Close())
+
+	r, err := newMMapStoreReader(filepath.Join(testKVPath, "000011.sst"), "000011.sst")
+	assert.NoError(t, err)
+	defer func() {
+		_ = r.Close()
+	}()
+	var blocks [][]byte
+	var read []byte
+	calls := 0
+	batchReadFunc = func(f *os.File, ranges []fileutil.ReadRange, dst []byte) (int, error) {
+		calls++
+		n, err := fileutil.BatchRead(f, ranges, dst)
+		read = append(read, dst[:n]...)
+		return n, err
+	}
+	// few blocks, no prefetch
+	v, _ := r.Get(1)
+	r.Prefetch([][]byte{v})
+	assert.Nil(t, read)
+	// blocks not belong to file
+	for i := 0; i < 10; i++ {
+		blocks = append(blocks, []byte("abc"))
+	}
+	r.Prefetch(blocks)
+	assert.Nil(t, read)
+
+	blocks = blocks[:0]
+	var expect []byte
+	for i := 19; i >= 0; i-- {
+		v, _ := r.Get(uint32(i))
+		blocks = append(blocks, v)
+	}
+	for i := 0; i < 20; i++ {
+		expect = append(expect, []byte(fmt.Sprintf("test-%d", i))...)
+	}
+	r.Prefetch(blocks)
+	assert.Equal(t, expect, read)
+	// read blocks chunk by chunk
+	read = nil
+	calls = 0
+	_ = prefetchBufferPool.Get()
+	buf := make([]byte, 10)
+	prefetchBufferPool.Put(&buf)
+	r.Prefetch(blocks)
+	assert.Equal(t, expect, read)
+	assert.True(t, calls > 1)
+
+	// batch read failure
+	batchReadFunc = func(f *os.File, ranges []fileutil.ReadRange, dst []byte) (int, error) {
+		return 0, fmt.Errorf("err")
+	}
+	r.Prefetch(blocks)
+}
+
+func TestReader_CompactionIterator(t *testing.T) {
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	defer func() {
+		madviseFunc = fileutil.Madvise
+		fadviseFunc = fileutil.FadviseRange
+		_ = os.RemoveAll(testKVPath)
+	}()
+
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000012.sst"))
+	assert.NoError(t, err)
+	value := make([]byte, 1024*1024)
+	for i := 1; i <= 6; i++ {
+		_ = builder.Add(uint32(i), value)
+	}
+	assert.NoError(t, builder.Close())
+
+	r, err := newMMapStoreReader(filepath.Join(testKVPath, "000012.sst"), "000012.sst")
+	assert.NoError(t, err)
+	defer func() {
+		_ = r.Close()
+	}()
+	size := len(r.(*storeMMapReader).fullBlock)
+	var advised []fileutil.Advice
+	madviseFunc = func(data []byte, advice fileutil.Advice) error {
+		advised = append(advised, advice)
+		return fmt.Errorf("err")
+	}
+	type released struct{ offset, length int64 }
+	var releases []released
+	fadviseFunc = func(_ *os.File, offset, length int64, advice fileutil.Advice) error {
+		assert.Equal(t, fileutil.AdviceDontNeed, advice)
+		releases = append(releases, released{offset: offset, length: length})
+		return fmt.Errorf("err")
+	}
+	// query advice applies on whole file
+	r.Advise(fileutil.AdviceRandom)
+	assert.Equal(t, []fileutil.Advice{fileutil.AdviceRandom}, advised)
+
+	// advise ahead, release consumed range chunk by chunk
+	advised = nil
+	it := r.CompactionIterator(0, fileutil.AdviceSequential)
+	assert.Equal(t, []fileutil.Advice{fileutil.AdviceSequential}, advised)
+	count := 0
+	for it.HasNext() {
+		assert.Equal(t, uint32(count+1), it.Key())
+		assert.Len(t, it.Value(), len(value))
+		count++
+	}
+	assert.Equal(t, 6, count)
+	assert.Equal(t, []released{
+		{offset: 0, length: compactionAdviseSize},
+		{offset: compactionAdviseSize, length: int64(size - compactionAdviseSize)},
+	}, releases)
+	// rest of file released once
+	assert.False(t, it.HasNext())
+	assert.Len(t, releases, 2)
+
+	// iterate from middle of file, normal advice not applied
+	advised = nil
+	releases = nil
+	it = r.CompactionIterator(4, fileutil.AdviceNormal)
+	assert.Empty(t, advised)
+	count = 0
+	for it.HasNext() {
+		assert.Equal(t, uint32(count+4), it.Key())
+		assert.Len(t, it.Value(), len(value))
+		count++
+	}
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []released{{offset: 3 * 1024 * 1024, length: int64(size - 3*1024*1024)}}, releases)
+}
+
+func TestReader_BlockCache(t *testing.T) {
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	SetBlockCache(NewBlockCache(1024 * 1024))
+	defer func() {
+		SetBlockCache(nil)
+		_ = os.RemoveAll(testKVPath)
+	}()
+
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000012.sst"))
+	assert.NoError(t, err)
+	_ = builder.Add(1, []byte("test"))
+	assert.NoError(t, builder.Close())
+
+	r, err := newMMapStoreReader(filepath.Join(testKVPath, "000012.sst"), "000012.sst")
+	assert.NoError(t, err)
+	// key not exist
+	_, _, err = r.GetCached(2)
+	assert.Equal(t, ErrKeyNotExist, err)
+	// get without block cache
+	value, err := r.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+	assert.Equal(t, int64(0), blockCache.Size())
+	// add into block cache
+	value, cached, err := r.GetCached(1)
+	assert.NoError(t, err)
+	assert.Nil(t, cached)
+	assert.Equal(t, []byte("test"), value)
+	assert.Equal(t, int64(pageSize), blockCache.Size())
+	// get from block cache
+	value, cached, err = r.GetCached(1)
+	assert.NoError(t, err)
+	assert.NotNil(t, cached)
+	assert.Equal(t, []byte("test"), value)
+	cached.Release()
+	assert.NoError(t, r.Close())
+	assert.Equal(t, int64(0), blockCache.Size())
+}
+
+func TestReader_blockFileOf(t *testing.T) {
+	f1, n1 := blockFileOf(filepath.Join("store", "1", "000012.sst"), "000012.sst")
+	f2, n2 := blockFileOf(filepath.Join("store", "2", "000012.sst"), "000012.sst")
+	assert.Equal(t, FileNumber(12), n1)
+	assert.Equal(t, n1, n2)
+	assert.NotEqual(t, f1, f2)
+	f3, n3 := blockFileOf(filepath.Join("store", "1", "test.sst"), "test.sst")
+	assert.Equal(t, FileNumber(0), n3)
+	assert.NotEqual(t, f1, f3)
+}
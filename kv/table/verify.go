@@ -0,0 +1,110 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/lindb/lindb/metrics"
+)
+
+// ValueChecker checks the integrity of value stored under key, returns err if value is corrupt.
+type ValueChecker func(key uint32, value []byte) error
+
+// NewCRC32Checker returns a ValueChecker which validates the crc32 checksum stored in the last 4 bytes of value,
+// the checksum covers the bytes before footer(footerSize includes the checksum).
+func NewCRC32Checker(footerSize int) ValueChecker {
+	return func(key uint32, value []byte) error {
+		if len(value) < footerSize {
+			return fmt.Errorf("value of key:%d length:%d too short than footer size:%d", key, len(value), footerSize)
+		}
+		expect := binary.LittleEndian.Uint32(value[len(value)-4:])
+		if actual := crc32.ChecksumIEEE(value[:len(value)-footerSize]); actual != expect {
+			return fmt.Errorf("verify crc32 checksum of key:%d failure, expect:%d, actual:%d", key, expect, actual)
+		}
+		return nil
+	}
+}
+
+// VerifyFile verifies the integrity of sst file, includes footer/magic-number/index block,
+// and checks each value by checker if checker not nil.
+func VerifyFile(path string, checker ValueChecker) (err error) {
+	r, err := newMMapStoreReader(path, path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := r.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	reader := r.(*storeMMapReader)
+	keyIt := reader.keys.Iterator()
+	idx := 0
+	for keyIt.HasNext() {
+		key := keyIt.Next()
+		value, err0 := reader.offsets.GetBlock(idx, reader.entriesBlock)
+		if err0 != nil {
+			return fmt.Errorf("read value of key:%d from sstfile:%s error:%s", key, path, err0)
+		}
+		if checker != nil {
+			if err0 = checker(key, value); err0 != nil {
+				return fmt.Errorf("verify value of sstfile:%s error:%s", path, err0)
+			}
+		}
+		idx++
+	}
+	return nil
+}
+
+// Verify verifies the value of key by checker, each key is verified only once in reader's lifecycle.
+func (r *storeMMapReader) Verify(key uint32, value []byte, checker ValueChecker) error {
+	if checker == nil {
+		return nil
+	}
+	r.verifyLock.Lock()
+	verified := r.verifiedKeys.Contains(key)
+	r.verifyLock.Unlock()
+	if verified {
+		return nil
+	}
+	metrics.TableReadStatistics.Verifies.Incr()
+	if err := checker(key, value); err != nil {
+		metrics.TableReadStatistics.VerifyFailures.Incr()
+		return fmt.Errorf("%w: %s, error:%s", ErrCorruptFile, r.path, err)
+	}
+	r.verifyLock.Lock()
+	r.verifiedKeys.Add(key)
+	r.verifyLock.Unlock()
+	return nil
+}
+
+// ReadKeyRange returns the min/max key of sst file.
+func ReadKeyRange(path string) (minKey, maxKey uint32, err error) {
+	r, err := newMMapStoreReader(path, path)
+	if err != nil {
+		return 0, 0, err
+	}
+	reader := r.(*storeMMapReader)
+	if !reader.keys.IsEmpty() {
+		minKey, maxKey = reader.keys.Minimum(), reader.keys.Maximum()
+	}
+	return minKey, maxKey, r.Close()
+}
@@ -0,0 +1,143 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func newCRC32Value(data []byte) []byte {
+	value := make([]byte, len(data)+8)
+	copy(value, data)
+	binary.LittleEndian.PutUint32(value[len(data)+4:], crc32.ChecksumIEEE(data))
+	return value
+}
+
+func TestNewCRC32Checker(t *testing.T) {
+	checker := NewCRC32Checker(8)
+	// case 1: value too short
+	assert.Error(t, checker(1, []byte{1, 2, 3}))
+	// case 2: checksum ok
+	value := newCRC32Value([]byte("test"))
+	assert.NoError(t, checker(1, value))
+	// case 3: checksum mismatch
+	value[0] = 'T'
+	assert.Error(t, checker(1, value))
+}
+
+func TestVerifyFile(t *testing.T) {
+	defer func() {
+		_ = os.RemoveAll(testKVPath)
+	}()
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	path := filepath.Join(testKVPath, "000010.sst")
+	// case 1: file not exist
+	assert.Error(t, VerifyFile(path, nil))
+
+	builder, err := NewStoreBuilder(10, path)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, newCRC32Value([]byte("test"))))
+	assert.NoError(t, builder.Add(10, newCRC32Value([]byte("test10"))))
+	assert.NoError(t, builder.Close())
+	// case 2: verify ok
+	assert.NoError(t, VerifyFile(path, nil))
+	assert.NoError(t, VerifyFile(path, NewCRC32Checker(8)))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	// case 3: value corrupt
+	corrupt := append([]byte{}, data...)
+	corrupt[0] = 'T'
+	assert.NoError(t, os.WriteFile(path, corrupt, 0644))
+	assert.NoError(t, VerifyFile(path, nil))
+	assert.Error(t, VerifyFile(path, NewCRC32Checker(8)))
+	// case 4: magic number corrupt
+	corrupt = append([]byte{}, data...)
+	corrupt[len(corrupt)-1]++
+	assert.NoError(t, os.WriteFile(path, corrupt, 0644))
+	assert.Error(t, VerifyFile(path, nil))
+	// case 5: file truncated
+	assert.NoError(t, os.WriteFile(path, data[:len(data)/2], 0644))
+	assert.Error(t, VerifyFile(path, nil))
+}
+
+func TestStoreMMapReader_Verify(t *testing.T) {
+	defer func() {
+		_ = os.RemoveAll(testKVPath)
+	}()
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	path := filepath.Join(testKVPath, "000011.sst")
+	builder, err := NewStoreBuilder(11, path)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, newCRC32Value([]byte("test"))))
+	assert.NoError(t, builder.Add(10, newCRC32Value([]byte("test10"))))
+	assert.NoError(t, builder.Close())
+
+	r, err := newMMapStoreReader(path, path)
+	assert.NoError(t, err)
+	defer func() {
+		_ = r.Close()
+	}()
+	checker := NewCRC32Checker(8)
+	value, err := r.Get(1)
+	assert.NoError(t, err)
+	// case 1: without checker
+	assert.NoError(t, r.Verify(1, value, nil))
+	// case 2: verify ok, then skip verified key
+	assert.NoError(t, r.Verify(1, value, checker))
+	assert.NoError(t, r.Verify(1, []byte{1}, checker))
+	// case 3: value corrupt
+	value, err = r.Get(10)
+	assert.NoError(t, err)
+	corrupt := append([]byte{}, value...)
+	corrupt[0] = 'T'
+	err = r.Verify(10, corrupt, checker)
+	assert.ErrorIs(t, err, ErrCorruptFile)
+	// corrupt key not marked verified
+	assert.Error(t, r.Verify(10, corrupt, checker))
+}
+
+func TestReadKeyRange(t *testing.T) {
+	defer func() {
+		_ = os.RemoveAll(testKVPath)
+	}()
+	_ = fileutil.MkDirIfNotExist(testKVPath)
+	path := filepath.Join(testKVPath, "000012.sst")
+	// case 1: file not exist
+	_, _, err := ReadKeyRange(path)
+	assert.Error(t, err)
+
+	builder, err := NewStoreBuilder(12, path)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(3, []byte("test3")))
+	assert.NoError(t, builder.Add(100, []byte("test100")))
+	assert.NoError(t, builder.Close())
+	// case 2: read key range
+	minKey, maxKey, err := ReadKeyRange(path)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), minKey)
+	assert.Equal(t, uint32(100), maxKey)
+}
@@ -18,6 +18,8 @@
 package version
 
 import (
+	"sync"
+
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/kv/table"
@@ -46,6 +48,9 @@ type snapshot struct {
 	readers []table.Reader // current read table.Reader list
 	version Version
 	closed  atomic.Bool
+
+	blocks []table.Block // cached blocks read by snapshot, released after snapshot closed
+	mutex  sync.Mutex
 }
 
 // newSnapshot new snapshot instance
@@ -77,7 +82,7 @@ func (s *snapshot) FindReaders(key uint32) ([]table.Reader, error) {
 		}
 		if reader != nil {
 			s.readers = append(s.readers, reader)
-			readers = append(readers, reader)
+			readers = append(readers, &snapshotReader{Reader: reader, snapshot: s})
 		}
 	}
 	return readers, nil
@@ -88,6 +93,7 @@ func (s *snapshot) GetReader(fileNumber table.FileNumber) (table.Reader, error)
 	reader, err := s.cache.GetReader(s.familyName, Table(fileNumber))
 	if reader != nil {
 		s.readers = append(s.readers, reader)
+		return &snapshotReader{Reader: reader, snapshot: s}, err
 	}
 	return reader, err
 }
@@ -96,7 +102,42 @@ func (s *snapshot) GetReader(fileNumber table.FileNumber) (table.Reader, error)
 func (s *snapshot) Close() {
 	// atomic set closed status, make sure only release once
 	if s.closed.CAS(false, true) {
+		s.releaseBlocks()
 		s.version.Release()
 		s.cache.ReleaseReaders(s.readers)
 	}
 }
+
+// holdBlock holds the cached block until snapshot closed.
+func (s *snapshot) holdBlock(block table.Block) {
+	s.mutex.Lock()
+	s.blocks = append(s.blocks, block)
+	s.mutex.Unlock()
+}
+
+// releaseBlocks releases the cached blocks held by snapshot.
+func (s *snapshot) releaseBlocks() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, block := range s.blocks {
+		block.Release()
+	}
+	s.blocks = nil
+}
+
+// snapshotReader reads value through block cache, the cached block which value references is held by snapshot,
+// so that the value can be referenced until snapshot closed.
+type snapshotReader struct {
+	table.Reader
+	snapshot *snapshot
+}
+
+// Get returns value for giving key, reads value from block cache if enabled.
+func (r *snapshotReader) Get(key uint32) ([]byte, error) {
+	value, cached, err := r.Reader.GetCached(key)
+	if cached != nil {
+		r.snapshot.holdBlock(cached)
+	}
+	return value, err
+}
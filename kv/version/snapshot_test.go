@@ -68,7 +68,22 @@ func TestSnapshot_FindReaders(t *testing.T) {
 	readers, err = snapshot.FindReaders(uint32(80))
 	assert.Error(t, err)
 	assert.Nil(t, readers)
-	// case 7: close snapshot
+	// case 7: get value, cached block is held by snapshot
+	reader0 := table.NewMockReader(ctrl)
+	cache.EXPECT().GetReader("test", Table(table.FileNumber(10))).Return(reader0, nil)
+	readers, err = snapshot.FindReaders(uint32(80))
+	assert.NoError(t, err)
+	block := table.NewMockBlock(ctrl)
+	reader0.EXPECT().GetCached(uint32(1)).Return([]byte{1}, block, nil)
+	value, err := readers[0].Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, value)
+	reader0.EXPECT().GetCached(uint32(2)).Return([]byte{2}, nil, nil)
+	value, err = readers[0].Get(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2}, value)
+	// case 8: close snapshot, release cached blocks
+	block.EXPECT().Release()
 	v.EXPECT().Release()
 	snapshot.Close()
 	snapshot.Close() // test version release only once
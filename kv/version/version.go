@@ -18,6 +18,8 @@
 package version
 
 import (
+	"sort"
+
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/kv/table"
@@ -61,6 +63,9 @@ type Version interface {
 	// PickL0Compaction picks level0 compaction context,
 	// if it hasn't congruent compaction return nil.
 	PickL0Compaction(compactThreshold int) *Compaction
+	// PickSmallFilesCompaction picks the longest run of adjacent small files in level1 to merge,
+	// if the number of adjacent small files is less than compactThreshold return nil.
+	PickSmallFilesCompaction(compactThreshold int, smallFileSize uint32) *Compaction
 
 	// AddRollupFile adds need rollup file and target interval
 	AddRollupFile(fileNumber table.FileNumber, interval timeutil.Interval)
@@ -197,6 +202,35 @@ func (v *version) PickL0Compaction(compactThreshold int) *Compaction {
 	return NewCompaction(v.fv.GetID(), 0, levelInputs, levelUpInputs)
 }
 
+// PickSmallFilesCompaction picks the longest run of adjacent small files in level1 to merge,
+// if the number of adjacent small files is less than compactThreshold return nil.
+// Only adjacent files(sorted by key range) are picked, so that the merged files don't overlap with others in level1.
+func (v *version) PickSmallFilesCompaction(compactThreshold int, smallFileSize uint32) *Compaction {
+	if compactThreshold < 2 || v.NumberOfFilesInLevel(1) < compactThreshold {
+		return nil
+	}
+	files := v.GetFiles(1)
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].GetMinKey() < files[j].GetMinKey()
+	})
+	var picked, run []*FileMeta
+	for _, file := range files {
+		if file.GetFileSize() >= smallFileSize {
+			run = nil
+			continue
+		}
+		run = append(run, file)
+		if len(run) > len(picked) {
+			picked = run
+		}
+	}
+	if len(picked) < compactThreshold {
+		return nil
+	}
+	// merge level1 files into level1, no level0 inputs.
+	return NewCompaction(v.fv.GetID(), 0, nil, picked)
+}
+
 // FindFiles finds all files include key from each level
 func (v *version) FindFiles(key uint32) []*FileMeta {
 	var files []*FileMeta
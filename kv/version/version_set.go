@@ -38,6 +38,7 @@ var (
 	writeFileFunc       = os.WriteFile
 	readFileFunc        = os.ReadFile
 	renameFunc          = os.Rename
+	removeFileFunc      = os.Remove
 	newBufferReaderFunc = bufioutil.NewBufioEntryReader
 	newBufferWriterFunc = bufioutil.NewBufioEntryWriter
 	newEmptyEditLogFunc = newEmptyEditLog
@@ -53,6 +54,11 @@ type StoreVersionSet interface {
 	NextFileNumber() table.FileNumber
 	// ManifestFileNumber returns the current manifest file number
 	ManifestFileNumber() table.FileNumber
+	// EditLogsSinceCheckpoint returns the number of edit logs appended into current manifest file after snapshot written.
+	EditLogsSinceCheckpoint() int64
+	// Checkpoint writes the snapshot of version set into a new manifest file, then switches current manifest to it,
+	// so that recovering doesn't replay the edit logs of old manifest file.
+	Checkpoint() error
 	// CommitFamilyEditLog persists edit logs to manifest file, then apply new version to family version
 	CommitFamilyEditLog(family string, editLog EditLog) error
 	// CreateFamilyVersion creates family version using family name,
@@ -79,6 +85,7 @@ type storeVersionSet struct {
 	familyVersions     map[string]FamilyVersion
 	familyIDs          map[FamilyID]string
 	versionID          atomic.Int64 // unique in for increasing version id
+	editLogs           atomic.Int64 // number of edit logs appended into current manifest file after snapshot written
 	storeCache         table.Cache
 
 	numOfLevels int // num of levels
@@ -139,6 +146,63 @@ func (vs *storeVersionSet) ManifestFileNumber() table.FileNumber {
 	return table.FileNumber(vs.manifestFileNumber.Load())
 }
 
+// EditLogsSinceCheckpoint returns the number of edit logs appended into current manifest file after snapshot written.
+func (vs *storeVersionSet) EditLogsSinceCheckpoint() int64 {
+	return vs.editLogs.Load()
+}
+
+// Checkpoint writes the snapshot of version set into a new manifest file, then switches current manifest to it.
+// Switchover is crash-safe, CURRENT file points to new manifest file only after snapshot persisted successfully,
+// old manifest file keeps being current one if checkpoint fails, and it can be deleted as obsolete file after switched.
+func (vs *storeVersionSet) Checkpoint() error {
+	vs.mutex.Lock()
+	defer vs.mutex.Unlock()
+
+	if vs.manifest == nil {
+		return fmt.Errorf("manifest journal not initialized")
+	}
+	manifestFileNumber := vs.nextFileNumber.Inc() - 1
+	manifestFileName := ManifestFileName(table.FileNumber(manifestFileNumber))
+	manifestPath := vs.getManifestFilePath(manifestFileName)
+	writer, err := newBufferWriterFunc(manifestPath)
+	if err != nil {
+		return err
+	}
+	abandon := func() {
+		if err := writer.Close(); err != nil {
+			versionLogger.Warn("close new manifest file error when checkpoint fail",
+				logger.String("path", vs.storePath), logger.String("manifest", manifestFileName), logger.Error(err))
+		}
+		if err := removeFileFunc(manifestPath); err != nil {
+			versionLogger.Warn("remove new manifest file error when checkpoint fail",
+				logger.String("path", vs.storePath), logger.String("manifest", manifestFileName), logger.Error(err))
+		}
+	}
+	// write snapshot of current versions into new manifest file
+	if err := vs.persistEditLogs(writer, vs.createSnapshot()); err != nil {
+		abandon()
+		return err
+	}
+	// switch current manifest file after snapshot persisted
+	if err := vs.setCurrent(manifestFileName); err != nil {
+		abandon()
+		return err
+	}
+	oldManifest := vs.manifest
+	vs.manifest = writer
+	vs.manifestFileNumber.Store(manifestFileNumber)
+	editLogs := vs.editLogs.Swap(0)
+	if err := oldManifest.Close(); err != nil {
+		versionLogger.Warn("close old manifest file error after checkpoint",
+			logger.String("path", vs.storePath), logger.Error(err))
+	}
+	versionLogger.Info("checkpoint version set into new manifest file",
+		logger.String("path", vs.storePath),
+		logger.String("manifest", manifestFileName),
+		logger.Int64("truncatedLogs", editLogs))
+	return nil
+}
+
 // CommitFamilyEditLog persists edit logs to manifest file, then apply new version to family version
 func (vs *storeVersionSet) CommitFamilyEditLog(family string, editLog EditLog) error {
 	// get family version based on family name
@@ -156,6 +220,7 @@ func (vs *storeVersionSet) CommitFamilyEditLog(family string, editLog EditLog) e
 	if err := vs.persistEditLogs(vs.manifest, []EditLog{editLog}); err != nil {
 		return err
 	}
+	vs.editLogs.Inc()
 	// get current snapshot
 	snapshot := familyVersion.GetSnapshot()
 	defer snapshot.Close()
@@ -283,7 +348,11 @@ func (vs *storeVersionSet) applyFamilyVersion(familyID FamilyID, editLog EditLog
 // setNextFileNumberWithoutLock set next file number, invoker must add lock
 func (vs *storeVersionSet) setNextFileNumberWithoutLock(newNextFileNumber table.FileNumber) {
 	next := int64(newNextFileNumber)
-	vs.manifestFileNumber.Store(next)
+	if vs.manifest == nil {
+		// manifest file number only changes when recovering,
+		// keeps the number of writing manifest file after journal initialized.
+		vs.manifestFileNumber.Store(next)
+	}
 	vs.nextFileNumber.Store(next + 1)
 }
 
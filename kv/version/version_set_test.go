@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -213,8 +215,11 @@ func TestCommitFamilyEditLog(t *testing.T) {
 	editLog.Add(CreateSequence(1, 10))
 	editLog.Add(CreateNewRollupFile(1, 10000))
 	editLog.Add(CreateNewReferenceFile("20230202", 1, 10))
+	manifestFileNumber := vs.ManifestFileNumber()
 	err = vs.CommitFamilyEditLog("f", editLog)
 	assert.Nil(t, err, "commit family edit log error")
+	// manifest file number not changed after committing edit log
+	assert.Equal(t, manifestFileNumber, vs.ManifestFileNumber())
 
 	_ = vs.Destroy()
 
@@ -309,7 +314,7 @@ func TestStoreVersionSet_Destroy(t *testing.T) {
 
 func TestStoreVersionSet(t *testing.T) {
 	path := t.TempDir()
-	cache := table.NewCache(path, time.Minute)
+	cache := table.NewCache(path, time.Minute, fileutil.AdviceNormal)
 	vs := NewStoreVersionSet(path, cache, 2)
 	err := vs.Recover()
 	assert.NoError(t, err)
@@ -339,7 +344,7 @@ func TestStoreVersionSet(t *testing.T) {
 
 func TestStoreVersionSet_NextFileNumber(t *testing.T) {
 	path := t.TempDir()
-	cache := table.NewCache(path, time.Minute)
+	cache := table.NewCache(path, time.Minute, fileutil.AdviceNormal)
 	vs := NewStoreVersionSet(path, cache, 2)
 	err := vs.Recover()
 	assert.NoError(t, err)
@@ -380,6 +385,87 @@ func TestStoreVersionSet_NextFileNumber(t *testing.T) {
 	assert.Equal(t, total, c)
 }
 
+func TestStoreVersionSet_Checkpoint(t *testing.T) {
+	defer func() {
+		renameFunc = os.Rename
+		newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+	}()
+	path := t.TempDir()
+	cache := table.NewCache(path, time.Minute, fileutil.AdviceNormal)
+	vs := NewStoreVersionSet(path, cache, 2)
+	// case 1: manifest not initialized
+	assert.Error(t, vs.Checkpoint())
+	assert.NoError(t, vs.Recover())
+	familyID := FamilyID(1)
+	vs.CreateFamilyVersion("f", familyID)
+	var liveFiles []table.FileNumber
+	for i := 0; i < 3; i++ {
+		fileNumber := vs.NextFileNumber()
+		liveFiles = append(liveFiles, fileNumber)
+		editLog := NewEditLog(familyID)
+		editLog.Add(CreateNewFile(0, NewFileMeta(fileNumber, 1, 100, 100)))
+		assert.NoError(t, vs.CommitFamilyEditLog("f", editLog))
+	}
+	editLog := NewEditLog(familyID)
+	editLog.Add(NewDeleteFile(0, liveFiles[0]))
+	assert.NoError(t, vs.CommitFamilyEditLog("f", editLog))
+	assert.Equal(t, int64(4), vs.EditLogsSinceCheckpoint())
+	oldManifest := ManifestFileName(vs.ManifestFileNumber())
+
+	// case 2: create new manifest file err
+	newBufferWriterFunc = func(fileName string) (bufioutil.BufioWriter, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, vs.Checkpoint())
+	newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+	// case 3: switch current manifest err, keeps old manifest
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, vs.Checkpoint())
+	renameFunc = os.Rename
+	assert.Equal(t, oldManifest, ManifestFileName(vs.ManifestFileNumber()))
+	assert.Equal(t, int64(4), vs.EditLogsSinceCheckpoint())
+	files, err := os.ReadDir(path)
+	assert.NoError(t, err)
+	for _, file := range files {
+		if strings.HasPrefix(file.Name(), ManifestPrefix) {
+			assert.Equal(t, oldManifest, file.Name())
+		}
+	}
+	// case 4: checkpoint success
+	assert.NoError(t, vs.Checkpoint())
+	assert.NotEqual(t, oldManifest, ManifestFileName(vs.ManifestFileNumber()))
+	assert.Equal(t, int64(0), vs.EditLogsSinceCheckpoint())
+	current, err := os.ReadFile(filepath.Join(path, current()))
+	assert.NoError(t, err)
+	assert.Equal(t, ManifestFileName(vs.ManifestFileNumber()), string(current))
+	// append edit log after checkpoint
+	nextFileNumber := vs.NextFileNumber()
+	editLog = NewEditLog(familyID)
+	editLog.Add(CreateNewFile(1, NewFileMeta(nextFileNumber, 1, 100, 100)))
+	assert.NoError(t, vs.CommitFamilyEditLog("f", editLog))
+	assert.Equal(t, int64(1), vs.EditLogsSinceCheckpoint())
+	assert.NoError(t, vs.Destroy())
+
+	// recover from checkpoint manifest without old manifest
+	assert.NoError(t, os.Remove(filepath.Join(path, oldManifest)))
+	vs = NewStoreVersionSet(path, cache, 2)
+	vs.CreateFamilyVersion("f", familyID)
+	assert.NoError(t, vs.Recover())
+	defer func() {
+		assert.NoError(t, vs.Destroy())
+	}()
+	snapshot := vs.GetFamilyVersion("f").GetSnapshot()
+	defer snapshot.Close()
+	var fileNumbers []table.FileNumber
+	for _, file := range snapshot.GetCurrent().GetAllFiles() {
+		fileNumbers = append(fileNumbers, file.GetFileNumber())
+	}
+	assert.ElementsMatch(t, []table.FileNumber{liveFiles[1], liveFiles[2], nextFileNumber}, fileNumbers)
+	assert.True(t, vs.(*storeVersionSet).nextFileNumber.Load() > nextFileNumber.Int64())
+}
+
 func initVersionSetTestData() {
 	if err := fileutil.MkDirIfNotExist(vsTestPath); err != nil {
 		fmt.Println("create test path error")
@@ -191,6 +191,47 @@ func TestVersion_PickL0Compaction(t *testing.T) {
 	assert.Equal(t, 3, len(compaction.levelUpInputs))
 }
 
+func TestVersion_PickSmallFilesCompaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fv := NewMockFamilyVersion(ctrl)
+	vs := NewMockStoreVersionSet(ctrl)
+	fv.EXPECT().GetVersionSet().Return(vs).AnyTimes()
+	fv.EXPECT().GetID().Return(FamilyID(1)).AnyTimes()
+	vs.EXPECT().numberOfLevels().Return(2).AnyTimes()
+	v := newVersion(1, fv)
+	/*
+	* Level 1:
+	* file 1: 1~5(small)
+	* file 2: 10~20(big)
+	* file 3: 30~40(small)
+	* file 4: 50~60(small)
+	* file 5: 70~80(small)
+	 */
+	f1 := FileMeta{fileNumber: 1, minKey: 1, maxKey: 5, fileSize: 10}
+	f2 := FileMeta{fileNumber: 2, minKey: 10, maxKey: 20, fileSize: 1000}
+	f3 := FileMeta{fileNumber: 3, minKey: 30, maxKey: 40, fileSize: 10}
+	f4 := FileMeta{fileNumber: 4, minKey: 50, maxKey: 60, fileSize: 10}
+	f5 := FileMeta{fileNumber: 5, minKey: 70, maxKey: 80, fileSize: 10}
+	v.AddFiles(1, []*FileMeta{&f5, &f2, &f3, &f1, &f4})
+
+	assert.Nil(t, v.PickSmallFilesCompaction(0, 100))
+	assert.Nil(t, v.PickSmallFilesCompaction(10, 100))
+	assert.Nil(t, v.PickSmallFilesCompaction(4, 100))
+
+	compaction := v.PickSmallFilesCompaction(3, 100)
+	assert.NotNil(t, compaction)
+	assert.Equal(t, 0, compaction.GetLevel())
+	assert.Empty(t, compaction.levelInputs)
+	assert.Equal(t, []*FileMeta{&f3, &f4, &f5}, compaction.levelUpInputs)
+	assert.False(t, compaction.IsTrivialMove())
+
+	compaction = v.PickSmallFilesCompaction(5, 10000)
+	assert.NotNil(t, compaction)
+	assert.Len(t, compaction.levelUpInputs, 5)
+}
+
 func TestVersion_RollupJob(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -27,10 +27,31 @@ type ConcurrentStatistics struct {
 	TasksConsumed      *linmetric.BoundCounter   // tasks consumed count
 	TasksRejected      *linmetric.BoundCounter   // tasks rejected count
 	TasksPanic         *linmetric.BoundCounter   // tasks execute panic count
+	TasksCanceled      *linmetric.BoundCounter   // tasks canceled before executing count
 	TasksWaitingTime   *linmetric.BoundHistogram // tasks waiting time
 	TasksExecutingTime *linmetric.BoundHistogram // tasks executing time with waiting period
 }
 
+// PriorityPoolStatistics represents priority pool statistics.
+type PriorityPoolStatistics struct {
+	Preempted        *linmetric.BoundCounter   // background tasks yield to interactive tasks
+	Starved          *linmetric.BoundCounter   // background tasks yield too long
+	PreemptedWaiting *linmetric.BoundHistogram // background tasks yield time
+}
+
+// FairPoolStatistics represents the statistics of tenant(database) in weighted fair pool.
+type FairPoolStatistics struct {
+	Weight         *linmetric.BoundGauge     // weight of tenant
+	MaxConcurrency *linmetric.BoundGauge     // max running tasks of tenant, 0 means unlimited
+	Running        *linmetric.BoundGauge     // current running tasks of tenant
+	Pending        *linmetric.BoundGauge     // current pending tasks of tenant
+	Submitted      *linmetric.BoundCounter   // tasks submitted count
+	Dispatched     *linmetric.BoundCounter   // tasks dispatched to worker pool count
+	Throttled      *linmetric.BoundCounter   // number of dispatching skipped because of reaching max concurrency
+	Rejected       *linmetric.BoundCounter   // tasks canceled before dispatching or rejected by stopped pool
+	WaitingTime    *linmetric.BoundHistogram // tasks waiting time in tenant queue
+}
+
 // LimitStatistics represents rate limit statistics.
 type LimitStatistics struct {
 	Throttles *linmetric.BoundCounter // number of reaches the max-concurrency
@@ -48,6 +69,7 @@ func NewConcurrentStatistics(poolName string, registry *linmetric.Registry) *Con
 		TasksConsumed:  scope.NewCounter("tasks_consumed"),
 		TasksRejected:  scope.NewCounter("tasks_rejected"),
 		TasksPanic:     scope.NewCounter("tasks_panic"),
+		TasksCanceled:  scope.NewCounter("tasks_canceled"),
 		TasksWaitingTime: scope.Scope("tasks_waiting_duration").
 			NewHistogramVec("pool_name").WithTagValues(poolName),
 		TasksExecutingTime: scope.Scope("tasks_executing_duration").
@@ -55,6 +77,39 @@ func NewConcurrentStatistics(poolName string, registry *linmetric.Registry) *Con
 	}
 }
 
+// NewPriorityPoolStatistics creates priority pool statistics.
+func NewPriorityPoolStatistics(poolName string, registry *linmetric.Registry) *PriorityPoolStatistics {
+	scope := registry.NewScope("lindb.concurrent.priority_pool", "pool_name", poolName)
+	return &PriorityPoolStatistics{
+		Preempted: scope.NewCounter("tasks_preempted"),
+		Starved:   scope.NewCounter("tasks_starved"),
+		PreemptedWaiting: scope.Scope("tasks_preempted_duration").
+			NewHistogramVec("pool_name").WithTagValues(poolName),
+	}
+}
+
+// NewFairPoolStatistics creates the statistics of tenant(database) in weighted fair pool.
+func NewFairPoolStatistics(poolName, tenant string, registry *linmetric.Registry) *FairPoolStatistics {
+	scope := registry.NewScope("lindb.concurrent.fair_pool", "pool_name", poolName, "db", tenant)
+	return &FairPoolStatistics{
+		Weight:         scope.NewGauge("weight"),
+		MaxConcurrency: scope.NewGauge("max_concurrency"),
+		Running:        scope.NewGauge("tasks_running"),
+		Pending:        scope.NewGauge("tasks_pending"),
+		Submitted:      scope.NewCounter("tasks_submitted"),
+		Dispatched:     scope.NewCounter("tasks_dispatched"),
+		Throttled:      scope.NewCounter("tasks_throttled"),
+		Rejected:       scope.NewCounter("tasks_rejected"),
+		WaitingTime: scope.Scope("tasks_waiting_duration").
+			NewHistogramVec("pool_name", "db").WithTagValues(poolName, tenant),
+	}
+}
+
+// DeleteFairPoolStatistics deletes the statistics of evicted tenant(database) in weighted fair pool.
+func DeleteFairPoolStatistics(poolName, tenant string, registry *linmetric.Registry) {
+	registry.DeleteScope("lindb.concurrent.fair_pool", "pool_name", poolName, "db", tenant)
+}
+
 // NewLimitStatistics creates a rate limit statistics.
 func NewLimitStatistics(limitType string, registry *linmetric.Registry) *LimitStatistics {
 	scope := registry.NewScope("lindb.concurrent.limit", "type", limitType)
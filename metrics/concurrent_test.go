@@ -28,4 +28,5 @@ import (
 func TestNewConcurrentStatistics(t *testing.T) {
 	assert.NotNil(t, NewConcurrentStatistics("test-pool", linmetric.StorageRegistry))
 	assert.NotNil(t, NewLimitStatistics("query", linmetric.BrokerRegistry))
+	assert.NotNil(t, NewFairPoolStatistics("test-pool", "db", linmetric.StorageRegistry))
 }
@@ -28,6 +28,7 @@ type FlatIngestionStatistics struct {
 	CorruptedData   *linmetric.BoundCounter // corrupted when parse
 	DroppedMetric   *linmetric.BoundCounter // drop when append
 	IngestedMetrics *linmetric.BoundCounter // ingested metrics
+	ZeroCopied      *linmetric.BoundCounter // ingested metrics without re-marshalling
 	ReadBytes       *linmetric.BoundCounter // read data bytes
 	LT10KiBCounter  *linmetric.BoundCounter // <=10k count
 	LT100KiBCounter *linmetric.BoundCounter // <=100k count
@@ -54,6 +55,20 @@ type NativeIngestionStatistics struct {
 	DroppedMetrics  *linmetric.BoundCounter // drop metric when append
 }
 
+// SanitizeStatistics represents the statistics of namespace/metric/field name sanitization when ingesting.
+type SanitizeStatistics struct {
+	Normalized *linmetric.DeltaCounterVec // names normalized by char map/case folding
+	Truncated  *linmetric.DeltaCounterVec // names truncated to max length
+	Rejected   *linmetric.DeltaCounterVec // metrics rejected by sanitization(name too long/empty after normalizing)
+}
+
+// RouteStatistics represents the statistics of ingestion relabeling/routing.
+type RouteStatistics struct {
+	Routed     *linmetric.DeltaCounterVec // metrics routed to target database
+	Relabeled  *linmetric.DeltaCounterVec // metrics relabeled to target namespace
+	RouteFails *linmetric.BoundCounter    // metrics failed to relabel
+}
+
 // CommonIngestionStatistics represents ingestion common statistics.
 type CommonIngestionStatistics struct {
 	Duration *linmetric.DeltaHistogramVec // ingest duration(include count)
@@ -95,6 +110,26 @@ func NewCommonIngestionStatistics() *CommonIngestionStatistics {
 	}
 }
 
+// NewSanitizeStatistics creates a name sanitization statistics.
+func NewSanitizeStatistics() *SanitizeStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.sanitize")
+	return &SanitizeStatistics{
+		Normalized: scope.NewCounterVec("normalized_names", "type"),
+		Truncated:  scope.NewCounterVec("truncated_names", "type"),
+		Rejected:   scope.NewCounterVec("rejected_metrics", "type"),
+	}
+}
+
+// NewRouteStatistics creates an ingestion relabeling/routing statistics.
+func NewRouteStatistics() *RouteStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.route")
+	return &RouteStatistics{
+		Routed:     scope.NewCounterVec("routed_metrics", "db"),
+		Relabeled:  scope.NewCounterVec("relabeled_metrics", "ns"),
+		RouteFails: scope.NewCounter("route_failures"),
+	}
+}
+
 // NewFlatIngestionStatistics creates a flat ingestion statistics.
 func NewFlatIngestionStatistics() *FlatIngestionStatistics {
 	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.flat")
@@ -103,6 +138,7 @@ func NewFlatIngestionStatistics() *FlatIngestionStatistics {
 		CorruptedData:   scope.NewCounter("data_corrupted"),
 		DroppedMetric:   scope.NewCounter("dropped_metrics"),
 		IngestedMetrics: scope.NewCounter("ingested_metrics"),
+		ZeroCopied:      scope.NewCounter("zero_copied_metrics"),
 		ReadBytes:       scope.NewCounter("read_bytes"),
 		LT10KiBCounter:  flatIngestionBlockScope.WithTagValues("<10KiB"),
 		LT100KiBCounter: flatIngestionBlockScope.WithTagValues("<100KiB"),
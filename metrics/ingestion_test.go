@@ -25,6 +25,7 @@ import (
 
 func TestIngestionStatistics_New(t *testing.T) {
 	assert.NotNil(t, NewFlatIngestionStatistics())
+	assert.NotNil(t, NewSanitizeStatistics())
 	assert.NotNil(t, NewCommonIngestionStatistics())
 	assert.NotNil(t, NewInfluxIngestionStatistics())
 	assert.NotNil(t, NewNativeIngestionStatistics())
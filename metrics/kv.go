@@ -53,6 +53,29 @@ var (
 		WriteBytes: tableWriteScope.NewCounter("write_bytes"),
 	}
 
+	// table block cache
+	blockCacheScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.block_cache")
+	// BlockCacheStatistics represents table value block cache statistics.
+	BlockCacheStatistics = struct {
+		Hit           *linmetric.BoundCounter // get block hit cache
+		Miss          *linmetric.BoundCounter // get block miss cache
+		Admit         *linmetric.BoundCounter // add block into cache
+		Reject        *linmetric.BoundCounter // reject block by admission policy
+		Evict         *linmetric.BoundCounter // evict block from cache
+		AllocFailures *linmetric.BoundCounter // allocate off-heap memory of block failure
+		FreeFailures  *linmetric.BoundCounter // free off-heap memory of block failure
+		Size          *linmetric.BoundGauge   // bytes of cached blocks
+	}{
+		Hit:           blockCacheScope.NewCounter("cache_hits"),
+		Miss:          blockCacheScope.NewCounter("cache_misses"),
+		Admit:         blockCacheScope.NewCounter("admits"),
+		Reject:        blockCacheScope.NewCounter("rejects"),
+		Evict:         blockCacheScope.NewCounter("evicts"),
+		AllocFailures: blockCacheScope.NewCounter("alloc_failures"),
+		FreeFailures:  blockCacheScope.NewCounter("free_failures"),
+		Size:          blockCacheScope.NewGauge("size"),
+	}
+
 	// table read
 	tableReadScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.read")
 	// TableReadStatistics represents table file read statistics.
@@ -64,6 +87,11 @@ var (
 		MMapFailures   *linmetric.BoundCounter // map file failure
 		UnMMaps        *linmetric.BoundCounter // unmap file success
 		UnMMapFailures *linmetric.BoundCounter // unmap file failures
+		Prefetches     *linmetric.BoundCounter // prefetch blocks by batch read success
+		PrefetchBytes  *linmetric.BoundCounter // bytes of prefetch blocks
+		PrefetchFails  *linmetric.BoundCounter // prefetch blocks by batch read failure
+		Verifies       *linmetric.BoundCounter // verify value checksum on read
+		VerifyFailures *linmetric.BoundCounter // verify value checksum on read failure
 	}{
 		Gets:           tableReadScope.NewCounter("gets"),
 		GetFailures:    tableReadScope.NewCounter("get_failures"),
@@ -72,6 +100,26 @@ var (
 		MMapFailures:   tableReadScope.NewCounter("mmap_failures"),
 		UnMMaps:        tableReadScope.NewCounter("unmmaps"),
 		UnMMapFailures: tableReadScope.NewCounter("unmmap_failures"),
+		Prefetches:     tableReadScope.NewCounter("prefetches"),
+		PrefetchBytes:  tableReadScope.NewCounter("prefetch_bytes"),
+		PrefetchFails:  tableReadScope.NewCounter("prefetch_failures"),
+		Verifies:       tableReadScope.NewCounter("verifies"),
+		VerifyFailures: tableReadScope.NewCounter("verify_failures"),
+	}
+
+	// table verify
+	tableVerifyScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.verify")
+	// TableVerifyStatistics represents table file integrity check statistics when opening store.
+	TableVerifyStatistics = struct {
+		Verified           *linmetric.BoundCounter // verify file success
+		Corrupted          *linmetric.BoundCounter // found corrupt file
+		Quarantined        *linmetric.BoundCounter // move corrupt file into quarantine dir success
+		QuarantineFailures *linmetric.BoundCounter // move corrupt file into quarantine dir failure
+	}{
+		Verified:           tableVerifyScope.NewCounter("verified_files"),
+		Corrupted:          tableVerifyScope.NewCounter("corrupt_files"),
+		Quarantined:        tableVerifyScope.NewCounter("quarantined_files"),
+		QuarantineFailures: tableVerifyScope.NewCounter("quarantine_failures"),
 	}
 
 	// compact job
@@ -87,6 +135,23 @@ var (
 		Duration:   compactScope.Scope("duration").NewHistogramVec("type"),
 	}
 
+	// compaction throttle
+	compactThrottleScope = linmetric.StorageRegistry.NewScope("lindb.kv.compaction.throttle")
+	// CompactThrottleStatistics represents compaction rate limit statistics.
+	CompactThrottleStatistics = struct {
+		Level          *linmetric.BoundGauge   // current throttle level(0,1], scales the configured rate limits
+		RateLimit      *linmetric.BoundGauge   // current effective global rate limit(bytes/sec), 0 means unlimited
+		SLOBreaches    *linmetric.BoundCounter // number of queries which breach the latency SLO
+		Throttled      *linmetric.BoundCounter // number of compaction reads delayed by rate limiter
+		ThrottledBytes *linmetric.BoundCounter // bytes of compaction reads delayed by rate limiter
+	}{
+		Level:          compactThrottleScope.NewGauge("level"),
+		RateLimit:      compactThrottleScope.NewGauge("rate_limit"),
+		SLOBreaches:    compactThrottleScope.NewCounter("slo_breaches"),
+		Throttled:      compactThrottleScope.NewCounter("throttled"),
+		ThrottledBytes: compactThrottleScope.NewCounter("throttled_bytes"),
+	}
+
 	// flush job
 	flushScope = linmetric.StorageRegistry.NewScope("lindb.kv.flush")
 	// FlushStatistics represents flush job statistics.
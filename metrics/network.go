@@ -53,6 +53,13 @@ type GRPCServerStatistics struct {
 	Panics *linmetric.BoundCounter // panic when grpc server handle request
 }
 
+// CircuitBreakerStatistics represents circuit breaker statistics of grpc client connections.
+type CircuitBreakerStatistics struct {
+	State    *linmetric.GaugeVec        // breaker state of target node(0:closed,1:open,2:half-open)
+	Trips    *linmetric.DeltaCounterVec // breaker trips of target node
+	Rejected *linmetric.DeltaCounterVec // requests rejected by open breaker
+}
+
 // NewConnStatistics creates tcp connection statistics.
 func NewConnStatistics(r *linmetric.Registry, addr string) *ConnStatistics {
 	tcpScope := r.NewScope("lindb.traffic.tcp", "addr", addr)
@@ -71,6 +78,16 @@ func NewConnStatistics(r *linmetric.Registry, addr string) *ConnStatistics {
 	}
 }
 
+// NewCircuitBreakerStatistics creates circuit breaker statistics.
+func NewCircuitBreakerStatistics(registry *linmetric.Registry) *CircuitBreakerStatistics {
+	scope := registry.NewScope("lindb.traffic.grpc_client.circuit_breaker")
+	return &CircuitBreakerStatistics{
+		State:    scope.NewGaugeVec("state", "target"),
+		Trips:    scope.NewCounterVec("trips", "target"),
+		Rejected: scope.NewCounterVec("rejected", "target"),
+	}
+}
+
 // NewGRPCUnaryClientStatistics creates unary grpc client statistics.
 func NewGRPCUnaryClientStatistics(registry *linmetric.Registry) *GRPCUnaryStatistics {
 	return newGRPCUnaryStatistics(registry, "lindb.traffic.grpc_client.unary")
@@ -81,3 +81,43 @@ func NewStorageQueryStatistics() *StorageQueryStatistics {
 		OmitRequest:         scope.NewCounter("omitted_requests"),
 	}
 }
+
+var (
+	// leaf query result cache
+	resultCacheScope = linmetric.StorageRegistry.NewScope("lindb.storage.query.result_cache")
+	// ResultCacheStatistics represents leaf query partial aggregate result cache statistics.
+	ResultCacheStatistics = struct {
+		Hit        *linmetric.BoundCounter // get result hit cache
+		Miss       *linmetric.BoundCounter // get result miss cache
+		Add        *linmetric.BoundCounter // add result into cache
+		Evict      *linmetric.BoundCounter // evict result by lru
+		Invalidate *linmetric.BoundCounter // invalidate result because family version changed
+		Size       *linmetric.BoundGauge   // bytes of cached results
+	}{
+		Hit:        resultCacheScope.NewCounter("cache_hits"),
+		Miss:       resultCacheScope.NewCounter("cache_misses"),
+		Add:        resultCacheScope.NewCounter("adds"),
+		Evict:      resultCacheScope.NewCounter("evicts"),
+		Invalidate: resultCacheScope.NewCounter("invalidates"),
+		Size:       resultCacheScope.NewGauge("size"),
+	}
+)
+
+var (
+	// broker group by spill
+	groupBySpillScope = linmetric.BrokerRegistry.NewScope("lindb.broker.query.group_by_spill")
+	// GroupBySpillStatistics represents the statistics of spilling groups into disk for group by query.
+	GroupBySpillStatistics = struct {
+		SpilledQueries   *linmetric.BoundCounter // queries which spill groups into disk
+		SpilledSeries    *linmetric.BoundCounter // time series spilled into disk
+		SpilledBytes     *linmetric.BoundCounter // bytes spilled into disk
+		MergedPartitions *linmetric.BoundCounter // spill partitions merged
+		SpillFailures    *linmetric.BoundCounter // write/read spill file failure
+	}{
+		SpilledQueries:   groupBySpillScope.NewCounter("spilled_queries"),
+		SpilledSeries:    groupBySpillScope.NewCounter("spilled_series"),
+		SpilledBytes:     groupBySpillScope.NewCounter("spilled_bytes"),
+		MergedPartitions: groupBySpillScope.NewCounter("merged_partitions"),
+		SpillFailures:    groupBySpillScope.NewCounter("spill_failures"),
+	}
+)
@@ -0,0 +1,41 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import "github.com/lindb/lindb/internal/linmetric"
+
+var (
+	// queue data page pre-allocation
+	pagePreAllocateScope = linmetric.StorageRegistry.NewScope("lindb.queue.page.pre_allocate")
+	// PagePreAllocateStatistics represents queue page pre-allocation statistics.
+	PagePreAllocateStatistics = struct {
+		Allocate         *linmetric.BoundCounter   // pre-allocate page success
+		AllocateFailures *linmetric.BoundCounter   // pre-allocate page failure
+		Hit              *linmetric.BoundCounter   // acquire page hit pre-allocated page
+		Miss             *linmetric.BoundCounter   // acquire page before pre-allocation completed
+		Discard          *linmetric.BoundCounter   // discard pre-allocated page which not used
+		Duration         *linmetric.BoundHistogram // pre-allocate duration(include count)
+	}{
+		Allocate:         pagePreAllocateScope.NewCounter("allocates"),
+		AllocateFailures: pagePreAllocateScope.NewCounter("allocate_failures"),
+		Hit:              pagePreAllocateScope.NewCounter("hits"),
+		Miss:             pagePreAllocateScope.NewCounter("misses"),
+		Discard:          pagePreAllocateScope.NewCounter("discards"),
+		Duration:         pagePreAllocateScope.Scope("duration").NewHistogram(),
+	}
+)
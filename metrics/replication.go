@@ -17,7 +17,20 @@
 
 package metrics
 
-import "github.com/lindb/lindb/internal/linmetric"
+import (
+	"time"
+
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+// Stages of write path, used as tag value of write latency histogram.
+const (
+	WriteStageDecode     = "decode"      // http request received -> rows decoded(broker)
+	WriteStageEnqueue    = "enqueue"     // rows decoded -> rows put into family channel(broker)
+	WriteStageSend       = "send"        // batch created in family channel -> batch sent to storage leader(broker)
+	WriteStageAppendWAL  = "append_wal"  // batch created in family channel -> batch appended to wal(storage)
+	WriteStageApplyMemDB = "apply_memdb" // batch appended to wal -> batch applied to memory database(storage)
+)
 
 // BrokerDatabaseWriteStatistics represents database channel write statistics.
 type BrokerDatabaseWriteStatistics struct {
@@ -41,6 +54,16 @@ type BrokerFamilyWriteStatistics struct {
 	CloseStream          *linmetric.BoundCounter // close replica stream success count
 	CloseStreamFailures  *linmetric.BoundCounter // close replica stream failure count
 	LeaderChanged        *linmetric.BoundCounter // shard leader changed
+	AuditSampled         *linmetric.BoundCounter // number of message tagged with audit id
+}
+
+// BrokerShardSpoolStatistics represents broker shard write spool statistics.
+type BrokerShardSpoolStatistics struct {
+	SpoolSize     *linmetric.BoundGauge   // bytes of spooled message waiting replay
+	Spool         *linmetric.BoundCounter // number of message spooled to disk
+	SpoolFailures *linmetric.BoundCounter // number of message spool failure
+	SpoolFull     *linmetric.BoundCounter // number of write request rejected when spool is full
+	Replay        *linmetric.BoundCounter // number of spooled message replayed
 }
 
 // StorageLocalReplicatorStatistics represents local replicator statistics.
@@ -97,6 +120,30 @@ type StorageWriteAheadLogStatistics struct {
 	ReplicaWALFailures *linmetric.BoundCounter // replica wal failure(storage leader->follower)
 }
 
+// BrokerWriteLatencyStatistics represents the latency statistics of each stage in broker write path.
+type BrokerWriteLatencyStatistics struct {
+	Decode  *linmetric.BoundHistogram // decode write request into rows
+	Enqueue *linmetric.BoundHistogram // put rows into family channel
+	Send    *linmetric.BoundHistogram // batch waiting in family channel until sent to storage leader
+}
+
+// StorageWriteLatencyStatistics represents the latency statistics of each stage in storage write path.
+type StorageWriteLatencyStatistics struct {
+	AppendWAL  *linmetric.BoundHistogram // batch created by broker until appended to wal(include clock skew between nodes)
+	ApplyMemDB *linmetric.BoundHistogram // batch appended to wal until applied to memory database
+}
+
+// StorageWriteAuditStatistics represents write audit statistics of the sampled message(broker->leader).
+type StorageWriteAuditStatistics struct {
+	Received          *linmetric.BoundCounter // number of audit message received
+	Lost              *linmetric.BoundCounter // number of audit message lost between broker and storage
+	Duplicated        *linmetric.BoundCounter // number of audit message received more than once
+	AppendWAL         *linmetric.BoundCounter // number of audit message appended to wal
+	AppendWALFailures *linmetric.BoundCounter // number of audit message append wal failure
+	Applied           *linmetric.BoundCounter // number of audit message applied to memory database
+	ApplyFailures     *linmetric.BoundCounter // number of audit message appended to wal, but not applied to memory database
+}
+
 // NewBrokerDatabaseWriteStatistics creates a database channel write statistics.
 func NewBrokerDatabaseWriteStatistics(database string) *BrokerDatabaseWriteStatistics {
 	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.database.write")
@@ -124,6 +171,19 @@ func NewBrokerFamilyWriteStatistics(database string) *BrokerFamilyWriteStatistic
 		CloseStream:          scope.NewCounterVec("close_stream", "db").WithTagValues(database),
 		CloseStreamFailures:  scope.NewCounterVec("close_stream_failures", "db").WithTagValues(database),
 		LeaderChanged:        scope.NewCounterVec("leader_changed", "db").WithTagValues(database),
+		AuditSampled:         scope.NewCounterVec("audit_sampled", "db").WithTagValues(database),
+	}
+}
+
+// NewBrokerShardSpoolStatistics creates a broker shard write spool statistics.
+func NewBrokerShardSpoolStatistics(database, shard string) *BrokerShardSpoolStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.shard.spool")
+	return &BrokerShardSpoolStatistics{
+		SpoolSize:     scope.NewGaugeVec("spool_size", "db", "shard").WithTagValues(database, shard),
+		Spool:         scope.NewCounterVec("spool", "db", "shard").WithTagValues(database, shard),
+		SpoolFailures: scope.NewCounterVec("spool_failures", "db", "shard").WithTagValues(database, shard),
+		SpoolFull:     scope.NewCounterVec("spool_full", "db", "shard").WithTagValues(database, shard),
+		Replay:        scope.NewCounterVec("replay", "db", "shard").WithTagValues(database, shard),
 	}
 }
 
@@ -225,3 +285,47 @@ func NewStorageWriteAheadLogStatistics(database, shard string) *StorageWriteAhea
 			WithTagValues(database, shard),
 	}
 }
+
+// NewStorageWriteAuditStatistics creates a write audit statistics.
+func NewStorageWriteAuditStatistics(database, shard string) *StorageWriteAuditStatistics {
+	scope := linmetric.StorageRegistry.NewScope("lindb.storage.write.audit")
+	return &StorageWriteAuditStatistics{
+		Received: scope.NewCounterVec("received", "db", "shard").
+			WithTagValues(database, shard),
+		Lost: scope.NewCounterVec("lost", "db", "shard").
+			WithTagValues(database, shard),
+		Duplicated: scope.NewCounterVec("duplicated", "db", "shard").
+			WithTagValues(database, shard),
+		AppendWAL: scope.NewCounterVec("append_wal", "db", "shard").
+			WithTagValues(database, shard),
+		AppendWALFailures: scope.NewCounterVec("append_wal_failures", "db", "shard").
+			WithTagValues(database, shard),
+		Applied: scope.NewCounterVec("applied", "db", "shard").
+			WithTagValues(database, shard),
+		ApplyFailures: scope.NewCounterVec("apply_failures", "db", "shard").
+			WithTagValues(database, shard),
+	}
+}
+
+// NewBrokerWriteLatencyStatistics creates a broker write path latency statistics.
+func NewBrokerWriteLatencyStatistics(database string) *BrokerWriteLatencyStatistics {
+	stages := linmetric.BrokerRegistry.NewScope("lindb.broker.write.latency").
+		NewHistogramVec("db", "stage").
+		WithExponentBuckets(time.Millisecond, time.Minute, 30)
+	return &BrokerWriteLatencyStatistics{
+		Decode:  stages.WithTagValues(database, WriteStageDecode),
+		Enqueue: stages.WithTagValues(database, WriteStageEnqueue),
+		Send:    stages.WithTagValues(database, WriteStageSend),
+	}
+}
+
+// NewStorageWriteLatencyStatistics creates a storage write path latency statistics.
+func NewStorageWriteLatencyStatistics(database string) *StorageWriteLatencyStatistics {
+	stages := linmetric.StorageRegistry.NewScope("lindb.storage.write.latency").
+		NewHistogramVec("db", "stage").
+		WithExponentBuckets(time.Millisecond, time.Minute, 30)
+	return &StorageWriteLatencyStatistics{
+		AppendWAL:  stages.WithTagValues(database, WriteStageAppendWAL),
+		ApplyMemDB: stages.WithTagValues(database, WriteStageApplyMemDB),
+	}
+}
@@ -25,9 +25,13 @@ import (
 
 func TestReplication_New(t *testing.T) {
 	assert.NotNil(t, NewBrokerFamilyWriteStatistics("db"))
+	assert.NotNil(t, NewBrokerShardSpoolStatistics("db", "1"))
 	assert.NotNil(t, NewBrokerDatabaseWriteStatistics("db"))
 	assert.NotNil(t, NewStorageReplicatorRunnerStatistics("type", "db", "shard"))
 	assert.NotNil(t, NewStorageLocalReplicatorStatistics("db", "shard"))
 	assert.NotNil(t, NewStorageRemoteReplicatorStatistics("db", "shard"))
 	assert.NotNil(t, NewStorageWriteAheadLogStatistics("db", "shard"))
+	assert.NotNil(t, NewStorageWriteAuditStatistics("db", "shard"))
+	assert.NotNil(t, NewBrokerWriteLatencyStatistics("db"))
+	assert.NotNil(t, NewStorageWriteLatencyStatistics("db"))
 }
@@ -27,15 +27,22 @@ var (
 
 	// FlushCheckerStatistics represents flush checker statistics.
 	FlushCheckerStatistics = struct {
-		FlushInFlight *linmetric.GaugeVec // number of family flushing
+		FlushInFlight *linmetric.GaugeVec     // number of family flushing
+		FlushDeadline *linmetric.GaugeVec     // predicted seconds before shard need flush
+		EarlyFlushes  *linmetric.BoundCounter // number of family flushed early by ingest rate prediction
+		FlushCanceled *linmetric.BoundCounter // number of family flush skipped because of checker stopped
 	}{
 		FlushInFlight: shardScope.NewGaugeVec("flush_inflight", "db", "shard"),
+		FlushDeadline: shardScope.NewGaugeVec("flush_deadline", "db", "shard"),
+		EarlyFlushes:  shardScope.NewCounter("early_flushes"),
+		FlushCanceled: shardScope.NewCounter("flush_canceled"),
 	}
 )
 
 // IndexDBStatistics represents index database statistics.
 type IndexDBStatistics = struct {
 	BuildInvertedIndex *linmetric.BoundCounter // build inverted index count
+	DeleteSeries       *linmetric.BoundCounter // deleted series count
 }
 
 // MemDBStatistics represents memory database statistics.
@@ -48,12 +55,18 @@ type MemDBStatistics = struct {
 type DatabaseStatistics struct {
 	MetaDBFlushFailures *linmetric.BoundCounter   // flush metadata database failure
 	MetaDBFlushDuration *linmetric.BoundHistogram // flush metadata database duration(include count)
+	MetaDiskUsage       *linmetric.BoundGauge     // on-disk bytes of metadata database
+	DiskQuota           *linmetric.BoundGauge     // disk quota of database, 0 means unlimited
+	DiskQuotaExceeded   *linmetric.BoundCounter   // disk usage exceeds disk quota when checking
 }
 
 // TagMetaStatistics represents tag metadata statistics.
 type TagMetaStatistics struct {
 	GenTagValueIDs        *linmetric.BoundCounter // generate tag value id success
 	GenTagValueIDFailures *linmetric.BoundCounter // generate tag value id failure
+	TagValueCacheHits     *linmetric.BoundCounter // collect tag value hit cache
+	TagValueCacheMisses   *linmetric.BoundCounter // collect tag value miss cache, need load from kv store
+	TagValueCacheEvicts   *linmetric.BoundCounter // tag value evicted from cache
 }
 
 // MetaDBStatistics represents metadata database statistics.
@@ -66,11 +79,28 @@ type MetaDBStatistics struct {
 	GenTagKeyIDFailures *linmetric.BoundCounter // generate tag key id failure
 }
 
+// MetaSequenceStatistics represents metadata id sequence statistics.
+type MetaSequenceStatistics struct {
+	Allocated  *linmetric.BoundCounter // allocate id from sequence
+	Reserved   *linmetric.BoundCounter // reserve ids by persisting sequence block
+	Burned     *linmetric.BoundCounter // reserved ids never allocated, found by recovery audit
+	Duplicated *linmetric.BoundCounter // duplicate ids found by recovery audit
+	Corrupted  *linmetric.BoundCounter // undecodable entries skipped by recovery audit
+	Repaired   *linmetric.BoundCounter // sequence repaired by recovery audit
+}
+
 // ShardStatistics represents shard statistics.
 type ShardStatistics struct {
 	LookupMetricMetaFailures *linmetric.BoundCounter   // lookup meta of metric failure
 	IndexDBFlushDuration     *linmetric.BoundHistogram // flush index database duration(include count)
 	IndexDBFlushFailures     *linmetric.BoundCounter   // flush index database failure
+	DataDiskUsage            *linmetric.BoundGauge     // on-disk bytes of data families
+	IndexDiskUsage           *linmetric.BoundGauge     // on-disk bytes of index/metadata of shard
+	WALDiskUsage             *linmetric.BoundGauge     // on-disk bytes of write ahead log
+	StagedRows               *linmetric.BoundCounter   // rows staged for generating metadata async
+	StagedRowsPending        *linmetric.BoundGauge     // number of staged rows waiting write
+	StagedRowsWritten        *linmetric.BoundCounter   // staged rows written after metadata generated
+	StageFullFallbacks       *linmetric.BoundCounter   // rows generated metadata sync because staging buffer is full
 }
 
 // FamilyStatistics represents family statistics.
@@ -84,6 +114,11 @@ type FamilyStatistics struct {
 	ActiveMemDBs        *linmetric.BoundGauge     // number of current active memory database
 	MemDBFlushFailures  *linmetric.BoundCounter   // flush memory database failure
 	MemDBFlushDuration  *linmetric.BoundHistogram // flush memory database duration(include count)
+	CorruptFiles        *linmetric.BoundCounter   // found corrupt file when reading data
+	Repairs             *linmetric.BoundCounter   // repair family files from leader replica success
+	RepairFailures      *linmetric.BoundCounter   // repair family files from leader replica failure
+	ActiveReaders       *linmetric.BoundGauge     // number of family snapshots held by readers
+	DeferredEvictions   *linmetric.BoundCounter   // family evictions deferred until readers released
 }
 
 // NewFamilyStatistics creates a family statistics.
@@ -107,6 +142,16 @@ func NewFamilyStatistics(database, shard string) *FamilyStatistics {
 			WithTagValues(database, shard),
 		MemDBFlushDuration: shardScope.Scope("memdb_flush_duration").NewHistogramVec("db", "shard").
 			WithTagValues(database, shard),
+		CorruptFiles: shardScope.NewCounterVec("corrupt_files", "db", "shard").
+			WithTagValues(database, shard),
+		Repairs: shardScope.NewCounterVec("family_repairs", "db", "shard").
+			WithTagValues(database, shard),
+		RepairFailures: shardScope.NewCounterVec("family_repair_failures", "db", "shard").
+			WithTagValues(database, shard),
+		ActiveReaders: shardScope.NewGaugeVec("family_active_readers", "db", "shard").
+			WithTagValues(database, shard),
+		DeferredEvictions: shardScope.NewCounterVec("family_deferred_evictions", "db", "shard").
+			WithTagValues(database, shard),
 	}
 }
 
@@ -119,6 +164,20 @@ func NewShardStatistics(database, shard string) *ShardStatistics {
 			WithTagValues(database, shard),
 		IndexDBFlushDuration: shardScope.Scope("indexdb_flush_duration").NewHistogramVec("db", "shard").
 			WithTagValues(database, shard),
+		DataDiskUsage: shardScope.NewGaugeVec("data_disk_usage", "db", "shard").
+			WithTagValues(database, shard),
+		IndexDiskUsage: shardScope.NewGaugeVec("index_disk_usage", "db", "shard").
+			WithTagValues(database, shard),
+		WALDiskUsage: shardScope.NewGaugeVec("wal_disk_usage", "db", "shard").
+			WithTagValues(database, shard),
+		StagedRows: shardScope.NewCounterVec("staged_rows", "db", "shard").
+			WithTagValues(database, shard),
+		StagedRowsPending: shardScope.NewGaugeVec("staged_rows_pending", "db", "shard").
+			WithTagValues(database, shard),
+		StagedRowsWritten: shardScope.NewCounterVec("staged_rows_written", "db", "shard").
+			WithTagValues(database, shard),
+		StageFullFallbacks: shardScope.NewCounterVec("stage_full_fallbacks", "db", "shard").
+			WithTagValues(database, shard),
 	}
 }
 
@@ -134,11 +193,26 @@ func NewMetaDBStatistics(database string) *MetaDBStatistics {
 	}
 }
 
+// NewMetaSequenceStatistics creates a metadata id sequence statistics.
+func NewMetaSequenceStatistics(database, sequence string) *MetaSequenceStatistics {
+	return &MetaSequenceStatistics{
+		Allocated:  metaDBScope.NewCounterVec("sequence_allocated", "db", "type").WithTagValues(database, sequence),
+		Reserved:   metaDBScope.NewCounterVec("sequence_reserved", "db", "type").WithTagValues(database, sequence),
+		Burned:     metaDBScope.NewCounterVec("sequence_burned", "db", "type").WithTagValues(database, sequence),
+		Duplicated: metaDBScope.NewCounterVec("sequence_duplicated", "db", "type").WithTagValues(database, sequence),
+		Corrupted:  metaDBScope.NewCounterVec("sequence_corrupted", "db", "type").WithTagValues(database, sequence),
+		Repaired:   metaDBScope.NewCounterVec("sequence_repaired", "db", "type").WithTagValues(database, sequence),
+	}
+}
+
 // NewTagMetaStatistics creates a tag metadata statistics.
 func NewTagMetaStatistics(database string) *TagMetaStatistics {
 	return &TagMetaStatistics{
 		GenTagValueIDs:        metaDBScope.NewCounterVec("gen_tag_value_ids", "db").WithTagValues(database),
 		GenTagValueIDFailures: metaDBScope.NewCounterVec("gen_tag_value_id_failures", "db").WithTagValues(database),
+		TagValueCacheHits:     metaDBScope.NewCounterVec("tag_value_cache_hits", "db").WithTagValues(database),
+		TagValueCacheMisses:   metaDBScope.NewCounterVec("tag_value_cache_misses", "db").WithTagValues(database),
+		TagValueCacheEvicts:   metaDBScope.NewCounterVec("tag_value_cache_evicts", "db").WithTagValues(database),
 	}
 }
 
@@ -148,6 +222,9 @@ func NewDatabaseStatistics(database string) *DatabaseStatistics {
 	return &DatabaseStatistics{
 		MetaDBFlushFailures: scope.NewCounterVec("metadb_flush_failures", "db").WithTagValues(database),
 		MetaDBFlushDuration: scope.Scope("metadb_flush_duration").NewHistogramVec("db").WithTagValues(database),
+		MetaDiskUsage:       scope.NewGaugeVec("meta_disk_usage", "db").WithTagValues(database),
+		DiskQuota:           scope.NewGaugeVec("disk_quota", "db").WithTagValues(database),
+		DiskQuotaExceeded:   scope.NewCounterVec("disk_quota_exceeded", "db").WithTagValues(database),
 	}
 }
 
@@ -165,5 +242,6 @@ func NewIndexDBStatistics(database string) *IndexDBStatistics {
 	scope := linmetric.StorageRegistry.NewScope("lindb.tsdb.indexdb")
 	return &IndexDBStatistics{
 		BuildInvertedIndex: scope.NewCounterVec("build_inverted_index", "db").WithTagValues(database),
+		DeleteSeries:       scope.NewCounterVec("delete_series", "db").WithTagValues(database),
 	}
 }
@@ -0,0 +1,34 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// NodeCapacity represents the disk capacity of storage node.
+type NodeCapacity struct {
+	Total      uint64 `json:"total"`
+	Used       uint64 `json:"used"`
+	Free       uint64 `json:"free"`
+	ReportTime int64  `json:"reportTime"` // report time(millisecond)
+}
+
+// UsedRatio returns the ratio of used disk space, range [0, 1].
+func (c *NodeCapacity) UsedRatio() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Used) / float64(c.Total)
+}
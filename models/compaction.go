@@ -0,0 +1,127 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+// CompactionBacklog represents the pending compaction backlog of database on storage node.
+type CompactionBacklog struct {
+	Database        string `json:"database"`
+	Paused          bool   `json:"paused"`          // database level pause
+	Stores          int    `json:"stores"`          // number of kv stores
+	Level0Files     int    `json:"level0Files"`     // number of level0 files waiting for compaction
+	PendingFamilies int    `json:"pendingFamilies"` // number of families whose level0 files reach compact threshold
+	Compacting      int    `json:"compacting"`      // number of families doing compaction
+}
+
+// CompactionState represents the background compaction state of storage node,
+// includes pause state of node/databases and pending compaction backlog of databases.
+type CompactionState struct {
+	Node            string               `json:"node,omitempty"`            // storage node indicator
+	Paused          bool                 `json:"paused"`                    // node level pause
+	PausedDatabases []string             `json:"pausedDatabases,omitempty"` // database level paused databases
+	Backlogs        []*CompactionBacklog `json:"backlogs,omitempty"`
+	ErrMsg          string               `json:"errMsg,omitempty"`
+}
+
+// CompactionStates represents the compaction state list of storage nodes.
+type CompactionStates []*CompactionState
+
+// ToTable returns compaction state list as table if it has value, else return empty string.
+func (s CompactionStates) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Database", "Paused", "Stores", "Level0 Files", "Pending Families", "Compacting", "Error"})
+	for _, r := range s {
+		if len(r.Backlogs) == 0 {
+			writer.AppendRow(table.Row{r.Node, "", r.Paused, "", "", "", "", r.ErrMsg})
+			rows++
+			continue
+		}
+		for _, b := range r.Backlogs {
+			writer.AppendRow(table.Row{r.Node, b.Database, r.Paused || b.Paused, b.Stores,
+				b.Level0Files, b.PendingFamilies, b.Compacting, r.ErrMsg})
+			rows++
+		}
+	}
+	return rows, writer.Render()
+}
+
+// CompactionJob represents the running/queued compact or rollup job of family on storage node.
+type CompactionJob struct {
+	Node       string `json:"node,omitempty"` // storage node indicator
+	Database   string `json:"database"`
+	Store      string `json:"store"`
+	Family     string `json:"family"`
+	Type       string `json:"type"`       // merge/rollup
+	Status     string `json:"status"`     // running/queued
+	Level      int    `json:"level"`      // level of input files
+	InputFiles int    `json:"inputFiles"` // number of input files
+	InputBytes int64  `json:"inputBytes"` // total bytes of input files, 0 if queued
+	ReadBytes  int64  `json:"readBytes"`  // bytes of input values read by merging
+	StartTime  int64  `json:"startTime,omitempty"`
+	Duration   int64  `json:"duration,omitempty"` // elapsed time(ms) of running job
+	ErrMsg     string `json:"errMsg,omitempty"`
+}
+
+// Progress returns the progress(0~1) of running job, estimated by bytes of read input values.
+func (j *CompactionJob) Progress() float64 {
+	if j.InputBytes <= 0 {
+		return 0
+	}
+	if j.ReadBytes >= j.InputBytes {
+		return 1
+	}
+	return float64(j.ReadBytes) / float64(j.InputBytes)
+}
+
+// CompactionJobs represents the compaction job list of storage nodes.
+type CompactionJobs []*CompactionJob
+
+// ToTable returns compaction job list as table if it has value, else return empty string.
+func (s CompactionJobs) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Database", "Store", "Family", "Type", "Status",
+		"Level", "Input Files", "Input Bytes", "Progress", "Duration", "Error"})
+	for _, j := range s {
+		if j.ErrMsg != "" {
+			writer.AppendRow(table.Row{j.Node, "", "", "", "", "", "", "", "", "", "", j.ErrMsg})
+			continue
+		}
+		progress, duration := "-", "-"
+		if j.Status == "running" {
+			progress = fmt.Sprintf("%.2f%%", j.Progress()*100)
+			duration = (time.Duration(j.Duration) * time.Millisecond).String()
+		}
+		writer.AppendRow(table.Row{j.Node, j.Database, j.Store, j.Family, j.Type, j.Status,
+			j.Level, j.InputFiles, ltoml.Size(j.InputBytes).String(), progress, duration, ""})
+	}
+	return len(s), writer.Render()
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactionStates_ToTable(t *testing.T) {
+	rows, str := CompactionStates{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	rows, str = CompactionStates{
+		{Node: "1.1.1.1:2080", Paused: true},
+		{Node: "1.1.1.2:2080", PausedDatabases: []string{"db1"}, Backlogs: []*CompactionBacklog{
+			{Database: "db1", Paused: true, Stores: 3, Level0Files: 10, PendingFamilies: 2},
+			{Database: "db2", Stores: 2, Level0Files: 1, Compacting: 1},
+		}},
+		{Node: "1.1.1.3:2080", ErrMsg: "connection refused"},
+	}.ToTable()
+	assert.Equal(t, 4, rows)
+	assert.Contains(t, str, "1.1.1.1:2080")
+	assert.Contains(t, str, "db2")
+	assert.Contains(t, str, "connection refused")
+}
+
+func TestCompactionJob_Progress(t *testing.T) {
+	assert.Zero(t, (&CompactionJob{}).Progress())
+	assert.Equal(t, 0.5, (&CompactionJob{InputBytes: 100, ReadBytes: 50}).Progress())
+	assert.Equal(t, 1.0, (&CompactionJob{InputBytes: 100, ReadBytes: 150}).Progress())
+}
+
+func TestCompactionJobs_ToTable(t *testing.T) {
+	rows, str := CompactionJobs{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	rows, str = CompactionJobs{
+		{Node: "1.1.1.1:2080", Database: "db", Store: "db/shard/1/segment/day/20221010", Family: "10",
+			Type: "merge", Status: "running", InputFiles: 4, InputBytes: 1024, ReadBytes: 256, Duration: 1500},
+		{Node: "1.1.1.1:2080", Database: "db", Store: "db/meta/tag", Family: "tag",
+			Type: "merge", Status: "queued", InputFiles: 4},
+		{Node: "1.1.1.3:2080", ErrMsg: "connection refused"},
+	}.ToTable()
+	assert.Equal(t, 3, rows)
+	assert.Contains(t, str, "25.00%")
+	assert.Contains(t, str, "1.5s")
+	assert.Contains(t, str, "1.0 KiB")
+	assert.Contains(t, str, "connection refused")
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/jedib0t/go-pretty/v6/table"
 
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 )
 
@@ -52,14 +53,103 @@ func (dbs Databases) ToTable() (rows int, tableStr string) {
 		return 0, ""
 	}
 	writer := NewTableFormatter()
-	writer.AppendHeader(table.Row{"Name", "Storage", "Desc"})
+	writer.AppendHeader(table.Row{"Name", "Storage", "Series", "Disk Usage", "Write Rate", "Last Flush", "Replication", "Desc"})
 	for i := range dbs {
 		r := dbs[i]
-		writer.AppendRow(table.Row{r.Name, r.Storage, r.Desc})
+		if r.Stats == nil {
+			writer.AppendRow(table.Row{r.Name, r.Storage, "-", "-", "-", "-", "-", r.Desc})
+			continue
+		}
+		writer.AppendRow(table.Row{
+			r.Name,
+			r.Storage,
+			r.Stats.NumOfSeries,
+			ltoml.Size(r.Stats.DiskUsage).String(),
+			fmt.Sprintf("%.2f/s", r.Stats.WriteRate),
+			formatStateTime(r.Stats.LastFlushTime),
+			r.Stats.ReplicationHealth(),
+			r.Desc,
+		})
 	}
 	return len(dbs), writer.Render()
 }
 
+// DatabaseStats represents the runtime statistics of database aggregated from live storage nodes.
+type DatabaseStats struct {
+	NumOfSeries          int64   `json:"numOfSeries"`          // series in memory databases(written recently)
+	DiskUsage            int64   `json:"diskUsage"`            // on-disk bytes of all replicas
+	WriteRate            float64 `json:"writeRate"`            // data points written per second
+	LastFlushTime        int64   `json:"lastFlushTime"`        // last flush time of data families
+	Replicators          int     `json:"replicators"`          // number of wal replicators
+	UnhealthyReplicators int     `json:"unhealthyReplicators"` // number of wal replicators not ready
+	ReplicaLag           int64   `json:"replicaLag"`           // pending wal messages of replicators
+}
+
+// NewDatabaseStats aggregates the runtime statistics of database based on the state of data families,
+// disk usage and wal replica state fetched from live storage nodes.
+// Series/write rate of a data family are counted once(max of replicas), because replicas hold the same data.
+func NewDatabaseStats(
+	families []DataFamilyState,
+	usages []*DatabaseDiskUsage,
+	replicas []FamilyLogReplicaState,
+) *DatabaseStats {
+	stats := &DatabaseStats{}
+	type familyStats struct {
+		numOfSeries int
+		writeRate   float64
+	}
+	replicaFamilies := make(map[string]*familyStats)
+	for idx := range families {
+		family := &families[idx]
+		key := family.ShardID.String() + "/" + family.FamilyTime
+		s, ok := replicaFamilies[key]
+		if !ok {
+			s = &familyStats{}
+			replicaFamilies[key] = s
+		}
+		if family.NumOfSeries > s.numOfSeries {
+			s.numOfSeries = family.NumOfSeries
+		}
+		if family.WriteRate > s.writeRate {
+			s.writeRate = family.WriteRate
+		}
+		if family.LastFlushTime > stats.LastFlushTime {
+			stats.LastFlushTime = family.LastFlushTime
+		}
+	}
+	for _, s := range replicaFamilies {
+		stats.NumOfSeries += int64(s.numOfSeries)
+		stats.WriteRate += s.writeRate
+	}
+	for _, usage := range usages {
+		stats.DiskUsage += usage.Total()
+	}
+	for idx := range replicas {
+		for _, replicator := range replicas[idx].Replicators {
+			stats.Replicators++
+			if replicator.State != ReplicatorReadyState {
+				stats.UnhealthyReplicators++
+			}
+			stats.ReplicaLag += replicator.Pending
+		}
+	}
+	return stats
+}
+
+// ReplicationHealth returns the summary of wal replication health.
+func (s *DatabaseStats) ReplicationHealth() string {
+	switch {
+	case s.Replicators == 0:
+		return "-"
+	case s.UnhealthyReplicators > 0:
+		return fmt.Sprintf("unhealthy(%d/%d)", s.UnhealthyReplicators, s.Replicators)
+	case s.ReplicaLag > 0:
+		return fmt.Sprintf("lag(%d)", s.ReplicaLag)
+	default:
+		return "healthy"
+	}
+}
+
 // ShardID represents type for shard id.
 type ShardID int
 
@@ -85,6 +175,19 @@ type Router struct {
 	Values   []string `json:"values" validate:"required"` // routing values
 	Broker   string   `json:"broker" validate:"required"` // target broker
 	Database string   `json:"database,omitempty"`         // target database
+	// replica group of router, routers in same replica group are replica clusters(hold same data),
+	// only one of them is queried; router without replica group is always queried.
+	ReplicaGroup string `json:"replicaGroup,omitempty"`
+	// routing weight between the routers in same replica group, 0 means no traffic(drain cluster), default 1
+	Weight *int `json:"weight,omitempty" validate:"omitempty,gte=0"`
+}
+
+// GetWeight returns the routing weight of router, returns 1 if weight not set.
+func (r *Router) GetWeight() int {
+	if r.Weight == nil {
+		return 1
+	}
+	return *r.Weight
 }
 
 // LogicDatabase defines database logic config, database can include multi-cluster.
@@ -92,6 +195,9 @@ type LogicDatabase struct {
 	Name    string   `json:"name" validate:"required"`    // database's name
 	Routers []Router `json:"routers" validate:"required"` // database router
 	Desc    string   `json:"desc,omitempty"`
+	// sticky routing, routes to the first available router of replica group in routers order(failover order),
+	// and keeps routing to it until it is unavailable; if false, routes by weight.
+	Sticky bool `json:"sticky,omitempty"`
 }
 
 // Database defines database config.
@@ -102,6 +208,20 @@ type Database struct {
 	ReplicaFactor int                    `json:"replicaFactor" validate:"gt=0"` // replica refactor
 	Option        *option.DatabaseOption `json:"option"`                        // time series database option
 	Desc          string                 `json:"desc,omitempty"`
+	Stats         *DatabaseStats         `json:"stats,omitempty"` // runtime statistics, only for listing databases
+	// label key of storage node's failure domain(like zone/rack), if set, replicas of a shard must be placed
+	// in different failure domains, else spread replicas across zones as far as possible.
+	FailureDomain string `json:"failureDomain,omitempty"`
+	// if true, brokers reject writes of database(queries still work), set by ALTER DATABASE x SET WRITE = OFF/ON.
+	WriteDisabled bool `json:"writeDisabled,omitempty"`
+}
+
+// GetFailureDomain returns the label key of failure domain for replica placement.
+func (db *Database) GetFailureDomain() string {
+	if db.FailureDomain == "" {
+		return NodeLabelZone
+	}
+	return db.FailureDomain
 }
 
 // String returns the database's description.
@@ -109,6 +229,9 @@ func (db *Database) String() string {
 	result := "create database " + db.Name + " with "
 	result += "shard " + fmt.Sprintf("%d", db.NumOfShard) + ", replica " + fmt.Sprintf("%d", db.ReplicaFactor)
 	result += ", intervals " + db.Option.Intervals.String()
+	if db.WriteDisabled {
+		result += ", write off"
+	}
 	return result
 }
 
@@ -51,6 +51,8 @@ func TestDatabase_String(t *testing.T) {
 			}},
 	}
 	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M]", database.String())
+	database.WriteDisabled = true
+	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M], write off", database.String())
 }
 
 func TestParseShardID(t *testing.T) {
@@ -63,9 +65,36 @@ func TestDatabases_ToTable(t *testing.T) {
 	rows, rs := Databases{}.ToTable()
 	assert.Zero(t, rows)
 	assert.Empty(t, rs)
-	rows, rs = Databases{{Name: "test"}}.ToTable()
+	rows, rs = Databases{{Name: "test"}, {Name: "stats", Stats: &DatabaseStats{Replicators: 1}}}.ToTable()
 	assert.NotEmpty(t, rs)
-	assert.Equal(t, rows, 1)
+	assert.Equal(t, rows, 2)
+}
+
+func TestNewDatabaseStats(t *testing.T) {
+	stats := NewDatabaseStats(
+		[]DataFamilyState{
+			{ShardID: 1, FamilyTime: "f1", NumOfSeries: 10, WriteRate: 5, LastFlushTime: 10},
+			{ShardID: 1, FamilyTime: "f1", NumOfSeries: 8, WriteRate: 6, LastFlushTime: 20}, // replica
+			{ShardID: 2, FamilyTime: "f1", NumOfSeries: 5, WriteRate: 1, LastFlushTime: 15},
+		},
+		[]*DatabaseDiskUsage{{Meta: 10}, {Meta: 20}},
+		[]FamilyLogReplicaState{
+			{ShardID: 1, Replicators: []ReplicaPeerState{{State: ReplicatorReadyState, Pending: 2}}},
+			{ShardID: 2, Replicators: []ReplicaPeerState{{State: ReplicatorReadyState}}},
+		},
+	)
+	assert.Equal(t, &DatabaseStats{
+		NumOfSeries:   15,
+		DiskUsage:     30,
+		WriteRate:     7,
+		LastFlushTime: 20,
+		Replicators:   2,
+		ReplicaLag:    2,
+	}, stats)
+	assert.Equal(t, "lag(2)", stats.ReplicationHealth())
+	assert.Equal(t, "-", (&DatabaseStats{}).ReplicationHealth())
+	assert.Equal(t, "healthy", (&DatabaseStats{Replicators: 1}).ReplicationHealth())
+	assert.Equal(t, "unhealthy(1/2)", (&DatabaseStats{Replicators: 2, UnhealthyReplicators: 1}).ReplicationHealth())
 }
 
 func TestReplica_Contain(t *testing.T) {
@@ -73,3 +102,19 @@ func TestReplica_Contain(t *testing.T) {
 	assert.True(t, replica.Contain(2))
 	assert.False(t, replica.Contain(4))
 }
+
+func TestRouter(t *testing.T) {
+	weight := 3
+	drain := 0
+	r1 := Router{Key: "region", Values: []string{"b", "a"}}
+	r2 := Router{Key: "region", Values: []string{"a", "b"}, Weight: &weight}
+	r3 := Router{Key: "region", Values: []string{"a", "b"}, Weight: &drain}
+	assert.Equal(t, 1, r1.GetWeight())
+	assert.Equal(t, 3, r2.GetWeight())
+	assert.Equal(t, 0, r3.GetWeight())
+}
+
+func TestDatabase_GetFailureDomain(t *testing.T) {
+	assert.Equal(t, NodeLabelZone, (&Database{}).GetFailureDomain())
+	assert.Equal(t, NodeLabelRack, (&Database{FailureDomain: NodeLabelRack}).GetFailureDomain())
+}
@@ -0,0 +1,116 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+// DatabaseDiskUsage represents the on-disk bytes of database in storage node.
+type DatabaseDiskUsage struct {
+	Node          string           `json:"node,omitempty"` // storage node which database belongs to
+	Database      string           `json:"database"`
+	Meta          int64            `json:"meta"` // metadata of database(namespace/metric/tag/field)
+	Shards        []ShardDiskUsage `json:"shards,omitempty"`
+	Quota         int64            `json:"quota,omitempty"` // disk quota of database, 0 means unlimited
+	QuotaExceeded bool             `json:"quotaExceeded,omitempty"`
+}
+
+// Total returns the total on-disk bytes of database.
+func (u *DatabaseDiskUsage) Total() int64 {
+	total := u.Meta
+	for i := range u.Shards {
+		total += u.Shards[i].Total()
+	}
+	return total
+}
+
+// ShardDiskUsage represents the on-disk bytes of shard.
+type ShardDiskUsage struct {
+	ShardID  ShardID           `json:"shardId"`
+	Data     int64             `json:"data"`  // sst files of data families
+	Index    int64             `json:"index"` // index and metadata of shard
+	WAL      int64             `json:"wal"`   // write ahead log of shard
+	Families []FamilyDiskUsage `json:"families,omitempty"`
+}
+
+// Total returns the total on-disk bytes of shard.
+func (u *ShardDiskUsage) Total() int64 {
+	return u.Data + u.Index + u.WAL
+}
+
+// FamilyDiskUsage represents the on-disk bytes of data family.
+type FamilyDiskUsage struct {
+	Interval string `json:"interval"` // interval type of segment(day/month/year)
+	Segment  string `json:"segment"`
+	Family   string `json:"family"`
+	Size     int64  `json:"size"`
+}
+
+// DiskUsages represents the disk usage list of databases.
+type DiskUsages []*DatabaseDiskUsage
+
+// ToTable returns disk usage list as table if it has value, else return empty string.
+func (u DiskUsages) ToTable() (rows int, tableStr string) {
+	if len(u) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Database", "Shard", "Data", "Index", "WAL", "Total", "Quota"})
+	for _, db := range u {
+		var data, index, wal int64
+		for i := range db.Shards {
+			shard := db.Shards[i]
+			writer.AppendRow(table.Row{
+				db.Node,
+				db.Database,
+				shard.ShardID,
+				ltoml.Size(shard.Data).String(),
+				ltoml.Size(shard.Index).String(),
+				ltoml.Size(shard.WAL).String(),
+				ltoml.Size(shard.Total()).String(),
+				"-",
+			})
+			data += shard.Data
+			index += shard.Index
+			wal += shard.WAL
+		}
+		quota := "-"
+		if db.Quota > 0 {
+			quota = ltoml.Size(db.Quota).String()
+			if db.QuotaExceeded {
+				quota = fmt.Sprintf("%s(exceeded)", quota)
+			}
+		}
+		writer.AppendRow(table.Row{
+			db.Node,
+			db.Database,
+			"total",
+			ltoml.Size(data).String(),
+			ltoml.Size(index + db.Meta).String(),
+			ltoml.Size(wal).String(),
+			ltoml.Size(db.Total()).String(),
+			quota,
+		})
+	}
+	return len(u), writer.Render()
+}
@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseDiskUsage_Total(t *testing.T) {
+	usage := &DatabaseDiskUsage{
+		Meta: 10,
+		Shards: []ShardDiskUsage{
+			{ShardID: 1, Data: 100, Index: 20, WAL: 5},
+			{ShardID: 2, Data: 50},
+		},
+	}
+	assert.Equal(t, int64(125), usage.Shards[0].Total())
+	assert.Equal(t, int64(185), usage.Total())
+}
+
+func TestDiskUsages_ToTable(t *testing.T) {
+	rows, rs := DiskUsages{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = DiskUsages{
+		{Node: "1.1.1.1:2891", Database: "db", Shards: []ShardDiskUsage{{ShardID: 1, Data: 100}}},
+		{Node: "1.1.1.1:2891", Database: "db2", Quota: 10, QuotaExceeded: true, Shards: []ShardDiskUsage{{ShardID: 1, Data: 100}}},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, rs)
+}
@@ -17,8 +17,62 @@
 
 package models
 
+import errorpkg "github.com/lindb/lindb/pkg/error"
+
 // ExecuteParam represents lin query language executor's param.
 type ExecuteParam struct {
 	Database string `form:"db" json:"db"`
 	SQL      string `form:"sql" json:"sql" binding:"required"`
+	// Priority represents the priority class of query(interactive/background/system), default interactive.
+	Priority string `form:"priority" json:"priority,omitempty"`
+	// Format represents the output format of result(table/vertical/csv/jsonl), default json.
+	Format string `form:"format" json:"format,omitempty"`
+}
+
+// ErrorResponse represents the structured error envelope of request,
+// client can implement retry/alert policies by the stable error code.
+type ErrorResponse struct {
+	Code      errorpkg.Code `json:"code"`
+	Message   string        `json:"message"`
+	Retryable bool          `json:"retryable"`
+	RequestID string        `json:"requestId,omitempty"`
+}
+
+// NewErrorResponse creates the error envelope of request by error.
+func NewErrorResponse(requestID string, err error) *ErrorResponse {
+	code := errorpkg.CodeOf(err)
+	return &ErrorResponse{
+		Code:      code,
+		Message:   err.Error(),
+		Retryable: code.Retryable(),
+		RequestID: requestID,
+	}
+}
+
+// Err returns the error with error code of envelope.
+func (e *ErrorResponse) Err() error {
+	return errorpkg.Errorf(e.Code, "%s", e.Message)
+}
+
+// StatementResult represents the execution result of one statement in batch.
+type StatementResult struct {
+	SQL    string         `json:"sql"`
+	Result interface{}    `json:"result,omitempty"`
+	Error  *ErrorResponse `json:"error,omitempty"`
+}
+
+// BatchResult represents the execution results of multiple statements in one request,
+// statements execute sequentially and stop at the first failure statement.
+type BatchResult struct {
+	Results []*StatementResult `json:"results"`
+}
+
+// Failure returns the error of failure statement, nil if all statements executed successfully.
+func (r *BatchResult) Failure() *ErrorResponse {
+	for _, rs := range r.Results {
+		if rs.Error != nil {
+			return rs.Error
+		}
+	}
+	return nil
 }
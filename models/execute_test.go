@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	errorpkg "github.com/lindb/lindb/pkg/error"
+)
+
+func TestNewErrorResponse(t *testing.T) {
+	resp := NewErrorResponse("1", constants.ErrNoLiveReplica)
+	assert.Equal(t, &ErrorResponse{
+		Code:      errorpkg.StorageUnavailable,
+		Message:   constants.ErrNoLiveReplica.Error(),
+		Retryable: true,
+		RequestID: "1",
+	}, resp)
+	err := resp.Err()
+	assert.EqualError(t, err, constants.ErrNoLiveReplica.Error())
+	assert.Equal(t, errorpkg.StorageUnavailable, errorpkg.CodeOf(err))
+}
+
+func TestBatchResult_Failure(t *testing.T) {
+	rs := &BatchResult{Results: []*StatementResult{{SQL: "use db"}}}
+	assert.Nil(t, rs.Failure())
+	failure := NewErrorResponse("1", constants.ErrNoLiveReplica)
+	rs.Results = append(rs.Results, &StatementResult{SQL: "select f from cpu", Error: failure})
+	assert.Equal(t, failure, rs.Failure())
+}
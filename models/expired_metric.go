@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// ExpiredMetric represents the metric which received no writes and no queries for the duration of expiry option.
+type ExpiredMetric struct {
+	Node       string `json:"node,omitempty"`
+	Namespace  string `json:"namespace"`
+	Metric     string `json:"metric"`
+	LastActive int64  `json:"lastActive"` // last time of metric written or queried
+	Removed    bool   `json:"removed"`    // false if only reported by dry-run policy
+}
+
+// ExpiredMetrics represents the report of unused metric expiry.
+type ExpiredMetrics []*ExpiredMetric
+
+// ToTable returns expired metric list as table if it has value, else return empty string.
+func (m ExpiredMetrics) ToTable() (rows int, tableStr string) {
+	if len(m) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Namespace", "Metric", "Last Active", "Removed"})
+	for _, metric := range m {
+		writer.AppendRow(table.Row{
+			metric.Node,
+			metric.Namespace,
+			metric.Metric,
+			timeutil.FormatTimestamp(metric.LastActive, timeutil.DataTimeFormat2),
+			metric.Removed,
+		})
+	}
+	return len(m), writer.Render()
+}
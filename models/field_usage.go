@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// FieldUsage represents the query-time access statistics of metric's field.
+type FieldUsage struct {
+	Namespace  string `json:"namespace"`
+	Metric     string `json:"metric"`
+	Field      string `json:"field"`
+	Type       string `json:"type"`
+	Count      uint64 `json:"count"`                // number of query accessed the field
+	LastAccess int64  `json:"lastAccess,omitempty"` // last time of query accessed the field
+}
+
+// FieldUsages represents the usage list of metric's fields.
+type FieldUsages []*FieldUsage
+
+// Merge merges the field usages from other node, sums the access counts and keeps the latest access time.
+func (u FieldUsages) Merge(other FieldUsages) FieldUsages {
+	for _, o := range other {
+		found := false
+		for _, usage := range u {
+			if usage.Namespace == o.Namespace && usage.Metric == o.Metric && usage.Field == o.Field {
+				usage.Count += o.Count
+				if o.LastAccess > usage.LastAccess {
+					usage.LastAccess = o.LastAccess
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			usage := *o
+			u = append(u, &usage)
+		}
+	}
+	return u
+}
+
+// ToTable returns field usage list as table if it has value, else return empty string.
+func (u FieldUsages) ToTable() (rows int, tableStr string) {
+	if len(u) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Namespace", "Metric", "Field", "Type", "Count", "Last Access"})
+	for _, usage := range u {
+		lastAccess := "-"
+		if usage.LastAccess > 0 {
+			lastAccess = timeutil.FormatTimestamp(usage.LastAccess, timeutil.DataTimeFormat2)
+		}
+		writer.AppendRow(table.Row{
+			usage.Namespace,
+			usage.Metric,
+			usage.Field,
+			usage.Type,
+			usage.Count,
+			lastAccess,
+		})
+	}
+	return len(u), writer.Render()
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldUsages_Merge(t *testing.T) {
+	usages := FieldUsages{}.Merge(FieldUsages{
+		{Namespace: "ns", Metric: "cpu", Field: "f1", Count: 1, LastAccess: 10},
+		{Namespace: "ns", Metric: "cpu", Field: "f2"},
+	})
+	usages = usages.Merge(FieldUsages{
+		{Namespace: "ns", Metric: "cpu", Field: "f1", Count: 2, LastAccess: 5},
+		{Namespace: "ns", Metric: "cpu", Field: "f2", Count: 3, LastAccess: 20},
+		{Namespace: "ns", Metric: "cpu", Field: "f3"},
+	})
+	assert.Len(t, usages, 3)
+	assert.Equal(t, uint64(3), usages[0].Count)
+	assert.Equal(t, int64(10), usages[0].LastAccess)
+	assert.Equal(t, uint64(3), usages[1].Count)
+	assert.Equal(t, int64(20), usages[1].LastAccess)
+	assert.Zero(t, usages[2].Count)
+}
+
+func TestFieldUsages_ToTable(t *testing.T) {
+	rows, rs := FieldUsages{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = FieldUsages{
+		{Namespace: "ns", Metric: "cpu", Field: "f1", Type: "sum", Count: 1, LastAccess: 10},
+		{Namespace: "ns", Metric: "cpu", Field: "f2", Type: "sum"},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, rs)
+}
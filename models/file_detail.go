@@ -0,0 +1,136 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+// blockSizeBounds represents the upper bounds of metric block size histogram.
+var blockSizeBounds = []int64{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, math.MaxInt64}
+
+// MetricBlockDetail represents the detail of metric's data block in data file.
+type MetricBlockDetail struct {
+	MetricID    uint32   `json:"metricId"`
+	NumOfSeries uint64   `json:"numOfSeries"`
+	Fields      []string `json:"fields"` // field metas, format: id(type)
+	SlotStart   uint16   `json:"slotStart"`
+	SlotEnd     uint16   `json:"slotEnd"`
+	BlockSize   int      `json:"blockSize"`
+}
+
+// BlockSizeBucket represents the bucket of metric block size histogram.
+type BlockSizeBucket struct {
+	UpperBound int64 `json:"upperBound"`
+	Count      int   `json:"count"`
+}
+
+// DataFileDetail represents the detail of data family's file, used for diagnosing suspicious file.
+type DataFileDetail struct {
+	Node        string              `json:"node,omitempty"` // storage node which file belongs to
+	File        string              `json:"file"`
+	Size        int64               `json:"size"`
+	NumOfSeries uint64              `json:"numOfSeries"`
+	SlotStart   uint16              `json:"slotStart"`
+	SlotEnd     uint16              `json:"slotEnd"`
+	Metrics     []MetricBlockDetail `json:"metrics"`
+	BlockSizes  []BlockSizeBucket   `json:"blockSizes"`
+}
+
+// NewDataFileDetail creates the detail of data file with empty block size histogram.
+func NewDataFileDetail(file string, size int64) *DataFileDetail {
+	detail := &DataFileDetail{File: file, Size: size}
+	for _, bound := range blockSizeBounds {
+		detail.BlockSizes = append(detail.BlockSizes, BlockSizeBucket{UpperBound: bound})
+	}
+	return detail
+}
+
+// AddMetricBlock adds the detail of metric's data block, then updates the statistics of file.
+func (d *DataFileDetail) AddMetricBlock(block MetricBlockDetail) {
+	if len(d.Metrics) == 0 || block.SlotStart < d.SlotStart {
+		d.SlotStart = block.SlotStart
+	}
+	if block.SlotEnd > d.SlotEnd {
+		d.SlotEnd = block.SlotEnd
+	}
+	d.NumOfSeries += block.NumOfSeries
+	d.Metrics = append(d.Metrics, block)
+	for idx := range d.BlockSizes {
+		if int64(block.BlockSize) <= d.BlockSizes[idx].UpperBound {
+			d.BlockSizes[idx].Count++
+			return
+		}
+	}
+}
+
+// DataFileDetails represents the detail list of data file from replicas.
+type DataFileDetails []*DataFileDetail
+
+// ToTable returns the detail list of data file as table if it has value, else return empty string.
+func (d DataFileDetails) ToTable() (rows int, tableStr string) {
+	if len(d) == 0 {
+		return 0, ""
+	}
+	var sb strings.Builder
+	for _, detail := range d {
+		summary := NewTableFormatter()
+		summary.AppendHeader(table.Row{"Node", "File", "Size", "Metrics", "Series", "Slot Range"})
+		summary.AppendRow(table.Row{
+			detail.Node, detail.File, ltoml.Size(detail.Size).String(), len(detail.Metrics), detail.NumOfSeries,
+			slotRangeString(detail.SlotStart, detail.SlotEnd),
+		})
+		sb.WriteString(summary.Render())
+		sb.WriteString("\n")
+
+		metrics := NewTableFormatter()
+		metrics.AppendHeader(table.Row{"Metric ID", "Series", "Slot Range", "Block Size", "Fields"})
+		for _, m := range detail.Metrics {
+			metrics.AppendRow(table.Row{
+				m.MetricID, m.NumOfSeries, slotRangeString(m.SlotStart, m.SlotEnd),
+				ltoml.Size(m.BlockSize).String(), strings.Join(m.Fields, ","),
+			})
+		}
+		sb.WriteString(metrics.Render())
+		sb.WriteString("\n")
+
+		histogram := NewTableFormatter()
+		histogram.AppendHeader(table.Row{"Block Size", "Count"})
+		for idx, bucket := range detail.BlockSizes {
+			bound := "<= " + ltoml.Size(bucket.UpperBound).String()
+			if bucket.UpperBound == math.MaxInt64 && idx > 0 {
+				bound = "> " + ltoml.Size(detail.BlockSizes[idx-1].UpperBound).String()
+			}
+			histogram.AppendRow(table.Row{bound, bucket.Count})
+		}
+		sb.WriteString(histogram.Render())
+		sb.WriteString("\n")
+	}
+	return len(d), strings.TrimSuffix(sb.String(), "\n")
+}
+
+// slotRangeString returns the string value of slot range.
+func slotRangeString(start, end uint16) string {
+	return fmt.Sprintf("[%d, %d]", start, end)
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataFileDetail_AddMetricBlock(t *testing.T) {
+	detail := NewDataFileDetail("000001.sst", 1024)
+	detail.AddMetricBlock(MetricBlockDetail{MetricID: 1, NumOfSeries: 10, SlotStart: 5, SlotEnd: 10, BlockSize: 100})
+	detail.AddMetricBlock(MetricBlockDetail{MetricID: 2, NumOfSeries: 5, SlotStart: 2, SlotEnd: 8, BlockSize: 2048})
+	detail.AddMetricBlock(MetricBlockDetail{MetricID: 3, NumOfSeries: 1, SlotStart: 3, SlotEnd: 20, BlockSize: 2 << 20})
+	assert.Equal(t, uint64(16), detail.NumOfSeries)
+	assert.Equal(t, uint16(2), detail.SlotStart)
+	assert.Equal(t, uint16(20), detail.SlotEnd)
+	assert.Len(t, detail.Metrics, 3)
+	assert.Equal(t, BlockSizeBucket{UpperBound: 1 << 10, Count: 1}, detail.BlockSizes[0])
+	assert.Equal(t, BlockSizeBucket{UpperBound: 4 << 10, Count: 1}, detail.BlockSizes[1])
+	assert.Equal(t, BlockSizeBucket{UpperBound: math.MaxInt64, Count: 1}, detail.BlockSizes[len(detail.BlockSizes)-1])
+}
+
+func TestDataFileDetails_ToTable(t *testing.T) {
+	rows, str := DataFileDetails{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	detail := NewDataFileDetail("000001.sst", 1024)
+	detail.Node = "1.1.1.1:2080"
+	detail.AddMetricBlock(MetricBlockDetail{MetricID: 1, NumOfSeries: 10, Fields: []string{"1(sum)", "2(max)"}, BlockSize: 100})
+	rows, str = DataFileDetails{detail}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, str, "1.1.1.1:2080")
+	assert.Contains(t, str, "1(sum),2(max)")
+	assert.Contains(t, str, "> 1.0 MiB")
+}
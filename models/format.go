@@ -18,10 +18,57 @@
 package models
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+// OutputFormat represents the output format of result.
+type OutputFormat string
+
+const (
+	// TableFormat formats result as wide table, one row per line.
+	TableFormat OutputFormat = "table"
+	// VerticalFormat formats result as vertical list, one column per line.
+	VerticalFormat OutputFormat = "vertical"
+	// CSVFormat formats result as csv with header.
+	CSVFormat OutputFormat = "csv"
+	// JSONLFormat formats result as json lines, one json object per row.
+	JSONLFormat OutputFormat = "jsonl"
 )
 
+// ParseOutputFormat parses output format by name(case-insensitive), wide is alias of table.
+func ParseOutputFormat(name string) (OutputFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", string(TableFormat), "wide":
+		return TableFormat, nil
+	case string(VerticalFormat):
+		return VerticalFormat, nil
+	case string(CSVFormat):
+		return CSVFormat, nil
+	case string(JSONLFormat):
+		return JSONLFormat, nil
+	default:
+		return "", fmt.Errorf("unknown output format: %s", name)
+	}
+}
+
+// ContentType returns the http content type of output format.
+func (f OutputFormat) ContentType() string {
+	switch f {
+	case CSVFormat:
+		return "text/csv; charset=utf-8"
+	case JSONLFormat:
+		return "application/x-ndjson; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
 // TableFormatter represents table formatter for displaying result in terminal.
 type TableFormatter interface {
 	// ToTable returns string value/row size for displaying result in terminal.
@@ -36,3 +83,92 @@ func NewTableFormatter() table.Writer {
 	writer.SetStyle(style)
 	return writer
 }
+
+// TableRowsFormatter represents the formatter which returns the header and rows of table,
+// so that the result can be formatted as vertical/csv/json lines besides table.
+type TableRowsFormatter interface {
+	TableFormatter
+	// TableRows returns the header and rows of table, returns empty header if not supported.
+	TableRows() (header table.Row, rows []table.Row)
+}
+
+// FormatTable returns the result of formatter in given output format,
+// falls back to table format if formatter cannot return the rows of table.
+func FormatTable(formatter TableFormatter, format OutputFormat) (rows int, result string) {
+	rowsFormatter, ok := formatter.(TableRowsFormatter)
+	if !ok || format == TableFormat || format == "" {
+		return formatter.ToTable()
+	}
+	header, tableRows := rowsFormatter.TableRows()
+	if len(header) == 0 {
+		return formatter.ToTable()
+	}
+	switch format {
+	case VerticalFormat:
+		return len(tableRows), formatVertical(header, tableRows)
+	case CSVFormat:
+		writer := NewTableFormatter()
+		writer.AppendHeader(header)
+		writer.AppendRows(tableRows)
+		return len(tableRows), writer.RenderCSV()
+	case JSONLFormat:
+		return len(tableRows), formatJSONLines(header, tableRows)
+	default:
+		return formatter.ToTable()
+	}
+}
+
+// formatVertical formats rows as vertical list, one column per line.
+func formatVertical(header table.Row, rows []table.Row) string {
+	width := 0
+	for _, col := range header {
+		if w := len(fmt.Sprint(col)); w > width {
+			width = w
+		}
+	}
+	var sb strings.Builder
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("*************************** %d. row ***************************", i+1))
+		for j, col := range header {
+			var value interface{}
+			if j < len(row) {
+				value = row[j]
+			}
+			sb.WriteString(fmt.Sprintf("\n%*s: %v", width, col, value))
+		}
+	}
+	return sb.String()
+}
+
+// formatJSONLines formats rows as json lines, keeps the order of columns.
+func formatJSONLines(header table.Row, rows []table.Row) string {
+	var sb strings.Builder
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("{")
+		for j, col := range header {
+			if j > 0 {
+				sb.WriteString(",")
+			}
+			var value interface{}
+			if j < len(row) {
+				value = row[j]
+			}
+			sb.Write(encoding.JSONMarshal(fmt.Sprint(col)))
+			sb.WriteString(":")
+			data := encoding.JSONMarshal(value)
+			if len(data) == 0 {
+				// value cannot be encoded, such as NaN
+				data = []byte("null")
+			}
+			sb.Write(data)
+		}
+		sb.WriteString("}")
+	}
+	return sb.String()
+}
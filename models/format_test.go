@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	cases := map[string]OutputFormat{
+		"":         TableFormat,
+		"Wide":     TableFormat,
+		"table":    TableFormat,
+		"VERTICAL": VerticalFormat,
+		"csv":      CSVFormat,
+		" jsonl ":  JSONLFormat,
+	}
+	for name, format := range cases {
+		f, err := ParseOutputFormat(name)
+		assert.NoError(t, err)
+		assert.Equal(t, format, f)
+	}
+	_, err := ParseOutputFormat("xml")
+	assert.Error(t, err)
+
+	assert.Equal(t, "text/plain; charset=utf-8", VerticalFormat.ContentType())
+	assert.Equal(t, "text/csv; charset=utf-8", CSVFormat.ContentType())
+	assert.Equal(t, "application/x-ndjson; charset=utf-8", JSONLFormat.ContentType())
+}
+
+func TestFormatTable(t *testing.T) {
+	rs := &ResultSet{
+		GroupBy: []string{"host"},
+		Fields:  []string{"usage", "load"},
+		Series: []*Series{{
+			Tags:   map[string]string{"host": "a"},
+			Fields: map[string]map[int64]float64{"usage": {0: 1.5}, "load": {0: math.NaN()}},
+		}, {
+			Tags:   map[string]string{"host": "b"},
+			Fields: map[string]map[int64]float64{"usage": {0: 2}},
+		}},
+	}
+	ts := timeutil.FormatTimestamp(0, timeutil.DataTimeFormat2)
+	rows, result := FormatTable(rs, CSVFormat)
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, "host,timestamp,usage,load\na,"+ts+",1.5,NaN\nb,"+ts+",2,0", result)
+
+	rows, result = FormatTable(rs, JSONLFormat)
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, `{"host":"a","timestamp":"`+ts+`","usage":1.5,"load":null}`+"\n"+
+		`{"host":"b","timestamp":"`+ts+`","usage":2,"load":0}`, result)
+
+	rows, result = FormatTable(rs, VerticalFormat)
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, "*************************** 1. row ***************************\n"+
+		"     host: a\ntimestamp: "+ts+"\n    usage: 1.5\n     load: NaN\n"+
+		"*************************** 2. row ***************************\n"+
+		"     host: b\ntimestamp: "+ts+"\n    usage: 2\n     load: 0", result)
+
+	rows, result = FormatTable(rs, TableFormat)
+	expectRows, expectResult := rs.ToTable()
+	assert.Equal(t, expectRows, rows)
+	assert.Equal(t, expectResult, result)
+	// unknown format
+	_, result = FormatTable(rs, "xml")
+	assert.Equal(t, expectResult, result)
+	// not support format rows, fallback to table
+	master := &Master{Node: &StatelessNode{}}
+	_, result = FormatTable(master, CSVFormat)
+	_, expectResult = master.ToTable()
+	assert.Equal(t, expectResult, result)
+	// no series
+	rows, result = FormatTable(&ResultSet{}, CSVFormat)
+	assert.Zero(t, rows)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,105 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// GrafanaQueryRequest represents the query request of grafana json datasource.
+type GrafanaQueryRequest struct {
+	Range         GrafanaRange     `json:"range"`
+	IntervalMs    int64            `json:"intervalMs,omitempty"`
+	MaxDataPoints int64            `json:"maxDataPoints,omitempty"`
+	Targets       []*GrafanaTarget `json:"targets"`
+}
+
+// GrafanaRange represents the time range of grafana query(RFC3339 format).
+type GrafanaRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GrafanaTarget represents the query target of grafana panel, target is lin query language.
+type GrafanaTarget struct {
+	RefID   string                 `json:"refId,omitempty"`
+	Target  string                 `json:"target"`
+	Type    string                 `json:"type,omitempty"` // timeserie(default)/table
+	Hide    bool                   `json:"hide,omitempty"`
+	Payload map[string]interface{} `json:"payload,omitempty"`
+}
+
+// GrafanaSearchRequest represents the metric search request of grafana json datasource.
+type GrafanaSearchRequest struct {
+	Target  string                 `json:"target"`
+	Payload map[string]interface{} `json:"payload,omitempty"`
+}
+
+// GrafanaTimeSeries represents the time series response of grafana json datasource.
+type GrafanaTimeSeries struct {
+	Target     string       `json:"target"`
+	RefID      string       `json:"refId,omitempty"`
+	DataPoints [][2]float64 `json:"datapoints"` // [value, timestamp(ms)]
+}
+
+// GrafanaTable represents the table response of grafana json datasource.
+type GrafanaTable struct {
+	Type    string           `json:"type"`
+	RefID   string           `json:"refId,omitempty"`
+	Columns []*GrafanaColumn `json:"columns"`
+	Rows    [][]interface{}  `json:"rows"`
+}
+
+// GrafanaColumn represents the column of grafana table.
+type GrafanaColumn struct {
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+// GrafanaAnnotationRequest represents the annotation query request of grafana json datasource.
+type GrafanaAnnotationRequest struct {
+	Range      GrafanaRange            `json:"range"`
+	Annotation GrafanaAnnotationTarget `json:"annotation"`
+}
+
+// GrafanaAnnotationTarget represents the annotation query of grafana dashboard,
+// query is lin query language with optional threshold clause(select ... threshold > 90).
+type GrafanaAnnotationTarget struct {
+	Name    string                 `json:"name"`
+	Query   string                 `json:"query"`
+	Enable  bool                   `json:"enable"`
+	Payload map[string]interface{} `json:"payload,omitempty"`
+}
+
+// GrafanaAnnotation represents the annotation(event or time region) of grafana dashboard.
+type GrafanaAnnotation struct {
+	Time    int64    `json:"time"`
+	TimeEnd int64    `json:"timeEnd,omitempty"`
+	Title   string   `json:"title"`
+	Text    string   `json:"text"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// GrafanaVariableRequest represents the variable query request of grafana json datasource,
+// target of payload is metadata statement of lin query language(show tag values ...).
+type GrafanaVariableRequest struct {
+	Range   GrafanaRange           `json:"range"`
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// GrafanaVariableValue represents the value of grafana template variable.
+type GrafanaVariableValue struct {
+	Text  string `json:"__text"`
+	Value string `json:"__value"`
+}
@@ -0,0 +1,70 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// IndexRebuildStatus represents the status of shard's index rebuild job.
+type IndexRebuildStatus string
+
+const (
+	// IndexRebuildRunning represents the rebuild job is running.
+	IndexRebuildRunning IndexRebuildStatus = "running"
+	// IndexRebuildCompleted represents the rebuild job completed successfully.
+	IndexRebuildCompleted IndexRebuildStatus = "completed"
+	// IndexRebuildFailed represents the rebuild job failed.
+	IndexRebuildFailed IndexRebuildStatus = "failed"
+)
+
+// IndexRebuildPhase represents the phase of shard's index rebuild job.
+type IndexRebuildPhase string
+
+const (
+	// IndexRebuildFlushMemory represents flushing memory index before rebuild.
+	IndexRebuildFlushMemory IndexRebuildPhase = "flushMemoryIndex"
+	// IndexRebuildScanDataFiles represents scanning data files for max series id of each metric.
+	IndexRebuildScanDataFiles IndexRebuildPhase = "scanDataFiles"
+	// IndexRebuildInvertedIndex represents rebuilding inverted index from forward index.
+	IndexRebuildInvertedIndex IndexRebuildPhase = "rebuildInvertedIndex"
+	// IndexRebuildDone represents rebuild job finished.
+	IndexRebuildDone IndexRebuildPhase = "done"
+)
+
+// IndexRebuildState represents the progress of shard's index rebuild job.
+type IndexRebuildState struct {
+	Database     string             `json:"database"`
+	ShardID      ShardID            `json:"shardId"`
+	Status       IndexRebuildStatus `json:"status"`
+	Phase        IndexRebuildPhase  `json:"phase"`
+	TotalFiles   int                `json:"totalFiles"`   // num. of data files need to scan
+	ScannedFiles int                `json:"scannedFiles"` // num. of data files scanned
+	Metrics      int                `json:"metrics"`      // num. of metrics which series sequence checked
+	TagKeys      int                `json:"tagKeys"`      // num. of tag keys which inverted index rebuilt
+	StartTime    int64              `json:"startTime"`
+	EndTime      int64              `json:"endTime,omitempty"`
+	ErrMsg       string             `json:"errMsg,omitempty"`
+}
+
+// Progress returns the progress(percent) of scanning data files.
+func (s *IndexRebuildState) Progress() float64 {
+	if s.TotalFiles == 0 {
+		if s.Status == IndexRebuildCompleted {
+			return 100
+		}
+		return 0
+	}
+	return float64(s.ScannedFiles) * 100 / float64(s.TotalFiles)
+}
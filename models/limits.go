@@ -19,11 +19,15 @@ package models
 
 import (
 	"fmt"
+	"strings"
 
 	commonconstants "github.com/lindb/common/constants"
 	commonseries "github.com/lindb/common/series"
 )
 
+// seriesLimitWildcard represents the wildcard of prefix pattern for metrics' series limit.
+const seriesLimitWildcard = "*"
+
 // Limits represents all the limit for database level; can be used to describe global
 // default limits, or per-database limits vis toml config.
 type Limits struct {
@@ -38,11 +42,17 @@ type Limits struct {
 	MaxTagValueLength   int    `toml:"max-tag-value-length"`
 	MaxTagsPerMetric    int    `toml:"max-tags-per-metric"`
 	MaxSeriesPerMetric  uint32 `toml:"max-series-per-metric"`
-	// max series limit for metric
+	// max series limit for metric, or for metrics matched by prefix pattern(e.g. namespace|*)
 	Metrics map[string]uint32 `toml:"metrics"`
+	// sanitization rules of namespace/metric/field names
+	Sanitize SanitizeRules `toml:"sanitize"`
 
 	// Read Limits
 	MaxSeriesPerQuery int `toml:"max-series-per-query"`
+
+	// Rollup limits
+	// metrics which are excluded from rollup and only keep raw data(metric or namespace|metric)
+	RawOnlyMetrics []string `toml:"raw-only-metrics"`
 }
 
 // NewDefaultLimits creates a default limits.
@@ -60,8 +70,11 @@ func NewDefaultLimits() *Limits {
 		MaxTagsPerMetric:    32,
 		MaxSeriesPerMetric:  200000,
 		Metrics:             make(map[string]uint32),
+		Sanitize:            NewDefaultSanitizeRules(),
 		// Read limits
 		MaxSeriesPerQuery: 200000,
+		// Rollup limits
+		RawOnlyMetrics: []string{},
 	}
 }
 
@@ -156,10 +169,20 @@ max-tag-value-length = %d
 ## Default: %d
 max-series-per-query = %d
 
+## Metrics which are excluded from rollup compaction and only keep raw data(e.g. billing counters),
+## the data of these metrics is only queryable from the raw(smallest) interval.
+## Example: ["billing.count", "namespace|billing.amount"]
+raw-only-metrics = %s
+
+## Sanitization rules of namespace/metric/field names when ingesting.
+[sanitize]%s
 ## Maximum number of active series for special metric.
 ## Must be the last limit configure item.
 ## Example: "system.cpu" = 100000
 ## Example: "namespace|system.cpu" = 100000
+## Prefix pattern ends with "*" matches metrics by longest prefix, exact metric takes precedence.
+## Example: "namespace|*" = 1000000
+## Example: "namespace|system.*" = 500000
 [metrics]
 %s
 		`,
@@ -185,6 +208,8 @@ max-series-per-query = %d
 		l.MaxTagValueLength,
 		l.MaxSeriesPerQuery,
 		l.MaxSeriesPerQuery,
+		l.rawOnlyMetricsTOML(),
+		l.Sanitize.TOML(),
 		l.metricsTOML(),
 	)
 }
@@ -198,18 +223,82 @@ func (l *Limits) metricsTOML() string {
 	return rs
 }
 
+// rawOnlyMetricsTOML returns limits' raw-only metrics as toml array.
+func (l *Limits) rawOnlyMetricsTOML() string {
+	metrics := make([]string, len(l.RawOnlyMetrics))
+	for idx, metric := range l.RawOnlyMetrics {
+		metrics[idx] = fmt.Sprintf("%q", metric)
+	}
+	return "[" + strings.Join(metrics, ", ") + "]"
+}
+
+// IsRawOnlyMetric returns if the metric is excluded from rollup and only keeps raw data.
+func (l *Limits) IsRawOnlyMetric(namespace, metricName string) bool {
+	for _, key := range l.RawOnlyMetrics {
+		ns, name := splitMetricKey(key)
+		if ns == namespace && name == metricName {
+			return true
+		}
+	}
+	return false
+}
+
+// RangeRawOnlyMetrics calls fn for each raw-only metric sequentially.
+func (l *Limits) RangeRawOnlyMetrics(fn func(namespace, metricName string)) {
+	for _, key := range l.RawOnlyMetrics {
+		fn(splitMetricKey(key))
+	}
+}
+
+// splitMetricKey splits the metric key(metric or namespace|metric) into namespace and metric name.
+func splitMetricKey(key string) (namespace, metricName string) {
+	if idx := strings.Index(key, "|"); idx >= 0 {
+		return key[:idx], key[idx+1:]
+	}
+	return commonconstants.DefaultNamespace, key
+}
+
+// Validate checks if the limits are valid.
+func (l *Limits) Validate() error {
+	for key := range l.Metrics {
+		if idx := strings.Index(key, seriesLimitWildcard); idx >= 0 && idx != len(key)-1 {
+			return fmt.Errorf("wildcard of metrics limit must be the last char, but got: %q", key)
+		}
+	}
+	return l.Sanitize.Validate()
+}
+
 // GetSeriesLimit returns the limit by given namespace/metric name.
 func (l *Limits) GetSeriesLimit(namespace, metricName string) uint32 {
+	limit, _ := l.MatchSeriesLimit(namespace, metricName)
+	return limit
+}
+
+// MatchSeriesLimit returns the limit and the matched rule by given namespace/metric name,
+// matches the exact metric first, then the prefix pattern with the longest prefix,
+// returns max-series-per-metric if nothing matched.
+func (l *Limits) MatchSeriesLimit(namespace, metricName string) (limit uint32, rule string) {
+	limit, rule = l.MaxSeriesPerMetric, "max-series-per-metric"
 	if len(l.Metrics) == 0 {
-		return l.MaxSeriesPerMetric
+		return
 	}
 	key := metricName
 	if namespace != commonconstants.DefaultNamespace {
 		key = commonseries.JoinNamespaceMetric(namespace, metricName)
 	}
-	limit, ok := l.Metrics[key]
-	if ok {
-		return limit
+	if v, ok := l.Metrics[key]; ok {
+		return v, key
+	}
+	prefixLen := -1
+	for pattern, v := range l.Metrics {
+		if !strings.HasSuffix(pattern, seriesLimitWildcard) {
+			continue
+		}
+		prefix := pattern[:len(pattern)-1]
+		if len(prefix) > prefixLen && strings.HasPrefix(key, prefix) {
+			prefixLen = len(prefix)
+			limit, rule = v, pattern
+		}
 	}
-	return l.MaxSeriesPerMetric
+	return
 }
@@ -0,0 +1,65 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// LimitsRevision returns the revision of database's limits(checksum of limits config),
+// returns empty string if limits not set(default limits).
+func LimitsRevision(limits []byte) string {
+	if len(limits) == 0 {
+		return ""
+	}
+	return strconv.FormatUint(xxhash.Sum64(limits), 16)
+}
+
+// LimitsState represents the state of database's limits applied on node.
+type LimitsState struct {
+	Node      string `json:"node,omitempty"`
+	Role      string `json:"role,omitempty"`
+	Revision  string `json:"revision,omitempty"`  // revision of applied limits, empty means default limits
+	AppliedAt int64  `json:"appliedAt,omitempty"` // timestamp of limits applied
+	Applied   bool   `json:"applied"`             // if the latest limits applied
+	ErrMsg    string `json:"errMsg,omitempty"`
+}
+
+// LimitsStates represents the limits applied state list of nodes.
+type LimitsStates []*LimitsState
+
+// ToTable returns the limits applied state list as table if it has value, else return empty string.
+func (s LimitsStates) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Role", "Revision", "Applied At", "Applied", "Error"})
+	for _, r := range s {
+		appliedAt := ""
+		if r.AppliedAt > 0 {
+			appliedAt = time.UnixMilli(r.AppliedAt).Format(time.RFC3339)
+		}
+		writer.AppendRow(table.Row{r.Node, r.Role, r.Revision, appliedAt, r.Applied, r.ErrMsg})
+	}
+	return len(s), writer.Render()
+}
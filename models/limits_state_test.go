@@ -0,0 +1,44 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsRevision(t *testing.T) {
+	assert.Empty(t, LimitsRevision(nil))
+	assert.NotEmpty(t, LimitsRevision([]byte("max-metrics=10")))
+	assert.Equal(t, LimitsRevision([]byte("max-metrics=10")), LimitsRevision([]byte("max-metrics=10")))
+	assert.NotEqual(t, LimitsRevision([]byte("max-metrics=10")), LimitsRevision([]byte("max-metrics=20")))
+}
+
+func TestLimitsStates_ToTable(t *testing.T) {
+	rows, str := LimitsStates{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	rows, str = LimitsStates{
+		{Node: "1.1.1.1:9000", Role: "broker", Revision: "abc", AppliedAt: 1, Applied: true},
+		{Node: "1.1.1.2:9000", Role: "storage", ErrMsg: "err"},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, str)
+}
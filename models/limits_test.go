@@ -46,6 +46,55 @@ func TestLimits_GetSeriesLimits(t *testing.T) {
 	assert.Equal(t, uint32(10), l.GetSeriesLimit(ns, name))
 	assert.Equal(t, uint32(100), l.GetSeriesLimit("default-ns", name))
 	assert.Equal(t, l.MaxSeriesPerMetric, l.GetSeriesLimit(ns, "test"))
+
+	// prefix pattern
+	l.Metrics["ns|*"] = 1000
+	l.Metrics["ns|system.*"] = 500
+	l.Metrics["system.*"] = 50
+	assert.Equal(t, uint32(10), l.GetSeriesLimit(ns, name))
+	assert.Equal(t, uint32(1000), l.GetSeriesLimit(ns, "test"))
+	assert.Equal(t, uint32(500), l.GetSeriesLimit(ns, "system.cpu"))
+	assert.Equal(t, uint32(50), l.GetSeriesLimit("default-ns", "system.cpu"))
+	assert.Equal(t, l.MaxSeriesPerMetric, l.GetSeriesLimit("other", "system.cpu"))
+	limit, rule := l.MatchSeriesLimit(ns, "system.mem")
+	assert.Equal(t, uint32(500), limit)
+	assert.Equal(t, "ns|system.*", rule)
+	limit, rule = l.MatchSeriesLimit("other", "test")
+	assert.Equal(t, l.MaxSeriesPerMetric, limit)
+	assert.Equal(t, "max-series-per-metric", rule)
+}
+
+func TestLimits_Validate(t *testing.T) {
+	l := NewDefaultLimits()
+	assert.NoError(t, l.Validate())
+	l.Metrics["ns|*"] = 1000
+	assert.NoError(t, l.Validate())
+	l.Metrics["ns|*.cpu"] = 1000
+	assert.Error(t, l.Validate())
+	delete(l.Metrics, "ns|*.cpu")
+	l.Sanitize.CaseFolding = "title"
+	assert.Error(t, l.Validate())
+}
+
+func TestLimits_RawOnlyMetrics(t *testing.T) {
+	l := NewDefaultLimits()
+	assert.False(t, l.IsRawOnlyMetric("default-ns", "billing"))
+	l.RawOnlyMetrics = []string{"billing", "ns|billing.amount"}
+	assert.True(t, l.IsRawOnlyMetric("default-ns", "billing"))
+	assert.False(t, l.IsRawOnlyMetric("ns", "billing"))
+	assert.True(t, l.IsRawOnlyMetric("ns", "billing.amount"))
+	assert.False(t, l.IsRawOnlyMetric("default-ns", "billing.amount"))
+
+	var metrics []string
+	l.RangeRawOnlyMetrics(func(namespace, metricName string) {
+		metrics = append(metrics, namespace+":"+metricName)
+	})
+	assert.Equal(t, []string{"default-ns:billing", "ns:billing.amount"}, metrics)
+
+	cfg := &Limits{}
+	_, err := toml.Decode(l.TOML(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, l.RawOnlyMetrics, cfg.RawOnlyMetrics)
 }
 
 func TestLimits_Disable(t *testing.T) {
@@ -82,3 +131,15 @@ func TestLimits_Disable(t *testing.T) {
 	l.MaxSeriesPerQuery = 0
 	assert.False(t, l.EnableSeriesCheckForQuery())
 }
+
+func TestLimits_Sanitize(t *testing.T) {
+	l := NewDefaultLimits()
+	l.Sanitize.CharMap["-"] = "_"
+	l.Sanitize.CharMap[" "] = ""
+	l.Sanitize.CaseFolding = LowerCase
+	l.Sanitize.LengthPolicy = TruncateLongName
+	cfg := &Limits{}
+	_, err := toml.Decode(l.TOML(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, l, cfg)
+}
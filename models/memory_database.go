@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// MemoryDatabaseStates represents the data family's memory database state of storage nodes(node => family states).
+type MemoryDatabaseStates map[string][]DataFamilyState
+
+// ToTable returns memory database state list as table if it has value, else return empty string.
+func (s MemoryDatabaseStates) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	nodes := make([]string, 0, len(s))
+	for node := range s {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Shard", "Family", "Mutable", "Immutable", "Total", "Metrics", "Series",
+		"Flushing", "Flush ETA", "Last Flush", "Buffers", "GC", "GC Buffers", "Last GC"})
+	for _, node := range nodes {
+		families := s[node]
+		sort.SliceStable(families, func(i, j int) bool {
+			if families[i].ShardID == families[j].ShardID {
+				return families[i].FamilyTime < families[j].FamilyTime
+			}
+			return families[i].ShardID < families[j].ShardID
+		})
+		for idx := range families {
+			family := families[idx]
+			writer.AppendRow(table.Row{
+				node,
+				family.ShardID,
+				family.FamilyTime,
+				ltoml.Size(family.MutableMemSize).String(),
+				ltoml.Size(family.ImmutableMemSize).String(),
+				ltoml.Size(family.MemSize()).String(),
+				family.NumOfMetrics,
+				family.NumOfSeries,
+				family.Flushing,
+				family.FlushETA.String(),
+				formatStateTime(family.LastFlushTime),
+				family.WriteBuffer.NumOfBuffers,
+				family.WriteBuffer.GCCount,
+				family.WriteBuffer.GCBuffers,
+				formatStateTime(family.WriteBuffer.LastGCTime),
+			})
+			rows++
+		}
+	}
+	if rows == 0 {
+		return 0, ""
+	}
+	return rows, writer.Render()
+}
+
+// formatStateTime formats timestamp of state, returns "-" if not set.
+func formatStateTime(timestamp int64) string {
+	if timestamp <= 0 {
+		return "-"
+	}
+	return timeutil.FormatTimestamp(timestamp, timeutil.DataTimeFormat2)
+}
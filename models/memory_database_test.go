@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryDatabaseStates_ToTable(t *testing.T) {
+	rows, rs := MemoryDatabaseStates{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = MemoryDatabaseStates{"1.1.1.1:9000": nil}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = MemoryDatabaseStates{
+		"1.1.1.2:9000": {{ShardID: 1, FamilyTime: "20220101 10:00:00"}},
+		"1.1.1.1:9000": {
+			{ShardID: 2, FamilyTime: "20220101 10:00:00"},
+			{ShardID: 1, FamilyTime: "20220101 11:00:00", MutableMemSize: 1024, ImmutableMemSize: 1024,
+				NumOfMetrics: 10, NumOfSeries: 100, Flushing: true, FlushETA: 90 * time.Second, LastFlushTime: 1600000000000,
+				WriteBuffer: WriteBufferState{NumOfBuffers: 1, GCCount: 2, GCBuffers: 3, LastGCTime: 1600000000000}},
+			{ShardID: 1, FamilyTime: "20220101 10:00:00"},
+		},
+	}.ToTable()
+	assert.Equal(t, 4, rows)
+	assert.Contains(t, rs, "1m30s")
+	assert.Contains(t, rs, "2.0 KiB")
+}
@@ -20,6 +20,7 @@ package models
 import (
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -28,6 +29,22 @@ import (
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
+// Defines all well-known labels of node.
+const (
+	// NodeLabelZone represents the zone(failure domain) which node running in.
+	NodeLabelZone = "zone"
+	// NodeLabelRack represents the rack which node running in.
+	NodeLabelRack = "rack"
+	// NodeLabelInstanceType represents the instance type of node.
+	NodeLabelInstanceType = "instance-type"
+	// NodeLabelPodName represents the pod name when node running in kubernetes.
+	NodeLabelPodName = "pod-name"
+	// NodeLabelPodNamespace represents the pod namespace when node running in kubernetes.
+	NodeLabelPodNamespace = "pod-namespace"
+	// NodeLabelK8sNodeName represents the kubernetes node name which pod scheduled on.
+	NodeLabelK8sNodeName = "k8s-node-name"
+)
+
 // NodeID represents node identifier.
 type NodeID int
 
@@ -67,12 +84,13 @@ func (n StatelessNodes) ToTable() (rows int, tableStr string) {
 		return 0, ""
 	}
 	writer := NewTableFormatter()
-	writer.AppendHeader(table.Row{"Online time", "Host IP", "Host Name", "Port(HTTP/GRPC)", "Version"})
+	writer.AppendHeader(table.Row{"Online time", "Host IP", "Host Name", "Port(HTTP/GRPC)", "Version", "Zone", "Labels"})
 	for i := range n {
 		r := n[i]
 		writer.AppendRow(table.Row{
 			timeutil.FormatTimestamp(r.OnlineTime, timeutil.DataTimeFormat2),
-			r.HostIP, r.HostName, fmt.Sprintf("%d/%d", r.HTTPPort, r.GRPCPort), r.Version})
+			r.HostIP, r.HostName, fmt.Sprintf("%d/%d", r.HTTPPort, r.GRPCPort), r.Version,
+			r.Zone(), r.LabelsString()})
 	}
 	return len(n), writer.Render()
 }
@@ -86,6 +104,35 @@ type StatelessNode struct {
 
 	Version    string `json:"version"`
 	OnlineTime int64  `json:"onlineTime"` // node online time(millisecond)
+
+	Labels map[string]string `json:"labels,omitempty"` // node attributes, like zone/instance type etc.
+}
+
+// Zone returns the zone which node running in, return empty string if not set.
+func (n *StatelessNode) Zone() string {
+	return n.Labels[NodeLabelZone]
+}
+
+// LabelsString returns node labels as string, format: k1=v1,k2=v2(sorted by key).
+func (n *StatelessNode) LabelsString() string {
+	if len(n.Labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(n.Labels))
+	for k := range n.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for idx, k := range keys {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(k)
+		sb.WriteString("=")
+		sb.WriteString(n.Labels[k])
+	}
+	return sb.String()
 }
 
 // Indicator returns node indicator's string.
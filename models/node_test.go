@@ -79,6 +79,24 @@ func TestStatelessNodes_ToTable(t *testing.T) {
 	rows, rs = (StatelessNodes{{OnlineTime: timeutil.Now()}}).ToTable()
 	assert.NotEmpty(t, rs)
 	assert.Equal(t, rows, 1)
+
+	rows, rs = (StatelessNodes{{
+		OnlineTime: timeutil.Now(),
+		Labels:     map[string]string{NodeLabelZone: "zone-a", NodeLabelPodName: "broker-0"},
+	}}).ToTable()
+	assert.Contains(t, rs, "zone-a")
+	assert.Contains(t, rs, "pod-name=broker-0,zone=zone-a")
+	assert.Equal(t, rows, 1)
+}
+
+func TestStatelessNode_Labels(t *testing.T) {
+	node := &StatelessNode{}
+	assert.Empty(t, node.Zone())
+	assert.Empty(t, node.LabelsString())
+
+	node.Labels = map[string]string{NodeLabelZone: "zone-a", NodeLabelInstanceType: "c5.large"}
+	assert.Equal(t, "zone-a", node.Zone())
+	assert.Equal(t, "instance-type=c5.large,zone=zone-a", node.LabelsString())
 }
 
 func TestNodeID(t *testing.T) {
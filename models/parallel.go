@@ -21,7 +21,8 @@ import "github.com/lindb/lindb/constants"
 
 // PhysicalPlan represents the distribution query's physical plan
 type PhysicalPlan struct {
-	Database  string    `json:"database"` // database name
+	Database  string    `json:"database"`          // database name
+	Cluster   string    `json:"cluster,omitempty"` // broker cluster name, only for federation query of root
 	Targets   []*Target `json:"targets"`
 	Receivers []string  `json:"receivers"`
 }
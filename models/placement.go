@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// PlacementViolation represents the replicas of shard which are placed in the same failure domain.
+type PlacementViolation struct {
+	ShardID  ShardID  `json:"shardId"`
+	Domain   string   `json:"domain"`
+	Replicas []NodeID `json:"replicas"`
+}
+
+// PlacementViolations represents the placement violation list of database.
+type PlacementViolations []PlacementViolation
+
+// ToTable returns placement violation list as table if it has value, else return empty string.
+func (v PlacementViolations) ToTable() (rows int, tableStr string) {
+	if len(v) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Shard", "Failure Domain", "Replicas"})
+	for i := range v {
+		r := v[i]
+		writer.AppendRow(table.Row{r.ShardID, r.Domain, nodeIDsString(r.Replicas)})
+	}
+	return len(v), writer.Render()
+}
+
+// PlacementMove represents a replica of shard moved from one node to another for placement repair.
+type PlacementMove struct {
+	ShardID ShardID `json:"shardId"`
+	From    NodeID  `json:"from"`
+	To      NodeID  `json:"to"`
+}
+
+// PlacementMoves represents the replica move list of database.
+type PlacementMoves []PlacementMove
+
+// ToTable returns replica move list as table if it has value, else return empty string.
+func (m PlacementMoves) ToTable() (rows int, tableStr string) {
+	if len(m) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Shard", "From", "To"})
+	for i := range m {
+		r := m[i]
+		writer.AppendRow(table.Row{r.ShardID, r.From, r.To})
+	}
+	return len(m), writer.Render()
+}
+
+// GetFailureDomains returns the failure domain of nodes by label key, nodes without the label are ignored.
+func GetFailureDomains(nodes []StatefulNode, labelKey string) map[NodeID]string {
+	var domains map[NodeID]string
+	for idx := range nodes {
+		node := nodes[idx]
+		if domain := node.Labels[labelKey]; domain != "" {
+			if domains == nil {
+				domains = make(map[NodeID]string)
+			}
+			domains[node.ID] = domain
+		}
+	}
+	return domains
+}
+
+// CheckPlacement returns the replicas of each shard which are placed in the same failure domain,
+// replicas on the node with unknown failure domain are ignored.
+func CheckPlacement(shardAssignment *ShardAssignment, domains map[NodeID]string) (rs PlacementViolations) {
+	if shardAssignment == nil || len(domains) == 0 {
+		return nil
+	}
+	for shardID, replica := range shardAssignment.Shards {
+		replicas := make(map[string][]NodeID)
+		for _, nodeID := range replica.Replicas {
+			if domain, ok := domains[nodeID]; ok {
+				replicas[domain] = append(replicas[domain], nodeID)
+			}
+		}
+		for domain, nodeIDs := range replicas {
+			if len(nodeIDs) > 1 {
+				rs = append(rs, PlacementViolation{ShardID: shardID, Domain: domain, Replicas: nodeIDs})
+			}
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].ShardID == rs[j].ShardID {
+			return rs[i].Domain < rs[j].Domain
+		}
+		return rs[i].ShardID < rs[j].ShardID
+	})
+	return rs
+}
+
+// nodeIDsString returns node id list as string, format: 1,2,3.
+func nodeIDsString(nodeIDs []NodeID) string {
+	ids := make([]string, len(nodeIDs))
+	for idx, id := range nodeIDs {
+		ids[idx] = id.String()
+	}
+	return strings.Join(ids, ",")
+}
@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFailureDomains(t *testing.T) {
+	assert.Nil(t, GetFailureDomains([]StatefulNode{{ID: 1}}, NodeLabelZone))
+	nodes := []StatefulNode{
+		{ID: 1},
+		{ID: 2, StatelessNode: StatelessNode{Labels: map[string]string{NodeLabelZone: "a", NodeLabelRack: "r1"}}},
+		{ID: 3, StatelessNode: StatelessNode{Labels: map[string]string{NodeLabelZone: "b"}}},
+	}
+	assert.Equal(t, map[NodeID]string{2: "a", 3: "b"}, GetFailureDomains(nodes, NodeLabelZone))
+	assert.Equal(t, map[NodeID]string{2: "r1"}, GetFailureDomains(nodes, NodeLabelRack))
+}
+
+func TestCheckPlacement(t *testing.T) {
+	assert.Nil(t, CheckPlacement(nil, map[NodeID]string{1: "a"}))
+	shardAssignment := &ShardAssignment{
+		Name: "test",
+		Shards: map[ShardID]*Replica{
+			2: {Replicas: []NodeID{1, 2, 3, 4}},
+			1: {Replicas: []NodeID{1, 3}},
+			0: {Replicas: []NodeID{1, 5}},
+		},
+	}
+	assert.Nil(t, CheckPlacement(shardAssignment, nil))
+	domains := map[NodeID]string{1: "a", 2: "a", 3: "b", 4: "b"}
+	assert.Equal(t, PlacementViolations{
+		{ShardID: 2, Domain: "a", Replicas: []NodeID{1, 2}},
+		{ShardID: 2, Domain: "b", Replicas: []NodeID{3, 4}},
+	}, CheckPlacement(shardAssignment, domains))
+}
+
+func TestPlacement_ToTable(t *testing.T) {
+	rows, rs := PlacementViolations{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = PlacementViolations{{ShardID: 1, Domain: "a", Replicas: []NodeID{1, 2}}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, rs, "1,2")
+
+	rows, rs = PlacementMoves{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = PlacementMoves{{ShardID: 1, From: 2, To: 3}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, rs)
+}
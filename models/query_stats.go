@@ -30,7 +30,8 @@ import (
 
 // SeriesStats represents the stats for series.
 type SeriesStats struct {
-	NumOfSeries uint64 `json:"numOfSeries"`
+	NumOfSeries  uint64 `json:"numOfSeries"`
+	IndexVersion int64  `json:"indexVersion,omitempty"` // version of index snapshot which series filtering reads
 }
 
 // OperatorStats represents the stats of operator.
@@ -43,6 +44,21 @@ type OperatorStats struct {
 	ErrMsg     string      `json:"errMsg,omitempty"`
 }
 
+// SelectStats represents the stats of select list.
+type SelectStats struct {
+	Columns []string `json:"columns"` // column names(alias first) of result set
+}
+
+// OrderByStats represents the stats of order by.
+type OrderByStats struct {
+	Items []string `json:"items"` // order by items, referenced by alias if defined
+}
+
+// HavingStats represents the stats of having filter.
+type HavingStats struct {
+	DroppedGroups int `json:"droppedGroups"`
+}
+
 // StageStats represents the stats of stage.
 type StageStats struct {
 	Identifier string           `json:"identifier"`
@@ -52,6 +68,7 @@ type StageStats struct {
 	State      string           `json:"state"`
 	ErrMsg     string           `json:"errMsg"`
 	Async      bool             `json:"async"`
+	Operator   string           `json:"operator,omitempty"` // current executing operator if stage is executing
 	Operators  []*OperatorStats `json:"operators,omitempty"`
 
 	Children []*StageStats `json:"children"`
@@ -139,6 +156,11 @@ func nodeTitle(node *NodeStats) string {
 func stageToTable(tree treeprint.Tree, stage *StageStats) {
 	stageNode := tree.AddBranch(fmt.Sprintf("Stage(%s), [Cost:%s]", stage.Identifier, time.Duration(stage.Cost)))
 	for _, op := range stage.Operators {
+		if havingStats, ok := op.Stats.(*HavingStats); ok {
+			stageNode.AddNode(fmt.Sprintf("Operator(%s), [Cost:%s, Dropped:%d]",
+				op.Identifier, time.Duration(op.Cost), havingStats.DroppedGroups))
+			continue
+		}
 		stageNode.AddNode(fmt.Sprintf("Operator(%s), [Cost:%s]", op.Identifier, time.Duration(op.Cost)))
 	}
 	for _, child := range stage.Children {
@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// ReadOnlyState represents the read-only mode of storage node and its databases for maintenance,
+// writes of read-only node/database are rejected, queries still work.
+type ReadOnlyState struct {
+	Node      string   `json:"node,omitempty"`      // storage node indicator
+	ReadOnly  bool     `json:"readOnly"`            // node level read-only mode
+	Databases []string `json:"databases,omitempty"` // database level read-only databases
+	ErrMsg    string   `json:"errMsg,omitempty"`
+}
+
+// IsReadOnly returns if the database is read-only(node or database level).
+func (s *ReadOnlyState) IsReadOnly(database string) bool {
+	if s.ReadOnly {
+		return true
+	}
+	for _, name := range s.Databases {
+		if name == database {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadOnlyStates represents the read-only state list of storage nodes.
+type ReadOnlyStates []*ReadOnlyState
+
+// ToTable returns read-only state list as table if it has value, else return empty string.
+func (s ReadOnlyStates) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Read Only", "Read Only Databases", "Error"})
+	for _, r := range s {
+		writer.AppendRow(table.Row{r.Node, r.ReadOnly, strings.Join(r.Databases, ","), r.ErrMsg})
+	}
+	return len(s), writer.Render()
+}
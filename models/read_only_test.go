@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyState_IsReadOnly(t *testing.T) {
+	state := &ReadOnlyState{Databases: []string{"db1"}}
+	assert.True(t, state.IsReadOnly("db1"))
+	assert.False(t, state.IsReadOnly("db2"))
+	state.ReadOnly = true
+	assert.True(t, state.IsReadOnly("db2"))
+}
+
+func TestReadOnlyStates_ToTable(t *testing.T) {
+	rows, str := ReadOnlyStates{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	rows, str = ReadOnlyStates{
+		{Node: "1.1.1.1:2080", ReadOnly: true},
+		{Node: "1.1.1.2:2080", Databases: []string{"db1", "db2"}},
+		{Node: "1.1.1.3:2080", ErrMsg: "connection refused"},
+	}.ToTable()
+	assert.Equal(t, 3, rows)
+	assert.Contains(t, str, "1.1.1.1:2080")
+	assert.Contains(t, str, "db1,db2")
+	assert.Contains(t, str, "connection refused")
+}
@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// ReplicaChannelState represents the write channel state of database's shard family in broker.
+type ReplicaChannelState struct {
+	Node         string  `json:"node,omitempty"` // broker node which channel belongs to
+	Database     string  `json:"database"`
+	ShardID      ShardID `json:"shardId"`
+	FamilyTime   int64   `json:"familyTime"`
+	Leader       NodeID  `json:"leader"`
+	Target       string  `json:"target,omitempty"` // storage node of current send stream
+	Pending      int64   `json:"pending"`          // number of message waiting send
+	PendingBytes int64   `json:"pendingBytes"`     // bytes of message waiting send
+	RetryBuffers int64   `json:"retryBuffers"`     // number of message in retry buffer
+	Retry        int64   `json:"retry"`            // number of message retried
+	RetryDrop    int64   `json:"retryDrop"`        // number of message dropped after too many retry
+	LastSendTime int64   `json:"lastSendTime"`     // last successful send time
+	Lag          int64   `json:"lag"`              // millis since last successful send if message pending
+}
+
+// ReplicaChannelStates represents the write channel state list of broker.
+type ReplicaChannelStates []*ReplicaChannelState
+
+// ToTable returns write channel state list as table if it has value, else return empty string.
+func (s ReplicaChannelStates) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Database", "Shard", "Family", "Leader", "Pending",
+		"Pending Bytes", "Retry Buffers", "Retry", "Retry Drop", "Last Send", "Lag"})
+	for _, state := range s {
+		lastSend := "-"
+		if state.LastSendTime > 0 {
+			lastSend = timeutil.FormatTimestamp(state.LastSendTime, timeutil.DataTimeFormat2)
+		}
+		writer.AppendRow(table.Row{
+			state.Node,
+			state.Database,
+			state.ShardID,
+			timeutil.FormatTimestamp(state.FamilyTime, timeutil.DataTimeFormat2),
+			state.Leader,
+			state.Pending,
+			ltoml.Size(state.PendingBytes).String(),
+			state.RetryBuffers,
+			state.Retry,
+			state.RetryDrop,
+			lastSend,
+			(time.Duration(state.Lag) * time.Millisecond).String(),
+		})
+	}
+	return len(s), writer.Render()
+}
@@ -0,0 +1,37 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicaChannelStates_ToTable(t *testing.T) {
+	rows, rs := ReplicaChannelStates{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = ReplicaChannelStates{
+		{Node: "1.1.1.1:9000", Database: "db", ShardID: 1, FamilyTime: 1600000000000, Leader: 1},
+		{Node: "1.1.1.1:9000", Database: "db", ShardID: 2, FamilyTime: 1600000000000, Leader: 2,
+			Pending: 3, PendingBytes: 1024, RetryBuffers: 1, Retry: 2, LastSendTime: 1600000000000, Lag: 1500},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.Contains(t, rs, "1.5s")
+}
@@ -46,3 +46,15 @@ func NewRequest(entry, db, sql string) *Request {
 		Start: time.Now().UnixNano(),
 	}
 }
+
+// RequestNodeState represents the execution state of request on a node.
+type RequestNodeState struct {
+	Node   string        `json:"node"`
+	Stages []*StageStats `json:"stages,omitempty"`
+}
+
+// RequestDetail represents the detail of request, includes the execution state of all nodes.
+type RequestDetail struct {
+	Request *Request            `json:"request,omitempty"`
+	Nodes   []*RequestNodeState `json:"nodes,omitempty"`
+}
@@ -42,6 +42,10 @@ type ResultSet struct {
 	Interval   int64      `json:"interval,omitempty"`
 	Series     []*Series  `json:"series,omitempty"`
 	Stats      *NodeStats `json:"stats,omitempty"`
+	// field metadata(type/down sampling functions) of select all fields(select *), for labeling series by client
+	FieldMetas []FieldMeta `json:"fieldMetas,omitempty"`
+	// broker cluster => error message, the clusters which failed in federation query of root
+	PartialFailures map[string]string `json:"partialFailures,omitempty"`
 }
 
 // NewResultSet creates a new result set
@@ -70,8 +74,20 @@ func (rs *ResultSet) ToTable() (rows int, tableStr string) {
 	if len(rs.Series) == 0 {
 		return 0, ""
 	}
+	headers, tableRows := rs.TableRows()
+	result := NewTableFormatter()
+	result.AppendHeader(headers)
+	result.AppendRows(tableRows)
+	return len(rs.Series), result.Render()
+}
+
+// TableRows returns the header and rows of result set, one row per series and timestamp,
+// returns empty header for explain query.
+func (rs *ResultSet) TableRows() (headers table.Row, tableRows []table.Row) {
+	if rs.Stats != nil || len(rs.Series) == 0 {
+		return nil, nil
+	}
 	// 1. set headers
-	headers := table.Row{}
 	for _, k := range rs.GroupBy {
 		headers = append(headers, k)
 	}
@@ -80,7 +96,7 @@ func (rs *ResultSet) ToTable() (rows int, tableStr string) {
 		headers = append(headers, f)
 	}
 	// 2. build table rows
-	tableRows := make(map[string]*row)
+	rowsMap := make(map[string]*row)
 	var pks []string
 	for _, s := range rs.Series {
 		var values []string
@@ -91,22 +107,20 @@ func (rs *ResultSet) ToTable() (rows int, tableStr string) {
 		for n, f := range s.Fields {
 			for timestamp, v := range f {
 				k := fmt.Sprintf("%s_%d", key, timestamp)
-				i, ok := tableRows[k]
+				i, ok := rowsMap[k]
 				if !ok {
 					i = &row{values: make(map[string]float64), tags: s.Tags, timestamp: timestamp}
 					pks = append(pks, k)
-					tableRows[k] = i
+					rowsMap[k] = i
 				}
 				i.values[n] = v
 			}
 		}
 	}
-	// 3. format as table
-	result := NewTableFormatter()
-	result.AppendHeader(headers)
+	// 3. sort rows by tags and timestamp
 	sort.Strings(pks)
 	for _, pk := range pks {
-		r := tableRows[pk]
+		r := rowsMap[pk]
 		row := table.Row{}
 		for _, tagKey := range rs.GroupBy {
 			row = append(row, r.tags[tagKey])
@@ -115,9 +129,16 @@ func (rs *ResultSet) ToTable() (rows int, tableStr string) {
 		for _, f := range rs.Fields {
 			row = append(row, r.values[f])
 		}
-		result.AppendRow(row)
+		tableRows = append(tableRows, row)
 	}
-	return len(rs.Series), result.Render()
+	return headers, tableRows
+}
+
+// FieldMeta represents the metadata of field in result set.
+type FieldMeta struct {
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	DownSampling []string `json:"downSampling,omitempty"` // down sampling functions
 }
 
 // Series represents one time series for metric.
@@ -0,0 +1,174 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Defines all case folding of name sanitization.
+const (
+	// KeepCase keeps the case of name.
+	KeepCase = ""
+	// LowerCase folds name to lower case.
+	LowerCase = "lower"
+	// UpperCase folds name to upper case.
+	UpperCase = "upper"
+)
+
+// Defines all policies of name which is longer than max length.
+const (
+	// RejectLongName rejects the metric which name is too long.
+	RejectLongName = "reject"
+	// TruncateLongName truncates the name to max length.
+	TruncateLongName = "truncate"
+)
+
+// SanitizeRules represents the sanitization/normalization rules of namespace/metric/field names for database,
+// rules are applied when ingesting metric data.
+type SanitizeRules struct {
+	// CharMap replaces the char(key) in name with the replacement(value), empty replacement removes the char.
+	CharMap map[string]string `toml:"char-map"`
+	// CaseFolding folds the case of name after char mapping, lower/upper, empty keeps the case.
+	CaseFolding string `toml:"case-folding"`
+	// LengthPolicy represents the policy of name which is longer than max length, reject(default)/truncate.
+	LengthPolicy string `toml:"length-policy"`
+}
+
+// NewDefaultSanitizeRules creates the default sanitize rules which keep the name.
+func NewDefaultSanitizeRules() SanitizeRules {
+	return SanitizeRules{
+		CharMap:      make(map[string]string),
+		CaseFolding:  KeepCase,
+		LengthPolicy: RejectLongName,
+	}
+}
+
+// Validate checks if the sanitize rules are valid.
+func (r *SanitizeRules) Validate() error {
+	for char := range r.CharMap {
+		if utf8.RuneCountInString(char) != 1 {
+			return fmt.Errorf("char-map key must be a single char, but got: %q", char)
+		}
+	}
+	switch r.CaseFolding {
+	case KeepCase, LowerCase, UpperCase:
+	default:
+		return fmt.Errorf("case-folding must be one of lower/upper or empty, but got: %q", r.CaseFolding)
+	}
+	switch r.LengthPolicy {
+	case "", RejectLongName, TruncateLongName:
+	default:
+		return fmt.Errorf("length-policy must be one of reject/truncate, but got: %q", r.LengthPolicy)
+	}
+	return nil
+}
+
+// Enabled returns if name need to be normalized by char map or case folding.
+func (r *SanitizeRules) Enabled() bool {
+	return len(r.CharMap) > 0 || r.CaseFolding != KeepCase
+}
+
+// ShouldNormalize returns if the name will be changed after normalizing.
+func (r *SanitizeRules) ShouldNormalize(name string) bool {
+	if !r.Enabled() {
+		return false
+	}
+	return r.Normalize(name) != name
+}
+
+// Normalize replaces the chars by char map, then folds the case of name.
+func (r *SanitizeRules) Normalize(name string) string {
+	if len(r.CharMap) > 0 && strings.IndexFunc(name, r.mapped) >= 0 {
+		var sb strings.Builder
+		sb.Grow(len(name))
+		for _, c := range name {
+			if replacement, ok := r.CharMap[string(c)]; ok {
+				sb.WriteString(replacement)
+			} else {
+				sb.WriteRune(c)
+			}
+		}
+		name = sb.String()
+	}
+	switch r.CaseFolding {
+	case LowerCase:
+		return strings.ToLower(name)
+	case UpperCase:
+		return strings.ToUpper(name)
+	default:
+		return name
+	}
+}
+
+// Truncatable returns if the name longer than max length can be truncated.
+func (r *SanitizeRules) Truncatable() bool {
+	return r.LengthPolicy == TruncateLongName
+}
+
+// Truncate truncates the name to max length(bytes) without breaking utf8 char.
+func (r *SanitizeRules) Truncate(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+	end := maxLength
+	for end > 0 && !utf8.RuneStart(name[end]) {
+		end--
+	}
+	return name[:end]
+}
+
+// mapped returns if the char is in char map.
+func (r *SanitizeRules) mapped(c rune) bool {
+	_, ok := r.CharMap[string(c)]
+	return ok
+}
+
+// TOML returns sanitize rules' configuration string as toml format.
+func (r *SanitizeRules) TOML() string {
+	chars := make([]string, 0, len(r.CharMap))
+	for char := range r.CharMap {
+		chars = append(chars, char)
+	}
+	sort.Strings(chars)
+	rs := ""
+	for _, char := range chars {
+		rs += fmt.Sprintf("%q = %q\n", char, r.CharMap[char])
+	}
+	return fmt.Sprintf(`
+## Case folding of namespace/metric/field names, lower/upper, empty keeps the case.
+## Default: %q
+case-folding = %q
+## Policy of name which is longer than max length, reject/truncate.
+## Default: %q
+length-policy = %q
+
+## Replaces the char of namespace/metric/field names, empty replacement removes the char.
+## Example: "-" = "_"
+[sanitize.char-map]
+%s`,
+		KeepCase,
+		r.CaseFolding,
+		RejectLongName,
+		r.LengthPolicy,
+		rs,
+	)
+}
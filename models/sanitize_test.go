@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeRules_Validate(t *testing.T) {
+	r := NewDefaultSanitizeRules()
+	assert.NoError(t, r.Validate())
+	r.CharMap["ab"] = "_"
+	assert.Error(t, r.Validate())
+
+	r = NewDefaultSanitizeRules()
+	r.CaseFolding = "title"
+	assert.Error(t, r.Validate())
+
+	r = NewDefaultSanitizeRules()
+	r.LengthPolicy = "drop"
+	assert.Error(t, r.Validate())
+}
+
+func TestSanitizeRules_Normalize(t *testing.T) {
+	r := NewDefaultSanitizeRules()
+	assert.False(t, r.Enabled())
+	assert.False(t, r.ShouldNormalize("Cpu-Load"))
+	assert.Equal(t, "Cpu-Load", r.Normalize("Cpu-Load"))
+
+	r.CharMap["-"] = "_"
+	r.CharMap[" "] = ""
+	assert.True(t, r.Enabled())
+	assert.Equal(t, "Cpu_Load", r.Normalize("Cpu-Lo ad"))
+	assert.False(t, r.ShouldNormalize("cpu"))
+
+	r.CaseFolding = LowerCase
+	assert.Equal(t, "cpu_load", r.Normalize("Cpu-Load"))
+	assert.True(t, r.ShouldNormalize("Cpu"))
+	r.CaseFolding = UpperCase
+	assert.Equal(t, "CPU_LOAD", r.Normalize("Cpu-Load"))
+}
+
+func TestSanitizeRules_Truncate(t *testing.T) {
+	r := NewDefaultSanitizeRules()
+	assert.False(t, r.Truncatable())
+	r.LengthPolicy = TruncateLongName
+	assert.True(t, r.Truncatable())
+	assert.Equal(t, "cpu", r.Truncate("cpu", 10))
+	assert.Equal(t, "cp", r.Truncate("cpu", 2))
+	// not break utf8 char
+	assert.Equal(t, "a", r.Truncate("a中文", 3))
+}
@@ -0,0 +1,46 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// SeriesDeletion represents the result of deleting series from the replica of shard.
+type SeriesDeletion struct {
+	Node        string  `json:"node,omitempty"` // storage node which replica belongs to
+	ShardID     ShardID `json:"shardId"`
+	NumOfSeries uint64  `json:"numOfSeries"`
+	ErrMsg      string  `json:"errMsg,omitempty"`
+}
+
+// SeriesDeletions represents the deletion result list of shard replicas.
+type SeriesDeletions []*SeriesDeletion
+
+// ToTable returns series deletion result list as table if it has value, else return empty string.
+func (d SeriesDeletions) ToTable() (rows int, tableStr string) {
+	if len(d) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Shard", "Deleted Series", "Error"})
+	for _, r := range d {
+		writer.AppendRow(table.Row{r.Node, r.ShardID, r.NumOfSeries, r.ErrMsg})
+	}
+	return len(d), writer.Render()
+}
@@ -0,0 +1,38 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesDeletions_ToTable(t *testing.T) {
+	rows, str := SeriesDeletions{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, str)
+
+	rows, str = SeriesDeletions{
+		{Node: "1.1.1.1:2080", ShardID: 1, NumOfSeries: 10},
+		{Node: "1.1.1.2:2080", ShardID: 1, ErrMsg: "replica is offline"},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.Contains(t, str, "1.1.1.1:2080")
+	assert.Contains(t, str, "replica is offline")
+}
@@ -39,6 +39,22 @@ const (
 	NonExistentShard
 )
 
+// String returns the string value of ShardStateType.
+func (s ShardStateType) String() string {
+	switch s {
+	case NewShard:
+		return "New"
+	case OnlineShard:
+		return "Online"
+	case OfflineShard:
+		return "Offline"
+	case NonExistentShard:
+		return "NonExistent"
+	default:
+		return "Unknown"
+	}
+}
+
 const NoLeader NodeID = -1
 
 // NodeStateType represents node state type
@@ -165,6 +181,14 @@ func (r ReplicaState) String() string {
 		"]"
 }
 
+// CircuitBreakerState represents the circuit breaker state of target node's connection.
+type CircuitBreakerState struct {
+	Target   string `json:"target"`
+	State    string `json:"state"`
+	Failures int    `json:"failures"`
+	OpenedAt int64  `json:"openedAt,omitempty"`
+}
+
 // ShardState represents current state of shard.
 type ShardState struct {
 	ID      ShardID        `json:"id"`
@@ -310,13 +334,43 @@ type DataFamilyState struct {
 	AckSequences     map[int32]int64       `json:"ackSequences"`
 	ReplicaSequences map[int32]int64       `json:"replicaSequences"`
 	MemoryDatabases  []MemoryDatabaseState `json:"memoryDatabases"`
+	MutableMemSize   int64                 `json:"mutableMemSize"`   // memory size of mutable memory database
+	ImmutableMemSize int64                 `json:"immutableMemSize"` // memory size of immutable memory database(waiting flush)
+	NumOfMetrics     int                   `json:"numOfMetrics"`
+	NumOfSeries      int                   `json:"numOfSeries"`
+	Flushing         bool                  `json:"flushing"`
+	FlushETA         time.Duration         `json:"flushETA"`      // estimated time before mutable memory database need flush
+	LastFlushTime    int64                 `json:"lastFlushTime"` // last flush time of data family
+	WriteRate        float64               `json:"writeRate"`     // data points written per second into mutable memory database
+	WriteBuffer      WriteBufferState      `json:"writeBuffer"`   // write buffer state of shard which family belongs to
+}
+
+// MemSize returns the total memory size of data family's memory databases.
+func (s *DataFamilyState) MemSize() int64 {
+	return s.MutableMemSize + s.ImmutableMemSize
+}
+
+// WriteBufferState represents the state of shard's data point write buffer.
+type WriteBufferState struct {
+	NumOfBuffers int   `json:"numOfBuffers"` // number of alive buffers
+	GCCount      int64 `json:"gcCount"`      // number of garbage collect
+	GCBuffers    int64 `json:"gcBuffers"`    // number of buffers cleaned by garbage collect
+	LastGCTime   int64 `json:"lastGCTime"`   // last garbage collect time
+}
+
+// DataFamilyFiles represents the persisted replica sequences and live files of data family.
+type DataFamilyFiles struct {
+	Sequences map[int32]int64 `json:"sequences"`
+	Files     []string        `json:"files"`
 }
 
 // MemoryDatabaseState represents the state of memory database.
 type MemoryDatabaseState struct {
-	State        string        `json:"state"`
-	Uptime       time.Duration `json:"uptime"`
-	MemSize      int64         `json:"memSize"`
-	NumOfMetrics int           `json:"numOfMetrics"`
-	NumOfSeries  int           `json:"numOfSeries"`
+	State          string        `json:"state"`
+	Uptime         time.Duration `json:"uptime"`
+	MemSize        int64         `json:"memSize"`
+	NumOfMetrics   int           `json:"numOfMetrics"`
+	NumOfSeries    int           `json:"numOfSeries"`
+	ArenaAllocated int64         `json:"arenaAllocated"` // size of chunks allocated by arena
+	ArenaUsed      int64         `json:"arenaUsed"`      // size of buffers used in arena chunks
 }
@@ -0,0 +1,252 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// Replica roles of shard.
+const (
+	LeaderRole   = "leader"
+	FollowerRole = "follower"
+)
+
+// Topology represents the topology graph of whole cluster(root->brokers->storages->databases->shards->replicas).
+type Topology struct {
+	Roots   []NodeTopology    `json:"roots"`
+	Brokers []*BrokerTopology `json:"brokers"`
+}
+
+// BrokerTopology represents the topology of broker cluster.
+type BrokerTopology struct {
+	Name     string             `json:"name,omitempty"`
+	Nodes    []NodeTopology     `json:"nodes"`
+	Storages []*StorageTopology `json:"storages,omitempty"`
+	ErrMsg   string             `json:"errMsg,omitempty"` // error message if fetch topology of broker cluster failure
+}
+
+// NodeTopology represents the live node of cluster.
+type NodeTopology struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+}
+
+// StorageTopology represents the topology of storage cluster.
+type StorageTopology struct {
+	Name      string              `json:"name"`
+	Nodes     []NodeTopology      `json:"nodes"`
+	Databases []*DatabaseTopology `json:"databases,omitempty"`
+}
+
+// DatabaseTopology represents the shards of database in storage cluster.
+type DatabaseTopology struct {
+	Name   string           `json:"name"`
+	Shards []*ShardTopology `json:"shards"`
+}
+
+// ShardTopology represents the replicas of shard.
+type ShardTopology struct {
+	ID       ShardID            `json:"id"`
+	State    string             `json:"state"`
+	Leader   NodeID             `json:"leader"`
+	Replicas []*ReplicaTopology `json:"replicas"`
+}
+
+// Shards represents the shard list of database.
+type Shards []*ShardTopology
+
+// ToTable returns shard list as table if it has value, else return empty string.
+func (s Shards) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Shard", "State", "Leader", "Replica", "Role", "Online", "Lag"})
+	for _, shard := range s {
+		for _, replica := range shard.Replicas {
+			writer.AppendRow(table.Row{
+				shard.ID,
+				shard.State,
+				shard.Leader,
+				replica.Node,
+				replica.Role,
+				replica.Online,
+				replica.Lag,
+			})
+		}
+	}
+	return len(s), writer.Render()
+}
+
+// ReplicaTopology represents the replica of shard with health and replica lag.
+type ReplicaTopology struct {
+	Node   NodeID `json:"node"`
+	Role   string `json:"role"`
+	Online bool   `json:"online"`
+	Lag    int64  `json:"lag"` // pending wal log which not replicated to replica
+}
+
+// NewStorageTopology creates the topology of storage cluster based on storage state.
+func NewStorageTopology(state *StorageState) *StorageTopology {
+	topology := &StorageTopology{Name: state.Name}
+	for id := range state.LiveNodes {
+		node := state.LiveNodes[id]
+		topology.Nodes = append(topology.Nodes, NodeTopology{ID: id.String(), Address: node.Indicator()})
+	}
+	sort.Slice(topology.Nodes, func(i, j int) bool {
+		return topology.Nodes[i].ID < topology.Nodes[j].ID
+	})
+	for name, shards := range state.ShardStates {
+		database := &DatabaseTopology{Name: name}
+		for shardID := range shards {
+			shard := shards[shardID]
+			shardTopology := &ShardTopology{
+				ID:     shardID,
+				State:  shard.State.String(),
+				Leader: shard.Leader,
+			}
+			for _, replica := range shard.Replica.Replicas {
+				role := FollowerRole
+				if replica == shard.Leader {
+					role = LeaderRole
+				}
+				_, online := state.LiveNodes[replica]
+				shardTopology.Replicas = append(shardTopology.Replicas, &ReplicaTopology{
+					Node:   replica,
+					Role:   role,
+					Online: online,
+				})
+			}
+			database.Shards = append(database.Shards, shardTopology)
+		}
+		sort.Slice(database.Shards, func(i, j int) bool {
+			return database.Shards[i].ID < database.Shards[j].ID
+		})
+		topology.Databases = append(topology.Databases, database)
+	}
+	sort.Slice(topology.Databases, func(i, j int) bool {
+		return topology.Databases[i].Name < topology.Databases[j].Name
+	})
+	return topology
+}
+
+// FillLag fills the replica lag of shards based on wal replica state of database.
+func (t *DatabaseTopology) FillLag(states []FamilyLogReplicaState) {
+	shards := make(map[ShardID]*ShardTopology)
+	for _, shard := range t.Shards {
+		shards[shard.ID] = shard
+	}
+	for idx := range states {
+		state := states[idx]
+		shard, ok := shards[state.ShardID]
+		if !ok {
+			continue
+		}
+		for _, peer := range state.Replicators {
+			nodeID := ParseNodeID(peer.Replicator)
+			for _, replica := range shard.Replicas {
+				if replica.Node == nodeID {
+					// sum the pending of all families
+					replica.Lag += peer.Pending
+					break
+				}
+			}
+		}
+	}
+}
+
+// SegmentState represents the state of segment(interval + base time) of shard.
+type SegmentState struct {
+	Node      string             `json:"node,omitempty"` // storage node which segment belongs to
+	Interval  string             `json:"interval"`
+	Name      string             `json:"name"`
+	TimeRange timeutil.TimeRange `json:"timeRange"`
+	Families  []FamilyFilesState `json:"families"`
+}
+
+// FamilyFilesState represents the on-disk files of data family.
+type FamilyFilesState struct {
+	Family     string             `json:"family"` // family name of kv store
+	TimeRange  timeutil.TimeRange `json:"timeRange"`
+	NumOfFiles int                `json:"numOfFiles"`
+	Size       int64              `json:"size"`
+	Files      []FileState        `json:"files,omitempty"` // files recorded in segment manifest of shard
+}
+
+// NumOfSeries returns the number of series of all files, series may be counted repeatedly in different files.
+func (f *FamilyFilesState) NumOfSeries() (numOfSeries uint64) {
+	for idx := range f.Files {
+		numOfSeries += f.Files[idx].NumOfSeries
+	}
+	return
+}
+
+// FileState represents the state of on-disk file of data family,
+// it is used for pruning files before reading data from file.
+type FileState struct {
+	File        int64              `json:"file"`        // file number
+	MinKey      uint32             `json:"minKey"`      // min metric id of file
+	MaxKey      uint32             `json:"maxKey"`      // max metric id of file
+	NumOfSeries uint64             `json:"numOfSeries"` // number of series of all metrics in file
+	SlotRange   timeutil.SlotRange `json:"slotRange"`   // time slot range of all metrics in file
+	Size        int64              `json:"size"`
+}
+
+// MayContain checks if file may contain the data of metric in slot range.
+func (f *FileState) MayContain(metricKey uint32, slotRange timeutil.SlotRange) bool {
+	return metricKey >= f.MinKey && metricKey <= f.MaxKey && f.SlotRange.Overlap(slotRange)
+}
+
+// Segments represents the segment list of shard.
+type Segments []SegmentState
+
+// ToTable returns segment list as table if it has value, else return empty string.
+func (s Segments) ToTable() (rows int, tableStr string) {
+	if len(s) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Node", "Interval", "Segment", "Family", "Start", "End", "Files", "Series", "Size"})
+	for i := range s {
+		segment := s[i]
+		if len(segment.Families) == 0 {
+			writer.AppendRow(table.Row{segment.Node, segment.Interval, segment.Name, "-", "-", "-", 0, 0, ltoml.Size(0).String()})
+			continue
+		}
+		for _, family := range segment.Families {
+			writer.AppendRow(table.Row{
+				segment.Node,
+				segment.Interval,
+				segment.Name,
+				family.Family,
+				timeutil.FormatTimestamp(family.TimeRange.Start, timeutil.DataTimeFormat2),
+				timeutil.FormatTimestamp(family.TimeRange.End, timeutil.DataTimeFormat2),
+				family.NumOfFiles,
+				family.NumOfSeries(),
+				ltoml.Size(family.Size).String(),
+			})
+		}
+	}
+	return len(s), writer.Render()
+}
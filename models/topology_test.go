@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestNewStorageTopology(t *testing.T) {
+	state := NewStorageState("storage")
+	state.LiveNodes[2] = StatefulNode{ID: 2, StatelessNode: StatelessNode{HostIP: "1.1.1.2", GRPCPort: 9000}}
+	state.LiveNodes[1] = StatefulNode{ID: 1, StatelessNode: StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}}
+	state.ShardStates["b"] = map[ShardID]ShardState{
+		1: {ID: 1, State: OnlineShard, Leader: 1, Replica: Replica{Replicas: []NodeID{1, 3}}},
+		0: {ID: 0, State: OfflineShard, Leader: NoLeader, Replica: Replica{Replicas: []NodeID{3}}},
+	}
+	state.ShardStates["a"] = map[ShardID]ShardState{}
+
+	topology := NewStorageTopology(state)
+	assert.Equal(t, "storage", topology.Name)
+	assert.Equal(t, []NodeTopology{{ID: "1", Address: "1.1.1.1:9000"}, {ID: "2", Address: "1.1.1.2:9000"}}, topology.Nodes)
+	assert.Len(t, topology.Databases, 2)
+	assert.Equal(t, "a", topology.Databases[0].Name)
+	db := topology.Databases[1]
+	assert.Equal(t, ShardID(0), db.Shards[0].ID)
+	assert.Equal(t, "Offline", db.Shards[0].State)
+	assert.Equal(t, []*ReplicaTopology{
+		{Node: 1, Role: LeaderRole, Online: true},
+		{Node: 3, Role: FollowerRole, Online: false},
+	}, db.Shards[1].Replicas)
+
+	db.FillLag([]FamilyLogReplicaState{
+		{ShardID: 1, Replicators: []ReplicaPeerState{{Replicator: "3", Pending: 5}, {Replicator: "4", Pending: 1}}},
+		{ShardID: 1, Replicators: []ReplicaPeerState{{Replicator: "3", Pending: 2}}},
+		{ShardID: 9, Replicators: []ReplicaPeerState{{Replicator: "3", Pending: 2}}},
+	})
+	assert.Equal(t, int64(0), db.Shards[1].Replicas[0].Lag)
+	assert.Equal(t, int64(7), db.Shards[1].Replicas[1].Lag)
+}
+
+func TestShardStateType_String(t *testing.T) {
+	assert.Equal(t, "New", NewShard.String())
+	assert.Equal(t, "Online", OnlineShard.String())
+	assert.Equal(t, "Offline", OfflineShard.String())
+	assert.Equal(t, "NonExistent", NonExistentShard.String())
+	assert.Equal(t, "Unknown", UnknownShard.String())
+}
+
+func TestShards_ToTable(t *testing.T) {
+	rows, rs := Shards{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = Shards{{
+		ID:       1,
+		State:    OnlineShard.String(),
+		Leader:   1,
+		Replicas: []*ReplicaTopology{{Node: 1, Role: LeaderRole, Online: true}, {Node: 2, Role: FollowerRole, Lag: 10}},
+	}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.NotEmpty(t, rs)
+}
+
+func TestSegments_ToTable(t *testing.T) {
+	rows, rs := Segments{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = Segments{
+		{Node: "1.1.1.1:2891", Interval: "10s", Name: "20220101"},
+		{Node: "1.1.1.1:2891", Interval: "10s", Name: "20220102", Families: []FamilyFilesState{{Family: "1", NumOfFiles: 2, Size: 1024}}},
+	}.ToTable()
+	assert.Equal(t, 2, rows)
+	assert.NotEmpty(t, rs)
+}
+
+func TestFileState_MayContain(t *testing.T) {
+	f := FileState{MinKey: 10, MaxKey: 20, SlotRange: timeutil.SlotRange{Start: 5, End: 10}, NumOfSeries: 10}
+	assert.True(t, f.MayContain(10, timeutil.SlotRange{Start: 0, End: 5}))
+	assert.True(t, f.MayContain(20, timeutil.SlotRange{Start: 8, End: 20}))
+	assert.False(t, f.MayContain(9, timeutil.SlotRange{Start: 5, End: 10}))
+	assert.False(t, f.MayContain(21, timeutil.SlotRange{Start: 5, End: 10}))
+	assert.False(t, f.MayContain(15, timeutil.SlotRange{Start: 11, End: 20}))
+
+	family := FamilyFilesState{Files: []FileState{f, f}}
+	assert.Equal(t, uint64(20), family.NumOfSeries())
+}
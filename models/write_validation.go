@@ -0,0 +1,44 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// MetricError represents the validation error of metric in write payload.
+type MetricError struct {
+	Index  int    `json:"index"` // index of metric in write payload
+	Metric string `json:"metric,omitempty"`
+	Error  string `json:"error"`
+}
+
+// WriteValidation represents the validation result of write payload(dry-run), nothing is persisted.
+type WriteValidation struct {
+	Total  int           `json:"total"`
+	Valid  int           `json:"valid"`
+	Errors []MetricError `json:"errors,omitempty"`
+}
+
+// AddValid adds a valid metric.
+func (v *WriteValidation) AddValid() {
+	v.Total++
+	v.Valid++
+}
+
+// AddError adds an invalid metric with the validation error.
+func (v *WriteValidation) AddError(metricName string, err error) {
+	v.Errors = append(v.Errors, MetricError{Index: v.Total, Metric: metricName, Error: err.Error()})
+	v.Total++
+}
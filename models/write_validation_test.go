@@ -0,0 +1,37 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteValidation(t *testing.T) {
+	v := &WriteValidation{}
+	v.AddValid()
+	v.AddError("cpu", fmt.Errorf("err"))
+	v.AddValid()
+	assert.Equal(t, &WriteValidation{
+		Total:  3,
+		Valid:  2,
+		Errors: []MetricError{{Index: 1, Metric: "cpu", Error: "err"}},
+	}, v)
+}
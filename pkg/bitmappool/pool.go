@@ -0,0 +1,49 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bitmappool
+
+import (
+	"sync"
+
+	"github.com/lindb/roaring"
+)
+
+// maxPooledBitmapSize is the max size of bitmap returned to the pool,
+// larger bitmap is discarded for avoiding retaining its memory by the pool.
+const maxPooledBitmapSize = 1024 * 1024
+
+// bitmapPool is a set of temporary bitmaps for reducing allocation of transient bitmap operations.
+var bitmapPool = &sync.Pool{New: func() interface{} {
+	return roaring.New()
+}}
+
+// GetBitmap picks an empty bitmap from the pool and then returns it.
+func GetBitmap() *roaring.Bitmap {
+	return bitmapPool.Get().(*roaring.Bitmap)
+}
+
+// PutBitmap returns a bitmap to the pool,
+// NOTICE: the bitmap cannot be used after returning to the pool.
+func PutBitmap(bitmap *roaring.Bitmap) {
+	if bitmap == nil || bitmap.GetSizeInBytes() > maxPooledBitmapSize {
+		return
+	}
+	// clear bitmap, but retain the container slices for reusing
+	bitmap.Clear()
+	bitmapPool.Put(bitmap)
+}
@@ -0,0 +1,106 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package bitmappool
+
+import (
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BitmapPool(t *testing.T) {
+	bitmap := GetBitmap()
+	assert.True(t, bitmap.IsEmpty())
+	bitmap.AddRange(0, 100)
+	PutBitmap(bitmap)
+	PutBitmap(nil)
+
+	bitmap = GetBitmap()
+	assert.True(t, bitmap.IsEmpty())
+
+	// large bitmap isn't returned to the pool
+	large := mockSeriesIDs(0, 100_000_000)
+	assert.True(t, large.GetSizeInBytes() > maxPooledBitmapSize)
+	PutBitmap(large)
+	assert.False(t, large.IsEmpty())
+}
+
+func mockSeriesIDs(start, end uint32) *roaring.Bitmap {
+	seriesIDs := roaring.New()
+	for id := start; id < end; id += 2 {
+		seriesIDs.Add(id)
+	}
+	return seriesIDs
+}
+
+// Benchmark_Filtering_1M_Series simulates series filtering(a AND b) of 1M series metric.
+func Benchmark_Filtering_1M_Series(b *testing.B) {
+	source1 := mockSeriesIDs(0, 1_000_000)
+	source2 := mockSeriesIDs(500_000, 1_500_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		left := roaring.New()
+		left.Or(source1)
+		right := roaring.New()
+		right.Or(source2)
+		left.And(right)
+	}
+}
+
+// Benchmark_Filtering_1M_Series_Pool simulates series filtering(a AND b) of 1M series metric with bitmap pool.
+func Benchmark_Filtering_1M_Series_Pool(b *testing.B) {
+	source1 := mockSeriesIDs(0, 1_000_000)
+	source2 := mockSeriesIDs(500_000, 1_500_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		left := GetBitmap()
+		left.Or(source1)
+		right := GetBitmap()
+		right.Or(source2)
+		left.And(right)
+		PutBitmap(right)
+		PutBitmap(left)
+	}
+}
+
+func Benchmark_FastAnd_IsEmpty_1M_Series(b *testing.B) {
+	left := mockSeriesIDs(0, 1_000_000)
+	right := mockSeriesIDs(500_000, 1_500_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = roaring.FastAnd(left, right).IsEmpty()
+	}
+}
+
+func Benchmark_Intersects_1M_Series(b *testing.B) {
+	left := mockSeriesIDs(0, 1_000_000)
+	right := mockSeriesIDs(500_000, 1_500_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = left.Intersects(right)
+	}
+}
@@ -0,0 +1,274 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ddsketch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+const (
+	// RelativeAccuracy is the relative accuracy of quantile value which sketch guarantees.
+	RelativeAccuracy = 0.05
+	// boundTolerance is the relative tolerance when checking bin bound, for float rounding of bounds producer.
+	boundTolerance = 1e-9
+	// minIndexValue is the min value which can be mapped to bin, smaller value is put into zero bin.
+	minIndexValue = 1e-9
+	// maxInterpolatedBins is the max number of bins which count of range is interpolated into.
+	maxInterpolatedBins = 64
+)
+
+var (
+	gamma    = (1 + RelativeAccuracy) / (1 - RelativeAccuracy)
+	logGamma = math.Log(gamma)
+
+	errInvalidSketch = errors.New("invalid ddsketch data")
+)
+
+// Index returns the bin index of value, bin i covers (gamma^(i-1), gamma^i].
+func Index(value float64) int {
+	return int(math.Ceil(math.Log(value) / logGamma))
+}
+
+// LowerBound returns the lower bound of bin.
+func LowerBound(index int) float64 {
+	return math.Pow(gamma, float64(index-1))
+}
+
+// Value returns the representative value of bin, which relative error is less than relative accuracy.
+func Value(index int) float64 {
+	return 2 * math.Pow(gamma, float64(index)) / (1 + gamma)
+}
+
+// Sketch represents the DDSketch which has relative accuracy guarantee,
+// all the sketches use same logarithmic index mapping, so they can be merged by sum bins' count.
+type Sketch struct {
+	bins      map[int]float64
+	zeroCount float64 // count of values which <= 0 or too small
+	count     float64
+}
+
+// NewSketch creates an empty sketch.
+func NewSketch() *Sketch {
+	return &Sketch{
+		bins: make(map[int]float64),
+	}
+}
+
+// Add adds the count of value into sketch.
+func (s *Sketch) Add(value, count float64) {
+	if count <= 0 {
+		return
+	}
+	if value <= minIndexValue {
+		s.AddZero(count)
+		return
+	}
+	s.AddBin(Index(value), count)
+}
+
+// AddBin adds the count into bin.
+func (s *Sketch) AddBin(index int, count float64) {
+	if count <= 0 {
+		return
+	}
+	s.bins[index] += count
+	s.count += count
+}
+
+// AddZero adds the count into zero bin.
+func (s *Sketch) AddZero(count float64) {
+	if count <= 0 {
+		return
+	}
+	s.zeroCount += count
+	s.count += count
+}
+
+// AddRange adds the count of values in range (lower, upper] into sketch, e.g. bucket of histogram.
+// Values are assumed uniformly distributed in range(same as bucket based quantile), the count is interpolated
+// into the bins which range overlaps proportionally to the overlapped length.
+//
+// Error bound: if range is covered by one bin, the relative accuracy is kept; otherwise the quantile value
+// lies in the range which the true quantile lies in(with relative accuracy), the error is bounded by width of range.
+// Only the top maxInterpolatedBins bins below upper are interpolated, the count below them(at most
+// gamma^-maxInterpolatedBins of the count) is added into the lowest bin. Upper of +Inf is replaced by lower.
+func (s *Sketch) AddRange(lower, upper, count float64) {
+	if count <= 0 {
+		return
+	}
+	if math.IsInf(upper, 1) || upper < lower {
+		upper = lower
+	}
+	if upper <= minIndexValue {
+		s.AddZero(count)
+		return
+	}
+	if lower < 0 {
+		lower = 0
+	}
+	// tolerates float rounding of bounds which are aligned with bins by producer
+	upperIndex := Index(upper * (1 - boundTolerance))
+	if lower >= LowerBound(upperIndex)*(1-boundTolerance) {
+		// range covered by one bin
+		s.AddBin(upperIndex, count)
+		return
+	}
+	width := upper - lower
+	lowerIndex := upperIndex - maxInterpolatedBins + 1
+	if lower > minIndexValue {
+		if index := Index(lower * (1 + boundTolerance)); index > lowerIndex {
+			lowerIndex = index
+		}
+	}
+	remaining := count
+	for index := upperIndex; index > lowerIndex; index-- {
+		binLower := math.Max(LowerBound(index), lower)
+		binCount := count * (math.Min(LowerBound(index+1), upper) - binLower) / width
+		s.AddBin(index, binCount)
+		remaining -= binCount
+	}
+	// the lowest bin takes the remaining count, which includes the count below interpolated bins
+	s.AddBin(lowerIndex, remaining)
+}
+
+// Merge merges other sketch into current sketch.
+func (s *Sketch) Merge(other *Sketch) {
+	for index, count := range other.bins {
+		s.AddBin(index, count)
+	}
+	s.AddZero(other.zeroCount)
+}
+
+// Count returns the total count of values.
+func (s *Sketch) Count() float64 {
+	return s.count
+}
+
+// Bins returns the count of each bin.
+func (s *Sketch) Bins() map[int]float64 {
+	return s.bins
+}
+
+// ZeroCount returns the count of zero bin.
+func (s *Sketch) ZeroCount() float64 {
+	return s.zeroCount
+}
+
+// Quantile returns the approximate value of quantile, 0 <= q <= 1.
+func (s *Sketch) Quantile(q float64) (float64, error) {
+	if q < 0 || q > 1 {
+		return 0, fmt.Errorf("quantile with illegal value: %f", q)
+	}
+	if s.count <= 0 {
+		return 0, nil
+	}
+	rank := q * (s.count - 1)
+	if rank < s.zeroCount {
+		return 0, nil
+	}
+	indexes := s.sortedIndexes()
+	cumulative := s.zeroCount
+	for _, index := range indexes {
+		cumulative += s.bins[index]
+		if cumulative > rank {
+			return Value(index), nil
+		}
+	}
+	return Value(indexes[len(indexes)-1]), nil
+}
+
+// IsEmpty returns if the sketch has no value.
+func (s *Sketch) IsEmpty() bool {
+	return s.count <= 0
+}
+
+// sortedIndexes returns the indexes of bins in order.
+func (s *Sketch) sortedIndexes() []int {
+	indexes := make([]int, 0, len(s.bins))
+	for index := range s.bins {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// MarshalBinary marshals the sketch,
+// format: zero count + number of bins + (delta of bin index + bin count) for each bin in order of index.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	writer := stream.NewBufferWriter(nil)
+	s.writeTo(writer)
+	return writer.Bytes()
+}
+
+// writeTo writes the binary data of sketch into writer.
+func (s *Sketch) writeTo(writer *stream.BufferWriter) {
+	writer.PutUint64(math.Float64bits(s.zeroCount))
+	writer.PutUvarint32(uint32(len(s.bins)))
+	prev := 0
+	for _, index := range s.sortedIndexes() {
+		writer.PutVarint64(int64(index - prev))
+		writer.PutUint64(math.Float64bits(s.bins[index]))
+		prev = index
+	}
+}
+
+// UnmarshalBinary unmarshals the sketch from binary data, then merges it into current sketch.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	reader := stream.NewReader(data)
+	if err := s.readFrom(reader); err != nil {
+		return err
+	}
+	if !reader.Empty() {
+		return errInvalidSketch
+	}
+	return nil
+}
+
+// readFrom reads the binary data of sketch from reader, then merges it into current sketch.
+func (s *Sketch) readFrom(reader *stream.Reader) error {
+	zeroCount := math.Float64frombits(reader.ReadUint64())
+	numOfBins := reader.ReadUvarint32()
+	if reader.Error() != nil {
+		return errInvalidSketch
+	}
+	s.AddZero(zeroCount)
+	index := 0
+	for i := uint32(0); i < numOfBins; i++ {
+		index += int(reader.ReadVarint64())
+		count := math.Float64frombits(reader.ReadUint64())
+		if reader.Error() != nil {
+			return errInvalidSketch
+		}
+		s.AddBin(index, count)
+	}
+	return nil
+}
+
+// Reset resets the sketch for reusing.
+func (s *Sketch) Reset() {
+	for index := range s.bins {
+		delete(s.bins, index)
+	}
+	s.zeroCount = 0
+	s.count = 0
+}
@@ -0,0 +1,158 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ddsketch
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	for _, v := range []float64{0.001, 0.5, 1, 3, 100, 12345.6} {
+		index := Index(v)
+		assert.True(t, LowerBound(index) < v)
+		assert.True(t, LowerBound(index+1) >= v*(1-1e-9))
+		assert.InEpsilon(t, v, Value(index), RelativeAccuracy+1e-9)
+	}
+}
+
+func TestSketch_Quantile(t *testing.T) {
+	s := NewSketch()
+	v, err := s.Quantile(0.99)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, v)
+	_, err = s.Quantile(1.1)
+	assert.Error(t, err)
+
+	for i := 1; i <= 1000; i++ {
+		s.Add(float64(i), 1)
+	}
+	s.Add(1, 0)
+	assert.Equal(t, 1000.0, s.Count())
+	for _, q := range []float64{0.5, 0.9, 0.99} {
+		v, err = s.Quantile(q)
+		assert.NoError(t, err)
+		assert.InEpsilon(t, q*1000, v, RelativeAccuracy*1.1)
+	}
+	v, _ = s.Quantile(1)
+	assert.InEpsilon(t, 1000, v, RelativeAccuracy)
+
+	s.Reset()
+	s.Add(0, 10)
+	s.Add(-1, 10)
+	s.Add(5, 1)
+	assert.Equal(t, 20.0, s.ZeroCount())
+	v, _ = s.Quantile(0.5)
+	assert.Equal(t, 0.0, v)
+	v, _ = s.Quantile(1)
+	assert.InEpsilon(t, 5, v, RelativeAccuracy)
+}
+
+func TestSketch_AddRange(t *testing.T) {
+	s := NewSketch()
+	s.AddRange(0, 10, 0)
+	assert.Equal(t, 0.0, s.Count())
+	s.AddRange(-10, -1, 5)
+	assert.Equal(t, 5.0, s.ZeroCount())
+	s.Reset()
+
+	// raw value
+	s.AddRange(10, 10, 10)
+	assert.Equal(t, 10.0, s.Bins()[Index(10)])
+	// upper less than lower/+Inf upper, added into bin of lower
+	s.AddRange(10, 1, 5)
+	s.AddRange(10, math.Inf(1), 5)
+	assert.Equal(t, 20.0, s.Bins()[Index(10)])
+	v, _ := s.Quantile(0.5)
+	assert.InEpsilon(t, 10, v, RelativeAccuracy)
+
+	// range aligned with bin
+	s.Reset()
+	for i := 1; i <= 100; i++ {
+		s.AddRange(LowerBound(i), LowerBound(i+1), 1)
+	}
+	assert.Len(t, s.Bins(), 100)
+	v, _ = s.Quantile(0.99)
+	assert.Equal(t, Value(99), v)
+
+	// range spans over multi bins, count interpolated by overlapped length
+	s.Reset()
+	s.AddRange(10, 100, 900)
+	assert.InDelta(t, 900, s.Count(), 1e-6)
+	assert.Len(t, s.Bins(), Index(100)-Index(10)+1)
+	for _, q := range []float64{0.1, 0.5, 0.9} {
+		v, _ = s.Quantile(q)
+		assert.InEpsilon(t, 10+90*q, v, RelativeAccuracy)
+	}
+	// interpolated bins limited
+	s.Reset()
+	s.AddRange(0, 1000, 1000)
+	assert.InDelta(t, 1000, s.Count(), 1e-6)
+	assert.Len(t, s.Bins(), maxInterpolatedBins)
+	v, _ = s.Quantile(0.5)
+	assert.InEpsilon(t, 500, v, RelativeAccuracy)
+}
+
+func TestSketch_Merge(t *testing.T) {
+	s1 := NewSketch()
+	s2 := NewSketch()
+	for i := 1; i <= 100; i++ {
+		s1.Add(float64(i), 5)
+		s2.Add(float64(i), 5)
+	}
+	s2.AddZero(0)
+	s1.Merge(s2)
+	assert.InDelta(t, 1000.0, s1.Count(), 1e-6)
+	v, _ := s1.Quantile(0.99)
+	assert.InEpsilon(t, 99, v, 0.1)
+}
+
+func TestSketch_MarshalBinary(t *testing.T) {
+	s := NewSketch()
+	s.AddZero(2)
+	s.Add(10, 3)
+	s.Add(0.001, 1)
+	s.Add(1000, 4)
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+
+	s2 := NewSketch()
+	assert.NoError(t, s2.UnmarshalBinary(data))
+	assert.Equal(t, s.Bins(), s2.Bins())
+	assert.Equal(t, s.ZeroCount(), s2.ZeroCount())
+	assert.Equal(t, s.Count(), s2.Count())
+	// unmarshal merges into current sketch
+	assert.NoError(t, s2.UnmarshalBinary(data))
+	assert.Equal(t, s.Count()*2, s2.Count())
+
+	// empty sketch
+	data, err = NewSketch().MarshalBinary()
+	assert.NoError(t, err)
+	s3 := NewSketch()
+	assert.NoError(t, s3.UnmarshalBinary(data))
+	assert.True(t, s3.IsEmpty())
+
+	// invalid data
+	assert.Error(t, NewSketch().UnmarshalBinary(nil))
+	assert.Error(t, NewSketch().UnmarshalBinary([]byte{1, 2, 3}))
+	data, _ = s.MarshalBinary()
+	assert.Error(t, NewSketch().UnmarshalBinary(data[:len(data)-1]))
+	assert.Error(t, NewSketch().UnmarshalBinary(append(data, 1)))
+}
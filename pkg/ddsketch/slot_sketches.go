@@ -0,0 +1,143 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ddsketch
+
+import (
+	"sort"
+
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+// SlotSketches represents the sketches of time slots, which is the data of histogram sketch field.
+type SlotSketches struct {
+	sketches map[int]*Sketch
+}
+
+// NewSlotSketches creates an empty SlotSketches.
+func NewSlotSketches() *SlotSketches {
+	return &SlotSketches{
+		sketches: make(map[int]*Sketch),
+	}
+}
+
+// Get returns the sketch of time slot, if not exist creates it.
+func (ss *SlotSketches) Get(slot int) *Sketch {
+	sketch, ok := ss.sketches[slot]
+	if !ok {
+		sketch = NewSketch()
+		ss.sketches[slot] = sketch
+	}
+	return sketch
+}
+
+// Lookup returns the sketch of time slot, returns false if not exist.
+func (ss *SlotSketches) Lookup(slot int) (*Sketch, bool) {
+	sketch, ok := ss.sketches[slot]
+	return sketch, ok
+}
+
+// Merge merges the sketch into the sketch of time slot.
+func (ss *SlotSketches) Merge(slot int, sketch *Sketch) {
+	if sketch == nil || sketch.IsEmpty() {
+		return
+	}
+	ss.Get(slot).Merge(sketch)
+}
+
+// Slots returns the time slots in order.
+func (ss *SlotSketches) Slots() []int {
+	slots := make([]int, 0, len(ss.sketches))
+	for slot := range ss.sketches {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+	return slots
+}
+
+// Len returns the number of time slots.
+func (ss *SlotSketches) Len() int {
+	return len(ss.sketches)
+}
+
+// NumOfBins returns the total number of bins of all time slots.
+func (ss *SlotSketches) NumOfBins() int {
+	num := 0
+	for _, sketch := range ss.sketches {
+		num += len(sketch.bins)
+	}
+	return num
+}
+
+// MarshalBinary marshals the sketches of time slots in order,
+// format: (delta of slot + sketch) for each time slot.
+func (ss *SlotSketches) MarshalBinary() ([]byte, error) {
+	return ss.marshalRange(func(_ int) bool { return true })
+}
+
+// MarshalRange marshals the sketches of time slots which in range [start, end].
+func (ss *SlotSketches) MarshalRange(start, end int) ([]byte, error) {
+	return ss.marshalRange(func(slot int) bool { return slot >= start && slot <= end })
+}
+
+func (ss *SlotSketches) marshalRange(accept func(slot int) bool) ([]byte, error) {
+	writer := stream.NewBufferWriter(nil)
+	prev := 0
+	for _, slot := range ss.Slots() {
+		sketch := ss.sketches[slot]
+		if sketch.IsEmpty() || !accept(slot) {
+			continue
+		}
+		writer.PutVarint64(int64(slot - prev))
+		sketch.writeTo(writer)
+		prev = slot
+	}
+	return writer.Bytes()
+}
+
+// UnmarshalBinary unmarshals the sketches of time slots, then merges them.
+func (ss *SlotSketches) UnmarshalBinary(data []byte) error {
+	return ss.UnmarshalWith(data, func(slot int) (int, bool) { return slot, true })
+}
+
+// UnmarshalWith unmarshals the sketches of time slots, then merges them into the target slot
+// which is returned by slotFn, the sketch is dropped if slotFn returns false.
+func (ss *SlotSketches) UnmarshalWith(data []byte, slotFn func(slot int) (target int, ok bool)) error {
+	reader := stream.NewReader(data)
+	slot := 0
+	for !reader.Empty() {
+		slot += int(reader.ReadVarint64())
+		if reader.Error() != nil {
+			return errInvalidSketch
+		}
+		sketch := NewSketch()
+		if err := sketch.readFrom(reader); err != nil {
+			return err
+		}
+		if target, ok := slotFn(slot); ok {
+			ss.Merge(target, sketch)
+		}
+	}
+	return nil
+}
+
+// Reset resets the sketches for reusing.
+func (ss *SlotSketches) Reset() {
+	for slot := range ss.sketches {
+		delete(ss.sketches, slot)
+	}
+}
@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ddsketch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotSketches(t *testing.T) {
+	ss := NewSlotSketches()
+	ss.Merge(1, nil)
+	ss.Merge(1, NewSketch())
+	assert.Equal(t, 0, ss.Len())
+	_, ok := ss.Lookup(1)
+	assert.False(t, ok)
+
+	s := NewSketch()
+	s.Add(10, 1)
+	s.Add(100, 1)
+	ss.Merge(20, s)
+	ss.Merge(5, s)
+	ss.Merge(5, s)
+	assert.Equal(t, 2, ss.Len())
+	assert.Equal(t, 4, ss.NumOfBins())
+	assert.Equal(t, []int{5, 20}, ss.Slots())
+	sketch, ok := ss.Lookup(5)
+	assert.True(t, ok)
+	assert.Equal(t, 4.0, sketch.Count())
+
+	data, err := ss.MarshalBinary()
+	assert.NoError(t, err)
+	ss2 := NewSlotSketches()
+	assert.NoError(t, ss2.UnmarshalBinary(data))
+	assert.Equal(t, ss.Slots(), ss2.Slots())
+	sketch, _ = ss2.Lookup(20)
+	assert.Equal(t, 2.0, sketch.Count())
+
+	// marshal range
+	data, err = ss.MarshalRange(10, 30)
+	assert.NoError(t, err)
+	ss2.Reset()
+	assert.Equal(t, 0, ss2.Len())
+	assert.NoError(t, ss2.UnmarshalBinary(data))
+	assert.Equal(t, []int{20}, ss2.Slots())
+
+	// unmarshal with target slot
+	data, _ = ss.MarshalBinary()
+	ss2.Reset()
+	assert.NoError(t, ss2.UnmarshalWith(data, func(slot int) (int, bool) {
+		return 0, slot < 10
+	}))
+	assert.Equal(t, []int{0}, ss2.Slots())
+	sketch, _ = ss2.Lookup(0)
+	assert.Equal(t, 4.0, sketch.Count())
+
+	// invalid data
+	assert.Error(t, ss2.UnmarshalBinary([]byte{1}))
+	assert.Error(t, ss2.UnmarshalBinary(data[:len(data)-1]))
+}
@@ -0,0 +1,119 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package encoding
+
+import (
+	"math"
+	"sync"
+)
+
+var blockPool = sync.Pool{
+	New: func() interface{} {
+		return &TSDBlock{}
+	},
+}
+
+// GetTSDBlock picks a tsd block from pool.
+func GetTSDBlock() *TSDBlock {
+	return blockPool.Get().(*TSDBlock)
+}
+
+// ReleaseTSDBlock puts the tsd block back to pool for re-use.
+func ReleaseTSDBlock(block *TSDBlock) {
+	if block != nil {
+		blockPool.Put(block)
+	}
+}
+
+// TSDBlock represents the materialized values of a continuous slot range of tsd,
+// values are decoded at once, so that aggregation can iterate a plain float64 slice.
+type TSDBlock struct {
+	start  uint16
+	values []float64
+	exists []bool
+}
+
+// Start returns the first slot of block.
+func (b *TSDBlock) Start() uint16 {
+	return b.start
+}
+
+// Len returns the number of slots of block.
+func (b *TSDBlock) Len() int {
+	return len(b.values)
+}
+
+// Values returns the decoded values and the existence flags of each slot(index = slot - start).
+func (b *TSDBlock) Values() (values []float64, exists []bool) {
+	return b.values, b.exists
+}
+
+// GetValue returns value by time slot, if it hasn't, return false.
+func (b *TSDBlock) GetValue(slot uint16) (float64, bool) {
+	if slot < b.start {
+		return 0, false
+	}
+	idx := int(slot - b.start)
+	if idx >= len(b.values) || !b.exists[idx] {
+		return 0, false
+	}
+	return b.values[idx], true
+}
+
+// reset resets the block with slot range, keeps the underlying slices if the capacity is enough.
+func (b *TSDBlock) reset(start uint16, size int) {
+	b.start = start
+	if cap(b.values) < size {
+		b.values = make([]float64, size)
+		b.exists = make([]bool, size)
+		return
+	}
+	b.values = b.values[:size]
+	b.exists = b.exists[:size]
+	for i := range b.exists {
+		b.exists[i] = false
+	}
+}
+
+// DecodeBlock decodes all remaining slots of tsd into block,
+// instead of walking the data slot by slot when do down sampling.
+func (d *TSDDecoder) DecodeBlock(block *TSDBlock) {
+	start := d.startTime + d.idx
+	if d.reader == nil || start > d.endTime {
+		block.reset(start, 0)
+		return
+	}
+	size := int(d.endTime-start) + 1
+	block.reset(start, size)
+	values := block.values
+	exists := block.exists
+	for i := 0; i < size; i++ {
+		d.idx++
+		if !d.HasValue() {
+			if d.err != nil {
+				// truncate block when read failure
+				block.values = values[:i]
+				block.exists = exists[:i]
+				return
+			}
+			continue
+		}
+		values[i] = math.Float64frombits(d.Value())
+		exists[i] = true
+	}
+}
@@ -218,3 +218,95 @@ func TestTSDDecoder_GetValue(t *testing.T) {
 	assert.False(t, ok)
 	assert.Equal(t, 0.0, v)
 }
+
+func TestTSDDecoder_DecodeBlock(t *testing.T) {
+	encoder := NewTSDEncoder(10)
+	for i := 0; i < 100; i++ {
+		if i%3 == 0 {
+			encoder.AppendTime(bit.Zero)
+			continue
+		}
+		encoder.AppendTime(bit.One)
+		encoder.AppendValue(math.Float64bits(float64(i)))
+	}
+	data, err := encoder.Bytes()
+	assert.NoError(t, err)
+
+	block := GetTSDBlock()
+	defer ReleaseTSDBlock(block)
+	decoder := NewTSDDecoder(data)
+	decoder.DecodeBlock(block)
+	assert.Equal(t, uint16(10), block.Start())
+	assert.Equal(t, 100, block.Len())
+
+	decoder.Reset(data)
+	for slot := uint16(0); slot < 120; slot++ {
+		v1, ok1 := decoder.GetValue(slot)
+		v2, ok2 := block.GetValue(slot)
+		assert.Equal(t, ok1, ok2)
+		assert.Equal(t, v1, v2)
+	}
+	// decode remaining slots
+	decoder.Reset(data)
+	_, _ = decoder.GetValue(10)
+	_, _ = decoder.GetValue(11)
+	decoder.DecodeBlock(block)
+	assert.Equal(t, uint16(12), block.Start())
+	assert.Equal(t, 98, block.Len())
+	v, ok := block.GetValue(12)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, v)
+	// exhausted
+	decoder.DecodeBlock(block)
+	assert.Equal(t, 0, block.Len())
+	_, ok = block.GetValue(110)
+	assert.False(t, ok)
+	// empty decoder
+	NewTSDDecoder(nil).DecodeBlock(block)
+	assert.Equal(t, 0, block.Len())
+}
+
+func BenchmarkTSDDecoder_GetValue(b *testing.B) {
+	data := benchmarkTSDData()
+	decoder := NewTSDDecoder(data)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decoder.Reset(data)
+		sum := 0.0
+		for slot := decoder.StartTime(); slot <= decoder.EndTime(); slot++ {
+			if v, ok := decoder.GetValue(slot); ok {
+				sum += v
+			}
+		}
+	}
+}
+
+func BenchmarkTSDDecoder_DecodeBlock(b *testing.B) {
+	data := benchmarkTSDData()
+	decoder := NewTSDDecoder(data)
+	block := GetTSDBlock()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decoder.Reset(data)
+		decoder.DecodeBlock(block)
+		sum := 0.0
+		values, exists := block.Values()
+		for idx, v := range values {
+			if exists[idx] {
+				sum += v
+			}
+		}
+	}
+}
+
+func benchmarkTSDData() []byte {
+	encoder := NewTSDEncoder(0)
+	for i := 0; i < 4096; i++ {
+		encoder.AppendTime(bit.One)
+		encoder.AppendValue(math.Float64bits(float64(i % 100)))
+	}
+	data, _ := encoder.Bytes()
+	return data
+}
@@ -0,0 +1,249 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package error
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lindb/lindb/constants"
+)
+
+// errorDomain represents the domain of error info in grpc status details.
+const errorDomain = "lindb"
+
+// Code represents the stable error code responded to client,
+// so that client can implement precise retry/alert policies by it.
+type Code int32
+
+const (
+	// Internal represents the unclassified internal error(default).
+	Internal Code = iota
+	// ParseError represents the lin query language or request parameter cannot be parsed.
+	ParseError
+	// MetadataNotFound represents the database/metric/field/tag not found.
+	MetadataNotFound
+	// LimitExceeded represents the request exceeds the limits(concurrency/series/disk quota etc.).
+	LimitExceeded
+	// Timeout represents the request exceeds timeout.
+	Timeout
+	// StorageUnavailable represents no live storage node/replica can serve the request.
+	StorageUnavailable
+	// PartialResult represents the result only includes a part of targets, others failed.
+	PartialResult
+
+	numOfCodes
+)
+
+var codeNames = [numOfCodes]string{
+	"INTERNAL",
+	"PARSE_ERROR",
+	"METADATA_NOT_FOUND",
+	"LIMIT_EXCEEDED",
+	"TIMEOUT",
+	"STORAGE_UNAVAILABLE",
+	"PARTIAL_RESULT",
+}
+
+// String returns the name of error code.
+func (c Code) String() string {
+	if c < 0 || c >= numOfCodes {
+		return codeNames[Internal]
+	}
+	return codeNames[c]
+}
+
+// ParseCode parses the error code by name, returns internal if name is unknown.
+func ParseCode(name string) Code {
+	for idx, codeName := range codeNames {
+		if codeName == name {
+			return Code(idx)
+		}
+	}
+	return Internal
+}
+
+// MarshalText marshals the error code as name.
+func (c Code) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText unmarshals the error code from name.
+func (c *Code) UnmarshalText(text []byte) error {
+	*c = ParseCode(string(text))
+	return nil
+}
+
+// Retryable returns if the request may succeed when retrying later.
+func (c Code) Retryable() bool {
+	switch c {
+	case Timeout, StorageUnavailable, PartialResult:
+		return true
+	default:
+		return false
+	}
+}
+
+// HTTPStatus returns the http status code of error code.
+func (c Code) HTTPStatus() int {
+	switch c {
+	case ParseError:
+		return http.StatusBadRequest
+	case MetadataNotFound:
+		return http.StatusNotFound
+	case LimitExceeded:
+		return http.StatusTooManyRequests
+	case Timeout:
+		return http.StatusGatewayTimeout
+	case StorageUnavailable:
+		return http.StatusServiceUnavailable
+	case PartialResult:
+		return http.StatusOK
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GRPCCode returns the grpc status code of error code.
+func (c Code) GRPCCode() codes.Code {
+	switch c {
+	case ParseError:
+		return codes.InvalidArgument
+	case MetadataNotFound:
+		return codes.NotFound
+	case LimitExceeded:
+		return codes.ResourceExhausted
+	case Timeout:
+		return codes.DeadlineExceeded
+	case StorageUnavailable:
+		return codes.Unavailable
+	case PartialResult:
+		return codes.Aborted
+	default:
+		return codes.Internal
+	}
+}
+
+// codedError represents the error with error code.
+type codedError struct {
+	code Code
+	err  error
+}
+
+// Error returns the message of error.
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *codedError) Unwrap() error {
+	return e.err
+}
+
+// WithCode returns the error annotated with error code, returns nil if err is nil.
+func WithCode(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{code: code, err: err}
+}
+
+// Errorf formats the error annotated with error code.
+func Errorf(code Code, format string, args ...interface{}) error {
+	return WithCode(code, fmt.Errorf(format, args...))
+}
+
+// codesOfErrors represents the error code of well-known errors, checks in order.
+var codesOfErrors = []struct {
+	code Code
+	errs []error
+}{
+	{Timeout, []error{constants.ErrTimeout, context.DeadlineExceeded}},
+	{StorageUnavailable, []error{
+		constants.ErrNoLiveReplica, constants.ErrNoLiveNode, constants.ErrNoAvailableStorageNode,
+		constants.ErrNoStorageCluster, constants.ErrReplicaNotFound, constants.ErrTargetNodesNotFound,
+		constants.ErrReceiveNodesNotFound, constants.ErrStorageReadOnly,
+	}},
+	{LimitExceeded, []error{
+		constants.ErrTooManySeries, constants.ErrTooManyMetadata, constants.ErrTooManyTagKeys,
+		constants.ErrTooManyFields, constants.ErrTooManySeriesFound, constants.ErrTooManyGroupsForGroupByAll,
+		constants.ErrTooManyMetricsMatched, constants.ErrDiskQuotaExceeded,
+	}},
+	{MetadataNotFound, []error{constants.ErrNotFound, constants.ErrDatabaseNotExist}},
+	{ParseError, []error{constants.ErrDatabaseNameRequired, constants.ErrEmptySelectList}},
+}
+
+// CodeOf returns the error code of error, which is annotated by WithCode, carried by grpc status
+// or classified by well-known errors, returns internal if error is unclassified.
+func CodeOf(err error) Code {
+	if err == nil {
+		return Internal
+	}
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return codeOfStatus(st)
+	}
+	for _, item := range codesOfErrors {
+		for _, target := range item.errs {
+			if errors.Is(err, target) {
+				return item.code
+			}
+		}
+	}
+	return Internal
+}
+
+// codeOfStatus returns the error code carried by grpc status details, else maps grpc status code.
+func codeOfStatus(st *status.Status) Code {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain {
+			return ParseCode(info.Reason)
+		}
+	}
+	for c := Code(0); c < numOfCodes; c++ {
+		if c.GRPCCode() == st.Code() {
+			return c
+		}
+	}
+	return Internal
+}
+
+// GRPCError converts the error to grpc status error, error code is carried by status details.
+func GRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := CodeOf(err)
+	st := status.New(code.GRPCCode(), err.Error())
+	if detailed, err0 := st.WithDetails(&errdetails.ErrorInfo{Reason: code.String(), Domain: errorDomain}); err0 == nil {
+		st = detailed
+	}
+	return st.Err()
+}
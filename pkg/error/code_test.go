@@ -0,0 +1,115 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package error
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lindb/lindb/constants"
+)
+
+func TestCode_String(t *testing.T) {
+	for c := Internal; c < numOfCodes; c++ {
+		assert.Equal(t, c, ParseCode(c.String()))
+	}
+	assert.Equal(t, "INTERNAL", Code(100).String())
+	assert.Equal(t, Internal, ParseCode("unknown"))
+
+	data, err := json.Marshal(map[string]Code{"code": LimitExceeded})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"code":"LIMIT_EXCEEDED"}`, string(data))
+	rs := map[string]Code{}
+	assert.NoError(t, json.Unmarshal(data, &rs))
+	assert.Equal(t, LimitExceeded, rs["code"])
+}
+
+func TestCode_Mapping(t *testing.T) {
+	cases := []struct {
+		code      Code
+		retryable bool
+		http      int
+		grpc      codes.Code
+	}{
+		{Internal, false, http.StatusInternalServerError, codes.Internal},
+		{ParseError, false, http.StatusBadRequest, codes.InvalidArgument},
+		{MetadataNotFound, false, http.StatusNotFound, codes.NotFound},
+		{LimitExceeded, false, http.StatusTooManyRequests, codes.ResourceExhausted},
+		{Timeout, true, http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{StorageUnavailable, true, http.StatusServiceUnavailable, codes.Unavailable},
+		{PartialResult, true, http.StatusOK, codes.Aborted},
+	}
+	for _, tt := range cases {
+		assert.Equal(t, tt.retryable, tt.code.Retryable(), tt.code.String())
+		assert.Equal(t, tt.http, tt.code.HTTPStatus(), tt.code.String())
+		assert.Equal(t, tt.grpc, tt.code.GRPCCode(), tt.code.String())
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	assert.Nil(t, WithCode(Timeout, nil))
+	assert.Equal(t, Internal, CodeOf(nil))
+	assert.Equal(t, Internal, CodeOf(errors.New("err")))
+
+	err := Errorf(ParseError, "bad sql: %s", "select")
+	assert.Equal(t, "bad sql: select", err.Error())
+	assert.Equal(t, ParseError, CodeOf(fmt.Errorf("wrap: %w", err)))
+	sentinel := errors.New("sentinel")
+	assert.True(t, errors.Is(WithCode(LimitExceeded, sentinel), sentinel))
+
+	// well-known errors
+	assert.Equal(t, Timeout, CodeOf(constants.ErrTimeout))
+	assert.Equal(t, Timeout, CodeOf(context.DeadlineExceeded))
+	assert.Equal(t, StorageUnavailable, CodeOf(constants.ErrNoLiveReplica))
+	assert.Equal(t, StorageUnavailable, CodeOf(constants.ErrReplicaNotFound))
+	assert.Equal(t, StorageUnavailable, CodeOf(fmt.Errorf("%w, database: db", constants.ErrStorageReadOnly)))
+	assert.Equal(t, LimitExceeded, CodeOf(fmt.Errorf("%w, limit: 10", constants.ErrTooManySeriesFound)))
+	assert.Equal(t, MetadataNotFound, CodeOf(constants.ErrDatabaseNotFound))
+	assert.Equal(t, MetadataNotFound, CodeOf(constants.ErrMetricIDNotFound))
+	assert.Equal(t, ParseError, CodeOf(constants.ErrEmptySelectList))
+
+	// grpc status
+	assert.Equal(t, StorageUnavailable, CodeOf(status.Error(codes.Unavailable, "err")))
+	assert.Equal(t, Internal, CodeOf(status.Error(codes.Unknown, "err")))
+	assert.Equal(t, Internal, CodeOf(status.Error(codes.Canceled, "err")))
+}
+
+func TestGRPCError(t *testing.T) {
+	assert.Nil(t, GRPCError(nil))
+	st := status.Error(codes.Unavailable, "err")
+	assert.Equal(t, st, GRPCError(st))
+
+	err := GRPCError(WithCode(PartialResult, errors.New("partial")))
+	s, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, s.Code())
+	assert.Equal(t, "partial", s.Message())
+	assert.Equal(t, PartialResult, CodeOf(err))
+
+	err = GRPCError(constants.ErrTooManyFields)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, LimitExceeded, CodeOf(err))
+}
@@ -0,0 +1,35 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"os"
+)
+
+// ReadRange represents a contiguous region of file.
+type ReadRange struct {
+	Offset int64
+	Length int
+}
+
+// BatchRead reads the regions(sorted by offset, non-overlapping) of file into dst in order, returns the bytes read.
+// If platform supports vectorized read(preadv on linux), nearby regions are read by one syscall.
+// It is used for loading many small blocks, make page cache warm before accessing mmap data.
+func BatchRead(f *os.File, ranges []ReadRange, dst []byte) (int, error) {
+	return batchRead(f, ranges, dst)
+}
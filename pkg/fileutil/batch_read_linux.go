@@ -0,0 +1,102 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+
+package fileutil
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// maxIOVecs is the max number of io vectors for one preadv syscall(IOV_MAX).
+	maxIOVecs = 1024
+	// maxReadGap is the max gap between two regions which can be read by one preadv syscall.
+	maxReadGap = 4 * 1024
+)
+
+// for testing
+var (
+	preadvFunc = unix.Preadv
+)
+
+// batchRead reads the regions of file using preadv, nearby regions are read by one syscall,
+// the gap between regions is read into discard buffer.
+func batchRead(f *os.File, ranges []ReadRange, dst []byte) (int, error) {
+	fd := int(f.Fd())
+	discard := make([]byte, maxReadGap)
+	iovs := make([][]byte, 0, 2*len(ranges))
+	n := 0
+	for i := 0; i < len(ranges) && n+ranges[i].Length <= len(dst); {
+		start := ranges[i].Offset
+		offset := start
+		size := 0
+		iovs = iovs[:0]
+		for i < len(ranges) && len(iovs)+2 <= maxIOVecs && n+size+ranges[i].Length <= len(dst) {
+			r := ranges[i]
+			gap := r.Offset - offset
+			if gap < 0 || gap > maxReadGap {
+				break
+			}
+			if gap > 0 {
+				iovs = append(iovs, discard[:gap])
+			}
+			if r.Length > 0 {
+				iovs = append(iovs, dst[n+size:n+size+r.Length])
+			}
+			size += r.Length
+			offset = r.Offset + int64(r.Length)
+			i++
+		}
+		if err := preadvFull(fd, iovs, start); err != nil {
+			return n, err
+		}
+		n += size
+	}
+	return n, nil
+}
+
+// preadvFull reads the io vectors fully, preadv may read less bytes than requested(short read),
+// continues reading the remaining io vectors until all read or reaching the end of file.
+func preadvFull(fd int, iovs [][]byte, offset int64) error {
+	for len(iovs) > 0 {
+		read, err := preadvFunc(fd, iovs, offset)
+		if err != nil {
+			if err == unix.EINTR {
+				continue
+			}
+			return err
+		}
+		if read == 0 {
+			return io.ErrUnexpectedEOF
+		}
+		offset += int64(read)
+		for read > 0 {
+			if read < len(iovs[0]) {
+				iovs[0] = iovs[0][read:]
+				break
+			}
+			read -= len(iovs[0])
+			iovs = iovs[1:]
+		}
+	}
+	return nil
+}
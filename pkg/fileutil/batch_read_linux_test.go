@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestBatchRead_short_read(t *testing.T) {
+	defer func() {
+		preadvFunc = unix.Preadv
+	}()
+	filename := filepath.Join(t.TempDir(), "testdata")
+	content := make([]byte, 1024)
+	for i := range content {
+		content[i] = byte(i % 256)
+	}
+	assert.NoError(t, os.WriteFile(filename, content, 0644))
+	f, err := os.Open(filename)
+	assert.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+	ranges := []ReadRange{
+		{Offset: 0, Length: 10},
+		{Offset: 20, Length: 0},
+		{Offset: 100, Length: 20},
+	}
+	// read at most 7 bytes each syscall
+	calls := 0
+	preadvFunc = func(fd int, iovs [][]byte, offset int64) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, unix.EINTR
+		}
+		if len(iovs[0]) > 7 {
+			iovs = [][]byte{iovs[0][:7]}
+		}
+		return unix.Preadv(fd, iovs[:1], offset)
+	}
+	dst := make([]byte, 30)
+	n, err := BatchRead(f, ranges, dst)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, n)
+	assert.Equal(t, append(append([]byte{}, content[:10]...), content[100:120]...), dst)
+
+	// reach the end of file
+	preadvFunc = unix.Preadv
+	n, err = BatchRead(f, []ReadRange{{Offset: 1020, Length: 10}}, dst)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, 0, n)
+}
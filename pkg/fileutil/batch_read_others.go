@@ -0,0 +1,40 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !linux
+
+package fileutil
+
+import (
+	"os"
+)
+
+// batchRead reads the regions of file one by one if platform not supports vectorized read.
+func batchRead(f *os.File, ranges []ReadRange, dst []byte) (int, error) {
+	n := 0
+	for _, r := range ranges {
+		if n+r.Length > len(dst) {
+			break
+		}
+		read, err := f.ReadAt(dst[n:n+r.Length], r.Offset)
+		n += read
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,65 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchRead(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "testdata")
+	content := make([]byte, 20*1024)
+	for i := range content {
+		content[i] = byte(i % 256)
+	}
+	assert.NoError(t, os.WriteFile(filename, content, 0644))
+	f, err := os.Open(filename)
+	assert.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+
+	ranges := []ReadRange{
+		{Offset: 0, Length: 10},
+		{Offset: 10, Length: 5},
+		{Offset: 100, Length: 20},
+		{Offset: 10 * 1024, Length: 100},
+	}
+	dst := make([]byte, 135)
+	n, err := BatchRead(f, ranges, dst)
+	assert.NoError(t, err)
+	assert.Equal(t, 135, n)
+	var expect []byte
+	for _, r := range ranges {
+		expect = append(expect, content[r.Offset:r.Offset+int64(r.Length)]...)
+	}
+	assert.Equal(t, expect, dst)
+
+	// dst too small
+	n, err = BatchRead(f, ranges, make([]byte, 30))
+	assert.NoError(t, err)
+	assert.Equal(t, 15, n)
+	// read failure
+	_ = f.Close()
+	_, err = BatchRead(f, ranges, dst)
+	assert.Error(t, err)
+}
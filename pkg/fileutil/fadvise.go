@@ -0,0 +1,34 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"os"
+)
+
+// Fadvise applies advice on the page cache of file(fadvise), e.g. dontneed releases the cached pages which are not mapped,
+// it doesn't affect the read pattern of memory mapping shared with other readers of file(use Madvise instead).
+func Fadvise(f *os.File, advice Advice) error {
+	return fadvise(f, 0, 0, advice)
+}
+
+// FadviseRange applies advice on the page cache of file range[offset, offset+length),
+// length 0 means until the end of file.
+func FadviseRange(f *os.File, offset, length int64, advice Advice) error {
+	return fadvise(f, offset, length, advice)
+}
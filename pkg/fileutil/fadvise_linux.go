@@ -0,0 +1,41 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func fadvise(f *os.File, offset, length int64, advice Advice) error {
+	var flag int
+	switch advice {
+	case AdviceRandom:
+		flag = unix.FADV_RANDOM
+	case AdviceSequential:
+		flag = unix.FADV_SEQUENTIAL
+	case AdviceWillNeed:
+		flag = unix.FADV_WILLNEED
+	case AdviceDontNeed:
+		flag = unix.FADV_DONTNEED
+	default:
+		flag = unix.FADV_NORMAL
+	}
+	return unix.Fadvise(int(f.Fd()), offset, length, flag)
+}
@@ -0,0 +1,40 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"os"
+)
+
+// Fallocate pre-allocates the disk space of file with given size,
+// avoids block allocation when writing the memory-mapped file.
+func Fallocate(f *os.File, size int64) error {
+	fstat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fstat.Size() >= size {
+		return nil
+	}
+	return fallocate(f, size)
+}
+
+// truncate extends the file size without allocating disk space.
+func truncate(f *os.File, size int64) error {
+	return f.Truncate(size)
+}
@@ -0,0 +1,46 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFallocate(t *testing.T) {
+	f, err := os.OpenFile(filepath.Join(t.TempDir(), "f"), os.O_CREATE|os.O_RDWR, 0644)
+	assert.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+	assert.NoError(t, Fallocate(f, 8192))
+	stat, err := f.Stat()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8192), stat.Size())
+	// smaller size, do nothing
+	assert.NoError(t, Fallocate(f, 4096))
+	stat, err = f.Stat()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8192), stat.Size())
+	// file closed
+	assert.NoError(t, f.Close())
+	assert.Error(t, Fallocate(f, 4096))
+}
@@ -115,6 +115,39 @@ func GetExistPath(path string) string {
 	return GetExistPath(dir)
 }
 
+// DirSize returns the total bytes of all files under given dir, returns 0 if dir not exist.
+func DirSize(path string) (int64, error) {
+	if !Exist(path) {
+		return 0, nil
+	}
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// file removed when walking(compaction/ttl etc.)
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
 // readDir lists all files/directories.
 func readDir(path string, fn func(f fs.DirEntry)) error {
 	files, err := os.ReadDir(path)
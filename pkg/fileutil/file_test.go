@@ -136,3 +136,17 @@ func TestRemoveFile(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, files, 1)
 }
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	size, err := DirSize(filepath.Join(dir, "not_exist"))
+	assert.NoError(t, err)
+	assert.Zero(t, size)
+
+	assert.NoError(t, MkDir(filepath.Join(dir, "a", "b")))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a", "1.sst"), []byte("12345"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a", "b", "2.sst"), []byte("123"), 0600))
+	size, err = DirSize(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), size)
+}
@@ -0,0 +1,77 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import "strings"
+
+// Advice represents the access pattern advice of memory-mapped data(madvise).
+type Advice int
+
+// Defines all access pattern advices.
+const (
+	// AdviceNormal represents no special treatment.
+	AdviceNormal Advice = iota
+	// AdviceRandom expects page references in random order, disables read ahead.
+	AdviceRandom
+	// AdviceSequential expects page references in sequential order, reads ahead aggressively.
+	AdviceSequential
+	// AdviceWillNeed expects access in the near future, reads pages ahead.
+	AdviceWillNeed
+	// AdviceDontNeed expects no access in the near future, frees the page cache.
+	AdviceDontNeed
+)
+
+// String returns the string value of advice.
+func (a Advice) String() string {
+	switch a {
+	case AdviceRandom:
+		return "random"
+	case AdviceSequential:
+		return "sequential"
+	case AdviceWillNeed:
+		return "willneed"
+	case AdviceDontNeed:
+		return "dontneed"
+	default:
+		return "normal"
+	}
+}
+
+// ParseAdvice parses advice from string value, returns AdviceNormal if not match.
+func ParseAdvice(advice string) Advice {
+	switch strings.ToLower(advice) {
+	case "random":
+		return AdviceRandom
+	case "sequential":
+		return AdviceSequential
+	case "willneed":
+		return AdviceWillNeed
+	case "dontneed":
+		return AdviceDontNeed
+	default:
+		return AdviceNormal
+	}
+}
+
+// Madvise gives advice about use of memory-mapped data.
+func Madvise(data []byte, advice Advice) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return madvise(data, advice)
+}
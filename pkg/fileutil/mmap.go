@@ -78,6 +78,20 @@ func Unmap(f *os.File, data []byte) error {
 	return munmap(f, data)
 }
 
+// MapAnonymous allocates the memory(not backed by file) out of go heap with size,
+// the memory must be released by UnmapAnonymous.
+func MapAnonymous(size int) ([]byte, error) {
+	return mmapAnonymous(size)
+}
+
+// UnmapAnonymous releases the memory allocated by MapAnonymous.
+func UnmapAnonymous(data []byte) error {
+	if data == nil {
+		return nil
+	}
+	return munmapAnonymous(data)
+}
+
 func Sync(data []byte) error {
 	return msync(data)
 }
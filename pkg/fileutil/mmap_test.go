@@ -75,3 +75,29 @@ func TestRWMap(t *testing.T) {
 
 	assert.Equal(t, content, fileContent[0:len(content)])
 }
+
+func TestMapAnonymous(t *testing.T) {
+	data, err := MapAnonymous(1024)
+	assert.NoError(t, err)
+	assert.Len(t, data, 1024)
+	copy(data, "12345")
+	assert.Equal(t, []byte("12345"), data[:5])
+	assert.NoError(t, UnmapAnonymous(data))
+	assert.NoError(t, UnmapAnonymous(nil))
+}
+
+func TestMadvise(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "testdata")
+	assert.NoError(t, os.WriteFile(filename, []byte("abc123"), 0644))
+	file, err := os.Open(filename)
+	assert.NoError(t, err)
+	bys, err := Map(file)
+	assert.NoError(t, err)
+	for _, advice := range []Advice{AdviceNormal, AdviceRandom, AdviceSequential, AdviceWillNeed, AdviceDontNeed} {
+		assert.Equal(t, advice, ParseAdvice(advice.String()))
+		assert.NoError(t, Madvise(bys, advice))
+	}
+	assert.Equal(t, AdviceNormal, ParseAdvice("unknown"))
+	assert.NoError(t, Madvise(nil, AdviceRandom))
+	assert.NoError(t, Unmap(file, bys))
+}
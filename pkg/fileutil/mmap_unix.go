@@ -39,6 +39,14 @@ func mmap(fd int, offset int64, size, mode int) ([]byte, error) {
 	return data, err
 }
 
+func mmapAnonymous(size int) ([]byte, error) {
+	return unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+}
+
+func munmapAnonymous(data []byte) error {
+	return unix.Munmap(data)
+}
+
 func munmap(_ *os.File, data []byte) error {
 	return unix.Munmap(data)
 }
@@ -46,3 +54,20 @@ func munmap(_ *os.File, data []byte) error {
 func msync(data []byte) error {
 	return unix.Msync(data, unix.MS_SYNC)
 }
+
+func madvise(data []byte, advice Advice) error {
+	var flag int
+	switch advice {
+	case AdviceRandom:
+		flag = unix.MADV_RANDOM
+	case AdviceSequential:
+		flag = unix.MADV_SEQUENTIAL
+	case AdviceWillNeed:
+		flag = unix.MADV_WILLNEED
+	case AdviceDontNeed:
+		flag = unix.MADV_DONTNEED
+	default:
+		flag = unix.MADV_NORMAL
+	}
+	return unix.Madvise(data, flag)
+}
@@ -131,6 +131,30 @@ func munmap(f *os.File, bytes []byte) error {
 	return os.NewSyscallError("CloseHandle", e)
 }
 
+func mmapAnonymous(size int) ([]byte, error) {
+	addr, err := windows.VirtualAlloc(0, uintptr(size), windows.MEM_COMMIT|windows.MEM_RESERVE, windows.PAGE_READWRITE)
+	if err != nil {
+		return nil, os.NewSyscallError("VirtualAlloc", err)
+	}
+	mmap := MMap{}
+
+	hd := mmap.header()
+	hd.Data = addr
+	hd.Len = size
+	hd.Cap = hd.Len
+
+	return mmap, nil
+}
+
+func munmapAnonymous(bytes []byte) error {
+	mmap := MMap(bytes)
+	addr, _ := mmap.addrLen()
+	if err := windows.VirtualFree(addr, 0, windows.MEM_RELEASE); err != nil {
+		return os.NewSyscallError("VirtualFree", err)
+	}
+	return nil
+}
+
 func msync(bytes []byte) error {
 	mmap := MMap(bytes)
 	addr, size := mmap.addrLen()
@@ -156,3 +180,8 @@ func msync(bytes []byte) error {
 
 	return nil
 }
+
+// madvise is not supported on windows, ignore the advice.
+func madvise(_ []byte, _ Advice) error {
+	return nil
+}
@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"fmt"
+	"time"
+)
+
+// SyncPolicy represents when to fsync the written data to disk, which is the trade-off between durability and write throughput.
+type SyncPolicy string
+
+const (
+	// SyncPerWrite syncs after each write(queue message/sst key-value), no written data lost when crash,
+	// but has the lowest write throughput.
+	SyncPerWrite SyncPolicy = "per-write"
+	// SyncPerInterval syncs at most once per interval when writing, loses the data written in the last interval when crash.
+	SyncPerInterval SyncPolicy = "per-interval"
+	// SyncPerRollover syncs only when page/file rolls over(queue page is full, sst file is finished),
+	// relies on os flushing dirty pages of current page/file, has the highest write throughput.
+	SyncPerRollover SyncPolicy = "per-rollover"
+)
+
+// ParseSyncPolicy parses sync policy from string, empty string means SyncPerRollover.
+func ParseSyncPolicy(policy string) (SyncPolicy, error) {
+	switch SyncPolicy(policy) {
+	case "", SyncPerRollover:
+		return SyncPerRollover, nil
+	case SyncPerWrite, SyncPerInterval:
+		return SyncPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("unknown sync policy: %s, available: %s/%s/%s",
+			policy, SyncPerWrite, SyncPerInterval, SyncPerRollover)
+	}
+}
+
+// Syncer decides if syncing written data based on sync policy, it is not thread-safe.
+type Syncer struct {
+	policy   SyncPolicy
+	interval time.Duration
+	lastSync time.Time
+}
+
+// NewSyncer creates a Syncer, interval is used for SyncPerInterval policy.
+func NewSyncer(policy SyncPolicy, interval time.Duration) *Syncer {
+	return &Syncer{
+		policy:   policy,
+		interval: interval,
+		lastSync: time.Now(),
+	}
+}
+
+// Policy returns the sync policy.
+func (s *Syncer) Policy() SyncPolicy {
+	return s.policy
+}
+
+// NeedSync returns if the written data need to be synced after writing, marks synced if returns true.
+func (s *Syncer) NeedSync() bool {
+	switch s.policy {
+	case SyncPerWrite:
+		return true
+	case SyncPerInterval:
+		now := time.Now()
+		if now.Sub(s.lastSync) < s.interval {
+			return false
+		}
+		s.lastSync = now
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSyncPolicy(t *testing.T) {
+	policy, err := ParseSyncPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, SyncPerRollover, policy)
+	for _, p := range []SyncPolicy{SyncPerWrite, SyncPerInterval, SyncPerRollover} {
+		policy, err = ParseSyncPolicy(string(p))
+		assert.NoError(t, err)
+		assert.Equal(t, p, policy)
+	}
+	_, err = ParseSyncPolicy("always")
+	assert.Error(t, err)
+}
+
+func TestSyncer_NeedSync(t *testing.T) {
+	s := NewSyncer(SyncPerWrite, 0)
+	assert.Equal(t, SyncPerWrite, s.Policy())
+	assert.True(t, s.NeedSync())
+	assert.True(t, s.NeedSync())
+
+	s = NewSyncer(SyncPerRollover, time.Millisecond)
+	assert.False(t, s.NeedSync())
+
+	s = NewSyncer(SyncPerInterval, 50*time.Millisecond)
+	assert.False(t, s.NeedSync())
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, s.NeedSync())
+	assert.False(t, s.NeedSync())
+}
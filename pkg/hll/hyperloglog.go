@@ -0,0 +1,171 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package hll
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+const (
+	// precision is the number of bits of hash used for register index,
+	// standard error is about 1.04/sqrt(2^precision) = 1.625%.
+	precision    = 12
+	numRegisters = 1 << precision
+
+	denseFormat  byte = 0
+	sparseFormat byte = 1
+	headerLen         = 2
+	sparseItem        = 3 // register index(uint16) + register value(uint8)
+)
+
+var (
+	errInvalidSketch = errors.New("invalid hyperloglog sketch data")
+	alpha            = 0.7213 / (1 + 1.079/float64(numRegisters))
+)
+
+// Sketch represents the HyperLogLog sketch for approximate distinct count,
+// sketches can be merged across nodes without losing accuracy.
+type Sketch struct {
+	registers []uint8
+}
+
+// New creates an empty HyperLogLog sketch.
+func New() *Sketch {
+	return &Sketch{registers: make([]uint8, numRegisters)}
+}
+
+// Insert adds the value into sketch.
+func (s *Sketch) Insert(value []byte) {
+	s.InsertHash(xxhash.Sum64(value))
+}
+
+// InsertString adds the string value into sketch.
+func (s *Sketch) InsertString(value string) {
+	s.InsertHash(xxhash.Sum64String(value))
+}
+
+// InsertHash adds the hash of value into sketch.
+func (s *Sketch) InsertHash(hash uint64) {
+	idx := hash >> (64 - precision)
+	// keep a guard bit, max rank is 64-precision+1
+	rank := uint8(bits.LeadingZeros64(hash<<precision|1<<(precision-1))) + 1
+	if rank > s.registers[idx] {
+		s.registers[idx] = rank
+	}
+}
+
+// Merge merges other sketch into current sketch.
+func (s *Sketch) Merge(other *Sketch) {
+	if other == nil {
+		return
+	}
+	for idx, rank := range other.registers {
+		if rank > s.registers[idx] {
+			s.registers[idx] = rank
+		}
+	}
+}
+
+// Estimate returns the approximate distinct count of values.
+func (s *Sketch) Estimate() uint64 {
+	sum := 0.0
+	zeros := 0
+	for _, rank := range s.registers {
+		if rank == 0 {
+			zeros++
+		}
+		sum += 1 / float64(uint64(1)<<rank)
+	}
+	m := float64(numRegisters)
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// small range correction, using linear counting
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// MarshalBinary marshals the sketch, uses sparse format if only few registers are set.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	nonZeros := 0
+	for _, rank := range s.registers {
+		if rank > 0 {
+			nonZeros++
+		}
+	}
+	if nonZeros*sparseItem >= numRegisters {
+		data := make([]byte, headerLen+numRegisters)
+		data[0] = precision
+		data[1] = denseFormat
+		copy(data[headerLen:], s.registers)
+		return data, nil
+	}
+	data := make([]byte, headerLen+nonZeros*sparseItem)
+	data[0] = precision
+	data[1] = sparseFormat
+	pos := headerLen
+	for idx, rank := range s.registers {
+		if rank == 0 {
+			continue
+		}
+		binary.LittleEndian.PutUint16(data[pos:], uint16(idx))
+		data[pos+2] = rank
+		pos += sparseItem
+	}
+	return data, nil
+}
+
+// UnmarshalBinary unmarshals the sketch from binary data.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	if len(data) < headerLen || data[0] != precision {
+		return errInvalidSketch
+	}
+	if len(s.registers) != numRegisters {
+		s.registers = make([]uint8, numRegisters)
+	}
+	body := data[headerLen:]
+	switch data[1] {
+	case denseFormat:
+		if len(body) != numRegisters {
+			return errInvalidSketch
+		}
+		copy(s.registers, body)
+	case sparseFormat:
+		if len(body)%sparseItem != 0 {
+			return errInvalidSketch
+		}
+		for i := range s.registers {
+			s.registers[i] = 0
+		}
+		for pos := 0; pos < len(body); pos += sparseItem {
+			idx := binary.LittleEndian.Uint16(body[pos:])
+			if int(idx) >= numRegisters {
+				return errInvalidSketch
+			}
+			s.registers[idx] = body[pos+2]
+		}
+	default:
+		return errInvalidSketch
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package hll
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSketch_Estimate(t *testing.T) {
+	s := New()
+	assert.Equal(t, uint64(0), s.Estimate())
+	for _, n := range []int{10, 1000, 100000} {
+		s = New()
+		for i := 0; i < n; i++ {
+			s.InsertString("host-" + strconv.Itoa(i))
+			// duplicate value
+			s.Insert([]byte("host-" + strconv.Itoa(i)))
+		}
+		assert.InEpsilon(t, float64(n), float64(s.Estimate()), 0.05)
+	}
+}
+
+func TestSketch_Merge(t *testing.T) {
+	s1 := New()
+	s2 := New()
+	for i := 0; i < 1000; i++ {
+		s1.InsertString(strconv.Itoa(i))
+		s2.InsertString(strconv.Itoa(i + 500))
+	}
+	s1.Merge(s2)
+	s1.Merge(nil)
+	assert.InEpsilon(t, 1500.0, float64(s1.Estimate()), 0.05)
+}
+
+func TestSketch_Marshal(t *testing.T) {
+	for _, n := range []int{0, 10, 100000} {
+		s := New()
+		for i := 0; i < n; i++ {
+			s.InsertString(strconv.Itoa(i))
+		}
+		data, err := s.MarshalBinary()
+		assert.NoError(t, err)
+		s2 := &Sketch{}
+		assert.NoError(t, s2.UnmarshalBinary(data))
+		assert.Equal(t, s.registers, s2.registers)
+		assert.Equal(t, s.Estimate(), s2.Estimate())
+	}
+	s := New()
+	assert.Error(t, s.UnmarshalBinary(nil))
+	assert.Error(t, s.UnmarshalBinary([]byte{1, denseFormat}))
+	assert.Error(t, s.UnmarshalBinary([]byte{precision, denseFormat, 1}))
+	assert.Error(t, s.UnmarshalBinary([]byte{precision, sparseFormat, 1}))
+	assert.Error(t, s.UnmarshalBinary([]byte{precision, sparseFormat, 0xff, 0xff, 1}))
+	assert.Error(t, s.UnmarshalBinary([]byte{precision, 9}))
+}
@@ -22,6 +22,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 )
 
 // OK responses with content and set the http status code 200.
@@ -46,6 +51,31 @@ func Error(c *gin.Context, err error) {
 	response(c, http.StatusInternalServerError, err.Error())
 }
 
+// ErrorWithCode responses the structured error envelope, sets the http status code and header by error code,
+// so that client can implement retry/alert policies by the stable error code.
+func ErrorWithCode(c *gin.Context, err error) {
+	_ = c.Error(err)
+	code := errorpkg.CodeOf(err)
+	c.Header(constants.HeaderErrorCode, code.String())
+	response(c, code.HTTPStatus(), models.NewErrorResponse(c.Writer.Header().Get(constants.HeaderRequestID), err))
+}
+
+// WithRequestID returns the request id from request header, generates a new one if not set,
+// then sets it into response header.
+func WithRequestID(c *gin.Context) string {
+	requestID := c.GetHeader(constants.HeaderRequestID)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	c.Header(constants.HeaderRequestID, requestID)
+	return requestID
+}
+
+// ServiceUnavailable responses with content and set the http status code 503.
+func ServiceUnavailable(c *gin.Context, content interface{}) {
+	response(c, http.StatusServiceUnavailable, content)
+}
+
 // response responses json body for http restful api
 func response(c *gin.Context, httpCode int, content interface{}) {
 	c.JSON(httpCode, content)
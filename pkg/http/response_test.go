@@ -25,6 +25,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
 )
 
 func TestOK(t *testing.T) {
@@ -58,3 +60,34 @@ func TestError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	assert.Equal(t, `"err"`, resp.Body.String())
 }
+
+func TestServiceUnavailable(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	ServiceUnavailable(c, "down")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, `"down"`, resp.Body.String())
+}
+
+func TestErrorWithCode(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set(constants.HeaderRequestID, "req-1")
+	assert.Equal(t, "req-1", WithRequestID(c))
+	ErrorWithCode(c, constants.ErrTooManySeriesFound)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "LIMIT_EXCEEDED", resp.Header().Get(constants.HeaderErrorCode))
+	assert.Equal(t, "req-1", resp.Header().Get(constants.HeaderRequestID))
+	assert.JSONEq(t, `{"code":"LIMIT_EXCEEDED","message":"found too many series","retryable":false,"requestId":"req-1"}`,
+		resp.Body.String())
+}
+
+func TestWithRequestID(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	requestID := WithRequestID(c)
+	assert.NotEmpty(t, requestID)
+	assert.Equal(t, requestID, resp.Header().Get(constants.HeaderRequestID))
+}
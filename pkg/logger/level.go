@@ -0,0 +1,166 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// AllModules represents the wildcard module which changes the running level of all loggers.
+	AllModules = "*"
+	// DefaultLevel represents the level which removes the override of logger module.
+	DefaultLevel = "default"
+	// noOverride represents the module level not overridden.
+	noOverride = int32(zapcore.FatalLevel) + 1
+)
+
+var (
+	modulesLock sync.RWMutex
+	// modules keeps the level of all logger modules, key: module name.
+	modules = make(map[string]*moduleLevel)
+	// minOverrideLevel keeps the lowest level overridden by all modules,
+	// it lets zap core enable the messages of module which level is lower than running level.
+	minOverrideLevel = noOverride
+	// coreLevel is the level enabler of zap core.
+	coreLevel = zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+		return RunningAtomicLevel.Enabled(lvl) || int32(lvl) >= atomic.LoadInt32(&minOverrideLevel)
+	})
+)
+
+// ModuleLevel represents the running log level of logger module.
+type ModuleLevel struct {
+	Module     string `json:"module"`
+	Level      string `json:"level"`
+	Overridden bool   `json:"overridden"`
+}
+
+// moduleLevel represents the level override of logger module.
+type moduleLevel struct {
+	level int32
+}
+
+// enabled checks if the level is enabled for the module.
+func (m *moduleLevel) enabled(lvl zapcore.Level) bool {
+	level := atomic.LoadInt32(&m.level)
+	if level == noOverride {
+		return RunningAtomicLevel.Enabled(lvl)
+	}
+	return int32(lvl) >= level
+}
+
+// registerModule registers logger module, returns the level of module.
+func registerModule(module string) *moduleLevel {
+	modulesLock.RLock()
+	m, ok := modules[module]
+	modulesLock.RUnlock()
+	if ok {
+		return m
+	}
+	modulesLock.Lock()
+	defer modulesLock.Unlock()
+	if m, ok = modules[module]; ok {
+		return m
+	}
+	m = &moduleLevel{level: noOverride}
+	modules[module] = m
+	return m
+}
+
+// SetModuleLevel changes the log level of logger module at runtime,
+// module '*' changes the running level of all loggers, level 'default' removes the override of module.
+func SetModuleLevel(module, level string) error {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if module == AllModules {
+		if level == DefaultLevel {
+			return fmt.Errorf("cannot reset running level of all loggers to default")
+		}
+		return RunningAtomicLevel.UnmarshalText([]byte(level))
+	}
+	modulesLock.Lock()
+	defer modulesLock.Unlock()
+
+	m, ok := modules[module]
+	if !ok {
+		return fmt.Errorf("logger module not found: %s", module)
+	}
+	newLevel := noOverride
+	if level != DefaultLevel {
+		var zapLevel zapcore.Level
+		if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+			return err
+		}
+		newLevel = int32(zapLevel)
+	}
+	atomic.StoreInt32(&m.level, newLevel)
+	// re-calculate min level of all overridden modules
+	minLevel := noOverride
+	for _, ml := range modules {
+		if l := atomic.LoadInt32(&ml.level); l < minLevel {
+			minLevel = l
+		}
+	}
+	atomic.StoreInt32(&minOverrideLevel, minLevel)
+	return nil
+}
+
+// SetModuleLevels changes the log level of logger modules in module name order,
+// so that '*' is applied first and the following modules can override it.
+func SetModuleLevels(levels map[string]string) error {
+	modules := make([]string, 0, len(levels))
+	for module := range levels {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	for _, module := range modules {
+		if err := SetModuleLevel(module, levels[module]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetModuleLevels returns the running log level of all logger modules, sorted by module name,
+// the first one is the running level of all loggers(module: '*').
+func GetModuleLevels() []ModuleLevel {
+	modulesLock.RLock()
+	defer modulesLock.RUnlock()
+
+	runningLevel := RunningAtomicLevel.Level().String()
+	levels := make([]ModuleLevel, 0, len(modules)+1)
+	for name, m := range modules {
+		level := atomic.LoadInt32(&m.level)
+		ml := ModuleLevel{Module: name, Level: runningLevel}
+		if level != noOverride {
+			ml.Level = zapcore.Level(level).String()
+			ml.Overridden = true
+		}
+		levels = append(levels, ml)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Module < levels[j].Module
+	})
+	return append([]ModuleLevel{{Module: AllModules, Level: runningLevel}}, levels...)
+}
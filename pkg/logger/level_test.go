@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestSetModuleLevel(t *testing.T) {
+	RunningAtomicLevel.SetLevel(zapcore.InfoLevel)
+	defer func() {
+		RunningAtomicLevel.SetLevel(zapcore.InfoLevel)
+		_ = SetModuleLevel("LevelTest", DefaultLevel)
+	}()
+	log := GetLogger("LevelTest", "Test")
+	assert.False(t, log.level.enabled(zapcore.DebugLevel))
+	assert.False(t, coreLevel.Enabled(zapcore.DebugLevel))
+
+	// override module level
+	assert.NoError(t, SetModuleLevel("LevelTest", "DEBUG"))
+	assert.True(t, log.level.enabled(zapcore.DebugLevel))
+	assert.True(t, coreLevel.Enabled(zapcore.DebugLevel))
+	assert.False(t, GetLogger("PKG", "").level.enabled(zapcore.DebugLevel))
+	log.Debug("debug for module level")
+
+	assert.NoError(t, SetModuleLevel("LevelTest", "error"))
+	assert.False(t, log.level.enabled(zapcore.WarnLevel))
+	assert.False(t, coreLevel.Enabled(zapcore.DebugLevel))
+	log.Warn("warn not output")
+
+	levels := GetModuleLevels()
+	assert.Equal(t, ModuleLevel{Module: AllModules, Level: "info"}, levels[0])
+	assert.Contains(t, levels, ModuleLevel{Module: "LevelTest", Level: "error", Overridden: true})
+	assert.Contains(t, levels, ModuleLevel{Module: "PKG", Level: "info"})
+
+	// reset module level
+	assert.NoError(t, SetModuleLevel("LevelTest", DefaultLevel))
+	assert.True(t, log.level.enabled(zapcore.WarnLevel))
+	assert.Contains(t, GetModuleLevels(), ModuleLevel{Module: "LevelTest", Level: "info"})
+
+	// change running level
+	assert.NoError(t, SetModuleLevel(AllModules, "debug"))
+	assert.True(t, log.level.enabled(zapcore.DebugLevel))
+	assert.True(t, IsDebug())
+
+	assert.NoError(t, SetModuleLevels(map[string]string{"LevelTest": "warn", AllModules: "info"}))
+	assert.False(t, log.level.enabled(zapcore.InfoLevel))
+	assert.False(t, IsDebug())
+	assert.Error(t, SetModuleLevels(map[string]string{"LevelTest": "abc"}))
+
+	// invalid case
+	assert.Error(t, SetModuleLevel(AllModules, DefaultLevel))
+	assert.Error(t, SetModuleLevel(AllModules, "abc"))
+	assert.Error(t, SetModuleLevel("LevelTest", "abc"))
+	assert.Error(t, SetModuleLevel("not-exist", "debug"))
+}
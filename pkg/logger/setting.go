@@ -83,6 +83,7 @@ func GetLogger(module, role string) *Logger {
 	return &Logger{
 		module: module,
 		role:   role,
+		level:  registerModule(module),
 	}
 }
 
@@ -94,7 +95,7 @@ func newDefaultLogger() *zap.Logger {
 	core := zapcore.NewCore(
 		zapcore.NewConsoleEncoder(encoderConfig),
 		os.Stdout,
-		RunningAtomicLevel)
+		coreLevel)
 	return zap.New(core, zap.AddCaller(), zap.AddCallerSkip(2))
 }
 
@@ -134,7 +135,7 @@ func initLogger(logFilename string, cfg config.Logging) error {
 	core := zapcore.NewCore(
 		zapcore.NewConsoleEncoder(encoderConfig),
 		w,
-		RunningAtomicLevel)
+		coreLevel)
 	switch logFilename {
 	case SlowSQLLogFileName:
 		encoderConfig.LevelKey = ""
@@ -142,7 +143,7 @@ func initLogger(logFilename string, cfg config.Logging) error {
 		core = zapcore.NewCore(
 			zapcore.NewConsoleEncoder(encoderConfig),
 			w,
-			RunningAtomicLevel)
+			coreLevel)
 		slowSQLLogger.Store(zap.New(core))
 	case AccessLogFileName:
 		accessLogger.Store(zap.New(core))
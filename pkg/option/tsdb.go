@@ -24,9 +24,26 @@ import (
 	"strings"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
+// Policies when disk usage of database exceeds disk quota.
+const (
+	// DiskQuotaRejectWrite rejects the write request(default policy).
+	DiskQuotaRejectWrite = "reject"
+	// DiskQuotaAccelerateTTL drops the oldest segments before retention.
+	DiskQuotaAccelerateTTL = "ttl"
+)
+
+// Policies of unused metric expiry.
+const (
+	// MetricExpiryRemove removes the metadata of unused metrics(default policy).
+	MetricExpiryRemove = "remove"
+	// MetricExpiryDryRun only reports the unused metrics, never removes them.
+	MetricExpiryDryRun = "dryRun"
+)
+
 // Intervals represents the list of Interval.
 type Intervals []Interval
 
@@ -77,6 +94,13 @@ type FlusherOption struct {
 	SizeThreshold int64 `toml:"sizeThreshold" json:"sizeThreshold"` // size level flush threshold, unit(MB)
 }
 
+// PoolQuotaOption represents the quota of database in shared read(query)/write pool on storage node,
+// running slots are dispatched to databases by weighted fair scheduling.
+type PoolQuotaOption struct {
+	Weight         int `toml:"weight" json:"weight,omitempty"`                 // weight of database, default 1
+	MaxConcurrency int `toml:"maxConcurrency" json:"maxConcurrency,omitempty"` // max running tasks, 0 means unlimited
+}
+
 // DatabaseOption represents a database option include shard ids and shard's option
 type DatabaseOption struct {
 	// write interval(the number of second) => TTL
@@ -89,6 +113,28 @@ type DatabaseOption struct {
 	Behind string `toml:"behind" json:"behind,omitempty"` // allowed timestamp write behind
 	Ahead  string `toml:"ahead" json:"ahead,omitempty"`   // allowed timestamp write ahead
 
+	// store histogram sketch alongside compound field, which can be merged across different buckets,
+	// count of bucket is interpolated into sketch bins, exact for raw value or bucket bounds aligned with sketch bins
+	HistogramSketch bool `toml:"histogramSketch" json:"histogramSketch,omitempty"`
+
+	// auto select the coarsest rollup interval which satisfies the group by time resolution and time span of query
+	AutoSelectInterval bool `toml:"autoSelectInterval" json:"autoSelectInterval,omitempty"`
+
+	// disk quota of database(0 means unlimited), disk usage includes data/index/write ahead log
+	DiskQuota ltoml.Size `toml:"diskQuota" json:"diskQuota,omitempty"`
+	// policy when disk usage exceeds quota, reject(default)/ttl
+	DiskQuotaPolicy string `toml:"diskQuotaPolicy" json:"diskQuotaPolicy,omitempty"`
+
+	// metric received no writes and no queries for this duration(e.g. 30d) is expired, its metadata(name/schema/series index)
+	// is removed, independent of data retention, disable if not set
+	MetricExpireAfter string `toml:"metricExpireAfter" json:"metricExpireAfter,omitempty"`
+	// policy of unused metric expiry, remove(default)/dryRun
+	MetricExpiryPolicy string `toml:"metricExpiryPolicy" json:"metricExpiryPolicy,omitempty"`
+
+	// quota of shared pools on storage node, isolates noisy database from others
+	ReadQuota  PoolQuotaOption `toml:"readQuota" json:"readQuota,omitempty"`
+	WriteQuota PoolQuotaOption `toml:"writeQuota" json:"writeQuota,omitempty"`
+
 	Index FlusherOption `toml:"index" json:"index,omitempty"` // index flusher option
 	Data  FlusherOption `toml:"data" json:"data,omitempty"`   // data flusher data
 
@@ -118,6 +164,35 @@ func (e *DatabaseOption) FindMatchSmallestInterval(interval timeutil.Interval) t
 	return storageInterval
 }
 
+// FindCoarsestInterval returns the coarsest interval(rollup) which satisfies the query resolution(group by interval)
+// and the time span of query(retention covers start time of query), so that long time range query reads less data.
+// e.g. 30d range + 1h resolution reads 1h rollup instead of 10s raw data.
+func (e *DatabaseOption) FindCoarsestInterval(resolution timeutil.Interval, timeRange timeutil.TimeRange, now int64) timeutil.Interval {
+	intervals := make(Intervals, len(e.Intervals))
+	copy(intervals, e.Intervals)
+	// desc order
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Interval > intervals[j].Interval
+	})
+	var matched timeutil.Interval // coarsest interval satisfies resolution, but retention cannot cover time range
+	for _, i := range intervals {
+		if i.Interval <= 0 || i.Interval > resolution || resolution%i.Interval != 0 {
+			// bucket of query cannot be down sampled from this interval exactly
+			continue
+		}
+		if i.Retention <= 0 || now-i.Retention.Int64() <= timeRange.Start {
+			return i.Interval
+		}
+		if matched == 0 {
+			matched = i.Interval
+		}
+	}
+	if matched > 0 {
+		return matched
+	}
+	return e.FindMatchSmallestInterval(resolution)
+}
+
 // Validate validates engine option if valid
 func (e *DatabaseOption) Validate() error {
 	if len(e.Intervals) == 0 {
@@ -133,9 +208,43 @@ func (e *DatabaseOption) Validate() error {
 	if err := validateInterval(e.Behind, false); err != nil {
 		return err
 	}
+	switch e.DiskQuotaPolicy {
+	case "", DiskQuotaRejectWrite, DiskQuotaAccelerateTTL:
+	default:
+		return fmt.Errorf("unknown disk quota policy: %s", e.DiskQuotaPolicy)
+	}
+	if err := validateInterval(e.MetricExpireAfter, false); err != nil {
+		return err
+	}
+	switch e.MetricExpiryPolicy {
+	case "", MetricExpiryRemove, MetricExpiryDryRun:
+	default:
+		return fmt.Errorf("unknown metric expiry policy: %s", e.MetricExpiryPolicy)
+	}
+	if e.ReadQuota.Weight < 0 || e.ReadQuota.MaxConcurrency < 0 {
+		return errors.New("weight/max concurrency of read quota cannot be negative")
+	}
+	if e.WriteQuota.Weight < 0 || e.WriteQuota.MaxConcurrency < 0 {
+		return errors.New("weight/max concurrency of write quota cannot be negative")
+	}
 	return nil
 }
 
+// IsAccelerateTTL returns if drops the oldest segments when disk usage exceeds quota.
+func (e *DatabaseOption) IsAccelerateTTL() bool {
+	return e.DiskQuotaPolicy == DiskQuotaAccelerateTTL
+}
+
+// GetMetricExpireAfter returns the duration(ms) of unused metric expiry, 0 means disable.
+func (e *DatabaseOption) GetMetricExpireAfter() int64 {
+	return e.getIntervalVal(e.MetricExpireAfter)
+}
+
+// IsMetricExpiryDryRun returns if only reports the unused metrics, never removes them.
+func (e *DatabaseOption) IsMetricExpiryDryRun() bool {
+	return e.MetricExpiryPolicy == MetricExpiryDryRun
+}
+
 // GetAcceptWritableRange returns accept writable time range.
 func (e *DatabaseOption) GetAcceptWritableRange() (ahead, behind int64) {
 	if e.ahead <= 0 {
@@ -66,6 +66,46 @@ func TestDatabaseOption_Validate(t *testing.T) {
 			DatabaseOption{Intervals: Intervals{{}}, Behind: "1h", Ahead: "1h"},
 			false,
 		},
+		{
+			"disk quota policy invalid",
+			DatabaseOption{Intervals: Intervals{{}}, DiskQuota: 1024, DiskQuotaPolicy: "drop"},
+			true,
+		},
+		{
+			"disk quota policy valid",
+			DatabaseOption{Intervals: Intervals{{}}, DiskQuota: 1024, DiskQuotaPolicy: DiskQuotaAccelerateTTL},
+			false,
+		},
+		{
+			"metric expire after invalid",
+			DatabaseOption{Intervals: Intervals{{}}, MetricExpireAfter: "aa"},
+			true,
+		},
+		{
+			"metric expiry policy invalid",
+			DatabaseOption{Intervals: Intervals{{}}, MetricExpireAfter: "30d", MetricExpiryPolicy: "drop"},
+			true,
+		},
+		{
+			"metric expiry valid",
+			DatabaseOption{Intervals: Intervals{{}}, MetricExpireAfter: "30d", MetricExpiryPolicy: MetricExpiryDryRun},
+			false,
+		},
+		{
+			"read quota invalid",
+			DatabaseOption{Intervals: Intervals{{}}, ReadQuota: PoolQuotaOption{Weight: -1}},
+			true,
+		},
+		{
+			"write quota invalid",
+			DatabaseOption{Intervals: Intervals{{}}, WriteQuota: PoolQuotaOption{MaxConcurrency: -1}},
+			true,
+		},
+		{
+			"pool quota valid",
+			DatabaseOption{Intervals: Intervals{{}}, ReadQuota: PoolQuotaOption{Weight: 4}, WriteQuota: PoolQuotaOption{MaxConcurrency: 2}},
+			false,
+		},
 	}
 
 	for _, tt := range cases {
@@ -179,3 +219,53 @@ func TestDatabaseOption_FindMatchSmallestInterval(t *testing.T) {
 	interval := opt.FindMatchSmallestInterval(timeutil.Interval(timeutil.OneMinute * 3))
 	assert.Equal(t, timeutil.Interval(timeutil.OneMinute), interval)
 }
+
+func TestDatabaseOption_FindCoarsestInterval(t *testing.T) {
+	now := timeutil.Now()
+	opt := DatabaseOption{Intervals: Intervals{
+		{timeutil.Interval(10 * timeutil.OneSecond), timeutil.Interval(7 * timeutil.OneDay)},
+		{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+		{timeutil.Interval(timeutil.OneHour), timeutil.Interval(timeutil.OneYear)},
+	}}
+	cases := []struct {
+		name       string
+		resolution timeutil.Interval
+		start      int64
+		expect     timeutil.Interval
+	}{
+		{"30d range + 1h buckets", timeutil.Interval(timeutil.OneHour), now - 30*timeutil.OneDay, timeutil.Interval(timeutil.OneHour)},
+		{"resolution not aligned with 1h", timeutil.Interval(90 * timeutil.OneMinute), now - timeutil.OneDay, timeutil.Interval(5 * timeutil.OneMinute)},
+		{"fine resolution", timeutil.Interval(timeutil.OneMinute), now - timeutil.OneHour, timeutil.Interval(10 * timeutil.OneSecond)},
+		{"resolution too small", timeutil.Interval(timeutil.OneSecond), now - timeutil.OneHour, timeutil.Interval(10 * timeutil.OneSecond)},
+		{"retention not cover", timeutil.Interval(timeutil.OneMinute), now - 10*timeutil.OneDay, timeutil.Interval(10 * timeutil.OneSecond)},
+		{"1h retention covers", timeutil.Interval(2 * timeutil.OneHour), now - 60*timeutil.OneDay, timeutil.Interval(timeutil.OneHour)},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			interval := opt.FindCoarsestInterval(tt.resolution, timeutil.TimeRange{Start: tt.start, End: now}, now)
+			assert.Equal(t, tt.expect, interval)
+		})
+	}
+	// no retention covers time range, returns the coarsest interval satisfies resolution
+	opt = DatabaseOption{Intervals: Intervals{
+		{timeutil.Interval(10 * timeutil.OneSecond), timeutil.Interval(7 * timeutil.OneDay)},
+		{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+	}}
+	assert.Equal(t, timeutil.Interval(5*timeutil.OneMinute),
+		opt.FindCoarsestInterval(timeutil.Interval(timeutil.OneHour), timeutil.TimeRange{Start: now - 60*timeutil.OneDay, End: now}, now))
+}
+
+func TestDatabaseOption_IsAccelerateTTL(t *testing.T) {
+	assert.False(t, (&DatabaseOption{}).IsAccelerateTTL())
+	assert.False(t, (&DatabaseOption{DiskQuotaPolicy: DiskQuotaRejectWrite}).IsAccelerateTTL())
+	assert.True(t, (&DatabaseOption{DiskQuotaPolicy: DiskQuotaAccelerateTTL}).IsAccelerateTTL())
+}
+
+func TestDatabaseOption_MetricExpiry(t *testing.T) {
+	assert.Zero(t, (&DatabaseOption{}).GetMetricExpireAfter())
+	assert.Equal(t, 30*timeutil.OneDay, (&DatabaseOption{MetricExpireAfter: "30d"}).GetMetricExpireAfter())
+	assert.False(t, (&DatabaseOption{}).IsMetricExpiryDryRun())
+	assert.False(t, (&DatabaseOption{MetricExpiryPolicy: MetricExpiryRemove}).IsMetricExpiryDryRun())
+	assert.True(t, (&DatabaseOption{MetricExpiryPolicy: MetricExpiryDryRun}).IsMetricExpiryDryRun())
+}
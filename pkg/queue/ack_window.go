@@ -0,0 +1,70 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queue
+
+// ackWindow tracks the messages completed out of order by parallel consumers,
+// acknowledged sequence only advances to the max continuous completed sequence.
+type ackWindow struct {
+	ackSeq    int64
+	completed map[int64]struct{}
+}
+
+// newAckWindow creates an ack window starting from acknowledged sequence.
+func newAckWindow(ackSeq int64) *ackWindow {
+	return &ackWindow{
+		ackSeq:    ackSeq,
+		completed: make(map[int64]struct{}),
+	}
+}
+
+// complete marks the message completed, returns the acknowledged sequence and if it advanced.
+func (w *ackWindow) complete(seq int64) (ackSeq int64, advanced bool) {
+	if seq <= w.ackSeq {
+		return w.ackSeq, false
+	}
+	w.completed[seq] = struct{}{}
+	for {
+		next := w.ackSeq + 1
+		if _, ok := w.completed[next]; !ok {
+			break
+		}
+		delete(w.completed, next)
+		w.ackSeq = next
+		advanced = true
+	}
+	return w.ackSeq, advanced
+}
+
+// reset resets acknowledged sequence if it was changed by sequential ack,
+// drops the completed messages before acknowledged sequence.
+func (w *ackWindow) reset(ackSeq int64) {
+	if ackSeq == w.ackSeq {
+		return
+	}
+	w.ackSeq = ackSeq
+	for seq := range w.completed {
+		if seq <= ackSeq {
+			delete(w.completed, seq)
+		}
+	}
+}
+
+// size returns the number of messages completed but not acknowledged.
+func (w *ackWindow) size() int {
+	return len(w.completed)
+}
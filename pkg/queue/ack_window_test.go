@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAckWindow_complete(t *testing.T) {
+	w := newAckWindow(-1)
+	ack, ok := w.complete(1)
+	assert.False(t, ok)
+	assert.Equal(t, int64(-1), ack)
+	ack, ok = w.complete(2)
+	assert.False(t, ok)
+	assert.Equal(t, int64(-1), ack)
+	assert.Equal(t, 2, w.size())
+	ack, ok = w.complete(0)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), ack)
+	assert.Zero(t, w.size())
+	// completed before
+	ack, ok = w.complete(1)
+	assert.False(t, ok)
+	assert.Equal(t, int64(2), ack)
+
+	ack, ok = w.complete(5)
+	assert.False(t, ok)
+	assert.Equal(t, int64(2), ack)
+	w.reset(2)
+	assert.Equal(t, 1, w.size())
+	w.reset(4)
+	ack, ok = w.complete(6)
+	assert.True(t, ok)
+	assert.Equal(t, int64(6), ack)
+	w.complete(8)
+	w.reset(10)
+	assert.Zero(t, w.size())
+}
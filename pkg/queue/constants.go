@@ -21,19 +21,25 @@ const (
 	dataPath  = "data"
 	indexPath = "index"
 	metaPath  = "meta"
+	timePath  = "time"
 
 	metaPageIndex = 0
 
 	indexItemLength            = 8 + 4 + 4 // data page id(8bytes) + message offset in data page(4bytes) + message length(4bytes)
 	indexItemsPerPage          = 1024 * 256
 	indexPageSize              = indexItemsPerPage * indexItemLength
-	dataPageSize               = 128 * 1024 * 1024 // 128MB
-	metaPageSize               = 8 + 8 + 8         // append sequence(int64) + ack sequence(int64)
+	dataPageSize               = 128 * 1024 * 1024    // 128MB
+	dataPagePreAllocateOffset  = dataPageSize / 4 * 3 // pre-allocate next data page when message offset exceeds
+	metaPageSize               = 8 + 8 + 8            // append sequence(int64) + ack sequence(int64)
 	queueAppendedSeqOffset     = 0
 	queueAcknowledgedSeqOffset = queueAppendedSeqOffset + 8
 	queueDataPageIndexOffset   = 0
 	messageOffsetOffset        = 8
 	messageLengthOffset        = 8 + 4
+	timeIndexInterval          = 1024                                  // record put time every 1024 messages
+	timeItemLength             = 8                                     // put timestamp(int64)
+	timeItemsPerPage           = indexItemsPerPage / timeIndexInterval // one time page per index page
+	timePageSize               = timeItemsPerPage * timeItemLength
 
 	defaultDataSizeLimit = 4 * dataPageSize
 
@@ -43,4 +49,6 @@ const (
 	consumerGroupAcknowledgedSeqOffset = 8
 	// SeqNoNewMessageAvailable is the seqNum returned when no new message available
 	SeqNoNewMessageAvailable = int64(-1)
+	// defaultAckWindowSize is the max number of in-flight messages(consumed but not acknowledged) of parallel consumers.
+	defaultAckWindowSize = 1024
 )
@@ -33,6 +33,8 @@ import (
 // ConsumerGroup represents an individual consumer with own consume and ack sequence.
 // The typical way to use ConsumerGroup is using a single go-routine to consume message,
 // and using other go-routine to ack the messages which have been processed successfully.
+// If concurrency > 1, multiple go-routines can consume message in parallel and complete the messages out of order,
+// the acknowledged sequence advances in order, in-flight messages are limited by the ack window.
 type ConsumerGroup interface {
 	// Name returns a unique name for ConsumerGroup in a FanOutQueue.
 	Name() string
@@ -44,6 +46,13 @@ type ConsumerGroup interface {
 	SetConsumedSeq(seq int64)
 	// Ack mark the data processed with sequence less than or equals to acknowledged sequence.
 	Ack(ackSeq int64)
+	// Complete marks the message processed by parallel consumer, message can be completed out of order,
+	// acknowledged sequence advances to the max continuous completed sequence.
+	Complete(seq int64)
+	// SetConcurrency sets the number of parallel consumers, consume blocks when ack window is full if concurrency > 1.
+	SetConcurrency(concurrency int)
+	// Concurrency returns the number of parallel consumers.
+	Concurrency() int
 	// ConsumedSeq returns the sequence of consumed.
 	ConsumedSeq() int64
 	// AcknowledgedSeq returns the acknowledged sequence.
@@ -54,6 +63,9 @@ type ConsumerGroup interface {
 	Pause()
 	// SetSeq sets consumed/acknowledged sequence.
 	SetSeq(seq int64)
+	// SeekToTime repositions consumed/acknowledged sequence, so that consumer replays the messages put at or after the timestamp,
+	// returns the sequence of next message to consume.
+	SeekToTime(timestamp int64) int64
 	// Pending returns the offset between ConsumerGroup consumed sequence and FanOutQueue appended sequence.
 	Pending() int64
 	// IsEmpty returns if fan out consumer cannot consume any data.
@@ -77,6 +89,12 @@ type consumerGroup struct {
 	closed       atomic.Bool // false -> running, true -> closed
 	paused       atomic.Bool
 	lock4headSeq sync.RWMutex // lock to protect headSeq
+
+	concurrency atomic.Int32 // number of parallel consumers
+	window      *ackWindow   // tracks the messages completed out of order
+	windowSize  int64        // max number of in-flight messages of parallel consumers
+	windowCond  *sync.Cond   // waiting ack window not full
+	lock4window sync.Mutex   // lock to protect ack window
 }
 
 // NewConsumerGroup builds a ConsumerGroup from metaPath.
@@ -119,14 +137,19 @@ func NewConsumerGroup(parent, fanOutPath string, q FanOutQueue) (ConsumerGroup,
 	metaPage.PutUint64(uint64(consumedSeq), consumerGroupConsumedSeqOffset)
 	metaPage.PutUint64(uint64(ackSeq), consumerGroupAcknowledgedSeqOffset)
 
-	return &consumerGroup{
+	cg := &consumerGroup{
 		name:            name,
 		q:               q,
 		metaPageFct:     metaPageFct,
 		metaPage:        metaPage,
 		consumedSeq:     atomic.NewInt64(consumedSeq),
 		acknowledgedSeq: atomic.NewInt64(ackSeq),
-	}, nil
+		window:          newAckWindow(ackSeq),
+		windowSize:      defaultAckWindowSize,
+	}
+	cg.concurrency.Store(1)
+	cg.windowCond = sync.NewCond(&cg.lock4window)
+	return cg, nil
 }
 
 // Name returns a unique name for ConsumerGroup in a FanOutQueue.
@@ -138,6 +161,7 @@ func (f *consumerGroup) Name() string {
 func (f *consumerGroup) Pause() {
 	f.paused.Store(true)
 	f.Queue().Queue().Signal()
+	f.signalWindow()
 }
 
 // isPause returns if consumer group is paused.
@@ -148,6 +172,9 @@ func (f *consumerGroup) isPause() bool {
 // Consume returns the seq for the next data to consume.
 // If no new data is available, SeqNoNewMessageAvailable is returned.
 func (f *consumerGroup) Consume() int64 {
+	if !f.waitWindow() {
+		return SeqNoNewMessageAvailable
+	}
 	headSeq := f.consumedSeq.Load() + 1
 
 	// check queue if empty using current consume head without lock,
@@ -211,6 +238,55 @@ func (f *consumerGroup) Ack(ackSeq int64) {
 	}
 }
 
+// Complete marks the message processed by parallel consumer, message can be completed out of order,
+// acknowledged sequence advances to the max continuous completed sequence.
+func (f *consumerGroup) Complete(seq int64) {
+	f.lock4window.Lock()
+	defer f.lock4window.Unlock()
+
+	// acknowledged sequence maybe changed by Ack/SetSeq
+	f.window.reset(f.AcknowledgedSeq())
+	if ackSeq, advanced := f.window.complete(seq); advanced {
+		f.Ack(ackSeq)
+		f.windowCond.Broadcast()
+	}
+}
+
+// SetConcurrency sets the number of parallel consumers, consume blocks when ack window is full if concurrency > 1.
+func (f *consumerGroup) SetConcurrency(concurrency int) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	f.concurrency.Store(int32(concurrency))
+	f.signalWindow()
+}
+
+// Concurrency returns the number of parallel consumers.
+func (f *consumerGroup) Concurrency() int {
+	return int(f.concurrency.Load())
+}
+
+// waitWindow waits until ack window not full for parallel consumers, returns false if closed/paused.
+func (f *consumerGroup) waitWindow() bool {
+	if f.concurrency.Load() <= 1 {
+		return true
+	}
+	f.lock4window.Lock()
+	defer f.lock4window.Unlock()
+
+	for !f.isPause() && f.concurrency.Load() > 1 && f.ConsumedSeq()-f.AcknowledgedSeq() >= f.windowSize {
+		f.windowCond.Wait()
+	}
+	return !f.isPause()
+}
+
+// signalWindow wakes up the consumers waiting ack window.
+func (f *consumerGroup) signalWindow() {
+	f.lock4window.Lock()
+	f.windowCond.Broadcast()
+	f.lock4window.Unlock()
+}
+
 // ConsumedSeq returns the next sequence of consumed.
 func (f *consumerGroup) ConsumedSeq() int64 {
 	return f.consumedSeq.Load()
@@ -224,12 +300,30 @@ func (f *consumerGroup) AcknowledgedSeq() int64 {
 // SetSeq sets consumed/acknowledged sequence.
 func (f *consumerGroup) SetSeq(seq int64) {
 	f.lock4headSeq.Lock()
-	defer f.lock4headSeq.Unlock()
 
 	f.consumedSeq.Store(seq)
 	f.acknowledgedSeq.Store(seq)
 	f.metaPage.PutUint64(uint64(f.ConsumedSeq()), consumerGroupConsumedSeqOffset)
 	f.metaPage.PutUint64(uint64(f.AcknowledgedSeq()), consumerGroupAcknowledgedSeqOffset)
+	f.lock4headSeq.Unlock()
+
+	f.signalWindow()
+}
+
+// SeekToTime repositions consumed/acknowledged sequence, so that consumer replays the messages put at or after the timestamp,
+// returns the sequence of next message to consume.
+func (f *consumerGroup) SeekToTime(timestamp int64) int64 {
+	seq := f.q.Queue().SeqOfTime(timestamp)
+	// drop the messages completed out of order before seek
+	f.lock4window.Lock()
+	f.window = newAckWindow(seq - 1)
+	f.lock4window.Unlock()
+
+	f.SetSeq(seq - 1)
+
+	queueLogger.Info("consumer group seek to time",
+		logger.String("consumerGroup", f.name), logger.Int64("timestamp", timestamp), logger.Int64("seq", seq))
+	return seq
 }
 
 // Pending returns the offset between ConsumerGroup HeadSeq and FanOutQueue HeadSeq.
@@ -261,6 +355,7 @@ func (f *consumerGroup) IsEmpty() bool {
 func (f *consumerGroup) Close() {
 	if f.closed.CAS(false, true) {
 		f.Queue().Queue().Signal()
+		f.signalWindow()
 
 		if err := f.metaPageFct.Close(); err != nil {
 			queueLogger.Error("close consumerGroup meta error", logger.String("consumerGroup", f.name), logger.Error(err))
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/queue/page"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestNewConsumerGroup(t *testing.T) {
@@ -340,3 +342,127 @@ func TestConsumerGroup_Consume_CloseQueue(t *testing.T) {
 	<-consumed
 	fq.Close()
 }
+
+func TestConsumerGroup_ParallelConsume(t *testing.T) {
+	dir := path.Join(t.TempDir(), t.Name())
+
+	fq, err := NewFanOutQueue(dir, 1024)
+	assert.NoError(t, err)
+	defer fq.Close()
+	f1, err := fq.GetOrCreateConsumerGroup("f1")
+	assert.NoError(t, err)
+	f1.SetConcurrency(0)
+	assert.Equal(t, 1, f1.Concurrency())
+	f1.SetConcurrency(4)
+	assert.Equal(t, 4, f1.Concurrency())
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, fq.Queue().Put([]byte("123")))
+	}
+	var wait sync.WaitGroup
+	for i := 0; i < f1.Concurrency(); i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for {
+				seq := f1.Consume()
+				_, err0 := fq.Queue().Get(seq)
+				assert.NoError(t, err0)
+				// complete out of order
+				if seq%3 == 0 {
+					time.Sleep(time.Millisecond)
+				}
+				f1.Complete(seq)
+				if seq >= 96 {
+					return
+				}
+			}
+		}()
+	}
+	wait.Wait()
+	assert.Eventually(t, func() bool {
+		return f1.AcknowledgedSeq() == f1.ConsumedSeq()
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestConsumerGroup_AckWindow(t *testing.T) {
+	dir := path.Join(t.TempDir(), t.Name())
+
+	fq, err := NewFanOutQueue(dir, 1024)
+	assert.NoError(t, err)
+	defer fq.Close()
+	f1, err := fq.GetOrCreateConsumerGroup("f1")
+	assert.NoError(t, err)
+	f1.SetConcurrency(2)
+	f1.(*consumerGroup).windowSize = 2
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, fq.Queue().Put([]byte("123")))
+	}
+	assert.Equal(t, int64(0), f1.Consume())
+	assert.Equal(t, int64(1), f1.Consume())
+
+	consumed := make(chan int64)
+	go func() {
+		// wait ack window
+		consumed <- f1.Consume()
+	}()
+	f1.Complete(1)
+	select {
+	case <-consumed:
+		assert.Fail(t, "consume should be blocked when ack window is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, int64(-1), f1.AcknowledgedSeq())
+	f1.Complete(0)
+	assert.Equal(t, int64(2), <-consumed)
+	assert.Equal(t, int64(1), f1.AcknowledgedSeq())
+	// complete acknowledged message
+	f1.Complete(1)
+	assert.Equal(t, int64(1), f1.AcknowledgedSeq())
+
+	assert.Equal(t, int64(3), f1.Consume())
+	go func() {
+		consumed <- f1.Consume()
+	}()
+	time.Sleep(50 * time.Millisecond)
+	f1.Pause()
+	assert.Equal(t, SeqNoNewMessageAvailable, <-consumed)
+}
+
+func TestConsumerGroup_SeekToTime(t *testing.T) {
+	dir := path.Join(t.TempDir(), t.Name())
+	defer func() {
+		nowFunc = timeutil.Now
+	}()
+	now := int64(0)
+	nowFunc = func() int64 {
+		now += 10
+		return now
+	}
+
+	fq, err := NewFanOutQueue(dir, 1024)
+	assert.NoError(t, err)
+	defer fq.Close()
+	f1, err := fq.GetOrCreateConsumerGroup("f1")
+	assert.NoError(t, err)
+	// sampled messages: 0->10, 1024->20, 2048->30
+	for i := 0; i < 3000; i++ {
+		assert.NoError(t, fq.Queue().Put([]byte("123")))
+	}
+	for i := 0; i < 3000; i++ {
+		f1.Complete(f1.Consume())
+	}
+	assert.Equal(t, int64(2999), f1.AcknowledgedSeq())
+	// replay messages
+	assert.Equal(t, int64(1025), f1.SeekToTime(25))
+	assert.Equal(t, int64(1024), f1.ConsumedSeq())
+	assert.Equal(t, int64(1024), f1.AcknowledgedSeq())
+	assert.Equal(t, int64(1025), f1.Consume())
+	f1.Complete(1025)
+	assert.Equal(t, int64(1025), f1.AcknowledgedSeq())
+	// cannot seek before the acknowledged sequence of queue
+	fq.Sync()
+	assert.Equal(t, int64(1025), fq.Queue().AcknowledgedSeq())
+	assert.Equal(t, int64(1026), f1.SeekToTime(5))
+	assert.Equal(t, int64(1026), f1.Consume())
+}
@@ -25,9 +25,11 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 )
@@ -36,9 +38,10 @@ import (
 
 // for testing
 var (
-	mkDirFunc      = fileutil.MkDirIfNotExist
-	removeFileFunc = fileutil.RemoveFile
-	listDirFunc    = fileutil.ListDir
+	mkDirFunc               = fileutil.MkDirIfNotExist
+	removeFileFunc          = fileutil.RemoveFile
+	listDirFunc             = fileutil.ListDir
+	newPreAllocatedPageFunc = newPreAllocatedMappedPage
 )
 
 var pageLogger = logger.GetLogger("Queue", "PageFactory")
@@ -55,6 +58,9 @@ type Factory interface {
 	AcquirePage(index int64) (MappedPage, error)
 	// GetPage returns a mapped page with specific index
 	GetPage(index int64) (MappedPage, bool)
+	// PreAllocate allocates the page with specific index in background before acquiring,
+	// eliminates latency spikes at page rollover.
+	PreAllocate(index int64)
 	// TruncatePages truncates expired page by index(page id).
 	TruncatePages(index int64)
 	// Size returns the total page size
@@ -66,9 +72,11 @@ type factory struct {
 	path     string
 	pageSize int
 
-	pages  map[int64]MappedPage // store all acquire pages
-	closed atomic.Bool
-	size   atomic.Int64 // current total queue data size
+	pages         map[int64]MappedPage // store all acquire pages
+	preAllocated  map[int64]MappedPage // store pre-allocated pages not acquired
+	preAllocating map[int64]struct{}   // pages in pre-allocating
+	closed        atomic.Bool
+	size          atomic.Int64 // current total queue data size
 
 	mutex  sync.RWMutex
 	logger *logger.Logger
@@ -81,10 +89,12 @@ func NewFactory(path string, pageSize int) (fct Factory, err error) {
 	}
 
 	f := &factory{
-		path:     path,
-		pageSize: pageSize,
-		pages:    make(map[int64]MappedPage),
-		logger:   logger.GetLogger("Queue", "Page"),
+		path:          path,
+		pageSize:      pageSize,
+		pages:         make(map[int64]MappedPage),
+		preAllocated:  make(map[int64]MappedPage),
+		preAllocating: make(map[int64]struct{}),
+		logger:        logger.GetLogger("Queue", "Page"),
 	}
 
 	if err := f.loadPages(); err != nil {
@@ -109,6 +119,17 @@ func (f *factory) AcquirePage(index int64) (MappedPage, error) {
 	if ok {
 		return page, nil
 	}
+	if page, ok = f.preAllocated[index]; ok {
+		delete(f.preAllocated, index)
+		f.pages[index] = page
+		f.size.Add(int64(f.pageSize))
+		metrics.PagePreAllocateStatistics.Hit.Incr()
+		return page, nil
+	}
+	if _, ok = f.preAllocating[index]; ok {
+		// pre-allocation not completed, acquire page directly
+		metrics.PagePreAllocateStatistics.Miss.Incr()
+	}
 
 	page, err := NewMappedPage(f.pageFileName(index), f.pageSize)
 	if err != nil {
@@ -130,6 +151,57 @@ func (f *factory) GetPage(index int64) (MappedPage, bool) {
 	return page, ok
 }
 
+// PreAllocate allocates the page with specific index in background before acquiring,
+// eliminates latency spikes at page rollover.
+func (f *factory) PreAllocate(index int64) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.closed.Load() {
+		return
+	}
+	if _, ok := f.pages[index]; ok {
+		return
+	}
+	if _, ok := f.preAllocated[index]; ok {
+		return
+	}
+	if _, ok := f.preAllocating[index]; ok {
+		return
+	}
+	f.preAllocating[index] = struct{}{}
+	go f.preAllocate(index)
+}
+
+// preAllocate allocates the disk space and warms mapped bytes of page.
+func (f *factory) preAllocate(index int64) {
+	startTime := time.Now()
+	page, err := newPreAllocatedPageFunc(f.pageFileName(index), f.pageSize)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.preAllocating, index)
+	if err != nil {
+		metrics.PagePreAllocateStatistics.AllocateFailures.Incr()
+		f.logger.Warn("pre-allocate page failure",
+			logger.String("path", f.path), logger.Any("page", index), logger.Error(err))
+		return
+	}
+	metrics.PagePreAllocateStatistics.Allocate.Incr()
+	metrics.PagePreAllocateStatistics.Duration.UpdateSince(startTime)
+	if _, ok := f.pages[index]; ok || f.closed.Load() {
+		// page acquired before pre-allocation completed or factory closed, discard it
+		metrics.PagePreAllocateStatistics.Discard.Incr()
+		if err0 := page.Close(); err0 != nil {
+			f.logger.Warn("close pre-allocated page failure",
+				logger.String("path", f.path), logger.Any("page", index), logger.Error(err0))
+		}
+		return
+	}
+	f.preAllocated[index] = page
+}
+
 // TruncatePages truncates expired page by index(page id).
 func (f *factory) TruncatePages(index int64) {
 	f.mutex.Lock()
@@ -178,6 +250,12 @@ func (f *factory) Close() error {
 					logger.String("path", f.path), logger.Error(err))
 			}
 		}
+		for _, page := range f.preAllocated {
+			if err := page.Close(); err != nil {
+				pageLogger.Error("close pre-allocated page data err",
+					logger.String("path", f.path), logger.Error(err))
+			}
+		}
 	}
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -200,3 +201,71 @@ func TestFactory_TruncatePages(t *testing.T) {
 	page.EXPECT().Close().Return(fmt.Errorf("err"))
 	fct.TruncatePages(11)
 }
+
+func TestFactory_PreAllocate(t *testing.T) {
+	tmpDir := t.TempDir()
+	defer func() {
+		newPreAllocatedPageFunc = newPreAllocatedMappedPage
+	}()
+
+	fct, err := NewFactory(tmpDir, 4096*2)
+	assert.NoError(t, err)
+	fct1 := fct.(*factory)
+	preAllocated := func(index int64) bool {
+		fct1.mutex.RLock()
+		defer fct1.mutex.RUnlock()
+		_, ok := fct1.preAllocated[index]
+		return ok
+	}
+	// pre-allocate page, then acquire it
+	fct.PreAllocate(1)
+	assert.Eventually(t, func() bool { return preAllocated(1) }, time.Second, 10*time.Millisecond)
+	fct.PreAllocate(1)
+	assert.Zero(t, fct.Size())
+	page1, err := fct.AcquirePage(1)
+	assert.NoError(t, err)
+	assert.False(t, preAllocated(1))
+	assert.Equal(t, int64(4096*2), fct.Size())
+	// page acquired, ignore pre-allocate
+	fct.PreAllocate(1)
+	p, ok := fct.GetPage(1)
+	assert.True(t, ok)
+	assert.Equal(t, page1, p)
+
+	// pre-allocate failure
+	newPreAllocatedPageFunc = func(_ string, _ int) (MappedPage, error) {
+		return nil, fmt.Errorf("err")
+	}
+	fct.PreAllocate(2)
+	assert.Eventually(t, func() bool {
+		fct1.mutex.RLock()
+		defer fct1.mutex.RUnlock()
+		return len(fct1.preAllocating) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, preAllocated(2))
+
+	// page acquired before pre-allocation completed
+	allocating := make(chan struct{})
+	newPreAllocatedPageFunc = func(fileName string, size int) (MappedPage, error) {
+		<-allocating
+		return newPreAllocatedMappedPage(fileName, size)
+	}
+	fct.PreAllocate(3)
+	_, err = fct.AcquirePage(3)
+	assert.NoError(t, err)
+	close(allocating)
+	assert.Eventually(t, func() bool {
+		fct1.mutex.RLock()
+		defer fct1.mutex.RUnlock()
+		return len(fct1.preAllocating) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, preAllocated(3))
+
+	// close factory with pre-allocated page
+	newPreAllocatedPageFunc = newPreAllocatedMappedPage
+	fct.PreAllocate(4)
+	assert.Eventually(t, func() bool { return preAllocated(4) }, time.Second, 10*time.Millisecond)
+	assert.NoError(t, fct.Close())
+	fct.PreAllocate(5)
+	assert.False(t, preAllocated(5))
+}
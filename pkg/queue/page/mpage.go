@@ -30,10 +30,14 @@ import (
 
 // for testing
 var (
-	mapFileFunc  = fileutil.RWMap
-	openFileFunc = os.OpenFile
+	mapFileFunc   = fileutil.RWMap
+	openFileFunc  = os.OpenFile
+	fallocateFunc = fileutil.Fallocate
 )
 
+// osPageSize is the size of os memory page for warming mapped page.
+const osPageSize = 4096
+
 // MappedPage represents a holder for mmap bytes,
 // one MappedPage corresponds to a mapped file.
 type MappedPage interface {
@@ -110,6 +114,39 @@ func NewMappedPage(fileName string, size int) (MappedPage, error) {
 	}, nil
 }
 
+// newPreAllocatedMappedPage returns a new MappedPage with disk space allocated and mapped bytes warmed,
+// avoids block allocation and page fault stalls when writing the page.
+func newPreAllocatedMappedPage(fileName string, size int) (MappedPage, error) {
+	f, err := openFileFunc(fileName, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err = fallocateFunc(f, int64(size)); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	bytes, err := mapFileFunc(f, size)
+	if err != nil {
+		// need close file, if map file failure
+		_ = f.Close()
+		return nil, err
+	}
+	// warm mapped bytes, loads page cache before writing
+	_ = fileutil.Madvise(bytes, fileutil.AdviceWillNeed)
+	var sum byte
+	for offset := 0; offset < len(bytes); offset += osPageSize {
+		sum += bytes[offset]
+	}
+	_ = sum
+
+	return &mappedPage{
+		fileName:    fileName,
+		f:           f,
+		mappedBytes: bytes,
+		size:        size,
+	}, nil
+}
+
 // FilePath returns mapped filePath.
 func (mp *mappedPage) FilePath() string {
 	return mp.fileName
@@ -82,3 +82,48 @@ func TestMappedPage_Write_number(t *testing.T) {
 	err = mp.Close()
 	assert.NoError(t, err)
 }
+
+func TestMappedPage_PreAllocate(t *testing.T) {
+	defer func() {
+		mapFileFunc = fileutil.RWMap
+		openFileFunc = os.OpenFile
+		fallocateFunc = fileutil.Fallocate
+	}()
+	tmpDir := t.TempDir()
+	fileName := filepath.Join(tmpDir, "1.bat")
+
+	// case 1: open file failure
+	openFileFunc = func(name string, flag int, perm os.FileMode) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	mp, err := newPreAllocatedMappedPage(fileName, 4096*2)
+	assert.Error(t, err)
+	assert.Nil(t, mp)
+	openFileFunc = os.OpenFile
+	// case 2: fallocate failure
+	fallocateFunc = func(_ *os.File, _ int64) error {
+		return fmt.Errorf("err")
+	}
+	mp, err = newPreAllocatedMappedPage(fileName, 4096*2)
+	assert.Error(t, err)
+	assert.Nil(t, mp)
+	fallocateFunc = fileutil.Fallocate
+	// case 3: map file failure
+	mapFileFunc = func(_ *os.File, _ int) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	mp, err = newPreAllocatedMappedPage(fileName, 4096*2)
+	assert.Error(t, err)
+	assert.Nil(t, mp)
+	mapFileFunc = fileutil.RWMap
+	// case 4: pre-allocate page successfully
+	mp, err = newPreAllocatedMappedPage(fileName, 4096*2)
+	assert.NoError(t, err)
+	assert.Equal(t, 4096*2, mp.Size())
+	mp.PutUint64(10, 0)
+	assert.Equal(t, uint64(10), mp.ReadUint64(0))
+	assert.NoError(t, mp.Close())
+	stat, err := os.Stat(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4096*2), stat.Size())
+}
@@ -21,13 +21,16 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/queue/page"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./queue.go -destination ./queue_mock.go -package queue
@@ -37,6 +40,7 @@ var (
 	mkDirFunc          = fileutil.MkDirIfNotExist
 	newPageFactoryFunc = page.NewFactory
 	existFunc          = fileutil.Exist
+	nowFunc            = timeutil.Now
 )
 
 var (
@@ -52,6 +56,28 @@ var (
 
 var queueLogger = logger.GetLogger("Queue", "FanOutQueue")
 
+// preAllocateDataPage represents if pre-allocates next data page before current one fills.
+var preAllocateDataPage atomic.Bool
+
+// EnableDataPagePreAllocate enables/disables pre-allocating next data page for the queue created after,
+// eliminates write stalls at data page rollover under high write rates.
+func EnableDataPagePreAllocate(enable bool) {
+	preAllocateDataPage.Store(enable)
+}
+
+// sync policy/interval for the queue created after, pages are synced when rollover by default.
+var (
+	syncPolicy   = atomic.NewString(string(fileutil.SyncPerRollover))
+	syncInterval = atomic.NewDuration(0)
+)
+
+// SetSyncPolicy sets the policy of syncing data/index/meta pages after putting message for the queue created after,
+// interval is used for per-interval policy.
+func SetSyncPolicy(policy fileutil.SyncPolicy, interval time.Duration) {
+	syncPolicy.Store(string(policy))
+	syncInterval.Store(interval)
+}
+
 // Queue represents a sequence of segments, new data is appended at append sequence.
 // Segments with all message will be removed by gc which sequence < acknowledged sequence.
 type Queue interface {
@@ -73,6 +99,10 @@ type Queue interface {
 	NotEmpty(consumeHead int64, checkClosed func() bool) bool
 	// Signal signals waiting consumers.
 	Signal()
+	// SeqOfTime returns the sequence from which consumer can replay all messages put at or after the timestamp,
+	// based on the sparse sequence->timestamp index, may include at most timeIndexInterval earlier messages.
+	// Returns acknowledged sequence + 1 if all available messages are put after the timestamp.
+	SeqOfTime(timestamp int64) int64
 	// GC removes all message which sequence <= acknowledged sequence.
 	GC()
 	// Close closes the queue.
@@ -87,6 +117,7 @@ type queue struct {
 	indexPageFct page.Factory // index page factory
 	dataPageFct  page.Factory // data page factory
 	metaPageFct  page.Factory // meta page factory
+	timePageFct  page.Factory // time page factory, sparse sequence->timestamp index
 
 	// queue meta with headSeq and tailSeq
 	metaPage        page.MappedPage // meta buffer
@@ -95,12 +126,18 @@ type queue struct {
 
 	indexPage      page.MappedPage // index buffer
 	indexPageIndex int64
+	timePage       page.MappedPage // time index buffer of current index page
 
 	// message data write context
 	dataPageIndex int64
 	dataPage      page.MappedPage
 	messageOffset int
 
+	preAllocate       bool  // if pre-allocates next data page
+	preAllocatedIndex int64 // index of data page pre-allocated
+
+	syncer *fileutil.Syncer // decides if syncing pages after putting message
+
 	closed  atomic.Bool
 	rwMutex *sync.RWMutex
 
@@ -118,6 +155,8 @@ func NewQueue(dirPath string, dataSizeLimit int64) (Queue, error) {
 		dataSizeLimit: dataSizeLimit,
 		rwMutex:       lock,
 		notEmpty:      sync.NewCond(lock),
+		preAllocate:   preAllocateDataPage.Load(),
+		syncer:        fileutil.NewSyncer(fileutil.SyncPolicy(syncPolicy.Load()), syncInterval.Load()),
 	}
 
 	// if data size limit < default limit, need reset
@@ -185,6 +224,15 @@ func NewQueue(dirPath string, dataSizeLimit int64) (Queue, error) {
 		}
 	}
 
+	// init time page factory
+	var timePageFct page.Factory
+	timePageFct, err = newPageFactoryFunc(filepath.Join(dirPath, timePath), timePageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	q.timePageFct = timePageFct
+
 	// initialize data page indexes
 	err = q.initDataPageIndex()
 	if err != nil {
@@ -335,6 +383,13 @@ func (q *queue) Close() {
 					logger.String("queue", q.dirPath), logger.Error(err))
 			}
 		}
+
+		if q.timePageFct != nil {
+			if err := q.timePageFct.Close(); err != nil {
+				queueLogger.Error("close time page factory error",
+					logger.String("queue", q.dirPath), logger.Error(err))
+			}
+		}
 	}
 }
 
@@ -356,6 +411,50 @@ func (q *queue) GC() {
 
 	q.dataPageFct.TruncatePages(dataPageID)
 	q.indexPageFct.TruncatePages(indexPageID)
+	q.timePageFct.TruncatePages(indexPageID)
+}
+
+// SeqOfTime returns the sequence from which consumer can replay all messages put at or after the timestamp,
+// based on the sparse sequence->timestamp index, may include at most timeIndexInterval earlier messages.
+// Returns acknowledged sequence + 1 if all available messages are put after the timestamp.
+func (q *queue) SeqOfTime(timestamp int64) int64 {
+	q.rwMutex.RLock()
+	defer q.rwMutex.RUnlock()
+
+	firstSeq := q.acknowledgedSeq.Load() + 1
+	appendedSeq := q.appendedSeq.Load()
+	if appendedSeq < firstSeq {
+		return firstSeq
+	}
+	// sampled sequences in [first sequence, appended sequence]
+	low := (firstSeq + timeIndexInterval - 1) / timeIndexInterval
+	high := appendedSeq / timeIndexInterval
+	if high < low {
+		return firstSeq
+	}
+	// find the first sampled message put at or after the timestamp
+	idx := sort.Search(int(high-low+1), func(i int) bool {
+		return q.timeOfSeq((low+int64(i))*timeIndexInterval) >= timestamp
+	})
+	if idx == 0 {
+		return firstSeq
+	}
+	// messages after previous sampled message maybe put at or after the timestamp
+	return (low+int64(idx)-1)*timeIndexInterval + 1
+}
+
+// timeOfSeq returns the put timestamp of sampled sequence, returns 0 if not recorded(queue created by old version).
+func (q *queue) timeOfSeq(seq int64) int64 {
+	timePage, ok := q.timePageFct.GetPage(seq / indexItemsPerPage)
+	if !ok {
+		return 0
+	}
+	return int64(timePage.ReadUint64(timeOffsetOfSeq(seq)))
+}
+
+// timeOffsetOfSeq returns the offset in time page of sampled sequence.
+func timeOffsetOfSeq(seq int64) int {
+	return int((seq % indexItemsPerPage) / timeIndexInterval * timeItemLength)
 }
 
 // alloc allocates the data page and offset for message writing
@@ -388,9 +487,36 @@ func (q *queue) alloc(dataLen int) (dataPageIndex int64, dataPage page.MappedPag
 	// advance dataOffset
 	messageOffset := q.messageOffset
 	q.messageOffset += dataLen // set next message offset
+	q.preAllocateDataPage()
 	return q.dataPageIndex, q.dataPage, messageOffset, nil
 }
 
+// syncPages syncs the pages after putting message, must be called under lock.
+func (q *queue) syncPages(pages ...page.MappedPage) error {
+	for _, p := range pages {
+		if err := p.Sync(); err != nil {
+			queueLogger.Error("sync page err when put message",
+				logger.String("queue", q.dirPath), logger.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// preAllocateDataPage pre-allocates next data page in background when current data page is almost full,
+// must be called under lock.
+func (q *queue) preAllocateDataPage() {
+	if !q.preAllocate || q.messageOffset < dataPagePreAllocateOffset || q.preAllocatedIndex > q.dataPageIndex {
+		return
+	}
+	if err := q.checkDataSize(); err != nil {
+		// next data page cannot be acquired
+		return
+	}
+	q.preAllocatedIndex = q.dataPageIndex + 1
+	q.dataPageFct.PreAllocate(q.preAllocatedIndex)
+}
+
 // persistMetaOfMessage persists metadata of message after write data
 func (q *queue) persistMetaOfMessage(dataPageIndex int64, dataLen, messageOffset int) error {
 	q.rwMutex.Lock()
@@ -412,9 +538,14 @@ func (q *queue) persistMetaOfMessage(dataPageIndex int64, dataLen, messageOffset
 		if err != nil {
 			return err
 		}
+		timePage, err := q.timePageFct.AcquirePage(indexPageIndex)
+		if err != nil {
+			return err
+		}
 
 		q.indexPage = indexPage
 		q.indexPageIndex = indexPageIndex
+		q.timePage = timePage
 	}
 
 	// save index data
@@ -422,11 +553,29 @@ func (q *queue) persistMetaOfMessage(dataPageIndex int64, dataLen, messageOffset
 	q.indexPage.PutUint64(uint64(dataPageIndex), indexOffset+queueDataPageIndexOffset)
 	q.indexPage.PutUint32(uint32(messageOffset), indexOffset+messageOffsetOffset)
 	q.indexPage.PutUint32(uint32(dataLen), indexOffset+messageLengthOffset)
+	if seq%timeIndexInterval == 0 {
+		// save put time of sampled message
+		q.timePage.PutUint64(uint64(nowFunc()), timeOffsetOfSeq(seq))
+	}
+
+	needSync := q.syncer.NeedSync()
+	if needSync {
+		// sync data/index before meta, the message can be read after recovering if appended sequence persisted
+		if err := q.syncPages(q.dataPage, q.indexPage, q.timePage); err != nil {
+			return err
+		}
+	}
 
 	// save metadata
 	q.metaPage.PutUint64(uint64(seq), queueAppendedSeqOffset)
 	q.appendedSeq.Store(seq)
 
+	if needSync {
+		if err := q.syncPages(q.metaPage); err != nil {
+			return err
+		}
+	}
+
 	// new data written, notify all waiting consumer groups can consume data
 	q.notEmpty.Broadcast()
 	return nil
@@ -453,6 +602,10 @@ func (q *queue) initDataPageIndex() (err error) {
 			return err
 		}
 
+		if q.timePage, err = q.timePageFct.AcquirePage(0); err != nil {
+			return err
+		}
+
 		return nil
 	}
 
@@ -463,6 +616,10 @@ func (q *queue) initDataPageIndex() (err error) {
 		return err
 	}
 
+	if q.timePage, err = q.timePageFct.AcquirePage(q.indexPageIndex); err != nil {
+		return err
+	}
+
 	// calculate index offset of previous sequence
 	indexOffset := int((previousSeq % indexItemsPerPage) * indexItemLength)
 	q.dataPageIndex = int64(q.indexPage.ReadUint64(indexOffset + queueDataPageIndexOffset))
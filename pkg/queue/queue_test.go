@@ -31,6 +31,7 @@ import (
 
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/queue/page"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestQueue_Put(t *testing.T) {
@@ -291,6 +292,17 @@ func TestQueue_new_err(t *testing.T) {
 	q, err = NewQueue(filepath.Join(t.TempDir(), t.Name()), 1024)
 	assert.Error(t, err)
 	assert.Nil(t, q)
+	// case 8: create time page factory err
+	newPageFactoryFunc = func(path string, pageSize int) (page.Factory, error) {
+		if strings.HasSuffix(path, timePath) {
+			return nil, fmt.Errorf("err")
+		}
+
+		return page.NewFactory(path, pageSize)
+	}
+	q, err = NewQueue(filepath.Join(t.TempDir(), t.Name()), 1024)
+	assert.Error(t, err)
+	assert.Nil(t, q)
 }
 
 func TestQueue_Close(t *testing.T) {
@@ -309,6 +321,93 @@ func TestQueue_Close(t *testing.T) {
 	q.Close()
 }
 
+func TestQueue_preAllocateDataPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dataPageFct := page.NewMockFactory(ctrl)
+	indexPageFct := page.NewMockFactory(ctrl)
+	q := &queue{
+		dataPageFct:   dataPageFct,
+		indexPageFct:  indexPageFct,
+		dataSizeLimit: 1024,
+	}
+	// pre-allocate disabled
+	q.messageOffset = dataPagePreAllocateOffset
+	q.preAllocateDataPage()
+	q.preAllocate = true
+	// data page not almost full
+	q.messageOffset = dataPagePreAllocateOffset - 1
+	q.preAllocateDataPage()
+	// exceed data size limit
+	q.messageOffset = dataPagePreAllocateOffset
+	dataPageFct.EXPECT().Size().Return(int64(1024))
+	indexPageFct.EXPECT().Size().Return(int64(1))
+	q.preAllocateDataPage()
+	assert.Equal(t, int64(0), q.preAllocatedIndex)
+	// pre-allocate next data page
+	dataPageFct.EXPECT().Size().Return(int64(10))
+	indexPageFct.EXPECT().Size().Return(int64(1))
+	dataPageFct.EXPECT().PreAllocate(int64(1))
+	q.preAllocateDataPage()
+	assert.Equal(t, int64(1), q.preAllocatedIndex)
+	// next data page already pre-allocated
+	q.preAllocateDataPage()
+}
+
+func TestQueue_SyncPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		SetSyncPolicy(fileutil.SyncPerRollover, 0)
+		ctrl.Finish()
+	}()
+	dir := filepath.Join(t.TempDir(), t.Name())
+	SetSyncPolicy(fileutil.SyncPerWrite, 0)
+	q, err := NewQueue(dir, 1024)
+	assert.NoError(t, err)
+	q1 := q.(*queue)
+	assert.Equal(t, fileutil.SyncPerWrite, q1.syncer.Policy())
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, q.Put([]byte(fmt.Sprintf("msg-%d", i))))
+	}
+	// crash without closing queue, all messages can be read after recovering
+	q2, err := NewQueue(dir, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), q2.AppendedSeq())
+	for i := 0; i < 10; i++ {
+		data, err := q2.Get(int64(i))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), data)
+	}
+	q2.Close()
+
+	// sync data page failure, message not appended
+	dataPage := q1.dataPage
+	mockPage := page.NewMockMappedPage(ctrl)
+	mockPage.EXPECT().Sync().Return(fmt.Errorf("err"))
+	q1.dataPage = mockPage
+	mockPage.EXPECT().WriteBytes(gomock.Any(), gomock.Any())
+	assert.Error(t, q.Put([]byte("msg")))
+	assert.Equal(t, int64(9), q.AppendedSeq())
+	// sync meta page failure
+	q1.dataPage = dataPage
+	metaPage := q1.metaPage
+	mockPage.EXPECT().PutUint64(gomock.Any(), gomock.Any())
+	mockPage.EXPECT().Sync().Return(fmt.Errorf("err"))
+	q1.metaPage = mockPage
+	assert.Error(t, q.Put([]byte("msg")))
+	q1.metaPage = metaPage
+	q.Close()
+
+	// per interval: sync at most once per interval
+	SetSyncPolicy(fileutil.SyncPerInterval, time.Hour)
+	q, err = NewQueue(filepath.Join(t.TempDir(), "interval"), 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, fileutil.SyncPerInterval, q.(*queue).syncer.Policy())
+	assert.NoError(t, q.Put([]byte("msg")))
+	q.Close()
+}
+
 func TestQueue_reopen_err(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	dir := filepath.Join(t.TempDir(), t.Name())
@@ -358,6 +457,23 @@ func TestQueue_reopen_err(t *testing.T) {
 	q, err = NewQueue(dir, 1024)
 	assert.Error(t, err)
 	assert.Nil(t, q)
+
+	// case 3: acquire time page err
+	fct = page.NewMockFactory(ctrl)
+	newPageFactoryFunc = func(path string, pageSize int) (page.Factory, error) {
+		if strings.HasSuffix(path, timePath) {
+			return fct, nil
+		}
+
+		return page.NewFactory(path, pageSize)
+	}
+
+	fct.EXPECT().AcquirePage(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	fct.EXPECT().Close().Return(nil)
+
+	q, err = NewQueue(dir, 1024)
+	assert.Error(t, err)
+	assert.Nil(t, q)
 }
 
 func TestQueue_Put_err(t *testing.T) {
@@ -616,3 +732,55 @@ func mockMessageData(bucket, length int) map[string][]byte {
 
 	return data
 }
+
+func TestQueue_SeqOfTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dir := filepath.Join(t.TempDir(), t.Name())
+	defer func() {
+		nowFunc = timeutil.Now
+		ctrl.Finish()
+	}()
+	now := int64(0)
+	nowFunc = func() int64 {
+		now += 10
+		return now
+	}
+
+	q, err := NewQueue(dir, 1024)
+	assert.NoError(t, err)
+	// empty queue
+	assert.Equal(t, int64(0), q.SeqOfTime(10))
+	// sampled messages: 0->10, 1024->20, 2048->30, 3072->40
+	for i := 0; i < 4000; i++ {
+		assert.NoError(t, q.Put([]byte("123")))
+	}
+	assert.Equal(t, int64(0), q.SeqOfTime(5))
+	assert.Equal(t, int64(0), q.SeqOfTime(10))
+	assert.Equal(t, int64(1), q.SeqOfTime(15))
+	assert.Equal(t, int64(1), q.SeqOfTime(20))
+	assert.Equal(t, int64(1025), q.SeqOfTime(25))
+	assert.Equal(t, int64(3073), q.SeqOfTime(100))
+	// skip acknowledged messages
+	q.SetAcknowledgedSeq(2000)
+	assert.Equal(t, int64(2001), q.SeqOfTime(5))
+	assert.Equal(t, int64(2001), q.SeqOfTime(30))
+	assert.Equal(t, int64(2049), q.SeqOfTime(35))
+	q.Close()
+
+	// reopen queue
+	q, err = NewQueue(dir, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2049), q.SeqOfTime(35))
+	// time page not exist
+	q1 := q.(*queue)
+	timePageFct := q1.timePageFct
+	fct := page.NewMockFactory(ctrl)
+	fct.EXPECT().GetPage(gomock.Any()).Return(nil, false).AnyTimes()
+	q1.timePageFct = fct
+	assert.Equal(t, int64(3073), q.SeqOfTime(35))
+	q1.timePageFct = timePageFct
+	// all messages acknowledged
+	q.SetAcknowledgedSeq(3999)
+	assert.Equal(t, int64(4000), q.SeqOfTime(35))
+	q.Close()
+}
@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package strutil
+
+import (
+	"sort"
+	"strings"
+)
+
+// scores of fuzzy match, larger is better matched.
+const (
+	exactMatchScore       = 4000
+	prefixMatchScore      = 3000
+	substringMatchScore   = 2000
+	subsequenceMatchScore = 1000
+	maxMatchPenalty       = 999
+)
+
+// FuzzyMatch checks if the value matches the pattern(case-insensitive), returns the match score,
+// exact > prefix > substring > subsequence(chars of pattern appear in order), shorter/tighter match is better.
+func FuzzyMatch(pattern, value string) (score int, ok bool) {
+	if pattern == "" {
+		return subsequenceMatchScore, true
+	}
+	p := strings.ToLower(pattern)
+	v := strings.ToLower(value)
+	switch {
+	case p == v:
+		return exactMatchScore, true
+	case strings.HasPrefix(v, p):
+		return prefixMatchScore - penalty(len(v)-len(p)), true
+	}
+	if idx := strings.Index(v, p); idx > 0 {
+		return substringMatchScore - penalty(idx+len(v)-len(p)), true
+	}
+	// check if chars of pattern appear in order, penalty by the gaps between matched chars
+	gaps, start, pos := 0, -1, 0
+	for _, c := range p {
+		idx := strings.IndexRune(v[pos:], c)
+		if idx < 0 {
+			return 0, false
+		}
+		if start < 0 {
+			start = pos + idx
+		} else {
+			gaps += idx
+		}
+		pos += idx + len(string(c))
+	}
+	return subsequenceMatchScore - penalty(gaps*10+start), true
+}
+
+// FuzzyFilter returns the values which match the pattern, sorted by match score desc(then by value),
+// returns at most limit values if limit > 0.
+func FuzzyFilter(pattern string, values []string, limit int) []string {
+	type match struct {
+		value string
+		score int
+	}
+	matches := make([]match, 0, len(values))
+	for _, value := range values {
+		if score, ok := FuzzyMatch(pattern, value); ok {
+			matches = append(matches, match{value: value, score: score})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].value < matches[j].value
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	result := make([]string, len(matches))
+	for idx := range matches {
+		result[idx] = matches[idx].value
+	}
+	return result
+}
+
+// penalty returns the penalty of match score, keeps score in the range of match type.
+func penalty(n int) int {
+	if n > maxMatchPenalty {
+		return maxMatchPenalty
+	}
+	return n
+}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package strutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	exact, ok := FuzzyMatch("CPU", "cpu")
+	assert.True(t, ok)
+	prefix, ok := FuzzyMatch("cpu", "cpu.load")
+	assert.True(t, ok)
+	substring, ok := FuzzyMatch("load", "cpu.load")
+	assert.True(t, ok)
+	subsequence, ok := FuzzyMatch("cpld", "cpu.load")
+	assert.True(t, ok)
+	assert.True(t, exact > prefix)
+	assert.True(t, prefix > substring)
+	assert.True(t, substring > subsequence)
+
+	_, ok = FuzzyMatch("mem", "cpu.load")
+	assert.False(t, ok)
+	_, ok = FuzzyMatch("dl", "cpu.load")
+	assert.False(t, ok)
+	_, ok = FuzzyMatch("", "cpu.load")
+	assert.True(t, ok)
+
+	tight, _ := FuzzyMatch("cl", "cpu.cl")
+	loose, _ := FuzzyMatch("cl", "cpu.idle")
+	assert.True(t, tight > loose)
+}
+
+func TestFuzzyFilter(t *testing.T) {
+	values := []string{"memory", "cpu.load", "system.cpu", "cpu", "c.p.u", "disk"}
+	assert.Equal(t, []string{"cpu", "cpu.load", "system.cpu", "c.p.u"}, FuzzyFilter("cpu", values, 0))
+	assert.Equal(t, []string{"cpu", "cpu.load"}, FuzzyFilter("cpu", values, 2))
+	assert.Empty(t, FuzzyFilter("xyz", values, 0))
+}
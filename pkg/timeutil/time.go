@@ -48,19 +48,29 @@ const (
 	DataTimeFormat4 = "20060102150405"
 )
 
+// iso8601Formats represents the ISO8601 layouts(with/without timezone offset), fractional second is accepted when parsing.
+var iso8601Formats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+}
+
 // FormatTimestamp returns timestamp format based on layout
 func FormatTimestamp(timestamp int64, layout string) string {
 	t := time.Unix(timestamp/1000, 0)
 	return t.Format(layout)
 }
 
-// ParseTimestamp parses timestamp str value based on layout using local zone
+// ParseTimestamp parses timestamp str value based on layout using local zone,
+// ISO8601 timestamp with timezone offset is supported if layout not given.
 func ParseTimestamp(timestampStr string, layout ...string) (int64, error) {
 	var format string
 	if len(layout) > 0 {
 		format = layout[0]
 	} else {
 		switch {
+		case strings.Contains(timestampStr, "T"):
+			return parseISO8601(timestampStr)
 		case strings.Index(timestampStr, "-") > 0:
 			format = DataTimeFormat2
 		case strings.Index(timestampStr, "/") > 0:
@@ -78,6 +88,21 @@ func ParseTimestamp(timestampStr string, layout ...string) (int64, error) {
 	return tm.UnixNano() / 1000000, nil
 }
 
+// parseISO8601 parses ISO8601 timestamp str value, using local zone if timezone offset not given.
+func parseISO8601(timestampStr string) (int64, error) {
+	var err error
+	for _, format := range iso8601Formats {
+		tm, err0 := parseTimeFunc(format, timestampStr, time.Local)
+		if err0 == nil {
+			return tm.UnixNano() / 1000000, nil
+		}
+		if err == nil {
+			err = err0
+		}
+	}
+	return 0, err
+}
+
 // Now returns t as a Unix time, the number of millisecond elapsed
 // since January 1, 1970 UTC. The result does not depend on the
 // location associated with t.
@@ -45,6 +45,23 @@ func Test_ParseTimestamp(t *testing.T) {
 	_, err = ParseTimestamp("2019/12/12 10:11:10")
 	assert.Nil(t, err)
 
+	// iso8601
+	ts, err := ParseTimestamp("2019-12-12T10:11:10Z")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1576145470000), ts)
+	ts, err = ParseTimestamp("2019-12-12T18:11:10.500+08:00")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1576145470500), ts)
+	ts, err = ParseTimestamp("2019-12-12T18:11:10+0800")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1576145470000), ts)
+	ts, err = ParseTimestamp("2019-12-12T10:11:10")
+	assert.Nil(t, err)
+	local, _ := ParseTimestamp("2019-12-12 10:11:10")
+	assert.Equal(t, local, ts)
+	_, err = ParseTimestamp("2019-12-12T10:11")
+	assert.Error(t, err)
+
 	parseTimeFunc = func(layout, value string, loc *time.Location) (t time.Time, err error) {
 		return time.Now(), fmt.Errorf("err")
 	}
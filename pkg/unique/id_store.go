@@ -47,6 +47,8 @@ type IDStore interface {
 	Delete(key []byte) error
 	// IterKeys iterates the key list by given prefix, returns the key list.
 	IterKeys(prefix []byte, limit int) (rs [][]byte, err error)
+	// Walk walks all key/value pairs by given prefix, stops walking if fn returns false.
+	Walk(prefix []byte, fn func(key, value []byte) bool) error
 	// Flush flushes the memory table data under pebble db.
 	Flush() error
 }
@@ -146,6 +148,32 @@ func (s *idStore) IterKeys(prefix []byte, limit int) (rs [][]byte, err error) {
 	return rs, nil
 }
 
+// Walk walks all key/value pairs by given prefix, stops walking if fn returns false.
+// NOTICE: key/value are only valid in fn, need copy it if keeping them.
+func (s *idStore) Walk(prefix []byte, fn func(key, value []byte) bool) error {
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+	})
+	defer func() {
+		if err0 := it.Close(); err0 != nil {
+			s.logger.Warn("close kv iterator resource err",
+				logger.String("path", s.path),
+				logger.Error(err0))
+		}
+	}()
+
+	for it.First(); it.Valid(); it.Next() {
+		key := it.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		if !fn(key, it.Value()) {
+			break
+		}
+	}
+	return it.Error()
+}
+
 // Flush flushes the memory table data under pebble db.
 func (s *idStore) Flush() error {
 	return s.db.Flush()
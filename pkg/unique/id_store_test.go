@@ -215,6 +215,41 @@ func TestIdStore_IterIDKeys(t *testing.T) {
 	}
 }
 
+func TestIdStore_Walk(t *testing.T) {
+	p := t.TempDir()
+	store, err := NewIDStore(p)
+	assert.NoError(t, err)
+	defer func() {
+		_ = store.Close()
+	}()
+	mock(t, store)
+
+	count := 0
+	err = store.Walk([]byte("ns"), func(key, value []byte) bool {
+		assert.Equal(t, key, value)
+		count++
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, count)
+	// walk all
+	count = 0
+	err = store.Walk(nil, func(_, _ []byte) bool {
+		count++
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 11, count)
+	// stop walking
+	count = 0
+	err = store.Walk([]byte("ns"), func(_, _ []byte) bool {
+		count++
+		return count < 3
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
 func mock(t *testing.T, store IDStore) {
 	for i := 0; i < 10; i++ {
 		key := fmt.Sprintf("ns-%d", i)
@@ -73,6 +73,9 @@ type TaskRequest struct {
 	RequestType          RequestType `protobuf:"varint,3,opt,name=requestType,proto3,enum=protoCommonV1.RequestType" json:"requestType,omitempty"`
 	PhysicalPlan         []byte      `protobuf:"bytes,4,opt,name=physicalPlan,proto3" json:"physicalPlan,omitempty"`
 	Payload              []byte      `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Credits              int32       `protobuf:"varint,6,opt,name=credits,proto3" json:"credits,omitempty"`
+	Priority             int32       `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Cancel               bool        `protobuf:"varint,8,opt,name=cancel,proto3" json:"cancel,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -139,6 +142,27 @@ func (m *TaskRequest) GetPayload() []byte {
 	return nil
 }
 
+func (m *TaskRequest) GetCredits() int32 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
+func (m *TaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
 type TaskResponse struct {
 	RequestID            string      `protobuf:"bytes,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
 	RequestType          RequestType `protobuf:"varint,2,opt,name=requestType,proto3,enum=protoCommonV1.RequestType" json:"requestType,omitempty"`
@@ -147,6 +171,8 @@ type TaskResponse struct {
 	SendTime             int64       `protobuf:"varint,5,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
 	Payload              []byte      `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
 	Stats                []byte      `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	ErrCode              int32       `protobuf:"varint,8,opt,name=errCode,proto3" json:"errCode,omitempty"`
+	Partials             int32       `protobuf:"varint,9,opt,name=partials,proto3" json:"partials,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -234,6 +260,20 @@ func (m *TaskResponse) GetStats() []byte {
 	return nil
 }
 
+func (m *TaskResponse) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
+	}
+	return 0
+}
+
+func (m *TaskResponse) GetPartials() int32 {
+	if m != nil {
+		return m.Partials
+	}
+	return 0
+}
+
 type TimeSeriesList struct {
 	Start                int64             `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
 	End                  int64             `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -617,6 +657,26 @@ func (m *TaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Priority != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Credits != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.Credits))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -670,6 +730,16 @@ func (m *TaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Partials != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.Partials))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ErrCode != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.ErrCode))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Stats) > 0 {
 		i -= len(m.Stats)
 		copy(dAtA[i:], m.Stats)
@@ -935,6 +1005,15 @@ func (m *TaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if m.Credits != 0 {
+		n += 1 + sovCommon(uint64(m.Credits))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovCommon(uint64(m.Priority))
+	}
+	if m.Cancel {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -972,6 +1051,12 @@ func (m *TaskResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if m.ErrCode != 0 {
+		n += 1 + sovCommon(uint64(m.ErrCode))
+	}
+	if m.Partials != 0 {
+		n += 1 + sovCommon(uint64(m.Partials))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1219,6 +1304,64 @@ func (m *TaskRequest) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			m.Credits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credits |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -1460,6 +1603,44 @@ func (m *TaskResponse) Unmarshal(dAtA []byte) error {
 				m.Stats = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			}
+			m.ErrCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partials", wireType)
+			}
+			m.Partials = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partials |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -280,8 +280,13 @@ func (m *GetReplicaAckIndexResponse) GetAckIndex() int64 {
 }
 
 type ReplicaRequest struct {
+	Database             string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Shard                int32    `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Leader               int32    `protobuf:"varint,3,opt,name=leader,proto3" json:"leader,omitempty"`
 	ReplicaIndex         int64    `protobuf:"varint,4,opt,name=replicaIndex,proto3" json:"replicaIndex,omitempty"`
 	Record               []byte   `protobuf:"bytes,5,opt,name=record,proto3" json:"record,omitempty"`
+	Follower             int32    `protobuf:"varint,6,opt,name=follower,proto3" json:"follower,omitempty"`
+	FamilyTime           int64    `protobuf:"varint,7,opt,name=familyTime,proto3" json:"familyTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -320,6 +325,27 @@ func (m *ReplicaRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReplicaRequest proto.InternalMessageInfo
 
+func (m *ReplicaRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ReplicaRequest) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *ReplicaRequest) GetLeader() int32 {
+	if m != nil {
+		return m.Leader
+	}
+	return 0
+}
+
 func (m *ReplicaRequest) GetReplicaIndex() int64 {
 	if m != nil {
 		return m.ReplicaIndex
@@ -334,6 +360,20 @@ func (m *ReplicaRequest) GetRecord() []byte {
 	return nil
 }
 
+func (m *ReplicaRequest) GetFollower() int32 {
+	if m != nil {
+		return m.Follower
+	}
+	return 0
+}
+
+func (m *ReplicaRequest) GetFamilyTime() int64 {
+	if m != nil {
+		return m.FamilyTime
+	}
+	return 0
+}
+
 type ReplicaResponse struct {
 	Database             string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Shard                int32    `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
@@ -342,6 +382,7 @@ type ReplicaResponse struct {
 	AckIndex             int64    `protobuf:"varint,5,opt,name=ackIndex,proto3" json:"ackIndex,omitempty"`
 	ResponseTime         int64    `protobuf:"varint,6,opt,name=responseTime,proto3" json:"responseTime,omitempty"`
 	Err                  string   `protobuf:"bytes,7,opt,name=err,proto3" json:"err,omitempty"`
+	FamilyTime           int64    `protobuf:"varint,8,opt,name=familyTime,proto3" json:"familyTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -429,6 +470,13 @@ func (m *ReplicaResponse) GetErr() string {
 	return ""
 }
 
+func (m *ReplicaResponse) GetFamilyTime() int64 {
+	if m != nil {
+		return m.FamilyTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ResetIndexRequest)(nil), "protoReplicaV1.ResetIndexRequest")
 	proto.RegisterType((*ResetIndexResponse)(nil), "protoReplicaV1.ResetIndexResponse")
@@ -839,6 +887,16 @@ func (m *ReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FamilyTime != 0 {
+		i = encodeVarintReplica(dAtA, i, uint64(m.FamilyTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Follower != 0 {
+		i = encodeVarintReplica(dAtA, i, uint64(m.Follower))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Record) > 0 {
 		i -= len(m.Record)
 		copy(dAtA[i:], m.Record)
@@ -851,6 +909,23 @@ func (m *ReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
+	if m.Leader != 0 {
+		i = encodeVarintReplica(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Shard != 0 {
+		i = encodeVarintReplica(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintReplica(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -878,6 +953,11 @@ func (m *ReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FamilyTime != 0 {
+		i = encodeVarintReplica(dAtA, i, uint64(m.FamilyTime))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Err) > 0 {
 		i -= len(m.Err)
 		copy(dAtA[i:], m.Err)
@@ -1017,6 +1097,16 @@ func (m *ReplicaRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovReplica(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovReplica(uint64(m.Shard))
+	}
+	if m.Leader != 0 {
+		n += 1 + sovReplica(uint64(m.Leader))
+	}
 	if m.ReplicaIndex != 0 {
 		n += 1 + sovReplica(uint64(m.ReplicaIndex))
 	}
@@ -1024,6 +1114,12 @@ func (m *ReplicaRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReplica(uint64(l))
 	}
+	if m.Follower != 0 {
+		n += 1 + sovReplica(uint64(m.Follower))
+	}
+	if m.FamilyTime != 0 {
+		n += 1 + sovReplica(uint64(m.FamilyTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1059,6 +1155,9 @@ func (m *ReplicaResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReplica(uint64(l))
 	}
+	if m.FamilyTime != 0 {
+		n += 1 + sovReplica(uint64(m.FamilyTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1520,6 +1619,76 @@ func (m *ReplicaRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			m.Leader = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Leader |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaIndex", wireType)
@@ -1573,6 +1742,44 @@ func (m *ReplicaRequest) Unmarshal(dAtA []byte) error {
 				m.Record = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follower", wireType)
+			}
+			m.Follower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Follower |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyTime", wireType)
+			}
+			m.FamilyTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplica(dAtA[iNdEx:])
@@ -1783,6 +1990,25 @@ func (m *ReplicaResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyTime", wireType)
+			}
+			m.FamilyTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplica(dAtA[iNdEx:])
@@ -45,6 +45,8 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type WriteRequest struct {
 	Record               []byte   `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	AuditID              int64    `protobuf:"varint,2,opt,name=auditID,proto3" json:"auditID,omitempty"`
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -90,6 +92,20 @@ func (m *WriteRequest) GetRecord() []byte {
 	return nil
 }
 
+func (m *WriteRequest) GetAuditID() int64 {
+	if m != nil {
+		return m.AuditID
+	}
+	return 0
+}
+
+func (m *WriteRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type WriteResponse struct {
 	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -145,18 +161,20 @@ func init() {
 func init() { proto.RegisterFile("write.proto", fileDescriptor_67966b2b12a73214) }
 
 var fileDescriptor_67966b2b12a73214 = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
+	// 199 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x2f, 0xca, 0x2c,
 	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0x53, 0xe1, 0x20, 0x91, 0x30, 0x43,
-	0x25, 0x35, 0x2e, 0x1e, 0x30, 0x33, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x48, 0x8c, 0x8b,
-	0xad, 0x28, 0x35, 0x39, 0xbf, 0x28, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xca, 0x53,
-	0x52, 0xe4, 0xe2, 0x85, 0xaa, 0x2b, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x12, 0xe0, 0x62, 0x4e,
-	0x2d, 0x2a, 0x02, 0xab, 0xe2, 0x0c, 0x02, 0x31, 0x8d, 0xc2, 0xa0, 0x46, 0x05, 0xa7, 0x16, 0x95,
-	0x65, 0x26, 0xa7, 0x0a, 0xb9, 0x71, 0xb1, 0x82, 0xf9, 0x42, 0x52, 0x7a, 0xc8, 0x56, 0xea, 0x21,
-	0xdb, 0x27, 0x25, 0x8d, 0x55, 0x0e, 0x62, 0x87, 0x12, 0x83, 0x06, 0xa3, 0x01, 0xa3, 0x93, 0xc0,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3, 0xb1, 0x1c,
-	0x43, 0x12, 0x1b, 0x58, 0x8f, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x48, 0xda, 0x57, 0x19, 0xd8,
-	0x00, 0x00, 0x00,
+	0xa5, 0x38, 0x2e, 0x1e, 0x30, 0x33, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x48, 0x8c, 0x8b,
+	0xad, 0x28, 0x35, 0x39, 0xbf, 0x28, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xca, 0x13,
+	0x92, 0xe0, 0x62, 0x4f, 0x2c, 0x4d, 0xc9, 0x2c, 0xf1, 0x74, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60,
+	0x0e, 0x82, 0x71, 0x85, 0x64, 0xb8, 0x38, 0x4b, 0x32, 0x73, 0x53, 0x8b, 0x4b, 0x12, 0x73, 0x0b,
+	0x24, 0x98, 0xc1, 0x72, 0x08, 0x01, 0x25, 0x45, 0x2e, 0x5e, 0xa8, 0xf9, 0xc5, 0x05, 0xf9, 0x79,
+	0xc5, 0xa9, 0x42, 0x02, 0x5c, 0xcc, 0xa9, 0x45, 0x45, 0x60, 0xd3, 0x39, 0x83, 0x40, 0x4c, 0xa3,
+	0x30, 0xa8, 0x13, 0x82, 0x53, 0x8b, 0xca, 0x32, 0x93, 0x53, 0x85, 0xdc, 0xb8, 0x58, 0xc1, 0x7c,
+	0x21, 0x29, 0x3d, 0x64, 0xa7, 0xea, 0x21, 0xbb, 0x53, 0x4a, 0x1a, 0xab, 0x1c, 0xc4, 0x0e, 0x25,
+	0x06, 0x0d, 0x46, 0x03, 0x46, 0x27, 0x81, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c,
+	0xf0, 0x48, 0x8e, 0x71, 0xc6, 0x63, 0x39, 0x86, 0x24, 0x36, 0xb0, 0x1e, 0x63, 0xc0, 0x00, 0x42,
+	0xf4, 0x32, 0x34, 0x10, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -295,6 +313,16 @@ func (m *WriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timestamp != 0 {
+		i = encodeVarintWrite(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuditID != 0 {
+		i = encodeVarintWrite(dAtA, i, uint64(m.AuditID))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Record) > 0 {
 		i -= len(m.Record)
 		copy(dAtA[i:], m.Record)
@@ -360,6 +388,12 @@ func (m *WriteRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWrite(uint64(l))
 	}
+	if m.AuditID != 0 {
+		n += 1 + sovWrite(uint64(m.AuditID))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovWrite(uint64(m.Timestamp))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -451,6 +485,44 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 				m.Record = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditID", wireType)
+			}
+			m.AuditID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWrite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWrite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWrite(dAtA[iNdEx:])
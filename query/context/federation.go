@@ -0,0 +1,101 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// defaultFederationInterval is the group by interval of federation query if query time range is short and no interval input.
+const defaultFederationInterval = timeutil.Interval(10 * timeutil.OneSecond)
+
+var (
+	federationLogger = logger.GetLogger("Query", "Federation")
+)
+
+// isFederation checks if the query fans out to multi broker clusters(logic database with multi routers).
+func isFederation(physicalPlans []*models.PhysicalPlan) bool {
+	clusters := make(map[string]struct{})
+	for _, physicalPlan := range physicalPlans {
+		if physicalPlan.Cluster == "" {
+			return false
+		}
+		clusters[physicalPlan.Cluster] = struct{}{}
+	}
+	return len(clusters) > 1
+}
+
+// prepareFederation prepares the federation query context, aligns the time buckets of all broker clusters
+// and tolerates the failures of part of broker clusters.
+func (ctx *RootMetricContext) prepareFederation(physicalPlans []*models.PhysicalPlan) {
+	clusters := make(map[string]struct{})
+	for _, physicalPlan := range physicalPlans {
+		clusters[physicalPlan.Cluster] = struct{}{}
+	}
+	ctx.numOfClusters = len(clusters)
+	ctx.clusters = make(map[string]string)
+	ctx.partialFailure = ctx.tolerateClusterFailure
+	// only one time bucket if auto group by time, no alignment need
+	ctx.checkBucketAlignment = !ctx.Deps.Statement.AutoGroupByTime
+	alignFederationQuery(ctx.Deps.Statement)
+}
+
+// tolerateClusterFailure records the failure of broker cluster which target node belongs to,
+// returns false if the failure cannot be tolerated(all broker clusters fail).
+func (ctx *RootMetricContext) tolerateClusterFailure(fromNode string, err error) bool {
+	if errors.Is(err, constants.ErrNotFound) || errorpkg.CodeOf(err) == errorpkg.MetadataNotFound {
+		// not found error handled by tolerant not founds
+		return false
+	}
+	cluster, ok := ctx.clusters[fromNode]
+	if !ok {
+		return false
+	}
+	if ctx.clusterFailures == nil {
+		ctx.clusterFailures = make(map[string]string)
+	}
+	ctx.clusterFailures[cluster] = err.Error()
+	federationLogger.Warn("broker cluster of federation query fails, ignore it",
+		logger.String("cluster", cluster), logger.String("node", fromNode), logger.Error(err))
+	return len(ctx.clusterFailures) < ctx.numOfClusters
+}
+
+// alignFederationQuery fixes group by interval and time range of query before fans out to broker clusters,
+// because the storage intervals of broker clusters maybe different, which makes time buckets not aligned.
+func alignFederationQuery(statement *stmt.Query) {
+	if statement.AutoGroupByTime {
+		return
+	}
+	interval := timeutil.CalcQueryInterval(statement.TimeRange, statement.Interval)
+	if interval < statement.Interval {
+		interval = statement.Interval
+	}
+	if interval <= 0 {
+		interval = defaultFederationInterval
+	}
+	statement.TimeRange.Start = timeutil.Truncate(statement.TimeRange.Start, interval.Int64())
+	statement.TimeRange.End = timeutil.Truncate(statement.TimeRange.End, interval.Int64())
+	statement.Interval = interval
+}
@@ -0,0 +1,145 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	errorpkg "github.com/lindb/lindb/pkg/error"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestIsFederation(t *testing.T) {
+	assert.False(t, isFederation([]*models.PhysicalPlan{{Database: "db"}}))
+	assert.False(t, isFederation([]*models.PhysicalPlan{{Cluster: "a"}, {Cluster: "a"}}))
+	assert.False(t, isFederation([]*models.PhysicalPlan{{Cluster: "a"}, {}}))
+	assert.True(t, isFederation([]*models.PhysicalPlan{{Cluster: "a"}, {Cluster: "b"}}))
+}
+
+func TestAlignFederationQuery(t *testing.T) {
+	// short time range without interval
+	q := &stmt.Query{TimeRange: timeutil.TimeRange{Start: 12 * timeutil.OneSecond, End: 35 * timeutil.OneSecond}}
+	alignFederationQuery(q)
+	assert.Equal(t, defaultFederationInterval, q.Interval)
+	assert.Equal(t, timeutil.TimeRange{Start: 10 * timeutil.OneSecond, End: 30 * timeutil.OneSecond}, q.TimeRange)
+	// user input interval
+	q = &stmt.Query{
+		Interval:  timeutil.Interval(5 * timeutil.OneMinute),
+		TimeRange: timeutil.TimeRange{Start: 7 * timeutil.OneMinute, End: 23 * timeutil.OneMinute},
+	}
+	alignFederationQuery(q)
+	assert.Equal(t, timeutil.Interval(5*timeutil.OneMinute), q.Interval)
+	assert.Equal(t, timeutil.TimeRange{Start: 5 * timeutil.OneMinute, End: 20 * timeutil.OneMinute}, q.TimeRange)
+	// long time range
+	q = &stmt.Query{TimeRange: timeutil.TimeRange{Start: 0, End: 2 * timeutil.OneDay}}
+	alignFederationQuery(q)
+	assert.Equal(t, timeutil.Interval(10*timeutil.OneMinute), q.Interval)
+	// auto group by time
+	q = &stmt.Query{AutoGroupByTime: true, TimeRange: timeutil.TimeRange{Start: 12, End: 35}}
+	alignFederationQuery(q)
+	assert.Equal(t, timeutil.Interval(0), q.Interval)
+	assert.Equal(t, timeutil.TimeRange{Start: 12, End: 35}, q.TimeRange)
+}
+
+func newFederationMetricContext(t *testing.T, ctrl *gomock.Controller) *RootMetricContext {
+	choose := flow.NewMockNodeChoose(ctrl)
+	choose.EXPECT().Choose("test", 1).Return([]*models.PhysicalPlan{
+		{Database: "test", Cluster: "a", Targets: []*models.Target{{Indicator: "1.1.1.1:9000"}}},
+		{Database: "test", Cluster: "b", Targets: []*models.Target{{Indicator: "2.2.2.2:9000"}}},
+	}, nil)
+	ctx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:         context.TODO(),
+		Database:    "test",
+		Choose:      choose,
+		CurrentNode: models.StatelessNode{HostIP: "3.3.3.3", GRPCPort: 9000},
+		Request:     &models.Request{},
+		Statement: &stmt.Query{
+			TimeRange: timeutil.TimeRange{Start: 12 * timeutil.OneSecond, End: 35 * timeutil.OneSecond},
+		},
+	})
+	assert.NoError(t, ctx.MakePlan())
+	assert.Equal(t, map[string]string{"1.1.1.1:9000": "a", "2.2.2.2:9000": "b"}, ctx.clusters)
+	assert.Equal(t, 2, ctx.numOfClusters)
+	assert.Equal(t, defaultFederationInterval, ctx.Deps.Statement.Interval)
+	return ctx
+}
+
+func newFederationResponse(start, interval int64) *protoCommonV1.TaskResponse {
+	tsList := &protoCommonV1.TimeSeriesList{
+		Start:    start,
+		End:      start + 20*timeutil.OneSecond,
+		Interval: interval,
+		FieldAggSpecs: []*protoCommonV1.AggregatorSpec{{
+			FieldName: "f",
+			FieldType: uint32(field.SumField),
+		}},
+	}
+	data, _ := tsList.Marshal()
+	return &protoCommonV1.TaskResponse{Completed: true, Payload: data}
+}
+
+func TestRootMetricContext_Federation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("one cluster failure", func(t *testing.T) {
+		ctx := newFederationMetricContext(t, ctrl)
+		ctx.handleResponse(&protoCommonV1.TaskResponse{Completed: true, ErrMsg: "err"}, "1.1.1.1:9000")
+		assert.NoError(t, ctx.err)
+		ctx.handleResponse(newFederationResponse(10*timeutil.OneSecond, 10*timeutil.OneSecond), "2.2.2.2:9000")
+		assert.NoError(t, ctx.err)
+		assert.Equal(t, 0, ctx.expectResults)
+		rs, err := ctx.makeResultSet()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "err"}, rs.PartialFailures)
+	})
+	t.Run("all clusters failure", func(t *testing.T) {
+		ctx := newFederationMetricContext(t, ctrl)
+		ctx.handleResponse(&protoCommonV1.TaskResponse{Completed: true, ErrMsg: "err1"}, "1.1.1.1:9000")
+		assert.NoError(t, ctx.err)
+		ctx.handleResponse(&protoCommonV1.TaskResponse{Completed: true, ErrMsg: "err2"}, "2.2.2.2:9000")
+		assert.EqualError(t, ctx.err, "err2")
+	})
+	t.Run("not found with cluster failure", func(t *testing.T) {
+		ctx := newFederationMetricContext(t, ctrl)
+		ctx.handleResponse(&protoCommonV1.TaskResponse{Completed: true, ErrMsg: "err"}, "1.1.1.1:9000")
+		ctx.handleResponse(&protoCommonV1.TaskResponse{
+			Completed: true,
+			ErrMsg:    "metric not found",
+			ErrCode:   int32(errorpkg.MetadataNotFound),
+		}, "2.2.2.2:9000")
+		assert.EqualError(t, ctx.err, "metric not found")
+	})
+	t.Run("time bucket not aligned", func(t *testing.T) {
+		ctx := newFederationMetricContext(t, ctrl)
+		ctx.handleResponse(newFederationResponse(10*timeutil.OneSecond, 10*timeutil.OneSecond), "1.1.1.1:9000")
+		ctx.handleResponse(newFederationResponse(0, timeutil.OneMinute), "2.2.2.2:9000")
+		assert.NoError(t, ctx.err)
+		assert.Contains(t, ctx.clusterFailures["b"], "time bucket not aligned")
+		assert.Equal(t, int64(10*timeutil.OneSecond), ctx.interval)
+	})
+}
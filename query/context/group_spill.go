@@ -0,0 +1,182 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cespare/xxhash/v2"
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/logger"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series/field"
+)
+
+// for testing
+var (
+	createTempFn = os.CreateTemp
+)
+
+var spillLogger = logger.GetLogger("Query", "GroupBySpill")
+
+// GroupBySpillOptions represents the options of spilling groups into disk for group by query,
+// which bounds the memory of group by producing a huge number of groups.
+type GroupBySpillOptions struct {
+	Dir        string // directory of spill files, uses the default directory for temporary files if empty
+	Threshold  int    // max number of groups kept in memory, new groups exceeded are spilled into disk
+	Partitions int    // number of spill partitions, each partition is merged in memory independently
+}
+
+// groupSpiller spills the time series of groups into partition files(partitioned by tags),
+// all time series of one group are in the same partition, so each partition can be merged independently.
+type groupSpiller struct {
+	opts       *GroupBySpillOptions
+	partitions []*spillPartition
+	fields     map[field.Name]struct{}
+	buf        []byte
+}
+
+// spillPartition represents a temporary file of spill partition.
+type spillPartition struct {
+	f *os.File
+	w *bufio.Writer
+}
+
+// newGroupSpiller creates a group spiller, spill files are created lazily.
+func newGroupSpiller(opts *GroupBySpillOptions) *groupSpiller {
+	partitions := opts.Partitions
+	if partitions <= 0 {
+		partitions = 1
+	}
+	metrics.GroupBySpillStatistics.SpilledQueries.Incr()
+	return &groupSpiller{
+		opts:       opts,
+		partitions: make([]*spillPartition, partitions),
+		fields:     make(map[field.Name]struct{}),
+	}
+}
+
+// spill writes the time series of group into the partition file which the group belongs to.
+func (s *groupSpiller) spill(tags string, fields map[field.Name][]byte) error {
+	idx := int(xxhash.Sum64String(tags) % uint64(len(s.partitions)))
+	partition := s.partitions[idx]
+	if partition == nil {
+		f, err := createTempFn(s.opts.Dir, "lindb-group-by-spill-*")
+		if err != nil {
+			metrics.GroupBySpillStatistics.SpillFailures.Incr()
+			return err
+		}
+		partition = &spillPartition{f: f, w: bufio.NewWriter(f)}
+		s.partitions[idx] = partition
+	}
+	ts := &protoCommonV1.TimeSeries{Tags: tags, Fields: make(map[string][]byte, len(fields))}
+	for name, data := range fields {
+		ts.Fields[string(name)] = data
+		s.fields[name] = struct{}{}
+	}
+	data, _ := ts.Marshal()
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(scratch[:], uint64(len(data)))
+	s.buf = append(s.buf[:0], scratch[:n]...)
+	s.buf = append(s.buf, data...)
+	if _, err := partition.w.Write(s.buf); err != nil {
+		metrics.GroupBySpillStatistics.SpillFailures.Incr()
+		return err
+	}
+	metrics.GroupBySpillStatistics.SpilledSeries.Incr()
+	metrics.GroupBySpillStatistics.SpilledBytes.Add(float64(len(s.buf)))
+	return nil
+}
+
+// walk reads the time series of spill partitions one by one,
+// invokes fn for each time series of partition, then invokes done after partition read completed.
+func (s *groupSpiller) walk(fn func(tags string, fields map[field.Name][]byte), done func()) error {
+	for _, partition := range s.partitions {
+		if partition == nil {
+			continue
+		}
+		if err := partition.walk(fn); err != nil {
+			metrics.GroupBySpillStatistics.SpillFailures.Incr()
+			return err
+		}
+		done()
+		metrics.GroupBySpillStatistics.MergedPartitions.Incr()
+	}
+	return nil
+}
+
+// walk reads all time series from the file of spill partition.
+func (p *spillPartition) walk(fn func(tags string, fields map[field.Name][]byte)) error {
+	if err := p.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := p.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(p.f)
+	var data []byte
+	for {
+		length, err := binary.ReadUvarint(reader)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if uint64(cap(data)) < length {
+			data = make([]byte, length)
+		}
+		data = data[:length]
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return fmt.Errorf("read spill file: %s failure: %w", p.f.Name(), err)
+		}
+		ts := &protoCommonV1.TimeSeries{}
+		if err := ts.Unmarshal(data); err != nil {
+			return err
+		}
+		fields := make(map[field.Name][]byte, len(ts.Fields))
+		for name, value := range ts.Fields {
+			fields[field.Name(name)] = value
+		}
+		fn(ts.Tags, fields)
+	}
+}
+
+// close closes and removes all spill files.
+func (s *groupSpiller) close() error {
+	var result error
+	for _, partition := range s.partitions {
+		if partition == nil {
+			continue
+		}
+		if err := partition.f.Close(); err != nil {
+			result = multierror.Append(result, err)
+		}
+		if err := os.Remove(partition.f.Name()); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result
+}
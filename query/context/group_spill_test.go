@@ -0,0 +1,137 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestGroupSpiller(t *testing.T) {
+	dir := t.TempDir()
+	spiller := newGroupSpiller(&GroupBySpillOptions{Dir: dir, Partitions: 4})
+	for _, tags := range []string{"a", "b", "c", "a"} {
+		assert.NoError(t, spiller.spill(tags, map[field.Name][]byte{"f": []byte(tags)}))
+	}
+	assert.Equal(t, map[field.Name]struct{}{"f": {}}, spiller.fields)
+
+	var tagsList []string
+	partitions := 0
+	assert.NoError(t, spiller.walk(func(tags string, fields map[field.Name][]byte) {
+		assert.Equal(t, []byte(tags), fields["f"])
+		tagsList = append(tagsList, tags)
+	}, func() {
+		partitions++
+	}))
+	sort.Strings(tagsList)
+	assert.Equal(t, []string{"a", "a", "b", "c"}, tagsList)
+	assert.True(t, partitions > 0)
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, partitions)
+	assert.NoError(t, spiller.close())
+	files, err = os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	// walk closed spiller
+	assert.Error(t, spiller.walk(func(_ string, _ map[field.Name][]byte) {}, func() {}))
+
+	// default partition
+	spiller = newGroupSpiller(&GroupBySpillOptions{Dir: dir})
+	assert.Len(t, spiller.partitions, 1)
+}
+
+func TestGroupSpiller_CreateFile_Failure(t *testing.T) {
+	defer func() {
+		createTempFn = os.CreateTemp
+	}()
+	createTempFn = func(_, _ string) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	spiller := newGroupSpiller(&GroupBySpillOptions{Dir: t.TempDir(), Partitions: 1})
+	assert.Error(t, spiller.spill("a", nil))
+	assert.NoError(t, spiller.close())
+}
+
+func TestRootMetricContext_GroupBySpill(t *testing.T) {
+	dir := t.TempDir()
+	metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:     context.TODO(),
+		Request: &models.Request{},
+		Statement: &stmt.Query{
+			SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}}},
+			GroupBy:     []string{"host"},
+			Limit:       10,
+		},
+		GroupBySpill: &GroupBySpillOptions{Dir: dir, Threshold: 1, Partitions: 2},
+	})
+	tsList := &protoCommonV1.TimeSeriesList{
+		Start:    timeutil.OneHour,
+		End:      2 * timeutil.OneHour,
+		Interval: timeutil.OneMinute,
+		FieldAggSpecs: []*protoCommonV1.AggregatorSpec{{
+			FieldName: "f",
+			FieldType: uint32(field.SumField),
+		}},
+	}
+	for _, tags := range []string{"a", "b", "c", "a", "b"} {
+		tsList.TimeSeriesList = append(tsList.TimeSeriesList,
+			&protoCommonV1.TimeSeries{Tags: tags, Fields: map[string][]byte{"f": nil}})
+	}
+	payload, _ := tsList.Marshal()
+	metricCtx.handleResponse(&protoCommonV1.TaskResponse{Completed: true, Payload: payload}, "1.1.1.1:9000")
+	assert.NoError(t, metricCtx.err)
+	// only one group kept in memory
+	assert.Equal(t, 1, metricCtx.groupAgg.Size())
+	assert.True(t, metricCtx.groupAgg.Contains("a"))
+	assert.NotNil(t, metricCtx.spiller)
+
+	rs, err := metricCtx.makeResultSet()
+	assert.NoError(t, err)
+	assert.Len(t, rs.Series, 3)
+	for idx, tags := range []string{"a", "b", "c"} {
+		assert.Equal(t, tags, rs.Series[idx].TagValues)
+	}
+
+	metricCtx.closeSpiller()
+	assert.Nil(t, metricCtx.spiller)
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+
+	// spill disabled if not group by
+	metricCtx = NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:          context.TODO(),
+		Statement:    &stmt.Query{},
+		GroupBySpill: &GroupBySpillOptions{Dir: dir, Threshold: 1, Partitions: 2},
+	})
+	assert.Nil(t, metricCtx.spillOpts)
+	metricCtx.closeSpiller()
+}
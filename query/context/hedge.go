@@ -0,0 +1,212 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+)
+
+// hedgeWinner represents which side of hedged task wins.
+type hedgeWinner int
+
+const (
+	// undecided represents no side responds.
+	undecided hedgeWinner = iota
+	// primaryWins represents the original leaf task responds first.
+	primaryWins
+	// hedgeWins represents the hedged duplicates respond first.
+	hedgeWins
+)
+
+// hedgedTask represents the leaf task of slow target and its hedged duplicates which send to other replicas,
+// the side which responds first wins, the responses of loser are dropped and the loser is canceled.
+type hedgedTask struct {
+	primary string
+	hedges  []string
+	winner  hedgeWinner
+}
+
+// side returns the side of target node.
+func (t *hedgedTask) side(node string) hedgeWinner {
+	if node == t.primary {
+		return primaryWins
+	}
+	return hedgeWins
+}
+
+// losers returns the nodes of loser side.
+func (t *hedgedTask) losers() []string {
+	if t.winner == primaryWins {
+		return t.hedges
+	}
+	return []string{t.primary}
+}
+
+// addHedgeTargets adds the leaf targets which can be hedged.
+func (ctx *RootMetricContext) addHedgeTargets(physicalPlan *models.PhysicalPlan) {
+	for _, target := range physicalPlan.Targets {
+		if len(target.ShardIDs) == 0 {
+			// compute node(not leaf node), cannot hedge
+			continue
+		}
+		if ctx.hedgeTargets == nil {
+			ctx.hedgeTargets = make(map[string]*models.PhysicalPlan)
+		}
+		ctx.hedgeTargets[target.Indicator] = physicalPlan
+	}
+}
+
+// hedge issues hedged duplicates to other replicas for the leaf tasks which have no response after hedge delay.
+func (ctx *RootMetricContext) hedge() {
+	stateMgr, ok := ctx.Deps.Choose.(broker.StateManager)
+	if !ok {
+		return
+	}
+	requests := make(map[string]*protoCommonV1.TaskRequest)
+
+	ctx.mutex.Lock()
+	if ctx.completed.Load() || ctx.err != nil {
+		ctx.mutex.Unlock()
+		return
+	}
+	// cannot send hedged request to the node which already has task of this request
+	excludes := make(map[string]struct{})
+	for node := range ctx.state {
+		excludes[node] = struct{}{}
+	}
+	for target, physicalPlan := range ctx.hedgeTargets {
+		if ctx.state[target] != models.Send {
+			// target responded(partial result maybe merged) or not sent
+			continue
+		}
+		var shardIDs []models.ShardID
+		for _, t := range physicalPlan.Targets {
+			if t.Indicator == target {
+				shardIDs = t.ShardIDs
+				break
+			}
+		}
+		replicas, ok := stateMgr.GetHedgeReplicas(physicalPlan.Database, shardIDs, excludes)
+		if !ok {
+			continue
+		}
+		req := ctx.requests[target]
+		task := &hedgedTask{primary: target}
+		for node, replicaShardIDs := range replicas {
+			excludes[node] = struct{}{}
+			hedgePlan := &models.PhysicalPlan{
+				Database:  physicalPlan.Database,
+				Targets:   []*models.Target{{Indicator: node, ShardIDs: replicaShardIDs}},
+				Receivers: physicalPlan.Receivers,
+			}
+			requests[node] = &protoCommonV1.TaskRequest{
+				RequestID:    req.RequestID,
+				RequestType:  req.RequestType,
+				PhysicalPlan: encoding.JSONMarshal(hedgePlan),
+				Payload:      req.Payload,
+				Credits:      req.Credits,
+				Priority:     req.Priority,
+			}
+			task.hedges = append(task.hedges, node)
+			ctx.state[node] = models.Send
+			ctx.hedgedTasks[node] = task
+		}
+		ctx.hedgedTasks[target] = task
+	}
+	ctx.mutex.Unlock()
+
+	for node, req := range requests {
+		if err := ctx.transportMgr.SendRequest(node, req); err != nil {
+			// hedged request failure, wait the original leaf task
+			ctx.abandonHedge(node, req)
+		}
+	}
+}
+
+// abandonHedge abandons the hedged task if hedged request failure, primary side wins.
+func (ctx *MetricContext) abandonHedge(node string, req *protoCommonV1.TaskRequest) {
+	ctx.mutex.Lock()
+	task, ok := ctx.hedgedTasks[node]
+	var losers []string
+	if ok && task.winner == undecided {
+		losers = ctx.decideHedgeWinner(task, primaryWins)
+	}
+	ctx.mutex.Unlock()
+
+	ctx.cancelTasks(req.RequestID, req.RequestType, losers)
+}
+
+// dropHedgeLoser checks if the response is from the loser of hedged task, the response of loser need be dropped.
+// the first response decides the winner, cancels the loser.
+func (ctx *MetricContext) dropHedgeLoser(resp *protoCommonV1.TaskResponse, fromNode string) bool {
+	ctx.mutex.Lock()
+	task, ok := ctx.hedgedTasks[fromNode]
+	if !ok {
+		ctx.mutex.Unlock()
+		return false
+	}
+	side := task.side(fromNode)
+	if task.winner != undecided {
+		ctx.mutex.Unlock()
+		return task.winner != side
+	}
+	drop := false
+	winner := side
+	if side == hedgeWins && resp.ErrMsg != "" {
+		// hedged duplicate failure, shards of it maybe lost, wait the original leaf task
+		winner = primaryWins
+		drop = true
+	}
+	losers := ctx.decideHedgeWinner(task, winner)
+	ctx.mutex.Unlock()
+
+	ctx.cancelTasks(resp.RequestID, resp.RequestType, losers)
+	return drop
+}
+
+// decideHedgeWinner decides the winner of hedged task, returns the loser nodes which need be canceled.
+// NOTE: must hold lock.
+func (ctx *MetricContext) decideHedgeWinner(task *hedgedTask, winner hedgeWinner) []string {
+	task.winner = winner
+	if winner == hedgeWins {
+		// original leaf task is split to multi hedged duplicates, wait all of them
+		ctx.expectResults += len(task.hedges) - 1
+		ctx.tolerantNotFounds += int32(len(task.hedges) - 1)
+	}
+	losers := task.losers()
+	for _, node := range losers {
+		// loser is canceled, no response expected
+		ctx.state[node] = models.Complete
+	}
+	return losers
+}
+
+// cancelTasks cancels the running tasks of loser nodes.
+func (ctx *MetricContext) cancelTasks(requestID string, requestType protoCommonV1.RequestType, nodes []string) {
+	for _, node := range nodes {
+		// ignore cancel failure, the task of loser will be timeout
+		_ = ctx.transportMgr.SendRequest(node, &protoCommonV1.TaskRequest{
+			RequestID:   requestID,
+			RequestType: requestType,
+			Cancel:      true,
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/rpc"
+)
+
+func newHedgeMetricContext(ctrl *gomock.Controller) (*RootMetricContext, *broker.MockStateManager, *rpc.MockTransportManager) {
+	stateMgr := broker.NewMockStateManager(ctrl)
+	transportMgr := rpc.NewMockTransportManager(ctrl)
+	ctx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:          context.TODO(),
+		Choose:       stateMgr,
+		TransportMgr: transportMgr,
+		HedgeDelay:   time.Millisecond,
+	})
+	plan := &models.PhysicalPlan{
+		Database: "test",
+		Targets: []*models.Target{
+			{Indicator: "1.1.1.1:9000", ShardIDs: []models.ShardID{1, 2}},
+			{Indicator: "2.2.2.2:9000", ShardIDs: []models.ShardID{3}},
+		},
+		Receivers: []string{"broker"},
+	}
+	ctx.addHedgeTargets(plan)
+	ctx.addRequests(&protoCommonV1.TaskRequest{RequestID: "req", Payload: []byte("payload")}, plan)
+	ctx.state["1.1.1.1:9000"] = models.Send
+	ctx.state["2.2.2.2:9000"] = models.Receive
+	return ctx, stateMgr, transportMgr
+}
+
+func TestRootMetricContext_hedge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("no replica for slow target", func(t *testing.T) {
+		ctx, stateMgr, _ := newHedgeMetricContext(ctrl)
+		stateMgr.EXPECT().GetHedgeReplicas("test", []models.ShardID{1, 2}, gomock.Any()).Return(nil, false)
+		ctx.hedge()
+		assert.Empty(t, ctx.hedgedTasks)
+	})
+	t.Run("task completed", func(t *testing.T) {
+		ctx, _, _ := newHedgeMetricContext(ctrl)
+		ctx.err = fmt.Errorf("err")
+		ctx.hedge()
+		assert.Empty(t, ctx.hedgedTasks)
+	})
+	t.Run("hedged request failure", func(t *testing.T) {
+		ctx, stateMgr, transportMgr := newHedgeMetricContext(ctrl)
+		stateMgr.EXPECT().GetHedgeReplicas("test", []models.ShardID{1, 2}, gomock.Any()).
+			Return(map[string][]models.ShardID{"3.3.3.3:9000": {1, 2}}, true)
+		transportMgr.EXPECT().SendRequest("3.3.3.3:9000", gomock.Any()).Return(fmt.Errorf("err"))
+		// cancel hedged task
+		transportMgr.EXPECT().SendRequest("3.3.3.3:9000", gomock.Any()).Return(nil)
+		ctx.hedge()
+		assert.Equal(t, primaryWins, ctx.hedgedTasks["1.1.1.1:9000"].winner)
+	})
+	t.Run("hedged task wins", func(t *testing.T) {
+		ctx, stateMgr, transportMgr := newHedgeMetricContext(ctrl)
+		stateMgr.EXPECT().GetHedgeReplicas("test", []models.ShardID{1, 2}, gomock.Any()).
+			DoAndReturn(func(_ string, _ []models.ShardID, excludes map[string]struct{}) (map[string][]models.ShardID, bool) {
+				assert.Len(t, excludes, 2)
+				return map[string][]models.ShardID{"3.3.3.3:9000": {1}, "4.4.4.4:9000": {2}}, true
+			})
+		transportMgr.EXPECT().SendRequest("3.3.3.3:9000", gomock.Any()).
+			DoAndReturn(func(_ string, req *protoCommonV1.TaskRequest) error {
+				plan := &models.PhysicalPlan{}
+				assert.NoError(t, encoding.JSONUnmarshal(req.PhysicalPlan, plan))
+				assert.Equal(t, []*models.Target{{Indicator: "3.3.3.3:9000", ShardIDs: []models.ShardID{1}}}, plan.Targets)
+				assert.Equal(t, []string{"broker"}, plan.Receivers)
+				assert.Equal(t, "req", req.RequestID)
+				return nil
+			})
+		transportMgr.EXPECT().SendRequest("4.4.4.4:9000", gomock.Any()).Return(nil)
+		ctx.hedge()
+		expectResults := ctx.expectResults
+
+		// first response from hedged task, cancel original task
+		transportMgr.EXPECT().SendRequest("1.1.1.1:9000", gomock.Any()).
+			DoAndReturn(func(_ string, req *protoCommonV1.TaskRequest) error {
+				assert.True(t, req.Cancel)
+				return nil
+			})
+		assert.False(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "3.3.3.3:9000"))
+		assert.Equal(t, expectResults+1, ctx.expectResults)
+		assert.Equal(t, models.Complete, ctx.state["1.1.1.1:9000"])
+		assert.False(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "4.4.4.4:9000"))
+		// drop response of original task
+		assert.True(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "1.1.1.1:9000"))
+		// not hedged
+		assert.False(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "2.2.2.2:9000"))
+	})
+	t.Run("original task wins", func(t *testing.T) {
+		ctx, stateMgr, transportMgr := newHedgeMetricContext(ctrl)
+		stateMgr.EXPECT().GetHedgeReplicas("test", []models.ShardID{1, 2}, gomock.Any()).
+			Return(map[string][]models.ShardID{"3.3.3.3:9000": {1, 2}}, true)
+		transportMgr.EXPECT().SendRequest("3.3.3.3:9000", gomock.Any()).Return(nil).Times(2)
+		ctx.hedge()
+
+		assert.False(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "1.1.1.1:9000"))
+		assert.True(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "3.3.3.3:9000"))
+	})
+	t.Run("hedged task failure", func(t *testing.T) {
+		ctx, stateMgr, transportMgr := newHedgeMetricContext(ctrl)
+		stateMgr.EXPECT().GetHedgeReplicas("test", []models.ShardID{1, 2}, gomock.Any()).
+			Return(map[string][]models.ShardID{"3.3.3.3:9000": {1, 2}}, true)
+		transportMgr.EXPECT().SendRequest("3.3.3.3:9000", gomock.Any()).Return(nil).Times(2)
+		ctx.hedge()
+
+		assert.True(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req", ErrMsg: "err"}, "3.3.3.3:9000"))
+		assert.False(t, ctx.dropHedgeLoser(&protoCommonV1.TaskResponse{RequestID: "req"}, "1.1.1.1:9000"))
+	})
+}
+
+func TestRootMetricContext_WaitResponse_Hedge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, stateMgr, _ := newHedgeMetricContext(ctrl)
+	hedged := make(chan struct{})
+	stateMgr.EXPECT().GetHedgeReplicas(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ string, _ []models.ShardID, _ map[string]struct{}) (map[string][]models.ShardID, bool) {
+			close(hedged)
+			return nil, false
+		})
+	go func() {
+		<-hedged
+		ctx.mutex.Lock()
+		ctx.err = fmt.Errorf("err")
+		ctx.mutex.Unlock()
+		close(ctx.doneCh)
+	}()
+	_, err := ctx.WaitResponse()
+	assert.Error(t, err)
+}
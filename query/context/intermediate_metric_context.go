@@ -103,6 +103,8 @@ func (ctx *IntermediateMetricContext) MakePlan() error {
 				RequestType:  protoCommonV1.RequestType_Data,
 				PhysicalPlan: encoding.JSONMarshal(physicalPlan),
 				Payload:      payload,
+				Credits:      resultCreditWindow,
+				Priority:     ctx.req.Priority,
 			}, physicalPlan)
 	}
 	return nil
@@ -121,6 +123,7 @@ func (ctx *IntermediateMetricContext) makeTaskResponse() *protoCommonV1.TaskResp
 	if ctx.groupAgg != nil {
 		groupIts := ctx.groupAgg.ResultSet()
 		for _, itr := range groupIts {
+			tags := itr.Tags()
 			fields := make(map[string][]byte)
 			for itr.HasNext() {
 				fieldItr := itr.Next()
@@ -130,10 +133,17 @@ func (ctx *IntermediateMetricContext) makeTaskResponse() *protoCommonV1.TaskResp
 				}
 				fields[string(fieldItr.FieldName())] = data
 			}
+			for fieldName, count := range ctx.distincts[tags] {
+				data, err := count.MarshalBinary()
+				if err != nil {
+					continue
+				}
+				fields[fieldName] = data
+			}
 			if len(fields) > 0 {
 				// always have group by
 				timeSeriesList = append(timeSeriesList, &protoCommonV1.TimeSeries{
-					Tags:   itr.Tags(),
+					Tags:   tags,
 					Fields: fields,
 				})
 			}
@@ -25,6 +25,7 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -34,10 +35,22 @@ import (
 	"github.com/lindb/lindb/tsdb"
 )
 
+const (
+	// resultCreditWindow represents the number of partial results which the receiver can buffer,
+	// leaf node streams partial results only if the receiver grants credits in task request.
+	resultCreditWindow = 4
+)
+
 var (
 	leafExecuteCtxLogger = logger.GetLogger("Query", "LeafContext")
 )
 
+// for testing
+var (
+	// resultChunkSize represents the max number of time series of each partial result.
+	resultChunkSize = 512
+)
+
 // LeafExecuteContext represents leaf node execution context.
 type LeafExecuteContext struct {
 	TaskCtx           *flow.TaskContext
@@ -71,6 +84,8 @@ func NewLeafExecuteContext(taskCtx *flow.TaskContext,
 		Query:    queryStmt,
 		ShardIDs: leafNode.ShardIDs,
 	}
+	// collects tag value ids of distinct tag keys for each group, then builds tag value sketches when reduce result
+	storageExecuteCtx.DistinctTagKeys = queryStmt.DistinctTagKeys()
 	ctx := &LeafExecuteContext{
 		TaskCtx:           taskCtx,
 		Tracker:           tracker,
@@ -130,7 +145,20 @@ func (ctx *LeafExecuteContext) SendResponse(err error) {
 			ctx.sendResponse(nil, err)
 			return
 		}
+		if len(ctx.StorageExecuteCtx.DistinctTagKeyIDs) > 0 {
+			// resolve tag values of count distinct tag keys
+			tagMetadata := ctx.StorageExecuteCtx.TagMetadataSnapshot(ctx.Database.Metadata().TagMetadata().GetSnapshot)
+			if err := ctx.ReduceCtx.buildDistincts(tagMetadata); err != nil {
+				ctx.sendResponse(nil, err)
+				return
+			}
+		}
 
+		if ctx.Req.Credits > 0 {
+			// receiver accepts partial results, stream result set with flow control
+			ctx.streamResponse()
+			return
+		}
 		// build result set
 		resultSet := ctx.ReduceCtx.BuildResultSet(ctx.LeafNode, ctx.Receivers)
 		// complete stats track
@@ -142,14 +170,6 @@ func (ctx *LeafExecuteContext) SendResponse(err error) {
 
 // sendResponse sends result set based on receivers.
 func (ctx *LeafExecuteContext) sendResponse(resultData [][]byte, err error) {
-	var stats []byte
-	var errMsg string
-	if ctx.StorageExecuteCtx.Query.Explain {
-		stats = encoding.JSONMarshal(ctx.Tracker.GetStats())
-	}
-	if err != nil {
-		errMsg = err.Error()
-	}
 	// send result to upstream receivers
 	for idx, receiver := range ctx.Receivers {
 		stream := ctx.ServerFactory.GetStream(receiver)
@@ -162,18 +182,85 @@ func (ctx *LeafExecuteContext) sendResponse(resultData [][]byte, err error) {
 		if resultData != nil {
 			payload = resultData[idx]
 		}
-		resp := &protoCommonV1.TaskResponse{
-			RequestID:   ctx.Req.RequestID,
-			RequestType: ctx.Req.RequestType,
-			Completed:   true,
-			SendTime:    timeutil.NowNano(),
-			Payload:     payload,
-			Stats:       stats,
-			ErrMsg:      errMsg,
+		if err0 := stream.Send(ctx.newResponse(payload, true, err)); err0 != nil {
+			leafExecuteCtxLogger.Error("send storage query result, ignore result",
+				logger.String("target", receiver), logger.Error(err0))
 		}
+	}
+}
+
+// streamResponse streams the result set chunk by chunk based on receivers,
+// sends partial result after acquiring credit from receiver, receiver grants credit after partial result consumed,
+// finally sends the last chunk with completed flag and the number of partial results sent,
+// receiver finishes the task after all partial results handled.
+func (ctx *LeafExecuteContext) streamResponse() {
+	numOfReceivers := len(ctx.Receivers)
+	streams := make([]protoCommonV1.TaskService_HandleServer, numOfReceivers)
+	credits := make([]*flow.ResultCredits, numOfReceivers)
+	partials := make([]int32, numOfReceivers)
+	errs := make([]error, numOfReceivers)
+	for idx, receiver := range ctx.Receivers {
+		streams[idx] = ctx.ServerFactory.GetStream(receiver)
+		credits[idx] = ctx.TaskCtx.ResultCredits(receiver, int(ctx.Req.Credits))
+	}
+	lastChunks := ctx.ReduceCtx.EmitResultChunks(ctx.Receivers, resultChunkSize, func(idx int, chunk []byte) {
+		if streams[idx] == nil || errs[idx] != nil {
+			return
+		}
+		if err := credits[idx].Acquire(ctx.TaskCtx.Ctx); err != nil {
+			errs[idx] = err
+			return
+		}
+		if err := streams[idx].Send(ctx.newResponse(chunk, false, nil)); err != nil {
+			errs[idx] = err
+			return
+		}
+		partials[idx]++
+	})
+	// complete stats track
+	ctx.Tracker.Complete()
+
+	for idx, receiver := range ctx.Receivers {
+		stream := streams[idx]
+		if stream == nil {
+			leafExecuteCtxLogger.Error("unable to get stream for write response, ignore result",
+				logger.String("target", receiver))
+			continue
+		}
+		var resp *protoCommonV1.TaskResponse
+		if errs[idx] != nil {
+			resp = ctx.newResponse(nil, true, errs[idx])
+		} else {
+			resp = ctx.newResponse(lastChunks[idx], true, nil)
+		}
+		resp.Partials = partials[idx]
 		if err0 := stream.Send(resp); err0 != nil {
 			leafExecuteCtxLogger.Error("send storage query result, ignore result",
 				logger.String("target", receiver), logger.Error(err0))
 		}
 	}
 }
+
+// newResponse creates the task response, stats only be sent with completed response.
+func (ctx *LeafExecuteContext) newResponse(payload []byte, completed bool, err error) *protoCommonV1.TaskResponse {
+	var stats []byte
+	var errMsg string
+	var errCode errorpkg.Code
+	if completed && ctx.StorageExecuteCtx.Query.Explain {
+		stats = encoding.JSONMarshal(ctx.Tracker.GetStats())
+	}
+	if err != nil {
+		errMsg = err.Error()
+		errCode = errorpkg.CodeOf(err)
+	}
+	return &protoCommonV1.TaskResponse{
+		RequestID:   ctx.Req.RequestID,
+		RequestType: ctx.Req.RequestType,
+		Completed:   completed,
+		SendTime:    timeutil.NowNano(),
+		Payload:     payload,
+		Stats:       stats,
+		ErrMsg:      errMsg,
+		ErrCode:     int32(errCode),
+	}
+}
@@ -24,16 +24,18 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
-
 	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/rpc"
+	"github.com/lindb/lindb/series/tag"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/metadb"
 )
 
 func TestLeafExecuteContext_SendResponse(t *testing.T) {
@@ -91,6 +93,25 @@ func TestLeafExecuteContext_SendResponse(t *testing.T) {
 				})
 			},
 		},
+		{
+			name:      "build count distinct failure",
+			in:        nil,
+			receivers: []string{""},
+			prepare: func(ctx *LeafExecuteContext) {
+				ctx.StorageExecuteCtx.DistinctTagKeyIDs = []tag.KeyID{1}
+				ctx.ReduceCtx.ReduceDistinct("", []flow.DistinctTagValueIDs{{0: roaring.BitmapOf(1)}})
+				metadata := metadb.NewMockMetadata(ctrl)
+				tagMetadata := metadb.NewMockTagMetadata(ctrl)
+				db.EXPECT().Metadata().Return(metadata)
+				metadata.EXPECT().TagMetadata().Return(tagMetadata)
+				tagMetaSnapshot := flow.NewMockTagMetadataSnapshot(ctrl)
+				tagMetadata.EXPECT().GetSnapshot().Return(tagMetaSnapshot)
+				tagMetaSnapshot.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				tagMetaSnapshot.EXPECT().Close().Return(nil)
+				taskServerFct.EXPECT().GetStream(gomock.Any()).Return(stream)
+				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+		},
 		{
 			name:      "time out",
 			in:        nil,
@@ -131,3 +152,90 @@ func TestLeafExecuteContext_SendResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestLeafExecuteContext_streamResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	db := tsdb.NewMockDatabase(ctrl)
+	taskServerFct := rpc.NewMockTaskServerFactory(ctrl)
+	stream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
+
+	newCtx := func() *LeafExecuteContext {
+		c, cancel := context.WithCancel(context.TODO())
+		taskCtx := &flow.TaskContext{
+			Ctx:    c,
+			Cancel: cancel,
+		}
+		return NewLeafExecuteContext(taskCtx, tracker.NewStageTracker(taskCtx),
+			&stmtpkg.Query{},
+			&protoCommonV1.TaskRequest{RequestID: "req", Credits: 1}, taskServerFct, &models.Target{}, []string{"r"}, db)
+	}
+	t.Run("stream empty result", func(t *testing.T) {
+		ctx := newCtx()
+		taskServerFct.EXPECT().GetStream("r").Return(stream)
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+			assert.True(t, resp.Completed)
+			return fmt.Errorf("err")
+		})
+		ctx.SendResponse(nil)
+	})
+	t.Run("stream not found", func(_ *testing.T) {
+		ctx := newCtx()
+		taskServerFct.EXPECT().GetStream("r").Return(nil)
+		ctx.streamResponse()
+	})
+	resultChunkSize = 1
+	defer func() {
+		resultChunkSize = 512
+	}()
+	t.Run("stream partial results with credits", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.ReduceCtx.reduceAgg = newMockGroupingAggregator(ctrl, 3)
+		taskServerFct.EXPECT().GetStream("r").Return(stream)
+		partials := 0
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+			assert.False(t, resp.Completed)
+			partials++
+			// receiver consumed partial result
+			go ctx.TaskCtx.GrantResultCredits("r", 1)
+			return nil
+		}).Times(3)
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+			assert.True(t, resp.Completed)
+			assert.Equal(t, int32(3), resp.Partials)
+			assert.Empty(t, resp.ErrMsg)
+			return nil
+		})
+		ctx.streamResponse()
+		assert.Equal(t, 3, partials)
+	})
+	t.Run("send partial result failure", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.ReduceCtx.reduceAgg = newMockGroupingAggregator(ctrl, 2)
+		taskServerFct.EXPECT().GetStream("r").Return(stream)
+		stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+			assert.True(t, resp.Completed)
+			assert.Zero(t, resp.Partials)
+			assert.Equal(t, "err", resp.ErrMsg)
+			return nil
+		})
+		ctx.streamResponse()
+	})
+	t.Run("wait credit timeout", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.ReduceCtx.reduceAgg = newMockGroupingAggregator(ctrl, 3)
+		taskServerFct.EXPECT().GetStream("r").Return(stream)
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(_ *protoCommonV1.TaskResponse) error {
+			ctx.TaskCtx.Cancel()
+			return nil
+		})
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+			assert.True(t, resp.Completed)
+			assert.Equal(t, int32(1), resp.Partials)
+			assert.NotEmpty(t, resp.ErrMsg)
+			return nil
+		})
+		ctx.streamResponse()
+	})
+}
@@ -43,6 +43,7 @@ type LeafGroupingContext struct {
 	tagsMap                      map[string]string   // tag value ids => tag values
 	tagValuesMap                 []map[uint32]string // tag value id=> tag value for each group by tag key
 	tagValues                    []string
+	keyedTagKeys                 []string // tag keys which tag values returned with tag key(key=value) for group by *
 
 	mutex sync.Mutex
 }
@@ -53,7 +54,14 @@ func NewLeafGroupingContext(leafExecuteCtx *LeafExecuteContext) *LeafGroupingCon
 	ctx := &LeafGroupingContext{
 		leafExecuteCtx: leafExecuteCtx,
 	}
-	storageExecuteCtx := leafExecuteCtx.StorageExecuteCtx
+	ctx.InitGroupByTagKeys()
+	return ctx
+}
+
+// InitGroupByTagKeys initializes the grouping context based on group by tag keys,
+// need re-init after group by tag keys resolved for group by *.
+func (ctx *LeafGroupingContext) InitGroupByTagKeys() {
+	storageExecuteCtx := ctx.leafExecuteCtx.StorageExecuteCtx
 	groupByKenLen := len(storageExecuteCtx.Query.GroupBy)
 	if groupByKenLen > 0 {
 		ctx.tagValuesMap = make([]map[uint32]string, groupByKenLen)
@@ -62,7 +70,11 @@ func NewLeafGroupingContext(leafExecuteCtx *LeafExecuteContext) *LeafGroupingCon
 		ctx.collectGroupingTagsCompleted = make(chan struct{})
 		ctx.collectRelatedTasks = *atomic.NewInt32(int32(groupByKenLen))
 	}
-	return ctx
+	if storageExecuteCtx.Query.GroupByAll {
+		// group by *, tag values of grouping tag keys are returned with tag key(key=value),
+		// because the tag keys are resolved under storage, root node cannot know them.
+		ctx.keyedTagKeys = storageExecuteCtx.Query.GroupBy
+	}
 }
 
 // ForkGroupingTask forks a grouping task.
@@ -100,8 +112,7 @@ func (ctx *LeafGroupingContext) collectGroupByTagValues() {
 	})
 
 	// all shard pending query tasks and grouping task completed, start collect tag values
-	metadata := ctx.leafExecuteCtx.Database.Metadata()
-	tagMetadata := metadata.TagMetadata()
+	tagMetadata := storageExecuteCtx.TagMetadataSnapshot(ctx.leafExecuteCtx.Database.Metadata().TagMetadata().GetSnapshot)
 
 	storageExecuteCtx.CollectTagValues(func() {
 		for idx, tagKeyID := range storageExecuteCtx.GroupByTags {
@@ -155,11 +166,14 @@ func (ctx *LeafGroupingContext) getTagValues(tagValueIDs string) string {
 		tagValuesForKey := ctx.tagValuesMap[idx]
 		offset := idx * 4
 		tagValueID := binary.LittleEndian.Uint32(tagsData[offset:])
-		if tagValue, ok := tagValuesForKey[tagValueID]; ok {
-			ctx.tagValues[idx] = tagValue
-		} else {
-			ctx.tagValues[idx] = tagValueNotFound
+		tagValue, ok := tagValuesForKey[tagValueID]
+		if !ok {
+			tagValue = tagValueNotFound
+		}
+		if idx < len(ctx.keyedTagKeys) {
+			tagValue = ctx.keyedTagKeys[idx] + "=" + tagValue
 		}
+		ctx.tagValues[idx] = tagValue
 	}
 	tagsOfStr := tag.ConcatTagValues(ctx.tagValues)
 	ctx.tagsMap[tagValueIDs] = tagsOfStr
@@ -46,6 +46,9 @@ func TestLeafGroupingContext(t *testing.T) {
 	tagMeta := metadb.NewMockTagMetadata(ctrl)
 	db.EXPECT().Metadata().Return(meta).AnyTimes()
 	meta.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	tagMetaSnapshot := flow.NewMockTagMetadataSnapshot(ctrl)
+	tagMetaSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	tagMeta.EXPECT().GetSnapshot().Return(tagMetaSnapshot).AnyTimes()
 	c, cancel := context.WithCancel(context.TODO())
 	storageCtx := &flow.StorageExecuteContext{
 		Query: &stmtpkg.Query{
@@ -95,7 +98,7 @@ func TestLeafGroupingContext(t *testing.T) {
 				}}
 				storageCtx.GroupingTagValueIDs = []*roaring.Bitmap{roaring.BitmapOf(1, 2, 3)}
 				storageCtx.Query.GroupBy = []string{"key"}
-				tagMeta.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				tagMetaSnapshot.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 			},
 		},
 		{
@@ -107,7 +110,7 @@ func TestLeafGroupingContext(t *testing.T) {
 				}}
 				storageCtx.GroupingTagValueIDs = []*roaring.Bitmap{roaring.BitmapOf(1, 2, 3)}
 				storageCtx.Query.GroupBy = []string{"key"}
-				tagMeta.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				tagMetaSnapshot.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 	}
@@ -150,3 +153,22 @@ func TestLeafGroupingContext_getTagValues(t *testing.T) {
 		assert.Equal(t, tagValueNotFound, ctx.getTagValues(string([]byte{2, 0, 0, 0})))
 	})
 }
+
+func TestLeafGroupingContext_GroupByAll(t *testing.T) {
+	leafExecuteCtx := &LeafExecuteContext{
+		StorageExecuteCtx: &flow.StorageExecuteContext{
+			Query:           &stmtpkg.Query{GroupByAll: true},
+			DistinctTagKeys: []string{"ip"}, // distinct tag keys not grouped
+		},
+	}
+	ctx := NewLeafGroupingContext(leafExecuteCtx)
+	assert.Empty(t, ctx.keyedTagKeys)
+	// group by tag keys resolved under metadata lookup
+	leafExecuteCtx.StorageExecuteCtx.Query.GroupBy = []string{"host", "zone"}
+	ctx.InitGroupByTagKeys()
+	assert.NotNil(t, ctx.collectGroupingTagsCompleted)
+	assert.Equal(t, []string{"host", "zone"}, ctx.keyedTagKeys)
+	ctx.tagValuesMap = []map[uint32]string{{1: "h1"}, {1: "z1"}}
+	assert.Equal(t, "host=h1,zone="+tagValueNotFound,
+		ctx.getTagValues(string([]byte{1, 0, 0, 0, 2, 0, 0, 0})))
+}
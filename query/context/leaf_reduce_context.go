@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/cespare/xxhash/v2"
+	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/flow"
@@ -35,7 +36,10 @@ type LeafReduceContext struct {
 	storageExecuteCtx *flow.StorageExecuteContext
 	leafGroupingCtx   *LeafGroupingContext
 	reduceAgg         aggregation.GroupingAggregator
-	lock              sync.Mutex
+	// grouping key(tag value ids) => tag value ids/tag value sketches of count distinct tag keys
+	distincts      map[string][]flow.DistinctTagValueIDs
+	distinctCounts map[string][]*aggregation.DistinctCount
+	lock           sync.Mutex
 }
 
 // NewLeafReduceContext creates a LeafReduceContext instance.
@@ -61,7 +65,16 @@ func (ctx *LeafReduceContext) Reduce(it series.GroupedIterator) {
 }
 
 // BuildResultSet returns the result set from reduce aggregator based on receivers.
-func (ctx *LeafReduceContext) BuildResultSet(leafNode *models.Target, receivers []string) [][]byte {
+func (ctx *LeafReduceContext) BuildResultSet(_ *models.Target, receivers []string) [][]byte {
+	return ctx.EmitResultChunks(receivers, 0, nil)
+}
+
+// EmitResultChunks builds the result set from reduce aggregator based on receivers chunk by chunk,
+// emits the chunk of receiver once it has chunkSize time series, so that the chunk can be sent before
+// the whole result set built, returns the last chunk of each receiver.
+// if chunkSize <= 0, returns the whole result set as one chunk for each receiver.
+// NOTE: tag values of groups are resolved after all data scanned, so chunks cannot be emitted during reduce.
+func (ctx *LeafReduceContext) EmitResultChunks(receivers []string, chunkSize int, emit func(receiverIdx int, chunk []byte)) [][]byte {
 	aggSpecs := ctx.storageExecuteCtx.AggregatorSpecs
 	timeRange := ctx.storageExecuteCtx.Query.TimeRange
 	interval := ctx.storageExecuteCtx.Query.Interval.Int64()
@@ -75,57 +88,57 @@ func (ctx *LeafReduceContext) BuildResultSet(leafNode *models.Target, receivers
 			aggregatorSpecs[idx].FuncTypeList = append(aggregatorSpecs[idx].FuncTypeList, uint32(funcType))
 		}
 	}
-	numOfReceivers := len(receivers)
-	resultSet := make([][]byte, numOfReceivers)
-	timeSeriesList := ctx.makeTimeSeriesList()
-	// root -> leaf task, return the raw total series
-	if numOfReceivers == 1 {
-		leaf2RootSeries := protoCommonV1.TimeSeriesList{
+	marshal := func(timeSeriesList []*protoCommonV1.TimeSeries) []byte {
+		timeSeries := protoCommonV1.TimeSeriesList{
 			TimeSeriesList: timeSeriesList,
 			FieldAggSpecs:  aggregatorSpecs,
 			Start:          timeRange.Start,
 			End:            timeRange.End,
 			Interval:       interval,
 		}
-		leaf2RootSeriesPayload, _ := leaf2RootSeries.Marshal()
-		resultSet[0] = leaf2RootSeriesPayload
-	} else {
-		// during intermediate task, time series will be grouped by hash
-		// and send to multi intermediate receiver
-		// hash mod -> series list
-		var timeSeriesHashGroups = make([][]*protoCommonV1.TimeSeries, numOfReceivers)
-		for _, ts := range timeSeriesList {
+		payload, _ := timeSeries.Marshal()
+		return payload
+	}
+	numOfReceivers := len(receivers)
+	timeSeriesHashGroups := make([][]*protoCommonV1.TimeSeries, numOfReceivers)
+	ctx.forEachTimeSeries(func(ts *protoCommonV1.TimeSeries) {
+		// root -> leaf task, return the raw total series
+		index := 0
+		if numOfReceivers > 1 {
+			// during intermediate task, time series will be grouped by hash
+			// and send to multi intermediate receiver
+			// hash mod -> series list
 			h := xxhash.Sum64String(ts.Tags)
-			index := int(h % uint64(numOfReceivers))
-			timeSeriesHashGroups[index] = append(timeSeriesHashGroups[index], ts)
+			index = int(h % uint64(numOfReceivers))
 		}
-		for idx, timeSeriesHashGroup := range timeSeriesHashGroups {
-			leaf2IntermediateSeries := protoCommonV1.TimeSeriesList{
-				TimeSeriesList: timeSeriesHashGroup,
-				FieldAggSpecs:  aggregatorSpecs,
-				Start:          timeRange.Start,
-				End:            timeRange.End,
-				Interval:       interval,
-			}
-			leaf2IntermediatePayload, _ := leaf2IntermediateSeries.Marshal()
-			resultSet[idx] = leaf2IntermediatePayload
+		timeSeriesHashGroups[index] = append(timeSeriesHashGroups[index], ts)
+		if chunkSize > 0 && len(timeSeriesHashGroups[index]) >= chunkSize {
+			emit(index, marshal(timeSeriesHashGroups[index]))
+			timeSeriesHashGroups[index] = nil
 		}
+	})
+	resultSet := make([][]byte, numOfReceivers)
+	for idx, timeSeriesHashGroup := range timeSeriesHashGroups {
+		resultSet[idx] = marshal(timeSeriesHashGroup)
 	}
 	return resultSet
 }
 
-// makeTimeSeriesList returns the time series data from reduce aggregator.
-func (ctx *LeafReduceContext) makeTimeSeriesList() []*protoCommonV1.TimeSeries {
+// forEachTimeSeries builds the time series data from reduce aggregator one by one.
+func (ctx *LeafReduceContext) forEachTimeSeries(fn func(ts *protoCommonV1.TimeSeries)) {
 	if ctx.reduceAgg == nil {
 		// if no data found or do aggregate
-		return nil
+		return
 	}
+	ctx.buildTimeSeries(fn)
+}
 
+// buildTimeSeries builds the time series data from the grouped series of reduce aggregator.
+func (ctx *LeafReduceContext) buildTimeSeries(fn func(ts *protoCommonV1.TimeSeries)) {
 	hasGroupBy := ctx.storageExecuteCtx.Query.HasGroupBy()
 	// 1. get reduce aggregator result set
 	groupedSeriesList := ctx.reduceAgg.ResultSet()
 	// 2. build rpc response data
-	var timeSeriesList []*protoCommonV1.TimeSeries
 	for _, groupedSeriesItr := range groupedSeriesList {
 		fields := make(map[string][]byte)
 		for groupedSeriesItr.HasNext() {
@@ -139,6 +152,9 @@ func (ctx *LeafReduceContext) makeTimeSeriesList() []*protoCommonV1.TimeSeries {
 			}
 			fields[string(seriesItr.FieldName())] = data
 		}
+		if ctx.distinctCounts != nil {
+			ctx.fillDistincts(groupedSeriesItr.Tags(), fields)
+		}
 
 		if len(fields) > 0 {
 			tags := ""
@@ -146,11 +162,80 @@ func (ctx *LeafReduceContext) makeTimeSeriesList() []*protoCommonV1.TimeSeries {
 				tagValueIDs := groupedSeriesItr.Tags() // returns tag value ids string value under leaf node.
 				tags = ctx.leafGroupingCtx.getTagValues(tagValueIDs)
 			}
-			timeSeriesList = append(timeSeriesList, &protoCommonV1.TimeSeries{
+			fn(&protoCommonV1.TimeSeries{
 				Tags:   tags,
 				Fields: fields,
 			})
 		}
 	}
-	return timeSeriesList
+}
+
+// ReduceDistinct reduces the tag value ids of count distinct tag keys for the group.
+func (ctx *LeafReduceContext) ReduceDistinct(key string, distincts []flow.DistinctTagValueIDs) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	if ctx.distincts == nil {
+		ctx.distincts = make(map[string][]flow.DistinctTagValueIDs)
+	}
+	exist, ok := ctx.distincts[key]
+	if !ok {
+		ctx.distincts[key] = distincts
+		return
+	}
+	for idx, distinct := range distincts {
+		exist[idx].Merge(distinct)
+	}
+}
+
+// fillDistincts fills the tag value sketches of count distinct tag keys into fields of group.
+func (ctx *LeafReduceContext) fillDistincts(key string, fields map[string][]byte) {
+	for idx, count := range ctx.distinctCounts[key] {
+		data, err := count.MarshalBinary()
+		if err != nil {
+			leafExecuteCtxLogger.Error("marshal distinct count data, ignore it.", logger.Error(err))
+			continue
+		}
+		fields[aggregation.DistinctFieldName(ctx.storageExecuteCtx.DistinctTagKeys[idx])] = data
+	}
+}
+
+// buildDistincts resolves the tag value ids of count distinct tag keys to tag values,
+// then builds the tag value sketches for each group, because tag value ids are different between storage nodes.
+func (ctx *LeafReduceContext) buildDistincts(tagMetadata flow.TagMetadataSnapshot) error {
+	tagKeyIDs := ctx.storageExecuteCtx.DistinctTagKeyIDs
+	tagValues := make([]map[uint32]string, len(tagKeyIDs))
+	for idx, tagKeyID := range tagKeyIDs {
+		tagValueIDs := roaring.New()
+		for _, distincts := range ctx.distincts {
+			for _, ids := range distincts[idx] {
+				tagValueIDs.Or(ids)
+			}
+		}
+		tagValues[idx] = make(map[uint32]string)
+		if tagValueIDs.IsEmpty() {
+			continue
+		}
+		if err := tagMetadata.CollectTagValues(tagKeyID, tagValueIDs, tagValues[idx]); err != nil {
+			return err
+		}
+	}
+	ctx.distinctCounts = make(map[string][]*aggregation.DistinctCount, len(ctx.distincts))
+	for key, distincts := range ctx.distincts {
+		counts := make([]*aggregation.DistinctCount, len(distincts))
+		for idx, distinct := range distincts {
+			count := aggregation.NewDistinctCount()
+			for slot, ids := range distinct {
+				it := ids.Iterator()
+				for it.HasNext() {
+					if tagValue, ok := tagValues[idx][it.Next()]; ok {
+						count.Add(slot, tagValue)
+					}
+				}
+			}
+			counts[idx] = count
+		}
+		ctx.distinctCounts[key] = counts
+	}
+	return nil
 }
@@ -22,14 +22,17 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
@@ -129,3 +132,94 @@ func TestLeafReduceContext_BuildResultSet(t *testing.T) {
 		})
 	}
 }
+
+func TestLeafReduceContext_EmitResultChunks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	spec := aggregation.NewAggregatorSpec("f", field.SumField)
+	spec.AddFunctionType(function.Sum)
+	storageCtx := &flow.StorageExecuteContext{
+		Query:           &stmtpkg.Query{},
+		AggregatorSpecs: aggregation.AggregatorSpecs{spec},
+	}
+	ctx := NewLeafReduceContext(storageCtx, &LeafGroupingContext{})
+	ctx.reduceAgg = newMockGroupingAggregator(ctrl, 3)
+	var chunks [][]byte
+	lastChunks := ctx.EmitResultChunks([]string{""}, 2, func(receiverIdx int, chunk []byte) {
+		assert.Zero(t, receiverIdx)
+		chunks = append(chunks, chunk)
+	})
+	assert.Len(t, lastChunks, 1)
+	chunks = append(chunks, lastChunks[0])
+	assert.Len(t, chunks, 2)
+	for idx, size := range []int{2, 1} {
+		tsList := &protoCommonV1.TimeSeriesList{}
+		assert.NoError(t, tsList.Unmarshal(chunks[idx]))
+		assert.Len(t, tsList.TimeSeriesList, size)
+		assert.Len(t, tsList.FieldAggSpecs, 1)
+	}
+}
+
+// newMockGroupingAggregator creates the grouping aggregator which returns n grouped series.
+func newMockGroupingAggregator(ctrl *gomock.Controller, n int) aggregation.GroupingAggregator {
+	agg := aggregation.NewMockGroupingAggregator(ctrl)
+	var groupedIts series.GroupedIterators
+	for i := 0; i < n; i++ {
+		gIt := series.NewMockGroupedIterator(ctrl)
+		gIt.EXPECT().HasNext().Return(true)
+		it := series.NewMockIterator(ctrl)
+		it.EXPECT().FieldName().Return(field.Name("f"))
+		gIt.EXPECT().Next().Return(it)
+		it.EXPECT().MarshalBinary().Return([]byte{1, 2, 3}, nil)
+		gIt.EXPECT().HasNext().Return(false)
+		groupedIts = append(groupedIts, gIt)
+	}
+	agg.EXPECT().ResultSet().Return(groupedIts)
+	return agg
+}
+
+func TestLeafReduceContext_distinct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storageCtx := &flow.StorageExecuteContext{
+		Query:             &stmtpkg.Query{},
+		DistinctTagKeys:   []string{"host"},
+		DistinctTagKeyIDs: []tag.KeyID{2},
+	}
+	ctx := NewLeafReduceContext(storageCtx, &LeafGroupingContext{})
+	ctx.ReduceDistinct("", []flow.DistinctTagValueIDs{{0: roaring.BitmapOf(1, 2)}})
+	ctx.ReduceDistinct("", []flow.DistinctTagValueIDs{{0: roaring.BitmapOf(2, 3), 1: roaring.BitmapOf(1)}})
+
+	tagMetadata := flow.NewMockTagMetadataSnapshot(ctrl)
+	tagMetadata.EXPECT().CollectTagValues(tag.KeyID(2), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, ctx.buildDistincts(tagMetadata))
+	tagMetadata.EXPECT().CollectTagValues(tag.KeyID(2), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error {
+			assert.Equal(t, roaring.BitmapOf(1, 2, 3), tagValueIDs)
+			tagValues[1] = "a"
+			tagValues[2] = "b"
+			return nil
+		})
+	assert.NoError(t, ctx.buildDistincts(tagMetadata))
+	values := ctx.distinctCounts[""][0].Values(2)
+	assert.Equal(t, 2.0, values.GetValue(0)) // tag value not found
+	assert.Equal(t, 1.0, values.GetValue(1))
+
+	agg := aggregation.NewMockGroupingAggregator(ctrl)
+	gIt := series.NewMockGroupedIterator(ctrl)
+	agg.EXPECT().ResultSet().Return(series.GroupedIterators{gIt})
+	gIt.EXPECT().HasNext().Return(false)
+	gIt.EXPECT().Tags().Return("")
+	ctx.reduceAgg = agg
+	var tsList []*protoCommonV1.TimeSeries
+	ctx.forEachTimeSeries(func(ts *protoCommonV1.TimeSeries) {
+		tsList = append(tsList, ts)
+	})
+	assert.Len(t, tsList, 1)
+	assert.Len(t, tsList[0].Fields, 1)
+	count := aggregation.NewDistinctCount()
+	assert.NoError(t, count.UnmarshalBinary(tsList[0].Fields[aggregation.DistinctFieldName("host")]))
+	assert.Equal(t, values, count.Values(2))
+}
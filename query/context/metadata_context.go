@@ -19,11 +19,13 @@ package context
 
 import (
 	"context"
+	"errors"
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/sql/stmt"
@@ -115,6 +117,10 @@ func (ctx *MetadataContext) handleResponse(resp *protoCommonV1.TaskResponse, fro
 	ctx.handleTaskState(resp, fromNode)
 	ctx.expectResults--
 
+	if resp.ErrMsg != "" {
+		ctx.err = errorpkg.WithCode(errorpkg.Code(resp.ErrCode), errors.New(resp.ErrMsg))
+		return
+	}
 	result := &models.SuggestResult{}
 	if err := encoding.JSONUnmarshal(resp.Payload, result); err != nil {
 		ctx.err = err
@@ -20,6 +20,7 @@ package context
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,6 +29,8 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
+	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/rpc"
@@ -40,13 +43,29 @@ type MetricContext struct {
 	baseTaskContext
 
 	groupAgg aggregation.GroupingAggregator
+	aggSpecs aggregation.AggregatorSpecs
 	stats    *models.NodeStats
+	// spillOpts represents the options of spilling groups into disk, nil means disable spilling
+	spillOpts *GroupBySpillOptions
+	// spiller spills the groups exceeded the threshold into disk, created when spilling first time
+	spiller *groupSpiller
 	// field name -> aggregator spec
 	// we will use it during intermediate tasks
 	aggregatorSpecs map[string]*protoCommonV1.AggregatorSpec
-	timeRange       timeutil.TimeRange
-	interval        int64
-	startTime       time.Time // task start time
+	// tags => distinct field name => tag value sketches of count distinct
+	distincts map[string]map[string]*aggregation.DistinctCount
+	// leaf node(original/hedged) => hedged task
+	hedgedTasks map[string]*hedgedTask
+	// sender => partial results of streaming result
+	partials map[string]*partialResults
+	// partialFailure reports the failure of target node, returns true if it can be tolerated as partial failure
+	partialFailure func(fromNode string, err error) bool
+	// checkBucketAlignment checks if time buckets of response are aligned with merged result
+	checkBucketAlignment bool
+
+	timeRange timeutil.TimeRange
+	interval  int64
+	startTime time.Time // task start time
 }
 
 // newMetricContext creates metric data search context.
@@ -54,16 +73,40 @@ func newMetricContext(ctx context.Context, transportMgr rpc.TransportManager) Me
 	return MetricContext{
 		baseTaskContext: newBaseTaskContext(ctx, transportMgr),
 		aggregatorSpecs: make(map[string]*protoCommonV1.AggregatorSpec),
+		distincts:       make(map[string]map[string]*aggregation.DistinctCount),
+		hedgedTasks:     make(map[string]*hedgedTask),
+		partials:        make(map[string]*partialResults),
 		startTime:       time.Now(),
 	}
 }
 
 // HandleResponse handles metric data search task response.
 func (ctx *MetricContext) HandleResponse(resp *protoCommonV1.TaskResponse, fromNode string) {
+	if ctx.dropHedgeLoser(resp, fromNode) {
+		// response of hedged task's loser, drop it
+		return
+	}
 	ctx.handleResponse(resp, fromNode)
+	if !resp.Completed {
+		// partial result consumed, grant credit to sender for next partial result
+		ctx.grantCredit(resp, fromNode)
+	}
 	ctx.tryClose()
 }
 
+// grantCredit grants one credit to the partial result stream of sender.
+func (ctx *MetricContext) grantCredit(resp *protoCommonV1.TaskResponse, fromNode string) {
+	if err := ctx.transportMgr.SendRequest(fromNode, &protoCommonV1.TaskRequest{
+		RequestID:   resp.RequestID,
+		RequestType: resp.RequestType,
+		Credits:     1,
+	}); err != nil {
+		ctx.mutex.Lock()
+		ctx.err = err
+		ctx.mutex.Unlock()
+	}
+}
+
 // waitResponse waits metric data search task completed.
 func (ctx *MetricContext) waitResponse() error {
 	select {
@@ -77,19 +120,52 @@ func (ctx *MetricContext) waitResponse() error {
 	}
 }
 
+// finishSender returns true if all results of sender handled, partial results are handled concurrently,
+// maybe after completed response, so sender is finished after completed response and all partial results handled.
+// NOTE: must hold lock.
+func (ctx *MetricContext) finishSender(resp *protoCommonV1.TaskResponse, fromNode string) bool {
+	p, ok := ctx.partials[fromNode]
+	if !ok {
+		if resp.Completed && resp.Partials == 0 {
+			// not streaming result
+			return true
+		}
+		p = &partialResults{}
+		ctx.partials[fromNode] = p
+	}
+	if resp.Completed {
+		p.completed = true
+		p.sent = resp.Partials
+	} else {
+		p.handled++
+	}
+	if p.completed && p.handled >= p.sent {
+		delete(ctx.partials, fromNode)
+		return true
+	}
+	return false
+}
+
 // handleResponse hanles task response.
 func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromNode string) {
 	ctx.mutex.Lock()
 	defer ctx.mutex.Unlock()
 
 	ctx.handleTaskState(resp, fromNode)
-	ctx.expectResults--
+	if ctx.finishSender(resp, fromNode) {
+		ctx.expectResults--
+	}
 
 	ctx.handleStats(resp, fromNode)
 
+	if resp.ErrMsg != "" &&
+		ctx.tolerateFailure(fromNode, errorpkg.WithCode(errorpkg.Code(resp.ErrCode), errors.New(resp.ErrMsg))) {
+		return
+	}
 	ignoreResponse, err := ctx.checkError(resp.ErrMsg)
 	if err != nil {
-		ctx.err = err
+		// keep the error code of sender
+		ctx.err = errorpkg.WithCode(errorpkg.Code(resp.ErrCode), err)
 		return
 	}
 	// partial not-found errors
@@ -108,6 +184,16 @@ func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromN
 		// if not ignore, will build empty group aggregator, and cannot aggregate real response data.
 		return
 	}
+	if ctx.checkBucketAlignment && ctx.groupAgg != nil &&
+		(tsList.Interval != ctx.interval || tsList.Start != ctx.timeRange.Start) {
+		// storage interval of target cluster is different, cannot merge the time buckets
+		err := fmt.Errorf("time bucket not aligned, start: %d, interval: %d, expect start: %d, interval: %d",
+			tsList.Start, tsList.Interval, ctx.timeRange.Start, ctx.interval)
+		if !ctx.tolerateFailure(fromNode, err) {
+			ctx.err = err
+		}
+		return
+	}
 	ctx.timeRange = timeutil.TimeRange{
 		Start: tsList.Start,
 		End:   tsList.End,
@@ -129,12 +215,8 @@ func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromN
 				AggregatorSpecs[idx].AddFunctionType(function.FuncType(funcType))
 			}
 		}
-		ctx.groupAgg = newGroupingAgg(
-			timeutil.Interval(ctx.interval),
-			1, // interval ratio is 1 when do merge result.
-			ctx.timeRange,
-			AggregatorSpecs,
-		)
+		ctx.aggSpecs = AggregatorSpecs
+		ctx.groupAgg = ctx.newGroupAgg()
 	}
 
 	for _, ts := range tsList.TimeSeriesList {
@@ -144,12 +226,95 @@ func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromN
 		}
 		fields := make(map[field.Name][]byte)
 		for k, v := range ts.Fields {
+			if aggregation.IsDistinctField(k) {
+				if err := ctx.mergeDistinct(ts.Tags, k, v); err != nil {
+					ctx.err = err
+					return
+				}
+				continue
+			}
 			fields[field.Name(k)] = v
 		}
+		if ctx.needSpill(ts.Tags) {
+			if err := ctx.spill(ts.Tags, fields); err != nil {
+				ctx.err = err
+				return
+			}
+			continue
+		}
 		ctx.groupAgg.Aggregate(series.NewGroupedIterator(ts.Tags, fields))
 	}
 }
 
+// newGroupAgg creates the grouping aggregator for merging result.
+func (ctx *MetricContext) newGroupAgg() aggregation.GroupingAggregator {
+	return newGroupingAgg(
+		timeutil.Interval(ctx.interval),
+		1, // interval ratio is 1 when do merge result.
+		ctx.timeRange,
+		ctx.aggSpecs,
+	)
+}
+
+// needSpill checks if the time series of group need be spilled into disk,
+// the group is spilled if it isn't in memory and the groups in memory reach the threshold.
+func (ctx *MetricContext) needSpill(tags string) bool {
+	if ctx.spillOpts == nil {
+		return false
+	}
+	// groups in memory don't grow after reaching the threshold, so all the time series of one group
+	// are either merged in memory or spilled into disk.
+	return ctx.groupAgg.Size() >= ctx.spillOpts.Threshold && !ctx.groupAgg.Contains(tags)
+}
+
+// spill spills the time series of group into disk.
+func (ctx *MetricContext) spill(tags string, fields map[field.Name][]byte) error {
+	if ctx.spiller == nil {
+		ctx.spiller = newGroupSpiller(ctx.spillOpts)
+	}
+	return ctx.spiller.spill(tags, fields)
+}
+
+// closeSpiller closes the spiller, removes all spill files.
+func (ctx *MetricContext) closeSpiller() {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	if ctx.spiller == nil {
+		return
+	}
+	if err := ctx.spiller.close(); err != nil {
+		spillLogger.Warn("close group by spiller failure", logger.Error(err))
+	}
+	ctx.spiller = nil
+}
+
+// mergeDistinct merges the tag value sketches of count distinct.
+func (ctx *MetricContext) mergeDistinct(tags, fieldName string, data []byte) error {
+	distincts, ok := ctx.distincts[tags]
+	if !ok {
+		distincts = make(map[string]*aggregation.DistinctCount)
+		ctx.distincts[tags] = distincts
+	}
+	count, ok := distincts[fieldName]
+	if !ok {
+		count = aggregation.NewDistinctCount()
+		distincts[fieldName] = count
+	}
+	return count.UnmarshalBinary(data)
+}
+
+// tolerateFailure checks if the failure of target node can be tolerated as partial failure,
+// e.g. one broker cluster of federation query fails.
+func (ctx *MetricContext) tolerateFailure(fromNode string, err error) bool {
+	if ctx.partialFailure == nil || !ctx.partialFailure(fromNode, err) {
+		return false
+	}
+	// failed target has no result, same as not found
+	ctx.tolerantNotFounds--
+	return true
+}
+
 // checkError checks if it has an error should be returned.
 // node of the cluster may return not found error,
 // ignoreResponse=true symbols that the response should be ignored
@@ -191,3 +356,10 @@ func (ctx *MetricContext) handleStats(resp *protoCommonV1.TaskResponse, fromNode
 	nodeStats.NetPayload = int64(len(resp.Stats) + len(resp.Payload))
 	ctx.stats.Children = append(ctx.stats.Children, nodeStats)
 }
+
+// partialResults represents the partial results of streaming result which sent by sender.
+type partialResults struct {
+	sent      int32 // number of partial results sent by sender, known after completed response received
+	handled   int32 // number of partial results handled
+	completed bool  // completed response received
+}
@@ -23,14 +23,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query/tracker"
+	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/field"
 )
 
@@ -56,6 +59,25 @@ func TestMetricContext_HandleResponse(t *testing.T) {
 		},
 		TimeSeriesList: []*protoCommonV1.TimeSeries{{Fields: map[string][]byte{"test": nil}}},
 	}).Marshal()
+	distinct := aggregation.NewDistinctCount()
+	distinct.Add(0, "a")
+	distinctData, _ := distinct.MarshalBinary()
+	payloadWithDistinct := func(data []byte) []byte {
+		payload, _ := (&protoCommonV1.TimeSeriesList{
+			FieldAggSpecs: []*protoCommonV1.AggregatorSpec{
+				{
+					FieldName:    "test",
+					FieldType:    uint32(field.Sum),
+					FuncTypeList: []uint32{uint32(field.Sum)},
+				},
+			},
+			TimeSeriesList: []*protoCommonV1.TimeSeries{{Fields: map[string][]byte{
+				"test":                                nil,
+				aggregation.DistinctFieldName("host"): data,
+			}}},
+		}).Marshal()
+		return payload
+	}
 	stats := encoding.JSONMarshal(&models.NodeStats{})
 
 	cases := []struct {
@@ -66,7 +88,7 @@ func TestMetricContext_HandleResponse(t *testing.T) {
 	}{
 		{
 			name:    "resp with err",
-			resp:    &protoCommonV1.TaskResponse{ErrMsg: "err"},
+			resp:    &protoCommonV1.TaskResponse{Completed: true, ErrMsg: "err"},
 			wantErr: true,
 		},
 		{
@@ -74,24 +96,33 @@ func TestMetricContext_HandleResponse(t *testing.T) {
 			prepare: func(metricCtx *MetricContext) {
 				metricCtx.tolerantNotFounds = 2
 			},
-			resp: &protoCommonV1.TaskResponse{ErrMsg: "not found"},
+			resp: &protoCommonV1.TaskResponse{Completed: true, ErrMsg: "not found"},
 		},
 		{
 			name:    "unmarshal payload failure",
-			resp:    &protoCommonV1.TaskResponse{Payload: []byte("abc")},
+			resp:    &protoCommonV1.TaskResponse{Completed: true, Payload: []byte("abc")},
 			wantErr: true,
 		},
 		{
 			name: "handle empty response",
-			resp: &protoCommonV1.TaskResponse{Payload: emptyPayload},
+			resp: &protoCommonV1.TaskResponse{Completed: true, Payload: emptyPayload},
 		},
 		{
 			name: "handle task response without field data",
-			resp: &protoCommonV1.TaskResponse{Payload: payload},
+			resp: &protoCommonV1.TaskResponse{Completed: true, Payload: payload},
 		},
 		{
 			name: "handle task response with field data",
-			resp: &protoCommonV1.TaskResponse{Payload: payloadWithField, Stats: stats},
+			resp: &protoCommonV1.TaskResponse{Completed: true, Payload: payloadWithField, Stats: stats},
+		},
+		{
+			name: "handle task response with distinct data",
+			resp: &protoCommonV1.TaskResponse{Completed: true, Payload: payloadWithDistinct(distinctData)},
+		},
+		{
+			name:    "unmarshal distinct data failure",
+			resp:    &protoCommonV1.TaskResponse{Completed: true, Payload: payloadWithDistinct([]byte{1, 2, 1})},
+			wantErr: true,
 		},
 	}
 
@@ -111,6 +142,50 @@ func TestMetricContext_HandleResponse(t *testing.T) {
 	}
 }
 
+func TestMetricContext_HandlePartialResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	payload, _ := (&protoCommonV1.TimeSeriesList{}).Marshal()
+	transportMgr := rpc.NewMockTransportManager(ctrl)
+	transportMgr.EXPECT().ReportResponse("leaf", nil).AnyTimes()
+	metricCtx := newMetricContext(context.TODO(), transportMgr)
+	metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+	metricCtx.expectResults = 1
+
+	// case 1: partial result, grant credit
+	transportMgr.EXPECT().SendRequest("leaf", &protoCommonV1.TaskRequest{RequestID: "req", Credits: 1}).Return(nil)
+	metricCtx.HandleResponse(&protoCommonV1.TaskResponse{RequestID: "req", Payload: payload}, "leaf")
+	assert.NoError(t, metricCtx.err)
+	assert.Equal(t, 1, metricCtx.expectResults)
+	assert.False(t, metricCtx.completed.Load())
+	// case 2: completed response
+	metricCtx.HandleResponse(&protoCommonV1.TaskResponse{RequestID: "req", Payload: payload, Completed: true}, "leaf")
+	assert.NoError(t, metricCtx.err)
+	assert.Equal(t, 0, metricCtx.expectResults)
+	assert.True(t, metricCtx.completed.Load())
+	// case 3: completed response handled before partial result
+	metricCtx = newMetricContext(context.TODO(), transportMgr)
+	metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+	metricCtx.expectResults = 1
+	metricCtx.HandleResponse(&protoCommonV1.TaskResponse{RequestID: "req", Payload: payload, Completed: true, Partials: 1}, "leaf")
+	assert.Equal(t, 1, metricCtx.expectResults)
+	assert.False(t, metricCtx.completed.Load())
+	transportMgr.EXPECT().SendRequest("leaf", &protoCommonV1.TaskRequest{RequestID: "req", Credits: 1}).Return(nil)
+	metricCtx.HandleResponse(&protoCommonV1.TaskResponse{RequestID: "req", Payload: payload}, "leaf")
+	assert.Equal(t, 0, metricCtx.expectResults)
+	assert.True(t, metricCtx.completed.Load())
+	assert.Empty(t, metricCtx.partials)
+	// case 4: grant credit failure
+	metricCtx = newMetricContext(context.TODO(), transportMgr)
+	metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+	metricCtx.expectResults = 1
+	transportMgr.EXPECT().SendRequest(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	metricCtx.HandleResponse(&protoCommonV1.TaskResponse{RequestID: "req", Payload: payload}, "leaf")
+	assert.Error(t, metricCtx.err)
+	assert.True(t, metricCtx.completed.Load())
+}
+
 func TestMetricContext_waitResponse(t *testing.T) {
 	t.Run("time out", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.TODO())
@@ -20,6 +20,7 @@ package context
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 	"strings"
@@ -30,12 +31,15 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/rpc"
+	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
@@ -45,6 +49,7 @@ var (
 	newExpressionFn    = aggregation.NewExpression
 	newGroupingAgg     = aggregation.NewGroupingAggregator
 	newResultLimiterFn = aggregation.NewResultLimiter
+	newHavingFilterFn  = aggregation.NewHavingFilter
 )
 
 // RootMetricContextDeps represents root metric data search dependency.
@@ -56,6 +61,8 @@ type RootMetricContextDeps struct {
 	Statement    *stmt.Query
 	Choose       flow.NodeChoose
 	TransportMgr rpc.TransportManager
+	HedgeDelay   time.Duration        // delay of issuing hedged leaf request, 0 means disable
+	GroupBySpill *GroupBySpillOptions // spill groups into disk if too many groups, nil means disable
 }
 
 // RootMetricContext represents root metric data search context.
@@ -63,14 +70,27 @@ type RootMetricContext struct {
 	MetricContext
 
 	Deps *RootMetricContextDeps
+
+	// leaf target => physical plan, leaf task can be hedged if leaf node has no response after hedge delay
+	hedgeTargets map[string]*models.PhysicalPlan
+
+	// target node => broker cluster, only for federation query which fans out to multi broker clusters
+	clusters      map[string]string
+	numOfClusters int
+	// broker cluster => error message of failure cluster
+	clusterFailures map[string]string
 }
 
 // NewRootMetricContext creates the root metric data search context.
 func NewRootMetricContext(deps *RootMetricContextDeps) *RootMetricContext {
-	return &RootMetricContext{
+	ctx := &RootMetricContext{
 		MetricContext: newMetricContext(deps.Ctx, deps.TransportMgr),
 		Deps:          deps,
 	}
+	if deps.GroupBySpill != nil && deps.GroupBySpill.Threshold > 0 && deps.Statement.HasGroupBy() {
+		ctx.spillOpts = deps.GroupBySpill
+	}
+	return ctx
 }
 
 // MakePlan makes the metric data physical plan.
@@ -96,6 +116,8 @@ func (ctx *RootMetricContext) MakePlan() error {
 			return constants.ErrDatabaseNotExist
 		}
 		calcTimeRangeAndInterval(ctx.Deps.Statement, databaseCfg)
+	} else if isFederation(physicalPlans) {
+		ctx.prepareFederation(physicalPlans)
 	}
 	payload, _ := ctx.Deps.Statement.MarshalJSON()
 	for _, physicalPlan := range physicalPlans {
@@ -104,12 +126,22 @@ func (ctx *RootMetricContext) MakePlan() error {
 		if err := physicalPlan.Validate(); err != nil {
 			return err
 		}
+		if ctx.clusters != nil {
+			for _, target := range physicalPlan.Targets {
+				ctx.clusters[target.Indicator] = physicalPlan.Cluster
+			}
+		}
+		if ok && ctx.Deps.HedgeDelay > 0 {
+			ctx.addHedgeTargets(physicalPlan)
+		}
 		ctx.addRequests(
 			&protoCommonV1.TaskRequest{
 				RequestID:    ctx.Deps.Request.RequestID,
 				RequestType:  protoCommonV1.RequestType_Data,
 				PhysicalPlan: encoding.JSONMarshal(physicalPlan),
 				Payload:      payload,
+				Credits:      resultCreditWindow,
+				Priority:     int32(concurrent.PriorityFromContext(ctx.Deps.Ctx)),
 			}, physicalPlan)
 	}
 	return nil
@@ -117,6 +149,14 @@ func (ctx *RootMetricContext) MakePlan() error {
 
 // WaitResponse waits metric data search task completed, then returns the result set,
 func (ctx *RootMetricContext) WaitResponse() (any, error) {
+	// remove spill files after result set made
+	defer ctx.closeSpiller()
+
+	if len(ctx.hedgeTargets) > 0 {
+		// issue hedged requests for slow leaf tasks after hedge delay
+		timer := time.AfterFunc(ctx.Deps.HedgeDelay, ctx.hedge)
+		defer timer.Stop()
+	}
 	err := ctx.waitResponse()
 	if err != nil {
 		return nil, err
@@ -133,37 +173,81 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 	if err != nil {
 		return nil, err
 	}
+	having, err := ctx.buildHaving()
+	if err != nil {
+		return nil, err
+	}
 
 	statement := ctx.Deps.Statement
 	resultSet = new(models.ResultSet)
 	// TODO: merge stats for cross idc query?
 	groupByKeys := statement.GroupBy
 	groupByKeysLength := len(groupByKeys)
+	groupByAllKeys := make(map[string]struct{})
 	fieldsMap := make(map[string]struct{})
 	timeRange := ctx.timeRange
 	interval := ctx.interval
 	if ctx.groupAgg != nil {
-		groupIts := ctx.groupAgg.ResultSet()
 		selectItems := ctx.getSelectItems()
-		for _, it := range groupIts {
-			// TODO: reuse expression??
-			expression := newExpressionFn(
-				timeRange,
-				interval,
-				selectItems,
-			)
-			// do expression eval
-			expression.Eval(it)
-
-			// result order by/limit
-			orderBy.Push(aggregation.NewOrderByRow(it.Tags(), expression.ResultSet()))
+		evalGroups := func(groupIts series.GroupedIterators) {
+			for _, it := range groupIts {
+				// TODO: reuse expression??
+				expression := newExpressionFn(
+					timeRange,
+					interval,
+					selectItems,
+				)
+				// do expression eval
+				expression.Eval(it)
+				values := expression.ResultSet()
+				tags := it.Tags()
+				ctx.fillDistinctValues(tags, selectItems, values)
+
+				row := aggregation.NewOrderByRow(groupByKeys, tags, values)
+				// drop the group which not match having condition before order by/limit
+				if having != nil && !having.Filter(row) {
+					continue
+				}
+				// result order by/limit
+				orderBy.Push(row)
+			}
+		}
+		evalGroups(ctx.groupAgg.ResultSet())
+		if ctx.spiller != nil {
+			// merge spill partitions one by one, only the groups of one partition are kept in memory
+			var groupAgg aggregation.GroupingAggregator
+			if err := ctx.spiller.walk(func(tags string, fields map[field.Name][]byte) {
+				if groupAgg == nil {
+					groupAgg = ctx.newGroupAgg()
+				}
+				groupAgg.Aggregate(series.NewGroupedIterator(tags, fields))
+			}, func() {
+				if groupAgg != nil {
+					evalGroups(groupAgg.ResultSet())
+					groupAgg = nil
+				}
+			}); err != nil {
+				return nil, err
+			}
 		}
 
 		rows := orderBy.ResultSet()
 		for _, row := range rows {
 			var tags map[string]string
 			tagValues, fields := row.ResultSet()
-			if groupByKeysLength > 0 {
+			switch {
+			case statement.GroupByAll:
+				// group by *, tag values returned with tag key(key=value) which resolved under storage
+				tags = make(map[string]string)
+				for _, tagValue := range tag.SplitTagValues(tagValues) {
+					tagKey, value, ok := strings.Cut(tagValue, "=")
+					if !ok {
+						continue
+					}
+					tags[tagKey] = value
+					groupByAllKeys[tagKey] = struct{}{}
+				}
+			case groupByKeysLength > 0:
 				tagValues := tag.SplitTagValues(tagValues)
 				if groupByKeysLength != len(tagValues) {
 					// if tag values not match group by tag keys, ignore this time series
@@ -198,18 +282,34 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 		}
 	}
 
-	sort.Slice(resultSet.Series, func(i, j int) bool {
-		return resultSet.Series[i].TagValues < resultSet.Series[j].TagValues
-	})
+	if len(statement.OrderByItems) == 0 {
+		// keep the order of order by items, otherwise sort by tag values
+		sort.Slice(resultSet.Series, func(i, j int) bool {
+			return resultSet.Series[i].TagValues < resultSet.Series[j].TagValues
+		})
+	}
 
 	resultSet.MetricName = statement.MetricName
 	resultSet.GroupBy = statement.GroupBy
+	if statement.GroupByAll {
+		resultSet.GroupBy = nil
+		for tagKey := range groupByAllKeys {
+			resultSet.GroupBy = append(resultSet.GroupBy, tagKey)
+		}
+		sort.Strings(resultSet.GroupBy)
+	}
 	for fName := range fieldsMap {
 		resultSet.Fields = append(resultSet.Fields, fName)
 	}
+	if statement.AllFields {
+		resultSet.FieldMetas = ctx.buildFieldMetas()
+	}
 	resultSet.StartTime = timeRange.Start
 	resultSet.EndTime = timeRange.End
 	resultSet.Interval = interval
+	if len(ctx.clusterFailures) > 0 {
+		resultSet.PartialFailures = ctx.clusterFailures
+	}
 
 	if ctx.stats != nil {
 		now := time.Now()
@@ -217,19 +317,74 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 		ctx.stats.End = now.UnixNano()
 		ctx.stats.TotalCost = now.Sub(ctx.startTime).Nanoseconds()
 
-		ctx.stats.Stages = append(ctx.stats.Stages, &models.StageStats{
+		expressionStats := &models.StageStats{
 			Identifier: "Expression",
 			Start:      makeResultStartTime.UnixNano(),
 			End:        now.UnixNano(),
 			Cost:       now.Sub(makeResultStartTime).Nanoseconds(),
 			State:      tracker.CompleteState.String(),
 			Async:      false,
+		}
+		if having != nil {
+			expressionStats.Operators = append(expressionStats.Operators, &models.OperatorStats{
+				Identifier: "Having",
+				Stats:      &models.HavingStats{DroppedGroups: having.Dropped()},
+			})
+		}
+		if len(statement.OrderByItems) > 0 {
+			orderByStats := &models.OrderByStats{}
+			for _, item := range statement.OrderByItems {
+				orderByStats.Items = append(orderByStats.Items, item.Rewrite())
+			}
+			expressionStats.Operators = append(expressionStats.Operators, &models.OperatorStats{
+				Identifier: "Order By",
+				Stats:      orderByStats,
+			})
+		}
+		selectStats := &models.SelectStats{}
+		for _, item := range statement.SelectItems {
+			if selectItem, ok := item.(*stmt.SelectItem); ok {
+				selectStats.Columns = append(selectStats.Columns, selectItem.ColumnName())
+			}
+		}
+		expressionStats.Operators = append(expressionStats.Operators, &models.OperatorStats{
+			Identifier: "Select",
+			Stats:      selectStats,
 		})
+		ctx.stats.Stages = append(ctx.stats.Stages, expressionStats)
 		resultSet.Stats = ctx.stats
 	}
 	return resultSet, nil
 }
 
+// fillDistinctValues fills the approximate distinct count of tag values into the result of select item.
+func (ctx *RootMetricContext) fillDistinctValues(tags string, selectItems []stmt.Expr, resultSet map[string]*collections.FloatArray) {
+	distincts, ok := ctx.distincts[tags]
+	if !ok {
+		return
+	}
+	capacity := timeutil.CalPointCount(ctx.timeRange.Start, ctx.timeRange.End, ctx.interval) + 1
+	for _, item := range selectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if !ok {
+			continue
+		}
+		callExpr, ok := selectItem.Expr.(*stmt.CallExpr)
+		if !ok || callExpr.FuncType != function.CountDistinct {
+			continue
+		}
+		count, ok := distincts[aggregation.DistinctFieldName(callExpr.Params[0].Rewrite())]
+		if !ok {
+			continue
+		}
+		if len(selectItem.Alias) > 0 {
+			resultSet[selectItem.Alias] = count.Values(capacity)
+		} else {
+			resultSet[selectItem.Rewrite()] = count.Values(capacity)
+		}
+	}
+}
+
 // buildOrderBy builds order by container.
 func (ctx *RootMetricContext) buildOrderBy() (aggregation.OrderBy, error) {
 	statement := ctx.Deps.Statement
@@ -247,10 +402,27 @@ func (ctx *RootMetricContext) buildOrderBy() (aggregation.OrderBy, error) {
 		var fieldName string
 		switch e := expr.Expr.(type) {
 		case *stmt.FieldExpr:
+			if aliasFunc, ok := ctx.getAliasFunc(e.Name); ok {
+				// order by the column of alias
+				funcType = aliasFunc
+				fieldName = e.Name
+				break
+			}
 			aggSpec, ok := fields[e.Name]
 			if ok {
 				funcType = field.Type(aggSpec.FieldType).GetOrderByFunc()
 				fieldName = e.Name
+				break
+			}
+			if ctx.isGroupByTagKey(e.Name) {
+				// order by the tag value of group
+				orderByItems = append(orderByItems, &aggregation.OrderByItem{
+					Expr: expr,
+					Name: e.Name,
+					Desc: expr.Desc,
+					Tag:  true,
+				})
+				continue
 			}
 		case *stmt.CallExpr:
 			funcType = e.FuncType
@@ -269,17 +441,133 @@ func (ctx *RootMetricContext) buildOrderBy() (aggregation.OrderBy, error) {
 	return aggregation.NewTopNOrderBy(orderByItems, statement.Limit), nil
 }
 
+// isGroupByTagKey checks if the tag key is one of group by tag keys.
+func (ctx *RootMetricContext) isGroupByTagKey(tagKey string) bool {
+	statement := ctx.Deps.Statement
+	if statement.GroupByAll {
+		return true
+	}
+	for _, groupBy := range statement.GroupBy {
+		if groupBy == tagKey {
+			return true
+		}
+	}
+	return false
+}
+
+// getAliasFunc returns the function which aggregates the values of the column with given alias for order by/having,
+// uses function of select item if it supports order by, default function of field type for field,
+// otherwise uses avg for complex expression.
+func (ctx *RootMetricContext) getAliasFunc(alias string) (function.FuncType, bool) {
+	for _, item := range ctx.Deps.Statement.SelectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if !ok || selectItem.Alias != alias {
+			continue
+		}
+		switch e := selectItem.Expr.(type) {
+		case *stmt.CallExpr:
+			if function.IsSupportOrderBy(e.FuncType) {
+				return e.FuncType, true
+			}
+		case *stmt.FieldExpr:
+			if aggSpec, ok := ctx.aggregatorSpecs[e.Name]; ok {
+				return field.Type(aggSpec.FieldType).GetOrderByFunc(), true
+			}
+		}
+		return function.Avg, true
+	}
+	return function.Unknown, false
+}
+
+// buildHaving builds having filter if query has having condition.
+func (ctx *RootMetricContext) buildHaving() (aggregation.HavingFilter, error) {
+	having := ctx.Deps.Statement.Having
+	if having == nil {
+		return nil, nil
+	}
+	fieldFuncs := make(map[string]function.FuncType)
+	if err := ctx.collectHavingFieldFuncs(having, fieldFuncs); err != nil {
+		return nil, err
+	}
+	return newHavingFilterFn(having, fieldFuncs), nil
+}
+
+// collectHavingFieldFuncs collects the default function of field without function call in having condition.
+func (ctx *RootMetricContext) collectHavingFieldFuncs(expr stmt.Expr, fieldFuncs map[string]function.FuncType) error {
+	switch e := expr.(type) {
+	case *stmt.ParenExpr:
+		return ctx.collectHavingFieldFuncs(e.Expr, fieldFuncs)
+	case *stmt.BinaryExpr:
+		if err := ctx.collectHavingFieldFuncs(e.Left, fieldFuncs); err != nil {
+			return err
+		}
+		return ctx.collectHavingFieldFuncs(e.Right, fieldFuncs)
+	case *stmt.FieldExpr:
+		if aliasFunc, ok := ctx.getAliasFunc(e.Name); ok {
+			// filter by the column of alias
+			fieldFuncs[e.Name] = aliasFunc
+			return nil
+		}
+		aggSpec, ok := ctx.aggregatorSpecs[e.Name]
+		if !ok {
+			return fmt.Errorf("cannot parse having field: %s", e.Name)
+		}
+		fieldFuncs[e.Name] = field.Type(aggSpec.FieldType).GetOrderByFunc()
+	}
+	return nil
+}
+
+// buildFieldMetas builds the field metadata(sorted by name) of select all fields(select *),
+// histogram internal fields are excluded.
+func (ctx *RootMetricContext) buildFieldMetas() (fieldMetas []models.FieldMeta) {
+	for fieldName, aggSpec := range ctx.aggregatorSpecs {
+		fieldType := field.Type(aggSpec.FieldType)
+		if fieldType == field.HistogramField || fieldType == field.SketchField {
+			continue
+		}
+		fieldMeta := models.FieldMeta{Name: fieldName, Type: fieldType.String()}
+		for _, funcType := range aggSpec.FuncTypeList {
+			fieldMeta.DownSampling = append(fieldMeta.DownSampling, function.FuncType(funcType).String())
+		}
+		fieldMetas = append(fieldMetas, fieldMeta)
+	}
+	sort.Slice(fieldMetas, func(i, j int) bool {
+		return fieldMetas[i].Name < fieldMetas[j].Name
+	})
+	return fieldMetas
+}
+
+// isSketchField checks if field is the histogram sketch field.
+func (ctx *RootMetricContext) isSketchField(fieldName field.Name) bool {
+	aggSpec, ok := ctx.aggregatorSpecs[string(fieldName)]
+	return ok && field.Type(aggSpec.FieldType) == field.SketchField
+}
+
 // getSelectItems returns select field items.
 func (ctx *RootMetricContext) getSelectItems() []stmt.Expr {
 	statement := ctx.Deps.Statement
 	selectItems := statement.SelectItems
 	if statement.AllFields {
-		// if select all fields, read field names from aggregator
+		// if select all fields, read field names from aggregator(include the fields spilled into disk)
 		allAggFields := ctx.groupAgg.Fields()
+		if ctx.spiller != nil {
+			for fieldName := range ctx.spiller.fields {
+				found := false
+				for _, f := range allAggFields {
+					if f == fieldName {
+						found = true
+						break
+					}
+				}
+				if !found {
+					allAggFields = append(allAggFields, fieldName)
+				}
+			}
+		}
 		selectItems = []stmt.Expr{}
 		isHistogram := false
 		for _, fieldName := range allAggFields {
-			if strings.HasPrefix(string(fieldName), "__bucket_") {
+			if strings.HasPrefix(string(fieldName), "__bucket_") || ctx.isSketchField(fieldName) {
 				// filter histogram raw field
 				isHistogram = true
 				continue
@@ -227,6 +227,69 @@ func TestRootMetricDataContext_makeResultSet(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "build group by all result set",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.GroupBy = nil
+				ctx.Deps.Statement.GroupByAll = true
+				ctx.groupAgg = groupAgg
+				groupIt := series.NewMockGroupedIterator(ctrl)
+				groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt})
+				expr.EXPECT().Eval(gomock.Any())
+				groupIt.EXPECT().Tags().Return("tags")
+				expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"f": collections.NewFloatArray(10)})
+				orderBy.EXPECT().Push(gomock.Any())
+				row := aggregation.NewMockRow(ctrl)
+				row.EXPECT().ResultSet().Return("zone=z1,host=h1,bad", nil)
+				row.EXPECT().ResultSet().Return("host=h2,ip=1.1.1.1", nil)
+				orderBy.EXPECT().ResultSet().Return([]aggregation.Row{row, row})
+			},
+			assert: func(rs *models.ResultSet, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"host", "ip", "zone"}, rs.GroupBy)
+				assert.Len(t, rs.Series, 2)
+				assert.Equal(t, map[string]string{"host": "h2", "ip": "1.1.1.1"}, rs.Series[0].Tags)
+				assert.Equal(t, map[string]string{"zone": "z1", "host": "h1"}, rs.Series[1].Tags)
+			},
+		},
+		{
+			name: "having field not found",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.Having = &stmt.BinaryExpr{
+					Left:     &stmt.ParenExpr{Expr: &stmt.FieldExpr{Name: "g"}},
+					Operator: stmt.GREATER,
+					Right:    &stmt.NumberLiteral{Val: 1},
+				}
+			},
+			assert: func(rs *models.ResultSet, err error) {
+				assert.Nil(t, rs)
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "drop group by having filter",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.GroupBy = []string{"a"}
+				ctx.Deps.Statement.Having = &stmt.BinaryExpr{
+					Left:     &stmt.FieldExpr{Name: "f"},
+					Operator: stmt.GREATER,
+					Right:    &stmt.NumberLiteral{Val: 1},
+				}
+				ctx.groupAgg = groupAgg
+				groupIt := series.NewMockGroupedIterator(ctrl)
+				groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt})
+				expr.EXPECT().Eval(gomock.Any())
+				groupIt.EXPECT().Tags().Return("tags")
+				expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"f": collections.NewFloatArray(10)})
+				orderBy.EXPECT().ResultSet().Return(nil)
+			},
+			assert: func(rs *models.ResultSet, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, rs.Series)
+				stage := rs.Stats.Stages[len(rs.Stats.Stages)-1]
+				assert.Equal(t, &models.HavingStats{DroppedGroups: 1}, stage.Operators[0].Stats)
+			},
+		},
 		{
 			name: "build all fields result set",
 			prepare: func(ctx *RootMetricContext) {
@@ -305,3 +368,134 @@ func TestRootMetricDataContext_makeResultSet(t *testing.T) {
 		})
 	}
 }
+
+func TestRootMetricDataContext_fillDistinctValues(t *testing.T) {
+	metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:       context.TODO(),
+		Request:   &models.Request{},
+		Statement: &stmt.Query{},
+	})
+	metricCtx.timeRange = timeutil.TimeRange{Start: 0, End: 20}
+	metricCtx.interval = 10
+	count := aggregation.NewDistinctCount()
+	count.Add(1, "a")
+	count.Add(1, "b")
+	metricCtx.distincts["ip"] = map[string]*aggregation.DistinctCount{aggregation.DistinctFieldName("host"): count}
+	countDistinct := func(tagKey string) stmt.Expr {
+		return &stmt.CallExpr{FuncType: function.CountDistinct, Params: []stmt.Expr{&stmt.FieldExpr{Name: tagKey}}}
+	}
+	selectItems := []stmt.Expr{
+		&stmt.FieldExpr{Name: "f"},
+		&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}},
+		&stmt.SelectItem{Expr: countDistinct("host")},
+		&stmt.SelectItem{Expr: countDistinct("host"), Alias: "hosts"},
+		&stmt.SelectItem{Expr: countDistinct("zone")},
+	}
+	rs := make(map[string]*collections.FloatArray)
+	metricCtx.fillDistinctValues("not-exist", selectItems, rs)
+	assert.Empty(t, rs)
+	metricCtx.fillDistinctValues("ip", selectItems, rs)
+	assert.Len(t, rs, 2)
+	assert.Equal(t, 2.0, rs["hosts"].GetValue(1))
+	assert.Equal(t, 2.0, rs["count_distinct(host)"].GetValue(1))
+}
+
+func TestRootMetricDataContext_buildFieldMetas(t *testing.T) {
+	metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:       context.TODO(),
+		Request:   &models.Request{},
+		Statement: &stmt.Query{AllFields: true},
+	})
+	assert.Empty(t, metricCtx.buildFieldMetas())
+	metricCtx.aggregatorSpecs = map[string]*protoCommonV1.AggregatorSpec{
+		"g":          {FieldName: "g", FieldType: uint32(field.LastField), FuncTypeList: []uint32{uint32(function.Last)}},
+		"f":          {FieldName: "f", FieldType: uint32(field.SumField), FuncTypeList: []uint32{uint32(function.Sum)}},
+		"__bucket_1": {FieldName: "__bucket_1", FieldType: uint32(field.HistogramField)},
+	}
+	assert.Equal(t, []models.FieldMeta{
+		{Name: "f", Type: "sum", DownSampling: []string{"sum"}},
+		{Name: "g", Type: "last", DownSampling: []string{"last"}},
+	}, metricCtx.buildFieldMetas())
+}
+
+func TestRootMetricDataContext_alias(t *testing.T) {
+	sum := func(name string) stmt.Expr {
+		return &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: name}}}
+	}
+	metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:     context.TODO(),
+		Request: &models.Request{},
+		Statement: &stmt.Query{
+			SelectItems: []stmt.Expr{
+				&stmt.SelectItem{Expr: sum("f"), Alias: "total"},
+				&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "g"}, Alias: "gg"},
+				&stmt.SelectItem{Expr: &stmt.BinaryExpr{Left: sum("f"), Operator: stmt.DIV, Right: sum("g")}, Alias: "ratio"},
+				&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "h"}},
+			},
+		},
+	})
+	metricCtx.aggregatorSpecs = map[string]*protoCommonV1.AggregatorSpec{
+		"f": {FieldName: "f", FieldType: uint32(field.SumField)},
+		"g": {FieldName: "g", FieldType: uint32(field.MaxField)},
+		"h": {FieldName: "h", FieldType: uint32(field.LastField)},
+	}
+	cases := []struct {
+		alias    string
+		funcType function.FuncType
+		ok       bool
+	}{
+		{alias: "total", funcType: function.Sum, ok: true},
+		{alias: "gg", funcType: function.Max, ok: true},
+		{alias: "ratio", funcType: function.Avg, ok: true},
+		{alias: "h", funcType: function.Unknown, ok: false},
+	}
+	for _, tt := range cases {
+		funcType, ok := metricCtx.getAliasFunc(tt.alias)
+		assert.Equal(t, tt.ok, ok, tt.alias)
+		assert.Equal(t, tt.funcType, funcType, tt.alias)
+	}
+
+	// order by/having with alias
+	metricCtx.Deps.Statement.OrderByItems = []stmt.Expr{&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "ratio"}, Desc: true}}
+	orderBy, err := metricCtx.buildOrderBy()
+	assert.NoError(t, err)
+	assert.NotNil(t, orderBy)
+	fieldFuncs := make(map[string]function.FuncType)
+	assert.NoError(t, metricCtx.collectHavingFieldFuncs(&stmt.BinaryExpr{
+		Left:     &stmt.FieldExpr{Name: "total"},
+		Operator: stmt.GREATER,
+		Right:    &stmt.FieldExpr{Name: "h"},
+	}, fieldFuncs))
+	assert.Equal(t, map[string]function.FuncType{"total": function.Sum, "h": function.Last}, fieldFuncs)
+}
+
+func TestRootMetricDataContext_orderByTag(t *testing.T) {
+	metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:     context.TODO(),
+		Request: &models.Request{},
+		Statement: &stmt.Query{
+			GroupBy: []string{"host"},
+			OrderByItems: []stmt.Expr{
+				&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "host"}, Desc: true},
+				&stmt.OrderByExpr{Expr: &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}},
+			},
+		},
+	})
+	orderBy, err := metricCtx.buildOrderBy()
+	assert.NoError(t, err)
+	assert.NotNil(t, orderBy)
+	assert.True(t, metricCtx.isGroupByTagKey("host"))
+	assert.False(t, metricCtx.isGroupByTagKey("ip"))
+
+	// not group by tag key
+	metricCtx.Deps.Statement.OrderByItems = []stmt.Expr{&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "ip"}}}
+	orderBy, err = metricCtx.buildOrderBy()
+	assert.Error(t, err)
+	assert.Nil(t, orderBy)
+
+	// group by *
+	metricCtx.Deps.Statement.GroupByAll = true
+	orderBy, err = metricCtx.buildOrderBy()
+	assert.NoError(t, err)
+	assert.NotNil(t, orderBy)
+}
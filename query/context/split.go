@@ -0,0 +1,120 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"time"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// maxSubQueries represents the max number of sub-queries which a long time range query splits into.
+const maxSubQueries = 32
+
+// SplitQuery splits the long time range query into sub-queries by segment(day/month) of storage interval,
+// the time range of each sub-query is aligned with the bucket of whole query, so that the results can be merged directly.
+// returns nil if query cannot be split.
+func SplitQuery(statement *stmt.Query, cfg models.Database, threshold time.Duration) []*stmt.Query {
+	if !isSplittable(statement) || statement.TimeRange.End-statement.TimeRange.Start <= threshold.Milliseconds() {
+		return nil
+	}
+	planned := *statement
+	calcTimeRangeAndInterval(&planned, cfg)
+	interval := planned.Interval.Int64()
+	start := planned.TimeRange.Start
+	end := planned.TimeRange.End
+	if interval <= 0 || end <= start {
+		return nil
+	}
+	calc := planned.StorageInterval.Calculator()
+	lastBucket := (end - start) / interval
+	// bucket index of sub-query start time
+	boundaries := []int64{0}
+	for idx := int64(0); ; {
+		next := nextSegmentBucket(calc, start, interval, idx, lastBucket)
+		if next < 0 {
+			break
+		}
+		boundaries = append(boundaries, next)
+		idx = next
+	}
+	if len(boundaries) <= 1 {
+		return nil
+	}
+	// merge adjacent segments if too many sub-queries
+	if step := (len(boundaries) + maxSubQueries - 1) / maxSubQueries; step > 1 {
+		merged := boundaries[:0]
+		for idx := 0; idx < len(boundaries); idx += step {
+			merged = append(merged, boundaries[idx])
+		}
+		boundaries = merged
+	}
+	subQueries := make([]*stmt.Query, len(boundaries))
+	for idx, bucket := range boundaries {
+		subQuery := planned
+		subQuery.TimeRange.Start = start + bucket*interval
+		if idx+1 < len(boundaries) {
+			// end time is the last storage slot before next sub-query
+			subQuery.TimeRange.End = start + boundaries[idx+1]*interval - planned.StorageInterval.Int64()
+		}
+		subQueries[idx] = &subQuery
+	}
+	return subQueries
+}
+
+// isSplittable checks if the results of sub-queries can be merged by bucket,
+// the query which computes across buckets(auto group by time/order by/having/count distinct) cannot be split.
+func isSplittable(statement *stmt.Query) bool {
+	return !statement.Explain && !statement.AutoGroupByTime &&
+		len(statement.OrderByItems) == 0 && statement.Having == nil &&
+		len(statement.DistinctTagKeys()) == 0
+}
+
+// nextSegmentBucket returns the first bucket(start + idx*interval) in (from, last] which is in the next segment of bucket from,
+// returns -1 if all buckets are in same segment.
+func nextSegmentBucket(calc timeutil.IntervalCalculator, start, interval, from, last int64) int64 {
+	segment := calc.CalcSegmentTime(start + from*interval)
+	inSegment := func(idx int64) bool {
+		return calc.CalcSegmentTime(start+idx*interval) == segment
+	}
+	// gallop search, then binary search in (lo, hi]
+	lo, step := from, int64(1)
+	hi := lo + step
+	for hi <= last && inSegment(hi) {
+		lo = hi
+		step *= 2
+		hi = lo + step
+	}
+	if hi > last {
+		if inSegment(last) {
+			return -1
+		}
+		hi = last
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if inSegment(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestSplitQuery(t *testing.T) {
+	cfg := models.Database{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{
+				{Interval: timeutil.Interval(10 * timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneYear)},
+			},
+		},
+	}
+	now := timeutil.Now()
+	threshold := 24 * time.Hour
+
+	// cannot split
+	assert.Nil(t, SplitQuery(&stmt.Query{
+		TimeRange:    timeutil.TimeRange{Start: now - 3*timeutil.OneDay, End: now},
+		OrderByItems: []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+	}, cfg, threshold))
+	assert.Nil(t, SplitQuery(&stmt.Query{
+		TimeRange:       timeutil.TimeRange{Start: now - 3*timeutil.OneDay, End: now},
+		AutoGroupByTime: true,
+	}, cfg, threshold))
+	// time range too short
+	assert.Nil(t, SplitQuery(&stmt.Query{
+		TimeRange: timeutil.TimeRange{Start: now - timeutil.OneHour, End: now},
+	}, cfg, threshold))
+
+	statement := &stmt.Query{
+		MetricName: "cpu",
+		TimeRange:  timeutil.TimeRange{Start: now - 3*timeutil.OneDay, End: now},
+	}
+	subQueries := SplitQuery(statement, cfg, threshold)
+	assert.True(t, len(subQueries) >= 3)
+	// statement not changed
+	assert.Zero(t, statement.StorageInterval)
+	first := subQueries[0]
+	interval := first.Interval.Int64()
+	storageInterval := first.StorageInterval.Int64()
+	assert.Equal(t, 10*timeutil.OneMinute, interval)
+	for idx, subQuery := range subQueries {
+		assert.Equal(t, "cpu", subQuery.MetricName)
+		assert.Equal(t, first.Interval, subQuery.Interval)
+		assert.Equal(t, first.StorageInterval, subQuery.StorageInterval)
+		assert.Equal(t, first.IntervalRatio, subQuery.IntervalRatio)
+		// bucket aligned
+		assert.Zero(t, (subQuery.TimeRange.Start-first.TimeRange.Start)%interval)
+		if idx > 0 {
+			assert.Equal(t, subQueries[idx-1].TimeRange.End+storageInterval, subQuery.TimeRange.Start)
+		}
+	}
+	// sub-query keeps the planned interval
+	subQuery := *subQueries[1]
+	calcTimeRangeAndInterval(&subQuery, cfg)
+	assert.Equal(t, *subQueries[1], subQuery)
+
+	// too many segments, merge adjacent segments
+	statement = &stmt.Query{
+		TimeRange: timeutil.TimeRange{Start: now - 100*timeutil.OneDay, End: now},
+	}
+	subQueries = SplitQuery(statement, cfg, threshold)
+	assert.True(t, len(subQueries) > 1)
+	assert.True(t, len(subQueries) <= maxSubQueries)
+}
+
+func TestNextSegmentBucket(t *testing.T) {
+	calc := timeutil.Interval(10 * timeutil.OneSecond).Calculator()
+	start := calc.CalcSegmentTime(timeutil.Now())
+	interval := timeutil.OneHour
+	assert.Equal(t, int64(-1), nextSegmentBucket(calc, start, interval, 0, 10))
+	assert.Equal(t, int64(-1), nextSegmentBucket(calc, start, interval, 10, 10))
+	next := nextSegmentBucket(calc, start, interval, 0, 100)
+	assert.True(t, next > 0)
+	assert.NotEqual(t, calc.CalcSegmentTime(start), calc.CalcSegmentTime(start+next*interval))
+	assert.Equal(t, calc.CalcSegmentTime(start), calc.CalcSegmentTime(start+(next-1)*interval))
+}
@@ -163,4 +163,13 @@ func (ctx *baseTaskContext) handleTaskState(resp *protoCommonV1.TaskResponse, fr
 	} else {
 		ctx.state[fromNode] = models.Receive
 	}
+	// target node responded, report it to circuit breaker
+	ctx.reportResponse(fromNode, nil)
+}
+
+// reportResponse reports the response result of target node to circuit breaker.
+func (ctx *baseTaskContext) reportResponse(targetNodeID string, err error) {
+	if ctx.transportMgr != nil {
+		ctx.transportMgr.ReportResponse(targetNodeID, err)
+	}
 }
@@ -57,6 +57,7 @@ func TestTaskContext(t *testing.T) {
 
 	ctx.Complete(fmt.Errorf("err"))
 	ctx.Complete(fmt.Errorf("err"))
+	transportMgr.EXPECT().ReportResponse("leaf", nil).Times(2)
 	ctx.handleTaskState(&protoCommonV1.TaskResponse{Completed: false}, "leaf")
 	ctx.handleTaskState(&protoCommonV1.TaskResponse{Completed: true}, "leaf")
 }
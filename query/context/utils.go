@@ -25,6 +25,10 @@ import (
 
 // calcTimeRangeAndInterval calculates the query time range and interval based on input params and database config.
 func calcTimeRangeAndInterval(statement *stmt.Query, cfg models.Database) {
+	if statement.StorageInterval > 0 {
+		// statement already planned(sub-query of split query), keeps the interval for bucket alignment
+		return
+	}
 	option := cfg.Option
 	interval := statement.Interval
 	if interval <= 0 {
@@ -33,7 +37,17 @@ func calcTimeRangeAndInterval(statement *stmt.Query, cfg models.Database) {
 	}
 	// re-calc query interval based on query time range
 	interval = timeutil.CalcQueryInterval(statement.TimeRange, interval)
-	storageInterval := option.FindMatchSmallestInterval(interval)
+	var storageInterval timeutil.Interval
+	if option.AutoSelectInterval {
+		// honor group by time resolution of query, picks the coarsest rollup interval which satisfies it.
+		resolution := interval
+		if !statement.AutoGroupByTime && statement.Interval > resolution {
+			resolution = statement.Interval
+		}
+		storageInterval = option.FindCoarsestInterval(resolution, statement.TimeRange, timeutil.Now())
+	} else {
+		storageInterval = option.FindMatchSmallestInterval(interval)
+	}
 	intervalVal := storageInterval.Int64()
 	statement.TimeRange.Start = timeutil.Truncate(statement.TimeRange.Start, intervalVal)
 	statement.TimeRange.End = timeutil.Truncate(statement.TimeRange.End, intervalVal)
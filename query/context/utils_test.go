@@ -51,3 +51,39 @@ func Test_calcTimeRangeAndInterval(t *testing.T) {
 	calcTimeRangeAndInterval(statement, cfg)
 	assert.Equal(t, timeutil.Interval(6*timeutil.OneHour)+statement.StorageInterval, statement.Interval)
 }
+
+func Test_calcTimeRangeAndInterval_AutoSelectInterval(t *testing.T) {
+	cfg := models.Database{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{
+				{Interval: timeutil.Interval(10 * timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneMonth)},
+				{Interval: timeutil.Interval(5 * timeutil.OneMinute), Retention: timeutil.Interval(timeutil.OneMonth)},
+				{Interval: timeutil.Interval(timeutil.OneHour), Retention: timeutil.Interval(timeutil.OneYear)},
+			},
+			AutoSelectInterval: true,
+		},
+	}
+	now := timeutil.Now()
+	// 30d range + 1h buckets, reads 1h rollup
+	statement := &stmt.Query{Interval: timeutil.Interval(timeutil.OneHour)}
+	statement.TimeRange = timeutil.TimeRange{Start: now - 29*timeutil.OneDay, End: now}
+	calcTimeRangeAndInterval(statement, cfg)
+	assert.Equal(t, timeutil.Interval(timeutil.OneHour), statement.StorageInterval)
+	assert.Equal(t, timeutil.Interval(timeutil.OneHour), statement.Interval)
+	assert.Equal(t, 1, statement.IntervalRatio)
+
+	// 2h range + 30m buckets, reads 5m rollup
+	statement = &stmt.Query{Interval: timeutil.Interval(30 * timeutil.OneMinute)}
+	statement.TimeRange = timeutil.TimeRange{Start: now - 2*timeutil.OneHour, End: now}
+	calcTimeRangeAndInterval(statement, cfg)
+	assert.Equal(t, timeutil.Interval(5*timeutil.OneMinute), statement.StorageInterval)
+	assert.Equal(t, timeutil.Interval(30*timeutil.OneMinute), statement.Interval)
+	assert.Equal(t, 6, statement.IntervalRatio)
+
+	// disable auto select, reads raw data
+	cfg.Option.AutoSelectInterval = false
+	statement = &stmt.Query{Interval: timeutil.Interval(30 * timeutil.OneMinute)}
+	statement.TimeRange = timeutil.TimeRange{Start: now - 2*timeutil.OneHour, End: now}
+	calcTimeRangeAndInterval(statement, cfg)
+	assert.Equal(t, timeutil.Interval(10*timeutil.OneSecond), statement.StorageInterval)
+}
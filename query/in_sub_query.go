@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// resolveInSubQueries evaluates the sub queries(show tag values) of in expressions in query condition,
+// then replaces them with the tag values, so that storage nodes only see plain tag filters.
+func resolveInSubQueries(ctx context.Context,
+	param *models.ExecuteParam, expr stmtpkg.Expr,
+	mgr *SearchMgr,
+) error {
+	switch e := expr.(type) {
+	case *stmtpkg.InExpr:
+		if e.SubQuery == nil {
+			return nil
+		}
+		rs, err := metricMetadataSearchFn(ctx, param, e.SubQuery, mgr)
+		if err != nil {
+			return err
+		}
+		e.Values = rs.([]string)
+		e.SubQuery = nil
+	case *stmtpkg.NotExpr:
+		return resolveInSubQueries(ctx, param, e.Expr, mgr)
+	case *stmtpkg.ParenExpr:
+		return resolveInSubQueries(ctx, param, e.Expr, mgr)
+	case *stmtpkg.BinaryExpr:
+		if err := resolveInSubQueries(ctx, param, e.Left, mgr); err != nil {
+			return err
+		}
+		return resolveInSubQueries(ctx, param, e.Right, mgr)
+	}
+	return nil
+}
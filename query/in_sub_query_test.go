@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestResolveInSubQueries(t *testing.T) {
+	defer func() {
+		metricMetadataSearchFn = MetricMetadataSearch
+	}()
+	subQuery := &stmt.MetricMetadata{MetricName: "memory", Type: stmt.TagValue, TagKey: "host"}
+	newCondition := func() stmt.Expr {
+		return &stmt.BinaryExpr{
+			Left:     &stmt.NotExpr{Expr: &stmt.InExpr{Key: "host", SubQuery: subQuery}},
+			Operator: stmt.AND,
+			Right:    &stmt.ParenExpr{Expr: &stmt.InExpr{Key: "ip", Values: []string{"1.1.1.1"}}},
+		}
+	}
+
+	metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		_ *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, resolveInSubQueries(context.TODO(), &models.ExecuteParam{}, newCondition(), &SearchMgr{}))
+
+	metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		statement *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+		assert.Equal(t, subQuery, statement)
+		return []string{"h1", "h2"}, nil
+	}
+	condition := newCondition()
+	assert.NoError(t, resolveInSubQueries(context.TODO(), &models.ExecuteParam{}, condition, &SearchMgr{}))
+	assert.Equal(t, &stmt.BinaryExpr{
+		Left:     &stmt.NotExpr{Expr: &stmt.InExpr{Key: "host", Values: []string{"h1", "h2"}}},
+		Operator: stmt.AND,
+		Right:    &stmt.ParenExpr{Expr: &stmt.InExpr{Key: "ip", Values: []string{"1.1.1.1"}}},
+	}, condition)
+}
@@ -20,12 +20,15 @@ package query
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -135,10 +138,16 @@ func (p *leafTaskProcessor) processMetadataSuggest(
 	}
 	leafExecuteCtx := context.NewLeafMetadataContext(stmtQuery, db, shardIDs)
 	pipeline := newExecutePipelineFn(trackerpkg.NewStageTracker(ctx), func(err error) {
+		if leafExecuteCtx.StorageExecuteCtx != nil {
+			// release index/tag metadata snapshots of tag value suggest
+			leafExecuteCtx.StorageExecuteCtx.Release()
+		}
 		var errMsg string
+		var errCode errorpkg.Code
 		var payload []byte
 		if err != nil && !errors.Is(err, constants.ErrNotFound) {
 			errMsg = err.Error()
+			errCode = errorpkg.CodeOf(err)
 			p.statistics.MetaQueryFailures.Incr()
 		} else {
 			payload = encoding.JSONMarshal(&models.SuggestResult{Values: leafExecuteCtx.ResultSet})
@@ -149,6 +158,7 @@ func (p *leafTaskProcessor) processMetadataSuggest(
 			RequestID:   req.RequestID,
 			Completed:   true,
 			ErrMsg:      errMsg,
+			ErrCode:     int32(errCode),
 			SendTime:    timeutil.NowNano(),
 			Payload:     payload,
 		}); err != nil {
@@ -179,9 +189,12 @@ func (p *leafTaskProcessor) processDataSearch(
 	tracker := trackerpkg.NewStageTracker(ctx)
 	leafExecuteCtx := context.NewLeafExecuteContext(ctx, tracker, &stmtQuery, req, p.taskServerFactory, leafNode, receivers, db)
 
+	startTime := time.Now()
 	pipeline := newExecutePipelineFn(tracker, func(err error) {
 		// remove pipeline from cache after execute completed
 		defer GetPipelineManager().RemovePipeline(req.RequestID)
+		// feedback query latency, compaction is throttled if query latency SLO breached
+		kv.ObserveQueryLatency(time.Since(startTime))
 
 		leafExecuteCtx.SendResponse(err)
 	})
@@ -0,0 +1,195 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	singleMetricDataSearchFn = singleMetricDataSearch
+)
+
+// multiMetricDataSearch executes the query with metric name pattern(like: from 'cpu.*'):
+// 1) matches metric names by pattern from metric metadata
+// 2) executes the query of each matched metric in parallel
+// 3) merges the results of all matched metrics, segregated by synthetic tag(__metric__)
+func multiMetricDataSearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
+) (any, error) {
+	metricNames, err := matchMetricNames(ctx, param, statement, mgr)
+	if err != nil {
+		return nil, err
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg          sync.WaitGroup
+		once        sync.Once
+		firstErr    error
+		notFoundErr atomic.Error
+		notFounds   atomic.Int32
+	)
+	results := make([]*models.ResultSet, len(metricNames))
+	for idx := range metricNames {
+		idx := idx
+		subQuery := *statement
+		subQuery.MetricName = metricNames[idx]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rs, err0 := singleMetricDataSearchFn(subCtx, param, &subQuery, mgr)
+			if err0 != nil {
+				if strings.Contains(err0.Error(), "not found") {
+					// no data of matched metric in query time range
+					notFounds.Inc()
+					notFoundErr.Store(err0)
+					return
+				}
+				once.Do(func() {
+					firstErr = err0
+					// fail fast, cancel other metric queries
+					cancel()
+				})
+				return
+			}
+			results[idx], _ = rs.(*models.ResultSet)
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if int(notFounds.Load()) == len(metricNames) {
+		return nil, notFoundErr.Load()
+	}
+	return mergeMetricResultSets(statement, metricNames, results), nil
+}
+
+// matchMetricNames returns the metric names(sorted) which match the metric name pattern of query,
+// candidates are the metric names with the literal prefix of pattern.
+func matchMetricNames(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
+) ([]string, error) {
+	pattern, err := statement.MetricNameRegexp()
+	if err != nil {
+		return nil, err
+	}
+	prefix, _ := pattern.LiteralPrefix()
+	rs, err := metricMetadataSearchFn(ctx, param, &stmtpkg.MetricMetadata{
+		Namespace: statement.Namespace,
+		Type:      stmtpkg.Metric,
+		Prefix:    prefix,
+		Limit:     constants.MaxSuggestions,
+	}, mgr)
+	if err != nil {
+		return nil, err
+	}
+	candidates, _ := rs.([]string)
+	matched := make(map[string]struct{})
+	var metricNames []string
+	for _, metricName := range candidates {
+		if _, ok := matched[metricName]; ok || !pattern.MatchString(metricName) {
+			continue
+		}
+		matched[metricName] = struct{}{}
+		metricNames = append(metricNames, metricName)
+	}
+	if len(metricNames) == 0 {
+		return nil, constants.ErrMetricIDNotFound
+	}
+	if len(metricNames) > constants.MaxMatchedMetrics {
+		return nil, constants.ErrTooManyMetricsMatched
+	}
+	sort.Strings(metricNames)
+	return metricNames, nil
+}
+
+// mergeMetricResultSets merges the results of matched metrics, adds metric name as synthetic tag(__metric__) for each series.
+func mergeMetricResultSets(statement *stmtpkg.Query, metricNames []string, results []*models.ResultSet) *models.ResultSet {
+	resultSet := &models.ResultSet{
+		MetricName: statement.MetricName,
+	}
+	groupByKeys := make(map[string]struct{})
+	fieldsMap := make(map[string]struct{})
+	fieldMetas := make(map[string]models.FieldMeta)
+	for idx, rs := range results {
+		if rs == nil {
+			continue
+		}
+		if resultSet.Interval == 0 {
+			resultSet.StartTime = rs.StartTime
+			resultSet.EndTime = rs.EndTime
+			resultSet.Interval = rs.Interval
+		}
+		for _, tagKey := range rs.GroupBy {
+			groupByKeys[tagKey] = struct{}{}
+		}
+		for _, fieldName := range rs.Fields {
+			fieldsMap[fieldName] = struct{}{}
+		}
+		for _, fieldMeta := range rs.FieldMetas {
+			fieldMetas[fieldMeta.Name] = fieldMeta
+		}
+		for cluster, errMsg := range rs.PartialFailures {
+			if resultSet.PartialFailures == nil {
+				resultSet.PartialFailures = make(map[string]string)
+			}
+			resultSet.PartialFailures[cluster] = errMsg
+		}
+		metricName := metricNames[idx]
+		for _, series := range rs.Series {
+			if series.Tags == nil {
+				series.Tags = make(map[string]string)
+			}
+			series.Tags[constants.MetricNameTagKey] = metricName
+			series.TagValues = metricName + "," + series.TagValues
+			resultSet.AddSeries(series)
+		}
+	}
+	resultSet.GroupBy = append(resultSet.GroupBy, constants.MetricNameTagKey)
+	for tagKey := range groupByKeys {
+		resultSet.GroupBy = append(resultSet.GroupBy, tagKey)
+	}
+	sort.Strings(resultSet.GroupBy[1:])
+	for fieldName := range fieldsMap {
+		resultSet.Fields = append(resultSet.Fields, fieldName)
+	}
+	sort.Strings(resultSet.Fields)
+	for _, fieldMeta := range fieldMetas {
+		resultSet.FieldMetas = append(resultSet.FieldMetas, fieldMeta)
+	}
+	sort.Slice(resultSet.FieldMetas, func(i, j int) bool {
+		return resultSet.FieldMetas[i].Name < resultSet.FieldMetas[j].Name
+	})
+	return resultSet
+}
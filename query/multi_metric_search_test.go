@@ -0,0 +1,147 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestMultiMetricDataSearch(t *testing.T) {
+	defer func() {
+		metricMetadataSearchFn = MetricMetadataSearch
+		singleMetricDataSearchFn = singleMetricDataSearch
+	}()
+	metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		_ *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+		return []string{"cpu.user", "cpu.sys", "memory", "cpu.user"}, nil
+	}
+	statement := &stmt.Query{MetricName: "cpu.*", GroupBy: []string{"host"}}
+
+	t.Run("query metric failure", func(t *testing.T) {
+		singleMetricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			q *stmt.Query, _ *SearchMgr) (any, error) {
+			if q.MetricName == "cpu.sys" {
+				return nil, fmt.Errorf("err")
+			}
+			return &models.ResultSet{}, nil
+		}
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, &SearchMgr{})
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+	t.Run("all metrics not found", func(t *testing.T) {
+		singleMetricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmt.Query, _ *SearchMgr) (any, error) {
+			return nil, constants.ErrMetricIDNotFound
+		}
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, &SearchMgr{})
+		assert.ErrorIs(t, err, constants.ErrNotFound)
+		assert.Nil(t, rs)
+	})
+	t.Run("query successfully", func(t *testing.T) {
+		singleMetricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			q *stmt.Query, _ *SearchMgr) (any, error) {
+			if q.MetricName == "cpu.user" {
+				return nil, constants.ErrMetricIDNotFound
+			}
+			assert.Equal(t, []string{"host"}, q.GroupBy)
+			series := models.NewSeries(map[string]string{"host": "h1"}, "h1")
+			series.AddField("f", &models.Points{Points: map[int64]float64{10: 1}})
+			return &models.ResultSet{
+				MetricName: q.MetricName,
+				GroupBy:    q.GroupBy,
+				Fields:     []string{"f"},
+				FieldMetas: []models.FieldMeta{{Name: "f", Type: "sum"}},
+				Interval:   10,
+				Series:     []*models.Series{series},
+			}, nil
+		}
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, &SearchMgr{})
+		assert.NoError(t, err)
+		resultSet := rs.(*models.ResultSet)
+		assert.Equal(t, "cpu.*", resultSet.MetricName)
+		assert.Equal(t, []string{constants.MetricNameTagKey, "host"}, resultSet.GroupBy)
+		assert.Equal(t, []string{"f"}, resultSet.Fields)
+		assert.Equal(t, []models.FieldMeta{{Name: "f", Type: "sum"}}, resultSet.FieldMetas)
+		assert.Equal(t, int64(10), resultSet.Interval)
+		assert.Len(t, resultSet.Series, 1)
+		assert.Equal(t, map[string]string{"host": "h1", constants.MetricNameTagKey: "cpu.sys"}, resultSet.Series[0].Tags)
+	})
+}
+
+func TestMatchMetricNames(t *testing.T) {
+	defer func() {
+		metricMetadataSearchFn = MetricMetadataSearch
+	}()
+	param := &models.ExecuteParam{}
+	t.Run("invalid pattern", func(t *testing.T) {
+		names, err := matchMetricNames(context.TODO(), param, &stmt.Query{MetricName: "cpu.(*"}, &SearchMgr{})
+		assert.Error(t, err)
+		assert.Nil(t, names)
+	})
+	t.Run("search metric names failure", func(t *testing.T) {
+		metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+			return nil, fmt.Errorf("err")
+		}
+		names, err := matchMetricNames(context.TODO(), param, &stmt.Query{MetricName: "cpu.*"}, &SearchMgr{})
+		assert.Error(t, err)
+		assert.Nil(t, names)
+	})
+	t.Run("metric not matched", func(t *testing.T) {
+		metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+			return []string{"cpu"}, nil
+		}
+		names, err := matchMetricNames(context.TODO(), param, &stmt.Query{MetricName: "cpu.+"}, &SearchMgr{})
+		assert.Equal(t, constants.ErrMetricIDNotFound, err)
+		assert.Nil(t, names)
+	})
+	t.Run("too many metrics matched", func(t *testing.T) {
+		metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+			var names []string
+			for i := 0; i <= constants.MaxMatchedMetrics; i++ {
+				names = append(names, fmt.Sprintf("cpu.%d", i))
+			}
+			return names, nil
+		}
+		names, err := matchMetricNames(context.TODO(), param, &stmt.Query{MetricName: "cpu.*"}, &SearchMgr{})
+		assert.Equal(t, constants.ErrTooManyMetricsMatched, err)
+		assert.Nil(t, names)
+	})
+	t.Run("match metric names", func(t *testing.T) {
+		metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			statement *stmt.MetricMetadata, _ *SearchMgr) (any, error) {
+			assert.Equal(t, "cpu.", statement.Prefix)
+			assert.Equal(t, stmt.Metric, statement.Type)
+			return []string{"cpu.user", "cpu.sys", "cpu.idle", "cpu.sys"}, nil
+		}
+		names, err := matchMetricNames(context.TODO(), param, &stmt.Query{MetricName: "cpu\\.(user|sys)"}, &SearchMgr{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cpu.sys", "cpu.user"}, names)
+	})
+}
@@ -21,15 +21,18 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/lindb/roaring"
-
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
 )
 
+// blockDecodeThreshold represents the min slot range which decodes field data by block.
+const blockDecodeThreshold = 32
+
 // dataLoad represents load data operator by grouping context.
 type dataLoad struct {
 	executeCtx *flow.DataLoadContext
@@ -54,12 +57,29 @@ func NewDataLoad(executeCtx *flow.DataLoadContext,
 func (op *dataLoad) Execute() error {
 	defer op.executeCtx.PendingDataLoadTasks.Dec()
 
+	if err := op.executeCtx.Err(); err != nil {
+		// query timeout, skip data load
+		return err
+	}
 	seriesIDs := op.executeCtx.ShardExecuteCtx.SeriesIDsAfterFiltering // after group result
 	// double filtering, maybe some series ids be filtered out when do grouping.
 	// filter logic: forward_reader.go -> GetGroupingScanner
-	if roaring.FastAnd(seriesIDs, op.rs.SeriesIDs()).IsEmpty() {
+	if !seriesIDs.Intersects(op.rs.SeriesIDs()) {
 		return nil
 	}
+	// collects tag value ids of count distinct tag keys for the series which has data
+	distinct := flow.NewDistinctCollector(op.executeCtx)
+	if distinct != nil {
+		defer distinct.Flush()
+	}
+	key, cacheable := op.resultKey()
+	if cacheable {
+		if result, ok := resultCache.Get(key); ok {
+			// file not changed, replays the cached down sampling result
+			op.replay(result, distinct)
+			return nil
+		}
+	}
 	loader := op.rs.Load(op.executeCtx)
 	if loader == nil {
 		// maybe return nil loader
@@ -73,15 +93,71 @@ func (op *dataLoad) Execute() error {
 
 	// load field series data by series ids
 	op.executeCtx.Decoder = encoding.GetTSDDecoder()
+	block := encoding.GetTSDBlock()
+	var (
+		result   []*SeriesResult
+		slotBase int
+	)
+	if cacheable {
+		slotBase = op.slotBase()
+	}
 	op.executeCtx.DownSampling = func(slotRange timeutil.SlotRange, lowSeriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
 		seriesAggregator := op.executeCtx.GetSeriesAggregator(lowSeriesIdx, fieldIdx)
 
 		agg := seriesAggregator.GetAggregator(familyTime)
 		op.foundSeries++
+		emitValue := agg.AggregateBySlot
+		if cacheable {
+			// record down sampling result for caching
+			seriesResult := &SeriesResult{LowSeriesID: op.executeCtx.MinSeriesID + lowSeriesIdx, FieldIdx: fieldIdx}
+			result = append(result, seriesResult)
+			emitValue = func(slot int, value float64) {
+				agg.AggregateBySlot(slot, value)
+				seriesResult.Slots = append(seriesResult.Slots, slot-slotBase)
+				seriesResult.Values = append(seriesResult.Values, value)
+			}
+		}
+		if distinct != nil {
+			aggregateBySlot := emitValue
+			emitValue = func(slot int, value float64) {
+				aggregateBySlot(slot, value)
+				distinct.Collect(lowSeriesIdx, slot)
+			}
+		}
+		if decoder, ok := getter.(*encoding.TSDDecoder); ok && int(slotRange.End-slotRange.Start) >= blockDecodeThreshold {
+			// wide range scan, decodes the whole block at once, then aggregates the plain values.
+			decoder.DecodeBlock(block)
+			aggregation.DownSamplingBlock(
+				slotRange, targetSlotRange, queryIntervalRatio, baseSlot,
+				block,
+				emitValue,
+			)
+			return
+		}
 		aggregation.DownSampling(
 			slotRange, targetSlotRange, queryIntervalRatio, baseSlot,
 			getter,
-			agg.AggregateBySlot,
+			emitValue,
+		)
+	}
+
+	op.executeCtx.LoadSketch = func(slotRange timeutil.SlotRange, lowSeriesIdx uint16, fieldIdx int, sketches *ddsketch.SlotSketches) {
+		sketchAgg, ok := op.executeCtx.GetSeriesAggregator(lowSeriesIdx, fieldIdx).(aggregation.SketchAggregator)
+		if !ok {
+			return
+		}
+		op.foundSeries++
+		emitSketch := sketchAgg.AddSketch
+		if distinct != nil {
+			emitSketch = func(slot int, sketch *ddsketch.Sketch) {
+				sketchAgg.AddSketch(slot, sketch)
+				distinct.Collect(lowSeriesIdx, slot)
+			}
+		}
+		aggregation.DownSamplingSketches(
+			slotRange, targetSlotRange, queryIntervalRatio, baseSlot,
+			sketches,
+			emitSketch,
 		)
 	}
 
@@ -90,9 +166,71 @@ func (op *dataLoad) Execute() error {
 	loader.Load(op.executeCtx)
 	// release tsd decoder back to pool for re-use.
 	encoding.ReleaseTSDDecoder(op.executeCtx.Decoder)
+	encoding.ReleaseTSDBlock(block)
+	// maybe loading stopped because of query timeout
+	if err := op.executeCtx.Err(); err != nil {
+		return err
+	}
+	if cacheable {
+		resultCache.Add(key, result)
+	}
 	return nil
 }
 
+// resultKey returns the key of result cache, returns false if the result cannot be cached(memory database).
+func (op *dataLoad) resultKey() (key ResultKey, ok bool) {
+	if resultCache == nil {
+		return key, false
+	}
+	rs, ok := op.rs.(flow.CacheableResultSet)
+	if !ok {
+		return key, false
+	}
+	for _, f := range op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.Fields {
+		if f.Type == field.SketchField {
+			// cached result only records float values
+			return key, false
+		}
+	}
+	return ResultKey{
+		Query:      op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.QueryShape(),
+		File:       rs.File(),
+		HighKey:    op.executeCtx.SeriesIDHighKey,
+		Target:     op.segmentRS.Target,
+		SlotOffset: op.segmentRS.BaseSlot % int(op.segmentRS.IntervalRatio),
+	}, true
+}
+
+// slotBase returns the target slot of family start time,
+// target slot = (base slot + source slot) / ratio = base slot / ratio + (slot offset + source slot) / ratio.
+func (op *dataLoad) slotBase() int {
+	return op.segmentRS.BaseSlot / int(op.segmentRS.IntervalRatio)
+}
+
+// replay aggregates the cached down sampling result of the series which current query needs.
+func (op *dataLoad) replay(result []*SeriesResult, distinct *flow.DistinctCollector) {
+	familyTime := op.segmentRS.FamilyTime
+	slotBase := op.slotBase()
+	for _, seriesResult := range result {
+		lowSeriesID := seriesResult.LowSeriesID
+		if lowSeriesID < op.executeCtx.MinSeriesID || lowSeriesID > op.executeCtx.MaxSeriesID {
+			continue
+		}
+		lowSeriesIdx := lowSeriesID - op.executeCtx.MinSeriesID
+		if op.executeCtx.LowSeriesIDs[lowSeriesIdx] != lowSeriesID {
+			continue
+		}
+		agg := op.executeCtx.GetSeriesAggregator(lowSeriesIdx, seriesResult.FieldIdx).GetAggregator(familyTime)
+		op.foundSeries++
+		for idx, slot := range seriesResult.Slots {
+			agg.AggregateBySlot(slot+slotBase, seriesResult.Values[idx])
+			if distinct != nil {
+				distinct.Collect(lowSeriesIdx, slot+slotBase)
+			}
+		}
+	}
+}
+
 // Identifier returns identifier value of data load operator.
 func (op *dataLoad) Identifier() string {
 	identifiers := strings.Split(op.rs.Identifier(), "segment")
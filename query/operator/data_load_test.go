@@ -18,7 +18,9 @@
 package operator
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -86,6 +88,158 @@ func TestDataLoader_Execute(t *testing.T) {
 	})
 }
 
+type cacheableResultSet struct {
+	*flow.MockFilterResultSet
+	file string
+}
+
+func (rs *cacheableResultSet) File() string {
+	return rs.file
+}
+
+func TestDataLoader_Execute_ResultCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		SetResultCache(nil)
+		ctrl.Finish()
+	}()
+	SetResultCache(NewResultCache(1024 * 1024))
+
+	rs := &cacheableResultSet{MockFilterResultSet: flow.NewMockFilterResultSet(ctrl), file: "family/000001.sst"}
+	rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2)).AnyTimes()
+	ctx := &flow.DataLoadContext{
+		PendingDataLoadTasks: atomic.NewInt32(0),
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{
+					MetricName:    "cpu",
+					Interval:      1,
+					IntervalRatio: 1.0,
+				},
+				DownSamplingSpecs: aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
+		},
+		MinSeriesID:  1,
+		MaxSeriesID:  2,
+		LowSeriesIDs: []uint16{1, 2},
+	}
+	ctx.PrepareAggregatorWithoutGrouping()
+	agg := aggregation.NewMockSeriesAggregator(ctrl)
+	ctx.WithoutGroupingSeriesAgg.Aggregator = agg
+	segment := &flow.TimeSegmentResultSet{
+		FilterRS:      []flow.FilterResultSet{rs},
+		IntervalRatio: 1,
+		BaseSlot:      10,
+		Target:        timeutil.SlotRange{Start: 5, End: 5},
+	}
+	fAgg := aggregation.NewMockFieldAggregator(ctrl)
+	agg.EXPECT().GetAggregator(gomock.Any()).Return(fAgg).AnyTimes()
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(gomock.Any()).Return(5.0, true).AnyTimes()
+	load := func() {
+		loader := flow.NewMockDataLoader(ctrl)
+		rs.EXPECT().Load(gomock.Any()).Return(loader)
+		loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+			ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 1, 0, getter)
+		})
+	}
+
+	// load from storage, then cache the result
+	load()
+	fAgg.EXPECT().AggregateBySlot(15, 5.0)
+	assert.NoError(t, NewDataLoad(ctx, segment, rs).Execute())
+	// replay cached result
+	fAgg.EXPECT().AggregateBySlot(15, 5.0)
+	op := NewDataLoad(ctx, segment, rs)
+	assert.NoError(t, op.Execute())
+	assert.Equal(t, uint64(1), op.(*dataLoad).foundSeries)
+	// series not in query, skip it
+	ctx.LowSeriesIDs = []uint16{1, 0}
+	op = NewDataLoad(ctx, segment, rs)
+	assert.NoError(t, op.Execute())
+	assert.Zero(t, op.(*dataLoad).foundSeries)
+	ctx.LowSeriesIDs = []uint16{1, 2}
+	// file removed, load from storage again
+	resultCache.Invalidate("family/000001.sst")
+	load()
+	fAgg.EXPECT().AggregateBySlot(15, 5.0)
+	assert.NoError(t, NewDataLoad(ctx, segment, rs).Execute())
+	// query canceled when loading, result not cached
+	resultCache.Invalidate("family/000001.sst")
+	taskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx = taskCtx
+	loader := flow.NewMockDataLoader(ctrl)
+	rs.EXPECT().Load(gomock.Any()).Return(loader)
+	loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 1, 0, getter)
+		taskCtx.Cancel()
+	})
+	fAgg.EXPECT().AggregateBySlot(15, 5.0)
+	assert.Error(t, NewDataLoad(ctx, segment, rs).Execute())
+	ctx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx = nil
+	load()
+	fAgg.EXPECT().AggregateBySlot(15, 5.0)
+	assert.NoError(t, NewDataLoad(ctx, segment, rs).Execute())
+}
+
+func TestDataLoader_Execute_Distinct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		SetResultCache(nil)
+		ctrl.Finish()
+	}()
+	SetResultCache(NewResultCache(1024 * 1024))
+
+	rs := &cacheableResultSet{MockFilterResultSet: flow.NewMockFilterResultSet(ctrl), file: "family/000002.sst"}
+	rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2)).AnyTimes()
+	ctx := &flow.DataLoadContext{
+		PendingDataLoadTasks: atomic.NewInt32(0),
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{
+					MetricName:    "cpu",
+					Interval:      1,
+					IntervalRatio: 1.0,
+				},
+				DownSamplingSpecs: aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
+		},
+		MinSeriesID:         1,
+		MaxSeriesID:         2,
+		LowSeriesIDs:        []uint16{1, 2},
+		DistinctTagValueIDs: [][]uint32{{0, 7}},
+	}
+	ctx.PrepareAggregatorWithoutGrouping()
+	segment := &flow.TimeSegmentResultSet{
+		FilterRS:      []flow.FilterResultSet{rs},
+		IntervalRatio: 1,
+		BaseSlot:      10,
+		Target:        timeutil.SlotRange{Start: 5, End: 5},
+	}
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(gomock.Any()).Return(5.0, true).AnyTimes()
+	loader := flow.NewMockDataLoader(ctrl)
+	rs.EXPECT().Load(gomock.Any()).Return(loader)
+	loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter)
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 1, 0, getter)
+	})
+	reduce := func() (rs []flow.DistinctTagValueIDs) {
+		ctx.ReduceDistinct(func(_ string, distincts []flow.DistinctTagValueIDs) {
+			rs = distincts
+		})
+		return
+	}
+	// load from storage, collects the tag value of series which has data
+	assert.NoError(t, NewDataLoad(ctx, segment, rs).Execute())
+	assert.Equal(t, []flow.DistinctTagValueIDs{{15: roaring.BitmapOf(7)}}, reduce())
+	// replay cached result
+	assert.NoError(t, NewDataLoad(ctx, segment, rs).Execute())
+	assert.Equal(t, []flow.DistinctTagValueIDs{{15: roaring.BitmapOf(7)}}, reduce())
+}
+
 func TestDataLoad_Stats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -20,6 +20,7 @@ package operator
 import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/field"
 )
 
 // fieldSuggest represents field suggest operator.
@@ -37,7 +38,17 @@ func NewFieldSuggest(ctx *context.LeafMetadataContext) Operator {
 // Execute returns all fields by given metric.
 func (op *fieldSuggest) Execute() error {
 	req := op.ctx.Request
-	fields, err := op.ctx.Database.Metadata().MetadataDatabase().GetAllFields(req.Namespace, req.MetricName)
+	var (
+		fields field.Metas
+		err    error
+	)
+	metadata := op.ctx.Database.Metadata().MetadataDatabase()
+	if req.Timestamp > 0 {
+		// get fields as of the timestamp
+		fields, err = metadata.GetAllFieldsAt(req.Namespace, req.MetricName, req.Timestamp)
+	} else {
+		fields, err = metadata.GetAllFields(req.Namespace, req.MetricName)
+	}
 	if err != nil {
 		return err
 	}
@@ -64,6 +64,14 @@ func TestFieldSuggest_Execute(t *testing.T) {
 					Return(field.Metas{{}}, nil)
 			},
 		},
+		{
+			name: "find fields as of timestamp",
+			prepare: func() {
+				ctx.Request.Timestamp = 100
+				metaDB.EXPECT().GetAllFieldsAt(gomock.Any(), gomock.Any(), int64(100)).
+					Return(field.Metas{{}}, nil)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -41,7 +41,8 @@ func (op *groupingContextBuild) Execute() error {
 	if op.executeCtx.IsSeriesIDsEmpty() {
 		return nil
 	}
-	return op.shard.IndexDatabase().GetGroupingContext(op.executeCtx)
+	// grouping reads the same index snapshot as series filtering
+	return op.executeCtx.IndexSnapshot(op.shard.IndexDatabase().GetSnapshot).GetGroupingContext(op.executeCtx)
 }
 
 // Identifier returns identifier string value of grouping context build operator.
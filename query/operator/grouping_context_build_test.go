@@ -34,8 +34,11 @@ func TestGroupingContextBuild_Execute(t *testing.T) {
 
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexSnapshot := flow.NewMockIndexSnapshot(ctrl)
+	indexSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	indexDB.EXPECT().GetSnapshot().Return(indexSnapshot).AnyTimes()
 	shard.EXPECT().IndexDatabase().Return(indexDB)
-	indexDB.EXPECT().GetGroupingContext(gomock.Any()).Return(nil)
+	indexSnapshot.EXPECT().GetGroupingContext(gomock.Any()).Return(nil)
 	ctx := flow.NewShardExecuteContext(nil)
 	ctx.TimeSegmentContext.SeriesIDs.Add(1)
 	op := NewGroupingContextBuild(ctx, shard)
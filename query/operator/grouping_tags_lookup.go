@@ -34,12 +34,17 @@ func NewGroupingTagsLookup(executeCtx *flow.DataLoadContext) Operator {
 // Execute executes grouping tag value ids lookup, if it hasn't grouping tag key returns no grouping.
 func (op *groupingTagsLookup) Execute() error {
 	op.executeCtx.Grouping()
-	if op.executeCtx.ShardExecuteCtx.GroupingContext != nil {
+	groupingCtx := op.executeCtx.ShardExecuteCtx.GroupingContext
+	if groupingCtx != nil && op.executeCtx.IsGrouping {
 		// lookup grouping tags, grouped series: tags => series IDs(based on low series ids)
-		op.executeCtx.ShardExecuteCtx.GroupingContext.BuildGroup(op.executeCtx)
+		groupingCtx.BuildGroup(op.executeCtx)
 	} else {
 		op.executeCtx.PrepareAggregatorWithoutGrouping()
 	}
+	if groupingCtx != nil {
+		// lookup tag value ids of count distinct tag keys for each series
+		groupingCtx.BuildDistinct(op.executeCtx)
+	}
 	return nil
 }
 
@@ -41,6 +41,7 @@ func (op *leafReduce) Execute() error {
 	if op.executeCtx.PendingDataLoadTasks.Load() == 0 {
 		// after load, need to reduce the aggregator's result to query flow.
 		op.executeCtx.Reduce(op.leafExecuteCtx.ReduceCtx.Reduce)
+		op.executeCtx.ReduceDistinct(op.leafExecuteCtx.ReduceCtx.ReduceDistinct)
 	}
 	return nil
 }
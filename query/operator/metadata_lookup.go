@@ -19,6 +19,7 @@ package operator
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/lindb/roaring"
@@ -73,13 +74,35 @@ func (op *metadataLookup) Execute() error {
 	if err := op.selectList(); err != nil {
 		return err
 	}
+	if err := op.distinctTagKeys(); err != nil {
+		return err
+	}
+	if err := op.distinctField(); err != nil {
+		return err
+	}
 
 	op.buildField()
+	op.recordFieldUsage()
 	return nil
 }
 
+// recordFieldUsage records the fields accessed by query, used for finding never queried fields.
+func (op *metadataLookup) recordFieldUsage() {
+	fields := make([]field.Name, len(op.executeCtx.Fields))
+	for idx := range op.executeCtx.Fields {
+		fields[idx] = op.executeCtx.Fields[idx].Name
+	}
+	query := op.executeCtx.Query
+	op.database.FieldUsage().Record(query.Namespace, query.MetricName, fields)
+}
+
 // groupBy parses group by tag keys
 func (op *metadataLookup) groupBy() error {
+	if op.executeCtx.Query.GroupByAll {
+		if err := op.groupByAll(); err != nil {
+			return err
+		}
+	}
 	groupBy := op.executeCtx.Query.GroupBy
 	lengthOfGroupByTagKeys := len(groupBy)
 	if lengthOfGroupByTagKeys == 0 {
@@ -107,6 +130,33 @@ func (op *metadataLookup) groupBy() error {
 	return nil
 }
 
+// groupByAll resolves all tag keys of metric from the stored schema for group by *,
+// the resolved tag keys(sorted by name) are put before the tag keys which are grouped by already.
+func (op *metadataLookup) groupByAll() error {
+	queryStmt := op.executeCtx.Query
+	tagKeys, err := op.metadata.GetAllTagKeys(queryStmt.Namespace, queryStmt.MetricName)
+	if err != nil {
+		return err
+	}
+	exist := make(map[string]struct{})
+	for _, tagKey := range queryStmt.GroupBy {
+		exist[tagKey] = struct{}{}
+	}
+	var groupBy []string
+	for _, tagKey := range tagKeys {
+		if _, ok := exist[tagKey.Key]; !ok {
+			groupBy = append(groupBy, tagKey.Key)
+		}
+	}
+	sort.Strings(groupBy)
+	queryStmt.GroupBy = append(groupBy, queryStmt.GroupBy...)
+	if len(queryStmt.GroupBy) == 0 {
+		// metric without tags, query it as non-grouping query
+		queryStmt.GroupByAll = false
+	}
+	return nil
+}
+
 // getDownSamplingAggSpecs returns the down sampling aggregate specs.
 func (op *metadataLookup) buildField() {
 	lengthOfFields := len(op.fields)
@@ -143,6 +193,10 @@ func (op *metadataLookup) selectList() error {
 			return err
 		}
 		for _, fieldMeta := range fields {
+			if fieldMeta.Type == field.HistogramField || fieldMeta.Type == field.SketchField {
+				// exclude histogram internal fields(buckets/sketch), only planned if requested explicitly
+				continue
+			}
 			op.planField(nil, fieldMeta)
 		}
 		return nil
@@ -170,9 +224,13 @@ func (op *metadataLookup) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 	case *stmt.SelectItem:
 		op.field(nil, e.Expr)
 	case *stmt.CallExpr:
-		if e.FuncType == function.Quantile {
+		switch e.FuncType {
+		case function.Quantile:
 			op.planHistogramFields(e)
 			return
+		case function.CountDistinct:
+			// param is tag key, resolved as distinct tag key
+			return
 		}
 		for _, param := range e.Params {
 			op.field(e, param)
@@ -228,6 +286,48 @@ func (op *metadataLookup) planField(parentFunc *stmt.CallExpr, fieldMeta field.M
 	aggregator.DownSampling.AddFunctionType(funcType)
 }
 
+// distinctTagKeys parses the tag keys of count distinct function.
+func (op *metadataLookup) distinctTagKeys() error {
+	queryStmt := op.executeCtx.Query
+	for _, tagKey := range op.executeCtx.DistinctTagKeys {
+		tagKeyID, err := op.metadata.GetTagKeyID(queryStmt.Namespace, queryStmt.MetricName, tagKey)
+		if err != nil {
+			return err
+		}
+		op.executeCtx.DistinctTagKeyIDs = append(op.executeCtx.DistinctTagKeyIDs, tagKeyID)
+		// cache tag keys in context
+		op.executeCtx.TagKeys[tagKey] = tagKeyID
+	}
+	return nil
+}
+
+// distinctField plans all fields of metric for finding the series which has data,
+// because count distinct need to know which tag values have data for each time slot.
+func (op *metadataLookup) distinctField() error {
+	if len(op.executeCtx.DistinctTagKeys) == 0 {
+		return nil
+	}
+	queryStmt := op.executeCtx.Query
+	fields, err := op.metadata.GetAllFields(queryStmt.Namespace, queryStmt.MetricName)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return constants.ErrEmptySelectList
+	}
+	for _, fieldMeta := range fields {
+		if _, ok := op.fields[fieldMeta.ID]; ok {
+			// field planned by select list
+			continue
+		}
+		op.planField(nil, fieldMeta)
+		if op.err != nil {
+			return op.err
+		}
+	}
+	return nil
+}
+
 func (op *metadataLookup) planHistogramFields(e *stmt.CallExpr) {
 	if len(e.Params) != 1 {
 		op.err = fmt.Errorf("qunantile params more than one")
@@ -44,6 +44,8 @@ func TestMetadataLookup_Execute(t *testing.T) {
 	metaDB := metadb.NewMockMetadataDatabase(ctrl)
 	db.EXPECT().Metadata().Return(meta).AnyTimes()
 	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	fieldUsage := tsdb.NewMockFieldUsageTracker(ctrl)
+	db.EXPECT().FieldUsage().Return(fieldUsage).AnyTimes()
 
 	ctx := &flow.StorageExecuteContext{
 		Query: &stmtpkg.Query{},
@@ -84,6 +86,7 @@ func TestMetadataLookup_Execute(t *testing.T) {
 			Type: field.SumField,
 			Name: "f",
 		}, nil)
+		fieldUsage.EXPECT().Record(gomock.Any(), gomock.Any(), []field.Name{"f"})
 		assert.NoError(t, op.Execute())
 	})
 	t.Run("get all fields failure", func(t *testing.T) {
@@ -102,10 +105,64 @@ func TestMetadataLookup_Execute(t *testing.T) {
 			Type: field.SumField,
 			Name: "f",
 		}}, nil)
+		fieldUsage.EXPECT().Record(gomock.Any(), gomock.Any(), []field.Name{"f"})
 		assert.NoError(t, op.Execute())
 	})
 }
 
+func TestMetadataLookup_distinctField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	newOp := func() *metadataLookup {
+		return &metadataLookup{
+			executeCtx: &flow.StorageExecuteContext{
+				Query:           &stmtpkg.Query{},
+				DistinctTagKeys: []string{"host"},
+			},
+			metadata: metaDB,
+			fields:   make(map[field.ID]*aggregation.Aggregator),
+		}
+	}
+	op := newOp()
+	op.field(nil, &stmtpkg.CallExpr{FuncType: function.CountDistinct, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "host"}}})
+	assert.NoError(t, op.err)
+	assert.Empty(t, op.fields)
+
+	metaDB.EXPECT().GetAllFields(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, op.distinctField())
+	metaDB.EXPECT().GetAllFields(gomock.Any(), gomock.Any()).Return(nil, nil)
+	assert.Error(t, op.distinctField())
+	metaDB.EXPECT().GetAllFields(gomock.Any(), gomock.Any()).Return(field.Metas{{ID: 1, Type: field.SumField, Name: "f"}}, nil)
+	assert.NoError(t, op.distinctField())
+	assert.Len(t, op.fields, 1)
+	// plans all fields, keeps planned select field
+	metaDB.EXPECT().GetAllFields(gomock.Any(), gomock.Any()).Return(field.Metas{
+		{ID: 1, Type: field.SumField, Name: "f"},
+		{ID: 2, Type: field.MaxField, Name: "f2"},
+		{ID: 3, Type: field.HistogramField, Name: "__bucket_1"},
+	}, nil)
+	assert.NoError(t, op.distinctField())
+	assert.Len(t, op.fields, 3)
+	assert.Len(t, op.fields[1].Aggregator.Functions(), 1)
+	metaDB.EXPECT().GetAllFields(gomock.Any(), gomock.Any()).Return(field.Metas{{ID: 4, Type: field.Unknown, Name: "f4"}}, nil)
+	assert.Error(t, op.distinctField())
+
+	// distinct tag keys
+	metaDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "host").Return(tag.KeyID(0), fmt.Errorf("err"))
+	assert.Error(t, op.distinctTagKeys())
+	metaDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), "host").Return(tag.KeyID(5), nil)
+	op = newOp()
+	op.executeCtx.TagKeys = make(map[string]tag.KeyID)
+	assert.NoError(t, op.distinctTagKeys())
+	assert.Equal(t, []tag.KeyID{5}, op.executeCtx.DistinctTagKeyIDs)
+
+	op = newOp()
+	op.executeCtx.DistinctTagKeys = nil
+	assert.NoError(t, op.distinctField())
+}
+
 func TestMetadataLookup_groupBy(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -128,6 +185,72 @@ func TestMetadataLookup_groupBy(t *testing.T) {
 	assert.NoError(t, op.groupBy())
 }
 
+func TestMetadataLookup_groupByAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	newOp := func(groupBy ...string) *metadataLookup {
+		return &metadataLookup{
+			executeCtx: &flow.StorageExecuteContext{
+				Query:   &stmtpkg.Query{GroupByAll: true, GroupBy: groupBy},
+				TagKeys: make(map[string]tag.KeyID),
+			},
+			metadata: metaDB,
+		}
+	}
+	t.Run("get all tag keys failure", func(t *testing.T) {
+		metaDB.EXPECT().GetAllTagKeys(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+		assert.Error(t, newOp().groupBy())
+	})
+	t.Run("metric without tags", func(t *testing.T) {
+		metaDB.EXPECT().GetAllTagKeys(gomock.Any(), gomock.Any()).Return(nil, nil)
+		op := newOp()
+		assert.NoError(t, op.groupBy())
+		assert.False(t, op.executeCtx.Query.GroupByAll)
+		assert.Empty(t, op.executeCtx.GroupByTags)
+	})
+	t.Run("resolve all tag keys", func(t *testing.T) {
+		metaDB.EXPECT().GetAllTagKeys(gomock.Any(), gomock.Any()).Return(tag.Metas{
+			{Key: "zone", ID: 3}, {Key: "host", ID: 1}, {Key: "ip", ID: 2},
+		}, nil)
+		metaDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_, _, tagKey string) (tag.KeyID, error) {
+				return map[string]tag.KeyID{"zone": 3, "host": 1, "ip": 2}[tagKey], nil
+			}).Times(3)
+		// ip as distinct tag key
+		op := newOp("ip")
+		assert.NoError(t, op.groupBy())
+		assert.True(t, op.executeCtx.Query.GroupByAll)
+		assert.Equal(t, []string{"host", "zone", "ip"}, op.executeCtx.Query.GroupBy)
+		assert.Equal(t, []tag.KeyID{1, 3, 2}, op.executeCtx.GroupByTagKeyIDs)
+		assert.Len(t, op.executeCtx.GroupingTagValueIDs, 3)
+	})
+}
+
+func TestMetadataLookup_selectAllFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	op := &metadataLookup{
+		executeCtx: &flow.StorageExecuteContext{
+			Query: &stmtpkg.Query{AllFields: true},
+		},
+		metadata: metaDB,
+		fields:   make(map[field.ID]*aggregation.Aggregator),
+	}
+	metaDB.EXPECT().GetAllFields(gomock.Any(), gomock.Any()).Return(field.Metas{
+		{ID: 1, Type: field.SumField, Name: "f"},
+		{ID: 2, Type: field.HistogramField, Name: "__bucket_1"},
+		{ID: 3, Type: field.SketchField, Name: "HistogramSketch"},
+	}, nil)
+	assert.NoError(t, op.selectList())
+	// histogram internal fields excluded
+	assert.Len(t, op.fields, 1)
+	assert.NotNil(t, op.fields[1])
+}
+
 func TestMetadataLookup_field(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
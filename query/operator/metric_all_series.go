@@ -51,6 +51,9 @@ func (op *metricAllSeries) Execute() error {
 		// add series id without tags, maybe metric has too many series, but one series without tags
 		seriesIDs.Add(series.IDWithoutTags)
 	}
+	if err := excludeDeletedSeries(op.indexDB, op.executeCtx.StorageExecuteCtx.MetricID, seriesIDs); err != nil {
+		return err
+	}
 	op.executeCtx.SeriesIDsAfterFiltering.Or(seriesIDs)
 	return nil
 }
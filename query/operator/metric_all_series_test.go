@@ -68,9 +68,25 @@ func TestMetricAllSeries_Execute(t *testing.T) {
 		ctx.SeriesIDsAfterFiltering = roaring.New()
 		op := NewMetricAllSeries(ctx, shard)
 		indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(3, 5), nil)
+		indexDB.EXPECT().GetDeletedSeries(gomock.Any()).Return(nil, nil)
 		assert.NoError(t, op.Execute())
 		assert.Equal(t, roaring.BitmapOf(0, 3, 5), ctx.SeriesIDsAfterFiltering)
 	})
+	t.Run("get deleted series failure", func(t *testing.T) {
+		ctx.SeriesIDsAfterFiltering = roaring.New()
+		op := NewMetricAllSeries(ctx, shard)
+		indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(3, 5), nil)
+		indexDB.EXPECT().GetDeletedSeries(gomock.Any()).Return(nil, fmt.Errorf("err"))
+		assert.Error(t, op.Execute())
+	})
+	t.Run("exclude deleted series", func(t *testing.T) {
+		ctx.SeriesIDsAfterFiltering = roaring.New()
+		op := NewMetricAllSeries(ctx, shard)
+		indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(3, 5), nil)
+		indexDB.EXPECT().GetDeletedSeries(gomock.Any()).Return(roaring.BitmapOf(3), nil)
+		assert.NoError(t, op.Execute())
+		assert.Equal(t, []uint32{0, 5}, ctx.SeriesIDsAfterFiltering.ToArray())
+	})
 }
 
 func TestMetricAllSeries_Stats(t *testing.T) {
@@ -17,7 +17,11 @@
 
 package operator
 
-import "github.com/lindb/lindb/query/context"
+import (
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/query/context"
+)
 
 // metricSuggest represents metric suggest operator.
 type metricSuggest struct {
@@ -35,6 +39,15 @@ func NewMetricSuggest(ctx *context.LeafMetadataContext) Operator {
 func (op *metricSuggest) Execute() error {
 	req := op.ctx.Request
 	limit := op.ctx.Limit
+	if req.Fuzzy {
+		// scan metric names of namespace, then filter/rank by fuzzy match
+		rs, err := op.ctx.Database.Metadata().MetadataDatabase().SuggestMetrics(req.Namespace, "", constants.MaxFuzzySuggestionCandidates)
+		if err != nil {
+			return err
+		}
+		op.ctx.ResultSet = strutil.FuzzyFilter(req.Prefix, rs, limit)
+		return nil
+	}
 	rs, err := op.ctx.Database.Metadata().MetadataDatabase().SuggestMetrics(req.Namespace, req.Prefix, limit)
 	if err != nil {
 		return err
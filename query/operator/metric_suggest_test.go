@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/query/context"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
@@ -47,6 +48,7 @@ func TestMetricSuggest_Execute(t *testing.T) {
 	cases := []struct {
 		name    string
 		prepare func()
+		assert  func()
 		wantErr bool
 	}{
 		{
@@ -64,6 +66,28 @@ func TestMetricSuggest_Execute(t *testing.T) {
 					Return([]string{"name"}, nil)
 			},
 		},
+		{
+			name: "metric fuzzy suggest failure",
+			prepare: func() {
+				ctx.Request.Fuzzy = true
+				metaDB.EXPECT().SuggestMetrics(gomock.Any(), "", constants.MaxFuzzySuggestionCandidates).
+					Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric fuzzy suggest successfully",
+			prepare: func() {
+				ctx.Request.Fuzzy = true
+				ctx.Request.Prefix = "load"
+				ctx.Limit = 1
+				metaDB.EXPECT().SuggestMetrics(gomock.Any(), "", constants.MaxFuzzySuggestionCandidates).
+					Return([]string{"memory", "system.load", "load"}, nil)
+			},
+			assert: func() {
+				assert.Equal(t, []string{"load"}, ctx.ResultSet)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -77,6 +101,9 @@ func TestMetricSuggest_Execute(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Fatal(tt.name)
 			}
+			if tt.assert != nil {
+				tt.assert()
+			}
 		})
 	}
 }
@@ -0,0 +1,194 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"container/list"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+const (
+	// maxResultRatio limits the max size of result which can be cached(capacity/maxResultRatio).
+	maxResultRatio = 16
+	// estimated bytes of result entry/series result without slots and values.
+	resultEntryOverhead  = 128
+	seriesResultOverhead = 64
+)
+
+// resultCache is the partial aggregate result cache shared by all leaf queries of storage node, nil disables it.
+var resultCache ResultCache
+
+// SetResultCache sets the shared partial aggregate result cache for leaf query, nil disables result cache.
+func SetResultCache(cache ResultCache) {
+	resultCache = cache
+}
+
+// ResultKey represents the key of cached down sampling result which loads from one sst file of family.
+type ResultKey struct {
+	Query      string             // normalized query(without time range)
+	File       string             // path of immutable sst file which result set reads from
+	HighKey    uint16             // high key of series ids
+	Target     timeutil.SlotRange // source slot range of family in query time range
+	SlotOffset int                // base slot % interval ratio, alignment of down sampling
+}
+
+// SeriesResult represents the down sampling result of one field of series,
+// slot is relative to the target slot of family start time.
+type SeriesResult struct {
+	LowSeriesID uint16
+	FieldIdx    int
+	Slots       []int
+	Values      []float64
+}
+
+// ResultCache caches the partial aggregate(down sampling) result of flushed family for leaf query,
+// avoids recomputing the same family for overlapping panel refreshes, evicts by lru(memory bounded).
+// Sst file is immutable, the results of file are valid until the file removed(after compaction/rollup).
+type ResultCache interface {
+	// Get returns the cached result.
+	Get(key ResultKey) ([]*SeriesResult, bool)
+	// Add tries to add the result into cache.
+	Add(key ResultKey, result []*SeriesResult)
+	// Invalidate removes all results of the sst file, or all files under the directory.
+	Invalidate(path string)
+	// Size returns the estimated bytes of cached results.
+	Size() int64
+}
+
+// resultEntry represents the cached result.
+type resultEntry struct {
+	key    ResultKey
+	result []*SeriesResult
+	size   int64
+}
+
+// lruResultCache implements ResultCache interface, uses lru for eviction.
+type lruResultCache struct {
+	capacity  int64
+	size      int64
+	items     map[ResultKey]*list.Element
+	files     map[string]map[ResultKey]struct{}
+	evictList *list.List
+
+	mutex sync.Mutex
+}
+
+// NewResultCache creates a result cache with capacity(bytes).
+func NewResultCache(capacity int64) ResultCache {
+	return &lruResultCache{
+		capacity:  capacity,
+		items:     make(map[ResultKey]*list.Element),
+		files:     make(map[string]map[ResultKey]struct{}),
+		evictList: list.New(),
+	}
+}
+
+// Get returns the cached result.
+func (c *lruResultCache) Get(key ResultKey) ([]*SeriesResult, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if ent, ok := c.items[key]; ok {
+		c.evictList.MoveToFront(ent)
+		metrics.ResultCacheStatistics.Hit.Incr()
+		return ent.Value.(*resultEntry).result, true
+	}
+	metrics.ResultCacheStatistics.Miss.Incr()
+	return nil, false
+}
+
+// Add tries to add the result into cache.
+func (c *lruResultCache) Add(key ResultKey, result []*SeriesResult) {
+	size := resultSize(key, result)
+	if size > c.capacity/maxResultRatio {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.items[key]; ok {
+		return
+	}
+	for c.size+size > c.capacity {
+		c.removeElement(c.evictList.Back())
+		metrics.ResultCacheStatistics.Evict.Incr()
+	}
+	c.items[key] = c.evictList.PushFront(&resultEntry{key: key, result: result, size: size})
+	file, ok := c.files[key.File]
+	if !ok {
+		file = make(map[ResultKey]struct{})
+		c.files[key.File] = file
+	}
+	file[key] = struct{}{}
+	c.size += size
+	metrics.ResultCacheStatistics.Add.Incr()
+	metrics.ResultCacheStatistics.Size.Add(float64(size))
+}
+
+// Invalidate removes all results of the sst file, or all files under the directory(store closed).
+func (c *lruResultCache) Invalidate(path string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	dir := path + string(filepath.Separator)
+	for file, items := range c.files {
+		if file != path && !strings.HasPrefix(file, dir) {
+			continue
+		}
+		for item := range items {
+			c.removeElement(c.items[item])
+			metrics.ResultCacheStatistics.Invalidate.Incr()
+		}
+	}
+}
+
+// Size returns the estimated bytes of cached results.
+func (c *lruResultCache) Size() int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.size
+}
+
+// removeElement removes the entry from cache.
+func (c *lruResultCache) removeElement(ent *list.Element) {
+	entry := c.evictList.Remove(ent).(*resultEntry)
+	delete(c.items, entry.key)
+	if file, ok := c.files[entry.key.File]; ok {
+		delete(file, entry.key)
+		if len(file) == 0 {
+			delete(c.files, entry.key.File)
+		}
+	}
+	c.size -= entry.size
+	metrics.ResultCacheStatistics.Size.Sub(float64(entry.size))
+}
+
+// resultSize returns the estimated bytes of result.
+func resultSize(key ResultKey, result []*SeriesResult) int64 {
+	size := int64(resultEntryOverhead + len(key.Query) + len(key.File))
+	for _, rs := range result {
+		size += int64(seriesResultOverhead + 8*cap(rs.Slots) + 8*cap(rs.Values))
+	}
+	return size
+}
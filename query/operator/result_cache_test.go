@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCache_Get_Add(t *testing.T) {
+	key := ResultKey{Query: "q", File: "1.sst"}
+	result := []*SeriesResult{{LowSeriesID: 1, Slots: []int{1}, Values: []float64{1}}}
+	cache := NewResultCache(resultSize(key, result) * maxResultRatio)
+	_, ok := cache.Get(key)
+	assert.False(t, ok)
+
+	cache.Add(key, result)
+	rs, ok := cache.Get(key)
+	assert.True(t, ok)
+	assert.Equal(t, result, rs)
+	assert.Equal(t, resultSize(key, result), cache.Size())
+	// add again
+	cache.Add(key, result)
+	assert.Equal(t, resultSize(key, result), cache.Size())
+
+	// result too large
+	cache.Add(ResultKey{Query: "q", File: "2.sst"},
+		[]*SeriesResult{{Slots: make([]int, 100), Values: make([]float64, 100)}})
+	assert.Equal(t, resultSize(key, result), cache.Size())
+}
+
+func TestResultCache_Evict(t *testing.T) {
+	key1 := ResultKey{Query: "q", File: "1.sst"}
+	key2 := ResultKey{Query: "q", File: "2.sst"}
+	key3 := ResultKey{Query: "q", File: "3.sst"}
+	result := []*SeriesResult{{LowSeriesID: 1, Slots: []int{1}, Values: []float64{1}}}
+	size := resultSize(key1, result)
+	cache := NewResultCache(size * maxResultRatio)
+	for i := 0; i < maxResultRatio; i++ {
+		cache.Add(ResultKey{Query: "q", File: "1.sst", HighKey: uint16(i)}, result)
+	}
+	assert.Equal(t, size*maxResultRatio, cache.Size())
+	// key1 is recently used
+	_, ok := cache.Get(key1)
+	assert.True(t, ok)
+	cache.Add(key2, result)
+	cache.Add(key3, result)
+	assert.Equal(t, size*maxResultRatio, cache.Size())
+	_, ok = cache.Get(key1)
+	assert.True(t, ok)
+	_, ok = cache.Get(ResultKey{Query: "q", File: "1.sst", HighKey: 1})
+	assert.False(t, ok)
+}
+
+func TestResultCache_Invalidate(t *testing.T) {
+	key1 := ResultKey{Query: "q", File: "store/f/1.sst"}
+	key2 := ResultKey{Query: "q", File: "store/f/2.sst"}
+	key3 := ResultKey{Query: "q", File: "store2/f/1.sst"}
+	result := []*SeriesResult{{LowSeriesID: 1, Slots: []int{1}, Values: []float64{1}}}
+	cache := NewResultCache(1024 * 1024)
+	cache.Add(key1, result)
+	cache.Add(key2, result)
+	cache.Add(key3, result)
+
+	// file removed after compaction
+	cache.Invalidate("store/f/1.sst")
+	_, ok := cache.Get(key1)
+	assert.False(t, ok)
+	_, ok = cache.Get(key2)
+	assert.True(t, ok)
+	assert.Equal(t, resultSize(key2, result)+resultSize(key3, result), cache.Size())
+
+	// store closed, all files under store removed
+	cache.Invalidate("store")
+	_, ok = cache.Get(key2)
+	assert.False(t, ok)
+	_, ok = cache.Get(key3)
+	assert.True(t, ok)
+	assert.Equal(t, resultSize(key3, result), cache.Size())
+}
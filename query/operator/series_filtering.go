@@ -25,6 +25,9 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/bitmappool"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
@@ -35,6 +38,7 @@ import (
 type seriesFiltering struct {
 	executeCtx *flow.ShardExecuteContext
 	indexDB    indexdb.IndexDatabase
+	snapshot   flow.IndexSnapshot
 
 	err error
 }
@@ -55,24 +59,47 @@ func (op *seriesFiltering) Execute() error {
 	if op.err != nil {
 		return op.err
 	}
+	if err := excludeDeletedSeries(op.indexDB, op.executeCtx.StorageExecuteCtx.MetricID, seriesIDs); err != nil {
+		return err
+	}
 	op.executeCtx.SeriesIDsAfterFiltering.Or(seriesIDs)
+	bitmappool.PutBitmap(seriesIDs)
+	return nil
+}
+
+// excludeDeletedSeries removes the deleted series(tombstones) of metric from series ids.
+func excludeDeletedSeries(indexDB indexdb.IndexDatabase, metricID metric.ID, seriesIDs *roaring.Bitmap) error {
+	tombstones, err := indexDB.GetDeletedSeries(metricID)
+	if err != nil {
+		return err
+	}
+	if tombstones != nil {
+		seriesIDs.AndNot(tombstones)
+	}
 	return nil
 }
 
 // findSeriesIDsByExpr finds series ids by expr, recursion filter for expr
 func (op *seriesFiltering) findSeriesIDsByExpr(condition stmt.Expr) (tag.KeyID, *roaring.Bitmap) {
 	if condition == nil {
-		return 0, roaring.New() // create an empty series ids for parent expr
+		return 0, bitmappool.GetBitmap() // create an empty series ids for parent expr
 	}
 	if op.err != nil {
-		return 0, roaring.New() // create an empty series ids for parent expr
+		return 0, bitmappool.GetBitmap() // create an empty series ids for parent expr
 	}
 	switch expr := condition.(type) {
+	case *stmt.IsNullExpr:
+		tagKey, seriesIDs, err := op.getSeriesIDsByIsNull(expr)
+		if err != nil {
+			op.err = err
+			return tagKey, bitmappool.GetBitmap() // create an empty series ids for parent expr
+		}
+		return tagKey, seriesIDs
 	case stmt.TagFilter:
 		tagKey, seriesIDs, err := op.getSeriesIDsByExpr(expr)
 		if err != nil {
 			op.err = err
-			return tagKey, roaring.New() // create an empty series ids for parent expr
+			return tagKey, bitmappool.GetBitmap() // create an empty series ids for parent expr
 		}
 		return tagKey, seriesIDs
 	case *stmt.ParenExpr:
@@ -81,25 +108,35 @@ func (op *seriesFiltering) findSeriesIDsByExpr(condition stmt.Expr) (tag.KeyID,
 		// get filter series ids
 		tagKey, matchResult := op.findSeriesIDsByExpr(expr.Expr)
 		// get all series ids for tag key
-		all, err := op.indexDB.GetSeriesIDsForTag(tagKey)
+		all, err := op.indexSnapshot().GetSeriesIDsForTag(tagKey)
 		if err != nil {
 			op.err = err
-			return tagKey, roaring.New() // create an empty series ids for parent expr
+			return tagKey, matchResult // reuse match result as empty series ids for parent expr
 		}
 		// do and not got series ids not in 'a' list
 		all.AndNot(matchResult)
+		bitmappool.PutBitmap(matchResult)
 		return 0, all
 	case *stmt.BinaryExpr:
 		_, left := op.findSeriesIDsByExpr(expr.Left)
 		_, right := op.findSeriesIDsByExpr(expr.Right)
+		if left == right {
+			return 0, left
+		}
 		if expr.Operator == stmt.AND {
+			// intersect in place into smaller one, fewer containers need to be written
+			if right.GetCardinality() < left.GetCardinality() {
+				left, right = right, left
+			}
 			left.And(right)
 		} else {
 			left.Or(right)
 		}
+		// right is transient result, return it to pool
+		bitmappool.PutBitmap(right)
 		return 0, left
 	}
-	return 0, roaring.New() // create an empty series ids for parent expr
+	return 0, bitmappool.GetBitmap() // create an empty series ids for parent expr
 }
 
 // getTagKeyID returns the tag key id by tag key
@@ -108,13 +145,49 @@ func (op *seriesFiltering) getSeriesIDsByExpr(expr stmt.Expr) (tag.KeyID, *roari
 	if !ok {
 		return 0, nil, fmt.Errorf("%w, expr: %s", constants.ErrTagValueFilterResultNotFound, expr.Rewrite())
 	}
-	seriesIDs, err := op.indexDB.GetSeriesIDsByTagValueIDs(tagValues.TagKeyID, tagValues.TagValueIDs)
+	seriesIDs, err := op.indexSnapshot().GetSeriesIDsByTagValueIDs(tagValues.TagKeyID, tagValues.TagValueIDs)
 	if err != nil {
 		return 0, nil, err
 	}
 	return tagValues.TagKeyID, seriesIDs, nil
 }
 
+// getSeriesIDsByIsNull returns the series ids of metric which lack the tag key(all series - series of tag key).
+func (op *seriesFiltering) getSeriesIDsByIsNull(expr *stmt.IsNullExpr) (tag.KeyID, *roaring.Bitmap, error) {
+	tagFilter, ok := op.executeCtx.StorageExecuteCtx.TagFilterResult[expr.Rewrite()]
+	if !ok {
+		return 0, nil, fmt.Errorf("%w, expr: %s", constants.ErrTagValueFilterResultNotFound, expr.Rewrite())
+	}
+	queryStmt := op.executeCtx.StorageExecuteCtx.Query
+	seriesIDs, err := op.indexDB.GetSeriesIDsForMetric(queryStmt.Namespace, queryStmt.MetricName)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !queryStmt.HasGroupBy() {
+		// series without tags also lacks the tag key
+		seriesIDs.Add(series.IDWithoutTags)
+	}
+	if tagFilter.TagKeyID == tag.EmptyTagKeyID {
+		// tag key not exist under metric, all series match
+		return tagFilter.TagKeyID, seriesIDs, nil
+	}
+	seriesIDsForTag, err := op.indexSnapshot().GetSeriesIDsForTag(tagFilter.TagKeyID)
+	if err != nil {
+		return 0, nil, err
+	}
+	seriesIDs.AndNot(seriesIDsForTag)
+	bitmappool.PutBitmap(seriesIDsForTag)
+	return tagFilter.TagKeyID, seriesIDs, nil
+}
+
+// indexSnapshot returns the index snapshot held by shard context, grouping reads the same snapshot later.
+func (op *seriesFiltering) indexSnapshot() flow.IndexSnapshot {
+	if op.snapshot == nil {
+		op.snapshot = op.executeCtx.IndexSnapshot(op.indexDB.GetSnapshot)
+	}
+	return op.snapshot
+}
+
 // Identifier returns identifier value of series filtering operator.
 func (op *seriesFiltering) Identifier() string {
 	return "Series Filtering"
@@ -122,7 +195,11 @@ func (op *seriesFiltering) Identifier() string {
 
 // Stats returns the stats of series filtering operator.
 func (op *seriesFiltering) Stats() interface{} {
-	return &models.SeriesStats{
+	stats := &models.SeriesStats{
 		NumOfSeries: op.executeCtx.SeriesIDsAfterFiltering.GetCardinality(),
 	}
+	if op.snapshot != nil {
+		stats.IndexVersion = op.snapshot.Version()
+	}
+	return stats
 }
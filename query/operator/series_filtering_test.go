@@ -27,6 +27,8 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
@@ -39,7 +41,11 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexSnapshot := flow.NewMockIndexSnapshot(ctrl)
+	indexSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	indexDB.EXPECT().GetSnapshot().Return(indexSnapshot).AnyTimes()
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	indexDB.EXPECT().GetDeletedSeries(gomock.Any()).Return(nil, nil).AnyTimes()
 	storageCtx := &flow.StorageExecuteContext{
 		Query: &stmtpkg.Query{},
 		TagFilterResult: map[string]*flow.TagFilterResult{
@@ -47,6 +53,8 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				TagKeyID:    tag.KeyID(1),
 				TagValueIDs: roaring.BitmapOf(1, 2, 3),
 			},
+			"key1 is null": {TagKeyID: tag.KeyID(1)},
+			"key3 is null": {TagKeyID: tag.EmptyTagKeyID},
 		},
 	}
 	shardCtx := flow.NewShardExecuteContext(storageCtx)
@@ -74,7 +82,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				Value: "value1",
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
@@ -85,7 +93,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				Value: "value1",
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
 			},
 		},
 		{
@@ -97,7 +105,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
 			},
 		},
 		{
@@ -109,8 +117,8 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsForTag(gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsForTag(gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
 			},
 		},
 		{
@@ -122,8 +130,8 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsForTag(gomock.Any()).Return(nil, fmt.Errorf("err"))
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsForTag(gomock.Any()).Return(nil, fmt.Errorf("err"))
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
 			},
 			wantErr: true,
 		},
@@ -140,7 +148,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
@@ -158,7 +166,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).
 					Return(roaring.BitmapOf(1, 2), nil).MaxTimes(2)
 			},
 		},
@@ -176,10 +184,47 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).
 					Return(roaring.BitmapOf(1, 2), nil).MaxTimes(2)
 			},
 		},
+		{
+			name:    "is null expr, tag filter result not found",
+			in:      &stmtpkg.IsNullExpr{Key: "key2"},
+			wantErr: true,
+		},
+		{
+			name: "is null expr, get series for metric failure",
+			in:   &stmtpkg.IsNullExpr{Key: "key1"},
+			prepare: func() {
+				indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "is null expr, get series for tag failure",
+			in:   &stmtpkg.IsNullExpr{Key: "key1"},
+			prepare: func() {
+				indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3, 4), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsForTag(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "is null expr successfully",
+			in:   &stmtpkg.IsNullExpr{Key: "key1"},
+			prepare: func() {
+				indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3, 4), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsForTag(tag.KeyID(1)).Return(roaring.BitmapOf(1, 2), nil)
+			},
+		},
+		{
+			name: "is null expr, tag key not exist",
+			in:   &stmtpkg.IsNullExpr{Key: "key3"},
+			prepare: func() {
+				indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3, 4), nil)
+			},
+		},
 		{
 			name: "unknown condition expr",
 			in: &stmtpkg.FieldExpr{
@@ -204,12 +249,50 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 	}
 }
 
+func TestSeriesFiltering_Execute_Tombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexSnapshot := flow.NewMockIndexSnapshot(ctrl)
+	indexSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	indexDB.EXPECT().GetSnapshot().Return(indexSnapshot).AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	storageCtx := &flow.StorageExecuteContext{
+		MetricID: 10,
+		Query: &stmtpkg.Query{
+			Condition: &stmtpkg.EqualsExpr{Key: "key1", Value: "value1"},
+		},
+		TagFilterResult: map[string]*flow.TagFilterResult{
+			"key1=value1": {
+				TagKeyID:    tag.KeyID(1),
+				TagValueIDs: roaring.BitmapOf(1, 2, 3),
+			},
+		},
+	}
+	shardCtx := flow.NewShardExecuteContext(storageCtx)
+	op := NewSeriesFiltering(shardCtx, shard)
+	// case 1: get tombstones failure
+	indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
+	indexDB.EXPECT().GetDeletedSeries(metric.ID(10)).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, op.Execute())
+	// case 2: exclude deleted series
+	indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
+	indexDB.EXPECT().GetDeletedSeries(metric.ID(10)).Return(roaring.BitmapOf(2, 5), nil)
+	assert.NoError(t, op.Execute())
+	assert.Equal(t, []uint32{1, 3}, shardCtx.SeriesIDsAfterFiltering.ToArray())
+}
+
 func TestSeriesFiltering_Stats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexSnapshot := flow.NewMockIndexSnapshot(ctrl)
+	indexSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	indexDB.EXPECT().GetSnapshot().Return(indexSnapshot).AnyTimes()
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
 	shardCtx := &flow.ShardExecuteContext{
 		SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2),
@@ -217,5 +300,9 @@ func TestSeriesFiltering_Stats(t *testing.T) {
 	op := NewSeriesFiltering(shardCtx, shard)
 	assert.Equal(t, "Series Filtering", op.Identifier())
 	op1 := op.(TrackableOperator)
-	assert.NotNil(t, op1.Stats())
+	assert.Equal(t, &models.SeriesStats{NumOfSeries: 2}, op1.Stats())
+	// stats with version of index snapshot which filtering reads
+	indexSnapshot.EXPECT().Version().Return(int64(3))
+	op.(*seriesFiltering).indexSnapshot()
+	assert.Equal(t, &models.SeriesStats{NumOfSeries: 2, IndexVersion: 3}, op1.Stats())
 }
@@ -47,6 +47,10 @@ func (op *seriesLimit) Execute() error {
 	if limit.EnableSeriesCheckForQuery() && numOfSeries > uint64(limit.MaxSeriesPerQuery) {
 		return constants.ErrTooManySeriesFound
 	}
+	if op.executeCtx.StorageExecuteCtx.Query.GroupByAll && numOfSeries > constants.MaxGroupByAllSeries {
+		// group by all tag keys, the number of groups is close to the number of series
+		return constants.ErrTooManyGroupsForGroupByAll
+	}
 	return nil
 }
 
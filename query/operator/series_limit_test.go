@@ -26,6 +26,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 )
 
@@ -36,7 +37,7 @@ func TestSeriesLimit_Execute(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	db := tsdb.NewMockDatabase(ctrl)
 	shard.EXPECT().Database().Return(db).MaxTimes(3)
-	ctx := flow.NewShardExecuteContext(nil)
+	ctx := flow.NewShardExecuteContext(&flow.StorageExecuteContext{Query: &stmtpkg.Query{}})
 	op := NewSeriesLimit(ctx, shard)
 	assert.NoError(t, op.Execute())
 
@@ -52,6 +53,22 @@ func TestSeriesLimit_Execute(t *testing.T) {
 	assert.NoError(t, op.Execute())
 }
 
+func TestSeriesLimit_GroupByAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	db.EXPECT().GetLimits().Return(models.NewDefaultLimits()).AnyTimes()
+	ctx := flow.NewShardExecuteContext(&flow.StorageExecuteContext{Query: &stmtpkg.Query{GroupByAll: true}})
+	op := NewSeriesLimit(ctx, shard)
+	ctx.SeriesIDsAfterFiltering.AddRange(0, constants.MaxGroupByAllSeries)
+	assert.NoError(t, op.Execute())
+	ctx.SeriesIDsAfterFiltering.Add(constants.MaxGroupByAllSeries)
+	assert.Equal(t, constants.ErrTooManyGroupsForGroupByAll, op.Execute())
+}
+
 func TestSeriesLimit_Identifier(t *testing.T) {
 	assert.Equal(t, "Series Limit", NewSeriesLimit(nil, nil).Identifier())
 }
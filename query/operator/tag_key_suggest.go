@@ -17,7 +17,10 @@
 
 package operator
 
-import "github.com/lindb/lindb/query/context"
+import (
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/tag"
+)
 
 // tagKeySuggest represents tag key suggest operator.
 type tagKeySuggest struct {
@@ -34,7 +37,17 @@ func NewTagKeySuggest(ctx *context.LeafMetadataContext) Operator {
 // Execute returns tag key list by given namespace/metric name.
 func (op *tagKeySuggest) Execute() error {
 	req := op.ctx.Request
-	tagKeys, err := op.ctx.Database.Metadata().MetadataDatabase().GetAllTagKeys(req.Namespace, req.MetricName)
+	var (
+		tagKeys tag.Metas
+		err     error
+	)
+	metadata := op.ctx.Database.Metadata().MetadataDatabase()
+	if req.Timestamp > 0 {
+		// get tag keys as of the timestamp
+		tagKeys, err = metadata.GetAllTagKeysAt(req.Namespace, req.MetricName, req.Timestamp)
+	} else {
+		tagKeys, err = metadata.GetAllTagKeys(req.Namespace, req.MetricName)
+	}
 	if err != nil {
 		return err
 	}
@@ -65,6 +65,14 @@ func TestTagKeySuggest_Execute(t *testing.T) {
 					Return(tag.Metas{{}}, nil)
 			},
 		},
+		{
+			name: "tag key suggest as of timestamp",
+			prepare: func() {
+				ctx.Request.Timestamp = 100
+				metaDB.EXPECT().GetAllTagKeysAt(gomock.Any(), gomock.Any(), int64(100)).
+					Return(tag.Metas{{}}, nil)
+			},
+		},
 	}
 
 	for _, tt := range cases {
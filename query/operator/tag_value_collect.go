@@ -61,9 +61,10 @@ func (op *tagValueCollect) execute() error {
 	tagKeyID := op.executeCtx.TagKeyID
 	op.executeCtx.StorageExecuteCtx.GroupByTagKeyIDs = []tag.KeyID{tagKeyID}
 	// get grouping based on tag keys and series ids
-	if err := op.shard.IndexDatabase().GetGroupingContext(op.shardExecuteCtx); err != nil {
+	if err := op.shardExecuteCtx.IndexSnapshot(op.shard.IndexDatabase().GetSnapshot).GetGroupingContext(op.shardExecuteCtx); err != nil {
 		return err
 	}
+	tagMetaSnapshot := op.executeCtx.StorageExecuteCtx.TagMetadataSnapshot(op.executeCtx.Database.Metadata().TagMetadata().GetSnapshot)
 	seriesIDs := op.shardExecuteCtx.SeriesIDsAfterFiltering
 	highKeys := seriesIDs.GetHighKeys()
 	for i, highKey := range highKeys {
@@ -71,7 +72,7 @@ func (op *tagValueCollect) execute() error {
 		tagValueIDs := op.shardExecuteCtx.GroupingContext.ScanTagValueIDs(highKey, seriesIDs.GetContainerAtIndex(i))
 		tagValues := make(map[uint32]string)
 		// get tag value
-		err := op.executeCtx.Database.Metadata().TagMetadata().CollectTagValues(tagKeyID, tagValueIDs[0], tagValues)
+		err := tagMetaSnapshot.CollectTagValues(tagKeyID, tagValueIDs[0], tagValues)
 		if err != nil {
 			return err
 		}
@@ -45,10 +45,16 @@ func TestTagValueCollect_Execute(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	shard.EXPECT().ShardID().Return(models.ShardID(10)).AnyTimes()
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexSnapshot := flow.NewMockIndexSnapshot(ctrl)
+	indexSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	indexDB.EXPECT().GetSnapshot().Return(indexSnapshot).AnyTimes()
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
 	meta := metadb.NewMockMetadata(ctrl)
 	db.EXPECT().Metadata().Return(meta).AnyTimes()
 	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	tagMetaSnapshot := flow.NewMockTagMetadataSnapshot(ctrl)
+	tagMetaSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	tagMeta.EXPECT().GetSnapshot().Return(tagMetaSnapshot).AnyTimes()
 	meta.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
 
 	ctx := &context.LeafMetadataContext{
@@ -68,21 +74,21 @@ func TestTagValueCollect_Execute(t *testing.T) {
 		{
 			name: "get grouping context failure",
 			prepare: func() {
-				indexDB.EXPECT().GetGroupingContext(gomock.Any()).Return(fmt.Errorf("err"))
+				indexSnapshot.EXPECT().GetGroupingContext(gomock.Any()).Return(fmt.Errorf("err"))
 			},
 		},
 		{
 			name: "collect tag value failure",
 			prepare: func() {
-				indexDB.EXPECT().GetGroupingContext(gomock.Any()).Return(nil)
-				tagMeta.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				indexSnapshot.EXPECT().GetGroupingContext(gomock.Any()).Return(nil)
+				tagMetaSnapshot.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 			},
 		},
 		{
 			name: "collect tag value successfully",
 			prepare: func() {
-				indexDB.EXPECT().GetGroupingContext(gomock.Any()).Return(nil)
-				tagMeta.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ tag.KeyID,
+				indexSnapshot.EXPECT().GetGroupingContext(gomock.Any()).Return(nil)
+				tagMetaSnapshot.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ tag.KeyID,
 					_ *roaring.Bitmap,
 					tagValues map[uint32]string) error {
 					tagValues[10] = "value10"
@@ -17,7 +17,11 @@
 
 package operator
 
-import "github.com/lindb/lindb/query/context"
+import (
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/query/context"
+)
 
 // tagValueSuggest represent tag value suggest without condition operator.
 type tagValueSuggest struct {
@@ -35,6 +39,12 @@ func NewTagValueSuggest(ctx *context.LeafMetadataContext) Operator {
 func (op *tagValueSuggest) Execute() error {
 	req := op.ctx.Request
 	limit := op.ctx.Limit
+	if req.Fuzzy {
+		// scan tag values of tag key, then filter/rank by fuzzy match
+		rs := op.ctx.Database.Metadata().TagMetadata().SuggestTagValues(op.ctx.TagKeyID, "", constants.MaxFuzzySuggestionCandidates)
+		op.ctx.ResultSet = strutil.FuzzyFilter(req.Prefix, rs, limit)
+		return nil
+	}
 	op.ctx.ResultSet = op.ctx.Database.Metadata().TagMetadata().SuggestTagValues(op.ctx.TagKeyID, req.Prefix, limit)
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/query/context"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
@@ -46,6 +47,13 @@ func TestTagValueSuggest_Execute(t *testing.T) {
 	op := NewTagValueSuggest(ctx)
 	tagMeta.EXPECT().SuggestTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"name"})
 	assert.NoError(t, op.Execute())
+
+	ctx.Request.Fuzzy = true
+	ctx.Request.Prefix = "web"
+	tagMeta.EXPECT().SuggestTagValues(gomock.Any(), "", constants.MaxFuzzySuggestionCandidates).
+		Return([]string{"db-1", "web-2", "w-e-b"})
+	assert.NoError(t, op.Execute())
+	assert.Equal(t, []string{"web-2", "w-e-b"}, ctx.ResultSet)
 }
 
 func TestTagValueSuggest_Identifier(t *testing.T) {
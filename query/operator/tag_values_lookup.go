@@ -18,8 +18,10 @@
 package operator
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
@@ -59,13 +61,22 @@ func (op *tagValuesLookup) findTagValueIDsByExpr(expr stmt.Expr) {
 		return
 	}
 	switch expr := expr.(type) {
+	case *stmt.IsNullExpr:
+		// series ids lacking the tag key are resolved by index in series filtering, only find tag key id here
+		tagKeyID, err := op.getTagKeyID(expr.Key)
+		if err != nil && !errors.Is(err, constants.ErrTagKeyIDNotFound) {
+			op.err = err
+			return
+		}
+		op.executeCtx.TagFilterResult[expr.Rewrite()] = &flow.TagFilterResult{TagKeyID: tagKeyID}
 	case stmt.TagFilter:
 		tagKeyID, err := op.getTagKeyID(expr.TagKey())
 		if err != nil {
 			op.err = err
 			return
 		}
-		tagValueIDs, err := op.metadata.TagMetadata().FindTagValueDsByExpr(tagKeyID, expr)
+		// tag value reads of query go through the same tag metadata snapshot
+		tagValueIDs, err := op.executeCtx.TagMetadataSnapshot(op.metadata.TagMetadata().GetSnapshot).FindTagValueDsByExpr(tagKeyID, expr)
 		if err != nil {
 			op.err = err
 			return
@@ -26,6 +26,7 @@ import (
 
 	"github.com/lindb/roaring"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/series/tag"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -41,6 +42,9 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 	meta := metadb.NewMockMetadata(ctrl)
 	metaDB := metadb.NewMockMetadataDatabase(ctrl)
 	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	tagMetaSnapshot := flow.NewMockTagMetadataSnapshot(ctrl)
+	tagMetaSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	tagMeta.EXPECT().GetSnapshot().Return(tagMetaSnapshot).AnyTimes()
 	meta.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
 	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
 	db.EXPECT().Metadata().Return(meta).AnyTimes()
@@ -78,7 +82,7 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 				Value: "value",
 			},
 			prepare: func() {
-				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
@@ -90,7 +94,7 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 			},
 			prepare: func() {
 				metaDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.EmptyTagKeyID, nil)
-				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
 			},
 			wantErr: false,
 		},
@@ -115,7 +119,7 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
 					Return(roaring.BitmapOf(1, 2, 3), nil).MaxTimes(2)
 			},
 			wantErr: false,
@@ -134,7 +138,7 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
 					Return(nil, fmt.Errorf("err"))
 			},
 			wantErr: true,
@@ -148,11 +152,33 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
 					Return(roaring.BitmapOf(1, 2, 3), nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "is null expr, get tag key failure",
+			in:   &stmtpkg.IsNullExpr{Key: "key"},
+			prepare: func() {
+				metaDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.EmptyTagKeyID, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "is null expr, tag key not found",
+			in:   &stmtpkg.IsNullExpr{Key: "key"},
+			prepare: func() {
+				metaDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(tag.EmptyTagKeyID, constants.ErrTagKeyIDNotFound)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "is null expr successfully",
+			in:      &stmtpkg.NotExpr{Expr: &stmtpkg.IsNullExpr{Key: "key-10"}},
+			wantErr: false,
+		},
 		{
 			name: "paren expr successfully",
 			in: &stmtpkg.ParenExpr{
@@ -162,7 +188,7 @@ func TestTagValuesLookup_Execute(t *testing.T) {
 				},
 			},
 			prepare: func() {
-				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).
 					Return(roaring.BitmapOf(1, 2, 3), nil)
 			},
 			wantErr: false,
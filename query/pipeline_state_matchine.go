@@ -57,8 +57,18 @@ func newPipelineStateMachine(tracker *trackerpkg.StageTracker, completeCallback
 	}
 }
 
-// GetStats returns the states of stages.
+// GetStats returns the states of stages, refreshes elapsed time and current operator of executing stages.
 func (sm *pipelineStateMachine) GetStats() []*models.StageStats {
+	sm.mutex.Lock()
+	now := time.Now()
+	for _, s := range sm.stages {
+		if s.state == trackerpkg.ExecutingState {
+			s.stats.Cost = now.Sub(s.startTime).Nanoseconds()
+			s.stats.Operator = s.stage.CurrentOperator()
+		}
+	}
+	sm.mutex.Unlock()
+
 	return sm.tracker.GetStages()
 }
 
@@ -102,6 +112,7 @@ func (sm *pipelineStateMachine) completeStage(stageID string, err error) {
 		}
 
 		s.stats.Operators = s.stage.Stats()
+		s.stats.Operator = ""
 		s.endTime = time.Now()
 		s.stats.End = s.endTime.UnixNano()
 		s.stats.Cost = s.endTime.Sub(s.startTime).Nanoseconds()
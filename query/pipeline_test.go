@@ -91,6 +91,21 @@ func TestPipeline_Execute(t *testing.T) {
 		s.EXPECT().Complete()
 		p.Execute(s)
 	})
+	t.Run("stats of executing stage", func(t *testing.T) {
+		p := NewExecutePipeline(trackerpkg.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)), nil)
+		s := stage.NewMockStage(ctrl)
+		s.EXPECT().Plan()
+		s.EXPECT().Identifier().Return("stage")
+		s.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any())
+		p.Execute(s)
+		s.EXPECT().CurrentOperator().Return("op")
+		stats := p.Stats()
+		assert.Len(t, stats, 1)
+		assert.Equal(t, "stage", stats[0].Identifier)
+		assert.Equal(t, "op", stats[0].Operator)
+		assert.Equal(t, trackerpkg.ExecutingState.String(), stats[0].State)
+		assert.True(t, stats[0].Cost > 0)
+	})
 	t.Run("panic", func(t *testing.T) {
 		p := NewExecutePipeline(tracker, func(err error) {
 			assert.Error(t, err)
@@ -0,0 +1,174 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"container/list"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+const (
+	// defaultPopularityCapacity represents the max number of metric names/tag values tracked by query popularity.
+	defaultPopularityCapacity = 10000
+	// maxPopularityHits represents the max hits used for ranking, avoids hot metadata always ranked first.
+	maxPopularityHits = 10
+	// popularityWeight represents the score weight of one hit, max boost of popularity is a bit more than one match level,
+	// so that popular substring match can be ranked before cold prefix match.
+	popularityWeight = 110
+)
+
+// popularity tracks the recent query popularity of broker.
+var popularity = newQueryPopularity(defaultPopularityCapacity)
+
+// popularityEntry represents the hits of metric name/tag value.
+type popularityEntry struct {
+	key  string
+	hits int
+}
+
+// queryPopularity tracks the recent query popularity(hits) of metric names and tag values by lru,
+// which is used for ranking fuzzy metadata suggestions.
+type queryPopularity struct {
+	capacity int
+	entries  map[string]*list.Element
+	ll       *list.List
+
+	mutex sync.Mutex
+}
+
+// newQueryPopularity creates a query popularity tracker with max capacity.
+func newQueryPopularity(capacity int) *queryPopularity {
+	return &queryPopularity{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		ll:       list.New(),
+	}
+}
+
+// hit increases the hits of key, evicts the least recently queried key if full.
+func (p *queryPopularity) hit(key string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if elem, ok := p.entries[key]; ok {
+		elem.Value.(*popularityEntry).hits++
+		p.ll.MoveToFront(elem)
+		return
+	}
+	p.entries[key] = p.ll.PushFront(&popularityEntry{key: key, hits: 1})
+	if p.ll.Len() > p.capacity {
+		oldest := p.ll.Back()
+		p.ll.Remove(oldest)
+		delete(p.entries, oldest.Value.(*popularityEntry).key)
+	}
+}
+
+// hits returns the hits of key, returns 0 if key not queried recently.
+func (p *queryPopularity) hits(key string) int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if elem, ok := p.entries[key]; ok {
+		return elem.Value.(*popularityEntry).hits
+	}
+	return 0
+}
+
+// recordQuery records the metric name and tag values(equals/in condition) of query.
+func (p *queryPopularity) recordQuery(database string, statement *stmtpkg.Query) {
+	if statement.IsMetricNamePattern() {
+		return
+	}
+	p.hit(metricPopularityKey(database, statement.Namespace, statement.MetricName))
+	var visit func(expr stmtpkg.Expr)
+	visit = func(expr stmtpkg.Expr) {
+		switch e := expr.(type) {
+		case *stmtpkg.EqualsExpr:
+			p.hit(tagValuePopularityKey(database, statement.Namespace, statement.MetricName, e.Key, e.Value))
+		case *stmtpkg.InExpr:
+			for _, value := range e.Values {
+				p.hit(tagValuePopularityKey(database, statement.Namespace, statement.MetricName, e.Key, value))
+			}
+		case *stmtpkg.ParenExpr:
+			visit(e.Expr)
+		case *stmtpkg.BinaryExpr:
+			visit(e.Left)
+			visit(e.Right)
+		}
+	}
+	visit(statement.Condition)
+}
+
+// rank ranks the fuzzy suggestions by match score and query popularity, returns at most limit values.
+func (p *queryPopularity) rank(database string, statement *stmtpkg.MetricMetadata, values []string) []string {
+	type suggestion struct {
+		value string
+		score int
+	}
+	suggestions := make([]suggestion, 0, len(values))
+	for _, value := range strutil.DeDupStringSlice(values) {
+		score, ok := strutil.FuzzyMatch(statement.Prefix, value)
+		if !ok {
+			continue
+		}
+		var key string
+		switch statement.Type {
+		case stmtpkg.Metric:
+			key = metricPopularityKey(database, statement.Namespace, value)
+		case stmtpkg.TagValue:
+			key = tagValuePopularityKey(database, statement.Namespace, statement.MetricName, statement.TagKey, value)
+		}
+		if key != "" {
+			hits := p.hits(key)
+			if hits > maxPopularityHits {
+				hits = maxPopularityHits
+			}
+			score += hits * popularityWeight
+		}
+		suggestions = append(suggestions, suggestion{value: value, score: score})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].score != suggestions[j].score {
+			return suggestions[i].score > suggestions[j].score
+		}
+		return suggestions[i].value < suggestions[j].value
+	})
+	if statement.Limit > 0 && len(suggestions) > statement.Limit {
+		suggestions = suggestions[:statement.Limit]
+	}
+	result := make([]string, len(suggestions))
+	for idx := range suggestions {
+		result[idx] = suggestions[idx].value
+	}
+	return result
+}
+
+// metricPopularityKey returns the popularity key of metric name.
+func metricPopularityKey(database, namespace, metricName string) string {
+	return strings.Join([]string{database, namespace, metricName}, "\x00")
+}
+
+// tagValuePopularityKey returns the popularity key of tag value.
+func tagValuePopularityKey(database, namespace, metricName, tagKey, tagValue string) string {
+	return strings.Join([]string{database, namespace, metricName, tagKey, tagValue}, "\x00")
+}
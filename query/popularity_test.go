@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestQueryPopularity_Hit(t *testing.T) {
+	p := newQueryPopularity(2)
+	p.hit("a")
+	p.hit("a")
+	p.hit("b")
+	assert.Equal(t, 2, p.hits("a"))
+	assert.Equal(t, 1, p.hits("b"))
+	// evict least recently queried key
+	p.hit("c")
+	assert.Equal(t, 0, p.hits("a"))
+	assert.Equal(t, 1, p.hits("b"))
+	assert.Equal(t, 1, p.hits("c"))
+}
+
+func TestQueryPopularity_RecordQuery(t *testing.T) {
+	p := newQueryPopularity(100)
+	p.recordQuery("db", &stmtpkg.Query{
+		Namespace:  "ns",
+		MetricName: "cpu",
+		Condition: &stmtpkg.BinaryExpr{
+			Left: &stmtpkg.EqualsExpr{Key: "host", Value: "a"},
+			Right: &stmtpkg.ParenExpr{Expr: &stmtpkg.InExpr{
+				Key: "host", Values: []string{"a", "b"},
+			}},
+			Operator: stmtpkg.AND,
+		},
+	})
+	assert.Equal(t, 1, p.hits(metricPopularityKey("db", "ns", "cpu")))
+	assert.Equal(t, 2, p.hits(tagValuePopularityKey("db", "ns", "cpu", "host", "a")))
+	assert.Equal(t, 1, p.hits(tagValuePopularityKey("db", "ns", "cpu", "host", "b")))
+
+	p.recordQuery("db", &stmtpkg.Query{Namespace: "ns", MetricName: "cpu.*"})
+	assert.Equal(t, 0, p.hits(metricPopularityKey("db", "ns", "cpu.*")))
+}
+
+func TestQueryPopularity_Rank(t *testing.T) {
+	p := newQueryPopularity(100)
+	statement := &stmtpkg.MetricMetadata{Namespace: "ns", Type: stmtpkg.Metric, Prefix: "cpu", Limit: 3}
+	values := []string{"cpu.load", "system.cpu", "memory", "cpu.load", "cpu.idle", "c.p.u"}
+	assert.Equal(t, []string{"cpu.idle", "cpu.load", "system.cpu"}, p.rank("db", statement, values))
+
+	// popular substring match is ranked before cold prefix match
+	for i := 0; i < 20; i++ {
+		p.hit(metricPopularityKey("db", "ns", "system.cpu"))
+	}
+	assert.Equal(t, []string{"system.cpu", "cpu.idle", "cpu.load"}, p.rank("db", statement, values))
+
+	statement = &stmtpkg.MetricMetadata{Namespace: "ns", MetricName: "cpu", TagKey: "host", Type: stmtpkg.TagValue, Prefix: "web"}
+	p.hit(tagValuePopularityKey("db", "ns", "cpu", "host", "web-2"))
+	assert.Equal(t, []string{"web-2", "web-1"}, p.rank("db", statement, []string{"web-1", "web-2", "db-1"}))
+}
@@ -19,14 +19,20 @@ package query
 
 import (
 	"context"
+	"errors"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"go.uber.org/atomic"
+
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/strutil"
 	queryctx "github.com/lindb/lindb/query/context"
 	"github.com/lindb/lindb/query/stage"
@@ -39,12 +45,15 @@ import (
 // SearchMgr represents the dependencies for searching.
 type SearchMgr struct {
 	// for intermediate processor set reqeust id, must keep using same request id
-	RequestID    string
-	Timeout      time.Duration
-	CurNode      models.StatelessNode
-	Choose       flow.NodeChoose
-	TaskMgr      TaskManager
-	TransportMgr rpc.TransportManager
+	RequestID      string
+	Timeout        time.Duration
+	HedgeDelay     time.Duration                 // delay of issuing hedged leaf request, 0 means disable
+	SplitThreshold time.Duration                 // split query by segment if time range longer than threshold, 0 means disable
+	GroupBySpill   *queryctx.GroupBySpillOptions // spill groups into disk if too many groups, nil means disable
+	CurNode        models.StatelessNode
+	Choose         flow.NodeChoose
+	TaskMgr        TaskManager
+	TransportMgr   rpc.TransportManager
 }
 
 // MetricMetadataSearchWithResult represents the metadata query executor and retruns the final result set.
@@ -56,7 +65,12 @@ func MetricMetadataSearchWithResult(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return buildMetadataResultSet(statement, rs.([]string))
+	values := rs.([]string)
+	if statement.Fuzzy {
+		// rank fuzzy suggestions from all storage nodes by match score and recent query popularity
+		values = popularity.rank(param.Database, statement, values)
+	}
+	return buildMetadataResultSet(statement, values)
 }
 
 // MetricMetadata represents the metadata query executor, includes:
@@ -109,6 +123,36 @@ func MetricMetadataSearch(ctx context.Context,
 func MetricDataSearch(ctx context.Context,
 	param *models.ExecuteParam, statement *stmtpkg.Query,
 	mgr *SearchMgr,
+) (any, error) {
+	// evaluate sub queries of in expression first, values of sub queries are used as tag filter
+	if err := resolveInSubQueries(ctx, param, statement.Condition, mgr); err != nil {
+		return nil, err
+	}
+	if mgr.RequestID == "" {
+		// only root query of broker records query popularity
+		popularity.recordQuery(param.Database, statement)
+	}
+	if statement.IsMetricNamePattern() {
+		return multiMetricDataSearch(ctx, param, statement, mgr)
+	}
+	return singleMetricDataSearch(ctx, param, statement, mgr)
+}
+
+// singleMetricDataSearch executes the query of one metric, splits it into sub-queries if time range is too long.
+func singleMetricDataSearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
+) (any, error) {
+	if subQueries := splitMetricQuery(param, statement, mgr); len(subQueries) > 1 {
+		return splitMetricDataSearch(ctx, param, statement, subQueries, mgr)
+	}
+	return metricDataSearch(ctx, param, statement, mgr)
+}
+
+// metricDataSearch executes the metric data query.
+func metricDataSearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
 ) (any, error) {
 	req := models.NewRequest(mgr.CurNode.Indicator(), param.Database, param.SQL)
 	taskCtx := queryctx.NewRootMetricContext(
@@ -120,10 +164,143 @@ func MetricDataSearch(ctx context.Context,
 			Statement:    statement,
 			Choose:       mgr.Choose,
 			TransportMgr: mgr.TransportMgr,
+			HedgeDelay:   mgr.HedgeDelay,
+			GroupBySpill: mgr.GroupBySpill,
 		})
 	return exec(taskCtx, req, mgr)
 }
 
+// splitMetricQuery splits the long time range query into sub-queries by segment if query splitting enabled,
+// only root query of broker can be split.
+func splitMetricQuery(param *models.ExecuteParam, statement *stmtpkg.Query, mgr *SearchMgr) []*stmtpkg.Query {
+	if mgr.SplitThreshold <= 0 || mgr.RequestID != "" {
+		return nil
+	}
+	stateMgr, ok := mgr.Choose.(broker.StateManager)
+	if !ok {
+		return nil
+	}
+	databaseCfg, ok := stateMgr.GetDatabaseCfg(param.Database)
+	if !ok {
+		return nil
+	}
+	return queryctx.SplitQuery(statement, databaseCfg, mgr.SplitThreshold)
+}
+
+// splitMetricDataSearch executes the sub-queries in parallel, then merges the results of sub-queries.
+func splitMetricDataSearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query, subQueries []*stmtpkg.Query,
+	mgr *SearchMgr,
+) (any, error) {
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		once      sync.Once
+		firstErr  error
+		notFounds atomic.Int32
+	)
+	results := make([]*models.ResultSet, len(subQueries))
+	for idx := range subQueries {
+		idx := idx
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rs, err := metricDataSearch(subCtx, param, subQueries[idx], mgr)
+			if err != nil {
+				if errors.Is(err, constants.ErrNotFound) || errorpkg.CodeOf(err) == errorpkg.MetadataNotFound {
+					// no data in time range of sub-query
+					notFounds.Inc()
+					return
+				}
+				once.Do(func() {
+					firstErr = err
+					// fail fast, cancel other sub-queries
+					cancel()
+				})
+				return
+			}
+			results[idx], _ = rs.(*models.ResultSet)
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if int(notFounds.Load()) == len(subQueries) {
+		// all sub-queries not found, execute whole query for returning the real error
+		return metricDataSearch(ctx, param, statement, mgr)
+	}
+	for _, rs := range results {
+		if rs != nil && statement.Limit > 0 && len(rs.Series) >= statement.Limit {
+			// series of sub-query may be truncated by limit, results cannot be merged
+			return metricDataSearch(ctx, param, statement, mgr)
+		}
+	}
+	return mergeResultSets(subQueries, results, statement.Limit), nil
+}
+
+// mergeResultSets merges the results of sub-queries by series tags, sub-queries have same bucket alignment.
+func mergeResultSets(subQueries []*stmtpkg.Query, results []*models.ResultSet, limit int) *models.ResultSet {
+	first := subQueries[0]
+	resultSet := &models.ResultSet{
+		MetricName: first.MetricName,
+		GroupBy:    first.GroupBy,
+		StartTime:  first.TimeRange.Start,
+		EndTime:    subQueries[len(subQueries)-1].TimeRange.End,
+		Interval:   first.Interval.Int64(),
+	}
+	seriesMap := make(map[string]*models.Series)
+	fieldsMap := make(map[string]struct{})
+	groupByKeys := make(map[string]struct{})
+	for _, rs := range results {
+		if rs == nil {
+			continue
+		}
+		for _, tagKey := range rs.GroupBy {
+			groupByKeys[tagKey] = struct{}{}
+		}
+		if len(resultSet.FieldMetas) == 0 {
+			resultSet.FieldMetas = rs.FieldMetas
+		}
+		for _, fieldName := range rs.Fields {
+			fieldsMap[fieldName] = struct{}{}
+		}
+		for _, series := range rs.Series {
+			existSeries, ok := seriesMap[series.TagValues]
+			if !ok {
+				seriesMap[series.TagValues] = series
+				resultSet.AddSeries(series)
+				continue
+			}
+			for fieldName, points := range series.Fields {
+				existSeries.AddField(fieldName, &models.Points{Points: points})
+			}
+		}
+	}
+	sort.Slice(resultSet.Series, func(i, j int) bool {
+		return resultSet.Series[i].TagValues < resultSet.Series[j].TagValues
+	})
+	if limit > 0 && len(resultSet.Series) > limit {
+		resultSet.Series = resultSet.Series[:limit]
+	}
+	for fieldName := range fieldsMap {
+		resultSet.Fields = append(resultSet.Fields, fieldName)
+	}
+	sort.Strings(resultSet.Fields)
+	if first.GroupByAll {
+		// group by *, tag keys resolved under storage by each sub-query
+		resultSet.GroupBy = nil
+		for tagKey := range groupByKeys {
+			resultSet.GroupBy = append(resultSet.GroupBy, tagKey)
+		}
+		sort.Strings(resultSet.GroupBy)
+	}
+	return resultSet
+}
+
 // exec executes the query pipeline.
 func exec(ctx queryctx.TaskContext, req *models.Request, mgr *SearchMgr) (any, error) {
 	if strings.TrimSpace(req.DB) == "" {
@@ -158,8 +335,12 @@ func exec(ctx queryctx.TaskContext, req *models.Request, mgr *SearchMgr) (any, e
 
 // buildMetadataResultSet builds metric metadata result set.
 func buildMetadataResultSet(statement *stmtpkg.MetricMetadata, result []string) (*models.Metadata, error) {
-	values := strutil.DeDupStringSlice(result)
-	sort.Strings(values)
+	values := result
+	if !statement.Fuzzy {
+		// fuzzy suggestions keep the order of ranking
+		values = strutil.DeDupStringSlice(result)
+		sort.Strings(values)
+	}
 	switch statement.Type {
 	case stmtpkg.Field:
 		// build field result model
@@ -175,7 +356,7 @@ func buildMetadataResultSet(statement *stmtpkg.MetricMetadata, result []string)
 			}
 		}
 		// HistogramSum(sum), HistogramCount(sum), HistogramMin(min), HistogramMax(max) is visible
-		// __bucket_{id}(HistogramField) is not visible for api,
+		// __bucket_{id}(HistogramField)/HistogramSketch(SketchField) is not visible for api,
 		// underlying histogram data is only restricted access by user via quantile function
 		// furthermore, we suggest some quantile functions for user in field names, such as quantile(0.99)
 		var (
@@ -183,7 +364,7 @@ func buildMetadataResultSet(statement *stmtpkg.MetricMetadata, result []string)
 			hasHistogram bool
 		)
 		for _, f := range result {
-			if f.Type != field.HistogramField {
+			if f.Type != field.HistogramField && f.Type != field.SketchField {
 				resultFields = append(resultFields, models.Field{
 					Name: string(f.Name),
 					Type: f.Type.String(),
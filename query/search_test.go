@@ -20,12 +20,16 @@ package query
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	trackerpkg "github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql/stmt"
@@ -83,4 +87,75 @@ func TestBuildMetadataResultSet(t *testing.T) {
 	)
 	assert.NoError(t, err)
 	assert.NotNil(t, rs)
+
+	// keep the order of fuzzy ranking
+	rs, err = buildMetadataResultSet(&stmt.MetricMetadata{Type: stmt.Metric, Fuzzy: true}, []string{"cpu", "a.cpu"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cpu", "a.cpu"}, rs.Values)
+}
+
+func TestSplitMetricQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := timeutil.Now()
+	statement := &stmt.Query{TimeRange: timeutil.TimeRange{Start: now - 3*timeutil.OneDay, End: now}}
+	param := &models.ExecuteParam{Database: "test"}
+	// split disabled
+	assert.Nil(t, splitMetricQuery(param, statement, &SearchMgr{}))
+	// intermediate query
+	assert.Nil(t, splitMetricQuery(param, statement, &SearchMgr{SplitThreshold: time.Hour, RequestID: "req"}))
+	// not broker
+	assert.Nil(t, splitMetricQuery(param, statement, &SearchMgr{SplitThreshold: time.Hour}))
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{}, false)
+	assert.Nil(t, splitMetricQuery(param, statement, &SearchMgr{SplitThreshold: time.Hour, Choose: stateMgr}))
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(models.Database{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{{Interval: timeutil.Interval(10 * timeutil.OneSecond)}},
+		},
+	}, true)
+	assert.True(t, len(splitMetricQuery(param, statement, &SearchMgr{SplitThreshold: time.Hour, Choose: stateMgr})) > 1)
+}
+
+func TestMergeResultSets(t *testing.T) {
+	subQueries := []*stmt.Query{
+		{MetricName: "cpu", GroupBy: []string{"host"}, Interval: 10, TimeRange: timeutil.TimeRange{Start: 10, End: 20}},
+		{MetricName: "cpu", GroupBy: []string{"host"}, Interval: 10, TimeRange: timeutil.TimeRange{Start: 30, End: 40}},
+	}
+	newSeries := func(host string, points map[int64]float64) *models.Series {
+		series := models.NewSeries(map[string]string{"host": host}, host)
+		series.AddField("f", &models.Points{Points: points})
+		return series
+	}
+	results := []*models.ResultSet{
+		{Fields: []string{"f"}, Series: []*models.Series{newSeries("b", map[int64]float64{10: 1}), newSeries("a", map[int64]float64{20: 2})}},
+		{Fields: []string{"f"}, Series: []*models.Series{newSeries("a", map[int64]float64{30: 3}), newSeries("c", map[int64]float64{40: 4})}},
+		nil,
+	}
+	rs := mergeResultSets(subQueries, results, 2)
+	assert.Equal(t, "cpu", rs.MetricName)
+	assert.Equal(t, []string{"host"}, rs.GroupBy)
+	assert.Equal(t, []string{"f"}, rs.Fields)
+	assert.Equal(t, int64(10), rs.StartTime)
+	assert.Equal(t, int64(40), rs.EndTime)
+	assert.Equal(t, int64(10), rs.Interval)
+	assert.Len(t, rs.Series, 2)
+	assert.Equal(t, map[int64]float64{20: 2, 30: 3}, rs.Series[0].Fields["f"])
+	assert.Equal(t, map[int64]float64{10: 1}, rs.Series[1].Fields["f"])
+}
+
+func TestMergeResultSets_GroupByAll(t *testing.T) {
+	subQueries := []*stmt.Query{
+		{MetricName: "cpu", GroupByAll: true, Interval: 10, TimeRange: timeutil.TimeRange{Start: 10, End: 20}},
+		{MetricName: "cpu", GroupByAll: true, Interval: 10, TimeRange: timeutil.TimeRange{Start: 30, End: 40}},
+	}
+	results := []*models.ResultSet{
+		{GroupBy: []string{"zone", "host"}},
+		{GroupBy: []string{"host", "ip"}},
+		nil,
+	}
+	rs := mergeResultSets(subQueries, results, 0)
+	assert.Equal(t, []string{"host", "ip", "zone"}, rs.GroupBy)
 }
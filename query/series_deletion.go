@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/query/operator"
+	"github.com/lindb/lindb/series/tag"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// DeleteSeries deletes the series of shard which match the tag filter condition of delete series statement,
+// the deleted series are excluded by query immediately and purged by next compaction/rollup job,
+// returns the number of deleted series.
+func DeleteSeries(shard tsdb.Shard, statement *stmtpkg.DeleteSeries) (uint64, error) {
+	database := shard.Database()
+	metricID, err := database.Metadata().MetadataDatabase().GetMetricID(statement.Namespace, statement.MetricName)
+	if err != nil {
+		return 0, ignoreNotFound(err)
+	}
+	storageExecuteCtx := &flow.StorageExecuteContext{
+		Query: &stmtpkg.Query{
+			Namespace:  statement.Namespace,
+			MetricName: statement.MetricName,
+			Condition:  statement.Condition,
+		},
+		MetricID: metricID,
+		TagKeys:  make(map[string]tag.KeyID),
+	}
+	shardExecuteCtx := flow.NewShardExecuteContext(storageExecuteCtx)
+	storageExecuteCtx.ShardContexts = []*flow.ShardExecuteContext{shardExecuteCtx}
+	// release index/tag metadata snapshots after finding series ids
+	defer storageExecuteCtx.Release()
+	// reuse the operators of query for finding series ids
+	for _, op := range []operator.Operator{
+		operator.NewTagValuesLookup(storageExecuteCtx, database),
+		operator.NewSeriesFiltering(shardExecuteCtx, shard),
+	} {
+		if err = op.Execute(); err != nil {
+			return 0, ignoreNotFound(err)
+		}
+	}
+	seriesIDs := shardExecuteCtx.SeriesIDsAfterFiltering
+	if seriesIDs.IsEmpty() {
+		return 0, nil
+	}
+	if _, err = shard.IndexDatabase().DeleteSeries(metricID, seriesIDs); err != nil {
+		return 0, err
+	}
+	return seriesIDs.GetCardinality(), nil
+}
+
+// ignoreNotFound returns nil if metric/tag/series not found, because there is no series need to delete.
+func ignoreNotFound(err error) error {
+	if errors.Is(err, constants.ErrNotFound) {
+		return nil
+	}
+	return err
+}
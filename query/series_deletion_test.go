@@ -0,0 +1,149 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestDeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	tagMetaSnapshot := flow.NewMockTagMetadataSnapshot(ctrl)
+	tagMetaSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	tagMeta.EXPECT().GetSnapshot().Return(tagMetaSnapshot).AnyTimes()
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexSnapshot := flow.NewMockIndexSnapshot(ctrl)
+	indexSnapshot.EXPECT().Close().Return(nil).AnyTimes()
+	indexDB.EXPECT().GetSnapshot().Return(indexSnapshot).AnyTimes()
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadata.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	metadata.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	statement := &stmtpkg.DeleteSeries{
+		Namespace:  "ns",
+		MetricName: "cpu",
+		Condition:  &stmtpkg.EqualsExpr{Key: "host", Value: "x"},
+	}
+
+	cases := []struct {
+		name    string
+		prepare func()
+		deleted uint64
+		wantErr bool
+	}{
+		{
+			name: "metric not found",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.EmptyMetricID, constants.ErrMetricIDNotFound)
+			},
+		},
+		{
+			name: "get metric id failure",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.EmptyMetricID, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "tag value not found",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metaDB.EXPECT().GetTagKeyID("ns", "cpu", "host").Return(tag.KeyID(1), nil)
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(tag.KeyID(1), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "find series failure",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metaDB.EXPECT().GetTagKeyID("ns", "cpu", "host").Return(tag.KeyID(1), nil)
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(tag.KeyID(1), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "series all deleted",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metaDB.EXPECT().GetTagKeyID("ns", "cpu", "host").Return(tag.KeyID(1), nil)
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexDB.EXPECT().GetDeletedSeries(metric.ID(10)).Return(roaring.BitmapOf(1, 2), nil)
+			},
+		},
+		{
+			name: "delete series failure",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metaDB.EXPECT().GetTagKeyID("ns", "cpu", "host").Return(tag.KeyID(1), nil)
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexDB.EXPECT().GetDeletedSeries(metric.ID(10)).Return(nil, nil)
+				indexDB.EXPECT().DeleteSeries(metric.ID(10), gomock.Any()).Return(0, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete series successfully",
+			prepare: func() {
+				metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				metaDB.EXPECT().GetTagKeyID("ns", "cpu", "host").Return(tag.KeyID(1), nil)
+				tagMetaSnapshot.EXPECT().FindTagValueDsByExpr(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexSnapshot.EXPECT().GetSeriesIDsByTagValueIDs(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil)
+				indexDB.EXPECT().GetDeletedSeries(metric.ID(10)).Return(roaring.BitmapOf(1), nil)
+				indexDB.EXPECT().DeleteSeries(metric.ID(10), gomock.Any()).Return(2, nil)
+			},
+			deleted: 2,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			deleted, err := DeleteSeries(shard, statement)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			assert.Equal(t, tt.deleted, deleted)
+		})
+	}
+}
@@ -20,6 +20,7 @@ package stage
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/concurrent"
@@ -34,6 +35,9 @@ type baseStage struct {
 	execPool  concurrent.Pool
 
 	operators []*models.OperatorStats
+
+	current PlanNode // executing plan node
+	lock    sync.RWMutex
 }
 
 // Stats returns the stats of current stage.
@@ -41,6 +45,25 @@ func (stage *baseStage) Stats() []*models.OperatorStats {
 	return stage.operators
 }
 
+// CurrentOperator returns the identifier of executing operator, returns empty if no operator executing.
+func (stage *baseStage) CurrentOperator() string {
+	stage.lock.RLock()
+	defer stage.lock.RUnlock()
+
+	if stage.current == nil {
+		return ""
+	}
+	return stage.current.Identifier()
+}
+
+// setCurrent sets the executing plan node.
+func (stage *baseStage) setCurrent(node PlanNode) {
+	stage.lock.Lock()
+	defer stage.lock.Unlock()
+
+	stage.current = node
+}
+
 // Type returns the type of stage.
 func (stage *baseStage) Type() Type {
 	return stage.stageType
@@ -53,32 +76,44 @@ func (stage *baseStage) IsAsync() bool {
 
 // Execute executes the plan node, if it executes success invoke completeHandle func else invoke errHande func.
 func (stage *baseStage) Execute(node PlanNode, completeHandle func(), errHandle func(err error)) {
-	execFn := func() {
+	execFn := func(ctx context.Context) {
 		// execute sub plan tree for current stage
-		if err := stage.execute(node); err != nil {
+		if err := stage.execute(ctx, node); err != nil {
 			errHandle(err)
 		} else {
 			completeHandle()
 		}
 	}
 	if stage.IsAsync() {
-		stage.execPool.Submit(stage.ctx, concurrent.NewTask(func() {
-			execFn()
-		}, errHandle))
+		stage.execPool.Submit(stage.ctx, concurrent.NewContextTask(execFn, errHandle))
 	} else {
-		execFn()
+		ctx := stage.ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		execFn(ctx)
 	}
 }
 
-// execute the plan node under current stage.
-func (stage *baseStage) execute(node PlanNode) (err error) {
+// execute the plan node under current stage,
+// stops executing the remaining plan nodes if ctx is canceled(query timeout).
+func (stage *baseStage) execute(ctx context.Context, node PlanNode) (err error) {
 	if node == nil {
 		return nil
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// preemption point, background query yields to interactive queries between plan nodes
+	if err := concurrent.Yield(ctx); err != nil {
+		return err
+	}
 
 	var stats *models.OperatorStats
 	// execute current plan node logic
+	stage.setCurrent(node)
 	stats, err = node.ExecuteWithStats()
+	stage.setCurrent(nil)
 	if stats != nil {
 		stage.operators = append(stage.operators, stats)
 	}
@@ -92,7 +127,7 @@ func (stage *baseStage) execute(node PlanNode) (err error) {
 	// if it has child node, need execute child node logic
 	children := node.Children()
 	for idx := range children {
-		if err := stage.execute(children[idx]); err != nil {
+		if err := stage.execute(ctx, children[idx]); err != nil {
 			return err
 		}
 	}
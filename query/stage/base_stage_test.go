@@ -118,6 +118,19 @@ func TestBaseStage_Execute(t *testing.T) {
 				assert.True(t, true)
 			},
 		},
+		{
+			name: "query timeout, skip execute plan",
+			plan: NewMockPlanNode(ctrl),
+			prepare: func(_ *MockPlanNode) {
+				ctx, cancel := context.WithCancel(context.TODO())
+				cancel()
+				s.ctx = ctx
+				s.execPool = nil
+			},
+			errHandler: func(err error) {
+				assert.Equal(t, context.Canceled, err)
+			},
+		},
 		{
 			name: "execute plan successfully",
 			plan: NewMockPlanNode(ctrl),
@@ -139,6 +152,7 @@ func TestBaseStage_Execute(t *testing.T) {
 		t.Run(tt.name, func(_ *testing.T) {
 			defer func() {
 				s.ctx = context.TODO()
+				s.execPool = pool
 			}()
 			if tt.prepare != nil {
 				tt.prepare(tt.plan.(*MockPlanNode))
@@ -160,11 +174,16 @@ func TestBaseStage_Track(t *testing.T) {
 	}
 
 	p := NewMockPlanNode(ctrl)
-	p.EXPECT().ExecuteWithStats().Return(&models.OperatorStats{}, nil)
+	p.EXPECT().Identifier().Return("op")
+	p.EXPECT().ExecuteWithStats().DoAndReturn(func() (*models.OperatorStats, error) {
+		assert.Equal(t, "op", s.CurrentOperator())
+		return &models.OperatorStats{}, nil
+	})
 	p.EXPECT().Children().Return(nil)
 	s.Execute(p, func() {
 	}, func(err error) {
 	})
 	assert.NotNil(t, s.Stats())
 	assert.True(t, s.IsAsync())
+	assert.Empty(t, s.CurrentOperator())
 }
@@ -75,6 +75,8 @@ type Stage interface {
 	Identifier() string
 	// Stats returns the execution stats of current stage.
 	Stats() []*models.OperatorStats
+	// CurrentOperator returns the identifier of executing operator, returns empty if no operator executing.
+	CurrentOperator() string
 	// Type returns the type of stage.
 	Type() Type
 	// Plan plans sub execute tree for this stage.
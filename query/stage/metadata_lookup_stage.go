@@ -57,6 +57,10 @@ func (stage *metadataLookupStage) Plan() PlanNode {
 // NextStages returns the next stages after metadata lookup completed.
 func (stage *metadataLookupStage) NextStages() (stages []Stage) {
 	storageExecuteCtx := stage.leafExecuteCtx.StorageExecuteCtx
+	if storageExecuteCtx.Query.GroupByAll {
+		// group by tag keys resolved from metric's schema, re-init grouping context
+		stage.leafExecuteCtx.GroupingCtx.InitGroupByTagKeys()
+	}
 	shardIDs := storageExecuteCtx.ShardIDs
 	storageExecuteCtx.ShardContexts = make([]*flow.ShardExecuteContext, len(shardIDs))
 	for shardIdx := range shardIDs {
@@ -94,6 +94,7 @@ func (stage *metadataSuggestStage) NextStages() (stages []Stage) {
 		}
 		// if shard exist, add shard lookup stage
 		shardExecuteCtx := flow.NewShardExecuteContext(stage.ctx.StorageExecuteCtx)
+		stage.ctx.StorageExecuteCtx.ShardContexts = append(stage.ctx.StorageExecuteCtx.ShardContexts, shardExecuteCtx)
 		stages = append(stages, NewShardLookupStage(stage.ctx, shardExecuteCtx, shard))
 	}
 	return
@@ -38,6 +38,8 @@ type PlanNode interface {
 	AddChild(node PlanNode)
 	// IgnoreNotFound returns if the stage ignore not found error.
 	IgnoreNotFound() bool
+	// Identifier returns the identifier of operator, returns empty if node without operator.
+	Identifier() string
 }
 
 // planNode implements PlanNode interface.
@@ -112,3 +114,11 @@ func (p *planNode) AddChild(child PlanNode) {
 func (p *planNode) IgnoreNotFound() bool {
 	return p.ignore
 }
+
+// Identifier returns the identifier of operator, returns empty if node without operator.
+func (p *planNode) Identifier() string {
+	if p.op == nil {
+		return ""
+	}
+	return p.op.Identifier()
+}
@@ -48,6 +48,7 @@ func TestPlanNode(t *testing.T) {
 	empty := NewEmptyPlanNode()
 	n := empty.(*planNode)
 	assert.Nil(t, n.op)
+	assert.Empty(t, n.Identifier())
 	assert.NoError(t, n.Execute())
 	stats, err := n.ExecuteWithStats()
 	assert.NoError(t, err)
@@ -70,6 +71,7 @@ func TestPlanNode(t *testing.T) {
 
 	assert.True(t, NewPlanNodeWithIgnore(op).IgnoreNotFound())
 	plan = NewPlanNode(&mockOp{})
+	assert.Equal(t, "id", plan.Identifier())
 	stats, err = plan.ExecuteWithStats()
 	assert.NoError(t, err)
 	assert.NotNil(t, stats)
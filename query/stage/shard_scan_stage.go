@@ -62,8 +62,16 @@ func (stage *shardScanStage) Plan() PlanNode {
 	shard := stage.shard
 	// if shard exist, add shard to query list
 	families := shard.GetDataFamilies(queryStmt.StorageInterval.Type(), queryStmt.TimeRange)
+	// skip data family which has no data of metric in query time range
+	var matchedFamilies []tsdb.DataFamily
+	for _, family := range families {
+		if family.MayContain(shardExecuteCtx.StorageExecuteCtx) {
+			matchedFamilies = append(matchedFamilies, family)
+		}
+	}
+	families = matchedFamilies
 	if len(families) == 0 {
-		// no data family found
+		// no data family found, skip whole shard
 		return nil
 	}
 	execPlan := NewEmptyPlanNode()
@@ -81,8 +89,8 @@ func (stage *shardScanStage) Plan() PlanNode {
 		execPlan.AddChild(NewPlanNodeWithIgnore(operator.NewDataFamilyRead(shardExecuteCtx, family)))
 	}
 
-	if shardExecuteCtx.StorageExecuteCtx.Query.HasGroupBy() {
-		// if it has grouping, do group by tag keys, else just split series ids as batch first,
+	if shardExecuteCtx.StorageExecuteCtx.Query.HasGroupBy() || len(shardExecuteCtx.StorageExecuteCtx.DistinctTagKeyIDs) > 0 {
+		// if it has grouping(or count distinct), do group by tag keys, else just split series ids as batch first,
 		// get grouping context if it needs
 		// group context find task maybe change shardExecuteContext.SeriesIDsAfterFiltering value.
 		execPlan.AddChild(NewPlanNodeWithIgnore(operator.NewGroupingContextBuild(shardExecuteCtx, shard)))
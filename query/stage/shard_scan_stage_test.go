@@ -69,16 +69,26 @@ func TestShardScanStage(t *testing.T) {
 		shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).Return(nil)
 		assert.Nil(t, s.Plan())
 	})
+	newFamily := func(mayContain bool) tsdb.DataFamily {
+		family := tsdb.NewMockDataFamily(ctrl)
+		family.EXPECT().MayContain(gomock.Any()).Return(mayContain)
+		return family
+	}
+	t.Run("no data in family", func(t *testing.T) {
+		shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).
+			Return([]tsdb.DataFamily{newFamily(false)})
+		assert.Nil(t, s.Plan())
+	})
 	t.Run("all series", func(t *testing.T) {
 		storageCtx.Query.Condition = nil
 		shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).
-			Return([]tsdb.DataFamily{tsdb.NewMockDataFamily(ctrl)})
+			Return([]tsdb.DataFamily{newFamily(true), newFamily(false)})
 		assert.NotNil(t, s.Plan())
 	})
 	t.Run("query condition", func(t *testing.T) {
 		storageCtx.Query.Condition = &stmt.EqualsExpr{}
 		shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).
-			Return([]tsdb.DataFamily{tsdb.NewMockDataFamily(ctrl)})
+			Return([]tsdb.DataFamily{newFamily(true)})
 		assert.NotNil(t, s.Plan())
 	})
 
@@ -19,11 +19,14 @@ package query
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/pkg/encoding"
+	errorpkg "github.com/lindb/lindb/pkg/error"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -39,9 +42,19 @@ type TaskHandler struct {
 
 	taskPool concurrent.Pool
 
+	// request id/client node => running task, for canceling task by client(hedged request loser)
+	tasks map[runningTaskKey]*flow.TaskContext
+	mutex sync.Mutex
+
 	logger *logger.Logger
 }
 
+// runningTaskKey represents the key of running task.
+type runningTaskKey struct {
+	requestID string
+	client    string
+}
+
 // NewTaskHandler creates the task rpc handler
 func NewTaskHandler(
 	cfg config.Query,
@@ -55,6 +68,7 @@ func NewTaskHandler(
 		taskPool:  pool,
 		fct:       fct,
 		processor: processor,
+		tasks:     make(map[runningTaskKey]*flow.TaskContext),
 		logger:    logger.GetLogger("Query", "TaskHandler"),
 	}
 }
@@ -86,13 +100,32 @@ func (q *TaskHandler) Handle(stream protoCommonV1.TaskService_HandleServer) (err
 			q.logger.Error("task server stream error", logger.Error(err))
 			return err
 		}
-		q.process(stream.Context(), stream, req)
+		if req.GetCredits() > 0 && len(req.GetPhysicalPlan()) == 0 {
+			// receiver grants credits for streaming partial results
+			q.grantCredits(req.RequestID, nodeID, req.Credits)
+			continue
+		}
+		if req.GetCancel() {
+			// client cancels the running task(hedged request loser)
+			q.cancel(req.RequestID, nodeID)
+			continue
+		}
+		q.process(stream.Context(), nodeID, stream, req)
 	}
 }
 
 // process dispatches request with timeout
-func (q *TaskHandler) process(ctx context.Context, stream protoCommonV1.TaskService_HandleServer, req *protoCommonV1.TaskRequest) {
+func (q *TaskHandler) process(ctx context.Context, client string,
+	stream protoCommonV1.TaskService_HandleServer, req *protoCommonV1.TaskRequest,
+) {
+	// execute task with the priority class of request
+	ctx = concurrent.WithPriority(ctx, concurrent.Priority(req.GetPriority()))
+	// isolate the tasks of different databases if task pool is weighted fair pool
+	if database := databaseOfPlan(req.GetPhysicalPlan()); database != "" {
+		ctx = concurrent.WithTenant(ctx, database)
+	}
 	taskCtx := flow.NewTaskContextWithTimeout(ctx, q.timeout)
+	q.track(runningTaskKey{requestID: req.GetRequestID(), client: client}, taskCtx)
 	q.taskPool.Submit(taskCtx.Ctx,
 		concurrent.NewTask(func() {
 			if err := q.processor.Process(taskCtx, stream, req); err != nil {
@@ -101,6 +134,7 @@ func (q *TaskHandler) process(ctx context.Context, stream protoCommonV1.TaskServ
 					RequestID: req.RequestID,
 					Completed: true,
 					ErrMsg:    err.Error(),
+					ErrCode:   int32(errorpkg.CodeOf(err)),
 					SendTime:  timeutil.NowNano(),
 				}); sendError != nil {
 					q.logger.Error("failed to send error message to target stream",
@@ -115,6 +149,7 @@ func (q *TaskHandler) process(ctx context.Context, stream protoCommonV1.TaskServ
 				RequestID: req.RequestID,
 				Completed: true,
 				ErrMsg:    err.Error(),
+				ErrCode:   int32(errorpkg.CodeOf(err)),
 				SendTime:  timeutil.NowNano(),
 			}); sendError != nil {
 				q.logger.Error("failed to send error message to target stream",
@@ -124,3 +159,64 @@ func (q *TaskHandler) process(ctx context.Context, stream protoCommonV1.TaskServ
 			}
 		}))
 }
+
+// databaseOfPlan returns the database name of physical plan, returns empty if plan is bad.
+func databaseOfPlan(plan []byte) string {
+	if len(plan) == 0 {
+		return ""
+	}
+	p := struct {
+		Database string `json:"database"`
+	}{}
+	if err := encoding.JSONUnmarshal(plan, &p); err != nil {
+		return ""
+	}
+	return p.Database
+}
+
+// track tracks the running task until task completed(released) or timeout.
+func (q *TaskHandler) track(key runningTaskKey, taskCtx *flow.TaskContext) {
+	q.mutex.Lock()
+	q.tasks[key] = taskCtx
+	q.mutex.Unlock()
+
+	go func() {
+		<-taskCtx.Ctx.Done()
+
+		q.mutex.Lock()
+		if q.tasks[key] == taskCtx {
+			delete(q.tasks, key)
+		}
+		q.mutex.Unlock()
+	}()
+}
+
+// grantCredits grants credits to the partial result streams of running task, which send to receiver.
+func (q *TaskHandler) grantCredits(requestID, receiver string, credits int32) {
+	var tasks []*flow.TaskContext
+	q.mutex.Lock()
+	for key, taskCtx := range q.tasks {
+		// receiver maybe not the client which sent the request(intermediate task)
+		if key.requestID == requestID {
+			tasks = append(tasks, taskCtx)
+		}
+	}
+	q.mutex.Unlock()
+
+	for _, taskCtx := range tasks {
+		taskCtx.GrantResultCredits(receiver, credits)
+	}
+}
+
+// cancel cancels the running task of request which sent by client.
+func (q *TaskHandler) cancel(requestID, client string) {
+	q.mutex.Lock()
+	taskCtx, ok := q.tasks[runningTaskKey{requestID: requestID, client: client}]
+	q.mutex.Unlock()
+
+	if ok {
+		q.logger.Info("cancel running task by client",
+			logger.String("requestID", requestID), logger.String("client", client))
+		taskCtx.Cancel()
+	}
+}
@@ -71,6 +71,41 @@ func TestTaskHandler_Handle(t *testing.T) {
 	server.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
 	processor.EXPECT().Process(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	_ = handler.Handle(server)
+
+	// grant credits, not dispatch to processor
+	server.EXPECT().Context().Return(ctx).MaxTimes(2)
+	server.EXPECT().Recv().Return(&protoCommonV1.TaskRequest{RequestID: "req", Credits: 1}, nil)
+	server.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
+	_ = handler.Handle(server)
+	// grant credits to the result stream of running task
+	receiver := (&models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}).Indicator()
+	streamTaskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	handler.track(runningTaskKey{requestID: "stream-req", client: "root"}, streamTaskCtx)
+	credits := streamTaskCtx.ResultCredits(receiver, 1)
+	assert.NoError(t, credits.Acquire(context.TODO()))
+	server.EXPECT().Context().Return(ctx).MaxTimes(2)
+	server.EXPECT().Recv().Return(&protoCommonV1.TaskRequest{RequestID: "stream-req", Credits: 1}, nil)
+	server.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
+	_ = handler.Handle(server)
+	assert.NoError(t, credits.Acquire(streamTaskCtx.Ctx))
+	streamTaskCtx.Release()
+
+	// cancel running task, not dispatch to processor
+	client := (&models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}).Indicator()
+	taskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	handler.track(runningTaskKey{requestID: "req", client: client}, taskCtx)
+	server.EXPECT().Context().Return(ctx).MaxTimes(2)
+	server.EXPECT().Recv().Return(&protoCommonV1.TaskRequest{RequestID: "req", Cancel: true}, nil)
+	server.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
+	_ = handler.Handle(server)
+	assert.Equal(t, context.Canceled, taskCtx.Err())
+	// task removed after canceled
+	assert.Eventually(t, func() bool {
+		handler.mutex.Lock()
+		defer handler.mutex.Unlock()
+		_, ok := handler.tasks[runningTaskKey{requestID: "req", client: client}]
+		return !ok
+	}, time.Second, 10*time.Millisecond)
 }
 
 func TestTaskHandler_dispatch(t *testing.T) {
@@ -89,8 +124,14 @@ func TestTaskHandler_dispatch(t *testing.T) {
 		DoAndReturn(func(ctx *flow.TaskContext, stream protoCommonV1.TaskService_HandleServer, req *protoCommonV1.TaskRequest) error {
 			panic("err")
 		})
-	handler.process(context.Background(), stream, req)
+	handler.process(context.Background(), "client", stream, req)
 	processor.EXPECT().Process(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
-	handler.process(context.Background(), stream, req)
+	handler.process(context.Background(), "client", stream, req)
 	time.Sleep(300 * time.Millisecond)
 }
+
+func TestTaskHandler_databaseOfPlan(t *testing.T) {
+	assert.Empty(t, databaseOfPlan(nil))
+	assert.Empty(t, databaseOfPlan([]byte("bad plan")))
+	assert.Equal(t, "test-db", databaseOfPlan([]byte(`{"database":"test-db","targets":[]}`)))
+}
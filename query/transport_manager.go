@@ -31,21 +31,25 @@ import (
 type transportManager struct {
 	taskClientFactory rpc.TaskClientFactory
 	taskServerFactory rpc.TaskServerFactory
+	connectionMgr     rpc.ConnectionManager
 
 	statistics *metrics.TransportStatistics
 
 	logger *logger.Logger
 }
 
-// NewTransportManager creates a rpc transport manager instance.
+// NewTransportManager creates a rpc transport manager instance,
+// connectionMgr is optional, if set, requests are guarded by the circuit breaker of target node.
 func NewTransportManager(
 	taskClientFactory rpc.TaskClientFactory,
 	taskServerFactory rpc.TaskServerFactory,
+	connectionMgr rpc.ConnectionManager,
 	registry *linmetric.Registry,
 ) rpc.TransportManager {
 	return &transportManager{
 		taskClientFactory: taskClientFactory,
 		taskServerFactory: taskServerFactory,
+		connectionMgr:     connectionMgr,
 		statistics:        metrics.NewTransportStatistics(registry),
 		logger:            logger.GetLogger("Query", "TransportManager"),
 	}
@@ -54,19 +58,40 @@ func NewTransportManager(
 // SendRequest sends the task request to target node.
 func (mgr *transportManager) SendRequest(targetNodeID string, req *protoCommonV1.TaskRequest) error {
 	mgr.logger.Debug("send query task", logger.String("target", targetNodeID))
+	// control request(credit grant/cancel) has no response, cannot be used as probe of circuit breaker
+	expectResponse := len(req.PhysicalPlan) > 0 && !req.Cancel
+	if expectResponse && mgr.connectionMgr != nil && !mgr.connectionMgr.AllowRequest(targetNodeID) {
+		// fail fast, target node is broken
+		mgr.statistics.SentRequestFailures.Incr()
+		return fmt.Errorf("SendRequest: %w, targetNodeID: %s", ErrCircuitBreakerOpen, targetNodeID)
+	}
 	client := mgr.taskClientFactory.GetTaskClient(targetNodeID)
 	if client == nil {
 		mgr.statistics.SentRequestFailures.Incr()
+		mgr.ReportResponse(targetNodeID, ErrNoSendStream)
 		return fmt.Errorf("SendRequest: %w, targetNodeID: %s", ErrNoSendStream, targetNodeID)
 	}
 	if err := client.Send(req); err != nil {
 		mgr.statistics.SentRequestFailures.Incr()
+		mgr.ReportResponse(targetNodeID, err)
 		return fmt.Errorf("SendRequest: %w, targetNodeID: %s", ErrTaskSend, targetNodeID)
 	}
 	mgr.statistics.SentRequest.Incr()
 	return nil
 }
 
+// ReportResponse reports the response result of target node for circuit breaker.
+func (mgr *transportManager) ReportResponse(targetNodeID string, err error) {
+	if mgr.connectionMgr == nil {
+		return
+	}
+	if err != nil {
+		mgr.connectionMgr.ReportFailure(targetNodeID)
+	} else {
+		mgr.connectionMgr.ReportSuccess(targetNodeID)
+	}
+}
+
 // SendResponse sends the task response to target node.
 func (mgr *transportManager) SendResponse(targetNodeID string, resp *protoCommonV1.TaskResponse) error {
 	stream := mgr.taskServerFactory.GetStream(targetNodeID)
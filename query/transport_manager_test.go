@@ -18,6 +18,7 @@
 package query
 
 import (
+	"errors"
 	"io"
 	"testing"
 
@@ -35,7 +36,7 @@ func TestTransportManager_SendResponse(t *testing.T) {
 
 	taskServerFactory := rpc.NewMockTaskServerFactory(ctrl)
 
-	transportMgr := NewTransportManager(nil, taskServerFactory, linmetric.RootRegistry)
+	transportMgr := NewTransportManager(nil, taskServerFactory, nil, linmetric.RootRegistry)
 
 	// empty stream
 	taskServerFactory.EXPECT().GetStream(gomock.Any()).Return(nil)
@@ -58,7 +59,7 @@ func TestTransportManager_SendRequest(t *testing.T) {
 
 	taskClientFactory := rpc.NewMockTaskClientFactory(ctrl)
 
-	transportMgr := NewTransportManager(taskClientFactory, nil, linmetric.RootRegistry)
+	transportMgr := NewTransportManager(taskClientFactory, nil, nil, linmetric.RootRegistry)
 
 	// empty stream
 	taskClientFactory.EXPECT().GetTaskClient(gomock.Any()).Return(nil)
@@ -74,3 +75,40 @@ func TestTransportManager_SendRequest(t *testing.T) {
 	client.EXPECT().Send(gomock.Any()).Return(nil)
 	assert.Nil(t, transportMgr.SendRequest("1", &protoCommonV1.TaskRequest{}))
 }
+
+func TestTransportManager_CircuitBreaker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskClientFactory := rpc.NewMockTaskClientFactory(ctrl)
+	connectionMgr := rpc.NewMockConnectionManager(ctrl)
+	transportMgr := NewTransportManager(taskClientFactory, nil, connectionMgr, linmetric.RootRegistry)
+
+	// circuit breaker open, fail fast
+	connectionMgr.EXPECT().AllowRequest("1").Return(false)
+	err := transportMgr.SendRequest("1", &protoCommonV1.TaskRequest{PhysicalPlan: []byte("plan")})
+	assert.True(t, errors.Is(err, ErrCircuitBreakerOpen))
+
+	// control request doesn't check circuit breaker
+	taskClientFactory.EXPECT().GetTaskClient(gomock.Any()).Return(nil).Times(2)
+	connectionMgr.EXPECT().ReportFailure("1").Times(2)
+	assert.Error(t, transportMgr.SendRequest("1", &protoCommonV1.TaskRequest{Credits: 1}))
+	assert.Error(t, transportMgr.SendRequest("1", &protoCommonV1.TaskRequest{PhysicalPlan: []byte("plan"), Cancel: true}))
+
+	// send failure, report it
+	connectionMgr.EXPECT().AllowRequest("1").Return(true).Times(2)
+	taskClientFactory.EXPECT().GetTaskClient(gomock.Any()).Return(nil)
+	connectionMgr.EXPECT().ReportFailure("1")
+	assert.Error(t, transportMgr.SendRequest("1", &protoCommonV1.TaskRequest{PhysicalPlan: []byte("plan")}))
+	client := protoCommonV1.NewMockTaskService_HandleClient(ctrl)
+	taskClientFactory.EXPECT().GetTaskClient(gomock.Any()).Return(client)
+	client.EXPECT().Send(gomock.Any()).Return(io.ErrClosedPipe)
+	connectionMgr.EXPECT().ReportFailure("1")
+	assert.Error(t, transportMgr.SendRequest("1", &protoCommonV1.TaskRequest{PhysicalPlan: []byte("plan")}))
+
+	// report response
+	connectionMgr.EXPECT().ReportSuccess("1")
+	transportMgr.ReportResponse("1", nil)
+	transportMgr = NewTransportManager(taskClientFactory, nil, nil, linmetric.RootRegistry)
+	transportMgr.ReportResponse("1", nil)
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -47,6 +48,8 @@ type DatabaseChannel interface {
 	CreateChannel(numOfShard int32, shardID models.ShardID) (ShardChannel, error)
 	// Stop stops current database write shardChannel.
 	Stop()
+	// ChannelStates returns the write state of all family channels of database.
+	ChannelStates() []models.ReplicaChannelState
 
 	// garbageCollect recycles write families which is expired.
 	garbageCollect()
@@ -70,6 +73,7 @@ type (
 		interval      timeutil.Interval
 
 		statistics *metrics.BrokerDatabaseWriteStatistics
+		latency    *metrics.BrokerWriteLatencyStatistics
 		logger     *logger.Logger
 	}
 )
@@ -88,6 +92,7 @@ func newDatabaseChannel(
 		cancel:      cancel,
 		fct:         fct,
 		statistics:  metrics.NewBrokerDatabaseWriteStatistics(databaseCfg.Name),
+		latency:     metrics.NewBrokerWriteLatencyStatistics(databaseCfg.Name),
 		logger:      logger.GetLogger("Replica", "DatabaseChannel"),
 	}
 	ch.shardChannels.value.Store(make(shard2Channel))
@@ -125,6 +130,9 @@ func (dc *databaseChannel) garbageCollect() {
 func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.BrokerBatchRows) error {
 	var err error
 
+	start := time.Now()
+	defer dc.latency.Enqueue.UpdateSince(start)
+
 	behind := dc.behind.Load()
 	ahead := dc.ahead.Load()
 
@@ -202,6 +210,16 @@ func (dc *databaseChannel) Stop() {
 }
 
 // getChannelByShardID gets the replica shardChannel by shard id
+// ChannelStates returns the write state of all family channels of database.
+func (dc *databaseChannel) ChannelStates() []models.ReplicaChannelState {
+	var states []models.ReplicaChannelState
+	channels := dc.shardChannels.value.Load().(shard2Channel)
+	for _, channel := range channels {
+		states = append(states, channel.FamilyStates()...)
+	}
+	return states
+}
+
 func (dc *databaseChannel) getChannelByShardID(shardID models.ShardID) (ShardChannel, bool) {
 	ch, ok := dc.shardChannels.value.Load().(shard2Channel)[shardID]
 	return ch, ok
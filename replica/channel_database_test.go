@@ -124,6 +124,9 @@ func TestDatabaseChannel_Stop(t *testing.T) {
 	shardCh.EXPECT().garbageCollect(gomock.Any(), gomock.Any())
 	ch.garbageCollect()
 
+	shardCh.EXPECT().FamilyStates().Return([]models.ReplicaChannelState{{Database: "database"}})
+	assert.Len(t, ch.ChannelStates(), 1)
+
 	shardCh.EXPECT().Stop()
 	ch.Stop()
 }
@@ -52,6 +52,35 @@ type FamilyChannel interface {
 	FamilyTime() int64
 	// isExpire returns if current family is expired.
 	isExpire(ahead, behind int64) bool
+	// resend re-sends the compressed message replayed from spool.
+	resend(data []byte) error
+	// State returns the write state of current family channel.
+	State() models.ReplicaChannelState
+}
+
+// familyChannelState represents the send state of family channel.
+type familyChannelState struct {
+	pending      atomic.Int64 // number of message waiting send
+	pendingBytes atomic.Int64 // bytes of message waiting send
+	retryBuffers atomic.Int64 // number of message in retry buffer
+	retry        atomic.Int64 // number of message retried
+	retryDrop    atomic.Int64 // number of message dropped
+	lastSendTime atomic.Int64 // last successful send time
+}
+
+// enqueue records the message waiting send.
+func (s *familyChannelState) enqueue(compressed *compressedChunk) {
+	if compressed == nil {
+		return
+	}
+	s.pending.Inc()
+	s.pendingBytes.Add(int64(len(*compressed)))
+}
+
+// dequeue records the message sent or dropped.
+func (s *familyChannelState) dequeue(compressed *compressedChunk) {
+	s.pending.Dec()
+	s.pendingBytes.Sub(int64(len(*compressed)))
 }
 
 // familyChannel implements FamilyChannel interface.
@@ -80,8 +109,12 @@ type familyChannel struct {
 	leaderChangedSignal chan struct{}
 	stoppedSignal       chan struct{}
 	stoppingSignal      chan struct{}
-	chunk               Chunk // buffer current writeTask metric for compress
+	chunk               Chunk    // buffer current writeTask metric for compress
+	batchTime           int64    // create time of batch buffered in chunk(first row written), guarded by lock4write
+	batchTimes          sync.Map // create time of compressed batch waiting send, *compressedChunk => int64
+	replayAcks          sync.Map // result of message replayed from spool waiting send, *compressedChunk => chan bool
 
+	createTime         int64         // create time of family channel
 	lastFlushTime      *atomic.Int64 // last flush time
 	checkFlushInterval time.Duration // interval for check flush
 	batchTimeout       time.Duration // interval for flush
@@ -90,7 +123,13 @@ type familyChannel struct {
 	lock4write sync.Mutex
 	lock4meta  sync.Mutex
 
+	spool   Spool              // spool message to disk when too many retry messages, maybe nil if disabled
+	sampler *writeAuditSampler // samples message for write audit, maybe nil if disabled
+
+	state familyChannelState
+
 	statistics *metrics.BrokerFamilyWriteStatistics
+	latency    *metrics.BrokerWriteLatencyStatistics
 	logger     *logger.Logger
 }
 
@@ -103,6 +142,7 @@ func newFamilyChannel(
 	fct rpc.ClientStreamFactory,
 	shardState models.ShardState,
 	liveNodes map[models.NodeID]models.StatefulNode,
+	spool Spool,
 ) FamilyChannel {
 	c, cancel := context.WithCancel(ctx)
 	fc := &familyChannel{
@@ -123,8 +163,12 @@ func newFamilyChannel(
 		batchTimeout:        cfg.BatchTimeout.Duration(),
 		maxRetryBuf:         100, // TODO add config
 		chunk:               newChunk(cfg.BatchBlockSize),
+		createTime:          timeutil.Now(),
 		lastFlushTime:       atomic.NewInt64(timeutil.Now()),
+		spool:               spool,
+		sampler:             newWriteAuditSampler(cfg.AuditSampleRatio),
 		statistics:          metrics.NewBrokerFamilyWriteStatistics(database),
+		latency:             metrics.NewBrokerWriteLatencyStatistics(database),
 		logger:              logger.GetLogger("Replica", "FamilyChannel"),
 	}
 
@@ -146,6 +190,12 @@ func (fc *familyChannel) Write(ctx context.Context, rows []metric.BrokerRow) err
 	total := len(rows)
 	success := 0
 
+	if fc.spool != nil && fc.spool.IsFull() {
+		// storage unreachable and spool is full, reject new write
+		fc.statistics.BatchMetricFailures.Add(float64(total))
+		return ErrSpoolFull
+	}
+
 	fc.lock4write.Lock()
 	defer func() {
 		if total > 0 {
@@ -156,6 +206,10 @@ func (fc *familyChannel) Write(ctx context.Context, rows []metric.BrokerRow) err
 	}()
 
 	for idx := 0; idx < total; idx++ {
+		if fc.batchTime == 0 {
+			// first row of new batch
+			fc.batchTime = timeutil.Now()
+		}
 		if _, err := rows[idx].WriteTo(fc.chunk); err != nil {
 			return err
 		}
@@ -187,17 +241,20 @@ func (fc *familyChannel) flushChunkOnFull(ctx context.Context) error {
 	if !fc.chunk.IsFull() {
 		return nil
 	}
-	compressed, err := fc.chunk.Compress()
+	compressed, err := fc.compressChunk()
 	if err != nil {
 		return err
 	}
 
 	select {
 	case <-ctx.Done(): // timeout of http ingestion api
+		fc.batchTimes.Delete(compressed)
 		return ErrIngestTimeout
 	case <-fc.ctx.Done():
+		fc.batchTimes.Delete(compressed)
 		return ErrFamilyChannelCanceled
 	case fc.ch <- compressed:
+		fc.state.enqueue(compressed)
 		fc.lastFlushTime.Store(timeutil.Now())
 		return nil
 	}
@@ -210,13 +267,37 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 	defer ticker.Stop()
 
 	retryBuffers := make([]*compressedChunk, 0)
+	auditIDs := make(map[*compressedChunk]int64) // audit id of sampled message which is sending
 	retry := func(compressed *compressedChunk) {
+		if fc.ackReplay(compressed, false) {
+			// replayed message is kept in spool, replay it again after storage reachable
+			delete(auditIDs, compressed)
+			fc.statistics.PendingSend.Decr()
+			fc.state.dequeue(compressed)
+			return
+		}
 		if len(retryBuffers) > fc.maxRetryBuf {
+			delete(auditIDs, compressed)
+			if fc.spool != nil {
+				// spool message to disk, replay it after storage reachable
+				if err := fc.spool.Put(fc.familyTime, *compressed); err == nil {
+					fc.statistics.PendingSend.Decr()
+					fc.state.dequeue(compressed)
+					fc.batchTimes.Delete(compressed)
+					compressed.Release()
+					return
+				}
+			}
 			fc.logger.Error("too many retry messages, drop current message")
 			fc.statistics.RetryDrop.Incr()
+			fc.state.retryDrop.Inc()
+			fc.state.dequeue(compressed)
+			fc.batchTimes.Delete(compressed)
 		} else {
 			retryBuffers = append(retryBuffers, compressed)
 			fc.statistics.Retry.Incr()
+			fc.state.retry.Inc()
+			fc.state.retryBuffers.Store(int64(len(retryBuffers)))
 		}
 	}
 	var stream rpc.WriteStream
@@ -243,7 +324,22 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 			fc.statistics.CreateStream.Incr()
 			stream = s
 		}
-		if err := stream.Send(*compressed); err != nil {
+		auditID, ok := auditIDs[compressed]
+		if !ok {
+			if auditID = fc.sampler.next(); auditID != 0 {
+				// keep audit id for retry message
+				auditIDs[compressed] = auditID
+				fc.statistics.AuditSampled.Incr()
+			}
+		}
+		batchTime := fc.batchTimeOf(compressed)
+		var err error
+		if auditID != 0 {
+			err = stream.SendAudit(auditID, *compressed, batchTime)
+		} else {
+			err = stream.Send(*compressed, batchTime)
+		}
+		if err != nil {
 			fc.statistics.SendFailure.Incr()
 			fc.logger.Error(
 				"failed writing compressed chunk to storage",
@@ -268,7 +364,18 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 		fc.statistics.SendSuccess.Incr()
 		fc.statistics.SendSize.Add(float64(len(*compressed)))
 		fc.statistics.PendingSend.Decr()
+		fc.state.dequeue(compressed)
+		fc.state.lastSendTime.Store(timeutil.Now())
+		if batchTime > 0 {
+			fc.latency.Send.UpdateMilliseconds(float64(timeutil.Now() - batchTime))
+			fc.batchTimes.Delete(compressed)
+		}
+		delete(auditIDs, compressed)
+		fc.ackReplay(compressed, true)
 		compressed.Release()
+		if fc.spool != nil {
+			fc.spool.Notify()
+		}
 		return true
 	}
 
@@ -296,10 +403,11 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 		// flush chunk pending data if chunk not empty
 		if !fc.chunk.IsEmpty() {
 			// flush chunk pending data if chunk not empty
-			compressed, err0 := fc.chunk.Compress()
+			compressed, err0 := fc.compressChunk()
 			if err0 != nil {
 				fc.logger.Error("compress chunk err when send last chunk data", logger.Error(err0))
 			} else {
+				fc.state.enqueue(compressed)
 				sendLastMsg(compressed)
 			}
 		}
@@ -331,6 +439,7 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 				if len(retryBuffers) > 0 {
 					messages := retryBuffers
 					retryBuffers = make([]*compressedChunk, 0)
+					fc.state.retryBuffers.Store(0)
 					for _, msg := range messages {
 						if !send(msg) {
 							retry(msg)
@@ -386,7 +495,7 @@ func (fc *familyChannel) Stop(timeout int64) {
 
 // flushChunk flushes the chunk data and appends data into queue
 func (fc *familyChannel) flushChunk() {
-	compressed, err := fc.chunk.Compress()
+	compressed, err := fc.compressChunk()
 	if err != nil {
 		fc.logger.Error("compress chunk err", logger.Error(err))
 		return
@@ -397,11 +506,75 @@ func (fc *familyChannel) flushChunk() {
 	select {
 	case fc.ch <- compressed:
 		fc.statistics.PendingSend.Incr()
+		fc.state.enqueue(compressed)
 	case <-fc.ctx.Done():
+		fc.batchTimes.Delete(compressed)
 		fc.logger.Warn("writer is canceled")
 	}
 }
 
+// compressChunk compresses the batch buffered in chunk, keeps the create time of batch for latency statistics.
+func (fc *familyChannel) compressChunk() (*compressedChunk, error) {
+	batchTime := fc.batchTime
+	fc.batchTime = 0
+	compressed, err := fc.chunk.Compress()
+	if err != nil {
+		return nil, err
+	}
+	if compressed != nil && len(*compressed) > 0 && batchTime > 0 {
+		fc.batchTimes.Store(compressed, batchTime)
+	}
+	return compressed, nil
+}
+
+// batchTimeOf returns the create time of compressed batch, returns 0 if unknown(replayed from spool).
+func (fc *familyChannel) batchTimeOf(compressed *compressedChunk) int64 {
+	if batchTime, ok := fc.batchTimes.Load(compressed); ok {
+		return batchTime.(int64)
+	}
+	return 0
+}
+
+// ackReplay notifies the send result of message replayed from spool, returns if message is replayed.
+func (fc *familyChannel) ackReplay(compressed *compressedChunk, sent bool) bool {
+	ack, ok := fc.replayAcks.LoadAndDelete(compressed)
+	if ok {
+		ack.(chan bool) <- sent
+	}
+	return ok
+}
+
+// resend re-sends the compressed message replayed from spool, waits until message is sent to storage,
+// so that spool acknowledges the message only after it is sent.
+func (fc *familyChannel) resend(data []byte) error {
+	select {
+	case <-fc.stoppingSignal:
+		// channel is stopping, cannot send message into closed channel
+		return ErrFamilyChannelCanceled
+	default:
+	}
+	compressed := compressedChunk(data)
+	sent := make(chan bool, 1)
+	fc.replayAcks.Store(&compressed, sent)
+	select {
+	case <-fc.ctx.Done():
+		fc.replayAcks.Delete(&compressed)
+		return ErrFamilyChannelCanceled
+	case fc.ch <- &compressed:
+		fc.statistics.PendingSend.Incr()
+		fc.state.enqueue(&compressed)
+	}
+	select {
+	case <-fc.ctx.Done():
+		return ErrFamilyChannelCanceled
+	case ok := <-sent:
+		if !ok {
+			return errReplaySendFailure
+		}
+		return nil
+	}
+}
+
 // isExpire returns if current family is expired.
 func (fc *familyChannel) isExpire(ahead, _ int64) bool {
 	now := timeutil.Now()
@@ -414,6 +587,37 @@ func (fc *familyChannel) isExpire(ahead, _ int64) bool {
 	return fc.lastFlushTime.Load()+ahead+15*time.Minute.Milliseconds() < now
 }
 
+// State returns the write state of current family channel.
+func (fc *familyChannel) State() models.ReplicaChannelState {
+	fc.lock4meta.Lock()
+	state := models.ReplicaChannelState{
+		Database:   fc.database,
+		ShardID:    fc.shardID,
+		FamilyTime: fc.familyTime,
+		Leader:     fc.shardState.Leader,
+	}
+	if fc.currentTarget != nil {
+		state.Target = fc.currentTarget.Indicator()
+	}
+	fc.lock4meta.Unlock()
+
+	state.Pending = fc.state.pending.Load()
+	state.PendingBytes = fc.state.pendingBytes.Load()
+	state.RetryBuffers = fc.state.retryBuffers.Load()
+	state.Retry = fc.state.retry.Load()
+	state.RetryDrop = fc.state.retryDrop.Load()
+	state.LastSendTime = fc.state.lastSendTime.Load()
+	if state.Pending > 0 {
+		// lag since last successful send(or family created if never sent)
+		since := state.LastSendTime
+		if since == 0 {
+			since = fc.createTime
+		}
+		state.Lag = timeutil.Now() - since
+	}
+	return state
+}
+
 // FamilyTime returns the family time of current shardChannel.
 func (fc *familyChannel) FamilyTime() int64 {
 	return fc.familyTime
@@ -42,12 +42,12 @@ import (
 
 func TestFamilyChannel_new(t *testing.T) {
 	f := newFamilyChannel(context.TODO(), config.Write{}, "db", 1,
-		1, nil, models.ShardState{}, nil)
+		1, nil, models.ShardState{}, nil, nil)
 	assert.NotNil(t, f)
 	f.Stop(10)
 
 	f = newFamilyChannel(context.TODO(), config.Write{}, "db", 1,
-		1, nil, models.ShardState{}, nil)
+		1, nil, models.ShardState{}, nil, nil)
 	assert.NotNil(t, f)
 	go func() {
 		time.Sleep(100 * time.Millisecond)
@@ -119,6 +119,7 @@ func TestFamilyChannel_Write(t *testing.T) {
 				stoppedSignal:  make(chan struct{}, 1),
 				stoppingSignal: make(chan struct{}, 1),
 				statistics:     metrics.NewBrokerFamilyWriteStatistics("db"),
+				latency:        metrics.NewBrokerWriteLatencyStatistics("db"),
 			}
 			if tt.prepare != nil {
 				tt.prepare()
@@ -139,6 +140,7 @@ func TestFamilyChannel_leaderChanged(t *testing.T) {
 	fc := &familyChannel{
 		leaderChangedSignal: make(chan struct{}, 1),
 		statistics:          metrics.NewBrokerFamilyWriteStatistics("db"),
+		latency:             metrics.NewBrokerWriteLatencyStatistics("db"),
 	}
 	fc.leaderChanged(shard, liveNodes)
 	fc.lock4meta.Lock()
@@ -165,6 +167,7 @@ func TestChannel_checkFlush(t *testing.T) {
 		stoppedSignal:  make(chan struct{}, 1),
 		ch:             make(chan *compressedChunk),
 		statistics:     metrics.NewBrokerFamilyWriteStatistics("test"),
+		latency:        metrics.NewBrokerWriteLatencyStatistics("test"),
 		logger:         logger.GetLogger("Replica", "Test"),
 	}
 	f.checkFlush()
@@ -195,6 +198,7 @@ func TestFamilyChannel_flushChunkOnFull(t *testing.T) {
 		lastFlushTime: atomic.NewInt64(timeutil.Now()),
 		ch:            make(chan *compressedChunk, 1),
 		statistics:    metrics.NewBrokerFamilyWriteStatistics("db"),
+		latency:       metrics.NewBrokerWriteLatencyStatistics("db"),
 		logger:        logger.GetLogger("Replica", "Test"),
 	}
 	assert.NoError(t, f.flushChunkOnFull(context.TODO()))
@@ -214,6 +218,7 @@ func TestFamilyChannel_isExpire(t *testing.T) {
 		ch:             make(chan *compressedChunk),
 		stoppingSignal: make(chan struct{}, 1),
 		statistics:     metrics.NewBrokerFamilyWriteStatistics("db"),
+		latency:        metrics.NewBrokerWriteLatencyStatistics("db"),
 		lastFlushTime:  atomic.NewInt64(timeutil.Now()),
 		logger:         logger.GetLogger("Replica", "Test"),
 	}
@@ -242,6 +247,7 @@ func TestFamilyChannel_flushChunk(t *testing.T) {
 		chunk:      chunk,
 		ch:         make(chan *compressedChunk, 1),
 		statistics: metrics.NewBrokerFamilyWriteStatistics("db"),
+		latency:    metrics.NewBrokerWriteLatencyStatistics("db"),
 		logger:     logger.GetLogger("Replica", "Test"),
 	}
 	// compress failure
@@ -251,13 +257,20 @@ func TestFamilyChannel_flushChunk(t *testing.T) {
 	chunk.EXPECT().Compress().Return(nil, nil)
 	f.flushChunk()
 	// flush data
-	chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+	compressed := &compressedChunk{1, 2, 3}
+	f.batchTime = timeutil.Now()
+	chunk.EXPECT().Compress().Return(compressed, nil)
 	f.flushChunk()
+	assert.Zero(t, f.batchTime)
+	assert.True(t, f.batchTimeOf(compressed) > 0)
 
 	cancel()
-	chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+	compressed = &compressedChunk{1, 2, 3}
+	f.batchTime = timeutil.Now()
+	chunk.EXPECT().Compress().Return(compressed, nil)
 	// family is stopped
 	f.flushChunk()
+	assert.Zero(t, f.batchTimeOf(compressed))
 }
 
 func TestFamilyChannel_writeTask(t *testing.T) {
@@ -348,7 +361,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					return stream, nil
 				}
 				stream.EXPECT().Close()
-				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 				go func() {
 					f.Stop(10)
 				}()
@@ -368,7 +381,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(nil)
-				stream.EXPECT().Send(gomock.Any()).Return(io.EOF)
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(io.EOF)
 				go func() {
 					f.Stop(10)
 				}()
@@ -388,7 +401,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
-				stream.EXPECT().Send(gomock.Any()).Return(io.EOF)
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(io.EOF)
 				go func() {
 					f.Stop(10)
 				}()
@@ -408,7 +421,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
-				stream.EXPECT().Send(gomock.Any()).Return(nil)
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
 				go func() {
 					f.Stop(timeutil.OneSecond)
 				}()
@@ -452,7 +465,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
-				stream.EXPECT().Send(gomock.Any()).Return(nil)
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
 				f.ch <- &compressedChunk{1, 2, 3}
 
@@ -477,7 +490,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
-				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
 				stream.EXPECT().Close().Return(nil).AnyTimes()
 				f.ch <- &compressedChunk{1, 2, 3}
 				f.ch <- &compressedChunk{1, 2, 3}
@@ -488,6 +501,88 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				}()
 			},
 		},
+		{
+			name: "send msg failure, spool message",
+			prepare: func(f *familyChannel) {
+				chunk := NewMockChunk(ctrl)
+				f.chunk = chunk
+				f.maxRetryBuf = 0
+				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+				spool := NewMockSpool(ctrl)
+				f.spool = spool
+				stream := rpc.NewMockWriteStream(ctrl)
+				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
+					database string, shardState *models.ShardState, familyTime int64,
+					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					return stream, nil
+				}
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+				stream.EXPECT().Close().Return(nil).AnyTimes()
+				spool.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+				spool.EXPECT().Put(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+				f.ch <- &compressedChunk{1, 2, 3}
+				f.ch <- &compressedChunk{1, 2, 3}
+
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					f.Stop(10)
+				}()
+			},
+		},
+		{
+			name: "send msg successfully, notify spool replay",
+			prepare: func(f *familyChannel) {
+				chunk := NewMockChunk(ctrl)
+				f.chunk = chunk
+				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+				spool := NewMockSpool(ctrl)
+				f.spool = spool
+				stream := rpc.NewMockWriteStream(ctrl)
+				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
+					database string, shardState *models.ShardState, familyTime int64,
+					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					return stream, nil
+				}
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+				stream.EXPECT().Close().Return(nil).AnyTimes()
+				spool.EXPECT().Notify()
+				f.ch <- &compressedChunk{1, 2, 3}
+
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					f.Stop(10)
+				}()
+			},
+		},
+		{
+			name: "send replayed msg failure, keep msg in spool without retry",
+			prepare: func(f *familyChannel) {
+				chunk := NewMockChunk(ctrl)
+				f.chunk = chunk
+				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+				stream := rpc.NewMockWriteStream(ctrl)
+				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
+					database string, shardState *models.ShardState, familyTime int64,
+					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					return stream, nil
+				}
+				// replayed message sent only once
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+				stream.EXPECT().Close().Return(nil).AnyTimes()
+				replayed := &compressedChunk{1, 2, 3}
+				sent := make(chan bool, 1)
+				f.replayAcks.Store(replayed, sent)
+				f.ch <- replayed
+				f.ch <- &compressedChunk{1, 2, 3}
+
+				go func() {
+					assert.False(t, <-sent)
+					time.Sleep(200 * time.Millisecond)
+					f.Stop(10)
+				}()
+			},
+		},
 		{
 			name: "send msg failure, retry",
 			prepare: func(f *familyChannel) {
@@ -501,9 +596,36 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
-				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
-				stream.EXPECT().Send(gomock.Any()).Return(nil)
-				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+				stream.EXPECT().Send(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+				stream.EXPECT().Close().Return(nil).AnyTimes()
+				f.ch <- &compressedChunk{1, 2, 3}
+				f.ch <- &compressedChunk{1, 2, 3}
+
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					f.Stop(10)
+				}()
+			},
+		},
+		{
+			name: "send audit msg failure, retry with same audit id",
+			prepare: func(f *familyChannel) {
+				chunk := NewMockChunk(ctrl)
+				f.chunk = chunk
+				f.sampler = &writeAuditSampler{ratio: 1, session: 1}
+				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+				stream := rpc.NewMockWriteStream(ctrl)
+				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
+					database string, shardState *models.ShardState, familyTime int64,
+					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					return stream, nil
+				}
+				auditID := int64(1<<auditSeqBits | 1)
+				stream.EXPECT().SendAudit(auditID, gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				stream.EXPECT().SendAudit(auditID+1, gomock.Any(), gomock.Any()).Return(nil)
+				stream.EXPECT().SendAudit(auditID, gomock.Any(), gomock.Any()).Return(nil)
 				stream.EXPECT().Close().Return(nil).AnyTimes()
 				f.ch <- &compressedChunk{1, 2, 3}
 				f.ch <- &compressedChunk{1, 2, 3}
@@ -536,6 +658,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 					1: {},
 				},
 				statistics: metrics.NewBrokerFamilyWriteStatistics("db"),
+				latency:    metrics.NewBrokerWriteLatencyStatistics("db"),
 				logger:     logger.GetLogger("Replica", "Test"),
 			}
 			if tt.prepare != nil {
@@ -546,6 +669,81 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 	}
 }
 
+func TestFamilyChannel_State(t *testing.T) {
+	f := &familyChannel{
+		database:   "db",
+		shardID:    1,
+		familyTime: 10,
+		createTime: timeutil.Now() - 100,
+		shardState: models.ShardState{Leader: 2},
+	}
+	state := f.State()
+	assert.Equal(t, models.ReplicaChannelState{Database: "db", ShardID: 1, FamilyTime: 10, Leader: 2}, state)
+
+	f.currentTarget = &models.StatefulNode{StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}}
+	f.state.enqueue(&compressedChunk{1, 2, 3})
+	f.state.enqueue(nil)
+	f.state.retry.Inc()
+	state = f.State()
+	assert.Equal(t, "1.1.1.1:9000", state.Target)
+	assert.Equal(t, int64(1), state.Pending)
+	assert.Equal(t, int64(3), state.PendingBytes)
+	assert.Equal(t, int64(1), state.Retry)
+	assert.True(t, state.Lag >= 100)
+
+	f.state.lastSendTime.Store(timeutil.Now())
+	state = f.State()
+	assert.True(t, state.Lag < 100)
+	f.state.dequeue(&compressedChunk{1, 2, 3})
+	state = f.State()
+	assert.Zero(t, state.Pending)
+	assert.Zero(t, state.Lag)
+}
+
+func TestFamilyChannel_resend(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	f := &familyChannel{
+		ctx:            ctx,
+		ch:             make(chan *compressedChunk, 1),
+		stoppingSignal: make(chan struct{}),
+		statistics:     metrics.NewBrokerFamilyWriteStatistics("db"),
+		latency:        metrics.NewBrokerWriteLatencyStatistics("db"),
+	}
+	// ack replayed message after sent
+	go func() {
+		compressed := <-f.ch
+		assert.Equal(t, compressedChunk{1, 2, 3}, *compressed)
+		assert.True(t, f.ackReplay(compressed, true))
+		compressed = <-f.ch
+		assert.True(t, f.ackReplay(compressed, false))
+		<-f.ch
+		cancel()
+	}()
+	assert.NoError(t, f.resend([]byte{1, 2, 3}))
+	assert.Equal(t, errReplaySendFailure, f.resend([]byte{1, 2, 3}))
+	// canceled when waiting send result
+	assert.Equal(t, ErrFamilyChannelCanceled, f.resend([]byte{1, 2, 3}))
+	assert.False(t, f.ackReplay(&compressedChunk{1, 2, 3}, true))
+	f.ch <- &compressedChunk{}
+	assert.Equal(t, ErrFamilyChannelCanceled, f.resend([]byte{1, 2, 3}))
+	close(f.stoppingSignal)
+	assert.Equal(t, ErrFamilyChannelCanceled, f.resend([]byte{1, 2, 3}))
+}
+
+func TestFamilyChannel_Write_SpoolFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	spool := NewMockSpool(ctrl)
+	f := &familyChannel{
+		spool:      spool,
+		statistics: metrics.NewBrokerFamilyWriteStatistics("db"),
+		latency:    metrics.NewBrokerWriteLatencyStatistics("db"),
+	}
+	spool.EXPECT().IsFull().Return(true)
+	assert.Equal(t, ErrSpoolFull, f.Write(context.TODO(), []metric.BrokerRow{{}}))
+}
+
 func TestFamilyChannel_sendingLastMessage(t *testing.T) {
 	f := &familyChannel{
 		ch:     make(chan *compressedChunk, 2),
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -41,6 +42,9 @@ type ChannelManager interface {
 	// Write writes a MetricList, the manager handler the database, sharding things.
 	Write(ctx context.Context, database string, brokerBatchRows *metric.BrokerBatchRows) error
 
+	// ChannelStates returns the write state of family channels, returns all databases if database is empty.
+	ChannelStates(database string) models.ReplicaChannelStates
+
 	// Close closes all the shardChannel.
 	Close()
 }
@@ -136,6 +140,31 @@ func (cm *channelManager) CreateChannel(databaseCfg models.Database, numOfShard
 	return ch.CreateChannel(numOfShard, shardID)
 }
 
+// ChannelStates returns the write state of family channels, returns all databases if database is empty.
+func (cm *channelManager) ChannelStates(database string) models.ReplicaChannelStates {
+	var rs models.ReplicaChannelStates
+	channels := cm.databaseChannels.value.Load().(database2Channel)
+	for name, channel := range channels {
+		if database != "" && name != database {
+			continue
+		}
+		states := channel.ChannelStates()
+		for idx := range states {
+			rs = append(rs, &states[idx])
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Database != rs[j].Database {
+			return rs[i].Database < rs[j].Database
+		}
+		if rs[i].ShardID != rs[j].ShardID {
+			return rs[i].ShardID < rs[j].ShardID
+		}
+		return rs[i].FamilyTime < rs[j].FamilyTime
+	})
+	return rs
+}
+
 // Close closes all the shardChannel.
 func (cm *channelManager) Close() {
 	cm.cancel()
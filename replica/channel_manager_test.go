@@ -72,6 +72,39 @@ func TestChannelManager_GetChannel(t *testing.T) {
 	cm.Close()
 }
 
+func TestChannelManager_ChannelStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().WatchShardStateChangeEvent(gomock.Any())
+	cm := NewChannelManager(context.TODO(), nil, stateMgr)
+	defer cm.Close()
+	cm1 := cm.(*channelManager)
+	db1 := NewMockDatabaseChannel(ctrl)
+	db2 := NewMockDatabaseChannel(ctrl)
+	cm1.insertDatabaseChannel("db1", db1)
+	cm1.insertDatabaseChannel("db2", db2)
+	db1.EXPECT().ChannelStates().Return([]models.ReplicaChannelState{
+		{Database: "db1", ShardID: 2, FamilyTime: 1},
+		{Database: "db1", ShardID: 1, FamilyTime: 2},
+		{Database: "db1", ShardID: 1, FamilyTime: 1},
+	}).Times(2)
+	db2.EXPECT().ChannelStates().Return([]models.ReplicaChannelState{{Database: "db2", ShardID: 0}})
+
+	states := cm.ChannelStates("db1")
+	assert.Len(t, states, 3)
+	assert.Equal(t, models.ShardID(1), states[0].ShardID)
+	assert.Equal(t, int64(1), states[0].FamilyTime)
+	assert.Equal(t, models.ShardID(2), states[2].ShardID)
+	states = cm.ChannelStates("")
+	assert.Len(t, states, 4)
+	assert.Equal(t, "db2", states[3].Database)
+	assert.Empty(t, cm.ChannelStates("db3"))
+	db1.EXPECT().Stop()
+	db2.EXPECT().Stop()
+}
+
 func TestChannelManager_Write(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	dirPath := filepath.Join(os.TempDir(), "test_channel_manager")
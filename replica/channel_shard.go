@@ -43,6 +43,8 @@ type ShardChannel interface {
 	GetOrCreateFamilyChannel(familyTime int64) FamilyChannel
 	// Stop stops shard shardChannel.
 	Stop()
+	// FamilyStates returns the write state of all family channels.
+	FamilyStates() []models.ReplicaChannelState
 
 	// garbageCollect recycles expired write family.
 	garbageCollect(ahead, behind int64)
@@ -59,6 +61,7 @@ type shardChannel struct {
 	fct      rpc.ClientStreamFactory
 
 	families   *familyChannelSet // send shardChannel for each family time
+	spool      Spool             // spool write data when storage unreachable, nil if disabled
 	shardState models.ShardState
 	liveNodes  map[models.NodeID]models.StatefulNode
 
@@ -74,7 +77,7 @@ func newShardChannel(
 	shardID models.ShardID,
 	fct rpc.ClientStreamFactory,
 ) ShardChannel {
-	return &shardChannel{
+	c := &shardChannel{
 		ctx:      ctx,
 		cfg:      config.GlobalBrokerConfig().Write,
 		database: database,
@@ -83,6 +86,8 @@ func newShardChannel(
 		fct:      fct,
 		logger:   logger.GetLogger("Replica", "ShardChannel"),
 	}
+	c.spool = newShardSpool(ctx, c.cfg.SpoolDir, int64(c.cfg.SpoolSize), database, shardID, c.replay)
+	return c
 }
 
 func (c *shardChannel) SyncShardState(shardState models.ShardState, liveNodes map[models.NodeID]models.StatefulNode) {
@@ -118,7 +123,7 @@ func (c *shardChannel) GetOrCreateFamilyChannel(familyTime int64) FamilyChannel
 	if exist {
 		return familyChannel
 	}
-	familyChannel = newFamilyChannel(c.ctx, c.cfg, c.database, c.shardID, familyTime, c.fct, c.shardState, c.liveNodes)
+	familyChannel = newFamilyChannel(c.ctx, c.cfg, c.database, c.shardID, familyTime, c.fct, c.shardState, c.liveNodes, c.spool)
 	c.families.InsertFamily(familyTime, familyChannel)
 
 	return familyChannel
@@ -126,6 +131,11 @@ func (c *shardChannel) GetOrCreateFamilyChannel(familyTime int64) FamilyChannel
 
 // Stop stops shard shardChannel.
 func (c *shardChannel) Stop() {
+	if c.spool != nil {
+		// stop replay before stopping family channels
+		c.spool.Close()
+	}
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
@@ -135,6 +145,21 @@ func (c *shardChannel) Stop() {
 	}
 }
 
+// FamilyStates returns the write state of all family channels.
+func (c *shardChannel) FamilyStates() []models.ReplicaChannelState {
+	families := c.families.Entries()
+	states := make([]models.ReplicaChannelState, 0, len(families))
+	for _, family := range families {
+		states = append(states, family.State())
+	}
+	return states
+}
+
+// replay re-sends the spooled message by family channel.
+func (c *shardChannel) replay(familyTime int64, data []byte) bool {
+	return c.GetOrCreateFamilyChannel(familyTime).resend(data) == nil
+}
+
 // garbageCollect recycles expired write family.
 func (c *shardChannel) garbageCollect(ahead, behind int64) {
 	c.mutex.Lock()
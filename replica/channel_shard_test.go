@@ -113,3 +113,35 @@ func TestShardChannel_GetOrCreateFamilyChannel(t *testing.T) {
 	f3 := ch.GetOrCreateFamilyChannel(3)
 	assert.Equal(t, f1, f3)
 }
+
+func TestShardChannel_FamilyStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ch := newShardChannel(context.TODO(), "database", 1, nil)
+	assert.Empty(t, ch.FamilyStates())
+	familyCh := NewMockFamilyChannel(ctrl)
+	ch.(*shardChannel).families.InsertFamily(1, familyCh)
+	familyCh.EXPECT().State().Return(models.ReplicaChannelState{Database: "database", ShardID: 1, FamilyTime: 1})
+	assert.Equal(t, []models.ReplicaChannelState{{Database: "database", ShardID: 1, FamilyTime: 1}}, ch.FamilyStates())
+}
+
+func TestShardChannel_replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ch := newShardChannel(context.TODO(), "database", 1, nil)
+	ch1 := ch.(*shardChannel)
+	familyCh := NewMockFamilyChannel(ctrl)
+	ch1.families.InsertFamily(1, familyCh)
+	familyCh.EXPECT().resend(gomock.Any()).Return(nil)
+	assert.True(t, ch1.replay(1, []byte{1, 2, 3}))
+	familyCh.EXPECT().resend(gomock.Any()).Return(ErrFamilyChannelCanceled)
+	assert.False(t, ch1.replay(1, []byte{1, 2, 3}))
+
+	spool := NewMockSpool(ctrl)
+	ch1.spool = spool
+	spool.EXPECT().Close()
+	familyCh.EXPECT().Stop(gomock.Any())
+	ch.Stop()
+}
@@ -0,0 +1,254 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/queue"
+)
+
+//go:generate mockgen -source=./channel_spool.go -destination=./channel_spool_mock.go -package=replica
+
+// for testing
+var (
+	newSpoolQueueFn = queue.NewQueue
+	spoolExistFn    = fileutil.Exist
+)
+
+// spoolEntryHeaderSize is the size of family time before spooled message.
+const spoolEntryHeaderSize = 8
+
+// replayFunc re-sends the spooled message of family, returns if the message is sent to storage successfully.
+type replayFunc func(familyTime int64, data []byte) bool
+
+// Spool represents a disk buffer for the write data of shard when storage is unreachable,
+// spooled message will be replayed after storage is reachable.
+type Spool interface {
+	// Put spools the compressed message of family into disk.
+	Put(familyTime int64, compressed []byte) error
+	// IsFull returns if spool reaches the max size limit, new write need be rejected.
+	IsFull() bool
+	// Notify notifies spool to replay spooled message.
+	Notify()
+	// Close closes the spool.
+	Close()
+}
+
+// shardSpool implements Spool interface based on disk queue.
+type shardSpool struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	dir    string
+	size   int64
+
+	q        queue.Queue
+	replayFn replayFunc
+	signal   chan struct{}
+	full     atomic.Bool
+	pending  atomic.Int64 // bytes of message waiting replay
+	closed   chan struct{}
+	stopped  bool // spool is closed, cannot put message, guarded by lock
+
+	lock sync.Mutex
+
+	statistics *metrics.BrokerShardSpoolStatistics
+	logger     *logger.Logger
+}
+
+// newShardSpool creates the spool of shard, returns nil if spooling is disabled.
+func newShardSpool(
+	ctx context.Context,
+	dir string, size int64,
+	database string, shardID models.ShardID,
+	replayFn replayFunc,
+) Spool {
+	if dir == "" || size <= 0 {
+		return nil
+	}
+	c, cancel := context.WithCancel(ctx)
+	s := &shardSpool{
+		ctx:        c,
+		cancel:     cancel,
+		dir:        filepath.Join(dir, database, shardID.String()),
+		size:       size,
+		replayFn:   replayFn,
+		signal:     make(chan struct{}, 1),
+		closed:     make(chan struct{}),
+		statistics: metrics.NewBrokerShardSpoolStatistics(database, shardID.String()),
+		logger:     logger.GetLogger("Replica", "ShardSpool"),
+	}
+	if spoolExistFn(s.dir) {
+		// replay message spooled before broker restart
+		s.lock.Lock()
+		if err := s.openQueue(); err != nil {
+			s.logger.Error("open spool queue failure", logger.String("dir", s.dir), logger.Error(err))
+		}
+		s.lock.Unlock()
+		s.Notify()
+	}
+	go s.replayTask()
+	return s
+}
+
+// Put spools the compressed message of family into disk.
+func (s *shardSpool) Put(familyTime int64, compressed []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stopped {
+		// cannot re-open queue after closed, it would never be closed
+		s.statistics.SpoolFailures.Incr()
+		return errSpoolClosed
+	}
+	if err := s.openQueue(); err != nil {
+		s.statistics.SpoolFailures.Incr()
+		return err
+	}
+	entry := make([]byte, spoolEntryHeaderSize+len(compressed))
+	binary.BigEndian.PutUint64(entry, uint64(familyTime))
+	copy(entry[spoolEntryHeaderSize:], compressed)
+	if err := s.q.Put(entry); err != nil {
+		if errors.Is(err, queue.ErrExceedingTotalSizeLimit) {
+			s.full.Store(true)
+			s.statistics.SpoolFull.Incr()
+			return ErrSpoolFull
+		}
+		s.statistics.SpoolFailures.Incr()
+		return err
+	}
+	s.statistics.Spool.Incr()
+	s.statistics.SpoolSize.Update(float64(s.pending.Add(int64(len(compressed)))))
+	return nil
+}
+
+// IsFull returns if spool reaches the max size limit, new write need be rejected.
+func (s *shardSpool) IsFull() bool {
+	return s.full.Load()
+}
+
+// Notify notifies spool to replay spooled message.
+func (s *shardSpool) Notify() {
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Close closes the spool.
+func (s *shardSpool) Close() {
+	s.cancel()
+	<-s.closed
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.stopped = true
+	if s.q != nil {
+		s.q.Close()
+		s.q = nil
+	}
+}
+
+// openQueue opens the disk queue if not opened, must be called under lock.
+func (s *shardSpool) openQueue() error {
+	if s.q != nil {
+		return nil
+	}
+	q, err := newSpoolQueueFn(s.dir, s.size)
+	if err != nil {
+		return err
+	}
+	s.q = q
+	return nil
+}
+
+// replayTask replays spooled message when receiving notify signal.
+func (s *shardSpool) replayTask() {
+	defer close(s.closed)
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.signal:
+			s.replay()
+		}
+	}
+}
+
+// replay re-sends spooled message in order until re-send failure.
+func (s *shardSpool) replay() {
+	s.lock.Lock()
+	q := s.q
+	s.lock.Unlock()
+	if q == nil {
+		return
+	}
+
+	replayed := false
+	defer func() {
+		if replayed {
+			q.GC()
+		}
+	}()
+	for seq := q.AcknowledgedSeq() + 1; seq <= q.AppendedSeq(); seq++ {
+		if s.ctx.Err() != nil {
+			return
+		}
+		entry, err := q.Get(seq)
+		if err != nil {
+			s.logger.Error("get spooled message failure, skip it",
+				logger.String("dir", s.dir), logger.Int64("seq", seq), logger.Error(err))
+			q.SetAcknowledgedSeq(seq)
+			continue
+		}
+		if len(entry) < spoolEntryHeaderSize {
+			q.SetAcknowledgedSeq(seq)
+			continue
+		}
+		familyTime := int64(binary.BigEndian.Uint64(entry))
+		// copy message, because queue page maybe released after gc
+		data := make([]byte, len(entry)-spoolEntryHeaderSize)
+		copy(data, entry[spoolEntryHeaderSize:])
+		if !s.replayFn(familyTime, data) {
+			// message not sent, keep it in spool, replay it again after storage reachable
+			return
+		}
+		q.SetAcknowledgedSeq(seq)
+		replayed = true
+		s.full.Store(false)
+		s.statistics.Replay.Incr()
+		pending := s.pending.Sub(int64(len(data)))
+		if pending < 0 {
+			// message spooled before broker restart not counted
+			pending = 0
+			s.pending.Store(0)
+		}
+		s.statistics.SpoolSize.Update(float64(pending))
+	}
+}
@@ -0,0 +1,150 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/queue"
+)
+
+func TestShardSpool_new(t *testing.T) {
+	assert.Nil(t, newShardSpool(context.TODO(), "", 1024, "db", 1, nil))
+	assert.Nil(t, newShardSpool(context.TODO(), t.TempDir(), 0, "db", 1, nil))
+
+	s := newShardSpool(context.TODO(), t.TempDir(), 1024, "db", 1, nil)
+	assert.NotNil(t, s)
+	assert.False(t, s.IsFull())
+	s.Close()
+}
+
+func TestShardSpool_PutAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	var lock sync.Mutex
+	var replayed [][]byte
+	success := false
+	replayFn := func(familyTime int64, data []byte) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		if !success {
+			return false
+		}
+		assert.Equal(t, int64(10), familyTime)
+		replayed = append(replayed, data)
+		return true
+	}
+	s := newShardSpool(context.TODO(), dir, 1024, "db", 1, replayFn)
+	assert.NoError(t, s.Put(10, []byte("abc")))
+	assert.NoError(t, s.Put(10, []byte("def")))
+	// replay failure, keep message in spool
+	s.Notify()
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	assert.Empty(t, replayed)
+	success = true
+	lock.Unlock()
+
+	s.Notify()
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	assert.Equal(t, [][]byte{[]byte("abc"), []byte("def")}, replayed)
+	replayed = nil
+	lock.Unlock()
+
+	// spooled message replayed after restart
+	assert.NoError(t, s.Put(10, []byte("ghi")))
+	s.Close()
+	assert.DirExists(t, filepath.Join(dir, "db", "1"))
+	s = newShardSpool(context.TODO(), dir, 1024, "db", 1, replayFn)
+	time.Sleep(100 * time.Millisecond)
+	lock.Lock()
+	assert.Equal(t, [][]byte{[]byte("ghi")}, replayed)
+	lock.Unlock()
+	s.Close()
+}
+
+func TestShardSpool_Put_Failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newSpoolQueueFn = queue.NewQueue
+		ctrl.Finish()
+	}()
+	q := queue.NewMockQueue(ctrl)
+	newSpoolQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return nil, fmt.Errorf("err")
+	}
+	s := newShardSpool(context.TODO(), t.TempDir(), 1024, "db", 1, nil)
+	// open queue failure
+	assert.Error(t, s.Put(1, []byte("abc")))
+	assert.False(t, s.IsFull())
+
+	newSpoolQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return q, nil
+	}
+	// spool full
+	q.EXPECT().Put(gomock.Any()).Return(queue.ErrExceedingTotalSizeLimit)
+	assert.ErrorIs(t, s.Put(1, []byte("abc")), ErrSpoolFull)
+	assert.True(t, s.IsFull())
+
+	q.EXPECT().Close()
+	s.Close()
+	// cannot re-open queue after closed
+	assert.ErrorIs(t, s.Put(1, []byte("abc")), errSpoolClosed)
+}
+
+func TestShardSpool_replay_bad_message(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newSpoolQueueFn = queue.NewQueue
+		ctrl.Finish()
+	}()
+	q := queue.NewMockQueue(ctrl)
+	newSpoolQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return q, nil
+	}
+	s := newShardSpool(context.TODO(), t.TempDir(), 1024, "db", 1, func(_ int64, _ []byte) bool {
+		return true
+	})
+	s1 := s.(*shardSpool)
+	s1.full.Store(true)
+	q.EXPECT().Put(gomock.Any()).Return(nil)
+	assert.NoError(t, s.Put(1, []byte("abc")))
+
+	q.EXPECT().AcknowledgedSeq().Return(int64(-1))
+	q.EXPECT().AppendedSeq().Return(int64(2)).AnyTimes()
+	gomock.InOrder(
+		q.EXPECT().Get(int64(0)).Return(nil, fmt.Errorf("err")),
+		q.EXPECT().Get(int64(1)).Return([]byte{1, 2}, nil),
+		q.EXPECT().Get(int64(2)).Return(make([]byte, 12), nil),
+	)
+	q.EXPECT().SetAcknowledgedSeq(gomock.Any()).Times(3)
+	q.EXPECT().GC()
+	s1.replay()
+	assert.False(t, s.IsFull())
+
+	q.EXPECT().Close()
+	s.Close()
+}
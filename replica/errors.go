@@ -27,4 +27,10 @@ var (
 	// ErrFamilyChannelCanceled is the error returned when a family channel is closed.
 	ErrFamilyChannelCanceled = errors.New("family Channel is canceled")
 	ErrIngestTimeout         = errors.New("ingest timout")
+	// ErrSpoolFull is the error returned when write spool of shard is full, need reject new write.
+	ErrSpoolFull = errors.New("write spool of shard is full, storage maybe unreachable")
+	// errSpoolClosed is the error returned when putting message into closed spool.
+	errSpoolClosed = errors.New("write spool of shard is closed")
+	// errReplaySendFailure is the error returned when message replayed from spool is not sent.
+	errReplaySendFailure = errors.New("send message replayed from spool failure")
 )
@@ -23,7 +23,10 @@ import (
 	"io"
 	"sync"
 
+	"go.uber.org/atomic"
+
 	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -52,8 +55,11 @@ type Partition interface {
 	// ReplicaLog writes msg that leader sends replica msg.
 	// return appended index, if success.
 	ReplicaLog(replicaIdx int64, msg []byte) (int64, error)
-	// WriteLog writes msg that leader handle client writeTask request.
-	WriteLog(msg []byte) error
+	// WriteLog writes msg that leader handle client writeTask request,
+	// batch time is the create time of msg by broker(0 if unknown).
+	WriteLog(msg []byte, batchTime int64) error
+	// WriteAuditLog writes msg tagged with audit id by broker, verifies if it is applied.
+	WriteAuditLog(auditID int64, msg []byte, batchTime int64) error
 	// ReplicaAckIndex returns the index which replica appended index.
 	ReplicaAckIndex() int64
 	// ResetReplicaIndex resets replica index.
@@ -85,6 +91,13 @@ type partition struct {
 	cliFct   rpc.ClientStreamFactory
 	stateMgr storage.StateManager
 
+	familyCli client.FamilyCli
+	repairing atomic.Bool
+
+	auditor    WriteAuditor
+	latency    WriteLatencyTracker
+	lock4write sync.RWMutex // exclusive lock for audit message to get its wal sequence
+
 	mutex sync.Mutex
 
 	statistics *metrics.StorageWriteAheadLogStatistics
@@ -114,7 +127,10 @@ func NewPartition(
 		currentNodeID: currentNodeID,
 		cliFct:        cliFct,
 		stateMgr:      stateMgr,
+		familyCli:     client.NewFamilyCli(),
 		peers:         make(map[models.NodeID]ReplicatorPeer),
+		auditor:       NewWriteAuditor(shard.Database().Name(), shard.ShardID().String()),
+		latency:       NewWriteLatencyTracker(shard.Database().Name()),
 		statistics:    metrics.NewStorageWriteAheadLogStatistics(shard.Database().Name(), shard.ShardID().String()),
 		logger:        logger.GetLogger("Replica", "Partition"),
 	}
@@ -197,10 +213,48 @@ func (p *partition) stopReplicator(node string) {
 }
 
 // WriteLog writes msg that leader sends replica msg.
-func (p *partition) WriteLog(msg []byte) error {
+func (p *partition) WriteLog(msg []byte, batchTime int64) error {
+	if len(msg) == 0 {
+		return nil
+	}
+	p.lock4write.RLock()
+	defer p.lock4write.RUnlock()
+
+	if err := p.writeLog(msg); err != nil {
+		return err
+	}
+	// appended sequence maybe the sequence of concurrent write, it's acceptable for latency statistics
+	p.latency.Appended(p.log.Queue().AppendedSeq(), batchTime)
+	return nil
+}
+
+// WriteAuditLog writes msg tagged with audit id by broker, verifies if it is applied.
+func (p *partition) WriteAuditLog(auditID int64, msg []byte, batchTime int64) error {
 	if len(msg) == 0 {
 		return nil
 	}
+	p.auditor.Received(auditID)
+
+	// write audit message exclusively, appended sequence is the wal sequence of audit message
+	p.lock4write.Lock()
+	defer p.lock4write.Unlock()
+
+	err := p.writeLog(msg)
+	appendedSeq := p.log.Queue().AppendedSeq()
+	p.auditor.Appended(appendedSeq, auditID, err)
+	if err == nil {
+		p.latency.Appended(appendedSeq, batchTime)
+	}
+	return err
+}
+
+// writeLog writes msg into write ahead log.
+func (p *partition) writeLog(msg []byte) error {
+	// reject write if disk usage of database exceeds disk quota
+	if err := p.shard.Database().CheckDiskQuota(); err != nil {
+		p.statistics.WriteWALFailures.Incr()
+		return err
+	}
 	p.statistics.ReceiveWriteSize.Add(float64(len(msg)))
 	if err := p.log.Queue().Put(msg); err != nil {
 		p.statistics.WriteWALFailures.Incr()
@@ -333,8 +387,16 @@ func (p *partition) buildReplica(leader, replica models.NodeID) error {
 		ConsumerGroup: walConsumer,
 	}
 	if replica == p.currentNodeID {
+		channel.Auditor = p.auditor
+		channel.Latency = p.latency
 		// local replicator
 		replicator = newLocalReplicatorFn(&channel, p.shard, p.family)
+		if leader != p.currentNodeID {
+			// current node is follower, repairs data family from leader if found corrupt file
+			p.family.WatchCorruption(int32(leader), func() {
+				go p.repair(leader)
+			})
+		}
 	} else {
 		// build remote replicator
 		replicator = newRemoteReplicatorFn(p.ctx, &channel, p.stateMgr, p.cliFct)
@@ -0,0 +1,86 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// for testing
+var (
+	repairRetryInterval = time.Minute
+	maxRepairRetries    = 10
+)
+
+// repair fetches the data family files from leader replica to replace the local files,
+// when found corrupt file in local data family, retries if repair failure.
+func (p *partition) repair(leader models.NodeID) {
+	if !p.repairing.CAS(false, true) {
+		// repair is doing
+		return
+	}
+	defer p.repairing.Store(false)
+
+	for retry := 0; retry < maxRepairRetries; retry++ {
+		err := p.repairFromLeader(leader)
+		if err == nil {
+			p.logger.Info("repair data family from leader successfully",
+				logger.String("family", p.family.Indicator()), logger.String("leader", leader.String()))
+			return
+		}
+		p.logger.Warn("repair data family from leader failure, retry later",
+			logger.String("family", p.family.Indicator()), logger.String("leader", leader.String()),
+			logger.Int("retry", retry), logger.Error(err))
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-time.After(repairRetryInterval):
+		}
+	}
+	p.logger.Error("repair data family from leader failure, exceed max retries",
+		logger.String("family", p.family.Indicator()), logger.String("leader", leader.String()))
+}
+
+// repairFromLeader fetches the live files of data family from leader, then replaces the local files.
+func (p *partition) repairFromLeader(leader models.NodeID) error {
+	node, ok := p.stateMgr.GetLiveNode(leader)
+	if !ok {
+		return fmt.Errorf("leader node: %s not alive", leader.String())
+	}
+	familyTime := p.family.FamilyTime()
+	familyFiles, err := p.familyCli.FetchFamilyFiles(&node, p.db, p.shardID, familyTime)
+	if err != nil {
+		return err
+	}
+	return p.family.Repair(familyFiles.Sequences, func(dir string) ([]string, error) {
+		var files []string
+		for _, file := range familyFiles.Files {
+			target := filepath.Join(dir, file)
+			if err0 := p.familyCli.DownloadFamilyFile(&node, p.db, p.shardID, familyTime, file, target); err0 != nil {
+				return nil, err0
+			}
+			files = append(files, target)
+		}
+		return files, nil
+	})
+}
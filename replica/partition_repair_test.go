@@ -0,0 +1,108 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestPartition_repair(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		repairRetryInterval = time.Minute
+		maxRepairRetries = 10
+		ctrl.Finish()
+	}()
+	repairRetryInterval = time.Millisecond
+	maxRepairRetries = 2
+
+	family := tsdb.NewMockDataFamily(ctrl)
+	family.EXPECT().Indicator().Return("family").AnyTimes()
+	family.EXPECT().FamilyTime().Return(int64(100)).AnyTimes()
+	stateMgr := storage.NewMockStateManager(ctrl)
+	familyCli := client.NewMockFamilyCli(ctrl)
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	p := &partition{
+		ctx:       ctx,
+		db:        "db",
+		shardID:   1,
+		family:    family,
+		stateMgr:  stateMgr,
+		familyCli: familyCli,
+		logger:    logger.GetLogger("Replica", "Test"),
+	}
+	leader := models.StatefulNode{ID: 2}
+
+	t.Run("leader not alive", func(t *testing.T) {
+		stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(leader, false).Times(2)
+		p.repair(2)
+		assert.False(t, p.repairing.Load())
+	})
+	t.Run("fetch family files failure", func(t *testing.T) {
+		stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(leader, true).Times(2)
+		familyCli.EXPECT().FetchFamilyFiles(gomock.Any(), "db", models.ShardID(1), int64(100)).
+			Return(nil, fmt.Errorf("err")).Times(2)
+		p.repair(2)
+	})
+	t.Run("download file failure, then repair successfully", func(t *testing.T) {
+		files := &models.DataFamilyFiles{Sequences: map[int32]int64{1: 10}, Files: []string{"000001.sst"}}
+		stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(leader, true).Times(2)
+		familyCli.EXPECT().FetchFamilyFiles(gomock.Any(), "db", models.ShardID(1), int64(100)).Return(files, nil).Times(2)
+		gomock.InOrder(
+			familyCli.EXPECT().DownloadFamilyFile(gomock.Any(), "db", models.ShardID(1), int64(100),
+				"000001.sst", filepath.Join("dir", "000001.sst")).Return(fmt.Errorf("err")),
+			familyCli.EXPECT().DownloadFamilyFile(gomock.Any(), "db", models.ShardID(1), int64(100),
+				"000001.sst", filepath.Join("dir", "000001.sst")).Return(nil),
+		)
+		family.EXPECT().Repair(files.Sequences, gomock.Any()).
+			DoAndReturn(func(_ map[int32]int64, fetch func(dir string) ([]string, error)) error {
+				rs, err := fetch("dir")
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, []string{filepath.Join("dir", "000001.sst")}, rs)
+				return nil
+			}).Times(2)
+		p.repair(2)
+	})
+	t.Run("repair is doing", func(t *testing.T) {
+		p.repairing.Store(true)
+		p.repair(2)
+		p.repairing.Store(false)
+	})
+	t.Run("partition closed", func(t *testing.T) {
+		repairRetryInterval = time.Minute
+		stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(leader, false)
+		cancel()
+		p.repair(2)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
@@ -124,6 +125,7 @@ func TestPartition_BuildReplicaForFollower(t *testing.T) {
 	log := queue.NewMockFanOutQueue(ctrl)
 	log.EXPECT().GetOrCreateConsumerGroup(gomock.Any()).Return(nil, nil)
 	family.EXPECT().TimeRange().Return(timeutil.TimeRange{}).AnyTimes()
+	family.EXPECT().WatchCorruption(int32(2), gomock.Any())
 	p := NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
 	err := p.BuildReplicaForFollower(2, 2)
 	assert.Error(t, err)
@@ -199,15 +201,55 @@ func TestPartition_WriteLog(t *testing.T) {
 	family := tsdb.NewMockDataFamily(ctrl)
 	family.EXPECT().FamilyTime().Return(timeutil.Now()).AnyTimes()
 	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	db.EXPECT().CheckDiskQuota().Return(nil).Times(2)
 	q.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
-	err := p.WriteLog([]byte{1})
+	err := p.WriteLog([]byte{1}, 0)
 	assert.Error(t, err)
 	// msg is empty
-	err = p.WriteLog(nil)
+	err = p.WriteLog(nil, 0)
 	assert.NoError(t, err)
 	q.EXPECT().Put(gomock.Any()).Return(nil)
-	err = p.WriteLog([]byte{1})
+	q.EXPECT().AppendedSeq().Return(int64(1))
+	err = p.WriteLog([]byte{1}, timeutil.Now())
 	assert.NoError(t, err)
+	// disk quota exceeded
+	db.EXPECT().CheckDiskQuota().Return(constants.ErrDiskQuotaExceeded)
+	err = p.WriteLog([]byte{1}, 0)
+	assert.ErrorIs(t, err, constants.ErrDiskQuotaExceeded)
+}
+
+func TestPartition_WriteAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		ctrl.Finish()
+	}()
+	l := queue.NewMockFanOutQueue(ctrl)
+	q := queue.NewMockQueue(ctrl)
+	l.EXPECT().Queue().Return(q).AnyTimes()
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	db.EXPECT().CheckDiskQuota().Return(nil).AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	family := tsdb.NewMockDataFamily(ctrl)
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	auditor := NewMockWriteAuditor(ctrl)
+	p.(*partition).auditor = auditor
+	// msg is empty
+	assert.NoError(t, p.WriteAuditLog(1, nil, 0))
+	// write wal failure
+	auditor.EXPECT().Received(int64(1))
+	q.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
+	q.EXPECT().AppendedSeq().Return(int64(9))
+	auditor.EXPECT().Appended(int64(9), int64(1), gomock.Any())
+	assert.Error(t, p.WriteAuditLog(1, []byte{1}, 0))
+	// write wal successfully
+	auditor.EXPECT().Received(int64(2))
+	q.EXPECT().Put(gomock.Any()).Return(nil)
+	q.EXPECT().AppendedSeq().Return(int64(10))
+	auditor.EXPECT().Appended(int64(10), int64(2), nil)
+	assert.NoError(t, p.WriteAuditLog(2, []byte{1}, timeutil.Now()))
 }
 
 func TestPartition_ReplicaLog(t *testing.T) {
@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"sort"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./read_only.go -destination=./read_only_mock.go -package=replica
+
+// ReadOnlyController controls the read-only mode of storage node and databases for maintenance,
+// writes/replication of read-only database are rejected(broker retries/spools the writes), queries still work.
+// NOTE: read-only mode is kept in memory, resets after storage node restarted.
+type ReadOnlyController interface {
+	// SetReadOnly switches the read-only mode of database, switches node level mode if database is empty.
+	SetReadOnly(database string, readOnly bool)
+	// IsReadOnly returns if the database is read-only(node or database level).
+	IsReadOnly(database string) bool
+	// GetState returns current read-only state of node and databases.
+	GetState() *models.ReadOnlyState
+}
+
+// readOnlyController implements ReadOnlyController interface.
+type readOnlyController struct {
+	node      atomic.Bool
+	databases sync.Map // database => struct{}
+}
+
+// NewReadOnlyController creates a ReadOnlyController instance, node and databases are writable by default.
+func NewReadOnlyController() ReadOnlyController {
+	return &readOnlyController{}
+}
+
+// SetReadOnly switches the read-only mode of database, switches node level mode if database is empty.
+func (c *readOnlyController) SetReadOnly(database string, readOnly bool) {
+	switch {
+	case database == "":
+		c.node.Store(readOnly)
+	case readOnly:
+		c.databases.Store(database, struct{}{})
+	default:
+		c.databases.Delete(database)
+	}
+}
+
+// IsReadOnly returns if the database is read-only(node or database level).
+func (c *readOnlyController) IsReadOnly(database string) bool {
+	if c.node.Load() {
+		return true
+	}
+	_, ok := c.databases.Load(database)
+	return ok
+}
+
+// GetState returns current read-only state of node and databases.
+func (c *readOnlyController) GetState() *models.ReadOnlyState {
+	state := &models.ReadOnlyState{ReadOnly: c.node.Load()}
+	c.databases.Range(func(key, _ interface{}) bool {
+		state.Databases = append(state.Databases, key.(string))
+		return true
+	})
+	sort.Strings(state.Databases)
+	return state
+}
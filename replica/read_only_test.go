@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestReadOnlyController(t *testing.T) {
+	c := NewReadOnlyController()
+	assert.False(t, c.IsReadOnly("db"))
+	assert.Equal(t, &models.ReadOnlyState{}, c.GetState())
+
+	c.SetReadOnly("db2", true)
+	c.SetReadOnly("db1", true)
+	assert.True(t, c.IsReadOnly("db1"))
+	assert.False(t, c.IsReadOnly("db"))
+	assert.Equal(t, &models.ReadOnlyState{Databases: []string{"db1", "db2"}}, c.GetState())
+
+	c.SetReadOnly("", true)
+	assert.True(t, c.IsReadOnly("db"))
+	assert.True(t, c.GetState().ReadOnly)
+
+	c.SetReadOnly("", false)
+	c.SetReadOnly("db1", false)
+	assert.False(t, c.IsReadOnly("db1"))
+	assert.True(t, c.IsReadOnly("db2"))
+	assert.Equal(t, &models.ReadOnlyState{Databases: []string{"db2"}}, c.GetState())
+}
@@ -28,4 +28,8 @@ type ReplicatorChannel struct {
 
 	// underlying ConsumerGroup records the replication process.
 	ConsumerGroup queue.ConsumerGroup
+	// Auditor verifies the audit message applied to local storage, only for local replicator.
+	Auditor WriteAuditor
+	// Latency tracks the latency of message applied to local storage, only for local replicator.
+	Latency WriteLatencyTracker
 }
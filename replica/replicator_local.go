@@ -18,6 +18,8 @@
 package replica
 
 import (
+	"sync"
+
 	"github.com/golang/snappy"
 
 	"github.com/lindb/lindb/metrics"
@@ -36,6 +38,7 @@ type localReplicator struct {
 	family    tsdb.DataFamily
 	logger    *logger.Logger
 	batchRows *metric.StorageBatchRows
+	applied   *appliedSequences
 
 	block []byte
 
@@ -55,6 +58,7 @@ func NewLocalReplicator(channel *ReplicatorChannel, shard tsdb.Shard, family tsd
 		logger:     logger.GetLogger("Replica", "LocalReplicator"),
 		block:      make([]byte, 256*1024),
 	}
+	lr.applied = &appliedSequences{report: lr.reportApplied}
 
 	// add ack sequence callback
 	family.AckSequence(lr.leader, func(seq int64) {
@@ -94,6 +98,8 @@ func (r *localReplicator) Replica(sequence int64, msg []byte) {
 		return
 	}
 
+	applied := false
+	entry := r.applied.add(sequence)
 	// flat will always panic when data are corrupted,
 	// or data are not serialized correctly
 	defer func() {
@@ -107,7 +113,10 @@ func (r *localReplicator) Replica(sequence int64, msg []byte) {
 		r.block = r.block[:0]
 
 		// after write need commit sequence, drop write failure data.
+		// the sequence persisted by flush doesn't advance past the staged rows not written.
 		r.family.CommitSequence(r.leader, sequence)
+		// sequence is applied after staged rows written
+		r.applied.done(entry, applied)
 	}()
 
 	// TODO: add util
@@ -125,20 +134,15 @@ func (r *localReplicator) Replica(sequence int64, msg []byte) {
 	r.batchRows.UnmarshalRows(r.block)
 	rowsLen := r.batchRows.Len()
 	if rowsLen == 0 {
+		applied = true
 		return
 	}
-	rows := r.batchRows.Rows()
-
-	// lookup metric metadata
-	if err := r.shard.LookupRowMetricMeta(rows); err != nil {
-		r.statistics.ReplicaFailures.Incr()
-		r.logger.Error("failed lookup row metric meta",
-			logger.Int64("sequence", sequence),
-			logger.Int("rows", r.batchRows.Len()),
-			logger.String("replicator", r.String()),
-			logger.Error(err))
-		return
-	}
+	// lookup metric metadata, rows of new series are staged and written asynchronously,
+	// so that writing of existing series is not blocked by series id generation.
+	r.applied.retain(entry)
+	rows := r.shard.ResolveRowMetricMeta(r.family, r.leader, sequence, r.batchRows.Rows(), func(success bool) {
+		r.applied.done(entry, success)
+	})
 	// write metric data
 	if err := r.family.WriteRows(rows); err != nil {
 		r.statistics.ReplicaFailures.Incr()
@@ -150,6 +154,18 @@ func (r *localReplicator) Replica(sequence int64, msg []byte) {
 		return
 	}
 	r.statistics.ReplicaRows.Add(float64(rowsLen))
+	applied = true
+}
+
+// reportApplied reports the applied result of sequence after all rows of it written into memory database.
+func (r *localReplicator) reportApplied(sequence int64, success bool) {
+	if r.channel.Auditor != nil {
+		// verify audit message if applied
+		r.channel.Auditor.Applied(sequence, success)
+	}
+	if success && r.channel.Latency != nil {
+		r.channel.Latency.Applied(sequence)
+	}
 }
 
 // Close closes local replicator.
@@ -157,3 +173,53 @@ func (r *localReplicator) Close() {
 	// mark write data completed.
 	r.family.Release()
 }
+
+// appliedSequence represents the replica sequence waiting for applied,
+// refs is the number of writes not completed(rows written directly and rows staged).
+type appliedSequence struct {
+	sequence int64
+	refs     int
+	success  bool
+}
+
+// appliedSequences reports the applied result of replica sequences in order,
+// the sequence is applied after all rows of it(includes staged rows) written into memory database.
+type appliedSequences struct {
+	pending []*appliedSequence // ordered by sequence
+	report  func(sequence int64, success bool)
+	lock    sync.Mutex
+}
+
+// add adds the sequence waiting for applied, the write of it is completed by done.
+func (a *appliedSequences) add(sequence int64) *appliedSequence {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	entry := &appliedSequence{sequence: sequence, refs: 1, success: true}
+	a.pending = append(a.pending, entry)
+	return entry
+}
+
+// retain adds a write of sequence, which is completed by done.
+func (a *appliedSequences) retain(entry *appliedSequence) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	entry.refs++
+}
+
+// done completes a write of sequence, reports the sequences which all writes completed in order.
+func (a *appliedSequences) done(entry *appliedSequence, success bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	entry.refs--
+	entry.success = entry.success && success
+	idx := 0
+	for idx < len(a.pending) && a.pending[idx].refs == 0 {
+		a.report(a.pending[idx].sequence, a.pending[idx].success)
+		a.pending[idx] = nil
+		idx++
+	}
+	a.pending = a.pending[idx:]
+}
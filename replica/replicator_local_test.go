@@ -117,15 +117,18 @@ func TestLocalReplicator_Replica(t *testing.T) {
 	_, _ = row.WriteTo(buf)
 	var dst []byte
 	dst = snappy.Encode(dst, buf.Bytes())
-	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(fmt.Errorf("err"))
-	replicator.Replica(1, dst)
-
+	resolveRows := func(_ tsdb.DataFamily, _ int32, _ int64, rows []metric.StorageRow,
+		written func(success bool),
+	) []metric.StorageRow {
+		written(true)
+		return rows
+	}
 	// write failure
-	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(nil)
+	shard.EXPECT().ResolveRowMetricMeta(family, int32(1), int64(1), gomock.Any(), gomock.Any()).DoAndReturn(resolveRows)
 	family.EXPECT().WriteRows(gomock.Any()).Return(fmt.Errorf("err"))
 	replicator.Replica(1, dst)
 	// write success
-	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(nil)
+	shard.EXPECT().ResolveRowMetricMeta(family, int32(1), int64(1), gomock.Any(), gomock.Any()).DoAndReturn(resolveRows)
 	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
 	replicator.Replica(1, dst)
 	// bad data
@@ -137,6 +140,43 @@ func TestLocalReplicator_Replica(t *testing.T) {
 	// empty rows
 	dst = snappy.Encode(dst, []byte{})
 	replicator.Replica(1, dst)
+
+	// verify audit message
+	auditor := NewMockWriteAuditor(ctrl)
+	replicator.(*localReplicator).channel.Auditor = auditor
+	auditor.EXPECT().Applied(int64(2), true)
+	replicator.Replica(2, dst)
+	auditor.EXPECT().Applied(int64(3), false)
+	replicator.Replica(3, []byte{1, 2, 3})
+
+	// track latency of applied message
+	latency := NewMockWriteLatencyTracker(ctrl)
+	replicator.(*localReplicator).channel.Latency = latency
+	auditor.EXPECT().Applied(int64(4), true)
+	latency.EXPECT().Applied(int64(4))
+	replicator.Replica(4, dst)
+	auditor.EXPECT().Applied(int64(5), false)
+	replicator.Replica(5, []byte{1, 2, 3})
+
+	// staged rows written asynchronously, sequences are applied in order after staged rows written
+	data := snappy.Encode(nil, buf.Bytes())
+	var stagedWritten func(success bool)
+	shard.EXPECT().ResolveRowMetricMeta(family, int32(1), int64(6), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ tsdb.DataFamily, _ int32, _ int64, _ []metric.StorageRow, written func(success bool)) []metric.StorageRow {
+			stagedWritten = written
+			return nil
+		})
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil).Times(2)
+	replicator.Replica(6, data)
+	shard.EXPECT().ResolveRowMetricMeta(family, int32(1), int64(7), gomock.Any(), gomock.Any()).DoAndReturn(resolveRows)
+	replicator.Replica(7, data)
+	gomock.InOrder(
+		auditor.EXPECT().Applied(int64(6), false),
+		auditor.EXPECT().Applied(int64(7), true),
+	)
+	latency.EXPECT().Applied(int64(7))
+	stagedWritten(false)
+	assert.Empty(t, replicator.(*localReplicator).applied.pending)
 }
 
 func TestLocalReplicator_Close(t *testing.T) {
@@ -23,11 +23,9 @@ import (
 
 	"go.uber.org/atomic"
 
-	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
-	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/rpc"
@@ -41,8 +39,9 @@ type remoteReplicator struct {
 	state atomic.Value // ref: state
 
 	cliFct        rpc.ClientStreamFactory
+	followerNode  models.Node
 	replicaCli    protoReplicaV1.ReplicaServiceClient
-	replicaStream protoReplicaV1.ReplicaService_ReplicaClient
+	replicaStream rpc.ReplicaStream
 	stateMgr      storage.StateManager
 
 	isSuspend *atomic.Bool
@@ -97,19 +96,16 @@ func (r *remoteReplicator) handleNodeStateChangeEvent(state models.NodeStateType
 }
 
 // Connect connects follower node, if replica stream exist, use old one, else creates new stream.
+// Replica stream is multiplexed with other family channels of the same (follower node, database).
 func (r *remoteReplicator) Connect() bool {
 	if r.replicaStream != nil {
 		return true
 	}
 
 	r.state.Store(&state{state: models.ReplicatorInitState, errMsg: "creating replica stream"})
-	// pass metadata(database/shard state) when create rpc connection.
-	replicaState := encoding.JSONMarshal(&r.channel.State)
-	ctx := rpc.CreateOutgoingContextWithPairs(r.ctx,
-		constants.RPCMetaReplicaState, string(replicaState))
-	replicaStream, err := r.replicaCli.Replica(ctx) // TODO add timeout ??
+	replicaStream, err := r.cliFct.CreateReplicaStream(r.followerNode, r.channel.State)
 	if err != nil {
-		r.statistics.CloseLastStreamFailures.Incr()
+		r.statistics.CreateReplicaStreamFailures.Incr()
 		r.logger.Warn("create replica service client stream err",
 			logger.String("replicator", r.String()),
 			logger.Error(err))
@@ -168,6 +164,7 @@ func (r *remoteReplicator) IsReady() bool {
 		r.state.Store(&state{state: models.ReplicatorFailureState, errMsg: "create replica client failure, root cause: " + err.Error()})
 		return false
 	}
+	r.followerNode = &node
 	r.replicaCli = replicaCli
 	r.statistics.CreateReplicaCli.Incr()
 
@@ -256,29 +253,16 @@ func (r *remoteReplicator) IsReady() bool {
 
 // Replica sends data to remote replica node.
 func (r *remoteReplicator) Replica(idx int64, msg []byte) {
-	cli := r.replicaStream
-	err := cli.Send(&protoReplicaV1.ReplicaRequest{
-		ReplicaIndex: idx,
-		Record:       msg,
-	})
+	resp, err := r.replicaStream.Replica(idx, msg)
 	if err != nil {
-		r.state.Store(&state{state: models.ReplicatorFailureState, errMsg: "send replica req failure, root cause: " + err.Error()})
+		r.state.Store(&state{state: models.ReplicatorFailureState, errMsg: "replica failure, root cause: " + err.Error()})
 		r.statistics.SendMsgFailures.Incr()
-		r.logger.Error("send replica request",
+		r.logger.Error("replica log to follower",
 			logger.String("replicator", r.String()),
 			logger.Int64("replicaIdx", idx), logger.Error(err))
 		return
 	}
 	r.statistics.SendMsg.Incr()
-	resp, err := cli.Recv()
-	if err != nil {
-		r.state.Store(&state{state: models.ReplicatorFailureState, errMsg: "receive replica resp failure, root case: " + err.Error()})
-		r.statistics.ReceiveMsgFailures.Incr()
-		r.logger.Error("receive replica response",
-			logger.String("replicator", r.String()),
-			logger.Int64("replicaIdx", idx), logger.Error(err))
-		return
-	}
 	r.statistics.ReceiveMsg.Incr()
 	r.logger.Debug("receive replica response",
 		logger.String("replicator", r.String()),
@@ -304,7 +288,7 @@ func (r *remoteReplicator) closeStream() {
 	if r.replicaStream != nil {
 		// need close old replica stream
 		r.statistics.NeedCloseLastStream.Incr()
-		if err := r.replicaStream.CloseSend(); err != nil {
+		if err := r.replicaStream.Close(); err != nil {
 			r.statistics.CloseLastStreamFailures.Incr()
 			r.logger.Warn("close replica service client stream err, when reconnection",
 				logger.String("replicator", r.String()),
@@ -166,8 +166,8 @@ func TestRemoteReplicator_IsReady(t *testing.T) {
 			name: "reconnect after failure",
 			prepare: func(r *remoteReplicator) {
 				r.state.Store(&state{state: models.ReplicatorFailureState})
-				stream := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
-				stream.EXPECT().CloseSend().Return(fmt.Errorf("err"))
+				stream := rpc.NewMockReplicaStream(ctrl)
+				stream.EXPECT().Close().Return(fmt.Errorf("err"))
 				r.replicaStream = stream
 
 				cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil)
@@ -216,7 +216,6 @@ func TestRemoteReplicator_NodeStateChange(t *testing.T) {
 	cg.EXPECT().ConsumedSeq().Return(int64(10)).AnyTimes()
 	replicaCli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
 	cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil).AnyTimes()
-	replicaCli.EXPECT().Replica(gomock.Any()).Return(nil, nil).AnyTimes()
 	replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
 		AckIndex: 10,
 	}, nil).AnyTimes()
@@ -277,26 +276,20 @@ func TestRemoteReplicator_Replica(t *testing.T) {
 
 	r := NewRemoteReplicator(context.TODO(), rc, stateMgr, cliFct)
 	r1 := r.(*remoteReplicator)
-	cli := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+	cli := rpc.NewMockReplicaStream(ctrl)
 	r1.replicaStream = cli
 
-	cli.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
+	cli.EXPECT().Replica(int64(1), gomock.Any()).Return(nil, fmt.Errorf("err"))
 	r.Replica(1, []byte{})
 
-	cli.EXPECT().Send(gomock.Any()).Return(nil)
-	cli.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
-	r.Replica(1, []byte{})
-
-	cli.EXPECT().Send(gomock.Any()).Return(nil)
-	cli.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{
+	cli.EXPECT().Replica(int64(1), gomock.Any()).Return(&protoReplicaV1.ReplicaResponse{
 		AckIndex:     1,
 		ReplicaIndex: 1,
 	}, nil)
 	q.EXPECT().Ack(int64(1))
 	r.Replica(1, []byte{})
 	// invalid ack sequence
-	cli.EXPECT().Send(gomock.Any()).Return(nil)
-	cli.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{
+	cli.EXPECT().Replica(int64(1), gomock.Any()).Return(&protoReplicaV1.ReplicaResponse{
 		AckIndex:     1,
 		ReplicaIndex: 2,
 	}, nil)
@@ -312,7 +305,6 @@ func TestRemoteReplicator_Connect(t *testing.T) {
 	stateMgr.EXPECT().WatchNodeStateChangeEvent(gomock.Any(), gomock.Any()).AnyTimes()
 	stateMgr.EXPECT().GetLiveNode(gomock.Any()).Return(models.StatefulNode{}, true).AnyTimes()
 	cliFct := rpc.NewMockClientStreamFactory(ctrl)
-	replicaCli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
 	q := queue.NewMockConsumerGroup(ctrl)
 	fq := queue.NewMockFanOutQueue(ctrl)
 	q.EXPECT().Queue().Return(fq).AnyTimes()
@@ -334,23 +326,21 @@ func TestRemoteReplicator_Connect(t *testing.T) {
 		{
 			name: "stream exist",
 			prepare: func(r *remoteReplicator) {
-				r.replicaStream = protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+				r.replicaStream = rpc.NewMockReplicaStream(ctrl)
 			},
 			ready: true,
 		},
 		{
 			name: "create stream failure",
 			prepare: func(r *remoteReplicator) {
-				r.replicaCli = replicaCli
-				replicaCli.EXPECT().Replica(gomock.Any()).Return(nil, fmt.Errorf("err"))
+				cliFct.EXPECT().CreateReplicaStream(gomock.Any(), rc.State).Return(nil, fmt.Errorf("err"))
 			},
 			ready: false,
 		},
 		{
 			name: "create stream successfully",
 			prepare: func(r *remoteReplicator) {
-				r.replicaCli = replicaCli
-				replicaCli.EXPECT().Replica(gomock.Any()).Return(nil, nil)
+				cliFct.EXPECT().CreateReplicaStream(gomock.Any(), rc.State).Return(rpc.NewMockReplicaStream(ctrl), nil)
 			},
 			ready: true,
 		},
@@ -378,7 +368,7 @@ func TestRemoteReplicator_Close(t *testing.T) {
 		r.Close()
 	})
 	t.Run("close stream failure", func(_ *testing.T) {
-		stream := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+		stream := rpc.NewMockReplicaStream(ctrl)
 		r := &remoteReplicator{
 			replicaStream: stream,
 			statistics:    metrics.NewStorageRemoteReplicatorStatistics("test", "0"),
@@ -392,7 +382,7 @@ func TestRemoteReplicator_Close(t *testing.T) {
 				},
 			}},
 		}
-		stream.EXPECT().CloseSend().Return(fmt.Errorf("err"))
+		stream.EXPECT().Close().Return(fmt.Errorf("err"))
 		r.Close()
 	})
 }
@@ -0,0 +1,183 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/lindb/lindb/metrics"
+)
+
+//go:generate mockgen -source=./write_audit.go -destination=./write_audit_mock.go -package=replica
+
+// for testing
+var (
+	randFloat64Fn = rand.Float64
+)
+
+const (
+	// auditSeqBits is the bits of audit sequence in audit id, high bits is the audit session.
+	auditSeqBits = 32
+	// auditReorderWindow is the max number of audit message can be received out of order(retry message),
+	// missing audit message is regarded as lost if it doesn't arrive in window.
+	auditReorderWindow = 256
+)
+
+// writeAuditSampler samples the message sent by family channel, tags sampled message with audit id,
+// audit id = session(random for each family channel) << 32 | sequence(increases by sampled message).
+type writeAuditSampler struct {
+	ratio   float64
+	session int64
+	seq     int64
+}
+
+// newWriteAuditSampler creates a write audit sampler, returns nil if audit is disabled.
+func newWriteAuditSampler(ratio float64) *writeAuditSampler {
+	if ratio <= 0 {
+		return nil
+	}
+	return &writeAuditSampler{
+		ratio:   ratio,
+		session: rand.Int63n(math.MaxInt32) + 1,
+	}
+}
+
+// next returns the audit id of message if it is sampled, else returns 0.
+func (s *writeAuditSampler) next() int64 {
+	if s == nil || randFloat64Fn() >= s.ratio {
+		return 0
+	}
+	s.seq++
+	return s.session<<auditSeqBits | (s.seq & math.MaxUint32)
+}
+
+// WriteAuditor verifies the sampled message tagged with audit id by broker,
+// checks if message is lost/duplicated between broker and storage, then applied to write ahead log/memory database.
+type WriteAuditor interface {
+	// Received checks the message received from broker if lost/duplicated based on audit id.
+	Received(auditID int64)
+	// Appended records the message appended to write ahead log with sequence.
+	Appended(sequence, auditID int64, err error)
+	// Applied checks the message of sequence if applied to memory database successfully.
+	Applied(sequence int64, success bool)
+}
+
+// writeAuditSession represents the audit state of a broker's family channel.
+type writeAuditSession struct {
+	last    int64              // max audit sequence received
+	missing map[int64]struct{} // audit sequence not received yet(lost or out of order)
+}
+
+// writeAuditor implements WriteAuditor interface.
+type writeAuditor struct {
+	sessions map[int64]*writeAuditSession // session => audit state
+	pending  map[int64]int64              // wal sequence => audit id, appended but not applied
+	lock     sync.Mutex
+
+	statistics *metrics.StorageWriteAuditStatistics
+}
+
+// NewWriteAuditor creates a write auditor for partition.
+func NewWriteAuditor(database, shard string) WriteAuditor {
+	return &writeAuditor{
+		sessions:   make(map[int64]*writeAuditSession),
+		pending:    make(map[int64]int64),
+		statistics: metrics.NewStorageWriteAuditStatistics(database, shard),
+	}
+}
+
+// Received checks the message received from broker if lost/duplicated based on audit id.
+func (a *writeAuditor) Received(auditID int64) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.statistics.Received.Incr()
+
+	sessionID := auditID >> auditSeqBits
+	seq := auditID & math.MaxUint32
+	session, ok := a.sessions[sessionID]
+	if !ok {
+		// first message of session(maybe leader changed), cannot check message before it
+		a.sessions[sessionID] = &writeAuditSession{last: seq, missing: make(map[int64]struct{})}
+		return
+	}
+	switch {
+	case seq > session.last:
+		start := session.last + 1
+		if seq-start > auditReorderWindow {
+			// too many message missing, out of reorder window
+			a.statistics.Lost.Add(float64(seq - start - auditReorderWindow))
+			start = seq - auditReorderWindow
+		}
+		for missing := start; missing < seq; missing++ {
+			session.missing[missing] = struct{}{}
+		}
+		session.last = seq
+		// missing message out of reorder window is lost
+		for missing := range session.missing {
+			if session.last-missing > auditReorderWindow {
+				delete(session.missing, missing)
+				a.statistics.Lost.Incr()
+			}
+		}
+	default:
+		if _, ok := session.missing[seq]; ok {
+			// out of order message(retry message) arrived
+			delete(session.missing, seq)
+		} else {
+			a.statistics.Duplicated.Incr()
+		}
+	}
+}
+
+// Appended records the message appended to write ahead log with sequence.
+func (a *writeAuditor) Appended(sequence, auditID int64, err error) {
+	if err != nil {
+		a.statistics.AppendWALFailures.Incr()
+		return
+	}
+	a.statistics.AppendWAL.Incr()
+
+	a.lock.Lock()
+	a.pending[sequence] = auditID
+	a.lock.Unlock()
+}
+
+// Applied checks the message of sequence if applied to memory database successfully.
+func (a *writeAuditor) Applied(sequence int64, success bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if len(a.pending) == 0 {
+		return
+	}
+	for seq := range a.pending {
+		switch {
+		case seq == sequence && success:
+			a.statistics.Applied.Incr()
+		case seq <= sequence:
+			// apply failure or skipped by local replicator
+			a.statistics.ApplyFailures.Incr()
+		default:
+			continue
+		}
+		delete(a.pending, seq)
+	}
+}
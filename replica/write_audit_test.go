@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteAuditSampler(t *testing.T) {
+	defer func() {
+		randFloat64Fn = rand.Float64
+	}()
+	assert.Nil(t, newWriteAuditSampler(0))
+	var sampler *writeAuditSampler
+	assert.Zero(t, sampler.next())
+
+	sampler = newWriteAuditSampler(0.5)
+	randFloat64Fn = func() float64 {
+		return 0.6
+	}
+	assert.Zero(t, sampler.next())
+	randFloat64Fn = func() float64 {
+		return 0.1
+	}
+	auditID := sampler.next()
+	assert.Equal(t, sampler.session, auditID>>auditSeqBits)
+	assert.Equal(t, int64(1), auditID&(1<<auditSeqBits-1))
+	assert.Equal(t, auditID+1, sampler.next())
+}
+
+func TestWriteAuditor_Received(t *testing.T) {
+	auditor := NewWriteAuditor("db", "1").(*writeAuditor)
+	auditID := func(session, seq int64) int64 {
+		return session<<auditSeqBits | seq
+	}
+	// first message of session
+	auditor.Received(auditID(1, 10))
+	auditor.Received(auditID(1, 11))
+	// out of order
+	auditor.Received(auditID(1, 14))
+	assert.Len(t, auditor.sessions[1].missing, 2)
+	auditor.Received(auditID(1, 12))
+	assert.Len(t, auditor.sessions[1].missing, 1)
+	// duplicated
+	auditor.Received(auditID(1, 12))
+	auditor.Received(auditID(1, 14))
+	// missing message out of reorder window
+	auditor.Received(auditID(1, 14+auditReorderWindow+10))
+	assert.Len(t, auditor.sessions[1].missing, auditReorderWindow)
+	_, ok := auditor.sessions[1].missing[13]
+	assert.False(t, ok)
+	// another session
+	auditor.Received(auditID(2, 1))
+	assert.Len(t, auditor.sessions, 2)
+}
+
+func TestWriteAuditor_Applied(t *testing.T) {
+	auditor := NewWriteAuditor("db", "1").(*writeAuditor)
+	auditor.Applied(1, true)
+	auditor.Appended(1, 10, fmt.Errorf("err"))
+	assert.Empty(t, auditor.pending)
+	auditor.Appended(1, 10, nil)
+	auditor.Appended(3, 11, nil)
+	auditor.Appended(5, 12, nil)
+	auditor.Applied(1, true)
+	assert.Len(t, auditor.pending, 2)
+	// sequence 3 skipped
+	auditor.Applied(4, true)
+	assert.Len(t, auditor.pending, 1)
+	auditor.Applied(5, false)
+	assert.Empty(t, auditor.pending)
+}
@@ -0,0 +1,105 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"sync"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./write_latency.go -destination=./write_latency_mock.go -package=replica
+
+// maxPendingApplyLatency is the max number of appended message tracked for apply latency,
+// new message isn't tracked if local replicator lags too much.
+const maxPendingApplyLatency = 4096
+
+// WriteLatencyTracker tracks the latency of each stage in storage write path:
+// 1. append wal: since batch created by broker until appended to write ahead log;
+// 2. apply memory database: since appended to write ahead log until applied to memory database by local replicator.
+type WriteLatencyTracker interface {
+	// Appended records the message appended to write ahead log with sequence,
+	// batch time is the create time of message by broker(0 if unknown).
+	Appended(sequence, batchTime int64)
+	// Applied records the message of sequence applied to memory database.
+	Applied(sequence int64)
+}
+
+// appendedMessage represents the message appended to write ahead log, but not applied.
+type appendedMessage struct {
+	sequence   int64
+	appendTime int64
+}
+
+// writeLatencyTracker implements WriteLatencyTracker interface.
+type writeLatencyTracker struct {
+	pending []appendedMessage // appended but not applied message, ordered by sequence
+	lock    sync.Mutex
+
+	statistics *metrics.StorageWriteLatencyStatistics
+}
+
+// NewWriteLatencyTracker creates a write latency tracker for partition.
+func NewWriteLatencyTracker(database string) WriteLatencyTracker {
+	return &writeLatencyTracker{
+		statistics: metrics.NewStorageWriteLatencyStatistics(database),
+	}
+}
+
+// Appended records the message appended to write ahead log with sequence,
+// batch time is the create time of message by broker(0 if unknown).
+func (t *writeLatencyTracker) Appended(sequence, batchTime int64) {
+	now := timeutil.Now()
+	if batchTime > 0 {
+		// NOTE: latency includes the clock skew between broker and storage
+		latency := now - batchTime
+		if latency < 0 {
+			latency = 0
+		}
+		t.statistics.AppendWAL.UpdateMilliseconds(float64(latency))
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	n := len(t.pending)
+	if n >= maxPendingApplyLatency || (n > 0 && t.pending[n-1].sequence >= sequence) {
+		// local replicator lags too much, or sequence already tracked by concurrent write
+		return
+	}
+	t.pending = append(t.pending, appendedMessage{sequence: sequence, appendTime: now})
+}
+
+// Applied records the message of sequence applied to memory database.
+func (t *writeLatencyTracker) Applied(sequence int64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	idx := 0
+	n := len(t.pending)
+	// skip message not tracked(sequence applied/ignored before)
+	for idx < n && t.pending[idx].sequence < sequence {
+		idx++
+	}
+	if idx < n && t.pending[idx].sequence == sequence {
+		t.statistics.ApplyMemDB.UpdateMilliseconds(float64(timeutil.Now() - t.pending[idx].appendTime))
+		idx++
+	}
+	t.pending = t.pending[idx:]
+}
@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestWriteLatencyTracker(t *testing.T) {
+	tracker := NewWriteLatencyTracker("test").(*writeLatencyTracker)
+	// applied before appended
+	tracker.Applied(1)
+	assert.Empty(t, tracker.pending)
+
+	tracker.Appended(1, 0)
+	tracker.Appended(2, timeutil.Now()-10)
+	// sequence tracked by concurrent write
+	tracker.Appended(2, timeutil.Now())
+	tracker.Appended(4, timeutil.Now()+10)
+	assert.Len(t, tracker.pending, 3)
+
+	tracker.Applied(2)
+	assert.Len(t, tracker.pending, 1)
+	assert.Equal(t, int64(4), tracker.pending[0].sequence)
+	// sequence not tracked
+	tracker.Applied(3)
+	assert.Len(t, tracker.pending, 1)
+	tracker.Applied(4)
+	assert.Empty(t, tracker.pending)
+
+	// local replicator lags too much
+	for i := 0; i < maxPendingApplyLatency+10; i++ {
+		tracker.Appended(int64(10+i), 0)
+	}
+	assert.Len(t, tracker.pending, maxPendingApplyLatency)
+}
@@ -0,0 +1,183 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/models"
+)
+
+const (
+	// breakerFailureThreshold represents the number of consecutive failures which trips the breaker.
+	breakerFailureThreshold = 5
+	// breakerOpenTimeout represents the duration before open breaker becomes half-open for probing.
+	breakerOpenTimeout = 10 * time.Second
+)
+
+// BreakerState represents the state of circuit breaker.
+type BreakerState int
+
+// Defines all states of circuit breaker.
+const (
+	// BreakerClosed represents requests can be sent to target node.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen represents target node is broken, all requests are rejected.
+	BreakerOpen
+	// BreakerHalfOpen represents only one probe request can be sent to target node.
+	BreakerHalfOpen
+)
+
+// String returns the string value of breaker state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker trips when target node fails continuously,
+// after open timeout, allows one probe request(half-open),
+// closes if probe succeeds, else opens again,
+// if probe has no result after open timeout(e.g. response lost), allows another probe.
+type circuitBreaker struct {
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+	probeAt  time.Time
+
+	failureThreshold int
+	openTimeout      time.Duration
+	now              func() time.Time
+
+	mutex sync.Mutex
+}
+
+// newCircuitBreaker creates a closed circuit breaker.
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{
+		state:            BreakerClosed,
+		failureThreshold: breakerFailureThreshold,
+		openTimeout:      breakerOpenTimeout,
+		now:              time.Now,
+	}
+}
+
+// available returns true if breaker is not open, doesn't take the probe of half-open.
+func (b *circuitBreaker) available() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.checkOpenTimeout()
+	return b.state != BreakerOpen
+}
+
+// allow returns true if request can be sent, takes the probe if breaker is half-open.
+func (b *circuitBreaker) allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.checkOpenTimeout()
+	switch b.state {
+	case BreakerClosed:
+		return true
+	case BreakerHalfOpen:
+		now := b.now()
+		if b.probing && now.Sub(b.probeAt) < b.openTimeout {
+			// only one probe request in flight, probe without result after open timeout is given up
+			return false
+		}
+		b.probing = true
+		b.probeAt = now
+		return true
+	default:
+		return false
+	}
+}
+
+// onSuccess closes the breaker, returns the state before.
+func (b *circuitBreaker) onSuccess() BreakerState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	prev := b.state
+	b.state = BreakerClosed
+	b.failures = 0
+	b.probing = false
+	return prev
+}
+
+// onFailure records a failure, returns true if breaker trips(becomes open).
+func (b *circuitBreaker) onFailure() (tripped bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures++
+	switch b.state {
+	case BreakerClosed:
+		if b.failures >= b.failureThreshold {
+			b.open()
+			return true
+		}
+	case BreakerHalfOpen:
+		// probe failure, open again
+		b.open()
+		return true
+	}
+	return false
+}
+
+// getState returns the current state of breaker.
+func (b *circuitBreaker) getState(target string) models.CircuitBreakerState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.checkOpenTimeout()
+	state := models.CircuitBreakerState{
+		Target:   target,
+		State:    b.state.String(),
+		Failures: b.failures,
+	}
+	if b.state != BreakerClosed {
+		state.OpenedAt = b.openedAt.UnixMilli()
+	}
+	return state
+}
+
+// open trips the breaker.
+func (b *circuitBreaker) open() {
+	b.state = BreakerOpen
+	b.openedAt = b.now()
+	b.probing = false
+}
+
+// checkOpenTimeout changes open breaker to half-open after open timeout.
+func (b *circuitBreaker) checkOpenTimeout() {
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.openTimeout {
+		b.state = BreakerHalfOpen
+		b.probing = false
+	}
+}
@@ -0,0 +1,72 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakerState_String(t *testing.T) {
+	assert.Equal(t, "closed", BreakerClosed.String())
+	assert.Equal(t, "open", BreakerOpen.String())
+	assert.Equal(t, "half-open", BreakerHalfOpen.String())
+	assert.Equal(t, "unknown", BreakerState(10).String())
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker()
+	b.now = func() time.Time { return now }
+	assert.True(t, b.allow())
+	assert.True(t, b.available())
+	// consecutive failures trip the breaker
+	for i := 0; i < breakerFailureThreshold-1; i++ {
+		assert.False(t, b.onFailure())
+	}
+	assert.True(t, b.onFailure())
+	assert.False(t, b.allow())
+	assert.False(t, b.available())
+	state := b.getState("target")
+	assert.Equal(t, "open", state.State)
+	assert.Equal(t, now.UnixMilli(), state.OpenedAt)
+	// half-open after open timeout, only one probe
+	now = now.Add(breakerOpenTimeout)
+	assert.True(t, b.available())
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+	// probe without result, allow another probe after open timeout
+	now = now.Add(breakerOpenTimeout)
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+	// probe failure, open again
+	assert.True(t, b.onFailure())
+	assert.False(t, b.available())
+	// probe success, close breaker
+	now = now.Add(breakerOpenTimeout)
+	assert.True(t, b.allow())
+	assert.Equal(t, BreakerHalfOpen, b.onSuccess())
+	assert.True(t, b.allow())
+	assert.True(t, b.allow())
+	state = b.getState("target")
+	assert.Equal(t, "closed", state.State)
+	assert.Zero(t, state.Failures)
+	assert.Zero(t, state.OpenedAt)
+}
@@ -19,8 +19,11 @@ package rpc
 
 import (
 	"io"
+	"sort"
 	"sync"
 
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 )
@@ -35,23 +38,42 @@ type ConnectionManager interface {
 	CreateConnection(target models.Node)
 	// CloseConnection closes a grpc connection.
 	CloseConnection(target models.Node)
+
+	// IsAvailable returns true if the circuit breaker of target node is not open,
+	// used to route requests around broken nodes.
+	IsAvailable(target string) bool
+	// AllowRequest returns true if request can be sent to target node,
+	// if circuit breaker is half-open, only one probe request is allowed.
+	AllowRequest(target string) bool
+	// ReportSuccess reports target node responded successfully, closes the circuit breaker.
+	ReportSuccess(target string)
+	// ReportFailure reports target node failed(send failure/timeout),
+	// trips the circuit breaker on consecutive failures.
+	ReportFailure(target string)
+	// GetCircuitBreakerStates returns the circuit breaker state of target nodes which have failed.
+	GetCircuitBreakerStates() []models.CircuitBreakerState
 }
 
 // connectionManager implements ConnectionManager interface.
 type connectionManager struct {
 	connections   map[string]struct{}
+	breakers      map[string]*circuitBreaker // target node => circuit breaker
 	taskClientFct TaskClientFactory
 
-	mutex sync.Mutex
+	mutex        sync.Mutex
+	breakerMutex sync.RWMutex
 
-	logger *logger.Logger
+	statistics *metrics.CircuitBreakerStatistics
+	logger     *logger.Logger
 }
 
 // NewConnectionManager creates a ConnectionManager instance.
-func NewConnectionManager(taskClientFct TaskClientFactory) ConnectionManager {
+func NewConnectionManager(taskClientFct TaskClientFactory, registry *linmetric.Registry) ConnectionManager {
 	return &connectionManager{
 		taskClientFct: taskClientFct,
 		connections:   make(map[string]struct{}),
+		breakers:      make(map[string]*circuitBreaker),
+		statistics:    metrics.NewCircuitBreakerStatistics(registry),
 		logger:        logger.GetLogger("RPC", "ConnectionManager"),
 	}
 }
@@ -105,6 +127,7 @@ func (m *connectionManager) Close() error {
 func (m *connectionManager) closeConnection(target string) {
 	closed, err := m.taskClientFct.CloseTaskClient(target)
 	delete(m.connections, target)
+	m.removeBreaker(target)
 
 	if closed {
 		if err == nil {
@@ -123,3 +146,93 @@ func (m *connectionManager) closeConnection(target string) {
 		)
 	}
 }
+
+// IsAvailable returns true if the circuit breaker of target node is not open.
+func (m *connectionManager) IsAvailable(target string) bool {
+	breaker := m.getBreaker(target)
+	if breaker == nil {
+		return true
+	}
+	return breaker.available()
+}
+
+// AllowRequest returns true if request can be sent to target node.
+func (m *connectionManager) AllowRequest(target string) bool {
+	breaker := m.getBreaker(target)
+	if breaker == nil || breaker.allow() {
+		return true
+	}
+	m.statistics.Rejected.WithTagValues(target).Incr()
+	return false
+}
+
+// ReportSuccess reports target node responded successfully, closes the circuit breaker.
+func (m *connectionManager) ReportSuccess(target string) {
+	breaker := m.getBreaker(target)
+	if breaker == nil {
+		return
+	}
+	if prev := breaker.onSuccess(); prev != BreakerClosed {
+		m.logger.Info("circuit breaker closed, target node recovered",
+			logger.String("target", target))
+		m.statistics.State.WithTagValues(target).Update(float64(BreakerClosed))
+	}
+}
+
+// ReportFailure reports target node failed, trips the circuit breaker on consecutive failures.
+func (m *connectionManager) ReportFailure(target string) {
+	breaker := m.getOrCreateBreaker(target)
+	if breaker.onFailure() {
+		m.logger.Warn("circuit breaker tripped, route requests around target node",
+			logger.String("target", target))
+		m.statistics.Trips.WithTagValues(target).Incr()
+		m.statistics.State.WithTagValues(target).Update(float64(BreakerOpen))
+	}
+}
+
+// GetCircuitBreakerStates returns the circuit breaker state of target nodes which have failed.
+func (m *connectionManager) GetCircuitBreakerStates() []models.CircuitBreakerState {
+	m.breakerMutex.RLock()
+	defer m.breakerMutex.RUnlock()
+
+	states := make([]models.CircuitBreakerState, 0, len(m.breakers))
+	for target, breaker := range m.breakers {
+		states = append(states, breaker.getState(target))
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Target < states[j].Target
+	})
+	return states
+}
+
+// getBreaker returns the circuit breaker of target node, returns nil if target node never failed.
+func (m *connectionManager) getBreaker(target string) *circuitBreaker {
+	m.breakerMutex.RLock()
+	defer m.breakerMutex.RUnlock()
+
+	return m.breakers[target]
+}
+
+// getOrCreateBreaker returns the circuit breaker of target node, creates it if not exist.
+func (m *connectionManager) getOrCreateBreaker(target string) *circuitBreaker {
+	if breaker := m.getBreaker(target); breaker != nil {
+		return breaker
+	}
+	m.breakerMutex.Lock()
+	defer m.breakerMutex.Unlock()
+
+	breaker, ok := m.breakers[target]
+	if !ok {
+		breaker = newCircuitBreaker()
+		m.breakers[target] = breaker
+	}
+	return breaker
+}
+
+// removeBreaker removes the circuit breaker of target node when connection closed.
+func (m *connectionManager) removeBreaker(target string) {
+	m.breakerMutex.Lock()
+	defer m.breakerMutex.Unlock()
+
+	delete(m.breakers, target)
+}
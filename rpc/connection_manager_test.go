@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/models"
 )
 
@@ -32,7 +33,7 @@ func TestConnectionManager_CreateConnection(t *testing.T) {
 	defer ctrl.Finish()
 
 	taskClientFct := NewMockTaskClientFactory(ctrl)
-	connection := NewConnectionManager(taskClientFct)
+	connection := NewConnectionManager(taskClientFct, linmetric.BrokerRegistry)
 	target := &models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}
 
 	testCases := []struct {
@@ -77,7 +78,7 @@ func TestConnectionManager_CloseConnection(t *testing.T) {
 	defer ctrl.Finish()
 
 	taskClientFct := NewMockTaskClientFactory(ctrl)
-	connection := NewConnectionManager(taskClientFct)
+	connection := NewConnectionManager(taskClientFct, linmetric.BrokerRegistry)
 	target := &models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}
 
 	testCases := []struct {
@@ -121,7 +122,7 @@ func TestConnectionManager_Close(t *testing.T) {
 	defer ctrl.Finish()
 
 	taskClientFct := NewMockTaskClientFactory(ctrl)
-	connection := NewConnectionManager(taskClientFct)
+	connection := NewConnectionManager(taskClientFct, linmetric.BrokerRegistry)
 	target := &models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}
 
 	taskClientFct.EXPECT().CreateTaskClient(gomock.Any()).Return(nil)
@@ -129,3 +130,33 @@ func TestConnectionManager_Close(t *testing.T) {
 	connection.CreateConnection(target)
 	assert.NoError(t, connection.Close())
 }
+
+func TestConnectionManager_CircuitBreaker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskClientFct := NewMockTaskClientFactory(ctrl)
+	connection := NewConnectionManager(taskClientFct, linmetric.BrokerRegistry)
+	target := &models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}
+	// no failures
+	assert.True(t, connection.IsAvailable(target.Indicator()))
+	assert.True(t, connection.AllowRequest(target.Indicator()))
+	connection.ReportSuccess(target.Indicator())
+	assert.Empty(t, connection.GetCircuitBreakerStates())
+	// trip breaker
+	for i := 0; i < breakerFailureThreshold; i++ {
+		connection.ReportFailure(target.Indicator())
+	}
+	assert.False(t, connection.IsAvailable(target.Indicator()))
+	assert.False(t, connection.AllowRequest(target.Indicator()))
+	states := connection.GetCircuitBreakerStates()
+	assert.Len(t, states, 1)
+	assert.Equal(t, BreakerOpen.String(), states[0].State)
+	// recover
+	connection.ReportSuccess(target.Indicator())
+	assert.True(t, connection.AllowRequest(target.Indicator()))
+	// remove breaker after connection closed
+	taskClientFct.EXPECT().CloseTaskClient(gomock.Any()).Return(true, nil)
+	connection.CloseConnection(target)
+	assert.Empty(t, connection.GetCircuitBreakerStates())
+}
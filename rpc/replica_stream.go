@@ -0,0 +1,390 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
+)
+
+//go:generate mockgen -source=./replica_stream.go -destination=./replica_stream_mock.go -package=rpc
+
+var (
+	// errReplicaStreamClosed represents the multiplexed replica stream is closed.
+	errReplicaStreamClosed = errors.New("replica stream is closed")
+	// errReplicaFamilyRegistered represents the family channel is registered by other replicator.
+	errReplicaFamilyRegistered = errors.New("replica family channel is already registered")
+)
+
+const (
+	// noWaitingReplicaIdx represents family channel doesn't wait any ack.
+	noWaitingReplicaIdx = int64(-1)
+	// pendingAckTTL is the max duration of keeping the ack which has no family channel waiting.
+	pendingAckTTL = time.Minute
+	// maxPendingAcks is the max number of pending acks kept by multiplexed stream.
+	maxPendingAcks = 1024
+)
+
+// ReplicaStream represents the family channel which replicates wal log to follower node,
+// all family channels of the same (target node, database) share one multiplexed grpc stream.
+type ReplicaStream interface {
+	io.Closer
+	// Replica sends replica log of family to follower node, then waits the ack of this family.
+	Replica(replicaIdx int64, record []byte) (*protoReplicaV1.ReplicaResponse, error)
+}
+
+// replicaFamilyKey represents the family channel's identifier under a multiplexed replica stream.
+type replicaFamilyKey struct {
+	shard      int32
+	leader     int32
+	familyTime int64
+}
+
+// pendingAck represents the ack which has no family channel waiting.
+type pendingAck struct {
+	resp       *protoReplicaV1.ReplicaResponse
+	receivedAt int64
+}
+
+// replicaFamilyStream implements ReplicaStream interface, it's a family channel of multiplexed stream.
+type replicaFamilyStream struct {
+	key     replicaFamilyKey
+	state   models.ReplicaState
+	mux     *replicaMuxStream
+	acks    chan *protoReplicaV1.ReplicaResponse
+	waiting atomic.Int64 // replica index which family channel waits the ack of
+	once    sync.Once
+}
+
+// Replica sends replica log of family to follower node, then waits the ack of this family.
+func (s *replicaFamilyStream) Replica(replicaIdx int64, record []byte) (*protoReplicaV1.ReplicaResponse, error) {
+	s.waiting.Store(replicaIdx)
+	defer s.waiting.Store(noWaitingReplicaIdx)
+
+	err := s.mux.send(&protoReplicaV1.ReplicaRequest{
+		Database:     s.state.Database,
+		Shard:        s.key.shard,
+		Leader:       s.key.leader,
+		Follower:     int32(s.state.Follower),
+		FamilyTime:   s.key.familyTime,
+		ReplicaIndex: replicaIdx,
+		Record:       record,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		select {
+		case resp := <-s.acks:
+			if resp.ReplicaIndex == replicaIdx {
+				return resp, nil
+			}
+			// ack of other replica index(late ack of previous request), drop it
+			s.dropAck(resp, replicaIdx)
+		case <-s.mux.done:
+			return nil, s.mux.err
+		}
+	}
+}
+
+// deliver delivers the ack to family channel if the ack matches waiting replica index,
+// replaces the stale ack if family channel has pending ack.
+func (s *replicaFamilyStream) deliver(resp *protoReplicaV1.ReplicaResponse) {
+	if waiting := s.waiting.Load(); resp.ReplicaIndex != waiting {
+		// nobody waits this ack, drop it, cannot replace the ack waited by family channel
+		s.dropAck(resp, waiting)
+		return
+	}
+	for {
+		select {
+		case s.acks <- resp:
+			return
+		default:
+		}
+		// family channel waits one ack at a time, drop stale ack, keep the newest one
+		select {
+		case stale := <-s.acks:
+			s.mux.logger.Warn("family channel has pending ack, replace stale replica ack",
+				logger.String("database", s.state.Database),
+				logger.Int32("shard", s.key.shard),
+				logger.Int64("familyTime", s.key.familyTime),
+				logger.Int64("staleReplicaIdx", stale.ReplicaIndex),
+				logger.Int64("replicaIdx", resp.ReplicaIndex))
+		default:
+		}
+	}
+}
+
+// dropAck drops the ack which doesn't match waiting replica index.
+func (s *replicaFamilyStream) dropAck(resp *protoReplicaV1.ReplicaResponse, waiting int64) {
+	s.mux.logger.Warn("replica ack not matched with waiting replica index, drop it",
+		logger.String("database", s.state.Database),
+		logger.Int32("shard", s.key.shard),
+		logger.Int64("familyTime", s.key.familyTime),
+		logger.Int64("waitingReplicaIdx", waiting),
+		logger.Int64("replicaIdx", resp.ReplicaIndex))
+}
+
+// Close removes family channel from multiplexed stream, closes the stream if no family channel.
+func (s *replicaFamilyStream) Close() error {
+	var err error
+	s.once.Do(func() {
+		err = s.mux.unregister(s)
+	})
+	return err
+}
+
+// replicaMuxStream multiplexes family channels of the same (target node, database) on one grpc stream,
+// family frames are interleaved on send side, acks are dispatched to each family channel by receive loop.
+type replicaMuxStream struct {
+	key      string
+	target   models.Node
+	database string
+	cli      protoReplicaV1.ReplicaService_ReplicaClient
+	cancel   context.CancelFunc
+	release  func(mux *replicaMuxStream)
+
+	families map[replicaFamilyKey]*replicaFamilyStream
+	// pendingAcks keeps the latest ack which has no family channel waiting,
+	// it will be delivered when the family channel registers before expired(pendingAckTTL).
+	pendingAcks map[replicaFamilyKey]pendingAck
+	closed      bool
+	err         error
+	done        chan struct{}
+
+	sendLock sync.Mutex
+	mutex    sync.Mutex
+
+	logger *logger.Logger
+}
+
+// newReplicaMuxStream creates a multiplexed replica stream, then starts receive ack task.
+func newReplicaMuxStream(
+	ctx context.Context,
+	key string,
+	target models.Node,
+	database string,
+	cli protoReplicaV1.ReplicaServiceClient,
+	logicNode models.Node,
+	release func(mux *replicaMuxStream),
+) (*replicaMuxStream, error) {
+	c, cancel := context.WithCancel(ctx)
+	// pass metadata(logic node/database) when create rpc stream, family state is carried by each frame.
+	streamCtx := CreateOutgoingContextWithPairs(c,
+		constants.RPCMetaKeyLogicNode, logicNode.Indicator(),
+		constants.RPCMetaKeyDatabase, database)
+	stream, err := cli.Replica(streamCtx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	mux := &replicaMuxStream{
+		key:         key,
+		target:      target,
+		database:    database,
+		cli:         stream,
+		cancel:      cancel,
+		release:     release,
+		families:    make(map[replicaFamilyKey]*replicaFamilyStream),
+		pendingAcks: make(map[replicaFamilyKey]pendingAck),
+		done:        make(chan struct{}),
+		logger:      logger.GetLogger("RPC", "ReplicaStream"),
+	}
+	go mux.recvLoop()
+
+	mux.logger.Info("initialize replica stream successfully",
+		logger.String("database", database),
+		logger.String("target", target.Indicator()))
+	return mux, nil
+}
+
+// register registers family channel, returns errReplicaStreamClosed if stream is closed,
+// returns errReplicaFamilyRegistered if the family channel is registered and not closed.
+func (s *replicaMuxStream) register(state *models.ReplicaState) (*replicaFamilyStream, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, errReplicaStreamClosed
+	}
+	key := replicaFamilyKey{
+		shard:      int32(state.ShardID),
+		leader:     int32(state.Leader),
+		familyTime: state.FamilyTime,
+	}
+	if _, ok := s.families[key]; ok {
+		// cannot replace the live family channel, its waiter would never get the ack
+		return nil, errReplicaFamilyRegistered
+	}
+	family := &replicaFamilyStream{
+		key:   key,
+		state: *state,
+		mux:   s,
+		acks:  make(chan *protoReplicaV1.ReplicaResponse, 1),
+	}
+	family.waiting.Store(noWaitingReplicaIdx)
+	if ack, ok := s.pendingAcks[key]; ok {
+		delete(s.pendingAcks, key)
+		if !ack.expired(timeutil.Now()) {
+			// deliver the ack received before family channel registered,
+			// family channel drops it if not matched with waiting replica index.
+			family.acks <- ack.resp
+		}
+	}
+	s.families[key] = family
+	return family, nil
+}
+
+// unregister removes family channel, closes stream if no family channel.
+func (s *replicaMuxStream) unregister(family *replicaFamilyStream) error {
+	s.mutex.Lock()
+	if s.families[family.key] == family {
+		delete(s.families, family.key)
+	}
+	if len(s.families) > 0 || s.closed {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.err = errReplicaStreamClosed
+	close(s.done)
+	s.mutex.Unlock()
+
+	s.release(s)
+	defer s.cancel()
+
+	s.logger.Info("close replica stream",
+		logger.String("database", s.database),
+		logger.String("target", s.target.Indicator()))
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	return s.cli.CloseSend()
+}
+
+// send sends family frame, grpc stream doesn't support concurrent send.
+func (s *replicaMuxStream) send(req *protoReplicaV1.ReplicaRequest) error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+	}
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	return s.cli.Send(req)
+}
+
+// fail marks stream is broken, notifies all family channels waiting ack.
+func (s *replicaMuxStream) fail(err error) {
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return
+	}
+	s.closed = true
+	s.err = err
+	close(s.done)
+	s.mutex.Unlock()
+
+	s.release(s)
+	s.cancel()
+}
+
+// recvLoop is a loop to receive ack from replica stream, then dispatches ack to family channel.
+func (s *replicaMuxStream) recvLoop() {
+	defer func() {
+		if err := recover(); err != nil {
+			s.logger.Error("panic when receive response from replica stream",
+				logger.String("target", s.target.Indicator()),
+				logger.Any("err", err),
+				logger.Stack())
+			s.fail(errReplicaStreamClosed)
+		}
+	}()
+
+	for {
+		resp, err := s.cli.Recv()
+		if err != nil {
+			if err != io.EOF {
+				s.logger.Error("receive error from replica stream",
+					logger.String("database", s.database),
+					logger.String("target", s.target.Indicator()),
+					logger.Error(err))
+				s.fail(err)
+			} else {
+				s.fail(errReplicaStreamClosed)
+			}
+			return
+		}
+		key := replicaFamilyKey{
+			shard:      resp.Shard,
+			leader:     resp.Leader,
+			familyTime: resp.FamilyTime,
+		}
+		s.mutex.Lock()
+		family, ok := s.families[key]
+		kept := false
+		if !ok {
+			// keep the latest ack, family channel maybe re-registers later
+			kept = s.addPendingAck(key, resp)
+		}
+		s.mutex.Unlock()
+		if !ok {
+			s.logger.Warn("family channel not found, keep replica ack as pending",
+				logger.String("database", s.database),
+				logger.Int32("shard", resp.Shard),
+				logger.Int64("familyTime", resp.FamilyTime),
+				logger.Int64("replicaIdx", resp.ReplicaIndex),
+				logger.Any("kept", kept))
+			continue
+		}
+		family.deliver(resp)
+	}
+}
+
+// addPendingAck keeps the ack which has no family channel waiting, removes expired pending acks,
+// returns false if too many pending acks(family channels never register again).
+// NOTE: must acquire lock
+func (s *replicaMuxStream) addPendingAck(key replicaFamilyKey, resp *protoReplicaV1.ReplicaResponse) bool {
+	now := timeutil.Now()
+	for k, ack := range s.pendingAcks {
+		if ack.expired(now) {
+			delete(s.pendingAcks, k)
+		}
+	}
+	if _, ok := s.pendingAcks[key]; !ok && len(s.pendingAcks) >= maxPendingAcks {
+		return false
+	}
+	s.pendingAcks[key] = pendingAck{resp: resp, receivedAt: now}
+	return true
+}
+
+// expired checks if pending ack is expired.
+func (ack pendingAck) expired(now int64) bool {
+	return now-ack.receivedAt > pendingAckTTL.Milliseconds()
+}
@@ -0,0 +1,294 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
+)
+
+func TestReplicaStream_Multiplexed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newReplicaServiceClientFn = protoReplicaV1.NewReplicaServiceClient
+		ctrl.Finish()
+	}()
+
+	connFct := NewMockClientConnFactory(ctrl)
+	connFct.EXPECT().GetClientConn(gomock.Any()).Return(nil, nil).AnyTimes()
+	replicaCli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
+	newReplicaServiceClientFn = func(_ *grpc.ClientConn) protoReplicaV1.ReplicaServiceClient {
+		return replicaCli
+	}
+	cli := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+	// only one stream for (target node, database)
+	replicaCli.EXPECT().Replica(gomock.Any()).Return(cli, nil)
+
+	requests := make(chan *protoReplicaV1.ReplicaRequest, 2)
+	cli.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *protoReplicaV1.ReplicaRequest) error {
+		requests <- req
+		return nil
+	}).Times(2)
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaResponse, error) {
+		req := <-requests
+		return &protoReplicaV1.ReplicaResponse{
+			Database:     req.Database,
+			Shard:        req.Shard,
+			Leader:       req.Leader,
+			FamilyTime:   req.FamilyTime,
+			ReplicaIndex: req.ReplicaIndex,
+			AckIndex:     req.ReplicaIndex,
+		}, nil
+	}).Times(2)
+	closed := make(chan struct{})
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaResponse, error) {
+		<-closed
+		return nil, io.EOF
+	})
+	cli.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(closed)
+		return nil
+	})
+
+	fct := NewClientStreamFactory(context.TODO(), &node, connFct)
+	s1, err := fct.CreateReplicaStream(&node, &models.ReplicaState{Database: "db", ShardID: 1, Leader: 1, Follower: 2, FamilyTime: 10})
+	assert.NoError(t, err)
+	s2, err := fct.CreateReplicaStream(&node, &models.ReplicaState{Database: "db", ShardID: 2, Leader: 1, Follower: 2, FamilyTime: 10})
+	assert.NoError(t, err)
+
+	var wait sync.WaitGroup
+	wait.Add(2)
+	for idx, s := range []ReplicaStream{s1, s2} {
+		go func(replicaIdx int64, s ReplicaStream) {
+			defer wait.Done()
+			resp, err := s.Replica(replicaIdx, []byte("record"))
+			assert.NoError(t, err)
+			assert.Equal(t, replicaIdx, resp.AckIndex)
+		}(int64(idx+1), s)
+	}
+	wait.Wait()
+
+	// close one family, stream still open
+	assert.NoError(t, s1.Close())
+	assert.NoError(t, s1.Close())
+	assert.Len(t, fct.(*clientStreamFactory).replicaStreams, 1)
+	// close last family, stream closed
+	assert.NoError(t, s2.Close())
+	assert.Empty(t, fct.(*clientStreamFactory).replicaStreams)
+	_, err = s2.Replica(3, nil)
+	assert.Equal(t, errReplicaStreamClosed, err)
+}
+
+func TestReplicaStream_Broken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newReplicaServiceClientFn = protoReplicaV1.NewReplicaServiceClient
+		ctrl.Finish()
+	}()
+
+	connFct := NewMockClientConnFactory(ctrl)
+	replicaCli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
+	newReplicaServiceClientFn = func(_ *grpc.ClientConn) protoReplicaV1.ReplicaServiceClient {
+		return replicaCli
+	}
+	fct := NewClientStreamFactory(context.TODO(), &node, connFct)
+	state := &models.ReplicaState{Database: "db", ShardID: 1, Leader: 1, Follower: 2, FamilyTime: 10}
+
+	// case 1: get connection failure
+	connFct.EXPECT().GetClientConn(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	s, err := fct.CreateReplicaStream(&node, state)
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// case 2: create stream failure
+	connFct.EXPECT().GetClientConn(gomock.Any()).Return(nil, nil).AnyTimes()
+	replicaCli.EXPECT().Replica(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	s, err = fct.CreateReplicaStream(&node, state)
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// case 3: stream broken, waiting family gets err, then creates new stream
+	cli := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+	replicaCli.EXPECT().Replica(gomock.Any()).Return(cli, nil).Times(2)
+	sent := make(chan struct{})
+	cli.EXPECT().Send(gomock.Any()).DoAndReturn(func(_ *protoReplicaV1.ReplicaRequest) error {
+		close(sent)
+		return nil
+	})
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaResponse, error) {
+		<-sent
+		return nil, fmt.Errorf("err")
+	})
+	s, err = fct.CreateReplicaStream(&node, state)
+	assert.NoError(t, err)
+	resp, err := s.Replica(1, nil)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	// send after stream broken
+	_, err = s.Replica(2, nil)
+	assert.Error(t, err)
+	assert.NoError(t, s.Close())
+
+	receiving := make(chan struct{})
+	blocked := make(chan struct{})
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaResponse, error) {
+		close(receiving)
+		<-blocked
+		return nil, io.EOF
+	})
+	cli.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(blocked)
+		return nil
+	})
+	s, err = fct.CreateReplicaStream(&node, state)
+	assert.NoError(t, err)
+	<-receiving
+	assert.NoError(t, s.Close())
+}
+
+func TestReplicaStream_PendingAck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	replicaCli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
+	cli := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+	replicaCli.EXPECT().Replica(gomock.Any()).Return(cli, nil)
+	received := make(chan struct{})
+	blocked := make(chan struct{})
+	// ack received before family channel registered
+	cli.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{Shard: 1, Leader: 1, FamilyTime: 10, ReplicaIndex: 1, AckIndex: 1}, nil)
+	cli.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{Shard: 1, Leader: 1, FamilyTime: 10, ReplicaIndex: 2, AckIndex: 2}, nil)
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaResponse, error) {
+		close(received)
+		<-blocked
+		return nil, io.EOF
+	})
+	cli.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(blocked)
+		return nil
+	})
+	mux, err := newReplicaMuxStream(context.TODO(), "key", &node, "db", replicaCli, &node, func(_ *replicaMuxStream) {})
+	assert.NoError(t, err)
+	<-received
+
+	state := &models.ReplicaState{Database: "db", ShardID: 1, Leader: 1, Follower: 2, FamilyTime: 10}
+	family, err := mux.register(state)
+	assert.NoError(t, err)
+	// latest pending ack delivered after registered
+	resp := <-family.acks
+	assert.Equal(t, int64(2), resp.AckIndex)
+	// cannot replace live family channel
+	_, err = mux.register(state)
+	assert.Equal(t, errReplicaFamilyRegistered, err)
+
+	// drop ack if no replica index waiting
+	family.deliver(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 3, AckIndex: 3})
+	assert.Empty(t, family.acks)
+	// drop ack not matched with waiting replica index, keep matched one
+	family.waiting.Store(4)
+	family.deliver(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 4, AckIndex: 4})
+	family.deliver(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 3, AckIndex: 3})
+	resp = <-family.acks
+	assert.Equal(t, int64(4), resp.AckIndex)
+	// replace stale ack with the newest one
+	family.deliver(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 4, AckIndex: 4})
+	family.deliver(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 4, AckIndex: 5})
+	resp = <-family.acks
+	assert.Equal(t, int64(5), resp.AckIndex)
+
+	assert.NoError(t, family.Close())
+	_, err = mux.register(state)
+	assert.Equal(t, errReplicaStreamClosed, err)
+}
+
+func TestReplicaStream_Replica_AckNotMatched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	replicaCli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
+	cli := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+	replicaCli.EXPECT().Replica(gomock.Any()).Return(cli, nil)
+	receiving := make(chan struct{})
+	blocked := make(chan struct{})
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoReplicaV1.ReplicaResponse, error) {
+		close(receiving)
+		<-blocked
+		return nil, io.EOF
+	})
+	cli.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(blocked)
+		return nil
+	})
+	mux, err := newReplicaMuxStream(context.TODO(), "key", &node, "db", replicaCli, &node, func(_ *replicaMuxStream) {})
+	assert.NoError(t, err)
+	<-receiving
+	family, err := mux.register(&models.ReplicaState{Database: "db", ShardID: 1, Leader: 1, Follower: 2, FamilyTime: 10})
+	assert.NoError(t, err)
+	// stale ack buffered before sending
+	family.acks <- &protoReplicaV1.ReplicaResponse{ReplicaIndex: 1, AckIndex: 1}
+	cli.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *protoReplicaV1.ReplicaRequest) error {
+		go family.deliver(&protoReplicaV1.ReplicaResponse{ReplicaIndex: req.ReplicaIndex, AckIndex: req.ReplicaIndex})
+		return nil
+	})
+	resp, err := family.Replica(2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), resp.AckIndex)
+	assert.Equal(t, noWaitingReplicaIdx, family.waiting.Load())
+	assert.NoError(t, family.Close())
+}
+
+func TestReplicaStream_PendingAck_Expire(t *testing.T) {
+	mux := &replicaMuxStream{
+		families:    make(map[replicaFamilyKey]*replicaFamilyStream),
+		pendingAcks: make(map[replicaFamilyKey]pendingAck),
+	}
+	now := timeutil.Now()
+	// expired pending acks removed
+	for i := 0; i < maxPendingAcks; i++ {
+		mux.pendingAcks[replicaFamilyKey{shard: int32(i)}] = pendingAck{receivedAt: now - 2*pendingAckTTL.Milliseconds()}
+	}
+	assert.True(t, mux.addPendingAck(replicaFamilyKey{shard: 1}, &protoReplicaV1.ReplicaResponse{}))
+	assert.Len(t, mux.pendingAcks, 1)
+	// too many pending acks
+	for i := 0; i < maxPendingAcks; i++ {
+		assert.True(t, mux.addPendingAck(replicaFamilyKey{shard: int32(i)}, &protoReplicaV1.ReplicaResponse{}))
+	}
+	assert.False(t, mux.addPendingAck(replicaFamilyKey{shard: maxPendingAcks}, &protoReplicaV1.ReplicaResponse{}))
+	// replace pending ack of the same family
+	assert.True(t, mux.addPendingAck(replicaFamilyKey{shard: 1}, &protoReplicaV1.ReplicaResponse{AckIndex: 1}))
+	assert.Len(t, mux.pendingAcks, maxPendingAcks)
+	// expired pending ack not delivered when registering
+	mux.pendingAcks[replicaFamilyKey{shard: 1}] = pendingAck{
+		resp:       &protoReplicaV1.ReplicaResponse{},
+		receivedAt: now - 2*pendingAckTTL.Milliseconds(),
+	}
+	family, err := mux.register(&models.ReplicaState{ShardID: 1})
+	assert.NoError(t, err)
+	assert.Empty(t, family.acks)
+	_, ok := mux.pendingAcks[replicaFamilyKey{shard: 1}]
+	assert.False(t, ok)
+}
@@ -39,8 +39,9 @@ import (
 
 // just for testing
 var (
-	grpcDialFn             = grpc.Dial
-	newTaskServiceClientFn = protoCommonV1.NewTaskServiceClient
+	grpcDialFn                = grpc.Dial
+	newTaskServiceClientFn    = protoCommonV1.NewTaskServiceClient
+	newReplicaServiceClientFn = protoReplicaV1.NewReplicaServiceClient
 )
 
 var (
@@ -161,6 +162,9 @@ type ClientStreamFactory interface {
 	CreateTaskClient(target models.Node) (protoCommonV1.TaskService_HandleClient, error)
 	// CreateReplicaServiceClient creates a protoReplicaV1.ReplicaServiceClient.
 	CreateReplicaServiceClient(target models.Node) (protoReplicaV1.ReplicaServiceClient, error)
+	// CreateReplicaStream creates a ReplicaStream for the family channel,
+	// family channels of the same (target node, database) share one multiplexed stream.
+	CreateReplicaStream(target models.Node, state *models.ReplicaState) (ReplicaStream, error)
 	// CreateWriteServiceClient creates a protoWriteV1.WriteServiceClient.
 	CreateWriteServiceClient(target models.Node) (protoWriteV1.WriteServiceClient, error)
 }
@@ -170,6 +174,11 @@ type clientStreamFactory struct {
 	ctx       context.Context
 	logicNode models.Node
 	connFct   ClientConnFactory
+
+	// target's indicator/database -> multiplexed replica stream
+	replicaStreams map[string]*replicaMuxStream
+	// lock to protect replicaStreams
+	mutex sync.Mutex
 }
 
 // NewClientStreamFactory returns a factory to get clientStream.
@@ -178,6 +187,8 @@ func NewClientStreamFactory(ctx context.Context, logicNode models.Node, connFct
 		ctx:       ctx,
 		logicNode: logicNode,
 		connFct:   connFct,
+
+		replicaStreams: make(map[string]*replicaMuxStream),
 	}
 }
 
@@ -207,7 +218,51 @@ func (w *clientStreamFactory) CreateReplicaServiceClient(target models.Node) (pr
 	if err != nil {
 		return nil, err
 	}
-	return protoReplicaV1.NewReplicaServiceClient(conn), nil
+	return newReplicaServiceClientFn(conn), nil
+}
+
+// CreateReplicaStream creates a ReplicaStream for the family channel,
+// family channels of the same (target node, database) share one multiplexed stream.
+func (w *clientStreamFactory) CreateReplicaStream(target models.Node, state *models.ReplicaState) (ReplicaStream, error) {
+	key := target.Indicator() + "/" + state.Database
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if mux, ok := w.replicaStreams[key]; ok {
+		family, err := mux.register(state)
+		if err == nil {
+			return family, nil
+		}
+		if !errors.Is(err, errReplicaStreamClosed) {
+			return nil, err
+		}
+		// stream is closed, need create new one
+	}
+	replicaCli, err := w.CreateReplicaServiceClient(target)
+	if err != nil {
+		return nil, err
+	}
+	mux, err := newReplicaMuxStream(w.ctx, key, target, state.Database, replicaCli, w.logicNode, w.releaseReplicaStream)
+	if err != nil {
+		return nil, err
+	}
+	w.replicaStreams[key] = mux
+	family, err := mux.register(state)
+	if err != nil {
+		return nil, err
+	}
+	return family, nil
+}
+
+// releaseReplicaStream removes the closed multiplexed replica stream from pool.
+func (w *clientStreamFactory) releaseReplicaStream(mux *replicaMuxStream) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.replicaStreams[mux.key] == mux {
+		delete(w.replicaStreams, mux.key)
+	}
 }
 
 // CreateWriteServiceClient creates a protoWriteV1.WriteServiceClient.
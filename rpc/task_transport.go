@@ -39,6 +39,9 @@ type TransportManager interface {
 	SendRequest(targetNodeID string, req *protoCommonV1.TaskRequest) error
 	// SendResponse sends the task response to target node.
 	SendResponse(targetNodeID string, resp *protoCommonV1.TaskResponse) error
+	// ReportResponse reports the response result of target node which request sent to,
+	// err is nil if target node responded, else send failure or timeout, used by circuit breaker.
+	ReportResponse(targetNodeID string, err error)
 }
 
 // TaskClientFactory represents the task stream manage
@@ -36,8 +36,10 @@ import (
 // and receives write response in background.
 type WriteStream interface {
 	io.Closer
-	// Send sends metric data to storage.
-	Send(data []byte) error
+	// Send sends metric data to storage, batch time is the create time of data batch(0 if unknown).
+	Send(data []byte, batchTime int64) error
+	// SendAudit sends metric data tagged with audit id to storage, storage verifies if it is applied.
+	SendAudit(auditID int64, data []byte, batchTime int64) error
 }
 
 // writeStream implements WriteStream interface.
@@ -103,6 +105,10 @@ func (s *writeStream) initialize() error {
 	if err != nil {
 		return err
 	}
+	// wait storage accepts the stream(maybe rejected if storage is read-only), so that no data is sent to rejected stream.
+	if _, err := writeCli.Header(); err != nil {
+		return err
+	}
 
 	// set write client
 	s.cli = writeCli
@@ -117,13 +123,22 @@ func (s *writeStream) initialize() error {
 	return nil
 }
 
-// Send sends metric data to storage.
-func (s *writeStream) Send(data []byte) error {
+// Send sends metric data to storage, batch time is the create time of data batch(0 if unknown).
+func (s *writeStream) Send(data []byte, batchTime int64) error {
+	if s.closed.Load() {
+		// if write stream is closed, return EOF err
+		return io.EOF
+	}
+	return s.cli.Send(&protoWriteV1.WriteRequest{Record: data, Timestamp: batchTime})
+}
+
+// SendAudit sends metric data tagged with audit id to storage, storage verifies if it is applied.
+func (s *writeStream) SendAudit(auditID int64, data []byte, batchTime int64) error {
 	if s.closed.Load() {
 		// if write stream is closed, return EOF err
 		return io.EOF
 	}
-	return s.cli.Send(&protoWriteV1.WriteRequest{Record: data})
+	return s.cli.Send(&protoWriteV1.WriteRequest{Record: data, AuditID: auditID, Timestamp: batchTime})
 }
 
 // Close closes send stream, and cancel stream context, server will stop receive write request under this stream.
@@ -176,6 +191,10 @@ func (s *writeStream) recvLoop() {
 				s.logger.Error("get err write response",
 					logger.String("target", s.target.Indicator()),
 					logger.String("err", resp.Err))
+				if resp.Err == constants.ErrStorageReadOnly.Error() {
+					// storage switched to read-only, stop sending, so that writes are retried/spooled by family channel
+					s.closed.Store(true)
+				}
 			}
 		}
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
@@ -52,9 +53,16 @@ func TestNewWriteStream(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, stream)
 
-	// case 3: create instance success
+	// case 3: stream rejected by storage
 	cli := protoWriteV1.NewMockWriteService_WriteClient(ctrl)
-	writeSrv.EXPECT().Write(gomock.Any()).Return(cli, nil)
+	writeSrv.EXPECT().Write(gomock.Any()).Return(cli, nil).Times(2)
+	cli.EXPECT().Header().Return(nil, fmt.Errorf("err"))
+	stream, err = NewWriteStream(context.TODO(), &models.StatefulNode{}, "test", &models.ShardState{}, 1, fct)
+	assert.Error(t, err)
+	assert.Nil(t, stream)
+
+	// case 4: create instance success
+	cli.EXPECT().Header().Return(nil, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
 	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
 	stream, err = NewWriteStream(context.TODO(), &models.StatefulNode{}, "test", &models.ShardState{}, 1, fct)
@@ -75,10 +83,25 @@ func TestWriteStream_Send(t *testing.T) {
 		cli:    cli,
 		closed: atomic.NewBool(true),
 	}
-	assert.Equal(t, io.EOF, stream.Send(nil))
+	assert.Equal(t, io.EOF, stream.Send(nil, 0))
+	stream.closed.Store(false)
+	cli.EXPECT().Send(&protoWriteV1.WriteRequest{Record: []byte{1}, Timestamp: 100}).Return(nil)
+	assert.NoError(t, stream.Send([]byte{1}, 100))
+}
+
+func TestWriteStream_SendAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cli := protoWriteV1.NewMockWriteService_WriteClient(ctrl)
+	stream := &writeStream{
+		cli:    cli,
+		closed: atomic.NewBool(true),
+	}
+	assert.Equal(t, io.EOF, stream.SendAudit(1, nil, 0))
 	stream.closed.Store(false)
-	cli.EXPECT().Send(gomock.Any()).Return(nil)
-	assert.NoError(t, stream.Send(nil))
+	cli.EXPECT().Send(&protoWriteV1.WriteRequest{Record: []byte{1}, AuditID: 10, Timestamp: 100}).Return(nil)
+	assert.NoError(t, stream.SendAudit(10, []byte{1}, 100))
 }
 
 func TestWriteStream_Recv(t *testing.T) {
@@ -118,4 +141,20 @@ func TestWriteStream_Recv(t *testing.T) {
 	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{Err: "err"}, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF)
 	stream.recvLoop()
+	// case 4: storage switched to read-only, stop sending
+	stream = &writeStream{
+		cli:    cli,
+		closed: atomic.NewBool(false),
+		target: &models.StatefulNode{},
+		logger: logger.GetLogger("RPC", "WriteStream"),
+	}
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoWriteV1.WriteResponse, error) {
+		assert.False(t, stream.closed.Load())
+		return &protoWriteV1.WriteResponse{Err: constants.ErrStorageReadOnly.Error()}, nil
+	})
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoWriteV1.WriteResponse, error) {
+		assert.True(t, stream.closed.Load())
+		return nil, io.EOF
+	})
+	stream.recvLoop()
 }
@@ -79,6 +79,7 @@ const (
 	LastField
 	HistogramField // alias for sumField, only visible for tsdb
 	FirstField
+	SketchField // histogram sketch of compound field, bins are merged by sum, only visible for tsdb
 )
 
 // String returns the field type's string value
@@ -96,6 +97,8 @@ func (t Type) String() string {
 		return "histogram"
 	case FirstField:
 		return "first"
+	case SketchField:
+		return "sketch"
 	default:
 		return "unknown"
 	}
@@ -104,7 +107,7 @@ func (t Type) String() string {
 // AggType returns the aggregate function
 func (t Type) AggType() AggType {
 	switch t {
-	case SumField, HistogramField:
+	case SumField, HistogramField, SketchField:
 		return Sum
 	case MinField:
 		return Min
@@ -131,7 +134,7 @@ func (t Type) DownSamplingFunc() function.FuncType {
 		return function.Last
 	case FirstField:
 		return function.First
-	case HistogramField:
+	case HistogramField, SketchField:
 		return function.Sum
 	default:
 		return function.Unknown
@@ -175,7 +178,7 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 		default:
 			return false
 		}
-	case HistogramField:
+	case HistogramField, SketchField:
 		switch funcType {
 		case function.Sum:
 			return true
@@ -200,7 +203,7 @@ func (t Type) GetFuncFieldParams(funcType function.FuncType) []AggType {
 		return getFieldParamsForMinField(funcType)
 	case MaxField:
 		return getFieldParamsForMaxField(funcType)
-	case HistogramField:
+	case HistogramField, SketchField:
 		// Histogram field only supports sum
 		return []AggType{Sum}
 	}
@@ -220,7 +223,7 @@ func (t Type) GetDefaultFuncFieldParams() []AggType {
 		return []AggType{First}
 	case MaxField:
 		return []AggType{Max}
-	case HistogramField:
+	case HistogramField, SketchField:
 		return []AggType{Sum}
 	}
 	return nil
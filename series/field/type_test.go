@@ -28,6 +28,7 @@ import (
 func TestDownSamplingFunc(t *testing.T) {
 	assert.Equal(t, function.Sum, SumField.DownSamplingFunc())
 	assert.Equal(t, function.Sum, HistogramField.DownSamplingFunc())
+	assert.Equal(t, function.Sum, SketchField.DownSamplingFunc())
 	assert.Equal(t, function.Min, MinField.DownSamplingFunc())
 	assert.Equal(t, function.Max, MaxField.DownSamplingFunc())
 	assert.Equal(t, function.Last, LastField.DownSamplingFunc())
@@ -42,6 +43,7 @@ func TestType_String(t *testing.T) {
 	assert.Equal(t, "last", LastField.String())
 	assert.Equal(t, "first", FirstField.String())
 	assert.Equal(t, "histogram", HistogramField.String())
+	assert.Equal(t, "sketch", SketchField.String())
 	assert.Equal(t, "unknown", Unknown.String())
 	assert.Equal(t, "name", Name("name").String())
 }
@@ -49,6 +51,8 @@ func TestType_String(t *testing.T) {
 func TestIsSupportFunc(t *testing.T) {
 	assert.True(t, HistogramField.IsFuncSupported(function.Sum))
 	assert.False(t, HistogramField.IsFuncSupported(function.Last))
+	assert.True(t, SketchField.IsFuncSupported(function.Sum))
+	assert.False(t, SketchField.IsFuncSupported(function.Quantile))
 
 	assert.True(t, SumField.IsFuncSupported(function.Sum))
 	assert.True(t, SumField.IsFuncSupported(function.Min))
@@ -122,6 +126,7 @@ func TestType_GetFuncFieldParams(t *testing.T) {
 func TestType_GetDefaultFuncFieldParams(t *testing.T) {
 	assert.Empty(t, Type(99).GetDefaultFuncFieldParams())
 	assert.Equal(t, []AggType{Sum}, HistogramField.GetDefaultFuncFieldParams())
+	assert.Equal(t, []AggType{Sum}, SketchField.GetDefaultFuncFieldParams())
 	assert.Equal(t, []AggType{Sum}, SumField.GetDefaultFuncFieldParams())
 	assert.Equal(t, []AggType{Max}, MaxField.GetDefaultFuncFieldParams())
 	assert.Equal(t, []AggType{Min}, MinField.GetDefaultFuncFieldParams())
@@ -37,4 +37,6 @@ var (
 	ErrMetricNanField = fmt.Errorf("%w, field is not a number", ErrBadMetricPBFormat)
 	// ErrMetricInfField represents field value is infinity, positive or negative
 	ErrMetricInfField = fmt.Errorf("%w, field is infinity", ErrBadMetricPBFormat)
+	// ErrSanitizedNameEmpty represents namespace/metric/field name is empty after sanitizing
+	ErrSanitizedNameEmpty = errors.New("name is empty after sanitizing")
 )
@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/common/pkg/encoding"
 	"github.com/lindb/common/pkg/fasttime"
 	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
 
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -53,6 +54,57 @@ func (row *BrokerRow) FromBlock(block []byte) {
 
 func (row *BrokerRow) Metric() flatMetricsV1.Metric { return row.m }
 
+// RewriteNamespace rebuilds the row with the new namespace by row builder,
+// metric name, timestamp, tags and fields are kept as is.
+func (row *BrokerRow) RewriteNamespace(builder *commonseries.RowBuilder, namespace []byte) error {
+	builder.Reset()
+	originRow := readOnlyRow{m: row.m}
+	kvItr := originRow.NewKeyValueIterator()
+	for kvItr.HasNext() {
+		if err := builder.AddTag(kvItr.NextKey(), kvItr.NextValue()); err != nil {
+			return err
+		}
+	}
+	simpleFieldItr := originRow.NewSimpleFieldIterator()
+	for simpleFieldItr.HasNext() {
+		if err := builder.AddSimpleField(
+			simpleFieldItr.NextRawName(),
+			simpleFieldItr.NextRawType(),
+			simpleFieldItr.NextValue(),
+		); err != nil {
+			return err
+		}
+	}
+	if compoundFieldItr, ok := originRow.NewCompoundFieldIterator(); ok {
+		var values, bounds []float64
+		for compoundFieldItr.HasNextBucket() {
+			bounds = append(bounds, compoundFieldItr.NextExplicitBound())
+			values = append(values, compoundFieldItr.NextValue())
+		}
+		if err := builder.AddCompoundFieldData(values, bounds); err != nil {
+			return err
+		}
+		if err := builder.AddCompoundFieldMMSC(
+			compoundFieldItr.Min(),
+			compoundFieldItr.Max(),
+			compoundFieldItr.Sum(),
+			compoundFieldItr.Count(),
+		); err != nil {
+			return err
+		}
+	}
+	builder.AddMetricName(row.m.Name())
+	builder.AddTimestamp(row.m.Timestamp())
+	builder.AddNameSpace(namespace)
+	// NOTE: row builder copies all data of origin row, so row's buffer can be overwritten after building
+	data, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	row.FromBlock(data)
+	return nil
+}
+
 func (row *BrokerRow) Size() int {
 	if row.IsOutOfTimeRange {
 		return 0
@@ -134,6 +186,15 @@ func (br *BrokerBatchRows) TryAppend(appendFunc func(row *BrokerRow) error) erro
 	return nil
 }
 
+// AppendRow appends a copy of the given row, used for splitting rows into different batches.
+func (br *BrokerBatchRows) AppendRow(row *BrokerRow) {
+	_ = br.TryAppend(func(dst *BrokerRow) error {
+		dst.FromBlock(row.buffer)
+		dst.IsOutOfTimeRange = row.IsOutOfTimeRange
+		return nil
+	})
+}
+
 func (br *BrokerBatchRows) NewShardGroupIterator(numOfShards int32) *BrokerBatchShardIterator {
 	for i := 0; i < br.Len(); i++ {
 		br.rows[i].shardIdx = int(jump.Hash(br.rows[i].m.Hash(), numOfShards))
@@ -20,6 +20,7 @@ package metric
 import (
 	"bytes"
 	"io"
+	"math"
 	"strconv"
 	"testing"
 
@@ -131,6 +132,50 @@ func Test_BrokerRow_Writer(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_BrokerRow_RewriteNamespace(t *testing.T) {
+	var row BrokerRow
+	buildRow(&row, 1000)
+	builder := commonseries.CreateRowBuilder()
+	assert.NoError(t, row.RewriteNamespace(builder, []byte("ns")))
+	m := row.Metric()
+	assert.Equal(t, "ns", string(m.Namespace()))
+	assert.Equal(t, "test", string(m.Name()))
+	assert.Equal(t, int64(1000), m.Timestamp())
+	assert.Equal(t, 1, m.KeyValuesLength())
+	assert.Equal(t, 1, m.SimpleFieldsLength())
+
+	// compound field
+	builder.Reset()
+	builder.AddMetricName([]byte("histogram"))
+	assert.NoError(t, builder.AddCompoundFieldData([]float64{1, 2}, []float64{1, math.Inf(1)}))
+	assert.NoError(t, builder.AddCompoundFieldMMSC(1, 2, 3, 3))
+	data, err := builder.Build()
+	assert.NoError(t, err)
+	row.FromBlock(data)
+	assert.NoError(t, row.RewriteNamespace(builder, []byte("ns2")))
+	m = row.Metric()
+	assert.Equal(t, "ns2", string(m.Namespace()))
+	var compoundField flatMetricsV1.CompoundField
+	assert.NotNil(t, m.CompoundField(&compoundField))
+	assert.Equal(t, 2, compoundField.ValuesLength())
+	assert.Equal(t, float64(3), compoundField.Count())
+}
+
+func Test_BrokerBatchRows_AppendRow(t *testing.T) {
+	var row BrokerRow
+	buildRow(&row, 1000)
+	row.IsOutOfTimeRange = true
+
+	batch := NewBrokerBatchRows()
+	defer batch.Release()
+	batch.AppendRow(&row)
+	assert.Equal(t, 1, batch.Len())
+	assert.True(t, batch.Rows()[0].IsOutOfTimeRange)
+	assert.Equal(t, row.buffer, batch.Rows()[0].buffer)
+	m := batch.Rows()[0].Metric()
+	assert.Equal(t, "test", string(m.Name()))
+}
+
 func Test_BrokerBatchRows_FamilyRowsForNextShard_SingleShard(t *testing.T) {
 	now := fasttime.UnixMilliseconds()
 
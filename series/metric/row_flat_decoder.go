@@ -18,12 +18,16 @@
 package metric
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"sync"
 
+	"github.com/cespare/xxhash/v2"
 	flatbuffers "github.com/google/flatbuffers/go"
 
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
 	commonseries "github.com/lindb/common/series"
 
 	"github.com/lindb/lindb/constants"
@@ -36,16 +40,19 @@ var (
 )
 
 type BrokerRowFlatDecoder struct {
-	reader  io.Reader
-	size    int // head length
-	buf     []byte
-	readLen int
+	reader     io.Reader
+	size       int // head length
+	sizePrefix [flatbuffers.SizeUOffsetT]byte
+	readLen    int
+	zeroCopied int // number of rows appended without re-marshalling
+	rowRead    bool
 
 	rowBuilder commonseries.RowBuilder
 	originRow  readOnlyRow // used for unmarshal
 
 	compoundValues []float64
 	compoundBounds []float64
+	hashBuf        bytes.Buffer
 
 	namespace    []byte
 	enrichedTags tag.Tags
@@ -67,6 +74,7 @@ func NewBrokerRowFlatDecoder(
 	releaseFunc = func(decoder *BrokerRowFlatDecoder) {
 		decoder.reader = nil
 		decoder.readLen = 0
+		decoder.zeroCopied = 0
 		brokerRowFlatDecoderPool.Put(decoder)
 	}
 	item := brokerRowFlatDecoderPool.Get()
@@ -85,6 +93,7 @@ func NewBrokerRowFlatDecoder(
 // resetForNextDecode resets context for decoding next row
 func (itr *BrokerRowFlatDecoder) resetForNextDecode() {
 	itr.rowBuilder.Reset()
+	itr.rowRead = false
 
 	itr.compoundValues = itr.compoundValues[:0]
 	itr.compoundBounds = itr.compoundBounds[:0]
@@ -95,18 +104,28 @@ func (itr *BrokerRowFlatDecoder) HasNext() bool {
 	if itr.reader == nil {
 		return false
 	}
-	var scratch [flatbuffers.SizeUOffsetT]byte
-	n, err := io.ReadFull(itr.reader, scratch[:])
+	n, err := io.ReadFull(itr.reader, itr.sizePrefix[:])
 	if err == io.EOF {
 		return false
 	}
 	itr.readLen += n
-	itr.size = int(flatbuffers.GetSizePrefix(scratch[:], 0))
+	itr.size = int(flatbuffers.GetSizePrefix(itr.sizePrefix[:], 0))
 	return n == flatbuffers.SizeUOffsetT
 }
 
 func (itr *BrokerRowFlatDecoder) ReadLen() int { return itr.readLen }
 
+// ZeroCopied returns the number of rows appended without re-marshalling.
+func (itr *BrokerRowFlatDecoder) ZeroCopied() int { return itr.zeroCopied }
+
+// MetricName returns the metric name of the row being decoded, returns nil if row isn't read.
+func (itr *BrokerRowFlatDecoder) MetricName() []byte {
+	if !itr.rowRead {
+		return nil
+	}
+	return itr.originRow.Name()
+}
+
 // DecodeTo decodes next flat block into BrokerRow
 func (itr *BrokerRowFlatDecoder) DecodeTo(row *BrokerRow) error {
 	itr.resetForNextDecode()
@@ -114,18 +133,30 @@ func (itr *BrokerRowFlatDecoder) DecodeTo(row *BrokerRow) error {
 	if itr.size <= 0 || itr.size > maxRowLength {
 		return fmt.Errorf("invalid flat row length: %d", itr.size)
 	}
-	if itr.size > cap(itr.buf) {
-		itr.buf = make([]byte, itr.size)
+	// read size prefixed row into row's buffer directly, avoid copying payload again if row is canonical
+	blockLen := flatbuffers.SizeUOffsetT + itr.size
+	if blockLen > cap(row.buffer) {
+		row.buffer = make([]byte, blockLen)
 	}
-	itr.buf = itr.buf[0:itr.size]
-	n, err := io.ReadFull(itr.reader, itr.buf)
+	row.buffer = row.buffer[:blockLen]
+	copy(row.buffer, itr.sizePrefix[:])
+	buf := row.buffer[flatbuffers.SizeUOffsetT:]
+	n, err := io.ReadFull(itr.reader, buf)
 	if n != itr.size || err != nil {
 		return fmt.Errorf("expect length: %d, read length: %d", itr.size, n)
 	}
 	itr.readLen += n
 
-	itr.originRow.m.Init(itr.buf, flatbuffers.GetUOffsetT(itr.buf))
+	itr.originRow.m.Init(buf, flatbuffers.GetUOffsetT(buf))
+	itr.rowRead = true
 
+	if itr.isCanonical() {
+		// row is same as rebuilt by row builder, use it directly
+		row.m.Init(buf, flatbuffers.GetUOffsetT(buf))
+		itr.zeroCopied++
+		return nil
+	}
+	// NOTE: row builder copies all data of origin row, so row's buffer can be overwritten after building
 	if err0 := itr.rebuild(); err0 != nil {
 		return err0
 	}
@@ -137,6 +168,97 @@ func (itr *BrokerRowFlatDecoder) DecodeTo(row *BrokerRow) error {
 	return nil
 }
 
+// isCanonical checks if the origin row is canonical(valid, within limits and same as rebuilt by row builder),
+// returns false if row need to be rebuilt, rebuild will report the error if row is invalid.
+func (itr *BrokerRowFlatDecoder) isCanonical() bool {
+	if len(itr.enrichedTags) > 0 {
+		return false
+	}
+	row := &itr.originRow
+	// metric name/namespace/timestamp
+	metricName := row.Name()
+	if len(metricName) == 0 || commonseries.ShouldSanitizeNamespaceOrMetricName(metricName) ||
+		shouldSanitizeRawName(itr.limits, metricName) ||
+		(itr.limits.EnableMetricNameLengthCheck() && len(metricName) > itr.limits.MaxMetricNameLength) {
+		return false
+	}
+	ns := row.NameSpace()
+	if len(ns) == 0 || commonseries.ShouldSanitizeNamespaceOrMetricName(ns) ||
+		shouldSanitizeRawName(itr.limits, ns) ||
+		(itr.limits.EnableNamespaceLengthCheck() && len(ns) > itr.limits.MaxNamespaceLength) {
+		return false
+	}
+	if row.Timestamp() == 0 {
+		return false
+	}
+	// tags must be sorted without duplicated keys, and hash must be matched
+	if itr.limits.EnableTagsCheck() && row.TagsLen() > itr.limits.MaxTagsPerMetric {
+		return false
+	}
+	itr.hashBuf.Reset()
+	var prevKey []byte
+	kvItr := row.NewKeyValueIterator()
+	for kvItr.HasNext() {
+		tagKey := kvItr.NextKey()
+		tagValue := kvItr.NextValue()
+		if len(tagKey) == 0 || len(tagValue) == 0 ||
+			(prevKey != nil && bytes.Compare(prevKey, tagKey) >= 0) ||
+			(itr.limits.EnableTagNameLengthCheck() && len(tagKey) > itr.limits.MaxTagNameLength) ||
+			(itr.limits.EnableTagValueLengthCheck() && len(tagValue) > itr.limits.MaxTagValueLength) {
+			return false
+		}
+		if prevKey != nil {
+			_ = itr.hashBuf.WriteByte(',')
+		}
+		_, _ = itr.hashBuf.Write(tagKey)
+		_ = itr.hashBuf.WriteByte('=')
+		_, _ = itr.hashBuf.Write(tagValue)
+		prevKey = tagKey
+	}
+	if xxhash.Sum64(itr.hashBuf.Bytes()) != row.TagsHash() {
+		return false
+	}
+	// simple fields
+	if itr.limits.EnableFieldsCheck() && row.SimpleFieldsLen() > int(itr.limits.MaxFieldsPerMetric) {
+		return false
+	}
+	simpleFieldItr := row.NewSimpleFieldIterator()
+	for simpleFieldItr.HasNext() {
+		fieldName := simpleFieldItr.NextRawName()
+		value := simpleFieldItr.NextValue()
+		if len(fieldName) == 0 || commonseries.ShouldSanitizeFieldName(fieldName) ||
+			shouldSanitizeRawName(itr.limits, fieldName) ||
+			(itr.limits.EnableFieldNameLengthCheck() && len(fieldName) > itr.limits.MaxFieldNameLength) ||
+			simpleFieldItr.NextRawType() == flatMetricsV1.SimpleFieldTypeUnSpecified ||
+			math.IsInf(value, 0) || math.IsNaN(value) {
+			return false
+		}
+	}
+	// compound field
+	compoundFieldItr, ok := row.NewCompoundFieldIterator()
+	if !ok {
+		return row.SimpleFieldsLen() > 0
+	}
+	if compoundFieldItr.f.ValuesLength() != compoundFieldItr.f.ExplicitBoundsLength() || compoundFieldItr.BucketLen() < 2 {
+		return false
+	}
+	prevBound := 0.0
+	for compoundFieldItr.HasNextBucket() {
+		bound := compoundFieldItr.NextExplicitBound()
+		value := compoundFieldItr.NextValue()
+		if bound < prevBound || math.IsInf(value, 0) || math.IsNaN(value) || value < 0 {
+			return false
+		}
+		prevBound = bound
+	}
+	if !math.IsInf(prevBound, 1) {
+		return false
+	}
+	minValue, maxValue := compoundFieldItr.Min(), compoundFieldItr.Max()
+	sum, count := compoundFieldItr.Sum(), compoundFieldItr.Count()
+	return minValue >= 0 && maxValue >= 0 && sum >= 0 && count >= 0
+}
+
 func (itr *BrokerRowFlatDecoder) rebuild() error {
 	if itr.limits.EnableTagsCheck() && itr.originRow.TagsLen()+len(itr.enrichedTags) > itr.limits.MaxTagsPerMetric {
 		return constants.ErrTooManyTagKeys
@@ -168,12 +290,13 @@ func (itr *BrokerRowFlatDecoder) rebuild() error {
 	}
 	simpleFieldItr := itr.originRow.NewSimpleFieldIterator()
 	for simpleFieldItr.HasNext() {
-		fieldName := simpleFieldItr.NextRawName()
-		if itr.limits.EnableFieldNameLengthCheck() && len(fieldName) > itr.limits.MaxFieldNameLength {
-			return constants.ErrFieldNameTooLong
+		fieldName, err := sanitizeRawName(itr.limits, fieldNameType,
+			simpleFieldItr.NextRawName(), itr.limits.MaxFieldNameLength, constants.ErrFieldNameTooLong)
+		if err != nil {
+			return err
 		}
 		if err := itr.rowBuilder.AddSimpleField(
-			simpleFieldItr.NextRawName(),
+			fieldName,
 			simpleFieldItr.NextRawType(),
 			simpleFieldItr.NextValue(),
 		); err != nil {
@@ -201,9 +324,10 @@ func (itr *BrokerRowFlatDecoder) rebuild() error {
 	}
 
 End:
-	metricName := itr.originRow.Name()
-	if itr.limits.EnableMetricNameLengthCheck() && len(metricName) > itr.limits.MaxMetricNameLength {
-		return constants.ErrMetricNameTooLong
+	metricName, err := sanitizeRawName(itr.limits, metricNameType,
+		itr.originRow.Name(), itr.limits.MaxMetricNameLength, constants.ErrMetricNameTooLong)
+	if err != nil {
+		return err
 	}
 
 	itr.rowBuilder.AddMetricName(metricName)
@@ -213,8 +337,11 @@ End:
 		// if row namespace is empty, use request's namespace
 		ns = itr.namespace
 	}
-	if itr.limits.EnableNamespaceLengthCheck() && len(ns) > itr.limits.MaxNamespaceLength {
-		return constants.ErrNamespaceTooLong
+	if len(ns) > 0 {
+		if ns, err = sanitizeRawName(itr.limits, namespaceNameType,
+			ns, itr.limits.MaxNamespaceLength, constants.ErrNamespaceTooLong); err != nil {
+			return err
+		}
 	}
 	itr.rowBuilder.AddNameSpace(ns)
 	return nil
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"testing"
 
+	flatbuffers "github.com/google/flatbuffers/go"
 	"github.com/stretchr/testify/assert"
 
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
@@ -291,3 +292,113 @@ func mockDecoder(limits *models.Limits) *BrokerRowFlatDecoder {
 		}, limits)
 	return decoder
 }
+
+func Test_BrokerRowFlatDecoder_ZeroCopy(t *testing.T) {
+	converter := NewProtoConverter(models.NewDefaultLimits())
+	var buf bytes.Buffer
+	now := timeutil.Now()
+	for _, ns := range []string{"ns", ""} {
+		data, err := converter.MarshalProtoMetricV1(&protoMetricsV1.Metric{
+			Name:      "test",
+			Namespace: ns,
+			Timestamp: now,
+			Tags: []*protoMetricsV1.KeyValue{
+				{Key: "b", Value: "2"},
+				{Key: "a", Value: "1"},
+			},
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: "F1", Type: protoMetricsV1.SimpleFieldType_Min, Value: 1},
+			},
+			CompoundField: &protoMetricsV1.CompoundField{
+				Min:            1,
+				Max:            1,
+				Sum:            1,
+				Count:          1,
+				ExplicitBounds: []float64{1, 2, math.Inf(1)},
+				Values:         []float64{0, 1, 0},
+			},
+		})
+		assert.NoError(t, err)
+		_, _ = buf.Write(data)
+	}
+
+	decoder, releaseFunc := NewBrokerRowFlatDecoder(bytes.NewReader(buf.Bytes()), []byte("lindb-ns"), nil, models.NewDefaultLimits())
+	defer releaseFunc(decoder)
+
+	var rows [2]BrokerRow
+	for i := range rows {
+		assert.True(t, decoder.HasNext())
+		assert.NoError(t, decoder.DecodeTo(&rows[i]))
+	}
+	assert.False(t, decoder.HasNext())
+	// first row is canonical, second row need to be rebuilt with request namespace
+	assert.Equal(t, 1, decoder.ZeroCopied())
+	assert.Equal(t, len(buf.Bytes()), decoder.ReadLen())
+	first := rows[0].Metric()
+	for i, ns := range []string{"ns", "lindb-ns"} {
+		m := rows[i].Metric()
+		assert.Equal(t, ns, string(m.Namespace()))
+		assert.Equal(t, "test", string(m.Name()))
+		assert.Equal(t, now, m.Timestamp())
+		assert.Equal(t, 2, m.KeyValuesLength())
+		assert.Equal(t, first.Hash(), m.Hash())
+	}
+	// both rows have same size prefixed block layout
+	assert.Equal(t, int(flatbuffers.GetSizePrefix(rows[0].buffer, 0))+flatbuffers.SizeUOffsetT, len(rows[0].buffer))
+	assert.Equal(t, int(flatbuffers.GetSizePrefix(rows[1].buffer, 0))+flatbuffers.SizeUOffsetT, len(rows[1].buffer))
+
+	// limits enforcement also applies to canonical row
+	limits := models.NewDefaultLimits()
+	limits.MaxTagsPerMetric = 1
+	decoder2, releaseFunc2 := NewBrokerRowFlatDecoder(bytes.NewReader(buf.Bytes()), nil, nil, limits)
+	defer releaseFunc2(decoder2)
+	assert.True(t, decoder2.HasNext())
+	assert.Nil(t, decoder2.MetricName())
+	assert.Equal(t, constants.ErrTooManyTagKeys, decoder2.DecodeTo(&rows[0]))
+	assert.Equal(t, "test", string(decoder2.MetricName()))
+	assert.Zero(t, decoder2.ZeroCopied())
+}
+
+func Benchmark_BrokerRowFlatDecoder(b *testing.B) {
+	converter := NewProtoConverter(models.NewDefaultLimits())
+	var buf bytes.Buffer
+	for i := 0; i < 100; i++ {
+		data, _ := converter.MarshalProtoMetricV1(&protoMetricsV1.Metric{
+			Name:      "test",
+			Namespace: "ns",
+			Timestamp: timeutil.Now(),
+			Tags: []*protoMetricsV1.KeyValue{
+				{Key: "host", Value: "1.1.1.1"},
+				{Key: "ip", Value: "1.1.1.1"},
+				{Key: "zone", Value: "sh"},
+			},
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: "F1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1},
+				{Name: "F2", Type: protoMetricsV1.SimpleFieldType_Max, Value: 1},
+			},
+		})
+		_, _ = buf.Write(data)
+	}
+	run := func(b *testing.B, enrichedTags tag.Tags) {
+		batch := NewBrokerBatchRows()
+		b.ReportAllocs()
+		b.SetBytes(int64(buf.Len()))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			batch.reset()
+			decoder, releaseFunc := NewBrokerRowFlatDecoder(bytes.NewReader(buf.Bytes()), nil, enrichedTags, models.NewDefaultLimits())
+			for decoder.HasNext() {
+				if err := batch.TryAppend(decoder.DecodeTo); err != nil {
+					b.Fatal(err)
+				}
+			}
+			releaseFunc(decoder)
+		}
+	}
+	b.Run("zero-copy", func(b *testing.B) {
+		run(b, nil)
+	})
+	b.Run("rebuild", func(b *testing.B) {
+		run(b, tag.Tags{tag.NewTag([]byte("a"), []byte("b"))})
+	})
+}
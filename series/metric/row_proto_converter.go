@@ -75,10 +75,12 @@ func (rc *BrokerRowProtoConverter) validateMetric(m *protoMetricsV1.Metric) erro
 	if m.Name == "" {
 		return ErrMetricPBEmptyMetricName
 	}
-	if rc.limits.EnableMetricNameLengthCheck() && len(m.Name) > rc.limits.MaxMetricNameLength {
-		return constants.ErrMetricNameTooLong
+	metricName, err := sanitizeName(rc.limits, metricNameType,
+		commonseries.SanitizeMetricName(m.Name), rc.limits.MaxMetricNameLength, constants.ErrMetricNameTooLong)
+	if err != nil {
+		return err
 	}
-	m.Name = commonseries.SanitizeMetricName(m.Name)
+	m.Name = metricName
 	// empty field
 	if len(m.SimpleFields) == 0 && m.CompoundField == nil {
 		return ErrMetricPBEmptyField
@@ -98,6 +100,14 @@ func (rc *BrokerRowProtoConverter) validateMetric(m *protoMetricsV1.Metric) erro
 		m.Namespace = string(rc.namespace)
 	}
 	m.Namespace = commonseries.SanitizeNamespace(m.Namespace)
+	if m.Namespace != "" {
+		namespace, err := sanitizeName(rc.limits, namespaceNameType,
+			m.Namespace, rc.limits.MaxNamespaceLength, constants.ErrNamespaceTooLong)
+		if err != nil {
+			return err
+		}
+		m.Namespace = namespace
+	}
 
 	tags := len(m.Tags)
 	if rc.limits.EnableTagsCheck() && tags > rc.limits.MaxTagsPerMetric {
@@ -138,9 +148,12 @@ func (rc *BrokerRowProtoConverter) validateMetric(m *protoMetricsV1.Metric) erro
 		if m.SimpleFields[idx].Name == "" {
 			return ErrMetricEmptyFieldName
 		}
-		if rc.limits.EnableFieldNameLengthCheck() && len(m.SimpleFields[idx].Name) > rc.limits.MaxFieldNameLength {
-			return constants.ErrFieldNameTooLong
+		name, err := sanitizeName(rc.limits, fieldNameType,
+			m.SimpleFields[idx].Name, rc.limits.MaxFieldNameLength, constants.ErrFieldNameTooLong)
+		if err != nil {
+			return err
 		}
+		m.SimpleFields[idx].Name = name
 		// check sanitize
 		fieldName := strutil.String2ByteSlice(m.SimpleFields[idx].Name)
 		if commonseries.ShouldSanitizeFieldName(fieldName) {
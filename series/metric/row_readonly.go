@@ -25,6 +25,7 @@ import (
 
 	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
 
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/series/field"
 )
 
@@ -181,11 +182,32 @@ func (itr *CompoundFieldIterator) BucketName() field.Name {
 	return field.Name(BucketNameOfHistogramExplicitBound(itr.NextExplicitBound()))
 }
 
+// Sketch builds the histogram sketch from the buckets of histogram, each bucket range is narrowed by min/max of
+// histogram(if set), then the count of bucket is interpolated into the bins of sketch(see ddsketch.Sketch.AddRange for
+// error bound), raw value(min==max) or bucket bounds aligned with sketch bins keep the relative accuracy of sketch.
+func (itr *CompoundFieldIterator) Sketch(sketch *ddsketch.Sketch) {
+	min, max := itr.Min(), itr.Max()
+	lower := 0.0
+	for i := 0; i < itr.num; i++ {
+		upper := itr.f.ExplicitBounds(i)
+		bucketLower, bucketUpper := lower, upper
+		if min > bucketLower && min <= bucketUpper {
+			bucketLower = min
+		}
+		if max > 0 && max >= bucketLower && max < bucketUpper {
+			bucketUpper = max
+		}
+		sketch.AddRange(bucketLower, bucketUpper, itr.f.Values(i))
+		lower = upper
+	}
+}
+
 const (
-	histogramSum   = field.Name("HistogramSum")
-	histogramCount = field.Name("HistogramCount")
-	histogramMax   = field.Name("HistogramMax")
-	histogramMin   = field.Name("HistogramMin")
+	histogramSum    = field.Name("HistogramSum")
+	histogramCount  = field.Name("HistogramCount")
+	histogramMax    = field.Name("HistogramMax")
+	histogramMin    = field.Name("HistogramMin")
+	histogramSketch = field.Name("HistogramSketch")
 )
 
 func (itr *CompoundFieldIterator) HistogramSumFieldName() field.Name { return histogramSum }
@@ -196,6 +218,8 @@ func (itr *CompoundFieldIterator) HistogramMaxFieldName() field.Name { return hi
 
 func (itr *CompoundFieldIterator) HistogramMinFieldName() field.Name { return histogramMin }
 
+func (itr *CompoundFieldIterator) HistogramSketchFieldName() field.Name { return histogramSketch }
+
 // BucketNameOfHistogramExplicitBound converts reserved field-name for histogram buckets.
 func BucketNameOfHistogramExplicitBound(upperBound float64) string {
 	if math.IsInf(upperBound, 1) {
@@ -20,6 +20,7 @@ package metric
 import (
 	flatbuffers "github.com/google/flatbuffers/go"
 
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/series/field"
 )
 
@@ -29,6 +30,8 @@ type StorageRow struct {
 	SeriesID  uint32
 	SlotIndex uint16
 	FieldIDs  []field.ID
+	// histogram sketch converted from compound field, if database enables histogram sketch
+	Sketch *ddsketch.Sketch
 
 	Writable bool // Writable symbols if all meta information is set
 	readOnlyRow
@@ -41,9 +44,20 @@ func (mr *StorageRow) Unmarshal(data []byte) {
 	mr.SeriesID = 0
 	mr.SlotIndex = 0
 	mr.FieldIDs = mr.FieldIDs[:0]
+	if mr.Sketch != nil {
+		mr.Sketch.Reset()
+	}
 	mr.Writable = false
 }
 
+// Clone returns a copy of row which owns the underlying data,
+// so that the row can be kept after the buffer of batch rows reused, metadata of row is reset.
+func (mr *StorageRow) Clone() StorageRow {
+	var row StorageRow
+	row.Unmarshal(append([]byte(nil), mr.m.Table().Bytes...))
+	return row
+}
+
 // StorageBatchRows holds multi rows for inserting into memdb
 // It is reused in sync.Pool
 type StorageBatchRows struct {
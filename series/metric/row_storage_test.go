@@ -29,7 +29,9 @@ import (
 
 	"github.com/lindb/common/pkg/fasttime"
 	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
 
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/series/field"
 )
 
@@ -247,6 +249,79 @@ func Test_HistogramConverter(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_MetricRow_Sketch(t *testing.T) {
+	var builder = flatbuffers.NewBuilder(1024)
+	buildFlatMetric(builder)
+
+	var mr StorageRow
+	mr.Unmarshal(builder.FinishedBytes())
+	itr, ok := mr.NewCompoundFieldIterator()
+	assert.True(t, ok)
+	assert.Equal(t, field.Name("HistogramSketch"), itr.HistogramSketchFieldName())
+	mr.Sketch = ddsketch.NewSketch()
+	// buckets span over multi bins, count of bucket interpolated into bins
+	itr.Sketch(mr.Sketch)
+	assert.False(t, mr.Sketch.IsEmpty())
+	mr.Sketch.Reset()
+
+	buildSketchRow := func(values, bounds []float64, min, max float64) {
+		rowBuilder := commonseries.CreateRowBuilder()
+		rowBuilder.AddMetricName([]byte("histogram"))
+		assert.NoError(t, rowBuilder.AddCompoundFieldData(values, bounds))
+		assert.NoError(t, rowBuilder.AddCompoundFieldMMSC(min, max, 0, 0))
+		data, err := rowBuilder.Build()
+		assert.NoError(t, err)
+		mr.Unmarshal(data[flatbuffers.SizeUOffsetT:])
+		itr, ok = mr.NewCompoundFieldIterator()
+		assert.True(t, ok)
+	}
+	// raw value, narrowed by min/max
+	buildSketchRow([]float64{0, 3, 0}, []float64{1, 10, math.Inf(1)}, 5, 5)
+	itr.Sketch(mr.Sketch)
+	assert.Equal(t, 3.0, mr.Sketch.Bins()[ddsketch.Index(5)])
+	assert.Len(t, mr.Sketch.Bins(), 1)
+	// bucket bounds aligned with sketch bins
+	buildSketchRow([]float64{0, 2, 0}, []float64{ddsketch.LowerBound(10), ddsketch.LowerBound(11), math.Inf(1)}, 0, 0)
+	mr.Sketch.Reset()
+	itr.Sketch(mr.Sketch)
+	assert.Equal(t, 2.0, mr.Sketch.Bins()[10])
+	assert.Len(t, mr.Sketch.Bins(), 1)
+	// buckets narrowed by min/max
+	buildSketchRow([]float64{2, 6, 2}, []float64{10, 20, math.Inf(1)}, 8, 30)
+	mr.Sketch.Reset()
+	itr.Sketch(mr.Sketch)
+	assert.InDelta(t, 10, mr.Sketch.Count(), 1e-6)
+	v, _ := mr.Sketch.Quantile(0)
+	assert.InEpsilon(t, 8, v, ddsketch.RelativeAccuracy)
+	// +Inf bucket narrowed to (20, 30]
+	v, _ = mr.Sketch.Quantile(1)
+	assert.True(t, v > 20*(1-ddsketch.RelativeAccuracy) && v <= 30*(1+ddsketch.RelativeAccuracy))
+
+	mr.Unmarshal(builder.FinishedBytes())
+	assert.True(t, mr.Sketch.IsEmpty())
+}
+
+func TestStorageRow_Clone(t *testing.T) {
+	var builder = flatbuffers.NewBuilder(1024)
+	buildFlatMetric(builder)
+
+	data := builder.FinishedBytes()
+	var mr StorageRow
+	mr.Unmarshal(data)
+	mr.MetricID = 10
+	mr.Writable = true
+	row := mr.Clone()
+	// reuse buffer
+	for i := range data {
+		data[i] = 0
+	}
+	assert.Equal(t, "hello", string(row.Name()))
+	assert.Equal(t, 10, row.TagsLen())
+	assert.Equal(t, 10, row.SimpleFieldsLen())
+	assert.Zero(t, row.MetricID)
+	assert.False(t, row.Writable)
+}
+
 func TestStorageBatchRows_Sorts(t *testing.T) {
 	var builder = flatbuffers.NewBuilder(1024)
 	buildFlatMetric(builder)
@@ -0,0 +1,77 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metric
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/strutil"
+)
+
+var sanitizeStatistics = metrics.NewSanitizeStatistics()
+
+// name types of sanitization statistics.
+const (
+	namespaceNameType = "namespace"
+	metricNameType    = "metric"
+	fieldNameType     = "field"
+)
+
+// sanitizeName normalizes the name by sanitization rules of database, then checks the length of name
+// by length policy(truncate or reject), max length <= 0 means no length limit.
+func sanitizeName(limits *models.Limits, nameType, name string, maxLength int, errTooLong error) (string, error) {
+	rules := &limits.Sanitize
+	if rules.Enabled() {
+		if normalized := rules.Normalize(name); normalized != name {
+			sanitizeStatistics.Normalized.WithTagValues(nameType).Incr()
+			name = normalized
+		}
+		if name == "" {
+			sanitizeStatistics.Rejected.WithTagValues(nameType).Incr()
+			return "", fmt.Errorf("%w, %s", ErrSanitizedNameEmpty, nameType)
+		}
+	}
+	if maxLength <= 0 || len(name) <= maxLength {
+		return name, nil
+	}
+	if !rules.Truncatable() {
+		sanitizeStatistics.Rejected.WithTagValues(nameType).Incr()
+		return "", errTooLong
+	}
+	sanitizeStatistics.Truncated.WithTagValues(nameType).Incr()
+	return rules.Truncate(name, maxLength), nil
+}
+
+// sanitizeRawName sanitizes the raw name of flat row, returns the raw name directly if nothing need to do.
+func sanitizeRawName(limits *models.Limits, nameType string, name []byte, maxLength int, errTooLong error) ([]byte, error) {
+	if !limits.Sanitize.Enabled() && (maxLength <= 0 || len(name) <= maxLength) {
+		return name, nil
+	}
+	sanitized, err := sanitizeName(limits, nameType, string(name), maxLength, errTooLong)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(sanitized), nil
+}
+
+// shouldSanitizeRawName returns if the raw name of flat row will be changed by sanitization rules.
+func shouldSanitizeRawName(limits *models.Limits, name []byte) bool {
+	return limits.Sanitize.ShouldNormalize(strutil.ByteSlice2String(name))
+}
@@ -0,0 +1,131 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metric
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestSanitizeName(t *testing.T) {
+	limits := models.NewDefaultLimits()
+	name, err := sanitizeName(limits, metricNameType, "Cpu-Load", 0, constants.ErrMetricNameTooLong)
+	assert.NoError(t, err)
+	assert.Equal(t, "Cpu-Load", name)
+	_, err = sanitizeName(limits, metricNameType, "Cpu-Load", 3, constants.ErrMetricNameTooLong)
+	assert.Equal(t, constants.ErrMetricNameTooLong, err)
+
+	limits.Sanitize.CharMap["-"] = "_"
+	limits.Sanitize.CharMap["$"] = ""
+	limits.Sanitize.CaseFolding = models.LowerCase
+	name, err = sanitizeName(limits, metricNameType, "Cpu-Load", 0, constants.ErrMetricNameTooLong)
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu_load", name)
+	_, err = sanitizeName(limits, metricNameType, "$$", 0, constants.ErrMetricNameTooLong)
+	assert.True(t, errors.Is(err, ErrSanitizedNameEmpty))
+
+	limits.Sanitize.LengthPolicy = models.TruncateLongName
+	name, err = sanitizeName(limits, fieldNameType, "Cpu-Load", 3, constants.ErrFieldNameTooLong)
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu", name)
+
+	raw := []byte("cpu")
+	rawName, err := sanitizeRawName(models.NewDefaultLimits(), metricNameType, raw, 10, constants.ErrMetricNameTooLong)
+	assert.NoError(t, err)
+	assert.Equal(t, raw, rawName)
+	rawName, err = sanitizeRawName(limits, metricNameType, []byte("Cpu-Load"), 10, constants.ErrMetricNameTooLong)
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu_load", string(rawName))
+	_, err = sanitizeRawName(limits, metricNameType, []byte("$"), 10, constants.ErrMetricNameTooLong)
+	assert.Error(t, err)
+}
+
+func TestProtoConverter_Sanitize(t *testing.T) {
+	limits := models.NewDefaultLimits()
+	limits.Sanitize.CharMap["-"] = "_"
+	limits.Sanitize.CaseFolding = models.LowerCase
+	limits.Sanitize.LengthPolicy = models.TruncateLongName
+	limits.MaxFieldNameLength = 3
+	converter, releaseFunc := NewBrokerRowProtoConverter(nil, nil, limits)
+	defer releaseFunc(converter)
+
+	m := &protoMetricsV1.Metric{
+		Name:      "Cpu-Load",
+		Namespace: "My-Ns",
+		Tags:      []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "F-1-max", Type: protoMetricsV1.SimpleFieldType_Max, Value: 1},
+		},
+	}
+	assert.NoError(t, converter.validateMetric(m))
+	assert.Equal(t, "cpu_load", m.Name)
+	assert.Equal(t, "my_ns", m.Namespace)
+	assert.Equal(t, "f_1", m.SimpleFields[0].Name)
+
+	limits.Sanitize.LengthPolicy = models.RejectLongName
+	m.SimpleFields[0].Name = "F-1-max"
+	assert.Equal(t, constants.ErrFieldNameTooLong, converter.validateMetric(m))
+}
+
+func TestBrokerRowFlatDecoder_Sanitize(t *testing.T) {
+	converter := NewProtoConverter(models.NewDefaultLimits())
+	data, err := converter.MarshalProtoMetricV1(&protoMetricsV1.Metric{
+		Name:      "Cpu-Load",
+		Namespace: "My-Ns",
+		Timestamp: timeutil.Now(),
+		Tags:      []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "F-1", Type: protoMetricsV1.SimpleFieldType_Max, Value: 1},
+		},
+	})
+	assert.NoError(t, err)
+
+	limits := models.NewDefaultLimits()
+	limits.Sanitize.CharMap["-"] = "_"
+	limits.Sanitize.CaseFolding = models.LowerCase
+	decoder, releaseFunc := NewBrokerRowFlatDecoder(bytes.NewReader(data), nil, nil, limits)
+	defer releaseFunc(decoder)
+
+	var row BrokerRow
+	assert.True(t, decoder.HasNext())
+	assert.NoError(t, decoder.DecodeTo(&row))
+	assert.Zero(t, decoder.ZeroCopied())
+	m := row.Metric()
+	assert.Equal(t, "cpu_load", string(m.Name()))
+	assert.Equal(t, "my_ns", string(m.Namespace()))
+	var f flatMetricsV1.SimpleField
+	assert.True(t, m.SimpleFields(&f, 0))
+	assert.Equal(t, "f_1", string(f.Name()))
+
+	// reject metric which name is too long
+	limits.MaxMetricNameLength = 3
+	decoder2, releaseFunc2 := NewBrokerRowFlatDecoder(bytes.NewReader(data), nil, nil, limits)
+	defer releaseFunc2(decoder2)
+	assert.True(t, decoder2.HasNext())
+	assert.Equal(t, constants.ErrMetricNameTooLong, decoder2.DecodeTo(&row))
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -95,6 +96,69 @@ func (b *baseStmtParser) visitTagValue(ctx *grammar.TagValueContext) {
 	}
 }
 
+// visitSubQuery sets the sub query(show tag values) for in expression
+func (b *baseStmtParser) visitSubQuery(subQuery stmt.Statement, err error) {
+	if err != nil {
+		b.err = err
+		return
+	}
+	if b.exprStack.Empty() {
+		return
+	}
+	var inExpr *stmt.InExpr
+	switch expr := b.exprStack.Peek().(type) {
+	case *stmt.NotExpr:
+		inExpr, _ = expr.Expr.(*stmt.InExpr)
+	case *stmt.InExpr:
+		inExpr = expr
+	}
+	if inExpr != nil {
+		inExpr.SubQuery, _ = subQuery.(*stmt.MetricMetadata)
+	}
+}
+
+// parseDuration parses time duration from duration string
+func (b *baseStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
+	if ctx == nil {
+		return 0
+	}
+	durationCtx, ok := ctx.(*grammar.DurationLitContext)
+	if !ok {
+		return 0
+	}
+
+	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
+	if err != nil {
+		b.err = err
+		return 0
+	}
+	var result int64
+	if durationCtx.IntervalItem() == nil {
+		return result
+	}
+	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
+	if !ok {
+		return result
+	}
+	switch {
+	case unit.T_SECOND() != nil:
+		result = duration * timeutil.OneSecond
+	case unit.T_MINUTE() != nil:
+		result = duration * timeutil.OneMinute
+	case unit.T_HOUR() != nil:
+		result = duration * timeutil.OneHour
+	case unit.T_DAY() != nil:
+		result = duration * timeutil.OneDay
+	case unit.T_WEEK() != nil:
+		result = duration * timeutil.OneWeek
+	case unit.T_MONTH() != nil:
+		result = duration * timeutil.OneMonth
+	case unit.T_YEAR() != nil:
+		result = duration * timeutil.OneYear
+	}
+	return result
+}
+
 // setTagFilterExprValue sets tag value for tag filter expression
 func (b *baseStmtParser) setTagFilterExprValue(expr stmt.Expr, tagValue string) {
 	switch e := expr.(type) {
@@ -116,6 +180,12 @@ func (b *baseStmtParser) createTagFilterExpr(tagKey grammar.ITagKeyContext,
 	if tagKeyCtx, ok := tagKey.(*grammar.TagKeyContext); ok {
 		tagKeyStr := strutil.GetStringValue(tagKeyCtx.Ident().GetText())
 		switch {
+		case ctx.T_IS() != nil:
+			if ctx.T_NOT() != nil {
+				expr = &stmt.NotExpr{Expr: &stmt.IsNullExpr{Key: tagKeyStr}}
+			} else {
+				expr = &stmt.IsNullExpr{Key: tagKeyStr}
+			}
 		case ctx.T_EQUAL() != nil:
 			expr = &stmt.EqualsExpr{Key: tagKeyStr}
 		case ctx.T_LIKE() != nil:
@@ -0,0 +1,51 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// compactionStmtParser represents compaction statement parser.
+type compactionStmtParser struct {
+	compaction *stmt.Compaction
+}
+
+// newCompactionStmtParse creates a compaction statement parser.
+func newCompactionStmtParse(op stmt.CompactionOpType) *compactionStmtParser {
+	return &compactionStmtParser{
+		compaction: &stmt.Compaction{Type: op},
+	}
+}
+
+// visitDatabaseName visits database name.
+func (s *compactionStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.compaction.Database = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitSwitchValue visits compaction switch value, OFF means pause compaction.
+func (s *compactionStmtParser) visitSwitchValue(ctx *grammar.SwitchValueContext) {
+	s.compaction.Paused = ctx.T_OFF() != nil
+}
+
+// build returns the compaction statement.
+func (s *compactionStmtParser) build() (stmt.Statement, error) {
+	return s.compaction, nil
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestCompactionStmt_Parse(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt stmt.Statement
+	}{
+		{"show compaction", &stmt.Compaction{Type: stmt.ShowCompaction}},
+		{"SHOW COMPACTION FOR DATABASE db", &stmt.Compaction{Type: stmt.ShowCompaction, Database: "db"}},
+		{"show compaction jobs", &stmt.Compaction{Type: stmt.ShowCompactionJobs}},
+		{"SHOW COMPACTION JOBS FOR DATABASE 'db'", &stmt.Compaction{Type: stmt.ShowCompactionJobs, Database: "db"}},
+		{"set compaction = off", &stmt.Compaction{Type: stmt.SetCompaction, Paused: true}},
+		{"SET COMPACTION=ON", &stmt.Compaction{Type: stmt.SetCompaction}},
+		{"set compaction = off for database 'db'", &stmt.Compaction{Type: stmt.SetCompaction, Database: "db", Paused: true}},
+		{"set compaction = on for database db", &stmt.Compaction{Type: stmt.SetCompaction, Database: "db"}},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+}
+
+func TestCompactionStmt_Parse_Fail(t *testing.T) {
+	for _, sql := range []string{
+		"show compaction db",
+		"show compaction for",
+		"show compaction for db",
+		"show compaction for database",
+		"show compaction jobs db",
+		"show compaction jobs for",
+		"set compaction jobs = on",
+		"set compaction",
+		"set compaction off",
+		"set compaction = true",
+		"set compaction = on database db",
+		"set compaction = off for database db node",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// deleteSeriesStmtParser represents delete series statement parser.
+type deleteSeriesStmtParser struct {
+	baseStmtParser
+}
+
+// newDeleteSeriesStmtParse creates a delete series statement parser.
+func newDeleteSeriesStmtParse() *deleteSeriesStmtParser {
+	return &deleteSeriesStmtParser{
+		baseStmtParser: baseStmtParser{
+			exprStack: collections.NewStack(),
+			namespace: commonconstants.DefaultNamespace,
+		},
+	}
+}
+
+// build returns the delete series statement.
+func (s *deleteSeriesStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &stmt.DeleteSeries{
+		Namespace:  s.namespace,
+		MetricName: s.metricName,
+		Condition:  s.condition,
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteSeriesStmt_Parse(t *testing.T) {
+	q, err := Parse("delete series from cpu where host='x'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.DeleteSeries{
+		Namespace:  "default-ns",
+		MetricName: "cpu",
+		Condition:  &stmt.EqualsExpr{Key: "host", Value: "x"},
+	}, q)
+	q, err = Parse("DELETE SERIES FROM cpu ON 'ns' WHERE host='x' and region in ('a','b')")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.DeleteSeries)
+	assert.Equal(t, "ns", deleteStmt.Namespace)
+	assert.Equal(t, "cpu", deleteStmt.MetricName)
+	assert.Equal(t, "host=xandregion in (a,b)", deleteStmt.Condition.Rewrite())
+}
+
+func TestDeleteSeriesStmt_Parse_Fail(t *testing.T) {
+	for _, sql := range []string{
+		"delete series cpu",
+		"delete series from",
+		"delete series from cpu",
+		"delete series from cpu where host='x' group by host",
+		"delete series from cpu where host='x' limit 10",
+		"delete series from cpu where host='x' and time>now()-1h",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
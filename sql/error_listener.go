@@ -18,14 +18,305 @@
 package sql
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+
+	"github.com/lindb/lindb/sql/grammar"
 )
 
+// maxExpectedTokens represents the max number of expected alternatives in error message.
+const maxExpectedTokens = 10
+
+// tokenDisplayNames represents the display names of literal tokens.
+var tokenDisplayNames = map[string]string{
+	"L_ID":   "identifier",
+	"L_INT":  "integer",
+	"L_DEC":  "decimal",
+	"STRING": "string",
+}
+
+// keywordPattern matches the symbolic name of keyword(T_FROM) in raw message.
+var keywordPattern = regexp.MustCompile(`\bT_([A-Z_]+)`)
+
+// statementKeywords represents the leading keywords of statements.
+var statementKeywords = []string{"SELECT", "SHOW", "FROM", "EXPLAIN", "USE", "CREATE", "DROP", "SET", "KILL", "DELETE"}
+
+// tagValueOperators represents the operators before tag value of tag filter expression.
+var tagValueOperators = map[int]struct{}{
+	grammar.SQLLexerT_EQUAL:        {},
+	grammar.SQLLexerT_NOTEQUAL:     {},
+	grammar.SQLLexerT_NOTEQUAL2:    {},
+	grammar.SQLLexerT_LIKE:         {},
+	grammar.SQLLexerT_REGEXP:       {},
+	grammar.SQLLexerT_NEQREGEXP:    {},
+	grammar.SQLLexerT_OPEN_P:       {},
+	grammar.SQLLexerT_COMMA:        {},
+	grammar.SQLLexerT_GREATER:      {},
+	grammar.SQLLexerT_LESS:         {},
+	grammar.SQLLexerT_LESSEQUAL:    {},
+	grammar.SQLLexerT_GREATEREQUAL: {},
+}
+
+// SyntaxError represents the syntax error of sql with position and suggestion.
+type SyntaxError struct {
+	Line       int      `json:"line"`                 // line of offending token, start with 1
+	Column     int      `json:"column"`               // column of offending token, start with 1
+	Token      string   `json:"token"`                // text of offending token
+	Expected   []string `json:"expected,omitempty"`   // expected alternatives
+	Suggestion string   `json:"suggestion,omitempty"` // suggestion for common mistake
+	Msg        string   `json:"msg"`                  // raw message from parser
+}
+
+// Error returns the error message with position, expected alternatives and suggestion.
+func (e *SyntaxError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("syntax error at line %d:%d", e.Line, e.Column))
+	if e.Token != "" {
+		sb.WriteString(fmt.Sprintf(" near '%s'", e.Token))
+	}
+	msg := keywordPattern.ReplaceAllString(e.Msg, "$1")
+	if idx := strings.Index(msg, " expecting "); idx > 0 && len(e.Expected) > 0 {
+		msg = msg[:idx]
+	}
+	sb.WriteString(": ")
+	sb.WriteString(msg)
+	if len(e.Expected) > 0 {
+		sb.WriteString(", expecting ")
+		if len(e.Expected) > maxExpectedTokens {
+			sb.WriteString(strings.Join(e.Expected[:maxExpectedTokens], ", "))
+			sb.WriteString(fmt.Sprintf(" and %d more", len(e.Expected)-maxExpectedTokens))
+		} else {
+			sb.WriteString(strings.Join(e.Expected, ", "))
+		}
+	}
+	if e.Suggestion != "" {
+		sb.WriteString("; ")
+		sb.WriteString(e.Suggestion)
+	}
+	return sb.String()
+}
+
+// Pointer returns the offending line of sql with a caret under the offending column.
+func (e *SyntaxError) Pointer(sql string) string {
+	lines := strings.Split(sql, "\n")
+	if e.Line < 1 || e.Line > len(lines) {
+		return ""
+	}
+	line := lines[e.Line-1]
+	column := e.Column
+	if column < 1 {
+		column = 1
+	}
+	if column > len(line)+1 {
+		column = len(line) + 1
+	}
+	return line + "\n" + strings.Repeat(" ", column-1) + "^"
+}
+
+// errorListener converts the syntax error reported by lexer/parser to SyntaxError.
 type errorListener struct {
 	antlr.ErrorListener
 }
 
+// SyntaxError panics with SyntaxError which is recovered by Parse.
 func (l *errorListener) SyntaxError(recognizer antlr.Recognizer,
 	offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	panic(msg)
+	err := &SyntaxError{
+		Line:   line,
+		Column: column + 1,
+		Msg:    msg,
+	}
+	token, _ := offendingSymbol.(antlr.Token)
+	if token != nil {
+		err.Token = token.GetText()
+	} else if idx := strings.Index(msg, "at: '"); idx >= 0 {
+		// lexer error: token recognition error at: 'xxx'
+		err.Token = strings.TrimSuffix(msg[idx+5:], "'")
+	}
+	if parser, ok := recognizer.(antlr.Parser); ok {
+		err.Expected = expectedTokens(parser)
+		err.Suggestion = suggestForParser(parser, token, err.Expected)
+	} else {
+		err.Suggestion = suggestForLexer(err.Token)
+	}
+	panic(err)
+}
+
+// expectedTokens returns the display names of expected alternatives in current parser state.
+func expectedTokens(parser antlr.Parser) (rs []string) {
+	defer func() {
+		// expected tokens are optional, ignore the failure of computing them
+		if r := recover(); r != nil {
+			rs = nil
+		}
+	}()
+	expected := parser.GetExpectedTokens()
+	if expected == nil {
+		return nil
+	}
+	literalNames := parser.GetLiteralNames()
+	symbolicNames := parser.GetSymbolicNames()
+	var keywords []string
+	identifierExpected := false
+	for _, interval := range expected.GetIntervals() {
+		for tokenType := interval.Start; tokenType < interval.Stop; tokenType++ {
+			switch {
+			case tokenType == grammar.SQLLexerL_ID:
+				identifierExpected = true
+			case isKeyword(tokenType, symbolicNames):
+				keywords = append(keywords, tokenDisplayName(tokenType, literalNames, symbolicNames))
+			default:
+				rs = append(rs, tokenDisplayName(tokenType, literalNames, symbolicNames))
+			}
+		}
+	}
+	if identifierExpected {
+		// most of keywords are non-reserved words which can be used as identifier, so ignore them
+		return append([]string{tokenDisplayNames["L_ID"]}, rs...)
+	}
+	return append(keywords, rs...)
+}
+
+// isKeyword checks if the token type is keyword.
+func isKeyword(tokenType int, symbolicNames []string) bool {
+	return tokenType > 0 && tokenType < len(symbolicNames) && strings.HasPrefix(symbolicNames[tokenType], "T_")
+}
+
+// tokenDisplayName returns the display name of token type, keyword without T_ prefix.
+func tokenDisplayName(tokenType int, literalNames, symbolicNames []string) string {
+	if tokenType == antlr.TokenEOF {
+		return "<EOF>"
+	}
+	if tokenType > 0 && tokenType < len(literalNames) && literalNames[tokenType] != "" {
+		return literalNames[tokenType]
+	}
+	if tokenType > 0 && tokenType < len(symbolicNames) {
+		name := symbolicNames[tokenType]
+		if displayName, ok := tokenDisplayNames[name]; ok {
+			return displayName
+		}
+		return strings.TrimPrefix(name, "T_")
+	}
+	return fmt.Sprintf("<%d>", tokenType)
+}
+
+// suggestForParser returns the suggestion for common mistakes based on offending token.
+func suggestForParser(parser antlr.Parser, token antlr.Token, expected []string) string {
+	if token == nil {
+		return ""
+	}
+	if token.GetTokenType() == antlr.TokenEOF {
+		return "statement is incomplete"
+	}
+	if isUnquotedTagValue(parser.GetTokenStream(), token) {
+		return "tag value must be quoted, e.g. host='192.168.1.1'"
+	}
+	if keyword := similarKeyword(token.GetText(), expected); keyword != "" {
+		return fmt.Sprintf("did you mean '%s'?", keyword)
+	}
+	if stream := parser.GetTokenStream(); stream != nil && token.GetTokenIndex() > 0 {
+		// misspelled statement keyword(selec f from cpu)
+		first := stream.Get(0)
+		if first.GetTokenType() == grammar.SQLLexerL_ID {
+			if keyword := similarKeyword(first.GetText(), statementKeywords); keyword != "" {
+				return fmt.Sprintf("did you mean '%s'?", keyword)
+			}
+		}
+	}
+	return ""
+}
+
+// suggestForLexer returns the suggestion for unrecognized token.
+func suggestForLexer(text string) string {
+	if strings.HasPrefix(text, "'") || strings.HasPrefix(text, `"`) {
+		return "quote is not closed, tag value must be enclosed in matching quotes"
+	}
+	return ""
+}
+
+// isUnquotedTagValue checks if the offending token is a part of unquoted tag value in where clause,
+// like host=192.168.1.1 or host=a/b.
+func isUnquotedTagValue(stream antlr.TokenStream, token antlr.Token) bool {
+	idx := token.GetTokenIndex()
+	if stream == nil || idx < 1 || !inWhereClause(stream, idx) {
+		return false
+	}
+	prev := stream.Get(idx - 1).GetTokenType()
+	switch token.GetTokenType() {
+	case grammar.SQLLexerL_INT, grammar.SQLLexerL_DEC:
+		_, ok := tagValueOperators[prev]
+		return ok
+	}
+	if prev != grammar.SQLLexerL_ID || idx < 2 {
+		return false
+	}
+	// tag value splits by special char(host=a/b)
+	switch stream.Get(idx - 2).GetTokenType() {
+	case grammar.SQLLexerT_EQUAL, grammar.SQLLexerT_NOTEQUAL, grammar.SQLLexerT_NOTEQUAL2:
+		return true
+	default:
+		return false
+	}
+}
+
+// inWhereClause checks if there is where keyword before the token.
+func inWhereClause(stream antlr.TokenStream, idx int) bool {
+	for i := idx - 1; i >= 0; i-- {
+		if stream.Get(i).GetTokenType() == grammar.SQLLexerT_WHERE {
+			return true
+		}
+	}
+	return false
+}
+
+// similarKeyword returns the expected keyword which is similar with the text(misspelling).
+func similarKeyword(text string, expected []string) string {
+	text = strings.ToLower(text)
+	if len(text) < 3 {
+		return ""
+	}
+	keyword := ""
+	minDistance := 3
+	for _, candidate := range expected {
+		name := strings.ToLower(candidate)
+		if name == text || strings.ContainsAny(name, "<' ") {
+			continue
+		}
+		if distance := editDistance(text, name); distance < minDistance {
+			minDistance = distance
+			keyword = candidate
+		}
+	}
+	return keyword
+}
+
+// editDistance returns the levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
 }
@@ -0,0 +1,140 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyntaxError(t *testing.T) {
+	cases := []struct {
+		sql        string
+		line       int
+		column     int
+		token      string
+		expected   []string
+		suggestion string
+	}{
+		{
+			sql:        "select f from cpu where host=1.2.3.4",
+			line:       1,
+			column:     30,
+			token:      "1.2",
+			expected:   []string{"identifier"},
+			suggestion: "tag value must be quoted, e.g. host='192.168.1.1'",
+		},
+		{
+			sql:        "select f from cpu where host in ('a', 1)",
+			line:       1,
+			column:     39,
+			token:      "1",
+			expected:   []string{"identifier"},
+			suggestion: "tag value must be quoted, e.g. host='192.168.1.1'",
+		},
+		{
+			sql:        "select f form cpu",
+			line:       1,
+			column:     10,
+			token:      "form",
+			expected:   []string{"FROM"},
+			suggestion: "did you mean 'FROM'?",
+		},
+		{
+			sql:        "selec f from cpu",
+			line:       1,
+			column:     7,
+			token:      "f",
+			expected:   []string{"FORMAT", "<EOF>"},
+			suggestion: "did you mean 'SELECT'?",
+		},
+		{
+			sql:        "select f from cpu\ngroup by",
+			line:       2,
+			column:     9,
+			token:      "<EOF>",
+			expected:   []string{"identifier"},
+			suggestion: "statement is incomplete",
+		},
+		{
+			sql:        "select f from cpu limit x",
+			line:       1,
+			column:     25,
+			token:      "x",
+			expected:   []string{"integer"},
+			suggestion: "",
+		},
+		{
+			sql:        "select f from cpu where host='a",
+			line:       1,
+			column:     30,
+			token:      "'a",
+			suggestion: "quote is not closed, tag value must be enclosed in matching quotes",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			_, err := Parse(tt.sql)
+			var syntaxErr *SyntaxError
+			assert.True(t, errors.As(err, &syntaxErr))
+			assert.Equal(t, tt.line, syntaxErr.Line)
+			assert.Equal(t, tt.column, syntaxErr.Column)
+			assert.Equal(t, tt.token, syntaxErr.Token)
+			assert.Equal(t, tt.expected, syntaxErr.Expected)
+			assert.Equal(t, tt.suggestion, syntaxErr.Suggestion)
+		})
+	}
+}
+
+func TestSyntaxError_Error(t *testing.T) {
+	_, err := Parse("select f form cpu")
+	assert.EqualError(t, err, "syntax error at line 1:10 near 'form': missing FROM at 'form', expecting FROM; did you mean 'FROM'?")
+
+	err = &SyntaxError{Line: 1, Column: 1, Msg: "mismatched input 'a' expecting {b, c}"}
+	assert.EqualError(t, err, "syntax error at line 1:1: mismatched input 'a' expecting {b, c}")
+
+	err = &SyntaxError{Line: 1, Column: 1, Token: "a", Msg: "mismatched input 'a' expecting {...}",
+		Expected: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}}
+	assert.EqualError(t, err, "syntax error at line 1:1 near 'a': mismatched input 'a', expecting 1, 2, 3, 4, 5, 6, 7, 8, 9, 10 and 2 more")
+}
+
+func TestSyntaxError_Pointer(t *testing.T) {
+	err := &SyntaxError{Line: 2, Column: 3}
+	assert.Equal(t, "group\n  ^", err.Pointer("select f from cpu\ngroup"))
+	err = &SyntaxError{Line: 1, Column: 0}
+	assert.Equal(t, "abc\n^", err.Pointer("abc"))
+	err = &SyntaxError{Line: 1, Column: 10}
+	assert.Equal(t, "abc\n   ^", err.Pointer("abc"))
+	err = &SyntaxError{Line: 3, Column: 1}
+	assert.Empty(t, err.Pointer("abc"))
+}
+
+func TestTokenDisplayName(t *testing.T) {
+	assert.Equal(t, "'m'", tokenDisplayName(1, []string{"", "'m'"}, []string{"", ""}))
+	assert.Equal(t, "<100>", tokenDisplayName(100, nil, nil))
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("from", "from"))
+	assert.Equal(t, 2, editDistance("form", "from"))
+	assert.Equal(t, 1, editDistance("selec", "select"))
+	assert.Equal(t, 4, editDistance("", "show"))
+}
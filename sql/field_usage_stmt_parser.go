@@ -0,0 +1,64 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// fieldUsageStmtParser represents show field usage statement parser.
+type fieldUsageStmtParser struct {
+	baseStmtParser
+	since int64
+}
+
+// newFieldUsageStmtParse creates a show field usage statement parser.
+func newFieldUsageStmtParse() *fieldUsageStmtParser {
+	return &fieldUsageStmtParser{
+		baseStmtParser: baseStmtParser{
+			namespace: commonconstants.DefaultNamespace,
+		},
+	}
+}
+
+// visitSinceClause visits the time duration before now of since clause.
+func (s *fieldUsageStmtParser) visitSinceClause(ctx *grammar.SinceClauseContext) {
+	since := s.parseDuration(ctx.DurationLit())
+	if since <= 0 {
+		s.err = errors.New("invalid duration of SINCE clause, e.g. since 30d")
+		return
+	}
+	s.since = since
+}
+
+// build returns the show field usage statement.
+func (s *fieldUsageStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &stmt.FieldUsage{
+		Namespace:  s.namespace,
+		MetricName: s.metricName,
+		Since:      s.since,
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestFieldUsageStmt_Parse(t *testing.T) {
+	q, err := Parse("show field usage from cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.FieldUsage{Namespace: "default-ns", MetricName: "cpu"}, q)
+	q, err = Parse("SHOW FIELD USAGE FROM cpu ON 'ns' SINCE 30d")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.FieldUsage{Namespace: "ns", MetricName: "cpu", Since: 30 * timeutil.OneDay}, q)
+	q, err = Parse("show field usage from 'system.cpu' since 12h")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.FieldUsage{Namespace: "default-ns", MetricName: "system.cpu", Since: 12 * timeutil.OneHour}, q)
+}
+
+func TestFieldUsageStmt_Parse_Fail(t *testing.T) {
+	for _, sql := range []string{
+		"show field usage cpu",
+		"show field usage from",
+		"show field usage from cpu where host='x'",
+		"show field usage from cpu since",
+		"show field usage from cpu since 30x",
+		"show field usage from cpu since -1d",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
@@ -2,7 +2,8 @@
 // antlr4 SQL.g4 -Dlanguage=Go -package grammar
 grammar SQL;
 
-statement               : showStmt
+statement               : (
+                          showStmt
                         | createStorageStmt
                         | createBrokerStmt
                         | recoverStorageStmt
@@ -10,12 +11,23 @@ statement               : showStmt
                         | queryStmt
                         | createDatabaseStmt
                         | dropDatabaseStmt
+                        | alterDatabaseStmt
+                        | alterStorageReadOnlyStmt
+                        | alterDatabaseReadOnlyStmt
+                        | setCompactionStmt
 						| setLimitStmt
+                        | setLogLevelStmt
+                        | repairPlacementStmt
+                        | deleteSeriesStmt
                         | ident // just for suggest filtering.
-                        EOF ;
+                        ) formatClause? EOF ;
+
+formatClause            : T_FORMAT ident ;
 
 useStmt                 : T_USE ident ;
 setLimitStmt            : T_SET T_LIMIT toml;
+setLogLevelStmt         : T_SET T_LOG T_LEVEL logLevel (T_COMMA logLevel)* ;
+logLevel                : ident T_EQUAL ident ;
 
 showStmt                : showMasterStmt
                         | showMetadataTypesStmt
@@ -26,6 +38,7 @@ showStmt                : showMasterStmt
                         | showStoragesStmt
                         | showBrokersStmt
 						| showLimitStmt
+                        | showLimitStatusStmt
                         | showAliveStmt
                         | showRootMetricStmt
                         | showBrokerMetricStmt
@@ -37,10 +50,21 @@ showStmt                : showMasterStmt
                         | showNameSpacesStmt
                         | showMetricsStmt
                         | showFieldsStmt
+                        | showFieldUsageStmt
                         | showTagKeysStmt
                         | showTagValuesStmt
 						| showRequestsStmt
 						| showRequestStmt
+                        | showShardsStmt
+                        | showSegmentsStmt
+                        | showDiskUsageStmt
+                        | showFileDetailStmt
+                        | showReplicationChannelsStmt
+                        | showExpiredMetricsStmt
+                        | showLogLevelsStmt
+                        | showPlacementStmt
+                        | showReadOnlyStmt
+                        | showCompactionStmt
                         ;
 //meta data query statement
 showMasterStmt       : T_SHOW T_MASTER ;
@@ -49,6 +73,7 @@ showRequestStmt      : T_SHOW T_REQUEST T_WHERE T_ID T_EQUAL requestID;
 showStoragesStmt     : T_SHOW T_STORAGES ;
 showBrokersStmt      : T_SHOW T_BROKERS ;
 showLimitStmt        : T_SHOW T_LIMIT ; 
+showLimitStatusStmt  : T_SHOW T_LIMIT T_STATUS ;
 showMetadataTypesStmt: T_SHOW T_METADATA T_TYPES;
 showRootMetaStmt     : T_SHOW T_ROOT T_METADATA T_FROM source T_WHERE typeFilter;
 showBrokerMetaStmt   : T_SHOW T_BROKER T_METADATA T_FROM source T_WHERE typeFilter (T_AND brokerFilter)?;
@@ -57,6 +82,16 @@ showStorageMetaStmt  : T_SHOW T_STORAGE T_METADATA T_FROM source T_WHERE (storag
 showAliveStmt        : T_SHOW (T_ROOT | T_BROKER | T_STORAGE) T_ALIVE;
 showReplicationStmt  : T_SHOW T_REPLICATION T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
 showMemoryDatabaseStmt  : T_SHOW T_MEMORY T_DATASBAE T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
+showShardsStmt       : T_SHOW T_SHARDS T_FROM databaseName ;
+showSegmentsStmt     : T_SHOW T_SEGMENTS T_FROM databaseName T_SHARD shardID ;
+showDiskUsageStmt    : T_SHOW T_DISK T_USAGE (T_FROM databaseName)? ;
+showFileDetailStmt   : T_SHOW T_FILE T_DETAIL T_FROM databaseName T_SHARD shardID T_FAMILY familyTime T_FILE fileNumber ;
+showReplicationChannelsStmt : T_SHOW T_REPLICATION T_CHANNELS (T_FROM databaseName)? ;
+showExpiredMetricsStmt : T_SHOW T_EXPIRED T_METRICS T_FROM databaseName ;
+showLogLevelsStmt    : T_SHOW T_LOG T_LEVELS ;
+showPlacementStmt    : T_SHOW T_PLACEMENT T_SUGGESTIONS? T_FROM databaseName ;
+repairPlacementStmt  : T_REPAIR T_PLACEMENT T_FROM databaseName ;
+deleteSeriesStmt     : T_DELETE T_SERIES fromClause T_WHERE tagFilterExpr ;
 showRootMetricStmt   : T_SHOW T_ROOT T_METRIC T_WHERE metricListFilter ;
 showBrokerMetricStmt : T_SHOW T_BROKER T_METRIC T_WHERE metricListFilter ;
 showStorageMetricStmt: T_SHOW T_STORAGE T_METRIC T_WHERE (storageFilter|metricListFilter) T_AND (storageFilter|metricListFilter) ;
@@ -66,18 +101,33 @@ recoverStorageStmt   : T_RECOVER T_STORAGE storageName;
 showSchemasStmt      : T_SHOW T_SCHEMAS ;
 createDatabaseStmt   : T_CREATE T_DATASBAE json;
 dropDatabaseStmt     : T_DROP T_DATASBAE databaseName;
+alterDatabaseStmt    : T_ALTER T_DATASBAE databaseName T_SET T_WRITE T_EQUAL switchValue ;
+switchValue          : T_ON | T_OFF ;
+showReadOnlyStmt     : T_SHOW T_READONLY T_FROM storageName ;
+alterStorageReadOnlyStmt  : T_ALTER T_STORAGE storageName (T_NODE nodeID)? T_SET T_READONLY T_EQUAL switchValue ;
+alterDatabaseReadOnlyStmt : T_ALTER T_DATASBAE databaseName T_SET T_READONLY T_EQUAL switchValue ;
+nodeID               : L_INT ;
+showCompactionStmt   : T_SHOW T_COMPACTION T_JOBS? (T_FOR T_DATASBAE databaseName)? ;
+setCompactionStmt    : T_SET T_COMPACTION T_EQUAL switchValue (T_FOR T_DATASBAE databaseName)? ;
 showDatabaseStmt     : T_SHOW T_DATASBAES ;
 showNameSpacesStmt   : T_SHOW T_NAMESPACES (T_WHERE T_NAMESPACE T_EQUAL prefix)? limitClause?;
-showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQUAL prefix)? limitClause?;
-showFieldsStmt       : T_SHOW T_FIELDS fromClause;
-showTagKeysStmt      : T_SHOW T_TAG T_KEYS fromClause;
-showTagValuesStmt    : T_SHOW T_TAG T_VALUES fromClause T_WITH T_KEY T_EQUAL withTagKey whereClause? limitClause?;
+showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQUAL prefix)? fuzzyClause? limitClause?;
+showFieldsStmt       : T_SHOW T_FIELDS fromClause atTimestampClause?;
+showFieldUsageStmt   : T_SHOW T_FIELD T_USAGE fromClause sinceClause? ;
+sinceClause          : T_SINCE durationLit ;
+showTagKeysStmt      : T_SHOW T_TAG T_KEYS fromClause atTimestampClause?;
+showTagValuesStmt    : T_SHOW T_TAG T_VALUES fromClause T_WITH T_KEY T_EQUAL withTagKey whereClause? fuzzyClause? limitClause?;
 prefix               : ident ;
+fuzzyClause          : T_FUZZY prefix? ;
+atTimestampClause    : T_AT T_TIMESTAMP ident ;
 withTagKey           : ident ;
 namespace            : ident ;
 databaseName         : ident ;
 storageName          : ident ;
 requestID            : ident ;
+shardID              : L_INT ;
+familyTime           : L_INT | ident ;
+fileNumber           : L_INT ;
 source               : (T_STATE_MACHINE|T_STATE_REPO) ;
 
 //data query plan
@@ -104,24 +154,28 @@ conditionExpr           : tagFilterExpr | tagFilterExpr T_AND timeRangeExpr | ti
 tagFilterExpr           :
                          T_OPEN_P tagFilterExpr T_CLOSE_P
                         | tagKey (T_EQUAL | T_LIKE | T_NOT T_LIKE | T_REGEXP | T_NEQREGEXP | T_NOTEQUAL | T_NOTEQUAL2) tagValue
-                       | tagKey (T_IN | T_NOT T_IN) T_OPEN_P tagValueList T_CLOSE_P
+                       | tagKey (T_IN | T_NOT T_IN) T_OPEN_P (tagValueList | subQuery) T_CLOSE_P
+                       | tagKey T_IS T_NOT? (T_NULL | 'null')
                        | tagFilterExpr (T_AND | T_OR) tagFilterExpr
                        ;
 
 tagValueList           : tagValue (T_COMMA tagValue)*;
+subQuery               : showTagValuesStmt ;
 metricListFilter       : T_METRIC T_IN (T_OPEN_P metricList T_CLOSE_P) ;
 metricList             : ident (T_COMMA ident)*;
 timeRangeExpr          : timeExpr (T_AND timeExpr)? ;
 timeExpr               : T_TIME binaryOperator (nowExpr | ident) ;
 
-nowExpr                 : nowFunc  durationLit? ;
+nowExpr                 : nowFunc (durationLit | truncateDuration)* ;
+
+truncateDuration        : T_DIV durationLit ;
 
 nowFunc                 : T_NOW T_OPEN_P exprFuncParams? T_CLOSE_P ;
 
 //group by
 groupByClause          : T_GROUP T_BY groupByKeys (T_FILL T_OPEN_P fillOption T_CLOSE_P)? havingClause? ;
 groupByKeys            : groupByKey (T_COMMA groupByKey)* ;
-groupByKey             : ident | T_TIME T_OPEN_P durationLit T_CLOSE_P | T_TIME T_OPEN_P T_CLOSE_P;
+groupByKey             : ident | T_MUL | T_TIME T_OPEN_P durationLit T_CLOSE_P | T_TIME T_OPEN_P T_CLOSE_P;
 fillOption             : T_NULL | T_PREVIOUS | L_INT | L_DEC ;
 
 orderByClause          : T_ORDER T_BY sortFields ;
@@ -172,7 +226,7 @@ intervalItem            :
                          | T_YEAR
                          ;
 exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P ;
-funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE;
+funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_COUNT_DISTINCT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE;
 exprFuncParams          : funcParam (T_COMMA funcParam)* ;
 funcParam               :
                            fieldExpr
@@ -294,6 +348,7 @@ nonReservedWords      :
                         | T_MIN
                         | T_MAX
                         | T_COUNT
+                        | T_COUNT_DISTINCT
                         | T_LAST
                         | T_FIRST
                         | T_AVG
@@ -324,6 +379,34 @@ nonReservedWords      :
                         | T_REQUESTS
                         | T_REQUEST
                         | T_ID
+                        | T_SHARDS
+                        | T_SEGMENTS
+                        | T_DISK
+                        | T_USAGE
+                        | T_FILE
+                        | T_DETAIL
+                        | T_FAMILY
+                        | T_CHANNELS
+                        | T_EXPIRED
+                        | T_LEVEL
+                        | T_LEVELS
+                        | T_PLACEMENT
+                        | T_SUGGESTIONS
+                        | T_REPAIR
+                        | T_DELETE
+                        | T_SERIES
+                        | T_FORMAT
+                        | T_FUZZY
+                        | T_ALTER
+                        | T_WRITE
+                        | T_OFF
+                        | T_READONLY
+                        | T_AT
+                        | T_TIMESTAMP
+                        | T_STATUS
+                        | T_SINCE
+                        | T_COMPACTION
+                        | T_JOBS
                         ;
 
 STRING
@@ -359,6 +442,8 @@ T_CREATE             : C R E A T E                      ;
 T_UPDATE             : U P D A T E                      ;
 T_SET                : S E T                            ;
 T_DROP               : D R O P                          ;
+T_DELETE             : D E L E T E                      ;
+T_ALTER              : A L T E R                        ;
 T_INTERVAL           : I N T E R V A L                  ;
 T_INTERVAL_NAME      : N A M E                          ;
 T_SHARD              : S H A R D                        ;
@@ -372,6 +457,7 @@ T_KILL               : K I L L                          ;
 T_ON                 : O N                              ;
 T_SHOW               : S H O W                          ;
 T_RECOVER            : R E C O V E R                    ;
+T_REPAIR             : R E P A I R                      ;
 T_USE                : U S E                            ;
 T_STATE_REPO         : S T A T E T_UNDERLINE R E P O    ;
 T_STATE_MACHINE      : S T A T E T_UNDERLINE M A C H I N E;
@@ -433,15 +519,41 @@ T_NOW                : N O W                            ;
 T_IN                 : I N                              ;
 
 T_LOG                : L O G                            ;
+T_LEVELS             : L E V E L S                      ;
+T_LEVEL              : L E V E L                        ;
 T_PROFILE            : P R O F I L E                    ;
 T_REQUESTS           : R E Q U E S T S                  ;
 T_REQUEST            : R E Q U E S T                    ;
 T_ID                 : I D                              ;
+T_SHARDS             : S H A R D S                      ;
+T_SEGMENTS           : S E G M E N T S                  ;
+T_DISK               : D I S K                          ;
+T_USAGE              : U S A G E                        ;
+T_FILE               : F I L E                          ;
+T_DETAIL             : D E T A I L                      ;
+T_FAMILY             : F A M I L Y                      ;
+T_CHANNELS           : C H A N N E L S                  ;
+T_EXPIRED            : E X P I R E D                    ;
+T_PLACEMENT          : P L A C E M E N T                ;
+T_SUGGESTIONS        : S U G G E S T I O N S            ;
+T_SERIES             : S E R I E S                      ;
+T_FORMAT             : F O R M A T                      ;
+T_FUZZY              : F U Z Z Y                        ;
+T_WRITE              : W R I T E                        ;
+T_OFF                : O F F                            ;
+T_READONLY           : R E A D O N L Y                  ;
+T_AT                 : A T                              ;
+T_TIMESTAMP          : T I M E S T A M P                ;
+T_STATUS             : S T A T U S                      ;
+T_SINCE              : S I N C E                        ;
+T_COMPACTION         : C O M P A C T I O N              ;
+T_JOBS               : J O B S                          ;
 
 T_SUM                : S U M                            ;
 T_MIN                : M I N                            ;
 T_MAX                : M A X                            ;
 T_COUNT              : C O U N T                        ;
+T_COUNT_DISTINCT     : C O U N T T_UNDERLINE D I S T I N C T;
 T_LAST               : L A S T                          ;
 T_FIRST              : F I R S T                        ;
 T_AVG                : A V G                            ;
@@ -43,6 +43,12 @@ func (s *BaseSQLListener) EnterStatement(ctx *StatementContext) {}
 // ExitStatement is called when production statement is exited.
 func (s *BaseSQLListener) ExitStatement(ctx *StatementContext) {}
 
+// EnterFormatClause is called when production formatClause is entered.
+func (s *BaseSQLListener) EnterFormatClause(ctx *FormatClauseContext) {}
+
+// ExitFormatClause is called when production formatClause is exited.
+func (s *BaseSQLListener) ExitFormatClause(ctx *FormatClauseContext) {}
+
 // EnterUseStmt is called when production useStmt is entered.
 func (s *BaseSQLListener) EnterUseStmt(ctx *UseStmtContext) {}
 
@@ -55,6 +61,18 @@ func (s *BaseSQLListener) EnterSetLimitStmt(ctx *SetLimitStmtContext) {}
 // ExitSetLimitStmt is called when production setLimitStmt is exited.
 func (s *BaseSQLListener) ExitSetLimitStmt(ctx *SetLimitStmtContext) {}
 
+// EnterSetLogLevelStmt is called when production setLogLevelStmt is entered.
+func (s *BaseSQLListener) EnterSetLogLevelStmt(ctx *SetLogLevelStmtContext) {}
+
+// ExitSetLogLevelStmt is called when production setLogLevelStmt is exited.
+func (s *BaseSQLListener) ExitSetLogLevelStmt(ctx *SetLogLevelStmtContext) {}
+
+// EnterLogLevel is called when production logLevel is entered.
+func (s *BaseSQLListener) EnterLogLevel(ctx *LogLevelContext) {}
+
+// ExitLogLevel is called when production logLevel is exited.
+func (s *BaseSQLListener) ExitLogLevel(ctx *LogLevelContext) {}
+
 // EnterShowStmt is called when production showStmt is entered.
 func (s *BaseSQLListener) EnterShowStmt(ctx *ShowStmtContext) {}
 
@@ -97,6 +115,12 @@ func (s *BaseSQLListener) EnterShowLimitStmt(ctx *ShowLimitStmtContext) {}
 // ExitShowLimitStmt is called when production showLimitStmt is exited.
 func (s *BaseSQLListener) ExitShowLimitStmt(ctx *ShowLimitStmtContext) {}
 
+// EnterShowLimitStatusStmt is called when production showLimitStatusStmt is entered.
+func (s *BaseSQLListener) EnterShowLimitStatusStmt(ctx *ShowLimitStatusStmtContext) {}
+
+// ExitShowLimitStatusStmt is called when production showLimitStatusStmt is exited.
+func (s *BaseSQLListener) ExitShowLimitStatusStmt(ctx *ShowLimitStatusStmtContext) {}
+
 // EnterShowMetadataTypesStmt is called when production showMetadataTypesStmt is entered.
 func (s *BaseSQLListener) EnterShowMetadataTypesStmt(ctx *ShowMetadataTypesStmtContext) {}
 
@@ -145,6 +169,66 @@ func (s *BaseSQLListener) EnterShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStm
 // ExitShowMemoryDatabaseStmt is called when production showMemoryDatabaseStmt is exited.
 func (s *BaseSQLListener) ExitShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStmtContext) {}
 
+// EnterShowShardsStmt is called when production showShardsStmt is entered.
+func (s *BaseSQLListener) EnterShowShardsStmt(ctx *ShowShardsStmtContext) {}
+
+// ExitShowShardsStmt is called when production showShardsStmt is exited.
+func (s *BaseSQLListener) ExitShowShardsStmt(ctx *ShowShardsStmtContext) {}
+
+// EnterShowSegmentsStmt is called when production showSegmentsStmt is entered.
+func (s *BaseSQLListener) EnterShowSegmentsStmt(ctx *ShowSegmentsStmtContext) {}
+
+// ExitShowSegmentsStmt is called when production showSegmentsStmt is exited.
+func (s *BaseSQLListener) ExitShowSegmentsStmt(ctx *ShowSegmentsStmtContext) {}
+
+// EnterShowDiskUsageStmt is called when production showDiskUsageStmt is entered.
+func (s *BaseSQLListener) EnterShowDiskUsageStmt(ctx *ShowDiskUsageStmtContext) {}
+
+// ExitShowDiskUsageStmt is called when production showDiskUsageStmt is exited.
+func (s *BaseSQLListener) ExitShowDiskUsageStmt(ctx *ShowDiskUsageStmtContext) {}
+
+// EnterShowFileDetailStmt is called when production showFileDetailStmt is entered.
+func (s *BaseSQLListener) EnterShowFileDetailStmt(ctx *ShowFileDetailStmtContext) {}
+
+// ExitShowFileDetailStmt is called when production showFileDetailStmt is exited.
+func (s *BaseSQLListener) ExitShowFileDetailStmt(ctx *ShowFileDetailStmtContext) {}
+
+// EnterShowReplicationChannelsStmt is called when production showReplicationChannelsStmt is entered.
+func (s *BaseSQLListener) EnterShowReplicationChannelsStmt(ctx *ShowReplicationChannelsStmtContext) {}
+
+// ExitShowReplicationChannelsStmt is called when production showReplicationChannelsStmt is exited.
+func (s *BaseSQLListener) ExitShowReplicationChannelsStmt(ctx *ShowReplicationChannelsStmtContext) {}
+
+// EnterShowExpiredMetricsStmt is called when production showExpiredMetricsStmt is entered.
+func (s *BaseSQLListener) EnterShowExpiredMetricsStmt(ctx *ShowExpiredMetricsStmtContext) {}
+
+// ExitShowExpiredMetricsStmt is called when production showExpiredMetricsStmt is exited.
+func (s *BaseSQLListener) ExitShowExpiredMetricsStmt(ctx *ShowExpiredMetricsStmtContext) {}
+
+// EnterShowLogLevelsStmt is called when production showLogLevelsStmt is entered.
+func (s *BaseSQLListener) EnterShowLogLevelsStmt(ctx *ShowLogLevelsStmtContext) {}
+
+// ExitShowLogLevelsStmt is called when production showLogLevelsStmt is exited.
+func (s *BaseSQLListener) ExitShowLogLevelsStmt(ctx *ShowLogLevelsStmtContext) {}
+
+// EnterShowPlacementStmt is called when production showPlacementStmt is entered.
+func (s *BaseSQLListener) EnterShowPlacementStmt(ctx *ShowPlacementStmtContext) {}
+
+// ExitShowPlacementStmt is called when production showPlacementStmt is exited.
+func (s *BaseSQLListener) ExitShowPlacementStmt(ctx *ShowPlacementStmtContext) {}
+
+// EnterRepairPlacementStmt is called when production repairPlacementStmt is entered.
+func (s *BaseSQLListener) EnterRepairPlacementStmt(ctx *RepairPlacementStmtContext) {}
+
+// ExitRepairPlacementStmt is called when production repairPlacementStmt is exited.
+func (s *BaseSQLListener) ExitRepairPlacementStmt(ctx *RepairPlacementStmtContext) {}
+
+// EnterDeleteSeriesStmt is called when production deleteSeriesStmt is entered.
+func (s *BaseSQLListener) EnterDeleteSeriesStmt(ctx *DeleteSeriesStmtContext) {}
+
+// ExitDeleteSeriesStmt is called when production deleteSeriesStmt is exited.
+func (s *BaseSQLListener) ExitDeleteSeriesStmt(ctx *DeleteSeriesStmtContext) {}
+
 // EnterShowRootMetricStmt is called when production showRootMetricStmt is entered.
 func (s *BaseSQLListener) EnterShowRootMetricStmt(ctx *ShowRootMetricStmtContext) {}
 
@@ -199,6 +283,54 @@ func (s *BaseSQLListener) EnterDropDatabaseStmt(ctx *DropDatabaseStmtContext) {}
 // ExitDropDatabaseStmt is called when production dropDatabaseStmt is exited.
 func (s *BaseSQLListener) ExitDropDatabaseStmt(ctx *DropDatabaseStmtContext) {}
 
+// EnterAlterDatabaseStmt is called when production alterDatabaseStmt is entered.
+func (s *BaseSQLListener) EnterAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) {}
+
+// ExitAlterDatabaseStmt is called when production alterDatabaseStmt is exited.
+func (s *BaseSQLListener) ExitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) {}
+
+// EnterSwitchValue is called when production switchValue is entered.
+func (s *BaseSQLListener) EnterSwitchValue(ctx *SwitchValueContext) {}
+
+// ExitSwitchValue is called when production switchValue is exited.
+func (s *BaseSQLListener) ExitSwitchValue(ctx *SwitchValueContext) {}
+
+// EnterShowReadOnlyStmt is called when production showReadOnlyStmt is entered.
+func (s *BaseSQLListener) EnterShowReadOnlyStmt(ctx *ShowReadOnlyStmtContext) {}
+
+// ExitShowReadOnlyStmt is called when production showReadOnlyStmt is exited.
+func (s *BaseSQLListener) ExitShowReadOnlyStmt(ctx *ShowReadOnlyStmtContext) {}
+
+// EnterAlterStorageReadOnlyStmt is called when production alterStorageReadOnlyStmt is entered.
+func (s *BaseSQLListener) EnterAlterStorageReadOnlyStmt(ctx *AlterStorageReadOnlyStmtContext) {}
+
+// ExitAlterStorageReadOnlyStmt is called when production alterStorageReadOnlyStmt is exited.
+func (s *BaseSQLListener) ExitAlterStorageReadOnlyStmt(ctx *AlterStorageReadOnlyStmtContext) {}
+
+// EnterAlterDatabaseReadOnlyStmt is called when production alterDatabaseReadOnlyStmt is entered.
+func (s *BaseSQLListener) EnterAlterDatabaseReadOnlyStmt(ctx *AlterDatabaseReadOnlyStmtContext) {}
+
+// ExitAlterDatabaseReadOnlyStmt is called when production alterDatabaseReadOnlyStmt is exited.
+func (s *BaseSQLListener) ExitAlterDatabaseReadOnlyStmt(ctx *AlterDatabaseReadOnlyStmtContext) {}
+
+// EnterNodeID is called when production nodeID is entered.
+func (s *BaseSQLListener) EnterNodeID(ctx *NodeIDContext) {}
+
+// ExitNodeID is called when production nodeID is exited.
+func (s *BaseSQLListener) ExitNodeID(ctx *NodeIDContext) {}
+
+// EnterShowCompactionStmt is called when production showCompactionStmt is entered.
+func (s *BaseSQLListener) EnterShowCompactionStmt(ctx *ShowCompactionStmtContext) {}
+
+// ExitShowCompactionStmt is called when production showCompactionStmt is exited.
+func (s *BaseSQLListener) ExitShowCompactionStmt(ctx *ShowCompactionStmtContext) {}
+
+// EnterSetCompactionStmt is called when production setCompactionStmt is entered.
+func (s *BaseSQLListener) EnterSetCompactionStmt(ctx *SetCompactionStmtContext) {}
+
+// ExitSetCompactionStmt is called when production setCompactionStmt is exited.
+func (s *BaseSQLListener) ExitSetCompactionStmt(ctx *SetCompactionStmtContext) {}
+
 // EnterShowDatabaseStmt is called when production showDatabaseStmt is entered.
 func (s *BaseSQLListener) EnterShowDatabaseStmt(ctx *ShowDatabaseStmtContext) {}
 
@@ -223,6 +355,18 @@ func (s *BaseSQLListener) EnterShowFieldsStmt(ctx *ShowFieldsStmtContext) {}
 // ExitShowFieldsStmt is called when production showFieldsStmt is exited.
 func (s *BaseSQLListener) ExitShowFieldsStmt(ctx *ShowFieldsStmtContext) {}
 
+// EnterShowFieldUsageStmt is called when production showFieldUsageStmt is entered.
+func (s *BaseSQLListener) EnterShowFieldUsageStmt(ctx *ShowFieldUsageStmtContext) {}
+
+// ExitShowFieldUsageStmt is called when production showFieldUsageStmt is exited.
+func (s *BaseSQLListener) ExitShowFieldUsageStmt(ctx *ShowFieldUsageStmtContext) {}
+
+// EnterSinceClause is called when production sinceClause is entered.
+func (s *BaseSQLListener) EnterSinceClause(ctx *SinceClauseContext) {}
+
+// ExitSinceClause is called when production sinceClause is exited.
+func (s *BaseSQLListener) ExitSinceClause(ctx *SinceClauseContext) {}
+
 // EnterShowTagKeysStmt is called when production showTagKeysStmt is entered.
 func (s *BaseSQLListener) EnterShowTagKeysStmt(ctx *ShowTagKeysStmtContext) {}
 
@@ -241,6 +385,18 @@ func (s *BaseSQLListener) EnterPrefix(ctx *PrefixContext) {}
 // ExitPrefix is called when production prefix is exited.
 func (s *BaseSQLListener) ExitPrefix(ctx *PrefixContext) {}
 
+// EnterFuzzyClause is called when production fuzzyClause is entered.
+func (s *BaseSQLListener) EnterFuzzyClause(ctx *FuzzyClauseContext) {}
+
+// ExitFuzzyClause is called when production fuzzyClause is exited.
+func (s *BaseSQLListener) ExitFuzzyClause(ctx *FuzzyClauseContext) {}
+
+// EnterAtTimestampClause is called when production atTimestampClause is entered.
+func (s *BaseSQLListener) EnterAtTimestampClause(ctx *AtTimestampClauseContext) {}
+
+// ExitAtTimestampClause is called when production atTimestampClause is exited.
+func (s *BaseSQLListener) ExitAtTimestampClause(ctx *AtTimestampClauseContext) {}
+
 // EnterWithTagKey is called when production withTagKey is entered.
 func (s *BaseSQLListener) EnterWithTagKey(ctx *WithTagKeyContext) {}
 
@@ -271,6 +427,24 @@ func (s *BaseSQLListener) EnterRequestID(ctx *RequestIDContext) {}
 // ExitRequestID is called when production requestID is exited.
 func (s *BaseSQLListener) ExitRequestID(ctx *RequestIDContext) {}
 
+// EnterShardID is called when production shardID is entered.
+func (s *BaseSQLListener) EnterShardID(ctx *ShardIDContext) {}
+
+// ExitShardID is called when production shardID is exited.
+func (s *BaseSQLListener) ExitShardID(ctx *ShardIDContext) {}
+
+// EnterFamilyTime is called when production familyTime is entered.
+func (s *BaseSQLListener) EnterFamilyTime(ctx *FamilyTimeContext) {}
+
+// ExitFamilyTime is called when production familyTime is exited.
+func (s *BaseSQLListener) ExitFamilyTime(ctx *FamilyTimeContext) {}
+
+// EnterFileNumber is called when production fileNumber is entered.
+func (s *BaseSQLListener) EnterFileNumber(ctx *FileNumberContext) {}
+
+// ExitFileNumber is called when production fileNumber is exited.
+func (s *BaseSQLListener) ExitFileNumber(ctx *FileNumberContext) {}
+
 // EnterSource is called when production source is entered.
 func (s *BaseSQLListener) EnterSource(ctx *SourceContext) {}
 
@@ -367,6 +541,12 @@ func (s *BaseSQLListener) EnterTagValueList(ctx *TagValueListContext) {}
 // ExitTagValueList is called when production tagValueList is exited.
 func (s *BaseSQLListener) ExitTagValueList(ctx *TagValueListContext) {}
 
+// EnterSubQuery is called when production subQuery is entered.
+func (s *BaseSQLListener) EnterSubQuery(ctx *SubQueryContext) {}
+
+// ExitSubQuery is called when production subQuery is exited.
+func (s *BaseSQLListener) ExitSubQuery(ctx *SubQueryContext) {}
+
 // EnterMetricListFilter is called when production metricListFilter is entered.
 func (s *BaseSQLListener) EnterMetricListFilter(ctx *MetricListFilterContext) {}
 
@@ -397,6 +577,12 @@ func (s *BaseSQLListener) EnterNowExpr(ctx *NowExprContext) {}
 // ExitNowExpr is called when production nowExpr is exited.
 func (s *BaseSQLListener) ExitNowExpr(ctx *NowExprContext) {}
 
+// EnterTruncateDuration is called when production truncateDuration is entered.
+func (s *BaseSQLListener) EnterTruncateDuration(ctx *TruncateDurationContext) {}
+
+// ExitTruncateDuration is called when production truncateDuration is exited.
+func (s *BaseSQLListener) ExitTruncateDuration(ctx *TruncateDurationContext) {}
+
 // EnterNowFunc is called when production nowFunc is entered.
 func (s *BaseSQLListener) EnterNowFunc(ctx *NowFuncContext) {}
 
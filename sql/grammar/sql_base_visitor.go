@@ -28,6 +28,10 @@ func (v *BaseSQLVisitor) VisitStatement(ctx *StatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitFormatClause(ctx *FormatClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitUseStmt(ctx *UseStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -36,6 +40,14 @@ func (v *BaseSQLVisitor) VisitSetLimitStmt(ctx *SetLimitStmtContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitSetLogLevelStmt(ctx *SetLogLevelStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitLogLevel(ctx *LogLevelContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowStmt(ctx *ShowStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -64,6 +76,10 @@ func (v *BaseSQLVisitor) VisitShowLimitStmt(ctx *ShowLimitStmtContext) interface
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowLimitStatusStmt(ctx *ShowLimitStatusStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowMetadataTypesStmt(ctx *ShowMetadataTypesStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -96,6 +112,46 @@ func (v *BaseSQLVisitor) VisitShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStmt
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowShardsStmt(ctx *ShowShardsStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowSegmentsStmt(ctx *ShowSegmentsStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowDiskUsageStmt(ctx *ShowDiskUsageStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowFileDetailStmt(ctx *ShowFileDetailStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowReplicationChannelsStmt(ctx *ShowReplicationChannelsStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowExpiredMetricsStmt(ctx *ShowExpiredMetricsStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowLogLevelsStmt(ctx *ShowLogLevelsStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowPlacementStmt(ctx *ShowPlacementStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRepairPlacementStmt(ctx *RepairPlacementStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDeleteSeriesStmt(ctx *DeleteSeriesStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowRootMetricStmt(ctx *ShowRootMetricStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -132,6 +188,38 @@ func (v *BaseSQLVisitor) VisitDropDatabaseStmt(ctx *DropDatabaseStmtContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitSwitchValue(ctx *SwitchValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowReadOnlyStmt(ctx *ShowReadOnlyStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitAlterStorageReadOnlyStmt(ctx *AlterStorageReadOnlyStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitAlterDatabaseReadOnlyStmt(ctx *AlterDatabaseReadOnlyStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitNodeID(ctx *NodeIDContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowCompactionStmt(ctx *ShowCompactionStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitSetCompactionStmt(ctx *SetCompactionStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -148,6 +236,14 @@ func (v *BaseSQLVisitor) VisitShowFieldsStmt(ctx *ShowFieldsStmtContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowFieldUsageStmt(ctx *ShowFieldUsageStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitSinceClause(ctx *SinceClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowTagKeysStmt(ctx *ShowTagKeysStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -160,6 +256,14 @@ func (v *BaseSQLVisitor) VisitPrefix(ctx *PrefixContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitFuzzyClause(ctx *FuzzyClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitAtTimestampClause(ctx *AtTimestampClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitWithTagKey(ctx *WithTagKeyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -180,6 +284,18 @@ func (v *BaseSQLVisitor) VisitRequestID(ctx *RequestIDContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShardID(ctx *ShardIDContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitFamilyTime(ctx *FamilyTimeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitFileNumber(ctx *FileNumberContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitSource(ctx *SourceContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -244,6 +360,10 @@ func (v *BaseSQLVisitor) VisitTagValueList(ctx *TagValueListContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitSubQuery(ctx *SubQueryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitMetricListFilter(ctx *MetricListFilterContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -264,6 +384,10 @@ func (v *BaseSQLVisitor) VisitNowExpr(ctx *NowExprContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitTruncateDuration(ctx *TruncateDurationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitNowFunc(ctx *NowFuncContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -27,12 +27,21 @@ type SQLListener interface {
 	// EnterStatement is called when entering the statement production.
 	EnterStatement(c *StatementContext)
 
+	// EnterFormatClause is called when entering the formatClause production.
+	EnterFormatClause(c *FormatClauseContext)
+
 	// EnterUseStmt is called when entering the useStmt production.
 	EnterUseStmt(c *UseStmtContext)
 
 	// EnterSetLimitStmt is called when entering the setLimitStmt production.
 	EnterSetLimitStmt(c *SetLimitStmtContext)
 
+	// EnterSetLogLevelStmt is called when entering the setLogLevelStmt production.
+	EnterSetLogLevelStmt(c *SetLogLevelStmtContext)
+
+	// EnterLogLevel is called when entering the logLevel production.
+	EnterLogLevel(c *LogLevelContext)
+
 	// EnterShowStmt is called when entering the showStmt production.
 	EnterShowStmt(c *ShowStmtContext)
 
@@ -54,6 +63,9 @@ type SQLListener interface {
 	// EnterShowLimitStmt is called when entering the showLimitStmt production.
 	EnterShowLimitStmt(c *ShowLimitStmtContext)
 
+	// EnterShowLimitStatusStmt is called when entering the showLimitStatusStmt production.
+	EnterShowLimitStatusStmt(c *ShowLimitStatusStmtContext)
+
 	// EnterShowMetadataTypesStmt is called when entering the showMetadataTypesStmt production.
 	EnterShowMetadataTypesStmt(c *ShowMetadataTypesStmtContext)
 
@@ -78,6 +90,36 @@ type SQLListener interface {
 	// EnterShowMemoryDatabaseStmt is called when entering the showMemoryDatabaseStmt production.
 	EnterShowMemoryDatabaseStmt(c *ShowMemoryDatabaseStmtContext)
 
+	// EnterShowShardsStmt is called when entering the showShardsStmt production.
+	EnterShowShardsStmt(c *ShowShardsStmtContext)
+
+	// EnterShowSegmentsStmt is called when entering the showSegmentsStmt production.
+	EnterShowSegmentsStmt(c *ShowSegmentsStmtContext)
+
+	// EnterShowDiskUsageStmt is called when entering the showDiskUsageStmt production.
+	EnterShowDiskUsageStmt(c *ShowDiskUsageStmtContext)
+
+	// EnterShowFileDetailStmt is called when entering the showFileDetailStmt production.
+	EnterShowFileDetailStmt(c *ShowFileDetailStmtContext)
+
+	// EnterShowReplicationChannelsStmt is called when entering the showReplicationChannelsStmt production.
+	EnterShowReplicationChannelsStmt(c *ShowReplicationChannelsStmtContext)
+
+	// EnterShowExpiredMetricsStmt is called when entering the showExpiredMetricsStmt production.
+	EnterShowExpiredMetricsStmt(c *ShowExpiredMetricsStmtContext)
+
+	// EnterShowLogLevelsStmt is called when entering the showLogLevelsStmt production.
+	EnterShowLogLevelsStmt(c *ShowLogLevelsStmtContext)
+
+	// EnterShowPlacementStmt is called when entering the showPlacementStmt production.
+	EnterShowPlacementStmt(c *ShowPlacementStmtContext)
+
+	// EnterRepairPlacementStmt is called when entering the repairPlacementStmt production.
+	EnterRepairPlacementStmt(c *RepairPlacementStmtContext)
+
+	// EnterDeleteSeriesStmt is called when entering the deleteSeriesStmt production.
+	EnterDeleteSeriesStmt(c *DeleteSeriesStmtContext)
+
 	// EnterShowRootMetricStmt is called when entering the showRootMetricStmt production.
 	EnterShowRootMetricStmt(c *ShowRootMetricStmtContext)
 
@@ -105,6 +147,30 @@ type SQLListener interface {
 	// EnterDropDatabaseStmt is called when entering the dropDatabaseStmt production.
 	EnterDropDatabaseStmt(c *DropDatabaseStmtContext)
 
+	// EnterAlterDatabaseStmt is called when entering the alterDatabaseStmt production.
+	EnterAlterDatabaseStmt(c *AlterDatabaseStmtContext)
+
+	// EnterSwitchValue is called when entering the switchValue production.
+	EnterSwitchValue(c *SwitchValueContext)
+
+	// EnterShowReadOnlyStmt is called when entering the showReadOnlyStmt production.
+	EnterShowReadOnlyStmt(c *ShowReadOnlyStmtContext)
+
+	// EnterAlterStorageReadOnlyStmt is called when entering the alterStorageReadOnlyStmt production.
+	EnterAlterStorageReadOnlyStmt(c *AlterStorageReadOnlyStmtContext)
+
+	// EnterAlterDatabaseReadOnlyStmt is called when entering the alterDatabaseReadOnlyStmt production.
+	EnterAlterDatabaseReadOnlyStmt(c *AlterDatabaseReadOnlyStmtContext)
+
+	// EnterNodeID is called when entering the nodeID production.
+	EnterNodeID(c *NodeIDContext)
+
+	// EnterShowCompactionStmt is called when entering the showCompactionStmt production.
+	EnterShowCompactionStmt(c *ShowCompactionStmtContext)
+
+	// EnterSetCompactionStmt is called when entering the setCompactionStmt production.
+	EnterSetCompactionStmt(c *SetCompactionStmtContext)
+
 	// EnterShowDatabaseStmt is called when entering the showDatabaseStmt production.
 	EnterShowDatabaseStmt(c *ShowDatabaseStmtContext)
 
@@ -117,6 +183,12 @@ type SQLListener interface {
 	// EnterShowFieldsStmt is called when entering the showFieldsStmt production.
 	EnterShowFieldsStmt(c *ShowFieldsStmtContext)
 
+	// EnterShowFieldUsageStmt is called when entering the showFieldUsageStmt production.
+	EnterShowFieldUsageStmt(c *ShowFieldUsageStmtContext)
+
+	// EnterSinceClause is called when entering the sinceClause production.
+	EnterSinceClause(c *SinceClauseContext)
+
 	// EnterShowTagKeysStmt is called when entering the showTagKeysStmt production.
 	EnterShowTagKeysStmt(c *ShowTagKeysStmtContext)
 
@@ -126,6 +198,12 @@ type SQLListener interface {
 	// EnterPrefix is called when entering the prefix production.
 	EnterPrefix(c *PrefixContext)
 
+	// EnterFuzzyClause is called when entering the fuzzyClause production.
+	EnterFuzzyClause(c *FuzzyClauseContext)
+
+	// EnterAtTimestampClause is called when entering the atTimestampClause production.
+	EnterAtTimestampClause(c *AtTimestampClauseContext)
+
 	// EnterWithTagKey is called when entering the withTagKey production.
 	EnterWithTagKey(c *WithTagKeyContext)
 
@@ -141,6 +219,15 @@ type SQLListener interface {
 	// EnterRequestID is called when entering the requestID production.
 	EnterRequestID(c *RequestIDContext)
 
+	// EnterShardID is called when entering the shardID production.
+	EnterShardID(c *ShardIDContext)
+
+	// EnterFamilyTime is called when entering the familyTime production.
+	EnterFamilyTime(c *FamilyTimeContext)
+
+	// EnterFileNumber is called when entering the fileNumber production.
+	EnterFileNumber(c *FileNumberContext)
+
 	// EnterSource is called when entering the source production.
 	EnterSource(c *SourceContext)
 
@@ -189,6 +276,9 @@ type SQLListener interface {
 	// EnterTagValueList is called when entering the tagValueList production.
 	EnterTagValueList(c *TagValueListContext)
 
+	// EnterSubQuery is called when entering the subQuery production.
+	EnterSubQuery(c *SubQueryContext)
+
 	// EnterMetricListFilter is called when entering the metricListFilter production.
 	EnterMetricListFilter(c *MetricListFilterContext)
 
@@ -204,6 +294,9 @@ type SQLListener interface {
 	// EnterNowExpr is called when entering the nowExpr production.
 	EnterNowExpr(c *NowExprContext)
 
+	// EnterTruncateDuration is called when entering the truncateDuration production.
+	EnterTruncateDuration(c *TruncateDurationContext)
+
 	// EnterNowFunc is called when entering the nowFunc production.
 	EnterNowFunc(c *NowFuncContext)
 
@@ -321,12 +414,21 @@ type SQLListener interface {
 	// ExitStatement is called when exiting the statement production.
 	ExitStatement(c *StatementContext)
 
+	// ExitFormatClause is called when exiting the formatClause production.
+	ExitFormatClause(c *FormatClauseContext)
+
 	// ExitUseStmt is called when exiting the useStmt production.
 	ExitUseStmt(c *UseStmtContext)
 
 	// ExitSetLimitStmt is called when exiting the setLimitStmt production.
 	ExitSetLimitStmt(c *SetLimitStmtContext)
 
+	// ExitSetLogLevelStmt is called when exiting the setLogLevelStmt production.
+	ExitSetLogLevelStmt(c *SetLogLevelStmtContext)
+
+	// ExitLogLevel is called when exiting the logLevel production.
+	ExitLogLevel(c *LogLevelContext)
+
 	// ExitShowStmt is called when exiting the showStmt production.
 	ExitShowStmt(c *ShowStmtContext)
 
@@ -348,6 +450,9 @@ type SQLListener interface {
 	// ExitShowLimitStmt is called when exiting the showLimitStmt production.
 	ExitShowLimitStmt(c *ShowLimitStmtContext)
 
+	// ExitShowLimitStatusStmt is called when exiting the showLimitStatusStmt production.
+	ExitShowLimitStatusStmt(c *ShowLimitStatusStmtContext)
+
 	// ExitShowMetadataTypesStmt is called when exiting the showMetadataTypesStmt production.
 	ExitShowMetadataTypesStmt(c *ShowMetadataTypesStmtContext)
 
@@ -372,6 +477,36 @@ type SQLListener interface {
 	// ExitShowMemoryDatabaseStmt is called when exiting the showMemoryDatabaseStmt production.
 	ExitShowMemoryDatabaseStmt(c *ShowMemoryDatabaseStmtContext)
 
+	// ExitShowShardsStmt is called when exiting the showShardsStmt production.
+	ExitShowShardsStmt(c *ShowShardsStmtContext)
+
+	// ExitShowSegmentsStmt is called when exiting the showSegmentsStmt production.
+	ExitShowSegmentsStmt(c *ShowSegmentsStmtContext)
+
+	// ExitShowDiskUsageStmt is called when exiting the showDiskUsageStmt production.
+	ExitShowDiskUsageStmt(c *ShowDiskUsageStmtContext)
+
+	// ExitShowFileDetailStmt is called when exiting the showFileDetailStmt production.
+	ExitShowFileDetailStmt(c *ShowFileDetailStmtContext)
+
+	// ExitShowReplicationChannelsStmt is called when exiting the showReplicationChannelsStmt production.
+	ExitShowReplicationChannelsStmt(c *ShowReplicationChannelsStmtContext)
+
+	// ExitShowExpiredMetricsStmt is called when exiting the showExpiredMetricsStmt production.
+	ExitShowExpiredMetricsStmt(c *ShowExpiredMetricsStmtContext)
+
+	// ExitShowLogLevelsStmt is called when exiting the showLogLevelsStmt production.
+	ExitShowLogLevelsStmt(c *ShowLogLevelsStmtContext)
+
+	// ExitShowPlacementStmt is called when exiting the showPlacementStmt production.
+	ExitShowPlacementStmt(c *ShowPlacementStmtContext)
+
+	// ExitRepairPlacementStmt is called when exiting the repairPlacementStmt production.
+	ExitRepairPlacementStmt(c *RepairPlacementStmtContext)
+
+	// ExitDeleteSeriesStmt is called when exiting the deleteSeriesStmt production.
+	ExitDeleteSeriesStmt(c *DeleteSeriesStmtContext)
+
 	// ExitShowRootMetricStmt is called when exiting the showRootMetricStmt production.
 	ExitShowRootMetricStmt(c *ShowRootMetricStmtContext)
 
@@ -399,6 +534,30 @@ type SQLListener interface {
 	// ExitDropDatabaseStmt is called when exiting the dropDatabaseStmt production.
 	ExitDropDatabaseStmt(c *DropDatabaseStmtContext)
 
+	// ExitAlterDatabaseStmt is called when exiting the alterDatabaseStmt production.
+	ExitAlterDatabaseStmt(c *AlterDatabaseStmtContext)
+
+	// ExitSwitchValue is called when exiting the switchValue production.
+	ExitSwitchValue(c *SwitchValueContext)
+
+	// ExitShowReadOnlyStmt is called when exiting the showReadOnlyStmt production.
+	ExitShowReadOnlyStmt(c *ShowReadOnlyStmtContext)
+
+	// ExitAlterStorageReadOnlyStmt is called when exiting the alterStorageReadOnlyStmt production.
+	ExitAlterStorageReadOnlyStmt(c *AlterStorageReadOnlyStmtContext)
+
+	// ExitAlterDatabaseReadOnlyStmt is called when exiting the alterDatabaseReadOnlyStmt production.
+	ExitAlterDatabaseReadOnlyStmt(c *AlterDatabaseReadOnlyStmtContext)
+
+	// ExitNodeID is called when exiting the nodeID production.
+	ExitNodeID(c *NodeIDContext)
+
+	// ExitShowCompactionStmt is called when exiting the showCompactionStmt production.
+	ExitShowCompactionStmt(c *ShowCompactionStmtContext)
+
+	// ExitSetCompactionStmt is called when exiting the setCompactionStmt production.
+	ExitSetCompactionStmt(c *SetCompactionStmtContext)
+
 	// ExitShowDatabaseStmt is called when exiting the showDatabaseStmt production.
 	ExitShowDatabaseStmt(c *ShowDatabaseStmtContext)
 
@@ -411,6 +570,12 @@ type SQLListener interface {
 	// ExitShowFieldsStmt is called when exiting the showFieldsStmt production.
 	ExitShowFieldsStmt(c *ShowFieldsStmtContext)
 
+	// ExitShowFieldUsageStmt is called when exiting the showFieldUsageStmt production.
+	ExitShowFieldUsageStmt(c *ShowFieldUsageStmtContext)
+
+	// ExitSinceClause is called when exiting the sinceClause production.
+	ExitSinceClause(c *SinceClauseContext)
+
 	// ExitShowTagKeysStmt is called when exiting the showTagKeysStmt production.
 	ExitShowTagKeysStmt(c *ShowTagKeysStmtContext)
 
@@ -420,6 +585,12 @@ type SQLListener interface {
 	// ExitPrefix is called when exiting the prefix production.
 	ExitPrefix(c *PrefixContext)
 
+	// ExitFuzzyClause is called when exiting the fuzzyClause production.
+	ExitFuzzyClause(c *FuzzyClauseContext)
+
+	// ExitAtTimestampClause is called when exiting the atTimestampClause production.
+	ExitAtTimestampClause(c *AtTimestampClauseContext)
+
 	// ExitWithTagKey is called when exiting the withTagKey production.
 	ExitWithTagKey(c *WithTagKeyContext)
 
@@ -435,6 +606,15 @@ type SQLListener interface {
 	// ExitRequestID is called when exiting the requestID production.
 	ExitRequestID(c *RequestIDContext)
 
+	// ExitShardID is called when exiting the shardID production.
+	ExitShardID(c *ShardIDContext)
+
+	// ExitFamilyTime is called when exiting the familyTime production.
+	ExitFamilyTime(c *FamilyTimeContext)
+
+	// ExitFileNumber is called when exiting the fileNumber production.
+	ExitFileNumber(c *FileNumberContext)
+
 	// ExitSource is called when exiting the source production.
 	ExitSource(c *SourceContext)
 
@@ -483,6 +663,9 @@ type SQLListener interface {
 	// ExitTagValueList is called when exiting the tagValueList production.
 	ExitTagValueList(c *TagValueListContext)
 
+	// ExitSubQuery is called when exiting the subQuery production.
+	ExitSubQuery(c *SubQueryContext)
+
 	// ExitMetricListFilter is called when exiting the metricListFilter production.
 	ExitMetricListFilter(c *MetricListFilterContext)
 
@@ -498,6 +681,9 @@ type SQLListener interface {
 	// ExitNowExpr is called when exiting the nowExpr production.
 	ExitNowExpr(c *NowExprContext)
 
+	// ExitTruncateDuration is called when exiting the truncateDuration production.
+	ExitTruncateDuration(c *TruncateDurationContext)
+
 	// ExitNowFunc is called when exiting the nowFunc production.
 	ExitNowFunc(c *NowFuncContext)
 
@@ -49,442 +49,592 @@ var sqlParserStaticData struct {
 func sqlParserInit() {
 	staticData := &sqlParserStaticData
 	staticData.literalNames = []string{
-		"", "'true'", "'false'", "'null'", "", "", "", "", "", "", "", "", "",
+		"", "'null'", "'true'", "'false'", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"'m'", "", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'",
-		"'>='", "'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'",
-		"'('", "')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "'m'", "", "", "", "'M'",
+		"", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'", "'<='",
+		"'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'", "'+'",
+		"'-'", "'/'", "'*'", "'%'", "'_'",
 	}
 	staticData.symbolicNames = []string{
 		"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
-		"T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_MEMORY",
-		"T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON",
-		"T_SHOW", "T_RECOVER", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER",
-		"T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES", "T_STORAGE", "T_BROKER",
-		"T_ROOT", "T_BROKERS", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES",
-		"T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD",
-		"T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH", "T_VALUES",
-		"T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN",
-		"T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL",
-		"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
-		"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME",
-		"T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID",
-		"T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG",
-		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR",
-		"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
-		"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS",
-		"T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B",
-		"T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB",
-		"T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
+		"T_DELETE", "T_ALTER", "T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION",
+		"T_MEMORY", "T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL",
+		"T_ON", "T_SHOW", "T_RECOVER", "T_REPAIR", "T_USE", "T_STATE_REPO",
+		"T_STATE_MACHINE", "T_MASTER", "T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES",
+		"T_STORAGE", "T_BROKER", "T_ROOT", "T_BROKERS", "T_ALIVE", "T_SCHEMAS",
+		"T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", "T_NODE",
+		"T_METRICS", "T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", "T_KEYS",
+		"T_KEY", "T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT",
+		"T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", "T_AS",
+		"T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", "T_ORDER", "T_ASC",
+		"T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", "T_GROUP", "T_HAVING",
+		"T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", "T_IN", "T_LOG", "T_LEVELS",
+		"T_LEVEL", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID", "T_SHARDS",
+		"T_SEGMENTS", "T_DISK", "T_USAGE", "T_FILE", "T_DETAIL", "T_FAMILY",
+		"T_CHANNELS", "T_EXPIRED", "T_PLACEMENT", "T_SUGGESTIONS", "T_SERIES",
+		"T_FORMAT", "T_FUZZY", "T_WRITE", "T_OFF", "T_READONLY", "T_AT", "T_TIMESTAMP",
+		"T_STATUS", "T_SINCE", "T_COMPACTION", "T_JOBS", "T_SUM", "T_MIN", "T_MAX",
+		"T_COUNT", "T_COUNT_DISTINCT", "T_LAST", "T_FIRST", "T_AVG", "T_STDDEV",
+		"T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK",
+		"T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2",
+		"T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP",
+		"T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB",
+		"T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD",
+		"T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
 	}
 	staticData.ruleNames = []string{
-		"statement", "useStmt", "setLimitStmt", "showStmt", "showMasterStmt",
-		"showRequestsStmt", "showRequestStmt", "showStoragesStmt", "showBrokersStmt",
-		"showLimitStmt", "showMetadataTypesStmt", "showRootMetaStmt", "showBrokerMetaStmt",
-		"showMasterMetaStmt", "showStorageMetaStmt", "showAliveStmt", "showReplicationStmt",
-		"showMemoryDatabaseStmt", "showRootMetricStmt", "showBrokerMetricStmt",
-		"showStorageMetricStmt", "createStorageStmt", "createBrokerStmt", "recoverStorageStmt",
-		"showSchemasStmt", "createDatabaseStmt", "dropDatabaseStmt", "showDatabaseStmt",
-		"showNameSpacesStmt", "showMetricsStmt", "showFieldsStmt", "showTagKeysStmt",
-		"showTagValuesStmt", "prefix", "withTagKey", "namespace", "databaseName",
-		"storageName", "requestID", "source", "queryStmt", "sourceAndSelect",
+		"statement", "formatClause", "useStmt", "setLimitStmt", "setLogLevelStmt",
+		"logLevel", "showStmt", "showMasterStmt", "showRequestsStmt", "showRequestStmt",
+		"showStoragesStmt", "showBrokersStmt", "showLimitStmt", "showLimitStatusStmt",
+		"showMetadataTypesStmt", "showRootMetaStmt", "showBrokerMetaStmt", "showMasterMetaStmt",
+		"showStorageMetaStmt", "showAliveStmt", "showReplicationStmt", "showMemoryDatabaseStmt",
+		"showShardsStmt", "showSegmentsStmt", "showDiskUsageStmt", "showFileDetailStmt",
+		"showReplicationChannelsStmt", "showExpiredMetricsStmt", "showLogLevelsStmt",
+		"showPlacementStmt", "repairPlacementStmt", "deleteSeriesStmt", "showRootMetricStmt",
+		"showBrokerMetricStmt", "showStorageMetricStmt", "createStorageStmt",
+		"createBrokerStmt", "recoverStorageStmt", "showSchemasStmt", "createDatabaseStmt",
+		"dropDatabaseStmt", "alterDatabaseStmt", "switchValue", "showReadOnlyStmt",
+		"alterStorageReadOnlyStmt", "alterDatabaseReadOnlyStmt", "nodeID", "showCompactionStmt",
+		"setCompactionStmt", "showDatabaseStmt", "showNameSpacesStmt", "showMetricsStmt",
+		"showFieldsStmt", "showFieldUsageStmt", "sinceClause", "showTagKeysStmt",
+		"showTagValuesStmt", "prefix", "fuzzyClause", "atTimestampClause", "withTagKey",
+		"namespace", "databaseName", "storageName", "requestID", "shardID",
+		"familyTime", "fileNumber", "source", "queryStmt", "sourceAndSelect",
 		"selectExpr", "fields", "field", "alias", "storageFilter", "brokerFilter",
 		"databaseFilter", "typeFilter", "fromClause", "whereClause", "conditionExpr",
-		"tagFilterExpr", "tagValueList", "metricListFilter", "metricList", "timeRangeExpr",
-		"timeExpr", "nowExpr", "nowFunc", "groupByClause", "groupByKeys", "groupByKey",
-		"fillOption", "orderByClause", "sortField", "sortFields", "havingClause",
-		"boolExpr", "boolExprLogicalOp", "boolExprAtom", "binaryExpr", "binaryOperator",
-		"fieldExpr", "star", "durationLit", "intervalItem", "exprFunc", "funcName",
-		"exprFuncParams", "funcParam", "exprAtom", "identFilter", "json", "toml",
-		"obj", "pair", "arr", "value", "intNumber", "decNumber", "limitClause",
-		"metricName", "tagKey", "tagValue", "ident", "nonReservedWords",
+		"tagFilterExpr", "tagValueList", "subQuery", "metricListFilter", "metricList",
+		"timeRangeExpr", "timeExpr", "nowExpr", "truncateDuration", "nowFunc",
+		"groupByClause", "groupByKeys", "groupByKey", "fillOption", "orderByClause",
+		"sortField", "sortFields", "havingClause", "boolExpr", "boolExprLogicalOp",
+		"boolExprAtom", "binaryExpr", "binaryOperator", "fieldExpr", "star",
+		"durationLit", "intervalItem", "exprFunc", "funcName", "exprFuncParams",
+		"funcParam", "exprAtom", "identFilter", "json", "toml", "obj", "pair",
+		"arr", "value", "intNumber", "decNumber", "limitClause", "metricName",
+		"tagKey", "tagValue", "ident", "nonReservedWords",
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 130, 861, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
-		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
-		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
-		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
-		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
-		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
-		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
-		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
-		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
-		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
-		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
-		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7,
-		94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 209, 8, 0, 1, 1, 1,
-		1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 242, 8, 3, 1, 4, 1, 4, 1, 4, 1,
-		5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1,
-		7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11,
-		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1,
-		12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 287, 8, 12, 1, 13, 1, 13,
-		1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1,
-		14, 1, 14, 1, 14, 1, 14, 3, 14, 305, 8, 14, 1, 14, 1, 14, 1, 14, 3, 14,
-		310, 8, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1,
-		16, 3, 16, 321, 8, 16, 1, 16, 1, 16, 1, 16, 3, 16, 326, 8, 16, 1, 17, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 17, 3, 17, 334, 8, 17, 1, 17, 1, 17, 1, 17,
-		3, 17, 339, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20,
-		3, 20, 359, 8, 20, 1, 20, 1, 20, 1, 20, 3, 20, 364, 8, 20, 1, 21, 1, 21,
-		1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1,
-		24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26,
-		1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 398,
-		8, 28, 1, 28, 3, 28, 401, 8, 28, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 407,
-		8, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 413, 8, 29, 1, 29, 3, 29, 416,
-		8, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1,
-		32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 3, 32, 436,
-		8, 32, 1, 32, 3, 32, 439, 8, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1,
-		35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39, 1, 39, 1, 40, 3, 40,
-		456, 8, 40, 1, 40, 1, 40, 3, 40, 460, 8, 40, 1, 40, 3, 40, 463, 8, 40,
-		1, 40, 3, 40, 466, 8, 40, 1, 40, 3, 40, 469, 8, 40, 1, 40, 3, 40, 472,
-		8, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 480, 8, 41, 1,
-		42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 5, 43, 488, 8, 43, 10, 43, 12, 43,
-		491, 9, 43, 1, 44, 1, 44, 3, 44, 495, 8, 44, 1, 45, 1, 45, 1, 45, 1, 46,
-		1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1,
-		48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 3, 50, 520,
-		8, 50, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 3, 52, 533, 8, 52, 3, 52, 535, 8, 52, 1, 53, 1, 53, 1, 53, 1,
-		53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53,
-		3, 53, 551, 8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 559,
-		8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 565, 8, 53, 1, 53, 1, 53, 1,
-		53, 5, 53, 570, 8, 53, 10, 53, 12, 53, 573, 9, 53, 1, 54, 1, 54, 1, 54,
-		5, 54, 578, 8, 54, 10, 54, 12, 54, 581, 9, 54, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 5, 56, 592, 8, 56, 10, 56, 12, 56,
-		595, 9, 56, 1, 57, 1, 57, 1, 57, 3, 57, 600, 8, 57, 1, 58, 1, 58, 1, 58,
-		1, 58, 3, 58, 606, 8, 58, 1, 59, 1, 59, 3, 59, 610, 8, 59, 1, 60, 1, 60,
-		1, 60, 3, 60, 615, 8, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1,
-		61, 1, 61, 1, 61, 1, 61, 3, 61, 627, 8, 61, 1, 61, 3, 61, 630, 8, 61, 1,
-		62, 1, 62, 1, 62, 5, 62, 635, 8, 62, 10, 62, 12, 62, 638, 9, 62, 1, 63,
-		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 649, 8,
-		63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 5, 66, 659,
-		8, 66, 10, 66, 12, 66, 662, 9, 66, 1, 67, 1, 67, 1, 67, 5, 67, 667, 8,
-		67, 10, 67, 12, 67, 670, 9, 67, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69,
-		1, 69, 1, 69, 1, 69, 3, 69, 681, 8, 69, 1, 69, 1, 69, 1, 69, 1, 69, 5,
-		69, 687, 8, 69, 10, 69, 12, 69, 690, 9, 69, 1, 70, 1, 70, 1, 71, 1, 71,
-		1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1,
-		73, 1, 73, 3, 73, 708, 8, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74,
-		1, 74, 1, 74, 1, 74, 3, 74, 719, 8, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1,
-		74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 5, 74, 733, 8, 74,
-		10, 74, 12, 74, 736, 9, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 77, 1,
-		77, 1, 78, 1, 78, 1, 78, 3, 78, 748, 8, 78, 1, 78, 1, 78, 1, 79, 1, 79,
-		1, 80, 1, 80, 1, 80, 5, 80, 757, 8, 80, 10, 80, 12, 80, 760, 9, 80, 1,
-		81, 1, 81, 3, 81, 764, 8, 81, 1, 82, 1, 82, 3, 82, 768, 8, 82, 1, 82, 1,
-		82, 3, 82, 772, 8, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 85,
-		1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 5, 86, 786, 8, 86, 10, 86, 12, 86, 789,
-		9, 86, 1, 86, 1, 86, 1, 86, 1, 86, 3, 86, 795, 8, 86, 1, 87, 1, 87, 1,
-		87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 5, 88, 805, 8, 88, 10, 88, 12, 88,
-		808, 9, 88, 1, 88, 1, 88, 1, 88, 1, 88, 3, 88, 814, 8, 88, 1, 89, 1, 89,
-		1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 3, 89, 824, 8, 89, 1, 90, 3,
-		90, 827, 8, 90, 1, 90, 1, 90, 1, 91, 3, 91, 832, 8, 91, 1, 91, 1, 91, 1,
-		92, 1, 92, 1, 92, 1, 93, 1, 93, 1, 94, 1, 94, 1, 95, 1, 95, 1, 96, 1, 96,
-		3, 96, 847, 8, 96, 1, 96, 1, 96, 1, 96, 3, 96, 852, 8, 96, 5, 96, 854,
-		8, 96, 10, 96, 12, 96, 857, 9, 96, 1, 97, 1, 97, 1, 97, 0, 3, 106, 138,
-		148, 98, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32,
-		34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68,
-		70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104,
-		106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134,
-		136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164,
-		166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194,
-		0, 10, 1, 0, 31, 33, 1, 0, 24, 25, 1, 0, 62, 63, 2, 0, 65, 66, 129, 130,
-		1, 0, 68, 69, 2, 0, 70, 70, 113, 113, 1, 0, 97, 103, 1, 0, 87, 96, 1, 0,
-		122, 123, 2, 0, 6, 21, 23, 103, 885, 0, 208, 1, 0, 0, 0, 2, 210, 1, 0,
-		0, 0, 4, 213, 1, 0, 0, 0, 6, 241, 1, 0, 0, 0, 8, 243, 1, 0, 0, 0, 10, 246,
-		1, 0, 0, 0, 12, 249, 1, 0, 0, 0, 14, 256, 1, 0, 0, 0, 16, 259, 1, 0, 0,
-		0, 18, 262, 1, 0, 0, 0, 20, 265, 1, 0, 0, 0, 22, 269, 1, 0, 0, 0, 24, 277,
-		1, 0, 0, 0, 26, 288, 1, 0, 0, 0, 28, 296, 1, 0, 0, 0, 30, 311, 1, 0, 0,
-		0, 32, 315, 1, 0, 0, 0, 34, 327, 1, 0, 0, 0, 36, 340, 1, 0, 0, 0, 38, 346,
-		1, 0, 0, 0, 40, 352, 1, 0, 0, 0, 42, 365, 1, 0, 0, 0, 44, 369, 1, 0, 0,
-		0, 46, 373, 1, 0, 0, 0, 48, 377, 1, 0, 0, 0, 50, 380, 1, 0, 0, 0, 52, 384,
-		1, 0, 0, 0, 54, 388, 1, 0, 0, 0, 56, 391, 1, 0, 0, 0, 58, 402, 1, 0, 0,
-		0, 60, 417, 1, 0, 0, 0, 62, 421, 1, 0, 0, 0, 64, 426, 1, 0, 0, 0, 66, 440,
-		1, 0, 0, 0, 68, 442, 1, 0, 0, 0, 70, 444, 1, 0, 0, 0, 72, 446, 1, 0, 0,
-		0, 74, 448, 1, 0, 0, 0, 76, 450, 1, 0, 0, 0, 78, 452, 1, 0, 0, 0, 80, 455,
-		1, 0, 0, 0, 82, 479, 1, 0, 0, 0, 84, 481, 1, 0, 0, 0, 86, 484, 1, 0, 0,
-		0, 88, 492, 1, 0, 0, 0, 90, 496, 1, 0, 0, 0, 92, 499, 1, 0, 0, 0, 94, 503,
-		1, 0, 0, 0, 96, 507, 1, 0, 0, 0, 98, 511, 1, 0, 0, 0, 100, 515, 1, 0, 0,
-		0, 102, 521, 1, 0, 0, 0, 104, 534, 1, 0, 0, 0, 106, 564, 1, 0, 0, 0, 108,
-		574, 1, 0, 0, 0, 110, 582, 1, 0, 0, 0, 112, 588, 1, 0, 0, 0, 114, 596,
-		1, 0, 0, 0, 116, 601, 1, 0, 0, 0, 118, 607, 1, 0, 0, 0, 120, 611, 1, 0,
-		0, 0, 122, 618, 1, 0, 0, 0, 124, 631, 1, 0, 0, 0, 126, 648, 1, 0, 0, 0,
-		128, 650, 1, 0, 0, 0, 130, 652, 1, 0, 0, 0, 132, 656, 1, 0, 0, 0, 134,
-		663, 1, 0, 0, 0, 136, 671, 1, 0, 0, 0, 138, 680, 1, 0, 0, 0, 140, 691,
-		1, 0, 0, 0, 142, 693, 1, 0, 0, 0, 144, 695, 1, 0, 0, 0, 146, 707, 1, 0,
-		0, 0, 148, 718, 1, 0, 0, 0, 150, 737, 1, 0, 0, 0, 152, 739, 1, 0, 0, 0,
-		154, 742, 1, 0, 0, 0, 156, 744, 1, 0, 0, 0, 158, 751, 1, 0, 0, 0, 160,
-		753, 1, 0, 0, 0, 162, 763, 1, 0, 0, 0, 164, 771, 1, 0, 0, 0, 166, 773,
-		1, 0, 0, 0, 168, 777, 1, 0, 0, 0, 170, 779, 1, 0, 0, 0, 172, 794, 1, 0,
-		0, 0, 174, 796, 1, 0, 0, 0, 176, 813, 1, 0, 0, 0, 178, 823, 1, 0, 0, 0,
-		180, 826, 1, 0, 0, 0, 182, 831, 1, 0, 0, 0, 184, 835, 1, 0, 0, 0, 186,
-		838, 1, 0, 0, 0, 188, 840, 1, 0, 0, 0, 190, 842, 1, 0, 0, 0, 192, 846,
-		1, 0, 0, 0, 194, 858, 1, 0, 0, 0, 196, 209, 3, 6, 3, 0, 197, 209, 3, 42,
-		21, 0, 198, 209, 3, 44, 22, 0, 199, 209, 3, 46, 23, 0, 200, 209, 3, 2,
-		1, 0, 201, 209, 3, 80, 40, 0, 202, 209, 3, 50, 25, 0, 203, 209, 3, 52,
-		26, 0, 204, 209, 3, 4, 2, 0, 205, 206, 3, 192, 96, 0, 206, 207, 5, 0, 0,
-		1, 207, 209, 1, 0, 0, 0, 208, 196, 1, 0, 0, 0, 208, 197, 1, 0, 0, 0, 208,
-		198, 1, 0, 0, 0, 208, 199, 1, 0, 0, 0, 208, 200, 1, 0, 0, 0, 208, 201,
-		1, 0, 0, 0, 208, 202, 1, 0, 0, 0, 208, 203, 1, 0, 0, 0, 208, 204, 1, 0,
-		0, 0, 208, 205, 1, 0, 0, 0, 209, 1, 1, 0, 0, 0, 210, 211, 5, 23, 0, 0,
-		211, 212, 3, 192, 96, 0, 212, 3, 1, 0, 0, 0, 213, 214, 5, 8, 0, 0, 214,
-		215, 5, 55, 0, 0, 215, 216, 3, 170, 85, 0, 216, 5, 1, 0, 0, 0, 217, 242,
-		3, 8, 4, 0, 218, 242, 3, 20, 10, 0, 219, 242, 3, 22, 11, 0, 220, 242, 3,
-		24, 12, 0, 221, 242, 3, 26, 13, 0, 222, 242, 3, 28, 14, 0, 223, 242, 3,
-		14, 7, 0, 224, 242, 3, 16, 8, 0, 225, 242, 3, 18, 9, 0, 226, 242, 3, 30,
-		15, 0, 227, 242, 3, 36, 18, 0, 228, 242, 3, 38, 19, 0, 229, 242, 3, 40,
-		20, 0, 230, 242, 3, 32, 16, 0, 231, 242, 3, 34, 17, 0, 232, 242, 3, 48,
-		24, 0, 233, 242, 3, 54, 27, 0, 234, 242, 3, 56, 28, 0, 235, 242, 3, 58,
-		29, 0, 236, 242, 3, 60, 30, 0, 237, 242, 3, 62, 31, 0, 238, 242, 3, 64,
-		32, 0, 239, 242, 3, 10, 5, 0, 240, 242, 3, 12, 6, 0, 241, 217, 1, 0, 0,
-		0, 241, 218, 1, 0, 0, 0, 241, 219, 1, 0, 0, 0, 241, 220, 1, 0, 0, 0, 241,
-		221, 1, 0, 0, 0, 241, 222, 1, 0, 0, 0, 241, 223, 1, 0, 0, 0, 241, 224,
-		1, 0, 0, 0, 241, 225, 1, 0, 0, 0, 241, 226, 1, 0, 0, 0, 241, 227, 1, 0,
-		0, 0, 241, 228, 1, 0, 0, 0, 241, 229, 1, 0, 0, 0, 241, 230, 1, 0, 0, 0,
-		241, 231, 1, 0, 0, 0, 241, 232, 1, 0, 0, 0, 241, 233, 1, 0, 0, 0, 241,
-		234, 1, 0, 0, 0, 241, 235, 1, 0, 0, 0, 241, 236, 1, 0, 0, 0, 241, 237,
-		1, 0, 0, 0, 241, 238, 1, 0, 0, 0, 241, 239, 1, 0, 0, 0, 241, 240, 1, 0,
-		0, 0, 242, 7, 1, 0, 0, 0, 243, 244, 5, 21, 0, 0, 244, 245, 5, 26, 0, 0,
-		245, 9, 1, 0, 0, 0, 246, 247, 5, 21, 0, 0, 247, 248, 5, 84, 0, 0, 248,
-		11, 1, 0, 0, 0, 249, 250, 5, 21, 0, 0, 250, 251, 5, 85, 0, 0, 251, 252,
-		5, 54, 0, 0, 252, 253, 5, 86, 0, 0, 253, 254, 5, 106, 0, 0, 254, 255, 3,
-		76, 38, 0, 255, 13, 1, 0, 0, 0, 256, 257, 5, 21, 0, 0, 257, 258, 5, 30,
-		0, 0, 258, 15, 1, 0, 0, 0, 259, 260, 5, 21, 0, 0, 260, 261, 5, 34, 0, 0,
-		261, 17, 1, 0, 0, 0, 262, 263, 5, 21, 0, 0, 263, 264, 5, 55, 0, 0, 264,
-		19, 1, 0, 0, 0, 265, 266, 5, 21, 0, 0, 266, 267, 5, 27, 0, 0, 267, 268,
-		5, 28, 0, 0, 268, 21, 1, 0, 0, 0, 269, 270, 5, 21, 0, 0, 270, 271, 5, 33,
-		0, 0, 271, 272, 5, 27, 0, 0, 272, 273, 5, 53, 0, 0, 273, 274, 3, 78, 39,
-		0, 274, 275, 5, 54, 0, 0, 275, 276, 3, 98, 49, 0, 276, 23, 1, 0, 0, 0,
-		277, 278, 5, 21, 0, 0, 278, 279, 5, 32, 0, 0, 279, 280, 5, 27, 0, 0, 280,
-		281, 5, 53, 0, 0, 281, 282, 3, 78, 39, 0, 282, 283, 5, 54, 0, 0, 283, 286,
-		3, 98, 49, 0, 284, 285, 5, 62, 0, 0, 285, 287, 3, 94, 47, 0, 286, 284,
-		1, 0, 0, 0, 286, 287, 1, 0, 0, 0, 287, 25, 1, 0, 0, 0, 288, 289, 5, 21,
-		0, 0, 289, 290, 5, 26, 0, 0, 290, 291, 5, 27, 0, 0, 291, 292, 5, 53, 0,
-		0, 292, 293, 3, 78, 39, 0, 293, 294, 5, 54, 0, 0, 294, 295, 3, 98, 49,
-		0, 295, 27, 1, 0, 0, 0, 296, 297, 5, 21, 0, 0, 297, 298, 5, 31, 0, 0, 298,
-		299, 5, 27, 0, 0, 299, 300, 5, 53, 0, 0, 300, 301, 3, 78, 39, 0, 301, 304,
-		5, 54, 0, 0, 302, 305, 3, 92, 46, 0, 303, 305, 3, 98, 49, 0, 304, 302,
-		1, 0, 0, 0, 304, 303, 1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 309, 5, 62,
-		0, 0, 307, 310, 3, 92, 46, 0, 308, 310, 3, 98, 49, 0, 309, 307, 1, 0, 0,
-		0, 309, 308, 1, 0, 0, 0, 310, 29, 1, 0, 0, 0, 311, 312, 5, 21, 0, 0, 312,
-		313, 7, 0, 0, 0, 313, 314, 5, 35, 0, 0, 314, 31, 1, 0, 0, 0, 315, 316,
-		5, 21, 0, 0, 316, 317, 5, 13, 0, 0, 317, 320, 5, 54, 0, 0, 318, 321, 3,
-		92, 46, 0, 319, 321, 3, 96, 48, 0, 320, 318, 1, 0, 0, 0, 320, 319, 1, 0,
-		0, 0, 321, 322, 1, 0, 0, 0, 322, 325, 5, 62, 0, 0, 323, 326, 3, 92, 46,
-		0, 324, 326, 3, 96, 48, 0, 325, 323, 1, 0, 0, 0, 325, 324, 1, 0, 0, 0,
-		326, 33, 1, 0, 0, 0, 327, 328, 5, 21, 0, 0, 328, 329, 5, 14, 0, 0, 329,
-		330, 5, 37, 0, 0, 330, 333, 5, 54, 0, 0, 331, 334, 3, 92, 46, 0, 332, 334,
-		3, 96, 48, 0, 333, 331, 1, 0, 0, 0, 333, 332, 1, 0, 0, 0, 334, 335, 1,
-		0, 0, 0, 335, 338, 5, 62, 0, 0, 336, 339, 3, 92, 46, 0, 337, 339, 3, 96,
-		48, 0, 338, 336, 1, 0, 0, 0, 338, 337, 1, 0, 0, 0, 339, 35, 1, 0, 0, 0,
-		340, 341, 5, 21, 0, 0, 341, 342, 5, 33, 0, 0, 342, 343, 5, 43, 0, 0, 343,
-		344, 5, 54, 0, 0, 344, 345, 3, 110, 55, 0, 345, 37, 1, 0, 0, 0, 346, 347,
-		5, 21, 0, 0, 347, 348, 5, 32, 0, 0, 348, 349, 5, 43, 0, 0, 349, 350, 5,
-		54, 0, 0, 350, 351, 3, 110, 55, 0, 351, 39, 1, 0, 0, 0, 352, 353, 5, 21,
-		0, 0, 353, 354, 5, 31, 0, 0, 354, 355, 5, 43, 0, 0, 355, 358, 5, 54, 0,
-		0, 356, 359, 3, 92, 46, 0, 357, 359, 3, 110, 55, 0, 358, 356, 1, 0, 0,
-		0, 358, 357, 1, 0, 0, 0, 359, 360, 1, 0, 0, 0, 360, 363, 5, 62, 0, 0, 361,
-		364, 3, 92, 46, 0, 362, 364, 3, 110, 55, 0, 363, 361, 1, 0, 0, 0, 363,
-		362, 1, 0, 0, 0, 364, 41, 1, 0, 0, 0, 365, 366, 5, 6, 0, 0, 366, 367, 5,
-		31, 0, 0, 367, 368, 3, 168, 84, 0, 368, 43, 1, 0, 0, 0, 369, 370, 5, 6,
-		0, 0, 370, 371, 5, 32, 0, 0, 371, 372, 3, 168, 84, 0, 372, 45, 1, 0, 0,
-		0, 373, 374, 5, 22, 0, 0, 374, 375, 5, 31, 0, 0, 375, 376, 3, 74, 37, 0,
-		376, 47, 1, 0, 0, 0, 377, 378, 5, 21, 0, 0, 378, 379, 5, 36, 0, 0, 379,
-		49, 1, 0, 0, 0, 380, 381, 5, 6, 0, 0, 381, 382, 5, 37, 0, 0, 382, 383,
-		3, 168, 84, 0, 383, 51, 1, 0, 0, 0, 384, 385, 5, 9, 0, 0, 385, 386, 5,
-		37, 0, 0, 386, 387, 3, 72, 36, 0, 387, 53, 1, 0, 0, 0, 388, 389, 5, 21,
-		0, 0, 389, 390, 5, 38, 0, 0, 390, 55, 1, 0, 0, 0, 391, 392, 5, 21, 0, 0,
-		392, 397, 5, 40, 0, 0, 393, 394, 5, 54, 0, 0, 394, 395, 5, 39, 0, 0, 395,
-		396, 5, 106, 0, 0, 396, 398, 3, 66, 33, 0, 397, 393, 1, 0, 0, 0, 397, 398,
-		1, 0, 0, 0, 398, 400, 1, 0, 0, 0, 399, 401, 3, 184, 92, 0, 400, 399, 1,
-		0, 0, 0, 400, 401, 1, 0, 0, 0, 401, 57, 1, 0, 0, 0, 402, 403, 5, 21, 0,
-		0, 403, 406, 5, 42, 0, 0, 404, 405, 5, 20, 0, 0, 405, 407, 3, 70, 35, 0,
-		406, 404, 1, 0, 0, 0, 406, 407, 1, 0, 0, 0, 407, 412, 1, 0, 0, 0, 408,
-		409, 5, 54, 0, 0, 409, 410, 5, 43, 0, 0, 410, 411, 5, 106, 0, 0, 411, 413,
-		3, 66, 33, 0, 412, 408, 1, 0, 0, 0, 412, 413, 1, 0, 0, 0, 413, 415, 1,
-		0, 0, 0, 414, 416, 3, 184, 92, 0, 415, 414, 1, 0, 0, 0, 415, 416, 1, 0,
-		0, 0, 416, 59, 1, 0, 0, 0, 417, 418, 5, 21, 0, 0, 418, 419, 5, 45, 0, 0,
-		419, 420, 3, 100, 50, 0, 420, 61, 1, 0, 0, 0, 421, 422, 5, 21, 0, 0, 422,
-		423, 5, 46, 0, 0, 423, 424, 5, 48, 0, 0, 424, 425, 3, 100, 50, 0, 425,
-		63, 1, 0, 0, 0, 426, 427, 5, 21, 0, 0, 427, 428, 5, 46, 0, 0, 428, 429,
-		5, 51, 0, 0, 429, 430, 3, 100, 50, 0, 430, 431, 5, 50, 0, 0, 431, 432,
-		5, 49, 0, 0, 432, 433, 5, 106, 0, 0, 433, 435, 3, 68, 34, 0, 434, 436,
-		3, 102, 51, 0, 435, 434, 1, 0, 0, 0, 435, 436, 1, 0, 0, 0, 436, 438, 1,
-		0, 0, 0, 437, 439, 3, 184, 92, 0, 438, 437, 1, 0, 0, 0, 438, 439, 1, 0,
-		0, 0, 439, 65, 1, 0, 0, 0, 440, 441, 3, 192, 96, 0, 441, 67, 1, 0, 0, 0,
-		442, 443, 3, 192, 96, 0, 443, 69, 1, 0, 0, 0, 444, 445, 3, 192, 96, 0,
-		445, 71, 1, 0, 0, 0, 446, 447, 3, 192, 96, 0, 447, 73, 1, 0, 0, 0, 448,
-		449, 3, 192, 96, 0, 449, 75, 1, 0, 0, 0, 450, 451, 3, 192, 96, 0, 451,
-		77, 1, 0, 0, 0, 452, 453, 7, 1, 0, 0, 453, 79, 1, 0, 0, 0, 454, 456, 5,
-		58, 0, 0, 455, 454, 1, 0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 457, 1, 0, 0,
-		0, 457, 459, 3, 82, 41, 0, 458, 460, 3, 102, 51, 0, 459, 458, 1, 0, 0,
-		0, 459, 460, 1, 0, 0, 0, 460, 462, 1, 0, 0, 0, 461, 463, 3, 122, 61, 0,
-		462, 461, 1, 0, 0, 0, 462, 463, 1, 0, 0, 0, 463, 465, 1, 0, 0, 0, 464,
-		466, 3, 130, 65, 0, 465, 464, 1, 0, 0, 0, 465, 466, 1, 0, 0, 0, 466, 468,
-		1, 0, 0, 0, 467, 469, 3, 184, 92, 0, 468, 467, 1, 0, 0, 0, 468, 469, 1,
-		0, 0, 0, 469, 471, 1, 0, 0, 0, 470, 472, 5, 59, 0, 0, 471, 470, 1, 0, 0,
-		0, 471, 472, 1, 0, 0, 0, 472, 81, 1, 0, 0, 0, 473, 474, 3, 84, 42, 0, 474,
-		475, 3, 100, 50, 0, 475, 480, 1, 0, 0, 0, 476, 477, 3, 100, 50, 0, 477,
-		478, 3, 84, 42, 0, 478, 480, 1, 0, 0, 0, 479, 473, 1, 0, 0, 0, 479, 476,
-		1, 0, 0, 0, 480, 83, 1, 0, 0, 0, 481, 482, 5, 60, 0, 0, 482, 483, 3, 86,
-		43, 0, 483, 85, 1, 0, 0, 0, 484, 489, 3, 88, 44, 0, 485, 486, 5, 115, 0,
-		0, 486, 488, 3, 88, 44, 0, 487, 485, 1, 0, 0, 0, 488, 491, 1, 0, 0, 0,
-		489, 487, 1, 0, 0, 0, 489, 490, 1, 0, 0, 0, 490, 87, 1, 0, 0, 0, 491, 489,
-		1, 0, 0, 0, 492, 494, 3, 148, 74, 0, 493, 495, 3, 90, 45, 0, 494, 493,
-		1, 0, 0, 0, 494, 495, 1, 0, 0, 0, 495, 89, 1, 0, 0, 0, 496, 497, 5, 61,
-		0, 0, 497, 498, 3, 192, 96, 0, 498, 91, 1, 0, 0, 0, 499, 500, 5, 31, 0,
-		0, 500, 501, 5, 106, 0, 0, 501, 502, 3, 192, 96, 0, 502, 93, 1, 0, 0, 0,
-		503, 504, 5, 32, 0, 0, 504, 505, 5, 106, 0, 0, 505, 506, 3, 192, 96, 0,
-		506, 95, 1, 0, 0, 0, 507, 508, 5, 37, 0, 0, 508, 509, 5, 106, 0, 0, 509,
-		510, 3, 192, 96, 0, 510, 97, 1, 0, 0, 0, 511, 512, 5, 29, 0, 0, 512, 513,
-		5, 106, 0, 0, 513, 514, 3, 192, 96, 0, 514, 99, 1, 0, 0, 0, 515, 516, 5,
-		53, 0, 0, 516, 519, 3, 186, 93, 0, 517, 518, 5, 20, 0, 0, 518, 520, 3,
-		70, 35, 0, 519, 517, 1, 0, 0, 0, 519, 520, 1, 0, 0, 0, 520, 101, 1, 0,
-		0, 0, 521, 522, 5, 54, 0, 0, 522, 523, 3, 104, 52, 0, 523, 103, 1, 0, 0,
-		0, 524, 535, 3, 106, 53, 0, 525, 526, 3, 106, 53, 0, 526, 527, 5, 62, 0,
-		0, 527, 528, 3, 114, 57, 0, 528, 535, 1, 0, 0, 0, 529, 532, 3, 114, 57,
-		0, 530, 531, 5, 62, 0, 0, 531, 533, 3, 106, 53, 0, 532, 530, 1, 0, 0, 0,
-		532, 533, 1, 0, 0, 0, 533, 535, 1, 0, 0, 0, 534, 524, 1, 0, 0, 0, 534,
-		525, 1, 0, 0, 0, 534, 529, 1, 0, 0, 0, 535, 105, 1, 0, 0, 0, 536, 537,
-		6, 53, -1, 0, 537, 538, 5, 120, 0, 0, 538, 539, 3, 106, 53, 0, 539, 540,
-		5, 121, 0, 0, 540, 565, 1, 0, 0, 0, 541, 550, 3, 188, 94, 0, 542, 551,
-		5, 106, 0, 0, 543, 551, 5, 70, 0, 0, 544, 545, 5, 71, 0, 0, 545, 551, 5,
-		70, 0, 0, 546, 551, 5, 113, 0, 0, 547, 551, 5, 114, 0, 0, 548, 551, 5,
-		107, 0, 0, 549, 551, 5, 108, 0, 0, 550, 542, 1, 0, 0, 0, 550, 543, 1, 0,
-		0, 0, 550, 544, 1, 0, 0, 0, 550, 546, 1, 0, 0, 0, 550, 547, 1, 0, 0, 0,
-		550, 548, 1, 0, 0, 0, 550, 549, 1, 0, 0, 0, 551, 552, 1, 0, 0, 0, 552,
-		553, 3, 190, 95, 0, 553, 565, 1, 0, 0, 0, 554, 558, 3, 188, 94, 0, 555,
-		559, 5, 81, 0, 0, 556, 557, 5, 71, 0, 0, 557, 559, 5, 81, 0, 0, 558, 555,
-		1, 0, 0, 0, 558, 556, 1, 0, 0, 0, 559, 560, 1, 0, 0, 0, 560, 561, 5, 120,
-		0, 0, 561, 562, 3, 108, 54, 0, 562, 563, 5, 121, 0, 0, 563, 565, 1, 0,
-		0, 0, 564, 536, 1, 0, 0, 0, 564, 541, 1, 0, 0, 0, 564, 554, 1, 0, 0, 0,
-		565, 571, 1, 0, 0, 0, 566, 567, 10, 1, 0, 0, 567, 568, 7, 2, 0, 0, 568,
-		570, 3, 106, 53, 2, 569, 566, 1, 0, 0, 0, 570, 573, 1, 0, 0, 0, 571, 569,
-		1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 107, 1, 0, 0, 0, 573, 571, 1, 0,
-		0, 0, 574, 579, 3, 190, 95, 0, 575, 576, 5, 115, 0, 0, 576, 578, 3, 190,
-		95, 0, 577, 575, 1, 0, 0, 0, 578, 581, 1, 0, 0, 0, 579, 577, 1, 0, 0, 0,
-		579, 580, 1, 0, 0, 0, 580, 109, 1, 0, 0, 0, 581, 579, 1, 0, 0, 0, 582,
-		583, 5, 43, 0, 0, 583, 584, 5, 81, 0, 0, 584, 585, 5, 120, 0, 0, 585, 586,
-		3, 112, 56, 0, 586, 587, 5, 121, 0, 0, 587, 111, 1, 0, 0, 0, 588, 593,
-		3, 192, 96, 0, 589, 590, 5, 115, 0, 0, 590, 592, 3, 192, 96, 0, 591, 589,
-		1, 0, 0, 0, 592, 595, 1, 0, 0, 0, 593, 591, 1, 0, 0, 0, 593, 594, 1, 0,
-		0, 0, 594, 113, 1, 0, 0, 0, 595, 593, 1, 0, 0, 0, 596, 599, 3, 116, 58,
-		0, 597, 598, 5, 62, 0, 0, 598, 600, 3, 116, 58, 0, 599, 597, 1, 0, 0, 0,
-		599, 600, 1, 0, 0, 0, 600, 115, 1, 0, 0, 0, 601, 602, 5, 79, 0, 0, 602,
-		605, 3, 146, 73, 0, 603, 606, 3, 118, 59, 0, 604, 606, 3, 192, 96, 0, 605,
-		603, 1, 0, 0, 0, 605, 604, 1, 0, 0, 0, 606, 117, 1, 0, 0, 0, 607, 609,
-		3, 120, 60, 0, 608, 610, 3, 152, 76, 0, 609, 608, 1, 0, 0, 0, 609, 610,
-		1, 0, 0, 0, 610, 119, 1, 0, 0, 0, 611, 612, 5, 80, 0, 0, 612, 614, 5, 120,
-		0, 0, 613, 615, 3, 160, 80, 0, 614, 613, 1, 0, 0, 0, 614, 615, 1, 0, 0,
-		0, 615, 616, 1, 0, 0, 0, 616, 617, 5, 121, 0, 0, 617, 121, 1, 0, 0, 0,
-		618, 619, 5, 74, 0, 0, 619, 620, 5, 76, 0, 0, 620, 626, 3, 124, 62, 0,
-		621, 622, 5, 64, 0, 0, 622, 623, 5, 120, 0, 0, 623, 624, 3, 128, 64, 0,
-		624, 625, 5, 121, 0, 0, 625, 627, 1, 0, 0, 0, 626, 621, 1, 0, 0, 0, 626,
-		627, 1, 0, 0, 0, 627, 629, 1, 0, 0, 0, 628, 630, 3, 136, 68, 0, 629, 628,
-		1, 0, 0, 0, 629, 630, 1, 0, 0, 0, 630, 123, 1, 0, 0, 0, 631, 636, 3, 126,
-		63, 0, 632, 633, 5, 115, 0, 0, 633, 635, 3, 126, 63, 0, 634, 632, 1, 0,
-		0, 0, 635, 638, 1, 0, 0, 0, 636, 634, 1, 0, 0, 0, 636, 637, 1, 0, 0, 0,
-		637, 125, 1, 0, 0, 0, 638, 636, 1, 0, 0, 0, 639, 649, 3, 192, 96, 0, 640,
-		641, 5, 79, 0, 0, 641, 642, 5, 120, 0, 0, 642, 643, 3, 152, 76, 0, 643,
-		644, 5, 121, 0, 0, 644, 649, 1, 0, 0, 0, 645, 646, 5, 79, 0, 0, 646, 647,
-		5, 120, 0, 0, 647, 649, 5, 121, 0, 0, 648, 639, 1, 0, 0, 0, 648, 640, 1,
-		0, 0, 0, 648, 645, 1, 0, 0, 0, 649, 127, 1, 0, 0, 0, 650, 651, 7, 3, 0,
-		0, 651, 129, 1, 0, 0, 0, 652, 653, 5, 67, 0, 0, 653, 654, 5, 76, 0, 0,
-		654, 655, 3, 134, 67, 0, 655, 131, 1, 0, 0, 0, 656, 660, 3, 148, 74, 0,
-		657, 659, 7, 4, 0, 0, 658, 657, 1, 0, 0, 0, 659, 662, 1, 0, 0, 0, 660,
-		658, 1, 0, 0, 0, 660, 661, 1, 0, 0, 0, 661, 133, 1, 0, 0, 0, 662, 660,
-		1, 0, 0, 0, 663, 668, 3, 132, 66, 0, 664, 665, 5, 115, 0, 0, 665, 667,
-		3, 132, 66, 0, 666, 664, 1, 0, 0, 0, 667, 670, 1, 0, 0, 0, 668, 666, 1,
-		0, 0, 0, 668, 669, 1, 0, 0, 0, 669, 135, 1, 0, 0, 0, 670, 668, 1, 0, 0,
-		0, 671, 672, 5, 75, 0, 0, 672, 673, 3, 138, 69, 0, 673, 137, 1, 0, 0, 0,
-		674, 675, 6, 69, -1, 0, 675, 676, 5, 120, 0, 0, 676, 677, 3, 138, 69, 0,
-		677, 678, 5, 121, 0, 0, 678, 681, 1, 0, 0, 0, 679, 681, 3, 142, 71, 0,
-		680, 674, 1, 0, 0, 0, 680, 679, 1, 0, 0, 0, 681, 688, 1, 0, 0, 0, 682,
-		683, 10, 2, 0, 0, 683, 684, 3, 140, 70, 0, 684, 685, 3, 138, 69, 3, 685,
-		687, 1, 0, 0, 0, 686, 682, 1, 0, 0, 0, 687, 690, 1, 0, 0, 0, 688, 686,
-		1, 0, 0, 0, 688, 689, 1, 0, 0, 0, 689, 139, 1, 0, 0, 0, 690, 688, 1, 0,
-		0, 0, 691, 692, 7, 2, 0, 0, 692, 141, 1, 0, 0, 0, 693, 694, 3, 144, 72,
-		0, 694, 143, 1, 0, 0, 0, 695, 696, 3, 148, 74, 0, 696, 697, 3, 146, 73,
-		0, 697, 698, 3, 148, 74, 0, 698, 145, 1, 0, 0, 0, 699, 708, 5, 106, 0,
-		0, 700, 708, 5, 107, 0, 0, 701, 708, 5, 108, 0, 0, 702, 708, 5, 111, 0,
-		0, 703, 708, 5, 112, 0, 0, 704, 708, 5, 109, 0, 0, 705, 708, 5, 110, 0,
-		0, 706, 708, 7, 5, 0, 0, 707, 699, 1, 0, 0, 0, 707, 700, 1, 0, 0, 0, 707,
-		701, 1, 0, 0, 0, 707, 702, 1, 0, 0, 0, 707, 703, 1, 0, 0, 0, 707, 704,
-		1, 0, 0, 0, 707, 705, 1, 0, 0, 0, 707, 706, 1, 0, 0, 0, 708, 147, 1, 0,
-		0, 0, 709, 710, 6, 74, -1, 0, 710, 711, 5, 120, 0, 0, 711, 712, 3, 148,
-		74, 0, 712, 713, 5, 121, 0, 0, 713, 719, 1, 0, 0, 0, 714, 719, 3, 156,
-		78, 0, 715, 719, 3, 164, 82, 0, 716, 719, 3, 152, 76, 0, 717, 719, 3, 150,
-		75, 0, 718, 709, 1, 0, 0, 0, 718, 714, 1, 0, 0, 0, 718, 715, 1, 0, 0, 0,
-		718, 716, 1, 0, 0, 0, 718, 717, 1, 0, 0, 0, 719, 734, 1, 0, 0, 0, 720,
-		721, 10, 9, 0, 0, 721, 722, 5, 125, 0, 0, 722, 733, 3, 148, 74, 10, 723,
-		724, 10, 8, 0, 0, 724, 725, 5, 124, 0, 0, 725, 733, 3, 148, 74, 9, 726,
-		727, 10, 7, 0, 0, 727, 728, 5, 122, 0, 0, 728, 733, 3, 148, 74, 8, 729,
-		730, 10, 6, 0, 0, 730, 731, 5, 123, 0, 0, 731, 733, 3, 148, 74, 7, 732,
-		720, 1, 0, 0, 0, 732, 723, 1, 0, 0, 0, 732, 726, 1, 0, 0, 0, 732, 729,
-		1, 0, 0, 0, 733, 736, 1, 0, 0, 0, 734, 732, 1, 0, 0, 0, 734, 735, 1, 0,
-		0, 0, 735, 149, 1, 0, 0, 0, 736, 734, 1, 0, 0, 0, 737, 738, 5, 125, 0,
-		0, 738, 151, 1, 0, 0, 0, 739, 740, 3, 180, 90, 0, 740, 741, 3, 154, 77,
-		0, 741, 153, 1, 0, 0, 0, 742, 743, 7, 6, 0, 0, 743, 155, 1, 0, 0, 0, 744,
-		745, 3, 158, 79, 0, 745, 747, 5, 120, 0, 0, 746, 748, 3, 160, 80, 0, 747,
-		746, 1, 0, 0, 0, 747, 748, 1, 0, 0, 0, 748, 749, 1, 0, 0, 0, 749, 750,
-		5, 121, 0, 0, 750, 157, 1, 0, 0, 0, 751, 752, 7, 7, 0, 0, 752, 159, 1,
-		0, 0, 0, 753, 758, 3, 162, 81, 0, 754, 755, 5, 115, 0, 0, 755, 757, 3,
-		162, 81, 0, 756, 754, 1, 0, 0, 0, 757, 760, 1, 0, 0, 0, 758, 756, 1, 0,
-		0, 0, 758, 759, 1, 0, 0, 0, 759, 161, 1, 0, 0, 0, 760, 758, 1, 0, 0, 0,
-		761, 764, 3, 148, 74, 0, 762, 764, 3, 106, 53, 0, 763, 761, 1, 0, 0, 0,
-		763, 762, 1, 0, 0, 0, 764, 163, 1, 0, 0, 0, 765, 767, 3, 192, 96, 0, 766,
-		768, 3, 166, 83, 0, 767, 766, 1, 0, 0, 0, 767, 768, 1, 0, 0, 0, 768, 772,
-		1, 0, 0, 0, 769, 772, 3, 182, 91, 0, 770, 772, 3, 180, 90, 0, 771, 765,
-		1, 0, 0, 0, 771, 769, 1, 0, 0, 0, 771, 770, 1, 0, 0, 0, 772, 165, 1, 0,
-		0, 0, 773, 774, 5, 118, 0, 0, 774, 775, 3, 106, 53, 0, 775, 776, 5, 119,
-		0, 0, 776, 167, 1, 0, 0, 0, 777, 778, 3, 178, 89, 0, 778, 169, 1, 0, 0,
-		0, 779, 780, 3, 192, 96, 0, 780, 171, 1, 0, 0, 0, 781, 782, 5, 116, 0,
-		0, 782, 787, 3, 174, 87, 0, 783, 784, 5, 115, 0, 0, 784, 786, 3, 174, 87,
-		0, 785, 783, 1, 0, 0, 0, 786, 789, 1, 0, 0, 0, 787, 785, 1, 0, 0, 0, 787,
-		788, 1, 0, 0, 0, 788, 790, 1, 0, 0, 0, 789, 787, 1, 0, 0, 0, 790, 791,
-		5, 117, 0, 0, 791, 795, 1, 0, 0, 0, 792, 793, 5, 116, 0, 0, 793, 795, 5,
-		117, 0, 0, 794, 781, 1, 0, 0, 0, 794, 792, 1, 0, 0, 0, 795, 173, 1, 0,
-		0, 0, 796, 797, 5, 4, 0, 0, 797, 798, 5, 105, 0, 0, 798, 799, 3, 178, 89,
-		0, 799, 175, 1, 0, 0, 0, 800, 801, 5, 118, 0, 0, 801, 806, 3, 178, 89,
-		0, 802, 803, 5, 115, 0, 0, 803, 805, 3, 178, 89, 0, 804, 802, 1, 0, 0,
-		0, 805, 808, 1, 0, 0, 0, 806, 804, 1, 0, 0, 0, 806, 807, 1, 0, 0, 0, 807,
-		809, 1, 0, 0, 0, 808, 806, 1, 0, 0, 0, 809, 810, 5, 119, 0, 0, 810, 814,
-		1, 0, 0, 0, 811, 812, 5, 118, 0, 0, 812, 814, 5, 119, 0, 0, 813, 800, 1,
-		0, 0, 0, 813, 811, 1, 0, 0, 0, 814, 177, 1, 0, 0, 0, 815, 824, 5, 4, 0,
-		0, 816, 824, 3, 180, 90, 0, 817, 824, 3, 182, 91, 0, 818, 824, 3, 172,
-		86, 0, 819, 824, 3, 176, 88, 0, 820, 824, 5, 1, 0, 0, 821, 824, 5, 2, 0,
-		0, 822, 824, 5, 3, 0, 0, 823, 815, 1, 0, 0, 0, 823, 816, 1, 0, 0, 0, 823,
-		817, 1, 0, 0, 0, 823, 818, 1, 0, 0, 0, 823, 819, 1, 0, 0, 0, 823, 820,
-		1, 0, 0, 0, 823, 821, 1, 0, 0, 0, 823, 822, 1, 0, 0, 0, 824, 179, 1, 0,
-		0, 0, 825, 827, 7, 8, 0, 0, 826, 825, 1, 0, 0, 0, 826, 827, 1, 0, 0, 0,
-		827, 828, 1, 0, 0, 0, 828, 829, 5, 129, 0, 0, 829, 181, 1, 0, 0, 0, 830,
-		832, 7, 8, 0, 0, 831, 830, 1, 0, 0, 0, 831, 832, 1, 0, 0, 0, 832, 833,
-		1, 0, 0, 0, 833, 834, 5, 130, 0, 0, 834, 183, 1, 0, 0, 0, 835, 836, 5,
-		55, 0, 0, 836, 837, 5, 129, 0, 0, 837, 185, 1, 0, 0, 0, 838, 839, 3, 192,
-		96, 0, 839, 187, 1, 0, 0, 0, 840, 841, 3, 192, 96, 0, 841, 189, 1, 0, 0,
-		0, 842, 843, 3, 192, 96, 0, 843, 191, 1, 0, 0, 0, 844, 847, 5, 128, 0,
-		0, 845, 847, 3, 194, 97, 0, 846, 844, 1, 0, 0, 0, 846, 845, 1, 0, 0, 0,
-		847, 855, 1, 0, 0, 0, 848, 851, 5, 104, 0, 0, 849, 852, 5, 128, 0, 0, 850,
-		852, 3, 194, 97, 0, 851, 849, 1, 0, 0, 0, 851, 850, 1, 0, 0, 0, 852, 854,
-		1, 0, 0, 0, 853, 848, 1, 0, 0, 0, 854, 857, 1, 0, 0, 0, 855, 853, 1, 0,
-		0, 0, 855, 856, 1, 0, 0, 0, 856, 193, 1, 0, 0, 0, 857, 855, 1, 0, 0, 0,
-		858, 859, 7, 9, 0, 0, 859, 195, 1, 0, 0, 0, 67, 208, 241, 286, 304, 309,
-		320, 325, 333, 338, 358, 363, 397, 400, 406, 412, 415, 435, 438, 455, 459,
-		462, 465, 468, 471, 479, 489, 494, 519, 532, 534, 550, 558, 564, 571, 579,
-		593, 599, 605, 609, 614, 626, 629, 636, 648, 660, 668, 680, 688, 707, 718,
-		732, 734, 747, 758, 763, 767, 771, 787, 794, 806, 813, 823, 826, 831, 846,
-		851, 855,
+		4, 1, 159, 1146, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
+		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
+		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
+		2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31,
+		7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7,
+		36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41,
+		2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2,
+		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
+		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
+		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73,
+		7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7,
+		78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83,
+		2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2,
+		89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94,
+		7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7,
+		99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2,
+		104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108, 7,
+		108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112, 2,
+		113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117, 7,
+		117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121, 2,
+		122, 7, 122, 2, 123, 7, 123, 2, 124, 7, 124, 2, 125, 7, 125, 2, 126, 7,
+		126, 2, 127, 7, 127, 2, 128, 7, 128, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0,
+		276, 8, 0, 1, 0, 3, 0, 279, 8, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 2, 1,
+		2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 5,
+		4, 299, 8, 4, 10, 4, 12, 4, 302, 9, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1,
+		6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 3, 6, 344,
+		8, 6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9,
+		1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1,
+		12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 393, 8, 16, 1, 17, 1, 17, 1, 17,
+		1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
+		18, 1, 18, 1, 18, 3, 18, 411, 8, 18, 1, 18, 1, 18, 1, 18, 3, 18, 416, 8,
+		18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20,
+		427, 8, 20, 1, 20, 1, 20, 1, 20, 3, 20, 432, 8, 20, 1, 21, 1, 21, 1, 21,
+		1, 21, 1, 21, 1, 21, 3, 21, 440, 8, 21, 1, 21, 1, 21, 1, 21, 3, 21, 445,
+		8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 464, 8, 24,
+		1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
+		25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 3, 26, 483, 8, 26, 1, 27,
+		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1,
+		29, 1, 29, 3, 29, 498, 8, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30,
+		1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1,
+		32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34,
+		1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 3, 34, 532, 8, 34, 1, 34, 1, 34, 1,
+		34, 3, 34, 537, 8, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36,
+		1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1,
+		39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
+		1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		44, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 582, 8, 44, 1, 44, 1, 44, 1, 44,
+		1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1,
+		46, 1, 46, 1, 47, 1, 47, 1, 47, 3, 47, 602, 8, 47, 1, 47, 1, 47, 1, 47,
+		3, 47, 607, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3,
+		48, 616, 8, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 50, 3, 50, 627, 8, 50, 1, 50, 3, 50, 630, 8, 50, 1, 51, 1, 51, 1, 51,
+		1, 51, 3, 51, 636, 8, 51, 1, 51, 1, 51, 1, 51, 1, 51, 3, 51, 642, 8, 51,
+		1, 51, 3, 51, 645, 8, 51, 1, 51, 3, 51, 648, 8, 51, 1, 52, 1, 52, 1, 52,
+		1, 52, 3, 52, 654, 8, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 661,
+		8, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 671,
+		8, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 3,
+		56, 682, 8, 56, 1, 56, 3, 56, 685, 8, 56, 1, 56, 3, 56, 688, 8, 56, 1,
+		57, 1, 57, 1, 58, 1, 58, 3, 58, 694, 8, 58, 1, 59, 1, 59, 1, 59, 1, 59,
+		1, 60, 1, 60, 1, 61, 1, 61, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1,
+		65, 1, 65, 1, 66, 1, 66, 3, 66, 714, 8, 66, 1, 67, 1, 67, 1, 68, 1, 68,
+		1, 69, 3, 69, 721, 8, 69, 1, 69, 1, 69, 3, 69, 725, 8, 69, 1, 69, 3, 69,
+		728, 8, 69, 1, 69, 3, 69, 731, 8, 69, 1, 69, 3, 69, 734, 8, 69, 1, 69,
+		3, 69, 737, 8, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 3, 70, 745,
+		8, 70, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 5, 72, 753, 8, 72, 10,
+		72, 12, 72, 756, 9, 72, 1, 73, 1, 73, 3, 73, 760, 8, 73, 1, 74, 1, 74,
+		1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1,
+		77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 79,
+		3, 79, 785, 8, 79, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1,
+		81, 1, 81, 1, 81, 1, 81, 3, 81, 798, 8, 81, 3, 81, 800, 8, 81, 1, 82, 1,
+		82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82,
+		1, 82, 1, 82, 3, 82, 816, 8, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1,
+		82, 3, 82, 824, 8, 82, 1, 82, 1, 82, 1, 82, 3, 82, 829, 8, 82, 1, 82, 1,
+		82, 1, 82, 1, 82, 1, 82, 3, 82, 836, 8, 82, 1, 82, 1, 82, 3, 82, 840, 8,
+		82, 1, 82, 1, 82, 1, 82, 5, 82, 845, 8, 82, 10, 82, 12, 82, 848, 9, 82,
+		1, 83, 1, 83, 1, 83, 5, 83, 853, 8, 83, 10, 83, 12, 83, 856, 9, 83, 1,
+		84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86,
+		5, 86, 869, 8, 86, 10, 86, 12, 86, 872, 9, 86, 1, 87, 1, 87, 1, 87, 3,
+		87, 877, 8, 87, 1, 88, 1, 88, 1, 88, 1, 88, 3, 88, 883, 8, 88, 1, 89, 1,
+		89, 1, 89, 5, 89, 888, 8, 89, 10, 89, 12, 89, 891, 9, 89, 1, 90, 1, 90,
+		1, 90, 1, 91, 1, 91, 1, 91, 3, 91, 899, 8, 91, 1, 91, 1, 91, 1, 92, 1,
+		92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 3, 92, 911, 8, 92, 1, 92,
+		3, 92, 914, 8, 92, 1, 93, 1, 93, 1, 93, 5, 93, 919, 8, 93, 10, 93, 12,
+		93, 922, 9, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94,
+		1, 94, 1, 94, 3, 94, 934, 8, 94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1,
+		96, 1, 97, 1, 97, 5, 97, 944, 8, 97, 10, 97, 12, 97, 947, 9, 97, 1, 98,
+		1, 98, 1, 98, 5, 98, 952, 8, 98, 10, 98, 12, 98, 955, 9, 98, 1, 99, 1,
+		99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 3, 100, 966,
+		8, 100, 1, 100, 1, 100, 1, 100, 1, 100, 5, 100, 972, 8, 100, 10, 100, 12,
+		100, 975, 9, 100, 1, 101, 1, 101, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103,
+		1, 103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104,
+		3, 104, 993, 8, 104, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1,
+		105, 1, 105, 1, 105, 3, 105, 1004, 8, 105, 1, 105, 1, 105, 1, 105, 1, 105,
+		1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 1, 105, 5, 105,
+		1018, 8, 105, 10, 105, 12, 105, 1021, 9, 105, 1, 106, 1, 106, 1, 107, 1,
+		107, 1, 107, 1, 108, 1, 108, 1, 109, 1, 109, 1, 109, 3, 109, 1033, 8, 109,
+		1, 109, 1, 109, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111, 5, 111, 1042, 8,
+		111, 10, 111, 12, 111, 1045, 9, 111, 1, 112, 1, 112, 3, 112, 1049, 8, 112,
+		1, 113, 1, 113, 3, 113, 1053, 8, 113, 1, 113, 1, 113, 3, 113, 1057, 8,
+		113, 1, 114, 1, 114, 1, 114, 1, 114, 1, 115, 1, 115, 1, 116, 1, 116, 1,
+		117, 1, 117, 1, 117, 1, 117, 5, 117, 1071, 8, 117, 10, 117, 12, 117, 1074,
+		9, 117, 1, 117, 1, 117, 1, 117, 1, 117, 3, 117, 1080, 8, 117, 1, 118, 1,
+		118, 1, 118, 1, 118, 1, 119, 1, 119, 1, 119, 1, 119, 5, 119, 1090, 8, 119,
+		10, 119, 12, 119, 1093, 9, 119, 1, 119, 1, 119, 1, 119, 1, 119, 3, 119,
+		1099, 8, 119, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120, 1,
+		120, 3, 120, 1109, 8, 120, 1, 121, 3, 121, 1112, 8, 121, 1, 121, 1, 121,
+		1, 122, 3, 122, 1117, 8, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1, 123, 1,
+		124, 1, 124, 1, 125, 1, 125, 1, 126, 1, 126, 1, 127, 1, 127, 3, 127, 1132,
+		8, 127, 1, 127, 1, 127, 1, 127, 3, 127, 1137, 8, 127, 5, 127, 1139, 8,
+		127, 10, 127, 12, 127, 1142, 9, 127, 1, 128, 1, 128, 1, 128, 0, 3, 164,
+		200, 210, 129, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
+		32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
+		68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
+		104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132,
+		134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162,
+		164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192,
+		194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214, 216, 218, 220, 222,
+		224, 226, 228, 230, 232, 234, 236, 238, 240, 242, 244, 246, 248, 250, 252,
+		254, 256, 0, 12, 1, 0, 34, 36, 2, 0, 22, 22, 107, 107, 1, 0, 27, 28, 2,
+		0, 1, 1, 68, 68, 1, 0, 65, 66, 2, 0, 68, 69, 158, 159, 1, 0, 71, 72, 2,
+		0, 73, 73, 142, 142, 1, 0, 126, 132, 1, 0, 115, 125, 1, 0, 151, 152, 2,
+		0, 6, 23, 25, 132, 1179, 0, 275, 1, 0, 0, 0, 2, 282, 1, 0, 0, 0, 4, 285,
+		1, 0, 0, 0, 6, 288, 1, 0, 0, 0, 8, 292, 1, 0, 0, 0, 10, 303, 1, 0, 0, 0,
+		12, 343, 1, 0, 0, 0, 14, 345, 1, 0, 0, 0, 16, 348, 1, 0, 0, 0, 18, 351,
+		1, 0, 0, 0, 20, 358, 1, 0, 0, 0, 22, 361, 1, 0, 0, 0, 24, 364, 1, 0, 0,
+		0, 26, 367, 1, 0, 0, 0, 28, 371, 1, 0, 0, 0, 30, 375, 1, 0, 0, 0, 32, 383,
+		1, 0, 0, 0, 34, 394, 1, 0, 0, 0, 36, 402, 1, 0, 0, 0, 38, 417, 1, 0, 0,
+		0, 40, 421, 1, 0, 0, 0, 42, 433, 1, 0, 0, 0, 44, 446, 1, 0, 0, 0, 46, 451,
+		1, 0, 0, 0, 48, 458, 1, 0, 0, 0, 50, 465, 1, 0, 0, 0, 52, 477, 1, 0, 0,
+		0, 54, 484, 1, 0, 0, 0, 56, 490, 1, 0, 0, 0, 58, 494, 1, 0, 0, 0, 60, 502,
+		1, 0, 0, 0, 62, 507, 1, 0, 0, 0, 64, 513, 1, 0, 0, 0, 66, 519, 1, 0, 0,
+		0, 68, 525, 1, 0, 0, 0, 70, 538, 1, 0, 0, 0, 72, 542, 1, 0, 0, 0, 74, 546,
+		1, 0, 0, 0, 76, 550, 1, 0, 0, 0, 78, 553, 1, 0, 0, 0, 80, 557, 1, 0, 0,
+		0, 82, 561, 1, 0, 0, 0, 84, 569, 1, 0, 0, 0, 86, 571, 1, 0, 0, 0, 88, 576,
+		1, 0, 0, 0, 90, 588, 1, 0, 0, 0, 92, 596, 1, 0, 0, 0, 94, 598, 1, 0, 0,
+		0, 96, 608, 1, 0, 0, 0, 98, 617, 1, 0, 0, 0, 100, 620, 1, 0, 0, 0, 102,
+		631, 1, 0, 0, 0, 104, 649, 1, 0, 0, 0, 106, 655, 1, 0, 0, 0, 108, 662,
+		1, 0, 0, 0, 110, 665, 1, 0, 0, 0, 112, 672, 1, 0, 0, 0, 114, 689, 1, 0,
+		0, 0, 116, 691, 1, 0, 0, 0, 118, 695, 1, 0, 0, 0, 120, 699, 1, 0, 0, 0,
+		122, 701, 1, 0, 0, 0, 124, 703, 1, 0, 0, 0, 126, 705, 1, 0, 0, 0, 128,
+		707, 1, 0, 0, 0, 130, 709, 1, 0, 0, 0, 132, 713, 1, 0, 0, 0, 134, 715,
+		1, 0, 0, 0, 136, 717, 1, 0, 0, 0, 138, 720, 1, 0, 0, 0, 140, 744, 1, 0,
+		0, 0, 142, 746, 1, 0, 0, 0, 144, 749, 1, 0, 0, 0, 146, 757, 1, 0, 0, 0,
+		148, 761, 1, 0, 0, 0, 150, 764, 1, 0, 0, 0, 152, 768, 1, 0, 0, 0, 154,
+		772, 1, 0, 0, 0, 156, 776, 1, 0, 0, 0, 158, 780, 1, 0, 0, 0, 160, 786,
+		1, 0, 0, 0, 162, 799, 1, 0, 0, 0, 164, 839, 1, 0, 0, 0, 166, 849, 1, 0,
+		0, 0, 168, 857, 1, 0, 0, 0, 170, 859, 1, 0, 0, 0, 172, 865, 1, 0, 0, 0,
+		174, 873, 1, 0, 0, 0, 176, 878, 1, 0, 0, 0, 178, 884, 1, 0, 0, 0, 180,
+		892, 1, 0, 0, 0, 182, 895, 1, 0, 0, 0, 184, 902, 1, 0, 0, 0, 186, 915,
+		1, 0, 0, 0, 188, 933, 1, 0, 0, 0, 190, 935, 1, 0, 0, 0, 192, 937, 1, 0,
+		0, 0, 194, 941, 1, 0, 0, 0, 196, 948, 1, 0, 0, 0, 198, 956, 1, 0, 0, 0,
+		200, 965, 1, 0, 0, 0, 202, 976, 1, 0, 0, 0, 204, 978, 1, 0, 0, 0, 206,
+		980, 1, 0, 0, 0, 208, 992, 1, 0, 0, 0, 210, 1003, 1, 0, 0, 0, 212, 1022,
+		1, 0, 0, 0, 214, 1024, 1, 0, 0, 0, 216, 1027, 1, 0, 0, 0, 218, 1029, 1,
+		0, 0, 0, 220, 1036, 1, 0, 0, 0, 222, 1038, 1, 0, 0, 0, 224, 1048, 1, 0,
+		0, 0, 226, 1056, 1, 0, 0, 0, 228, 1058, 1, 0, 0, 0, 230, 1062, 1, 0, 0,
+		0, 232, 1064, 1, 0, 0, 0, 234, 1079, 1, 0, 0, 0, 236, 1081, 1, 0, 0, 0,
+		238, 1098, 1, 0, 0, 0, 240, 1108, 1, 0, 0, 0, 242, 1111, 1, 0, 0, 0, 244,
+		1116, 1, 0, 0, 0, 246, 1120, 1, 0, 0, 0, 248, 1123, 1, 0, 0, 0, 250, 1125,
+		1, 0, 0, 0, 252, 1127, 1, 0, 0, 0, 254, 1131, 1, 0, 0, 0, 256, 1143, 1,
+		0, 0, 0, 258, 276, 3, 12, 6, 0, 259, 276, 3, 70, 35, 0, 260, 276, 3, 72,
+		36, 0, 261, 276, 3, 74, 37, 0, 262, 276, 3, 4, 2, 0, 263, 276, 3, 138,
+		69, 0, 264, 276, 3, 78, 39, 0, 265, 276, 3, 80, 40, 0, 266, 276, 3, 82,
+		41, 0, 267, 276, 3, 88, 44, 0, 268, 276, 3, 90, 45, 0, 269, 276, 3, 96,
+		48, 0, 270, 276, 3, 6, 3, 0, 271, 276, 3, 8, 4, 0, 272, 276, 3, 60, 30,
+		0, 273, 276, 3, 62, 31, 0, 274, 276, 3, 254, 127, 0, 275, 258, 1, 0, 0,
+		0, 275, 259, 1, 0, 0, 0, 275, 260, 1, 0, 0, 0, 275, 261, 1, 0, 0, 0, 275,
+		262, 1, 0, 0, 0, 275, 263, 1, 0, 0, 0, 275, 264, 1, 0, 0, 0, 275, 265,
+		1, 0, 0, 0, 275, 266, 1, 0, 0, 0, 275, 267, 1, 0, 0, 0, 275, 268, 1, 0,
+		0, 0, 275, 269, 1, 0, 0, 0, 275, 270, 1, 0, 0, 0, 275, 271, 1, 0, 0, 0,
+		275, 272, 1, 0, 0, 0, 275, 273, 1, 0, 0, 0, 275, 274, 1, 0, 0, 0, 276,
+		278, 1, 0, 0, 0, 277, 279, 3, 2, 1, 0, 278, 277, 1, 0, 0, 0, 278, 279,
+		1, 0, 0, 0, 279, 280, 1, 0, 0, 0, 280, 281, 5, 0, 0, 1, 281, 1, 1, 0, 0,
+		0, 282, 283, 5, 104, 0, 0, 283, 284, 3, 254, 127, 0, 284, 3, 1, 0, 0, 0,
+		285, 286, 5, 26, 0, 0, 286, 287, 3, 254, 127, 0, 287, 5, 1, 0, 0, 0, 288,
+		289, 5, 8, 0, 0, 289, 290, 5, 58, 0, 0, 290, 291, 3, 232, 116, 0, 291,
+		7, 1, 0, 0, 0, 292, 293, 5, 8, 0, 0, 293, 294, 5, 85, 0, 0, 294, 295, 5,
+		87, 0, 0, 295, 300, 3, 10, 5, 0, 296, 297, 5, 144, 0, 0, 297, 299, 3, 10,
+		5, 0, 298, 296, 1, 0, 0, 0, 299, 302, 1, 0, 0, 0, 300, 298, 1, 0, 0, 0,
+		300, 301, 1, 0, 0, 0, 301, 9, 1, 0, 0, 0, 302, 300, 1, 0, 0, 0, 303, 304,
+		3, 254, 127, 0, 304, 305, 5, 135, 0, 0, 305, 306, 3, 254, 127, 0, 306,
+		11, 1, 0, 0, 0, 307, 344, 3, 14, 7, 0, 308, 344, 3, 28, 14, 0, 309, 344,
+		3, 30, 15, 0, 310, 344, 3, 32, 16, 0, 311, 344, 3, 34, 17, 0, 312, 344,
+		3, 36, 18, 0, 313, 344, 3, 20, 10, 0, 314, 344, 3, 22, 11, 0, 315, 344,
+		3, 24, 12, 0, 316, 344, 3, 26, 13, 0, 317, 344, 3, 38, 19, 0, 318, 344,
+		3, 64, 32, 0, 319, 344, 3, 66, 33, 0, 320, 344, 3, 68, 34, 0, 321, 344,
+		3, 40, 20, 0, 322, 344, 3, 42, 21, 0, 323, 344, 3, 76, 38, 0, 324, 344,
+		3, 98, 49, 0, 325, 344, 3, 100, 50, 0, 326, 344, 3, 102, 51, 0, 327, 344,
+		3, 104, 52, 0, 328, 344, 3, 106, 53, 0, 329, 344, 3, 110, 55, 0, 330, 344,
+		3, 112, 56, 0, 331, 344, 3, 16, 8, 0, 332, 344, 3, 18, 9, 0, 333, 344,
+		3, 44, 22, 0, 334, 344, 3, 46, 23, 0, 335, 344, 3, 48, 24, 0, 336, 344,
+		3, 50, 25, 0, 337, 344, 3, 52, 26, 0, 338, 344, 3, 54, 27, 0, 339, 344,
+		3, 56, 28, 0, 340, 344, 3, 58, 29, 0, 341, 344, 3, 86, 43, 0, 342, 344,
+		3, 94, 47, 0, 343, 307, 1, 0, 0, 0, 343, 308, 1, 0, 0, 0, 343, 309, 1,
+		0, 0, 0, 343, 310, 1, 0, 0, 0, 343, 311, 1, 0, 0, 0, 343, 312, 1, 0, 0,
+		0, 343, 313, 1, 0, 0, 0, 343, 314, 1, 0, 0, 0, 343, 315, 1, 0, 0, 0, 343,
+		316, 1, 0, 0, 0, 343, 317, 1, 0, 0, 0, 343, 318, 1, 0, 0, 0, 343, 319,
+		1, 0, 0, 0, 343, 320, 1, 0, 0, 0, 343, 321, 1, 0, 0, 0, 343, 322, 1, 0,
+		0, 0, 343, 323, 1, 0, 0, 0, 343, 324, 1, 0, 0, 0, 343, 325, 1, 0, 0, 0,
+		343, 326, 1, 0, 0, 0, 343, 327, 1, 0, 0, 0, 343, 328, 1, 0, 0, 0, 343,
+		329, 1, 0, 0, 0, 343, 330, 1, 0, 0, 0, 343, 331, 1, 0, 0, 0, 343, 332,
+		1, 0, 0, 0, 343, 333, 1, 0, 0, 0, 343, 334, 1, 0, 0, 0, 343, 335, 1, 0,
+		0, 0, 343, 336, 1, 0, 0, 0, 343, 337, 1, 0, 0, 0, 343, 338, 1, 0, 0, 0,
+		343, 339, 1, 0, 0, 0, 343, 340, 1, 0, 0, 0, 343, 341, 1, 0, 0, 0, 343,
+		342, 1, 0, 0, 0, 344, 13, 1, 0, 0, 0, 345, 346, 5, 23, 0, 0, 346, 347,
+		5, 29, 0, 0, 347, 15, 1, 0, 0, 0, 348, 349, 5, 23, 0, 0, 349, 350, 5, 89,
+		0, 0, 350, 17, 1, 0, 0, 0, 351, 352, 5, 23, 0, 0, 352, 353, 5, 90, 0, 0,
+		353, 354, 5, 57, 0, 0, 354, 355, 5, 91, 0, 0, 355, 356, 5, 135, 0, 0, 356,
+		357, 3, 128, 64, 0, 357, 19, 1, 0, 0, 0, 358, 359, 5, 23, 0, 0, 359, 360,
+		5, 33, 0, 0, 360, 21, 1, 0, 0, 0, 361, 362, 5, 23, 0, 0, 362, 363, 5, 37,
+		0, 0, 363, 23, 1, 0, 0, 0, 364, 365, 5, 23, 0, 0, 365, 366, 5, 58, 0, 0,
+		366, 25, 1, 0, 0, 0, 367, 368, 5, 23, 0, 0, 368, 369, 5, 58, 0, 0, 369,
+		370, 5, 111, 0, 0, 370, 27, 1, 0, 0, 0, 371, 372, 5, 23, 0, 0, 372, 373,
+		5, 30, 0, 0, 373, 374, 5, 31, 0, 0, 374, 29, 1, 0, 0, 0, 375, 376, 5, 23,
+		0, 0, 376, 377, 5, 36, 0, 0, 377, 378, 5, 30, 0, 0, 378, 379, 5, 56, 0,
+		0, 379, 380, 3, 136, 68, 0, 380, 381, 5, 57, 0, 0, 381, 382, 3, 156, 78,
+		0, 382, 31, 1, 0, 0, 0, 383, 384, 5, 23, 0, 0, 384, 385, 5, 35, 0, 0, 385,
+		386, 5, 30, 0, 0, 386, 387, 5, 56, 0, 0, 387, 388, 3, 136, 68, 0, 388,
+		389, 5, 57, 0, 0, 389, 392, 3, 156, 78, 0, 390, 391, 5, 65, 0, 0, 391,
+		393, 3, 152, 76, 0, 392, 390, 1, 0, 0, 0, 392, 393, 1, 0, 0, 0, 393, 33,
+		1, 0, 0, 0, 394, 395, 5, 23, 0, 0, 395, 396, 5, 29, 0, 0, 396, 397, 5,
+		30, 0, 0, 397, 398, 5, 56, 0, 0, 398, 399, 3, 136, 68, 0, 399, 400, 5,
+		57, 0, 0, 400, 401, 3, 156, 78, 0, 401, 35, 1, 0, 0, 0, 402, 403, 5, 23,
+		0, 0, 403, 404, 5, 34, 0, 0, 404, 405, 5, 30, 0, 0, 405, 406, 5, 56, 0,
+		0, 406, 407, 3, 136, 68, 0, 407, 410, 5, 57, 0, 0, 408, 411, 3, 150, 75,
+		0, 409, 411, 3, 156, 78, 0, 410, 408, 1, 0, 0, 0, 410, 409, 1, 0, 0, 0,
+		411, 412, 1, 0, 0, 0, 412, 415, 5, 65, 0, 0, 413, 416, 3, 150, 75, 0, 414,
+		416, 3, 156, 78, 0, 415, 413, 1, 0, 0, 0, 415, 414, 1, 0, 0, 0, 416, 37,
+		1, 0, 0, 0, 417, 418, 5, 23, 0, 0, 418, 419, 7, 0, 0, 0, 419, 420, 5, 38,
+		0, 0, 420, 39, 1, 0, 0, 0, 421, 422, 5, 23, 0, 0, 422, 423, 5, 15, 0, 0,
+		423, 426, 5, 57, 0, 0, 424, 427, 3, 150, 75, 0, 425, 427, 3, 154, 77, 0,
+		426, 424, 1, 0, 0, 0, 426, 425, 1, 0, 0, 0, 427, 428, 1, 0, 0, 0, 428,
+		431, 5, 65, 0, 0, 429, 432, 3, 150, 75, 0, 430, 432, 3, 154, 77, 0, 431,
+		429, 1, 0, 0, 0, 431, 430, 1, 0, 0, 0, 432, 41, 1, 0, 0, 0, 433, 434, 5,
+		23, 0, 0, 434, 435, 5, 16, 0, 0, 435, 436, 5, 40, 0, 0, 436, 439, 5, 57,
+		0, 0, 437, 440, 3, 150, 75, 0, 438, 440, 3, 154, 77, 0, 439, 437, 1, 0,
+		0, 0, 439, 438, 1, 0, 0, 0, 440, 441, 1, 0, 0, 0, 441, 444, 5, 65, 0, 0,
+		442, 445, 3, 150, 75, 0, 443, 445, 3, 154, 77, 0, 444, 442, 1, 0, 0, 0,
+		444, 443, 1, 0, 0, 0, 445, 43, 1, 0, 0, 0, 446, 447, 5, 23, 0, 0, 447,
+		448, 5, 92, 0, 0, 448, 449, 5, 56, 0, 0, 449, 450, 3, 124, 62, 0, 450,
+		45, 1, 0, 0, 0, 451, 452, 5, 23, 0, 0, 452, 453, 5, 93, 0, 0, 453, 454,
+		5, 56, 0, 0, 454, 455, 3, 124, 62, 0, 455, 456, 5, 14, 0, 0, 456, 457,
+		3, 130, 65, 0, 457, 47, 1, 0, 0, 0, 458, 459, 5, 23, 0, 0, 459, 460, 5,
+		94, 0, 0, 460, 463, 5, 95, 0, 0, 461, 462, 5, 56, 0, 0, 462, 464, 3, 124,
+		62, 0, 463, 461, 1, 0, 0, 0, 463, 464, 1, 0, 0, 0, 464, 49, 1, 0, 0, 0,
+		465, 466, 5, 23, 0, 0, 466, 467, 5, 96, 0, 0, 467, 468, 5, 97, 0, 0, 468,
+		469, 5, 56, 0, 0, 469, 470, 3, 124, 62, 0, 470, 471, 5, 14, 0, 0, 471,
+		472, 3, 130, 65, 0, 472, 473, 5, 98, 0, 0, 473, 474, 3, 132, 66, 0, 474,
+		475, 5, 96, 0, 0, 475, 476, 3, 134, 67, 0, 476, 51, 1, 0, 0, 0, 477, 478,
+		5, 23, 0, 0, 478, 479, 5, 15, 0, 0, 479, 482, 5, 99, 0, 0, 480, 481, 5,
+		56, 0, 0, 481, 483, 3, 124, 62, 0, 482, 480, 1, 0, 0, 0, 482, 483, 1, 0,
+		0, 0, 483, 53, 1, 0, 0, 0, 484, 485, 5, 23, 0, 0, 485, 486, 5, 100, 0,
+		0, 486, 487, 5, 45, 0, 0, 487, 488, 5, 56, 0, 0, 488, 489, 3, 124, 62,
+		0, 489, 55, 1, 0, 0, 0, 490, 491, 5, 23, 0, 0, 491, 492, 5, 85, 0, 0, 492,
+		493, 5, 86, 0, 0, 493, 57, 1, 0, 0, 0, 494, 495, 5, 23, 0, 0, 495, 497,
+		5, 101, 0, 0, 496, 498, 5, 102, 0, 0, 497, 496, 1, 0, 0, 0, 497, 498, 1,
+		0, 0, 0, 498, 499, 1, 0, 0, 0, 499, 500, 5, 56, 0, 0, 500, 501, 3, 124,
+		62, 0, 501, 59, 1, 0, 0, 0, 502, 503, 5, 25, 0, 0, 503, 504, 5, 101, 0,
+		0, 504, 505, 5, 56, 0, 0, 505, 506, 3, 124, 62, 0, 506, 61, 1, 0, 0, 0,
+		507, 508, 5, 10, 0, 0, 508, 509, 5, 103, 0, 0, 509, 510, 3, 158, 79, 0,
+		510, 511, 5, 57, 0, 0, 511, 512, 3, 164, 82, 0, 512, 63, 1, 0, 0, 0, 513,
+		514, 5, 23, 0, 0, 514, 515, 5, 36, 0, 0, 515, 516, 5, 46, 0, 0, 516, 517,
+		5, 57, 0, 0, 517, 518, 3, 170, 85, 0, 518, 65, 1, 0, 0, 0, 519, 520, 5,
+		23, 0, 0, 520, 521, 5, 35, 0, 0, 521, 522, 5, 46, 0, 0, 522, 523, 5, 57,
+		0, 0, 523, 524, 3, 170, 85, 0, 524, 67, 1, 0, 0, 0, 525, 526, 5, 23, 0,
+		0, 526, 527, 5, 34, 0, 0, 527, 528, 5, 46, 0, 0, 528, 531, 5, 57, 0, 0,
+		529, 532, 3, 150, 75, 0, 530, 532, 3, 170, 85, 0, 531, 529, 1, 0, 0, 0,
+		531, 530, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0, 533, 536, 5, 65, 0, 0, 534,
+		537, 3, 150, 75, 0, 535, 537, 3, 170, 85, 0, 536, 534, 1, 0, 0, 0, 536,
+		535, 1, 0, 0, 0, 537, 69, 1, 0, 0, 0, 538, 539, 5, 6, 0, 0, 539, 540, 5,
+		34, 0, 0, 540, 541, 3, 230, 115, 0, 541, 71, 1, 0, 0, 0, 542, 543, 5, 6,
+		0, 0, 543, 544, 5, 35, 0, 0, 544, 545, 3, 230, 115, 0, 545, 73, 1, 0, 0,
+		0, 546, 547, 5, 24, 0, 0, 547, 548, 5, 34, 0, 0, 548, 549, 3, 126, 63,
+		0, 549, 75, 1, 0, 0, 0, 550, 551, 5, 23, 0, 0, 551, 552, 5, 39, 0, 0, 552,
+		77, 1, 0, 0, 0, 553, 554, 5, 6, 0, 0, 554, 555, 5, 40, 0, 0, 555, 556,
+		3, 230, 115, 0, 556, 79, 1, 0, 0, 0, 557, 558, 5, 9, 0, 0, 558, 559, 5,
+		40, 0, 0, 559, 560, 3, 124, 62, 0, 560, 81, 1, 0, 0, 0, 561, 562, 5, 11,
+		0, 0, 562, 563, 5, 40, 0, 0, 563, 564, 3, 124, 62, 0, 564, 565, 5, 8, 0,
+		0, 565, 566, 5, 106, 0, 0, 566, 567, 5, 135, 0, 0, 567, 568, 3, 84, 42,
+		0, 568, 83, 1, 0, 0, 0, 569, 570, 7, 1, 0, 0, 570, 85, 1, 0, 0, 0, 571,
+		572, 5, 23, 0, 0, 572, 573, 5, 108, 0, 0, 573, 574, 5, 56, 0, 0, 574, 575,
+		3, 126, 63, 0, 575, 87, 1, 0, 0, 0, 576, 577, 5, 11, 0, 0, 577, 578, 5,
+		34, 0, 0, 578, 581, 3, 126, 63, 0, 579, 580, 5, 44, 0, 0, 580, 582, 3,
+		92, 46, 0, 581, 579, 1, 0, 0, 0, 581, 582, 1, 0, 0, 0, 582, 583, 1, 0,
+		0, 0, 583, 584, 5, 8, 0, 0, 584, 585, 5, 108, 0, 0, 585, 586, 5, 135, 0,
+		0, 586, 587, 3, 84, 42, 0, 587, 89, 1, 0, 0, 0, 588, 589, 5, 11, 0, 0,
+		589, 590, 5, 40, 0, 0, 590, 591, 3, 124, 62, 0, 591, 592, 5, 8, 0, 0, 592,
+		593, 5, 108, 0, 0, 593, 594, 5, 135, 0, 0, 594, 595, 3, 84, 42, 0, 595,
+		91, 1, 0, 0, 0, 596, 597, 5, 158, 0, 0, 597, 93, 1, 0, 0, 0, 598, 599,
+		5, 23, 0, 0, 599, 601, 5, 113, 0, 0, 600, 602, 5, 114, 0, 0, 601, 600,
+		1, 0, 0, 0, 601, 602, 1, 0, 0, 0, 602, 606, 1, 0, 0, 0, 603, 604, 5, 80,
+		0, 0, 604, 605, 5, 40, 0, 0, 605, 607, 3, 124, 62, 0, 606, 603, 1, 0, 0,
+		0, 606, 607, 1, 0, 0, 0, 607, 95, 1, 0, 0, 0, 608, 609, 5, 8, 0, 0, 609,
+		610, 5, 113, 0, 0, 610, 611, 5, 135, 0, 0, 611, 615, 3, 84, 42, 0, 612,
+		613, 5, 80, 0, 0, 613, 614, 5, 40, 0, 0, 614, 616, 3, 124, 62, 0, 615,
+		612, 1, 0, 0, 0, 615, 616, 1, 0, 0, 0, 616, 97, 1, 0, 0, 0, 617, 618, 5,
+		23, 0, 0, 618, 619, 5, 41, 0, 0, 619, 99, 1, 0, 0, 0, 620, 621, 5, 23,
+		0, 0, 621, 626, 5, 43, 0, 0, 622, 623, 5, 57, 0, 0, 623, 624, 5, 42, 0,
+		0, 624, 625, 5, 135, 0, 0, 625, 627, 3, 114, 57, 0, 626, 622, 1, 0, 0,
+		0, 626, 627, 1, 0, 0, 0, 627, 629, 1, 0, 0, 0, 628, 630, 3, 246, 123, 0,
+		629, 628, 1, 0, 0, 0, 629, 630, 1, 0, 0, 0, 630, 101, 1, 0, 0, 0, 631,
+		632, 5, 23, 0, 0, 632, 635, 5, 45, 0, 0, 633, 634, 5, 22, 0, 0, 634, 636,
+		3, 122, 61, 0, 635, 633, 1, 0, 0, 0, 635, 636, 1, 0, 0, 0, 636, 641, 1,
+		0, 0, 0, 637, 638, 5, 57, 0, 0, 638, 639, 5, 46, 0, 0, 639, 640, 5, 135,
+		0, 0, 640, 642, 3, 114, 57, 0, 641, 637, 1, 0, 0, 0, 641, 642, 1, 0, 0,
+		0, 642, 644, 1, 0, 0, 0, 643, 645, 3, 116, 58, 0, 644, 643, 1, 0, 0, 0,
+		644, 645, 1, 0, 0, 0, 645, 647, 1, 0, 0, 0, 646, 648, 3, 246, 123, 0, 647,
+		646, 1, 0, 0, 0, 647, 648, 1, 0, 0, 0, 648, 103, 1, 0, 0, 0, 649, 650,
+		5, 23, 0, 0, 650, 651, 5, 48, 0, 0, 651, 653, 3, 158, 79, 0, 652, 654,
+		3, 118, 59, 0, 653, 652, 1, 0, 0, 0, 653, 654, 1, 0, 0, 0, 654, 105, 1,
+		0, 0, 0, 655, 656, 5, 23, 0, 0, 656, 657, 5, 47, 0, 0, 657, 658, 5, 95,
+		0, 0, 658, 660, 3, 158, 79, 0, 659, 661, 3, 108, 54, 0, 660, 659, 1, 0,
+		0, 0, 660, 661, 1, 0, 0, 0, 661, 107, 1, 0, 0, 0, 662, 663, 5, 112, 0,
+		0, 663, 664, 3, 214, 107, 0, 664, 109, 1, 0, 0, 0, 665, 666, 5, 23, 0,
+		0, 666, 667, 5, 49, 0, 0, 667, 668, 5, 51, 0, 0, 668, 670, 3, 158, 79,
+		0, 669, 671, 3, 118, 59, 0, 670, 669, 1, 0, 0, 0, 670, 671, 1, 0, 0, 0,
+		671, 111, 1, 0, 0, 0, 672, 673, 5, 23, 0, 0, 673, 674, 5, 49, 0, 0, 674,
+		675, 5, 54, 0, 0, 675, 676, 3, 158, 79, 0, 676, 677, 5, 53, 0, 0, 677,
+		678, 5, 52, 0, 0, 678, 679, 5, 135, 0, 0, 679, 681, 3, 120, 60, 0, 680,
+		682, 3, 160, 80, 0, 681, 680, 1, 0, 0, 0, 681, 682, 1, 0, 0, 0, 682, 684,
+		1, 0, 0, 0, 683, 685, 3, 116, 58, 0, 684, 683, 1, 0, 0, 0, 684, 685, 1,
+		0, 0, 0, 685, 687, 1, 0, 0, 0, 686, 688, 3, 246, 123, 0, 687, 686, 1, 0,
+		0, 0, 687, 688, 1, 0, 0, 0, 688, 113, 1, 0, 0, 0, 689, 690, 3, 254, 127,
+		0, 690, 115, 1, 0, 0, 0, 691, 693, 5, 105, 0, 0, 692, 694, 3, 114, 57,
+		0, 693, 692, 1, 0, 0, 0, 693, 694, 1, 0, 0, 0, 694, 117, 1, 0, 0, 0, 695,
+		696, 5, 109, 0, 0, 696, 697, 5, 110, 0, 0, 697, 698, 3, 254, 127, 0, 698,
+		119, 1, 0, 0, 0, 699, 700, 3, 254, 127, 0, 700, 121, 1, 0, 0, 0, 701, 702,
+		3, 254, 127, 0, 702, 123, 1, 0, 0, 0, 703, 704, 3, 254, 127, 0, 704, 125,
+		1, 0, 0, 0, 705, 706, 3, 254, 127, 0, 706, 127, 1, 0, 0, 0, 707, 708, 3,
+		254, 127, 0, 708, 129, 1, 0, 0, 0, 709, 710, 5, 158, 0, 0, 710, 131, 1,
+		0, 0, 0, 711, 714, 5, 158, 0, 0, 712, 714, 3, 254, 127, 0, 713, 711, 1,
+		0, 0, 0, 713, 712, 1, 0, 0, 0, 714, 133, 1, 0, 0, 0, 715, 716, 5, 158,
+		0, 0, 716, 135, 1, 0, 0, 0, 717, 718, 7, 2, 0, 0, 718, 137, 1, 0, 0, 0,
+		719, 721, 5, 61, 0, 0, 720, 719, 1, 0, 0, 0, 720, 721, 1, 0, 0, 0, 721,
+		722, 1, 0, 0, 0, 722, 724, 3, 140, 70, 0, 723, 725, 3, 160, 80, 0, 724,
+		723, 1, 0, 0, 0, 724, 725, 1, 0, 0, 0, 725, 727, 1, 0, 0, 0, 726, 728,
+		3, 184, 92, 0, 727, 726, 1, 0, 0, 0, 727, 728, 1, 0, 0, 0, 728, 730, 1,
+		0, 0, 0, 729, 731, 3, 192, 96, 0, 730, 729, 1, 0, 0, 0, 730, 731, 1, 0,
+		0, 0, 731, 733, 1, 0, 0, 0, 732, 734, 3, 246, 123, 0, 733, 732, 1, 0, 0,
+		0, 733, 734, 1, 0, 0, 0, 734, 736, 1, 0, 0, 0, 735, 737, 5, 62, 0, 0, 736,
+		735, 1, 0, 0, 0, 736, 737, 1, 0, 0, 0, 737, 139, 1, 0, 0, 0, 738, 739,
+		3, 142, 71, 0, 739, 740, 3, 158, 79, 0, 740, 745, 1, 0, 0, 0, 741, 742,
+		3, 158, 79, 0, 742, 743, 3, 142, 71, 0, 743, 745, 1, 0, 0, 0, 744, 738,
+		1, 0, 0, 0, 744, 741, 1, 0, 0, 0, 745, 141, 1, 0, 0, 0, 746, 747, 5, 63,
+		0, 0, 747, 748, 3, 144, 72, 0, 748, 143, 1, 0, 0, 0, 749, 754, 3, 146,
+		73, 0, 750, 751, 5, 144, 0, 0, 751, 753, 3, 146, 73, 0, 752, 750, 1, 0,
+		0, 0, 753, 756, 1, 0, 0, 0, 754, 752, 1, 0, 0, 0, 754, 755, 1, 0, 0, 0,
+		755, 145, 1, 0, 0, 0, 756, 754, 1, 0, 0, 0, 757, 759, 3, 210, 105, 0, 758,
+		760, 3, 148, 74, 0, 759, 758, 1, 0, 0, 0, 759, 760, 1, 0, 0, 0, 760, 147,
+		1, 0, 0, 0, 761, 762, 5, 64, 0, 0, 762, 763, 3, 254, 127, 0, 763, 149,
+		1, 0, 0, 0, 764, 765, 5, 34, 0, 0, 765, 766, 5, 135, 0, 0, 766, 767, 3,
+		254, 127, 0, 767, 151, 1, 0, 0, 0, 768, 769, 5, 35, 0, 0, 769, 770, 5,
+		135, 0, 0, 770, 771, 3, 254, 127, 0, 771, 153, 1, 0, 0, 0, 772, 773, 5,
+		40, 0, 0, 773, 774, 5, 135, 0, 0, 774, 775, 3, 254, 127, 0, 775, 155, 1,
+		0, 0, 0, 776, 777, 5, 32, 0, 0, 777, 778, 5, 135, 0, 0, 778, 779, 3, 254,
+		127, 0, 779, 157, 1, 0, 0, 0, 780, 781, 5, 56, 0, 0, 781, 784, 3, 248,
+		124, 0, 782, 783, 5, 22, 0, 0, 783, 785, 3, 122, 61, 0, 784, 782, 1, 0,
+		0, 0, 784, 785, 1, 0, 0, 0, 785, 159, 1, 0, 0, 0, 786, 787, 5, 57, 0, 0,
+		787, 788, 3, 162, 81, 0, 788, 161, 1, 0, 0, 0, 789, 800, 3, 164, 82, 0,
+		790, 791, 3, 164, 82, 0, 791, 792, 5, 65, 0, 0, 792, 793, 3, 174, 87, 0,
+		793, 800, 1, 0, 0, 0, 794, 797, 3, 174, 87, 0, 795, 796, 5, 65, 0, 0, 796,
+		798, 3, 164, 82, 0, 797, 795, 1, 0, 0, 0, 797, 798, 1, 0, 0, 0, 798, 800,
+		1, 0, 0, 0, 799, 789, 1, 0, 0, 0, 799, 790, 1, 0, 0, 0, 799, 794, 1, 0,
+		0, 0, 800, 163, 1, 0, 0, 0, 801, 802, 6, 82, -1, 0, 802, 803, 5, 149, 0,
+		0, 803, 804, 3, 164, 82, 0, 804, 805, 5, 150, 0, 0, 805, 840, 1, 0, 0,
+		0, 806, 815, 3, 250, 125, 0, 807, 816, 5, 135, 0, 0, 808, 816, 5, 73, 0,
+		0, 809, 810, 5, 74, 0, 0, 810, 816, 5, 73, 0, 0, 811, 816, 5, 142, 0, 0,
+		812, 816, 5, 143, 0, 0, 813, 816, 5, 136, 0, 0, 814, 816, 5, 137, 0, 0,
+		815, 807, 1, 0, 0, 0, 815, 808, 1, 0, 0, 0, 815, 809, 1, 0, 0, 0, 815,
+		811, 1, 0, 0, 0, 815, 812, 1, 0, 0, 0, 815, 813, 1, 0, 0, 0, 815, 814,
+		1, 0, 0, 0, 816, 817, 1, 0, 0, 0, 817, 818, 3, 252, 126, 0, 818, 840, 1,
+		0, 0, 0, 819, 823, 3, 250, 125, 0, 820, 824, 5, 84, 0, 0, 821, 822, 5,
+		74, 0, 0, 822, 824, 5, 84, 0, 0, 823, 820, 1, 0, 0, 0, 823, 821, 1, 0,
+		0, 0, 824, 825, 1, 0, 0, 0, 825, 828, 5, 149, 0, 0, 826, 829, 3, 166, 83,
+		0, 827, 829, 3, 168, 84, 0, 828, 826, 1, 0, 0, 0, 828, 827, 1, 0, 0, 0,
+		829, 830, 1, 0, 0, 0, 830, 831, 5, 150, 0, 0, 831, 840, 1, 0, 0, 0, 832,
+		833, 3, 250, 125, 0, 833, 835, 5, 76, 0, 0, 834, 836, 5, 74, 0, 0, 835,
+		834, 1, 0, 0, 0, 835, 836, 1, 0, 0, 0, 836, 837, 1, 0, 0, 0, 837, 838,
+		7, 3, 0, 0, 838, 840, 1, 0, 0, 0, 839, 801, 1, 0, 0, 0, 839, 806, 1, 0,
+		0, 0, 839, 819, 1, 0, 0, 0, 839, 832, 1, 0, 0, 0, 840, 846, 1, 0, 0, 0,
+		841, 842, 10, 1, 0, 0, 842, 843, 7, 4, 0, 0, 843, 845, 3, 164, 82, 2, 844,
+		841, 1, 0, 0, 0, 845, 848, 1, 0, 0, 0, 846, 844, 1, 0, 0, 0, 846, 847,
+		1, 0, 0, 0, 847, 165, 1, 0, 0, 0, 848, 846, 1, 0, 0, 0, 849, 854, 3, 252,
+		126, 0, 850, 851, 5, 144, 0, 0, 851, 853, 3, 252, 126, 0, 852, 850, 1,
+		0, 0, 0, 853, 856, 1, 0, 0, 0, 854, 852, 1, 0, 0, 0, 854, 855, 1, 0, 0,
+		0, 855, 167, 1, 0, 0, 0, 856, 854, 1, 0, 0, 0, 857, 858, 3, 112, 56, 0,
+		858, 169, 1, 0, 0, 0, 859, 860, 5, 46, 0, 0, 860, 861, 5, 84, 0, 0, 861,
+		862, 5, 149, 0, 0, 862, 863, 3, 172, 86, 0, 863, 864, 5, 150, 0, 0, 864,
+		171, 1, 0, 0, 0, 865, 870, 3, 254, 127, 0, 866, 867, 5, 144, 0, 0, 867,
+		869, 3, 254, 127, 0, 868, 866, 1, 0, 0, 0, 869, 872, 1, 0, 0, 0, 870, 868,
+		1, 0, 0, 0, 870, 871, 1, 0, 0, 0, 871, 173, 1, 0, 0, 0, 872, 870, 1, 0,
+		0, 0, 873, 876, 3, 176, 88, 0, 874, 875, 5, 65, 0, 0, 875, 877, 3, 176,
+		88, 0, 876, 874, 1, 0, 0, 0, 876, 877, 1, 0, 0, 0, 877, 175, 1, 0, 0, 0,
+		878, 879, 5, 82, 0, 0, 879, 882, 3, 208, 104, 0, 880, 883, 3, 178, 89,
+		0, 881, 883, 3, 254, 127, 0, 882, 880, 1, 0, 0, 0, 882, 881, 1, 0, 0, 0,
+		883, 177, 1, 0, 0, 0, 884, 889, 3, 182, 91, 0, 885, 888, 3, 214, 107, 0,
+		886, 888, 3, 180, 90, 0, 887, 885, 1, 0, 0, 0, 887, 886, 1, 0, 0, 0, 888,
+		891, 1, 0, 0, 0, 889, 887, 1, 0, 0, 0, 889, 890, 1, 0, 0, 0, 890, 179,
+		1, 0, 0, 0, 891, 889, 1, 0, 0, 0, 892, 893, 5, 153, 0, 0, 893, 894, 3,
+		214, 107, 0, 894, 181, 1, 0, 0, 0, 895, 896, 5, 83, 0, 0, 896, 898, 5,
+		149, 0, 0, 897, 899, 3, 222, 111, 0, 898, 897, 1, 0, 0, 0, 898, 899, 1,
+		0, 0, 0, 899, 900, 1, 0, 0, 0, 900, 901, 5, 150, 0, 0, 901, 183, 1, 0,
+		0, 0, 902, 903, 5, 77, 0, 0, 903, 904, 5, 79, 0, 0, 904, 910, 3, 186, 93,
+		0, 905, 906, 5, 67, 0, 0, 906, 907, 5, 149, 0, 0, 907, 908, 3, 190, 95,
+		0, 908, 909, 5, 150, 0, 0, 909, 911, 1, 0, 0, 0, 910, 905, 1, 0, 0, 0,
+		910, 911, 1, 0, 0, 0, 911, 913, 1, 0, 0, 0, 912, 914, 3, 198, 99, 0, 913,
+		912, 1, 0, 0, 0, 913, 914, 1, 0, 0, 0, 914, 185, 1, 0, 0, 0, 915, 920,
+		3, 188, 94, 0, 916, 917, 5, 144, 0, 0, 917, 919, 3, 188, 94, 0, 918, 916,
+		1, 0, 0, 0, 919, 922, 1, 0, 0, 0, 920, 918, 1, 0, 0, 0, 920, 921, 1, 0,
+		0, 0, 921, 187, 1, 0, 0, 0, 922, 920, 1, 0, 0, 0, 923, 934, 3, 254, 127,
+		0, 924, 934, 5, 154, 0, 0, 925, 926, 5, 82, 0, 0, 926, 927, 5, 149, 0,
+		0, 927, 928, 3, 214, 107, 0, 928, 929, 5, 150, 0, 0, 929, 934, 1, 0, 0,
+		0, 930, 931, 5, 82, 0, 0, 931, 932, 5, 149, 0, 0, 932, 934, 5, 150, 0,
+		0, 933, 923, 1, 0, 0, 0, 933, 924, 1, 0, 0, 0, 933, 925, 1, 0, 0, 0, 933,
+		930, 1, 0, 0, 0, 934, 189, 1, 0, 0, 0, 935, 936, 7, 5, 0, 0, 936, 191,
+		1, 0, 0, 0, 937, 938, 5, 70, 0, 0, 938, 939, 5, 79, 0, 0, 939, 940, 3,
+		196, 98, 0, 940, 193, 1, 0, 0, 0, 941, 945, 3, 210, 105, 0, 942, 944, 7,
+		6, 0, 0, 943, 942, 1, 0, 0, 0, 944, 947, 1, 0, 0, 0, 945, 943, 1, 0, 0,
+		0, 945, 946, 1, 0, 0, 0, 946, 195, 1, 0, 0, 0, 947, 945, 1, 0, 0, 0, 948,
+		953, 3, 194, 97, 0, 949, 950, 5, 144, 0, 0, 950, 952, 3, 194, 97, 0, 951,
+		949, 1, 0, 0, 0, 952, 955, 1, 0, 0, 0, 953, 951, 1, 0, 0, 0, 953, 954,
+		1, 0, 0, 0, 954, 197, 1, 0, 0, 0, 955, 953, 1, 0, 0, 0, 956, 957, 5, 78,
+		0, 0, 957, 958, 3, 200, 100, 0, 958, 199, 1, 0, 0, 0, 959, 960, 6, 100,
+		-1, 0, 960, 961, 5, 149, 0, 0, 961, 962, 3, 200, 100, 0, 962, 963, 5, 150,
+		0, 0, 963, 966, 1, 0, 0, 0, 964, 966, 3, 204, 102, 0, 965, 959, 1, 0, 0,
+		0, 965, 964, 1, 0, 0, 0, 966, 973, 1, 0, 0, 0, 967, 968, 10, 2, 0, 0, 968,
+		969, 3, 202, 101, 0, 969, 970, 3, 200, 100, 3, 970, 972, 1, 0, 0, 0, 971,
+		967, 1, 0, 0, 0, 972, 975, 1, 0, 0, 0, 973, 971, 1, 0, 0, 0, 973, 974,
+		1, 0, 0, 0, 974, 201, 1, 0, 0, 0, 975, 973, 1, 0, 0, 0, 976, 977, 7, 4,
+		0, 0, 977, 203, 1, 0, 0, 0, 978, 979, 3, 206, 103, 0, 979, 205, 1, 0, 0,
+		0, 980, 981, 3, 210, 105, 0, 981, 982, 3, 208, 104, 0, 982, 983, 3, 210,
+		105, 0, 983, 207, 1, 0, 0, 0, 984, 993, 5, 135, 0, 0, 985, 993, 5, 136,
+		0, 0, 986, 993, 5, 137, 0, 0, 987, 993, 5, 140, 0, 0, 988, 993, 5, 141,
+		0, 0, 989, 993, 5, 138, 0, 0, 990, 993, 5, 139, 0, 0, 991, 993, 7, 7, 0,
+		0, 992, 984, 1, 0, 0, 0, 992, 985, 1, 0, 0, 0, 992, 986, 1, 0, 0, 0, 992,
+		987, 1, 0, 0, 0, 992, 988, 1, 0, 0, 0, 992, 989, 1, 0, 0, 0, 992, 990,
+		1, 0, 0, 0, 992, 991, 1, 0, 0, 0, 993, 209, 1, 0, 0, 0, 994, 995, 6, 105,
+		-1, 0, 995, 996, 5, 149, 0, 0, 996, 997, 3, 210, 105, 0, 997, 998, 5, 150,
+		0, 0, 998, 1004, 1, 0, 0, 0, 999, 1004, 3, 218, 109, 0, 1000, 1004, 3,
+		226, 113, 0, 1001, 1004, 3, 214, 107, 0, 1002, 1004, 3, 212, 106, 0, 1003,
+		994, 1, 0, 0, 0, 1003, 999, 1, 0, 0, 0, 1003, 1000, 1, 0, 0, 0, 1003, 1001,
+		1, 0, 0, 0, 1003, 1002, 1, 0, 0, 0, 1004, 1019, 1, 0, 0, 0, 1005, 1006,
+		10, 9, 0, 0, 1006, 1007, 5, 154, 0, 0, 1007, 1018, 3, 210, 105, 10, 1008,
+		1009, 10, 8, 0, 0, 1009, 1010, 5, 153, 0, 0, 1010, 1018, 3, 210, 105, 9,
+		1011, 1012, 10, 7, 0, 0, 1012, 1013, 5, 151, 0, 0, 1013, 1018, 3, 210,
+		105, 8, 1014, 1015, 10, 6, 0, 0, 1015, 1016, 5, 152, 0, 0, 1016, 1018,
+		3, 210, 105, 7, 1017, 1005, 1, 0, 0, 0, 1017, 1008, 1, 0, 0, 0, 1017, 1011,
+		1, 0, 0, 0, 1017, 1014, 1, 0, 0, 0, 1018, 1021, 1, 0, 0, 0, 1019, 1017,
+		1, 0, 0, 0, 1019, 1020, 1, 0, 0, 0, 1020, 211, 1, 0, 0, 0, 1021, 1019,
+		1, 0, 0, 0, 1022, 1023, 5, 154, 0, 0, 1023, 213, 1, 0, 0, 0, 1024, 1025,
+		3, 242, 121, 0, 1025, 1026, 3, 216, 108, 0, 1026, 215, 1, 0, 0, 0, 1027,
+		1028, 7, 8, 0, 0, 1028, 217, 1, 0, 0, 0, 1029, 1030, 3, 220, 110, 0, 1030,
+		1032, 5, 149, 0, 0, 1031, 1033, 3, 222, 111, 0, 1032, 1031, 1, 0, 0, 0,
+		1032, 1033, 1, 0, 0, 0, 1033, 1034, 1, 0, 0, 0, 1034, 1035, 5, 150, 0,
+		0, 1035, 219, 1, 0, 0, 0, 1036, 1037, 7, 9, 0, 0, 1037, 221, 1, 0, 0, 0,
+		1038, 1043, 3, 224, 112, 0, 1039, 1040, 5, 144, 0, 0, 1040, 1042, 3, 224,
+		112, 0, 1041, 1039, 1, 0, 0, 0, 1042, 1045, 1, 0, 0, 0, 1043, 1041, 1,
+		0, 0, 0, 1043, 1044, 1, 0, 0, 0, 1044, 223, 1, 0, 0, 0, 1045, 1043, 1,
+		0, 0, 0, 1046, 1049, 3, 210, 105, 0, 1047, 1049, 3, 164, 82, 0, 1048, 1046,
+		1, 0, 0, 0, 1048, 1047, 1, 0, 0, 0, 1049, 225, 1, 0, 0, 0, 1050, 1052,
+		3, 254, 127, 0, 1051, 1053, 3, 228, 114, 0, 1052, 1051, 1, 0, 0, 0, 1052,
+		1053, 1, 0, 0, 0, 1053, 1057, 1, 0, 0, 0, 1054, 1057, 3, 244, 122, 0, 1055,
+		1057, 3, 242, 121, 0, 1056, 1050, 1, 0, 0, 0, 1056, 1054, 1, 0, 0, 0, 1056,
+		1055, 1, 0, 0, 0, 1057, 227, 1, 0, 0, 0, 1058, 1059, 5, 147, 0, 0, 1059,
+		1060, 3, 164, 82, 0, 1060, 1061, 5, 148, 0, 0, 1061, 229, 1, 0, 0, 0, 1062,
+		1063, 3, 240, 120, 0, 1063, 231, 1, 0, 0, 0, 1064, 1065, 3, 254, 127, 0,
+		1065, 233, 1, 0, 0, 0, 1066, 1067, 5, 145, 0, 0, 1067, 1072, 3, 236, 118,
+		0, 1068, 1069, 5, 144, 0, 0, 1069, 1071, 3, 236, 118, 0, 1070, 1068, 1,
+		0, 0, 0, 1071, 1074, 1, 0, 0, 0, 1072, 1070, 1, 0, 0, 0, 1072, 1073, 1,
+		0, 0, 0, 1073, 1075, 1, 0, 0, 0, 1074, 1072, 1, 0, 0, 0, 1075, 1076, 5,
+		146, 0, 0, 1076, 1080, 1, 0, 0, 0, 1077, 1078, 5, 145, 0, 0, 1078, 1080,
+		5, 146, 0, 0, 1079, 1066, 1, 0, 0, 0, 1079, 1077, 1, 0, 0, 0, 1080, 235,
+		1, 0, 0, 0, 1081, 1082, 5, 4, 0, 0, 1082, 1083, 5, 134, 0, 0, 1083, 1084,
+		3, 240, 120, 0, 1084, 237, 1, 0, 0, 0, 1085, 1086, 5, 147, 0, 0, 1086,
+		1091, 3, 240, 120, 0, 1087, 1088, 5, 144, 0, 0, 1088, 1090, 3, 240, 120,
+		0, 1089, 1087, 1, 0, 0, 0, 1090, 1093, 1, 0, 0, 0, 1091, 1089, 1, 0, 0,
+		0, 1091, 1092, 1, 0, 0, 0, 1092, 1094, 1, 0, 0, 0, 1093, 1091, 1, 0, 0,
+		0, 1094, 1095, 5, 148, 0, 0, 1095, 1099, 1, 0, 0, 0, 1096, 1097, 5, 147,
+		0, 0, 1097, 1099, 5, 148, 0, 0, 1098, 1085, 1, 0, 0, 0, 1098, 1096, 1,
+		0, 0, 0, 1099, 239, 1, 0, 0, 0, 1100, 1109, 5, 4, 0, 0, 1101, 1109, 3,
+		242, 121, 0, 1102, 1109, 3, 244, 122, 0, 1103, 1109, 3, 234, 117, 0, 1104,
+		1109, 3, 238, 119, 0, 1105, 1109, 5, 2, 0, 0, 1106, 1109, 5, 3, 0, 0, 1107,
+		1109, 5, 1, 0, 0, 1108, 1100, 1, 0, 0, 0, 1108, 1101, 1, 0, 0, 0, 1108,
+		1102, 1, 0, 0, 0, 1108, 1103, 1, 0, 0, 0, 1108, 1104, 1, 0, 0, 0, 1108,
+		1105, 1, 0, 0, 0, 1108, 1106, 1, 0, 0, 0, 1108, 1107, 1, 0, 0, 0, 1109,
+		241, 1, 0, 0, 0, 1110, 1112, 7, 10, 0, 0, 1111, 1110, 1, 0, 0, 0, 1111,
+		1112, 1, 0, 0, 0, 1112, 1113, 1, 0, 0, 0, 1113, 1114, 5, 158, 0, 0, 1114,
+		243, 1, 0, 0, 0, 1115, 1117, 7, 10, 0, 0, 1116, 1115, 1, 0, 0, 0, 1116,
+		1117, 1, 0, 0, 0, 1117, 1118, 1, 0, 0, 0, 1118, 1119, 5, 159, 0, 0, 1119,
+		245, 1, 0, 0, 0, 1120, 1121, 5, 58, 0, 0, 1121, 1122, 5, 158, 0, 0, 1122,
+		247, 1, 0, 0, 0, 1123, 1124, 3, 254, 127, 0, 1124, 249, 1, 0, 0, 0, 1125,
+		1126, 3, 254, 127, 0, 1126, 251, 1, 0, 0, 0, 1127, 1128, 3, 254, 127, 0,
+		1128, 253, 1, 0, 0, 0, 1129, 1132, 5, 157, 0, 0, 1130, 1132, 3, 256, 128,
+		0, 1131, 1129, 1, 0, 0, 0, 1131, 1130, 1, 0, 0, 0, 1132, 1140, 1, 0, 0,
+		0, 1133, 1136, 5, 133, 0, 0, 1134, 1137, 5, 157, 0, 0, 1135, 1137, 3, 256,
+		128, 0, 1136, 1134, 1, 0, 0, 0, 1136, 1135, 1, 0, 0, 0, 1137, 1139, 1,
+		0, 0, 0, 1138, 1133, 1, 0, 0, 0, 1139, 1142, 1, 0, 0, 0, 1140, 1138, 1,
+		0, 0, 0, 1140, 1141, 1, 0, 0, 0, 1141, 255, 1, 0, 0, 0, 1142, 1140, 1,
+		0, 0, 0, 1143, 1144, 7, 11, 0, 0, 1144, 257, 1, 0, 0, 0, 86, 275, 278,
+		300, 343, 392, 410, 415, 426, 431, 439, 444, 463, 482, 497, 531, 536, 581,
+		601, 606, 615, 626, 629, 635, 641, 644, 647, 653, 660, 670, 681, 684, 687,
+		693, 713, 720, 724, 727, 730, 733, 736, 744, 754, 759, 784, 797, 799, 815,
+		823, 828, 835, 839, 846, 854, 870, 876, 882, 887, 889, 898, 910, 913, 920,
+		933, 945, 953, 965, 973, 992, 1003, 1017, 1019, 1032, 1043, 1048, 1052,
+		1056, 1072, 1079, 1091, 1098, 1108, 1111, 1116, 1131, 1136, 1140,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -522,239 +672,299 @@ func NewSQLParser(input antlr.TokenStream) *SQLParser {
 
 // SQLParser tokens.
 const (
-	SQLParserEOF             = antlr.TokenEOF
-	SQLParserT__0            = 1
-	SQLParserT__1            = 2
-	SQLParserT__2            = 3
-	SQLParserSTRING          = 4
-	SQLParserWS              = 5
-	SQLParserT_CREATE        = 6
-	SQLParserT_UPDATE        = 7
-	SQLParserT_SET           = 8
-	SQLParserT_DROP          = 9
-	SQLParserT_INTERVAL      = 10
-	SQLParserT_INTERVAL_NAME = 11
-	SQLParserT_SHARD         = 12
-	SQLParserT_REPLICATION   = 13
-	SQLParserT_MEMORY        = 14
-	SQLParserT_TTL           = 15
-	SQLParserT_META_TTL      = 16
-	SQLParserT_PAST_TTL      = 17
-	SQLParserT_FUTURE_TTL    = 18
-	SQLParserT_KILL          = 19
-	SQLParserT_ON            = 20
-	SQLParserT_SHOW          = 21
-	SQLParserT_RECOVER       = 22
-	SQLParserT_USE           = 23
-	SQLParserT_STATE_REPO    = 24
-	SQLParserT_STATE_MACHINE = 25
-	SQLParserT_MASTER        = 26
-	SQLParserT_METADATA      = 27
-	SQLParserT_TYPES         = 28
-	SQLParserT_TYPE          = 29
-	SQLParserT_STORAGES      = 30
-	SQLParserT_STORAGE       = 31
-	SQLParserT_BROKER        = 32
-	SQLParserT_ROOT          = 33
-	SQLParserT_BROKERS       = 34
-	SQLParserT_ALIVE         = 35
-	SQLParserT_SCHEMAS       = 36
-	SQLParserT_DATASBAE      = 37
-	SQLParserT_DATASBAES     = 38
-	SQLParserT_NAMESPACE     = 39
-	SQLParserT_NAMESPACES    = 40
-	SQLParserT_NODE          = 41
-	SQLParserT_METRICS       = 42
-	SQLParserT_METRIC        = 43
-	SQLParserT_FIELD         = 44
-	SQLParserT_FIELDS        = 45
-	SQLParserT_TAG           = 46
-	SQLParserT_INFO          = 47
-	SQLParserT_KEYS          = 48
-	SQLParserT_KEY           = 49
-	SQLParserT_WITH          = 50
-	SQLParserT_VALUES        = 51
-	SQLParserT_VALUE         = 52
-	SQLParserT_FROM          = 53
-	SQLParserT_WHERE         = 54
-	SQLParserT_LIMIT         = 55
-	SQLParserT_QUERIES       = 56
-	SQLParserT_QUERY         = 57
-	SQLParserT_EXPLAIN       = 58
-	SQLParserT_WITH_VALUE    = 59
-	SQLParserT_SELECT        = 60
-	SQLParserT_AS            = 61
-	SQLParserT_AND           = 62
-	SQLParserT_OR            = 63
-	SQLParserT_FILL          = 64
-	SQLParserT_NULL          = 65
-	SQLParserT_PREVIOUS      = 66
-	SQLParserT_ORDER         = 67
-	SQLParserT_ASC           = 68
-	SQLParserT_DESC          = 69
-	SQLParserT_LIKE          = 70
-	SQLParserT_NOT           = 71
-	SQLParserT_BETWEEN       = 72
-	SQLParserT_IS            = 73
-	SQLParserT_GROUP         = 74
-	SQLParserT_HAVING        = 75
-	SQLParserT_BY            = 76
-	SQLParserT_FOR           = 77
-	SQLParserT_STATS         = 78
-	SQLParserT_TIME          = 79
-	SQLParserT_NOW           = 80
-	SQLParserT_IN            = 81
-	SQLParserT_LOG           = 82
-	SQLParserT_PROFILE       = 83
-	SQLParserT_REQUESTS      = 84
-	SQLParserT_REQUEST       = 85
-	SQLParserT_ID            = 86
-	SQLParserT_SUM           = 87
-	SQLParserT_MIN           = 88
-	SQLParserT_MAX           = 89
-	SQLParserT_COUNT         = 90
-	SQLParserT_LAST          = 91
-	SQLParserT_FIRST         = 92
-	SQLParserT_AVG           = 93
-	SQLParserT_STDDEV        = 94
-	SQLParserT_QUANTILE      = 95
-	SQLParserT_RATE          = 96
-	SQLParserT_SECOND        = 97
-	SQLParserT_MINUTE        = 98
-	SQLParserT_HOUR          = 99
-	SQLParserT_DAY           = 100
-	SQLParserT_WEEK          = 101
-	SQLParserT_MONTH         = 102
-	SQLParserT_YEAR          = 103
-	SQLParserT_DOT           = 104
-	SQLParserT_COLON         = 105
-	SQLParserT_EQUAL         = 106
-	SQLParserT_NOTEQUAL      = 107
-	SQLParserT_NOTEQUAL2     = 108
-	SQLParserT_GREATER       = 109
-	SQLParserT_GREATEREQUAL  = 110
-	SQLParserT_LESS          = 111
-	SQLParserT_LESSEQUAL     = 112
-	SQLParserT_REGEXP        = 113
-	SQLParserT_NEQREGEXP     = 114
-	SQLParserT_COMMA         = 115
-	SQLParserT_OPEN_B        = 116
-	SQLParserT_CLOSE_B       = 117
-	SQLParserT_OPEN_SB       = 118
-	SQLParserT_CLOSE_SB      = 119
-	SQLParserT_OPEN_P        = 120
-	SQLParserT_CLOSE_P       = 121
-	SQLParserT_ADD           = 122
-	SQLParserT_SUB           = 123
-	SQLParserT_DIV           = 124
-	SQLParserT_MUL           = 125
-	SQLParserT_MOD           = 126
-	SQLParserT_UNDERLINE     = 127
-	SQLParserL_ID            = 128
-	SQLParserL_INT           = 129
-	SQLParserL_DEC           = 130
+	SQLParserEOF              = antlr.TokenEOF
+	SQLParserT__0             = 1
+	SQLParserT__1             = 2
+	SQLParserT__2             = 3
+	SQLParserSTRING           = 4
+	SQLParserWS               = 5
+	SQLParserT_CREATE         = 6
+	SQLParserT_UPDATE         = 7
+	SQLParserT_SET            = 8
+	SQLParserT_DROP           = 9
+	SQLParserT_DELETE         = 10
+	SQLParserT_ALTER          = 11
+	SQLParserT_INTERVAL       = 12
+	SQLParserT_INTERVAL_NAME  = 13
+	SQLParserT_SHARD          = 14
+	SQLParserT_REPLICATION    = 15
+	SQLParserT_MEMORY         = 16
+	SQLParserT_TTL            = 17
+	SQLParserT_META_TTL       = 18
+	SQLParserT_PAST_TTL       = 19
+	SQLParserT_FUTURE_TTL     = 20
+	SQLParserT_KILL           = 21
+	SQLParserT_ON             = 22
+	SQLParserT_SHOW           = 23
+	SQLParserT_RECOVER        = 24
+	SQLParserT_REPAIR         = 25
+	SQLParserT_USE            = 26
+	SQLParserT_STATE_REPO     = 27
+	SQLParserT_STATE_MACHINE  = 28
+	SQLParserT_MASTER         = 29
+	SQLParserT_METADATA       = 30
+	SQLParserT_TYPES          = 31
+	SQLParserT_TYPE           = 32
+	SQLParserT_STORAGES       = 33
+	SQLParserT_STORAGE        = 34
+	SQLParserT_BROKER         = 35
+	SQLParserT_ROOT           = 36
+	SQLParserT_BROKERS        = 37
+	SQLParserT_ALIVE          = 38
+	SQLParserT_SCHEMAS        = 39
+	SQLParserT_DATASBAE       = 40
+	SQLParserT_DATASBAES      = 41
+	SQLParserT_NAMESPACE      = 42
+	SQLParserT_NAMESPACES     = 43
+	SQLParserT_NODE           = 44
+	SQLParserT_METRICS        = 45
+	SQLParserT_METRIC         = 46
+	SQLParserT_FIELD          = 47
+	SQLParserT_FIELDS         = 48
+	SQLParserT_TAG            = 49
+	SQLParserT_INFO           = 50
+	SQLParserT_KEYS           = 51
+	SQLParserT_KEY            = 52
+	SQLParserT_WITH           = 53
+	SQLParserT_VALUES         = 54
+	SQLParserT_VALUE          = 55
+	SQLParserT_FROM           = 56
+	SQLParserT_WHERE          = 57
+	SQLParserT_LIMIT          = 58
+	SQLParserT_QUERIES        = 59
+	SQLParserT_QUERY          = 60
+	SQLParserT_EXPLAIN        = 61
+	SQLParserT_WITH_VALUE     = 62
+	SQLParserT_SELECT         = 63
+	SQLParserT_AS             = 64
+	SQLParserT_AND            = 65
+	SQLParserT_OR             = 66
+	SQLParserT_FILL           = 67
+	SQLParserT_NULL           = 68
+	SQLParserT_PREVIOUS       = 69
+	SQLParserT_ORDER          = 70
+	SQLParserT_ASC            = 71
+	SQLParserT_DESC           = 72
+	SQLParserT_LIKE           = 73
+	SQLParserT_NOT            = 74
+	SQLParserT_BETWEEN        = 75
+	SQLParserT_IS             = 76
+	SQLParserT_GROUP          = 77
+	SQLParserT_HAVING         = 78
+	SQLParserT_BY             = 79
+	SQLParserT_FOR            = 80
+	SQLParserT_STATS          = 81
+	SQLParserT_TIME           = 82
+	SQLParserT_NOW            = 83
+	SQLParserT_IN             = 84
+	SQLParserT_LOG            = 85
+	SQLParserT_LEVELS         = 86
+	SQLParserT_LEVEL          = 87
+	SQLParserT_PROFILE        = 88
+	SQLParserT_REQUESTS       = 89
+	SQLParserT_REQUEST        = 90
+	SQLParserT_ID             = 91
+	SQLParserT_SHARDS         = 92
+	SQLParserT_SEGMENTS       = 93
+	SQLParserT_DISK           = 94
+	SQLParserT_USAGE          = 95
+	SQLParserT_FILE           = 96
+	SQLParserT_DETAIL         = 97
+	SQLParserT_FAMILY         = 98
+	SQLParserT_CHANNELS       = 99
+	SQLParserT_EXPIRED        = 100
+	SQLParserT_PLACEMENT      = 101
+	SQLParserT_SUGGESTIONS    = 102
+	SQLParserT_SERIES         = 103
+	SQLParserT_FORMAT         = 104
+	SQLParserT_FUZZY          = 105
+	SQLParserT_WRITE          = 106
+	SQLParserT_OFF            = 107
+	SQLParserT_READONLY       = 108
+	SQLParserT_AT             = 109
+	SQLParserT_TIMESTAMP      = 110
+	SQLParserT_STATUS         = 111
+	SQLParserT_SINCE          = 112
+	SQLParserT_COMPACTION     = 113
+	SQLParserT_JOBS           = 114
+	SQLParserT_SUM            = 115
+	SQLParserT_MIN            = 116
+	SQLParserT_MAX            = 117
+	SQLParserT_COUNT          = 118
+	SQLParserT_COUNT_DISTINCT = 119
+	SQLParserT_LAST           = 120
+	SQLParserT_FIRST          = 121
+	SQLParserT_AVG            = 122
+	SQLParserT_STDDEV         = 123
+	SQLParserT_QUANTILE       = 124
+	SQLParserT_RATE           = 125
+	SQLParserT_SECOND         = 126
+	SQLParserT_MINUTE         = 127
+	SQLParserT_HOUR           = 128
+	SQLParserT_DAY            = 129
+	SQLParserT_WEEK           = 130
+	SQLParserT_MONTH          = 131
+	SQLParserT_YEAR           = 132
+	SQLParserT_DOT            = 133
+	SQLParserT_COLON          = 134
+	SQLParserT_EQUAL          = 135
+	SQLParserT_NOTEQUAL       = 136
+	SQLParserT_NOTEQUAL2      = 137
+	SQLParserT_GREATER        = 138
+	SQLParserT_GREATEREQUAL   = 139
+	SQLParserT_LESS           = 140
+	SQLParserT_LESSEQUAL      = 141
+	SQLParserT_REGEXP         = 142
+	SQLParserT_NEQREGEXP      = 143
+	SQLParserT_COMMA          = 144
+	SQLParserT_OPEN_B         = 145
+	SQLParserT_CLOSE_B        = 146
+	SQLParserT_OPEN_SB        = 147
+	SQLParserT_CLOSE_SB       = 148
+	SQLParserT_OPEN_P         = 149
+	SQLParserT_CLOSE_P        = 150
+	SQLParserT_ADD            = 151
+	SQLParserT_SUB            = 152
+	SQLParserT_DIV            = 153
+	SQLParserT_MUL            = 154
+	SQLParserT_MOD            = 155
+	SQLParserT_UNDERLINE      = 156
+	SQLParserL_ID             = 157
+	SQLParserL_INT            = 158
+	SQLParserL_DEC            = 159
 )
 
 // SQLParser rules.
 const (
-	SQLParserRULE_statement              = 0
-	SQLParserRULE_useStmt                = 1
-	SQLParserRULE_setLimitStmt           = 2
-	SQLParserRULE_showStmt               = 3
-	SQLParserRULE_showMasterStmt         = 4
-	SQLParserRULE_showRequestsStmt       = 5
-	SQLParserRULE_showRequestStmt        = 6
-	SQLParserRULE_showStoragesStmt       = 7
-	SQLParserRULE_showBrokersStmt        = 8
-	SQLParserRULE_showLimitStmt          = 9
-	SQLParserRULE_showMetadataTypesStmt  = 10
-	SQLParserRULE_showRootMetaStmt       = 11
-	SQLParserRULE_showBrokerMetaStmt     = 12
-	SQLParserRULE_showMasterMetaStmt     = 13
-	SQLParserRULE_showStorageMetaStmt    = 14
-	SQLParserRULE_showAliveStmt          = 15
-	SQLParserRULE_showReplicationStmt    = 16
-	SQLParserRULE_showMemoryDatabaseStmt = 17
-	SQLParserRULE_showRootMetricStmt     = 18
-	SQLParserRULE_showBrokerMetricStmt   = 19
-	SQLParserRULE_showStorageMetricStmt  = 20
-	SQLParserRULE_createStorageStmt      = 21
-	SQLParserRULE_createBrokerStmt       = 22
-	SQLParserRULE_recoverStorageStmt     = 23
-	SQLParserRULE_showSchemasStmt        = 24
-	SQLParserRULE_createDatabaseStmt     = 25
-	SQLParserRULE_dropDatabaseStmt       = 26
-	SQLParserRULE_showDatabaseStmt       = 27
-	SQLParserRULE_showNameSpacesStmt     = 28
-	SQLParserRULE_showMetricsStmt        = 29
-	SQLParserRULE_showFieldsStmt         = 30
-	SQLParserRULE_showTagKeysStmt        = 31
-	SQLParserRULE_showTagValuesStmt      = 32
-	SQLParserRULE_prefix                 = 33
-	SQLParserRULE_withTagKey             = 34
-	SQLParserRULE_namespace              = 35
-	SQLParserRULE_databaseName           = 36
-	SQLParserRULE_storageName            = 37
-	SQLParserRULE_requestID              = 38
-	SQLParserRULE_source                 = 39
-	SQLParserRULE_queryStmt              = 40
-	SQLParserRULE_sourceAndSelect        = 41
-	SQLParserRULE_selectExpr             = 42
-	SQLParserRULE_fields                 = 43
-	SQLParserRULE_field                  = 44
-	SQLParserRULE_alias                  = 45
-	SQLParserRULE_storageFilter          = 46
-	SQLParserRULE_brokerFilter           = 47
-	SQLParserRULE_databaseFilter         = 48
-	SQLParserRULE_typeFilter             = 49
-	SQLParserRULE_fromClause             = 50
-	SQLParserRULE_whereClause            = 51
-	SQLParserRULE_conditionExpr          = 52
-	SQLParserRULE_tagFilterExpr          = 53
-	SQLParserRULE_tagValueList           = 54
-	SQLParserRULE_metricListFilter       = 55
-	SQLParserRULE_metricList             = 56
-	SQLParserRULE_timeRangeExpr          = 57
-	SQLParserRULE_timeExpr               = 58
-	SQLParserRULE_nowExpr                = 59
-	SQLParserRULE_nowFunc                = 60
-	SQLParserRULE_groupByClause          = 61
-	SQLParserRULE_groupByKeys            = 62
-	SQLParserRULE_groupByKey             = 63
-	SQLParserRULE_fillOption             = 64
-	SQLParserRULE_orderByClause          = 65
-	SQLParserRULE_sortField              = 66
-	SQLParserRULE_sortFields             = 67
-	SQLParserRULE_havingClause           = 68
-	SQLParserRULE_boolExpr               = 69
-	SQLParserRULE_boolExprLogicalOp      = 70
-	SQLParserRULE_boolExprAtom           = 71
-	SQLParserRULE_binaryExpr             = 72
-	SQLParserRULE_binaryOperator         = 73
-	SQLParserRULE_fieldExpr              = 74
-	SQLParserRULE_star                   = 75
-	SQLParserRULE_durationLit            = 76
-	SQLParserRULE_intervalItem           = 77
-	SQLParserRULE_exprFunc               = 78
-	SQLParserRULE_funcName               = 79
-	SQLParserRULE_exprFuncParams         = 80
-	SQLParserRULE_funcParam              = 81
-	SQLParserRULE_exprAtom               = 82
-	SQLParserRULE_identFilter            = 83
-	SQLParserRULE_json                   = 84
-	SQLParserRULE_toml                   = 85
-	SQLParserRULE_obj                    = 86
-	SQLParserRULE_pair                   = 87
-	SQLParserRULE_arr                    = 88
-	SQLParserRULE_value                  = 89
-	SQLParserRULE_intNumber              = 90
-	SQLParserRULE_decNumber              = 91
-	SQLParserRULE_limitClause            = 92
-	SQLParserRULE_metricName             = 93
-	SQLParserRULE_tagKey                 = 94
-	SQLParserRULE_tagValue               = 95
-	SQLParserRULE_ident                  = 96
-	SQLParserRULE_nonReservedWords       = 97
+	SQLParserRULE_statement                   = 0
+	SQLParserRULE_formatClause                = 1
+	SQLParserRULE_useStmt                     = 2
+	SQLParserRULE_setLimitStmt                = 3
+	SQLParserRULE_setLogLevelStmt             = 4
+	SQLParserRULE_logLevel                    = 5
+	SQLParserRULE_showStmt                    = 6
+	SQLParserRULE_showMasterStmt              = 7
+	SQLParserRULE_showRequestsStmt            = 8
+	SQLParserRULE_showRequestStmt             = 9
+	SQLParserRULE_showStoragesStmt            = 10
+	SQLParserRULE_showBrokersStmt             = 11
+	SQLParserRULE_showLimitStmt               = 12
+	SQLParserRULE_showLimitStatusStmt         = 13
+	SQLParserRULE_showMetadataTypesStmt       = 14
+	SQLParserRULE_showRootMetaStmt            = 15
+	SQLParserRULE_showBrokerMetaStmt          = 16
+	SQLParserRULE_showMasterMetaStmt          = 17
+	SQLParserRULE_showStorageMetaStmt         = 18
+	SQLParserRULE_showAliveStmt               = 19
+	SQLParserRULE_showReplicationStmt         = 20
+	SQLParserRULE_showMemoryDatabaseStmt      = 21
+	SQLParserRULE_showShardsStmt              = 22
+	SQLParserRULE_showSegmentsStmt            = 23
+	SQLParserRULE_showDiskUsageStmt           = 24
+	SQLParserRULE_showFileDetailStmt          = 25
+	SQLParserRULE_showReplicationChannelsStmt = 26
+	SQLParserRULE_showExpiredMetricsStmt      = 27
+	SQLParserRULE_showLogLevelsStmt           = 28
+	SQLParserRULE_showPlacementStmt           = 29
+	SQLParserRULE_repairPlacementStmt         = 30
+	SQLParserRULE_deleteSeriesStmt            = 31
+	SQLParserRULE_showRootMetricStmt          = 32
+	SQLParserRULE_showBrokerMetricStmt        = 33
+	SQLParserRULE_showStorageMetricStmt       = 34
+	SQLParserRULE_createStorageStmt           = 35
+	SQLParserRULE_createBrokerStmt            = 36
+	SQLParserRULE_recoverStorageStmt          = 37
+	SQLParserRULE_showSchemasStmt             = 38
+	SQLParserRULE_createDatabaseStmt          = 39
+	SQLParserRULE_dropDatabaseStmt            = 40
+	SQLParserRULE_alterDatabaseStmt           = 41
+	SQLParserRULE_switchValue                 = 42
+	SQLParserRULE_showReadOnlyStmt            = 43
+	SQLParserRULE_alterStorageReadOnlyStmt    = 44
+	SQLParserRULE_alterDatabaseReadOnlyStmt   = 45
+	SQLParserRULE_nodeID                      = 46
+	SQLParserRULE_showCompactionStmt          = 47
+	SQLParserRULE_setCompactionStmt           = 48
+	SQLParserRULE_showDatabaseStmt            = 49
+	SQLParserRULE_showNameSpacesStmt          = 50
+	SQLParserRULE_showMetricsStmt             = 51
+	SQLParserRULE_showFieldsStmt              = 52
+	SQLParserRULE_showFieldUsageStmt          = 53
+	SQLParserRULE_sinceClause                 = 54
+	SQLParserRULE_showTagKeysStmt             = 55
+	SQLParserRULE_showTagValuesStmt           = 56
+	SQLParserRULE_prefix                      = 57
+	SQLParserRULE_fuzzyClause                 = 58
+	SQLParserRULE_atTimestampClause           = 59
+	SQLParserRULE_withTagKey                  = 60
+	SQLParserRULE_namespace                   = 61
+	SQLParserRULE_databaseName                = 62
+	SQLParserRULE_storageName                 = 63
+	SQLParserRULE_requestID                   = 64
+	SQLParserRULE_shardID                     = 65
+	SQLParserRULE_familyTime                  = 66
+	SQLParserRULE_fileNumber                  = 67
+	SQLParserRULE_source                      = 68
+	SQLParserRULE_queryStmt                   = 69
+	SQLParserRULE_sourceAndSelect             = 70
+	SQLParserRULE_selectExpr                  = 71
+	SQLParserRULE_fields                      = 72
+	SQLParserRULE_field                       = 73
+	SQLParserRULE_alias                       = 74
+	SQLParserRULE_storageFilter               = 75
+	SQLParserRULE_brokerFilter                = 76
+	SQLParserRULE_databaseFilter              = 77
+	SQLParserRULE_typeFilter                  = 78
+	SQLParserRULE_fromClause                  = 79
+	SQLParserRULE_whereClause                 = 80
+	SQLParserRULE_conditionExpr               = 81
+	SQLParserRULE_tagFilterExpr               = 82
+	SQLParserRULE_tagValueList                = 83
+	SQLParserRULE_subQuery                    = 84
+	SQLParserRULE_metricListFilter            = 85
+	SQLParserRULE_metricList                  = 86
+	SQLParserRULE_timeRangeExpr               = 87
+	SQLParserRULE_timeExpr                    = 88
+	SQLParserRULE_nowExpr                     = 89
+	SQLParserRULE_truncateDuration            = 90
+	SQLParserRULE_nowFunc                     = 91
+	SQLParserRULE_groupByClause               = 92
+	SQLParserRULE_groupByKeys                 = 93
+	SQLParserRULE_groupByKey                  = 94
+	SQLParserRULE_fillOption                  = 95
+	SQLParserRULE_orderByClause               = 96
+	SQLParserRULE_sortField                   = 97
+	SQLParserRULE_sortFields                  = 98
+	SQLParserRULE_havingClause                = 99
+	SQLParserRULE_boolExpr                    = 100
+	SQLParserRULE_boolExprLogicalOp           = 101
+	SQLParserRULE_boolExprAtom                = 102
+	SQLParserRULE_binaryExpr                  = 103
+	SQLParserRULE_binaryOperator              = 104
+	SQLParserRULE_fieldExpr                   = 105
+	SQLParserRULE_star                        = 106
+	SQLParserRULE_durationLit                 = 107
+	SQLParserRULE_intervalItem                = 108
+	SQLParserRULE_exprFunc                    = 109
+	SQLParserRULE_funcName                    = 110
+	SQLParserRULE_exprFuncParams              = 111
+	SQLParserRULE_funcParam                   = 112
+	SQLParserRULE_exprAtom                    = 113
+	SQLParserRULE_identFilter                 = 114
+	SQLParserRULE_json                        = 115
+	SQLParserRULE_toml                        = 116
+	SQLParserRULE_obj                         = 117
+	SQLParserRULE_pair                        = 118
+	SQLParserRULE_arr                         = 119
+	SQLParserRULE_value                       = 120
+	SQLParserRULE_intNumber                   = 121
+	SQLParserRULE_decNumber                   = 122
+	SQLParserRULE_limitClause                 = 123
+	SQLParserRULE_metricName                  = 124
+	SQLParserRULE_tagKey                      = 125
+	SQLParserRULE_tagValue                    = 126
+	SQLParserRULE_ident                       = 127
+	SQLParserRULE_nonReservedWords            = 128
 )
 
 // IStatementContext is an interface to support dynamic dispatch.
@@ -765,6 +975,7 @@ type IStatementContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	EOF() antlr.TerminalNode
 	ShowStmt() IShowStmtContext
 	CreateStorageStmt() ICreateStorageStmtContext
 	CreateBrokerStmt() ICreateBrokerStmtContext
@@ -773,9 +984,16 @@ type IStatementContext interface {
 	QueryStmt() IQueryStmtContext
 	CreateDatabaseStmt() ICreateDatabaseStmtContext
 	DropDatabaseStmt() IDropDatabaseStmtContext
+	AlterDatabaseStmt() IAlterDatabaseStmtContext
+	AlterStorageReadOnlyStmt() IAlterStorageReadOnlyStmtContext
+	AlterDatabaseReadOnlyStmt() IAlterDatabaseReadOnlyStmtContext
+	SetCompactionStmt() ISetCompactionStmtContext
 	SetLimitStmt() ISetLimitStmtContext
+	SetLogLevelStmt() ISetLogLevelStmtContext
+	RepairPlacementStmt() IRepairPlacementStmtContext
+	DeleteSeriesStmt() IDeleteSeriesStmtContext
 	Ident() IIdentContext
-	EOF() antlr.TerminalNode
+	FormatClause() IFormatClauseContext
 
 	// IsStatementContext differentiates from other interfaces.
 	IsStatementContext()
@@ -808,6 +1026,10 @@ func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, in
 
 func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *StatementContext) EOF() antlr.TerminalNode {
+	return s.GetToken(SQLParserEOF, 0)
+}
+
 func (s *StatementContext) ShowStmt() IShowStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -936,6 +1158,70 @@ func (s *StatementContext) DropDatabaseStmt() IDropDatabaseStmtContext {
 	return t.(IDropDatabaseStmtContext)
 }
 
+func (s *StatementContext) AlterDatabaseStmt() IAlterDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlterDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterDatabaseStmtContext)
+}
+
+func (s *StatementContext) AlterStorageReadOnlyStmt() IAlterStorageReadOnlyStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlterStorageReadOnlyStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterStorageReadOnlyStmtContext)
+}
+
+func (s *StatementContext) AlterDatabaseReadOnlyStmt() IAlterDatabaseReadOnlyStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlterDatabaseReadOnlyStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterDatabaseReadOnlyStmtContext)
+}
+
+func (s *StatementContext) SetCompactionStmt() ISetCompactionStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISetCompactionStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISetCompactionStmtContext)
+}
+
 func (s *StatementContext) SetLimitStmt() ISetLimitStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -952,6 +1238,54 @@ func (s *StatementContext) SetLimitStmt() ISetLimitStmtContext {
 	return t.(ISetLimitStmtContext)
 }
 
+func (s *StatementContext) SetLogLevelStmt() ISetLogLevelStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISetLogLevelStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISetLogLevelStmtContext)
+}
+
+func (s *StatementContext) RepairPlacementStmt() IRepairPlacementStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRepairPlacementStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRepairPlacementStmtContext)
+}
+
+func (s *StatementContext) DeleteSeriesStmt() IDeleteSeriesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeleteSeriesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeleteSeriesStmtContext)
+}
+
 func (s *StatementContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -968,8 +1302,20 @@ func (s *StatementContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *StatementContext) EOF() antlr.TerminalNode {
-	return s.GetToken(SQLParserEOF, 0)
+func (s *StatementContext) FormatClause() IFormatClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFormatClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFormatClauseContext)
 }
 
 func (s *StatementContext) GetRuleContext() antlr.RuleContext {
@@ -1008,6 +1354,7 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 
 	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 0, SQLParserRULE_statement)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1025,135 +1372,179 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(208)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(275)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
 	case 1:
-		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(196)
+			p.SetState(258)
 			p.ShowStmt()
 		}
 
 	case 2:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(197)
+			p.SetState(259)
 			p.CreateStorageStmt()
 		}
 
 	case 3:
-		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(198)
+			p.SetState(260)
 			p.CreateBrokerStmt()
 		}
 
 	case 4:
-		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(199)
+			p.SetState(261)
 			p.RecoverStorageStmt()
 		}
 
 	case 5:
-		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(200)
+			p.SetState(262)
 			p.UseStmt()
 		}
 
 	case 6:
-		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(201)
+			p.SetState(263)
 			p.QueryStmt()
 		}
 
 	case 7:
-		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(202)
+			p.SetState(264)
 			p.CreateDatabaseStmt()
 		}
 
 	case 8:
-		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(203)
+			p.SetState(265)
 			p.DropDatabaseStmt()
 		}
 
 	case 9:
-		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(204)
-			p.SetLimitStmt()
+			p.SetState(266)
+			p.AlterDatabaseStmt()
 		}
 
 	case 10:
-		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(205)
+			p.SetState(267)
+			p.AlterStorageReadOnlyStmt()
+		}
+
+	case 11:
+		{
+			p.SetState(268)
+			p.AlterDatabaseReadOnlyStmt()
+		}
+
+	case 12:
+		{
+			p.SetState(269)
+			p.SetCompactionStmt()
+		}
+
+	case 13:
+		{
+			p.SetState(270)
+			p.SetLimitStmt()
+		}
+
+	case 14:
+		{
+			p.SetState(271)
+			p.SetLogLevelStmt()
+		}
+
+	case 15:
+		{
+			p.SetState(272)
+			p.RepairPlacementStmt()
+		}
+
+	case 16:
+		{
+			p.SetState(273)
+			p.DeleteSeriesStmt()
+		}
+
+	case 17:
+		{
+			p.SetState(274)
 			p.Ident()
 		}
+
+	}
+	p.SetState(278)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_FORMAT {
 		{
-			p.SetState(206)
-			p.Match(SQLParserEOF)
+			p.SetState(277)
+			p.FormatClause()
 		}
 
 	}
+	{
+		p.SetState(280)
+		p.Match(SQLParserEOF)
+	}
 
 	return localctx
 }
 
-// IUseStmtContext is an interface to support dynamic dispatch.
-type IUseStmtContext interface {
+// IFormatClauseContext is an interface to support dynamic dispatch.
+type IFormatClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_USE() antlr.TerminalNode
+	T_FORMAT() antlr.TerminalNode
 	Ident() IIdentContext
 
-	// IsUseStmtContext differentiates from other interfaces.
-	IsUseStmtContext()
+	// IsFormatClauseContext differentiates from other interfaces.
+	IsFormatClauseContext()
 }
 
-type UseStmtContext struct {
+type FormatClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUseStmtContext() *UseStmtContext {
-	var p = new(UseStmtContext)
+func NewEmptyFormatClauseContext() *FormatClauseContext {
+	var p = new(FormatClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_useStmt
+	p.RuleIndex = SQLParserRULE_formatClause
 	return p
 }
 
-func (*UseStmtContext) IsUseStmtContext() {}
+func (*FormatClauseContext) IsFormatClauseContext() {}
 
-func NewUseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UseStmtContext {
-	var p = new(UseStmtContext)
+func NewFormatClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormatClauseContext {
+	var p = new(FormatClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_useStmt
+	p.RuleIndex = SQLParserRULE_formatClause
 
 	return p
 }
 
-func (s *UseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *FormatClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UseStmtContext) T_USE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_USE, 0)
+func (s *FormatClauseContext) T_FORMAT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FORMAT, 0)
 }
 
-func (s *UseStmtContext) Ident() IIdentContext {
+func (s *FormatClauseContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -1169,42 +1560,42 @@ func (s *UseStmtContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *UseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *FormatClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FormatClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FormatClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterUseStmt(s)
+		listenerT.EnterFormatClause(s)
 	}
 }
 
-func (s *UseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FormatClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitUseStmt(s)
+		listenerT.ExitFormatClause(s)
 	}
 }
 
-func (s *UseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FormatClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitUseStmt(s)
+		return t.VisitFormatClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
+func (p *SQLParser) FormatClause() (localctx IFormatClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewUseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, SQLParserRULE_useStmt)
+	localctx = NewFormatClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, SQLParserRULE_formatClause)
 
 	defer func() {
 		p.ExitRule()
@@ -1224,72 +1615,67 @@ func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(210)
-		p.Match(SQLParserT_USE)
+		p.SetState(282)
+		p.Match(SQLParserT_FORMAT)
 	}
 	{
-		p.SetState(211)
+		p.SetState(283)
 		p.Ident()
 	}
 
 	return localctx
 }
 
-// ISetLimitStmtContext is an interface to support dynamic dispatch.
-type ISetLimitStmtContext interface {
+// IUseStmtContext is an interface to support dynamic dispatch.
+type IUseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SET() antlr.TerminalNode
-	T_LIMIT() antlr.TerminalNode
-	Toml() ITomlContext
+	T_USE() antlr.TerminalNode
+	Ident() IIdentContext
 
-	// IsSetLimitStmtContext differentiates from other interfaces.
-	IsSetLimitStmtContext()
+	// IsUseStmtContext differentiates from other interfaces.
+	IsUseStmtContext()
 }
 
-type SetLimitStmtContext struct {
+type UseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySetLimitStmtContext() *SetLimitStmtContext {
-	var p = new(SetLimitStmtContext)
+func NewEmptyUseStmtContext() *UseStmtContext {
+	var p = new(UseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_setLimitStmt
+	p.RuleIndex = SQLParserRULE_useStmt
 	return p
 }
 
-func (*SetLimitStmtContext) IsSetLimitStmtContext() {}
+func (*UseStmtContext) IsUseStmtContext() {}
 
-func NewSetLimitStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetLimitStmtContext {
-	var p = new(SetLimitStmtContext)
+func NewUseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UseStmtContext {
+	var p = new(UseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_setLimitStmt
+	p.RuleIndex = SQLParserRULE_useStmt
 
 	return p
 }
 
-func (s *SetLimitStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SetLimitStmtContext) T_SET() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SET, 0)
-}
+func (s *UseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SetLimitStmtContext) T_LIMIT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_LIMIT, 0)
+func (s *UseStmtContext) T_USE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USE, 0)
 }
 
-func (s *SetLimitStmtContext) Toml() ITomlContext {
+func (s *UseStmtContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITomlContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1299,45 +1685,45 @@ func (s *SetLimitStmtContext) Toml() ITomlContext {
 		return nil
 	}
 
-	return t.(ITomlContext)
+	return t.(IIdentContext)
 }
 
-func (s *SetLimitStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *UseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SetLimitStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SetLimitStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSetLimitStmt(s)
+		listenerT.EnterUseStmt(s)
 	}
 }
 
-func (s *SetLimitStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSetLimitStmt(s)
+		listenerT.ExitUseStmt(s)
 	}
 }
 
-func (s *SetLimitStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSetLimitStmt(s)
+		return t.VisitUseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
+func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewSetLimitStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, SQLParserRULE_setLimitStmt)
+	localctx = NewUseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, SQLParserRULE_useStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -1357,105 +1743,72 @@ func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
-		p.Match(SQLParserT_SET)
-	}
-	{
-		p.SetState(214)
-		p.Match(SQLParserT_LIMIT)
+		p.SetState(285)
+		p.Match(SQLParserT_USE)
 	}
 	{
-		p.SetState(215)
-		p.Toml()
+		p.SetState(286)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IShowStmtContext is an interface to support dynamic dispatch.
-type IShowStmtContext interface {
+// ISetLimitStmtContext is an interface to support dynamic dispatch.
+type ISetLimitStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ShowMasterStmt() IShowMasterStmtContext
-	ShowMetadataTypesStmt() IShowMetadataTypesStmtContext
-	ShowRootMetaStmt() IShowRootMetaStmtContext
-	ShowBrokerMetaStmt() IShowBrokerMetaStmtContext
-	ShowMasterMetaStmt() IShowMasterMetaStmtContext
-	ShowStorageMetaStmt() IShowStorageMetaStmtContext
-	ShowStoragesStmt() IShowStoragesStmtContext
-	ShowBrokersStmt() IShowBrokersStmtContext
-	ShowLimitStmt() IShowLimitStmtContext
-	ShowAliveStmt() IShowAliveStmtContext
-	ShowRootMetricStmt() IShowRootMetricStmtContext
-	ShowBrokerMetricStmt() IShowBrokerMetricStmtContext
-	ShowStorageMetricStmt() IShowStorageMetricStmtContext
-	ShowReplicationStmt() IShowReplicationStmtContext
-	ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContext
-	ShowSchemasStmt() IShowSchemasStmtContext
-	ShowDatabaseStmt() IShowDatabaseStmtContext
-	ShowNameSpacesStmt() IShowNameSpacesStmtContext
-	ShowMetricsStmt() IShowMetricsStmtContext
-	ShowFieldsStmt() IShowFieldsStmtContext
-	ShowTagKeysStmt() IShowTagKeysStmtContext
-	ShowTagValuesStmt() IShowTagValuesStmtContext
-	ShowRequestsStmt() IShowRequestsStmtContext
-	ShowRequestStmt() IShowRequestStmtContext
+	T_SET() antlr.TerminalNode
+	T_LIMIT() antlr.TerminalNode
+	Toml() ITomlContext
 
-	// IsShowStmtContext differentiates from other interfaces.
-	IsShowStmtContext()
+	// IsSetLimitStmtContext differentiates from other interfaces.
+	IsSetLimitStmtContext()
 }
 
-type ShowStmtContext struct {
+type SetLimitStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStmtContext() *ShowStmtContext {
-	var p = new(ShowStmtContext)
+func NewEmptySetLimitStmtContext() *SetLimitStmtContext {
+	var p = new(SetLimitStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStmt
+	p.RuleIndex = SQLParserRULE_setLimitStmt
 	return p
 }
 
-func (*ShowStmtContext) IsShowStmtContext() {}
+func (*SetLimitStmtContext) IsSetLimitStmtContext() {}
 
-func NewShowStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStmtContext {
-	var p = new(ShowStmtContext)
+func NewSetLimitStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetLimitStmtContext {
+	var p = new(SetLimitStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStmt
+	p.RuleIndex = SQLParserRULE_setLimitStmt
 
 	return p
 }
 
-func (s *ShowStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowStmtContext) ShowMasterStmt() IShowMasterStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMasterStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *SetLimitStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *SetLimitStmtContext) T_SET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SET, 0)
+}
 
-	return t.(IShowMasterStmtContext)
+func (s *SetLimitStmtContext) T_LIMIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMIT, 0)
 }
 
-func (s *ShowStmtContext) ShowMetadataTypesStmt() IShowMetadataTypesStmtContext {
+func (s *SetLimitStmtContext) Toml() ITomlContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMetadataTypesStmtContext); ok {
+		if _, ok := ctx.(ITomlContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1465,111 +1818,169 @@ func (s *ShowStmtContext) ShowMetadataTypesStmt() IShowMetadataTypesStmtContext
 		return nil
 	}
 
-	return t.(IShowMetadataTypesStmtContext)
+	return t.(ITomlContext)
 }
 
-func (s *ShowStmtContext) ShowRootMetaStmt() IShowRootMetaStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowRootMetaStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *SetLimitStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SetLimitStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SetLimitStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSetLimitStmt(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *SetLimitStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSetLimitStmt(s)
 	}
+}
 
-	return t.(IShowRootMetaStmtContext)
+func (s *SetLimitStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSetLimitStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *ShowStmtContext) ShowBrokerMetaStmt() IShowBrokerMetaStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowBrokerMetaStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewSetLimitStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, SQLParserRULE_setLimitStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
-	}
+	}()
 
-	if t == nil {
-		return nil
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(288)
+		p.Match(SQLParserT_SET)
+	}
+	{
+		p.SetState(289)
+		p.Match(SQLParserT_LIMIT)
+	}
+	{
+		p.SetState(290)
+		p.Toml()
 	}
 
-	return t.(IShowBrokerMetaStmtContext)
+	return localctx
 }
 
-func (s *ShowStmtContext) ShowMasterMetaStmt() IShowMasterMetaStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMasterMetaStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+// ISetLogLevelStmtContext is an interface to support dynamic dispatch.
+type ISetLogLevelStmtContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(IShowMasterMetaStmtContext)
+	// Getter signatures
+	T_SET() antlr.TerminalNode
+	T_LOG() antlr.TerminalNode
+	T_LEVEL() antlr.TerminalNode
+	AllLogLevel() []ILogLevelContext
+	LogLevel(i int) ILogLevelContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
+
+	// IsSetLogLevelStmtContext differentiates from other interfaces.
+	IsSetLogLevelStmtContext()
 }
 
-func (s *ShowStmtContext) ShowStorageMetaStmt() IShowStorageMetaStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowStorageMetaStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+type SetLogLevelStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	if t == nil {
-		return nil
-	}
+func NewEmptySetLogLevelStmtContext() *SetLogLevelStmtContext {
+	var p = new(SetLogLevelStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_setLogLevelStmt
+	return p
+}
 
-	return t.(IShowStorageMetaStmtContext)
+func (*SetLogLevelStmtContext) IsSetLogLevelStmtContext() {}
+
+func NewSetLogLevelStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetLogLevelStmtContext {
+	var p = new(SetLogLevelStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_setLogLevelStmt
+
+	return p
 }
 
-func (s *ShowStmtContext) ShowStoragesStmt() IShowStoragesStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowStoragesStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *SetLogLevelStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *SetLogLevelStmtContext) T_SET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SET, 0)
+}
 
-	return t.(IShowStoragesStmtContext)
+func (s *SetLogLevelStmtContext) T_LOG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG, 0)
 }
 
-func (s *ShowStmtContext) ShowBrokersStmt() IShowBrokersStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowBrokersStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *SetLogLevelStmtContext) T_LEVEL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LEVEL, 0)
+}
+
+func (s *SetLogLevelStmtContext) AllLogLevel() []ILogLevelContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILogLevelContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]ILogLevelContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILogLevelContext); ok {
+			tst[i] = t.(ILogLevelContext)
+			i++
+		}
 	}
 
-	return t.(IShowBrokersStmtContext)
+	return tst
 }
 
-func (s *ShowStmtContext) ShowLimitStmt() IShowLimitStmtContext {
+func (s *SetLogLevelStmtContext) LogLevel(i int) ILogLevelContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowLimitStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ILogLevelContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -1577,12 +1988,507 @@ func (s *ShowStmtContext) ShowLimitStmt() IShowLimitStmtContext {
 		return nil
 	}
 
-	return t.(IShowLimitStmtContext)
+	return t.(ILogLevelContext)
 }
 
-func (s *ShowStmtContext) ShowAliveStmt() IShowAliveStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
+func (s *SetLogLevelStmtContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *SetLogLevelStmtContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *SetLogLevelStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SetLogLevelStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SetLogLevelStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSetLogLevelStmt(s)
+	}
+}
+
+func (s *SetLogLevelStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSetLogLevelStmt(s)
+	}
+}
+
+func (s *SetLogLevelStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSetLogLevelStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) SetLogLevelStmt() (localctx ISetLogLevelStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewSetLogLevelStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, SQLParserRULE_setLogLevelStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(292)
+		p.Match(SQLParserT_SET)
+	}
+	{
+		p.SetState(293)
+		p.Match(SQLParserT_LOG)
+	}
+	{
+		p.SetState(294)
+		p.Match(SQLParserT_LEVEL)
+	}
+	{
+		p.SetState(295)
+		p.LogLevel()
+	}
+	p.SetState(300)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SQLParserT_COMMA {
+		{
+			p.SetState(296)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(297)
+			p.LogLevel()
+		}
+
+		p.SetState(302)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ILogLevelContext is an interface to support dynamic dispatch.
+type ILogLevelContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllIdent() []IIdentContext
+	Ident(i int) IIdentContext
+	T_EQUAL() antlr.TerminalNode
+
+	// IsLogLevelContext differentiates from other interfaces.
+	IsLogLevelContext()
+}
+
+type LogLevelContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLogLevelContext() *LogLevelContext {
+	var p = new(LogLevelContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_logLevel
+	return p
+}
+
+func (*LogLevelContext) IsLogLevelContext() {}
+
+func NewLogLevelContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LogLevelContext {
+	var p = new(LogLevelContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_logLevel
+
+	return p
+}
+
+func (s *LogLevelContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LogLevelContext) AllIdent() []IIdentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIdentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIdentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIdentContext); ok {
+			tst[i] = t.(IIdentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *LogLevelContext) Ident(i int) IIdentContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *LogLevelContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *LogLevelContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LogLevelContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LogLevelContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterLogLevel(s)
+	}
+}
+
+func (s *LogLevelContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitLogLevel(s)
+	}
+}
+
+func (s *LogLevelContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitLogLevel(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) LogLevel() (localctx ILogLevelContext) {
+	this := p
+	_ = this
+
+	localctx = NewLogLevelContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, SQLParserRULE_logLevel)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(303)
+		p.Ident()
+	}
+	{
+		p.SetState(304)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(305)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IShowStmtContext is an interface to support dynamic dispatch.
+type IShowStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ShowMasterStmt() IShowMasterStmtContext
+	ShowMetadataTypesStmt() IShowMetadataTypesStmtContext
+	ShowRootMetaStmt() IShowRootMetaStmtContext
+	ShowBrokerMetaStmt() IShowBrokerMetaStmtContext
+	ShowMasterMetaStmt() IShowMasterMetaStmtContext
+	ShowStorageMetaStmt() IShowStorageMetaStmtContext
+	ShowStoragesStmt() IShowStoragesStmtContext
+	ShowBrokersStmt() IShowBrokersStmtContext
+	ShowLimitStmt() IShowLimitStmtContext
+	ShowLimitStatusStmt() IShowLimitStatusStmtContext
+	ShowAliveStmt() IShowAliveStmtContext
+	ShowRootMetricStmt() IShowRootMetricStmtContext
+	ShowBrokerMetricStmt() IShowBrokerMetricStmtContext
+	ShowStorageMetricStmt() IShowStorageMetricStmtContext
+	ShowReplicationStmt() IShowReplicationStmtContext
+	ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContext
+	ShowSchemasStmt() IShowSchemasStmtContext
+	ShowDatabaseStmt() IShowDatabaseStmtContext
+	ShowNameSpacesStmt() IShowNameSpacesStmtContext
+	ShowMetricsStmt() IShowMetricsStmtContext
+	ShowFieldsStmt() IShowFieldsStmtContext
+	ShowFieldUsageStmt() IShowFieldUsageStmtContext
+	ShowTagKeysStmt() IShowTagKeysStmtContext
+	ShowTagValuesStmt() IShowTagValuesStmtContext
+	ShowRequestsStmt() IShowRequestsStmtContext
+	ShowRequestStmt() IShowRequestStmtContext
+	ShowShardsStmt() IShowShardsStmtContext
+	ShowSegmentsStmt() IShowSegmentsStmtContext
+	ShowDiskUsageStmt() IShowDiskUsageStmtContext
+	ShowFileDetailStmt() IShowFileDetailStmtContext
+	ShowReplicationChannelsStmt() IShowReplicationChannelsStmtContext
+	ShowExpiredMetricsStmt() IShowExpiredMetricsStmtContext
+	ShowLogLevelsStmt() IShowLogLevelsStmtContext
+	ShowPlacementStmt() IShowPlacementStmtContext
+	ShowReadOnlyStmt() IShowReadOnlyStmtContext
+	ShowCompactionStmt() IShowCompactionStmtContext
+
+	// IsShowStmtContext differentiates from other interfaces.
+	IsShowStmtContext()
+}
+
+type ShowStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStmtContext() *ShowStmtContext {
+	var p = new(ShowStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStmt
+	return p
+}
+
+func (*ShowStmtContext) IsShowStmtContext() {}
+
+func NewShowStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStmtContext {
+	var p = new(ShowStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStmt
+
+	return p
+}
+
+func (s *ShowStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStmtContext) ShowMasterStmt() IShowMasterStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMasterStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMasterStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMetadataTypesStmt() IShowMetadataTypesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMetadataTypesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMetadataTypesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRootMetaStmt() IShowRootMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRootMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRootMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowBrokerMetaStmt() IShowBrokerMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowBrokerMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowBrokerMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMasterMetaStmt() IShowMasterMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMasterMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMasterMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowStorageMetaStmt() IShowStorageMetaStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowStorageMetaStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowStorageMetaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowStoragesStmt() IShowStoragesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowStoragesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowStoragesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowBrokersStmt() IShowBrokersStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowBrokersStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowBrokersStmtContext)
+}
+
+func (s *ShowStmtContext) ShowLimitStmt() IShowLimitStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowLimitStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowLimitStmtContext)
+}
+
+func (s *ShowStmtContext) ShowLimitStatusStmt() IShowLimitStatusStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowLimitStatusStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowLimitStatusStmtContext)
+}
+
+func (s *ShowStmtContext) ShowAliveStmt() IShowAliveStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IShowAliveStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
@@ -1593,45 +2499,4988 @@ func (s *ShowStmtContext) ShowAliveStmt() IShowAliveStmtContext {
 		return nil
 	}
 
-	return t.(IShowAliveStmtContext)
+	return t.(IShowAliveStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRootMetricStmt() IShowRootMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRootMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRootMetricStmtContext)
+}
+
+func (s *ShowStmtContext) ShowBrokerMetricStmt() IShowBrokerMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowBrokerMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowBrokerMetricStmtContext)
+}
+
+func (s *ShowStmtContext) ShowStorageMetricStmt() IShowStorageMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowStorageMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowStorageMetricStmtContext)
+}
+
+func (s *ShowStmtContext) ShowReplicationStmt() IShowReplicationStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowReplicationStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowReplicationStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMemoryDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMemoryDatabaseStmtContext)
+}
+
+func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowSchemasStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowSchemasStmtContext)
+}
+
+func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowDatabaseStmtContext)
+}
+
+func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowNameSpacesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowNameSpacesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMetricsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMetricsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowFieldsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowFieldsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowFieldUsageStmt() IShowFieldUsageStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowFieldUsageStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowFieldUsageStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTagKeysStmt() IShowTagKeysStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTagKeysStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagKeysStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTagValuesStmt() IShowTagValuesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTagValuesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagValuesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRequestsStmt() IShowRequestsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRequestsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRequestsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRequestStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRequestStmtContext)
+}
+
+func (s *ShowStmtContext) ShowShardsStmt() IShowShardsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowShardsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowShardsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowSegmentsStmt() IShowSegmentsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowSegmentsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowSegmentsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowDiskUsageStmt() IShowDiskUsageStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowDiskUsageStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowDiskUsageStmtContext)
+}
+
+func (s *ShowStmtContext) ShowFileDetailStmt() IShowFileDetailStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowFileDetailStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowFileDetailStmtContext)
+}
+
+func (s *ShowStmtContext) ShowReplicationChannelsStmt() IShowReplicationChannelsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowReplicationChannelsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowReplicationChannelsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowExpiredMetricsStmt() IShowExpiredMetricsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowExpiredMetricsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowExpiredMetricsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowLogLevelsStmt() IShowLogLevelsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowLogLevelsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowLogLevelsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowPlacementStmt() IShowPlacementStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowPlacementStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowPlacementStmtContext)
+}
+
+func (s *ShowStmtContext) ShowReadOnlyStmt() IShowReadOnlyStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowReadOnlyStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowReadOnlyStmtContext)
+}
+
+func (s *ShowStmtContext) ShowCompactionStmt() IShowCompactionStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowCompactionStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowCompactionStmtContext)
+}
+
+func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStmt(s)
+	}
+}
+
+func (s *ShowStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStmt(s)
+	}
+}
+
+func (s *ShowStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, SQLParserRULE_showStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(343)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(307)
+			p.ShowMasterStmt()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(308)
+			p.ShowMetadataTypesStmt()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(309)
+			p.ShowRootMetaStmt()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(310)
+			p.ShowBrokerMetaStmt()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(311)
+			p.ShowMasterMetaStmt()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(312)
+			p.ShowStorageMetaStmt()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(313)
+			p.ShowStoragesStmt()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(314)
+			p.ShowBrokersStmt()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(315)
+			p.ShowLimitStmt()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(316)
+			p.ShowLimitStatusStmt()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(317)
+			p.ShowAliveStmt()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(318)
+			p.ShowRootMetricStmt()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(319)
+			p.ShowBrokerMetricStmt()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(320)
+			p.ShowStorageMetricStmt()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(321)
+			p.ShowReplicationStmt()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(322)
+			p.ShowMemoryDatabaseStmt()
+		}
+
+	case 17:
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(323)
+			p.ShowSchemasStmt()
+		}
+
+	case 18:
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(324)
+			p.ShowDatabaseStmt()
+		}
+
+	case 19:
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(325)
+			p.ShowNameSpacesStmt()
+		}
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(326)
+			p.ShowMetricsStmt()
+		}
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(327)
+			p.ShowFieldsStmt()
+		}
+
+	case 22:
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(328)
+			p.ShowFieldUsageStmt()
+		}
+
+	case 23:
+		p.EnterOuterAlt(localctx, 23)
+		{
+			p.SetState(329)
+			p.ShowTagKeysStmt()
+		}
+
+	case 24:
+		p.EnterOuterAlt(localctx, 24)
+		{
+			p.SetState(330)
+			p.ShowTagValuesStmt()
+		}
+
+	case 25:
+		p.EnterOuterAlt(localctx, 25)
+		{
+			p.SetState(331)
+			p.ShowRequestsStmt()
+		}
+
+	case 26:
+		p.EnterOuterAlt(localctx, 26)
+		{
+			p.SetState(332)
+			p.ShowRequestStmt()
+		}
+
+	case 27:
+		p.EnterOuterAlt(localctx, 27)
+		{
+			p.SetState(333)
+			p.ShowShardsStmt()
+		}
+
+	case 28:
+		p.EnterOuterAlt(localctx, 28)
+		{
+			p.SetState(334)
+			p.ShowSegmentsStmt()
+		}
+
+	case 29:
+		p.EnterOuterAlt(localctx, 29)
+		{
+			p.SetState(335)
+			p.ShowDiskUsageStmt()
+		}
+
+	case 30:
+		p.EnterOuterAlt(localctx, 30)
+		{
+			p.SetState(336)
+			p.ShowFileDetailStmt()
+		}
+
+	case 31:
+		p.EnterOuterAlt(localctx, 31)
+		{
+			p.SetState(337)
+			p.ShowReplicationChannelsStmt()
+		}
+
+	case 32:
+		p.EnterOuterAlt(localctx, 32)
+		{
+			p.SetState(338)
+			p.ShowExpiredMetricsStmt()
+		}
+
+	case 33:
+		p.EnterOuterAlt(localctx, 33)
+		{
+			p.SetState(339)
+			p.ShowLogLevelsStmt()
+		}
+
+	case 34:
+		p.EnterOuterAlt(localctx, 34)
+		{
+			p.SetState(340)
+			p.ShowPlacementStmt()
+		}
+
+	case 35:
+		p.EnterOuterAlt(localctx, 35)
+		{
+			p.SetState(341)
+			p.ShowReadOnlyStmt()
+		}
+
+	case 36:
+		p.EnterOuterAlt(localctx, 36)
+		{
+			p.SetState(342)
+			p.ShowCompactionStmt()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowMasterStmtContext is an interface to support dynamic dispatch.
+type IShowMasterStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_MASTER() antlr.TerminalNode
+
+	// IsShowMasterStmtContext differentiates from other interfaces.
+	IsShowMasterStmtContext()
+}
+
+type ShowMasterStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMasterStmtContext() *ShowMasterStmtContext {
+	var p = new(ShowMasterStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMasterStmt
+	return p
+}
+
+func (*ShowMasterStmtContext) IsShowMasterStmtContext() {}
+
+func NewShowMasterStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterStmtContext {
+	var p = new(ShowMasterStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMasterStmt
+
+	return p
+}
+
+func (s *ShowMasterStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMasterStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMasterStmtContext) T_MASTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MASTER, 0)
+}
+
+func (s *ShowMasterStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMasterStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMasterStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMasterStmt(s)
+	}
+}
+
+func (s *ShowMasterStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMasterStmt(s)
+	}
+}
+
+func (s *ShowMasterStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMasterStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMasterStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, SQLParserRULE_showMasterStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(345)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(346)
+		p.Match(SQLParserT_MASTER)
+	}
+
+	return localctx
+}
+
+// IShowRequestsStmtContext is an interface to support dynamic dispatch.
+type IShowRequestsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_REQUESTS() antlr.TerminalNode
+
+	// IsShowRequestsStmtContext differentiates from other interfaces.
+	IsShowRequestsStmtContext()
+}
+
+type ShowRequestsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRequestsStmtContext() *ShowRequestsStmtContext {
+	var p = new(ShowRequestsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRequestsStmt
+	return p
+}
+
+func (*ShowRequestsStmtContext) IsShowRequestsStmtContext() {}
+
+func NewShowRequestsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestsStmtContext {
+	var p = new(ShowRequestsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRequestsStmt
+
+	return p
+}
+
+func (s *ShowRequestsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRequestsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRequestsStmtContext) T_REQUESTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REQUESTS, 0)
+}
+
+func (s *ShowRequestsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRequestsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRequestsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRequestsStmt(s)
+	}
+}
+
+func (s *ShowRequestsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRequestsStmt(s)
+	}
+}
+
+func (s *ShowRequestsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRequestsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowRequestsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, SQLParserRULE_showRequestsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(348)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(349)
+		p.Match(SQLParserT_REQUESTS)
+	}
+
+	return localctx
+}
+
+// IShowRequestStmtContext is an interface to support dynamic dispatch.
+type IShowRequestStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_REQUEST() antlr.TerminalNode
+	T_WHERE() antlr.TerminalNode
+	T_ID() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	RequestID() IRequestIDContext
+
+	// IsShowRequestStmtContext differentiates from other interfaces.
+	IsShowRequestStmtContext()
+}
+
+type ShowRequestStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRequestStmtContext() *ShowRequestStmtContext {
+	var p = new(ShowRequestStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRequestStmt
+	return p
+}
+
+func (*ShowRequestStmtContext) IsShowRequestStmtContext() {}
+
+func NewShowRequestStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestStmtContext {
+	var p = new(ShowRequestStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRequestStmt
+
+	return p
+}
+
+func (s *ShowRequestStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRequestStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRequestStmtContext) T_REQUEST() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REQUEST, 0)
+}
+
+func (s *ShowRequestStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowRequestStmtContext) T_ID() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ID, 0)
+}
+
+func (s *ShowRequestStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowRequestStmtContext) RequestID() IRequestIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRequestIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRequestIDContext)
+}
+
+func (s *ShowRequestStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRequestStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRequestStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRequestStmt(s)
+	}
+}
+
+func (s *ShowRequestStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRequestStmt(s)
+	}
+}
+
+func (s *ShowRequestStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRequestStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowRequestStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, SQLParserRULE_showRequestStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(351)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(352)
+		p.Match(SQLParserT_REQUEST)
+	}
+	{
+		p.SetState(353)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(354)
+		p.Match(SQLParserT_ID)
+	}
+	{
+		p.SetState(355)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(356)
+		p.RequestID()
+	}
+
+	return localctx
+}
+
+// IShowStoragesStmtContext is an interface to support dynamic dispatch.
+type IShowStoragesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_STORAGES() antlr.TerminalNode
+
+	// IsShowStoragesStmtContext differentiates from other interfaces.
+	IsShowStoragesStmtContext()
+}
+
+type ShowStoragesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStoragesStmtContext() *ShowStoragesStmtContext {
+	var p = new(ShowStoragesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	return p
+}
+
+func (*ShowStoragesStmtContext) IsShowStoragesStmtContext() {}
+
+func NewShowStoragesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStoragesStmtContext {
+	var p = new(ShowStoragesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStoragesStmt
+
+	return p
+}
+
+func (s *ShowStoragesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStoragesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowStoragesStmtContext) T_STORAGES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGES, 0)
+}
+
+func (s *ShowStoragesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStoragesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStoragesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStoragesStmt(s)
+	}
+}
+
+func (s *ShowStoragesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStoragesStmt(s)
+	}
+}
+
+func (s *ShowStoragesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStoragesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStoragesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SQLParserRULE_showStoragesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(358)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(359)
+		p.Match(SQLParserT_STORAGES)
+	}
+
+	return localctx
+}
+
+// IShowBrokersStmtContext is an interface to support dynamic dispatch.
+type IShowBrokersStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_BROKERS() antlr.TerminalNode
+
+	// IsShowBrokersStmtContext differentiates from other interfaces.
+	IsShowBrokersStmtContext()
+}
+
+type ShowBrokersStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowBrokersStmtContext() *ShowBrokersStmtContext {
+	var p = new(ShowBrokersStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokersStmt
+	return p
+}
+
+func (*ShowBrokersStmtContext) IsShowBrokersStmtContext() {}
+
+func NewShowBrokersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokersStmtContext {
+	var p = new(ShowBrokersStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokersStmt
+
+	return p
+}
+
+func (s *ShowBrokersStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokersStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowBrokersStmtContext) T_BROKERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKERS, 0)
+}
+
+func (s *ShowBrokersStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowBrokersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowBrokersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowBrokersStmt(s)
+	}
+}
+
+func (s *ShowBrokersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowBrokersStmt(s)
+	}
+}
+
+func (s *ShowBrokersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowBrokersStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowBrokersStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SQLParserRULE_showBrokersStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(361)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(362)
+		p.Match(SQLParserT_BROKERS)
+	}
+
+	return localctx
+}
+
+// IShowLimitStmtContext is an interface to support dynamic dispatch.
+type IShowLimitStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_LIMIT() antlr.TerminalNode
+
+	// IsShowLimitStmtContext differentiates from other interfaces.
+	IsShowLimitStmtContext()
+}
+
+type ShowLimitStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowLimitStmtContext() *ShowLimitStmtContext {
+	var p = new(ShowLimitStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showLimitStmt
+	return p
+}
+
+func (*ShowLimitStmtContext) IsShowLimitStmtContext() {}
+
+func NewShowLimitStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowLimitStmtContext {
+	var p = new(ShowLimitStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showLimitStmt
+
+	return p
+}
+
+func (s *ShowLimitStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowLimitStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowLimitStmtContext) T_LIMIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMIT, 0)
+}
+
+func (s *ShowLimitStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowLimitStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowLimitStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowLimitStmt(s)
+	}
+}
+
+func (s *ShowLimitStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowLimitStmt(s)
+	}
+}
+
+func (s *ShowLimitStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowLimitStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowLimitStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SQLParserRULE_showLimitStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(364)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(365)
+		p.Match(SQLParserT_LIMIT)
+	}
+
+	return localctx
+}
+
+// IShowLimitStatusStmtContext is an interface to support dynamic dispatch.
+type IShowLimitStatusStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_LIMIT() antlr.TerminalNode
+	T_STATUS() antlr.TerminalNode
+
+	// IsShowLimitStatusStmtContext differentiates from other interfaces.
+	IsShowLimitStatusStmtContext()
+}
+
+type ShowLimitStatusStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowLimitStatusStmtContext() *ShowLimitStatusStmtContext {
+	var p = new(ShowLimitStatusStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showLimitStatusStmt
+	return p
+}
+
+func (*ShowLimitStatusStmtContext) IsShowLimitStatusStmtContext() {}
+
+func NewShowLimitStatusStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowLimitStatusStmtContext {
+	var p = new(ShowLimitStatusStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showLimitStatusStmt
+
+	return p
+}
+
+func (s *ShowLimitStatusStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowLimitStatusStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowLimitStatusStmtContext) T_LIMIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMIT, 0)
+}
+
+func (s *ShowLimitStatusStmtContext) T_STATUS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATUS, 0)
+}
+
+func (s *ShowLimitStatusStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowLimitStatusStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowLimitStatusStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowLimitStatusStmt(s)
+	}
+}
+
+func (s *ShowLimitStatusStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowLimitStatusStmt(s)
+	}
+}
+
+func (s *ShowLimitStatusStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowLimitStatusStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowLimitStatusStmt() (localctx IShowLimitStatusStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowLimitStatusStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, SQLParserRULE_showLimitStatusStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(367)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(368)
+		p.Match(SQLParserT_LIMIT)
+	}
+	{
+		p.SetState(369)
+		p.Match(SQLParserT_STATUS)
+	}
+
+	return localctx
+}
+
+// IShowMetadataTypesStmtContext is an interface to support dynamic dispatch.
+type IShowMetadataTypesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_METADATA() antlr.TerminalNode
+	T_TYPES() antlr.TerminalNode
+
+	// IsShowMetadataTypesStmtContext differentiates from other interfaces.
+	IsShowMetadataTypesStmtContext()
+}
+
+type ShowMetadataTypesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMetadataTypesStmtContext() *ShowMetadataTypesStmtContext {
+	var p = new(ShowMetadataTypesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+	return p
+}
+
+func (*ShowMetadataTypesStmtContext) IsShowMetadataTypesStmtContext() {}
+
+func NewShowMetadataTypesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetadataTypesStmtContext {
+	var p = new(ShowMetadataTypesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+
+	return p
+}
+
+func (s *ShowMetadataTypesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMetadataTypesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetadataTypesStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowMetadataTypesStmtContext) T_TYPES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TYPES, 0)
+}
+
+func (s *ShowMetadataTypesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMetadataTypesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMetadataTypesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMetadataTypesStmt(s)
+	}
+}
+
+func (s *ShowMetadataTypesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMetadataTypesStmt(s)
+	}
+}
+
+func (s *ShowMetadataTypesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMetadataTypesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMetadataTypesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SQLParserRULE_showMetadataTypesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(371)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(372)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(373)
+		p.Match(SQLParserT_TYPES)
+	}
+
+	return localctx
+}
+
+// IShowRootMetaStmtContext is an interface to support dynamic dispatch.
+type IShowRootMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_ROOT() antlr.TerminalNode
+	T_METADATA() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	Source() ISourceContext
+	T_WHERE() antlr.TerminalNode
+	TypeFilter() ITypeFilterContext
+
+	// IsShowRootMetaStmtContext differentiates from other interfaces.
+	IsShowRootMetaStmtContext()
+}
+
+type ShowRootMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRootMetaStmtContext() *ShowRootMetaStmtContext {
+	var p = new(ShowRootMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRootMetaStmt
+	return p
+}
+
+func (*ShowRootMetaStmtContext) IsShowRootMetaStmtContext() {}
+
+func NewShowRootMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRootMetaStmtContext {
+	var p = new(ShowRootMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRootMetaStmt
+
+	return p
+}
+
+func (s *ShowRootMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowRootMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowRootMetaStmtContext) T_ROOT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROOT, 0)
+}
+
+func (s *ShowRootMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowRootMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowRootMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowRootMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowRootMetaStmtContext) TypeFilter() ITypeFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowRootMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowRootMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowRootMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRootMetaStmt(s)
+	}
+}
+
+func (s *ShowRootMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRootMetaStmt(s)
+	}
+}
+
+func (s *ShowRootMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRootMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowRootMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, SQLParserRULE_showRootMetaStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(375)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(376)
+		p.Match(SQLParserT_ROOT)
+	}
+	{
+		p.SetState(377)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(378)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(379)
+		p.Source()
+	}
+	{
+		p.SetState(380)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(381)
+		p.TypeFilter()
+	}
+
+	return localctx
+}
+
+// IShowBrokerMetaStmtContext is an interface to support dynamic dispatch.
+type IShowBrokerMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_BROKER() antlr.TerminalNode
+	T_METADATA() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	Source() ISourceContext
+	T_WHERE() antlr.TerminalNode
+	TypeFilter() ITypeFilterContext
+	T_AND() antlr.TerminalNode
+	BrokerFilter() IBrokerFilterContext
+
+	// IsShowBrokerMetaStmtContext differentiates from other interfaces.
+	IsShowBrokerMetaStmtContext()
+}
+
+type ShowBrokerMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowBrokerMetaStmtContext() *ShowBrokerMetaStmtContext {
+	var p = new(ShowBrokerMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+	return p
+}
+
+func (*ShowBrokerMetaStmtContext) IsShowBrokerMetaStmtContext() {}
+
+func NewShowBrokerMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetaStmtContext {
+	var p = new(ShowBrokerMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+
+	return p
+}
+
+func (s *ShowBrokerMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokerMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) TypeFilter() ITypeFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowBrokerMetaStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowBrokerMetaStmtContext) BrokerFilter() IBrokerFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBrokerFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBrokerFilterContext)
+}
+
+func (s *ShowBrokerMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowBrokerMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowBrokerMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowBrokerMetaStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowBrokerMetaStmt(s)
+	}
+}
+
+func (s *ShowBrokerMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowBrokerMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowBrokerMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SQLParserRULE_showBrokerMetaStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(383)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(384)
+		p.Match(SQLParserT_BROKER)
+	}
+	{
+		p.SetState(385)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(386)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(387)
+		p.Source()
+	}
+	{
+		p.SetState(388)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(389)
+		p.TypeFilter()
+	}
+	p.SetState(392)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_AND {
+		{
+			p.SetState(390)
+			p.Match(SQLParserT_AND)
+		}
+		{
+			p.SetState(391)
+			p.BrokerFilter()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowMasterMetaStmtContext is an interface to support dynamic dispatch.
+type IShowMasterMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_MASTER() antlr.TerminalNode
+	T_METADATA() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	Source() ISourceContext
+	T_WHERE() antlr.TerminalNode
+	TypeFilter() ITypeFilterContext
+
+	// IsShowMasterMetaStmtContext differentiates from other interfaces.
+	IsShowMasterMetaStmtContext()
+}
+
+type ShowMasterMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMasterMetaStmtContext() *ShowMasterMetaStmtContext {
+	var p = new(ShowMasterMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+	return p
+}
+
+func (*ShowMasterMetaStmtContext) IsShowMasterMetaStmtContext() {}
+
+func NewShowMasterMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterMetaStmtContext {
+	var p = new(ShowMasterMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+
+	return p
+}
+
+func (s *ShowMasterMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMasterMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) T_MASTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MASTER, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowMasterMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMasterMetaStmtContext) TypeFilter() ITypeFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowMasterMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMasterMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMasterMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMasterMetaStmt(s)
+	}
+}
+
+func (s *ShowMasterMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMasterMetaStmt(s)
+	}
+}
+
+func (s *ShowMasterMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMasterMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMasterMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SQLParserRULE_showMasterMetaStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(394)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(395)
+		p.Match(SQLParserT_MASTER)
+	}
+	{
+		p.SetState(396)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(397)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(398)
+		p.Source()
+	}
+	{
+		p.SetState(399)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(400)
+		p.TypeFilter()
+	}
+
+	return localctx
+}
+
+// IShowStorageMetaStmtContext is an interface to support dynamic dispatch.
+type IShowStorageMetaStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_STORAGE() antlr.TerminalNode
+	T_METADATA() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	Source() ISourceContext
+	T_WHERE() antlr.TerminalNode
+	T_AND() antlr.TerminalNode
+	AllStorageFilter() []IStorageFilterContext
+	StorageFilter(i int) IStorageFilterContext
+	AllTypeFilter() []ITypeFilterContext
+	TypeFilter(i int) ITypeFilterContext
+
+	// IsShowStorageMetaStmtContext differentiates from other interfaces.
+	IsShowStorageMetaStmtContext()
+}
+
+type ShowStorageMetaStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStorageMetaStmtContext() *ShowStorageMetaStmtContext {
+	var p = new(ShowStorageMetaStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
+	return p
+}
+
+func (*ShowStorageMetaStmtContext) IsShowStorageMetaStmtContext() {}
+
+func NewShowStorageMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetaStmtContext {
+	var p = new(ShowStorageMetaStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
+
+	return p
+}
+
+func (s *ShowStorageMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStorageMetaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_METADATA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METADATA, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) Source() ISourceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceContext)
+}
+
+func (s *ShowStorageMetaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowStorageMetaStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetaStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowStorageMetaStmtContext) AllTypeFilter() []ITypeFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeFilterContext); ok {
+			tst[i] = t.(ITypeFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetaStmtContext) TypeFilter(i int) ITypeFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFilterContext)
+}
+
+func (s *ShowStorageMetaStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStorageMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStorageMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStorageMetaStmt(s)
+	}
+}
+
+func (s *ShowStorageMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStorageMetaStmt(s)
+	}
+}
+
+func (s *ShowStorageMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowStorageMetaStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowStorageMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, SQLParserRULE_showStorageMetaStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(402)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(403)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(404)
+		p.Match(SQLParserT_METADATA)
+	}
+	{
+		p.SetState(405)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(406)
+		p.Source()
+	}
+	{
+		p.SetState(407)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(410)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(408)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_TYPE:
+		{
+			p.SetState(409)
+			p.TypeFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(412)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(415)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(413)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_TYPE:
+		{
+			p.SetState(414)
+			p.TypeFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowAliveStmtContext is an interface to support dynamic dispatch.
+type IShowAliveStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_ALIVE() antlr.TerminalNode
+	T_ROOT() antlr.TerminalNode
+	T_BROKER() antlr.TerminalNode
+	T_STORAGE() antlr.TerminalNode
+
+	// IsShowAliveStmtContext differentiates from other interfaces.
+	IsShowAliveStmtContext()
+}
+
+type ShowAliveStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowAliveStmtContext() *ShowAliveStmtContext {
+	var p = new(ShowAliveStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showAliveStmt
+	return p
+}
+
+func (*ShowAliveStmtContext) IsShowAliveStmtContext() {}
+
+func NewShowAliveStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAliveStmtContext {
+	var p = new(ShowAliveStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showAliveStmt
+
+	return p
+}
+
+func (s *ShowAliveStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowAliveStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowAliveStmtContext) T_ALIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALIVE, 0)
+}
+
+func (s *ShowAliveStmtContext) T_ROOT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROOT, 0)
+}
+
+func (s *ShowAliveStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *ShowAliveStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *ShowAliveStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowAliveStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowAliveStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowAliveStmt(s)
+	}
+}
+
+func (s *ShowAliveStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowAliveStmt(s)
+	}
+}
+
+func (s *ShowAliveStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowAliveStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowAliveStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SQLParserRULE_showAliveStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(417)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(418)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&120259084288) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(419)
+		p.Match(SQLParserT_ALIVE)
+	}
+
+	return localctx
+}
+
+// IShowReplicationStmtContext is an interface to support dynamic dispatch.
+type IShowReplicationStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_REPLICATION() antlr.TerminalNode
+	T_WHERE() antlr.TerminalNode
+	T_AND() antlr.TerminalNode
+	AllStorageFilter() []IStorageFilterContext
+	StorageFilter(i int) IStorageFilterContext
+	AllDatabaseFilter() []IDatabaseFilterContext
+	DatabaseFilter(i int) IDatabaseFilterContext
+
+	// IsShowReplicationStmtContext differentiates from other interfaces.
+	IsShowReplicationStmtContext()
+}
+
+type ShowReplicationStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowReplicationStmtContext() *ShowReplicationStmtContext {
+	var p = new(ShowReplicationStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showReplicationStmt
+	return p
+}
+
+func (*ShowReplicationStmtContext) IsShowReplicationStmtContext() {}
+
+func NewShowReplicationStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReplicationStmtContext {
+	var p = new(ShowReplicationStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showReplicationStmt
+
+	return p
+}
+
+func (s *ShowReplicationStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowReplicationStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowReplicationStmtContext) T_REPLICATION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICATION, 0)
+}
+
+func (s *ShowReplicationStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowReplicationStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowReplicationStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowReplicationStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowReplicationStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDatabaseFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDatabaseFilterContext); ok {
+			tst[i] = t.(IDatabaseFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowReplicationStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseFilterContext)
+}
+
+func (s *ShowReplicationStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowReplicationStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowReplicationStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowReplicationStmt(s)
+	}
+}
+
+func (s *ShowReplicationStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowReplicationStmt(s)
+	}
+}
+
+func (s *ShowReplicationStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowReplicationStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowReplicationStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SQLParserRULE_showReplicationStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(421)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(422)
+		p.Match(SQLParserT_REPLICATION)
+	}
+	{
+		p.SetState(423)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(426)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(424)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(425)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(428)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(431)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(429)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(430)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowMemoryDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowMemoryDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_MEMORY() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	T_WHERE() antlr.TerminalNode
+	T_AND() antlr.TerminalNode
+	AllStorageFilter() []IStorageFilterContext
+	StorageFilter(i int) IStorageFilterContext
+	AllDatabaseFilter() []IDatabaseFilterContext
+	DatabaseFilter(i int) IDatabaseFilterContext
+
+	// IsShowMemoryDatabaseStmtContext differentiates from other interfaces.
+	IsShowMemoryDatabaseStmtContext()
+}
+
+type ShowMemoryDatabaseStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMemoryDatabaseStmtContext() *ShowMemoryDatabaseStmtContext {
+	var p = new(ShowMemoryDatabaseStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+	return p
+}
+
+func (*ShowMemoryDatabaseStmtContext) IsShowMemoryDatabaseStmtContext() {}
+
+func NewShowMemoryDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMemoryDatabaseStmtContext {
+	var p = new(ShowMemoryDatabaseStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+
+	return p
+}
+
+func (s *ShowMemoryDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMemoryDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_MEMORY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MEMORY, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowMemoryDatabaseStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDatabaseFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDatabaseFilterContext); ok {
+			tst[i] = t.(IDatabaseFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowMemoryDatabaseStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseFilterContext)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMemoryDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMemoryDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMemoryDatabaseStmt(s)
+	}
+}
+
+func (s *ShowMemoryDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMemoryDatabaseStmt(s)
+	}
+}
+
+func (s *ShowMemoryDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMemoryDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMemoryDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SQLParserRULE_showMemoryDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(433)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(434)
+		p.Match(SQLParserT_MEMORY)
+	}
+	{
+		p.SetState(435)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(436)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(439)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(437)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(438)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(441)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(444)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(442)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_DATASBAE:
+		{
+			p.SetState(443)
+			p.DatabaseFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IShowShardsStmtContext is an interface to support dynamic dispatch.
+type IShowShardsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_SHARDS() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+
+	// IsShowShardsStmtContext differentiates from other interfaces.
+	IsShowShardsStmtContext()
+}
+
+type ShowShardsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowShardsStmtContext() *ShowShardsStmtContext {
+	var p = new(ShowShardsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showShardsStmt
+	return p
+}
+
+func (*ShowShardsStmtContext) IsShowShardsStmtContext() {}
+
+func NewShowShardsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowShardsStmtContext {
+	var p = new(ShowShardsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showShardsStmt
+
+	return p
+}
+
+func (s *ShowShardsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowShardsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowShardsStmtContext) T_SHARDS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHARDS, 0)
+}
+
+func (s *ShowShardsStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowShardsStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowShardsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowShardsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowShardsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowShardsStmt(s)
+	}
+}
+
+func (s *ShowShardsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowShardsStmt(s)
+	}
+}
+
+func (s *ShowShardsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowShardsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowShardsStmt() (localctx IShowShardsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowShardsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SQLParserRULE_showShardsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(446)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(447)
+		p.Match(SQLParserT_SHARDS)
+	}
+	{
+		p.SetState(448)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(449)
+		p.DatabaseName()
+	}
+
+	return localctx
+}
+
+// IShowSegmentsStmtContext is an interface to support dynamic dispatch.
+type IShowSegmentsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_SEGMENTS() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+	T_SHARD() antlr.TerminalNode
+	ShardID() IShardIDContext
+
+	// IsShowSegmentsStmtContext differentiates from other interfaces.
+	IsShowSegmentsStmtContext()
+}
+
+type ShowSegmentsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowSegmentsStmtContext() *ShowSegmentsStmtContext {
+	var p = new(ShowSegmentsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showSegmentsStmt
+	return p
+}
+
+func (*ShowSegmentsStmtContext) IsShowSegmentsStmtContext() {}
+
+func NewShowSegmentsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSegmentsStmtContext {
+	var p = new(ShowSegmentsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showSegmentsStmt
+
+	return p
+}
+
+func (s *ShowSegmentsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowSegmentsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowSegmentsStmtContext) T_SEGMENTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SEGMENTS, 0)
+}
+
+func (s *ShowSegmentsStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowSegmentsStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowSegmentsStmtContext) T_SHARD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHARD, 0)
+}
+
+func (s *ShowSegmentsStmtContext) ShardID() IShardIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShardIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShardIDContext)
+}
+
+func (s *ShowSegmentsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowSegmentsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowSegmentsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowSegmentsStmt(s)
+	}
+}
+
+func (s *ShowSegmentsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowSegmentsStmt(s)
+	}
+}
+
+func (s *ShowSegmentsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowSegmentsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowSegmentsStmt() (localctx IShowSegmentsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowSegmentsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SQLParserRULE_showSegmentsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(451)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(452)
+		p.Match(SQLParserT_SEGMENTS)
+	}
+	{
+		p.SetState(453)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(454)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(455)
+		p.Match(SQLParserT_SHARD)
+	}
+	{
+		p.SetState(456)
+		p.ShardID()
+	}
+
+	return localctx
+}
+
+// IShowDiskUsageStmtContext is an interface to support dynamic dispatch.
+type IShowDiskUsageStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_DISK() antlr.TerminalNode
+	T_USAGE() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+
+	// IsShowDiskUsageStmtContext differentiates from other interfaces.
+	IsShowDiskUsageStmtContext()
+}
+
+type ShowDiskUsageStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowDiskUsageStmtContext() *ShowDiskUsageStmtContext {
+	var p = new(ShowDiskUsageStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showDiskUsageStmt
+	return p
+}
+
+func (*ShowDiskUsageStmtContext) IsShowDiskUsageStmtContext() {}
+
+func NewShowDiskUsageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDiskUsageStmtContext {
+	var p = new(ShowDiskUsageStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showDiskUsageStmt
+
+	return p
+}
+
+func (s *ShowDiskUsageStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowDiskUsageStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowDiskUsageStmtContext) T_DISK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DISK, 0)
+}
+
+func (s *ShowDiskUsageStmtContext) T_USAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USAGE, 0)
+}
+
+func (s *ShowDiskUsageStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowDiskUsageStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowDiskUsageStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowDiskUsageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowDiskUsageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowDiskUsageStmt(s)
+	}
+}
+
+func (s *ShowDiskUsageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowDiskUsageStmt(s)
+	}
+}
+
+func (s *ShowDiskUsageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowDiskUsageStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowDiskUsageStmt() (localctx IShowDiskUsageStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowDiskUsageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SQLParserRULE_showDiskUsageStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(458)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(459)
+		p.Match(SQLParserT_DISK)
+	}
+	{
+		p.SetState(460)
+		p.Match(SQLParserT_USAGE)
+	}
+	p.SetState(463)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_FROM {
+		{
+			p.SetState(461)
+			p.Match(SQLParserT_FROM)
+		}
+		{
+			p.SetState(462)
+			p.DatabaseName()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowFileDetailStmtContext is an interface to support dynamic dispatch.
+type IShowFileDetailStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	AllT_FILE() []antlr.TerminalNode
+	T_FILE(i int) antlr.TerminalNode
+	T_DETAIL() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+	T_SHARD() antlr.TerminalNode
+	ShardID() IShardIDContext
+	T_FAMILY() antlr.TerminalNode
+	FamilyTime() IFamilyTimeContext
+	FileNumber() IFileNumberContext
+
+	// IsShowFileDetailStmtContext differentiates from other interfaces.
+	IsShowFileDetailStmtContext()
+}
+
+type ShowFileDetailStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowFileDetailStmtContext() *ShowFileDetailStmtContext {
+	var p = new(ShowFileDetailStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showFileDetailStmt
+	return p
+}
+
+func (*ShowFileDetailStmtContext) IsShowFileDetailStmtContext() {}
+
+func NewShowFileDetailStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFileDetailStmtContext {
+	var p = new(ShowFileDetailStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showFileDetailStmt
+
+	return p
+}
+
+func (s *ShowFileDetailStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowFileDetailStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowFileDetailStmtContext) AllT_FILE() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_FILE)
+}
+
+func (s *ShowFileDetailStmtContext) T_FILE(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FILE, i)
+}
+
+func (s *ShowFileDetailStmtContext) T_DETAIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DETAIL, 0)
+}
+
+func (s *ShowFileDetailStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowFileDetailStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowFileDetailStmtContext) T_SHARD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHARD, 0)
+}
+
+func (s *ShowFileDetailStmtContext) ShardID() IShardIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShardIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShardIDContext)
+}
+
+func (s *ShowFileDetailStmtContext) T_FAMILY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FAMILY, 0)
+}
+
+func (s *ShowFileDetailStmtContext) FamilyTime() IFamilyTimeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFamilyTimeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFamilyTimeContext)
+}
+
+func (s *ShowFileDetailStmtContext) FileNumber() IFileNumberContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFileNumberContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFileNumberContext)
+}
+
+func (s *ShowFileDetailStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowFileDetailStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowFileDetailStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowFileDetailStmt(s)
+	}
+}
+
+func (s *ShowFileDetailStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowFileDetailStmt(s)
+	}
+}
+
+func (s *ShowFileDetailStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowFileDetailStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowFileDetailStmt() (localctx IShowFileDetailStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowFileDetailStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SQLParserRULE_showFileDetailStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(465)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(466)
+		p.Match(SQLParserT_FILE)
+	}
+	{
+		p.SetState(467)
+		p.Match(SQLParserT_DETAIL)
+	}
+	{
+		p.SetState(468)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(469)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(470)
+		p.Match(SQLParserT_SHARD)
+	}
+	{
+		p.SetState(471)
+		p.ShardID()
+	}
+	{
+		p.SetState(472)
+		p.Match(SQLParserT_FAMILY)
+	}
+	{
+		p.SetState(473)
+		p.FamilyTime()
+	}
+	{
+		p.SetState(474)
+		p.Match(SQLParserT_FILE)
+	}
+	{
+		p.SetState(475)
+		p.FileNumber()
+	}
+
+	return localctx
+}
+
+// IShowReplicationChannelsStmtContext is an interface to support dynamic dispatch.
+type IShowReplicationChannelsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_REPLICATION() antlr.TerminalNode
+	T_CHANNELS() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+
+	// IsShowReplicationChannelsStmtContext differentiates from other interfaces.
+	IsShowReplicationChannelsStmtContext()
+}
+
+type ShowReplicationChannelsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowReplicationChannelsStmtContext() *ShowReplicationChannelsStmtContext {
+	var p = new(ShowReplicationChannelsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showReplicationChannelsStmt
+	return p
+}
+
+func (*ShowReplicationChannelsStmtContext) IsShowReplicationChannelsStmtContext() {}
+
+func NewShowReplicationChannelsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReplicationChannelsStmtContext {
+	var p = new(ShowReplicationChannelsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showReplicationChannelsStmt
+
+	return p
+}
+
+func (s *ShowReplicationChannelsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowReplicationChannelsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowReplicationChannelsStmtContext) T_REPLICATION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICATION, 0)
+}
+
+func (s *ShowReplicationChannelsStmtContext) T_CHANNELS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CHANNELS, 0)
+}
+
+func (s *ShowReplicationChannelsStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowReplicationChannelsStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowReplicationChannelsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowReplicationChannelsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowReplicationChannelsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowReplicationChannelsStmt(s)
+	}
+}
+
+func (s *ShowReplicationChannelsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowReplicationChannelsStmt(s)
+	}
+}
+
+func (s *ShowReplicationChannelsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowReplicationChannelsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowReplicationChannelsStmt() (localctx IShowReplicationChannelsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowReplicationChannelsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SQLParserRULE_showReplicationChannelsStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(477)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(478)
+		p.Match(SQLParserT_REPLICATION)
+	}
+	{
+		p.SetState(479)
+		p.Match(SQLParserT_CHANNELS)
+	}
+	p.SetState(482)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_FROM {
+		{
+			p.SetState(480)
+			p.Match(SQLParserT_FROM)
+		}
+		{
+			p.SetState(481)
+			p.DatabaseName()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowExpiredMetricsStmtContext is an interface to support dynamic dispatch.
+type IShowExpiredMetricsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_EXPIRED() antlr.TerminalNode
+	T_METRICS() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+
+	// IsShowExpiredMetricsStmtContext differentiates from other interfaces.
+	IsShowExpiredMetricsStmtContext()
+}
+
+type ShowExpiredMetricsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowExpiredMetricsStmtContext() *ShowExpiredMetricsStmtContext {
+	var p = new(ShowExpiredMetricsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showExpiredMetricsStmt
+	return p
+}
+
+func (*ShowExpiredMetricsStmtContext) IsShowExpiredMetricsStmtContext() {}
+
+func NewShowExpiredMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowExpiredMetricsStmtContext {
+	var p = new(ShowExpiredMetricsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showExpiredMetricsStmt
+
+	return p
+}
+
+func (s *ShowExpiredMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowExpiredMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowExpiredMetricsStmtContext) T_EXPIRED() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXPIRED, 0)
+}
+
+func (s *ShowExpiredMetricsStmtContext) T_METRICS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRICS, 0)
+}
+
+func (s *ShowExpiredMetricsStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowExpiredMetricsStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowExpiredMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowExpiredMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowExpiredMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowExpiredMetricsStmt(s)
+	}
+}
+
+func (s *ShowExpiredMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowExpiredMetricsStmt(s)
+	}
+}
+
+func (s *ShowExpiredMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowExpiredMetricsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowExpiredMetricsStmt() (localctx IShowExpiredMetricsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowExpiredMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SQLParserRULE_showExpiredMetricsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(484)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(485)
+		p.Match(SQLParserT_EXPIRED)
+	}
+	{
+		p.SetState(486)
+		p.Match(SQLParserT_METRICS)
+	}
+	{
+		p.SetState(487)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(488)
+		p.DatabaseName()
+	}
+
+	return localctx
+}
+
+// IShowLogLevelsStmtContext is an interface to support dynamic dispatch.
+type IShowLogLevelsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_LOG() antlr.TerminalNode
+	T_LEVELS() antlr.TerminalNode
+
+	// IsShowLogLevelsStmtContext differentiates from other interfaces.
+	IsShowLogLevelsStmtContext()
+}
+
+type ShowLogLevelsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowLogLevelsStmtContext() *ShowLogLevelsStmtContext {
+	var p = new(ShowLogLevelsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showLogLevelsStmt
+	return p
+}
+
+func (*ShowLogLevelsStmtContext) IsShowLogLevelsStmtContext() {}
+
+func NewShowLogLevelsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowLogLevelsStmtContext {
+	var p = new(ShowLogLevelsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showLogLevelsStmt
+
+	return p
+}
+
+func (s *ShowLogLevelsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowLogLevelsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowLogLevelsStmtContext) T_LOG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG, 0)
+}
+
+func (s *ShowLogLevelsStmtContext) T_LEVELS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LEVELS, 0)
+}
+
+func (s *ShowLogLevelsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowLogLevelsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowLogLevelsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowLogLevelsStmt(s)
+	}
+}
+
+func (s *ShowLogLevelsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowLogLevelsStmt(s)
+	}
+}
+
+func (s *ShowLogLevelsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowLogLevelsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowLogLevelsStmt() (localctx IShowLogLevelsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowLogLevelsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SQLParserRULE_showLogLevelsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(490)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(491)
+		p.Match(SQLParserT_LOG)
+	}
+	{
+		p.SetState(492)
+		p.Match(SQLParserT_LEVELS)
+	}
+
+	return localctx
+}
+
+// IShowPlacementStmtContext is an interface to support dynamic dispatch.
+type IShowPlacementStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_PLACEMENT() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+	T_SUGGESTIONS() antlr.TerminalNode
+
+	// IsShowPlacementStmtContext differentiates from other interfaces.
+	IsShowPlacementStmtContext()
+}
+
+type ShowPlacementStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowPlacementStmtContext() *ShowPlacementStmtContext {
+	var p = new(ShowPlacementStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showPlacementStmt
+	return p
+}
+
+func (*ShowPlacementStmtContext) IsShowPlacementStmtContext() {}
+
+func NewShowPlacementStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowPlacementStmtContext {
+	var p = new(ShowPlacementStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showPlacementStmt
+
+	return p
+}
+
+func (s *ShowPlacementStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowPlacementStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowPlacementStmtContext) T_PLACEMENT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PLACEMENT, 0)
+}
+
+func (s *ShowPlacementStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *ShowPlacementStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *ShowPlacementStmtContext) T_SUGGESTIONS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUGGESTIONS, 0)
+}
+
+func (s *ShowPlacementStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowPlacementStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowPlacementStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowPlacementStmt(s)
+	}
+}
+
+func (s *ShowPlacementStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowPlacementStmt(s)
+	}
+}
+
+func (s *ShowPlacementStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowPlacementStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowPlacementStmt() (localctx IShowPlacementStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowPlacementStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SQLParserRULE_showPlacementStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(494)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(495)
+		p.Match(SQLParserT_PLACEMENT)
+	}
+	p.SetState(497)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_SUGGESTIONS {
+		{
+			p.SetState(496)
+			p.Match(SQLParserT_SUGGESTIONS)
+		}
+
+	}
+	{
+		p.SetState(499)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(500)
+		p.DatabaseName()
+	}
+
+	return localctx
+}
+
+// IRepairPlacementStmtContext is an interface to support dynamic dispatch.
+type IRepairPlacementStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_REPAIR() antlr.TerminalNode
+	T_PLACEMENT() antlr.TerminalNode
+	T_FROM() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+
+	// IsRepairPlacementStmtContext differentiates from other interfaces.
+	IsRepairPlacementStmtContext()
+}
+
+type RepairPlacementStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRepairPlacementStmtContext() *RepairPlacementStmtContext {
+	var p = new(RepairPlacementStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_repairPlacementStmt
+	return p
+}
+
+func (*RepairPlacementStmtContext) IsRepairPlacementStmtContext() {}
+
+func NewRepairPlacementStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RepairPlacementStmtContext {
+	var p = new(RepairPlacementStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_repairPlacementStmt
+
+	return p
+}
+
+func (s *RepairPlacementStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RepairPlacementStmtContext) T_REPAIR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPAIR, 0)
+}
+
+func (s *RepairPlacementStmtContext) T_PLACEMENT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PLACEMENT, 0)
+}
+
+func (s *RepairPlacementStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RepairPlacementStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowStmtContext) ShowRootMetricStmt() IShowRootMetricStmtContext {
+func (s *RepairPlacementStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RepairPlacementStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RepairPlacementStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRepairPlacementStmt(s)
+	}
+}
+
+func (s *RepairPlacementStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRepairPlacementStmt(s)
+	}
+}
+
+func (s *RepairPlacementStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRepairPlacementStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RepairPlacementStmt() (localctx IRepairPlacementStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewRepairPlacementStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SQLParserRULE_repairPlacementStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(502)
+		p.Match(SQLParserT_REPAIR)
+	}
+	{
+		p.SetState(503)
+		p.Match(SQLParserT_PLACEMENT)
+	}
+	{
+		p.SetState(504)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(505)
+		p.DatabaseName()
+	}
+
+	return localctx
+}
+
+// IDeleteSeriesStmtContext is an interface to support dynamic dispatch.
+type IDeleteSeriesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_DELETE() antlr.TerminalNode
+	T_SERIES() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	T_WHERE() antlr.TerminalNode
+	TagFilterExpr() ITagFilterExprContext
+
+	// IsDeleteSeriesStmtContext differentiates from other interfaces.
+	IsDeleteSeriesStmtContext()
+}
+
+type DeleteSeriesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDeleteSeriesStmtContext() *DeleteSeriesStmtContext {
+	var p = new(DeleteSeriesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_deleteSeriesStmt
+	return p
+}
+
+func (*DeleteSeriesStmtContext) IsDeleteSeriesStmtContext() {}
+
+func NewDeleteSeriesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteSeriesStmtContext {
+	var p = new(DeleteSeriesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_deleteSeriesStmt
+
+	return p
+}
+
+func (s *DeleteSeriesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DeleteSeriesStmtContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
+func (s *DeleteSeriesStmtContext) T_SERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SERIES, 0)
+}
+
+func (s *DeleteSeriesStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowRootMetricStmtContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *DeleteSeriesStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *DeleteSeriesStmtContext) TagFilterExpr() ITagFilterExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagFilterExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagFilterExprContext)
+}
+
+func (s *DeleteSeriesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DeleteSeriesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DeleteSeriesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDeleteSeriesStmt(s)
+	}
+}
+
+func (s *DeleteSeriesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDeleteSeriesStmt(s)
+	}
+}
+
+func (s *DeleteSeriesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDeleteSeriesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) DeleteSeriesStmt() (localctx IDeleteSeriesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewDeleteSeriesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SQLParserRULE_deleteSeriesStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(507)
+		p.Match(SQLParserT_DELETE)
+	}
+	{
+		p.SetState(508)
+		p.Match(SQLParserT_SERIES)
+	}
+	{
+		p.SetState(509)
+		p.FromClause()
+	}
+	{
+		p.SetState(510)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(511)
+		p.tagFilterExpr(0)
+	}
+
+	return localctx
+}
+
+// IShowRootMetricStmtContext is an interface to support dynamic dispatch.
+type IShowRootMetricStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_ROOT() antlr.TerminalNode
+	T_METRIC() antlr.TerminalNode
+	T_WHERE() antlr.TerminalNode
+	MetricListFilter() IMetricListFilterContext
+
+	// IsShowRootMetricStmtContext differentiates from other interfaces.
+	IsShowRootMetricStmtContext()
+}
+
+type ShowRootMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowRootMetricStmtContext() *ShowRootMetricStmtContext {
+	var p = new(ShowRootMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showRootMetricStmt
+	return p
+}
+
+func (*ShowRootMetricStmtContext) IsShowRootMetricStmtContext() {}
+
+func NewShowRootMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRootMetricStmtContext {
+	var p = new(ShowRootMetricStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showRootMetricStmt
+
+	return p
+}
+
+func (s *ShowRootMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IShowRootMetricStmtContext)
+func (s *ShowRootMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowStmtContext) ShowBrokerMetricStmt() IShowBrokerMetricStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowBrokerMetricStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ShowRootMetricStmtContext) T_ROOT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROOT, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *ShowRootMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
 
-	return t.(IShowBrokerMetricStmtContext)
+func (s *ShowRootMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *ShowStmtContext) ShowStorageMetricStmt() IShowStorageMetricStmtContext {
+func (s *ShowRootMetricStmtContext) MetricListFilter() IMetricListFilterContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowStorageMetricStmtContext); ok {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1641,173 +7490,152 @@ func (s *ShowStmtContext) ShowStorageMetricStmt() IShowStorageMetricStmtContext
 		return nil
 	}
 
-	return t.(IShowStorageMetricStmtContext)
+	return t.(IMetricListFilterContext)
 }
 
-func (s *ShowStmtContext) ShowReplicationStmt() IShowReplicationStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowReplicationStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ShowRootMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IShowReplicationStmtContext)
+func (s *ShowRootMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStmtContext) ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMemoryDatabaseStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *ShowRootMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowRootMetricStmt(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *ShowRootMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowRootMetricStmt(s)
 	}
-
-	return t.(IShowMemoryDatabaseStmtContext)
 }
 
-func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowSchemasStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ShowRootMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowRootMetricStmt(s)
 
-	if t == nil {
-		return nil
+	default:
+		return t.VisitChildren(s)
 	}
-
-	return t.(IShowSchemasStmtContext)
 }
 
-func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowDatabaseStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
+	this := p
+	_ = this
 
-	if t == nil {
-		return nil
-	}
+	localctx = NewShowRootMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SQLParserRULE_showRootMetricStmt)
 
-	return t.(IShowDatabaseStmtContext)
-}
+	defer func() {
+		p.ExitRule()
+	}()
 
-func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowNameSpacesStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
-	}
+	}()
 
-	if t == nil {
-		return nil
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(513)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(514)
+		p.Match(SQLParserT_ROOT)
+	}
+	{
+		p.SetState(515)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(516)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(517)
+		p.MetricListFilter()
 	}
 
-	return t.(IShowNameSpacesStmtContext)
+	return localctx
 }
 
-func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMetricsStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
+type IShowBrokerMetricStmtContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(IShowMetricsStmtContext)
-}
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_BROKER() antlr.TerminalNode
+	T_METRIC() antlr.TerminalNode
+	T_WHERE() antlr.TerminalNode
+	MetricListFilter() IMetricListFilterContext
 
-func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowFieldsStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
+	IsShowBrokerMetricStmtContext()
+}
 
-	if t == nil {
-		return nil
-	}
+type ShowBrokerMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	return t.(IShowFieldsStmtContext)
+func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	return p
 }
 
-func (s *ShowStmtContext) ShowTagKeysStmt() IShowTagKeysStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowTagKeysStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
 
-	if t == nil {
-		return nil
-	}
+func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
 
-	return t.(IShowTagKeysStmtContext)
-}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-func (s *ShowStmtContext) ShowTagValuesStmt() IShowTagValuesStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowTagValuesStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
 
-	if t == nil {
-		return nil
-	}
+	return p
+}
 
-	return t.(IShowTagValuesStmtContext)
+func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowStmtContext) ShowRequestsStmt() IShowRequestsStmtContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowRequestsStmtContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
 
-	return t.(IShowRequestsStmtContext)
+func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
+func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowRequestStmtContext); ok {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1817,45 +7645,45 @@ func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
 		return nil
 	}
 
-	return t.(IShowRequestStmtContext)
+	return t.(IMetricListFilterContext)
 }
 
-func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStmt(s)
+		listenerT.EnterShowBrokerMetricStmt(s)
 	}
 }
 
-func (s *ShowStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStmt(s)
+		listenerT.ExitShowBrokerMetricStmt(s)
 	}
 }
 
-func (s *ShowStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowBrokerMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStmt(s)
+		return t.VisitShowBrokerMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
+func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, SQLParserRULE_showStmt)
+	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SQLParserRULE_showBrokerMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -1873,268 +7701,218 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 		}
 	}()
 
-	p.SetState(241)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(217)
-			p.ShowMasterStmt()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(218)
-			p.ShowMetadataTypesStmt()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(219)
-			p.ShowRootMetaStmt()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(220)
-			p.ShowBrokerMetaStmt()
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(519)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(520)
+		p.Match(SQLParserT_BROKER)
+	}
+	{
+		p.SetState(521)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(522)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(523)
+		p.MetricListFilter()
+	}
 
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(221)
-			p.ShowMasterMetaStmt()
-		}
+	return localctx
+}
 
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(222)
-			p.ShowStorageMetaStmt()
-		}
+// IShowStorageMetricStmtContext is an interface to support dynamic dispatch.
+type IShowStorageMetricStmtContext interface {
+	antlr.ParserRuleContext
 
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(223)
-			p.ShowStoragesStmt()
-		}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(224)
-			p.ShowBrokersStmt()
-		}
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_STORAGE() antlr.TerminalNode
+	T_METRIC() antlr.TerminalNode
+	T_WHERE() antlr.TerminalNode
+	T_AND() antlr.TerminalNode
+	AllStorageFilter() []IStorageFilterContext
+	StorageFilter(i int) IStorageFilterContext
+	AllMetricListFilter() []IMetricListFilterContext
+	MetricListFilter(i int) IMetricListFilterContext
 
-	case 9:
-		p.EnterOuterAlt(localctx, 9)
-		{
-			p.SetState(225)
-			p.ShowLimitStmt()
-		}
+	// IsShowStorageMetricStmtContext differentiates from other interfaces.
+	IsShowStorageMetricStmtContext()
+}
 
-	case 10:
-		p.EnterOuterAlt(localctx, 10)
-		{
-			p.SetState(226)
-			p.ShowAliveStmt()
-		}
+type ShowStorageMetricStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	case 11:
-		p.EnterOuterAlt(localctx, 11)
-		{
-			p.SetState(227)
-			p.ShowRootMetricStmt()
-		}
+func NewEmptyShowStorageMetricStmtContext() *ShowStorageMetricStmtContext {
+	var p = new(ShowStorageMetricStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	return p
+}
 
-	case 12:
-		p.EnterOuterAlt(localctx, 12)
-		{
-			p.SetState(228)
-			p.ShowBrokerMetricStmt()
-		}
+func (*ShowStorageMetricStmtContext) IsShowStorageMetricStmtContext() {}
 
-	case 13:
-		p.EnterOuterAlt(localctx, 13)
-		{
-			p.SetState(229)
-			p.ShowStorageMetricStmt()
-		}
+func NewShowStorageMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetricStmtContext {
+	var p = new(ShowStorageMetricStmtContext)
 
-	case 14:
-		p.EnterOuterAlt(localctx, 14)
-		{
-			p.SetState(230)
-			p.ShowReplicationStmt()
-		}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	case 15:
-		p.EnterOuterAlt(localctx, 15)
-		{
-			p.SetState(231)
-			p.ShowMemoryDatabaseStmt()
-		}
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
 
-	case 16:
-		p.EnterOuterAlt(localctx, 16)
-		{
-			p.SetState(232)
-			p.ShowSchemasStmt()
-		}
+	return p
+}
 
-	case 17:
-		p.EnterOuterAlt(localctx, 17)
-		{
-			p.SetState(233)
-			p.ShowDatabaseStmt()
-		}
+func (s *ShowStorageMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	case 18:
-		p.EnterOuterAlt(localctx, 18)
-		{
-			p.SetState(234)
-			p.ShowNameSpacesStmt()
-		}
+func (s *ShowStorageMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
 
-	case 19:
-		p.EnterOuterAlt(localctx, 19)
-		{
-			p.SetState(235)
-			p.ShowMetricsStmt()
-		}
+func (s *ShowStorageMetricStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
 
-	case 20:
-		p.EnterOuterAlt(localctx, 20)
-		{
-			p.SetState(236)
-			p.ShowFieldsStmt()
-		}
+func (s *ShowStorageMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
 
-	case 21:
-		p.EnterOuterAlt(localctx, 21)
-		{
-			p.SetState(237)
-			p.ShowTagKeysStmt()
-		}
+func (s *ShowStorageMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
 
-	case 22:
-		p.EnterOuterAlt(localctx, 22)
-		{
-			p.SetState(238)
-			p.ShowTagValuesStmt()
-		}
+func (s *ShowStorageMetricStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
 
-	case 23:
-		p.EnterOuterAlt(localctx, 23)
-		{
-			p.SetState(239)
-			p.ShowRequestsStmt()
+func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
 		}
+	}
 
-	case 24:
-		p.EnterOuterAlt(localctx, 24)
-		{
-			p.SetState(240)
-			p.ShowRequestStmt()
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
 		}
-
 	}
 
-	return localctx
+	return tst
 }
 
-// IShowMasterStmtContext is an interface to support dynamic dispatch.
-type IShowMasterStmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_MASTER() antlr.TerminalNode
-
-	// IsShowMasterStmtContext differentiates from other interfaces.
-	IsShowMasterStmtContext()
-}
+func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-type ShowMasterStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+	if t == nil {
+		return nil
+	}
 
-func NewEmptyShowMasterStmtContext() *ShowMasterStmtContext {
-	var p = new(ShowMasterStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMasterStmt
-	return p
+	return t.(IStorageFilterContext)
 }
 
-func (*ShowMasterStmtContext) IsShowMasterStmtContext() {}
-
-func NewShowMasterStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterStmtContext {
-	var p = new(ShowMasterStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			len++
+		}
+	}
 
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMasterStmt
+	tst := make([]IMetricListFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricListFilterContext); ok {
+			tst[i] = t.(IMetricListFilterContext)
+			i++
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *ShowMasterStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-func (s *ShowMasterStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *ShowMasterStmtContext) T_MASTER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_MASTER, 0)
+	return t.(IMetricListFilterContext)
 }
 
-func (s *ShowMasterStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowStorageMetricStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMasterStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowStorageMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMasterStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowStorageMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMasterStmt(s)
+		listenerT.EnterShowStorageMetricStmt(s)
 	}
 }
 
-func (s *ShowMasterStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMasterStmt(s)
+		listenerT.ExitShowStorageMetricStmt(s)
 	}
 }
 
-func (s *ShowMasterStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowStorageMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMasterStmt(s)
+		return t.VisitShowStorageMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
+func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMasterStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, SQLParserRULE_showMasterStmt)
+	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SQLParserRULE_showStorageMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2154,103 +7932,170 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(243)
+		p.SetState(525)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(244)
-		p.Match(SQLParserT_MASTER)
+		p.SetState(526)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(527)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(528)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(531)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(529)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_METRIC:
+		{
+			p.SetState(530)
+			p.MetricListFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(533)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(536)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(534)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_METRIC:
+		{
+			p.SetState(535)
+			p.MetricListFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IShowRequestsStmtContext is an interface to support dynamic dispatch.
-type IShowRequestsStmtContext interface {
+// ICreateStorageStmtContext is an interface to support dynamic dispatch.
+type ICreateStorageStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_REQUESTS() antlr.TerminalNode
+	T_CREATE() antlr.TerminalNode
+	T_STORAGE() antlr.TerminalNode
+	Json() IJsonContext
 
-	// IsShowRequestsStmtContext differentiates from other interfaces.
-	IsShowRequestsStmtContext()
+	// IsCreateStorageStmtContext differentiates from other interfaces.
+	IsCreateStorageStmtContext()
 }
 
-type ShowRequestsStmtContext struct {
+type CreateStorageStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRequestsStmtContext() *ShowRequestsStmtContext {
-	var p = new(ShowRequestsStmtContext)
+func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRequestsStmt
+	p.RuleIndex = SQLParserRULE_createStorageStmt
 	return p
 }
 
-func (*ShowRequestsStmtContext) IsShowRequestsStmtContext() {}
+func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
 
-func NewShowRequestsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestsStmtContext {
-	var p = new(ShowRequestsStmtContext)
+func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRequestsStmt
+	p.RuleIndex = SQLParserRULE_createStorageStmt
 
 	return p
 }
 
-func (s *ShowRequestsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowRequestsStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowRequestsStmtContext) T_REQUESTS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REQUESTS, 0)
+func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *ShowRequestsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateStorageStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowRequestsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowRequestsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRequestsStmt(s)
+		listenerT.EnterCreateStorageStmt(s)
 	}
 }
 
-func (s *ShowRequestsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRequestsStmt(s)
+		listenerT.ExitCreateStorageStmt(s)
 	}
 }
 
-func (s *ShowRequestsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowRequestsStmt(s)
+		return t.VisitCreateStorageStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
+func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowRequestsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, SQLParserRULE_showRequestsStmt)
+	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SQLParserRULE_createStorageStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2270,87 +8115,76 @@ func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(246)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(538)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(247)
-		p.Match(SQLParserT_REQUESTS)
+		p.SetState(539)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(540)
+		p.Json()
 	}
 
 	return localctx
 }
 
-// IShowRequestStmtContext is an interface to support dynamic dispatch.
-type IShowRequestStmtContext interface {
+// ICreateBrokerStmtContext is an interface to support dynamic dispatch.
+type ICreateBrokerStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_REQUEST() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	T_ID() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	RequestID() IRequestIDContext
+	T_CREATE() antlr.TerminalNode
+	T_BROKER() antlr.TerminalNode
+	Json() IJsonContext
 
-	// IsShowRequestStmtContext differentiates from other interfaces.
-	IsShowRequestStmtContext()
+	// IsCreateBrokerStmtContext differentiates from other interfaces.
+	IsCreateBrokerStmtContext()
 }
 
-type ShowRequestStmtContext struct {
+type CreateBrokerStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRequestStmtContext() *ShowRequestStmtContext {
-	var p = new(ShowRequestStmtContext)
+func NewEmptyCreateBrokerStmtContext() *CreateBrokerStmtContext {
+	var p = new(CreateBrokerStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRequestStmt
+	p.RuleIndex = SQLParserRULE_createBrokerStmt
 	return p
 }
 
-func (*ShowRequestStmtContext) IsShowRequestStmtContext() {}
+func (*CreateBrokerStmtContext) IsCreateBrokerStmtContext() {}
 
-func NewShowRequestStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRequestStmtContext {
-	var p = new(ShowRequestStmtContext)
+func NewCreateBrokerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateBrokerStmtContext {
+	var p = new(CreateBrokerStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRequestStmt
-
-	return p
-}
-
-func (s *ShowRequestStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowRequestStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowRequestStmtContext) T_REQUEST() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REQUEST, 0)
-}
+	p.RuleIndex = SQLParserRULE_createBrokerStmt
 
-func (s *ShowRequestStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+	return p
 }
 
-func (s *ShowRequestStmtContext) T_ID() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ID, 0)
+func (s *CreateBrokerStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateBrokerStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowRequestStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *CreateBrokerStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
 }
 
-func (s *ShowRequestStmtContext) RequestID() IRequestIDContext {
+func (s *CreateBrokerStmtContext) Json() IJsonContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IRequestIDContext); ok {
+		if _, ok := ctx.(IJsonContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2360,45 +8194,45 @@ func (s *ShowRequestStmtContext) RequestID() IRequestIDContext {
 		return nil
 	}
 
-	return t.(IRequestIDContext)
+	return t.(IJsonContext)
 }
 
-func (s *ShowRequestStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateBrokerStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowRequestStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateBrokerStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowRequestStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateBrokerStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRequestStmt(s)
+		listenerT.EnterCreateBrokerStmt(s)
 	}
 }
 
-func (s *ShowRequestStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateBrokerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRequestStmt(s)
+		listenerT.ExitCreateBrokerStmt(s)
 	}
 }
 
-func (s *ShowRequestStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateBrokerStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowRequestStmt(s)
+		return t.VisitCreateBrokerStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
+func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowRequestStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, SQLParserRULE_showRequestStmt)
+	localctx = NewCreateBrokerStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SQLParserRULE_createBrokerStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2418,119 +8252,124 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(249)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(250)
-		p.Match(SQLParserT_REQUEST)
-	}
-	{
-		p.SetState(251)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(252)
-		p.Match(SQLParserT_ID)
+		p.SetState(542)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(253)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(543)
+		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(254)
-		p.RequestID()
+		p.SetState(544)
+		p.Json()
 	}
 
 	return localctx
 }
 
-// IShowStoragesStmtContext is an interface to support dynamic dispatch.
-type IShowStoragesStmtContext interface {
+// IRecoverStorageStmtContext is an interface to support dynamic dispatch.
+type IRecoverStorageStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_STORAGES() antlr.TerminalNode
+	T_RECOVER() antlr.TerminalNode
+	T_STORAGE() antlr.TerminalNode
+	StorageName() IStorageNameContext
 
-	// IsShowStoragesStmtContext differentiates from other interfaces.
-	IsShowStoragesStmtContext()
+	// IsRecoverStorageStmtContext differentiates from other interfaces.
+	IsRecoverStorageStmtContext()
 }
 
-type ShowStoragesStmtContext struct {
+type RecoverStorageStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStoragesStmtContext() *ShowStoragesStmtContext {
-	var p = new(ShowStoragesStmtContext)
+func NewEmptyRecoverStorageStmtContext() *RecoverStorageStmtContext {
+	var p = new(RecoverStorageStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	p.RuleIndex = SQLParserRULE_recoverStorageStmt
 	return p
 }
 
-func (*ShowStoragesStmtContext) IsShowStoragesStmtContext() {}
+func (*RecoverStorageStmtContext) IsRecoverStorageStmtContext() {}
 
-func NewShowStoragesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStoragesStmtContext {
-	var p = new(ShowStoragesStmtContext)
+func NewRecoverStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecoverStorageStmtContext {
+	var p = new(RecoverStorageStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	p.RuleIndex = SQLParserRULE_recoverStorageStmt
 
 	return p
 }
 
-func (s *ShowStoragesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *RecoverStorageStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStoragesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *RecoverStorageStmtContext) T_RECOVER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECOVER, 0)
 }
 
-func (s *ShowStoragesStmtContext) T_STORAGES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGES, 0)
+func (s *RecoverStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *ShowStoragesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageNameContext)
+}
+
+func (s *RecoverStorageStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStoragesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RecoverStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStoragesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RecoverStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStoragesStmt(s)
+		listenerT.EnterRecoverStorageStmt(s)
 	}
 }
 
-func (s *ShowStoragesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RecoverStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStoragesStmt(s)
+		listenerT.ExitRecoverStorageStmt(s)
 	}
 }
 
-func (s *ShowStoragesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RecoverStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStoragesStmt(s)
+		return t.VisitRecoverStorageStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
+func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStoragesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SQLParserRULE_showStoragesStmt)
+	localctx = NewRecoverStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SQLParserRULE_recoverStorageStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2550,19 +8389,23 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(256)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(546)
+		p.Match(SQLParserT_RECOVER)
 	}
 	{
-		p.SetState(257)
-		p.Match(SQLParserT_STORAGES)
+		p.SetState(547)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(548)
+		p.StorageName()
 	}
 
 	return localctx
 }
 
-// IShowBrokersStmtContext is an interface to support dynamic dispatch.
-type IShowBrokersStmtContext interface {
+// IShowSchemasStmtContext is an interface to support dynamic dispatch.
+type IShowSchemasStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -2570,83 +8413,83 @@ type IShowBrokersStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_BROKERS() antlr.TerminalNode
+	T_SCHEMAS() antlr.TerminalNode
 
-	// IsShowBrokersStmtContext differentiates from other interfaces.
-	IsShowBrokersStmtContext()
+	// IsShowSchemasStmtContext differentiates from other interfaces.
+	IsShowSchemasStmtContext()
 }
 
-type ShowBrokersStmtContext struct {
+type ShowSchemasStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokersStmtContext() *ShowBrokersStmtContext {
-	var p = new(ShowBrokersStmtContext)
+func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokersStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 	return p
 }
 
-func (*ShowBrokersStmtContext) IsShowBrokersStmtContext() {}
+func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
 
-func NewShowBrokersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokersStmtContext {
-	var p = new(ShowBrokersStmtContext)
+func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokersStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 
 	return p
 }
 
-func (s *ShowBrokersStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokersStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowBrokersStmtContext) T_BROKERS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKERS, 0)
+func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SCHEMAS, 0)
 }
 
-func (s *ShowBrokersStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokersStmt(s)
+		listenerT.EnterShowSchemasStmt(s)
 	}
 }
 
-func (s *ShowBrokersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokersStmt(s)
+		listenerT.ExitShowSchemasStmt(s)
 	}
 }
 
-func (s *ShowBrokersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokersStmt(s)
+		return t.VisitShowSchemasStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
+func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokersStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SQLParserRULE_showBrokersStmt)
+	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SQLParserRULE_showSchemasStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2666,103 +8509,120 @@ func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(259)
+		p.SetState(550)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(260)
-		p.Match(SQLParserT_BROKERS)
+		p.SetState(551)
+		p.Match(SQLParserT_SCHEMAS)
 	}
 
 	return localctx
 }
 
-// IShowLimitStmtContext is an interface to support dynamic dispatch.
-type IShowLimitStmtContext interface {
+// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
+type ICreateDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_LIMIT() antlr.TerminalNode
+	T_CREATE() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	Json() IJsonContext
 
-	// IsShowLimitStmtContext differentiates from other interfaces.
-	IsShowLimitStmtContext()
+	// IsCreateDatabaseStmtContext differentiates from other interfaces.
+	IsCreateDatabaseStmtContext()
 }
 
-type ShowLimitStmtContext struct {
+type CreateDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowLimitStmtContext() *ShowLimitStmtContext {
-	var p = new(ShowLimitStmtContext)
+func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showLimitStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 	return p
 }
 
-func (*ShowLimitStmtContext) IsShowLimitStmtContext() {}
+func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
 
-func NewShowLimitStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowLimitStmtContext {
-	var p = new(ShowLimitStmtContext)
+func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showLimitStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 
 	return p
 }
 
-func (s *ShowLimitStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowLimitStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowLimitStmtContext) T_LIMIT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_LIMIT, 0)
+func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowLimitStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowLimitStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowLimitStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowLimitStmt(s)
+		listenerT.EnterCreateDatabaseStmt(s)
 	}
 }
 
-func (s *ShowLimitStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowLimitStmt(s)
+		listenerT.ExitCreateDatabaseStmt(s)
 	}
 }
 
-func (s *ShowLimitStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowLimitStmt(s)
+		return t.VisitCreateDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
+func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowLimitStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SQLParserRULE_showLimitStmt)
+	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SQLParserRULE_createDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2782,108 +8642,124 @@ func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(262)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(553)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(263)
-		p.Match(SQLParserT_LIMIT)
+		p.SetState(554)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(555)
+		p.Json()
 	}
 
 	return localctx
 }
 
-// IShowMetadataTypesStmtContext is an interface to support dynamic dispatch.
-type IShowMetadataTypesStmtContext interface {
+// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
+type IDropDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_METADATA() antlr.TerminalNode
-	T_TYPES() antlr.TerminalNode
+	T_DROP() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
 
-	// IsShowMetadataTypesStmtContext differentiates from other interfaces.
-	IsShowMetadataTypesStmtContext()
+	// IsDropDatabaseStmtContext differentiates from other interfaces.
+	IsDropDatabaseStmtContext()
 }
 
-type ShowMetadataTypesStmtContext struct {
+type DropDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetadataTypesStmtContext() *ShowMetadataTypesStmtContext {
-	var p = new(ShowMetadataTypesStmtContext)
+func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 	return p
 }
 
-func (*ShowMetadataTypesStmtContext) IsShowMetadataTypesStmtContext() {}
+func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
 
-func NewShowMetadataTypesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetadataTypesStmtContext {
-	var p = new(ShowMetadataTypesStmtContext)
+func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetadataTypesStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 
 	return p
 }
 
-func (s *ShowMetadataTypesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetadataTypesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *ShowMetadataTypesStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
+func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowMetadataTypesStmtContext) T_TYPES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TYPES, 0)
+func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowMetadataTypesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetadataTypesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMetadataTypesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetadataTypesStmt(s)
+		listenerT.EnterDropDatabaseStmt(s)
 	}
 }
 
-func (s *ShowMetadataTypesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetadataTypesStmt(s)
+		listenerT.ExitDropDatabaseStmt(s)
 	}
 }
 
-func (s *ShowMetadataTypesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetadataTypesStmt(s)
+		return t.VisitDropDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtContext) {
+func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMetadataTypesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SQLParserRULE_showMetadataTypesStmt)
+	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SQLParserRULE_dropDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2903,88 +8779,80 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(265)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(557)
+		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(266)
-		p.Match(SQLParserT_METADATA)
+		p.SetState(558)
+		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(267)
-		p.Match(SQLParserT_TYPES)
+		p.SetState(559)
+		p.DatabaseName()
 	}
 
 	return localctx
 }
 
-// IShowRootMetaStmtContext is an interface to support dynamic dispatch.
-type IShowRootMetaStmtContext interface {
+// IAlterDatabaseStmtContext is an interface to support dynamic dispatch.
+type IAlterDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_ROOT() antlr.TerminalNode
-	T_METADATA() antlr.TerminalNode
-	T_FROM() antlr.TerminalNode
-	Source() ISourceContext
-	T_WHERE() antlr.TerminalNode
-	TypeFilter() ITypeFilterContext
+	T_ALTER() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+	T_SET() antlr.TerminalNode
+	T_WRITE() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	SwitchValue() ISwitchValueContext
 
-	// IsShowRootMetaStmtContext differentiates from other interfaces.
-	IsShowRootMetaStmtContext()
+	// IsAlterDatabaseStmtContext differentiates from other interfaces.
+	IsAlterDatabaseStmtContext()
 }
 
-type ShowRootMetaStmtContext struct {
+type AlterDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRootMetaStmtContext() *ShowRootMetaStmtContext {
-	var p = new(ShowRootMetaStmtContext)
+func NewEmptyAlterDatabaseStmtContext() *AlterDatabaseStmtContext {
+	var p = new(AlterDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRootMetaStmt
+	p.RuleIndex = SQLParserRULE_alterDatabaseStmt
 	return p
 }
 
-func (*ShowRootMetaStmtContext) IsShowRootMetaStmtContext() {}
+func (*AlterDatabaseStmtContext) IsAlterDatabaseStmtContext() {}
 
-func NewShowRootMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRootMetaStmtContext {
-	var p = new(ShowRootMetaStmtContext)
+func NewAlterDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterDatabaseStmtContext {
+	var p = new(AlterDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRootMetaStmt
+	p.RuleIndex = SQLParserRULE_alterDatabaseStmt
 
 	return p
 }
 
-func (s *ShowRootMetaStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowRootMetaStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowRootMetaStmtContext) T_ROOT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ROOT, 0)
-}
+func (s *AlterDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowRootMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
+func (s *AlterDatabaseStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
 }
 
-func (s *ShowRootMetaStmtContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
+func (s *AlterDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowRootMetaStmtContext) Source() ISourceContext {
+func (s *AlterDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2994,17 +8862,25 @@ func (s *ShowRootMetaStmtContext) Source() ISourceContext {
 		return nil
 	}
 
-	return t.(ISourceContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowRootMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *AlterDatabaseStmtContext) T_SET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SET, 0)
 }
 
-func (s *ShowRootMetaStmtContext) TypeFilter() ITypeFilterContext {
+func (s *AlterDatabaseStmtContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *AlterDatabaseStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *AlterDatabaseStmtContext) SwitchValue() ISwitchValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
+		if _, ok := ctx.(ISwitchValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3014,45 +8890,45 @@ func (s *ShowRootMetaStmtContext) TypeFilter() ITypeFilterContext {
 		return nil
 	}
 
-	return t.(ITypeFilterContext)
+	return t.(ISwitchValueContext)
 }
 
-func (s *ShowRootMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowRootMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowRootMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRootMetaStmt(s)
+		listenerT.EnterAlterDatabaseStmt(s)
 	}
 }
 
-func (s *ShowRootMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRootMetaStmt(s)
+		listenerT.ExitAlterDatabaseStmt(s)
 	}
 }
 
-func (s *ShowRootMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowRootMetaStmt(s)
+		return t.VisitAlterDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
+func (p *SQLParser) AlterDatabaseStmt() (localctx IAlterDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowRootMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SQLParserRULE_showRootMetaStmt)
+	localctx = NewAlterDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SQLParserRULE_alterDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3072,194 +8948,123 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(269)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(561)
+		p.Match(SQLParserT_ALTER)
 	}
 	{
-		p.SetState(270)
-		p.Match(SQLParserT_ROOT)
+		p.SetState(562)
+		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(271)
-		p.Match(SQLParserT_METADATA)
+		p.SetState(563)
+		p.DatabaseName()
 	}
 	{
-		p.SetState(272)
-		p.Match(SQLParserT_FROM)
+		p.SetState(564)
+		p.Match(SQLParserT_SET)
 	}
 	{
-		p.SetState(273)
-		p.Source()
+		p.SetState(565)
+		p.Match(SQLParserT_WRITE)
 	}
 	{
-		p.SetState(274)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(566)
+		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(275)
-		p.TypeFilter()
+		p.SetState(567)
+		p.SwitchValue()
 	}
 
 	return localctx
 }
 
-// IShowBrokerMetaStmtContext is an interface to support dynamic dispatch.
-type IShowBrokerMetaStmtContext interface {
+// ISwitchValueContext is an interface to support dynamic dispatch.
+type ISwitchValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_BROKER() antlr.TerminalNode
-	T_METADATA() antlr.TerminalNode
-	T_FROM() antlr.TerminalNode
-	Source() ISourceContext
-	T_WHERE() antlr.TerminalNode
-	TypeFilter() ITypeFilterContext
-	T_AND() antlr.TerminalNode
-	BrokerFilter() IBrokerFilterContext
+	T_ON() antlr.TerminalNode
+	T_OFF() antlr.TerminalNode
 
-	// IsShowBrokerMetaStmtContext differentiates from other interfaces.
-	IsShowBrokerMetaStmtContext()
+	// IsSwitchValueContext differentiates from other interfaces.
+	IsSwitchValueContext()
 }
 
-type ShowBrokerMetaStmtContext struct {
+type SwitchValueContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokerMetaStmtContext() *ShowBrokerMetaStmtContext {
-	var p = new(ShowBrokerMetaStmtContext)
+func NewEmptySwitchValueContext() *SwitchValueContext {
+	var p = new(SwitchValueContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
+	p.RuleIndex = SQLParserRULE_switchValue
 	return p
 }
 
-func (*ShowBrokerMetaStmtContext) IsShowBrokerMetaStmtContext() {}
+func (*SwitchValueContext) IsSwitchValueContext() {}
 
-func NewShowBrokerMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetaStmtContext {
-	var p = new(ShowBrokerMetaStmtContext)
+func NewSwitchValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchValueContext {
+	var p = new(SwitchValueContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokerMetaStmt
-
-	return p
-}
-
-func (s *ShowBrokerMetaStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowBrokerMetaStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) Source() ISourceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISourceContext)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowBrokerMetaStmtContext) TypeFilter() ITypeFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeFilterContext)
-}
-
-func (s *ShowBrokerMetaStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+	p.RuleIndex = SQLParserRULE_switchValue
+
+	return p
 }
 
-func (s *ShowBrokerMetaStmtContext) BrokerFilter() IBrokerFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBrokerFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *SwitchValueContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *SwitchValueContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
 
-	return t.(IBrokerFilterContext)
+func (s *SwitchValueContext) T_OFF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFF, 0)
 }
 
-func (s *ShowBrokerMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *SwitchValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokerMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SwitchValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokerMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SwitchValueContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokerMetaStmt(s)
+		listenerT.EnterSwitchValue(s)
 	}
 }
 
-func (s *ShowBrokerMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SwitchValueContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokerMetaStmt(s)
+		listenerT.ExitSwitchValue(s)
 	}
 }
 
-func (s *ShowBrokerMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SwitchValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokerMetaStmt(s)
+		return t.VisitSwitchValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
+func (p *SQLParser) SwitchValue() (localctx ISwitchValueContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokerMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SQLParserRULE_showBrokerMetaStmt)
+	localctx = NewSwitchValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SQLParserRULE_switchValue)
 	var _la int
 
 	defer func() {
@@ -3280,54 +9085,22 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(277)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(278)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(279)
-		p.Match(SQLParserT_METADATA)
-	}
-	{
-		p.SetState(280)
-		p.Match(SQLParserT_FROM)
-	}
-	{
-		p.SetState(281)
-		p.Source()
-	}
-	{
-		p.SetState(282)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(283)
-		p.TypeFilter()
-	}
-	p.SetState(286)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+		p.SetState(569)
+		_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_AND {
-		{
-			p.SetState(284)
-			p.Match(SQLParserT_AND)
-		}
-		{
-			p.SetState(285)
-			p.BrokerFilter()
+		if !(_la == SQLParserT_ON || _la == SQLParserT_OFF) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
 	}
 
 	return localctx
 }
 
-// IShowMasterMetaStmtContext is an interface to support dynamic dispatch.
-type IShowMasterMetaStmtContext interface {
+// IShowReadOnlyStmtContext is an interface to support dynamic dispatch.
+type IShowReadOnlyStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -3335,84 +9108,57 @@ type IShowMasterMetaStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_MASTER() antlr.TerminalNode
-	T_METADATA() antlr.TerminalNode
+	T_READONLY() antlr.TerminalNode
 	T_FROM() antlr.TerminalNode
-	Source() ISourceContext
-	T_WHERE() antlr.TerminalNode
-	TypeFilter() ITypeFilterContext
+	StorageName() IStorageNameContext
 
-	// IsShowMasterMetaStmtContext differentiates from other interfaces.
-	IsShowMasterMetaStmtContext()
+	// IsShowReadOnlyStmtContext differentiates from other interfaces.
+	IsShowReadOnlyStmtContext()
 }
 
-type ShowMasterMetaStmtContext struct {
+type ShowReadOnlyStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMasterMetaStmtContext() *ShowMasterMetaStmtContext {
-	var p = new(ShowMasterMetaStmtContext)
+func NewEmptyShowReadOnlyStmtContext() *ShowReadOnlyStmtContext {
+	var p = new(ShowReadOnlyStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+	p.RuleIndex = SQLParserRULE_showReadOnlyStmt
 	return p
 }
 
-func (*ShowMasterMetaStmtContext) IsShowMasterMetaStmtContext() {}
+func (*ShowReadOnlyStmtContext) IsShowReadOnlyStmtContext() {}
 
-func NewShowMasterMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMasterMetaStmtContext {
-	var p = new(ShowMasterMetaStmtContext)
+func NewShowReadOnlyStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReadOnlyStmtContext {
+	var p = new(ShowReadOnlyStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMasterMetaStmt
+	p.RuleIndex = SQLParserRULE_showReadOnlyStmt
 
 	return p
 }
 
-func (s *ShowMasterMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowReadOnlyStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMasterMetaStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowReadOnlyStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowMasterMetaStmtContext) T_MASTER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_MASTER, 0)
-}
-
-func (s *ShowMasterMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
+func (s *ShowReadOnlyStmtContext) T_READONLY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READONLY, 0)
 }
 
-func (s *ShowMasterMetaStmtContext) T_FROM() antlr.TerminalNode {
+func (s *ShowReadOnlyStmtContext) T_FROM() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_FROM, 0)
 }
 
-func (s *ShowMasterMetaStmtContext) Source() ISourceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISourceContext)
-}
-
-func (s *ShowMasterMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowMasterMetaStmtContext) TypeFilter() ITypeFilterContext {
+func (s *ShowReadOnlyStmtContext) StorageName() IStorageNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
+		if _, ok := ctx.(IStorageNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3422,45 +9168,45 @@ func (s *ShowMasterMetaStmtContext) TypeFilter() ITypeFilterContext {
 		return nil
 	}
 
-	return t.(ITypeFilterContext)
+	return t.(IStorageNameContext)
 }
 
-func (s *ShowMasterMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowReadOnlyStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMasterMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowReadOnlyStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMasterMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowReadOnlyStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMasterMetaStmt(s)
+		listenerT.EnterShowReadOnlyStmt(s)
 	}
 }
 
-func (s *ShowMasterMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowReadOnlyStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMasterMetaStmt(s)
+		listenerT.ExitShowReadOnlyStmt(s)
 	}
 }
 
-func (s *ShowMasterMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowReadOnlyStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMasterMetaStmt(s)
+		return t.VisitShowReadOnlyStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
+func (p *SQLParser) ShowReadOnlyStmt() (localctx IShowReadOnlyStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMasterMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SQLParserRULE_showMasterMetaStmt)
+	localctx = NewShowReadOnlyStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SQLParserRULE_showReadOnlyStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3480,108 +9226,86 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(288)
+		p.SetState(571)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(289)
-		p.Match(SQLParserT_MASTER)
-	}
-	{
-		p.SetState(290)
-		p.Match(SQLParserT_METADATA)
+		p.SetState(572)
+		p.Match(SQLParserT_READONLY)
 	}
 	{
-		p.SetState(291)
+		p.SetState(573)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(292)
-		p.Source()
-	}
-	{
-		p.SetState(293)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(294)
-		p.TypeFilter()
+		p.SetState(574)
+		p.StorageName()
 	}
 
 	return localctx
 }
 
-// IShowStorageMetaStmtContext is an interface to support dynamic dispatch.
-type IShowStorageMetaStmtContext interface {
+// IAlterStorageReadOnlyStmtContext is an interface to support dynamic dispatch.
+type IAlterStorageReadOnlyStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
+	T_ALTER() antlr.TerminalNode
 	T_STORAGE() antlr.TerminalNode
-	T_METADATA() antlr.TerminalNode
-	T_FROM() antlr.TerminalNode
-	Source() ISourceContext
-	T_WHERE() antlr.TerminalNode
-	T_AND() antlr.TerminalNode
-	AllStorageFilter() []IStorageFilterContext
-	StorageFilter(i int) IStorageFilterContext
-	AllTypeFilter() []ITypeFilterContext
-	TypeFilter(i int) ITypeFilterContext
+	StorageName() IStorageNameContext
+	T_SET() antlr.TerminalNode
+	T_READONLY() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	SwitchValue() ISwitchValueContext
+	T_NODE() antlr.TerminalNode
+	NodeID() INodeIDContext
 
-	// IsShowStorageMetaStmtContext differentiates from other interfaces.
-	IsShowStorageMetaStmtContext()
+	// IsAlterStorageReadOnlyStmtContext differentiates from other interfaces.
+	IsAlterStorageReadOnlyStmtContext()
 }
 
-type ShowStorageMetaStmtContext struct {
+type AlterStorageReadOnlyStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStorageMetaStmtContext() *ShowStorageMetaStmtContext {
-	var p = new(ShowStorageMetaStmtContext)
+func NewEmptyAlterStorageReadOnlyStmtContext() *AlterStorageReadOnlyStmtContext {
+	var p = new(AlterStorageReadOnlyStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
+	p.RuleIndex = SQLParserRULE_alterStorageReadOnlyStmt
 	return p
 }
 
-func (*ShowStorageMetaStmtContext) IsShowStorageMetaStmtContext() {}
+func (*AlterStorageReadOnlyStmtContext) IsAlterStorageReadOnlyStmtContext() {}
 
-func NewShowStorageMetaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetaStmtContext {
-	var p = new(ShowStorageMetaStmtContext)
+func NewAlterStorageReadOnlyStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterStorageReadOnlyStmtContext {
+	var p = new(AlterStorageReadOnlyStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStorageMetaStmt
+	p.RuleIndex = SQLParserRULE_alterStorageReadOnlyStmt
 
 	return p
 }
 
-func (s *ShowStorageMetaStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterStorageReadOnlyStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStorageMetaStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *AlterStorageReadOnlyStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
 }
 
-func (s *ShowStorageMetaStmtContext) T_STORAGE() antlr.TerminalNode {
+func (s *AlterStorageReadOnlyStmtContext) T_STORAGE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *ShowStorageMetaStmtContext) T_METADATA() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METADATA, 0)
-}
-
-func (s *ShowStorageMetaStmtContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
-}
-
-func (s *ShowStorageMetaStmtContext) Source() ISourceContext {
+func (s *AlterStorageReadOnlyStmtContext) StorageName() IStorageNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceContext); ok {
+		if _, ok := ctx.(IStorageNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3591,48 +9315,27 @@ func (s *ShowStorageMetaStmtContext) Source() ISourceContext {
 		return nil
 	}
 
-	return t.(ISourceContext)
+	return t.(IStorageNameContext)
 }
 
-func (s *ShowStorageMetaStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *AlterStorageReadOnlyStmtContext) T_SET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SET, 0)
 }
 
-func (s *ShowStorageMetaStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+func (s *AlterStorageReadOnlyStmtContext) T_READONLY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READONLY, 0)
 }
 
-func (s *ShowStorageMetaStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *AlterStorageReadOnlyStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
 }
 
-func (s *ShowStorageMetaStmtContext) StorageFilter(i int) IStorageFilterContext {
+func (s *AlterStorageReadOnlyStmtContext) SwitchValue() ISwitchValueContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ISwitchValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3640,40 +9343,19 @@ func (s *ShowStorageMetaStmtContext) StorageFilter(i int) IStorageFilterContext
 		return nil
 	}
 
-	return t.(IStorageFilterContext)
-}
-
-func (s *ShowStorageMetaStmtContext) AllTypeFilter() []ITypeFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ITypeFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ITypeFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ITypeFilterContext); ok {
-			tst[i] = t.(ITypeFilterContext)
-			i++
-		}
-	}
-
-	return tst
+	return t.(ISwitchValueContext)
 }
 
-func (s *ShowStorageMetaStmtContext) TypeFilter(i int) ITypeFilterContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+func (s *AlterStorageReadOnlyStmtContext) T_NODE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NODE, 0)
+}
+
+func (s *AlterStorageReadOnlyStmtContext) NodeID() INodeIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INodeIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3681,45 +9363,46 @@ func (s *ShowStorageMetaStmtContext) TypeFilter(i int) ITypeFilterContext {
 		return nil
 	}
 
-	return t.(ITypeFilterContext)
+	return t.(INodeIDContext)
 }
 
-func (s *ShowStorageMetaStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterStorageReadOnlyStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStorageMetaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterStorageReadOnlyStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStorageMetaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterStorageReadOnlyStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStorageMetaStmt(s)
+		listenerT.EnterAlterStorageReadOnlyStmt(s)
 	}
 }
 
-func (s *ShowStorageMetaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterStorageReadOnlyStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStorageMetaStmt(s)
+		listenerT.ExitAlterStorageReadOnlyStmt(s)
 	}
 }
 
-func (s *ShowStorageMetaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterStorageReadOnlyStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStorageMetaStmt(s)
+		return t.VisitAlterStorageReadOnlyStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext) {
+func (p *SQLParser) AlterStorageReadOnlyStmt() (localctx IAlterStorageReadOnlyStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStorageMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SQLParserRULE_showStorageMetaStmt)
+	localctx = NewAlterStorageReadOnlyStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SQLParserRULE_alterStorageReadOnlyStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3739,177 +9422,187 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(296)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(576)
+		p.Match(SQLParserT_ALTER)
 	}
 	{
-		p.SetState(297)
+		p.SetState(577)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(298)
-		p.Match(SQLParserT_METADATA)
-	}
-	{
-		p.SetState(299)
-		p.Match(SQLParserT_FROM)
-	}
-	{
-		p.SetState(300)
-		p.Source()
-	}
-	{
-		p.SetState(301)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(578)
+		p.StorageName()
 	}
-	p.SetState(304)
+	p.SetState(581)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	if _la == SQLParserT_NODE {
 		{
-			p.SetState(302)
-			p.StorageFilter()
+			p.SetState(579)
+			p.Match(SQLParserT_NODE)
 		}
-
-	case SQLParserT_TYPE:
 		{
-			p.SetState(303)
-			p.TypeFilter()
+			p.SetState(580)
+			p.NodeID()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(306)
-		p.Match(SQLParserT_AND)
+		p.SetState(583)
+		p.Match(SQLParserT_SET)
 	}
-	p.SetState(309)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(307)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_TYPE:
-		{
-			p.SetState(308)
-			p.TypeFilter()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	{
+		p.SetState(584)
+		p.Match(SQLParserT_READONLY)
+	}
+	{
+		p.SetState(585)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(586)
+		p.SwitchValue()
 	}
 
 	return localctx
 }
 
-// IShowAliveStmtContext is an interface to support dynamic dispatch.
-type IShowAliveStmtContext interface {
+// IAlterDatabaseReadOnlyStmtContext is an interface to support dynamic dispatch.
+type IAlterDatabaseReadOnlyStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_ALIVE() antlr.TerminalNode
-	T_ROOT() antlr.TerminalNode
-	T_BROKER() antlr.TerminalNode
-	T_STORAGE() antlr.TerminalNode
+	T_ALTER() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+	T_SET() antlr.TerminalNode
+	T_READONLY() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	SwitchValue() ISwitchValueContext
 
-	// IsShowAliveStmtContext differentiates from other interfaces.
-	IsShowAliveStmtContext()
+	// IsAlterDatabaseReadOnlyStmtContext differentiates from other interfaces.
+	IsAlterDatabaseReadOnlyStmtContext()
 }
 
-type ShowAliveStmtContext struct {
+type AlterDatabaseReadOnlyStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowAliveStmtContext() *ShowAliveStmtContext {
-	var p = new(ShowAliveStmtContext)
+func NewEmptyAlterDatabaseReadOnlyStmtContext() *AlterDatabaseReadOnlyStmtContext {
+	var p = new(AlterDatabaseReadOnlyStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showAliveStmt
+	p.RuleIndex = SQLParserRULE_alterDatabaseReadOnlyStmt
 	return p
 }
 
-func (*ShowAliveStmtContext) IsShowAliveStmtContext() {}
+func (*AlterDatabaseReadOnlyStmtContext) IsAlterDatabaseReadOnlyStmtContext() {}
 
-func NewShowAliveStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAliveStmtContext {
-	var p = new(ShowAliveStmtContext)
+func NewAlterDatabaseReadOnlyStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterDatabaseReadOnlyStmtContext {
+	var p = new(AlterDatabaseReadOnlyStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showAliveStmt
+	p.RuleIndex = SQLParserRULE_alterDatabaseReadOnlyStmt
 
 	return p
 }
 
-func (s *ShowAliveStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterDatabaseReadOnlyStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowAliveStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *AlterDatabaseReadOnlyStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
 }
 
-func (s *ShowAliveStmtContext) T_ALIVE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ALIVE, 0)
+func (s *AlterDatabaseReadOnlyStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowAliveStmtContext) T_ROOT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ROOT, 0)
+func (s *AlterDatabaseReadOnlyStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowAliveStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
+func (s *AlterDatabaseReadOnlyStmtContext) T_SET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SET, 0)
 }
 
-func (s *ShowAliveStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *AlterDatabaseReadOnlyStmtContext) T_READONLY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READONLY, 0)
 }
 
-func (s *ShowAliveStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterDatabaseReadOnlyStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *AlterDatabaseReadOnlyStmtContext) SwitchValue() ISwitchValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitchValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchValueContext)
+}
+
+func (s *AlterDatabaseReadOnlyStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowAliveStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterDatabaseReadOnlyStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowAliveStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterDatabaseReadOnlyStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowAliveStmt(s)
+		listenerT.EnterAlterDatabaseReadOnlyStmt(s)
 	}
 }
 
-func (s *ShowAliveStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterDatabaseReadOnlyStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowAliveStmt(s)
+		listenerT.ExitAlterDatabaseReadOnlyStmt(s)
 	}
 }
 
-func (s *ShowAliveStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterDatabaseReadOnlyStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowAliveStmt(s)
+		return t.VisitAlterDatabaseReadOnlyStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
+func (p *SQLParser) AlterDatabaseReadOnlyStmt() (localctx IAlterDatabaseReadOnlyStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowAliveStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SQLParserRULE_showAliveStmt)
-	var _la int
+	localctx = NewAlterDatabaseReadOnlyStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SQLParserRULE_alterDatabaseReadOnlyStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3929,210 +9622,118 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(311)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(588)
+		p.Match(SQLParserT_ALTER)
 	}
 	{
-		p.SetState(312)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&15032385536) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
+		p.SetState(589)
+		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(313)
-		p.Match(SQLParserT_ALIVE)
+		p.SetState(590)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(591)
+		p.Match(SQLParserT_SET)
+	}
+	{
+		p.SetState(592)
+		p.Match(SQLParserT_READONLY)
+	}
+	{
+		p.SetState(593)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(594)
+		p.SwitchValue()
 	}
 
 	return localctx
 }
 
-// IShowReplicationStmtContext is an interface to support dynamic dispatch.
-type IShowReplicationStmtContext interface {
+// INodeIDContext is an interface to support dynamic dispatch.
+type INodeIDContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_REPLICATION() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	T_AND() antlr.TerminalNode
-	AllStorageFilter() []IStorageFilterContext
-	StorageFilter(i int) IStorageFilterContext
-	AllDatabaseFilter() []IDatabaseFilterContext
-	DatabaseFilter(i int) IDatabaseFilterContext
-
-	// IsShowReplicationStmtContext differentiates from other interfaces.
-	IsShowReplicationStmtContext()
-}
-
-type ShowReplicationStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyShowReplicationStmtContext() *ShowReplicationStmtContext {
-	var p = new(ShowReplicationStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showReplicationStmt
-	return p
-}
-
-func (*ShowReplicationStmtContext) IsShowReplicationStmtContext() {}
-
-func NewShowReplicationStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReplicationStmtContext {
-	var p = new(ShowReplicationStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showReplicationStmt
-
-	return p
-}
-
-func (s *ShowReplicationStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowReplicationStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowReplicationStmtContext) T_REPLICATION() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REPLICATION, 0)
-}
-
-func (s *ShowReplicationStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowReplicationStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
-}
-
-func (s *ShowReplicationStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *ShowReplicationStmtContext) StorageFilter(i int) IStorageFilterContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+	L_INT() antlr.TerminalNode
 
-	if t == nil {
-		return nil
-	}
+	// IsNodeIDContext differentiates from other interfaces.
+	IsNodeIDContext()
+}
 
-	return t.(IStorageFilterContext)
+type NodeIDContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *ShowReplicationStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
-			len++
-		}
-	}
+func NewEmptyNodeIDContext() *NodeIDContext {
+	var p = new(NodeIDContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_nodeID
+	return p
+}
 
-	tst := make([]IDatabaseFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IDatabaseFilterContext); ok {
-			tst[i] = t.(IDatabaseFilterContext)
-			i++
-		}
-	}
+func (*NodeIDContext) IsNodeIDContext() {}
 
-	return tst
-}
+func NewNodeIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NodeIDContext {
+	var p = new(NodeIDContext)
 
-func (s *ShowReplicationStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_nodeID
 
-	return t.(IDatabaseFilterContext)
+	return p
 }
 
-func (s *ShowReplicationStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *NodeIDContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NodeIDContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *NodeIDContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowReplicationStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NodeIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowReplicationStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NodeIDContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowReplicationStmt(s)
+		listenerT.EnterNodeID(s)
 	}
 }
 
-func (s *ShowReplicationStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NodeIDContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowReplicationStmt(s)
+		listenerT.ExitNodeID(s)
 	}
 }
 
-func (s *ShowReplicationStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NodeIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowReplicationStmt(s)
+		return t.VisitNodeID(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext) {
+func (p *SQLParser) NodeID() (localctx INodeIDContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowReplicationStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SQLParserRULE_showReplicationStmt)
+	localctx = NewNodeIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SQLParserRULE_nodeID)
 
 	defer func() {
 		p.ExitRule()
@@ -4152,65 +9753,15 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(315)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(316)
-		p.Match(SQLParserT_REPLICATION)
-	}
-	{
-		p.SetState(317)
-		p.Match(SQLParserT_WHERE)
-	}
-	p.SetState(320)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(318)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_DATASBAE:
-		{
-			p.SetState(319)
-			p.DatabaseFilter()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	{
-		p.SetState(322)
-		p.Match(SQLParserT_AND)
-	}
-	p.SetState(325)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(323)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_DATASBAE:
-		{
-			p.SetState(324)
-			p.DatabaseFilter()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(596)
+		p.Match(SQLParserL_INT)
 	}
 
 	return localctx
 }
 
-// IShowMemoryDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowMemoryDatabaseStmtContext interface {
+// IShowCompactionStmtContext is an interface to support dynamic dispatch.
+type IShowCompactionStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -4218,138 +9769,69 @@ type IShowMemoryDatabaseStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_MEMORY() antlr.TerminalNode
+	T_COMPACTION() antlr.TerminalNode
+	T_JOBS() antlr.TerminalNode
+	T_FOR() antlr.TerminalNode
 	T_DATASBAE() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	T_AND() antlr.TerminalNode
-	AllStorageFilter() []IStorageFilterContext
-	StorageFilter(i int) IStorageFilterContext
-	AllDatabaseFilter() []IDatabaseFilterContext
-	DatabaseFilter(i int) IDatabaseFilterContext
+	DatabaseName() IDatabaseNameContext
 
-	// IsShowMemoryDatabaseStmtContext differentiates from other interfaces.
-	IsShowMemoryDatabaseStmtContext()
+	// IsShowCompactionStmtContext differentiates from other interfaces.
+	IsShowCompactionStmtContext()
 }
 
-type ShowMemoryDatabaseStmtContext struct {
+type ShowCompactionStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMemoryDatabaseStmtContext() *ShowMemoryDatabaseStmtContext {
-	var p = new(ShowMemoryDatabaseStmtContext)
+func NewEmptyShowCompactionStmtContext() *ShowCompactionStmtContext {
+	var p = new(ShowCompactionStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showCompactionStmt
 	return p
 }
 
-func (*ShowMemoryDatabaseStmtContext) IsShowMemoryDatabaseStmtContext() {}
+func (*ShowCompactionStmtContext) IsShowCompactionStmtContext() {}
 
-func NewShowMemoryDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMemoryDatabaseStmtContext {
-	var p = new(ShowMemoryDatabaseStmtContext)
+func NewShowCompactionStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowCompactionStmtContext {
+	var p = new(ShowCompactionStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMemoryDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showCompactionStmt
 
 	return p
 }
 
-func (s *ShowMemoryDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowCompactionStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMemoryDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowCompactionStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) T_MEMORY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_MEMORY, 0)
-}
-
-func (s *ShowMemoryDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
-}
-
-func (s *ShowMemoryDatabaseStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowMemoryDatabaseStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+func (s *ShowCompactionStmtContext) T_COMPACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMPACTION, 0)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *ShowCompactionStmtContext) T_JOBS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_JOBS, 0)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) StorageFilter(i int) IStorageFilterContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStorageFilterContext)
+func (s *ShowCompactionStmtContext) T_FOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FOR, 0)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IDatabaseFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IDatabaseFilterContext); ok {
-			tst[i] = t.(IDatabaseFilterContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *ShowCompactionStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
+func (s *ShowCompactionStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4357,45 +9839,46 @@ func (s *ShowMemoryDatabaseStmtContext) DatabaseFilter(i int) IDatabaseFilterCon
 		return nil
 	}
 
-	return t.(IDatabaseFilterContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowCompactionStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMemoryDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowCompactionStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMemoryDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowCompactionStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMemoryDatabaseStmt(s)
+		listenerT.EnterShowCompactionStmt(s)
 	}
 }
 
-func (s *ShowMemoryDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowCompactionStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMemoryDatabaseStmt(s)
+		listenerT.ExitShowCompactionStmt(s)
 	}
 }
 
-func (s *ShowMemoryDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowCompactionStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMemoryDatabaseStmt(s)
+		return t.VisitShowCompactionStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtContext) {
+func (p *SQLParser) ShowCompactionStmt() (localctx IShowCompactionStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMemoryDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SQLParserRULE_showMemoryDatabaseStmt)
+	localctx = NewShowCompactionStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SQLParserRULE_showCompactionStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4415,132 +9898,134 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(327)
+		p.SetState(598)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(328)
-		p.Match(SQLParserT_MEMORY)
-	}
-	{
-		p.SetState(329)
-		p.Match(SQLParserT_DATASBAE)
-	}
-	{
-		p.SetState(330)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(599)
+		p.Match(SQLParserT_COMPACTION)
 	}
-	p.SetState(333)
+	p.SetState(601)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(331)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_DATASBAE:
+	if _la == SQLParserT_JOBS {
 		{
-			p.SetState(332)
-			p.DatabaseFilter()
+			p.SetState(600)
+			p.Match(SQLParserT_JOBS)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	{
-		p.SetState(335)
-		p.Match(SQLParserT_AND)
-	}
-	p.SetState(338)
+	p.SetState(606)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	if _la == SQLParserT_FOR {
+		{
+			p.SetState(603)
+			p.Match(SQLParserT_FOR)
+		}
 		{
-			p.SetState(336)
-			p.StorageFilter()
+			p.SetState(604)
+			p.Match(SQLParserT_DATASBAE)
 		}
-
-	case SQLParserT_DATASBAE:
 		{
-			p.SetState(337)
-			p.DatabaseFilter()
+			p.SetState(605)
+			p.DatabaseName()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IShowRootMetricStmtContext is an interface to support dynamic dispatch.
-type IShowRootMetricStmtContext interface {
+// ISetCompactionStmtContext is an interface to support dynamic dispatch.
+type ISetCompactionStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_ROOT() antlr.TerminalNode
-	T_METRIC() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	MetricListFilter() IMetricListFilterContext
+	T_SET() antlr.TerminalNode
+	T_COMPACTION() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	SwitchValue() ISwitchValueContext
+	T_FOR() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
 
-	// IsShowRootMetricStmtContext differentiates from other interfaces.
-	IsShowRootMetricStmtContext()
+	// IsSetCompactionStmtContext differentiates from other interfaces.
+	IsSetCompactionStmtContext()
 }
 
-type ShowRootMetricStmtContext struct {
+type SetCompactionStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRootMetricStmtContext() *ShowRootMetricStmtContext {
-	var p = new(ShowRootMetricStmtContext)
+func NewEmptySetCompactionStmtContext() *SetCompactionStmtContext {
+	var p = new(SetCompactionStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRootMetricStmt
+	p.RuleIndex = SQLParserRULE_setCompactionStmt
 	return p
 }
 
-func (*ShowRootMetricStmtContext) IsShowRootMetricStmtContext() {}
+func (*SetCompactionStmtContext) IsSetCompactionStmtContext() {}
 
-func NewShowRootMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRootMetricStmtContext {
-	var p = new(ShowRootMetricStmtContext)
+func NewSetCompactionStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetCompactionStmtContext {
+	var p = new(SetCompactionStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRootMetricStmt
+	p.RuleIndex = SQLParserRULE_setCompactionStmt
 
 	return p
 }
 
-func (s *ShowRootMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *SetCompactionStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowRootMetricStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *SetCompactionStmtContext) T_SET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SET, 0)
 }
 
-func (s *ShowRootMetricStmtContext) T_ROOT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ROOT, 0)
+func (s *SetCompactionStmtContext) T_COMPACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMPACTION, 0)
 }
 
-func (s *ShowRootMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
+func (s *SetCompactionStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
 }
 
-func (s *ShowRootMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *SetCompactionStmtContext) SwitchValue() ISwitchValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISwitchValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchValueContext)
 }
 
-func (s *ShowRootMetricStmtContext) MetricListFilter() IMetricListFilterContext {
+func (s *SetCompactionStmtContext) T_FOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FOR, 0)
+}
+
+func (s *SetCompactionStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *SetCompactionStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4550,45 +10035,46 @@ func (s *ShowRootMetricStmtContext) MetricListFilter() IMetricListFilterContext
 		return nil
 	}
 
-	return t.(IMetricListFilterContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowRootMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *SetCompactionStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowRootMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SetCompactionStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowRootMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SetCompactionStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRootMetricStmt(s)
+		listenerT.EnterSetCompactionStmt(s)
 	}
 }
 
-func (s *ShowRootMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SetCompactionStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRootMetricStmt(s)
+		listenerT.ExitSetCompactionStmt(s)
 	}
 }
 
-func (s *ShowRootMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SetCompactionStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowRootMetricStmt(s)
+		return t.VisitSetCompactionStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
+func (p *SQLParser) SetCompactionStmt() (localctx ISetCompactionStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowRootMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SQLParserRULE_showRootMetricStmt)
+	localctx = NewSetCompactionStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SQLParserRULE_setCompactionStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4608,31 +10094,46 @@ func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(340)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(608)
+		p.Match(SQLParserT_SET)
 	}
 	{
-		p.SetState(341)
-		p.Match(SQLParserT_ROOT)
+		p.SetState(609)
+		p.Match(SQLParserT_COMPACTION)
 	}
 	{
-		p.SetState(342)
-		p.Match(SQLParserT_METRIC)
+		p.SetState(610)
+		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(343)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(611)
+		p.SwitchValue()
 	}
-	{
-		p.SetState(344)
-		p.MetricListFilter()
+	p.SetState(615)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_FOR {
+		{
+			p.SetState(612)
+			p.Match(SQLParserT_FOR)
+		}
+		{
+			p.SetState(613)
+			p.Match(SQLParserT_DATASBAE)
+		}
+		{
+			p.SetState(614)
+			p.DatabaseName()
+		}
+
 	}
 
 	return localctx
 }
 
-// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
-type IShowBrokerMetricStmtContext interface {
+// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -4640,110 +10141,83 @@ type IShowBrokerMetricStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_BROKER() antlr.TerminalNode
-	T_METRIC() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	MetricListFilter() IMetricListFilterContext
+	T_DATASBAES() antlr.TerminalNode
 
-	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
-	IsShowBrokerMetricStmtContext()
+	// IsShowDatabaseStmtContext differentiates from other interfaces.
+	IsShowDatabaseStmtContext()
 }
 
-type ShowBrokerMetricStmtContext struct {
+type ShowDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
 	return p
 }
 
-func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
+func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
 
-func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
 
 	return p
 }
 
-func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMetricListFilterContext)
+func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAES, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokerMetricStmt(s)
+		listenerT.EnterShowDatabaseStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokerMetricStmt(s)
+		listenerT.ExitShowDatabaseStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokerMetricStmt(s)
+		return t.VisitShowDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
+func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SQLParserRULE_showBrokerMetricStmt)
+	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SQLParserRULE_showDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4763,31 +10237,19 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(346)
+		p.SetState(617)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(347)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(348)
-		p.Match(SQLParserT_METRIC)
-	}
-	{
-		p.SetState(349)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(350)
-		p.MetricListFilter()
+		p.SetState(618)
+		p.Match(SQLParserT_DATASBAES)
 	}
 
 	return localctx
 }
 
-// IShowStorageMetricStmtContext is an interface to support dynamic dispatch.
-type IShowStorageMetricStmtContext interface {
+// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
+type IShowNameSpacesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -4795,97 +10257,70 @@ type IShowStorageMetricStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_STORAGE() antlr.TerminalNode
-	T_METRIC() antlr.TerminalNode
+	T_NAMESPACES() antlr.TerminalNode
 	T_WHERE() antlr.TerminalNode
-	T_AND() antlr.TerminalNode
-	AllStorageFilter() []IStorageFilterContext
-	StorageFilter(i int) IStorageFilterContext
-	AllMetricListFilter() []IMetricListFilterContext
-	MetricListFilter(i int) IMetricListFilterContext
+	T_NAMESPACE() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	Prefix() IPrefixContext
+	LimitClause() ILimitClauseContext
 
-	// IsShowStorageMetricStmtContext differentiates from other interfaces.
-	IsShowStorageMetricStmtContext()
+	// IsShowNameSpacesStmtContext differentiates from other interfaces.
+	IsShowNameSpacesStmtContext()
 }
 
-type ShowStorageMetricStmtContext struct {
+type ShowNameSpacesStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStorageMetricStmtContext() *ShowStorageMetricStmtContext {
-	var p = new(ShowStorageMetricStmtContext)
+func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
 	return p
 }
 
-func (*ShowStorageMetricStmtContext) IsShowStorageMetricStmtContext() {}
+func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
 
-func NewShowStorageMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetricStmtContext {
-	var p = new(ShowStorageMetricStmtContext)
+func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
 
 	return p
 }
 
-func (s *ShowStorageMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStorageMetricStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
-}
-
-func (s *ShowStorageMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
-
-func (s *ShowStorageMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowStorageMetricStmtContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
-}
-
-func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			len++
-		}
-	}
+func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACES, 0)
+}
 
-	tst := make([]IStorageFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IStorageFilterContext); ok {
-			tst[i] = t.(IStorageFilterContext)
-			i++
-		}
-	}
+func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
 
-	return tst
+func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContext {
+func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4893,40 +10328,15 @@ func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContex
 		return nil
 	}
 
-	return t.(IStorageFilterContext)
-}
-
-func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilterContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IMetricListFilterContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IMetricListFilterContext); ok {
-			tst[i] = t.(IMetricListFilterContext)
-			i++
-		}
-	}
-
-	return tst
+	return t.(IPrefixContext)
 }
 
-func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilterContext {
+func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4934,45 +10344,46 @@ func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilter
 		return nil
 	}
 
-	return t.(IMetricListFilterContext)
+	return t.(ILimitClauseContext)
 }
 
-func (s *ShowStorageMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStorageMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStorageMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStorageMetricStmt(s)
+		listenerT.EnterShowNameSpacesStmt(s)
 	}
 }
 
-func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStorageMetricStmt(s)
+		listenerT.ExitShowNameSpacesStmt(s)
 	}
 }
 
-func (s *ShowStorageMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStorageMetricStmt(s)
+		return t.VisitShowNameSpacesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
+func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SQLParserRULE_showStorageMetricStmt)
+	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SQLParserRULE_showNameSpacesStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4992,122 +10403,117 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(352)
+		p.SetState(620)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(353)
-		p.Match(SQLParserT_STORAGE)
-	}
-	{
-		p.SetState(354)
-		p.Match(SQLParserT_METRIC)
-	}
-	{
-		p.SetState(355)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(621)
+		p.Match(SQLParserT_NAMESPACES)
 	}
-	p.SetState(358)
+	p.SetState(626)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
+	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(356)
-			p.StorageFilter()
+			p.SetState(622)
+			p.Match(SQLParserT_WHERE)
 		}
-
-	case SQLParserT_METRIC:
 		{
-			p.SetState(357)
-			p.MetricListFilter()
+			p.SetState(623)
+			p.Match(SQLParserT_NAMESPACE)
+		}
+		{
+			p.SetState(624)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(625)
+			p.Prefix()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	{
-		p.SetState(360)
-		p.Match(SQLParserT_AND)
 	}
-	p.SetState(363)
+	p.SetState(629)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_STORAGE:
-		{
-			p.SetState(361)
-			p.StorageFilter()
-		}
-
-	case SQLParserT_METRIC:
+	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(362)
-			p.MetricListFilter()
+			p.SetState(628)
+			p.LimitClause()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ICreateStorageStmtContext is an interface to support dynamic dispatch.
-type ICreateStorageStmtContext interface {
+// IShowMetricsStmtContext is an interface to support dynamic dispatch.
+type IShowMetricsStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_CREATE() antlr.TerminalNode
-	T_STORAGE() antlr.TerminalNode
-	Json() IJsonContext
+	T_SHOW() antlr.TerminalNode
+	T_METRICS() antlr.TerminalNode
+	T_ON() antlr.TerminalNode
+	Namespace() INamespaceContext
+	T_WHERE() antlr.TerminalNode
+	T_METRIC() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	Prefix() IPrefixContext
+	FuzzyClause() IFuzzyClauseContext
+	LimitClause() ILimitClauseContext
 
-	// IsCreateStorageStmtContext differentiates from other interfaces.
-	IsCreateStorageStmtContext()
+	// IsShowMetricsStmtContext differentiates from other interfaces.
+	IsShowMetricsStmtContext()
 }
 
-type CreateStorageStmtContext struct {
+type ShowMetricsStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
 	return p
 }
 
-func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
+func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
 
-func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
 
 	return p
 }
 
-func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRICS, 0)
 }
 
-func (s *CreateStorageStmtContext) Json() IJsonContext {
+func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(INamespaceContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5117,45 +10523,106 @@ func (s *CreateStorageStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(INamespaceContext)
+}
+
+func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowMetricsStmtContext) FuzzyClause() IFuzzyClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuzzyClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuzzyClauseContext)
+}
+
+func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
 }
 
-func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateStorageStmt(s)
+		listenerT.EnterShowMetricsStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateStorageStmt(s)
+		listenerT.ExitShowMetricsStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateStorageStmt(s)
+		return t.VisitShowMetricsStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
+func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SQLParserRULE_createStorageStmt)
+	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SQLParserRULE_showMetricsStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5175,76 +10642,133 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(365)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(631)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(366)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(632)
+		p.Match(SQLParserT_METRICS)
 	}
-	{
-		p.SetState(367)
-		p.Json()
+	p.SetState(635)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(633)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(634)
+			p.Namespace()
+		}
+
+	}
+	p.SetState(641)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(637)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(638)
+			p.Match(SQLParserT_METRIC)
+		}
+		{
+			p.SetState(639)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(640)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(644)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_FUZZY {
+		{
+			p.SetState(643)
+			p.FuzzyClause()
+		}
+
+	}
+	p.SetState(647)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(646)
+			p.LimitClause()
+		}
+
 	}
 
 	return localctx
 }
 
-// ICreateBrokerStmtContext is an interface to support dynamic dispatch.
-type ICreateBrokerStmtContext interface {
+// IShowFieldsStmtContext is an interface to support dynamic dispatch.
+type IShowFieldsStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_CREATE() antlr.TerminalNode
-	T_BROKER() antlr.TerminalNode
-	Json() IJsonContext
+	T_SHOW() antlr.TerminalNode
+	T_FIELDS() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	AtTimestampClause() IAtTimestampClauseContext
 
-	// IsCreateBrokerStmtContext differentiates from other interfaces.
-	IsCreateBrokerStmtContext()
+	// IsShowFieldsStmtContext differentiates from other interfaces.
+	IsShowFieldsStmtContext()
 }
 
-type CreateBrokerStmtContext struct {
+type ShowFieldsStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateBrokerStmtContext() *CreateBrokerStmtContext {
-	var p = new(CreateBrokerStmtContext)
+func NewEmptyShowFieldsStmtContext() *ShowFieldsStmtContext {
+	var p = new(ShowFieldsStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	p.RuleIndex = SQLParserRULE_showFieldsStmt
 	return p
 }
 
-func (*CreateBrokerStmtContext) IsCreateBrokerStmtContext() {}
+func (*ShowFieldsStmtContext) IsShowFieldsStmtContext() {}
 
-func NewCreateBrokerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateBrokerStmtContext {
-	var p = new(CreateBrokerStmtContext)
+func NewShowFieldsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldsStmtContext {
+	var p = new(ShowFieldsStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	p.RuleIndex = SQLParserRULE_showFieldsStmt
 
 	return p
 }
 
-func (s *CreateBrokerStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowFieldsStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateBrokerStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *ShowFieldsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateBrokerStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
+func (s *ShowFieldsStmtContext) T_FIELDS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIELDS, 0)
 }
 
-func (s *CreateBrokerStmtContext) Json() IJsonContext {
+func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5254,45 +10778,62 @@ func (s *CreateBrokerStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *CreateBrokerStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowFieldsStmtContext) AtTimestampClause() IAtTimestampClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAtTimestampClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtTimestampClauseContext)
+}
+
+func (s *ShowFieldsStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateBrokerStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowFieldsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateBrokerStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowFieldsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateBrokerStmt(s)
+		listenerT.EnterShowFieldsStmt(s)
 	}
 }
 
-func (s *CreateBrokerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateBrokerStmt(s)
+		listenerT.ExitShowFieldsStmt(s)
 	}
 }
 
-func (s *CreateBrokerStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateBrokerStmt(s)
+		return t.VisitShowFieldsStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
+func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateBrokerStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SQLParserRULE_createBrokerStmt)
+	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SQLParserRULE_showFieldsStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5312,76 +10853,93 @@ func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(369)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(649)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(370)
-		p.Match(SQLParserT_BROKER)
+		p.SetState(650)
+		p.Match(SQLParserT_FIELDS)
 	}
 	{
-		p.SetState(371)
-		p.Json()
+		p.SetState(651)
+		p.FromClause()
+	}
+	p.SetState(653)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_AT {
+		{
+			p.SetState(652)
+			p.AtTimestampClause()
+		}
+
 	}
 
 	return localctx
 }
 
-// IRecoverStorageStmtContext is an interface to support dynamic dispatch.
-type IRecoverStorageStmtContext interface {
+// IShowFieldUsageStmtContext is an interface to support dynamic dispatch.
+type IShowFieldUsageStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_RECOVER() antlr.TerminalNode
-	T_STORAGE() antlr.TerminalNode
-	StorageName() IStorageNameContext
+	T_SHOW() antlr.TerminalNode
+	T_FIELD() antlr.TerminalNode
+	T_USAGE() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	SinceClause() ISinceClauseContext
 
-	// IsRecoverStorageStmtContext differentiates from other interfaces.
-	IsRecoverStorageStmtContext()
+	// IsShowFieldUsageStmtContext differentiates from other interfaces.
+	IsShowFieldUsageStmtContext()
 }
 
-type RecoverStorageStmtContext struct {
+type ShowFieldUsageStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRecoverStorageStmtContext() *RecoverStorageStmtContext {
-	var p = new(RecoverStorageStmtContext)
+func NewEmptyShowFieldUsageStmtContext() *ShowFieldUsageStmtContext {
+	var p = new(ShowFieldUsageStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	p.RuleIndex = SQLParserRULE_showFieldUsageStmt
 	return p
 }
 
-func (*RecoverStorageStmtContext) IsRecoverStorageStmtContext() {}
+func (*ShowFieldUsageStmtContext) IsShowFieldUsageStmtContext() {}
 
-func NewRecoverStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecoverStorageStmtContext {
-	var p = new(RecoverStorageStmtContext)
+func NewShowFieldUsageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldUsageStmtContext {
+	var p = new(ShowFieldUsageStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	p.RuleIndex = SQLParserRULE_showFieldUsageStmt
 
 	return p
 }
 
-func (s *RecoverStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowFieldUsageStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RecoverStorageStmtContext) T_RECOVER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_RECOVER, 0)
+func (s *ShowFieldUsageStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *RecoverStorageStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *ShowFieldUsageStmtContext) T_FIELD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIELD, 0)
 }
 
-func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
+func (s *ShowFieldUsageStmtContext) T_USAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USAGE, 0)
+}
+
+func (s *ShowFieldUsageStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageNameContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5391,45 +10949,62 @@ func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
 		return nil
 	}
 
-	return t.(IStorageNameContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *RecoverStorageStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowFieldUsageStmtContext) SinceClause() ISinceClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISinceClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISinceClauseContext)
+}
+
+func (s *ShowFieldUsageStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RecoverStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowFieldUsageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RecoverStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowFieldUsageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRecoverStorageStmt(s)
+		listenerT.EnterShowFieldUsageStmt(s)
 	}
 }
 
-func (s *RecoverStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowFieldUsageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRecoverStorageStmt(s)
+		listenerT.ExitShowFieldUsageStmt(s)
 	}
 }
 
-func (s *RecoverStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowFieldUsageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRecoverStorageStmt(s)
+		return t.VisitShowFieldUsageStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
+func (p *SQLParser) ShowFieldUsageStmt() (localctx IShowFieldUsageStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewRecoverStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SQLParserRULE_recoverStorageStmt)
+	localctx = NewShowFieldUsageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SQLParserRULE_showFieldUsageStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5449,107 +11024,134 @@ func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(373)
-		p.Match(SQLParserT_RECOVER)
+		p.SetState(655)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(374)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(656)
+		p.Match(SQLParserT_FIELD)
 	}
 	{
-		p.SetState(375)
-		p.StorageName()
+		p.SetState(657)
+		p.Match(SQLParserT_USAGE)
+	}
+	{
+		p.SetState(658)
+		p.FromClause()
+	}
+	p.SetState(660)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_SINCE {
+		{
+			p.SetState(659)
+			p.SinceClause()
+		}
+
 	}
 
 	return localctx
 }
 
-// IShowSchemasStmtContext is an interface to support dynamic dispatch.
-type IShowSchemasStmtContext interface {
+// ISinceClauseContext is an interface to support dynamic dispatch.
+type ISinceClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_SCHEMAS() antlr.TerminalNode
+	T_SINCE() antlr.TerminalNode
+	DurationLit() IDurationLitContext
 
-	// IsShowSchemasStmtContext differentiates from other interfaces.
-	IsShowSchemasStmtContext()
+	// IsSinceClauseContext differentiates from other interfaces.
+	IsSinceClauseContext()
 }
 
-type ShowSchemasStmtContext struct {
+type SinceClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewEmptySinceClauseContext() *SinceClauseContext {
+	var p = new(SinceClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_sinceClause
 	return p
 }
 
-func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
+func (*SinceClauseContext) IsSinceClauseContext() {}
 
-func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewSinceClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SinceClauseContext {
+	var p = new(SinceClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_sinceClause
 
 	return p
 }
 
-func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *SinceClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *SinceClauseContext) T_SINCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SINCE, 0)
 }
 
-func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SCHEMAS, 0)
+func (s *SinceClauseContext) DurationLit() IDurationLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLitContext)
 }
 
-func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *SinceClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SinceClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SinceClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowSchemasStmt(s)
+		listenerT.EnterSinceClause(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SinceClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowSchemasStmt(s)
+		listenerT.ExitSinceClause(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SinceClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowSchemasStmt(s)
+		return t.VisitSinceClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
+func (p *SQLParser) SinceClause() (localctx ISinceClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SQLParserRULE_showSchemasStmt)
+	localctx = NewSinceClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SQLParserRULE_sinceClause)
 
 	defer func() {
 		p.ExitRule()
@@ -5569,72 +11171,78 @@ func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(377)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(662)
+		p.Match(SQLParserT_SINCE)
 	}
 	{
-		p.SetState(378)
-		p.Match(SQLParserT_SCHEMAS)
+		p.SetState(663)
+		p.DurationLit()
 	}
 
 	return localctx
 }
 
-// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
-type ICreateDatabaseStmtContext interface {
+// IShowTagKeysStmtContext is an interface to support dynamic dispatch.
+type IShowTagKeysStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_CREATE() antlr.TerminalNode
-	T_DATASBAE() antlr.TerminalNode
-	Json() IJsonContext
+	T_SHOW() antlr.TerminalNode
+	T_TAG() antlr.TerminalNode
+	T_KEYS() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	AtTimestampClause() IAtTimestampClauseContext
 
-	// IsCreateDatabaseStmtContext differentiates from other interfaces.
-	IsCreateDatabaseStmtContext()
+	// IsShowTagKeysStmtContext differentiates from other interfaces.
+	IsShowTagKeysStmtContext()
 }
 
-type CreateDatabaseStmtContext struct {
+type ShowTagKeysStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewEmptyShowTagKeysStmtContext() *ShowTagKeysStmtContext {
+	var p = new(ShowTagKeysStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showTagKeysStmt
 	return p
 }
 
-func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
+func (*ShowTagKeysStmtContext) IsShowTagKeysStmtContext() {}
 
-func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewShowTagKeysStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeysStmtContext {
+	var p = new(ShowTagKeysStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showTagKeysStmt
 
 	return p
 }
 
-func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTagKeysStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *ShowTagKeysStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *ShowTagKeysStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
 }
 
-func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+func (s *ShowTagKeysStmtContext) T_KEYS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEYS, 0)
+}
+
+func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5644,45 +11252,62 @@ func (s *CreateDatabaseStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTagKeysStmtContext) AtTimestampClause() IAtTimestampClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAtTimestampClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtTimestampClauseContext)
+}
+
+func (s *ShowTagKeysStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTagKeysStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagKeysStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateDatabaseStmt(s)
+		listenerT.EnterShowTagKeysStmt(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateDatabaseStmt(s)
+		listenerT.ExitShowTagKeysStmt(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateDatabaseStmt(s)
+		return t.VisitShowTagKeysStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
+func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SQLParserRULE_createDatabaseStmt)
+	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SQLParserRULE_showTagKeysStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5702,76 +11327,179 @@ func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(380)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(665)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(381)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(666)
+		p.Match(SQLParserT_TAG)
 	}
 	{
-		p.SetState(382)
-		p.Json()
+		p.SetState(667)
+		p.Match(SQLParserT_KEYS)
+	}
+	{
+		p.SetState(668)
+		p.FromClause()
+	}
+	p.SetState(670)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_AT {
+		{
+			p.SetState(669)
+			p.AtTimestampClause()
+		}
+
 	}
 
 	return localctx
 }
 
-// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
-type IDropDatabaseStmtContext interface {
+// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
+type IShowTagValuesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_DROP() antlr.TerminalNode
-	T_DATASBAE() antlr.TerminalNode
-	DatabaseName() IDatabaseNameContext
+	T_SHOW() antlr.TerminalNode
+	T_TAG() antlr.TerminalNode
+	T_VALUES() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	T_WITH() antlr.TerminalNode
+	T_KEY() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	WithTagKey() IWithTagKeyContext
+	WhereClause() IWhereClauseContext
+	FuzzyClause() IFuzzyClauseContext
+	LimitClause() ILimitClauseContext
 
-	// IsDropDatabaseStmtContext differentiates from other interfaces.
-	IsDropDatabaseStmtContext()
+	// IsShowTagValuesStmtContext differentiates from other interfaces.
+	IsShowTagValuesStmtContext()
 }
 
-type DropDatabaseStmtContext struct {
+type ShowTagValuesStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
 	return p
 }
 
-func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
+func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
 
-func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+
+	return p
+}
+
+func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VALUES, 0)
+}
+
+func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEY, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWithTagKeyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithTagKeyContext)
+}
+
+func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IWhereClauseContext)
 }
 
-func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTagValuesStmtContext) FuzzyClause() IFuzzyClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuzzyClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DROP, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+	return t.(IFuzzyClauseContext)
 }
 
-func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseNameContext); ok {
+		if _, ok := ctx.(ILimitClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5781,45 +11509,46 @@ func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 		return nil
 	}
 
-	return t.(IDatabaseNameContext)
+	return t.(ILimitClauseContext)
 }
 
-func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDropDatabaseStmt(s)
+		listenerT.EnterShowTagValuesStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDropDatabaseStmt(s)
+		listenerT.ExitShowTagValuesStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDropDatabaseStmt(s)
+		return t.VisitShowTagValuesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
+func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SQLParserRULE_dropDatabaseStmt)
+	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SQLParserRULE_showTagValuesStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5839,224 +11568,119 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(384)
-		p.Match(SQLParserT_DROP)
+		p.SetState(672)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(385)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(673)
+		p.Match(SQLParserT_TAG)
 	}
 	{
-		p.SetState(386)
-		p.DatabaseName()
+		p.SetState(674)
+		p.Match(SQLParserT_VALUES)
 	}
-
-	return localctx
-}
-
-// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowDatabaseStmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_DATASBAES() antlr.TerminalNode
-
-	// IsShowDatabaseStmtContext differentiates from other interfaces.
-	IsShowDatabaseStmtContext()
-}
-
-type ShowDatabaseStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
-	return p
-}
-
-func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
-
-func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
-
-	return p
-}
-
-func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAES, 0)
-}
-
-func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowDatabaseStmt(s)
+	{
+		p.SetState(675)
+		p.FromClause()
 	}
-}
-
-func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowDatabaseStmt(s)
+	{
+		p.SetState(676)
+		p.Match(SQLParserT_WITH)
 	}
-}
+	{
+		p.SetState(677)
+		p.Match(SQLParserT_KEY)
+	}
+	{
+		p.SetState(678)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(679)
+		p.WithTagKey()
+	}
+	p.SetState(681)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SQLVisitor:
-		return t.VisitShowDatabaseStmt(s)
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(680)
+			p.WhereClause()
+		}
 
-	default:
-		return t.VisitChildren(s)
 	}
-}
-
-func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
-	this := p
-	_ = this
+	p.SetState(684)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SQLParserRULE_showDatabaseStmt)
+	if _la == SQLParserT_FUZZY {
+		{
+			p.SetState(683)
+			p.FuzzyClause()
+		}
 
-	defer func() {
-		p.ExitRule()
-	}()
+	}
+	p.SetState(687)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(686)
+			p.LimitClause()
 		}
-	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(388)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(389)
-		p.Match(SQLParserT_DATASBAES)
 	}
 
 	return localctx
 }
 
-// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
-type IShowNameSpacesStmtContext interface {
+// IPrefixContext is an interface to support dynamic dispatch.
+type IPrefixContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_NAMESPACES() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	T_NAMESPACE() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	Prefix() IPrefixContext
-	LimitClause() ILimitClauseContext
+	Ident() IIdentContext
 
-	// IsShowNameSpacesStmtContext differentiates from other interfaces.
-	IsShowNameSpacesStmtContext()
+	// IsPrefixContext differentiates from other interfaces.
+	IsPrefixContext()
 }
 
-type ShowNameSpacesStmtContext struct {
+type PrefixContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewEmptyPrefixContext() *PrefixContext {
+	var p = new(PrefixContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_prefix
 	return p
 }
 
-func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+func (*PrefixContext) IsPrefixContext() {}
 
-func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
+	var p = new(PrefixContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
-
-	return p
-}
-
-func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACES, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACE, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_prefix
 
-	return t.(IPrefixContext)
+	return p
 }
 
-func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrefixContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6066,46 +11690,45 @@ func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(IIdentContext)
 }
 
-func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowNameSpacesStmt(s)
+		listenerT.EnterPrefix(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowNameSpacesStmt(s)
+		listenerT.ExitPrefix(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowNameSpacesStmt(s)
+		return t.VisitPrefix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SQLParserRULE_showNameSpacesStmt)
-	var _la int
+	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, SQLParserRULE_prefix)
 
 	defer func() {
 		p.ExitRule()
@@ -6125,141 +11748,60 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(391)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(392)
-		p.Match(SQLParserT_NAMESPACES)
-	}
-	p.SetState(397)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(393)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(394)
-			p.Match(SQLParserT_NAMESPACE)
-		}
-		{
-			p.SetState(395)
-			p.Match(SQLParserT_EQUAL)
-		}
-		{
-			p.SetState(396)
-			p.Prefix()
-		}
-
-	}
-	p.SetState(400)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(399)
-			p.LimitClause()
-		}
-
+		p.SetState(689)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IShowMetricsStmtContext is an interface to support dynamic dispatch.
-type IShowMetricsStmtContext interface {
+// IFuzzyClauseContext is an interface to support dynamic dispatch.
+type IFuzzyClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_METRICS() antlr.TerminalNode
-	T_ON() antlr.TerminalNode
-	Namespace() INamespaceContext
-	T_WHERE() antlr.TerminalNode
-	T_METRIC() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
+	T_FUZZY() antlr.TerminalNode
 	Prefix() IPrefixContext
-	LimitClause() ILimitClauseContext
 
-	// IsShowMetricsStmtContext differentiates from other interfaces.
-	IsShowMetricsStmtContext()
+	// IsFuzzyClauseContext differentiates from other interfaces.
+	IsFuzzyClauseContext()
 }
 
-type ShowMetricsStmtContext struct {
+type FuzzyClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewEmptyFuzzyClauseContext() *FuzzyClauseContext {
+	var p = new(FuzzyClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_fuzzyClause
 	return p
 }
 
-func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+func (*FuzzyClauseContext) IsFuzzyClauseContext() {}
 
-func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewFuzzyClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FuzzyClauseContext {
+	var p = new(FuzzyClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_fuzzyClause
 
 	return p
 }
 
-func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRICS, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
-}
-
-func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INamespaceContext)
-}
-
-func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
+func (s *FuzzyClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *FuzzyClauseContext) T_FUZZY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FUZZY, 0)
 }
 
-func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+func (s *FuzzyClauseContext) Prefix() IPrefixContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IPrefixContext); ok {
@@ -6275,59 +11817,42 @@ func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
 	return t.(IPrefixContext)
 }
 
-func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ILimitClauseContext)
-}
-
-func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *FuzzyClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FuzzyClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FuzzyClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetricsStmt(s)
+		listenerT.EnterFuzzyClause(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FuzzyClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetricsStmt(s)
+		listenerT.ExitFuzzyClause(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FuzzyClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetricsStmt(s)
+		return t.VisitFuzzyClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+func (p *SQLParser) FuzzyClause() (localctx IFuzzyClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SQLParserRULE_showMetricsStmt)
-	var _la int
+	localctx = NewFuzzyClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, SQLParserRULE_fuzzyClause)
 
 	defer func() {
 		p.ExitRule()
@@ -6347,121 +11872,78 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(402)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(403)
-		p.Match(SQLParserT_METRICS)
-	}
-	p.SetState(406)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ON {
-		{
-			p.SetState(404)
-			p.Match(SQLParserT_ON)
-		}
-		{
-			p.SetState(405)
-			p.Namespace()
-		}
-
+		p.SetState(691)
+		p.Match(SQLParserT_FUZZY)
 	}
-	p.SetState(412)
+	p.SetState(693)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_WHERE {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(408)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(409)
-			p.Match(SQLParserT_METRIC)
-		}
-		{
-			p.SetState(410)
-			p.Match(SQLParserT_EQUAL)
-		}
-		{
-			p.SetState(411)
+			p.SetState(692)
 			p.Prefix()
 		}
 
-	}
-	p.SetState(415)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(414)
-			p.LimitClause()
-		}
-
 	}
 
 	return localctx
 }
 
-// IShowFieldsStmtContext is an interface to support dynamic dispatch.
-type IShowFieldsStmtContext interface {
+// IAtTimestampClauseContext is an interface to support dynamic dispatch.
+type IAtTimestampClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_FIELDS() antlr.TerminalNode
-	FromClause() IFromClauseContext
+	T_AT() antlr.TerminalNode
+	T_TIMESTAMP() antlr.TerminalNode
+	Ident() IIdentContext
 
-	// IsShowFieldsStmtContext differentiates from other interfaces.
-	IsShowFieldsStmtContext()
+	// IsAtTimestampClauseContext differentiates from other interfaces.
+	IsAtTimestampClauseContext()
 }
 
-type ShowFieldsStmtContext struct {
+type AtTimestampClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowFieldsStmtContext() *ShowFieldsStmtContext {
-	var p = new(ShowFieldsStmtContext)
+func NewEmptyAtTimestampClauseContext() *AtTimestampClauseContext {
+	var p = new(AtTimestampClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	p.RuleIndex = SQLParserRULE_atTimestampClause
 	return p
 }
 
-func (*ShowFieldsStmtContext) IsShowFieldsStmtContext() {}
+func (*AtTimestampClauseContext) IsAtTimestampClauseContext() {}
 
-func NewShowFieldsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldsStmtContext {
-	var p = new(ShowFieldsStmtContext)
+func NewAtTimestampClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AtTimestampClauseContext {
+	var p = new(AtTimestampClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	p.RuleIndex = SQLParserRULE_atTimestampClause
 
 	return p
 }
 
-func (s *ShowFieldsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *AtTimestampClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowFieldsStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *AtTimestampClauseContext) T_AT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AT, 0)
 }
 
-func (s *ShowFieldsStmtContext) T_FIELDS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FIELDS, 0)
+func (s *AtTimestampClauseContext) T_TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIMESTAMP, 0)
 }
 
-func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
+func (s *AtTimestampClauseContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6471,45 +11953,45 @@ func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(IIdentContext)
 }
 
-func (s *ShowFieldsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AtTimestampClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowFieldsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AtTimestampClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowFieldsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AtTimestampClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowFieldsStmt(s)
+		listenerT.EnterAtTimestampClause(s)
 	}
 }
 
-func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AtTimestampClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowFieldsStmt(s)
+		listenerT.ExitAtTimestampClause(s)
 	}
 }
 
-func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AtTimestampClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowFieldsStmt(s)
+		return t.VisitAtTimestampClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
+func (p *SQLParser) AtTimestampClause() (localctx IAtTimestampClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SQLParserRULE_showFieldsStmt)
+	localctx = NewAtTimestampClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, SQLParserRULE_atTimestampClause)
 
 	defer func() {
 		p.ExitRule()
@@ -6529,81 +12011,66 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(417)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(695)
+		p.Match(SQLParserT_AT)
 	}
 	{
-		p.SetState(418)
-		p.Match(SQLParserT_FIELDS)
+		p.SetState(696)
+		p.Match(SQLParserT_TIMESTAMP)
 	}
 	{
-		p.SetState(419)
-		p.FromClause()
+		p.SetState(697)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IShowTagKeysStmtContext is an interface to support dynamic dispatch.
-type IShowTagKeysStmtContext interface {
+// IWithTagKeyContext is an interface to support dynamic dispatch.
+type IWithTagKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_TAG() antlr.TerminalNode
-	T_KEYS() antlr.TerminalNode
-	FromClause() IFromClauseContext
+	Ident() IIdentContext
 
-	// IsShowTagKeysStmtContext differentiates from other interfaces.
-	IsShowTagKeysStmtContext()
+	// IsWithTagKeyContext differentiates from other interfaces.
+	IsWithTagKeyContext()
 }
 
-type ShowTagKeysStmtContext struct {
+type WithTagKeyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagKeysStmtContext() *ShowTagKeysStmtContext {
-	var p = new(ShowTagKeysStmtContext)
+func NewEmptyWithTagKeyContext() *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	p.RuleIndex = SQLParserRULE_withTagKey
 	return p
 }
 
-func (*ShowTagKeysStmtContext) IsShowTagKeysStmtContext() {}
+func (*WithTagKeyContext) IsWithTagKeyContext() {}
 
-func NewShowTagKeysStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeysStmtContext {
-	var p = new(ShowTagKeysStmtContext)
+func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	p.RuleIndex = SQLParserRULE_withTagKey
 
 	return p
 }
 
-func (s *ShowTagKeysStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowTagKeysStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowTagKeysStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
-}
-
-func (s *ShowTagKeysStmtContext) T_KEYS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEYS, 0)
-}
+func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
+func (s *WithTagKeyContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6613,45 +12080,45 @@ func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(IIdentContext)
 }
 
-func (s *ShowTagKeysStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagKeysStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagKeysStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagKeysStmt(s)
+		listenerT.EnterWithTagKey(s)
 	}
 }
 
-func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagKeysStmt(s)
+		listenerT.ExitWithTagKey(s)
 	}
 }
 
-func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagKeysStmt(s)
+		return t.VisitWithTagKey(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
+func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SQLParserRULE_showTagKeysStmt)
+	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, SQLParserRULE_withTagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -6671,151 +12138,58 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(421)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(422)
-		p.Match(SQLParserT_TAG)
-	}
-	{
-		p.SetState(423)
-		p.Match(SQLParserT_KEYS)
-	}
-	{
-		p.SetState(424)
-		p.FromClause()
+		p.SetState(699)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
-type IShowTagValuesStmtContext interface {
+// INamespaceContext is an interface to support dynamic dispatch.
+type INamespaceContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_TAG() antlr.TerminalNode
-	T_VALUES() antlr.TerminalNode
-	FromClause() IFromClauseContext
-	T_WITH() antlr.TerminalNode
-	T_KEY() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	WithTagKey() IWithTagKeyContext
-	WhereClause() IWhereClauseContext
-	LimitClause() ILimitClauseContext
+	Ident() IIdentContext
 
-	// IsShowTagValuesStmtContext differentiates from other interfaces.
-	IsShowTagValuesStmtContext()
+	// IsNamespaceContext differentiates from other interfaces.
+	IsNamespaceContext()
 }
 
-type ShowTagValuesStmtContext struct {
+type NamespaceContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewEmptyNamespaceContext() *NamespaceContext {
+	var p = new(NamespaceContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_namespace
 	return p
 }
 
-func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
+func (*NamespaceContext) IsNamespaceContext() {}
 
-func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
+	var p = new(NamespaceContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_namespace
 
 	return p
 }
 
-func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_VALUES, 0)
-}
-
-func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFromClauseContext)
-}
-
-func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEY, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
-
-func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWithTagKeyContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWithTagKeyContext)
-}
-
-func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWhereClauseContext)
-}
+func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
+func (s *NamespaceContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6825,46 +12199,45 @@ func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(IIdentContext)
 }
 
-func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagValuesStmt(s)
+		listenerT.EnterNamespace(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagValuesStmt(s)
+		listenerT.ExitNamespace(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagValuesStmt(s)
+		return t.VisitNamespace(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
+func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SQLParserRULE_showTagValuesStmt)
-	var _la int
+	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, SQLParserRULE_namespace)
 
 	defer func() {
 		p.ExitRule()
@@ -6876,73 +12249,23 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 				localctx.SetException(v)
 				p.GetErrorHandler().ReportError(p, v)
 				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(426)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(427)
-		p.Match(SQLParserT_TAG)
-	}
-	{
-		p.SetState(428)
-		p.Match(SQLParserT_VALUES)
-	}
-	{
-		p.SetState(429)
-		p.FromClause()
-	}
-	{
-		p.SetState(430)
-		p.Match(SQLParserT_WITH)
-	}
-	{
-		p.SetState(431)
-		p.Match(SQLParserT_KEY)
-	}
-	{
-		p.SetState(432)
-		p.Match(SQLParserT_EQUAL)
-	}
-	{
-		p.SetState(433)
-		p.WithTagKey()
-	}
-	p.SetState(435)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(434)
-			p.WhereClause()
-		}
-
-	}
-	p.SetState(438)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(437)
-			p.LimitClause()
+			} else {
+				panic(err)
+			}
 		}
+	}()
 
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(701)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IPrefixContext is an interface to support dynamic dispatch.
-type IPrefixContext interface {
+// IDatabaseNameContext is an interface to support dynamic dispatch.
+type IDatabaseNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -6951,38 +12274,38 @@ type IPrefixContext interface {
 	// Getter signatures
 	Ident() IIdentContext
 
-	// IsPrefixContext differentiates from other interfaces.
-	IsPrefixContext()
+	// IsDatabaseNameContext differentiates from other interfaces.
+	IsDatabaseNameContext()
 }
 
-type PrefixContext struct {
+type DatabaseNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrefixContext() *PrefixContext {
-	var p = new(PrefixContext)
+func NewEmptyDatabaseNameContext() *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_databaseName
 	return p
 }
 
-func (*PrefixContext) IsPrefixContext() {}
+func (*DatabaseNameContext) IsDatabaseNameContext() {}
 
-func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
-	var p = new(PrefixContext)
+func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_databaseName
 
 	return p
 }
 
-func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
+func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PrefixContext) Ident() IIdentContext {
+func (s *DatabaseNameContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -6998,42 +12321,42 @@ func (s *PrefixContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
+func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterPrefix(s)
+		listenerT.EnterDatabaseName(s)
 	}
 }
 
-func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitPrefix(s)
+		listenerT.ExitDatabaseName(s)
 	}
 }
 
-func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitPrefix(s)
+		return t.VisitDatabaseName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Prefix() (localctx IPrefixContext) {
+func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 	this := p
 	_ = this
 
-	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SQLParserRULE_prefix)
+	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, SQLParserRULE_databaseName)
 
 	defer func() {
 		p.ExitRule()
@@ -7053,15 +12376,15 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(440)
+		p.SetState(703)
 		p.Ident()
 	}
 
 	return localctx
 }
 
-// IWithTagKeyContext is an interface to support dynamic dispatch.
-type IWithTagKeyContext interface {
+// IStorageNameContext is an interface to support dynamic dispatch.
+type IStorageNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -7070,38 +12393,38 @@ type IWithTagKeyContext interface {
 	// Getter signatures
 	Ident() IIdentContext
 
-	// IsWithTagKeyContext differentiates from other interfaces.
-	IsWithTagKeyContext()
+	// IsStorageNameContext differentiates from other interfaces.
+	IsStorageNameContext()
 }
 
-type WithTagKeyContext struct {
+type StorageNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWithTagKeyContext() *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewEmptyStorageNameContext() *StorageNameContext {
+	var p = new(StorageNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_storageName
 	return p
 }
 
-func (*WithTagKeyContext) IsWithTagKeyContext() {}
+func (*StorageNameContext) IsStorageNameContext() {}
 
-func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewStorageNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageNameContext {
+	var p = new(StorageNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_storageName
 
 	return p
 }
 
-func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *StorageNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithTagKeyContext) Ident() IIdentContext {
+func (s *StorageNameContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7117,42 +12440,42 @@ func (s *WithTagKeyContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *StorageNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StorageNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StorageNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterWithTagKey(s)
+		listenerT.EnterStorageName(s)
 	}
 }
 
-func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StorageNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitWithTagKey(s)
+		listenerT.ExitStorageName(s)
 	}
 }
 
-func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StorageNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitWithTagKey(s)
+		return t.VisitStorageName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
+func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
 	this := p
 	_ = this
 
-	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SQLParserRULE_withTagKey)
+	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, SQLParserRULE_storageName)
 
 	defer func() {
 		p.ExitRule()
@@ -7172,15 +12495,15 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(442)
+		p.SetState(705)
 		p.Ident()
 	}
 
 	return localctx
 }
 
-// INamespaceContext is an interface to support dynamic dispatch.
-type INamespaceContext interface {
+// IRequestIDContext is an interface to support dynamic dispatch.
+type IRequestIDContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -7189,38 +12512,38 @@ type INamespaceContext interface {
 	// Getter signatures
 	Ident() IIdentContext
 
-	// IsNamespaceContext differentiates from other interfaces.
-	IsNamespaceContext()
+	// IsRequestIDContext differentiates from other interfaces.
+	IsRequestIDContext()
 }
 
-type NamespaceContext struct {
+type RequestIDContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamespaceContext() *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewEmptyRequestIDContext() *RequestIDContext {
+	var p = new(RequestIDContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_requestID
 	return p
 }
 
-func (*NamespaceContext) IsNamespaceContext() {}
+func (*RequestIDContext) IsRequestIDContext() {}
 
-func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
+	var p = new(RequestIDContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_requestID
 
 	return p
 }
 
-func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamespaceContext) Ident() IIdentContext {
+func (s *RequestIDContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7236,42 +12559,42 @@ func (s *NamespaceContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNamespace(s)
+		listenerT.EnterRequestID(s)
 	}
 }
 
-func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNamespace(s)
+		listenerT.ExitRequestID(s)
 	}
 }
 
-func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNamespace(s)
+		return t.VisitRequestID(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 	this := p
 	_ = this
 
-	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SQLParserRULE_namespace)
+	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, SQLParserRULE_requestID)
 
 	defer func() {
 		p.ExitRule()
@@ -7291,106 +12614,94 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(444)
+		p.SetState(707)
 		p.Ident()
 	}
 
 	return localctx
 }
 
-// IDatabaseNameContext is an interface to support dynamic dispatch.
-type IDatabaseNameContext interface {
+// IShardIDContext is an interface to support dynamic dispatch.
+type IShardIDContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
+	L_INT() antlr.TerminalNode
 
-	// IsDatabaseNameContext differentiates from other interfaces.
-	IsDatabaseNameContext()
+	// IsShardIDContext differentiates from other interfaces.
+	IsShardIDContext()
 }
 
-type DatabaseNameContext struct {
+type ShardIDContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseNameContext() *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewEmptyShardIDContext() *ShardIDContext {
+	var p = new(ShardIDContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_shardID
 	return p
 }
 
-func (*DatabaseNameContext) IsDatabaseNameContext() {}
+func (*ShardIDContext) IsShardIDContext() {}
 
-func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewShardIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShardIDContext {
+	var p = new(ShardIDContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_shardID
 
 	return p
 }
 
-func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DatabaseNameContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ShardIDContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IIdentContext)
+func (s *ShardIDContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
 }
 
-func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+func (s *ShardIDContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShardIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShardIDContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseName(s)
+		listenerT.EnterShardID(s)
 	}
 }
 
-func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShardIDContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseName(s)
+		listenerT.ExitShardID(s)
 	}
 }
 
-func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShardIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseName(s)
+		return t.VisitShardID(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+func (p *SQLParser) ShardID() (localctx IShardIDContext) {
 	this := p
 	_ = this
 
-	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SQLParserRULE_databaseName)
+	localctx = NewShardIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, SQLParserRULE_shardID)
 
 	defer func() {
 		p.ExitRule()
@@ -7410,55 +12721,60 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(446)
-		p.Ident()
+		p.SetState(709)
+		p.Match(SQLParserL_INT)
 	}
 
 	return localctx
 }
 
-// IStorageNameContext is an interface to support dynamic dispatch.
-type IStorageNameContext interface {
+// IFamilyTimeContext is an interface to support dynamic dispatch.
+type IFamilyTimeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	L_INT() antlr.TerminalNode
 	Ident() IIdentContext
 
-	// IsStorageNameContext differentiates from other interfaces.
-	IsStorageNameContext()
+	// IsFamilyTimeContext differentiates from other interfaces.
+	IsFamilyTimeContext()
 }
 
-type StorageNameContext struct {
+type FamilyTimeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStorageNameContext() *StorageNameContext {
-	var p = new(StorageNameContext)
+func NewEmptyFamilyTimeContext() *FamilyTimeContext {
+	var p = new(FamilyTimeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_familyTime
 	return p
 }
 
-func (*StorageNameContext) IsStorageNameContext() {}
+func (*FamilyTimeContext) IsFamilyTimeContext() {}
 
-func NewStorageNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageNameContext {
-	var p = new(StorageNameContext)
+func NewFamilyTimeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FamilyTimeContext {
+	var p = new(FamilyTimeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_familyTime
 
 	return p
 }
 
-func (s *StorageNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *FamilyTimeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StorageNameContext) Ident() IIdentContext {
+func (s *FamilyTimeContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *FamilyTimeContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7474,42 +12790,42 @@ func (s *StorageNameContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *StorageNameContext) GetRuleContext() antlr.RuleContext {
+func (s *FamilyTimeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StorageNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FamilyTimeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StorageNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FamilyTimeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterStorageName(s)
+		listenerT.EnterFamilyTime(s)
 	}
 }
 
-func (s *StorageNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FamilyTimeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitStorageName(s)
+		listenerT.ExitFamilyTime(s)
 	}
 }
 
-func (s *StorageNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FamilyTimeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitStorageName(s)
+		return t.VisitFamilyTime(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
+func (p *SQLParser) FamilyTime() (localctx IFamilyTimeContext) {
 	this := p
 	_ = this
 
-	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SQLParserRULE_storageName)
+	localctx = NewFamilyTimeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, SQLParserRULE_familyTime)
 
 	defer func() {
 		p.ExitRule()
@@ -7527,108 +12843,112 @@ func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(448)
-		p.Ident()
+	p.SetState(713)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserL_INT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(711)
+			p.Match(SQLParserL_INT)
+		}
+
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_DELETE, SQLParserT_ALTER, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_REPAIR, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_LEVELS, SQLParserT_LEVEL, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SHARDS, SQLParserT_SEGMENTS, SQLParserT_DISK, SQLParserT_USAGE, SQLParserT_FILE, SQLParserT_DETAIL, SQLParserT_FAMILY, SQLParserT_CHANNELS, SQLParserT_EXPIRED, SQLParserT_PLACEMENT, SQLParserT_SUGGESTIONS, SQLParserT_SERIES, SQLParserT_FORMAT, SQLParserT_FUZZY, SQLParserT_WRITE, SQLParserT_OFF, SQLParserT_READONLY, SQLParserT_AT, SQLParserT_TIMESTAMP, SQLParserT_STATUS, SQLParserT_SINCE, SQLParserT_COMPACTION, SQLParserT_JOBS, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_COUNT_DISTINCT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(712)
+			p.Ident()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IRequestIDContext is an interface to support dynamic dispatch.
-type IRequestIDContext interface {
+// IFileNumberContext is an interface to support dynamic dispatch.
+type IFileNumberContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
+	L_INT() antlr.TerminalNode
 
-	// IsRequestIDContext differentiates from other interfaces.
-	IsRequestIDContext()
+	// IsFileNumberContext differentiates from other interfaces.
+	IsFileNumberContext()
 }
 
-type RequestIDContext struct {
+type FileNumberContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRequestIDContext() *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewEmptyFileNumberContext() *FileNumberContext {
+	var p = new(FileNumberContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_fileNumber
 	return p
 }
 
-func (*RequestIDContext) IsRequestIDContext() {}
+func (*FileNumberContext) IsFileNumberContext() {}
 
-func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewFileNumberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FileNumberContext {
+	var p = new(FileNumberContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_fileNumber
 
 	return p
 }
 
-func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *RequestIDContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *FileNumberContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IIdentContext)
+func (s *FileNumberContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
 }
 
-func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
+func (s *FileNumberContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FileNumberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FileNumberContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRequestID(s)
+		listenerT.EnterFileNumber(s)
 	}
 }
 
-func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FileNumberContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRequestID(s)
+		listenerT.ExitFileNumber(s)
 	}
 }
 
-func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FileNumberContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRequestID(s)
+		return t.VisitFileNumber(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
+func (p *SQLParser) FileNumber() (localctx IFileNumberContext) {
 	this := p
 	_ = this
 
-	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SQLParserRULE_requestID)
+	localctx = NewFileNumberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, SQLParserRULE_fileNumber)
 
 	defer func() {
 		p.ExitRule()
@@ -7648,8 +12968,8 @@ func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(450)
-		p.Ident()
+		p.SetState(715)
+		p.Match(SQLParserL_INT)
 	}
 
 	return localctx
@@ -7740,7 +13060,7 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 	_ = this
 
 	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, SQLParserRULE_source)
+	p.EnterRule(localctx, 136, SQLParserRULE_source)
 	var _la int
 
 	defer func() {
@@ -7761,7 +13081,7 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(452)
+		p.SetState(717)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
@@ -7945,7 +13265,7 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	_ = this
 
 	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SQLParserRULE_queryStmt)
+	p.EnterRule(localctx, 138, SQLParserRULE_queryStmt)
 	var _la int
 
 	defer func() {
@@ -7965,72 +13285,72 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(455)
+	p.SetState(720)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_EXPLAIN {
 		{
-			p.SetState(454)
+			p.SetState(719)
 			p.Match(SQLParserT_EXPLAIN)
 		}
 
 	}
 	{
-		p.SetState(457)
+		p.SetState(722)
 		p.SourceAndSelect()
 	}
-	p.SetState(459)
+	p.SetState(724)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(458)
+			p.SetState(723)
 			p.WhereClause()
 		}
 
 	}
-	p.SetState(462)
+	p.SetState(727)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_GROUP {
 		{
-			p.SetState(461)
+			p.SetState(726)
 			p.GroupByClause()
 		}
 
 	}
-	p.SetState(465)
+	p.SetState(730)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ORDER {
 		{
-			p.SetState(464)
+			p.SetState(729)
 			p.OrderByClause()
 		}
 
 	}
-	p.SetState(468)
+	p.SetState(733)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(467)
+			p.SetState(732)
 			p.LimitClause()
 		}
 
 	}
-	p.SetState(471)
+	p.SetState(736)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_WITH_VALUE {
 		{
-			p.SetState(470)
+			p.SetState(735)
 			p.Match(SQLParserT_WITH_VALUE)
 		}
 
@@ -8148,7 +13468,7 @@ func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
 	_ = this
 
 	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SQLParserRULE_sourceAndSelect)
+	p.EnterRule(localctx, 140, SQLParserRULE_sourceAndSelect)
 
 	defer func() {
 		p.ExitRule()
@@ -8166,29 +13486,29 @@ func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
 		}
 	}()
 
-	p.SetState(479)
+	p.SetState(744)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_SELECT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(473)
+			p.SetState(738)
 			p.SelectExpr()
 		}
 		{
-			p.SetState(474)
+			p.SetState(739)
 			p.FromClause()
 		}
 
 	case SQLParserT_FROM:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(476)
+			p.SetState(741)
 			p.FromClause()
 		}
 		{
-			p.SetState(477)
+			p.SetState(742)
 			p.SelectExpr()
 		}
 
@@ -8296,7 +13616,7 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 	_ = this
 
 	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SQLParserRULE_selectExpr)
+	p.EnterRule(localctx, 142, SQLParserRULE_selectExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -8316,11 +13636,11 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(481)
+		p.SetState(746)
 		p.Match(SQLParserT_SELECT)
 	}
 	{
-		p.SetState(482)
+		p.SetState(747)
 		p.Fields()
 	}
 
@@ -8455,7 +13775,7 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 	_ = this
 
 	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, SQLParserRULE_fields)
+	p.EnterRule(localctx, 144, SQLParserRULE_fields)
 	var _la int
 
 	defer func() {
@@ -8476,24 +13796,24 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(484)
+		p.SetState(749)
 		p.Field()
 	}
-	p.SetState(489)
+	p.SetState(754)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(485)
+			p.SetState(750)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(486)
+			p.SetState(751)
 			p.Field()
 		}
 
-		p.SetState(491)
+		p.SetState(756)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -8610,7 +13930,7 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 	_ = this
 
 	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SQLParserRULE_field)
+	p.EnterRule(localctx, 146, SQLParserRULE_field)
 	var _la int
 
 	defer func() {
@@ -8631,16 +13951,16 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(492)
+		p.SetState(757)
 		p.fieldExpr(0)
 	}
-	p.SetState(494)
+	p.SetState(759)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_AS {
 		{
-			p.SetState(493)
+			p.SetState(758)
 			p.Alias()
 		}
 
@@ -8746,7 +14066,7 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 	_ = this
 
 	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SQLParserRULE_alias)
+	p.EnterRule(localctx, 148, SQLParserRULE_alias)
 
 	defer func() {
 		p.ExitRule()
@@ -8766,11 +14086,11 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(496)
+		p.SetState(761)
 		p.Match(SQLParserT_AS)
 	}
 	{
-		p.SetState(497)
+		p.SetState(762)
 		p.Ident()
 	}
 
@@ -8879,7 +14199,7 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 	_ = this
 
 	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SQLParserRULE_storageFilter)
+	p.EnterRule(localctx, 150, SQLParserRULE_storageFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8899,15 +14219,15 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(499)
+		p.SetState(764)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(500)
+		p.SetState(765)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(501)
+		p.SetState(766)
 		p.Ident()
 	}
 
@@ -9016,7 +14336,7 @@ func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
 	_ = this
 
 	localctx = NewBrokerFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SQLParserRULE_brokerFilter)
+	p.EnterRule(localctx, 152, SQLParserRULE_brokerFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -9036,15 +14356,15 @@ func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(503)
+		p.SetState(768)
 		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(504)
+		p.SetState(769)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(505)
+		p.SetState(770)
 		p.Ident()
 	}
 
@@ -9153,7 +14473,7 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 	_ = this
 
 	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, SQLParserRULE_databaseFilter)
+	p.EnterRule(localctx, 154, SQLParserRULE_databaseFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -9173,15 +14493,15 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(507)
+		p.SetState(772)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(508)
+		p.SetState(773)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(509)
+		p.SetState(774)
 		p.Ident()
 	}
 
@@ -9290,7 +14610,7 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 	_ = this
 
 	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SQLParserRULE_typeFilter)
+	p.EnterRule(localctx, 156, SQLParserRULE_typeFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -9310,15 +14630,15 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(511)
+		p.SetState(776)
 		p.Match(SQLParserT_TYPE)
 	}
 	{
-		p.SetState(512)
+		p.SetState(777)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(513)
+		p.SetState(778)
 		p.Ident()
 	}
 
@@ -9444,7 +14764,7 @@ func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 	_ = this
 
 	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SQLParserRULE_fromClause)
+	p.EnterRule(localctx, 158, SQLParserRULE_fromClause)
 	var _la int
 
 	defer func() {
@@ -9465,24 +14785,24 @@ func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(515)
+		p.SetState(780)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(516)
+		p.SetState(781)
 		p.MetricName()
 	}
-	p.SetState(519)
+	p.SetState(784)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ON {
 		{
-			p.SetState(517)
+			p.SetState(782)
 			p.Match(SQLParserT_ON)
 		}
 		{
-			p.SetState(518)
+			p.SetState(783)
 			p.Namespace()
 		}
 
@@ -9588,7 +14908,7 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 	_ = this
 
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SQLParserRULE_whereClause)
+	p.EnterRule(localctx, 160, SQLParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -9608,11 +14928,11 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(521)
+		p.SetState(786)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(522)
+		p.SetState(787)
 		p.ConditionExpr()
 	}
 
@@ -9733,7 +15053,7 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	_ = this
 
 	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SQLParserRULE_conditionExpr)
+	p.EnterRule(localctx, 162, SQLParserRULE_conditionExpr)
 	var _la int
 
 	defer func() {
@@ -9752,48 +15072,48 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 		}
 	}()
 
-	p.SetState(534)
+	p.SetState(799)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(524)
+			p.SetState(789)
 			p.tagFilterExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(525)
+			p.SetState(790)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(526)
+			p.SetState(791)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(527)
+			p.SetState(792)
 			p.TimeRangeExpr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(529)
+			p.SetState(794)
 			p.TimeRangeExpr()
 		}
-		p.SetState(532)
+		p.SetState(797)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SQLParserT_AND {
 			{
-				p.SetState(530)
+				p.SetState(795)
 				p.Match(SQLParserT_AND)
 			}
 			{
-				p.SetState(531)
+				p.SetState(796)
 				p.tagFilterExpr(0)
 			}
 
@@ -9825,8 +15145,11 @@ type ITagFilterExprContext interface {
 	T_NEQREGEXP() antlr.TerminalNode
 	T_NOTEQUAL() antlr.TerminalNode
 	T_NOTEQUAL2() antlr.TerminalNode
-	TagValueList() ITagValueListContext
 	T_IN() antlr.TerminalNode
+	TagValueList() ITagValueListContext
+	SubQuery() ISubQueryContext
+	T_IS() antlr.TerminalNode
+	T_NULL() antlr.TerminalNode
 	T_AND() antlr.TerminalNode
 	T_OR() antlr.TerminalNode
 
@@ -9970,6 +15293,10 @@ func (s *TagFilterExprContext) T_NOTEQUAL2() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_NOTEQUAL2, 0)
 }
 
+func (s *TagFilterExprContext) T_IN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IN, 0)
+}
+
 func (s *TagFilterExprContext) TagValueList() ITagValueListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -9986,8 +15313,28 @@ func (s *TagFilterExprContext) TagValueList() ITagValueListContext {
 	return t.(ITagValueListContext)
 }
 
-func (s *TagFilterExprContext) T_IN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_IN, 0)
+func (s *TagFilterExprContext) SubQuery() ISubQueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubQueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubQueryContext)
+}
+
+func (s *TagFilterExprContext) T_IS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IS, 0)
+}
+
+func (s *TagFilterExprContext) T_NULL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NULL, 0)
 }
 
 func (s *TagFilterExprContext) T_AND() antlr.TerminalNode {
@@ -10041,8 +15388,8 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx ITagFilterExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 106
-	p.EnterRecursionRule(localctx, 106, SQLParserRULE_tagFilterExpr, _p)
+	_startState := 164
+	p.EnterRecursionRule(localctx, 164, SQLParserRULE_tagFilterExpr, _p)
 	var _la int
 
 	defer func() {
@@ -10064,75 +15411,75 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(564)
+	p.SetState(839)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(537)
+			p.SetState(802)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(538)
+			p.SetState(803)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(539)
+			p.SetState(804)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(541)
+			p.SetState(806)
 			p.TagKey()
 		}
-		p.SetState(550)
+		p.SetState(815)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_EQUAL:
 			{
-				p.SetState(542)
+				p.SetState(807)
 				p.Match(SQLParserT_EQUAL)
 			}
 
 		case SQLParserT_LIKE:
 			{
-				p.SetState(543)
+				p.SetState(808)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(544)
+				p.SetState(809)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(545)
+				p.SetState(810)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_REGEXP:
 			{
-				p.SetState(546)
+				p.SetState(811)
 				p.Match(SQLParserT_REGEXP)
 			}
 
 		case SQLParserT_NEQREGEXP:
 			{
-				p.SetState(547)
+				p.SetState(812)
 				p.Match(SQLParserT_NEQREGEXP)
 			}
 
 		case SQLParserT_NOTEQUAL:
 			{
-				p.SetState(548)
+				p.SetState(813)
 				p.Match(SQLParserT_NOTEQUAL)
 			}
 
 		case SQLParserT_NOTEQUAL2:
 			{
-				p.SetState(549)
+				p.SetState(814)
 				p.Match(SQLParserT_NOTEQUAL2)
 			}
 
@@ -10140,32 +15487,32 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(552)
+			p.SetState(817)
 			p.TagValue()
 		}
 
 	case 3:
 		{
-			p.SetState(554)
+			p.SetState(819)
 			p.TagKey()
 		}
-		p.SetState(558)
+		p.SetState(823)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_IN:
 			{
-				p.SetState(555)
+				p.SetState(820)
 				p.Match(SQLParserT_IN)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(556)
+				p.SetState(821)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(557)
+				p.SetState(822)
 				p.Match(SQLParserT_IN)
 			}
 
@@ -10173,23 +15520,67 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(560)
+			p.SetState(825)
 			p.Match(SQLParserT_OPEN_P)
 		}
-		{
-			p.SetState(561)
-			p.TagValueList()
+		p.SetState(828)
+		p.GetErrorHandler().Sync(p)
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(826)
+				p.TagValueList()
+			}
+
+		case 2:
+			{
+				p.SetState(827)
+				p.SubQuery()
+			}
+
 		}
 		{
-			p.SetState(562)
+			p.SetState(830)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
+	case 4:
+		{
+			p.SetState(832)
+			p.TagKey()
+		}
+		{
+			p.SetState(833)
+			p.Match(SQLParserT_IS)
+		}
+		p.SetState(835)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_NOT {
+			{
+				p.SetState(834)
+				p.Match(SQLParserT_NOT)
+			}
+
+		}
+		{
+			p.SetState(837)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SQLParserT__0 || _la == SQLParserT_NULL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(571)
+	p.SetState(846)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -10199,13 +15590,13 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			_prevctx = localctx
 			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
-			p.SetState(566)
+			p.SetState(841)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(567)
+				p.SetState(842)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -10216,14 +15607,14 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 				}
 			}
 			{
-				p.SetState(568)
+				p.SetState(843)
 				p.tagFilterExpr(2)
 			}
 
 		}
-		p.SetState(573)
+		p.SetState(848)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10291,19 +15682,165 @@ func (s *TagValueListContext) AllTagValue() []ITagValueContext {
 		}
 	}
 
-	return tst
+	return tst
+}
+
+func (s *TagValueListContext) TagValue(i int) ITagValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagValueContext)
+}
+
+func (s *TagValueListContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *TagValueListContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *TagValueListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TagValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TagValueListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTagValueList(s)
+	}
+}
+
+func (s *TagValueListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTagValueList(s)
+	}
+}
+
+func (s *TagValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTagValueList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
+	this := p
+	_ = this
+
+	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 166, SQLParserRULE_tagValueList)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(849)
+		p.TagValue()
+	}
+	p.SetState(854)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SQLParserT_COMMA {
+		{
+			p.SetState(850)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(851)
+			p.TagValue()
+		}
+
+		p.SetState(856)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ISubQueryContext is an interface to support dynamic dispatch.
+type ISubQueryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ShowTagValuesStmt() IShowTagValuesStmtContext
+
+	// IsSubQueryContext differentiates from other interfaces.
+	IsSubQueryContext()
+}
+
+type SubQueryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubQueryContext() *SubQueryContext {
+	var p = new(SubQueryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_subQuery
+	return p
+}
+
+func (*SubQueryContext) IsSubQueryContext() {}
+
+func NewSubQueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubQueryContext {
+	var p = new(SubQueryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_subQuery
+
+	return p
 }
 
-func (s *TagValueListContext) TagValue(i int) ITagValueContext {
+func (s *SubQueryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubQueryContext) ShowTagValuesStmt() IShowTagValuesStmtContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagValueContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IShowTagValuesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -10311,54 +15848,45 @@ func (s *TagValueListContext) TagValue(i int) ITagValueContext {
 		return nil
 	}
 
-	return t.(ITagValueContext)
-}
-
-func (s *TagValueListContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
-}
-
-func (s *TagValueListContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+	return t.(IShowTagValuesStmtContext)
 }
 
-func (s *TagValueListContext) GetRuleContext() antlr.RuleContext {
+func (s *SubQueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TagValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SubQueryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TagValueListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SubQueryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTagValueList(s)
+		listenerT.EnterSubQuery(s)
 	}
 }
 
-func (s *TagValueListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SubQueryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTagValueList(s)
+		listenerT.ExitSubQuery(s)
 	}
 }
 
-func (s *TagValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SubQueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTagValueList(s)
+		return t.VisitSubQuery(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
+func (p *SQLParser) SubQuery() (localctx ISubQueryContext) {
 	this := p
 	_ = this
 
-	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SQLParserRULE_tagValueList)
-	var _la int
+	localctx = NewSubQueryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 168, SQLParserRULE_subQuery)
 
 	defer func() {
 		p.ExitRule()
@@ -10378,26 +15906,8 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(574)
-		p.TagValue()
-	}
-	p.SetState(579)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(575)
-			p.Match(SQLParserT_COMMA)
-		}
-		{
-			p.SetState(576)
-			p.TagValue()
-		}
-
-		p.SetState(581)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(857)
+		p.ShowTagValuesStmt()
 	}
 
 	return localctx
@@ -10515,7 +16025,7 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 	_ = this
 
 	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, SQLParserRULE_metricListFilter)
+	p.EnterRule(localctx, 170, SQLParserRULE_metricListFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -10535,24 +16045,24 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(582)
+		p.SetState(859)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(583)
+		p.SetState(860)
 		p.Match(SQLParserT_IN)
 	}
 
 	{
-		p.SetState(584)
+		p.SetState(861)
 		p.Match(SQLParserT_OPEN_P)
 	}
 	{
-		p.SetState(585)
+		p.SetState(862)
 		p.MetricList()
 	}
 	{
-		p.SetState(586)
+		p.SetState(863)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -10687,7 +16197,7 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 	_ = this
 
 	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SQLParserRULE_metricList)
+	p.EnterRule(localctx, 172, SQLParserRULE_metricList)
 	var _la int
 
 	defer func() {
@@ -10708,24 +16218,24 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(588)
+		p.SetState(865)
 		p.Ident()
 	}
-	p.SetState(593)
+	p.SetState(870)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(589)
+			p.SetState(866)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(590)
+			p.SetState(867)
 			p.Ident()
 		}
 
-		p.SetState(595)
+		p.SetState(872)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10856,7 +16366,7 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 	_ = this
 
 	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, SQLParserRULE_timeRangeExpr)
+	p.EnterRule(localctx, 174, SQLParserRULE_timeRangeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -10876,19 +16386,19 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(596)
+		p.SetState(873)
 		p.TimeExpr()
 	}
-	p.SetState(599)
+	p.SetState(876)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(597)
+			p.SetState(874)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(598)
+			p.SetState(875)
 			p.TimeExpr()
 		}
 
@@ -10970,19 +16480,244 @@ func (s *TimeExprContext) NowExpr() INowExprContext {
 		}
 	}
 
-	if t == nil {
-		return nil
+	if t == nil {
+		return nil
+	}
+
+	return t.(INowExprContext)
+}
+
+func (s *TimeExprContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *TimeExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TimeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TimeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTimeExpr(s)
+	}
+}
+
+func (s *TimeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTimeExpr(s)
+	}
+}
+
+func (s *TimeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTimeExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 176, SQLParserRULE_timeExpr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(878)
+		p.Match(SQLParserT_TIME)
+	}
+	{
+		p.SetState(879)
+		p.BinaryOperator()
+	}
+	p.SetState(882)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(880)
+			p.NowExpr()
+		}
+
+	case 2:
+		{
+			p.SetState(881)
+			p.Ident()
+		}
+
+	}
+
+	return localctx
+}
+
+// INowExprContext is an interface to support dynamic dispatch.
+type INowExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NowFunc() INowFuncContext
+	AllDurationLit() []IDurationLitContext
+	DurationLit(i int) IDurationLitContext
+	AllTruncateDuration() []ITruncateDurationContext
+	TruncateDuration(i int) ITruncateDurationContext
+
+	// IsNowExprContext differentiates from other interfaces.
+	IsNowExprContext()
+}
+
+type NowExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNowExprContext() *NowExprContext {
+	var p = new(NowExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_nowExpr
+	return p
+}
+
+func (*NowExprContext) IsNowExprContext() {}
+
+func NewNowExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NowExprContext {
+	var p = new(NowExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_nowExpr
+
+	return p
+}
+
+func (s *NowExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NowExprContext) NowFunc() INowFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INowFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INowFuncContext)
+}
+
+func (s *NowExprContext) AllDurationLit() []IDurationLitContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDurationLitContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDurationLitContext); ok {
+			tst[i] = t.(IDurationLitContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *NowExprContext) DurationLit(i int) IDurationLitContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLitContext)
+}
+
+func (s *NowExprContext) AllTruncateDuration() []ITruncateDurationContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITruncateDurationContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITruncateDurationContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITruncateDurationContext); ok {
+			tst[i] = t.(ITruncateDurationContext)
+			i++
+		}
 	}
 
-	return t.(INowExprContext)
+	return tst
 }
 
-func (s *TimeExprContext) Ident() IIdentContext {
+func (s *NowExprContext) TruncateDuration(i int) ITruncateDurationContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITruncateDurationContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -10990,45 +16725,46 @@ func (s *TimeExprContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(ITruncateDurationContext)
 }
 
-func (s *TimeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *NowExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TimeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NowExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TimeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NowExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTimeExpr(s)
+		listenerT.EnterNowExpr(s)
 	}
 }
 
-func (s *TimeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NowExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTimeExpr(s)
+		listenerT.ExitNowExpr(s)
 	}
 }
 
-func (s *TimeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NowExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTimeExpr(s)
+		return t.VisitNowExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
+func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 	this := p
 	_ = this
 
-	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SQLParserRULE_timeExpr)
+	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 178, SQLParserRULE_nowExpr)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -11048,92 +16784,89 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(601)
-		p.Match(SQLParserT_TIME)
-	}
-	{
-		p.SetState(602)
-		p.BinaryOperator()
+		p.SetState(884)
+		p.NowFunc()
 	}
-	p.SetState(605)
+	p.SetState(889)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(603)
-			p.NowExpr()
-		}
+	_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		{
-			p.SetState(604)
-			p.Ident()
+	for (int64((_la-151)) & ^0x3f) == 0 && ((int64(1)<<(_la-151))&135) != 0 {
+		p.SetState(887)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SQLParserT_ADD, SQLParserT_SUB, SQLParserL_INT:
+			{
+				p.SetState(885)
+				p.DurationLit()
+			}
+
+		case SQLParserT_DIV:
+			{
+				p.SetState(886)
+				p.TruncateDuration()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
+		p.SetState(891)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// INowExprContext is an interface to support dynamic dispatch.
-type INowExprContext interface {
+// ITruncateDurationContext is an interface to support dynamic dispatch.
+type ITruncateDurationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NowFunc() INowFuncContext
+	T_DIV() antlr.TerminalNode
 	DurationLit() IDurationLitContext
 
-	// IsNowExprContext differentiates from other interfaces.
-	IsNowExprContext()
+	// IsTruncateDurationContext differentiates from other interfaces.
+	IsTruncateDurationContext()
 }
 
-type NowExprContext struct {
+type TruncateDurationContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNowExprContext() *NowExprContext {
-	var p = new(NowExprContext)
+func NewEmptyTruncateDurationContext() *TruncateDurationContext {
+	var p = new(TruncateDurationContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_nowExpr
+	p.RuleIndex = SQLParserRULE_truncateDuration
 	return p
 }
 
-func (*NowExprContext) IsNowExprContext() {}
+func (*TruncateDurationContext) IsTruncateDurationContext() {}
 
-func NewNowExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NowExprContext {
-	var p = new(NowExprContext)
+func NewTruncateDurationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TruncateDurationContext {
+	var p = new(TruncateDurationContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_nowExpr
+	p.RuleIndex = SQLParserRULE_truncateDuration
 
 	return p
 }
 
-func (s *NowExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *NowExprContext) NowFunc() INowFuncContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INowFuncContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *TruncateDurationContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(INowFuncContext)
+func (s *TruncateDurationContext) T_DIV() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DIV, 0)
 }
 
-func (s *NowExprContext) DurationLit() IDurationLitContext {
+func (s *TruncateDurationContext) DurationLit() IDurationLitContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IDurationLitContext); ok {
@@ -11149,43 +16882,42 @@ func (s *NowExprContext) DurationLit() IDurationLitContext {
 	return t.(IDurationLitContext)
 }
 
-func (s *NowExprContext) GetRuleContext() antlr.RuleContext {
+func (s *TruncateDurationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NowExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TruncateDurationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NowExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TruncateDurationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNowExpr(s)
+		listenerT.EnterTruncateDuration(s)
 	}
 }
 
-func (s *NowExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TruncateDurationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNowExpr(s)
+		listenerT.ExitTruncateDuration(s)
 	}
 }
 
-func (s *NowExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TruncateDurationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNowExpr(s)
+		return t.VisitTruncateDuration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) NowExpr() (localctx INowExprContext) {
+func (p *SQLParser) TruncateDuration() (localctx ITruncateDurationContext) {
 	this := p
 	_ = this
 
-	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, SQLParserRULE_nowExpr)
-	var _la int
+	localctx = NewTruncateDurationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 180, SQLParserRULE_truncateDuration)
 
 	defer func() {
 		p.ExitRule()
@@ -11205,19 +16937,12 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(607)
-		p.NowFunc()
+		p.SetState(892)
+		p.Match(SQLParserT_DIV)
 	}
-	p.SetState(609)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64((_la-122)) & ^0x3f) == 0 && ((int64(1)<<(_la-122))&131) != 0 {
-		{
-			p.SetState(608)
-			p.DurationLit()
-		}
-
+	{
+		p.SetState(893)
+		p.DurationLit()
 	}
 
 	return localctx
@@ -11330,7 +17055,7 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 	_ = this
 
 	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, SQLParserRULE_nowFunc)
+	p.EnterRule(localctx, 182, SQLParserRULE_nowFunc)
 	var _la int
 
 	defer func() {
@@ -11351,26 +17076,26 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(611)
+		p.SetState(895)
 		p.Match(SQLParserT_NOW)
 	}
 	{
-		p.SetState(612)
+		p.SetState(896)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(614)
+	p.SetState(898)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-65537) != 0) || ((int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&2068278146049769471) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-16777280) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&3852468255) != 0) {
 		{
-			p.SetState(613)
+			p.SetState(897)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(616)
+		p.SetState(900)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -11528,7 +17253,7 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 	_ = this
 
 	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SQLParserRULE_groupByClause)
+	p.EnterRule(localctx, 184, SQLParserRULE_groupByClause)
 	var _la int
 
 	defer func() {
@@ -11549,47 +17274,47 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(618)
+		p.SetState(902)
 		p.Match(SQLParserT_GROUP)
 	}
 	{
-		p.SetState(619)
+		p.SetState(903)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(620)
+		p.SetState(904)
 		p.GroupByKeys()
 	}
-	p.SetState(626)
+	p.SetState(910)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_FILL {
 		{
-			p.SetState(621)
+			p.SetState(905)
 			p.Match(SQLParserT_FILL)
 		}
 		{
-			p.SetState(622)
+			p.SetState(906)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(623)
+			p.SetState(907)
 			p.FillOption()
 		}
 		{
-			p.SetState(624)
+			p.SetState(908)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
-	p.SetState(629)
+	p.SetState(913)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_HAVING {
 		{
-			p.SetState(628)
+			p.SetState(912)
 			p.HavingClause()
 		}
 
@@ -11726,7 +17451,7 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 	_ = this
 
 	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SQLParserRULE_groupByKeys)
+	p.EnterRule(localctx, 186, SQLParserRULE_groupByKeys)
 	var _la int
 
 	defer func() {
@@ -11747,24 +17472,24 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(631)
+		p.SetState(915)
 		p.GroupByKey()
 	}
-	p.SetState(636)
+	p.SetState(920)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(632)
+			p.SetState(916)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(633)
+			p.SetState(917)
 			p.GroupByKey()
 		}
 
-		p.SetState(638)
+		p.SetState(922)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -11781,6 +17506,7 @@ type IGroupByKeyContext interface {
 
 	// Getter signatures
 	Ident() IIdentContext
+	T_MUL() antlr.TerminalNode
 	T_TIME() antlr.TerminalNode
 	T_OPEN_P() antlr.TerminalNode
 	DurationLit() IDurationLitContext
@@ -11833,6 +17559,10 @@ func (s *GroupByKeyContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
+func (s *GroupByKeyContext) T_MUL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MUL, 0)
+}
+
 func (s *GroupByKeyContext) T_TIME() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_TIME, 0)
 }
@@ -11896,7 +17626,7 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 	_ = this
 
 	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SQLParserRULE_groupByKey)
+	p.EnterRule(localctx, 188, SQLParserRULE_groupByKey)
 
 	defer func() {
 		p.ExitRule()
@@ -11914,47 +17644,54 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 		}
 	}()
 
-	p.SetState(648)
+	p.SetState(933)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(639)
+			p.SetState(923)
 			p.Ident()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(640)
+			p.SetState(924)
+			p.Match(SQLParserT_MUL)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(925)
 			p.Match(SQLParserT_TIME)
 		}
 		{
-			p.SetState(641)
+			p.SetState(926)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(642)
+			p.SetState(927)
 			p.DurationLit()
 		}
 		{
-			p.SetState(643)
+			p.SetState(928)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(645)
+			p.SetState(930)
 			p.Match(SQLParserT_TIME)
 		}
 		{
-			p.SetState(646)
+			p.SetState(931)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(647)
+			p.SetState(932)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
@@ -12058,7 +17795,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 	_ = this
 
 	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, SQLParserRULE_fillOption)
+	p.EnterRule(localctx, 190, SQLParserRULE_fillOption)
 	var _la int
 
 	defer func() {
@@ -12079,7 +17816,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(650)
+		p.SetState(935)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_NULL || _la == SQLParserT_PREVIOUS || _la == SQLParserL_INT || _la == SQLParserL_DEC) {
@@ -12195,7 +17932,7 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 	_ = this
 
 	localctx = NewOrderByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SQLParserRULE_orderByClause)
+	p.EnterRule(localctx, 192, SQLParserRULE_orderByClause)
 
 	defer func() {
 		p.ExitRule()
@@ -12215,15 +17952,15 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(652)
+		p.SetState(937)
 		p.Match(SQLParserT_ORDER)
 	}
 	{
-		p.SetState(653)
+		p.SetState(938)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(654)
+		p.SetState(939)
 		p.SortFields()
 	}
 
@@ -12342,7 +18079,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 	_ = this
 
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SQLParserRULE_sortField)
+	p.EnterRule(localctx, 194, SQLParserRULE_sortField)
 	var _la int
 
 	defer func() {
@@ -12363,16 +18100,16 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(656)
+		p.SetState(941)
 		p.fieldExpr(0)
 	}
-	p.SetState(660)
+	p.SetState(945)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_ASC || _la == SQLParserT_DESC {
 		{
-			p.SetState(657)
+			p.SetState(942)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ASC || _la == SQLParserT_DESC) {
@@ -12383,7 +18120,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 			}
 		}
 
-		p.SetState(662)
+		p.SetState(947)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -12519,7 +18256,7 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 	_ = this
 
 	localctx = NewSortFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SQLParserRULE_sortFields)
+	p.EnterRule(localctx, 196, SQLParserRULE_sortFields)
 	var _la int
 
 	defer func() {
@@ -12540,24 +18277,24 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(663)
+		p.SetState(948)
 		p.SortField()
 	}
-	p.SetState(668)
+	p.SetState(953)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(664)
+			p.SetState(949)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(665)
+			p.SetState(950)
 			p.SortField()
 		}
 
-		p.SetState(670)
+		p.SetState(955)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -12662,7 +18399,7 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 	_ = this
 
 	localctx = NewHavingClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SQLParserRULE_havingClause)
+	p.EnterRule(localctx, 198, SQLParserRULE_havingClause)
 
 	defer func() {
 		p.ExitRule()
@@ -12682,11 +18419,11 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(671)
+		p.SetState(956)
 		p.Match(SQLParserT_HAVING)
 	}
 	{
-		p.SetState(672)
+		p.SetState(957)
 		p.boolExpr(0)
 	}
 
@@ -12863,8 +18600,8 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	localctx = NewBoolExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IBoolExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 138
-	p.EnterRecursionRule(localctx, 138, SQLParserRULE_boolExpr, _p)
+	_startState := 200
+	p.EnterRecursionRule(localctx, 200, SQLParserRULE_boolExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -12885,34 +18622,34 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(680)
+	p.SetState(965)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(675)
+			p.SetState(960)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(676)
+			p.SetState(961)
 			p.boolExpr(0)
 		}
 		{
-			p.SetState(677)
+			p.SetState(962)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(679)
+			p.SetState(964)
 			p.BoolExprAtom()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(688)
+	p.SetState(973)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -12922,24 +18659,24 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			_prevctx = localctx
 			localctx = NewBoolExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_boolExpr)
-			p.SetState(682)
+			p.SetState(967)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
 			{
-				p.SetState(683)
+				p.SetState(968)
 				p.BoolExprLogicalOp()
 			}
 			{
-				p.SetState(684)
+				p.SetState(969)
 				p.boolExpr(3)
 			}
 
 		}
-		p.SetState(690)
+		p.SetState(975)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13030,7 +18767,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 	_ = this
 
 	localctx = NewBoolExprLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SQLParserRULE_boolExprLogicalOp)
+	p.EnterRule(localctx, 202, SQLParserRULE_boolExprLogicalOp)
 	var _la int
 
 	defer func() {
@@ -13051,7 +18788,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(691)
+		p.SetState(976)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -13157,7 +18894,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 	_ = this
 
 	localctx = NewBoolExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SQLParserRULE_boolExprAtom)
+	p.EnterRule(localctx, 204, SQLParserRULE_boolExprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -13177,7 +18914,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(693)
+		p.SetState(978)
 		p.BinaryExpr()
 	}
 
@@ -13319,7 +19056,7 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 	_ = this
 
 	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SQLParserRULE_binaryExpr)
+	p.EnterRule(localctx, 206, SQLParserRULE_binaryExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -13339,15 +19076,15 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(695)
+		p.SetState(980)
 		p.fieldExpr(0)
 	}
 	{
-		p.SetState(696)
+		p.SetState(981)
 		p.BinaryOperator()
 	}
 	{
-		p.SetState(697)
+		p.SetState(982)
 		p.fieldExpr(0)
 	}
 
@@ -13474,7 +19211,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	_ = this
 
 	localctx = NewBinaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, SQLParserRULE_binaryOperator)
+	p.EnterRule(localctx, 208, SQLParserRULE_binaryOperator)
 	var _la int
 
 	defer func() {
@@ -13493,63 +19230,63 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 		}
 	}()
 
-	p.SetState(707)
+	p.SetState(992)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_EQUAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(699)
+			p.SetState(984)
 			p.Match(SQLParserT_EQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(700)
+			p.SetState(985)
 			p.Match(SQLParserT_NOTEQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL2:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(701)
+			p.SetState(986)
 			p.Match(SQLParserT_NOTEQUAL2)
 		}
 
 	case SQLParserT_LESS:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(702)
+			p.SetState(987)
 			p.Match(SQLParserT_LESS)
 		}
 
 	case SQLParserT_LESSEQUAL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(703)
+			p.SetState(988)
 			p.Match(SQLParserT_LESSEQUAL)
 		}
 
 	case SQLParserT_GREATER:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(704)
+			p.SetState(989)
 			p.Match(SQLParserT_GREATER)
 		}
 
 	case SQLParserT_GREATEREQUAL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(705)
+			p.SetState(990)
 			p.Match(SQLParserT_GREATEREQUAL)
 		}
 
 	case SQLParserT_LIKE, SQLParserT_REGEXP:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(706)
+			p.SetState(991)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_LIKE || _la == SQLParserT_REGEXP) {
@@ -13791,8 +19528,8 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	localctx = NewFieldExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IFieldExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 148
-	p.EnterRecursionRule(localctx, 148, SQLParserRULE_fieldExpr, _p)
+	_startState := 210
+	p.EnterRecursionRule(localctx, 210, SQLParserRULE_fieldExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -13813,52 +19550,52 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(718)
+	p.SetState(1003)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(710)
+			p.SetState(995)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(711)
+			p.SetState(996)
 			p.fieldExpr(0)
 		}
 		{
-			p.SetState(712)
+			p.SetState(997)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(714)
+			p.SetState(999)
 			p.ExprFunc()
 		}
 
 	case 3:
 		{
-			p.SetState(715)
+			p.SetState(1000)
 			p.ExprAtom()
 		}
 
 	case 4:
 		{
-			p.SetState(716)
+			p.SetState(1001)
 			p.DurationLit()
 		}
 
 	case 5:
 		{
-			p.SetState(717)
+			p.SetState(1002)
 			p.Star()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(734)
+	p.SetState(1019)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -13866,83 +19603,83 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(732)
+			p.SetState(1017)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(720)
+				p.SetState(1005)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(721)
+					p.SetState(1006)
 					p.Match(SQLParserT_MUL)
 				}
 				{
-					p.SetState(722)
+					p.SetState(1007)
 					p.fieldExpr(10)
 				}
 
 			case 2:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(723)
+				p.SetState(1008)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(724)
+					p.SetState(1009)
 					p.Match(SQLParserT_DIV)
 				}
 				{
-					p.SetState(725)
+					p.SetState(1010)
 					p.fieldExpr(9)
 				}
 
 			case 3:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(726)
+				p.SetState(1011)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(727)
+					p.SetState(1012)
 					p.Match(SQLParserT_ADD)
 				}
 				{
-					p.SetState(728)
+					p.SetState(1013)
 					p.fieldExpr(8)
 				}
 
 			case 4:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(729)
+				p.SetState(1014)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(730)
+					p.SetState(1015)
 					p.Match(SQLParserT_SUB)
 				}
 				{
-					p.SetState(731)
+					p.SetState(1016)
 					p.fieldExpr(7)
 				}
 
 			}
 
 		}
-		p.SetState(736)
+		p.SetState(1021)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -14028,7 +19765,7 @@ func (p *SQLParser) Star() (localctx IStarContext) {
 	_ = this
 
 	localctx = NewStarContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SQLParserRULE_star)
+	p.EnterRule(localctx, 212, SQLParserRULE_star)
 
 	defer func() {
 		p.ExitRule()
@@ -14048,7 +19785,7 @@ func (p *SQLParser) Star() (localctx IStarContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(737)
+		p.SetState(1022)
 		p.Match(SQLParserT_MUL)
 	}
 
@@ -14164,7 +19901,7 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 	_ = this
 
 	localctx = NewDurationLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SQLParserRULE_durationLit)
+	p.EnterRule(localctx, 214, SQLParserRULE_durationLit)
 
 	defer func() {
 		p.ExitRule()
@@ -14184,11 +19921,11 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(739)
+		p.SetState(1024)
 		p.IntNumber()
 	}
 	{
-		p.SetState(740)
+		p.SetState(1025)
 		p.IntervalItem()
 	}
 
@@ -14305,7 +20042,7 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 	_ = this
 
 	localctx = NewIntervalItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SQLParserRULE_intervalItem)
+	p.EnterRule(localctx, 216, SQLParserRULE_intervalItem)
 	var _la int
 
 	defer func() {
@@ -14326,10 +20063,10 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(742)
+		p.SetState(1027)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-97)) & ^0x3f) == 0 && ((int64(1)<<(_la-97))&127) != 0) {
+		if !((int64((_la-126)) & ^0x3f) == 0 && ((int64(1)<<(_la-126))&127) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -14459,7 +20196,7 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 	_ = this
 
 	localctx = NewExprFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, SQLParserRULE_exprFunc)
+	p.EnterRule(localctx, 218, SQLParserRULE_exprFunc)
 	var _la int
 
 	defer func() {
@@ -14480,26 +20217,26 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(744)
+		p.SetState(1029)
 		p.FuncName()
 	}
 	{
-		p.SetState(745)
+		p.SetState(1030)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(747)
+	p.SetState(1032)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-65537) != 0) || ((int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&2068278146049769471) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-16777280) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&3852468255) != 0) {
 		{
-			p.SetState(746)
+			p.SetState(1031)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(749)
+		p.SetState(1034)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -14519,6 +20256,7 @@ type IFuncNameContext interface {
 	T_MAX() antlr.TerminalNode
 	T_AVG() antlr.TerminalNode
 	T_COUNT() antlr.TerminalNode
+	T_COUNT_DISTINCT() antlr.TerminalNode
 	T_LAST() antlr.TerminalNode
 	T_FIRST() antlr.TerminalNode
 	T_STDDEV() antlr.TerminalNode
@@ -14576,6 +20314,10 @@ func (s *FuncNameContext) T_COUNT() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_COUNT, 0)
 }
 
+func (s *FuncNameContext) T_COUNT_DISTINCT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COUNT_DISTINCT, 0)
+}
+
 func (s *FuncNameContext) T_LAST() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_LAST, 0)
 }
@@ -14631,7 +20373,7 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 	_ = this
 
 	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SQLParserRULE_funcName)
+	p.EnterRule(localctx, 220, SQLParserRULE_funcName)
 	var _la int
 
 	defer func() {
@@ -14652,10 +20394,10 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(751)
+		p.SetState(1036)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&1023) != 0) {
+		if !((int64((_la-115)) & ^0x3f) == 0 && ((int64(1)<<(_la-115))&2047) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -14794,7 +20536,7 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 	_ = this
 
 	localctx = NewExprFuncParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SQLParserRULE_exprFuncParams)
+	p.EnterRule(localctx, 222, SQLParserRULE_exprFuncParams)
 	var _la int
 
 	defer func() {
@@ -14815,24 +20557,24 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(753)
+		p.SetState(1038)
 		p.FuncParam()
 	}
-	p.SetState(758)
+	p.SetState(1043)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(754)
+			p.SetState(1039)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(755)
+			p.SetState(1040)
 			p.FuncParam()
 		}
 
-		p.SetState(760)
+		p.SetState(1045)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -14949,7 +20691,7 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	_ = this
 
 	localctx = NewFuncParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SQLParserRULE_funcParam)
+	p.EnterRule(localctx, 224, SQLParserRULE_funcParam)
 
 	defer func() {
 		p.ExitRule()
@@ -14967,20 +20709,20 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 		}
 	}()
 
-	p.SetState(763)
+	p.SetState(1048)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(761)
+			p.SetState(1046)
 			p.fieldExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(762)
+			p.SetState(1047)
 			p.tagFilterExpr(0)
 		}
 
@@ -15132,7 +20874,7 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	_ = this
 
 	localctx = NewExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, SQLParserRULE_exprAtom)
+	p.EnterRule(localctx, 226, SQLParserRULE_exprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -15150,21 +20892,21 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 		}
 	}()
 
-	p.SetState(771)
+	p.SetState(1056)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(765)
+			p.SetState(1050)
 			p.Ident()
 		}
-		p.SetState(767)
+		p.SetState(1052)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(766)
+				p.SetState(1051)
 				p.IdentFilter()
 			}
 
@@ -15173,14 +20915,14 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(769)
+			p.SetState(1054)
 			p.DecNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(770)
+			p.SetState(1055)
 			p.IntNumber()
 		}
 
@@ -15291,7 +21033,7 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 	_ = this
 
 	localctx = NewIdentFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, SQLParserRULE_identFilter)
+	p.EnterRule(localctx, 228, SQLParserRULE_identFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -15311,15 +21053,15 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(773)
+		p.SetState(1058)
 		p.Match(SQLParserT_OPEN_SB)
 	}
 	{
-		p.SetState(774)
+		p.SetState(1059)
 		p.tagFilterExpr(0)
 	}
 	{
-		p.SetState(775)
+		p.SetState(1060)
 		p.Match(SQLParserT_CLOSE_SB)
 	}
 
@@ -15418,7 +21160,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 	_ = this
 
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, SQLParserRULE_json)
+	p.EnterRule(localctx, 230, SQLParserRULE_json)
 
 	defer func() {
 		p.ExitRule()
@@ -15438,7 +21180,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(777)
+		p.SetState(1062)
 		p.Value()
 	}
 
@@ -15537,7 +21279,7 @@ func (p *SQLParser) Toml() (localctx ITomlContext) {
 	_ = this
 
 	localctx = NewTomlContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, SQLParserRULE_toml)
+	p.EnterRule(localctx, 232, SQLParserRULE_toml)
 
 	defer func() {
 		p.ExitRule()
@@ -15557,7 +21299,7 @@ func (p *SQLParser) Toml() (localctx ITomlContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(779)
+		p.SetState(1064)
 		p.Ident()
 	}
 
@@ -15702,7 +21444,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 	_ = this
 
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, SQLParserRULE_obj)
+	p.EnterRule(localctx, 234, SQLParserRULE_obj)
 	var _la int
 
 	defer func() {
@@ -15721,50 +21463,50 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 		}
 	}()
 
-	p.SetState(794)
+	p.SetState(1079)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(781)
+			p.SetState(1066)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(782)
+			p.SetState(1067)
 			p.Pair()
 		}
-		p.SetState(787)
+		p.SetState(1072)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(783)
+				p.SetState(1068)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(784)
+				p.SetState(1069)
 				p.Pair()
 			}
 
-			p.SetState(789)
+			p.SetState(1074)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(790)
+			p.SetState(1075)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(792)
+			p.SetState(1077)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(793)
+			p.SetState(1078)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
@@ -15875,7 +21617,7 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 	_ = this
 
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, SQLParserRULE_pair)
+	p.EnterRule(localctx, 236, SQLParserRULE_pair)
 
 	defer func() {
 		p.ExitRule()
@@ -15895,15 +21637,15 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(796)
+		p.SetState(1081)
 		p.Match(SQLParserSTRING)
 	}
 	{
-		p.SetState(797)
+		p.SetState(1082)
 		p.Match(SQLParserT_COLON)
 	}
 	{
-		p.SetState(798)
+		p.SetState(1083)
 		p.Value()
 	}
 
@@ -16048,7 +21790,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 	_ = this
 
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, SQLParserRULE_arr)
+	p.EnterRule(localctx, 238, SQLParserRULE_arr)
 	var _la int
 
 	defer func() {
@@ -16067,50 +21809,50 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 		}
 	}()
 
-	p.SetState(813)
+	p.SetState(1098)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(800)
+			p.SetState(1085)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(801)
+			p.SetState(1086)
 			p.Value()
 		}
-		p.SetState(806)
+		p.SetState(1091)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(802)
+				p.SetState(1087)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(803)
+				p.SetState(1088)
 				p.Value()
 			}
 
-			p.SetState(808)
+			p.SetState(1093)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(809)
+			p.SetState(1094)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(811)
+			p.SetState(1096)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(812)
+			p.SetState(1097)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
@@ -16267,7 +22009,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	_ = this
 
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, SQLParserRULE_value)
+	p.EnterRule(localctx, 240, SQLParserRULE_value)
 
 	defer func() {
 		p.ExitRule()
@@ -16285,63 +22027,63 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 		}
 	}()
 
-	p.SetState(823)
+	p.SetState(1108)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(815)
+			p.SetState(1100)
 			p.Match(SQLParserSTRING)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(816)
+			p.SetState(1101)
 			p.IntNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(817)
+			p.SetState(1102)
 			p.DecNumber()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(818)
+			p.SetState(1103)
 			p.Obj()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(819)
+			p.SetState(1104)
 			p.Arr()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(820)
-			p.Match(SQLParserT__0)
+			p.SetState(1105)
+			p.Match(SQLParserT__1)
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(821)
-			p.Match(SQLParserT__1)
+			p.SetState(1106)
+			p.Match(SQLParserT__2)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(822)
-			p.Match(SQLParserT__2)
+			p.SetState(1107)
+			p.Match(SQLParserT__0)
 		}
 
 	}
@@ -16439,7 +22181,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	_ = this
 
 	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, SQLParserRULE_intNumber)
+	p.EnterRule(localctx, 242, SQLParserRULE_intNumber)
 	var _la int
 
 	defer func() {
@@ -16459,13 +22201,13 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(826)
+	p.SetState(1111)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(825)
+			p.SetState(1110)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -16478,7 +22220,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 
 	}
 	{
-		p.SetState(828)
+		p.SetState(1113)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -16575,7 +22317,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	_ = this
 
 	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, SQLParserRULE_decNumber)
+	p.EnterRule(localctx, 244, SQLParserRULE_decNumber)
 	var _la int
 
 	defer func() {
@@ -16595,13 +22337,13 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(831)
+	p.SetState(1116)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(830)
+			p.SetState(1115)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -16614,7 +22356,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 
 	}
 	{
-		p.SetState(833)
+		p.SetState(1118)
 		p.Match(SQLParserL_DEC)
 	}
 
@@ -16706,7 +22448,7 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 	_ = this
 
 	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, SQLParserRULE_limitClause)
+	p.EnterRule(localctx, 246, SQLParserRULE_limitClause)
 
 	defer func() {
 		p.ExitRule()
@@ -16726,11 +22468,11 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(835)
+		p.SetState(1120)
 		p.Match(SQLParserT_LIMIT)
 	}
 	{
-		p.SetState(836)
+		p.SetState(1121)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -16829,7 +22571,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 	_ = this
 
 	localctx = NewMetricNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, SQLParserRULE_metricName)
+	p.EnterRule(localctx, 248, SQLParserRULE_metricName)
 
 	defer func() {
 		p.ExitRule()
@@ -16849,7 +22591,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(838)
+		p.SetState(1123)
 		p.Ident()
 	}
 
@@ -16948,7 +22690,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 	_ = this
 
 	localctx = NewTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, SQLParserRULE_tagKey)
+	p.EnterRule(localctx, 250, SQLParserRULE_tagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -16968,7 +22710,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(840)
+		p.SetState(1125)
 		p.Ident()
 	}
 
@@ -17067,7 +22809,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 	_ = this
 
 	localctx = NewTagValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, SQLParserRULE_tagValue)
+	p.EnterRule(localctx, 252, SQLParserRULE_tagValue)
 
 	defer func() {
 		p.ExitRule()
@@ -17087,7 +22829,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(842)
+		p.SetState(1127)
 		p.Ident()
 	}
 
@@ -17232,7 +22974,7 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	_ = this
 
 	localctx = NewIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, SQLParserRULE_ident)
+	p.EnterRule(localctx, 254, SQLParserRULE_ident)
 
 	defer func() {
 		p.ExitRule()
@@ -17253,48 +22995,48 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(846)
+	p.SetState(1131)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserL_ID:
 		{
-			p.SetState(844)
+			p.SetState(1129)
 			p.Match(SQLParserL_ID)
 		}
 
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_DELETE, SQLParserT_ALTER, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_REPAIR, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_LEVELS, SQLParserT_LEVEL, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SHARDS, SQLParserT_SEGMENTS, SQLParserT_DISK, SQLParserT_USAGE, SQLParserT_FILE, SQLParserT_DETAIL, SQLParserT_FAMILY, SQLParserT_CHANNELS, SQLParserT_EXPIRED, SQLParserT_PLACEMENT, SQLParserT_SUGGESTIONS, SQLParserT_SERIES, SQLParserT_FORMAT, SQLParserT_FUZZY, SQLParserT_WRITE, SQLParserT_OFF, SQLParserT_READONLY, SQLParserT_AT, SQLParserT_TIMESTAMP, SQLParserT_STATUS, SQLParserT_SINCE, SQLParserT_COMPACTION, SQLParserT_JOBS, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_COUNT_DISTINCT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 		{
-			p.SetState(845)
+			p.SetState(1130)
 			p.NonReservedWords()
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(855)
+	p.SetState(1140)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 85, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(848)
+				p.SetState(1133)
 				p.Match(SQLParserT_DOT)
 			}
-			p.SetState(851)
+			p.SetState(1136)
 			p.GetErrorHandler().Sync(p)
 
 			switch p.GetTokenStream().LA(1) {
 			case SQLParserL_ID:
 				{
-					p.SetState(849)
+					p.SetState(1134)
 					p.Match(SQLParserL_ID)
 				}
 
-			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_DELETE, SQLParserT_ALTER, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_REPAIR, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_LEVELS, SQLParserT_LEVEL, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SHARDS, SQLParserT_SEGMENTS, SQLParserT_DISK, SQLParserT_USAGE, SQLParserT_FILE, SQLParserT_DETAIL, SQLParserT_FAMILY, SQLParserT_CHANNELS, SQLParserT_EXPIRED, SQLParserT_PLACEMENT, SQLParserT_SUGGESTIONS, SQLParserT_SERIES, SQLParserT_FORMAT, SQLParserT_FUZZY, SQLParserT_WRITE, SQLParserT_OFF, SQLParserT_READONLY, SQLParserT_AT, SQLParserT_TIMESTAMP, SQLParserT_STATUS, SQLParserT_SINCE, SQLParserT_COMPACTION, SQLParserT_JOBS, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_COUNT_DISTINCT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 				{
-					p.SetState(850)
+					p.SetState(1135)
 					p.NonReservedWords()
 				}
 
@@ -17303,9 +23045,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			}
 
 		}
-		p.SetState(857)
+		p.SetState(1142)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 85, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -17386,6 +23128,7 @@ type INonReservedWordsContext interface {
 	T_MIN() antlr.TerminalNode
 	T_MAX() antlr.TerminalNode
 	T_COUNT() antlr.TerminalNode
+	T_COUNT_DISTINCT() antlr.TerminalNode
 	T_LAST() antlr.TerminalNode
 	T_FIRST() antlr.TerminalNode
 	T_AVG() antlr.TerminalNode
@@ -17416,6 +23159,34 @@ type INonReservedWordsContext interface {
 	T_REQUESTS() antlr.TerminalNode
 	T_REQUEST() antlr.TerminalNode
 	T_ID() antlr.TerminalNode
+	T_SHARDS() antlr.TerminalNode
+	T_SEGMENTS() antlr.TerminalNode
+	T_DISK() antlr.TerminalNode
+	T_USAGE() antlr.TerminalNode
+	T_FILE() antlr.TerminalNode
+	T_DETAIL() antlr.TerminalNode
+	T_FAMILY() antlr.TerminalNode
+	T_CHANNELS() antlr.TerminalNode
+	T_EXPIRED() antlr.TerminalNode
+	T_LEVEL() antlr.TerminalNode
+	T_LEVELS() antlr.TerminalNode
+	T_PLACEMENT() antlr.TerminalNode
+	T_SUGGESTIONS() antlr.TerminalNode
+	T_REPAIR() antlr.TerminalNode
+	T_DELETE() antlr.TerminalNode
+	T_SERIES() antlr.TerminalNode
+	T_FORMAT() antlr.TerminalNode
+	T_FUZZY() antlr.TerminalNode
+	T_ALTER() antlr.TerminalNode
+	T_WRITE() antlr.TerminalNode
+	T_OFF() antlr.TerminalNode
+	T_READONLY() antlr.TerminalNode
+	T_AT() antlr.TerminalNode
+	T_TIMESTAMP() antlr.TerminalNode
+	T_STATUS() antlr.TerminalNode
+	T_SINCE() antlr.TerminalNode
+	T_COMPACTION() antlr.TerminalNode
+	T_JOBS() antlr.TerminalNode
 
 	// IsNonReservedWordsContext differentiates from other interfaces.
 	IsNonReservedWordsContext()
@@ -17716,6 +23487,10 @@ func (s *NonReservedWordsContext) T_COUNT() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_COUNT, 0)
 }
 
+func (s *NonReservedWordsContext) T_COUNT_DISTINCT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COUNT_DISTINCT, 0)
+}
+
 func (s *NonReservedWordsContext) T_LAST() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_LAST, 0)
 }
@@ -17836,6 +23611,118 @@ func (s *NonReservedWordsContext) T_ID() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_ID, 0)
 }
 
+func (s *NonReservedWordsContext) T_SHARDS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHARDS, 0)
+}
+
+func (s *NonReservedWordsContext) T_SEGMENTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SEGMENTS, 0)
+}
+
+func (s *NonReservedWordsContext) T_DISK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DISK, 0)
+}
+
+func (s *NonReservedWordsContext) T_USAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USAGE, 0)
+}
+
+func (s *NonReservedWordsContext) T_FILE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FILE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DETAIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DETAIL, 0)
+}
+
+func (s *NonReservedWordsContext) T_FAMILY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FAMILY, 0)
+}
+
+func (s *NonReservedWordsContext) T_CHANNELS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CHANNELS, 0)
+}
+
+func (s *NonReservedWordsContext) T_EXPIRED() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXPIRED, 0)
+}
+
+func (s *NonReservedWordsContext) T_LEVEL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LEVEL, 0)
+}
+
+func (s *NonReservedWordsContext) T_LEVELS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LEVELS, 0)
+}
+
+func (s *NonReservedWordsContext) T_PLACEMENT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PLACEMENT, 0)
+}
+
+func (s *NonReservedWordsContext) T_SUGGESTIONS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUGGESTIONS, 0)
+}
+
+func (s *NonReservedWordsContext) T_REPAIR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPAIR, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
+func (s *NonReservedWordsContext) T_SERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SERIES, 0)
+}
+
+func (s *NonReservedWordsContext) T_FORMAT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FORMAT, 0)
+}
+
+func (s *NonReservedWordsContext) T_FUZZY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FUZZY, 0)
+}
+
+func (s *NonReservedWordsContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
+}
+
+func (s *NonReservedWordsContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *NonReservedWordsContext) T_OFF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFF, 0)
+}
+
+func (s *NonReservedWordsContext) T_READONLY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READONLY, 0)
+}
+
+func (s *NonReservedWordsContext) T_AT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AT, 0)
+}
+
+func (s *NonReservedWordsContext) T_TIMESTAMP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIMESTAMP, 0)
+}
+
+func (s *NonReservedWordsContext) T_STATUS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATUS, 0)
+}
+
+func (s *NonReservedWordsContext) T_SINCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SINCE, 0)
+}
+
+func (s *NonReservedWordsContext) T_COMPACTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMPACTION, 0)
+}
+
+func (s *NonReservedWordsContext) T_JOBS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_JOBS, 0)
+}
+
 func (s *NonReservedWordsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -17871,7 +23758,7 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 	_ = this
 
 	localctx = NewNonReservedWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, SQLParserRULE_nonReservedWords)
+	p.EnterRule(localctx, 256, SQLParserRULE_nonReservedWords)
 	var _la int
 
 	defer func() {
@@ -17892,10 +23779,10 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(858)
+		p.SetState(1143)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&1099511627775) != 0)) {
+		if !(((int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-262145) != 0) || ((int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&9223372036854775807) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17908,21 +23795,21 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 func (p *SQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 53:
+	case 82:
 		var t *TagFilterExprContext = nil
 		if localctx != nil {
 			t = localctx.(*TagFilterExprContext)
 		}
 		return p.TagFilterExpr_Sempred(t, predIndex)
 
-	case 69:
+	case 100:
 		var t *BoolExprContext = nil
 		if localctx != nil {
 			t = localctx.(*BoolExprContext)
 		}
 		return p.BoolExpr_Sempred(t, predIndex)
 
-	case 74:
+	case 105:
 		var t *FieldExprContext = nil
 		if localctx != nil {
 			t = localctx.(*FieldExprContext)
@@ -27,12 +27,21 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#statement.
 	VisitStatement(ctx *StatementContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#formatClause.
+	VisitFormatClause(ctx *FormatClauseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#useStmt.
 	VisitUseStmt(ctx *UseStmtContext) interface{}
 
 	// Visit a parse tree produced by SQLParser#setLimitStmt.
 	VisitSetLimitStmt(ctx *SetLimitStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#setLogLevelStmt.
+	VisitSetLogLevelStmt(ctx *SetLogLevelStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#logLevel.
+	VisitLogLevel(ctx *LogLevelContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showStmt.
 	VisitShowStmt(ctx *ShowStmtContext) interface{}
 
@@ -54,6 +63,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showLimitStmt.
 	VisitShowLimitStmt(ctx *ShowLimitStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showLimitStatusStmt.
+	VisitShowLimitStatusStmt(ctx *ShowLimitStatusStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showMetadataTypesStmt.
 	VisitShowMetadataTypesStmt(ctx *ShowMetadataTypesStmtContext) interface{}
 
@@ -78,6 +90,36 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showMemoryDatabaseStmt.
 	VisitShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showShardsStmt.
+	VisitShowShardsStmt(ctx *ShowShardsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showSegmentsStmt.
+	VisitShowSegmentsStmt(ctx *ShowSegmentsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showDiskUsageStmt.
+	VisitShowDiskUsageStmt(ctx *ShowDiskUsageStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showFileDetailStmt.
+	VisitShowFileDetailStmt(ctx *ShowFileDetailStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showReplicationChannelsStmt.
+	VisitShowReplicationChannelsStmt(ctx *ShowReplicationChannelsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showExpiredMetricsStmt.
+	VisitShowExpiredMetricsStmt(ctx *ShowExpiredMetricsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showLogLevelsStmt.
+	VisitShowLogLevelsStmt(ctx *ShowLogLevelsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showPlacementStmt.
+	VisitShowPlacementStmt(ctx *ShowPlacementStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#repairPlacementStmt.
+	VisitRepairPlacementStmt(ctx *RepairPlacementStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#deleteSeriesStmt.
+	VisitDeleteSeriesStmt(ctx *DeleteSeriesStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showRootMetricStmt.
 	VisitShowRootMetricStmt(ctx *ShowRootMetricStmtContext) interface{}
 
@@ -105,6 +147,30 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#dropDatabaseStmt.
 	VisitDropDatabaseStmt(ctx *DropDatabaseStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#alterDatabaseStmt.
+	VisitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#switchValue.
+	VisitSwitchValue(ctx *SwitchValueContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showReadOnlyStmt.
+	VisitShowReadOnlyStmt(ctx *ShowReadOnlyStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#alterStorageReadOnlyStmt.
+	VisitAlterStorageReadOnlyStmt(ctx *AlterStorageReadOnlyStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#alterDatabaseReadOnlyStmt.
+	VisitAlterDatabaseReadOnlyStmt(ctx *AlterDatabaseReadOnlyStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#nodeID.
+	VisitNodeID(ctx *NodeIDContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showCompactionStmt.
+	VisitShowCompactionStmt(ctx *ShowCompactionStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#setCompactionStmt.
+	VisitSetCompactionStmt(ctx *SetCompactionStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showDatabaseStmt.
 	VisitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) interface{}
 
@@ -117,6 +183,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showFieldsStmt.
 	VisitShowFieldsStmt(ctx *ShowFieldsStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showFieldUsageStmt.
+	VisitShowFieldUsageStmt(ctx *ShowFieldUsageStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#sinceClause.
+	VisitSinceClause(ctx *SinceClauseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showTagKeysStmt.
 	VisitShowTagKeysStmt(ctx *ShowTagKeysStmtContext) interface{}
 
@@ -126,6 +198,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#prefix.
 	VisitPrefix(ctx *PrefixContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#fuzzyClause.
+	VisitFuzzyClause(ctx *FuzzyClauseContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#atTimestampClause.
+	VisitAtTimestampClause(ctx *AtTimestampClauseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#withTagKey.
 	VisitWithTagKey(ctx *WithTagKeyContext) interface{}
 
@@ -141,6 +219,15 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#requestID.
 	VisitRequestID(ctx *RequestIDContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#shardID.
+	VisitShardID(ctx *ShardIDContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#familyTime.
+	VisitFamilyTime(ctx *FamilyTimeContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#fileNumber.
+	VisitFileNumber(ctx *FileNumberContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#source.
 	VisitSource(ctx *SourceContext) interface{}
 
@@ -189,6 +276,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#tagValueList.
 	VisitTagValueList(ctx *TagValueListContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#subQuery.
+	VisitSubQuery(ctx *SubQueryContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#metricListFilter.
 	VisitMetricListFilter(ctx *MetricListFilterContext) interface{}
 
@@ -204,6 +294,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#nowExpr.
 	VisitNowExpr(ctx *NowExprContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#truncateDuration.
+	VisitTruncateDuration(ctx *TruncateDurationContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#nowFunc.
 	VisitNowFunc(ctx *NowFuncContext) interface{}
 
@@ -39,3 +39,12 @@ func TestShowLimitStatement(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, stmt.ShowLimit, q.(*stmt.Limit).Type)
 }
+
+func TestShowLimitStatusStatement(t *testing.T) {
+	q, err := Parse("show limit status")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Limit{Type: stmt.ShowLimitStatus}, q)
+	q, err = Parse("SHOW LIMIT STATUS")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Limit{Type: stmt.ShowLimitStatus}, q)
+}
@@ -18,6 +18,10 @@
 package sql
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -35,6 +39,15 @@ type listener struct {
 	requestStmt        *requestStmtParser
 	brokerStmt         *brokerStmtParser
 	limitStmt          *limitStmtParser
+	logLevelStmt       *logLevelStmtParser
+	placementStmt      *placementStmtParser
+	readOnlyStmt       *readOnlyStmtParser
+	deleteSeriesStmt   *deleteSeriesStmtParser
+	fieldUsageStmt     *fieldUsageStmtParser
+	compactionStmt     *compactionStmtParser
+
+	format  string
+	parents []listener // listeners of outer statements when parsing sub query
 }
 
 // EnterQueryStmt is called when production queryStmt is entered.
@@ -139,6 +152,51 @@ func (l *listener) EnterShowMemoryDatabaseStmt(_ *grammar.ShowMemoryDatabaseStmt
 	l.stateStmt = newStateStmtParse(stmt.MemoryDatabase)
 }
 
+// EnterShowShardsStmt is called when production showShardsStmt is entered.
+func (l *listener) EnterShowShardsStmt(_ *grammar.ShowShardsStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.Shards)
+}
+
+// EnterShowSegmentsStmt is called when production showSegmentsStmt is entered.
+func (l *listener) EnterShowSegmentsStmt(_ *grammar.ShowSegmentsStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.Segments)
+}
+
+// EnterShowDiskUsageStmt is called when production showDiskUsageStmt is entered.
+func (l *listener) EnterShowDiskUsageStmt(_ *grammar.ShowDiskUsageStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.DiskUsage)
+}
+
+// EnterShowFileDetailStmt is called when production showFileDetailStmt is entered.
+func (l *listener) EnterShowFileDetailStmt(_ *grammar.ShowFileDetailStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.FileDetail)
+}
+
+// EnterShowReplicationChannelsStmt is called when production showReplicationChannelsStmt is entered.
+func (l *listener) EnterShowReplicationChannelsStmt(_ *grammar.ShowReplicationChannelsStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.ReplicationChannels)
+}
+
+// EnterShowExpiredMetricsStmt is called when production showExpiredMetricsStmt is entered.
+func (l *listener) EnterShowExpiredMetricsStmt(_ *grammar.ShowExpiredMetricsStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.ExpiredMetrics)
+}
+
+// EnterShardID is called when production shardID is entered.
+func (l *listener) EnterShardID(ctx *grammar.ShardIDContext) {
+	l.stateStmt.visitShardID(ctx)
+}
+
+// EnterFamilyTime is called when production familyTime is entered.
+func (l *listener) EnterFamilyTime(ctx *grammar.FamilyTimeContext) {
+	l.stateStmt.visitFamilyTime(ctx)
+}
+
+// EnterFileNumber is called when production fileNumber is entered.
+func (l *listener) EnterFileNumber(ctx *grammar.FileNumberContext) {
+	l.stateStmt.visitFileNumber(ctx)
+}
+
 // EnterRecoverStorageStmt is called when entering the recoverStorageStmt production.
 func (l *listener) EnterRecoverStorageStmt(_ *grammar.RecoverStorageStmtContext) {
 	l.storageStmt = newStorageStmtParse(stmt.StorageOpRecover)
@@ -146,7 +204,12 @@ func (l *listener) EnterRecoverStorageStmt(_ *grammar.RecoverStorageStmtContext)
 
 // EnterStorageName is called when entering the storageName production.
 func (l *listener) EnterStorageName(c *grammar.StorageNameContext) {
-	l.storageStmt.visitStorageName(c)
+	switch {
+	case l.storageStmt != nil:
+		l.storageStmt.visitStorageName(c)
+	case l.readOnlyStmt != nil:
+		l.readOnlyStmt.visitStorageName(c)
+	}
 }
 
 // EnterBrokerFilter is called when production brokerFilter is entered.
@@ -216,9 +279,73 @@ func (l *listener) EnterDropDatabaseStmt(_ *grammar.DropDatabaseStmtContext) {
 	l.schemasStmt = newSchemasStmtParse(stmt.DropDatabaseSchemaType)
 }
 
+// EnterAlterDatabaseStmt is called when production alterDatabaseStmt is entered.
+func (l *listener) EnterAlterDatabaseStmt(_ *grammar.AlterDatabaseStmtContext) {
+	l.schemasStmt = newSchemasStmtParse(stmt.AlterDatabaseSchemaType)
+}
+
+// EnterSwitchValue is called when production switchValue is entered.
+func (l *listener) EnterSwitchValue(ctx *grammar.SwitchValueContext) {
+	switch {
+	case l.schemasStmt != nil:
+		l.schemasStmt.visitSwitchValue(ctx)
+	case l.readOnlyStmt != nil:
+		l.readOnlyStmt.visitSwitchValue(ctx)
+	case l.compactionStmt != nil:
+		l.compactionStmt.visitSwitchValue(ctx)
+	}
+}
+
+// EnterShowReadOnlyStmt is called when production showReadOnlyStmt is entered.
+func (l *listener) EnterShowReadOnlyStmt(_ *grammar.ShowReadOnlyStmtContext) {
+	l.readOnlyStmt = newReadOnlyStmtParse(stmt.ShowReadOnly)
+}
+
+// EnterAlterStorageReadOnlyStmt is called when production alterStorageReadOnlyStmt is entered.
+func (l *listener) EnterAlterStorageReadOnlyStmt(_ *grammar.AlterStorageReadOnlyStmtContext) {
+	l.readOnlyStmt = newReadOnlyStmtParse(stmt.SetStorageReadOnly)
+}
+
+// EnterAlterDatabaseReadOnlyStmt is called when production alterDatabaseReadOnlyStmt is entered.
+func (l *listener) EnterAlterDatabaseReadOnlyStmt(_ *grammar.AlterDatabaseReadOnlyStmtContext) {
+	l.readOnlyStmt = newReadOnlyStmtParse(stmt.SetDatabaseReadOnly)
+}
+
+// EnterShowCompactionStmt is called when production showCompactionStmt is entered.
+func (l *listener) EnterShowCompactionStmt(ctx *grammar.ShowCompactionStmtContext) {
+	if ctx.T_JOBS() != nil {
+		l.compactionStmt = newCompactionStmtParse(stmt.ShowCompactionJobs)
+		return
+	}
+	l.compactionStmt = newCompactionStmtParse(stmt.ShowCompaction)
+}
+
+// EnterSetCompactionStmt is called when production setCompactionStmt is entered.
+func (l *listener) EnterSetCompactionStmt(_ *grammar.SetCompactionStmtContext) {
+	l.compactionStmt = newCompactionStmtParse(stmt.SetCompaction)
+}
+
+// EnterNodeID is called when production nodeID is entered.
+func (l *listener) EnterNodeID(ctx *grammar.NodeIDContext) {
+	if l.readOnlyStmt != nil {
+		l.readOnlyStmt.visitNodeID(ctx)
+	}
+}
+
 // EnterDatabaseName is called when production databaseName is entered.
 func (l *listener) EnterDatabaseName(ctx *grammar.DatabaseNameContext) {
-	l.schemasStmt.visitDatabaseName(ctx)
+	switch {
+	case l.schemasStmt != nil:
+		l.schemasStmt.visitDatabaseName(ctx)
+	case l.stateStmt != nil:
+		l.stateStmt.visitDatabaseName(ctx)
+	case l.placementStmt != nil:
+		l.placementStmt.visitDatabaseName(ctx)
+	case l.readOnlyStmt != nil:
+		l.readOnlyStmt.visitDatabaseName(ctx)
+	case l.compactionStmt != nil:
+		l.compactionStmt.visitDatabaseName(ctx)
+	}
 }
 
 // EnterSetLimtStmt is called when production setLimitStmt is entered.
@@ -232,6 +359,45 @@ func (l *listener) EnterShowLimitStmt(ctx *grammar.ShowLimitStmtContext) {
 	l.limitStmt = newLimitStmtParse(stmt.ShowLimit)
 }
 
+// EnterShowLimitStatusStmt is called when production showLimitStatusStmt is entered.
+func (l *listener) EnterShowLimitStatusStmt(_ *grammar.ShowLimitStatusStmtContext) {
+	l.limitStmt = newLimitStmtParse(stmt.ShowLimitStatus)
+}
+
+// EnterSetLogLevelStmt is called when production setLogLevelStmt is entered.
+func (l *listener) EnterSetLogLevelStmt(_ *grammar.SetLogLevelStmtContext) {
+	l.logLevelStmt = newLogLevelStmtParse(stmt.SetLogLevel)
+}
+
+// EnterShowLogLevelsStmt is called when production showLogLevelsStmt is entered.
+func (l *listener) EnterShowLogLevelsStmt(_ *grammar.ShowLogLevelsStmtContext) {
+	l.logLevelStmt = newLogLevelStmtParse(stmt.ShowLogLevels)
+}
+
+// EnterLogLevel is called when production logLevel is entered.
+func (l *listener) EnterLogLevel(ctx *grammar.LogLevelContext) {
+	l.logLevelStmt.visitLogLevel(ctx)
+}
+
+// EnterShowPlacementStmt is called when production showPlacementStmt is entered.
+func (l *listener) EnterShowPlacementStmt(ctx *grammar.ShowPlacementStmtContext) {
+	if ctx.T_SUGGESTIONS() != nil {
+		l.placementStmt = newPlacementStmtParse(stmt.ShowPlacementSuggestions)
+		return
+	}
+	l.placementStmt = newPlacementStmtParse(stmt.ShowPlacement)
+}
+
+// EnterRepairPlacementStmt is called when production repairPlacementStmt is entered.
+func (l *listener) EnterRepairPlacementStmt(_ *grammar.RepairPlacementStmtContext) {
+	l.placementStmt = newPlacementStmtParse(stmt.RepairPlacement)
+}
+
+// EnterDeleteSeriesStmt is called when production deleteSeriesStmt is entered.
+func (l *listener) EnterDeleteSeriesStmt(_ *grammar.DeleteSeriesStmtContext) {
+	l.deleteSeriesStmt = newDeleteSeriesStmtParse()
+}
+
 // EnterUseStmt is called when production useStmt is entered.
 func (l *listener) EnterUseStmt(ctx *grammar.UseStmtContext) {
 	l.useStmt = newUseStmtParse()
@@ -268,6 +434,18 @@ func (l *listener) EnterShowTagValuesStmt(_ *grammar.ShowTagValuesStmtContext) {
 	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.TagValue)
 }
 
+// EnterShowFieldUsageStmt is called when production showFieldUsageStmt is entered.
+func (l *listener) EnterShowFieldUsageStmt(_ *grammar.ShowFieldUsageStmtContext) {
+	l.fieldUsageStmt = newFieldUsageStmtParse()
+}
+
+// EnterSinceClause is called when production sinceClause is entered.
+func (l *listener) EnterSinceClause(ctx *grammar.SinceClauseContext) {
+	if l.fieldUsageStmt != nil {
+		l.fieldUsageStmt.visitSinceClause(ctx)
+	}
+}
+
 // EnterNamespace is called when production namespace is entered.
 func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 	switch {
@@ -275,6 +453,10 @@ func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 		l.queryStmt.visitNamespace(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitNamespace(ctx)
+	case l.deleteSeriesStmt != nil:
+		l.deleteSeriesStmt.visitNamespace(ctx)
+	case l.fieldUsageStmt != nil:
+		l.fieldUsageStmt.visitNamespace(ctx)
 	}
 }
 
@@ -292,6 +474,20 @@ func (l *listener) EnterPrefix(ctx *grammar.PrefixContext) {
 	}
 }
 
+// EnterFuzzyClause is called when production fuzzyClause is entered.
+func (l *listener) EnterFuzzyClause(_ *grammar.FuzzyClauseContext) {
+	if l.metricMetadataStmt != nil {
+		l.metricMetadataStmt.visitFuzzyClause()
+	}
+}
+
+// EnterAtTimestampClause is called when production atTimestampClause is entered.
+func (l *listener) EnterAtTimestampClause(ctx *grammar.AtTimestampClauseContext) {
+	if l.metricMetadataStmt != nil {
+		l.metricMetadataStmt.visitAtTimestampClause(ctx)
+	}
+}
+
 // EnterMetricName is called when production metricName is entered.
 func (l *listener) EnterMetricName(ctx *grammar.MetricNameContext) {
 	switch {
@@ -299,6 +495,10 @@ func (l *listener) EnterMetricName(ctx *grammar.MetricNameContext) {
 		l.queryStmt.visitMetricName(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitMetricName(ctx)
+	case l.deleteSeriesStmt != nil:
+		l.deleteSeriesStmt.visitMetricName(ctx)
+	case l.fieldUsageStmt != nil:
+		l.fieldUsageStmt.visitMetricName(ctx)
 	}
 }
 
@@ -375,6 +575,8 @@ func (l *listener) EnterTagFilterExpr(ctx *grammar.TagFilterExprContext) {
 		l.queryStmt.visitTagFilterExpr(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitTagFilterExpr(ctx)
+	case l.deleteSeriesStmt != nil:
+		l.deleteSeriesStmt.visitTagFilterExpr(ctx)
 	}
 }
 
@@ -385,6 +587,8 @@ func (l *listener) ExitTagFilterExpr(_ *grammar.TagFilterExprContext) {
 		l.queryStmt.completeTagFilterExpr()
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.completeTagFilterExpr()
+	case l.deleteSeriesStmt != nil:
+		l.deleteSeriesStmt.completeTagFilterExpr()
 	}
 }
 
@@ -395,6 +599,34 @@ func (l *listener) EnterTagValue(ctx *grammar.TagValueContext) {
 		l.queryStmt.visitTagValue(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitTagValue(ctx)
+	case l.deleteSeriesStmt != nil:
+		l.deleteSeriesStmt.visitTagValue(ctx)
+	}
+}
+
+// EnterSubQuery is called when production subQuery is entered,
+// keeps the parsers of outer statement, sub query is parsed by new parsers.
+func (l *listener) EnterSubQuery(_ *grammar.SubQueryContext) {
+	parents := append(l.parents, *l)
+	*l = listener{parents: parents}
+}
+
+// ExitSubQuery is called when production subQuery is exited,
+// restores the parsers of outer statement, then sets the sub query to in expression of outer statement.
+func (l *listener) ExitSubQuery(_ *grammar.SubQueryContext) {
+	subQuery, err := l.statement()
+	*l = l.parents[len(l.parents)-1]
+	if err == nil && l.queryStmt == nil {
+		// sub query is resolved by broker before query dispatched, only supports select statement
+		err = errors.New("sub query of in expression only supports select statement")
+	}
+	switch {
+	case l.queryStmt != nil:
+		l.queryStmt.visitSubQuery(subQuery, err)
+	case l.metricMetadataStmt != nil:
+		l.metricMetadataStmt.visitSubQuery(subQuery, err)
+	case l.deleteSeriesStmt != nil:
+		l.deleteSeriesStmt.visitSubQuery(subQuery, err)
 	}
 }
 
@@ -412,6 +644,48 @@ func (l *listener) EnterGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// EnterHavingClause is called when production havingClause is entered.
+func (l *listener) EnterHavingClause(ctx *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitHavingClause(ctx)
+	}
+}
+
+// ExitHavingClause is called when production havingClause is exited.
+func (l *listener) ExitHavingClause(ctx *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeHavingClause(ctx)
+	}
+}
+
+// EnterBoolExpr is called when production boolExpr is entered.
+func (l *listener) EnterBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBoolExpr(ctx)
+	}
+}
+
+// ExitBoolExpr is called when production boolExpr is exited.
+func (l *listener) ExitBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBoolExpr(ctx)
+	}
+}
+
+// EnterBinaryExpr is called when production binaryExpr is entered.
+func (l *listener) EnterBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBinaryExpr(ctx)
+	}
+}
+
+// ExitBinaryExpr is called when production binaryExpr is exited.
+func (l *listener) ExitBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBinaryExpr(ctx)
+	}
+}
+
 // EnterSortField is called when production sortField is entered.
 func (l *listener) EnterSortField(ctx *grammar.SortFieldContext) {
 	if l.queryStmt != nil {
@@ -426,6 +700,11 @@ func (l *listener) ExitSortField(ctx *grammar.SortFieldContext) {
 	}
 }
 
+// EnterFormatClause is called when production formatClause is entered.
+func (l *listener) EnterFormatClause(ctx *grammar.FormatClauseContext) {
+	l.format = strings.ToLower(strutil.GetStringValue(ctx.Ident().GetText()))
+}
+
 // statement returns query statement, if failure return error
 func (l *listener) statement() (stmt.Statement, error) {
 	switch {
@@ -449,6 +728,18 @@ func (l *listener) statement() (stmt.Statement, error) {
 		return l.brokerStmt.build()
 	case l.limitStmt != nil:
 		return l.limitStmt.build()
+	case l.logLevelStmt != nil:
+		return l.logLevelStmt.build()
+	case l.placementStmt != nil:
+		return l.placementStmt.build()
+	case l.readOnlyStmt != nil:
+		return l.readOnlyStmt.build()
+	case l.fieldUsageStmt != nil:
+		return l.fieldUsageStmt.build()
+	case l.compactionStmt != nil:
+		return l.compactionStmt.build()
+	case l.deleteSeriesStmt != nil:
+		return l.deleteSeriesStmt.build()
 	default:
 		return nil, nil
 	}
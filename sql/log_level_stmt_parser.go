@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// logLevelStmtParser represents log level statement parser.
+type logLevelStmtParser struct {
+	op     stmt.LogLevelOpType
+	levels map[string]string
+	err    error
+}
+
+// newLogLevelStmtParse creates a log level statement parser.
+func newLogLevelStmtParse(op stmt.LogLevelOpType) *logLevelStmtParser {
+	return &logLevelStmtParser{op: op}
+}
+
+// visitLogLevel visits the level of logger module, module/level can be quoted or bare word.
+func (s *logLevelStmtParser) visitLogLevel(ctx *grammar.LogLevelContext) {
+	module := strutil.GetStringValue(ctx.Ident(0).GetText())
+	level := strutil.GetStringValue(ctx.Ident(1).GetText())
+	if module == "" || level == "" {
+		s.err = errors.New("module and level of log level cannot be empty")
+		return
+	}
+	if s.levels == nil {
+		s.levels = make(map[string]string)
+	}
+	s.levels[module] = level
+}
+
+// build returns the log level statement.
+func (s *logLevelStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &stmt.LogLevel{Type: s.op, Levels: s.levels}, nil
+}
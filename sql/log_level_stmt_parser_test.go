@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestLogLevelStmt_Parse(t *testing.T) {
+	q, err := Parse("show log levels")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.LogLevel{Type: stmt.ShowLogLevels}, q)
+	q, err = Parse("SET LOG LEVEL 'Replica'='debug'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.LogLevel{Type: stmt.SetLogLevel, Levels: map[string]string{"Replica": "debug"}}, q)
+	q, err = Parse("set log level 'Replica' = 'debug', TSDB=info , '*'='warn'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.LogLevel{
+		Type:   stmt.SetLogLevel,
+		Levels: map[string]string{"Replica": "debug", "TSDB": "info", "*": "warn"},
+	}, q)
+}
+
+func TestLogLevelStmt_Parse_Fail(t *testing.T) {
+	for _, sql := range []string{
+		"show log levels db",
+		"set log level",
+		"set log level 'Replica'",
+		"set log level 'Replica'=",
+		"set log level 'Replica'='debug',",
+		"set log level 'Replica'='debug' 'TSDB'='info'",
+		"set log level 'Replica='debug'",
+		"set log level ''='debug'",
+		"set log level 'Replica'=''",
+		"set log level =debug",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -32,6 +33,8 @@ type metricMetadataStmtParser struct {
 	metadataType stmt.MetricMetadataType
 	tagKey       string
 	prefix       string
+	fuzzy        bool
+	timestamp    int64
 }
 
 // newMetricMetadataStmtParser creates a new metric metadata statement parser
@@ -59,8 +62,10 @@ func (s *metricMetadataStmtParser) build() (stmt.Statement, error) {
 		Type:       s.metadataType,
 		TagKey:     s.tagKey,
 		Prefix:     s.prefix,
+		Fuzzy:      s.fuzzy,
 		Condition:  s.condition,
 		Limit:      s.limit,
+		Timestamp:  s.timestamp,
 	}, nil
 }
 
@@ -69,6 +74,21 @@ func (s *metricMetadataStmtParser) visitPrefix(ctx *grammar.PrefixContext) {
 	s.prefix = strutil.GetStringValue(ctx.Ident().GetText())
 }
 
+// visitFuzzyClause visits when production fuzzy clause expression is entered
+func (s *metricMetadataStmtParser) visitFuzzyClause() {
+	s.fuzzy = true
+}
+
+// visitAtTimestampClause visits when production at timestamp clause expression is entered
+func (s *metricMetadataStmtParser) visitAtTimestampClause(ctx *grammar.AtTimestampClauseContext) {
+	timestamp, err := timeutil.ParseTimestamp(strutil.GetStringValue(ctx.Ident().GetText()))
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.timestamp = timestamp
+}
+
 // visitWithTagKey visits when production with tag key expression is entered
 func (s *metricMetadataStmtParser) visitWithTagKey(ctx *grammar.WithTagKeyContext) {
 	s.tagKey = strutil.GetStringValue(ctx.Ident().GetText())
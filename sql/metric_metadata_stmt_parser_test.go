@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -106,3 +107,56 @@ func TestMetaStmt_ShowTagValues(t *testing.T) {
 			Right:    &stmt.EqualsExpr{Key: "key2", Value: "value2"},
 		}, *expr)
 }
+
+func TestMetaStmt_Fuzzy(t *testing.T) {
+	q, err := Parse("show metrics on 'ns' fuzzy 'cpu' limit 10")
+	assert.NoError(t, err)
+	query := q.(*stmt.MetricMetadata)
+	assert.True(t, query.Fuzzy)
+	assert.Equal(t, "cpu", query.Prefix)
+	assert.Equal(t, "ns", query.Namespace)
+	assert.Equal(t, 10, query.Limit)
+
+	q, err = Parse("SHOW METRICS where metric='load' FUZZY")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.True(t, query.Fuzzy)
+	assert.Equal(t, "load", query.Prefix)
+
+	q, err = Parse("show tag values from 'cpu' with key=host fuzzy 'web'")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.True(t, query.Fuzzy)
+	assert.Equal(t, stmt.TagValue, query.Type)
+	assert.Equal(t, "web", query.Prefix)
+
+	q, err = Parse("show metrics where metric='fuzzy'")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.False(t, query.Fuzzy)
+	assert.Equal(t, "fuzzy", query.Prefix)
+}
+
+func TestMetaStmt_AtTimestamp(t *testing.T) {
+	timestamp, _ := timeutil.ParseTimestamp("2023-01-01 10:00:00")
+	q, err := Parse("show fields from cpu at timestamp '2023-01-01 10:00:00'")
+	assert.NoError(t, err)
+	query := q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.Field, query.Type)
+	assert.Equal(t, "cpu", query.MetricName)
+	assert.Equal(t, timestamp, query.Timestamp)
+
+	q, err = Parse("SHOW TAG KEYS FROM 'cpu' AT TIMESTAMP '2023-01-01 10:00:00'")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.TagKey, query.Type)
+	assert.Equal(t, timestamp, query.Timestamp)
+
+	q, err = Parse("show fields from cpu")
+	assert.NoError(t, err)
+	assert.Zero(t, q.(*stmt.MetricMetadata).Timestamp)
+
+	q, err = Parse("show fields from cpu at timestamp 'abc'")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+}
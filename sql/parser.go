@@ -42,6 +42,13 @@ var walker = antlr.ParseTreeWalkerDefault
 
 // Parse parses sql using the grammar of LinDB query language
 func Parse(sql string) (stmt stmtpkg.Statement, err error) {
+	stmt, _, err = ParseWithFormat(sql)
+	return stmt, err
+}
+
+// ParseWithFormat parses sql like Parse, also returns the output format name(lower case) of
+// format clause(FORMAT TABLE/WIDE/VERTICAL/CSV/JSONL) at the end of statement, empty if not set.
+func ParseWithFormat(sql string) (stmt stmtpkg.Statement, format string, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			switch x := r.(type) {
@@ -54,6 +61,7 @@ func Parse(sql string) (stmt stmtpkg.Statement, err error) {
 			}
 			log.Error("parse sql", logger.String("sql", sql), logger.Error(err), logger.Stack())
 			stmt = nil
+			format = ""
 		}
 	}()
 
@@ -76,7 +84,43 @@ func Parse(sql string) (stmt stmtpkg.Statement, err error) {
 	walker.Walk(&sqlListener, ctx)
 
 	stmt, err = sqlListener.statement()
-	return stmt, err
+	if err != nil {
+		return nil, "", err
+	}
+	return stmt, sqlListener.format, nil
+}
+
+// SplitStatements splits multiple statements separated by semicolon into statement list,
+// semicolons in quotes are not separators, blank statements are ignored.
+func SplitStatements(sql string) (statements []string) {
+	sql = strings.ReplaceAll(sql, `\"`, `"`)
+	var quote rune
+	escaped := false
+	start := 0
+	appendStatement := func(end int) {
+		if statement := strings.TrimSpace(sql[start:end]); statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+	for idx, c := range sql {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if c == '\\' {
+				escaped = true
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ';':
+			appendStatement(idx)
+			start = idx + 1
+		}
+	}
+	appendStatement(len(sql))
+	return statements
 }
 
 var (
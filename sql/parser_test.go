@@ -148,3 +148,65 @@ func TestShowStorageMetric(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, &stmt.State{Type: stmt.StorageMetric, StorageName: "s", MetricNames: []string{"a", "b"}}, query)
 }
+
+func TestSplitStatements(t *testing.T) {
+	cases := []struct {
+		sql        string
+		statements []string
+	}{
+		{sql: "", statements: nil},
+		{sql: " ; ;", statements: nil},
+		{sql: "show master", statements: []string{"show master"}},
+		{sql: "show master;", statements: []string{"show master"}},
+		{
+			sql:        "use db; set limit 'a=1';\n select f from cpu",
+			statements: []string{"use db", "set limit 'a=1'", "select f from cpu"},
+		},
+		{
+			sql:        `select f from cpu where host='a;b' and ip="c;d"; show databases`,
+			statements: []string{`select f from cpu where host='a;b' and ip="c;d"`, "show databases"},
+		},
+		{
+			sql:        `select f from cpu where host='a\';b'; show databases`,
+			statements: []string{`select f from cpu where host='a\';b'`, "show databases"},
+		},
+		{
+			sql:        `select f from cpu where host=\"a;b\"; show databases`,
+			statements: []string{`select f from cpu where host="a;b"`, "show databases"},
+		},
+		{
+			sql:        "select f from cpu where host='a;b",
+			statements: []string{"select f from cpu where host='a;b"},
+		},
+	}
+	for _, tt := range cases {
+		assert.Equal(t, tt.statements, SplitStatements(tt.sql), tt.sql)
+	}
+}
+
+func TestParseWithFormat(t *testing.T) {
+	cases := []struct {
+		sql    string
+		format string
+	}{
+		{sql: "select f from cpu"},
+		{sql: "select format from cpu"},
+		{sql: "select f from cpu format csv", format: "csv"},
+		{sql: "select f from cpu group by host\nFORMAT Vertical ", format: "vertical"},
+		{sql: "select f from cpu where host='a format jsonl'"},
+		{sql: "show master format jsonl", format: "jsonl"},
+		{sql: "select f from cpu format xml", format: "xml"},
+	}
+	for _, tt := range cases {
+		q, format, err := ParseWithFormat(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.NotNil(t, q, tt.sql)
+		assert.Equal(t, tt.format, format, tt.sql)
+	}
+	q, format, err := ParseWithFormat("select f from cpu format")
+	assert.Error(t, err)
+	assert.Nil(t, q)
+	assert.Empty(t, format)
+	_, _, err = ParseWithFormat("format csv")
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// placementStmtParser represents replica placement statement parser.
+type placementStmtParser struct {
+	placement *stmt.Placement
+}
+
+// newPlacementStmtParse creates a replica placement statement parser.
+func newPlacementStmtParse(op stmt.PlacementOpType) *placementStmtParser {
+	return &placementStmtParser{
+		placement: &stmt.Placement{Type: op},
+	}
+}
+
+// visitDatabaseName visits database name.
+func (s *placementStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.placement.Database = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// build returns the placement statement.
+func (s *placementStmtParser) build() (stmt.Statement, error) {
+	return s.placement, nil
+}
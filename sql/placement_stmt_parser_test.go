@@ -0,0 +1,53 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestPlacementStmt_Parse(t *testing.T) {
+	q, err := Parse("show placement from db")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Placement{Type: stmt.ShowPlacement, Database: "db"}, q)
+	q, err = Parse("REPAIR PLACEMENT FROM 'db'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Placement{Type: stmt.RepairPlacement, Database: "db"}, q)
+	q, err = Parse("show placement suggestions from db")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Placement{Type: stmt.ShowPlacementSuggestions, Database: "db"}, q)
+}
+
+func TestPlacementStmt_Parse_Fail(t *testing.T) {
+	for _, sql := range []string{
+		"show placement",
+		"show placement db",
+		"repair placement from",
+		"repair placement from db shard",
+		"repair placement suggestions from db",
+		"show placement suggestions db",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	commonconstants "github.com/lindb/common/constants"
 
@@ -45,19 +46,27 @@ type queryStmtParser struct {
 	endTime   int64
 
 	groupBy         []string
+	groupByAll      bool
 	interval        int64
 	autoGroupByTime bool
 	orderBy         []stmt.Expr
+	having          stmt.Expr
 
 	curOrderByExpr *stmt.OrderByExpr
 	hasOrderBy     bool
+	hasHaving      bool
+
+	// qualified field identifiers(unquoted ident with dot, like: cpu.used) => parts of identifier,
+	// qualifier is resolved against the metrics of from clause when building query.
+	qualifiedFields map[*stmt.FieldExpr][]string
 }
 
 // newQueryStmtParse create a query statement parser
 func newQueryStmtParse(explain bool) *queryStmtParser {
 	return &queryStmtParser{
-		explain:    explain,
-		fieldNames: make(map[string]struct{}),
+		explain:         explain,
+		fieldNames:      make(map[string]struct{}),
+		qualifiedFields: make(map[*stmt.FieldExpr][]string),
 		baseStmtParser: baseStmtParser{
 			exprStack: collections.NewStack(),
 			namespace: commonconstants.DefaultNamespace,
@@ -72,6 +81,21 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 		return nil, err
 	}
 
+	// bind metric qualifier for field expr, like: cpu.used => used
+	for _, item := range q.selectItems {
+		if err := q.bindMetricQualifier(item); err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range q.orderBy {
+		if err := q.bindMetricQualifier(item); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.bindMetricQualifier(q.having); err != nil {
+		return nil, err
+	}
+
 	query := &stmt.Query{}
 	query.Explain = q.explain
 	query.Namespace = q.namespace
@@ -95,6 +119,8 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	query.AutoGroupByTime = q.autoGroupByTime
 	query.AllFields = q.allFields
 	query.GroupBy = q.groupBy
+	query.GroupByAll = q.groupByAll
+	query.Having = q.having
 	query.OrderByItems = q.orderBy
 	query.Limit = q.limit
 	return query, nil
@@ -108,12 +134,89 @@ func (q *queryStmtParser) validation() error {
 	if q.metricName == "" {
 		return fmt.Errorf("metric name cannot be empty")
 	}
+	if query := (&stmt.Query{MetricName: q.metricName}); query.IsMetricNamePattern() {
+		// from 'cpu.*', matches multiple metrics
+		if _, err := query.MetricNameRegexp(); err != nil {
+			return fmt.Errorf("invalid metric name pattern: %w", err)
+		}
+	}
 	if !q.allFields && len(q.selectItems) == 0 {
 		return fmt.Errorf("select fields cannbe be empty")
 	}
+	return q.checkAliases()
+}
+
+// checkAliases checks if the alias of select item conflicts with other column of result set.
+func (q *queryStmtParser) checkAliases() error {
+	columns := make(map[string]int)
+	for _, item := range q.selectItems {
+		if selectItem, ok := item.(*stmt.SelectItem); ok {
+			columns[selectItem.ColumnName()]++
+		}
+	}
+	for _, item := range q.selectItems {
+		if selectItem, ok := item.(*stmt.SelectItem); ok && selectItem.Alias != "" && columns[selectItem.Alias] > 1 {
+			return fmt.Errorf("duplicate alias in select fields, alias: %s", selectItem.Alias)
+		}
+	}
 	return nil
 }
 
+// bindMetricQualifier resolves the metric qualifier of qualified field identifier against the metrics of from clause,
+// then strips the qualifier. Returns error if qualifier doesn't match any metric,
+// dotted field name must be quoted, like: 'disk.used'.
+func (q *queryStmtParser) bindMetricQualifier(expr stmt.Expr) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return q.bindMetricQualifier(e.Expr)
+	case *stmt.OrderByExpr:
+		return q.bindMetricQualifier(e.Expr)
+	case *stmt.CallExpr:
+		if e.FuncType == function.CountDistinct {
+			// param is tag key, not field
+			return nil
+		}
+		for _, param := range e.Params {
+			if err := q.bindMetricQualifier(param); err != nil {
+				return err
+			}
+		}
+	case *stmt.ParenExpr:
+		return q.bindMetricQualifier(e.Expr)
+	case *stmt.BinaryExpr:
+		if err := q.bindMetricQualifier(e.Left); err != nil {
+			return err
+		}
+		return q.bindMetricQualifier(e.Right)
+	case *stmt.FieldExpr:
+		parts, ok := q.qualifiedFields[e]
+		if !ok {
+			return nil
+		}
+		fieldName, ok := resolveQualifiedField(parts, []string{q.metricName})
+		if !ok {
+			return fmt.Errorf("metric qualifier of field not match metric of from clause, field: %s, metric: %s",
+				e.Name, q.metricName)
+		}
+		e.Name = fieldName
+	}
+	return nil
+}
+
+// resolveQualifiedField resolves the qualifier(metric name maybe include dot) of qualified field identifier
+// against metrics, returns the field name without qualifier.
+func resolveQualifiedField(parts []string, metrics []string) (string, bool) {
+	for i := len(parts) - 1; i > 0; i-- {
+		qualifier := strings.Join(parts[:i], ".")
+		for _, metricName := range metrics {
+			if qualifier == metricName {
+				return strings.Join(parts[i:], "."), true
+			}
+		}
+	}
+	return "", false
+}
+
 // resetExprStack resets expr stack for next parse fragment.
 func (q *queryStmtParser) resetExprStack() {
 	q.exprStack = collections.NewStack()
@@ -123,8 +226,10 @@ func (q *queryStmtParser) resetExprStack() {
 func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	switch {
 	case ctx.Ident() != nil:
-		tagKey := strutil.GetStringValue(ctx.Ident().GetText())
-		q.groupBy = append(q.groupBy, tagKey)
+		q.groupBy = append(q.groupBy, strutil.GetStringValue(ctx.Ident().GetText()))
+	case ctx.T_MUL() != nil:
+		// group by all tag keys of metric, resolved from metric's schema under storage
+		q.groupByAll = true
 	case ctx.DurationLit() != nil:
 		// set group by time interval
 		q.interval = q.parseDuration(ctx.DurationLit())
@@ -136,6 +241,86 @@ func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// visitHavingClause visits when production having clause is entered.
+func (q *queryStmtParser) visitHavingClause(_ *grammar.HavingClauseContext) {
+	q.hasHaving = true
+	q.resetExprStack()
+}
+
+// completeHavingClause completes parse having clause.
+func (q *queryStmtParser) completeHavingClause(_ *grammar.HavingClauseContext) {
+	q.hasHaving = false
+}
+
+// visitBoolExpr visits when production bool expression is entered.
+func (q *queryStmtParser) visitBoolExpr(ctx *grammar.BoolExprContext) {
+	switch {
+	case ctx.T_OPEN_P() != nil:
+		q.exprStack.Push(&stmt.ParenExpr{})
+	case ctx.BoolExprLogicalOp() != nil:
+		logicalOp, ok := ctx.BoolExprLogicalOp().(*grammar.BoolExprLogicalOpContext)
+		if !ok {
+			return
+		}
+		if logicalOp.T_OR() != nil {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.OR})
+		} else {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.AND})
+		}
+	}
+}
+
+// completeBoolExpr completes a bool expression(paren/logical) for having clause.
+func (q *queryStmtParser) completeBoolExpr(ctx *grammar.BoolExprContext) {
+	if ctx.T_OPEN_P() != nil || ctx.BoolExprLogicalOp() != nil {
+		q.completeHavingExpr()
+	}
+}
+
+// visitBinaryExpr visits when production binary(compare) expression is entered.
+func (q *queryStmtParser) visitBinaryExpr(ctx *grammar.BinaryExprContext) {
+	op := stmt.UNKNOWN
+	if binaryOpCtx, ok := ctx.BinaryOperator().(*grammar.BinaryOperatorContext); ok {
+		switch {
+		case binaryOpCtx.T_EQUAL() != nil:
+			op = stmt.EQUAL
+		case binaryOpCtx.T_NOTEQUAL() != nil || binaryOpCtx.T_NOTEQUAL2() != nil:
+			op = stmt.NOTEQUAL
+		case binaryOpCtx.T_LESS() != nil:
+			op = stmt.LESS
+		case binaryOpCtx.T_LESSEQUAL() != nil:
+			op = stmt.LESSEQUAL
+		case binaryOpCtx.T_GREATER() != nil:
+			op = stmt.GREATER
+		case binaryOpCtx.T_GREATEREQUAL() != nil:
+			op = stmt.GREATEREQUAL
+		}
+	}
+	if op == stmt.UNKNOWN {
+		q.err = fmt.Errorf("having clause not support operator: %s", ctx.BinaryOperator().GetText())
+	}
+	q.exprStack.Push(&stmt.BinaryExpr{Operator: op})
+}
+
+// completeBinaryExpr completes a binary(compare) expression for having clause.
+func (q *queryStmtParser) completeBinaryExpr(_ *grammar.BinaryExprContext) {
+	q.completeHavingExpr()
+}
+
+// completeHavingExpr pops the current having expression, then sets it as parent's param or having condition.
+func (q *queryStmtParser) completeHavingExpr() {
+	cur := q.exprStack.Pop()
+	expr, ok := cur.(stmt.Expr)
+	if !ok {
+		return
+	}
+	if q.exprStack.Empty() {
+		q.having = expr
+		return
+	}
+	q.setExprParam(expr)
+}
+
 // visitSortField visits when production sort field expression is entered.
 func (q *queryStmtParser) visitSortField(ctx *grammar.SortFieldContext) {
 	q.hasOrderBy = true
@@ -191,11 +376,7 @@ func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext)
 		case timeExprCtx.Ident() != nil:
 			timestamp, err = timeutil.ParseTimestamp(strutil.GetStringValue(timeExprCtx.Ident().GetText()))
 		case timeExprCtx.NowExpr() != nil:
-			timestamp = timeutil.Now()
-			durationExpr, durationExist := timeExprCtx.NowExpr().(*grammar.NowExprContext)
-			if durationExist {
-				timestamp += q.parseDuration(durationExpr.DurationLit())
-			}
+			timestamp, err = q.parseNowExpr(timeExprCtx.NowExpr())
 		}
 		if err != nil {
 			q.err = err
@@ -218,46 +399,22 @@ func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext)
 	}
 }
 
-// parseDuration parses time duration from duration string
-func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
-	if ctx == nil {
-		return 0
-	}
-	durationCtx, ok := ctx.(*grammar.DurationLitContext)
-	if !ok {
-		return 0
-	}
-
-	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
-	if err != nil {
-		q.err = err
-		return 0
-	}
-	var result int64
-	if durationCtx.IntervalItem() == nil {
-		return result
-	}
-	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
-	if !ok {
-		return result
+// parseNowExpr parses timestamp from now expression with duration offsets and truncation, like now()-1d+2h, now()/1h.
+func (q *queryStmtParser) parseNowExpr(ctx grammar.INowExprContext) (int64, error) {
+	timestamp := timeutil.Now()
+	for _, child := range ctx.GetChildren() {
+		switch expr := child.(type) {
+		case *grammar.DurationLitContext:
+			timestamp += q.parseDuration(expr)
+		case *grammar.TruncateDurationContext:
+			interval := q.parseDuration(expr.DurationLit())
+			if interval <= 0 {
+				return 0, fmt.Errorf("invalid truncation interval of time expression: %s", ctx.GetText())
+			}
+			timestamp = timeutil.Truncate(timestamp, interval)
+		}
 	}
-	switch {
-	case unit.T_SECOND() != nil:
-		result = duration * timeutil.OneSecond
-	case unit.T_MINUTE() != nil:
-		result = duration * timeutil.OneMinute
-	case unit.T_HOUR() != nil:
-		result = duration * timeutil.OneHour
-	case unit.T_DAY() != nil:
-		result = duration * timeutil.OneDay
-	case unit.T_WEEK() != nil:
-		result = duration * timeutil.OneWeek
-	case unit.T_MONTH() != nil:
-		result = duration * timeutil.OneMonth
-	case unit.T_YEAR() != nil:
-		result = duration * timeutil.OneYear
-	}
-	return result
+	return timestamp, nil
 }
 
 // visitFieldExpr visits when production field expression is entered
@@ -310,6 +467,8 @@ func (q *queryStmtParser) visitFuncName(ctx *grammar.FuncNameContext) {
 		callExpr.FuncType = function.Max
 	case ctx.T_COUNT() != nil:
 		callExpr.FuncType = function.Count
+	case ctx.T_COUNT_DISTINCT() != nil:
+		callExpr.FuncType = function.CountDistinct
 	case ctx.T_LAST() != nil:
 		callExpr.FuncType = function.Last
 	case ctx.T_FIRST() != nil:
@@ -331,6 +490,7 @@ func (q *queryStmtParser) completeFuncExpr() {
 	if cur != nil {
 		expr, ok := cur.(stmt.Expr)
 		if ok {
+			q.checkCountDistinct(expr)
 			q.setExprParam(expr)
 		}
 		if q.exprStack.Empty() {
@@ -346,11 +506,29 @@ func (q *queryStmtParser) completeFuncExpr() {
 	}
 }
 
+// checkCountDistinct checks if count distinct function only has one tag key param.
+func (q *queryStmtParser) checkCountDistinct(expr stmt.Expr) {
+	callExpr, ok := expr.(*stmt.CallExpr)
+	if !ok || callExpr.FuncType != function.CountDistinct {
+		return
+	}
+	if len(callExpr.Params) == 1 {
+		if _, ok := callExpr.Params[0].(*stmt.FieldExpr); ok {
+			return
+		}
+	}
+	q.err = fmt.Errorf("count_distinct function only support one tag key param, but got: %s", callExpr.Rewrite())
+}
+
 // visitExprAtom visits when production atom expr expression is entered
 func (q *queryStmtParser) visitExprAtom(ctx *grammar.ExprAtomContext) {
 	switch {
 	case ctx.Ident() != nil: // field
-		q.parseFieldName(strutil.GetStringValue(ctx.Ident().GetText()))
+		rawName := ctx.Ident().GetText()
+		fieldExpr := q.parseFieldName(strutil.GetStringValue(rawName))
+		if fieldExpr != nil && isQualifiedIdent(rawName) {
+			q.qualifiedFields[fieldExpr] = strings.Split(rawName, ".")
+		}
 	case ctx.DecNumber() != nil || ctx.IntNumber() != nil:
 		valStr := ""
 		switch {
@@ -368,11 +546,25 @@ func (q *queryStmtParser) visitExprAtom(ctx *grammar.ExprAtomContext) {
 	}
 }
 
-// parseFieldName parses field name for select/order by expr.
-func (q *queryStmtParser) parseFieldName(fieldName string) {
+// isQualifiedIdent checks if the raw identifier is qualified identifier(unquoted identifier with dot).
+func isQualifiedIdent(rawName string) bool {
+	if rawName == "" || strings.ContainsAny(rawName[:1], "'\"`") {
+		return false
+	}
+	return strings.Contains(rawName, ".")
+}
+
+// parseFieldName parses field name for select/order by expr, returns the field expr, nil if failure.
+func (q *queryStmtParser) parseFieldName(fieldName string) *stmt.FieldExpr {
 	fieldExpr := &stmt.FieldExpr{Name: fieldName}
 
 	switch {
+	case q.hasHaving: // handle having item
+		if _, ok := q.fieldNames[fieldName]; !ok {
+			q.err = fmt.Errorf("having field not in select fields, having field: %s", fieldName)
+			return nil
+		}
+		q.setExprParam(fieldExpr)
 	case q.hasOrderBy: // handle order by item
 		if q.exprStack.Empty() {
 			q.curOrderByExpr.Expr = fieldExpr
@@ -387,6 +579,7 @@ func (q *queryStmtParser) parseFieldName(fieldName string) {
 		}
 		q.fieldNames[fieldName] = struct{}{}
 	}
+	return fieldExpr
 }
 
 // completeFieldExpr completes a field expr,
@@ -95,6 +95,22 @@ func TestMetricName(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestMetricNamePattern(t *testing.T) {
+	q, err := Parse("select f from 'cpu.*' group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "cpu.*", query.MetricName)
+	assert.True(t, query.IsMetricNamePattern())
+	q, err = Parse("select f from 'cpu.(user|sys)' on 'ns'")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "cpu.(user|sys)", query.MetricName)
+	assert.Equal(t, "ns", query.Namespace)
+	assert.True(t, query.IsMetricNamePattern())
+	_, err = Parse("select f from 'cpu.(*'")
+	assert.Error(t, err)
+}
+
 func TestSingleSelectItem(t *testing.T) {
 	sql := "select f from memory"
 	q, err := Parse(sql)
@@ -174,6 +190,39 @@ func TestSelectFuncItem(t *testing.T) {
 	}, *selectItem)
 }
 
+func TestCountDistinct(t *testing.T) {
+	q, err := Parse("select count_distinct(host) as hosts,count(f) from memory group by ip")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, 2, len(query.SelectItems))
+	assert.Equal(t, stmt.SelectItem{
+		Expr:  &stmt.CallExpr{FuncType: function.CountDistinct, Params: []stmt.Expr{&stmt.FieldExpr{Name: "host"}}},
+		Alias: "hosts",
+	}, *(query.SelectItems[0]).(*stmt.SelectItem))
+	assert.Equal(t, stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Count, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+	}, *(query.SelectItems[1]).(*stmt.SelectItem))
+	// count_distinct as field name
+	q, err = Parse("select count_distinct from memory")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "count_distinct"}}, *(query.SelectItems[0]).(*stmt.SelectItem))
+	// count_distinct as tag key
+	q, err = Parse("select f from memory where count_distinct='a' group by count_distinct")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, &stmt.EqualsExpr{Key: "count_distinct", Value: "a"}, query.Condition)
+	assert.Equal(t, []string{"count_distinct"}, query.GroupBy)
+	q, err = Parse("select count_distinct (host) from memory")
+	assert.NoError(t, err)
+	assert.Equal(t, "count_distinct(host)", q.(*stmt.Query).SelectItems[0].Rewrite())
+	// param not tag key
+	_, err = Parse("select count_distinct(host,ip) from memory")
+	assert.Error(t, err)
+	_, err = Parse("select count_distinct(sum(f)) from memory")
+	assert.Error(t, err)
+}
+
 func TestFieldExpression(t *testing.T) {
 	q, err := Parse("select f+100 from cpu")
 	query := q.(*stmt.Query)
@@ -333,6 +382,63 @@ func TestMathExpress(t *testing.T) {
 	assert.Equal(t, expr, query.SelectItems)
 }
 
+func TestMetricQualifierExpress(t *testing.T) {
+	sql := "select cpu.used*100/cpu.total as usage from cpu order by cpu.used"
+	q, err := Parse(sql)
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	expr := []stmt.Expr{
+		&stmt.SelectItem{
+			Expr: &stmt.BinaryExpr{
+				Left: &stmt.BinaryExpr{
+					Left:     &stmt.FieldExpr{Name: "used"},
+					Operator: stmt.MUL,
+					Right:    &stmt.NumberLiteral{Val: 100},
+				},
+				Operator: stmt.DIV,
+				Right:    &stmt.FieldExpr{Name: "total"},
+			},
+			Alias: "usage",
+		},
+	}
+	assert.Equal(t, expr, query.SelectItems)
+	assert.Equal(t, []stmt.Expr{&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "used"}}}, query.OrderByItems)
+
+	// qualifier not match metric of from clause
+	_, err = Parse("select cpu.used/mem.total from cpu")
+	assert.Error(t, err)
+	_, err = Parse("select used from cpu order by disk.used")
+	assert.Error(t, err)
+	_, err = Parse("select sum(memory.used) from cpu group by host having memory.used > 1")
+	assert.Error(t, err)
+	// quoted dotted field name
+	q, err = Parse("select sum('memory.used') from cpu group by host having 'memory.used' > 1")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "sum(memory.used)", query.SelectItems[0].Rewrite())
+	assert.Equal(t, "memory.used>1.00", query.Having.Rewrite())
+	// dotted metric name
+	q, err = Parse("select sum(system.cpu.used) from 'system.cpu'")
+	assert.NoError(t, err)
+	assert.Equal(t, "sum(used)", q.(*stmt.Query).SelectItems[0].Rewrite())
+	// dotted field name after qualifier
+	q, err = Parse("select cpu.used.total from cpu group by host having cpu.used.total > 1")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "used.total", query.SelectItems[0].Rewrite())
+	assert.Equal(t, "used.total>1.00", query.Having.Rewrite())
+	// quoted dotted field name is never qualified
+	q, err = Parse(`select 'cpu.used', 'disk.used' from cpu`)
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "cpu.used", query.SelectItems[0].Rewrite())
+	assert.Equal(t, "disk.used", query.SelectItems[1].Rewrite())
+	// tag key of count distinct is not qualified
+	q, err = Parse("select count_distinct(host.name) from cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, "count_distinct(host.name)", q.(*stmt.Query).SelectItems[0].Rewrite())
+}
+
 func TestLimit(t *testing.T) {
 	sql := "select f from cpu limit 10"
 	q, err := Parse(sql)
@@ -367,6 +473,44 @@ func TestTimeRange(t *testing.T) {
 	sql = "select f from cpu where time>'20190410 11:00:00' and time<'20190410 10:00:00'"
 	_, err = Parse(sql)
 	assert.Error(t, err)
+
+	// iso8601 with timezone offset
+	sql = "select f from cpu where time>='2019-04-10T00:00:00+08:00' and time<'2019-04-10T10:00:00Z'"
+	q, err = Parse(sql)
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, int64(1554825600000), query.TimeRange.Start)
+	assert.Equal(t, int64(1554890400000), query.TimeRange.End)
+}
+
+func TestTimeRange_NowExpr(t *testing.T) {
+	now := timeutil.Now()
+	q, err := Parse("select f from cpu where time>now()-1d+2h and time<now() - 1h")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.InDelta(t, now-timeutil.OneDay+2*timeutil.OneHour, query.TimeRange.Start, float64(timeutil.OneMinute))
+	assert.InDelta(t, now-timeutil.OneHour, query.TimeRange.End, float64(timeutil.OneMinute))
+
+	q, err = Parse("select f from cpu where time>NOW()/1h-2h and time<=now()/1h and host='a'")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, int64(0), query.TimeRange.Start%timeutil.OneHour)
+	assert.Equal(t, query.TimeRange.End-2*timeutil.OneHour, query.TimeRange.Start)
+	assert.Equal(t, "host=a", query.Condition.Rewrite())
+
+	q, err = Parse("select f from cpu where time>now()-1h group by host")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.InDelta(t, now-timeutil.OneHour, query.TimeRange.Start, float64(timeutil.OneMinute))
+	assert.Equal(t, []string{"host"}, query.GroupBy)
+
+	_, err = Parse("select f from cpu where time>now()/0h")
+	assert.Error(t, err)
+
+	for _, expr := range []string{"now()-", "now()-1", "now()-1x", "now()*1h", "now()-h", "now()/"} {
+		_, err = Parse("select f from cpu where time>" + expr)
+		assert.Error(t, err, expr)
+	}
 }
 
 func TestInterval(t *testing.T) {
@@ -426,6 +570,34 @@ func TestGroupBy(t *testing.T) {
 	assert.Equal(t, 2, len(query.GroupBy))
 	assert.Equal(t, "host", query.GroupBy[0])
 	assert.Equal(t, "/data", query.GroupBy[1])
+	assert.False(t, query.GroupByAll)
+}
+
+func TestGroupByAll(t *testing.T) {
+	q, err := Parse("select f from disk group by *")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.True(t, query.GroupByAll)
+	assert.Empty(t, query.GroupBy)
+	assert.True(t, query.HasGroupBy())
+	q, err = Parse("select f from disk where host='h1' group by *,time(1m) order by f desc")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.True(t, query.GroupByAll)
+	assert.Empty(t, query.GroupBy)
+	q, err = Parse("select count_distinct(host) from disk group by *")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.True(t, query.GroupByAll)
+	assert.Equal(t, []string{"host"}, query.DistinctTagKeys())
+	// '*' of select field is multiply
+	q, err = Parse("select f*2 from disk group by host")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.False(t, query.GroupByAll)
+	assert.Equal(t, []string{"host"}, query.GroupBy)
+	_, err = Parse("select f from disk group * by host")
+	assert.Error(t, err)
 }
 
 func TestEmptyCondition(t *testing.T) {
@@ -490,6 +662,37 @@ func TestRegexExpr(t *testing.T) {
 	assert.Equal(t, stmt.NotExpr{Expr: &stmt.RegexExpr{Key: "ip", Regexp: "/1.1.*.1/"}}, *notExpr)
 }
 
+func TestIsNullExpr(t *testing.T) {
+	sql := "select f from cpu where ip is null"
+	q, err := Parse(sql)
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	expr := query.Condition.(*stmt.IsNullExpr)
+	assert.Equal(t, stmt.IsNullExpr{Key: "ip"}, *expr)
+
+	// is not null
+	sql = "select f from cpu where (ip IS NOT NULL and path='/data') and time>now()-1h group by ip"
+	q, err = Parse(sql)
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+		Left:     &stmt.NotExpr{Expr: &stmt.IsNullExpr{Key: "ip"}},
+		Operator: stmt.AND,
+		Right:    &stmt.EqualsExpr{Key: "path", Value: "/data"},
+	}}, query.Condition)
+
+	// tag value null
+	sql = "select f from cpu where ip='null'"
+	q, err = Parse(sql)
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, &stmt.EqualsExpr{Key: "ip", Value: "null"}, query.Condition)
+
+	// is not followed by null
+	_, err = Parse("select f from cpu where ip is 'a'")
+	assert.Error(t, err)
+}
+
 func TestInExpr(t *testing.T) {
 	sql := "select f from cpu where ip in ('1.1.1.1','2.2.2.2')"
 	q, _ := Parse(sql)
@@ -673,3 +876,158 @@ func TestOrderBy(t *testing.T) {
 		})
 	}
 }
+
+func TestAlias(t *testing.T) {
+	cases := []struct {
+		name    string
+		sql     string
+		wantErr bool
+	}{
+		{
+			name: "alias",
+			sql:  "select sum(f) as total,g as gg,max(g) from cpu group by host having total > 1 order by total desc",
+		},
+		{
+			name:    "duplicate alias",
+			sql:     "select sum(f) as total,max(f) as total from cpu",
+			wantErr: true,
+		},
+		{
+			name:    "alias conflicts with field",
+			sql:     "select f,g as f from cpu",
+			wantErr: true,
+		},
+		{
+			name:    "alias conflicts with function",
+			sql:     "select sum(f),g as 'sum(f)' from cpu",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.sql)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHaving(t *testing.T) {
+	cases := []struct {
+		name    string
+		sql     string
+		having  stmt.Expr
+		wantErr bool
+	}{
+		{
+			name: "no having",
+			sql:  "select f from cpu group by host",
+		},
+		{
+			name:    "having field not in select list",
+			sql:     "select f from cpu group by host having g > 1",
+			wantErr: true,
+		},
+		{
+			name: "having with function",
+			sql:  "select f from cpu group by host having max(f) > 100",
+			having: &stmt.BinaryExpr{
+				Left:     &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+				Operator: stmt.GREATER,
+				Right:    &stmt.NumberLiteral{Val: 100},
+			},
+		},
+		{
+			name: "having with logic and paren",
+			sql:  "select f,g from cpu group by host having (f >= 1 or g != 2) and f*2 < 10",
+			having: &stmt.BinaryExpr{
+				Left: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+					Left: &stmt.BinaryExpr{
+						Left:     &stmt.FieldExpr{Name: "f"},
+						Operator: stmt.GREATEREQUAL,
+						Right:    &stmt.NumberLiteral{Val: 1},
+					},
+					Operator: stmt.OR,
+					Right: &stmt.BinaryExpr{
+						Left:     &stmt.FieldExpr{Name: "g"},
+						Operator: stmt.NOTEQUAL,
+						Right:    &stmt.NumberLiteral{Val: 2},
+					},
+				}},
+				Operator: stmt.AND,
+				Right: &stmt.BinaryExpr{
+					Left: &stmt.BinaryExpr{
+						Left:     &stmt.FieldExpr{Name: "f"},
+						Operator: stmt.MUL,
+						Right:    &stmt.NumberLiteral{Val: 2},
+					},
+					Operator: stmt.LESS,
+					Right:    &stmt.NumberLiteral{Val: 10},
+				},
+			},
+		},
+		{
+			name:    "having not support like",
+			sql:     "select f from cpu group by host having f like 10",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			assert.Equal(t, tt.having, query.Having)
+		})
+	}
+}
+
+func TestQueryStmt_InSubQuery(t *testing.T) {
+	q, err := Parse("select f from cpu where host in (show tag values from mem with key=host where ip='(a)' limit 10)" +
+		" and time>now()-1h")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "cpu", query.MetricName)
+	expr := query.Condition.(*stmt.InExpr)
+	assert.Equal(t, "host", expr.Key)
+	assert.Empty(t, expr.Values)
+	assert.Equal(t, &stmt.MetricMetadata{
+		Namespace:  commonconstants.DefaultNamespace,
+		MetricName: "mem",
+		Type:       stmt.TagValue,
+		TagKey:     "host",
+		Condition:  &stmt.EqualsExpr{Key: "ip", Value: "(a)"},
+		Limit:      10,
+	}, expr.SubQuery)
+
+	// not in sub query
+	q, err = Parse("select f from cpu where host not in (SHOW TAG VALUES from mem with key=host) or ip in ('a')")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	binary := query.Condition.(*stmt.BinaryExpr)
+	assert.NotNil(t, binary.Left.(*stmt.NotExpr).Expr.(*stmt.InExpr).SubQuery)
+	assert.Equal(t, &stmt.InExpr{Key: "ip", Values: []string{"a"}}, binary.Right)
+}
+
+func TestQueryStmt_InSubQuery_Fail(t *testing.T) {
+	cases := []string{
+		"select f from cpu where host in (show tag values from mem with key=host",
+		"select f from cpu where host in (show tag values from mem with key=)",
+		"select f from cpu where host in (show tag keys from mem)",
+		"show tag values from cpu with key=host where host in (show tag values from mem with key=host)",
+	}
+	for _, sql := range cases {
+		_, err := Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
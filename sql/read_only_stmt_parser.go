@@ -0,0 +1,72 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"strconv"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// readOnlyStmtParser represents read-only mode statement parser.
+type readOnlyStmtParser struct {
+	readOnly *stmt.ReadOnly
+	err      error
+}
+
+// newReadOnlyStmtParse creates a read-only mode statement parser.
+func newReadOnlyStmtParse(op stmt.ReadOnlyOpType) *readOnlyStmtParser {
+	return &readOnlyStmtParser{
+		readOnly: &stmt.ReadOnly{Type: op},
+	}
+}
+
+// visitStorageName visits storage name.
+func (s *readOnlyStmtParser) visitStorageName(ctx *grammar.StorageNameContext) {
+	s.readOnly.Storage = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitDatabaseName visits database name.
+func (s *readOnlyStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.readOnly.Database = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitNodeID visits storage node id.
+func (s *readOnlyStmtParser) visitNodeID(ctx *grammar.NodeIDContext) {
+	nodeID, err := strconv.Atoi(ctx.L_INT().GetText())
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.readOnly.NodeID = nodeID
+}
+
+// visitSwitchValue visits read-only mode value.
+func (s *readOnlyStmtParser) visitSwitchValue(ctx *grammar.SwitchValueContext) {
+	s.readOnly.ReadOnly = ctx.T_ON() != nil
+}
+
+// build returns the read-only mode statement.
+func (s *readOnlyStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.readOnly, nil
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestReadOnlyStmt_Parse(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt stmt.Statement
+	}{
+		{"show readonly from cluster", &stmt.ReadOnly{Type: stmt.ShowReadOnly, Storage: "cluster"}},
+		{"SHOW READONLY FROM 'cluster'", &stmt.ReadOnly{Type: stmt.ShowReadOnly, Storage: "cluster"}},
+		{"alter storage cluster set readonly = on", &stmt.ReadOnly{Type: stmt.SetStorageReadOnly, Storage: "cluster", ReadOnly: true}},
+		{"alter storage cluster node 2 set readonly=off", &stmt.ReadOnly{Type: stmt.SetStorageReadOnly, Storage: "cluster", NodeID: 2}},
+		{"ALTER DATABASE db SET READONLY = ON", &stmt.ReadOnly{Type: stmt.SetDatabaseReadOnly, Database: "db", ReadOnly: true}},
+		{"alter database db set write = off", &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "db", WriteDisabled: true}},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+}
+
+func TestReadOnlyStmt_Parse_Fail(t *testing.T) {
+	for _, sql := range []string{
+		"show readonly",
+		"show readonly cluster",
+		"show readonly from",
+		"show readonly from cluster node",
+		"alter storage",
+		"alter storage s",
+		"alter storage cluster node",
+		"alter storage cluster node 1 set",
+		"alter storage cluster set write = on",
+		"alter storage cluster set readonly on",
+		"alter storage cluster set readonly = true",
+		"alter storage cluster set readonly = on node",
+		"alter database db set readonly = yes",
+		"alter database db",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
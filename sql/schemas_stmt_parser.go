@@ -43,6 +43,11 @@ func (s *schemasStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext)
 	s.schema.Value = strutil.GetStringValue(ctx.GetText())
 }
 
+// visitSwitchValue visits when production write switch expression is entered.
+func (s *schemasStmtParser) visitSwitchValue(ctx *grammar.SwitchValueContext) {
+	s.schema.WriteDisabled = ctx.T_OFF() != nil
+}
+
 // build returns the state statement.
 func (s *schemasStmtParser) build() (stmt.Statement, error) {
 	return s.schema, nil
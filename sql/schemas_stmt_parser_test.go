@@ -47,3 +47,26 @@ func TestCreateDatabase(t *testing.T) {
 		Value: `{"name":"test"}`,
 	}, q)
 }
+
+func TestAlterDatabaseStatement(t *testing.T) {
+	q, err := Parse("alter database db set write = off")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "db", WriteDisabled: true}, q)
+	q, err = Parse("ALTER DATABASE 'db' SET WRITE=ON")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Schema{Type: stmt.AlterDatabaseSchemaType, Value: "db"}, q)
+
+	for _, sql := range []string{
+		"alter database",
+		"alter database db",
+		"alter database db set",
+		"alter database db set read = off",
+		"alter database db set write off",
+		"alter database db set write = true",
+		"alter database db set write = on shard",
+	} {
+		q, err = Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
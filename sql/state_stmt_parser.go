@@ -18,7 +18,10 @@
 package sql
 
 import (
+	"strconv"
+
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -26,6 +29,7 @@ import (
 // stateStmtParser represents show state statement parser.
 type stateStmtParser struct {
 	state *stmt.State
+	err   error
 }
 
 // newStateStmtParse creates a show state statement parser.
@@ -45,6 +49,49 @@ func (s *stateStmtParser) visitDatabaseFilter(ctx *grammar.DatabaseFilterContext
 	s.state.Database = strutil.GetStringValue(ctx.Ident().GetText())
 }
 
+// visitDatabaseName visits database name.
+func (s *stateStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.state.Database = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitShardID visits shard id.
+func (s *stateStmtParser) visitShardID(ctx *grammar.ShardIDContext) {
+	shardID, err := strconv.Atoi(ctx.L_INT().GetText())
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.state.ShardID = shardID
+}
+
+// visitFamilyTime visits family time, supports timestamp(ms) or time string, like '2006-01-02 15:04:05'.
+func (s *stateStmtParser) visitFamilyTime(ctx *grammar.FamilyTimeContext) {
+	var (
+		familyTime int64
+		err        error
+	)
+	if ctx.L_INT() != nil {
+		familyTime, err = strconv.ParseInt(ctx.L_INT().GetText(), 10, 64)
+	} else {
+		familyTime, err = timeutil.ParseTimestamp(strutil.GetStringValue(ctx.Ident().GetText()))
+	}
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.state.FamilyTime = familyTime
+}
+
+// visitFileNumber visits file number of data family.
+func (s *stateStmtParser) visitFileNumber(ctx *grammar.FileNumberContext) {
+	fileNumber, err := strconv.ParseInt(ctx.L_INT().GetText(), 10, 64)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.state.FileNumber = fileNumber
+}
+
 // visitMetricList visits metric name list.
 func (s *stateStmtParser) visitMetricList(ctx *grammar.MetricListContext) {
 	names := ctx.AllIdent()
@@ -55,5 +102,8 @@ func (s *stateStmtParser) visitMetricList(ctx *grammar.MetricListContext) {
 
 // build returns the state statement.
 func (s *stateStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return s.state, nil
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -31,3 +32,67 @@ func TestStateStmtParse_show_master(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, s, &stmt.State{Type: stmt.Master})
 }
+
+func TestStateStmtParse_show_shards(t *testing.T) {
+	q, err := Parse("show shards from db")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Shards, Database: "db"}, q)
+	q, err = Parse("SHOW SHARDS FROM 'test.db'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Shards, Database: "test.db"}, q)
+	q, err = Parse("show segments from db shard 10")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Segments, Database: "db", ShardID: 10}, q)
+	q, err = Parse("show disk usage")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.DiskUsage}, q)
+	q, err = Parse("SHOW DISK USAGE FROM db")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.DiskUsage, Database: "db"}, q)
+	q, err = Parse("show replication channels")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.ReplicationChannels}, q)
+	q, err = Parse("SHOW REPLICATION CHANNELS FROM 'db'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.ReplicationChannels, Database: "db"}, q)
+	q, err = Parse("show file detail from db shard 1 family 1600000000000 file 12")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.FileDetail, Database: "db", ShardID: 1, FamilyTime: 1600000000000, FileNumber: 12}, q)
+	q, err = Parse("SHOW EXPIRED METRICS FROM db")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.ExpiredMetrics, Database: "db"}, q)
+	familyTime, _ := timeutil.ParseTimestamp("2020-09-13 20:00:00")
+	q, err = Parse("SHOW FILE DETAIL FROM db SHARD 1 FAMILY '2020-09-13 20:00:00' FILE 12")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.FileDetail, Database: "db", ShardID: 1, FamilyTime: familyTime, FileNumber: 12}, q)
+}
+
+func TestStateStmtParse_show_shards_fail(t *testing.T) {
+	for _, sql := range []string{
+		"show shards",
+		"show shards db",
+		"show shards from 10",
+		"show shards from db shard 1",
+		"show segments from db",
+		"show segments from db shard",
+		"show segments from db shard a",
+		"show disk usage db",
+		"show disk usage from db shard 1",
+		"show disk size",
+		"show replication channels db",
+		"show expired metrics",
+		"show expired metrics from db shard 1",
+		"show file detail from db",
+		"show file detail from db shard 1",
+		"show file detail from db shard 1 family",
+		"show file detail from db shard 1 family 'abc' file 12",
+		"show file detail from db shard 1 family 100",
+		"show file detail from db shard 1 family 100 file",
+		"show file detail from db shard 1 family 100 file a",
+		"show file detail from db shard 1 family 100 file 12 a",
+	} {
+		q, err := Parse(sql)
+		assert.Error(t, err, sql)
+		assert.Nil(t, q, sql)
+	}
+}
@@ -30,6 +30,14 @@ const (
 	DIV
 
 	UNKNOWN
+
+	// comparison operators for having clause, appended after existing operators, keeps their values unchanged.
+	EQUAL
+	NOTEQUAL
+	LESS
+	LESSEQUAL
+	GREATER
+	GREATEREQUAL
 )
 
 // BinaryOPString returns the binary operator's string value
@@ -47,6 +55,18 @@ func BinaryOPString(op BinaryOP) string {
 		return "*"
 	case DIV:
 		return "/"
+	case EQUAL:
+		return "="
+	case NOTEQUAL:
+		return "!="
+	case LESS:
+		return "<"
+	case LESSEQUAL:
+		return "<="
+	case GREATER:
+		return ">"
+	case GREATEREQUAL:
+		return ">="
 	default:
 		return "unknown"
 	}
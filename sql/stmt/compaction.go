@@ -0,0 +1,42 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// CompactionOpType represents compaction statement operation type.
+type CompactionOpType int
+
+const (
+	// ShowCompaction represents show compaction state/backlog of storage nodes statement.
+	ShowCompaction CompactionOpType = iota + 1
+	// SetCompaction represents pause/resume background compaction on storage nodes statement.
+	SetCompaction
+	// ShowCompactionJobs represents show running/queued compact and rollup jobs of storage nodes statement.
+	ShowCompactionJobs
+)
+
+// Compaction represents pause/resume background compaction statement of storage nodes/databases.
+type Compaction struct {
+	Type     CompactionOpType
+	Database string // database name, empty means all databases
+	Paused   bool
+}
+
+// StatementType returns compaction type.
+func (q *Compaction) StatementType() StatementType {
+	return CompactionStatement
+}
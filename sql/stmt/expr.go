@@ -147,6 +147,9 @@ type EqualsExpr struct {
 type InExpr struct {
 	Key    string   `json:"key"`
 	Values []string `json:"values"`
+	// SubQuery represents the sub query(show tag values) which produces the values,
+	// it is resolved to values on broker before query dispatched.
+	SubQuery *MetricMetadata `json:"-"`
 }
 
 // LikeExpr represents a like expression
@@ -161,11 +164,24 @@ type RegexExpr struct {
 	Regexp string `json:"regexp"`
 }
 
+// IsNullExpr represents an is null expression, which matches the series lacking the tag key.
+type IsNullExpr struct {
+	Key string `json:"key"`
+}
+
 // NotExpr represents a not expression
 type NotExpr struct {
 	Expr Expr
 }
 
+// ColumnName returns the column name of select item in result set, alias first.
+func (e *SelectItem) ColumnName() string {
+	if e.Alias == "" {
+		return e.Expr.Rewrite()
+	}
+	return e.Alias
+}
+
 // Rewrite rewrites the select item expr after parse
 func (e *SelectItem) Rewrite() string {
 	if e.Alias == "" {
@@ -228,6 +244,11 @@ func (e *RegexExpr) Rewrite() string {
 	return fmt.Sprintf("%s=~%s", e.Key, e.Regexp)
 }
 
+// Rewrite rewrites the is null expr after parse
+func (e *IsNullExpr) Rewrite() string {
+	return fmt.Sprintf("%s is null", e.Key)
+}
+
 // Marshal returns json of expr using custom json marshal
 func Marshal(expr Expr) []byte {
 	switch e := expr.(type) {
@@ -239,6 +260,8 @@ func Marshal(expr Expr) []byte {
 		return encoding.JSONMarshal(&exprData{Type: "in", Expr: encoding.JSONMarshal(expr)})
 	case *EqualsExpr:
 		return encoding.JSONMarshal(&exprData{Type: "equals", Expr: encoding.JSONMarshal(expr)})
+	case *IsNullExpr:
+		return encoding.JSONMarshal(&exprData{Type: "isNull", Expr: encoding.JSONMarshal(expr)})
 	case *NumberLiteral:
 		return encoding.JSONMarshal(&exprData{Type: "number", Expr: encoding.JSONMarshal(expr)})
 	case *FieldExpr:
@@ -303,6 +326,8 @@ func Unmarshal(value []byte) (Expr, error) {
 		return unmarshal(&expr, &InExpr{})
 	case "equals":
 		return unmarshal(&expr, &EqualsExpr{})
+	case "isNull":
+		return unmarshal(&expr, &IsNullExpr{})
 	case "number":
 		return unmarshal(&expr, &NumberLiteral{})
 	case field:
@@ -420,3 +445,6 @@ func (e *LikeExpr) TagKey() string { return e.Key }
 
 // TagKey returns the regex filter's tag key
 func (e *RegexExpr) TagKey() string { return e.Key }
+
+// TagKey returns the is null filter's tag key
+func (e *IsNullExpr) TagKey() string { return e.Key }
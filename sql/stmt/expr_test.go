@@ -29,6 +29,8 @@ func TestExpr_Rewrite(t *testing.T) {
 	assert.Equal(t, "f", (&SelectItem{Expr: &FieldExpr{Name: "f"}}).Rewrite())
 	assert.Equal(t, "1.90", (&SelectItem{Expr: &NumberLiteral{Val: 1.9}}).Rewrite())
 	assert.Equal(t, "f as f1", (&SelectItem{Expr: &FieldExpr{Name: "f"}, Alias: "f1"}).Rewrite())
+	assert.Equal(t, "f1", (&SelectItem{Expr: &FieldExpr{Name: "f"}, Alias: "f1"}).ColumnName())
+	assert.Equal(t, "sum(f)", (&SelectItem{Expr: &CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "f"}}}}).ColumnName())
 
 	assert.Equal(t, "f", (&FieldExpr{Name: "f"}).Rewrite())
 
@@ -62,6 +64,7 @@ func TestExpr_Rewrite(t *testing.T) {
 	assert.Equal(t, "tagKey in ()", (&InExpr{Key: "tagKey"}).Rewrite())
 
 	assert.Equal(t, "tagKey=~Regexp", (&RegexExpr{Key: "tagKey", Regexp: "Regexp"}).Rewrite())
+	assert.Equal(t, "tagKey is null", (&IsNullExpr{Key: "tagKey"}).Rewrite())
 
 	assert.Equal(t, "f desc", (&OrderByExpr{Expr: &FieldExpr{Name: "f"}, Desc: true}).Rewrite())
 	assert.Equal(t, "max(f) asc", (&OrderByExpr{Expr: &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "f"}}}}).Rewrite())
@@ -72,6 +75,7 @@ func TestTagFilter(t *testing.T) {
 	assert.Equal(t, "tagKey", (&LikeExpr{Key: "tagKey", Value: "tagValue"}).TagKey())
 	assert.Equal(t, "tagKey", (&InExpr{Key: "tagKey", Values: []string{"a", "b", "c"}}).TagKey())
 	assert.Equal(t, "tagKey", (&RegexExpr{Key: "tagKey", Regexp: "Regexp"}).TagKey())
+	assert.Equal(t, "tagKey", (&IsNullExpr{Key: "tagKey"}).TagKey())
 }
 
 func TestExpr_Marshal_Fail(t *testing.T) {
@@ -121,6 +125,14 @@ func TestRegexExpr_Marshal(t *testing.T) {
 	assert.Equal(t, *expr, *e)
 }
 
+func TestIsNullExpr_Marshal(t *testing.T) {
+	expr := &NotExpr{Expr: &IsNullExpr{Key: "tagKey"}}
+	data := Marshal(expr)
+	exprData, _ := Unmarshal(data)
+	e := exprData.(*NotExpr)
+	assert.Equal(t, *expr, *e)
+}
+
 func TestLikeExpr_Marshal(t *testing.T) {
 	expr := &LikeExpr{Key: "tagKey", Value: "tagValue"}
 	data := Marshal(expr)
@@ -0,0 +1,30 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// FieldUsage represents show field usage statement, shows the query-time access counts of metric's fields.
+type FieldUsage struct {
+	Namespace  string // namespace
+	MetricName string // metric name
+	Since      int64  // time duration(ms) before now, 0 means all retained statistics
+}
+
+// StatementType returns field usage type.
+func (q *FieldUsage) StatementType() StatementType {
+	return FieldUsageStatement
+}
@@ -22,6 +22,8 @@ type LimitOpType int
 const (
 	SetLimit LimitOpType = iota + 1
 	ShowLimit
+	// ShowLimitStatus shows the state of limits applied on broker/storage nodes.
+	ShowLimitStatus
 )
 
 // Limit represents limit statement.
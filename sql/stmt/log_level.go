@@ -0,0 +1,37 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+type LogLevelOpType int
+
+const (
+	SetLogLevel LogLevelOpType = iota + 1
+	ShowLogLevels
+)
+
+// LogLevel represents log level statement of logger modules.
+type LogLevel struct {
+	Type LogLevelOpType
+	// Levels represents the level of logger modules(module => level).
+	Levels map[string]string
+}
+
+// StatementType returns log level type.
+func (q *LogLevel) StatementType() StatementType {
+	return LogLevelStatement
+}
@@ -60,8 +60,10 @@ type MetricMetadata struct {
 	Type       MetricMetadataType // metadata suggest type
 	TagKey     string
 	Prefix     string
-	Condition  Expr // tag filter condition expression
-	Limit      int  // result set limit
+	Fuzzy      bool  // fuzzy match by prefix(substring/subsequence), ranked by match score and popularity
+	Condition  Expr  // tag filter condition expression
+	Limit      int   // result set limit
+	Timestamp  int64 // show schema(fields/tag keys) as of timestamp, 0 means current schema
 }
 
 // StatementType returns metadata query type.
@@ -77,7 +79,9 @@ type innerMetadata struct {
 	TagKey     string             `json:"tagKey,omitempty"`
 	Condition  json.RawMessage    `json:"condition,omitempty"`
 	Prefix     string             `json:"prefix,omitempty"`
+	Fuzzy      bool               `json:"fuzzy,omitempty"`
 	Limit      int                `json:"limit,omitempty"`
+	Timestamp  int64              `json:"timestamp,omitempty"`
 }
 
 // MarshalJSON returns json data of query
@@ -89,7 +93,9 @@ func (q *MetricMetadata) MarshalJSON() ([]byte, error) {
 		TagKey:     q.TagKey,
 		Type:       q.Type,
 		Prefix:     q.Prefix,
+		Fuzzy:      q.Fuzzy,
 		Limit:      q.Limit,
+		Timestamp:  q.Timestamp,
 	}
 	return encoding.JSONMarshal(&inner), nil
 }
@@ -112,6 +118,8 @@ func (q *MetricMetadata) UnmarshalJSON(value []byte) error {
 	q.Type = inner.Type
 	q.TagKey = inner.TagKey
 	q.Prefix = inner.Prefix
+	q.Fuzzy = inner.Fuzzy
 	q.Limit = inner.Limit
+	q.Timestamp = inner.Timestamp
 	return nil
 }
@@ -52,9 +52,11 @@ func TestMetadata_MarshalJSON(t *testing.T) {
 				Right:    &EqualsExpr{Key: "path", Value: "/home"},
 			}},
 		},
-		TagKey: "tagKey",
-		Prefix: "prefix",
-		Limit:  100,
+		TagKey:    "tagKey",
+		Prefix:    "prefix",
+		Fuzzy:     true,
+		Limit:     100,
+		Timestamp: 1000,
 	}
 
 	data := encoding.JSONMarshal(&query)
@@ -0,0 +1,41 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// PlacementOpType represents placement statement operation type.
+type PlacementOpType int
+
+const (
+	// ShowPlacement represents show replica placement violations of database statement.
+	ShowPlacement PlacementOpType = iota + 1
+	// RepairPlacement represents repair replica placement of database statement.
+	RepairPlacement
+	// ShowPlacementSuggestions represents show replica re-placement suggestions of database statement.
+	ShowPlacementSuggestions
+)
+
+// Placement represents replica placement statement of database's shards.
+type Placement struct {
+	Type     PlacementOpType
+	Database string
+}
+
+// StatementType returns placement type.
+func (q *Placement) StatementType() StatementType {
+	return PlacementStatement
+}
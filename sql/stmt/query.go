@@ -19,7 +19,10 @@ package stmt
 
 import (
 	"encoding/json"
+	"regexp"
+	"strings"
 
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -41,6 +44,8 @@ type Query struct {
 	AutoGroupByTime bool               // auto fix group by interval based on query time range
 
 	GroupBy      []string // group by tag keys
+	GroupByAll   bool     // group by all tag keys of metric(group by *), resolved from metric's schema under storage
+	Having       Expr     // having condition for grouped result
 	OrderByItems []Expr   // order by field expr list
 	Limit        int      // num. of time series list for result
 }
@@ -52,7 +57,55 @@ func (q *Query) StatementType() StatementType {
 
 // HasGroupBy returns whether query has grouping tag keys
 func (q *Query) HasGroupBy() bool {
-	return len(q.GroupBy) > 0
+	return len(q.GroupBy) > 0 || q.GroupByAll
+}
+
+// metricNamePatternChars represents the regex meta chars which make metric name as pattern.
+const metricNamePatternChars = "*+?|()[]"
+
+// IsMetricNamePattern returns whether metric name is a regex pattern which matches multiple metrics, like: from 'cpu.*'.
+func (q *Query) IsMetricNamePattern() bool {
+	return strings.ContainsAny(q.MetricName, metricNamePatternChars)
+}
+
+// MetricNameRegexp returns the regexp of metric name pattern, the whole metric name must match the pattern.
+func (q *Query) MetricNameRegexp() (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + q.MetricName + ")$")
+}
+
+// DistinctTagKeys returns the tag keys of count distinct function in select list.
+func (q *Query) DistinctTagKeys() []string {
+	var tagKeys []string
+	var visit func(expr Expr)
+	visit = func(expr Expr) {
+		switch e := expr.(type) {
+		case *SelectItem:
+			visit(e.Expr)
+		case *ParenExpr:
+			visit(e.Expr)
+		case *BinaryExpr:
+			visit(e.Left)
+			visit(e.Right)
+		case *CallExpr:
+			if e.FuncType != function.CountDistinct {
+				for _, param := range e.Params {
+					visit(param)
+				}
+				return
+			}
+			tagKey := e.Params[0].Rewrite()
+			for _, key := range tagKeys {
+				if key == tagKey {
+					return
+				}
+			}
+			tagKeys = append(tagKeys, tagKey)
+		}
+	}
+	for _, item := range q.SelectItems {
+		visit(item)
+	}
+	return tagKeys
 }
 
 // innerQuery represents a wrapper of query for json encoding
@@ -71,6 +124,8 @@ type innerQuery struct {
 	AutoGroupByTime bool               `json:"autoGroupByTime,omitempty"`
 
 	GroupBy      []string          `json:"groupBy,omitempty"`
+	GroupByAll   bool              `json:"groupByAll,omitempty"`
+	Having       json.RawMessage   `json:"having,omitempty"`
 	OrderByItems []json.RawMessage `json:"orderByItems,omitempty"`
 	Limit        int               `json:"limit,omitempty"`
 }
@@ -83,12 +138,14 @@ func (q *Query) MarshalJSON() ([]byte, error) {
 		AllFields:       q.AllFields,
 		Namespace:       q.Namespace,
 		Condition:       Marshal(q.Condition),
+		Having:          Marshal(q.Having),
 		TimeRange:       q.TimeRange,
 		Interval:        q.Interval,
 		IntervalRatio:   q.IntervalRatio,
 		AutoGroupByTime: q.AutoGroupByTime,
 		StorageInterval: q.StorageInterval,
 		GroupBy:         q.GroupBy,
+		GroupByAll:      q.GroupByAll,
 		Limit:           q.Limit,
 	}
 	for _, item := range q.SelectItems {
@@ -113,6 +170,13 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 		}
 		q.Condition = condition
 	}
+	if inner.Having != nil {
+		having, err := Unmarshal(inner.Having)
+		if err != nil {
+			return err
+		}
+		q.Having = having
+	}
 	// select list
 	var selectItems []Expr
 	for _, item := range inner.SelectItems {
@@ -143,6 +207,7 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 	q.AutoGroupByTime = inner.AutoGroupByTime
 	q.StorageInterval = inner.StorageInterval
 	q.GroupBy = inner.GroupBy
+	q.GroupByAll = inner.GroupByAll
 	q.OrderByItems = orderByItems
 	q.Limit = inner.Limit
 	return nil
@@ -66,6 +66,11 @@ func TestQuery_Marshal(t *testing.T) {
 		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
 		Interval:  1000,
 		GroupBy:   []string{"a", "b", "c"},
+		Having: &BinaryExpr{
+			Left:     &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "c"}}},
+			Operator: GREATER,
+			Right:    &NumberLiteral{Val: 100},
+		},
 		OrderByItems: []Expr{
 			&FieldExpr{Name: "b"},
 			&CallExpr{
@@ -85,6 +90,37 @@ func TestQuery_Marshal(t *testing.T) {
 	assert.True(t, query.AllFields)
 }
 
+func TestQuery_GroupByAll(t *testing.T) {
+	query := Query{MetricName: "cpu", GroupByAll: true}
+	assert.True(t, query.HasGroupBy())
+	data := encoding.JSONMarshal(&query)
+	query1 := Query{}
+	err := encoding.JSONUnmarshal(data, &query1)
+	assert.NoError(t, err)
+	assert.Equal(t, query, query1)
+	assert.False(t, (&Query{}).HasGroupBy())
+}
+
+func TestQuery_MetricNamePattern(t *testing.T) {
+	query := &Query{MetricName: "cpu.load"}
+	assert.False(t, query.IsMetricNamePattern())
+	query.MetricName = "cpu.*"
+	assert.True(t, query.IsMetricNamePattern())
+	pattern, err := query.MetricNameRegexp()
+	assert.NoError(t, err)
+	assert.True(t, pattern.MatchString("cpu.load"))
+	assert.False(t, pattern.MatchString("system.cpu.load"))
+	query.MetricName = "cpu.(a|b)"
+	assert.True(t, query.IsMetricNamePattern())
+	pattern, err = query.MetricNameRegexp()
+	assert.NoError(t, err)
+	assert.True(t, pattern.MatchString("cpu.a"))
+	assert.False(t, pattern.MatchString("cpu.ab"))
+	query.MetricName = "cpu.(*"
+	_, err = query.MetricNameRegexp()
+	assert.Error(t, err)
+}
+
 func TestQuery_Marshal_Fail(t *testing.T) {
 	query := &Query{}
 	err := query.UnmarshalJSON([]byte{1, 2, 3})
@@ -95,8 +131,24 @@ func TestQuery_Marshal_Fail(t *testing.T) {
 	assert.Error(t, err)
 	err = query.UnmarshalJSON([]byte("{\"orderByItems\":[\"123\"]}"))
 	assert.Error(t, err)
+	err = query.UnmarshalJSON([]byte("{\"having\":\"123\"}"))
+	assert.Error(t, err)
 }
 
 func TestQuery_StatementType(t *testing.T) {
 	assert.Equal(t, QueryStatement, (&Query{}).StatementType())
 }
+
+func TestQuery_DistinctTagKeys(t *testing.T) {
+	query := &Query{SelectItems: []Expr{
+		&SelectItem{Expr: &CallExpr{FuncType: function.CountDistinct, Params: []Expr{&FieldExpr{Name: "host"}}}},
+		&SelectItem{Expr: &BinaryExpr{
+			Left:     &ParenExpr{Expr: &CallExpr{FuncType: function.CountDistinct, Params: []Expr{&FieldExpr{Name: "ip"}}}},
+			Operator: ADD,
+			Right:    &CallExpr{FuncType: function.CountDistinct, Params: []Expr{&FieldExpr{Name: "host"}}},
+		}},
+		&SelectItem{Expr: &CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "f"}}}},
+	}}
+	assert.Equal(t, []string{"host", "ip"}, query.DistinctTagKeys())
+	assert.Empty(t, (&Query{}).DistinctTagKeys())
+}
@@ -0,0 +1,44 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// ReadOnlyOpType represents read-only mode statement operation type.
+type ReadOnlyOpType int
+
+const (
+	// ShowReadOnly represents show read-only state of storage nodes statement.
+	ShowReadOnly ReadOnlyOpType = iota + 1
+	// SetStorageReadOnly represents switch node level read-only mode of storage nodes statement.
+	SetStorageReadOnly
+	// SetDatabaseReadOnly represents switch database level read-only mode on storage nodes statement.
+	SetDatabaseReadOnly
+)
+
+// ReadOnly represents read-only mode statement of storage nodes/databases for maintenance.
+type ReadOnly struct {
+	Type     ReadOnlyOpType
+	Storage  string
+	NodeID   int // storage node id, 0 means all live nodes of storage
+	Database string
+	ReadOnly bool
+}
+
+// StatementType returns read-only type.
+func (q *ReadOnly) StatementType() StatementType {
+	return ReadOnlyStatement
+}
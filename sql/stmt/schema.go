@@ -24,14 +24,18 @@ const (
 	DatabaseSchemaType
 	CreateDatabaseSchemaType
 	DropDatabaseSchemaType
+	// AlterDatabaseSchemaType represents alter database write state(ALTER DATABASE x SET WRITE = ON/OFF).
+	AlterDatabaseSchemaType
 )
 
 // Schema represents show all database schemas statement.
 type Schema struct {
 	Type SchemaType
 	// create stmt: value is database json config.
-	// drop stmt: value is database name.
+	// drop/alter stmt: value is database name.
 	Value string
+	// alter stmt: if true, rejects writes of database, else resumes writes.
+	WriteDisabled bool
 }
 
 // StatementType returns schema query type.
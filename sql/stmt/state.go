@@ -39,6 +39,18 @@ const (
 	StorageMetric
 	// MemoryDatabase represents show memory database statement.
 	MemoryDatabase
+	// Shards represents show shards of database statement.
+	Shards
+	// Segments represents show segments of database's shard statement.
+	Segments
+	// DiskUsage represents show disk usage of database statement.
+	DiskUsage
+	// FileDetail represents show detail of data family's file statement.
+	FileDetail
+	// ReplicationChannels represents show write replication channels of broker statement.
+	ReplicationChannels
+	// ExpiredMetrics represents show unused metrics found by metric expiry of database statement.
+	ExpiredMetrics
 )
 
 // State represents show state statement.
@@ -46,6 +58,9 @@ type State struct {
 	Type        StateType
 	StorageName string
 	Database    string
+	ShardID     int
+	FamilyTime  int64
+	FileNumber  int64
 
 	MetricNames []string
 }
@@ -30,6 +30,12 @@ const (
 	RequestStatement
 	BrokerStatement
 	LimitStatement
+	LogLevelStatement
+	PlacementStatement
+	DeleteSeriesStatement
+	ReadOnlyStatement
+	FieldUsageStatement
+	CompactionStatement
 )
 
 // Statement represents LinDB query language statement
@@ -20,10 +20,12 @@ package tsdb
 import (
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/lindb/roaring"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/common/pkg/fasttime"
@@ -31,6 +33,8 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -61,10 +65,24 @@ type DataFamily interface {
 	WriteRows(rows []metric.StorageRow) error
 	// ValidateSequence validates replica sequence if valid.
 	ValidateSequence(leader int32, seq int64) bool
-	// CommitSequence commits written sequence after write data.
+	// CommitSequence commits written sequence after write data,
+	// the sequence persisted by flush never advances past the staged sequence whose rows not written.
 	CommitSequence(leader int32, seq int64)
+	// StageSequence marks the rows of leader's sequence are staged, which are written into family asynchronously.
+	StageSequence(leader int32, seq int64)
+	// ReleaseStagedSequence removes the staged mark of leader's sequence after staged rows written.
+	ReleaseStagedSequence(leader int32, seq int64)
 	// AckSequence acknowledges sequence after memory database flush successfully.
 	AckSequence(leader int32, fn func(seq int64))
+	// WatchCorruption registers the callback of leader's replica, invoked after quarantining corrupt file.
+	WatchCorruption(leader int32, fn func())
+	// GetFamilyFiles returns the persisted replica sequences and live files of data family.
+	GetFamilyFiles() *models.DataFamilyFiles
+	// GetFileDetail returns the detail of live data file, includes field metas/series count/time slot range of metric blocks.
+	GetFileDetail(fileNumber table.FileNumber) (*models.DataFileDetail, error)
+	// Repair replaces the flushed files with the files fetched from leader replica,
+	// the persisted replica sequences of leader must be same as current family's.
+	Repair(sequences map[int32]int64, fetch func(dir string) ([]string, error)) error
 
 	// NeedFlush checks if memory database need to flush.
 	NeedFlush() bool
@@ -86,7 +104,18 @@ type DataFamily interface {
 	// Release decrements write ref count,
 	// if ref==0, no data will write this family.
 	Release()
-
+	// NumOfReaders returns the number of snapshots held by readers.
+	NumOfReaders() int32
+	// AfterReadersReleased invokes fn after all snapshots held by readers released,
+	// no snapshot can be acquired after calling it, returns true if invoking is deferred.
+	AfterReadersReleased(fn func()) (deferred bool)
+
+	// MayContain checks if family may have data of metric in query time range,
+	// be used for skipping family(even whole shard) before scanning shard.
+	MayContain(executeCtx *flow.StorageExecuteContext) bool
+	// MayContainSeries checks if memory database or sst files of family may still have data of given series,
+	// the data of deleted series in sst files is purged by compaction.
+	MayContainSeries(metricID metric.ID, seriesIDs *roaring.Bitmap) (bool, error)
 	// DataFilter filters data under data family based on query condition
 	flow.DataFilter
 	io.Closer
@@ -106,13 +135,24 @@ type dataFamily struct {
 
 	mutableMemDB   memdb.MemoryDatabase
 	immutableMemDB memdb.MemoryDatabase
+	writtenFields  atomic.Int64 // number of field data points written into mutable memory database
 
 	// leader => seq
 	seq          map[int32]atomic.Int64
 	immutableSeq map[int32]int64
 	persistSeq   map[int32]atomic.Int64
+	stagedSeq    map[int32]map[int64]int // leader => staged sequence => number of staging(rows not written)
+
+	callbacks           map[int32][]func(seq int64) // leader => callback
+	corruptionCallbacks map[int32]func()            // leader => callback
+	repairing           atomic.Bool
+
+	seriesTimeIndex *seriesTimeIndex // series time range index, skip family if no data in query range
 
-	callbacks map[int32][]func(seq int64) // leader => callback
+	manifest        *segmentManifest // files state of family, skip family before acquiring snapshot
+	manifestSegment string           // segment name of family in manifest
+	manifestFamily  string           // family name in manifest
+	manifestMutex   sync.Mutex       // serialize updating manifest
 
 	isFlushing     atomic.Bool    // restrict flusher concurrency
 	flushCondition sync.WaitGroup // flush condition
@@ -121,6 +161,11 @@ type dataFamily struct {
 	lastReadTime *atomic.Int64
 	mutex        sync.Mutex
 
+	readers      int32    // number of snapshots held by readers
+	released     bool     // all readers released, no snapshot can be acquired
+	releasedFns  []func() // invoked after all snapshots released
+	readersMutex sync.Mutex
+
 	statistics *metrics.FamilyStatistics
 	logger     *logger.Logger
 }
@@ -137,18 +182,22 @@ func newDataFamily(
 	dbName := shard.Database().Name()
 	shardIDStr := strconv.Itoa(int(shard.ShardID()))
 	f := &dataFamily{
-		shard:         shard,
-		segment:       segment,
-		interval:      interval,
-		intervalCalc:  interval.Calculator(),
-		timeRange:     timeRange,
-		familyTime:    familyTime,
-		family:        family,
-		lastFlushTime: timeutil.Now(),
-		seq:           make(map[int32]atomic.Int64),
-		persistSeq:    make(map[int32]atomic.Int64),
-		callbacks:     make(map[int32][]func(seq int64)),
-		lastReadTime:  atomic.NewInt64(fasttime.UnixMilliseconds()),
+		shard:               shard,
+		segment:             segment,
+		interval:            interval,
+		intervalCalc:        interval.Calculator(),
+		timeRange:           timeRange,
+		familyTime:          familyTime,
+		family:              family,
+		lastFlushTime:       timeutil.Now(),
+		seq:                 make(map[int32]atomic.Int64),
+		persistSeq:          make(map[int32]atomic.Int64),
+		stagedSeq:           make(map[int32]map[int64]int),
+		callbacks:           make(map[int32][]func(seq int64)),
+		corruptionCallbacks: make(map[int32]func()),
+		lastReadTime:        atomic.NewInt64(fasttime.UnixMilliseconds()),
+
+		seriesTimeIndex: newSeriesTimeIndex(interval.Calculator().CalcSlot(timeRange.End, familyTime, interval.Int64()) + 1),
 
 		statistics: metrics.NewFamilyStatistics(dbName, shardIDStr),
 		logger:     logger.GetLogger("TSDB", "Family"),
@@ -166,6 +215,20 @@ func newDataFamily(
 
 	f.indicator = fmt.Sprintf("%s/%s/%s", dbName, shardIDStr,
 		timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4))
+	// purge deleted series when doing compaction/rollup job
+	family.SetTombstones(f)
+	// keep raw data only for the metrics which are excluded from rollup
+	family.SetRollupFilter(f)
+	if f.manifest = shard.segmentManifest(); f.manifest != nil {
+		f.manifestSegment = f.intervalCalc.GetSegment(familyTime)
+		f.manifestFamily = strconv.Itoa(f.intervalCalc.CalcFamily(familyTime, f.intervalCalc.CalcSegmentTime(familyTime)))
+		// record files state of family into manifest after version changed
+		family.SetVersionListener(f)
+		if _, ok := f.manifest.GetFamily(f.interval.String(), f.manifestSegment, f.manifestFamily); !ok {
+			// family not recorded(created before manifest introduced), record it
+			f.OnVersionChanged()
+		}
+	}
 
 	// add data family into global family manager
 	GetFamilyManager().AddFamily(f)
@@ -198,6 +261,58 @@ func (f *dataFamily) Family() kv.Family {
 	return f.family
 }
 
+// GetTombstones returns the deleted series ids of metric, implements kv.Tombstones.
+func (f *dataFamily) GetTombstones(key uint32) *roaring.Bitmap {
+	indexDB := f.shard.IndexDatabase()
+	if indexDB == nil {
+		return nil
+	}
+	tombstones, err := indexDB.GetDeletedSeries(metric.ID(key))
+	if err != nil {
+		// keep the series data if tombstones cannot be loaded, try to purge them next time
+		f.logger.Error("get tombstones of metric failure",
+			logger.String("family", f.indicator), logger.Any("metricID", key), logger.Error(err))
+		return nil
+	}
+	return tombstones
+}
+
+// SkipRollup returns true if the metric is excluded from rollup(raw-only metric), implements kv.RollupFilter.
+func (f *dataFamily) SkipRollup(key uint32) bool {
+	db := f.shard.Database()
+	limits := db.GetLimits()
+	if limits == nil || len(limits.RawOnlyMetrics) == 0 {
+		return false
+	}
+	skip := false
+	metadataDB := db.Metadata().MetadataDatabase()
+	limits.RangeRawOnlyMetrics(func(namespace, metricName string) {
+		if skip {
+			return
+		}
+		// metric not found, maybe metric not written yet
+		if metricID, err := metadataDB.GetMetricID(namespace, metricName); err == nil && metricID == metric.ID(key) {
+			skip = true
+		}
+	})
+	return skip
+}
+
+// OnVersionChanged updates the files state of family in segment manifest after version changed,
+// implements kv.VersionListener.
+func (f *dataFamily) OnVersionChanged() {
+	if f.manifest == nil {
+		return
+	}
+	f.manifestMutex.Lock()
+	defer f.manifestMutex.Unlock()
+
+	interval := f.interval.String()
+	recorded, _ := f.manifest.GetFamily(interval, f.manifestSegment, f.manifestFamily)
+	state := buildFamilyFilesState(f.family, f.manifestFamily, f.timeRange, recorded.Files)
+	f.manifest.UpdateFamily(interval, f.manifestSegment, f.intervalCalc.CalcSegmentTime(f.familyTime), state)
+}
+
 // FamilyTime returns the timestamp of family.
 func (f *dataFamily) FamilyTime() int64 {
 	return f.familyTime
@@ -220,16 +335,7 @@ func (f *dataFamily) NeedFlush() bool {
 		// no data
 		return false
 	}
-	intervals := f.shard.Database().GetOption().Intervals
-	ttl := config.GlobalStorageConfig().TSDB.MutableMemDBTTL.Duration()
-	if len(intervals) > 1 {
-		// if set rollup interval, need check if ttl > smallest rollup interval.
-		// using small interval check flush ttl.
-		smallestRollupInterval := time.Duration(intervals[1].Interval.Int64() * int64(time.Millisecond))
-		if smallestRollupInterval < ttl {
-			ttl = smallestRollupInterval
-		}
-	}
+	ttl := f.flushTTL()
 	maxMemDBSize := config.GlobalStorageConfig().TSDB.MaxMemDBSize
 
 	f.logger.Info("check memory database if need flush",
@@ -251,6 +357,43 @@ func (f *dataFamily) NeedFlush() bool {
 	return false
 }
 
+// flushTTL returns the ttl of mutable memory database, it will be flushed after ttl.
+func (f *dataFamily) flushTTL() time.Duration {
+	intervals := f.shard.Database().GetOption().Intervals
+	ttl := config.GlobalStorageConfig().TSDB.MutableMemDBTTL.Duration()
+	if len(intervals) > 1 {
+		// if set rollup interval, need check if ttl > smallest rollup interval.
+		// using small interval check flush ttl.
+		smallestRollupInterval := time.Duration(intervals[1].Interval.Int64() * int64(time.Millisecond))
+		if smallestRollupInterval < ttl {
+			ttl = smallestRollupInterval
+		}
+	}
+	return ttl
+}
+
+// flushETA returns the estimated duration before mutable memory database need flush,
+// estimates by memory database's ttl and write rate(memory size/uptime), must hold the mutex.
+func (f *dataFamily) flushETA() time.Duration {
+	if f.mutableMemDB == nil {
+		return 0
+	}
+	uptime := f.mutableMemDB.Uptime()
+	eta := f.flushTTL() - uptime
+	memSize := f.mutableMemDB.MemSize()
+	maxMemDBSize := int64(config.GlobalStorageConfig().TSDB.MaxMemDBSize)
+	if memSize > 0 && uptime > 0 {
+		sizeETA := time.Duration(float64(maxMemDBSize-memSize) / float64(memSize) * float64(uptime))
+		if sizeETA < eta {
+			eta = sizeETA
+		}
+	}
+	if eta < 0 {
+		return 0
+	}
+	return eta
+}
+
 // IsFlushing returns it has flush job doing in background.
 func (f *dataFamily) IsFlushing() bool {
 	return f.isFlushing.Load()
@@ -270,30 +413,39 @@ func (f *dataFamily) Flush() error {
 
 		startTime := time.Now()
 
-		// add lock when switch memory database
-		f.mutex.Lock()
-		if f.immutableMemDB != nil || f.mutableMemDB == nil || f.mutableMemDB.NumOfMetrics() == 0 {
-			// if immutable memory database not nil or no data need flush, return it
-			f.mutex.Unlock()
+		var (
+			waitingFlushMemDB memdb.MemoryDatabase
+			immutableSeq      map[int32]int64
+		)
+		// switch memory database after staged rows(new series) written, no staged row is written during switching,
+		// the rows staged after it are written into new memory database, their sequences are not persisted.
+		f.shard.SyncStagedRows(func() {
+			// add lock when switch memory database
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if f.immutableMemDB != nil || f.mutableMemDB == nil || f.mutableMemDB.NumOfMetrics() == 0 {
+				// if immutable memory database not nil or no data need flush, return it
+				return
+			}
+			waitingFlushMemDB = f.mutableMemDB
+			f.immutableMemDB = waitingFlushMemDB
+			f.mutableMemDB = nil
+			// mark mutable memory database nil, write data will be created
+			waitingFlushMemDB.MarkReadOnly()
+			immutableSeq = f.flushSequences()
+			f.immutableSeq = immutableSeq
+		})
+		if waitingFlushMemDB == nil {
 			return nil
 		}
-		waitingFlushMemDB := f.mutableMemDB
-		f.immutableMemDB = waitingFlushMemDB
-		f.mutableMemDB = nil
-		// mark mutable memory database nil, write data will be created
-		waitingFlushMemDB.MarkReadOnly()
-		immutableSeq := make(map[int32]int64)
-		for leader, seq := range f.seq {
-			immutableSeq[leader] = seq.Load()
-		}
-		f.immutableSeq = immutableSeq
-		f.mutex.Unlock()
 
 		if err := f.flushMemoryDatabase(immutableSeq, waitingFlushMemDB); err != nil {
 			return err
 		}
 
 		// flush success, mark immutable memory database nil
+		f.seriesTimeIndex.Release(waitingFlushMemDB)
 		f.mutex.Lock()
 		f.immutableMemDB = nil
 		f.immutableSeq = nil
@@ -301,11 +453,11 @@ func (f *dataFamily) Flush() error {
 		for leader, seq := range immutableSeq {
 			f.persistSeq[leader] = *atomic.NewInt64(seq)
 		}
+		endTime := time.Now()
+		f.lastFlushTime = endTime.UnixMilli()
 
 		f.mutex.Unlock()
 
-		endTime := time.Now()
-		f.lastFlushTime = endTime.UnixMilli()
 		f.logger.Info("flush memory database successfully",
 			logger.String("family", f.indicator),
 			logger.String("flush-duration", endTime.Sub(startTime).String()),
@@ -324,6 +476,10 @@ func (f *dataFamily) Compact() {
 	if f.mutableMemDB != nil || f.immutableMemDB != nil {
 		return
 	}
+	if kv.IsCompactionPaused(f.indicator) {
+		// compaction of database paused by operator
+		return
+	}
 
 	diff := fasttime.UnixMilliseconds() - f.lastFlushTime - 2*timeutil.OneHour
 	if diff >= 0 {
@@ -343,12 +499,88 @@ func (f *dataFamily) Release() {
 	f.ref.Dec()
 }
 
+// NumOfReaders returns the number of snapshots held by readers.
+func (f *dataFamily) NumOfReaders() int32 {
+	f.readersMutex.Lock()
+	defer f.readersMutex.Unlock()
+
+	return f.readers
+}
+
+// AfterReadersReleased invokes fn after all snapshots held by readers released,
+// no snapshot can be acquired after calling it, returns true if invoking is deferred.
+func (f *dataFamily) AfterReadersReleased(fn func()) (deferred bool) {
+	f.readersMutex.Lock()
+	f.released = true
+	if f.readers > 0 {
+		f.releasedFns = append(f.releasedFns, fn)
+		f.readersMutex.Unlock()
+		f.statistics.DeferredEvictions.Incr()
+		return true
+	}
+	f.readersMutex.Unlock()
+
+	fn()
+	return false
+}
+
+// acquireSnapshot acquires the snapshot of kv family for reading, the files of family cannot be removed
+// until the snapshot closed, returns false if family released(dropped by ttl/evict).
+func (f *dataFamily) acquireSnapshot() (version.Snapshot, bool) {
+	f.readersMutex.Lock()
+	defer f.readersMutex.Unlock()
+
+	if f.released {
+		return nil, false
+	}
+	f.readers++
+	f.statistics.ActiveReaders.Incr()
+	return &familySnapshot{Snapshot: f.family.GetSnapshot(), family: f}, true
+}
+
+// releaseSnapshot releases the snapshot held by reader, invokes the deferred functions if the last reader released.
+func (f *dataFamily) releaseSnapshot() {
+	f.readersMutex.Lock()
+	f.readers--
+	f.statistics.ActiveReaders.Decr()
+	var fns []func()
+	if f.readers == 0 && f.released {
+		fns = f.releasedFns
+		f.releasedFns = nil
+	}
+	f.readersMutex.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// familySnapshot represents the snapshot held by reader, releases reader ref count of data family after closed.
+type familySnapshot struct {
+	version.Snapshot
+	family *dataFamily
+	closed atomic.Bool
+}
+
+// Close closes the snapshot, then releases reader ref count of data family.
+func (s *familySnapshot) Close() {
+	if s.closed.CAS(false, true) {
+		s.Snapshot.Close()
+		s.family.releaseSnapshot()
+	}
+}
+
 // Evict evicts family if long term no data write.
 func (f *dataFamily) Evict() {
 	ref := f.ref.Load()
 	if ref > 0 {
 		return
 	}
+	if f.NumOfReaders() > 0 {
+		// files are read by queries, evict it in next round
+		f.statistics.DeferredEvictions.Incr()
+		return
+	}
 
 	f.mutex.Lock()
 	if f.mutableMemDB != nil || f.immutableMemDB != nil {
@@ -408,6 +640,69 @@ func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []f
 	return
 }
 
+// MayContain checks if family may have data of metric in query time range,
+// checks memory part of series time index, then segment manifest and file part.
+func (f *dataFamily) MayContain(executeCtx *flow.StorageExecuteContext) bool {
+	metricID := executeCtx.MetricID
+	querySlotRange := executeCtx.CalcSourceSlotRange(f.familyTime)
+	if f.seriesTimeIndex.MetricMayContain(metricID, querySlotRange) {
+		return true
+	}
+	if f.manifest != nil &&
+		!f.manifest.MayContain(f.interval.String(), f.manifestSegment, f.manifestFamily, uint32(metricID), querySlotRange) {
+		return false
+	}
+	snapShot, ok := f.acquireSnapshot()
+	if !ok {
+		// family dropped by ttl/evict
+		return false
+	}
+	defer snapShot.Close()
+
+	return f.seriesTimeIndex.FileMayContain(snapShot.GetCurrent().ID(), metricID, nil, querySlotRange)
+}
+
+// MayContainSeries checks if memory database or sst files of family may still have data of given series,
+// the data of deleted series in sst files is purged by compaction.
+func (f *dataFamily) MayContainSeries(metricID metric.ID, seriesIDs *roaring.Bitmap) (bool, error) {
+	allSlots := timeutil.SlotRange{Start: 0, End: math.MaxUint16}
+	f.mutex.Lock()
+	for _, memDB := range []memdb.MemoryDatabase{f.mutableMemDB, f.immutableMemDB} {
+		if memDB != nil && f.seriesTimeIndex.MemoryMayContain(memDB, metricID, seriesIDs, allSlots) {
+			f.mutex.Unlock()
+			return true, nil
+		}
+	}
+	f.mutex.Unlock()
+
+	metricKey := uint32(metricID)
+	if f.manifest != nil &&
+		!f.manifest.MayContain(f.interval.String(), f.manifestSegment, f.manifestFamily, metricKey, allSlots) {
+		return false, nil
+	}
+	snapShot, ok := f.acquireSnapshot()
+	if !ok {
+		// family dropped by ttl/evict
+		return false, nil
+	}
+	defer snapShot.Close()
+
+	readers, err := snapShot.FindReaders(metricKey)
+	if err != nil {
+		return false, err
+	}
+	for _, reader := range readers {
+		r, found, err := f.getMetricReader(reader, metricKey)
+		if err != nil {
+			return false, err
+		}
+		if found && r.GetSeriesIDs().Intersects(seriesIDs) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // GetState returns the current state include memory database state.
 func (f *dataFamily) GetState() models.DataFamilyState {
 	f.mutex.Lock()
@@ -426,22 +721,19 @@ func (f *dataFamily) GetState() models.DataFamilyState {
 
 	var memoryDatabaseState []models.MemoryDatabaseState
 
-	memoryDBState := func(state string, memoryDatabase memdb.MemoryDatabase) {
-		memoryDatabaseState = append(memoryDatabaseState, models.MemoryDatabaseState{
-			State:        state,
-			Uptime:       memoryDatabase.Uptime(),
-			MemSize:      memoryDatabase.MemSize(),
-			NumOfMetrics: memoryDatabase.NumOfMetrics(),
-			NumOfSeries:  memoryDatabase.NumOfSeries(),
-		})
-	}
-
-	if f.immutableMemDB != nil {
-		memoryDBState("immutable", f.immutableMemDB)
-	}
-
-	if f.mutableMemDB != nil {
-		memoryDBState("mutable", f.mutableMemDB)
+	memoryDBState := func(state string, memoryDatabase memdb.MemoryDatabase) models.MemoryDatabaseState {
+		arenaAllocated, arenaUsed := memoryDatabase.ArenaUtilization()
+		dbState := models.MemoryDatabaseState{
+			State:          state,
+			Uptime:         memoryDatabase.Uptime(),
+			MemSize:        memoryDatabase.MemSize(),
+			NumOfMetrics:   memoryDatabase.NumOfMetrics(),
+			NumOfSeries:    memoryDatabase.NumOfSeries(),
+			ArenaAllocated: arenaAllocated,
+			ArenaUsed:      arenaUsed,
+		}
+		memoryDatabaseState = append(memoryDatabaseState, dbState)
+		return dbState
 	}
 
 	state := models.DataFamilyState{
@@ -449,14 +741,41 @@ func (f *dataFamily) GetState() models.DataFamilyState {
 		FamilyTime:       timeutil.FormatTimestamp(f.familyTime, timeutil.DataTimeFormat2),
 		AckSequences:     ackSequences,
 		ReplicaSequences: replicaSequences,
-		MemoryDatabases:  memoryDatabaseState,
+		Flushing:         f.IsFlushing(),
+		LastFlushTime:    f.lastFlushTime,
+		WriteBuffer:      f.shard.BufferManager().State(),
+	}
+
+	if f.immutableMemDB != nil {
+		dbState := memoryDBState("immutable", f.immutableMemDB)
+		state.ImmutableMemSize = dbState.MemSize
+		state.NumOfMetrics += dbState.NumOfMetrics
+		state.NumOfSeries += dbState.NumOfSeries
 	}
 
+	if f.mutableMemDB != nil {
+		dbState := memoryDBState("mutable", f.mutableMemDB)
+		state.MutableMemSize = dbState.MemSize
+		state.NumOfMetrics += dbState.NumOfMetrics
+		state.NumOfSeries += dbState.NumOfSeries
+		state.FlushETA = f.flushETA()
+		if uptime := dbState.Uptime.Seconds(); uptime > 0 {
+			state.WriteRate = float64(f.writtenFields.Load()) / uptime
+		}
+	}
+	state.MemoryDatabases = memoryDatabaseState
+
 	return state
 }
 
 func (f *dataFamily) memoryFilter(shardExecuteContext *flow.ShardExecuteContext) (resultSet []flow.FilterResultSet, err error) {
+	metricID := shardExecuteContext.StorageExecuteCtx.MetricID
+	querySlotRange := shardExecuteContext.StorageExecuteCtx.CalcSourceSlotRange(f.familyTime)
 	memFilter := func(memDB memdb.MemoryDatabase) error {
+		if !f.seriesTimeIndex.MemoryMayContain(memDB, metricID, shardExecuteContext.SeriesIDsAfterFiltering, querySlotRange) {
+			// series of metric have no data in query range
+			return nil
+		}
 		rs, err := memDB.Filter(shardExecuteContext)
 		if err != nil {
 			return err
@@ -480,36 +799,53 @@ func (f *dataFamily) memoryFilter(shardExecuteContext *flow.ShardExecuteContext)
 }
 
 func (f *dataFamily) fileFilter(shardExecuteContext *flow.ShardExecuteContext) (resultSet []flow.FilterResultSet, err error) {
-	snapShot := f.family.GetSnapshot()
+	metricID := shardExecuteContext.StorageExecuteCtx.MetricID
+	metricKey := uint32(metricID)
+	querySlotRange := shardExecuteContext.StorageExecuteCtx.CalcSourceSlotRange(f.familyTime)
+	if f.manifest != nil &&
+		!f.manifest.MayContain(f.interval.String(), f.manifestSegment, f.manifestFamily, metricKey, querySlotRange) {
+		// no file of family contains metric data in query range, skip acquiring snapshot
+		return
+	}
+	snapShot, ok := f.acquireSnapshot()
+	if !ok {
+		// family dropped by ttl/evict
+		return
+	}
 	defer func() {
 		if err != nil || len(resultSet) == 0 {
 			// if not find metrics data or has error, close snapshot directly
 			snapShot.Close()
 		}
 	}()
-	metricKey := uint32(shardExecuteContext.StorageExecuteCtx.MetricID)
+	version := snapShot.GetCurrent().ID()
+	if !f.seriesTimeIndex.FileMayContain(version, metricID, shardExecuteContext.SeriesIDsAfterFiltering, querySlotRange) {
+		// series of metric have no data in query range, skip loading sst files
+		return
+	}
 	readers, err := snapShot.FindReaders(metricKey)
 	if err != nil {
 		engineLogger.Error("filter data family error", logger.Error(err))
 		return
 	}
-	querySlotRange := shardExecuteContext.StorageExecuteCtx.CalcSourceSlotRange(f.familyTime)
 	var metricReaders []metricsdata.MetricReader
+	// collect series time range of metric in each sst file
+	var fileEntries []*seriesTimeEntry
 	for _, reader := range readers {
-		value, err0 := reader.Get(metricKey)
-		// metric data not found
+		r, found, err0 := f.getMetricReader(reader, metricKey)
 		if err0 != nil {
-			continue
+			return nil, err0
 		}
-		r, err := newReaderFunc(reader.Path(), value)
-		if err != nil {
-			return nil, err
+		if !found {
+			continue
 		}
 		storageSlotRange := r.GetTimeRange()
 		if storageSlotRange.Overlap(querySlotRange) {
 			metricReaders = append(metricReaders, r)
 		}
+		fileEntries = append(fileEntries, &seriesTimeEntry{slotRange: storageSlotRange, seriesIDs: r.GetSeriesIDs()})
 	}
+	f.seriesTimeIndex.SetFile(version, metricID, fileEntries)
 	if len(metricReaders) == 0 {
 		return
 	}
@@ -517,6 +853,26 @@ func (f *dataFamily) fileFilter(shardExecuteContext *flow.ShardExecuteContext) (
 	return filter.Filter(shardExecuteContext.SeriesIDsAfterFiltering, shardExecuteContext.StorageExecuteCtx.Fields)
 }
 
+// getMetricReader returns the metric reader of metric block in sst file, returns false if metric data not found,
+// returns error if metric block is corrupt.
+func (f *dataFamily) getMetricReader(reader table.Reader, metricKey uint32) (metricsdata.MetricReader, bool, error) {
+	value, err := reader.Get(metricKey)
+	// metric data not found
+	if err != nil {
+		return nil, false, nil
+	}
+	// verify checksum of metric block when reading it
+	if err = reader.Verify(metricKey, value, metricsdata.ChecksumChecker); err != nil {
+		f.handleCorruptFile(reader.FileName(), err)
+		return nil, false, err
+	}
+	r, err := newReaderFunc(reader.Path(), value, reader)
+	if err != nil {
+		return nil, false, err
+	}
+	return r, true, nil
+}
+
 // WriteRows writes metric rows with same family in batch.
 func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 	if len(rows) == 0 {
@@ -530,30 +886,44 @@ func (f *dataFamily) WriteRows(rows []metric.StorageRow) error {
 		return err
 	}
 	db.AcquireWrite()
-	releaseFunc := db.WithLock()
 	memSizeBefore := db.MemSize()
 	defer func() {
 		f.statistics.WriteBatches.Incr()
 		f.statistics.MemDBTotalSize.Add(float64(db.MemSize() - memSizeBefore))
 		db.CompleteWrite()
-		releaseFunc()
 	}()
 
+	slots := make([]seriesSlot, 0, len(rows))
 	for idx := range rows {
-		row := rows[idx]
+		row := &rows[idx]
 		if !row.Writable {
-			f.statistics.WriteMetricFailures.Incr()
 			continue
 		}
-		row.SlotIndex = uint16(f.intervalCalc.CalcSlot(
+		slot := uint16(f.intervalCalc.CalcSlot(
 			row.Timestamp(),
 			f.familyTime,
 			f.interval.Int64()),
 		)
+		slots = append(slots, seriesSlot{metricID: row.MetricID, seriesID: row.SeriesID, slot: slot})
+	}
+	// record series time range in batch before writing rows, so that query never skips the written data,
+	// also avoids acquiring lock of index for each row.
+	f.seriesTimeIndex.Write(db, slots)
+
+	writable := 0
+	for idx := range rows {
+		row := rows[idx]
+		if !row.Writable {
+			f.statistics.WriteMetricFailures.Incr()
+			continue
+		}
+		row.SlotIndex = slots[writable].slot
+		writable++
 		err := db.WriteRow(&row)
 		if err == nil {
 			f.statistics.WriteMetrics.Incr()
 			f.statistics.WriteFields.Add(float64(len(row.FieldIDs)))
+			f.writtenFields.Add(int64(len(row.FieldIDs)))
 		} else {
 			f.statistics.WriteMetricFailures.Incr()
 			f.logger.Error("failed writing row", logger.String("family", f.indicator), logger.Error(err))
@@ -574,7 +944,8 @@ func (f *dataFamily) ValidateSequence(leader int32, seq int64) bool {
 	return true
 }
 
-// CommitSequence commits written sequence after write data.
+// CommitSequence commits written sequence after write data,
+// the sequence persisted by flush never advances past the staged sequence whose rows not written.
 func (f *dataFamily) CommitSequence(leader int32, seq int64) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
@@ -584,6 +955,54 @@ func (f *dataFamily) CommitSequence(leader int32, seq int64) {
 	f.seq[leader] = seqForLeader
 }
 
+// StageSequence marks the rows of leader's sequence are staged, which are written into family asynchronously.
+func (f *dataFamily) StageSequence(leader int32, seq int64) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.stagedSeq == nil {
+		f.stagedSeq = make(map[int32]map[int64]int)
+	}
+	sequences, ok := f.stagedSeq[leader]
+	if !ok {
+		sequences = make(map[int64]int)
+		f.stagedSeq[leader] = sequences
+	}
+	sequences[seq]++
+}
+
+// ReleaseStagedSequence removes the staged mark of leader's sequence after staged rows written.
+func (f *dataFamily) ReleaseStagedSequence(leader int32, seq int64) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	sequences := f.stagedSeq[leader]
+	if n := sequences[seq]; n > 1 {
+		sequences[seq] = n - 1
+		return
+	}
+	delete(sequences, seq)
+	if len(sequences) == 0 {
+		delete(f.stagedSeq, leader)
+	}
+}
+
+// flushSequences returns the sequence of each leader which data are written into memory database,
+// which is less than the min staged sequence of leader, need hold lock.
+func (f *dataFamily) flushSequences() map[int32]int64 {
+	sequences := make(map[int32]int64)
+	for leader, seq := range f.seq {
+		flushSeq := seq.Load()
+		for stagedSeq := range f.stagedSeq[leader] {
+			if stagedSeq <= flushSeq {
+				flushSeq = stagedSeq - 1
+			}
+		}
+		sequences[leader] = flushSeq
+	}
+	return sequences
+}
+
 // AckSequence acknowledges sequence after memory database flush successfully.
 func (f *dataFamily) AckSequence(leader int32, fn func(seq int64)) {
 	f.mutex.Lock()
@@ -616,6 +1035,7 @@ func (f *dataFamily) GetOrCreateMemoryDatabase(familyTime int64) (memdb.MemoryDa
 			return nil, err
 		}
 		f.mutableMemDB = newDB
+		f.writtenFields.Store(0)
 		f.statistics.ActiveMemDBs.Incr()
 	}
 	return f.mutableMemDB, nil
@@ -637,11 +1057,7 @@ func (f *dataFamily) Close() error {
 		}
 	}
 	if f.mutableMemDB != nil {
-		sequences := make(map[int32]int64)
-		for leader, seq := range f.seq {
-			sequences[leader] = seq.Load()
-		}
-		if err := f.flushMemoryDatabase(sequences, f.mutableMemDB); err != nil {
+		if err := f.flushMemoryDatabase(f.flushSequences(), f.mutableMemDB); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+// GetFileDetail returns the detail of live data file, includes field metas/series count/time slot range of metric blocks.
+func (f *dataFamily) GetFileDetail(fileNumber table.FileNumber) (*models.DataFileDetail, error) {
+	snapshot, ok := f.acquireSnapshot()
+	if !ok {
+		return nil, fmt.Errorf("%w, family released, file number: %d", constants.ErrDataFileNotFound, fileNumber)
+	}
+	defer snapshot.Close()
+
+	for _, file := range snapshot.GetCurrent().GetAllFiles() {
+		if file.GetFileNumber() != fileNumber {
+			continue
+		}
+		reader, err := snapshot.GetReader(fileNumber)
+		if err != nil {
+			return nil, err
+		}
+		return metricsdata.Inspect(reader, int64(file.GetFileSize()))
+	}
+	return nil, fmt.Errorf("%w, file number: %d", constants.ErrDataFileNotFound, fileNumber)
+}
@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+)
+
+func TestDataFamily_GetFileDetail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	reader := table.NewMockReader(ctrl)
+	it := table.NewMockIterator(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{
+		version.NewFileMeta(3, 1, 10, 100),
+		version.NewFileMeta(12, 1, 10, 200),
+	}).AnyTimes()
+	f := newRepairTestDataFamily(family)
+
+	// case 1: file not found
+	detail, err := f.GetFileDetail(5)
+	assert.True(t, errors.Is(err, constants.ErrDataFileNotFound))
+	assert.Nil(t, detail)
+	// case 2: get reader failure
+	snapshot.EXPECT().GetReader(table.FileNumber(12)).Return(nil, fmt.Errorf("err"))
+	detail, err = f.GetFileDetail(12)
+	assert.Error(t, err)
+	assert.Nil(t, detail)
+	// case 3: inspect file
+	snapshot.EXPECT().GetReader(table.FileNumber(12)).Return(reader, nil)
+	reader.EXPECT().FileName().Return("000012.sst")
+	reader.EXPECT().Iterator().Return(it)
+	it.EXPECT().HasNext().Return(false)
+	detail, err = f.GetFileDetail(12)
+	assert.NoError(t, err)
+	assert.Equal(t, "000012.sst", detail.File)
+	assert.Equal(t, int64(200), detail.Size)
+	assert.Equal(t, int32(0), f.NumOfReaders())
+	// case 4: family released
+	assert.False(t, f.AfterReadersReleased(func() {}))
+	detail, err = f.GetFileDetail(12)
+	assert.True(t, errors.Is(err, constants.ErrDataFileNotFound))
+	assert.Nil(t, detail)
+}
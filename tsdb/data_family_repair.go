@@ -0,0 +1,120 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var (
+	// ErrFamilyRepairing represents data family is repairing by other replica.
+	ErrFamilyRepairing = errors.New("data family is repairing")
+	// ErrSequenceMismatch represents the replica sequences of leader and data family are different.
+	ErrSequenceMismatch = errors.New("replica sequences mismatch")
+)
+
+// WatchCorruption registers the callback of leader's replica, invoked after quarantining corrupt file.
+func (f *dataFamily) WatchCorruption(leader int32, fn func()) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.corruptionCallbacks[leader] = fn
+}
+
+// GetFamilyFiles returns the persisted replica sequences and live files of data family.
+func (f *dataFamily) GetFamilyFiles() *models.DataFamilyFiles {
+	snapshot := f.family.GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	rs := &models.DataFamilyFiles{
+		Sequences: make(map[int32]int64),
+	}
+	for leader, seq := range current.GetSequences() {
+		rs.Sequences[leader] = seq
+	}
+	for _, file := range current.GetAllFiles() {
+		rs.Files = append(rs.Files, version.Table(file.GetFileNumber()))
+	}
+	sort.Strings(rs.Files)
+	return rs
+}
+
+// Repair replaces the flushed files with the files fetched from leader replica,
+// the persisted replica sequences of leader must be same as current family's,
+// so that the files of leader contain the same data with the replaced files.
+func (f *dataFamily) Repair(sequences map[int32]int64, fetch func(dir string) ([]string, error)) (err error) {
+	if !f.repairing.CAS(false, true) {
+		return ErrFamilyRepairing
+	}
+	defer func() {
+		f.repairing.Store(false)
+		if err != nil {
+			f.statistics.RepairFailures.Incr()
+		}
+	}()
+
+	current := f.GetFamilyFiles().Sequences
+	if len(current) != len(sequences) {
+		return fmt.Errorf("%w, leader:%v, current:%v", ErrSequenceMismatch, sequences, current)
+	}
+	for leader, seq := range current {
+		if leaderSeq, ok := sequences[leader]; !ok || leaderSeq != seq {
+			return fmt.Errorf("%w, leader:%v, current:%v", ErrSequenceMismatch, sequences, current)
+		}
+	}
+	if err = f.family.ReplaceFiles(fetch); err != nil {
+		return err
+	}
+	f.statistics.Repairs.Incr()
+	f.logger.Info("repair data family from leader replica successfully",
+		logger.String("family", f.indicator), logger.Any("sequences", sequences))
+	return nil
+}
+
+// handleCorruptFile quarantines the corrupt file, then notifies the replica to repair data family.
+func (f *dataFamily) handleCorruptFile(fileName string, cause error) {
+	f.statistics.CorruptFiles.Incr()
+	f.logger.Error("found corrupt file when reading data, quarantine it",
+		logger.String("family", f.indicator), logger.String("file", fileName), logger.Error(cause))
+	fileDesc := version.ParseFileName(fileName)
+	if fileDesc == nil || fileDesc.FileType != version.TypeTable {
+		return
+	}
+	if err := f.family.QuarantineFile(fileDesc.FileNumber); err != nil {
+		f.logger.Error("quarantine corrupt file failure",
+			logger.String("family", f.indicator), logger.String("file", fileName), logger.Error(err))
+		return
+	}
+	f.mutex.Lock()
+	callbacks := make([]func(), 0, len(f.corruptionCallbacks))
+	for _, fn := range f.corruptionCallbacks {
+		callbacks = append(callbacks, fn)
+	}
+	f.mutex.Unlock()
+
+	for _, fn := range callbacks {
+		fn()
+	}
+}
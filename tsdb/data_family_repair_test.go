@@ -0,0 +1,165 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+func newRepairTestDataFamily(family kv.Family) *dataFamily {
+	return &dataFamily{
+		family:              family,
+		corruptionCallbacks: make(map[int32]func()),
+		statistics:          metrics.NewFamilyStatistics("db", "1"),
+		logger:              logger.GetLogger("TSDB", "Test"),
+	}
+}
+
+func TestDataFamily_GetFamilyFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot)
+	snapshot.EXPECT().GetCurrent().Return(v)
+	snapshot.EXPECT().Close()
+	v.EXPECT().GetSequences().Return(map[int32]int64{1: 10})
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{
+		version.NewFileMeta(12, 1, 10, 100),
+		version.NewFileMeta(3, 1, 10, 100),
+	})
+	f := newRepairTestDataFamily(family)
+	assert.Equal(t, &models.DataFamilyFiles{
+		Sequences: map[int32]int64{1: 10},
+		Files:     []string{"000003.sst", "000012.sst"},
+	}, f.GetFamilyFiles())
+}
+
+func TestDataFamily_Repair(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().GetSequences().Return(map[int32]int64{1: 10, 2: 20}).AnyTimes()
+	v.EXPECT().GetAllFiles().Return(nil).AnyTimes()
+	fetch := func(dir string) ([]string, error) {
+		return nil, nil
+	}
+
+	cases := []struct {
+		name      string
+		sequences map[int32]int64
+		prepare   func(f *dataFamily)
+		wantErr   error
+	}{
+		{
+			name:      "repair is doing",
+			sequences: map[int32]int64{1: 10, 2: 20},
+			prepare: func(f *dataFamily) {
+				f.repairing.Store(true)
+			},
+			wantErr: ErrFamilyRepairing,
+		},
+		{
+			name:      "sequences length mismatch",
+			sequences: map[int32]int64{1: 10},
+			wantErr:   ErrSequenceMismatch,
+		},
+		{
+			name:      "sequence value mismatch",
+			sequences: map[int32]int64{1: 10, 2: 21},
+			wantErr:   ErrSequenceMismatch,
+		},
+		{
+			name:      "leader not found",
+			sequences: map[int32]int64{1: 10, 3: 20},
+			wantErr:   ErrSequenceMismatch,
+		},
+		{
+			name:      "replace files failure",
+			sequences: map[int32]int64{1: 10, 2: 20},
+			prepare: func(_ *dataFamily) {
+				family.EXPECT().ReplaceFiles(gomock.Any()).Return(kv.ErrFamilyBusy)
+			},
+			wantErr: kv.ErrFamilyBusy,
+		},
+		{
+			name:      "repair successfully",
+			sequences: map[int32]int64{1: 10, 2: 20},
+			prepare: func(_ *dataFamily) {
+				family.EXPECT().ReplaceFiles(gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRepairTestDataFamily(family)
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+			err := f.Repair(tt.sequences, fetch)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, f.repairing.Load())
+			}
+		})
+	}
+}
+
+func TestDataFamily_handleCorruptFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	f := newRepairTestDataFamily(family)
+	notified := 0
+	f.WatchCorruption(1, func() {
+		notified++
+	})
+	// case 1: not sst file
+	f.handleCorruptFile("MANIFEST-000001", fmt.Errorf("err"))
+	assert.Equal(t, 0, notified)
+	// case 2: quarantine file failure
+	family.EXPECT().QuarantineFile(gomock.Any()).Return(fmt.Errorf("err"))
+	f.handleCorruptFile("000001.sst", fmt.Errorf("err"))
+	assert.Equal(t, 0, notified)
+	// case 3: quarantine file, then notify replica to repair
+	family.EXPECT().QuarantineFile(gomock.Any()).Return(nil)
+	f.handleCorruptFile("000001.sst", fmt.Errorf("err"))
+	assert.Equal(t, 1, notified)
+}
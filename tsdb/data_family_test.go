@@ -19,10 +19,13 @@ package tsdb
 
 import (
 	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
@@ -42,7 +45,9 @@ import (
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/metric"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/memdb"
+	"github.com/lindb/lindb/tsdb/metadb"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
 
@@ -66,6 +71,9 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	shard := NewMockShard(ctrl)
 	shard.EXPECT().Database().Return(database)
 	shard.EXPECT().ShardID().Return(models.ShardID(1))
+	shard.EXPECT().segmentManifest().Return(nil)
+	family.EXPECT().SetTombstones(gomock.Any())
+	family.EXPECT().SetRollupFilter(gomock.Any())
 	dataFamily := newDataFamily(shard, nil, timeutil.Interval(timeutil.OneSecond*10), timeRange, 10, family)
 	assert.Equal(t, timeRange, dataFamily.TimeRange())
 	assert.Equal(t, timeutil.Interval(10000), dataFamily.Interval())
@@ -84,6 +92,9 @@ func TestDataFamily_Filter(t *testing.T) {
 	family := kv.NewMockFamily(ctrl)
 	snapshot := version.NewMockSnapshot(ctrl)
 	snapshot.EXPECT().Close().AnyTimes()
+	v := version.NewMockVersion(ctrl)
+	v.EXPECT().ID().Return(int64(1)).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
 	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
 	reader := table.NewMockReader(ctrl)
 	reader.EXPECT().Path().Return("test").AnyTimes()
@@ -123,6 +134,17 @@ func TestDataFamily_Filter(t *testing.T) {
 			wantErr: false,
 			len:     1,
 		},
+		{
+			name: "skip memory database by series time index",
+			prepare: func(f *dataFamily) {
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.mutableMemDB = memDB
+				f.seriesTimeIndex.Write(memDB, []seriesSlot{{metricID: 2, seriesID: 1, slot: 1}})
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+			},
+			wantErr: false,
+			len:     0,
+		},
 		{
 			name: "get file reader failure",
 			prepare: func(_ *dataFamily) {
@@ -139,12 +161,27 @@ func TestDataFamily_Filter(t *testing.T) {
 			wantErr: false,
 			len:     0,
 		},
+		{
+			name: "verify metric data failure",
+			prepare: func(f *dataFamily) {
+				f.statistics = metrics.NewFamilyStatistics("db", "1")
+				f.logger = logger.GetLogger("TSDB", "Test")
+				f.corruptionCallbacks = make(map[int32]func())
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
+				reader.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3}, nil)
+				reader.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				reader.EXPECT().FileName().Return("000001.sst")
+				family.EXPECT().QuarantineFile(table.FileNumber(1)).Return(nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "new metric reader failure",
 			prepare: func(_ *dataFamily) {
 				snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
 				reader.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3}, nil)
-				newReaderFunc = func(path string, metricBlock []byte) (metricsdata.MetricReader, error) {
+				reader.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
 					return nil, fmt.Errorf("err")
 				}
 			},
@@ -155,11 +192,23 @@ func TestDataFamily_Filter(t *testing.T) {
 			prepare: func(_ *dataFamily) {
 				snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
 				reader.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3}, nil)
+				reader.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mReader := metricsdata.NewMockMetricReader(ctrl)
-				newReaderFunc = func(path string, metricBlock []byte) (metricsdata.MetricReader, error) {
+				newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
 					return mReader, nil
 				}
 				mReader.EXPECT().GetTimeRange().Return(timeutil.SlotRange{Start: 1000, End: 1000})
+				mReader.EXPECT().GetSeriesIDs().Return(roaring.BitmapOf(1))
+			},
+			wantErr: false,
+			len:     0,
+		},
+		{
+			name: "skip family by series time index",
+			prepare: func(f *dataFamily) {
+				f.seriesTimeIndex.SetFile(1, 1, []*seriesTimeEntry{
+					{seriesIDs: roaring.BitmapOf(1), slotRange: timeutil.SlotRange{Start: 1000, End: 1000}},
+				})
 			},
 			wantErr: false,
 			len:     0,
@@ -169,11 +218,13 @@ func TestDataFamily_Filter(t *testing.T) {
 			prepare: func(_ *dataFamily) {
 				snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
 				reader.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3}, nil)
+				reader.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mReader := metricsdata.NewMockMetricReader(ctrl)
-				newReaderFunc = func(path string, metricBlock []byte) (metricsdata.MetricReader, error) {
+				newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
 					return mReader, nil
 				}
 				mReader.EXPECT().GetTimeRange().Return(timeutil.SlotRange{Start: 0, End: 1000})
+				mReader.EXPECT().GetSeriesIDs().Return(roaring.BitmapOf(1))
 				filter := metricsdata.NewMockFilter(ctrl)
 				newFilterFunc = func(familyTime int64, snapshot version.Snapshot,
 					readers []metricsdata.MetricReader) metricsdata.Filter {
@@ -194,9 +245,11 @@ func TestDataFamily_Filter(t *testing.T) {
 				newFilterFunc = metricsdata.NewFilter
 			}()
 			f := &dataFamily{
-				familyTime:   now,
-				family:       family,
-				lastReadTime: atomic.NewInt64(fasttime.UnixMilliseconds()),
+				familyTime:      now,
+				family:          family,
+				lastReadTime:    atomic.NewInt64(fasttime.UnixMilliseconds()),
+				seriesTimeIndex: newSeriesTimeIndex(360),
+				statistics:      metrics.NewFamilyStatistics("data", "1"),
 			}
 			if tt.prepare != nil {
 				tt.prepare(f)
@@ -221,6 +274,162 @@ func TestDataFamily_Filter(t *testing.T) {
 	}
 }
 
+func TestDataFamily_MayContain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	v := version.NewMockVersion(ctrl)
+	v.EXPECT().ID().Return(int64(1)).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	now := timeutil.Now()
+	f := &dataFamily{
+		familyTime:      now,
+		family:          family,
+		seriesTimeIndex: newSeriesTimeIndex(360),
+		statistics:      metrics.NewFamilyStatistics("data", "1"),
+	}
+	ctx := &flow.StorageExecuteContext{
+		MetricID: 1,
+		Query: &stmtpkg.Query{
+			StorageInterval: timeutil.Interval(timeutil.OneMinute),
+			TimeRange:       timeutil.TimeRange{Start: now, End: now + 60000},
+		},
+	}
+	// file part unknown
+	assert.True(t, f.MayContain(ctx))
+	f.seriesTimeIndex.SetFile(1, 1, nil)
+	assert.False(t, f.MayContain(ctx))
+	// memory part
+	f.seriesTimeIndex.Write(memdb.NewMockMemoryDatabase(ctrl), []seriesSlot{{metricID: 1, seriesID: 1, slot: 0}})
+	assert.True(t, f.MayContain(ctx))
+	// family released
+	f.seriesTimeIndex = newSeriesTimeIndex(360)
+	f.released = true
+	assert.False(t, f.MayContain(ctx))
+}
+
+func TestDataFamily_MayContainSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	reader := table.NewMockReader(ctrl)
+	reader.EXPECT().Path().Return("test").AnyTimes()
+	mockMetricReader := func(seriesIDs *roaring.Bitmap) {
+		snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
+		reader.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3}, nil)
+		reader.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mReader := metricsdata.NewMockMetricReader(ctrl)
+		newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
+			return mReader, nil
+		}
+		mReader.EXPECT().GetSeriesIDs().Return(seriesIDs)
+	}
+	cases := []struct {
+		name    string
+		prepare func(f *dataFamily)
+		exist   bool
+		wantErr bool
+	}{
+		{
+			name: "memory database has data of series",
+			prepare: func(f *dataFamily) {
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.mutableMemDB = memDB
+				f.seriesTimeIndex.Write(memDB, []seriesSlot{{metricID: 1, seriesID: 2, slot: 100}})
+			},
+			exist: true,
+		},
+		{
+			name: "immutable memory database unknown",
+			prepare: func(f *dataFamily) {
+				f.immutableMemDB = memdb.NewMockMemoryDatabase(ctrl)
+			},
+			exist: true,
+		},
+		{
+			name: "family released",
+			prepare: func(f *dataFamily) {
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.mutableMemDB = memDB
+				f.seriesTimeIndex.Write(memDB, []seriesSlot{{metricID: 1, seriesID: 10, slot: 100}})
+				f.released = true
+			},
+		},
+		{
+			name: "find readers failure",
+			prepare: func(_ *dataFamily) {
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "new metric reader failure",
+			prepare: func(_ *dataFamily) {
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
+				reader.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3}, nil)
+				reader.EXPECT().Verify(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric data not found",
+			prepare: func(_ *dataFamily) {
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{reader}, nil)
+				reader.EXPECT().Get(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "deleted series purged from sst files",
+			prepare: func(_ *dataFamily) {
+				mockMetricReader(roaring.BitmapOf(1, 10))
+			},
+		},
+		{
+			name: "sst files have data of series",
+			prepare: func(_ *dataFamily) {
+				mockMetricReader(roaring.BitmapOf(1, 2))
+			},
+			exist: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newReaderFunc = metricsdata.NewReader
+			}()
+			f := &dataFamily{
+				family:          family,
+				lastReadTime:    atomic.NewInt64(fasttime.UnixMilliseconds()),
+				seriesTimeIndex: newSeriesTimeIndex(360),
+				statistics:      metrics.NewFamilyStatistics("data", "1"),
+			}
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+			exist, err := f.MayContainSeries(1, roaring.BitmapOf(2, 3))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.exist, exist)
+		})
+	}
+}
+
 func TestDataFamily_NeedFlush(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -348,6 +557,10 @@ func TestDataFamily_Flush(t *testing.T) {
 	family.EXPECT().NewFlusher().Return(flusher).AnyTimes()
 	flusher.EXPECT().Release().AnyTimes()
 	flusher.EXPECT().Sequence(gomock.Any(), gomock.Any()).AnyTimes()
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().SyncStagedRows(gomock.Any()).DoAndReturn(func(fn func()) {
+		fn()
+	}).AnyTimes()
 	cases := []struct {
 		name    string
 		prepare func(f *dataFamily)
@@ -436,6 +649,7 @@ func TestDataFamily_Flush(t *testing.T) {
 				newMetricDataFlusher = metricsdata.NewFlusher
 			}()
 			f := &dataFamily{
+				shard:  shard,
 				family: family,
 				seq: map[int32]atomic.Int64{
 					1: *atomic.NewInt64(10),
@@ -444,8 +658,9 @@ func TestDataFamily_Flush(t *testing.T) {
 				callbacks: map[int32][]func(seq int64){
 					1: {func(seq int64) {}},
 				},
-				statistics: metrics.NewFamilyStatistics("data", "1"),
-				logger:     logger.GetLogger("TSDB", "Test"),
+				seriesTimeIndex: newSeriesTimeIndex(360),
+				statistics:      metrics.NewFamilyStatistics("data", "1"),
+				logger:          logger.GetLogger("TSDB", "Test"),
 			}
 			if tt.prepare != nil {
 				tt.prepare(f)
@@ -458,6 +673,164 @@ func TestDataFamily_Flush(t *testing.T) {
 	}
 }
 
+func TestDataFamily_Flush_StagedRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newMemoryDBFunc = memdb.NewMemoryDatabase
+		newMetricDataFlusher = metricsdata.NewFlusher
+		ctrl.Finish()
+	}()
+	var (
+		lock      sync.Mutex
+		flushed   = make(map[int64]int) // sequence => number of rows flushed into file
+		committed = make(map[int64]int) // sequence => number of rows of sequence
+		acked     int64
+	)
+	// sequence of row is stored in metric id
+	newMemoryDBFunc = func(_ memdb.MemoryDatabaseCfg) (memdb.MemoryDatabase, error) {
+		var written []int64
+		memDB := memdb.NewMockMemoryDatabase(ctrl)
+		memDB.EXPECT().AcquireWrite().AnyTimes()
+		memDB.EXPECT().CompleteWrite().AnyTimes()
+		memDB.EXPECT().MemSize().AnyTimes()
+		memDB.EXPECT().MarkReadOnly().AnyTimes()
+		memDB.EXPECT().Close().Return(nil).AnyTimes()
+		memDB.EXPECT().WriteRow(gomock.Any()).DoAndReturn(func(row *metric.StorageRow) error {
+			lock.Lock()
+			defer lock.Unlock()
+			written = append(written, int64(row.MetricID))
+			return nil
+		}).AnyTimes()
+		memDB.EXPECT().NumOfMetrics().DoAndReturn(func() int {
+			lock.Lock()
+			defer lock.Unlock()
+			return len(written)
+		}).AnyTimes()
+		memDB.EXPECT().FlushFamilyTo(gomock.Any()).DoAndReturn(func(_ metricsdata.Flusher) error {
+			lock.Lock()
+			defer lock.Unlock()
+			for _, seq := range written {
+				flushed[seq]++
+			}
+			return nil
+		}).AnyTimes()
+		return memDB, nil
+	}
+	newMetricDataFlusher = func(_ kv.Flusher) (metricsdata.Flusher, error) {
+		return metricsdata.NewMockFlusher(ctrl), nil
+	}
+	kvFamily := kv.NewMockFamily(ctrl)
+	kvFlusher := kv.NewMockFlusher(ctrl)
+	kvFamily.EXPECT().NewFlusher().Return(kvFlusher).AnyTimes()
+	kvFlusher.EXPECT().Sequence(gomock.Any(), gomock.Any()).AnyTimes()
+	kvFlusher.EXPECT().Release().AnyTimes()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("db").AnyTimes()
+
+	statistics := metrics.NewShardStatistics("db", "1")
+	lookup := make(chan struct{})
+	proceed := make(chan struct{})
+	s := &shard{db: db, statistics: statistics}
+	s.stager = newRowStager("db/1", 100, func(rows []metric.StorageRow, _ *models.Limits) {
+		if rows[0].MetricID == 1 {
+			// keeps staged rows of sequence 1 pending until flush job is waiting
+			close(lookup)
+			<-proceed
+		}
+		for idx := range rows {
+			rows[idx].Writable = true
+		}
+	}, statistics)
+	defer s.stager.Close()
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	f := &dataFamily{
+		shard:        s,
+		family:       kvFamily,
+		interval:     interval,
+		intervalCalc: interval.Calculator(),
+		seq:          make(map[int32]atomic.Int64),
+		persistSeq:   make(map[int32]atomic.Int64),
+		callbacks: map[int32][]func(seq int64){
+			1: {func(seq int64) {
+				lock.Lock()
+				defer lock.Unlock()
+				// all rows of acknowledged sequences must be persisted, they are never replayed
+				for sequence, rows := range committed {
+					if sequence <= seq {
+						assert.Equal(t, rows, flushed[sequence], "rows of acked sequence %d lost", sequence)
+					}
+				}
+				acked = seq
+			}},
+		},
+		seriesTimeIndex: newSeriesTimeIndex(360),
+		statistics:      metrics.NewFamilyStatistics("db", "1"),
+		logger:          logger.GetLogger("TSDB", "Test"),
+	}
+	newRows := func(seq int64) []metric.StorageRow {
+		rows := newStagedRows(1)
+		rows[0].MetricID = metric.ID(seq)
+		rows[0].Writable = true
+		lock.Lock()
+		committed[seq] += len(rows)
+		lock.Unlock()
+		return rows
+	}
+	// writes rows of sequence like local replicator, rows are staged or written directly
+	replica := func(seq int64, staged bool) {
+		rows := newRows(seq)
+		if staged {
+			sequence := newStagedSequence(f, 1, seq, func(success bool) {
+				assert.True(t, success)
+			})
+			assert.True(t, s.stager.tryAcquire(len(rows)))
+			s.stager.stage(f, sequence, rows, nil, nil)
+			sequence.release(true)
+		} else {
+			assert.NoError(t, f.WriteRows(rows))
+		}
+		f.CommitSequence(1, seq)
+	}
+	replica(1, true)
+	replica(2, false)
+	<-lookup
+
+	flushed1 := make(chan struct{})
+	go func() {
+		assert.NoError(t, f.Flush())
+		close(flushed1)
+	}()
+	// waits until flush job waiting for staged rows of sequence 1
+	for {
+		s.stager.mutex.Lock()
+		waiting := len(s.stager.batches) > 0
+		s.stager.mutex.Unlock()
+		if waiting {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// rows of sequence 3 are staged after flush job, which are written into next memory database
+	replica(3, true)
+	replica(4, false)
+	close(proceed)
+	<-flushed1
+
+	lock.Lock()
+	// sequence 4 is committed, but staged rows of sequence 3 not flushed
+	assert.Equal(t, int64(2), acked)
+	assert.Equal(t, map[int64]int{1: 1, 2: 1, 4: 1}, flushed)
+	lock.Unlock()
+
+	// flush remaining rows
+	assert.NoError(t, f.Flush())
+	lock.Lock()
+	assert.Equal(t, int64(4), acked)
+	assert.Equal(t, map[int64]int{1: 1, 2: 1, 3: 1, 4: 1}, flushed)
+	lock.Unlock()
+	assert.Empty(t, f.stagedSeq)
+}
+
 func TestDataFamily_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -600,6 +973,21 @@ func TestDataFamily_Sequence(t *testing.T) {
 		c++
 	})
 	assert.Equal(t, 1, c)
+
+	// flush sequence never advances past staged sequence
+	f.StageSequence(1, 8)
+	f.StageSequence(1, 8)
+	f.StageSequence(1, 9)
+	f.StageSequence(1, 11)
+	assert.Equal(t, map[int32]int64{1: 7}, f.flushSequences())
+	f.ReleaseStagedSequence(1, 8)
+	assert.Equal(t, map[int32]int64{1: 7}, f.flushSequences())
+	f.ReleaseStagedSequence(1, 8)
+	assert.Equal(t, map[int32]int64{1: 8}, f.flushSequences())
+	f.ReleaseStagedSequence(1, 9)
+	assert.Equal(t, map[int32]int64{1: 10}, f.flushSequences())
+	f.ReleaseStagedSequence(1, 11)
+	assert.Empty(t, f.stagedSeq)
 }
 
 func TestDataFamily_WriteRows(t *testing.T) {
@@ -607,7 +995,6 @@ func TestDataFamily_WriteRows(t *testing.T) {
 	defer ctrl.Finish()
 
 	memDB := memdb.NewMockMemoryDatabase(ctrl)
-	memDB.EXPECT().WithLock().Return(func() {}).AnyTimes()
 	memDB.EXPECT().CompleteWrite().AnyTimes()
 	memDB.EXPECT().AcquireWrite().AnyTimes()
 	memDB.EXPECT().MemSize().Return(int64(10)).AnyTimes()
@@ -707,10 +1094,11 @@ func TestDataFamily_WriteRows(t *testing.T) {
 				newMemoryDBFunc = memdb.NewMemoryDatabase
 			}()
 			f := &dataFamily{
-				shard:      shard,
-				interval:   timeutil.Interval(10 * timeutil.OneSecond),
-				statistics: metrics.NewFamilyStatistics("data", "1"),
-				logger:     logger.GetLogger("TSDB", "Test"),
+				shard:           shard,
+				interval:        timeutil.Interval(10 * timeutil.OneSecond),
+				statistics:      metrics.NewFamilyStatistics("data", "1"),
+				seriesTimeIndex: newSeriesTimeIndex(360),
+				logger:          logger.GetLogger("TSDB", "Test"),
 			}
 			f.intervalCalc = f.interval.Calculator()
 			newMemoryDBFunc = func(cfg memdb.MemoryDatabaseCfg) (memdb.MemoryDatabase, error) {
@@ -721,6 +1109,11 @@ func TestDataFamily_WriteRows(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Errorf("WriteRows() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if !tt.wantErr && len(rows) > 0 {
+				// writable rows recorded in series time index
+				assert.Equal(t, rows[0].Writable,
+					f.seriesTimeIndex.MetricMayContain(rows[0].MetricID, timeutil.SlotRange{Start: 0, End: math.MaxUint16}))
+			}
 		})
 	}
 }
@@ -729,22 +1122,36 @@ func TestDataFamily_GetState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	cfg := config.NewDefaultStorageBase()
+	cfg.TSDB.MutableMemDBTTL = ltoml.Duration(time.Minute)
+	cfg.TSDB.MaxMemDBSize = ltoml.Size(40)
+	config.SetGlobalStorageConfig(cfg)
+
 	shard := NewMockShard(ctrl)
+	database := NewMockDatabase(ctrl)
+	bufferMgr := memdb.NewMockBufferManager(ctrl)
 	shard.EXPECT().ShardID().Return(models.ShardID(1))
+	shard.EXPECT().Database().Return(database).AnyTimes()
+	shard.EXPECT().BufferManager().Return(bufferMgr)
+	database.EXPECT().GetOption().Return(&option.DatabaseOption{}).AnyTimes()
+	bufferMgr.EXPECT().State().Return(models.WriteBufferState{NumOfBuffers: 1, GCCount: 2})
 	db := memdb.NewMockMemoryDatabase(ctrl)
 	db.EXPECT().NumOfMetrics().Return(10).MaxTimes(2)
 	db.EXPECT().NumOfSeries().Return(100).MaxTimes(2)
-	db.EXPECT().MemSize().Return(int64(10)).MaxTimes(2)
-	db.EXPECT().Uptime().Return(time.Duration(10)).MaxTimes(2)
+	db.EXPECT().MemSize().Return(int64(10)).AnyTimes()
+	db.EXPECT().Uptime().Return(time.Second).AnyTimes()
+	db.EXPECT().ArenaUtilization().Return(int64(1024), int64(512)).MaxTimes(2)
 	now := timeutil.Now()
 	f := &dataFamily{
 		shard:          shard,
 		familyTime:     now,
+		lastFlushTime:  now,
 		mutableMemDB:   db,
 		immutableMemDB: db,
 		seq:            map[int32]atomic.Int64{10: *atomic.NewInt64(10)},
 		persistSeq:     map[int32]atomic.Int64{10: *atomic.NewInt64(10)},
 	}
+	f.writtenFields.Store(50)
 
 	state := f.GetState()
 	assert.Equal(t, models.DataFamilyState{
@@ -754,21 +1161,65 @@ func TestDataFamily_GetState(t *testing.T) {
 		ReplicaSequences: map[int32]int64{10: 10},
 		MemoryDatabases: []models.MemoryDatabaseState{
 			{
-				State:        "immutable",
-				Uptime:       time.Duration(10),
-				MemSize:      10,
-				NumOfMetrics: 10,
-				NumOfSeries:  100,
+				State:          "immutable",
+				Uptime:         time.Second,
+				MemSize:        10,
+				NumOfMetrics:   10,
+				NumOfSeries:    100,
+				ArenaAllocated: 1024,
+				ArenaUsed:      512,
 			}, {
-				State:        "mutable",
-				Uptime:       time.Duration(10),
-				MemSize:      10,
-				NumOfMetrics: 10,
-				NumOfSeries:  100,
+				State:          "mutable",
+				Uptime:         time.Second,
+				MemSize:        10,
+				NumOfMetrics:   10,
+				NumOfSeries:    100,
+				ArenaAllocated: 1024,
+				ArenaUsed:      512,
 			}},
+		MutableMemSize:   10,
+		ImmutableMemSize: 10,
+		NumOfMetrics:     20,
+		NumOfSeries:      200,
+		FlushETA:         3 * time.Second, // (40-10)/10*1s, less than ttl
+		LastFlushTime:    now,
+		WriteRate:        50, // 50 data points in 1s
+		WriteBuffer:      models.WriteBufferState{NumOfBuffers: 1, GCCount: 2},
 	}, state)
 }
 
+func TestDataFamily_flushETA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.NewDefaultStorageBase()
+	cfg.TSDB.MutableMemDBTTL = ltoml.Duration(time.Minute)
+	cfg.TSDB.MaxMemDBSize = ltoml.Size(40)
+	config.SetGlobalStorageConfig(cfg)
+
+	shard := NewMockShard(ctrl)
+	database := NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(database).AnyTimes()
+	database.EXPECT().GetOption().Return(&option.DatabaseOption{}).AnyTimes()
+	f := &dataFamily{shard: shard}
+	// case 1: no mutable memory database
+	assert.Zero(t, f.flushETA())
+	db := memdb.NewMockMemoryDatabase(ctrl)
+	f.mutableMemDB = db
+	// case 2: no data, using ttl
+	db.EXPECT().Uptime().Return(time.Second)
+	db.EXPECT().MemSize().Return(int64(0))
+	assert.Equal(t, 59*time.Second, f.flushETA())
+	// case 3: exceed max memory size
+	db.EXPECT().Uptime().Return(time.Second)
+	db.EXPECT().MemSize().Return(int64(100))
+	assert.Zero(t, f.flushETA())
+	// case 4: exceed ttl
+	db.EXPECT().Uptime().Return(2 * time.Minute)
+	db.EXPECT().MemSize().Return(int64(0))
+	assert.Zero(t, f.flushETA())
+}
+
 func TestDataFamily_Compact(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -785,6 +1236,106 @@ func TestDataFamily_Compact(t *testing.T) {
 	f.family = kvFamily
 	kvFamily.EXPECT().Compact()
 	f.Compact()
+	// compaction paused
+	kv.SetCompactionPaused("", true)
+	defer kv.SetCompactionPaused("", false)
+	f.Compact()
+}
+
+func TestDataFamily_GetTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := NewMockShard(ctrl)
+	f := &dataFamily{
+		shard:  shard,
+		logger: logger.GetLogger("TSDB", "Test"),
+	}
+	// case 1: index database not exist
+	shard.EXPECT().IndexDatabase().Return(nil)
+	assert.Nil(t, f.GetTombstones(1))
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	// case 2: get tombstones failure
+	indexDB.EXPECT().GetDeletedSeries(metric.ID(1)).Return(nil, fmt.Errorf("err"))
+	assert.Nil(t, f.GetTombstones(1))
+	// case 3: get tombstones
+	indexDB.EXPECT().GetDeletedSeries(metric.ID(1)).Return(roaring.BitmapOf(1, 2), nil)
+	assert.Equal(t, roaring.BitmapOf(1, 2), f.GetTombstones(1))
+}
+
+func TestDataFamily_SkipRollup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := NewMockShard(ctrl)
+	db := NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	f := &dataFamily{
+		shard:  shard,
+		logger: logger.GetLogger("TSDB", "Test"),
+	}
+	// case 1: no raw-only metrics
+	db.EXPECT().GetLimits().Return(models.NewDefaultLimits())
+	assert.False(t, f.SkipRollup(1))
+
+	limits := models.NewDefaultLimits()
+	limits.RawOnlyMetrics = []string{"billing", "ns|billing.amount"}
+	db.EXPECT().GetLimits().Return(limits).AnyTimes()
+	meta := metadb.NewMockMetadata(ctrl)
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	db.EXPECT().Metadata().Return(meta).AnyTimes()
+	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	// case 2: metric not found
+	metaDB.EXPECT().GetMetricID("default-ns", "billing").Return(metric.EmptyMetricID, fmt.Errorf("err"))
+	metaDB.EXPECT().GetMetricID("ns", "billing.amount").Return(metric.ID(2), nil)
+	assert.False(t, f.SkipRollup(1))
+	// case 3: raw-only metric
+	metaDB.EXPECT().GetMetricID("default-ns", "billing").Return(metric.ID(1), nil)
+	assert.True(t, f.SkipRollup(1))
+}
+
+func TestDataFamily_Readers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot).Times(2)
+	snapshot.EXPECT().Close().Times(2)
+	f := &dataFamily{
+		family:     family,
+		statistics: metrics.NewFamilyStatistics("data", "1"),
+	}
+	s1, ok := f.acquireSnapshot()
+	assert.True(t, ok)
+	s2, ok := f.acquireSnapshot()
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), f.NumOfReaders())
+
+	released := 0
+	assert.True(t, f.AfterReadersReleased(func() {
+		released++
+	}))
+	// cannot acquire snapshot after family released
+	s3, ok := f.acquireSnapshot()
+	assert.False(t, ok)
+	assert.Nil(t, s3)
+
+	s1.Close()
+	// close snapshot repeatedly
+	s1.Close()
+	assert.Equal(t, 0, released)
+	assert.Equal(t, int32(1), f.NumOfReaders())
+	s2.Close()
+	assert.Equal(t, 1, released)
+	assert.Equal(t, int32(0), f.NumOfReaders())
+
+	// no reader, invoke directly
+	assert.False(t, f.AfterReadersReleased(func() {
+		released++
+	}))
+	assert.Equal(t, 2, released)
 }
 
 func TestDataFamily_Evict(t *testing.T) {
@@ -816,6 +1367,12 @@ func TestDataFamily_Evict(t *testing.T) {
 				f.mutableMemDB = memdb.NewMockMemoryDatabase(ctrl)
 			},
 		},
+		{
+			name: "family read by query",
+			prepare: func(f *dataFamily) {
+				f.readers = 1
+			},
+		},
 		{
 			name: "family time in write time range",
 			prepare: func(f *dataFamily) {
@@ -32,6 +32,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source=./data_flush_checker.go -destination=./data_flush_checker_mock.go -package=tsdb
@@ -56,10 +57,15 @@ var (
 //     If this family is above FamilyMemoryUsedThreshold. it will be flushed to disk.
 //  4. DatabaseMetaFlusher
 //     It is a simple checker which flush the meta of database to disk periodically.
+//  5. AdaptiveFlushScheduler
+//     This scheduler forecasts each family's memory usage growth from recent ingest rate,
+//     the family which will be above MaxMemDBSize before next check will be flushed early,
+//     the number of early flushes in each check is limited, so that shard flushes are staggered.
 //
 // a). Each family or database is restricted to flush by one goroutine at the same time via CAS operation;
 // b). The flush workers runs concurrently;
-// c). All unit will be flushed when closing;
+// c). Families of a shard are flushed concurrently after shard index flushed, bounded by FlushConcurrency;
+// d). All unit will be flushed when closing;
 type DataFlushChecker interface {
 	// Start starts the checker goroutine in background.
 	Start()
@@ -94,6 +100,8 @@ type dataFlushChecker struct {
 	isWatermarkFlushing  atomic.Bool        // this flag symbols if it has goroutine in high water-mark flushing
 	running              *atomic.Bool
 	memoryStatGetterFunc monitoring.MemoryStatGetter // used for mocking
+	scheduler            *flushScheduler             // adaptive flush scheduler based on ingest rate
+	familyFlushLimiter   chan struct{}               // limits concurrent family flushes of all shards
 
 	logger *logger.Logger
 }
@@ -101,12 +109,18 @@ type dataFlushChecker struct {
 // newDataFlushChecker creates the data flush checker
 func newDataFlushChecker(ctx context.Context) DataFlushChecker {
 	c, cancel := context.WithCancel(ctx)
+	flushConcurrency := config.GlobalStorageConfig().TSDB.FlushConcurrency
+	if flushConcurrency <= 0 {
+		flushConcurrency = 1
+	}
 	return &dataFlushChecker{
 		ctx:                  c,
 		cancel:               cancel,
 		flushRequestCh:       make(chan *flushRequest, 8),
 		memoryStatGetterFunc: mem.VirtualMemory,
 		running:              atomic.NewBool(false),
+		scheduler:            newFlushScheduler(),
+		familyFlushLimiter:   make(chan struct{}, flushConcurrency),
 		logger:               engineLogger,
 	}
 }
@@ -156,31 +170,41 @@ func (fc *dataFlushChecker) startCheckDataFlush() {
 // check finds family which need flush data.
 func (fc *dataFlushChecker) check() {
 	needFlushDBs := make(map[string]*flushRequest)
+	addFlushFamily := func(family DataFamily) {
+		shard := family.Shard()
+		dbName := shard.Database().Name()
+		needFlushDB, ok := needFlushDBs[dbName]
+		if !ok {
+			needFlushDB = &flushRequest{
+				db:     shard.Database(),
+				shards: make(map[models.ShardID]*flushShard),
+				global: false,
+			}
+			needFlushDBs[dbName] = needFlushDB
+		}
+		needFlushShard, ok := needFlushDB.shards[shard.ShardID()]
+		if !ok {
+			needFlushShard = &flushShard{
+				shard: shard,
+			}
+			needFlushDB.shards[shard.ShardID()] = needFlushShard
+		}
+		needFlushShard.families = append(needFlushShard.families, family)
+		metrics.FlushCheckerStatistics.FlushInFlight.WithTagValues(dbName, strconv.Itoa(int(shard.ShardID()))).Incr()
+	}
+	var notFlushFamilies []DataFamily
 	// check each family if it needs to do flush job
 	GetFamilyManager().WalkEntry(func(family DataFamily) {
 		if family.NeedFlush() {
-			shard := family.Shard()
-			dbName := shard.Database().Name()
-			needFlushDB, ok := needFlushDBs[dbName]
-			if !ok {
-				needFlushDB = &flushRequest{
-					db:     shard.Database(),
-					shards: make(map[models.ShardID]*flushShard),
-					global: false,
-				}
-				needFlushDBs[dbName] = needFlushDB
-			}
-			needFlushShard, ok := needFlushDB.shards[shard.ShardID()]
-			if !ok {
-				needFlushShard = &flushShard{
-					shard: shard,
-				}
-				needFlushDB.shards[shard.ShardID()] = needFlushShard
-			}
-			needFlushShard.families = append(needFlushShard.families, family)
-			metrics.FlushCheckerStatistics.FlushInFlight.WithTagValues(dbName, strconv.Itoa(int(shard.ShardID()))).Incr()
+			addFlushFamily(family)
+		} else {
+			notFlushFamilies = append(notFlushFamilies, family)
 		}
 	})
+	// forecast memory database growth, flush families early which will reach max size before next check
+	for _, family := range fc.scheduler.schedule(notFlushFamilies, timeutil.Now(), memoryUsageCheckInterval.Load()) {
+		addFlushFamily(family)
+	}
 
 	for _, request := range needFlushDBs {
 		fc.requestFlushJob(request)
@@ -281,14 +305,59 @@ func (fc *dataFlushChecker) flushShard(request *flushShard) {
 	// wait index flush job completed, maybe other goroutine is flushing.
 	request.shard.WaitFlushIndexCompleted()
 
-	// TODO add flush timeout?
+	// families of shard can be flushed concurrently after index flushed,
+	// total family flushes of all shards are bounded by flush concurrency.
+	concurrency := config.GlobalStorageConfig().TSDB.FamilyFlushConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if concurrency > len(request.families) {
+		concurrency = len(request.families)
+	}
+	familyCh := make(chan DataFamily, len(request.families))
 	for _, family := range request.families {
-		if err := family.Flush(); err != nil {
-			engineLogger.Error("flush family memory database error",
-				logger.String("family", family.Indicator()), logger.Error(err))
-		}
-		metrics.FlushCheckerStatistics.FlushInFlight.
-			WithTagValues(request.shard.Database().Name(), strconv.Itoa(int(request.shard.ShardID()))).Decr()
+		familyCh <- family
+	}
+	close(familyCh)
+
+	var wait sync.WaitGroup
+	wait.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wait.Done()
+			for family := range familyCh {
+				fc.flushFamily(request.shard, family)
+			}
+		}()
+	}
+	wait.Wait()
+}
+
+// flushFamily flushes family metric data, waits if too many families are flushing.
+// if flush checker stopped, skips the family flush, the family will be flushed when closing.
+func (fc *dataFlushChecker) flushFamily(shard Shard, family DataFamily) {
+	defer metrics.FlushCheckerStatistics.FlushInFlight.
+		WithTagValues(shard.Database().Name(), strconv.Itoa(int(shard.ShardID()))).Decr()
+
+	select {
+	case <-fc.ctx.Done():
+		metrics.FlushCheckerStatistics.FlushCanceled.Incr()
+		return
+	case fc.familyFlushLimiter <- struct{}{}:
+	}
+	defer func() {
+		<-fc.familyFlushLimiter
+	}()
+	// check cancellation again, maybe checker stopped when waiting flush limiter
+	if fc.ctx.Err() != nil {
+		metrics.FlushCheckerStatistics.FlushCanceled.Incr()
+		return
+	}
+
+	// TODO add flush timeout?
+	if err := family.Flush(); err != nil {
+		engineLogger.Error("flush family memory database error",
+			logger.String("family", family.Indicator()), logger.Error(err))
 	}
 }
 
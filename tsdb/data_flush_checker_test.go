@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/models"
@@ -100,6 +101,7 @@ func TestDataFamilyCheck_check(t *testing.T) {
 			prepare: func(_ *dataFlushChecker) {
 				GetFamilyManager().AddFamily(family1)
 				family1.EXPECT().NeedFlush().Return(false)
+				family1.EXPECT().MemDBSize().Return(int64(0))
 			},
 			assert: func(c *dataFlushChecker) {
 				v, ok := c.dbInFlushing.Load("db")
@@ -135,15 +137,16 @@ func TestDataFamilyCheck_check(t *testing.T) {
 			prepare: func(_ *dataFlushChecker) {
 				GetFamilyManager().AddFamily(family2)
 				family2.EXPECT().NeedFlush().Return(false)
-				family2.EXPECT().MemDBSize().Return(int64(2 * ignoreMemorySize))
+				family2.EXPECT().MemDBSize().Return(int64(2 * ignoreMemorySize)).Times(2)
 				family2.EXPECT().IsFlushing().Return(false)
 				GetFamilyManager().AddFamily(family1)
 				family1.EXPECT().NeedFlush().Return(false)
-				family1.EXPECT().MemDBSize().Return(int64(199 * ignoreMemorySize))
+				family1.EXPECT().MemDBSize().Return(int64(199 * ignoreMemorySize)).Times(2)
 				family1.EXPECT().IsFlushing().Return(false)
 				GetFamilyManager().AddFamily(family1)
 				cfg := config.GlobalStorageConfig()
 				cfg.TSDB.MaxMemUsageBeforeFlush = 0.0001
+				cfg.TSDB.MaxMemDBSize = 1024 * ignoreMemorySize
 				config.SetGlobalStorageConfig(cfg)
 			},
 			assert: func(c *dataFlushChecker) {
@@ -166,11 +169,12 @@ func TestDataFamilyCheck_check(t *testing.T) {
 			prepare: func(_ *dataFlushChecker) {
 				GetFamilyManager().AddFamily(family2)
 				family2.EXPECT().NeedFlush().Return(false)
-				family2.EXPECT().MemDBSize().Return(int64(199))
+				family2.EXPECT().MemDBSize().Return(int64(199)).AnyTimes()
 				family2.EXPECT().IsFlushing().Return(false)
 				GetFamilyManager().AddFamily(family1)
 				family1.EXPECT().NeedFlush().Return(false)
 				family1.EXPECT().IsFlushing().Return(true)
+				family1.EXPECT().MemDBSize().Return(int64(0)).AnyTimes()
 				GetFamilyManager().AddFamily(family1)
 				cfg := config.GlobalStorageConfig()
 				cfg.TSDB.MaxMemUsageBeforeFlush = 0.0001
@@ -361,6 +365,16 @@ func TestDataFlushChecker_doFlush(t *testing.T) {
 				family.EXPECT().Flush().Return(fmt.Errorf("err"))
 			},
 		},
+		{
+			name: "skip family flush after checker stopped",
+			prepare: func(c *dataFlushChecker) {
+				db.EXPECT().FlushMeta().Return(nil)
+				db.EXPECT().WaitFlushMetaCompleted()
+				shard.EXPECT().FlushIndex().Return(nil)
+				shard.EXPECT().WaitFlushIndexCompleted()
+				c.Stop()
+			},
+		},
 		{
 			name: "flush family successfully",
 			prepare: func(_ *dataFlushChecker) {
@@ -395,3 +409,58 @@ func TestDataFlushChecker_doFlush(t *testing.T) {
 		})
 	}
 }
+
+func TestDataFlushChecker_flushShard_concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+	cfg := config.NewDefaultStorageBase()
+	cfg.TSDB.FlushConcurrency = 2
+	cfg.TSDB.FamilyFlushConcurrency = 4
+	config.SetGlobalStorageConfig(cfg)
+
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	shard := NewMockShard(ctrl)
+	bufMgr := memdb.NewMockBufferManager(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().BufferManager().Return(bufMgr).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	bufMgr.EXPECT().GarbageCollect()
+	shard.EXPECT().FlushIndex().Return(nil)
+	shard.EXPECT().WaitFlushIndexCompleted()
+
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+		flushed     atomic.Int32
+	)
+	var families []DataFamily
+	for i := 0; i < 6; i++ {
+		family := NewMockDataFamily(ctrl)
+		family.EXPECT().Flush().DoAndReturn(func() error {
+			n := inFlight.Inc()
+			for {
+				max := maxInFlight.Load()
+				if n <= max || maxInFlight.CAS(max, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Dec()
+			flushed.Inc()
+			return nil
+		})
+		families = append(families, family)
+	}
+	checker := newDataFlushChecker(context.TODO())
+	checker.(*dataFlushChecker).flushShard(&flushShard{
+		shard:    shard,
+		families: families,
+	})
+	assert.Equal(t, int32(6), flushed.Load())
+	// bounded by global flush concurrency
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
@@ -0,0 +1,159 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+)
+
+const (
+	// ingestRateAlpha represents the smoothing factor of ingest rate(EWMA).
+	ingestRateAlpha = 0.3
+)
+
+// familyIngestRate represents the recent ingest rate of family's memory database.
+type familyIngestRate struct {
+	lastSize  int64
+	lastTime  int64   // last observed timestamp(ms)
+	rate      float64 // bytes per second
+	lastRound int64   // last check round observed
+}
+
+// shardKey represents the key of shard in all databases.
+type shardKey struct {
+	db      string
+	shardID models.ShardID
+}
+
+// flushCandidate represents the family which will reach max memory database size soon.
+type flushCandidate struct {
+	family   DataFamily
+	deadline time.Duration // predicted duration before memory database reaches max size
+}
+
+// flushScheduler forecasts memory database growth of families based on recent ingest rates,
+// picks families which will reach max memory database size before next check to flush early,
+// and limits the number of early flushes in each check round, so that shard flushes are staggered,
+// avoid simultaneous flush storms.
+// NOTICE: flushScheduler is not thread-safe, only used in the check goroutine of flush checker.
+type flushScheduler struct {
+	rates map[string]*familyIngestRate // family indicator => ingest rate
+	round int64
+}
+
+// newFlushScheduler creates a flush scheduler.
+func newFlushScheduler() *flushScheduler {
+	return &flushScheduler{
+		rates: make(map[string]*familyIngestRate),
+	}
+}
+
+// schedule observes memory database size of families, returns the families which need flush early.
+func (s *flushScheduler) schedule(families []DataFamily, now int64, checkInterval time.Duration) []DataFamily {
+	s.round++
+	tsdbCfg := config.GlobalStorageConfig().TSDB
+	maxMemDBSize := int64(tsdbCfg.MaxMemDBSize)
+	maxDeadline := tsdbCfg.MutableMemDBTTL.Duration()
+
+	var candidates []flushCandidate
+	shardDeadlines := make(map[shardKey]time.Duration)
+	for _, family := range families {
+		deadline := s.forecast(family, now, maxMemDBSize)
+		if deadline > maxDeadline {
+			deadline = maxDeadline
+		}
+		shard := family.Shard()
+		key := shardKey{db: shard.Database().Name(), shardID: shard.ShardID()}
+		if shardDeadline, ok := shardDeadlines[key]; !ok || deadline < shardDeadline {
+			shardDeadlines[key] = deadline
+		}
+		// family will reach max memory database size before next check, need flush early
+		if deadline <= checkInterval && !family.IsFlushing() {
+			candidates = append(candidates, flushCandidate{family: family, deadline: deadline})
+		}
+	}
+	// remove families which are not alive
+	for indicator, rate := range s.rates {
+		if rate.lastRound != s.round {
+			delete(s.rates, indicator)
+		}
+	}
+	// update flush deadline of each shard
+	for key, deadline := range shardDeadlines {
+		metrics.FlushCheckerStatistics.FlushDeadline.
+			WithTagValues(key.db, strconv.Itoa(int(key.shardID))).Update(deadline.Seconds())
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	// pick the families with the earliest deadline, others will be flushed in next round
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].deadline < candidates[j].deadline
+	})
+	limit := tsdbCfg.FlushConcurrency
+	if limit <= 0 || limit > len(candidates) {
+		limit = len(candidates)
+	}
+	rs := make([]DataFamily, limit)
+	for idx := range rs {
+		rs[idx] = candidates[idx].family
+	}
+	metrics.FlushCheckerStatistics.EarlyFlushes.Add(float64(limit))
+	return rs
+}
+
+// forecast updates ingest rate of family, then predicts the duration before memory database reaches max size.
+func (s *flushScheduler) forecast(family DataFamily, now, maxMemDBSize int64) time.Duration {
+	size := family.MemDBSize()
+	indicator := family.Indicator()
+	rate, ok := s.rates[indicator]
+	if !ok {
+		s.rates[indicator] = &familyIngestRate{lastSize: size, lastTime: now, lastRound: s.round}
+		return time.Duration(math.MaxInt64)
+	}
+	rate.lastRound = s.round
+	elapsed := float64(now-rate.lastTime) / 1000
+	if elapsed > 0 {
+		growth := size - rate.lastSize
+		if growth < 0 {
+			// memory database flushed, new memory database grows from zero
+			growth = size
+		}
+		rate.rate = ingestRateAlpha*(float64(growth)/elapsed) + (1-ingestRateAlpha)*rate.rate
+		rate.lastSize = size
+		rate.lastTime = now
+	}
+	if size >= maxMemDBSize {
+		return 0
+	}
+	if rate.rate <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	seconds := float64(maxMemDBSize-size) / rate.rate
+	if seconds >= math.MaxInt64/float64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
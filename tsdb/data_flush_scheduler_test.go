@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+func TestFlushScheduler_schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+	cfg := config.GlobalStorageConfig()
+	cfg.TSDB.MaxMemDBSize = ltoml.Size(1000)
+	cfg.TSDB.FlushConcurrency = 1
+	config.SetGlobalStorageConfig(cfg)
+
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("db").AnyTimes()
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	family1 := NewMockDataFamily(ctrl)
+	family1.EXPECT().Indicator().Return("family1").AnyTimes()
+	family1.EXPECT().Shard().Return(shard).AnyTimes()
+	family1.EXPECT().IsFlushing().Return(false).AnyTimes()
+	family2 := NewMockDataFamily(ctrl)
+	family2.EXPECT().Indicator().Return("family2").AnyTimes()
+	family2.EXPECT().Shard().Return(shard).AnyTimes()
+	family2.EXPECT().IsFlushing().Return(false).AnyTimes()
+
+	s := newFlushScheduler()
+	// first round, no ingest rate
+	family1.EXPECT().MemDBSize().Return(int64(100))
+	family2.EXPECT().MemDBSize().Return(int64(100))
+	assert.Empty(t, s.schedule([]DataFamily{family1, family2}, 0, time.Minute))
+	assert.Len(t, s.rates, 2)
+	// second round, family1 grows slowly, family2 grows quickly
+	family1.EXPECT().MemDBSize().Return(int64(110))
+	family2.EXPECT().MemDBSize().Return(int64(500))
+	assert.Empty(t, s.schedule([]DataFamily{family1, family2}, 10*time.Second.Milliseconds(), time.Second))
+	family1.EXPECT().MemDBSize().Return(int64(120))
+	family2.EXPECT().MemDBSize().Return(int64(900))
+	assert.Equal(t, []DataFamily{family2}, s.schedule([]DataFamily{family1, family2}, 20*time.Second.Milliseconds(), time.Minute))
+	// both families need flush, but pick the earliest one
+	family1.EXPECT().MemDBSize().Return(int64(1000))
+	family2.EXPECT().MemDBSize().Return(int64(950))
+	assert.Equal(t, []DataFamily{family1}, s.schedule([]DataFamily{family1, family2}, 30*time.Second.Milliseconds(), time.Minute))
+	// family2 flushed, memory database grows from zero
+	family2.EXPECT().MemDBSize().Return(int64(10))
+	assert.Empty(t, s.schedule([]DataFamily{family2}, 40*time.Second.Milliseconds(), time.Second))
+	// family1 is removed
+	assert.Len(t, s.rates, 1)
+}
+
+func TestFlushScheduler_forecast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := NewMockDataFamily(ctrl)
+	family.EXPECT().Indicator().Return("family").AnyTimes()
+	s := newFlushScheduler()
+	family.EXPECT().MemDBSize().Return(int64(100))
+	assert.Equal(t, time.Duration(math.MaxInt64), s.forecast(family, 0, 1000))
+	// no growth
+	family.EXPECT().MemDBSize().Return(int64(100))
+	assert.Equal(t, time.Duration(math.MaxInt64), s.forecast(family, 1000, 1000))
+	// same time
+	family.EXPECT().MemDBSize().Return(int64(100))
+	assert.Equal(t, time.Duration(math.MaxInt64), s.forecast(family, 1000, 1000))
+	// grows 300 bytes/s, rate = 90 bytes/s
+	family.EXPECT().MemDBSize().Return(int64(400))
+	assert.InDelta(t, 600/90.0, s.forecast(family, 2000, 1000).Seconds(), 0.0001)
+	// reach max size
+	family.EXPECT().MemDBSize().Return(int64(1000))
+	assert.Zero(t, s.forecast(family, 3000, 1000))
+}
@@ -21,12 +21,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"time"
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/kv"
@@ -38,6 +40,9 @@ import (
 	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
 )
 
+// metaSmallFileCompactThreshold represents the number of adjacent small files which triggers meta family compaction.
+const metaSmallFileCompactThreshold = 8
+
 //go:generate mockgen -source=./database.go -destination=./database_mock.go -package=tsdb
 
 // Database represents an abstract time series database
@@ -76,6 +81,21 @@ type Database interface {
 	SetLimits(limits *models.Limits)
 	// GetLimits returns database's limits.
 	GetLimits() *models.Limits
+	// CheckDiskUsage calculates the disk usage of database, then enforces disk quota if configured.
+	CheckDiskUsage()
+	// GetDiskUsage returns the disk usage of database calculated by last check, return nil if not checked.
+	GetDiskUsage() *models.DatabaseDiskUsage
+	// CheckDiskQuota returns error if rejects write because disk usage exceeds disk quota.
+	CheckDiskQuota() error
+	// FieldUsage returns the tracker of query-time field access counts.
+	FieldUsage() FieldUsageTracker
+	// GetFieldUsage returns the usage of all fields of metric since given timestamp, includes never accessed fields.
+	GetFieldUsage(namespace, metricName string, since int64) (models.FieldUsages, error)
+	// ExpireUnusedMetrics removes the metadata(name/schema/series index) of metrics which received no writes and
+	// no queries for the duration of metric expiry option, only reports the candidates if dry-run policy.
+	ExpireUnusedMetrics()
+	// GetExpiredMetrics returns the expired metrics found by last check, includes the candidates of dry-run policy.
+	GetExpiredMetrics() models.ExpiredMetrics
 }
 
 // database implements Database for storing families,
@@ -92,6 +112,10 @@ type database struct {
 	isFlushing     atomic.Bool            // restrict flusher concurrency
 	flushCondition *sync.Cond             // flush condition
 	limits         atomic.Value           // store models.Limits
+	diskUsage      atomic.Value           // store models.DatabaseDiskUsage calculated by last check
+	quotaExceeded  atomic.Bool            // disk usage exceeds disk quota
+	fieldUsage     FieldUsageTracker      // query-time field access counts
+	metricExpirer  *metricExpirer         // unused metric expiry
 
 	statistics *metrics.DatabaseStatistics
 
@@ -142,6 +166,8 @@ func newDatabase(
 		return nil, err0
 	}
 	db.dir = dbPath
+	db.fieldUsage = newFieldUsageTracker(filepath.Join(dbPath, fieldUsageFile))
+	db.metricExpirer = newMetricExpirer(filepath.Join(dbPath, metricActivityFile))
 	if err := db.dumpDatabaseConfig(cfg); err != nil {
 		return nil, err
 	}
@@ -191,6 +217,108 @@ func (db *database) GetLimits() *models.Limits {
 	return db.limits.Load().(*models.Limits)
 }
 
+// CheckDiskUsage calculates the disk usage of database, then enforces disk quota if configured:
+// 1. reject write(default policy)
+// 2. drop the oldest segment of each shard(ttl policy)
+func (db *database) CheckDiskUsage() {
+	opt := db.GetOption()
+	usage := &models.DatabaseDiskUsage{
+		Database: db.name,
+		Meta:     calcDirSize(metricsMetaPath(db.name)),
+		Quota:    int64(opt.DiskQuota),
+	}
+	shards := db.shardSet.Entries()
+	for _, shard := range shards {
+		usage.Shards = append(usage.Shards, shard.shard.GetDiskUsage())
+	}
+	usage.QuotaExceeded = usage.Quota > 0 && usage.Total() > usage.Quota
+	db.diskUsage.Store(usage)
+	db.quotaExceeded.Store(usage.QuotaExceeded)
+
+	db.statistics.MetaDiskUsage.Update(float64(usage.Meta))
+	db.statistics.DiskQuota.Update(float64(usage.Quota))
+	if !usage.QuotaExceeded {
+		return
+	}
+	db.statistics.DiskQuotaExceeded.Incr()
+	engineLogger.Warn("disk usage of database exceeds disk quota",
+		logger.String("db", db.name), logger.Any("usage", usage.Total()),
+		logger.Any("quota", usage.Quota), logger.String("policy", opt.DiskQuotaPolicy))
+	if opt.IsAccelerateTTL() {
+		for _, shard := range shards {
+			shard.shard.ExpireOldestSegment()
+		}
+	}
+}
+
+// GetDiskUsage returns the disk usage of database calculated by last check, return nil if not checked.
+func (db *database) GetDiskUsage() *models.DatabaseDiskUsage {
+	usage, ok := db.diskUsage.Load().(*models.DatabaseDiskUsage)
+	if !ok {
+		return nil
+	}
+	return usage
+}
+
+// CheckDiskQuota returns error if rejects write because disk usage exceeds disk quota.
+func (db *database) CheckDiskQuota() error {
+	if db.quotaExceeded.Load() && !db.GetOption().IsAccelerateTTL() {
+		return constants.ErrDiskQuotaExceeded
+	}
+	return nil
+}
+
+// FieldUsage returns the tracker of query-time field access counts.
+func (db *database) FieldUsage() FieldUsageTracker {
+	return db.fieldUsage
+}
+
+// GetFieldUsage returns the usage of all fields of metric since given timestamp, includes never accessed fields.
+func (db *database) GetFieldUsage(namespace, metricName string, since int64) (models.FieldUsages, error) {
+	fields, err := db.metadata.MetadataDatabase().GetAllFields(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	rs := make(models.FieldUsages, 0, len(fields))
+	for _, f := range fields {
+		count, lastAccess := db.fieldUsage.GetUsage(namespace, metricName, f.Name, since)
+		rs = append(rs, &models.FieldUsage{
+			Namespace:  namespace,
+			Metric:     metricName,
+			Field:      string(f.Name),
+			Type:       f.Type.String(),
+			Count:      count,
+			LastAccess: lastAccess,
+		})
+	}
+	return rs, nil
+}
+
+// ExpireUnusedMetrics removes the metadata(name/schema/series index) of metrics which received no writes and
+// no queries for the duration of metric expiry option, only reports the candidates if dry-run policy.
+func (db *database) ExpireUnusedMetrics() {
+	opt := db.GetOption()
+	expireAfter := opt.GetMetricExpireAfter()
+	if expireAfter <= 0 {
+		return
+	}
+	expired, err := db.metricExpirer.expire(db, expireAfter, opt.IsMetricExpiryDryRun())
+	if err != nil {
+		engineLogger.Warn("expire unused metrics failure",
+			logger.String("db", db.name), logger.Error(err))
+	}
+	if len(expired) > 0 {
+		engineLogger.Info("found unused metrics",
+			logger.String("db", db.name), logger.Any("metrics", len(expired)),
+			logger.Any("dryRun", opt.IsMetricExpiryDryRun()))
+	}
+}
+
+// GetExpiredMetrics returns the expired metrics found by last check, includes the candidates of dry-run policy.
+func (db *database) GetExpiredMetrics() models.ExpiredMetrics {
+	return db.metricExpirer.getReport()
+}
+
 // Metadata returns the metadata include metric/tag
 func (db *database) Metadata() metadb.Metadata {
 	return db.metadata
@@ -278,6 +406,11 @@ func (db *database) Close() error {
 	// wait previous flush job completed
 	db.WaitFlushMetaCompleted()
 
+	if err := db.fieldUsage.Aggregate(); err != nil {
+		engineLogger.Warn("persist field usage statistics failure when close database",
+			logger.String("db", db.name), logger.Error(err))
+	}
+
 	if err := db.metadata.Close(); err != nil {
 		return err
 	}
@@ -332,7 +465,9 @@ func (db *database) initMetadata() error {
 		tagValueDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagkeymeta.MergerName)})
+			// tag value trie of new tag key is flushed as small file, merge them in background.
+			SmallFileCompactThreshold: metaSmallFileCompactThreshold,
+			Merger:                    string(tagkeymeta.MergerName)})
 	if err != nil {
 		return err
 	}
@@ -20,6 +20,7 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
@@ -35,6 +37,7 @@ import (
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
 
@@ -317,6 +320,7 @@ func TestDatabase_Close(t *testing.T) {
 		shardSet:       *newShardSet(),
 		metaStore:      store,
 		flushCondition: sync.NewCond(&sync.Mutex{}),
+		fieldUsage:     newFieldUsageTracker(filepath.Join(t.TempDir(), fieldUsageFile)),
 	}
 	cases := []struct {
 		name    string
@@ -523,6 +527,7 @@ func TestDatabase_Drop(t *testing.T) {
 		shardSet:       *newShardSet(),
 		isFlushing:     *atomic.NewBool(false),
 		flushCondition: sync.NewCond(&sync.Mutex{}),
+		fieldUsage:     newFieldUsageTracker(filepath.Join(t.TempDir(), fieldUsageFile)),
 	}
 	store.EXPECT().Name().Return("test").AnyTimes()
 	metadata.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -663,3 +668,82 @@ func Benchmark_ShardSet_binarySearch(b *testing.B) {
 		}
 	})
 }
+
+func TestDatabase_CheckDiskUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		dirSize = fileutil.DirSize
+		ctrl.Finish()
+	}()
+
+	set := newShardSet()
+	shard1 := NewMockShard(ctrl)
+	set.InsertShard(models.ShardID(0), shard1)
+	opt := &option.DatabaseOption{}
+	db := &database{
+		name:       "test",
+		shardSet:   *set,
+		config:     &models.DatabaseConfig{Option: opt},
+		statistics: metrics.NewDatabaseStatistics("test"),
+	}
+	assert.Nil(t, db.GetDiskUsage())
+	dirSize = func(path string) (int64, error) {
+		return 10, nil
+	}
+	shard1.EXPECT().GetDiskUsage().Return(models.ShardDiskUsage{ShardID: 0, Data: 100}).AnyTimes()
+	// disk quota not set
+	db.CheckDiskUsage()
+	assert.Equal(t, &models.DatabaseDiskUsage{
+		Database: "test",
+		Meta:     10,
+		Shards:   []models.ShardDiskUsage{{ShardID: 0, Data: 100}},
+	}, db.GetDiskUsage())
+	assert.NoError(t, db.CheckDiskQuota())
+	// disk quota not exceeded
+	opt.DiskQuota = 1024
+	db.CheckDiskUsage()
+	assert.False(t, db.GetDiskUsage().QuotaExceeded)
+	assert.NoError(t, db.CheckDiskQuota())
+	// disk quota exceeded, reject write
+	opt.DiskQuota = 100
+	db.CheckDiskUsage()
+	assert.True(t, db.GetDiskUsage().QuotaExceeded)
+	assert.Equal(t, constants.ErrDiskQuotaExceeded, db.CheckDiskQuota())
+	// disk quota exceeded, accelerate ttl
+	opt.DiskQuotaPolicy = option.DiskQuotaAccelerateTTL
+	shard1.EXPECT().ExpireOldestSegment()
+	db.CheckDiskUsage()
+	assert.True(t, db.GetDiskUsage().QuotaExceeded)
+	assert.NoError(t, db.CheckDiskQuota())
+}
+
+func TestDatabase_GetFieldUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	db := &database{
+		metadata:   metadata,
+		fieldUsage: newFieldUsageTracker(filepath.Join(t.TempDir(), fieldUsageFile)),
+	}
+	metadataDB.EXPECT().GetAllFields("ns", "cpu").Return(nil, fmt.Errorf("err"))
+	rs, err := db.GetFieldUsage("ns", "cpu", 0)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+
+	db.FieldUsage().Record("ns", "cpu", []field.Name{"f1"})
+	metadataDB.EXPECT().GetAllFields("ns", "cpu").Return(field.Metas{
+		{Name: "f1", Type: field.SumField},
+		{Name: "f2", Type: field.LastField},
+	}, nil)
+	rs, err = db.GetFieldUsage("ns", "cpu", 0)
+	assert.NoError(t, err)
+	assert.Len(t, rs, 2)
+	assert.Equal(t, uint64(1), rs[0].Count)
+	assert.Equal(t, "sum", rs[0].Type)
+	assert.True(t, rs[0].LastAccess > 0)
+	assert.Zero(t, rs[1].Count)
+	assert.Zero(t, rs[1].LastAccess)
+}
@@ -0,0 +1,80 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+var diskUsageLogger = logger.GetLogger("TSDB", "DiskUsage")
+
+// calcDirSize returns the on-disk bytes of given dir, returns 0 if calculates failure.
+func calcDirSize(path string) int64 {
+	size, err := dirSize(path)
+	if err != nil {
+		diskUsageLogger.Warn("calculate disk usage of dir failure",
+			logger.String("path", path), logger.Error(err))
+		return 0
+	}
+	return size
+}
+
+// calcFamiliesDiskUsage returns the on-disk bytes of each data family under the segment root path of shard.
+// directory tree: segment/day/20191012/(family)
+func calcFamiliesDiskUsage(segmentRootPath string) (rs []models.FamilyDiskUsage) {
+	if !fileExist(segmentRootPath) {
+		return nil
+	}
+	walkDir := func(path string, fn func(name string)) {
+		names, err := listDir(path)
+		if err != nil {
+			diskUsageLogger.Warn("list dir failure when calculate disk usage",
+				logger.String("path", path), logger.Error(err))
+			return
+		}
+		// family name is number, sort it numerically
+		sort.Slice(names, func(i, j int) bool {
+			if len(names[i]) != len(names[j]) {
+				return len(names[i]) < len(names[j])
+			}
+			return names[i] < names[j]
+		})
+		for _, name := range names {
+			fn(name)
+		}
+	}
+	walkDir(segmentRootPath, func(intervalType string) {
+		intervalPath := filepath.Join(segmentRootPath, intervalType)
+		walkDir(intervalPath, func(segmentName string) {
+			segmentPath := filepath.Join(intervalPath, segmentName)
+			walkDir(segmentPath, func(familyName string) {
+				rs = append(rs, models.FamilyDiskUsage{
+					Interval: intervalType,
+					Segment:  segmentName,
+					Family:   familyName,
+					Size:     calcDirSize(filepath.Join(segmentPath, familyName)),
+				})
+			})
+		})
+	})
+	return rs
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestDiskUsage_calcDirSize(t *testing.T) {
+	defer func() {
+		dirSize = fileutil.DirSize
+	}()
+	dirSize = func(path string) (int64, error) {
+		return 0, fmt.Errorf("err")
+	}
+	assert.Zero(t, calcDirSize("test"))
+	dirSize = func(path string) (int64, error) {
+		return 10, nil
+	}
+	assert.Equal(t, int64(10), calcDirSize("test"))
+}
+
+func TestDiskUsage_calcFamiliesDiskUsage(t *testing.T) {
+	defer func() {
+		listDir = fileutil.GetDirectoryList
+	}()
+	dir := t.TempDir()
+	assert.Nil(t, calcFamiliesDiskUsage(filepath.Join(dir, "not_exist")))
+
+	for _, family := range []string{"2", "10"} {
+		familyPath := filepath.Join(dir, "day", "20220101", family)
+		assert.NoError(t, fileutil.MkDirIfNotExist(familyPath))
+		assert.NoError(t, os.WriteFile(filepath.Join(familyPath, "000001.sst"), []byte("12345"), 0600))
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "day", "20220101", "MANIFEST"), []byte("1"), 0600))
+	assert.Equal(t, []models.FamilyDiskUsage{
+		{Interval: "day", Segment: "20220101", Family: "2", Size: 5},
+		{Interval: "day", Segment: "20220101", Family: "10", Size: 5},
+	}, calcFamiliesDiskUsage(dir))
+
+	listDir = func(path string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Nil(t, calcFamiliesDiskUsage(dir))
+}
@@ -242,6 +242,8 @@ Level2 (KV table: Series Bucket Footer)
 ├──────────┼──────────┼──────────┼──────────┼──────────┤
 │  4 Byte  │ 4 Bytes  │ 4 Bytes  │ 4 Bytes  │  4 Bytes │
 └──────────┴──────────┴──────────┴──────────┴──────────┘
+Since format version 1, one byte version is written before footer,
+and the highest bit of start time slot is set as version flag.
 
 
 Level2(SingleField Meta)
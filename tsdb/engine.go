@@ -20,13 +20,17 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source=./engine.go -destination=./engine_mock.go -package=tsdb
@@ -63,6 +67,18 @@ type Engine interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// CheckDiskUsage calculates the disk usage of each database, then enforces disk quota if configured.
+	CheckDiskUsage()
+	// AggregateFieldUsage aggregates the query-time field access counts of each database into daily buckets.
+	AggregateFieldUsage()
+	// ExpireUnusedMetrics removes the metadata of unused metrics for each database which enables metric expiry.
+	ExpireUnusedMetrics()
+	// SetCompactionPaused pauses/resumes background compaction of database, pauses/resumes all databases if database is empty.
+	SetCompactionPaused(database string, paused bool)
+	// GetCompactionState returns the pause state and pending compaction backlog of databases.
+	GetCompactionState() *models.CompactionState
+	// GetCompactionJobs returns the running/queued compact and rollup jobs, filters by database if database is set.
+	GetCompactionJobs(database string) models.CompactionJobs
 	// Close closes the cached time series databases
 	Close()
 }
@@ -247,6 +263,98 @@ func (e *engine) EvictSegment() {
 	}
 }
 
+// CheckDiskUsage calculates the disk usage of each database, then enforces disk quota if configured.
+func (e *engine) CheckDiskUsage() {
+	for _, db := range e.dbSet.Entries() {
+		db.CheckDiskUsage()
+	}
+}
+
+// AggregateFieldUsage aggregates the query-time field access counts of each database into daily buckets.
+func (e *engine) AggregateFieldUsage() {
+	for _, db := range e.dbSet.Entries() {
+		if err := db.FieldUsage().Aggregate(); err != nil {
+			engineLogger.Warn("aggregate field usage statistics failure",
+				logger.String("db", db.Name()), logger.Error(err))
+		}
+	}
+}
+
+// ExpireUnusedMetrics removes the metadata of unused metrics for each database which enables metric expiry.
+func (e *engine) ExpireUnusedMetrics() {
+	for _, db := range e.dbSet.Entries() {
+		db.ExpireUnusedMetrics()
+	}
+}
+
+// SetCompactionPaused pauses/resumes background compaction of database, pauses/resumes all databases if database is empty.
+// NOTE: running compaction jobs are not interrupted, pause state resets after storage node restarted.
+func (e *engine) SetCompactionPaused(database string, paused bool) {
+	kv.SetCompactionPaused(database, paused)
+}
+
+// GetCompactionState returns the pause state and pending compaction backlog of databases.
+func (e *engine) GetCompactionState() *models.CompactionState {
+	paused, pausedDatabases := kv.GetPausedCompaction()
+	state := &models.CompactionState{Paused: paused, PausedDatabases: pausedDatabases}
+	backlogs := make(map[string]*models.CompactionBacklog)
+	// stores are sorted by name, store name starts with database name
+	for _, storeBacklog := range kv.GetCompactionBacklogs() {
+		database := storeDatabase(storeBacklog.Store)
+		backlog, ok := backlogs[database]
+		if !ok {
+			backlog = &models.CompactionBacklog{Database: database}
+			backlogs[database] = backlog
+			state.Backlogs = append(state.Backlogs, backlog)
+		}
+		backlog.Stores++
+		backlog.Level0Files += storeBacklog.Level0Files
+		backlog.PendingFamilies += storeBacklog.PendingFamilies
+		backlog.Compacting += storeBacklog.Compacting
+	}
+	for _, database := range pausedDatabases {
+		if backlog, ok := backlogs[database]; ok {
+			backlog.Paused = true
+		}
+	}
+	return state
+}
+
+// GetCompactionJobs returns the running/queued compact and rollup jobs, filters by database if database is set.
+func (e *engine) GetCompactionJobs(database string) models.CompactionJobs {
+	now := timeutil.Now()
+	var rs models.CompactionJobs
+	for _, job := range kv.GetCompactJobs() {
+		db := storeDatabase(job.Store)
+		if database != "" && database != db {
+			continue
+		}
+		var duration int64
+		if job.StartTime > 0 {
+			duration = now - job.StartTime
+		}
+		rs = append(rs, &models.CompactionJob{
+			Database:   db,
+			Store:      job.Store,
+			Family:     job.Family,
+			Type:       job.Type,
+			Status:     job.Status,
+			Level:      job.Level,
+			InputFiles: job.InputFiles,
+			InputBytes: job.InputBytes,
+			ReadBytes:  job.ReadBytes,
+			StartTime:  job.StartTime,
+			Duration:   duration,
+		})
+	}
+	return rs
+}
+
+// storeDatabase returns the database of kv store, store name starts with database name.
+func storeDatabase(storeName string) string {
+	return strings.SplitN(filepath.ToSlash(storeName), "/", 2)[0]
+}
+
 // load the time series engines if exist
 func (e *engine) load() error {
 	databaseNames, err := listDir(config.GlobalStorageConfig().TSDB.Dir)
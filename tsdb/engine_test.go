@@ -30,6 +30,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/ltoml"
@@ -505,3 +506,70 @@ func BenchmarkEngine_DatabaseWithLockFreeSlice(b *testing.B) {
 		}
 	})
 }
+
+func TestEngine_CheckDiskUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _ := NewEngine()
+	engineImpl := e.(*engine)
+	mockDatabase1 := NewMockDatabase(ctrl)
+	engineImpl.dbSet.PutDatabase("test_db_1", mockDatabase1)
+	mockDatabase1.EXPECT().CheckDiskUsage()
+	e.CheckDiskUsage()
+}
+
+func TestEngine_AggregateFieldUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _ := NewEngine()
+	engineImpl := e.(*engine)
+	mockDatabase1 := NewMockDatabase(ctrl)
+	engineImpl.dbSet.PutDatabase("test_db_1", mockDatabase1)
+	tracker := NewMockFieldUsageTracker(ctrl)
+	mockDatabase1.EXPECT().FieldUsage().Return(tracker).AnyTimes()
+	mockDatabase1.EXPECT().Name().Return("test_db_1").AnyTimes()
+	tracker.EXPECT().Aggregate().Return(fmt.Errorf("err"))
+	e.AggregateFieldUsage()
+	tracker.EXPECT().Aggregate().Return(nil)
+	e.AggregateFieldUsage()
+}
+
+func TestEngine_Compaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		kv.InitStoreManager(nil)
+		kv.SetCompactionPaused("db1", false)
+		ctrl.Finish()
+	}()
+
+	e, _ := NewEngine()
+	storeMgr := kv.NewMockStoreManager(ctrl)
+	kv.InitStoreManager(storeMgr)
+	var stores []kv.Store
+	for _, name := range []string{"db1/meta/tag", "db1/shard/1/index", "db2/meta/tag"} {
+		store := kv.NewMockStore(ctrl)
+		store.EXPECT().Name().Return(name).AnyTimes()
+		store.EXPECT().ListFamilyNames().Return(nil).AnyTimes()
+		stores = append(stores, store)
+	}
+	storeMgr.EXPECT().GetStores().Return(stores).AnyTimes()
+
+	e.SetCompactionPaused("db1", true)
+	state := e.GetCompactionState()
+	assert.False(t, state.Paused)
+	assert.Equal(t, []string{"db1"}, state.PausedDatabases)
+	assert.Equal(t, []*models.CompactionBacklog{
+		{Database: "db1", Paused: true, Stores: 2},
+		{Database: "db2", Stores: 1},
+	}, state.Backlogs)
+
+	e.SetCompactionPaused("db1", false)
+	state = e.GetCompactionState()
+	assert.Empty(t, state.PausedDatabases)
+	assert.False(t, state.Backlogs[0].Paused)
+	// no running/queued jobs
+	assert.Empty(t, e.GetCompactionJobs(""))
+	assert.Empty(t, e.GetCompactionJobs("db1"))
+}
@@ -0,0 +1,223 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"strconv"
+	"sync"
+
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+)
+
+//go:generate mockgen -source=./field_usage.go -destination=./field_usage_mock.go -package=tsdb
+
+const (
+	// fieldUsageFile represents the file name of field usage statistics under database dir.
+	fieldUsageFile = "FIELD_USAGE"
+	// fieldUsageRetention represents how long the daily access counts of field are kept.
+	fieldUsageRetention = 90 * timeutil.OneDay
+)
+
+// FieldUsageTracker tracks the query-time access counts of metric's fields,
+// the access counts are aggregated into daily buckets periodically and persisted under database dir.
+type FieldUsageTracker interface {
+	// Record records the fields of metric accessed by query.
+	Record(namespace, metricName string, fields []field.Name)
+	// GetUsage returns the access count since given timestamp and the last access time of metric's field.
+	GetUsage(namespace, metricName string, fieldName field.Name, since int64) (count uint64, lastAccess int64)
+	// GetLastAccess returns the last access time of all fields of metric, returns 0 if never accessed.
+	GetLastAccess(namespace, metricName string) int64
+	// Remove removes the statistics of metric's fields.
+	Remove(namespace, metricName string)
+	// Aggregate aggregates the access counts recorded since last aggregation into daily buckets,
+	// drops the expired buckets, then persists the statistics.
+	Aggregate() error
+}
+
+// fieldUsageStat represents the access statistics of field.
+type fieldUsageStat struct {
+	LastAccess int64             `toml:"last-access"`
+	Days       map[string]uint64 `toml:"days"` // start time of day => access count
+}
+
+// fieldUsageData represents the persisted data of field usage statistics.
+type fieldUsageData struct {
+	Metrics map[string]map[string]*fieldUsageStat `toml:"metrics"` // namespace|metric => field name => stat
+}
+
+// fieldUsageTracker implements FieldUsageTracker interface.
+type fieldUsageTracker struct {
+	path    string
+	pending map[string]map[string]uint64 // access counts not aggregated
+	stats   map[string]map[string]*fieldUsageStat
+
+	mutex  sync.Mutex
+	logger *logger.Logger
+}
+
+// newFieldUsageTracker creates field usage tracker, loads the statistics from file if exist.
+func newFieldUsageTracker(path string) FieldUsageTracker {
+	t := &fieldUsageTracker{
+		path:    path,
+		pending: make(map[string]map[string]uint64),
+		stats:   make(map[string]map[string]*fieldUsageStat),
+		logger:  logger.GetLogger("TSDB", "FieldUsage"),
+	}
+	if !fileExist(path) {
+		return t
+	}
+	data := &fieldUsageData{}
+	if err := decodeToml(path, data); err != nil {
+		// statistics is only for reference, discard it if it is corrupted
+		t.logger.Warn("load field usage statistics failure, discard it",
+			logger.String("path", path), logger.Error(err))
+		return t
+	}
+	for key, fields := range data.Metrics {
+		t.stats[key] = fields
+	}
+	return t
+}
+
+// Record records the fields of metric accessed by query.
+func (t *fieldUsageTracker) Record(namespace, metricName string, fields []field.Name) {
+	if len(fields) == 0 {
+		return
+	}
+	key := commonseries.JoinNamespaceMetric(namespace, metricName)
+	now := timeutil.Now()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	pending, ok := t.pending[key]
+	if !ok {
+		pending = make(map[string]uint64)
+		t.pending[key] = pending
+	}
+	for _, fieldName := range fields {
+		pending[string(fieldName)]++
+		t.getOrCreateStat(key, string(fieldName)).LastAccess = now
+	}
+}
+
+// GetUsage returns the access count since given timestamp and the last access time of metric's field.
+func (t *fieldUsageTracker) GetUsage(namespace, metricName string, fieldName field.Name, since int64) (count uint64, lastAccess int64) {
+	key := commonseries.JoinNamespaceMetric(namespace, metricName)
+	sinceDay := timeutil.Truncate(since, timeutil.OneDay)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	count = t.pending[key][string(fieldName)]
+	stat, ok := t.stats[key][string(fieldName)]
+	if !ok {
+		return count, 0
+	}
+	for day, c := range stat.Days {
+		dayTime, err := strconv.ParseInt(day, 10, 64)
+		if err == nil && dayTime >= sinceDay {
+			count += c
+		}
+	}
+	return count, stat.LastAccess
+}
+
+// GetLastAccess returns the last access time of all fields of metric, returns 0 if never accessed.
+func (t *fieldUsageTracker) GetLastAccess(namespace, metricName string) (lastAccess int64) {
+	key := commonseries.JoinNamespaceMetric(namespace, metricName)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, stat := range t.stats[key] {
+		if stat.LastAccess > lastAccess {
+			lastAccess = stat.LastAccess
+		}
+	}
+	return lastAccess
+}
+
+// Remove removes the statistics of metric's fields.
+func (t *fieldUsageTracker) Remove(namespace, metricName string) {
+	key := commonseries.JoinNamespaceMetric(namespace, metricName)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.pending, key)
+	delete(t.stats, key)
+}
+
+// Aggregate aggregates the access counts recorded since last aggregation into daily buckets,
+// drops the expired buckets, then persists the statistics.
+func (t *fieldUsageTracker) Aggregate() error {
+	now := timeutil.Now()
+	day := strconv.FormatInt(timeutil.Truncate(now, timeutil.OneDay), 10)
+	expired := timeutil.Truncate(now-fieldUsageRetention, timeutil.OneDay)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for key, fields := range t.pending {
+		for fieldName, count := range fields {
+			t.getOrCreateStat(key, fieldName).Days[day] += count
+		}
+	}
+	t.pending = make(map[string]map[string]uint64)
+
+	for key, fields := range t.stats {
+		for fieldName, stat := range fields {
+			for d := range stat.Days {
+				if dayTime, err := strconv.ParseInt(d, 10, 64); err != nil || dayTime < expired {
+					delete(stat.Days, d)
+				}
+			}
+			if len(stat.Days) == 0 && stat.LastAccess < expired {
+				delete(fields, fieldName)
+			}
+		}
+		if len(fields) == 0 {
+			delete(t.stats, key)
+		}
+	}
+	return encodeToml(t.path, &fieldUsageData{Metrics: t.stats})
+}
+
+// getOrCreateStat returns the access statistics of field, creates it if not exist,
+// !!!!! NOTICE: must hold lock.
+func (t *fieldUsageTracker) getOrCreateStat(key, fieldName string) *fieldUsageStat {
+	fields, ok := t.stats[key]
+	if !ok {
+		fields = make(map[string]*fieldUsageStat)
+		t.stats[key] = fields
+	}
+	stat, ok := fields[fieldName]
+	if !ok {
+		stat = &fieldUsageStat{}
+		fields[fieldName] = stat
+	}
+	if stat.Days == nil {
+		stat.Days = make(map[string]uint64)
+	}
+	return stat
+}
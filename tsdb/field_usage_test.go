@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+)
+
+func TestFieldUsageTracker(t *testing.T) {
+	defer func() {
+		encodeToml = ltoml.EncodeToml
+	}()
+	path := filepath.Join(t.TempDir(), fieldUsageFile)
+	tracker := newFieldUsageTracker(path)
+	tracker.Record("ns", "cpu", nil)
+	tracker.Record("ns", "cpu", []field.Name{"f1", "f2"})
+	tracker.Record("ns", "cpu", []field.Name{"f1"})
+
+	count, lastAccess := tracker.GetUsage("ns", "cpu", "f1", 0)
+	assert.Equal(t, uint64(2), count)
+	assert.True(t, lastAccess > 0)
+	count, lastAccess = tracker.GetUsage("ns", "cpu", "f3", 0)
+	assert.Zero(t, count)
+	assert.Zero(t, lastAccess)
+
+	assert.NoError(t, tracker.Aggregate())
+	count, _ = tracker.GetUsage("ns", "cpu", "f1", timeutil.Now())
+	assert.Equal(t, uint64(2), count)
+	// access counts before since are excluded
+	count, _ = tracker.GetUsage("ns", "cpu", "f1", timeutil.Now()+timeutil.OneDay)
+	assert.Zero(t, count)
+
+	// load from file
+	tracker = newFieldUsageTracker(path)
+	tracker.Record("ns", "cpu", []field.Name{"f2"})
+	count, _ = tracker.GetUsage("ns", "cpu", "f2", 0)
+	assert.Equal(t, uint64(2), count)
+
+	// drop expired statistics
+	expired := timeutil.Truncate(timeutil.Now()-fieldUsageRetention-timeutil.OneDay, timeutil.OneDay)
+	tracker1 := tracker.(*fieldUsageTracker)
+	tracker1.stats["ns|mem"] = map[string]*fieldUsageStat{
+		"f1": {LastAccess: expired, Days: map[string]uint64{fmt.Sprintf("%d", expired): 10}},
+	}
+	tracker1.stats["ns|cpu"]["f1"].Days["invalid"] = 10
+	assert.NoError(t, tracker.Aggregate())
+	count, lastAccess = tracker.GetUsage("ns", "mem", "f1", 0)
+	assert.Zero(t, count)
+	assert.Zero(t, lastAccess)
+	count, _ = tracker.GetUsage("ns", "cpu", "f1", 0)
+	assert.Equal(t, uint64(2), count)
+
+	// persist failure
+	encodeToml = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, tracker.Aggregate())
+}
+
+func TestFieldUsageTracker_Corrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), fieldUsageFile)
+	assert.NoError(t, os.WriteFile(path, []byte("corrupted"), 0o600))
+	tracker := newFieldUsageTracker(path)
+	count, lastAccess := tracker.GetUsage("ns", "cpu", "f1", 0)
+	assert.Zero(t, count)
+	assert.Zero(t, lastAccess)
+}
+
+func TestFieldUsageTracker_LastAccess(t *testing.T) {
+	tracker := newFieldUsageTracker(filepath.Join(t.TempDir(), fieldUsageFile))
+	assert.Zero(t, tracker.GetLastAccess("ns", "cpu"))
+	tracker.Record("ns", "cpu", []field.Name{"f1", "f2"})
+	assert.True(t, tracker.GetLastAccess("ns", "cpu") > 0)
+
+	tracker.Remove("ns", "cpu")
+	assert.Zero(t, tracker.GetLastAccess("ns", "cpu"))
+	count, _ := tracker.GetUsage("ns", "cpu", "f1", 0)
+	assert.Zero(t, count)
+}
@@ -105,6 +105,21 @@ func shardIndexIndicator(database string, shardID models.ShardID) string {
 	return filepath.Join(shardIndicator(database, shardID), indexParentDir)
 }
 
+// shardIndexPath returns shard level index path.
+func shardIndexPath(database string, shardID models.ShardID) string {
+	return filepath.Join(config.GlobalStorageConfig().TSDB.Dir, shardIndexIndicator(database, shardID))
+}
+
+// shardSegmentRootPath returns the root path of all interval segments in shard dir.
+func shardSegmentRootPath(database string, shardID models.ShardID) string {
+	return filepath.Join(shardPath(database, shardID), segmentDir)
+}
+
+// shardWALPath returns write ahead log path of shard, wal dir: base dir + database + shard.
+func shardWALPath(database string, shardID models.ShardID) string {
+	return filepath.Join(config.GlobalStorageConfig().WAL.Dir, database, strconv.Itoa(int(shardID)))
+}
+
 // shardMetaPath returns shard level metadata path.
 func shardMetaPath(database string, shardID models.ShardID) string {
 	return filepath.Join(shardPath(database, shardID), metaDir)
@@ -23,6 +23,8 @@ import (
 	"io"
 	"path"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fileutil"
@@ -41,6 +43,14 @@ var (
 
 const SeriesDB = "series"
 
+// tombstonesKeySuffix is the suffix of tombstones key(metric id + suffix),
+// the length of key is different with series sequence key(metric id) and series key(metric id + tags hash).
+const tombstonesKeySuffix = byte('t')
+
+// tombstonedMetricsKey is the key of metric ids which have tombstones,
+// the length of key is different with all keys under metric.
+var tombstonedMetricsKey = []byte{tombstonesKeySuffix}
+
 // IDMappingBackend represents the id mapping backend storage,
 // save series data(tags hash => series id) under metric
 type IDMappingBackend interface {
@@ -53,6 +63,20 @@ type IDMappingBackend interface {
 	getSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, err error)
 	// genSeries generates series id by metric id/tags hash.
 	genSeriesID(metricID metric.ID, tagsHash uint64, seriesID uint32) error
+	// loadTombstones loads the deleted series ids of metric, returns nil if no series deleted.
+	loadTombstones(metricID metric.ID) (*roaring.Bitmap, error)
+	// saveTombstones persists the deleted series ids of metric.
+	saveTombstones(metricID metric.ID, seriesIDs *roaring.Bitmap) error
+	// removeTombstones removes the deleted series ids of metric.
+	removeTombstones(metricID metric.ID) error
+	// loadTombstonedMetrics loads the metric ids which have tombstones,
+	// rebuilds them from tombstones of all metrics if not persisted before.
+	loadTombstonedMetrics() (*roaring.Bitmap, error)
+	// saveTombstonedMetrics persists the metric ids which have tombstones.
+	saveTombstonedMetrics(metricIDs *roaring.Bitmap) error
+	// removeSeriesIDs removes the mapping(tags hash => series id) of given series ids under metric,
+	// so that the series with same tags generates new series id after deleted.
+	removeSeriesIDs(metricID metric.ID, seriesIDs *roaring.Bitmap) (removed int, err error)
 	// sync the backend memory data into persist storage.
 	sync() error
 }
@@ -134,6 +158,100 @@ func (imb *idMappingBackend) genSeriesID(metricID metric.ID, tagsHash uint64, se
 	return imb.db.Put(key, scratch[:])
 }
 
+// loadTombstones loads the deleted series ids of metric, returns nil if no series deleted.
+func (imb *idMappingBackend) loadTombstones(metricID metric.ID) (*roaring.Bitmap, error) {
+	val, exist, err := imb.db.Get(tombstonesKey(metricID))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+	seriesIDs := roaring.New()
+	if err = seriesIDs.UnmarshalBinary(val); err != nil {
+		return nil, err
+	}
+	return seriesIDs, nil
+}
+
+// saveTombstones persists the deleted series ids of metric.
+func (imb *idMappingBackend) saveTombstones(metricID metric.ID, seriesIDs *roaring.Bitmap) error {
+	val, err := seriesIDs.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return imb.db.Put(tombstonesKey(metricID), val)
+}
+
+// removeTombstones removes the deleted series ids of metric.
+func (imb *idMappingBackend) removeTombstones(metricID metric.ID) error {
+	return imb.db.Delete(tombstonesKey(metricID))
+}
+
+// loadTombstonedMetrics loads the metric ids which have tombstones,
+// rebuilds them from tombstones of all metrics if not persisted before.
+func (imb *idMappingBackend) loadTombstonedMetrics() (*roaring.Bitmap, error) {
+	metricIDs := roaring.New()
+	val, exist, err := imb.db.Get(tombstonedMetricsKey)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		if err = metricIDs.UnmarshalBinary(val); err != nil {
+			return nil, err
+		}
+		return metricIDs, nil
+	}
+	tombstonesKeyLen := len(tombstonesKey(0))
+	if err = imb.db.Walk(nil, func(key, _ []byte) bool {
+		if len(key) == tombstonesKeyLen && key[tombstonesKeyLen-1] == tombstonesKeySuffix {
+			metricIDs.Add(binary.LittleEndian.Uint32(key))
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return metricIDs, nil
+}
+
+// saveTombstonedMetrics persists the metric ids which have tombstones.
+func (imb *idMappingBackend) saveTombstonedMetrics(metricIDs *roaring.Bitmap) error {
+	val, err := metricIDs.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return imb.db.Put(tombstonedMetricsKey, val)
+}
+
+// removeSeriesIDs removes the mapping(tags hash => series id) of given series ids under metric,
+// so that the series with same tags generates new series id after deleted.
+func (imb *idMappingBackend) removeSeriesIDs(metricID metric.ID, seriesIDs *roaring.Bitmap) (removed int, err error) {
+	mID := metricID.MarshalBinary()
+	seriesKeyLen := len(mID) + 8
+	var keys [][]byte
+	if err = imb.db.Walk(mID, func(key, value []byte) bool {
+		if len(key) == seriesKeyLen && seriesIDs.Contains(binary.LittleEndian.Uint32(value)) {
+			// key is only valid during iterating, copy it
+			keys = append(keys, append([]byte(nil), key...))
+		}
+		return true
+	}); err != nil {
+		return 0, err
+	}
+	for _, key := range keys {
+		if err = imb.db.Delete(key); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// tombstonesKey returns the key of metric's tombstones.
+func tombstonesKey(metricID metric.ID) []byte {
+	return append(metricID.MarshalBinary(), tombstonesKeySuffix)
+}
+
 // Close closes the backend storage resource.
 func (imb *idMappingBackend) Close() error {
 	return imb.db.Close()
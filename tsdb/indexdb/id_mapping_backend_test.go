@@ -18,12 +18,15 @@
 package indexdb
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/unique"
@@ -258,3 +261,107 @@ func TestIdMappingBackend_saveSeriesSequence(t *testing.T) {
 	store.EXPECT().Put([]byte{1, 0, 0, 0}, []byte{10, 0, 0, 0}).Return(nil)
 	assert.NoError(t, backend.saveSeriesSequence(metric.ID(1), uint32(10)))
 }
+
+func TestIDMappingBackend_tombstones(t *testing.T) {
+	backend, err := newIDMappingBackend(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = backend.Close()
+	}()
+	// no tombstones
+	tombstones, err := backend.loadTombstones(metric.ID(1))
+	assert.NoError(t, err)
+	assert.Nil(t, tombstones)
+	metricIDs, err := backend.loadTombstonedMetrics()
+	assert.NoError(t, err)
+	assert.True(t, metricIDs.IsEmpty())
+
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 100, 1))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 200, 2))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 300, 3))
+	assert.NoError(t, backend.saveSeriesSequence(metric.ID(1), 3))
+	assert.NoError(t, backend.genSeriesID(metric.ID(2), 100, 1))
+
+	assert.NoError(t, backend.saveTombstones(metric.ID(1), roaring.BitmapOf(1, 3)))
+	removed, err := backend.removeSeriesIDs(metric.ID(1), roaring.BitmapOf(1, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	tombstones, err = backend.loadTombstones(metric.ID(1))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 3}, tombstones.ToArray())
+	_, err = backend.getSeriesID(metric.ID(1), 100)
+	assert.True(t, errors.Is(err, constants.ErrNotFound))
+	seriesID, err := backend.getSeriesID(metric.ID(1), 200)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), seriesID)
+	seriesID, err = backend.getSeriesID(metric.ID(2), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), seriesID)
+	mapping, err := backend.loadMetricIDMapping(metric.ID(1))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), mapping.SeriesSequence().Current())
+
+	// rebuild tombstoned metrics if not persisted
+	assert.NoError(t, backend.saveTombstones(metric.ID(10), roaring.BitmapOf(1)))
+	metricIDs, err = backend.loadTombstonedMetrics()
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 10}, metricIDs.ToArray())
+	// load persisted tombstoned metrics
+	assert.NoError(t, backend.removeTombstones(metric.ID(1)))
+	assert.NoError(t, backend.saveTombstonedMetrics(roaring.BitmapOf(10)))
+	tombstones, err = backend.loadTombstones(metric.ID(1))
+	assert.NoError(t, err)
+	assert.Nil(t, tombstones)
+	metricIDs, err = backend.loadTombstonedMetrics()
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{10}, metricIDs.ToArray())
+}
+
+func TestIDMappingBackend_tombstones_failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{db: idStore}
+	// case 1: get tombstones failure
+	idStore.EXPECT().Get([]byte{1, 0, 0, 0, 't'}).Return(nil, false, fmt.Errorf("err"))
+	tombstones, err := backend.loadTombstones(metric.ID(1))
+	assert.Error(t, err)
+	assert.Nil(t, tombstones)
+	// case 2: unmarshal tombstones failure
+	idStore.EXPECT().Get([]byte{1, 0, 0, 0, 't'}).Return([]byte{1, 2, 3}, true, nil)
+	tombstones, err = backend.loadTombstones(metric.ID(1))
+	assert.Error(t, err)
+	assert.Nil(t, tombstones)
+	// case 3: get tombstoned metrics failure
+	idStore.EXPECT().Get([]byte{'t'}).Return(nil, false, fmt.Errorf("err"))
+	metricIDs, err := backend.loadTombstonedMetrics()
+	assert.Error(t, err)
+	assert.Nil(t, metricIDs)
+	// case 4: unmarshal tombstoned metrics failure
+	idStore.EXPECT().Get([]byte{'t'}).Return([]byte{1, 2, 3}, true, nil)
+	metricIDs, err = backend.loadTombstonedMetrics()
+	assert.Error(t, err)
+	assert.Nil(t, metricIDs)
+	// case 5: rebuild tombstoned metrics failure
+	idStore.EXPECT().Get([]byte{'t'}).Return(nil, false, nil)
+	idStore.EXPECT().Walk(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	metricIDs, err = backend.loadTombstonedMetrics()
+	assert.Error(t, err)
+	assert.Nil(t, metricIDs)
+	// case 6: walk failure
+	idStore.EXPECT().Walk(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	removed, err := backend.removeSeriesIDs(metric.ID(1), roaring.BitmapOf(1))
+	assert.Error(t, err)
+	assert.Zero(t, removed)
+	// case 7: delete failure
+	idStore.EXPECT().Walk(gomock.Any(), gomock.Any()).DoAndReturn(func(_ []byte, fn func(key, value []byte) bool) error {
+		fn([]byte{1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}, []byte{1, 0, 0, 0})
+		return nil
+	})
+	idStore.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+	removed, err = backend.removeSeriesIDs(metric.ID(1), roaring.BitmapOf(1))
+	assert.Error(t, err)
+	assert.Zero(t, removed)
+}
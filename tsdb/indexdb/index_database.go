@@ -30,6 +30,7 @@ import (
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -48,6 +49,8 @@ type indexDatabase struct {
 	cancel           context.CancelFunc
 	backend          IDMappingBackend              // id mapping backend storage
 	metricID2Mapping map[metric.ID]MetricIDMapping // key: metric id, value: metric id mapping
+	tombstones       map[metric.ID]*roaring.Bitmap // key: metric id, value: deleted series ids(cache)
+	tombstoned       *roaring.Bitmap               // metric ids which have tombstones
 	metadata         metadb.Metadata               // the metadata for generating ID of metric, field
 	index            InvertedIndex
 
@@ -65,6 +68,14 @@ func NewIndexDatabase(ctx context.Context, parent string, metadata metadb.Metada
 	if err != nil {
 		return nil, err
 	}
+	tombstoned, err := backend.loadTombstonedMetrics()
+	if err != nil {
+		if err0 := backend.Close(); err0 != nil {
+			indexLogger.Warn("close id mapping backend failure when load tombstones",
+				logger.String("path", parent), logger.Error(err0))
+		}
+		return nil, err
+	}
 	c, cancel := context.WithCancel(ctx)
 	db := &indexDatabase{
 		path:             parent,
@@ -73,6 +84,8 @@ func NewIndexDatabase(ctx context.Context, parent string, metadata metadb.Metada
 		backend:          backend,
 		metadata:         metadata,
 		metricID2Mapping: make(map[metric.ID]MetricIDMapping),
+		tombstones:       make(map[metric.ID]*roaring.Bitmap),
+		tombstoned:       tombstoned,
 		index:            newInvertedIndex(metadata, forwardFamily, invertedFamily),
 		statistics:       metrics.NewIndexDBStatistics(metadata.DatabaseName()),
 	}
@@ -90,6 +103,24 @@ func (db *indexDatabase) GetGroupingContext(ctx *flow.ShardExecuteContext) error
 	return db.index.GetGroupingContext(ctx)
 }
 
+// GetSnapshot returns the versioned consistent snapshot of inverted/forward index.
+func (db *indexDatabase) GetSnapshot() flow.IndexSnapshot {
+	return db.index.GetSnapshot()
+}
+
+// GetSeriesID gets series id by tags hash from memory cache only, never loads/generates series id,
+// returns false if series id not cached.
+func (db *indexDatabase) GetSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, ok bool) {
+	db.rwMutex.RLock()
+	defer db.rwMutex.RUnlock()
+
+	metricIDMapping, ok := db.metricID2Mapping[metricID]
+	if !ok {
+		return series.EmptySeriesID, false
+	}
+	return metricIDMapping.GetSeriesID(tagsHash)
+}
+
 // GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 // if generate a new series id returns isCreate is true
 // if generate fail return err
@@ -149,6 +180,148 @@ func (db *indexDatabase) GetOrCreateSeriesID(namespace, metricName string, metri
 	return seriesID, true, nil
 }
 
+// EnsureSeriesSequence ensures the series sequence of metric is not less than given series id,
+// so that the new generated series id never reuses the series id of persisted data.
+func (db *indexDatabase) EnsureSeriesSequence(metricID metric.ID, seriesID uint32) error {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	metricIDMapping, ok := db.metricID2Mapping[metricID]
+	if !ok {
+		var err error
+		metricIDMapping, err = db.backend.loadMetricIDMapping(metricID)
+		if err != nil {
+			return err
+		}
+		db.metricID2Mapping[metricID] = metricIDMapping
+	}
+	if metricIDMapping.SeriesSequence().Current() >= seriesID {
+		return nil
+	}
+	if err := db.backend.saveSeriesSequence(metricID, seriesID); err != nil {
+		return err
+	}
+	// series id cache of metric is dropped, loads it from backend storage lazily
+	newMapping := newMetricIDMapping(metricID, seriesID)
+	// allocates next batch of sequence when generating series id
+	newMapping.SeriesSequence().Limit(seriesID)
+	db.metricID2Mapping[metricID] = newMapping
+	return nil
+}
+
+// DeleteSeries marks the series of metric as deleted, records the tombstones which are excluded when querying,
+// and purged when doing compaction/rollup job, returns the number of removed series mappings.
+func (db *indexDatabase) DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap) (int, error) {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	deleted, err := db.loadTombstones(metricID)
+	if err != nil {
+		return 0, err
+	}
+	// copy on write, because tombstones maybe used by query/compaction
+	tombstones := roaring.New()
+	if deleted != nil {
+		tombstones.Or(deleted)
+	}
+	tombstones.Or(seriesIDs)
+	if !db.tombstoned.Contains(uint32(metricID)) {
+		// records metric id before tombstones saved, no tombstones can be lost after restart
+		tombstoned := db.tombstoned.Clone()
+		tombstoned.Add(uint32(metricID))
+		if err = db.backend.saveTombstonedMetrics(tombstoned); err != nil {
+			return 0, err
+		}
+		db.tombstoned = tombstoned
+	}
+	if err = db.backend.saveTombstones(metricID, tombstones); err != nil {
+		return 0, err
+	}
+	db.tombstones[metricID] = tombstones
+	// remove series mappings, the series with same tags will generate new series id
+	removed, err := db.backend.removeSeriesIDs(metricID, seriesIDs)
+	// series id cache of metric is dropped, loads it from backend storage lazily
+	delete(db.metricID2Mapping, metricID)
+	if err != nil {
+		return removed, err
+	}
+	db.statistics.DeleteSeries.Add(float64(removed))
+	return removed, nil
+}
+
+// GetDeletedSeries returns the deleted series ids of metric, returns nil if no series deleted.
+func (db *indexDatabase) GetDeletedSeries(metricID metric.ID) (*roaring.Bitmap, error) {
+	db.rwMutex.RLock()
+	if !db.tombstoned.Contains(uint32(metricID)) {
+		db.rwMutex.RUnlock()
+		return nil, nil
+	}
+	tombstones, ok := db.tombstones[metricID]
+	db.rwMutex.RUnlock()
+	if ok {
+		return tombstones, nil
+	}
+
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+	return db.loadTombstones(metricID)
+}
+
+// GetTombstonedMetrics returns the metric ids which have tombstones.
+func (db *indexDatabase) GetTombstonedMetrics() *roaring.Bitmap {
+	db.rwMutex.RLock()
+	defer db.rwMutex.RUnlock()
+
+	return db.tombstoned.Clone()
+}
+
+// PurgeTombstones removes the tombstones of metric after the data of deleted series purged from all families,
+// returns false if tombstones changed(series deleted again) after given tombstones checked.
+func (db *indexDatabase) PurgeTombstones(metricID metric.ID, tombstones *roaring.Bitmap) (bool, error) {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	if !db.tombstoned.Contains(uint32(metricID)) {
+		return true, nil
+	}
+	current, err := db.loadTombstones(metricID)
+	if err != nil {
+		return false, err
+	}
+	if current != tombstones {
+		return false, nil
+	}
+	if err = db.backend.removeTombstones(metricID); err != nil {
+		return false, err
+	}
+	delete(db.tombstones, metricID)
+	// removes metric id after tombstones removed, stale metric id is harmless if removing failure
+	tombstoned := db.tombstoned.Clone()
+	tombstoned.Remove(uint32(metricID))
+	if err = db.backend.saveTombstonedMetrics(tombstoned); err != nil {
+		return false, err
+	}
+	db.tombstoned = tombstoned
+	return true, nil
+}
+
+// loadTombstones loads the deleted series ids of metric from backend storage if not cached, need hold lock,
+// only caches the tombstones of tombstoned metric, so that the cache is bounded by the number of them.
+func (db *indexDatabase) loadTombstones(metricID metric.ID) (*roaring.Bitmap, error) {
+	if !db.tombstoned.Contains(uint32(metricID)) {
+		return nil, nil
+	}
+	if tombstones, ok := db.tombstones[metricID]; ok {
+		return tombstones, nil
+	}
+	tombstones, err := db.backend.loadTombstones(metricID)
+	if err != nil {
+		return nil, err
+	}
+	db.tombstones[metricID] = tombstones
+	return tombstones, nil
+}
+
 // GetSeriesIDsByTagValueIDs gets series ids by tag value ids for spec tag key of metric
 func (db *indexDatabase) GetSeriesIDsByTagValueIDs(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap) (*roaring.Bitmap, error) {
 	return db.index.GetSeriesIDsByTagValueIDs(tagKeyID, tagValueIDs)
@@ -32,6 +32,7 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/unique"
 	"github.com/lindb/lindb/series"
@@ -60,6 +61,16 @@ func TestNewIndexDatabase(t *testing.T) {
 	db2, err := NewIndexDatabase(context.TODO(), testPath, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Nil(t, db2)
+	// load tombstoned metrics failure
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+	backend.EXPECT().loadTombstonedMetrics().Return(nil, fmt.Errorf("err"))
+	backend.EXPECT().Close().Return(fmt.Errorf("err"))
+	db2, err = NewIndexDatabase(context.TODO(), testPath, nil, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, db2)
 
 	err = db.Close()
 	assert.NoError(t, err)
@@ -127,6 +138,31 @@ func TestIndexDatabase_BuildInvertIndex(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIndexDatabase_GetSeriesID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mapping := NewMockMetricIDMapping(ctrl)
+	db := &indexDatabase{
+		metricID2Mapping: map[metric.ID]MetricIDMapping{
+			2: mapping,
+		},
+	}
+	// case 1: metric mapping not cached
+	seriesID, ok := db.GetSeriesID(1, 3)
+	assert.False(t, ok)
+	assert.Equal(t, series.EmptySeriesID, seriesID)
+	// case 2: series id cached
+	mapping.EXPECT().GetSeriesID(uint64(3)).Return(uint32(10), true)
+	seriesID, ok = db.GetSeriesID(2, 3)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(10), seriesID)
+	// case 3: series id not cached
+	mapping.EXPECT().GetSeriesID(uint64(4)).Return(uint32(0), false)
+	_, ok = db.GetSeriesID(2, 4)
+	assert.False(t, ok)
+}
+
 func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -372,6 +408,137 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 	}
 }
 
+func TestIndexDatabase_EnsureSeriesSequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backend := NewMockIDMappingBackend(ctrl)
+	db := &indexDatabase{
+		backend:          backend,
+		metricID2Mapping: map[metric.ID]MetricIDMapping{},
+	}
+	// case 1: load metric id mapping failure
+	backend.EXPECT().loadMetricIDMapping(metric.ID(1)).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, db.EnsureSeriesSequence(1, 100))
+	// case 2: sequence greater than series id
+	backend.EXPECT().loadMetricIDMapping(metric.ID(1)).Return(newMetricIDMapping(1, 200), nil)
+	assert.NoError(t, db.EnsureSeriesSequence(1, 100))
+	// case 3: save sequence failure
+	backend.EXPECT().saveSeriesSequence(metric.ID(1), uint32(300)).Return(fmt.Errorf("err"))
+	assert.Error(t, db.EnsureSeriesSequence(1, 300))
+	// case 4: ensure sequence
+	backend.EXPECT().saveSeriesSequence(metric.ID(1), uint32(300)).Return(nil)
+	assert.NoError(t, db.EnsureSeriesSequence(1, 300))
+	seq := db.metricID2Mapping[1].SeriesSequence()
+	assert.Equal(t, uint32(300), seq.Current())
+	assert.False(t, seq.HasNext())
+}
+
+func TestIndexDatabase_DeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backend := NewMockIDMappingBackend(ctrl)
+	db := &indexDatabase{
+		backend:          backend,
+		metricID2Mapping: map[metric.ID]MetricIDMapping{1: newMetricIDMapping(1, 10)},
+		tombstones:       map[metric.ID]*roaring.Bitmap{},
+		tombstoned:       roaring.New(),
+		statistics:       metrics.NewIndexDBStatistics("test"),
+	}
+	// case 1: save tombstoned metrics failure, never loads tombstones of metric without tombstones
+	backend.EXPECT().saveTombstonedMetrics(roaring.BitmapOf(1)).Return(fmt.Errorf("err"))
+	_, err := db.DeleteSeries(1, roaring.BitmapOf(1))
+	assert.Error(t, err)
+	tombstones, err := db.GetDeletedSeries(1)
+	assert.NoError(t, err)
+	assert.Nil(t, tombstones)
+	assert.Empty(t, db.tombstones)
+	// case 2: save tombstones failure
+	backend.EXPECT().saveTombstonedMetrics(roaring.BitmapOf(1)).Return(nil)
+	backend.EXPECT().saveTombstones(metric.ID(1), gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = db.DeleteSeries(1, roaring.BitmapOf(1))
+	assert.Error(t, err)
+	assert.Equal(t, []uint32{1}, db.GetTombstonedMetrics().ToArray())
+	// case 3: load tombstones failure
+	backend.EXPECT().loadTombstones(metric.ID(1)).Return(nil, fmt.Errorf("err")).Times(2)
+	_, err = db.DeleteSeries(1, roaring.BitmapOf(1))
+	assert.Error(t, err)
+	tombstones, err = db.GetDeletedSeries(1)
+	assert.Error(t, err)
+	assert.Nil(t, tombstones)
+	// case 4: remove series mapping failure
+	backend.EXPECT().loadTombstones(metric.ID(1)).Return(nil, nil)
+	backend.EXPECT().saveTombstones(metric.ID(1), gomock.Any()).Return(nil)
+	backend.EXPECT().removeSeriesIDs(metric.ID(1), gomock.Any()).Return(0, fmt.Errorf("err"))
+	_, err = db.DeleteSeries(1, roaring.BitmapOf(1))
+	assert.Error(t, err)
+	tombstones, err = db.GetDeletedSeries(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1}, tombstones.ToArray())
+	// case 5: delete series successfully, tombstones copy on write
+	backend.EXPECT().saveTombstones(metric.ID(1), gomock.Any()).Return(nil)
+	backend.EXPECT().removeSeriesIDs(metric.ID(1), gomock.Any()).Return(2, nil)
+	removed, err := db.DeleteSeries(1, roaring.BitmapOf(2, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, []uint32{1}, tombstones.ToArray())
+	tombstones, err = db.GetDeletedSeries(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3}, tombstones.ToArray())
+	assert.Empty(t, db.metricID2Mapping)
+}
+
+func TestIndexDatabase_PurgeTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backend := NewMockIDMappingBackend(ctrl)
+	tombstones := roaring.BitmapOf(1, 2)
+	db := &indexDatabase{
+		backend:    backend,
+		tombstones: map[metric.ID]*roaring.Bitmap{1: tombstones},
+		tombstoned: roaring.BitmapOf(1, 2),
+	}
+	// case 1: metric without tombstones
+	purged, err := db.PurgeTombstones(3, nil)
+	assert.NoError(t, err)
+	assert.True(t, purged)
+	// case 2: load tombstones failure
+	backend.EXPECT().loadTombstones(metric.ID(2)).Return(nil, fmt.Errorf("err"))
+	purged, err = db.PurgeTombstones(2, nil)
+	assert.Error(t, err)
+	assert.False(t, purged)
+	// case 3: tombstones changed after checked
+	purged, err = db.PurgeTombstones(1, roaring.BitmapOf(1))
+	assert.NoError(t, err)
+	assert.False(t, purged)
+	// case 4: remove tombstones failure
+	backend.EXPECT().removeTombstones(metric.ID(1)).Return(fmt.Errorf("err"))
+	purged, err = db.PurgeTombstones(1, tombstones)
+	assert.Error(t, err)
+	assert.False(t, purged)
+	// case 5: save tombstoned metrics failure, stale metric id is kept
+	backend.EXPECT().removeTombstones(metric.ID(1)).Return(nil)
+	backend.EXPECT().saveTombstonedMetrics(roaring.BitmapOf(2)).Return(fmt.Errorf("err"))
+	purged, err = db.PurgeTombstones(1, tombstones)
+	assert.Error(t, err)
+	assert.False(t, purged)
+	assert.Empty(t, db.tombstones)
+	assert.Equal(t, []uint32{1, 2}, db.GetTombstonedMetrics().ToArray())
+	// case 6: purge tombstones successfully
+	backend.EXPECT().loadTombstones(metric.ID(1)).Return(nil, nil)
+	backend.EXPECT().removeTombstones(metric.ID(1)).Return(nil)
+	backend.EXPECT().saveTombstonedMetrics(roaring.BitmapOf(2)).Return(nil)
+	purged, err = db.PurgeTombstones(1, nil)
+	assert.NoError(t, err)
+	assert.True(t, purged)
+	assert.Equal(t, []uint32{2}, db.GetTombstonedMetrics().ToArray())
+	deleted, err := db.GetDeletedSeries(1)
+	assert.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
 func TestIndexDatabase_GetGroupingContext(t *testing.T) {
 	testPath := t.TempDir()
 	ctrl := gomock.NewController(t)
@@ -395,6 +562,10 @@ func TestIndexDatabase_GetGroupingContext(t *testing.T) {
 	err = db.GetGroupingContext(shardExecuteCtx)
 	assert.NoError(t, err)
 	assert.Nil(t, shardExecuteCtx.GroupingContext)
+	// get index snapshot
+	snapshot := flow.NewMockIndexSnapshot(ctrl)
+	index.EXPECT().GetSnapshot().Return(snapshot)
+	assert.Equal(t, snapshot, db.GetSnapshot())
 
 	index.EXPECT().Flush().Return(nil)
 	err = db.Close()
@@ -461,6 +632,7 @@ func TestIndexDatabase_Close(t *testing.T) {
 	createBackendFn = func(parent string) (IDMappingBackend, error) {
 		return backend, nil
 	}
+	backend.EXPECT().loadTombstonedMetrics().Return(roaring.New(), nil)
 	backend.EXPECT().sync().Return(nil)
 
 	meta := metadb.NewMockMetadata(ctrl)
@@ -488,6 +660,7 @@ func TestIndexDatabase_Flush(t *testing.T) {
 	createBackendFn = func(parent string) (IDMappingBackend, error) {
 		return backend, nil
 	}
+	backend.EXPECT().loadTombstonedMetrics().Return(roaring.New(), nil)
 
 	meta := metadb.NewMockMetadata(ctrl)
 	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
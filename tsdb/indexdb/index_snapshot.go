@@ -0,0 +1,218 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/bitmappool"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/tblstore/tagindex"
+)
+
+// indexSnapshot represents a consistent view of inverted index, includes memory tag index stores
+// and kv snapshots of forward/inverted family, which are captured atomically with flush job's switching.
+// The captured memory stores are kept even if they are flushed, so that the data never lost or
+// seen twice while reading, snapshot must be closed after reading for releasing kv snapshots.
+// Query acquires one snapshot for each shard(held by shard execute context), so that filtering and
+// grouping of the query read the same version of index.
+type indexSnapshot struct {
+	index     *invertedIndex
+	version   int64
+	mutable   *TagIndexStore
+	immutable *TagIndexStore
+	forward   version.Snapshot
+	inverted  version.Snapshot
+}
+
+// Version returns the version of inverted index when snapshot created.
+func (s *indexSnapshot) Version() int64 {
+	return s.version
+}
+
+// Close releases the kv snapshots.
+func (s *indexSnapshot) Close() error {
+	s.forward.Close()
+	s.inverted.Close()
+	return nil
+}
+
+// GetSeriesIDsByTagValueIDs finds series ids by tag value ids for spec tag key,
+// result is picked from bitmap pool, caller can return it to the pool after using.
+func (s *indexSnapshot) GetSeriesIDsByTagValueIDs(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap) (*roaring.Bitmap, error) {
+	result := bitmappool.GetBitmap()
+	// read data from mem
+	s.loadSeriesIDsInMem(tagKeyID, func(tagIndex TagIndex) {
+		seriesIDs := tagIndex.getSeriesIDsByTagValueIDs(tagValueIDs)
+		if seriesIDs != nil {
+			result.Or(seriesIDs)
+		}
+	})
+
+	// read data from kv store
+	if err := s.loadSeriesIDsInKV(tagKeyID, func(reader tagindex.InvertedReader) error {
+		seriesIDs, err := reader.GetSeriesIDsByTagValueIDs(tagKeyID, tagValueIDs)
+		if err != nil {
+			return err
+		}
+		result.Or(seriesIDs)
+		return nil
+	}); err != nil {
+		bitmappool.PutBitmap(result)
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetSeriesIDsForTag gets series ids for spec tag key,
+// result is picked from bitmap pool, caller can return it to the pool after using.
+func (s *indexSnapshot) GetSeriesIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error) {
+	result := bitmappool.GetBitmap()
+	// read data from mem
+	s.loadSeriesIDsInMem(tagKeyID, func(tagIndex TagIndex) {
+		result.Or(tagIndex.getAllSeriesIDs())
+	})
+
+	// read data from kv store
+	readers, err := s.forward.FindReaders(uint32(tagKeyID))
+	if err != nil {
+		// find table.Reader err, return it
+		bitmappool.PutBitmap(result)
+		return nil, err
+	}
+	if len(readers) > 0 {
+		// found tag data in kv store, try load series ids data
+		seriesIDs, err := newForwardReaderFunc(readers).GetSeriesIDsForTagKeyID(tagKeyID)
+		if err != nil {
+			bitmappool.PutBitmap(result)
+			return nil, err
+		}
+		result.Or(seriesIDs)
+	}
+	return result, nil
+}
+
+// GetGroupingContext builds the context of group by, grouping scanners read captured forward index
+// until query completed, so snapshot must be held by shard context.
+func (s *indexSnapshot) GetGroupingContext(ctx *flow.ShardExecuteContext) error {
+	scannerMap := make(map[tag.KeyID][]flow.GroupingScanner)
+	tagKeyIDs := ctx.StorageExecuteCtx.GroupByTagKeyIDs
+	seriesIDs := ctx.SeriesIDsAfterFiltering
+	finalSeriesIDs := seriesIDs.Clone()
+	defer func() {
+		// maybe filtering some series ids that is result of filtering.
+		// if not found, return empty series ids.
+		ctx.SeriesIDsAfterFiltering = finalSeriesIDs
+	}()
+	for _, tagKeyID := range tagKeyIDs {
+		// get grouping scanners by tag key
+		scanners, err := s.getGroupingScanners(tagKeyID, seriesIDs)
+		if err != nil {
+			return err
+		}
+		seriesIDsForCurrentTagKey := bitmappool.GetBitmap()
+		for idx := range scanners {
+			seriesIDsForCurrentTagKey.Or(scanners[idx].GetSeriesIDs())
+		}
+		finalSeriesIDs.And(seriesIDsForCurrentTagKey)
+		bitmappool.PutBitmap(seriesIDsForCurrentTagKey)
+		if finalSeriesIDs.IsEmpty() {
+			return constants.ErrNotFound
+		}
+		scannerMap[tagKeyID] = scanners
+	}
+	for _, tagKeyID := range ctx.StorageExecuteCtx.DistinctTagKeyIDs {
+		if _, ok := scannerMap[tagKeyID]; ok {
+			continue
+		}
+		// series without distinct tag key are not filtered, because they are not grouped by distinct tag key
+		scanners, err := s.getGroupingScanners(tagKeyID, seriesIDs)
+		if err != nil {
+			return err
+		}
+		scannerMap[tagKeyID] = scanners
+	}
+
+	// set context for next execution stage of query
+	ctx.GroupingContext = flow.NewGroupContext(tagKeyIDs, scannerMap)
+	return nil
+}
+
+// getGroupingScanners returns the grouping scanner list for tag key, need match series ids
+func (s *indexSnapshot) getGroupingScanners(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error) {
+	var result []flow.GroupingScanner
+	// read data from mem
+	s.loadSeriesIDsInMem(tagKeyID, func(tagIndex TagIndex) {
+		// get grouping scanner in memory, no err throw
+		scanners, _ := tagIndex.GetGroupingScanner(seriesIDs, s.index.withLock)
+		result = append(result, scanners...)
+	})
+
+	// read data from kv store
+	readers, err := s.forward.FindReaders(uint32(tagKeyID))
+	if err != nil {
+		// find table.Reader err, return it
+		return nil, err
+	}
+	if len(readers) > 0 {
+		// found tag data in kv store, try get grouping scanner
+		scanners, err := newForwardReaderFunc(readers).GetGroupingScanner(tagKeyID, seriesIDs)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, scanners...)
+	}
+	return result, nil
+}
+
+// loadSeriesIDsInMem loads series ids from captured mutable/immutable store.
+func (s *indexSnapshot) loadSeriesIDsInMem(tagKeyID tag.KeyID, fn func(tagIndex TagIndex)) {
+	// define get tag series ids func
+	getSeriesIDsIDs := func(tagIndexStore *TagIndexStore) {
+		if tagIndex, ok := tagIndexStore.Get(uint32(tagKeyID)); ok {
+			fn(tagIndex)
+		}
+	}
+
+	// captured mutable store maybe written by other goroutine, read data with read lock
+	s.index.rwMutex.RLock()
+	defer s.index.rwMutex.RUnlock()
+
+	getSeriesIDsIDs(s.mutable)
+	if s.immutable != nil {
+		getSeriesIDsIDs(s.immutable)
+	}
+}
+
+// loadSeriesIDsInKV loads series ids from inverted family snapshot.
+func (s *indexSnapshot) loadSeriesIDsInKV(tagKeyID tag.KeyID, fn func(reader tagindex.InvertedReader) error) error {
+	// try to get tag key id from kv store
+	readers, err := s.inverted.FindReaders(uint32(tagKeyID))
+	if err != nil {
+		// find table.Reader err, return it
+		return err
+	}
+	if len(readers) > 0 {
+		// found tag data in kv store, try load series ids data
+		return fn(newInvertedReaderFunc(readers))
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ package indexdb
 import (
 	"io"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -52,9 +54,28 @@ type IndexDatabase interface {
 	// if generate fail return err
 	GetOrCreateSeriesID(namespace, metricName string, metricID metric.ID, tagsHash uint64,
 		limits *models.Limits) (seriesID uint32, isCreated bool, err error)
+	// GetSnapshot returns the versioned consistent snapshot of inverted/forward index,
+	// query holds it in shard execute context, snapshot must be closed after reading.
+	GetSnapshot() flow.IndexSnapshot
+	// GetSeriesID gets series id by tags hash from memory cache only, never loads/generates series id,
+	// returns false if series id not cached.
+	GetSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, ok bool)
 	// BuildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
 	BuildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32, limits *models.Limits)
+	// EnsureSeriesSequence ensures the series sequence of metric is not less than given series id,
+	// so that the new generated series id never reuses the series id of persisted data.
+	EnsureSeriesSequence(metricID metric.ID, seriesID uint32) error
+	// DeleteSeries marks the series of metric as deleted, records the tombstones which are excluded when querying,
+	// and purged when doing compaction/rollup job, returns the number of removed series mappings.
+	DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap) (int, error)
+	// GetDeletedSeries returns the deleted series ids of metric, returns nil if no series deleted.
+	GetDeletedSeries(metricID metric.ID) (*roaring.Bitmap, error)
+	// GetTombstonedMetrics returns the metric ids which have tombstones.
+	GetTombstonedMetrics() *roaring.Bitmap
+	// PurgeTombstones removes the tombstones of metric after the data of deleted series purged from all families,
+	// returns false if tombstones changed(series deleted again) after given tombstones checked.
+	PurgeTombstones(metricID metric.ID, tombstones *roaring.Bitmap) (bool, error)
 	// Flush flushes index data to disk
 	Flush() error
 }
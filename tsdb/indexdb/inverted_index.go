@@ -22,11 +22,10 @@ import (
 
 	"github.com/lindb/roaring"
 
-	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
-	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/bitmappool"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -54,6 +53,8 @@ type InvertedIndex interface {
 	GetSeriesIDsForTags(tagKeyIDs []tag.KeyID) (*roaring.Bitmap, error)
 	// GetGroupingContext returns the context of group by
 	GetGroupingContext(ctx *flow.ShardExecuteContext) error
+	// GetSnapshot returns the versioned consistent snapshot of index, snapshot must be closed after reading.
+	GetSnapshot() flow.IndexSnapshot
 	// buildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
 	buildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32, limits *models.Limits)
@@ -68,6 +69,7 @@ type invertedIndex struct {
 
 	mutable   *TagIndexStore
 	immutable *TagIndexStore
+	version   int64 // increased when switching mutable/immutable or clearing immutable
 
 	rwMutex sync.RWMutex
 }
@@ -83,117 +85,46 @@ func newInvertedIndex(metadata metadb.Metadata, forwardFamily, invertedFamily kv
 
 // GetSeriesIDsByTagValueIDs finds series ids by tag filter expr
 func (index *invertedIndex) GetSeriesIDsByTagValueIDs(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap) (*roaring.Bitmap, error) {
-	result := roaring.New()
-	// read data from mem
-	index.loadSeriesIDsInMem(tagKeyID, func(tagIndex TagIndex) {
-		seriesIDs := tagIndex.getSeriesIDsByTagValueIDs(tagValueIDs)
-		if seriesIDs != nil {
-			result.Or(seriesIDs)
-		}
-	})
+	snapshot := index.getSnapshot()
+	defer snapshot.Close()
 
-	// read data from kv store
-	if err := index.loadSeriesIDsInKV(tagKeyID, func(reader tagindex.InvertedReader) error {
-		seriesIDs, err := reader.GetSeriesIDsByTagValueIDs(tagKeyID, tagValueIDs)
-		if err != nil {
-			return err
-		}
-		result.Or(seriesIDs)
-		return nil
-	}); err != nil {
-		return nil, err
-	}
-	return result, nil
+	return snapshot.GetSeriesIDsByTagValueIDs(tagKeyID, tagValueIDs)
 }
 
 // GetSeriesIDsForTag get series ids by tagKeyId
 func (index *invertedIndex) GetSeriesIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error) {
-	// get snapshot for getting data
-	snapshot := index.forwardFamily.GetSnapshot()
+	snapshot := index.getSnapshot()
 	defer snapshot.Close()
-	return index.getSeriesIDsForTag(tagKeyID, snapshot)
-}
-
-// getSeriesIDsForTag get series ids by tagKeyId and kv snapshot
-func (index *invertedIndex) getSeriesIDsForTag(tagKeyID tag.KeyID, snapshot version.Snapshot) (*roaring.Bitmap, error) {
-	result := roaring.New()
-	// read data from mem
-	index.loadSeriesIDsInMem(tagKeyID, func(tagIndex TagIndex) {
-		result.Or(tagIndex.getAllSeriesIDs())
-	})
-
-	// read data from kv store
-	// try to get tag key id from kv store
-	readers, err := snapshot.FindReaders(uint32(tagKeyID))
-	if err != nil {
-		// find table.Reader err, return it
-		return nil, err
-	}
-	var reader tagindex.ForwardReader
 
-	if len(readers) > 0 {
-		// found tag data in kv store, try load series ids data
-		reader = newForwardReaderFunc(readers)
-		seriesIDs, err := reader.GetSeriesIDsForTagKeyID(tagKeyID)
-		if err != nil {
-			return nil, err
-		}
-		result.Or(seriesIDs)
-	}
-	return result, nil
+	return snapshot.GetSeriesIDsForTag(tagKeyID)
 }
 
 // GetSeriesIDsForTags gets series ids for spec tag keys of metric
 func (index *invertedIndex) GetSeriesIDsForTags(tagKeyIDs []tag.KeyID) (*roaring.Bitmap, error) {
-	// get kv store snapshot
-	snapshot := index.forwardFamily.GetSnapshot()
+	snapshot := index.getSnapshot()
 	defer snapshot.Close()
 
 	result := roaring.New()
 	for _, tagKeyID := range tagKeyIDs {
-		seriesIDs, err := index.getSeriesIDsForTag(tagKeyID, snapshot)
+		seriesIDs, err := snapshot.GetSeriesIDsForTag(tagKeyID)
 		if err != nil {
 			return nil, err
 		}
 		result.Or(seriesIDs)
+		bitmappool.PutBitmap(seriesIDs)
 	}
 	return result, nil
 }
 
+// GetGroupingContext returns the context of group by, reads the index snapshot held by shard context,
+// so that grouping sees the same version of index as filtering.
 func (index *invertedIndex) GetGroupingContext(ctx *flow.ShardExecuteContext) error {
-	// get kv store snapshot
-	snapshot := index.forwardFamily.GetSnapshot()
-	defer snapshot.Close()
-
-	scannerMap := make(map[tag.KeyID][]flow.GroupingScanner)
-	tagKeyIDs := ctx.StorageExecuteCtx.GroupByTagKeyIDs
-	seriesIDs := ctx.SeriesIDsAfterFiltering
-	finalSeriesIDs := seriesIDs.Clone()
-	defer func() {
-		// maybe filtering some series ids that is result of filtering.
-		// if not found, return empty series ids.
-		ctx.SeriesIDsAfterFiltering = finalSeriesIDs
-	}()
-	for _, tagKeyID := range tagKeyIDs {
-		// get grouping scanners by tag key
-		scanners, err := index.getGroupingScanners(tagKeyID, seriesIDs, snapshot)
-		if err != nil {
-			return err
-		}
-		seriesIDsForCurrentTagKey := roaring.New()
-		for idx := range scanners {
-			seriesIDsForCurrentTagKey.Or(scanners[idx].GetSeriesIDs())
-		}
-		finalSeriesIDs.And(seriesIDsForCurrentTagKey)
-		if finalSeriesIDs.IsEmpty() {
-			return constants.ErrNotFound
-		}
-		scannerMap[tagKeyID] = scanners
-	}
+	return ctx.IndexSnapshot(index.GetSnapshot).GetGroupingContext(ctx)
+}
 
-	// set context for next execution stage of query
-	ctx.GroupingContext = flow.NewGroupContext(tagKeyIDs, scannerMap)
-	return nil
+// GetSnapshot returns the versioned consistent snapshot of index, snapshot must be closed after reading.
+func (index *invertedIndex) GetSnapshot() flow.IndexSnapshot {
+	return index.getSnapshot()
 }
 
 // withLock retrieves the lock of inverted index, and returns the release function.
@@ -203,40 +134,6 @@ func (index *invertedIndex) withLock() (release func()) {
 	return index.rwMutex.RUnlock
 }
 
-// getGroupingScanners returns the grouping scanner list for tag key, need match series ids
-func (index *invertedIndex) getGroupingScanners(
-	tagKeyID tag.KeyID,
-	seriesIDs *roaring.Bitmap,
-	snapshot version.Snapshot,
-) ([]flow.GroupingScanner, error) {
-	var result []flow.GroupingScanner
-	// read data from mem
-	index.loadSeriesIDsInMem(tagKeyID, func(tagIndex TagIndex) {
-		// get grouping scanner in memory, no err throw
-		scanners, _ := tagIndex.GetGroupingScanner(seriesIDs, index.withLock)
-		result = append(result, scanners...)
-	})
-
-	// read data from kv store
-	// try to get tag key id from kv store
-	readers, err := snapshot.FindReaders(uint32(tagKeyID))
-	if err != nil {
-		// find table.Reader err, return it
-		return nil, err
-	}
-	var reader tagindex.ForwardReader
-	if len(readers) > 0 {
-		// found tag data in kv store, try get grouping scanner
-		reader = newForwardReaderFunc(readers)
-		scanners, err := reader.GetGroupingScanner(tagKeyID, seriesIDs)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, scanners...)
-	}
-	return result, nil
-}
-
 // buildInvertIndex builds the inverted index for tag value => series ids,
 // the tags is considered as an empty key-value pair while tags is nil.
 func (index *invertedIndex) buildInvertIndex(namespace, metricName string,
@@ -313,6 +210,7 @@ func (index *invertedIndex) Flush() error {
 	// finally, clear immutable
 	index.rwMutex.Lock()
 	index.immutable = nil
+	index.version++
 	index.rwMutex.Unlock()
 	return nil
 }
@@ -330,47 +228,24 @@ func (index *invertedIndex) checkFlush() bool {
 		// reset mutable, if flush fail immutable is not nil
 		index.immutable = index.mutable
 		index.mutable = NewTagIndexStore()
+		index.version++
 	}
 	return true
 }
 
-// loadTagValueIDsInKV loads series ids in kv store
-func (index *invertedIndex) loadSeriesIDsInKV(tagKeyID tag.KeyID, fn func(reader tagindex.InvertedReader) error) error {
-	// try to get tag key id from kv store
-	snapshot := index.invertedFamily.GetSnapshot()
-	defer snapshot.Close()
-
-	readers, err := snapshot.FindReaders(uint32(tagKeyID))
-	if err != nil {
-		// find table.Reader err, return it
-		return err
-	}
-	var reader tagindex.InvertedReader
-	if len(readers) > 0 {
-		// found tag data in kv store, try load series ids data
-		reader = newInvertedReaderFunc(readers)
-		if err := fn(reader); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// loadSeriesIDsInMem loads series ids from mutable/immutable store
-func (index *invertedIndex) loadSeriesIDsInMem(tagKeyID tag.KeyID, fn func(tagIndex TagIndex)) {
-	// define get tag series ids func
-	getSeriesIDsIDs := func(tagIndexStore *TagIndexStore) {
-		if tagIndex, ok := tagIndexStore.Get(uint32(tagKeyID)); ok {
-			fn(tagIndex)
-		}
-	}
-
-	// read data with read lock
+// getSnapshot returns the snapshot of inverted index, the kv snapshots are captured with read lock,
+// because flush job clears immutable store with write lock after committing kv files,
+// so the data of immutable store is either in captured memory store or in captured kv snapshot.
+func (index *invertedIndex) getSnapshot() *indexSnapshot {
 	index.rwMutex.RLock()
 	defer index.rwMutex.RUnlock()
 
-	getSeriesIDsIDs(index.mutable)
-	if index.immutable != nil {
-		getSeriesIDsIDs(index.immutable)
+	return &indexSnapshot{
+		index:     index,
+		version:   index.version,
+		mutable:   index.mutable,
+		immutable: index.immutable,
+		forward:   index.forwardFamily.GetSnapshot(),
+		inverted:  index.invertedFamily.GetSnapshot(),
 	}
 }
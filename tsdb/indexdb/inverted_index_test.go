@@ -52,6 +52,7 @@ func TestInvertedIndex_buildInvertIndex(t *testing.T) {
 	family := kv.NewMockFamily(ctrl)
 	idx := index.(*invertedIndex)
 	idx.invertedFamily = family
+	idx.forwardFamily = family
 	snapshot := version.NewMockSnapshot(ctrl)
 	snapshot.EXPECT().Close().AnyTimes()
 	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
@@ -130,6 +131,7 @@ func TestInvertedIndex_GetSeriesIDsForTags(t *testing.T) {
 	family := kv.NewMockFamily(ctrl)
 	idx := index.(*invertedIndex)
 	idx.forwardFamily = family
+	idx.invertedFamily = family
 	snapshot := version.NewMockSnapshot(ctrl)
 	snapshot.EXPECT().Close().AnyTimes()
 	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
@@ -167,6 +169,7 @@ func TestInvertedIndex_GetSeriesIDsForTag(t *testing.T) {
 	family := kv.NewMockFamily(ctrl)
 	idx := index.(*invertedIndex)
 	idx.forwardFamily = family
+	idx.invertedFamily = family
 	snapshot := version.NewMockSnapshot(ctrl)
 	snapshot.EXPECT().Close().AnyTimes()
 	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
@@ -193,6 +196,7 @@ func TestInvertedIndex_GetGroupingContext(t *testing.T) {
 	snapshot.EXPECT().Close().AnyTimes()
 	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
 	idx.forwardFamily = family
+	idx.invertedFamily = family
 
 	// case 1: get sst file reader err
 	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
@@ -244,6 +248,60 @@ func TestInvertedIndex_GetGroupingContext(t *testing.T) {
 	assert.Equal(t, constants.ErrNotFound, err)
 	assert.Nil(t, shardExecuteCtx.GroupingContext)
 	assert.True(t, shardExecuteCtx.SeriesIDsAfterFiltering.IsEmpty())
+	// case 5: count distinct, series not filtered by distinct tag key
+	shardExecuteCtx = &flow.ShardExecuteContext{
+		StorageExecuteCtx: &flow.StorageExecuteContext{
+			DistinctTagKeyIDs: []tag.KeyID{3},
+		},
+		SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2, 3),
+	}
+	assert.NoError(t, index.GetGroupingContext(shardExecuteCtx))
+	assert.NotNil(t, shardExecuteCtx.GroupingContext)
+	assert.Equal(t, roaring.BitmapOf(1, 2, 3), shardExecuteCtx.SeriesIDsAfterFiltering)
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, index.GetGroupingContext(shardExecuteCtx))
+}
+
+func TestInvertedIndex_Snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := prepareInvertedIndex(ctrl)
+	idx := index.(*invertedIndex)
+	family := kv.NewMockFamily(ctrl)
+	kvSnapshot := version.NewMockSnapshot(ctrl)
+	family.EXPECT().GetSnapshot().Return(kvSnapshot).AnyTimes()
+	kvSnapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil).AnyTimes()
+	idx.forwardFamily = family
+	idx.invertedFamily = family
+
+	snapshot := idx.getSnapshot()
+	assert.Equal(t, int64(0), snapshot.Version())
+	// switch mutable/immutable, then clear immutable like flush job
+	assert.True(t, idx.checkFlush())
+	idx.rwMutex.Lock()
+	idx.immutable = nil
+	idx.version++
+	idx.rwMutex.Unlock()
+	// captured memory store is still visible for snapshot
+	seriesIDs, err := snapshot.GetSeriesIDsForTag(1)
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(1, 2), seriesIDs)
+	kvSnapshot.EXPECT().Close().Times(2)
+	assert.NoError(t, snapshot.Close())
+	// new snapshot reads the flushed data from kv store
+	kvSnapshot.EXPECT().Close().Times(2)
+	seriesIDs, err = index.GetSeriesIDsForTag(1)
+	assert.NoError(t, err)
+	assert.True(t, seriesIDs.IsEmpty())
+	assert.Equal(t, int64(2), idx.getSnapshot().Version())
+
+	// snapshot is held by shard context until query completed
+	shardExecuteCtx := flow.NewShardExecuteContext(&flow.StorageExecuteContext{GroupByTagKeyIDs: []tag.KeyID{1}})
+	shardExecuteCtx.SeriesIDsAfterFiltering = roaring.BitmapOf(1)
+	assert.Equal(t, constants.ErrNotFound, index.GetGroupingContext(shardExecuteCtx))
+	kvSnapshot.EXPECT().Close().Times(2)
+	shardExecuteCtx.Release()
 }
 
 func TestInvertedIndex_FlushInvertedIndexTo(t *testing.T) {
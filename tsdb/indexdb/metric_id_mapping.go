@@ -18,6 +18,8 @@
 package indexdb
 
 import (
+	"fmt"
+
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
@@ -80,10 +82,11 @@ func (mim *metricIDMapping) AddSeriesID(tagsHash uint64, seriesID uint32) {
 // GenSeriesID generates series id by tags hash, then cache new series id.
 func (mim *metricIDMapping) GenSeriesID(namespace, metricName string,
 	tagsHash uint64, limits *models.Limits) (seriesID uint32, err error) {
-	seriesLimit := limits.GetSeriesLimit(namespace, metricName)
+	seriesLimit, rule := limits.MatchSeriesLimit(namespace, metricName)
 	// generate new series id
 	if seriesLimit != 0 && mim.idSequence.Current() >= seriesLimit {
-		return series.EmptySeriesID, constants.ErrTooManySeries
+		return series.EmptySeriesID, fmt.Errorf("%w, namespace: %s, metric: %s, limit: %d(%s)",
+			constants.ErrTooManySeries, namespace, metricName, seriesLimit, rule)
 	} else {
 		seriesID = mim.idSequence.Next()
 	}
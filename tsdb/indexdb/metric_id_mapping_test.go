@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
@@ -63,8 +64,18 @@ func TestMetricIDMapping_SeriesLimit(t *testing.T) {
 	assert.Equal(t, uint32(1), seriesID)
 	// gt limit
 	seriesID, err = idMapping.GenSeriesID("ns", "metric", 1023, limits)
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, constants.ErrTooManySeries)
 	assert.Equal(t, series.EmptySeriesID, seriesID)
+	// gt limit of namespace
+	limits.Metrics["ns|*"] = 2
+	seriesID, err = idMapping.GenSeriesID("ns", "metric", 1023, limits)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), seriesID)
+	seriesID, err = idMapping.GenSeriesID("ns", "metric", 1024, limits)
+	assert.ErrorIs(t, err, constants.ErrTooManySeries)
+	assert.Contains(t, err.Error(), "ns|*")
+	assert.Equal(t, series.EmptySeriesID, seriesID)
+	delete(limits.Metrics, "ns|*")
 	// disable limit
 	limits.MaxSeriesPerMetric = 0
 	seriesID, err = idMapping.GenSeriesID("ns", "metric", 1023, limits)
@@ -22,6 +22,7 @@ import (
 	"path"
 	"sync"
 
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -35,12 +36,18 @@ type IntervalSegment interface {
 	GetOrCreateSegment(segmentName string) (Segment, error)
 	// GetDataFamilies returns data family list by time range, return nil if not match
 	GetDataFamilies(timeRange timeutil.TimeRange) []DataFamily
+	// GetAllDataFamilies returns all data families of the segments which are not expired.
+	GetAllDataFamilies() ([]DataFamily, error)
 	// Close closes interval segment, release resource
 	Close()
 	// TTL expires segment base on time to live.
 	TTL() error
+	// ExpireOldestSegment drops the oldest segment before retention, keeps the latest segment for writing.
+	ExpireOldestSegment() error
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// GetSegmentStates returns the states of all segments under current interval.
+	GetSegmentStates() ([]models.SegmentState, error)
 }
 
 // intervalSegment implements IntervalSegment interface
@@ -132,6 +139,33 @@ func (s *intervalSegment) GetDataFamilies(timeRange timeutil.TimeRange) []DataFa
 	return result
 }
 
+// GetAllDataFamilies returns all data families of the segments which are not expired.
+func (s *intervalSegment) GetAllDataFamilies() ([]DataFamily, error) {
+	var (
+		result  []DataFamily
+		loadErr error
+	)
+	now := timeutil.Now()
+	expireInterval := s.interval.Retention.Int64()
+	if err := s.walkSegment(func(segmentName string, segmentTime int64) {
+		if loadErr != nil || now-segmentTime >= expireInterval {
+			return
+		}
+		segment, err := s.getOrLoadSegment(segmentName)
+		if err != nil {
+			loadErr = err
+			return
+		}
+		result = append(result, segment.GetAllDataFamilies()...)
+	}); err != nil {
+		return nil, err
+	}
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return result, nil
+}
+
 // getOrLoadSegment returns segment for current interval.
 // 1. return segment if it's exist in memory cache;
 // 2. return segment if it's exist in storage.
@@ -193,6 +227,50 @@ func (s *intervalSegment) EvictSegment() {
 	}
 }
 
+// GetSegmentStates returns the states of all segments under current interval.
+func (s *intervalSegment) GetSegmentStates() ([]models.SegmentState, error) {
+	var rs []models.SegmentState
+	err := s.walkSegment(func(segmentName string, _ int64) {
+		segment, err := s.getOrLoadSegment(segmentName)
+		if err != nil {
+			s.logger.Warn("load segment failure when get segment state",
+				logger.String("path", s.dir), logger.String("segment", segmentName),
+				logger.Error(err))
+			return
+		}
+		state := segment.GetState()
+		state.Name = segmentName
+		rs = append(rs, state)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// ExpireOldestSegment drops the oldest segment before retention, keeps the latest segment for writing.
+func (s *intervalSegment) ExpireOldestSegment() error {
+	var (
+		oldestSegment string
+		oldestTime    int64
+		numOfSegments int
+	)
+	if err := s.walkSegment(func(segmentName string, segmentTime int64) {
+		numOfSegments++
+		if oldestSegment == "" || segmentTime < oldestTime {
+			oldestSegment = segmentName
+			oldestTime = segmentTime
+		}
+	}); err != nil {
+		return err
+	}
+	if numOfSegments <= 1 {
+		return nil
+	}
+	s.dropSegment(oldestSegment)
+	return nil
+}
+
 // walkSegment lists all segment under current interval segment dir.
 func (s *intervalSegment) walkSegment(fn func(segmentName string, segmentTime int64)) error {
 	segmentNames, err := listDir(s.dir)
@@ -218,11 +296,20 @@ func (s *intervalSegment) dropSegment(segmentName string) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	if segment, ok := s.segments[segmentName]; ok {
-		segment.Close()
-	}
+	segment, ok := s.segments[segmentName]
 	delete(s.segments, segmentName)
+	if ok {
+		// remove segment dir after all readers released
+		segment.Drop(func() {
+			s.removeSegment(segmentName)
+		})
+		return
+	}
+	s.removeSegment(segmentName)
+}
 
+// removeSegment removes segment's data dir.
+func (s *intervalSegment) removeSegment(segmentName string) {
 	if err := removeDir(path.Join(s.dir, segmentName)); err != nil {
 		s.logger.Warn("remove segment dir failure",
 			logger.String("path", s.dir), logger.String("segment", segmentName),
@@ -299,6 +299,53 @@ func TestIntervalSegment_GetDataFamilies(t *testing.T) {
 	}
 }
 
+func TestIntervalSegment_GetAllDataFamilies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDir = fileutil.ListDir
+		newSegmentFunc = newSegment
+		ctrl.Finish()
+	}()
+
+	now := timeutil.Now() - 4*timeutil.OneDay
+	segmentDir := timeutil.FormatTimestamp(now, "20060102")
+	expiredDir := timeutil.FormatTimestamp(now-30*timeutil.OneDay, "20060102")
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		segments: map[string]Segment{
+			segmentDir: segment,
+		},
+		interval: option.Interval{
+			Interval:  timeutil.Interval(timeutil.OneSecond * 10),
+			Retention: timeutil.Interval(timeutil.OneDay * 20),
+		},
+		logger: logger.GetLogger("test", "Segment"),
+	}
+	// case 1: list segment path failure
+	listDir = func(path string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	families, err := s.GetAllDataFamilies()
+	assert.Error(t, err)
+	assert.Empty(t, families)
+	// case 2: skip expired segment
+	listDir = func(path string) ([]string, error) {
+		return []string{expiredDir, segmentDir}, nil
+	}
+	segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{nil, nil})
+	families, err = s.GetAllDataFamilies()
+	assert.NoError(t, err)
+	assert.Len(t, families, 2)
+	// case 3: load segment failure
+	delete(s.segments, segmentDir)
+	newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	families, err = s.GetAllDataFamilies()
+	assert.Error(t, err)
+	assert.Empty(t, families)
+}
+
 func TestIntervalSegment_TTL(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -336,7 +383,9 @@ func TestIntervalSegment_TTL(t *testing.T) {
 				listDir = func(path string) ([]string, error) {
 					return []string{segmentDir}, nil
 				}
-				segment.EXPECT().Close()
+				segment.EXPECT().Drop(gomock.Any()).Do(func(fn func()) {
+					fn()
+				})
 				removeDir = func(path string) error {
 					return fmt.Errorf("err")
 				}
@@ -349,7 +398,9 @@ func TestIntervalSegment_TTL(t *testing.T) {
 				listDir = func(path string) ([]string, error) {
 					return []string{segmentDir}, nil
 				}
-				segment.EXPECT().Close()
+				segment.EXPECT().Drop(gomock.Any()).Do(func(fn func()) {
+					fn()
+				})
 				removeDir = func(path string) error {
 					return nil
 				}
@@ -408,3 +459,86 @@ func TestIntervalSegment_EvictSegment(t *testing.T) {
 	s.EvictSegment()
 	assert.Len(t, s.segments, 0)
 }
+
+func TestIntervalSegment_GetSegmentStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDir = fileutil.ListDir
+		newSegmentFunc = newSegment
+		ctrl.Finish()
+	}()
+
+	segmentDir := timeutil.FormatTimestamp(timeutil.Now(), "20060102")
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		segments: map[string]Segment{
+			segmentDir: segment,
+		},
+		interval: option.Interval{
+			Interval:  timeutil.Interval(timeutil.OneSecond * 10),
+			Retention: timeutil.Interval(timeutil.OneDay * 20),
+		},
+		logger: logger.GetLogger("TSDB", "Test"),
+	}
+	// list segment path failure
+	listDir = func(path string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	states, err := s.GetSegmentStates()
+	assert.Error(t, err)
+	assert.Nil(t, states)
+	// load segment failure
+	listDir = func(path string) ([]string, error) {
+		return []string{"abc", "20220101", segmentDir}, nil
+	}
+	newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	segment.EXPECT().GetState().Return(models.SegmentState{Interval: "10s"})
+	states, err = s.GetSegmentStates()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SegmentState{{Interval: "10s", Name: segmentDir}}, states)
+}
+
+func TestIntervalSegment_ExpireOldestSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDir = fileutil.GetDirectoryList
+		removeDir = fileutil.RemoveDir
+		ctrl.Finish()
+	}()
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		interval: option.Interval{
+			Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(30 * timeutil.OneDay),
+		},
+		segments: map[string]Segment{
+			"20220101": segment,
+		},
+		logger: logger.GetLogger("TSDB", "Segment"),
+	}
+	removeDir = func(path string) error {
+		return nil
+	}
+	// list segment failure
+	listDir = func(path string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.ExpireOldestSegment())
+	// keep the only segment
+	listDir = func(path string) ([]string, error) {
+		return []string{"20220101"}, nil
+	}
+	assert.NoError(t, s.ExpireOldestSegment())
+	assert.Len(t, s.segments, 1)
+	// drop the oldest segment
+	listDir = func(path string) ([]string, error) {
+		return []string{"20220103", "20220101", "20220102"}, nil
+	}
+	segment.EXPECT().Drop(gomock.Any()).Do(func(fn func()) {
+		fn()
+	})
+	assert.NoError(t, s.ExpireOldestSegment())
+	assert.Len(t, s.segments, 0)
+}
@@ -0,0 +1,111 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"go.uber.org/atomic"
+)
+
+const (
+	// arenaMinChunkSize represents the size of first chunk which arena allocates from heap,
+	// chunk size doubles until arenaChunkSize, so that stripes with few writes don't hold large chunks.
+	arenaMinChunkSize = 16 * 1024 // 16KB
+	// arenaChunkSize represents the max size of chunk which arena allocates from heap.
+	arenaChunkSize = 1024 * 1024 // 1MB
+	// arenaMaxAllocSize represents the max size of buffer allocated from chunk,
+	// large buffer allocates from heap directly for reducing fragment of chunk.
+	arenaMaxAllocSize = arenaChunkSize / 8
+)
+
+// arena represents a chunked bump allocator for compressed data of field store,
+// buffers are allocated from large chunks, so that millions of small slices don't pressure GC,
+// and all chunks can be released in large blocks after memory database flushed.
+// NOTICE: arena is not thread-safe, must be used under the lock of metric stripe.
+type arena struct {
+	chunks [][]byte
+	offset int // offset of current chunk
+
+	allocated atomic.Int64 // size of chunks allocated from heap
+	used      atomic.Int64 // size of buffers allocated from chunks
+}
+
+// newArena creates an arena.
+func newArena() *arena {
+	return &arena{}
+}
+
+// copy copies data into dst if dst has enough capacity, else allocates new buffer from arena then copies data,
+// new buffer reserves more capacity because compressed data of field store grows continuously.
+func (a *arena) copy(dst, data []byte) []byte {
+	if cap(dst) >= len(data) {
+		dst = dst[:len(data)]
+		copy(dst, data)
+		return dst
+	}
+	dst = a.alloc(len(data), len(data)+len(data)/2)
+	copy(dst, data)
+	return dst
+}
+
+// alloc allocates buffer with given length/capacity from arena.
+func (a *arena) alloc(length, capacity int) []byte {
+	if capacity > arenaMaxAllocSize {
+		return make([]byte, length, capacity)
+	}
+	if len(a.chunks) == 0 || a.offset+capacity > len(a.chunks[len(a.chunks)-1]) {
+		chunkSize := a.nextChunkSize(capacity)
+		a.chunks = append(a.chunks, make([]byte, chunkSize))
+		a.offset = 0
+		a.allocated.Add(int64(chunkSize))
+	}
+	chunk := a.chunks[len(a.chunks)-1]
+	// limit capacity of buffer, avoid overwriting other buffer in same chunk
+	buf := chunk[a.offset : a.offset+length : a.offset+capacity]
+	a.offset += capacity
+	a.used.Add(int64(capacity))
+	return buf
+}
+
+// nextChunkSize returns the size of next chunk, doubles the size of last chunk until arenaChunkSize,
+// and the chunk must be large enough for the buffer.
+func (a *arena) nextChunkSize(capacity int) int {
+	chunkSize := arenaMinChunkSize
+	if n := len(a.chunks); n > 0 {
+		chunkSize = len(a.chunks[n-1]) * 2
+		if chunkSize > arenaChunkSize {
+			chunkSize = arenaChunkSize
+		}
+	}
+	for chunkSize < capacity {
+		chunkSize <<= 1
+	}
+	return chunkSize
+}
+
+// utilization returns the size of allocated chunks and used buffers.
+func (a *arena) utilization() (allocated, used int64) {
+	return a.allocated.Load(), a.used.Load()
+}
+
+// release releases all chunks of arena, chunks can be collected by GC after field stores released.
+func (a *arena) release() {
+	a.chunks = nil
+	a.offset = 0
+	a.allocated.Store(0)
+	a.used.Store(0)
+}
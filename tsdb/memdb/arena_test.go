@@ -0,0 +1,82 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArena_alloc(t *testing.T) {
+	a := newArena()
+	allocated, used := a.utilization()
+	assert.Zero(t, allocated)
+	assert.Zero(t, used)
+
+	buf := a.alloc(10, 16)
+	assert.Len(t, buf, 10)
+	assert.Equal(t, 16, cap(buf))
+	allocated, used = a.utilization()
+	assert.Equal(t, int64(arenaMinChunkSize), allocated)
+	assert.Equal(t, int64(16), used)
+	// append cannot overwrite next buffer
+	buf2 := a.alloc(4, 4)
+	copy(buf2, []byte{1, 2, 3, 4})
+	buf = append(buf, make([]byte, 10)...)
+	assert.Equal(t, []byte{1, 2, 3, 4}, buf2)
+	assert.Len(t, buf, 20)
+
+	// allocate new chunk, chunk size doubles
+	_ = a.alloc(arenaMinChunkSize, arenaMinChunkSize)
+	allocated, _ = a.utilization()
+	assert.Equal(t, int64(3*arenaMinChunkSize), allocated)
+	// chunk must be large enough for buffer
+	_ = a.alloc(arenaMaxAllocSize, arenaMaxAllocSize)
+	allocated, _ = a.utilization()
+	assert.Equal(t, int64(3*arenaMinChunkSize+arenaMaxAllocSize), allocated)
+	// chunk size limited by max chunk size
+	for i := 0; i < 8; i++ {
+		_ = a.alloc(arenaMaxAllocSize, arenaMaxAllocSize)
+	}
+	assert.Equal(t, arenaChunkSize, len(a.chunks[len(a.chunks)-1]))
+	allocated, _ = a.utilization()
+	// large buffer allocates from heap
+	buf = a.alloc(arenaMaxAllocSize+1, arenaMaxAllocSize+1)
+	assert.Len(t, buf, arenaMaxAllocSize+1)
+	allocated2, _ := a.utilization()
+	assert.Equal(t, allocated, allocated2)
+
+	a.release()
+	allocated, used = a.utilization()
+	assert.Zero(t, allocated)
+	assert.Zero(t, used)
+}
+
+func TestArena_copy(t *testing.T) {
+	a := newArena()
+	dst := a.copy(nil, []byte{1, 2, 3, 4})
+	assert.Equal(t, []byte{1, 2, 3, 4}, dst)
+	assert.Equal(t, 6, cap(dst))
+	// reuse dst if capacity is enough
+	dst2 := a.copy(dst, []byte{4, 3, 2, 1, 0})
+	assert.Equal(t, []byte{4, 3, 2, 1, 0}, dst2)
+	assert.Equal(t, &dst[0], &dst2[0])
+	_, used := a.utilization()
+	assert.Equal(t, int64(6), used)
+}
@@ -23,6 +23,7 @@ import (
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -37,6 +38,8 @@ type BufferManager interface {
 	GarbageCollect()
 	// Cleanup cleans all history buffers.
 	Cleanup()
+	// State returns the state of write buffers.
+	State() models.WriteBufferState
 }
 
 // bufferManager implements BufferManager.
@@ -45,6 +48,10 @@ type bufferManager struct {
 
 	value atomic.Value // []DataPointBuffer
 
+	gcCount    atomic.Int64
+	gcBuffers  atomic.Int64
+	lastGCTime atomic.Int64
+
 	logger *logger.Logger
 }
 
@@ -103,6 +110,20 @@ func (b *bufferManager) GarbageCollect() {
 	}
 
 	b.value.Store(newSet)
+
+	b.gcCount.Inc()
+	b.gcBuffers.Add(int64(len(oldSet) - len(newSet)))
+	b.lastGCTime.Store(timeutil.Now())
+}
+
+// State returns the state of write buffers.
+func (b *bufferManager) State() models.WriteBufferState {
+	return models.WriteBufferState{
+		NumOfBuffers: len(b.value.Load().([]DataPointBuffer)),
+		GCCount:      b.gcCount.Load(),
+		GCBuffers:    b.gcBuffers.Load(),
+		LastGCTime:   b.lastGCTime.Load(),
+	}
 }
 
 // Cleanup cleans all history buffers.
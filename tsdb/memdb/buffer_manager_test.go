@@ -108,4 +108,10 @@ func TestBufferManager_GarbageCollect(t *testing.T) {
 	assert.Len(t, oldSet, 2)
 	assert.Same(t, oldSet[0], buf1)
 	assert.Same(t, oldSet[1], buf3)
+
+	state := mgr.State()
+	assert.Equal(t, 2, state.NumOfBuffers)
+	assert.Equal(t, int64(3), state.GCCount)
+	assert.Equal(t, int64(1), state.GCBuffers)
+	assert.True(t, state.LastGCTime > 0)
 }
@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"go.uber.org/atomic"
 
@@ -65,6 +66,7 @@ type dataPointBuffer struct {
 	files     []*os.File
 	pageIDSeq atomic.Int32
 	dirty     atomic.Bool
+	lock      sync.Mutex // lock of allocating page, metric stripes of memory database allocate page concurrently
 }
 
 // newDataPointBuffer creates data point buffer for writing points of metric.
@@ -80,6 +82,9 @@ func newDataPointBuffer(path string) (DataPointBuffer, error) {
 
 // AllocPage allocates the page buffer for writing data point
 func (d *dataPointBuffer) AllocPage() (buf []byte, err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
 	pageID := d.pageIDSeq.Inc()
 	if pageID%pageCount == 0 {
 		if err := mkdirFunc(d.path); err != nil {
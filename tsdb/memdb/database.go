@@ -19,6 +19,7 @@ package memdb
 
 import (
 	"io"
+	"sort"
 	"sync"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
@@ -47,10 +49,9 @@ type MemoryDatabase interface {
 	IsReadOnly() bool
 	// AcquireWrite acquires writing data points
 	AcquireWrite()
-	// WithLock retrieves the lock of memdb, and returns the release function
-	WithLock() (release func())
-	// WriteRow must be called after WithLock
-	// Used for batch write
+	// WithLock retrieves the lock of metric stripe which metric belongs to, and returns the release function
+	WithLock(metricID metric.ID) (release func())
+	// WriteRow writes row into metric store, acquires the lock of metric stripe which metric belongs to
 	WriteRow(row *metric.StorageRow) error
 	// CompleteWrite completes writing data points
 	CompleteWrite()
@@ -71,6 +72,8 @@ type MemoryDatabase interface {
 	NumOfMetrics() int
 	// NumOfSeries returns the number of series.
 	NumOfSeries() int
+	// ArenaUtilization returns the size of allocated chunks and used buffers of arena.
+	ArenaUtilization() (allocated, used int64)
 }
 
 // MemoryDatabaseCfg represents the memory database config
@@ -88,6 +91,18 @@ type flushContext struct {
 	fieldIdx           int
 }
 
+// numOfMetricStripes represents the number of metric stripes, must be power of 2.
+const numOfMetricStripes = 32
+
+// metricStripe holds the metric stores which metric ids mapping to same stripe,
+// writes of different stripes can be done concurrently.
+type metricStripe struct {
+	lock    sync.RWMutex       // lock of create metric store/write data
+	mStores *MetricBucketStore // metric id => mStoreINTF
+	arena   *arena             // arena of compressed data of field stores
+	unlock  func()             // cached unlock func, avoid allocating for each write
+}
+
 // memoryDatabase implements MemoryDatabase.
 type memoryDatabase struct {
 	allocSize   atomic.Int64 // allocated size
@@ -96,11 +111,10 @@ type memoryDatabase struct {
 	familyTime int64
 	name       string
 
-	mStores *MetricBucketStore // metric id => mStoreINTF
+	stripes [numOfMetricStripes]metricStripe
 	buf     DataPointBuffer
 
 	writeCondition sync.WaitGroup
-	rwMutex        sync.RWMutex // lock of create metric store
 
 	readonly atomic.Bool
 
@@ -119,11 +133,16 @@ func NewMemoryDatabase(cfg MemoryDatabaseCfg) (MemoryDatabase, error) {
 		familyTime:  cfg.FamilyTime,
 		name:        cfg.Name,
 		buf:         buf,
-		mStores:     NewMetricBucketStore(),
 		allocSize:   *atomic.NewInt64(0),
 		createdTime: fasttime.UnixNano(),
 		statistics:  metrics.NewMemDBStatistics(cfg.Name),
 	}
+	for idx := range db.stripes {
+		stripe := &db.stripes[idx]
+		stripe.mStores = NewMetricBucketStore()
+		stripe.arena = newArena()
+		stripe.unlock = stripe.lock.Unlock
+	}
 	return db, nil
 }
 
@@ -139,31 +158,38 @@ func (md *memoryDatabase) IsReadOnly() bool {
 
 func (md *memoryDatabase) FamilyTime() int64 { return md.familyTime }
 
-func (md *memoryDatabase) metricBucketSize() int {
-	var size int
-	size += cap(md.mStores.values)*24 + 24
-	for idx := range md.mStores.values {
-		size += cap(md.mStores.values[idx])*8 + 24
+// stripeOf returns the metric stripe which metric belongs to,
+// metric id is assigned by sequence, so low bits of metric id distribute metrics into stripes evenly.
+func (md *memoryDatabase) stripeOf(metricID metric.ID) *metricStripe {
+	return &md.stripes[uint32(metricID)&(numOfMetricStripes-1)]
+}
+
+// metricBucketSize returns the memory size of metric ids bitmap and metric store slices.
+func (s *metricStripe) metricBucketSize() int {
+	size := int(s.mStores.keys.GetSizeInBytes())
+	size += sliceSize(cap(s.mStores.values), sliceHeaderSize)
+	for idx := range s.mStores.values {
+		size += sliceSize(cap(s.mStores.values[idx]), interfaceSize)
 	}
 	return size
 }
 
-// getOrCreateMStore returns the mStore by metricHash.
-func (md *memoryDatabase) getOrCreateMStore(metricID metric.ID) (mStore mStoreINTF) {
+// getOrCreateMStore returns the mStore by metric id, must be called under lock of metric stripe.
+func (md *memoryDatabase) getOrCreateMStore(stripe *metricStripe, metricID metric.ID) (mStore mStoreINTF) {
 	metricKey := uint32(metricID)
 
-	if mStore0, ok := md.mStores.Get(metricKey); ok {
+	if mStore0, ok := stripe.mStores.Get(metricKey); ok {
 		// found metric store in current memory database
 		return mStore0
 	}
 	// not found need create new metric store
-	beforeMetricBucketSize := md.metricBucketSize()
+	beforeMetricBucketSize := stripe.metricBucketSize()
 	mStore = newMetricStore()
 	// add metric-store size
 	md.allocSize.Add(int64(mStore.Capacity()))
 	// add metric-bucket increased
-	md.mStores.Put(metricKey, mStore)
-	md.allocSize.Add(int64(md.metricBucketSize() - beforeMetricBucketSize))
+	stripe.mStores.Put(metricKey, mStore)
+	md.allocSize.Add(int64(stripe.metricBucketSize() - beforeMetricBucketSize))
 	return
 }
 
@@ -177,15 +203,24 @@ func (md *memoryDatabase) CompleteWrite() {
 	md.writeCondition.Done()
 }
 
-func (md *memoryDatabase) WithLock() (release func()) {
-	md.rwMutex.Lock()
-	return md.rwMutex.Unlock
+// WithLock retrieves the lock of metric stripe which metric belongs to, and returns the release function.
+func (md *memoryDatabase) WithLock(metricID metric.ID) (release func()) {
+	stripe := md.stripeOf(metricID)
+	stripe.lock.Lock()
+	return stripe.unlock
 }
 
+// WriteRow writes row into metric store, acquires the lock of metric stripe which metric belongs to.
 func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
-	mStore := md.getOrCreateMStore(row.MetricID)
+	stripe := md.stripeOf(row.MetricID)
+	stripe.lock.Lock()
+	defer stripe.lock.Unlock()
+
+	mStore := md.getOrCreateMStore(stripe, row.MetricID)
 	var size int
-	defer md.allocSize.Add(int64(size))
+	defer func() {
+		md.allocSize.Add(int64(size))
+	}()
 
 	beforeMStoreCapacity := mStore.Capacity()
 	tStore, created := mStore.GetOrCreateTStore(row.SeriesID)
@@ -208,7 +243,7 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 			row.FieldIDs[fieldIDIdx],
 			simpleFieldItr.NextType(),
 			simpleFieldItr.NextValue(),
-			mStore, tStore,
+			stripe.arena, mStore, tStore,
 		)
 		if err != nil {
 			return err
@@ -230,7 +265,7 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 		writtenLinFieldSize, err = md.writeLinField(
 			row.SlotIndex, row.FieldIDs[fieldIDIdx],
 			field.MinField, compoundFieldItr.Min(),
-			mStore, tStore)
+			stripe.arena, mStore, tStore)
 		if err != nil {
 			return err
 		}
@@ -241,7 +276,7 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 		writtenLinFieldSize, err = md.writeLinField(
 			row.SlotIndex, row.FieldIDs[fieldIDIdx],
 			field.MaxField, compoundFieldItr.Max(),
-			mStore, tStore)
+			stripe.arena, mStore, tStore)
 		if err != nil {
 			return err
 		}
@@ -251,7 +286,7 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 	writtenLinFieldSize, err = md.writeLinField(
 		row.SlotIndex, row.FieldIDs[fieldIDIdx],
 		field.SumField, compoundFieldItr.Sum(),
-		mStore, tStore)
+		stripe.arena, mStore, tStore)
 	if err != nil {
 		return err
 	}
@@ -261,7 +296,7 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 	writtenLinFieldSize, err = md.writeLinField(
 		row.SlotIndex, row.FieldIDs[fieldIDIdx],
 		field.SumField, compoundFieldItr.Count(),
-		mStore, tStore)
+		stripe.arena, mStore, tStore)
 	if err != nil {
 		return err
 	}
@@ -274,13 +309,18 @@ func (md *memoryDatabase) WriteRow(row *metric.StorageRow) error {
 		writtenLinFieldSize, err = md.writeLinField(
 			row.SlotIndex, row.FieldIDs[fieldIDIdx],
 			field.HistogramField, compoundFieldItr.NextValue(),
-			mStore, tStore)
+			stripe.arena, mStore, tStore)
 		if err != nil {
 			return err
 		}
 		afterWrite(writtenLinFieldSize)
 	}
 
+	// write histogram sketch, if database enables histogram sketch
+	if row.Sketch != nil && !row.Sketch.IsEmpty() {
+		afterWrite(md.writeSketchField(row.SlotIndex, row.FieldIDs[fieldIDIdx], row.Sketch, mStore, tStore))
+	}
+
 End:
 	if written {
 		mStore.SetSlot(row.SlotIndex)
@@ -291,7 +331,7 @@ End:
 func (md *memoryDatabase) writeLinField(
 	slotIndex uint16,
 	fieldID field.ID, fieldType field.Type, fieldValue float64,
-	mStore mStoreINTF, tStore tStoreINTF,
+	arena *arena, mStore mStoreINTF, tStore tStoreINTF,
 ) (writtenSize int, err error) {
 	fStore, ok := tStore.GetFStore(fieldID)
 	if !ok {
@@ -301,7 +341,7 @@ func (md *memoryDatabase) writeLinField(
 			return 0, err
 		}
 		md.statistics.AllocatedPages.Incr()
-		fStore = newFieldStore(buf, fieldID)
+		fStore = newFieldStore(arena, buf, fieldID)
 		writtenSize += fStore.Capacity()
 		beforeTStoreSize := tStore.Capacity()
 		tStore.InsertFStore(fStore)
@@ -316,33 +356,73 @@ func (md *memoryDatabase) writeLinField(
 	return writtenSize + fStore.Capacity() - beforeFStoreCapacity, nil
 }
 
+func (md *memoryDatabase) writeSketchField(
+	slotIndex uint16,
+	fieldID field.ID, sketch *ddsketch.Sketch,
+	mStore mStoreINTF, tStore tStoreINTF,
+) (writtenSize int) {
+	fStore, ok := tStore.GetFStore(fieldID)
+	if !ok {
+		fStore = newSketchFieldStore(fieldID)
+		beforeTStoreSize := tStore.Capacity()
+		tStore.InsertFStore(fStore)
+		writtenSize += tStore.Capacity() - beforeTStoreSize
+		mStore.AddField(fieldID, field.SketchField)
+
+		md.numOfSeries.Inc()
+	} else {
+		writtenSize -= fStore.Capacity()
+	}
+	sketchStore, ok := fStore.(*sketchFieldStore)
+	if !ok {
+		// field type of field id is fixed, cannot happen
+		return writtenSize + fStore.Capacity()
+	}
+	sketchStore.WriteSketch(slotIndex, sketch)
+	return writtenSize + sketchStore.Capacity()
+}
+
 // FlushFamilyTo flushes all data related to the family from metric-stores to builder.
 func (md *memoryDatabase) FlushFamilyTo(flusher metricsdata.Flusher) error {
 	// waiting current writing complete
 	md.writeCondition.Wait()
 
-	var flushCtx flushContext
-	if err := md.mStores.WalkEntry(func(metricID uint32, value mStoreINTF) error {
-		flushCtx.metricID = metricID
-		if err := value.FlushMetricsDataTo(flusher, &flushContext{
-			metricID: metricID,
+	// collect metric stores of all stripes, then flush them in order of metric id
+	var mStores []metricStoreEntry
+	for idx := range md.stripes {
+		_ = md.stripes[idx].mStores.WalkEntry(func(metricID uint32, value mStoreINTF) error {
+			mStores = append(mStores, metricStoreEntry{metricID: metricID, mStore: value})
+			return nil
+		})
+	}
+	sort.Slice(mStores, func(i, j int) bool {
+		return mStores[i].metricID < mStores[j].metricID
+	})
+	for idx := range mStores {
+		if err := mStores[idx].mStore.FlushMetricsDataTo(flusher, &flushContext{
+			metricID: mStores[idx].metricID,
 		}); err != nil {
 			return err
 		}
-		return nil
-	}); err != nil {
-		return err
 	}
 	return flusher.Close()
 }
 
+// metricStoreEntry represents the metric store with metric id.
+type metricStoreEntry struct {
+	metricID uint32
+	mStore   mStoreINTF
+}
+
 // Filter filters the data based on metric/seriesIDs,
 // if it finds data then returns the flow.FilterResultSet, else returns nil
 func (md *memoryDatabase) Filter(shardExecuteContext *flow.ShardExecuteContext) ([]flow.FilterResultSet, error) {
-	md.rwMutex.RLock()
-	defer md.rwMutex.RUnlock()
+	metricID := shardExecuteContext.StorageExecuteCtx.MetricID
+	stripe := md.stripeOf(metricID)
+	stripe.lock.RLock()
+	defer stripe.lock.RUnlock()
 
-	if mStore, ok := md.mStores.Get(uint32(shardExecuteContext.StorageExecuteCtx.MetricID)); ok {
+	if mStore, ok := stripe.mStores.Get(uint32(metricID)); ok {
 		querySlotRange := shardExecuteContext.StorageExecuteCtx.CalcSourceSlotRange(md.familyTime)
 		storageSlotRange := mStore.GetSlotRange()
 		if !storageSlotRange.Overlap(querySlotRange) {
@@ -353,14 +433,20 @@ func (md *memoryDatabase) Filter(shardExecuteContext *flow.ShardExecuteContext)
 	return nil, nil
 }
 
-// MemSize returns the time series database memory size
+// MemSize returns the time series database memory size,
+// includes size of metric/series/field stores and chunks allocated by arena.
 func (md *memoryDatabase) MemSize() int64 {
-	return md.allocSize.Load()
+	arenaAllocated, _ := md.ArenaUtilization()
+	return md.allocSize.Load() + arenaAllocated
 }
 
 // Close releases resources for current memory database.
 func (md *memoryDatabase) Close() error {
 	md.buf.Release()
+	// release chunks of arena in large blocks
+	for idx := range md.stripes {
+		md.stripes[idx].arena.release()
+	}
 	return nil
 }
 
@@ -370,13 +456,27 @@ func (md *memoryDatabase) Uptime() time.Duration {
 
 // NumOfMetrics returns the number of metrics.
 func (md *memoryDatabase) NumOfMetrics() int {
-	md.rwMutex.RLock()
-	defer md.rwMutex.RUnlock()
-
-	return md.mStores.Size()
+	var size int
+	for idx := range md.stripes {
+		stripe := &md.stripes[idx]
+		stripe.lock.RLock()
+		size += stripe.mStores.Size()
+		stripe.lock.RUnlock()
+	}
+	return size
 }
 
 // NumOfSeries returns the number of series.
 func (md *memoryDatabase) NumOfSeries() int {
 	return int(md.numOfSeries.Load())
 }
+
+// ArenaUtilization returns the size of allocated chunks and used buffers of arena.
+func (md *memoryDatabase) ArenaUtilization() (allocated, used int64) {
+	for idx := range md.stripes {
+		stripeAllocated, stripeUsed := md.stripes[idx].arena.utilization()
+		allocated += stripeAllocated
+		used += stripeUsed
+	}
+	return
+}
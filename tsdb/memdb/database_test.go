@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
@@ -149,7 +151,7 @@ func TestMemoryDatabase_Write(t *testing.T) {
 	assert.Zero(t, md.MemSize())
 
 	// load mock
-	md.mStores.Put(uint32(1), mockMStore)
+	md.stripeOf(1).mStores.Put(uint32(1), mockMStore)
 	// case 1: write ok
 	gomock.InOrder(
 		tStore.EXPECT().GetFStore(gomock.Any()).Return(fStore, true),
@@ -214,7 +216,7 @@ func TestMemoryDatabase_Write(t *testing.T) {
 	tStore.EXPECT().InsertFStore(gomock.Any()).AnyTimes()
 	mockMStore.EXPECT().AddField(gomock.Any(), gomock.Any()).AnyTimes()
 	mockMStore.EXPECT().SetSlot(gomock.Any()).AnyTimes()
-	releaseLock := md.WithLock()
+	releaseLock := md.WithLock(1)
 
 	row = protoToStorageRow(&protoMetricsV1.Metric{
 		Name:      "test1",
@@ -268,7 +270,7 @@ func TestMemoryDatabase_Write_err(t *testing.T) {
 	md := mdINTF.(*memoryDatabase)
 
 	// load mock
-	md.mStores.Put(uint32(1), mockMStore)
+	md.stripeOf(1).mStores.Put(uint32(1), mockMStore)
 	// case 1: write ok
 	tStore.EXPECT().GetFStore(gomock.Any()).Return(nil, false)
 
@@ -317,7 +319,7 @@ func TestMemoryDatabase_WriteHistogram_Err(t *testing.T) {
 	mdINTF, err := NewMemoryDatabase(cfg)
 	assert.NoError(t, err)
 	md := mdINTF.(*memoryDatabase)
-	md.mStores.Put(uint32(1), mockMStore)
+	md.stripeOf(1).mStores.Put(uint32(1), mockMStore)
 
 	row := protoToStorageRow(&protoMetricsV1.Metric{
 		Name:      "test1",
@@ -371,6 +373,21 @@ func TestMemoryDatabase_WriteHistogram_Err(t *testing.T) {
 	buf.EXPECT().AllocPage().Return(nil, fmt.Errorf("err"))
 	err = md.WriteRow(row)
 	assert.Error(t, err)
+	// write sketch successfully
+	row.Sketch = ddsketch.NewSketch()
+	row.Sketch.Add(10, 1)
+	row.FieldIDs = append(row.FieldIDs, 11)
+	tStore.EXPECT().GetFStore(field.ID(1)).Return(fStore, true)
+	tStore.EXPECT().GetFStore(field.ID(2)).Return(fStore, true)
+	tStore.EXPECT().GetFStore(field.ID(3)).Return(fStore, true)
+	tStore.EXPECT().GetFStore(field.ID(4)).Return(fStore, true)
+	tStore.EXPECT().GetFStore(gomock.Any()).Return(fStore, true).Times(6)
+	tStore.EXPECT().GetFStore(field.ID(11)).Return(nil, false)
+	tStore.EXPECT().InsertFStore(gomock.Any())
+	mockMStore.EXPECT().AddField(field.ID(11), field.SketchField)
+	mockMStore.EXPECT().SetSlot(uint16(15))
+	err = md.WriteRow(row)
+	assert.NoError(t, err)
 }
 
 func TestMemoryDatabase_FlushFamilyTo(t *testing.T) {
@@ -392,7 +409,7 @@ func TestMemoryDatabase_FlushFamilyTo(t *testing.T) {
 	flusher.EXPECT().Close().Return(nil).AnyTimes()
 	// mock mStore
 	mockMStore := NewMockmStoreINTF(ctrl)
-	md.mStores.Put(uint32(3333), mockMStore)
+	md.stripeOf(3333).mStores.Put(uint32(3333), mockMStore)
 
 	// case 1: flusher ok
 	mockMStore.EXPECT().FlushMetricsDataTo(gomock.Any(), gomock.Any()).Return(nil)
@@ -455,7 +472,7 @@ func TestMemoryDatabase_Filter(t *testing.T) {
 	// mock mStore
 	mockMStore := NewMockmStoreINTF(ctrl)
 	mockMStore.EXPECT().Filter(gomock.Any(), gomock.Any()).Return([]flow.FilterResultSet{}, nil)
-	md.mStores.Put(uint32(3333), mockMStore)
+	md.stripeOf(3333).mStores.Put(uint32(3333), mockMStore)
 	mockMStore.EXPECT().GetSlotRange().Return(&timeutil.SlotRange{Start: 0, End: 60})
 	rs, err = md.Filter(ctx)
 	assert.NoError(t, err)
@@ -470,3 +487,47 @@ func TestMemoryDatabase_Filter(t *testing.T) {
 	err = md.Close()
 	assert.NoError(t, err)
 }
+
+func TestMemoryDatabase_MemSize_MemStats(t *testing.T) {
+	bufferMgr := NewBufferManager(t.TempDir())
+	defer bufferMgr.Cleanup()
+	mdINTF, err := NewMemoryDatabase(MemoryDatabaseCfg{BufferMgr: bufferMgr})
+	assert.NoError(t, err)
+	md := mdINTF.(*memoryDatabase)
+
+	var rows []*metric.StorageRow
+	for i := 0; i < 20000; i++ {
+		for slot := 0; slot < 20; slot += 5 {
+			row := protoToStorageRow(&protoMetricsV1.Metric{
+				Name:      "test",
+				Namespace: "ns",
+				SimpleFields: []*protoMetricsV1.SimpleField{
+					{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: float64(i)},
+					{Name: "f2", Type: protoMetricsV1.SimpleFieldType_LAST, Value: float64(slot)},
+				},
+			})
+			row.MetricID = metric.ID(i % 100)
+			row.SeriesID = uint32(i)
+			row.SlotIndex = uint16(slot)
+			row.FieldIDs = []field.ID{1, 2}
+			rows = append(rows, row)
+		}
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for _, row := range rows {
+		assert.NoError(t, md.WriteRow(row))
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(rows)
+
+	// field store's write buffer allocates from mmap page, which is not heap memory
+	heapSize := md.MemSize() - int64(md.NumOfSeries())*pageSize
+	heapDelta := int64(after.HeapAlloc) - int64(before.HeapAlloc)
+	ratio := float64(heapSize) / float64(heapDelta)
+	assert.Truef(t, ratio > 0.8 && ratio < 1.2, "mem size: %d, heap delta: %d", heapSize, heapDelta)
+	runtime.KeepAlive(md)
+}
@@ -20,8 +20,7 @@ package memdb
 import (
 	"encoding/binary"
 	"math"
-
-	commonencoding "github.com/lindb/common/pkg/encoding"
+	"unsafe"
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/bit"
@@ -50,11 +49,11 @@ const (
 	headLen       = 8
 	valueSize     = 8
 	markContainer = 8
-
-	emptyFieldStoreSize = 24 + // empty buf slice cost
-		24 // empty compress slice cost
 )
 
+// fieldStoreSize represents the memory size of field store struct.
+var fieldStoreSize = roundUpSize(int(unsafe.Sizeof(fieldStore{})))
+
 // fStoreINTF represents field-store,
 // which abstracts a store for storing field data based on family start time + field id
 type fStoreINTF interface {
@@ -78,14 +77,16 @@ type fStoreINTF interface {
 // fieldStore implements fStoreINTF interface
 type fieldStore struct {
 	buf      []byte // current write buffer, accept write data
-	compress []byte // immutable compress data
+	compress []byte // immutable compress data, allocated from arena
+	arena    *arena
 }
 
 // newFieldStore creates a new field store
-func newFieldStore(buf []byte, fieldID field.ID) fStoreINTF {
+func newFieldStore(arena *arena, buf []byte, fieldID field.ID) fStoreINTF {
 	stream.PutUint16(buf, fieldOffset, uint16(fieldID))
 	return &fieldStore{
-		buf: buf,
+		buf:   buf,
+		arena: arena,
 	}
 }
 
@@ -171,7 +172,13 @@ func (fs *fieldStore) resetBuf() {
 
 func (fs *fieldStore) Capacity() int {
 	// notice: do not use cap as it's a allocated page
-	return cap(fs.compress) + len(fs.buf) + emptyFieldStoreSize
+	size := fieldStoreSize + len(fs.buf)
+	if cap(fs.compress) > arenaMaxAllocSize {
+		// large compress data allocates from heap directly,
+		// small compress data allocates from chunk of arena, which is counted by arena.
+		size += roundUpSize(cap(fs.compress))
+	}
+	return size
 }
 
 // compact the current write buffer,
@@ -195,7 +202,7 @@ func (fs *fieldStore) compact(fieldType field.Type, startTime uint16) {
 		memDBLogger.Error("compact field store data err", logger.Error(err))
 	}
 
-	fs.compress = commonencoding.MustCopy(fs.compress, data)
+	fs.compress = fs.arena.copy(fs.compress, data)
 	// !!!!! IMPORTANT: need reset current write buffer
 	fs.resetBuf()
 }
@@ -39,7 +39,7 @@ var encodeFunc = encoding.NewTSDEncoder
 func TestFieldStore_New(t *testing.T) {
 	buf := make([]byte, pageSize)
 
-	store := newFieldStore(buf, field.ID(1))
+	store := newFieldStore(newArena(), buf, field.ID(1))
 	assert.NotNil(t, store)
 	assert.Equal(t, field.ID(1), store.GetFieldID())
 	s := store.(*fieldStore)
@@ -53,7 +53,8 @@ func TestFieldStore_Write(t *testing.T) {
 	defer ctrl.Finish()
 
 	buf := make([]byte, pageSize)
-	store := newFieldStore(buf, field.ID(1))
+	a := newArena()
+	store := newFieldStore(a, buf, field.ID(1))
 	assert.NotNil(t, store)
 	s := store.(*fieldStore)
 
@@ -100,7 +101,8 @@ func TestFieldStore_Write(t *testing.T) {
 	// case 6: compact for slot < start time, time range[5,12]
 	capacity = store.Capacity()
 	store.Write(field.SumField, 5, 5.3)
-	assert.True(t, valueSize < store.Capacity()-capacity)
+	// compress data allocates from arena
+	assert.Zero(t, store.Capacity()-capacity)
 	thisSlotRange = s.slotRange(s.getStart())
 	assert.Equal(t, uint16(5), thisSlotRange.Start)
 	assert.Equal(t, uint16(12), thisSlotRange.End)
@@ -116,7 +118,7 @@ func TestFieldStore_Write(t *testing.T) {
 	// case 8: compact for slot > end time, time range[5,12]
 	capacity = store.Capacity()
 	store.Write(field.SumField, 50, 50.1)
-	assert.True(t, valueSize < store.Capacity()-capacity)
+	assert.Zero(t, store.Capacity()-capacity)
 	thisSlotRange = s.slotRange(s.getStart())
 	assert.Equal(t, uint16(5), thisSlotRange.Start)
 	assert.Equal(t, uint16(50), thisSlotRange.End)
@@ -127,16 +129,19 @@ func TestFieldStore_Write(t *testing.T) {
 	// case 9: write 10 slot, compact old value
 	capacity = store.Capacity()
 	store.Write(field.SumField, 10, 10.1)
-	assert.True(t, valueSize < store.Capacity()-capacity)
+	assert.Zero(t, store.Capacity()-capacity)
 	assert.Equal(t, uint16(0), s.getEnd())
 	value, ok = s.getCurrentValue(10, 10)
 	assert.True(t, ok)
 	assert.InDelta(t, 10.1, value, 0)
+	allocated, used := a.utilization()
+	assert.Equal(t, int64(arenaMinChunkSize), allocated)
+	assert.True(t, used >= int64(len(s.compress)))
 }
 
 func TestFieldStore_Write2(t *testing.T) {
 	buf := make([]byte, pageSize)
-	store := newFieldStore(buf, field.ID(1))
+	store := newFieldStore(newArena(), buf, field.ID(1))
 	s := store.(*fieldStore)
 	store.Write(field.SumField, 10, 178)
 	capacity := s.Capacity()
@@ -163,7 +168,7 @@ func TestFieldStore_Write_Compact_err(t *testing.T) {
 	}()
 
 	buf := make([]byte, pageSize)
-	store := newFieldStore(buf, field.ID(1))
+	store := newFieldStore(newArena(), buf, field.ID(1))
 	assert.NotNil(t, store)
 	s := store.(*fieldStore)
 
@@ -171,7 +176,7 @@ func TestFieldStore_Write_Compact_err(t *testing.T) {
 	assert.NotZero(t, store.Capacity())
 	capacity := store.Capacity()
 	store.Write(field.SumField, 100, 100.1)
-	assert.Equal(t, 13, store.Capacity()-capacity)
+	assert.Zero(t, store.Capacity()-capacity)
 	value, ok := s.getCurrentValue(100, 100)
 	assert.True(t, ok)
 	assert.InDelta(t, 100.1, value, 0)
@@ -180,6 +185,19 @@ func TestFieldStore_Write_Compact_err(t *testing.T) {
 	assert.NotNil(t, s.compress)
 }
 
+func TestFieldStore_Capacity(t *testing.T) {
+	buf := make([]byte, pageSize)
+	store := newFieldStore(newArena(), buf, field.ID(1))
+	s := store.(*fieldStore)
+	assert.Equal(t, fieldStoreSize+pageSize, store.Capacity())
+	// small compress data allocates from arena
+	s.compress = s.arena.alloc(10, 16)
+	assert.Equal(t, fieldStoreSize+pageSize, store.Capacity())
+	// large compress data allocates from heap
+	s.compress = s.arena.alloc(arenaMaxAllocSize+1, arenaMaxAllocSize+1)
+	assert.Equal(t, fieldStoreSize+pageSize+roundUpSize(arenaMaxAllocSize+1), store.Capacity())
+}
+
 func TestFieldStore_FlushFieldTo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -195,7 +213,7 @@ func TestFieldStore_FlushFieldTo(t *testing.T) {
 	slotRange := timeutil.SlotRange{Start: 5, End: 5}
 	for idx, f := range fields {
 		buf := make([]byte, pageSize)
-		store := newFieldStore(buf, f.ID)
+		store := newFieldStore(newArena(), buf, f.ID)
 		store.Write(field.SumField, 5, float64(f.ID))
 		assert.NoError(t, store.FlushFieldTo(flusher,
 			field.Meta{Type: field.SumField},
@@ -206,7 +224,7 @@ func TestFieldStore_FlushFieldTo(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, flusher.CommitMetric(slotRange))
 	data := nopKVFlusher.Bytes()
-	r, err := metricsdata.NewReader("1.sst", data)
+	r, err := metricsdata.NewReader("1.sst", data, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -249,7 +267,7 @@ func TestFieldStore_FlushFieldTo(t *testing.T) {
 func TestFieldStore_Load(t *testing.T) {
 	buf := make([]byte, pageSize)
 	f := field.Meta{ID: 1}
-	store := newFieldStore(buf, f.ID)
+	store := newFieldStore(newArena(), buf, f.ID)
 	store.Write(field.SumField, 5, float64(f.ID))
 
 	ctx := &flow.DataLoadContext{
@@ -26,10 +26,13 @@ import (
 	"sync"
 	"testing"
 
+	"go.uber.org/atomic"
+
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
 )
 
 func BenchmarkMemoryDatabase_write(b *testing.B) {
@@ -47,8 +50,6 @@ func BenchmarkMemoryDatabase_write(b *testing.B) {
 		_ = http.ListenAndServe("0.0.0.0:6060", nil)
 	}()
 	// batch write
-	release := db.WithLock()
-
 	row := protoToStorageRow(&protoMetricsV1.Metric{
 		Name:      "test",
 		Namespace: "ns",
@@ -64,7 +65,6 @@ func BenchmarkMemoryDatabase_write(b *testing.B) {
 		row.FieldIDs = []field.ID{1}
 		_ = db.WriteRow(row)
 	}
-	release()
 
 	runtime.GC()
 	fmt.Printf("cost:=%d\n", timeutil.Now()-now)
@@ -83,9 +83,7 @@ func BenchmarkMemoryDatabase_write(b *testing.B) {
 		row.SeriesID = uint32(i)
 		row.SlotIndex = uint16(i % 1024)
 		row.FieldIDs = []field.ID{1}
-		release := db.WithLock()
 		_ = db.WriteRow(row)
-		release()
 	}
 	runtime.GC()
 	fmt.Printf("cost:=%d\n", timeutil.Now()-now)
@@ -145,3 +143,52 @@ func BenchmarkMemoryDatabase_write_sum(b *testing.B) {
 	fmt.Println(timeutil.Now() - now)
 	run(0)
 }
+
+func BenchmarkMemoryDatabase_write_parallel(b *testing.B) {
+	const (
+		numOfMetrics = 1024
+		numOfSeries  = 2 * 1024 * 1024 // active series
+	)
+	bufferMgr := NewBufferManager(filepath.Join(b.TempDir(), "data_temp"))
+	db, err := NewMemoryDatabase(MemoryDatabaseCfg{
+		BufferMgr: bufferMgr,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		db.MarkReadOnly()
+		_ = db.Close()
+	}()
+	newRow := func() *metric.StorageRow {
+		row := protoToStorageRow(&protoMetricsV1.Metric{
+			Name:      "test",
+			Namespace: "ns",
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 10},
+			},
+		})
+		row.FieldIDs = []field.ID{1}
+		return row
+	}
+	// prepare active series
+	row := newRow()
+	for i := 0; i < numOfSeries; i++ {
+		row.MetricID = metric.ID(i % numOfMetrics)
+		row.SeriesID = uint32(i / numOfMetrics)
+		_ = db.WriteRow(row)
+	}
+	var seq atomic.Uint32
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		row := newRow()
+		for pb.Next() {
+			i := seq.Inc()
+			row.MetricID = metric.ID(i % numOfMetrics)
+			row.SeriesID = (i / numOfMetrics) % (numOfSeries / numOfMetrics)
+			row.SlotIndex = uint16(i % 60)
+			_ = db.WriteRow(row)
+		}
+	})
+}
@@ -19,6 +19,7 @@ package memdb
 
 import (
 	"sort"
+	"unsafe"
 
 	"go.uber.org/atomic"
 
@@ -37,15 +38,13 @@ var (
 	flushFunc = flush
 )
 
-const (
-	emptyMStoreSize = 4 + // metricStore put-count
-		8 + // metric store roaring pointer
-		4 + // capacity size
-		12 // slot range pointer and struct
-	fieldMetaSize = 2 + // field id
-		1 + // field Type
-		8 + // field name, string internal pointer
-		4 // field name, string internal len size
+var (
+	// metricStoreSize represents the memory size of metric store struct, includes keys bitmap and slot range.
+	metricStoreSize = roundUpSize(int(unsafe.Sizeof(metricStore{}))) +
+		roundUpSize(int(unsafe.Sizeof(roaring.Bitmap{}))) +
+		roundUpSize(int(unsafe.Sizeof(timeutil.SlotRange{})))
+	// fieldMetaSize represents the memory size of field meta.
+	fieldMetaSize = int(unsafe.Sizeof(field.Meta{}))
 )
 
 // mStoreINTF abstracts a metricStore
@@ -82,7 +81,7 @@ type metricStore struct {
 func newMetricStore() mStoreINTF {
 	var ms metricStore
 	ms.keys = roaring.New() // init keys
-	ms.capacity.Store(int32(emptyMStoreSize + cap(ms.fields)*fieldMetaSize))
+	ms.capacity.Store(int32(metricStoreSize + ms.mStoreSize()))
 	return &ms
 }
 
@@ -113,7 +112,7 @@ func (ms *metricStore) AddField(fieldID field.ID, fieldType field.Type) {
 			ID:   fieldID,
 			Type: fieldType,
 		})
-		ms.capacity.Add(int32((cap(ms.fields) - fieldsCap) * fieldMetaSize))
+		ms.capacity.Add(int32(sliceSize(cap(ms.fields), fieldMetaSize) - sliceSize(fieldsCap, fieldMetaSize)))
 		if len(ms.fields) <= 1 {
 			return
 		}
@@ -122,11 +121,12 @@ func (ms *metricStore) AddField(fieldID field.ID, fieldType field.Type) {
 	}
 }
 
+// mStoreSize returns the memory size of series ids bitmap and time series store slices.
 func (ms *metricStore) mStoreSize() int {
-	var size int
-	size += cap(ms.MetricStore.values)*24 + 24
+	size := int(ms.keys.GetSizeInBytes())
+	size += sliceSize(cap(ms.MetricStore.values), sliceHeaderSize)
 	for idx := range ms.MetricStore.values {
-		size += cap(ms.MetricStore.values[idx])*8 + 24
+		size += sliceSize(cap(ms.MetricStore.values[idx]), interfaceSize)
 	}
 	return size
 }
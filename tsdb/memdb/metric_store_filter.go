@@ -26,6 +26,7 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
 )
 
 // Filter filters the data based on fields/seriesIDs/family time,
@@ -53,6 +54,7 @@ func (ms *metricStore) Filter(shardExecuteContext *flow.ShardExecuteContext, db
 	return []flow.FilterResultSet{
 		&memFilterResultSet{
 			db:         db,
+			metricID:   shardExecuteContext.StorageExecuteCtx.MetricID,
 			familyTime: familyTime,
 			store:      ms,
 			fields:     fields,
@@ -64,6 +66,7 @@ func (ms *metricStore) Filter(shardExecuteContext *flow.ShardExecuteContext, db
 // memFilterResultSet represents memory filter result set for loading data in query flow
 type memFilterResultSet struct {
 	db         MemoryDatabase
+	metricID   metric.ID
 	familyTime int64
 	store      *metricStore
 	fields     field.Metas // sort by field id
@@ -112,7 +115,7 @@ func (rs *memFilterResultSet) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 	}
 
 	// must use lowContainer from store, because get series index based on container
-	return newMetricStoreLoader(rs.db, lowContainer, rs.store.values[highContainerIdx], *rs.store.slotRange, rs.fields)
+	return newMetricStoreLoader(rs.db, rs.metricID, lowContainer, rs.store.values[highContainerIdx], *rs.store.slotRange, rs.fields)
 }
 
 // Close release the resource during doing query operation.
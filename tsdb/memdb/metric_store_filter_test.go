@@ -95,7 +95,7 @@ func TestMemFilterResultSet_Load(t *testing.T) {
 
 	db := NewMockMemoryDatabase(ctrl)
 	db.EXPECT().FamilyTime().Return(int64(1)).AnyTimes()
-	db.EXPECT().WithLock().Return(func() {}).AnyTimes()
+	db.EXPECT().WithLock(gomock.Any()).Return(func() {}).AnyTimes()
 	shardCtx := &flow.ShardExecuteContext{
 		StorageExecuteCtx: &flow.StorageExecuteContext{
 			Fields: field.Metas{{ID: 1}, {ID: 20, Type: field.SumField}},
@@ -23,11 +23,13 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
 )
 
 // metricStoreLoader implements flow.DataLoader interface that loads metric data from memory storage.
 type metricStoreLoader struct {
 	db               MemoryDatabase
+	metricID         metric.ID
 	lowContainer     roaring.Container
 	timeSeriesStores []tStoreINTF
 	slotRange        timeutil.SlotRange // slot range of metric store
@@ -36,6 +38,7 @@ type metricStoreLoader struct {
 
 // newMetricStoreLoader creates a memory storage metric loader.
 func newMetricStoreLoader(db MemoryDatabase,
+	metricID metric.ID,
 	lowContainer roaring.Container,
 	timeSeriesStores []tStoreINTF,
 	slotRange timeutil.SlotRange,
@@ -43,6 +46,7 @@ func newMetricStoreLoader(db MemoryDatabase,
 ) flow.DataLoader {
 	return &metricStoreLoader{
 		db:               db,
+		metricID:         metricID,
 		lowContainer:     lowContainer,
 		timeSeriesStores: timeSeriesStores,
 		slotRange:        slotRange,
@@ -52,7 +56,7 @@ func newMetricStoreLoader(db MemoryDatabase,
 
 // Load loads the metric data by given series id from memory storage.
 func (s *metricStoreLoader) Load(loadCtx *flow.DataLoadContext) {
-	release := s.db.WithLock()
+	release := s.db.WithLock(s.metricID)
 	defer release()
 
 	loadCtx.IterateLowSeriesIDs(s.lowContainer, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
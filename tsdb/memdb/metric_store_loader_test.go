@@ -26,6 +26,7 @@ import (
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
 )
 
 func TestMetricStoreLoader_Load(t *testing.T) {
@@ -34,10 +35,10 @@ func TestMetricStoreLoader_Load(t *testing.T) {
 
 	var mutex sync.Mutex
 	db := NewMockMemoryDatabase(ctrl)
-	db.EXPECT().WithLock().Return(mutex.Unlock)
+	db.EXPECT().WithLock(metric.ID(10)).Return(mutex.Unlock)
 	// case 1: series not exist
 	mutex.Lock()
-	s := newMetricStoreLoader(db, roaring.BitmapOf(10, 100).GetContainer(0),
+	s := newMetricStoreLoader(db, metric.ID(10), roaring.BitmapOf(10, 100).GetContainer(0),
 		nil, timeutil.SlotRange{}, nil)
 	s.Load(&flow.DataLoadContext{
 		SeriesIDHighKey:       0,
@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import "sort"
+
+const (
+	// maxSmallSize represents the max size of small object which allocates from size classes.
+	maxSmallSize = 32 * 1024
+	// heapPageSize represents the page size of heap, large object allocates by pages.
+	heapPageSize = 8 * 1024
+	// interfaceSize represents the size of interface(type pointer + data pointer).
+	interfaceSize = 16
+	// sliceHeaderSize represents the size of slice header(data pointer + len + cap).
+	sliceHeaderSize = 24
+)
+
+// sizeClasses represents the size classes of go runtime allocator(tcmalloc/jemalloc style),
+// small object allocates from span of size class which is the smallest one that can hold the object,
+// so real memory usage of object is rounded up to its size class.
+// see: runtime/sizeclasses.go
+var sizeClasses = []int{
+	8, 16, 24, 32, 48, 64, 80, 96, 112, 128, 144, 160, 176, 192, 208, 224, 240, 256,
+	288, 320, 352, 384, 416, 448, 480, 512, 576, 640, 704, 768, 896, 1024, 1152, 1280, 1408, 1536, 1792,
+	2048, 2304, 2688, 3072, 3200, 3456, 4096, 4864, 5376, 6144, 6528, 6784, 6912, 8192, 9472, 9728, 10240,
+	10880, 12288, 13568, 14336, 16384, 18432, 19072, 20480, 21760, 24576, 27264, 28672, 32768,
+}
+
+// roundUpSize returns the real memory size allocated by go runtime for given object size.
+func roundUpSize(size int) int {
+	if size <= 0 {
+		return 0
+	}
+	if size > maxSmallSize {
+		// large object, round up to page size
+		return (size + heapPageSize - 1) / heapPageSize * heapPageSize
+	}
+	return sizeClasses[sort.SearchInts(sizeClasses, size)]
+}
+
+// sliceSize returns the real memory size of slice's backing array with given capacity and element size.
+func sliceSize(capacity, elemSize int) int {
+	return roundUpSize(capacity * elemSize)
+}
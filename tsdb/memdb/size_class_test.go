@@ -0,0 +1,38 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundUpSize(t *testing.T) {
+	assert.Zero(t, roundUpSize(0))
+	assert.Zero(t, roundUpSize(-1))
+	assert.Equal(t, 8, roundUpSize(1))
+	assert.Equal(t, 8, roundUpSize(8))
+	assert.Equal(t, 48, roundUpSize(33))
+	assert.Equal(t, 32768, roundUpSize(28673))
+	assert.Equal(t, 32768, roundUpSize(32768))
+	assert.Equal(t, 40960, roundUpSize(32769))
+	assert.Equal(t, 48, sliceSize(3, 16))
+	assert.Equal(t, 64, sliceSize(5, 12))
+	assert.Zero(t, sliceSize(0, 16))
+}
@@ -0,0 +1,93 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"unsafe"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/ddsketch"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+const (
+	// sketchSlotSize represents the estimated memory size of sketch for one time slot(map entry + sketch + bins map).
+	sketchSlotSize = 128
+	// sketchBinSize represents the estimated memory size of one bin in sketch(map entry of index => count).
+	sketchBinSize = 32
+)
+
+// sketchFieldStoreSize represents the memory size of sketch field store struct.
+var sketchFieldStoreSize = roundUpSize(int(unsafe.Sizeof(sketchFieldStore{})))
+
+// sketchFieldStore implements fStoreINTF interface, stores the histogram sketch of each time slot,
+// the sketches of same time slot are merged when writing.
+type sketchFieldStore struct {
+	fieldID  field.ID
+	sketches *ddsketch.SlotSketches
+}
+
+// newSketchFieldStore creates a sketch field store.
+func newSketchFieldStore(fieldID field.ID) *sketchFieldStore {
+	return &sketchFieldStore{
+		fieldID:  fieldID,
+		sketches: ddsketch.NewSlotSketches(),
+	}
+}
+
+// GetFieldID returns the field id of metric level.
+func (fs *sketchFieldStore) GetFieldID() field.ID {
+	return fs.fieldID
+}
+
+// Capacity returns the estimated size usage of sketches.
+func (fs *sketchFieldStore) Capacity() int {
+	return sketchFieldStoreSize + fs.sketches.Len()*sketchSlotSize + fs.sketches.NumOfBins()*sketchBinSize
+}
+
+// Write ignores the float value, sketch field only accepts sketch which is written by WriteSketch.
+func (fs *sketchFieldStore) Write(_ field.Type, _ uint16, _ float64) {}
+
+// WriteSketch merges the sketch into the sketch of time slot.
+func (fs *sketchFieldStore) WriteSketch(slotIndex uint16, sketch *ddsketch.Sketch) {
+	fs.sketches.Merge(int(slotIndex), sketch)
+}
+
+// FlushFieldTo flushes the sketches of time slots into kv store.
+func (fs *sketchFieldStore) FlushFieldTo(tableFlusher metricsdata.Flusher, _ field.Meta, flushCtx *flushContext) error {
+	data, err := fs.sketches.MarshalRange(int(flushCtx.SlotRange.Start), int(flushCtx.SlotRange.End))
+	if err != nil {
+		memDBLogger.Error("flush sketch field store err, data lost", logger.Error(err))
+		return nil
+	}
+	return tableFlusher.FlushField(data)
+}
+
+// Load loads the sketches of time slots.
+func (fs *sketchFieldStore) Load(ctx *flow.DataLoadContext,
+	seriesIdxFromQuery uint16, fieldIdx int,
+	_ field.Type, slotRange timeutil.SlotRange,
+) {
+	if ctx.LoadSketch == nil {
+		return
+	}
+	ctx.LoadSketch(slotRange, seriesIdxFromQuery, fieldIdx, fs.sketches)
+}
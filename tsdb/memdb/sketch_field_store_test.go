@@ -0,0 +1,117 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/pkg/ddsketch"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+func TestSketchFieldStore_Write(t *testing.T) {
+	store := newSketchFieldStore(field.ID(1))
+	assert.Equal(t, field.ID(1), store.GetFieldID())
+	assert.Equal(t, sketchFieldStoreSize, store.Capacity())
+	// float value ignored
+	store.Write(field.SketchField, 5, 10)
+	assert.Equal(t, sketchFieldStoreSize, store.Capacity())
+
+	sketch := ddsketch.NewSketch()
+	sketch.Add(10, 1)
+	store.WriteSketch(5, sketch)
+	store.WriteSketch(5, sketch)
+	store.WriteSketch(6, sketch)
+	assert.Equal(t, sketchFieldStoreSize+2*sketchSlotSize+2*sketchBinSize, store.Capacity())
+
+	loaded := 0.0
+	ctx := &flow.DataLoadContext{
+		LoadSketch: func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, sketches *ddsketch.SlotSketches) {
+			assert.Equal(t, timeutil.SlotRange{Start: 5, End: 6}, slotRange)
+			for _, slot := range sketches.Slots() {
+				s, _ := sketches.Lookup(slot)
+				loaded += s.Count()
+			}
+		},
+	}
+	store.Load(ctx, 0, 0, field.SketchField, timeutil.SlotRange{Start: 5, End: 6})
+	assert.Equal(t, 3.0, loaded)
+}
+
+func TestSketchFieldStore_FlushFieldTo(t *testing.T) {
+	nopKVFlusher := kv.NewNopFlusher()
+	flusher, err := metricsdata.NewFlusher(nopKVFlusher)
+	assert.NoError(t, err)
+	fields := field.Metas{{ID: 1, Type: field.SumField}, {ID: 2, Type: field.SketchField}}
+	flusher.PrepareMetric(39, fields)
+
+	slotRange := timeutil.SlotRange{Start: 5, End: 6}
+	sumStore := newFieldStore(newArena(), make([]byte, pageSize), fields[0].ID)
+	sumStore.Write(field.SumField, 5, 10)
+	assert.NoError(t, sumStore.FlushFieldTo(flusher, fields[0], &flushContext{SlotRange: slotRange, fieldIdx: 0}))
+	sketchStore := newSketchFieldStore(fields[1].ID)
+	sketch := ddsketch.NewSketch()
+	sketch.Add(10, 1)
+	sketchStore.WriteSketch(5, sketch)
+	sketchStore.WriteSketch(6, sketch)
+	// out of flush slot range
+	sketchStore.WriteSketch(8, sketch)
+	assert.NoError(t, sketchStore.FlushFieldTo(flusher, fields[1], &flushContext{SlotRange: slotRange, fieldIdx: 1}))
+	assert.NoError(t, flusher.FlushSeries(10))
+	assert.NoError(t, flusher.CommitMetric(slotRange))
+
+	r, err := metricsdata.NewReader("1.sst", nopKVFlusher.Bytes(), nil)
+	assert.NoError(t, err)
+	seriesIDs := roaring.BitmapOf(10)
+	var slots []int
+	sum := 0.0
+	ctx := &flow.DataLoadContext{
+		SeriesIDHighKey:       0,
+		LowSeriesIDsContainer: seriesIDs.GetContainer(0),
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Fields: fields,
+				Query:  &stmt.Query{},
+			},
+		},
+		DownSampling: func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
+			assert.Equal(t, 0, fieldIdx)
+			value, _ := getter.GetValue(5)
+			sum += value
+		},
+		LoadSketch: func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, sketches *ddsketch.SlotSketches) {
+			assert.Equal(t, 1, fieldIdx)
+			slots = append(slots, sketches.Slots()...)
+		},
+		Decoder: encoding.GetTSDDecoder(),
+	}
+	ctx.Grouping()
+	r.Load(ctx).Load(ctx)
+	assert.Equal(t, 10.0, sum)
+	assert.Equal(t, []int{5, 6}, slots)
+}
@@ -19,6 +19,7 @@ package memdb
 
 import (
 	"sort"
+	"unsafe"
 
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -28,7 +29,8 @@ import (
 
 //go:generate mockgen -source ./timeseries_store.go -destination=./timeseries_store_mock.go -package memdb
 
-const emptyTimeSeriesStoreSize = 24 // fStores slice
+// timeSeriesStoreSize represents the memory size of time series store struct.
+var timeSeriesStoreSize = roundUpSize(int(unsafe.Sizeof(timeSeriesStore{})))
 
 // tStoreINTF abstracts a time-series store
 type tStoreINTF interface {
@@ -91,7 +93,7 @@ func (ts *timeSeriesStore) GetFStore(fieldID field.ID) (fStoreINTF, bool) {
 }
 
 func (ts *timeSeriesStore) Capacity() int {
-	return emptyTimeSeriesStoreSize + 8*cap(ts.fStoreNodes)
+	return timeSeriesStoreSize + sliceSize(cap(ts.fStoreNodes), interfaceSize)
 }
 
 // InsertFStore inserts a new fStore to field list.
@@ -35,7 +35,7 @@ func TestTimeSeriesStore_GetOrCreateFStore(t *testing.T) {
 	f, ok := tStore.GetFStore(10)
 	assert.Nil(t, f)
 	assert.False(t, ok)
-	tStore.InsertFStore(newFieldStore(make([]byte, pageSize), 10))
+	tStore.InsertFStore(newFieldStore(newArena(), make([]byte, pageSize), 10))
 	// get field store
 	f, ok = tStore.GetFStore(10)
 	assert.NotNil(t, f)
@@ -45,8 +45,8 @@ func TestTimeSeriesStore_GetOrCreateFStore(t *testing.T) {
 	assert.Nil(t, f)
 	assert.False(t, ok)
 	for i := 1; i < 100; i++ {
-		tStore.InsertFStore(newFieldStore(make([]byte, pageSize), field.ID(10*i)))
-		tStore.InsertFStore(newFieldStore(make([]byte, pageSize), 10))
+		tStore.InsertFStore(newFieldStore(newArena(), make([]byte, pageSize), field.ID(10*i)))
+		tStore.InsertFStore(newFieldStore(newArena(), make([]byte, pageSize), 10))
 		f, ok = tStore.GetFStore(10)
 		assert.NotNil(t, f)
 		assert.True(t, ok)
@@ -32,14 +32,32 @@ import (
 
 var metaLogger = logger.GetLogger("TSDB", "MetaDB")
 
+// MetricKey represents the key(namespace + metric name) of metric for generating metric id in batch.
+type MetricKey struct {
+	Namespace  string
+	MetricName string
+}
+
+// FieldKey represents the key(field name + field type) of field for generating field id in batch.
+type FieldKey struct {
+	Name field.Name
+	Type field.Type
+}
+
 // IDGenerator generates unique ID numbers for metric, tag and field.
 type IDGenerator interface {
 	// GenMetricID generates the metric id in the memory
 	GenMetricID(namespace, metricName string, limits *models.Limits) (metricID metric.ID, err error)
+	// GenMetricIDs generates the metric ids in the memory in batch,
+	// metric id/error of each metric key is set into metricIDs/errs with same index.
+	GenMetricIDs(keys []MetricKey, metricIDs []metric.ID, errs []error, limits *models.Limits)
 	// GenFieldID generates the field id in the memory
 	// error-case1: field type doesn't match to before
 	// error-case2: there are too many fields
 	GenFieldID(namespace, metricName string, fieldName field.Name, fieldType field.Type, limits *models.Limits) (field.ID, error)
+	// GenFieldIDs generates the field ids of metric in the memory in batch, appends field ids into dst,
+	// returns the first error if generates any field id failure.
+	GenFieldIDs(namespace, metricName string, fields []FieldKey, dst []field.ID, limits *models.Limits) ([]field.ID, error)
 	// GenTagKeyID generates the tag key id in the memory
 	GenTagKeyID(namespace, metricName, tagKey string, limits *models.Limits) (tag.KeyID, error)
 }
@@ -60,6 +78,12 @@ type IDGetter interface {
 	// GetAllFields returns the all visible fields by namespace/metric name,
 	// if not exist return series.ErrNotFound
 	GetAllFields(namespace, metricName string) (fields field.Metas, err error)
+	// GetAllFieldsAt returns the fields which were created before(include) timestamp by namespace/metric name,
+	// if not exist return constants.ErrMetricIDNotFound.
+	GetAllFieldsAt(namespace, metricName string, timestamp int64) (fields field.Metas, err error)
+	// GetAllTagKeysAt returns the tag keys which were created before(include) timestamp by namespace/metric name,
+	// if not exist return constants.ErrMetricIDNotFound.
+	GetAllTagKeysAt(namespace, metricName string, timestamp int64) (tags tag.Metas, err error)
 	// GetAllHistogramFields returns histogram-fields namespace/metric name,
 	// if not exist return series.ErrNotFound
 	GetAllHistogramFields(namespace, metricName string) (fields field.Metas, err error)
@@ -86,6 +110,13 @@ type MetadataDatabase interface {
 
 	// SuggestNamespace suggests the namespace by namespace's prefix
 	SuggestNamespace(prefix string, limit int) (namespaces []string, err error)
+	// WalkMetrics walks all metrics with namespace/metric name and the last write time since metric cached in memory,
+	// the last write time is 0 if metric not written after opening, stops walking if fn returns false.
+	WalkMetrics(fn func(namespace, metricName string, lastWrite int64) bool) error
+	// DropMetric removes the metadata(metric id/fields/tag keys/schema) of metric from memory and backend storage,
+	// if not exist return constants.ErrMetricIDNotFound, if isActive returns true with the last write time checked
+	// under write lock, keeps the metric and returns constants.ErrMetricActive.
+	DropMetric(namespace, metricName string, isActive func(lastWrite int64) bool) (metric.ID, error)
 	// Sync syncs the pending metadata update event
 	Sync() error
 }
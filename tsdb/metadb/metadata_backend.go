@@ -25,12 +25,15 @@ import (
 	"path"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/pkg/unique"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
@@ -44,27 +47,40 @@ const (
 	MetricDB    = "metric"
 	TagKeyDB    = "tagkey"
 	FieldDB     = "field"
+	// SchemaDB stores the version records(created time) of fields/tag keys for each metric.
+	SchemaDB = "schema"
 )
 
 // for testing
 var (
 	mkDirFn      = fileutil.MkDirIfNotExist
 	newIDStoreFn = unique.NewIDStore
+	nowFunc      = timeutil.Now
 )
 
 var (
 	namespaceIDSequenceKey = []byte("__$$ns_seq$$__")
 	metricIDSequenceKey    = []byte("__$$metric_seq$$__")
 	tagKeyIDSequenceKey    = []byte("__$$key_key_seq$$__")
+	// reclaimed keys store the number of ids released by dropping metric, excluded from the count of limits check.
+	namespaceIDReclaimedKey = []byte("__$$ns_reclaimed$$__")
+	metricIDReclaimedKey    = []byte("__$$metric_reclaimed$$__")
+	// cleanShutdownKey marks the sequences persisted when closing, stores in tag key db.
+	cleanShutdownKey = []byte("__$$clean_shutdown$$__")
 
-	storageDBNames = []string{NamespaceDB, MetricDB, TagKeyDB, FieldDB}
+	storageDBNames = []string{NamespaceDB, MetricDB, TagKeyDB, FieldDB, SchemaDB}
 )
 
 // sequenceItem represents sequence metadata.
 type sequenceItem struct {
-	sequence unique.Sequence
-	store    unique.IDStore
-	key      []byte
+	sequence   unique.Sequence
+	store      unique.IDStore
+	key        []byte
+	reclaimKey []byte                               // key of reclaimed count, nil if ids never reclaimed
+	reclaimed  uint32                               // number of ids released, excluded from the count of limits check
+	blockSize  func() uint32                        // allocation block size of sequence
+	decodeIDs  func(value []byte) ([]uint32, error) // decodes allocated ids from value of store
+	statistics *metrics.MetaSequenceStatistics
 }
 
 // MetadataBackend represents the metadata backend storage.
@@ -88,6 +104,15 @@ type MetadataBackend interface {
 	// getAllFields returns the  all fields by metric id,
 	// if not exist return empty.
 	getAllFields(metricID metric.ID) (fields field.Metas, max field.ID, err error)
+	// getSchemaVersions returns the version records(created time) of fields/tag keys by metric id.
+	getSchemaVersions(metricID metric.ID) (versions *schemaVersions, err error)
+	// walkMetrics walks all metrics with namespace/metric name/metric id, stops walking if fn returns false,
+	// NOTICE: fn cannot modify backend storage.
+	walkMetrics(fn func(namespace, metricName string, metricID metric.ID) bool) error
+	// dropMetric removes the metric id/fields/tag keys/schema of metric, removes the namespace if no metric under it,
+	// the released ids are reclaimed from the count of limits check, returns the metric id of removed metric,
+	// if not exist return constants.ErrMetricIDNotFound.
+	dropMetric(namespace, metricName string) (metric.ID, error)
 
 	// getOrCreateMetricMetadata creates metric metadata if not exist, else load metric metadata from backend storage.
 	getOrCreateMetricMetadata(namespace, metricName string, limits *models.Limits) (MetricMetadata, error)
@@ -101,15 +126,15 @@ type MetadataBackend interface {
 
 // metadataBackend implements the MetadataBackend interface.
 type metadataBackend struct {
-	namespace, metric, tagKey, field                        unique.IDStore
-	namespaceIDSequence, metricIDSequence, tagKeyIDSequence unique.Sequence
+	namespace, metric, tagKey, field, schema                unique.IDStore
+	namespaceIDSequence, metricIDSequence, tagKeyIDSequence *sequenceItem
 
 	dbs       map[string]unique.IDStore
-	sequences []sequenceItem
+	sequences []*sequenceItem
 }
 
 // newMetadataBackend creates a new metadata backend storage.
-func newMetadataBackend(parent string) (MetadataBackend, error) {
+func newMetadataBackend(database, parent string) (MetadataBackend, error) {
 	var storageDBs map[string]unique.IDStore
 	var err error
 	defer func() {
@@ -133,12 +158,13 @@ func newMetadataBackend(parent string) (MetadataBackend, error) {
 		metric:    storageDBs[MetricDB],
 		tagKey:    storageDBs[TagKeyDB],
 		field:     storageDBs[FieldDB],
+		schema:    storageDBs[SchemaDB],
 
 		dbs: storageDBs,
 	}
 	// init seq function
-	initSeq := func(db unique.IDStore, key []byte, init func(seq unique.Sequence)) error {
-		val, exist, err0 := db.Get(key)
+	initSeq := func(item *sequenceItem) error {
+		val, exist, err0 := item.store.Get(item.key)
 		if err0 != nil {
 			return err0
 		}
@@ -146,61 +172,64 @@ func newMetadataBackend(parent string) (MetadataBackend, error) {
 		if exist {
 			sequenceInitValue = binary.LittleEndian.Uint32(val)
 		}
-		cacheSize := config.GlobalStorageConfig().TSDB.MetaSequenceCache
+		blockSize := item.blockSize()
 
 		// persist cached sequence value
-		err = unique.SaveSequence(db, key, sequenceInitValue+cacheSize)
-		if err != nil {
-			return err
+		err0 = unique.SaveSequence(item.store, item.key, sequenceInitValue+blockSize)
+		if err0 != nil {
+			return err0
+		}
+		item.statistics.Reserved.Add(float64(blockSize))
+		item.sequence = unique.NewSequence(sequenceInitValue, sequenceInitValue+blockSize)
+		if item.reclaimKey != nil {
+			val, exist, err0 = item.store.Get(item.reclaimKey)
+			if err0 != nil {
+				return err0
+			}
+			if exist {
+				item.reclaimed = binary.LittleEndian.Uint32(val)
+			}
 		}
-
-		sequence := unique.NewSequence(sequenceInitValue, cacheSize)
-		init(sequence)
 		// cache all sequences
-		backend.sequences = append(backend.sequences,
-			sequenceItem{
-				sequence: sequence,
-				store:    db,
-				key:      key,
-			})
+		backend.sequences = append(backend.sequences, item)
 		return nil
 	}
-	var sequences = []struct {
-		key  []byte
-		db   unique.IDStore
-		init func(seq unique.Sequence)
-	}{
-		{
-			key: namespaceIDSequenceKey,
-			db:  backend.namespace,
-			init: func(seq unique.Sequence) {
-				backend.namespaceIDSequence = seq
-			},
-		},
-		{
-			key: metricIDSequenceKey,
-			db:  backend.metric,
-			init: func(seq unique.Sequence) {
-				backend.metricIDSequence = seq
-			},
-		},
-		{
-			key: tagKeyIDSequenceKey,
-			db:  backend.tagKey,
-			init: func(seq unique.Sequence) {
-				backend.tagKeyIDSequence = seq
-			},
-		},
+	backend.namespaceIDSequence = &sequenceItem{
+		key:        namespaceIDSequenceKey,
+		reclaimKey: namespaceIDReclaimedKey,
+		store:      backend.namespace,
+		blockSize:  func() uint32 { return sequenceBlockSize(config.GlobalStorageConfig().TSDB.NamespaceSequenceCache) },
+		decodeIDs:  decodeID,
+		statistics: metrics.NewMetaSequenceStatistics(database, NamespaceDB),
+	}
+	backend.metricIDSequence = &sequenceItem{
+		key:        metricIDSequenceKey,
+		reclaimKey: metricIDReclaimedKey,
+		store:      backend.metric,
+		blockSize:  func() uint32 { return sequenceBlockSize(config.GlobalStorageConfig().TSDB.MetricSequenceCache) },
+		decodeIDs:  decodeID,
+		statistics: metrics.NewMetaSequenceStatistics(database, MetricDB),
+	}
+	backend.tagKeyIDSequence = &sequenceItem{
+		key:        tagKeyIDSequenceKey,
+		store:      backend.tagKey,
+		blockSize:  func() uint32 { return sequenceBlockSize(config.GlobalStorageConfig().TSDB.TagKeySequenceCache) },
+		decodeIDs:  decodeTagKeyIDs,
+		statistics: metrics.NewMetaSequenceStatistics(database, TagKeyDB),
 	}
 
 	// init sequence with value
-	for _, arg := range sequences {
-		err = initSeq(arg.db, arg.key, arg.init)
+	for _, item := range []*sequenceItem{backend.namespaceIDSequence, backend.metricIDSequence, backend.tagKeyIDSequence} {
+		err = initSeq(item)
 		if err != nil {
 			return nil, err
 		}
 	}
-
+	// audit sequences if not clean shutdown
+	err = backend.auditSequences()
+	if err != nil {
+		return nil, err
+	}
 	return backend, err
 }
 
@@ -227,7 +256,7 @@ func (mb *metadataBackend) suggestNamespace(prefix string, limit int) (namespace
 		return nil, err
 	}
 	for _, val := range values {
-		if bytes.Equal(val, namespaceIDSequenceKey) {
+		if bytes.Equal(val, namespaceIDSequenceKey) || bytes.Equal(val, namespaceIDReclaimedKey) {
 			continue
 		}
 		namespaces = append(namespaces, string(val))
@@ -291,7 +320,7 @@ func (mb *metadataBackend) getMetricID(namespace, metricName string) (metricID m
 
 // saveTagKey saves the tag meta for given metric id.
 func (mb *metadataBackend) saveTagKey(metricID metric.ID, tagKey string) (tag.KeyID, error) {
-	tagKeyID, err := nextSequence(mb.tagKeyIDSequence, mb.tagKey, tagKeyIDSequenceKey, false, 0)
+	tagKeyID, err := nextSequence(mb.tagKeyIDSequence, false, 0)
 	if err != nil {
 		return tag.EmptyTagKeyID, err
 	}
@@ -308,6 +337,9 @@ func (mb *metadataBackend) saveTagKey(metricID metric.ID, tagKey string) (tag.Ke
 	if err := mb.tagKey.Merge(scratch[:], val); err != nil {
 		return tag.EmptyTagKeyID, err
 	}
+	if err := mb.schema.Merge(scratch[:], marshalSchemaVersion(tagKeySchema, uint32(id), nowFunc())); err != nil {
+		return tag.EmptyTagKeyID, err
+	}
 	return id, nil
 }
 
@@ -335,7 +367,10 @@ func (mb *metadataBackend) saveField(metricID metric.ID, f field.Meta) error {
 	}
 	var scratch [4]byte
 	binary.LittleEndian.PutUint32(scratch[:], uint32(metricID))
-	return mb.field.Merge(scratch[:], val)
+	if err := mb.field.Merge(scratch[:], val); err != nil {
+		return err
+	}
+	return mb.schema.Merge(scratch[:], marshalSchemaVersion(fieldSchema, uint32(f.ID), nowFunc()))
 }
 
 // getAllFields returns the  all fields by metric id, if not exist returns empty.
@@ -354,6 +389,88 @@ func (mb *metadataBackend) getAllFields(metricID metric.ID) (fields field.Metas,
 	return
 }
 
+// getSchemaVersions returns the version records(created time) of fields/tag keys by metric id.
+func (mb *metadataBackend) getSchemaVersions(metricID metric.ID) (versions *schemaVersions, err error) {
+	val, _, err := mb.schema.Get(metricID.MarshalBinary())
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalSchemaVersions(val)
+}
+
+// walkMetrics walks all metrics with namespace/metric name/metric id, stops walking if fn returns false.
+func (mb *metadataBackend) walkMetrics(fn func(namespace, metricName string, metricID metric.ID) bool) error {
+	// namespace id => namespace
+	namespaces := make(map[uint32]string)
+	if err := mb.namespace.Walk(nil, func(key, value []byte) bool {
+		if !bytes.Equal(key, namespaceIDSequenceKey) && !bytes.Equal(key, namespaceIDReclaimedKey) && len(value) >= 4 {
+			namespaces[binary.LittleEndian.Uint32(value)] = string(key)
+		}
+		return true
+	}); err != nil {
+		return err
+	}
+	return mb.metric.Walk(nil, func(key, value []byte) bool {
+		if len(key) < 4 || len(value) < 4 {
+			return true
+		}
+		namespace, ok := namespaces[binary.LittleEndian.Uint32(key)]
+		if !ok {
+			// sequence keys or metric of removed namespace
+			return true
+		}
+		return fn(namespace, string(key[4:]), metric.ID(binary.LittleEndian.Uint32(value)))
+	})
+}
+
+// dropMetric removes the metric id/fields/tag keys/schema of metric, removes the namespace if no metric under it,
+// the released ids are reclaimed from the count of limits check.
+func (mb *metadataBackend) dropMetric(namespace, metricName string) (metric.ID, error) {
+	nsIDVal, exist, err := mb.namespace.Get([]byte(namespace))
+	if err != nil {
+		return metric.EmptyMetricID, err
+	}
+	if !exist {
+		return metric.EmptyMetricID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
+	var key []byte
+	key = append(key, nsIDVal...)
+	key = append(key, metricName...)
+	metricIDVal, exist, err := mb.metric.Get(key)
+	if err != nil {
+		return metric.EmptyMetricID, err
+	}
+	if !exist {
+		return metric.EmptyMetricID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
+	metricID := metric.ID(binary.LittleEndian.Uint32(metricIDVal))
+	idKey := metricID.MarshalBinary()
+	for _, store := range []unique.IDStore{mb.field, mb.tagKey, mb.schema} {
+		if err = store.Delete(idKey); err != nil {
+			return metric.EmptyMetricID, err
+		}
+	}
+	if err = mb.metric.Delete(key); err != nil {
+		return metric.EmptyMetricID, err
+	}
+	if err = reclaimSequence(mb.metricIDSequence); err != nil {
+		return metric.EmptyMetricID, err
+	}
+	metrics, err := mb.metric.IterKeys(nsIDVal, 1)
+	if err != nil {
+		return metric.EmptyMetricID, err
+	}
+	if len(metrics) == 0 {
+		if err = mb.namespace.Delete([]byte(namespace)); err != nil {
+			return metric.EmptyMetricID, err
+		}
+		if err = reclaimSequence(mb.namespaceIDSequence); err != nil {
+			return metric.EmptyMetricID, err
+		}
+	}
+	return metricID, nil
+}
+
 // getOrCreateMetricMetadata creates metric metadata if not exist, else load metric metadata from backend storage.
 func (mb *metadataBackend) getOrCreateMetricMetadata(namespace, metricName string, limits *models.Limits) (MetricMetadata, error) {
 	nsKey := []byte(namespace)
@@ -364,8 +481,7 @@ func (mb *metadataBackend) getOrCreateMetricMetadata(namespace, metricName strin
 	if !exist {
 		// gen namespace id
 		var nsID uint32
-		nsID, err = nextSequence(mb.namespaceIDSequence, mb.namespace, namespaceIDSequenceKey,
-			limits.EnableNamespacesCheck(), limits.MaxNamespaces)
+		nsID, err = nextSequence(mb.namespaceIDSequence, limits.EnableNamespacesCheck(), limits.MaxNamespaces)
 		if err != nil {
 			return nil, err
 		}
@@ -388,8 +504,7 @@ func (mb *metadataBackend) getOrCreateMetricMetadata(namespace, metricName strin
 	if !exist {
 		// gen metric id
 		var metricID uint32
-		metricID, err = nextSequence(mb.metricIDSequence, mb.metric, metricIDSequenceKey,
-			limits.EnableMetricsCheck(), limits.MaxMetrics)
+		metricID, err = nextSequence(mb.metricIDSequence, limits.EnableMetricsCheck(), limits.MaxMetrics)
 		if err != nil {
 			return nil, err
 		}
@@ -427,12 +542,9 @@ func (mb *metadataBackend) getMetricMetadata(metricID metric.ID) (metadata Metri
 }
 
 // sync the backend memory data into persist storage.
+// NOTICE: sequences not persisted, because the reserved block has been persisted when allocating.
 func (mb *metadataBackend) sync() error {
 	var result error
-	if err := mb.saveSequences(); err != nil {
-		result = multierror.Append(result, err)
-	}
-
 	for _, db := range mb.dbs {
 		if err := db.Flush(); err != nil {
 			result = multierror.Append(result, err)
@@ -444,6 +556,12 @@ func (mb *metadataBackend) sync() error {
 // Close closes the backend storage.
 func (mb *metadataBackend) Close() error {
 	var result error
+	// persist current value of sequences, then mark clean shutdown, no need to audit sequences when reopening.
+	if err := mb.saveSequences(); err != nil {
+		result = multierror.Append(result, err)
+	} else if err := mb.tagKey.Put(cleanShutdownKey, []byte{1}); err != nil {
+		result = multierror.Append(result, err)
+	}
 	if err := mb.sync(); err != nil {
 		result = multierror.Append(result, err)
 	}
@@ -468,19 +586,140 @@ func (mb *metadataBackend) saveSequences() error {
 	return result
 }
 
+// auditSequences audits all sequences if backend storage isn't shutdown cleanly.
+func (mb *metadataBackend) auditSequences() error {
+	_, clean, err := mb.tagKey.Get(cleanShutdownKey)
+	if err != nil {
+		return err
+	}
+	if clean {
+		// remove the mark, need audit when reopening if crash after opening.
+		if err := mb.tagKey.Delete(cleanShutdownKey); err != nil {
+			return err
+		}
+		return mb.tagKey.Flush()
+	}
+	for _, item := range mb.sequences {
+		if err := auditSequence(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// auditSequence checks all allocated ids by consulting the backend storage,
+// if max allocated id > sequence(crash before sequence persisted), repairs the sequence for avoiding duplicate id.
+// undecodable entry is skipped, sequence is repaired by the remaining entries.
+func auditSequence(item *sequenceItem) error {
+	allocated := roaring.New()
+	maxID := uint32(0)
+	duplicated := 0
+	err := item.store.Walk(nil, func(key, value []byte) bool {
+		if bytes.Equal(key, item.key) || bytes.Equal(key, item.reclaimKey) || bytes.Equal(key, cleanShutdownKey) {
+			return true
+		}
+		ids, err := item.decodeIDs(value)
+		if err != nil {
+			item.statistics.Corrupted.Incr()
+			metaLogger.Error("skip undecodable entry when auditing sequence",
+				logger.String("sequence", string(item.key)), logger.String("key", string(key)), logger.Error(err))
+			return true
+		}
+		for _, id := range ids {
+			if !allocated.CheckedAdd(id) {
+				duplicated++
+			}
+			if id > maxID {
+				maxID = id
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	current := item.sequence.Current()
+	if duplicated > 0 {
+		item.statistics.Duplicated.Add(float64(duplicated))
+		metaLogger.Error("found duplicate id allocated by sequence",
+			logger.String("sequence", string(item.key)), logger.Int32("duplicated", int32(duplicated)))
+	}
+	if maxID > current {
+		blockSize := item.blockSize()
+		if err := unique.SaveSequence(item.store, item.key, maxID+blockSize); err != nil {
+			return err
+		}
+		item.statistics.Reserved.Add(float64(blockSize))
+		item.statistics.Repaired.Incr()
+		item.sequence = unique.NewSequence(maxID, maxID+blockSize)
+		metaLogger.Warn("repair sequence after unclean shutdown",
+			logger.String("sequence", string(item.key)),
+			logger.Any("current", current), logger.Any("maxID", maxID))
+		return nil
+	}
+	// ids reserved, but not allocated before crash
+	if burned := uint64(current) - allocated.GetCardinality(); burned > 0 {
+		item.statistics.Burned.Add(float64(burned))
+	}
+	return nil
+}
+
+// decodeID decodes the id from value.
+func decodeID(value []byte) ([]uint32, error) {
+	if len(value) < 4 {
+		return nil, fmt.Errorf("invalid id value, length: %d", len(value))
+	}
+	return []uint32{binary.LittleEndian.Uint32(value)}, nil
+}
+
+// decodeTagKeyIDs decodes the tag key ids from tag metas.
+func decodeTagKeyIDs(value []byte) ([]uint32, error) {
+	tags, err := tag.UnmarshalBinary(value)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint32, len(tags))
+	for idx := range tags {
+		ids[idx] = uint32(tags[idx].ID)
+	}
+	return ids, nil
+}
+
+// sequenceBlockSize returns the allocation block size of sequence, if not set returns MetaSequenceCache.
+func sequenceBlockSize(blockSize uint32) uint32 {
+	if blockSize > 0 {
+		return blockSize
+	}
+	return config.GlobalStorageConfig().TSDB.MetaSequenceCache
+}
+
 // nextSequence returns next value from sequence,
 // if no data in cache, need to cache next back from storage.
-func nextSequence(seq unique.Sequence, store unique.IDStore, key []byte, enable bool, limit uint32) (uint32, error) {
+func nextSequence(item *sequenceItem, enable bool, limit uint32) (uint32, error) {
+	seq := item.sequence
 	if !seq.HasNext() {
 		cur := seq.Current()
-		if enable && cur > limit {
+		// released ids of dropped metrics are not counted
+		if enable && cur-item.reclaimed > limit {
 			return 0, constants.ErrTooManyMetadata
 		}
-		nextBatchSeriesSeq := cur + config.GlobalStorageConfig().TSDB.SeriesSequenceCache
-		if err := unique.SaveSequence(store, key, nextBatchSeriesSeq); err != nil {
+		blockSize := item.blockSize()
+		nextBlock := cur + blockSize
+		if err := unique.SaveSequence(item.store, item.key, nextBlock); err != nil {
 			return 0, err
 		}
-		seq.Limit(seq.Current() + config.GlobalStorageConfig().TSDB.SeriesSequenceCache)
+		seq.Limit(nextBlock)
+		item.statistics.Reserved.Add(float64(blockSize))
 	}
+	item.statistics.Allocated.Incr()
 	return seq.Next(), nil
 }
+
+// reclaimSequence increases the reclaimed count of sequence, then persists it.
+func reclaimSequence(item *sequenceItem) error {
+	if err := unique.SaveSequence(item.store, item.reclaimKey, item.reclaimed+1); err != nil {
+		return err
+	}
+	item.reclaimed++
+	return nil
+}
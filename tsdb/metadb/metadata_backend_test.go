@@ -26,8 +26,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/pkg/unique"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
@@ -92,7 +94,7 @@ func TestNewMetadataBackend(t *testing.T) {
 				store := unique.NewMockIDStore(ctrl)
 				store.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
 				// close fail
-				store.EXPECT().Close().Return(fmt.Errorf("err")).MaxTimes(4)
+				store.EXPECT().Close().Return(fmt.Errorf("err")).MaxTimes(5)
 
 				newIDStoreFn = func(path string) (unique.IDStore, error) {
 					return store, nil
@@ -110,7 +112,7 @@ func TestNewMetadataBackend(t *testing.T) {
 				store.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
 				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 				// close fail
-				store.EXPECT().Close().Return(fmt.Errorf("err")).MaxTimes(4)
+				store.EXPECT().Close().Return(fmt.Errorf("err")).MaxTimes(5)
 				newIDStoreFn = func(path string) (unique.IDStore, error) {
 					return store, nil
 				}
@@ -124,14 +126,35 @@ func TestNewMetadataBackend(t *testing.T) {
 					return nil
 				}
 				store := unique.NewMockIDStore(ctrl)
-				store.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil).MaxTimes(3)
+				store.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil).MaxTimes(6)
 				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(3)
+				// clean shutdown
+				store.EXPECT().Delete(cleanShutdownKey).Return(nil)
+				store.EXPECT().Flush().Return(nil)
 				newIDStoreFn = func(path string) (unique.IDStore, error) {
 					return store, nil
 				}
 			},
 			wantErr: false,
 		},
+		{
+			name: "audit sequence failure",
+			prepare: func() {
+				mkDirFn = func(path string) error {
+					return nil
+				}
+				store := unique.NewMockIDStore(ctrl)
+				store.EXPECT().Get(gomock.Any()).Return(nil, false, nil).MaxTimes(6)
+				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(3)
+				store.EXPECT().Walk(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+				store.EXPECT().Close().Return(nil).MaxTimes(5)
+
+				newIDStoreFn = func(path string) (unique.IDStore, error) {
+					return store, nil
+				}
+			},
+			wantErr: true,
+		},
 		{
 			name: "new backend successfully, init seq = 0",
 			prepare: func() {
@@ -139,8 +162,10 @@ func TestNewMetadataBackend(t *testing.T) {
 					return nil
 				}
 				store := unique.NewMockIDStore(ctrl)
-				store.EXPECT().Get(gomock.Any()).Return(nil, false, nil).MaxTimes(3)
+				store.EXPECT().Get(gomock.Any()).Return(nil, false, nil).MaxTimes(6)
 				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(3)
+				// audit sequences
+				store.EXPECT().Walk(gomock.Any(), gomock.Any()).Return(nil).Times(3)
 
 				newIDStoreFn = func(path string) (unique.IDStore, error) {
 					return store, nil
@@ -162,7 +187,7 @@ func TestNewMetadataBackend(t *testing.T) {
 				tt.prepare()
 			}
 
-			backend, err := newMetadataBackend(t.TempDir())
+			backend, err := newMetadataBackend("db", t.TempDir())
 
 			if ((err != nil) != tt.wantErr && backend == nil) || (!tt.wantErr && backend == nil) {
 				t.Errorf("newMetadataBackend() error = %v, wantErr %v", err, tt.wantErr)
@@ -453,11 +478,21 @@ func TestMetadataBackend_saveTagKey(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "store tag meta successfully",
+			name: "store tag schema version failure",
 			prepare: func() {
 				sequence.EXPECT().HasNext().Return(true)
 				sequence.EXPECT().Next().Return(uint32(10))
 				store.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "store tag meta successfully",
+			prepare: func() {
+				sequence.EXPECT().HasNext().Return(true)
+				sequence.EXPECT().Next().Return(uint32(10))
+				store.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 			wantErr: false,
 		},
@@ -469,7 +504,7 @@ func TestMetadataBackend_saveTagKey(t *testing.T) {
 				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
 				sequence.EXPECT().Limit(gomock.Any())
 				sequence.EXPECT().Next().Return(uint32(100))
-				store.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 			wantErr: false,
 		},
@@ -480,7 +515,8 @@ func TestMetadataBackend_saveTagKey(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			backend := &metadataBackend{
 				tagKey:           store,
-				tagKeyIDSequence: sequence,
+				schema:           store,
+				tagKeyIDSequence: newTestSequenceItem(sequence, store, tagKeyIDSequenceKey),
 			}
 			if tt.prepare != nil {
 				tt.prepare()
@@ -609,8 +645,16 @@ func TestMetadataBackend_saveField(t *testing.T) {
 	defer ctrl.Finish()
 
 	store := unique.NewMockIDStore(ctrl)
+	schema := unique.NewMockIDStore(ctrl)
+	nowFunc = func() int64 {
+		return 100
+	}
+	defer func() {
+		nowFunc = timeutil.Now
+	}()
 	backend := &metadataBackend{
-		field: store,
+		field:  store,
+		schema: schema,
 	}
 	f := field.Meta{
 		ID:   1,
@@ -619,11 +663,42 @@ func TestMetadataBackend_saveField(t *testing.T) {
 	}
 	v, err := f.MarshalBinary()
 	assert.NoError(t, err)
+	// save field failure
+	store.EXPECT().Merge([]byte{2, 0, 0, 0}, v).Return(fmt.Errorf("err"))
+	err = backend.saveField(metric.ID(2), f)
+	assert.Error(t, err)
+	// save field successfully, records created time of field
 	store.EXPECT().Merge([]byte{2, 0, 0, 0}, v).Return(nil)
+	schema.EXPECT().Merge([]byte{2, 0, 0, 0}, marshalSchemaVersion(fieldSchema, 1, 100)).Return(nil)
 	err = backend.saveField(metric.ID(2), f)
 	assert.NoError(t, err)
 }
 
+func TestMetadataBackend_getSchemaVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	schema := unique.NewMockIDStore(ctrl)
+	backend := &metadataBackend{
+		schema: schema,
+	}
+	// get failure
+	schema.EXPECT().Get([]byte{2, 0, 0, 0}).Return(nil, false, fmt.Errorf("err"))
+	versions, err := backend.getSchemaVersions(metric.ID(2))
+	assert.Error(t, err)
+	assert.Nil(t, versions)
+	// not exist
+	schema.EXPECT().Get([]byte{2, 0, 0, 0}).Return(nil, false, nil)
+	versions, err = backend.getSchemaVersions(metric.ID(2))
+	assert.NoError(t, err)
+	assert.Len(t, versions.fields, 0)
+	// get versions
+	schema.EXPECT().Get([]byte{2, 0, 0, 0}).Return(marshalSchemaVersion(fieldSchema, 1, 100), true, nil)
+	versions, err = backend.getSchemaVersions(metric.ID(2))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), versions.fields[1])
+}
+
 func TestMetadataBackend_getAllFields(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -948,8 +1023,8 @@ func TestMetadataBackend_getOrCreateMetricMetadata(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			backend := &metadataBackend{
-				namespaceIDSequence: nsSequence,
-				metricIDSequence:    metricSequence,
+				namespaceIDSequence: newTestSequenceItem(nsSequence, nsStore, namespaceIDSequenceKey),
+				metricIDSequence:    newTestSequenceItem(metricSequence, metricStore, metricIDSequenceKey),
 				namespace:           nsStore,
 				metric:              metricStore,
 				field:               fieldStore,
@@ -993,7 +1068,8 @@ func TestMetadataBackend_Close(t *testing.T) {
 			name: "all components release successfully",
 			prepare: func() {
 				sequence.EXPECT().Current().Return(uint32(10))
-				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().Put(tagKeyIDSequenceKey, gomock.Any()).Return(nil)
+				store.EXPECT().Put(cleanShutdownKey, gomock.Any()).Return(nil)
 				store.EXPECT().Flush().Return(nil)
 				store.EXPECT().Close().Return(nil)
 			},
@@ -1005,11 +1081,8 @@ func TestMetadataBackend_Close(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			backend := &metadataBackend{
-				sequences: []sequenceItem{{
-					sequence: sequence,
-					store:    store,
-					key:      tagKeyIDSequenceKey,
-				}},
+				sequences: []*sequenceItem{newTestSequenceItem(sequence, store, tagKeyIDSequenceKey)},
+				tagKey:    store,
 				dbs: map[string]unique.IDStore{
 					TagKeyDB: store,
 				},
@@ -1024,3 +1097,204 @@ func TestMetadataBackend_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataBackend_auditSequences(t *testing.T) {
+	dir := t.TempDir()
+	limits := models.NewDefaultLimits()
+	backend, err := newMetadataBackend("db", dir)
+	assert.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		meta, err := backend.getOrCreateMetricMetadata("ns", fmt.Sprintf("metric-%d", i), limits)
+		assert.NoError(t, err)
+		_, err = backend.saveTagKey(meta.getMetricID(), "key")
+		assert.NoError(t, err)
+	}
+	// clean shutdown
+	assert.NoError(t, backend.Close())
+	backend, err = newMetadataBackend("db", dir)
+	assert.NoError(t, err)
+	mb := backend.(*metadataBackend)
+	assert.Equal(t, uint32(5), mb.metricIDSequence.sequence.Current())
+	_, exist, err := mb.tagKey.Get(cleanShutdownKey)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	// mock crash with stale sequence
+	assert.NoError(t, unique.SaveSequence(mb.metric, metricIDSequenceKey, 2))
+	assert.NoError(t, unique.SaveSequence(mb.tagKey, tagKeyIDSequenceKey, 1))
+	for _, db := range mb.dbs {
+		assert.NoError(t, db.Flush())
+		assert.NoError(t, db.Close())
+	}
+	backend, err = newMetadataBackend("db", dir)
+	assert.NoError(t, err)
+	mb = backend.(*metadataBackend)
+	assert.Equal(t, uint32(5), mb.metricIDSequence.sequence.Current())
+	assert.Equal(t, uint32(5), mb.tagKeyIDSequence.sequence.Current())
+	assert.Equal(t, float64(1), mb.metricIDSequence.statistics.Repaired.Get())
+	meta, err := backend.getOrCreateMetricMetadata("ns", "metric-new", limits)
+	assert.NoError(t, err)
+	assert.Equal(t, metric.ID(6), meta.getMetricID())
+	tagKeyID, err := backend.saveTagKey(meta.getMetricID(), "key")
+	assert.NoError(t, err)
+	assert.Equal(t, tag.KeyID(6), tagKeyID)
+	assert.NoError(t, backend.Close())
+}
+
+func TestMetadataBackend_dropMetric(t *testing.T) {
+	dir := t.TempDir()
+	limits := models.NewDefaultLimits()
+	backend, err := newMetadataBackend("db", dir)
+	assert.NoError(t, err)
+	for _, name := range []string{"cpu", "mem"} {
+		meta, err := backend.getOrCreateMetricMetadata("ns", name, limits)
+		assert.NoError(t, err)
+		_, err = backend.saveTagKey(meta.getMetricID(), "key")
+		assert.NoError(t, err)
+		assert.NoError(t, backend.saveField(meta.getMetricID(), field.Meta{ID: 1, Name: "f", Type: field.SumField}))
+	}
+	walkMetrics := func() (rs []string) {
+		assert.NoError(t, backend.walkMetrics(func(namespace, metricName string, _ metric.ID) bool {
+			rs = append(rs, namespace+"/"+metricName)
+			return true
+		}))
+		return
+	}
+	assert.Equal(t, []string{"ns/cpu", "ns/mem"}, walkMetrics())
+
+	// metric not found
+	_, err = backend.dropMetric("ns-not-exist", "cpu")
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	_, err = backend.dropMetric("ns", "disk")
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	// drop metric, keep namespace
+	metricID, err := backend.dropMetric("ns", "cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns/mem"}, walkMetrics())
+	fields, _, err := backend.getAllFields(metricID)
+	assert.NoError(t, err)
+	assert.Empty(t, fields)
+	tags, err := backend.getAllTagKeys(metricID)
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+	namespaces, err := backend.suggestNamespace("", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns"}, namespaces)
+	// drop last metric of namespace
+	_, err = backend.dropMetric("ns", "mem")
+	assert.NoError(t, err)
+	assert.Empty(t, walkMetrics())
+	namespaces, err = backend.suggestNamespace("", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, namespaces)
+	mb := backend.(*metadataBackend)
+	assert.Equal(t, uint32(2), mb.metricIDSequence.reclaimed)
+	assert.Equal(t, uint32(1), mb.namespaceIDSequence.reclaimed)
+
+	// reclaimed count is persisted, metric id is never reused
+	assert.NoError(t, backend.Close())
+	backend, err = newMetadataBackend("db", dir)
+	assert.NoError(t, err)
+	mb = backend.(*metadataBackend)
+	assert.Equal(t, uint32(2), mb.metricIDSequence.reclaimed)
+	assert.Equal(t, uint32(1), mb.namespaceIDSequence.reclaimed)
+	meta, err := backend.getOrCreateMetricMetadata("ns", "cpu", limits)
+	assert.NoError(t, err)
+	assert.Equal(t, metric.ID(3), meta.getMetricID())
+	assert.NoError(t, backend.Close())
+}
+
+func TestMetadataBackend_dropMetric_Failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nsStore := unique.NewMockIDStore(ctrl)
+	metricStore := unique.NewMockIDStore(ctrl)
+	fieldStore := unique.NewMockIDStore(ctrl)
+	backend := &metadataBackend{
+		namespace:           nsStore,
+		metric:              metricStore,
+		field:               fieldStore,
+		metricIDSequence:    newTestSequenceItem(nil, metricStore, metricIDSequenceKey),
+		namespaceIDSequence: newTestSequenceItem(nil, nsStore, namespaceIDSequenceKey),
+	}
+	backend.metricIDSequence.reclaimKey = metricIDReclaimedKey
+	nsStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 0, 0, 0}, true, nil).AnyTimes()
+	// get metric failure
+	metricStore.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
+	_, err := backend.dropMetric("ns", "cpu")
+	assert.Error(t, err)
+	// delete fields failure
+	metricStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 0, 0, 0}, true, nil).AnyTimes()
+	fieldStore.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = backend.dropMetric("ns", "cpu")
+	assert.Error(t, err)
+	// persist reclaimed count failure
+	tagStore := unique.NewMockIDStore(ctrl)
+	schemaStore := unique.NewMockIDStore(ctrl)
+	backend.tagKey = tagStore
+	backend.schema = schemaStore
+	fieldStore.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+	tagStore.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+	schemaStore.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+	metricStore.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+	metricStore.EXPECT().Put(metricIDReclaimedKey, gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = backend.dropMetric("ns", "cpu")
+	assert.Error(t, err)
+	assert.Zero(t, backend.metricIDSequence.reclaimed)
+	// check metrics of namespace failure
+	metricStore.EXPECT().Put(metricIDReclaimedKey, gomock.Any()).Return(nil).AnyTimes()
+	metricStore.EXPECT().IterKeys(gomock.Any(), 1).Return(nil, fmt.Errorf("err"))
+	_, err = backend.dropMetric("ns", "cpu")
+	assert.Error(t, err)
+	// delete namespace failure
+	metricStore.EXPECT().IterKeys(gomock.Any(), 1).Return(nil, nil).AnyTimes()
+	nsStore.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = backend.dropMetric("ns", "cpu")
+	assert.Error(t, err)
+}
+
+func TestMetadataBackend_nextSequence_Reclaimed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := unique.NewMockIDStore(ctrl)
+	store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	item := newTestSequenceItem(unique.NewSequence(10, 10), store, metricIDSequenceKey)
+	_, err := nextSequence(item, true, 8)
+	assert.ErrorIs(t, err, constants.ErrTooManyMetadata)
+	// released ids are excluded from the count of limits check
+	item.reclaimed = 5
+	id, err := nextSequence(item, true, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(11), id)
+}
+
+func TestAuditSequence_skipUndecodable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := unique.NewMockIDStore(ctrl)
+	item := newTestSequenceItem(unique.NewSequence(2, 2), store, metricIDSequenceKey)
+	store.EXPECT().Walk(gomock.Any(), gomock.Any()).DoAndReturn(func(_ []byte, fn func(key, value []byte) bool) error {
+		fn([]byte("a"), []byte{1, 0, 0, 0})
+		fn([]byte("b"), []byte{1})
+		fn([]byte("c"), []byte{5, 0, 0, 0})
+		return nil
+	})
+	store.EXPECT().Put(metricIDSequenceKey, gomock.Any()).Return(nil)
+	assert.NoError(t, auditSequence(item))
+	assert.Equal(t, float64(1), item.statistics.Corrupted.Get())
+	assert.Equal(t, uint32(5), item.sequence.Current())
+}
+
+func newTestSequenceItem(sequence unique.Sequence, store unique.IDStore, key []byte) *sequenceItem {
+	return &sequenceItem{
+		sequence:   sequence,
+		store:      store,
+		key:        key,
+		blockSize:  func() uint32 { return 10 },
+		decodeIDs:  decodeID,
+		statistics: metrics.NewMetaSequenceStatistics("db", "test"),
+	}
+}
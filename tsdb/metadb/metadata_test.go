@@ -39,7 +39,7 @@ func TestNewMetadata(t *testing.T) {
 	assert.NotNil(t, metadata1.MetadataDatabase())
 	assert.Equal(t, "test", metadata1.DatabaseName())
 
-	createMetadataBackendFn = func(parent string) (MetadataBackend, error) {
+	createMetadataBackendFn = func(_, parent string) (MetadataBackend, error) {
 		return nil, fmt.Errorf("err")
 	}
 	metadata2, err := NewMetadata(context.TODO(), "test", testPath, nil)
@@ -55,7 +55,7 @@ type metadataDatabase struct {
 
 // NewMetadataDatabase creates new metadata database
 func NewMetadataDatabase(ctx context.Context, databaseName, parent string) (MetadataDatabase, error) {
-	backend, err := createMetadataBackendFn(parent)
+	backend, err := createMetadataBackendFn(databaseName, parent)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +143,43 @@ func (mdb *metadataDatabase) GetAllFields(namespace, metricName string) (fields
 	return
 }
 
+// GetAllFieldsAt returns the fields which were created before(include) timestamp by namespace/metric name,
+// if not exist return constants.ErrMetricIDNotFound.
+func (mdb *metadataDatabase) GetAllFieldsAt(namespace, metricName string, timestamp int64) (field.Metas, error) {
+	fields, err := mdb.GetAllFields(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := mdb.getSchemaVersions(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	return versions.filterFields(fields, timestamp), nil
+}
+
+// GetAllTagKeysAt returns the tag keys which were created before(include) timestamp by namespace/metric name,
+// if not exist return constants.ErrMetricIDNotFound.
+func (mdb *metadataDatabase) GetAllTagKeysAt(namespace, metricName string, timestamp int64) (tag.Metas, error) {
+	tags, err := mdb.GetAllTagKeys(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := mdb.getSchemaVersions(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	return versions.filterTagKeys(tags, timestamp), nil
+}
+
+// getSchemaVersions returns the version records of fields/tag keys by namespace/metric name.
+func (mdb *metadataDatabase) getSchemaVersions(namespace, metricName string) (*schemaVersions, error) {
+	metricID, err := mdb.GetMetricID(namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	return mdb.backend.getSchemaVersions(metricID)
+}
+
 // GetAllHistogramFields returns histogram-fields namespace/metric name,
 // if not exist return series.ErrNotFound
 func (mdb *metadataDatabase) GetAllHistogramFields(namespace, metricName string) (rs field.Metas, err error) {
@@ -150,9 +187,9 @@ func (mdb *metadataDatabase) GetAllHistogramFields(namespace, metricName string)
 	if err != nil {
 		return nil, err
 	}
-	// with format like __bucket_${boundary}
+	// with format like __bucket_${boundary}, and HistogramSketch(SketchField)
 	for idx := range fields {
-		if fields[idx].Type == field.HistogramField {
+		if fields[idx].Type == field.HistogramField || fields[idx].Type == field.SketchField {
 			rs = append(rs, fields[idx])
 		}
 	}
@@ -177,6 +214,7 @@ func (mdb *metadataDatabase) GetField(namespace, metricName string, fieldName fi
 func (mdb *metadataDatabase) GenMetricID(namespace, metricName string, limits *models.Limits) (metricID metric.ID, err error) {
 	// get metric id from memory
 	if metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName); ok {
+		metricMetadata.touch(nowFunc())
 		return metricMetadata.getMetricID(), nil
 	}
 	key := commonseries.JoinNamespaceMetric(namespace, metricName)
@@ -185,14 +223,56 @@ func (mdb *metadataDatabase) GenMetricID(namespace, metricName string, limits *m
 	defer mdb.rwMux.Unlock()
 	// double check with memory
 	if metricMetadata, ok := mdb.metrics[key]; ok {
+		metricMetadata.touch(nowFunc())
 		return metricMetadata.getMetricID(), nil
 	}
+	return mdb.createMetricMetadata(key, namespace, metricName, limits)
+}
+
+// GenMetricIDs generates the metric ids in the memory in batch,
+// gets cached metric ids with one read lock, then creates all missing metric metadata with one write lock.
+func (mdb *metadataDatabase) GenMetricIDs(keys []MetricKey, metricIDs []metric.ID, errs []error, limits *models.Limits) {
+	var missing []int
+	now := nowFunc()
+	mdb.rwMux.RLock()
+	for idx := range keys {
+		if metricMetadata, ok := mdb.metrics[commonseries.JoinNamespaceMetric(keys[idx].Namespace, keys[idx].MetricName)]; ok {
+			metricMetadata.touch(now)
+			metricIDs[idx] = metricMetadata.getMetricID()
+			errs[idx] = nil
+		} else {
+			missing = append(missing, idx)
+		}
+	}
+	mdb.rwMux.RUnlock()
+	if len(missing) == 0 {
+		return
+	}
+
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+	for _, idx := range missing {
+		key := commonseries.JoinNamespaceMetric(keys[idx].Namespace, keys[idx].MetricName)
+		// double check with memory, same metric maybe created by previous key
+		if metricMetadata, ok := mdb.metrics[key]; ok {
+			metricMetadata.touch(now)
+			metricIDs[idx], errs[idx] = metricMetadata.getMetricID(), nil
+			continue
+		}
+		metricIDs[idx], errs[idx] = mdb.createMetricMetadata(key, keys[idx].Namespace, keys[idx].MetricName, limits)
+	}
+}
+
+// createMetricMetadata gets or creates metric metadata from backend storage, then caches it in memory,
+// !!!!! NOTICE: must hold write lock.
+func (mdb *metadataDatabase) createMetricMetadata(key, namespace, metricName string, limits *models.Limits) (metric.ID, error) {
 	metricMetadata, err := mdb.backend.getOrCreateMetricMetadata(namespace, metricName, limits)
 	if err != nil {
 		mdb.statistics.GenMetricIDFailures.Incr()
-		return
+		return metric.EmptyMetricID, err
 	}
 	mdb.statistics.GenMetricIDs.Incr()
+	metricMetadata.touch(nowFunc())
 	mdb.metrics[key] = metricMetadata
 
 	return metricMetadata.getMetricID(), nil
@@ -208,10 +288,57 @@ func (mdb *metadataDatabase) GenFieldID(
 	if fieldType == field.Unknown {
 		return field.EmptyFieldID, series.ErrFieldTypeUnspecified
 	}
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped by unused metric expiry
+		return field.EmptyFieldID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
+
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+	return mdb.genFieldID(metricMetadata, fieldName, fieldType, limits)
+}
+
+// GenFieldIDs generates the field ids of metric in the memory in batch with one write lock,
+// !!!!! NOTICE: metric metadata must be existed in memory, because gen metric has been saved
+func (mdb *metadataDatabase) GenFieldIDs(
+	namespace, metricName string,
+	fields []FieldKey, dst []field.ID,
+	limits *models.Limits,
+) ([]field.ID, error) {
+	for idx := range fields {
+		if fields[idx].Type == field.Unknown {
+			return dst, series.ErrFieldTypeUnspecified
+		}
+	}
+	if len(fields) == 0 {
+		return dst, nil
+	}
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped by unused metric expiry
+		return dst, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
+	for idx := range fields {
+		fieldID, err := mdb.genFieldID(metricMetadata, fields[idx].Name, fields[idx].Type, limits)
+		if err != nil {
+			return dst, err
+		}
+		dst = append(dst, fieldID)
+	}
+	return dst, nil
+}
+
+// genFieldID gets field id from metric metadata, if not exist creates new field,
+// !!!!! NOTICE: must hold write lock.
+func (mdb *metadataDatabase) genFieldID(
+	metricMetadata MetricMetadata,
+	fieldName field.Name, fieldType field.Type,
+	limits *models.Limits,
+) (field.ID, error) {
 	// read from memory metric metadata
 	if f, ok := metricMetadata.getField(fieldName); ok {
 		if f.Type == fieldType {
@@ -240,7 +367,11 @@ func (mdb *metadataDatabase) GenFieldID(
 // GenTagKeyID generates the tag key id in the memory
 // !!!!! NOTICE: metric metadata must be existed in memory, because gen metric has been saved
 func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string, limits *models.Limits) (tagKeyID tag.KeyID, err error) {
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped by unused metric expiry
+		return tag.EmptyTagKeyID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
@@ -265,6 +396,51 @@ func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string, l
 	return tagKeyID, nil
 }
 
+// WalkMetrics walks all metrics with namespace/metric name and the last write time since metric cached in memory,
+// the last write time is 0 if metric not written after opening, stops walking if fn returns false.
+func (mdb *metadataDatabase) WalkMetrics(fn func(namespace, metricName string, lastWrite int64) bool) error {
+	// collect metrics first, because fn maybe drops metric
+	var keys []MetricKey
+	if err := mdb.backend.walkMetrics(func(namespace, metricName string, _ metric.ID) bool {
+		keys = append(keys, MetricKey{Namespace: namespace, MetricName: metricName})
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var lastWrite int64
+		if metricMetadata, ok := mdb.getMetricMetadataFromCache(key.Namespace, key.MetricName); ok {
+			lastWrite = metricMetadata.getLastWrite()
+		}
+		if !fn(key.Namespace, key.MetricName, lastWrite) {
+			break
+		}
+	}
+	return nil
+}
+
+// DropMetric removes the metadata(metric id/fields/tag keys/schema) of metric from memory and backend storage,
+// if not exist return constants.ErrMetricIDNotFound, if isActive returns true with the last write time checked
+// under write lock, keeps the metric and returns constants.ErrMetricActive.
+func (mdb *metadataDatabase) DropMetric(namespace, metricName string, isActive func(lastWrite int64) bool) (metric.ID, error) {
+	key := commonseries.JoinNamespaceMetric(namespace, metricName)
+
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	// re-check the activity of metric, write touches the metric before using its metric id,
+	// so the write which lands after expiry check is always seen here.
+	var lastWrite int64
+	if metricMetadata, ok := mdb.metrics[key]; ok {
+		lastWrite = metricMetadata.getLastWrite()
+	}
+	if isActive != nil && isActive(lastWrite) {
+		return metric.EmptyMetricID, constants.ErrMetricActive
+	}
+	delete(mdb.metrics, key)
+	return mdb.backend.dropMetric(namespace, metricName)
+}
+
 // Sync syncs the backend storage.
 func (mdb *metadataDatabase) Sync() error {
 	mdb.rwMux.Lock()
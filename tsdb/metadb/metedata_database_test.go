@@ -54,7 +54,7 @@ func TestMetadataDatabase_New(t *testing.T) {
 	assert.NoError(t, err)
 
 	// test: create backend err
-	createMetadataBackendFn = func(parent string) (MetadataBackend, error) {
+	createMetadataBackendFn = func(_, parent string) (MetadataBackend, error) {
 		return nil, fmt.Errorf("err")
 	}
 	db, err = NewMetadataDatabase(context.TODO(), "test", testPath)
@@ -97,7 +97,7 @@ func TestMetadataDatabase_GetMetricID(t *testing.T) {
 		ctrl.Finish()
 	}()
 	backend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (MetadataBackend, error) {
+	createMetadataBackendFn = func(_, parent string) (MetadataBackend, error) {
 		return backend, nil
 	}
 
@@ -124,7 +124,7 @@ func TestMetadataDatabase_GetAllTagKeys(t *testing.T) {
 		ctrl.Finish()
 	}()
 	backend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (MetadataBackend, error) {
+	createMetadataBackendFn = func(_, parent string) (MetadataBackend, error) {
 		return backend, nil
 	}
 	tags := tag.Metas{{ID: 1, Key: "key1"}, {ID: 2, Key: "key2"}}
@@ -203,7 +203,7 @@ func TestMetadataDatabase_GetTagKey(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	tags := tag.Metas{{ID: 1, Key: "key1"}, {ID: 2, Key: "key2"}}
@@ -286,7 +286,7 @@ func TestMetadataDatabase_GetAllFields(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	db := newMockMetadataDatabase(t, t.TempDir())
@@ -367,7 +367,7 @@ func TestMetadataDatabase_GetField(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	db := newMockMetadataDatabase(t, t.TempDir())
@@ -445,13 +445,14 @@ func TestMetadataDatabase_GetAllHistogramFields(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	db := newMockMetadataDatabase(t, t.TempDir())
 	fields := field.Metas{
 		{ID: 1, Type: field.SumField, Name: "sum"},
 		{ID: 2, Type: field.HistogramField, Name: "histogram"},
+		{ID: 3, Type: field.SketchField, Name: "HistogramSketch"},
 	}
 	db2 := db.(*metadataDatabase)
 	db2.rwMux.Lock()
@@ -488,7 +489,10 @@ func TestMetadataDatabase_GetAllHistogramFields(t *testing.T) {
 			out: struct {
 				f   field.Metas
 				err error
-			}{f: field.Metas{{ID: 2, Type: field.HistogramField, Name: "histogram"}}, err: nil},
+			}{f: field.Metas{
+				{ID: 2, Type: field.HistogramField, Name: "histogram"},
+				{ID: 3, Type: field.SketchField, Name: "HistogramSketch"},
+			}, err: nil},
 		},
 	}
 
@@ -506,6 +510,67 @@ func TestMetadataDatabase_GetAllHistogramFields(t *testing.T) {
 	}
 }
 
+func TestMetadataDatabase_GetSchemaAt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	db := newMockMetadataDatabase(t, t.TempDir())
+	fields := field.Metas{
+		{ID: 1, Type: field.SumField, Name: "sum"},
+		{ID: 2, Type: field.HistogramField, Name: "histogram"},
+	}
+	tagKeys := tag.Metas{{ID: 1, Key: "host"}, {ID: 2, Key: "ip"}}
+	db2 := db.(*metadataDatabase)
+	db2.rwMux.Lock()
+	metricMeta := newMetricMetadata(metric.ID(2))
+	metricMeta.initialize(fields, 2, tagKeys)
+	db2.metrics[commonseries.JoinNamespaceMetric("ns-1", "cache")] = metricMeta
+	db2.rwMux.Unlock()
+
+	// get metric failure
+	mockBackend.EXPECT().getMetricID(gomock.Any(), gomock.Any()).Return(metric.EmptyMetricID, fmt.Errorf("err")).Times(2)
+	rs, err := db.GetAllFieldsAt("ns-1", "metric-name", 100)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	tags, err := db.GetAllTagKeysAt("ns-1", "metric-name", 100)
+	assert.Error(t, err)
+	assert.Nil(t, tags)
+	// get schema versions failure
+	mockBackend.EXPECT().getSchemaVersions(metric.ID(2)).Return(nil, fmt.Errorf("err")).Times(2)
+	rs, err = db.GetAllFieldsAt("ns-1", "cache", 100)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	tags, err = db.GetAllTagKeysAt("ns-1", "cache", 100)
+	assert.Error(t, err)
+	assert.Nil(t, tags)
+	// get schema as of timestamp
+	var value []byte
+	value = append(value, marshalSchemaVersion(fieldSchema, 2, 200)...)
+	value = append(value, marshalSchemaVersion(tagKeySchema, 2, 200)...)
+	versions, err := unmarshalSchemaVersions(value)
+	assert.NoError(t, err)
+	mockBackend.EXPECT().getSchemaVersions(metric.ID(2)).Return(versions, nil).Times(4)
+	rs, err = db.GetAllFieldsAt("ns-1", "cache", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, fields[:1], rs)
+	rs, err = db.GetAllFieldsAt("ns-1", "cache", 200)
+	assert.NoError(t, err)
+	assert.Equal(t, fields, rs)
+	tags, err = db.GetAllTagKeysAt("ns-1", "cache", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, tagKeys[:1], tags)
+	tags, err = db.GetAllTagKeysAt("ns-1", "cache", 200)
+	assert.NoError(t, err)
+	assert.Equal(t, tagKeys, tags)
+}
+
 func TestMetadataDatabase_GenMetricID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -514,7 +579,7 @@ func TestMetadataDatabase_GenMetricID(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	db := newMockMetadataDatabase(t, t.TempDir())
@@ -559,6 +624,7 @@ func TestMetadataDatabase_GenMetricID(t *testing.T) {
 			prepare: func() {
 				metadata := NewMockMetricMetadata(ctrl)
 				metadata.EXPECT().getMetricID().Return(metric.ID(3))
+				metadata.EXPECT().touch(gomock.Any())
 				mockBackend.EXPECT().getOrCreateMetricMetadata("ns-1", "name", gomock.Any()).Return(metadata, nil)
 			},
 			out: struct {
@@ -588,7 +654,7 @@ func TestMetadataDatabase_GenFieldID(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	meta := NewMockMetricMetadata(ctrl)
@@ -699,6 +765,91 @@ func TestMetadataDatabase_GenFieldID(t *testing.T) {
 	}
 }
 
+func TestMetadataDatabase_GenMetricIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	db := newMockMetadataDatabase(t, t.TempDir())
+	db2 := db.(*metadataDatabase)
+	db2.rwMux.Lock()
+	db2.metrics[commonseries.JoinNamespaceMetric("ns-1", "cache")] = newMetricMetadata(metric.ID(2))
+	db2.rwMux.Unlock()
+
+	keys := []MetricKey{
+		{Namespace: "ns-1", MetricName: "cache"},
+		{Namespace: "ns-1", MetricName: "name"},
+		{Namespace: "ns-1", MetricName: "err"},
+		{Namespace: "ns-1", MetricName: "name"},
+	}
+	metricIDs := make([]metric.ID, len(keys))
+	errs := make([]error, len(keys))
+	// all metric ids in memory cache
+	db.GenMetricIDs(keys[:1], metricIDs, errs, models.NewDefaultLimits())
+	assert.Equal(t, metric.ID(2), metricIDs[0])
+	assert.NoError(t, errs[0])
+	assert.True(t, db2.metrics[commonseries.JoinNamespaceMetric("ns-1", "cache")].getLastWrite() > 0)
+
+	// create metric metadata once for same metric
+	mockBackend.EXPECT().getOrCreateMetricMetadata("ns-1", "name", gomock.Any()).Return(newMetricMetadata(metric.ID(3)), nil)
+	mockBackend.EXPECT().getOrCreateMetricMetadata("ns-1", "err", gomock.Any()).Return(nil, fmt.Errorf("err"))
+	db.GenMetricIDs(keys, metricIDs, errs, models.NewDefaultLimits())
+	assert.Equal(t, []metric.ID{2, 3, metric.EmptyMetricID, 3}, metricIDs)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.Error(t, errs[2])
+	assert.NoError(t, errs[3])
+}
+
+func TestMetadataDatabase_GenFieldIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	meta := NewMockMetricMetadata(ctrl)
+	db := newMockMetadataDatabase(t, t.TempDir())
+	db2 := db.(*metadataDatabase)
+	db2.rwMux.Lock()
+	db2.metrics[commonseries.JoinNamespaceMetric("ns-1", "cache")] = meta
+	db2.rwMux.Unlock()
+	limits := models.NewDefaultLimits()
+
+	// field type unspecified
+	ids, err := db.GenFieldIDs("ns-1", "cache", []FieldKey{{Name: "sum"}}, nil, limits)
+	assert.Equal(t, series.ErrFieldTypeUnspecified, err)
+	assert.Empty(t, ids)
+	// empty fields
+	ids, err = db.GenFieldIDs("ns-1", "cache", nil, nil, limits)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+	// gen field ids
+	meta.EXPECT().getField(field.Name("sum")).Return(field.Meta{Type: field.SumField, ID: 3}, true)
+	meta.EXPECT().getField(field.Name("max")).Return(field.Meta{}, false)
+	meta.EXPECT().createField(field.Name("max"), field.MaxField, gomock.Any()).Return(field.Meta{ID: 4}, nil)
+	meta.EXPECT().getMetricID().Return(metric.ID(3))
+	mockBackend.EXPECT().saveField(gomock.Any(), gomock.Any()).Return(nil)
+	ids, err = db.GenFieldIDs("ns-1", "cache",
+		[]FieldKey{{Name: "sum", Type: field.SumField}, {Name: "max", Type: field.MaxField}},
+		[]field.ID{1}, limits)
+	assert.NoError(t, err)
+	assert.Equal(t, []field.ID{1, 3, 4}, ids)
+	// wrong field type
+	meta.EXPECT().getField(field.Name("sum")).Return(field.Meta{Type: field.SumField, ID: 3}, true)
+	_, err = db.GenFieldIDs("ns-1", "cache", []FieldKey{{Name: "sum", Type: field.MaxField}}, nil, limits)
+	assert.Error(t, err)
+}
+
 func TestMetadataDatabase_GenTagKeyID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -706,7 +857,7 @@ func TestMetadataDatabase_GenTagKeyID(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	meta := NewMockMetricMetadata(ctrl)
@@ -792,6 +943,67 @@ func TestMetadataDatabase_GenTagKeyID(t *testing.T) {
 	}
 }
 
+func TestMetadataDatabase_WalkMetrics_DropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	db := newMockMetadataDatabase(t, t.TempDir())
+	db2 := db.(*metadataDatabase)
+	cached := newMetricMetadata(metric.ID(2))
+	cached.(*metricMetadata).lastWrite.Store(100)
+	db2.metrics[commonseries.JoinNamespaceMetric("ns", "cpu")] = cached
+
+	// walk failure
+	mockBackend.EXPECT().walkMetrics(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, db.WalkMetrics(func(_, _ string, _ int64) bool { return true }))
+	// walk metrics with last write time
+	mockBackend.EXPECT().walkMetrics(gomock.Any()).DoAndReturn(
+		func(fn func(namespace, metricName string, metricID metric.ID) bool) error {
+			fn("ns", "cpu", 2)
+			fn("ns", "mem", 3)
+			return nil
+		}).Times(2)
+	lastWrites := make(map[string]int64)
+	assert.NoError(t, db.WalkMetrics(func(_, metricName string, lastWrite int64) bool {
+		lastWrites[metricName] = lastWrite
+		return true
+	}))
+	assert.Equal(t, map[string]int64{"cpu": 100, "mem": 0}, lastWrites)
+	// stop walking
+	count := 0
+	assert.NoError(t, db.WalkMetrics(func(_, _ string, _ int64) bool {
+		count++
+		return false
+	}))
+	assert.Equal(t, 1, count)
+
+	// metric written after check, keep it
+	metricID, err := db.DropMetric("ns", "cpu", func(lastWrite int64) bool { return lastWrite > 50 })
+	assert.ErrorIs(t, err, constants.ErrMetricActive)
+	assert.Equal(t, metric.EmptyMetricID, metricID)
+	assert.Len(t, db2.metrics, 1)
+	// drop metric
+	mockBackend.EXPECT().dropMetric("ns", "cpu").Return(metric.ID(2), nil)
+	metricID, err = db.DropMetric("ns", "cpu", func(lastWrite int64) bool { return lastWrite > 100 })
+	assert.NoError(t, err)
+	assert.Equal(t, metric.ID(2), metricID)
+	assert.Empty(t, db2.metrics)
+	// metric dropped, cannot generate field/tag key
+	_, err = db.GenFieldID("ns", "cpu", "f", field.SumField, models.NewDefaultLimits())
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	_, err = db.GenFieldIDs("ns", "cpu", []FieldKey{{Name: "f", Type: field.SumField}}, nil, models.NewDefaultLimits())
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	_, err = db.GenTagKeyID("ns", "cpu", "key", models.NewDefaultLimits())
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+}
+
 func TestMetadataDatabase_Close_Sync(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -800,7 +1012,7 @@ func TestMetadataDatabase_Close_Sync(t *testing.T) {
 		ctrl.Finish()
 	}()
 	mockBackend := NewMockMetadataBackend(ctrl)
-	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+	createMetadataBackendFn = func(_, parent string) (backend MetadataBackend, err error) {
 		return mockBackend, nil
 	}
 	mockBackend.EXPECT().Close().Return(nil)
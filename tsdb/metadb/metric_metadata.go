@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -50,6 +51,10 @@ type MetricMetadata interface {
 	getTagKeyID(tagKey string) (tag.KeyID, bool)
 	// getAllTags returns the tag keys of the metric
 	getAllTagKeys() (tagKeys tag.Metas)
+	// touch records the write time of the metric, ignores it if recorded within one minute.
+	touch(now int64)
+	// getLastWrite returns the last write time of the metric since cached in memory.
+	getLastWrite() int64
 }
 
 // metricMetadata implements MetricMetadata interface
@@ -59,6 +64,7 @@ type metricMetadata struct {
 	tagKeys  tag.Metas
 
 	fieldIDSeq atomic.Int32
+	lastWrite  atomic.Int64
 }
 
 // newMetricMetadata creates the metric metadata with metric id and field id assign sequence
@@ -149,3 +155,16 @@ func (mm *metricMetadata) getAllTagKeys() (tagKeys tag.Metas) {
 	copy(tagKeys, mm.tagKeys)
 	return
 }
+
+// touch records the write time of the metric, ignores it if recorded within one minute,
+// avoids updating shared memory for each write.
+func (mm *metricMetadata) touch(now int64) {
+	if now-mm.lastWrite.Load() >= timeutil.OneMinute {
+		mm.lastWrite.Store(now)
+	}
+}
+
+// getLastWrite returns the last write time of the metric since cached in memory.
+func (mm *metricMetadata) getLastWrite() int64 {
+	return mm.lastWrite.Load()
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -172,3 +173,16 @@ func TestMetricMetadata_checkTagKey(t *testing.T) {
 	limits.MaxTagsPerMetric = 0
 	assert.NoError(t, m.checkTagKey("", limits))
 }
+
+func TestMetricMetadata_touch(t *testing.T) {
+	m := newMetricMetadata(metric.ID(2))
+	assert.Zero(t, m.getLastWrite())
+	now := timeutil.Now()
+	m.touch(now)
+	assert.Equal(t, now, m.getLastWrite())
+	// ignore if recorded within one minute
+	m.touch(now + timeutil.OneSecond)
+	assert.Equal(t, now, m.getLastWrite())
+	m.touch(now + timeutil.OneMinute)
+	assert.Equal(t, now+timeutil.OneMinute, m.getLastWrite())
+}
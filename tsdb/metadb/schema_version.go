@@ -0,0 +1,99 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadb
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
+)
+
+// schemaKind represents the kind of metric schema item.
+type schemaKind byte
+
+const (
+	fieldSchema schemaKind = iota + 1
+	tagKeySchema
+)
+
+// schemaVersionSize represents the size of schema version record(kind+id+created time).
+const schemaVersionSize = 1 + 4 + 8
+
+// marshalSchemaVersion returns the binary of schema version record which records the created time of field/tag key.
+func marshalSchemaVersion(kind schemaKind, id uint32, createdAt int64) []byte {
+	var scratch [schemaVersionSize]byte
+	scratch[0] = byte(kind)
+	binary.LittleEndian.PutUint32(scratch[1:], id)
+	binary.LittleEndian.PutUint64(scratch[5:], uint64(createdAt))
+	return scratch[:]
+}
+
+// schemaVersions represents the created time of fields/tag keys of metric,
+// used for getting the metric schema as of a past point.
+type schemaVersions struct {
+	fields  map[field.ID]int64
+	tagKeys map[tag.KeyID]int64
+}
+
+// unmarshalSchemaVersions parses the schema version records of metric.
+func unmarshalSchemaVersions(value []byte) (*schemaVersions, error) {
+	if len(value)%schemaVersionSize != 0 {
+		return nil, fmt.Errorf("invalid schema version value, length: %d", len(value))
+	}
+	versions := &schemaVersions{
+		fields:  make(map[field.ID]int64),
+		tagKeys: make(map[tag.KeyID]int64),
+	}
+	for offset := 0; offset < len(value); offset += schemaVersionSize {
+		id := binary.LittleEndian.Uint32(value[offset+1:])
+		createdAt := int64(binary.LittleEndian.Uint64(value[offset+5:]))
+		switch schemaKind(value[offset]) {
+		case fieldSchema:
+			versions.fields[field.ID(id)] = createdAt
+		case tagKeySchema:
+			versions.tagKeys[tag.KeyID(id)] = createdAt
+		default:
+			return nil, fmt.Errorf("unknown schema kind: %d", value[offset])
+		}
+	}
+	return versions, nil
+}
+
+// filterFields returns the fields which were created before(include) timestamp,
+// fields without version record(created before versioning) are always visible.
+func (v *schemaVersions) filterFields(fields field.Metas, timestamp int64) (rs field.Metas) {
+	for idx := range fields {
+		if createdAt, ok := v.fields[fields[idx].ID]; !ok || createdAt <= timestamp {
+			rs = append(rs, fields[idx])
+		}
+	}
+	return rs
+}
+
+// filterTagKeys returns the tag keys which were created before(include) timestamp,
+// tag keys without version record(created before versioning) are always visible.
+func (v *schemaVersions) filterTagKeys(tagKeys tag.Metas, timestamp int64) (rs tag.Metas) {
+	for idx := range tagKeys {
+		if createdAt, ok := v.tagKeys[tagKeys[idx].ID]; !ok || createdAt <= timestamp {
+			rs = append(rs, tagKeys[idx])
+		}
+	}
+	return rs
+}
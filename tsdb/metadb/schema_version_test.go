@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func TestSchemaVersions(t *testing.T) {
+	var value []byte
+	value = append(value, marshalSchemaVersion(fieldSchema, 2, 100)...)
+	value = append(value, marshalSchemaVersion(fieldSchema, 3, 200)...)
+	value = append(value, marshalSchemaVersion(tagKeySchema, 10, 150)...)
+	versions, err := unmarshalSchemaVersions(value)
+	assert.NoError(t, err)
+
+	fields := field.Metas{{ID: 1, Name: "f1"}, {ID: 2, Name: "f2"}, {ID: 3, Name: "f3"}}
+	assert.Equal(t, field.Metas{{ID: 1, Name: "f1"}}, versions.filterFields(fields, 50))
+	assert.Equal(t, field.Metas{{ID: 1, Name: "f1"}, {ID: 2, Name: "f2"}}, versions.filterFields(fields, 100))
+	assert.Equal(t, fields, versions.filterFields(fields, 200))
+
+	tagKeys := tag.Metas{{ID: 9, Key: "host"}, {ID: 10, Key: "ip"}}
+	assert.Equal(t, tag.Metas{{ID: 9, Key: "host"}}, versions.filterTagKeys(tagKeys, 100))
+	assert.Equal(t, tagKeys, versions.filterTagKeys(tagKeys, 150))
+
+	// empty versions
+	versions, err = unmarshalSchemaVersions(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fields, versions.filterFields(fields, 0))
+	// invalid length
+	versions, err = unmarshalSchemaVersions([]byte{1, 2})
+	assert.Error(t, err)
+	assert.Nil(t, versions)
+	// unknown kind
+	versions, err = unmarshalSchemaVersions(marshalSchemaVersion(schemaKind(9), 1, 1))
+	assert.Error(t, err)
+	assert.Nil(t, versions)
+}
@@ -19,12 +19,13 @@ package metadb
 
 import (
 	"errors"
-	"strings"
 	"sync"
 
 	"github.com/lindb/roaring"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/strutil"
@@ -58,6 +59,8 @@ type TagMetadata interface {
 		tagValueIDs *roaring.Bitmap,
 		tagValues map[uint32]string,
 	) error
+	// GetSnapshot returns the versioned consistent snapshot of tag metadata, snapshot must be closed after reading.
+	GetSnapshot() flow.TagMetadataSnapshot
 	// Flush flushes the memory tag metadata into kv store
 	Flush() error
 }
@@ -68,20 +71,27 @@ type tagMetadata struct {
 	family       kv.Family // store tag key/value data using common kv store
 	mutable      *TagStore // mutable store current writeable memory store
 	immutable    *TagStore // immutable need to flush into kv store
+	version      int64     // increased when switching mutable/immutable or clearing immutable
 
 	rwMutex sync.RWMutex
 
+	tagValueCache *tagValueCache // cache tag values collected from kv store, nil if disabled
+
 	statistics *metrics.TagMetaStatistics
 }
 
 // NewTagMetadata creates a tag metadata
 func NewTagMetadata(databaseName string, family kv.Family) TagMetadata {
-	return &tagMetadata{
+	m := &tagMetadata{
 		databaseName: databaseName,
 		family:       family,
 		mutable:      NewTagStore(),
 		statistics:   metrics.NewTagMetaStatistics(databaseName),
 	}
+	if cacheSize := config.GlobalStorageConfig().TSDB.TagValueCacheSize; cacheSize > 0 {
+		m.tagValueCache = newTagValueCache(cacheSize, m.statistics)
+	}
+	return m
 }
 
 // GenTagValueID generates the tag value id for spec tag key
@@ -158,81 +168,28 @@ func (m *tagMetadata) GenTagValueID(tagKeyID tag.KeyID, tagValue string) (tagVal
 
 // SuggestTagValues returns suggestions from given tag key id and prefix of tag value
 func (m *tagMetadata) SuggestTagValues(tagKeyID tag.KeyID, tagValuePrefix string, limit int) []string {
-	result := make([]string, 0)
-	m.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
-		for value := range tagEntry.getTagValues() {
-			if strings.HasPrefix(value, tagValuePrefix) {
-				result = append(result, value)
-			}
-		}
-	})
-
-	snapshot := m.family.GetSnapshot()
+	snapshot := m.getSnapshot()
 	defer snapshot.Close()
 
-	readers, err := snapshot.FindReaders(uint32(tagKeyID))
-	if err != nil {
-		// find table.Reader err, return it
-		return nil
-	}
-	var reader tagkeymeta.Reader
-	if len(readers) > 0 {
-		// found tag data in kv store, try load tag value data
-		reader = newTagReaderFunc(readers)
-		readerValues := reader.SuggestTagValues(tagKeyID, tagValuePrefix, limit)
-		result = append(result, readerValues...)
-	}
-	return result
+	return snapshot.SuggestTagValues(tagKeyID, tagValuePrefix, limit)
 }
 
 // FindTagValueDsByExpr finds tag value ids by tag filter expr for spec tag key,
 // if not exist, return nil, constants.ErrNotFound, else returns tag value ids
 func (m *tagMetadata) FindTagValueDsByExpr(tagKeyID tag.KeyID, expr stmt.TagFilter) (*roaring.Bitmap, error) {
-	result := roaring.New()
-	m.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
-		ids := tagEntry.findSeriesIDsByExpr(expr)
-		if ids != nil {
-			result.Or(ids)
-		}
-	})
+	snapshot := m.getSnapshot()
+	defer snapshot.Close()
 
-	err := m.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
-		tagValueIDs, err := reader.FindValueIDsByExprForTagKeyID(tagKeyID, expr)
-		if err != nil {
-			return err
-		}
-		result.Or(tagValueIDs)
-		return nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	return result, nil
+	return snapshot.FindTagValueDsByExpr(tagKeyID, expr)
 }
 
 // GetTagValueIDsForTag get tag value ids for spec tag key of metric,
 // if not exist, return nil, constants.ErrNotFound, else returns tag value ids
 func (m *tagMetadata) GetTagValueIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error) {
-	result := roaring.New()
-	m.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
-		ids := tagEntry.getTagValueIDs()
-		if ids != nil {
-			result.Or(ids)
-		}
-	})
+	snapshot := m.getSnapshot()
+	defer snapshot.Close()
 
-	err := m.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
-		tagValueIDs, err := reader.GetTagValueIDsForTagKeyID(tagKeyID)
-		if err != nil {
-			return err
-		}
-		result.Or(tagValueIDs)
-		return nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	return result, nil
+	return snapshot.GetTagValueIDsForTag(tagKeyID)
 }
 
 // CollectTagValues collects the tag values by tag value ids for spec tag key,
@@ -240,25 +197,31 @@ func (m *tagMetadata) CollectTagValues(tagKeyID tag.KeyID,
 	tagValueIDs *roaring.Bitmap,
 	tagValues map[uint32]string,
 ) error {
-	m.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
-		if tagValueIDs.IsEmpty() {
-			// if found all tag value in memory, return it(maybe load immutable memory)
-			return
-		}
-		tagEntry.collectTagValues(tagValueIDs, tagValues)
-	})
+	snapshot := m.getSnapshot()
+	defer snapshot.Close()
 
-	if tagValueIDs.IsEmpty() {
-		// no need collect tag value ids, returns it
-		return nil
-	}
-	err := m.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
-		return reader.CollectTagValues(tagKeyID, tagValueIDs, tagValues)
-	})
-	if err != nil {
-		return err
+	return snapshot.CollectTagValues(tagKeyID, tagValueIDs, tagValues)
+}
+
+// GetSnapshot returns the versioned consistent snapshot of tag metadata, snapshot must be closed after reading.
+func (m *tagMetadata) GetSnapshot() flow.TagMetadataSnapshot {
+	return m.getSnapshot()
+}
+
+// getSnapshot returns the snapshot of tag metadata, the kv snapshot is captured with read lock,
+// because flush job clears immutable store with write lock after committing kv files,
+// so the data of immutable store is either in captured memory store or in captured kv snapshot.
+func (m *tagMetadata) getSnapshot() *tagMetadataSnapshot {
+	m.rwMutex.RLock()
+	defer m.rwMutex.RUnlock()
+
+	return &tagMetadataSnapshot{
+		meta:      m,
+		version:   m.version,
+		mutable:   m.mutable,
+		immutable: m.immutable,
+		family:    m.family.GetSnapshot(),
 	}
-	return nil
 }
 
 // Flush flushes the memory tag metadata into kv store
@@ -275,7 +238,9 @@ func (m *tagMetadata) Flush() error {
 	if err != nil {
 		return err
 	}
+	flushedTagKeyIDs := make(map[tag.KeyID]struct{})
 	if err := m.immutable.WalkEntry(func(key uint32, value TagEntry) error {
+		flushedTagKeyIDs[tag.KeyID(key)] = struct{}{}
 		tagValues := value.getTagValues()
 		for tagValue, tagValueID := range tagValues {
 			tagFluster.FlushTagValue(strutil.String2ByteSlice(tagValue), tagValueID)
@@ -290,9 +255,14 @@ func (m *tagMetadata) Flush() error {
 	if err := tagFluster.Close(); err != nil {
 		return err
 	}
+	if m.tagValueCache != nil {
+		// invalidate cached tag values of flushed tag keys
+		m.tagValueCache.purge(flushedTagKeyIDs)
+	}
 	// finally, clear immutable
 	m.rwMutex.Lock()
 	m.immutable = nil
+	m.version++
 	m.rwMutex.Unlock()
 	return nil
 }
@@ -310,50 +280,11 @@ func (m *tagMetadata) checkFlush() bool {
 		// reset mutable, if flush fail immutable is not nil
 		m.immutable = m.mutable
 		m.mutable = NewTagStore()
+		m.version++
 	}
 	return true
 }
 
-// loadTagValueIDsInKV loads tag value ids in kv store
-func (m *tagMetadata) loadTagValueIDsInKV(tagKeyID tag.KeyID, fn func(reader tagkeymeta.Reader) error) error {
-	// try load tag value id from kv store
-	snapshot := m.family.GetSnapshot()
-	defer snapshot.Close()
-
-	readers, err := snapshot.FindReaders(uint32(tagKeyID))
-	if err != nil {
-		// find table.Reader err, return it
-		return err
-	}
-	var reader tagkeymeta.Reader
-	if len(readers) > 0 {
-		// found tag data in kv store, try load tag value data
-		reader = newTagReaderFunc(readers)
-		if err := fn(reader); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// loadTagValueIDsInMem loads tag value ids from mutable/immutable store
-func (m *tagMetadata) loadTagValueIDsInMem(tagKeyID tag.KeyID, fn func(tagEntry TagEntry)) {
-	// define get tag value ids func
-	getTagValueIDs := func(tagStore *TagStore) {
-		if tagEntry, ok := tagStore.Get(uint32(tagKeyID)); ok {
-			fn(tagEntry)
-		}
-	}
-
-	m.rwMutex.RLock()
-	defer m.rwMutex.RUnlock()
-
-	getTagValueIDs(m.mutable)
-	if m.immutable != nil {
-		getTagValueIDs(m.immutable)
-	}
-}
-
 // getTagValueIDInMem gets tag value id from mutable/immutable store
 func (m *tagMetadata) getTagValueIDInMem(tagKeyID tag.KeyID, tagValue string) (tagValueID uint32, ok bool) {
 	tagValueID, ok = getTagValueID(m.mutable, tagKeyID, tagValue)
@@ -0,0 +1,192 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadb
+
+import (
+	"strings"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
+)
+
+// tagMetadataSnapshot represents a consistent view of tag metadata, includes memory tag stores and
+// kv snapshot of tag family, which are captured atomically with flush job's switching.
+// The captured memory stores are kept even if they are flushed, so that the tag values never lost
+// while reading, snapshot must be closed after reading for releasing kv snapshot.
+type tagMetadataSnapshot struct {
+	meta      *tagMetadata
+	version   int64
+	mutable   *TagStore
+	immutable *TagStore
+	family    version.Snapshot
+}
+
+// Version returns the version of tag metadata when snapshot created.
+func (s *tagMetadataSnapshot) Version() int64 {
+	return s.version
+}
+
+// Close releases the kv snapshot.
+func (s *tagMetadataSnapshot) Close() error {
+	s.family.Close()
+	return nil
+}
+
+// SuggestTagValues returns suggestions from given tag key id and prefix of tag value.
+func (s *tagMetadataSnapshot) SuggestTagValues(tagKeyID tag.KeyID, tagValuePrefix string, limit int) []string {
+	result := make([]string, 0)
+	s.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
+		for value := range tagEntry.getTagValues() {
+			if strings.HasPrefix(value, tagValuePrefix) {
+				result = append(result, value)
+			}
+		}
+	})
+	if err := s.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
+		result = append(result, reader.SuggestTagValues(tagKeyID, tagValuePrefix, limit)...)
+		return nil
+	}); err != nil {
+		return nil
+	}
+	return result
+}
+
+// FindTagValueDsByExpr finds tag value ids by tag filter expr for spec tag key.
+func (s *tagMetadataSnapshot) FindTagValueDsByExpr(tagKeyID tag.KeyID, expr stmt.TagFilter) (*roaring.Bitmap, error) {
+	result := roaring.New()
+	s.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
+		ids := tagEntry.findSeriesIDsByExpr(expr)
+		if ids != nil {
+			result.Or(ids)
+		}
+	})
+
+	err := s.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
+		tagValueIDs, err := reader.FindValueIDsByExprForTagKeyID(tagKeyID, expr)
+		if err != nil {
+			return err
+		}
+		result.Or(tagValueIDs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetTagValueIDsForTag get tag value ids for spec tag key.
+func (s *tagMetadataSnapshot) GetTagValueIDsForTag(tagKeyID tag.KeyID) (*roaring.Bitmap, error) {
+	result := roaring.New()
+	s.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
+		ids := tagEntry.getTagValueIDs()
+		if ids != nil {
+			result.Or(ids)
+		}
+	})
+
+	err := s.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
+		tagValueIDs, err := reader.GetTagValueIDsForTagKeyID(tagKeyID)
+		if err != nil {
+			return err
+		}
+		result.Or(tagValueIDs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CollectTagValues collects the tag values by tag value ids for spec tag key.
+func (s *tagMetadataSnapshot) CollectTagValues(tagKeyID tag.KeyID,
+	tagValueIDs *roaring.Bitmap,
+	tagValues map[uint32]string,
+) error {
+	s.loadTagValueIDsInMem(tagKeyID, func(tagEntry TagEntry) {
+		if tagValueIDs.IsEmpty() {
+			// if found all tag value in memory, return it(maybe load immutable memory)
+			return
+		}
+		tagEntry.collectTagValues(tagValueIDs, tagValues)
+	})
+
+	if tagValueIDs.IsEmpty() {
+		// no need collect tag value ids, returns it
+		return nil
+	}
+	tagValueCache := s.meta.tagValueCache
+	if tagValueCache == nil {
+		return s.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
+			return reader.CollectTagValues(tagKeyID, tagValueIDs, tagValues)
+		})
+	}
+	tagValueCache.collectTagValues(tagKeyID, tagValueIDs, tagValues)
+	if tagValueIDs.IsEmpty() {
+		// found all tag values in cache
+		return nil
+	}
+	loadTagValueIDs := tagValueIDs.Clone()
+	err := s.loadTagValueIDsInKV(tagKeyID, func(reader tagkeymeta.Reader) error {
+		return reader.CollectTagValues(tagKeyID, tagValueIDs, tagValues)
+	})
+	if err != nil {
+		return err
+	}
+	// cache tag values loaded from kv store
+	tagValueCache.addTagValues(tagKeyID, loadTagValueIDs, tagValues)
+	return nil
+}
+
+// loadTagValueIDsInKV loads tag value ids from captured kv snapshot.
+func (s *tagMetadataSnapshot) loadTagValueIDsInKV(tagKeyID tag.KeyID, fn func(reader tagkeymeta.Reader) error) error {
+	readers, err := s.family.FindReaders(uint32(tagKeyID))
+	if err != nil {
+		// find table.Reader err, return it
+		return err
+	}
+	if len(readers) > 0 {
+		// found tag data in kv store, try load tag value data
+		return fn(newTagReaderFunc(readers))
+	}
+	return nil
+}
+
+// loadTagValueIDsInMem loads tag value ids from captured mutable/immutable store.
+func (s *tagMetadataSnapshot) loadTagValueIDsInMem(tagKeyID tag.KeyID, fn func(tagEntry TagEntry)) {
+	// define get tag value ids func
+	getTagValueIDs := func(tagStore *TagStore) {
+		if tagEntry, ok := tagStore.Get(uint32(tagKeyID)); ok {
+			fn(tagEntry)
+		}
+	}
+
+	// captured mutable store maybe written by other goroutine, read data with read lock
+	s.meta.rwMutex.RLock()
+	defer s.meta.rwMutex.RUnlock()
+
+	getTagValueIDs(s.mutable)
+	if s.immutable != nil {
+		getTagValueIDs(s.immutable)
+	}
+}
@@ -271,6 +271,28 @@ func TestTagMetadata_CollectTagValues(t *testing.T) {
 	tagReader.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	err = meta.CollectTagValues(5, roaring.BitmapOf(1000), make(map[uint32]string))
 	assert.NoError(t, err)
+	// case 5: collect from cache after loaded from kv
+	tagReader.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error {
+			tagValues[2000] = "2000"
+			tagValueIDs.Remove(2000)
+			return nil
+		})
+	tagValues := make(map[uint32]string)
+	err = meta.CollectTagValues(5, roaring.BitmapOf(2000), tagValues)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]string{2000: "2000"}, tagValues)
+	tagValues = make(map[uint32]string)
+	tagValueIDs := roaring.BitmapOf(2000)
+	err = meta.CollectTagValues(5, tagValueIDs, tagValues)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint32]string{2000: "2000"}, tagValues)
+	assert.True(t, tagValueIDs.IsEmpty())
+	// case 6: cache disabled
+	meta.(*tagMetadata).tagValueCache = nil
+	tagReader.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err = meta.CollectTagValues(5, roaring.BitmapOf(2000), make(map[uint32]string))
+	assert.NoError(t, err)
 }
 
 func TestTagMetadata_Flush(t *testing.T) {
@@ -335,6 +357,44 @@ func TestTagMetadata_Flush(t *testing.T) {
 	m.rwMutex.Unlock()
 }
 
+func TestTagMetadata_Snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	meta, _, kvSnapshot := mockTagMetadata(ctrl)
+	m := meta.(*tagMetadata)
+	mockTagMetadataMemData(meta)
+	m.rwMutex.Lock()
+	m.immutable = nil
+	m.rwMutex.Unlock()
+	kvSnapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	snapshot := meta.GetSnapshot()
+	assert.Equal(t, int64(0), snapshot.Version())
+	// switch mutable/immutable, then clear immutable like flush job
+	assert.True(t, m.checkFlush())
+	m.rwMutex.Lock()
+	m.immutable = nil
+	m.version++
+	m.rwMutex.Unlock()
+	// captured memory store is still visible for snapshot
+	ids, err := snapshot.GetTagValueIDsForTag(10)
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(20), ids)
+	ids, err = snapshot.FindTagValueDsByExpr(10, &stmt.EqualsExpr{Value: "tag-value-20"})
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(20), ids)
+	tagValues := make(map[uint32]string)
+	assert.NoError(t, snapshot.CollectTagValues(10, roaring.BitmapOf(20), tagValues))
+	assert.Equal(t, map[uint32]string{20: "tag-value-20"}, tagValues)
+	assert.NoError(t, snapshot.Close())
+	// new snapshot reads the flushed data from kv store
+	ids, err = meta.GetTagValueIDsForTag(10)
+	assert.NoError(t, err)
+	assert.True(t, ids.IsEmpty())
+	assert.Equal(t, int64(2), meta.GetSnapshot().Version())
+}
+
 func mockTagMetadata(ctrl *gomock.Controller) (TagMetadata, *kv.MockFamily, *version.MockSnapshot) {
 	family := kv.NewMockFamily(ctrl)
 	snapshot := version.NewMockSnapshot(ctrl)
@@ -0,0 +1,144 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadb
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/series/tag"
+)
+
+// tagValueKey represents the key of tag value cache.
+type tagValueKey struct {
+	tagKeyID   tag.KeyID
+	tagValueID uint32
+}
+
+// tagValueEntry represents the cached tag value.
+type tagValueEntry struct {
+	key      tagValueKey
+	tagValue string
+}
+
+// tagValueCache caches the tag values collected from kv store(tag key id/tag value id => tag value),
+// avoids repeated trie lookup for same grouping query(dashboard refresh), evicts by lru.
+type tagValueCache struct {
+	capacity  int
+	items     map[tagValueKey]*list.Element
+	evictList *list.List
+
+	statistics *metrics.TagMetaStatistics
+
+	mutex sync.Mutex
+}
+
+// newTagValueCache creates a tag value cache with max number of tag values.
+func newTagValueCache(capacity int, statistics *metrics.TagMetaStatistics) *tagValueCache {
+	return &tagValueCache{
+		capacity:   capacity,
+		items:      make(map[tagValueKey]*list.Element),
+		evictList:  list.New(),
+		statistics: statistics,
+	}
+}
+
+// collectTagValues collects the cached tag values by tag value ids, removes found tag value ids.
+func (c *tagValueCache) collectTagValues(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.items) == 0 {
+		c.statistics.TagValueCacheMisses.Add(float64(tagValueIDs.GetCardinality()))
+		return
+	}
+	found := roaring.New()
+	it := tagValueIDs.Iterator()
+	for it.HasNext() {
+		tagValueID := it.Next()
+		if ent, ok := c.items[tagValueKey{tagKeyID: tagKeyID, tagValueID: tagValueID}]; ok {
+			c.evictList.MoveToFront(ent)
+			tagValues[tagValueID] = ent.Value.(*tagValueEntry).tagValue
+			found.Add(tagValueID)
+		}
+	}
+	hits := found.GetCardinality()
+	c.statistics.TagValueCacheHits.Add(float64(hits))
+	c.statistics.TagValueCacheMisses.Add(float64(tagValueIDs.GetCardinality() - hits))
+	if hits > 0 {
+		// remove found tag value ids
+		tagValueIDs.Xor(found)
+	}
+}
+
+// addTagValues adds the tag values of tag value ids into cache.
+func (c *tagValueCache) addTagValues(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	it := tagValueIDs.Iterator()
+	for it.HasNext() {
+		tagValueID := it.Next()
+		tagValue, ok := tagValues[tagValueID]
+		if !ok {
+			continue
+		}
+		key := tagValueKey{tagKeyID: tagKeyID, tagValueID: tagValueID}
+		if ent, ok := c.items[key]; ok {
+			c.evictList.MoveToFront(ent)
+			ent.Value.(*tagValueEntry).tagValue = tagValue
+			continue
+		}
+		c.items[key] = c.evictList.PushFront(&tagValueEntry{key: key, tagValue: tagValue})
+		if c.evictList.Len() > c.capacity {
+			c.removeElement(c.evictList.Back())
+			c.statistics.TagValueCacheEvicts.Incr()
+		}
+	}
+}
+
+// purge removes all cached tag values of given tag key ids.
+func (c *tagValueCache) purge(tagKeyIDs map[tag.KeyID]struct{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for ent := c.evictList.Front(); ent != nil; {
+		next := ent.Next()
+		if _, ok := tagKeyIDs[ent.Value.(*tagValueEntry).key.tagKeyID]; ok {
+			c.removeElement(ent)
+		}
+		ent = next
+	}
+}
+
+// size returns the number of cached tag values.
+func (c *tagValueCache) size() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.evictList.Len()
+}
+
+// removeElement removes the cached tag value from cache.
+func (c *tagValueCache) removeElement(ent *list.Element) {
+	c.evictList.Remove(ent)
+	delete(c.items, ent.Value.(*tagValueEntry).key)
+}
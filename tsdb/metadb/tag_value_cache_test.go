@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadb
+
+import (
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func TestTagValueCache(t *testing.T) {
+	cache := newTagValueCache(3, metrics.NewTagMetaStatistics("test_cache_db"))
+	// case 1: empty cache
+	tagValueIDs := roaring.BitmapOf(1, 2)
+	tagValues := make(map[uint32]string)
+	cache.collectTagValues(1, tagValueIDs, tagValues)
+	assert.Empty(t, tagValues)
+	assert.Equal(t, uint64(2), tagValueIDs.GetCardinality())
+	// case 2: add tag values, skip not found
+	cache.addTagValues(1, roaring.BitmapOf(1, 2, 3), map[uint32]string{1: "a", 2: "b"})
+	cache.addTagValues(2, roaring.BitmapOf(1), map[uint32]string{1: "c"})
+	assert.Equal(t, 3, cache.size())
+	// case 3: collect from cache, remove found tag value ids
+	tagValueIDs = roaring.BitmapOf(1, 2, 3)
+	cache.collectTagValues(1, tagValueIDs, tagValues)
+	assert.Equal(t, map[uint32]string{1: "a", 2: "b"}, tagValues)
+	assert.Equal(t, roaring.BitmapOf(3), tagValueIDs)
+	// case 4: evict least recently used
+	cache.addTagValues(2, roaring.BitmapOf(2), map[uint32]string{2: "d"})
+	cache.addTagValues(1, roaring.BitmapOf(1), map[uint32]string{1: "a"})
+	assert.Equal(t, 3, cache.size())
+	tagValues = make(map[uint32]string)
+	cache.collectTagValues(2, roaring.BitmapOf(1, 2), tagValues)
+	assert.Equal(t, map[uint32]string{2: "d"}, tagValues)
+	// case 5: purge tag key
+	cache.purge(map[tag.KeyID]struct{}{1: {}})
+	assert.Equal(t, 1, cache.size())
+	tagValues = make(map[uint32]string)
+	cache.collectTagValues(1, roaring.BitmapOf(1, 2), tagValues)
+	assert.Empty(t, tagValues)
+}
@@ -0,0 +1,180 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"errors"
+	"sync"
+
+	commonseries "github.com/lindb/common/series"
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/indexdb"
+)
+
+// metricActivityFile represents the file name of metric's last active time under database dir.
+const metricActivityFile = "METRIC_ACTIVITY"
+
+// metricActivityData represents the persisted last active(written or queried) time of metrics.
+type metricActivityData struct {
+	Metrics map[string]int64 `toml:"metrics"` // namespace|metric => last active time
+}
+
+// metricExpirer expires the metrics which received no writes and no queries for a long time,
+// the last write time comes from metadata cache, the last query time comes from field usage statistics.
+type metricExpirer struct {
+	path       string
+	lastActive map[string]int64
+	report     models.ExpiredMetrics // report of last check
+
+	mutex sync.Mutex
+}
+
+// newMetricExpirer creates unused metric expirer, loads the last active time of metrics from file if exist.
+func newMetricExpirer(path string) *metricExpirer {
+	e := &metricExpirer{
+		path:       path,
+		lastActive: make(map[string]int64),
+	}
+	if !fileExist(path) {
+		return e
+	}
+	data := &metricActivityData{}
+	if err := decodeToml(path, data); err != nil {
+		// all metrics start counting from next check, never expires active metric by mistake
+		engineLogger.Warn("load last active time of metrics failure, discard it",
+			logger.String("path", path), logger.Error(err))
+		return e
+	}
+	for key, lastActive := range data.Metrics {
+		e.lastActive[key] = lastActive
+	}
+	return e
+}
+
+// expire finds the metrics which are inactive longer than expireAfter, removes them if not dry-run,
+// then persists the last active time of remaining metrics.
+func (e *metricExpirer) expire(db *database, expireAfter int64, dryRun bool) (models.ExpiredMetrics, error) {
+	now := timeutil.Now()
+	active := make(map[string]int64)
+	candidates := models.ExpiredMetrics{}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	err := db.metadata.MetadataDatabase().WalkMetrics(func(namespace, metricName string, lastWrite int64) bool {
+		key := commonseries.JoinNamespaceMetric(namespace, metricName)
+		lastActive := e.lastActive[key]
+		if lastWrite > lastActive {
+			lastActive = lastWrite
+		}
+		if lastQuery := db.fieldUsage.GetLastAccess(namespace, metricName); lastQuery > lastActive {
+			lastActive = lastQuery
+		}
+		if lastActive == 0 {
+			// first check of metric, starts counting from now
+			lastActive = now
+		}
+		active[key] = lastActive
+		if now-lastActive >= expireAfter {
+			candidates = append(candidates, &models.ExpiredMetric{
+				Namespace:  namespace,
+				Metric:     metricName,
+				LastActive: lastActive,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		for _, candidate := range candidates {
+			if err = db.dropMetric(candidate.Namespace, candidate.Metric, candidate.LastActive); err != nil {
+				if errors.Is(err, constants.ErrMetricActive) {
+					// metric written or queried after check, keep it
+					continue
+				}
+				engineLogger.Warn("remove unused metric failure",
+					logger.String("db", db.name), logger.String("namespace", candidate.Namespace),
+					logger.String("metric", candidate.Metric), logger.Error(err))
+				continue
+			}
+			candidate.Removed = true
+			delete(active, commonseries.JoinNamespaceMetric(candidate.Namespace, candidate.Metric))
+		}
+	}
+	e.lastActive = active
+	e.report = candidates
+	return candidates, encodeToml(e.path, &metricActivityData{Metrics: active})
+}
+
+// getReport returns the report of last check.
+func (e *metricExpirer) getReport() models.ExpiredMetrics {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.report
+}
+
+// dropMetric removes the metadata of metric if it is still inactive since lastActive, then removes the series index
+// of metric from all shards. Series ids are collected before dropping metadata, because new write after dropping
+// creates the metric again with new metric id.
+func (db *database) dropMetric(namespace, metricName string, lastActive int64) error {
+	metadata := db.metadata.MetadataDatabase()
+	metricID, err := metadata.GetMetricID(namespace, metricName)
+	if err != nil {
+		return err
+	}
+	type shardSeries struct {
+		indexDB   indexdb.IndexDatabase
+		seriesIDs *roaring.Bitmap
+	}
+	var series []shardSeries
+	for _, shardEntry := range db.shardSet.Entries() {
+		indexDB := shardEntry.shard.IndexDatabase()
+		seriesIDs, err := indexDB.GetSeriesIDsForMetric(namespace, metricName)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if seriesIDs.IsEmpty() {
+			continue
+		}
+		series = append(series, shardSeries{indexDB: indexDB, seriesIDs: seriesIDs})
+	}
+	// re-check last write/query time under write lock of metadata, avoids dropping metric touched after check
+	if _, err = metadata.DropMetric(namespace, metricName, func(lastWrite int64) bool {
+		return lastWrite > lastActive || db.fieldUsage.GetLastAccess(namespace, metricName) > lastActive
+	}); err != nil {
+		return err
+	}
+	db.fieldUsage.Remove(namespace, metricName)
+	for _, s := range series {
+		if _, err = s.indexDB.DeleteSeries(metricID, s.seriesIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestDatabase_ExpireUnusedMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		encodeToml = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	shard := NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	path := filepath.Join(t.TempDir(), metricActivityFile)
+	opt := &option.DatabaseOption{}
+	db := &database{
+		name:          "db",
+		config:        &models.DatabaseConfig{Option: opt},
+		shardSet:      *newShardSet(),
+		metadata:      metadata,
+		fieldUsage:    newFieldUsageTracker(filepath.Join(t.TempDir(), fieldUsageFile)),
+		metricExpirer: newMetricExpirer(path),
+	}
+	db.shardSet.InsertShard(1, shard)
+	walkMetrics := func(fn func(namespace, metricName string, lastWrite int64) bool) error {
+		fn("ns", "cpu", 0)
+		fn("ns", "mem", 0)
+		fn("ns", "disk", timeutil.Now())
+		return nil
+	}
+	// case 1: metric expiry disabled
+	db.ExpireUnusedMetrics()
+	assert.Empty(t, db.GetExpiredMetrics())
+
+	opt.MetricExpireAfter = "1d"
+	opt.MetricExpiryPolicy = option.MetricExpiryDryRun
+	// case 2: walk metrics failure
+	metadataDB.EXPECT().WalkMetrics(gomock.Any()).Return(fmt.Errorf("err"))
+	db.ExpireUnusedMetrics()
+	assert.Empty(t, db.GetExpiredMetrics())
+	// case 3: dry-run, only reports the unused metrics
+	twoDaysAgo := timeutil.Now() - 2*timeutil.OneDay
+	db.metricExpirer.lastActive = map[string]int64{"ns|cpu": twoDaysAgo, "ns|mem": twoDaysAgo, "ns|disk": twoDaysAgo}
+	db.fieldUsage.Record("ns", "mem", []field.Name{"f1"})
+	metadataDB.EXPECT().WalkMetrics(gomock.Any()).DoAndReturn(walkMetrics)
+	db.ExpireUnusedMetrics()
+	assert.Equal(t, models.ExpiredMetrics{{Namespace: "ns", Metric: "cpu", LastActive: twoDaysAgo}}, db.GetExpiredMetrics())
+	// case 4: remove unused metric
+	opt.MetricExpiryPolicy = option.MetricExpiryRemove
+	metadataDB.EXPECT().WalkMetrics(gomock.Any()).DoAndReturn(walkMetrics)
+	metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+	metadataDB.EXPECT().DropMetric("ns", "cpu", gomock.Any()).Return(metric.ID(1), nil)
+	indexDB.EXPECT().DeleteSeries(metric.ID(1), roaring.BitmapOf(1, 2)).Return(2, nil)
+	db.ExpireUnusedMetrics()
+	assert.Len(t, db.GetExpiredMetrics(), 1)
+	assert.True(t, db.GetExpiredMetrics()[0].Removed)
+	// load last active time from file, removed metric is excluded
+	expirer := newMetricExpirer(path)
+	assert.Len(t, expirer.lastActive, 2)
+	assert.NotContains(t, expirer.lastActive, "ns|cpu")
+	// case 5: remove unused metric failure
+	db.metricExpirer.lastActive["ns|mem"] = twoDaysAgo
+	db.fieldUsage.Remove("ns", "mem")
+	metadataDB.EXPECT().WalkMetrics(gomock.Any()).DoAndReturn(walkMetrics)
+	metadataDB.EXPECT().GetMetricID("ns", "mem").Return(metric.ID(2), nil)
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "mem").Return(nil, fmt.Errorf("err"))
+	// case 6: persist failure
+	encodeToml = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	db.ExpireUnusedMetrics()
+	assert.Len(t, db.GetExpiredMetrics(), 1)
+	assert.False(t, db.GetExpiredMetrics()[0].Removed)
+}
+
+func TestDatabase_dropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	shard := NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	db := &database{
+		shardSet:   *newShardSet(),
+		metadata:   metadata,
+		fieldUsage: newFieldUsageTracker(filepath.Join(t.TempDir(), fieldUsageFile)),
+	}
+	db.shardSet.InsertShard(1, shard)
+
+	// metric not found
+	metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.EmptyMetricID, constants.ErrMetricIDNotFound)
+	assert.Error(t, db.dropMetric("ns", "cpu", 100))
+	// series not found in shard
+	metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil).AnyTimes()
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(nil, constants.ErrNotFound)
+	metadataDB.EXPECT().DropMetric("ns", "cpu", gomock.Any()).Return(metric.ID(1), nil)
+	assert.NoError(t, db.dropMetric("ns", "cpu", 100))
+	// get series failure
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(nil, fmt.Errorf("err"))
+	assert.Error(t, db.dropMetric("ns", "cpu", 100))
+	// delete series failure
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1), nil)
+	metadataDB.EXPECT().DropMetric("ns", "cpu", gomock.Any()).Return(metric.ID(1), nil)
+	indexDB.EXPECT().DeleteSeries(metric.ID(1), gomock.Any()).Return(0, fmt.Errorf("err"))
+	assert.Error(t, db.dropMetric("ns", "cpu", 100))
+	// drop metadata failure
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.New(), nil)
+	metadataDB.EXPECT().DropMetric("ns", "cpu", gomock.Any()).Return(metric.EmptyMetricID, fmt.Errorf("err"))
+	assert.Error(t, db.dropMetric("ns", "cpu", 100))
+	// metric written or queried after check, keep series
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1), nil).Times(2)
+	metadataDB.EXPECT().DropMetric("ns", "cpu", gomock.Any()).DoAndReturn(
+		func(_, _ string, isActive func(lastWrite int64) bool) (metric.ID, error) {
+			if isActive(200) {
+				return metric.EmptyMetricID, constants.ErrMetricActive
+			}
+			return metric.ID(1), nil
+		})
+	assert.ErrorIs(t, db.dropMetric("ns", "cpu", 100), constants.ErrMetricActive)
+	db.fieldUsage.Record("ns", "cpu", []field.Name{"f1"})
+	metadataDB.EXPECT().DropMetric("ns", "cpu", gomock.Any()).DoAndReturn(
+		func(_, _ string, isActive func(lastWrite int64) bool) (metric.ID, error) {
+			if isActive(0) {
+				return metric.EmptyMetricID, constants.ErrMetricActive
+			}
+			return metric.ID(1), nil
+		})
+	assert.ErrorIs(t, db.dropMetric("ns", "cpu", 100), constants.ErrMetricActive)
+}
+
+func TestMetricExpirer_Corrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), metricActivityFile)
+	assert.NoError(t, os.WriteFile(path, []byte("corrupted"), 0o600))
+	expirer := newMetricExpirer(path)
+	assert.Empty(t, expirer.lastActive)
+}
@@ -0,0 +1,300 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// stagedRows represents the rows of same family staged for generating metadata,
+// if done is not nil, it's a barrier which invokes fn then notifies all staged rows before it are written.
+type stagedRows struct {
+	family   DataFamily
+	sequence *stagedSequence // replica sequence of rows, released after rows written
+	rows     []metric.StorageRow
+	series   []stagedSeries // series of rows, be used for removing pending mark after rows written
+	limits   *models.Limits
+	done     chan struct{}
+	fn       func()
+}
+
+// stagedSequence represents the replica sequence of leader which rows are staged,
+// the sequence is staged in family until all staged rows of it written, then notifies the write result.
+type stagedSequence struct {
+	family  DataFamily
+	leader  int32
+	seq     int64
+	staged  bool
+	refs    atomic.Int32 // number of staged batches not written, +1 held by resolving
+	failed  atomic.Bool
+	written func(success bool)
+}
+
+// newStagedSequence creates a staged sequence, the reference is held by caller until all rows resolved.
+func newStagedSequence(family DataFamily, leader int32, seq int64, written func(success bool)) *stagedSequence {
+	s := &stagedSequence{
+		family:  family,
+		leader:  leader,
+		seq:     seq,
+		written: written,
+	}
+	s.refs.Store(1)
+	return s
+}
+
+// retain retains the sequence for staged batch, stages the sequence in family for first batch,
+// must be invoked by resolving goroutine.
+func (s *stagedSequence) retain() {
+	if !s.staged {
+		s.staged = true
+		s.family.StageSequence(s.leader, s.seq)
+	}
+	s.refs.Inc()
+}
+
+// release releases the sequence after staged batch written(or all rows resolved),
+// releases the staged sequence in family and notifies the write result after the last reference released.
+func (s *stagedSequence) release(success bool) {
+	if !success {
+		s.failed.Store(true)
+	}
+	if s.refs.Dec() > 0 {
+		return
+	}
+	if s.staged {
+		s.family.ReleaseStagedSequence(s.leader, s.seq)
+	}
+	s.written(!s.failed.Load())
+}
+
+// stagedSeries represents the series of staged row.
+type stagedSeries struct {
+	metricID metric.ID
+	tagsHash uint64
+}
+
+// rowStager stages the rows whose metadata(series ids) need generating, generates metadata then writes them
+// into data family in background goroutine, so that writing of existing series is not blocked by new series.
+type rowStager struct {
+	indicator string
+	maxRows   int64
+	pending   atomic.Int64 // number of staged rows waiting write
+	lookup    func(rows []metric.StorageRow, limits *models.Limits)
+
+	mutex    sync.Mutex
+	batches  []*stagedRows
+	series   map[stagedSeries]int // number of staged rows of each series waiting write
+	isClosed bool                 // no batch is enqueued after closed, guarded by mutex
+	notify   chan struct{}
+	closed   chan struct{}
+	stopped  chan struct{}
+
+	statistics *metrics.ShardStatistics
+	logger     *logger.Logger
+}
+
+// newRowStager creates a row stager, then starts the background write goroutine.
+func newRowStager(
+	indicator string,
+	maxRows int,
+	lookup func(rows []metric.StorageRow, limits *models.Limits),
+	statistics *metrics.ShardStatistics,
+) *rowStager {
+	s := &rowStager{
+		indicator:  indicator,
+		maxRows:    int64(maxRows),
+		lookup:     lookup,
+		series:     make(map[stagedSeries]int),
+		notify:     make(chan struct{}, 1),
+		closed:     make(chan struct{}),
+		stopped:    make(chan struct{}),
+		statistics: statistics,
+		logger:     logger.GetLogger("TSDB", "RowStager"),
+	}
+	go s.run()
+	return s
+}
+
+// tryAcquire acquires the capacity of staging buffer for rows, returns false if staging buffer is full.
+func (s *rowStager) tryAcquire(rows int) bool {
+	if s.pending.Add(int64(rows)) > s.maxRows {
+		s.pending.Sub(int64(rows))
+		return false
+	}
+	return true
+}
+
+// markPending marks the series of row has pending staged row, must be invoked before row staged,
+// rows of this series are staged until all pending rows written, keeps the write order of series.
+func (s *rowStager) markPending(row *metric.StorageRow) stagedSeries {
+	key := stagedSeries{metricID: row.MetricID, tagsHash: row.TagsHash()}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.series[key]++
+	return key
+}
+
+// isPending checks if the series has pending staged rows.
+func (s *rowStager) isPending(metricID metric.ID, tagsHash uint64) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.series[stagedSeries{metricID: metricID, tagsHash: tagsHash}]
+	return ok
+}
+
+// releasePending removes pending mark of series after staged rows written.
+func (s *rowStager) releasePending(series []stagedSeries) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, key := range series {
+		if n := s.series[key]; n > 1 {
+			s.series[key] = n - 1
+		} else {
+			delete(s.series, key)
+		}
+	}
+}
+
+// stage stages the rows which capacity acquired before, rows must own the underlying data,
+// series are the pending marks of rows, which are removed after rows written,
+// sequence is the replica sequence of rows, which is released after rows written,
+// writes rows synchronously if stager closed.
+func (s *rowStager) stage(family DataFamily, sequence *stagedSequence,
+	rows []metric.StorageRow, series []stagedSeries, limits *models.Limits,
+) {
+	if len(rows) == 0 {
+		return
+	}
+	family.Retain() // mark family will write data
+	sequence.retain()
+	s.statistics.StagedRows.Add(float64(len(rows)))
+	s.statistics.StagedRowsPending.Update(float64(s.pending.Load()))
+	batch := &stagedRows{family: family, sequence: sequence, rows: rows, series: series, limits: limits}
+	if !s.enqueue(batch) {
+		// background write goroutine exits after closed, waits all rows staged before written, keeps write order
+		<-s.stopped
+		s.writeBatches([]*stagedRows{batch})
+	}
+}
+
+// Sync waits until all rows staged before are written into data family, then invokes fn(if not nil)
+// in background write goroutine, so that no staged row is written during invoking fn.
+func (s *rowStager) Sync(fn func()) {
+	done := make(chan struct{})
+	if s.enqueue(&stagedRows{done: done, fn: fn}) {
+		<-done
+		return
+	}
+	// stager closed, all staged rows are written before stopped
+	<-s.stopped
+	if fn != nil {
+		fn()
+	}
+}
+
+// Close stops the background write goroutine after all staged rows written.
+func (s *rowStager) Close() {
+	s.mutex.Lock()
+	if !s.isClosed {
+		s.isClosed = true
+		close(s.closed)
+	}
+	s.mutex.Unlock()
+	<-s.stopped
+}
+
+// enqueue appends staged rows into queue, then notifies background write goroutine,
+// returns false if stager closed.
+func (s *rowStager) enqueue(batch *stagedRows) bool {
+	s.mutex.Lock()
+	if s.isClosed {
+		s.mutex.Unlock()
+		return false
+	}
+	s.batches = append(s.batches, batch)
+	s.mutex.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// dequeue returns all queued staged rows.
+func (s *rowStager) dequeue() []*stagedRows {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	batches := s.batches
+	s.batches = nil
+	return batches
+}
+
+// run writes staged rows in background until stager closed.
+func (s *rowStager) run() {
+	defer close(s.stopped)
+
+	for {
+		select {
+		case <-s.notify:
+			s.writeBatches(s.dequeue())
+		case <-s.closed:
+			// write all remaining rows before exit
+			s.writeBatches(s.dequeue())
+			return
+		}
+	}
+}
+
+// writeBatches generates metadata of staged rows, then writes them into data family in order.
+func (s *rowStager) writeBatches(batches []*stagedRows) {
+	for _, batch := range batches {
+		if batch.done != nil {
+			if batch.fn != nil {
+				batch.fn()
+			}
+			close(batch.done)
+			continue
+		}
+		s.lookup(batch.rows, batch.limits)
+		err := batch.family.WriteRows(batch.rows)
+		if err != nil {
+			s.logger.Error("failed writing staged rows",
+				logger.String("shard", s.indicator),
+				logger.String("family", batch.family.Indicator()),
+				logger.Error(err))
+		} else {
+			s.statistics.StagedRowsWritten.Add(float64(len(batch.rows)))
+		}
+		batch.sequence.release(err == nil)
+		batch.family.Release()
+		s.releasePending(batch.series)
+		s.statistics.StagedRowsPending.Update(float64(s.pending.Sub(int64(len(batch.rows)))))
+	}
+}
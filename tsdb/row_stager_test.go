@@ -0,0 +1,164 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+func TestRowStager_Stage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var lookupRows int
+	s := newRowStager("db/1", 2, func(rows []metric.StorageRow, _ *models.Limits) {
+		lookupRows += len(rows)
+	}, metrics.NewShardStatistics("db", "1"))
+	// sync without staged rows
+	s.Sync(nil)
+
+	family := NewMockDataFamily(ctrl)
+	family.EXPECT().Indicator().Return("db/1/family").AnyTimes()
+	var results []bool
+	sequence := newStagedSequence(family, 1, 10, func(success bool) {
+		results = append(results, success)
+	})
+	// staging buffer is full
+	assert.True(t, s.tryAcquire(2))
+	assert.False(t, s.tryAcquire(1))
+	// stage empty rows
+	s.stage(family, sequence, nil, nil, nil)
+	// write staged rows failure
+	family.EXPECT().Retain()
+	family.EXPECT().StageSequence(int32(1), int64(10))
+	family.EXPECT().WriteRows(gomock.Any()).Return(fmt.Errorf("err"))
+	family.EXPECT().Release()
+	s.stage(family, sequence, newStagedRows(1), nil, nil)
+	// write staged rows successfully
+	family.EXPECT().Retain()
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	family.EXPECT().Release()
+	s.stage(family, sequence, newStagedRows(1), nil, nil)
+	// fn is invoked after staged rows written
+	synced := false
+	s.Sync(func() {
+		assert.Equal(t, 2, lookupRows)
+		synced = true
+	})
+	assert.True(t, synced)
+	assert.Zero(t, s.pending.Load())
+	assert.True(t, s.tryAcquire(2))
+	// staged sequence is released after all rows resolved and staged rows written, write failure is notified
+	assert.Empty(t, results)
+	family.EXPECT().ReleaseStagedSequence(int32(1), int64(10))
+	sequence.release(true)
+	assert.Equal(t, []bool{false}, results)
+
+	// write remaining rows when close
+	sequence = newStagedSequence(family, 1, 11, func(success bool) {
+		results = append(results, success)
+	})
+	family.EXPECT().Retain()
+	family.EXPECT().StageSequence(int32(1), int64(11))
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	family.EXPECT().ReleaseStagedSequence(int32(1), int64(11))
+	family.EXPECT().Release()
+	s.stage(family, sequence, newStagedRows(2), nil, nil)
+	sequence.release(true)
+	s.Close()
+	assert.Equal(t, 4, lookupRows)
+	assert.Equal(t, []bool{false, true}, results)
+	// close/sync after closed, fn is invoked by caller
+	s.Close()
+	synced = false
+	s.Sync(func() {
+		synced = true
+	})
+	assert.True(t, synced)
+	// write rows synchronously after closed
+	sequence = newStagedSequence(family, 1, 12, func(success bool) {
+		results = append(results, success)
+	})
+	assert.True(t, s.tryAcquire(1))
+	family.EXPECT().Retain()
+	family.EXPECT().StageSequence(int32(1), int64(12))
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	family.EXPECT().Release()
+	s.stage(family, sequence, newStagedRows(1), nil, nil)
+	assert.Equal(t, 5, lookupRows)
+	assert.Zero(t, s.pending.Load())
+	family.EXPECT().ReleaseStagedSequence(int32(1), int64(12))
+	sequence.release(true)
+	assert.Equal(t, []bool{false, true, true}, results)
+}
+
+func TestRowStager_Pending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := newRowStager("db/1", 10, func(rows []metric.StorageRow, _ *models.Limits) {}, metrics.NewShardStatistics("db", "1"))
+	defer s.Close()
+
+	family := NewMockDataFamily(ctrl)
+	family.EXPECT().Retain().AnyTimes()
+	family.EXPECT().Release().AnyTimes()
+	family.EXPECT().StageSequence(gomock.Any(), gomock.Any()).AnyTimes()
+	family.EXPECT().ReleaseStagedSequence(gomock.Any(), gomock.Any()).AnyTimes()
+	rows := newStagedRows(2)
+	row := &rows[0]
+	assert.False(t, s.isPending(row.MetricID, row.TagsHash()))
+	var series []stagedSeries
+	for idx := range rows {
+		series = append(series, s.markPending(&rows[idx]))
+	}
+	assert.True(t, s.isPending(row.MetricID, row.TagsHash()))
+	// pending until all staged rows of series written
+	s.releasePending(series[:1])
+	assert.True(t, s.isPending(row.MetricID, row.TagsHash()))
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	s.stage(family, newStagedSequence(family, 1, 1, func(bool) {}), rows[1:], series[1:], nil)
+	s.Sync(nil)
+	assert.False(t, s.isPending(row.MetricID, row.TagsHash()))
+}
+
+// newStagedRows returns the rows of same series.
+func newStagedRows(n int) (rows []metric.StorageRow) {
+	for i := 0; i < n; i++ {
+		rows = append(rows, mockBatchRows(&protoMetricsV1.Metric{
+			Name:      "test",
+			Timestamp: timeutil.Now(),
+			Tags:      []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
+			SimpleFields: []*protoMetricsV1.SimpleField{{
+				Name:  "f1",
+				Value: 1.0,
+				Type:  protoMetricsV1.SimpleFieldType_DELTA_SUM,
+			}},
+		})...)
+	}
+	return rows
+}
@@ -23,8 +23,11 @@ import (
 	"strconv"
 	"sync"
 
+	"go.uber.org/atomic"
+
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
@@ -41,22 +44,30 @@ type Segment interface {
 	GetOrCreateDataFamily(timestamp int64) (DataFamily, error)
 	// GetDataFamilies returns data family list by time range, return nil if not match.
 	GetDataFamilies(timeRange timeutil.TimeRange) []DataFamily
+	// GetAllDataFamilies returns all data families of segment.
+	GetAllDataFamilies() []DataFamily
 	// NeedEvict checks segment if it can evict, long term no read operation.
 	NeedEvict() bool
 	// EvictFamily evicts data family.
 	EvictFamily(familyTime int64)
-	// Close closes segment, include kv store.
+	// GetState returns the state of segment, includes on-disk files of each data family.
+	GetState() models.SegmentState
+	// Close closes segment, include kv store, kv store is closed after all readers of data families released.
 	Close()
+	// Drop closes segment, then drops segment data by drop function after all readers of data families released.
+	Drop(dropFn func())
 }
 
 // segment implements Segment interface.
 type segment struct {
 	indicator string
+	name      string
 	shard     Shard
 	baseTime  int64
 	kvStore   kv.Store
 	interval  timeutil.Interval
 	families  map[int]DataFamily
+	manifest  *segmentManifest
 
 	mutex sync.RWMutex
 
@@ -92,10 +103,12 @@ func newSegment(shard Shard, segmentName string, interval timeutil.Interval) (Se
 	return &segment{
 		shard:     shard,
 		indicator: indicator,
+		name:      segmentName,
 		baseTime:  baseTime,
 		kvStore:   kvStore,
 		interval:  interval,
 		families:  make(map[int]DataFamily),
+		manifest:  shard.segmentManifest(),
 		logger:    logger.GetLogger("TSDB", "Segment"),
 	}, nil
 }
@@ -114,6 +127,18 @@ func (s *segment) GetDataFamilies(timeRange timeutil.TimeRange) []DataFamily {
 		Start: calc.CalcFamilyStartTime(s.baseTime, calc.CalcFamily(timeRange.Start, s.baseTime)),
 		End:   calc.CalcFamilyStartTime(s.baseTime, calc.CalcFamily(timeRange.End, s.baseTime)),
 	}
+	for _, family := range s.GetAllDataFamilies() {
+		timeRange := family.TimeRange()
+		if familyQueryTimeRange.Overlap(timeRange) {
+			result = append(result, family)
+		}
+	}
+	return result
+}
+
+// GetAllDataFamilies returns all data families of segment.
+func (s *segment) GetAllDataFamilies() []DataFamily {
+	var result []DataFamily
 	familyNames := s.kvStore.ListFamilyNames()
 
 	for _, familyName := range familyNames {
@@ -122,11 +147,7 @@ func (s *segment) GetDataFamilies(timeRange timeutil.TimeRange) []DataFamily {
 			// TODO: add metric
 			continue
 		}
-		family := s.getOrLoadFamily(familyName, familyTime)
-		timeRange := family.TimeRange()
-		if familyQueryTimeRange.Overlap(timeRange) {
-			result = append(result, family)
-		}
+		result = append(result, s.getOrLoadFamily(familyName, familyTime))
 	}
 	return result
 }
@@ -187,8 +208,72 @@ func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	return dataFamily, nil
 }
 
+// GetState returns the state of segment, includes on-disk files of each data family.
+func (s *segment) GetState() models.SegmentState {
+	calc := s.interval.Calculator()
+	state := models.SegmentState{
+		Interval:  s.interval.String(),
+		TimeRange: timeutil.TimeRange{Start: s.baseTime, End: s.baseTime},
+	}
+	var familyTimes []int
+	for _, familyName := range s.kvStore.ListFamilyNames() {
+		familyTime, err := strconv.Atoi(familyName)
+		if err != nil {
+			continue
+		}
+		familyTimes = append(familyTimes, familyTime)
+	}
+	sort.Ints(familyTimes)
+	for _, familyTime := range familyTimes {
+		familyName := strconv.Itoa(familyTime)
+		family := s.kvStore.GetFamily(familyName)
+		if family == nil {
+			continue
+		}
+		familyStartTime := calc.CalcFamilyStartTime(s.baseTime, familyTime)
+		familyTimeRange := timeutil.TimeRange{
+			Start: familyStartTime,
+			End:   calc.CalcFamilyEndTime(familyStartTime),
+		}
+		var familyState models.FamilyFilesState
+		if s.manifest != nil {
+			// build files state with series count/slot range, then record it into manifest
+			recorded, _ := s.manifest.GetFamily(state.Interval, s.name, familyName)
+			familyState = buildFamilyFilesState(family, familyName, familyTimeRange, recorded.Files)
+			s.manifest.UpdateFamily(state.Interval, s.name, s.baseTime, familyState)
+		} else {
+			familyState = models.FamilyFilesState{
+				Family:    familyName,
+				TimeRange: familyTimeRange,
+			}
+			snapshot := family.GetSnapshot()
+			for _, file := range snapshot.GetCurrent().GetAllFiles() {
+				familyState.NumOfFiles++
+				familyState.Size += int64(file.GetFileSize())
+			}
+			snapshot.Close()
+		}
+		if familyState.TimeRange.End > state.TimeRange.End {
+			state.TimeRange.End = familyState.TimeRange.End
+		}
+		state.Families = append(state.Families, familyState)
+	}
+	return state
+}
+
 // Close closes segment, include kv store.
 func (s *segment) Close() {
+	s.close(nil)
+}
+
+// Drop closes segment, then drops segment data by drop function after all readers of data families released.
+func (s *segment) Drop(dropFn func()) {
+	s.close(dropFn)
+}
+
+// close closes all data families, then closes kv store and invokes drop function after all readers released,
+// because the files of kv store cannot be unmapped when queries hold the readers.
+func (s *segment) close(dropFn func()) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -197,9 +282,26 @@ func (s *segment) Close() {
 			s.logger.Error("close family err", logger.String("family", family.Indicator()))
 		}
 	}
-	if err := kv.GetStoreManager().CloseStore(s.kvStore.Name()); err != nil {
-		s.logger.Error("close kv store error", logger.Error(err))
+	// close kv store after the last data family released(includes current goroutine)
+	pending := atomic.NewInt32(int32(len(s.families) + 1))
+	release := func() {
+		if pending.Dec() > 0 {
+			return
+		}
+		if err := kv.GetStoreManager().CloseStore(s.kvStore.Name()); err != nil {
+			s.logger.Error("close kv store error", logger.Error(err))
+		}
+		if dropFn != nil {
+			dropFn()
+		}
+	}
+	for _, family := range s.families {
+		if family.AfterReadersReleased(release) {
+			s.logger.Info("defer closing data family until readers released",
+				logger.String("family", family.Indicator()))
+		}
 	}
+	release()
 	// clear family cache
 	s.families = make(map[int]DataFamily)
 }
@@ -0,0 +1,297 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// segmentManifestFile represents the file name of segment manifest under shard dir.
+const segmentManifestFile = "SEGMENT_MANIFEST"
+
+// segmentManifestData represents the persisted data of segment manifest.
+type segmentManifestData struct {
+	// Complete represents if all segments of shard are recorded in manifest.
+	Complete bool                  `toml:"complete"`
+	Segments []models.SegmentState `toml:"segments"`
+}
+
+// segmentManifest keeps the files state(family, file, series count, slot range, size) of all segments of shard,
+// it is updated after the version of data family changed and persisted alongside kv versions, so that:
+// 1. query can prune the files of data family before acquiring snapshot;
+// 2. show segments is served without scanning directories.
+type segmentManifest struct {
+	path     string
+	complete bool
+	segments map[string]*models.SegmentState // interval/segment name => segment state
+
+	mutex  sync.RWMutex
+	logger *logger.Logger
+}
+
+// newSegmentManifest creates segment manifest, loads it from file if exist.
+func newSegmentManifest(path string) *segmentManifest {
+	m := &segmentManifest{
+		path:     path,
+		segments: make(map[string]*models.SegmentState),
+		logger:   logger.GetLogger("TSDB", "SegmentManifest"),
+	}
+	if !fileExist(path) {
+		return m
+	}
+	data := &segmentManifestData{}
+	if err := decodeToml(path, data); err != nil {
+		// rebuild manifest if it is corrupted
+		m.logger.Warn("load segment manifest failure, rebuild it",
+			logger.String("path", path), logger.Error(err))
+		return m
+	}
+	m.complete = data.Complete
+	for idx := range data.Segments {
+		segment := data.Segments[idx]
+		m.segments[segmentManifestKey(segment.Interval, segment.Name)] = &segment
+	}
+	return m
+}
+
+// IsComplete returns if all segments of shard are recorded in manifest.
+func (m *segmentManifest) IsComplete() bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.complete
+}
+
+// MarkComplete marks all segments of shard are recorded in manifest, then persists it.
+func (m *segmentManifest) MarkComplete() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.complete = true
+	m.persist()
+}
+
+// GetFamily returns the files state of data family, returns false if not recorded.
+func (m *segmentManifest) GetFamily(interval, segmentName, familyName string) (models.FamilyFilesState, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.getFamily(interval, segmentName, familyName)
+}
+
+// UpdateFamily updates the files state of data family, then persists manifest.
+func (m *segmentManifest) UpdateFamily(interval, segmentName string, segmentTime int64, family models.FamilyFilesState) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := segmentManifestKey(interval, segmentName)
+	segment, ok := m.segments[key]
+	if !ok {
+		segment = &models.SegmentState{
+			Interval:  interval,
+			Name:      segmentName,
+			TimeRange: timeutil.TimeRange{Start: segmentTime, End: segmentTime},
+		}
+		m.segments[key] = segment
+	}
+	replaced := false
+	for idx := range segment.Families {
+		if segment.Families[idx].Family == family.Family {
+			segment.Families[idx] = family
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		segment.Families = append(segment.Families, family)
+		sort.Slice(segment.Families, func(i, j int) bool {
+			return segment.Families[i].TimeRange.Start < segment.Families[j].TimeRange.Start
+		})
+	}
+	if family.TimeRange.End > segment.TimeRange.End {
+		segment.TimeRange.End = family.TimeRange.End
+	}
+	m.persist()
+}
+
+// MayContain checks if data family may contain the data of metric in slot range,
+// returns true if data family not recorded in manifest.
+func (m *segmentManifest) MayContain(interval, segmentName, familyName string, metricKey uint32, slotRange timeutil.SlotRange) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	family, ok := m.getFamily(interval, segmentName, familyName)
+	if !ok {
+		return true
+	}
+	for idx := range family.Files {
+		if family.Files[idx].MayContain(metricKey, slotRange) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSegmentStates returns the states of all segments recorded in manifest, sorted by interval/segment time.
+func (m *segmentManifest) GetSegmentStates() []models.SegmentState {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.getSegmentStates()
+}
+
+// Retain removes the segments which segment dir not exist(segment dropped by ttl/expire), then persists manifest.
+func (m *segmentManifest) Retain(segmentRootPath string, intervals map[string]timeutil.Interval) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	removed := false
+	for key, segment := range m.segments {
+		interval, ok := intervals[segment.Interval]
+		if ok && fileExist(filepath.Join(segmentRootPath, interval.Type().String(), segment.Name)) {
+			continue
+		}
+		delete(m.segments, key)
+		removed = true
+	}
+	if removed {
+		m.persist()
+	}
+}
+
+// getFamily returns the files state of data family, must hold the lock.
+func (m *segmentManifest) getFamily(interval, segmentName, familyName string) (models.FamilyFilesState, bool) {
+	segment, ok := m.segments[segmentManifestKey(interval, segmentName)]
+	if !ok {
+		return models.FamilyFilesState{}, false
+	}
+	for idx := range segment.Families {
+		if segment.Families[idx].Family == familyName {
+			return segment.Families[idx], true
+		}
+	}
+	return models.FamilyFilesState{}, false
+}
+
+// getSegmentStates returns the states of all segments, must hold the lock.
+func (m *segmentManifest) getSegmentStates() []models.SegmentState {
+	rs := make([]models.SegmentState, 0, len(m.segments))
+	for _, segment := range m.segments {
+		state := *segment
+		state.Families = append([]models.FamilyFilesState(nil), segment.Families...)
+		rs = append(rs, state)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Interval != rs[j].Interval {
+			return rs[i].Interval < rs[j].Interval
+		}
+		return rs[i].TimeRange.Start < rs[j].TimeRange.Start
+	})
+	return rs
+}
+
+// persist writes manifest into file, must hold the lock.
+func (m *segmentManifest) persist() {
+	data := &segmentManifestData{
+		Complete: m.complete,
+		Segments: m.getSegmentStates(),
+	}
+	if err := encodeToml(m.path, data); err != nil {
+		// manifest is rebuilt if it is stale, so just log the error
+		m.logger.Warn("persist segment manifest failure",
+			logger.String("path", m.path), logger.Error(err))
+	}
+}
+
+// segmentManifestKey returns the key of segment in manifest.
+func segmentManifestKey(interval, segmentName string) string {
+	return interval + "/" + segmentName
+}
+
+// buildFamilyFilesState builds the files state of data family based on current version of kv family,
+// the files state recorded before is reused, reads the series count/slot range of new files from metric data.
+func buildFamilyFilesState(
+	family kv.Family,
+	familyName string,
+	timeRange timeutil.TimeRange,
+	recorded []models.FileState,
+) models.FamilyFilesState {
+	state := models.FamilyFilesState{
+		Family:    familyName,
+		TimeRange: timeRange,
+	}
+	recordedFiles := make(map[int64]models.FileState, len(recorded))
+	for _, file := range recorded {
+		recordedFiles[file.File] = file
+	}
+	snapshot := family.GetSnapshot()
+	defer snapshot.Close()
+
+	for _, file := range snapshot.GetCurrent().GetAllFiles() {
+		fileNumber := file.GetFileNumber().Int64()
+		fileState, ok := recordedFiles[fileNumber]
+		if !ok {
+			fileState = models.FileState{
+				File:   fileNumber,
+				MinKey: file.GetMinKey(),
+				MaxKey: file.GetMaxKey(),
+				Size:   int64(file.GetFileSize()),
+				// keep whole slot range if the metric data cannot be read, avoid pruning the file
+				SlotRange: timeutil.SlotRange{Start: 0, End: ^uint16(0)},
+			}
+			if reader, err := snapshot.GetReader(file.GetFileNumber()); err == nil {
+				if slotRange, numOfSeries, ok := readFileStats(reader); ok {
+					fileState.SlotRange = slotRange
+					fileState.NumOfSeries = numOfSeries
+				}
+			}
+		}
+		state.NumOfFiles++
+		state.Size += fileState.Size
+		state.Files = append(state.Files, fileState)
+	}
+	return state
+}
+
+// readFileStats reads the slot range/series count of all metrics in file, returns false if any metric data is invalid.
+func readFileStats(reader table.Reader) (slotRange timeutil.SlotRange, numOfSeries uint64, ok bool) {
+	itr := reader.Iterator()
+	first := true
+	for itr.HasNext() {
+		metricReader, err := newReaderFunc(reader.Path(), itr.Value(), nil)
+		if err != nil {
+			return slotRange, 0, false
+		}
+		if first {
+			slotRange = metricReader.GetTimeRange()
+			first = false
+		} else {
+			slotRange = slotRange.Union(metricReader.GetTimeRange())
+		}
+		numOfSeries += metricReader.GetSeriesIDs().GetCardinality()
+	}
+	return slotRange, numOfSeries, !first
+}
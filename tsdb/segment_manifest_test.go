@@ -0,0 +1,191 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+func TestSegmentManifest_UpdateFamily(t *testing.T) {
+	path := filepath.Join(t.TempDir(), segmentManifestFile)
+	m := newSegmentManifest(path)
+	assert.False(t, m.IsComplete())
+	assert.Empty(t, m.GetSegmentStates())
+	// family not recorded, cannot be pruned
+	assert.True(t, m.MayContain("10s", "20190904", "12", 10, timeutil.SlotRange{Start: 0, End: 10}))
+
+	m.UpdateFamily("10s", "20190904", 1000, models.FamilyFilesState{
+		Family:    "12",
+		TimeRange: timeutil.TimeRange{Start: 2000, End: 3000},
+		Files: []models.FileState{
+			{File: 1, MinKey: 10, MaxKey: 20, SlotRange: timeutil.SlotRange{Start: 5, End: 10}},
+		},
+	})
+	m.UpdateFamily("10s", "20190904", 1000, models.FamilyFilesState{
+		Family:    "2",
+		TimeRange: timeutil.TimeRange{Start: 1000, End: 1500},
+	})
+	assert.True(t, m.MayContain("10s", "20190904", "12", 10, timeutil.SlotRange{Start: 0, End: 5}))
+	assert.False(t, m.MayContain("10s", "20190904", "12", 30, timeutil.SlotRange{Start: 0, End: 5}))
+	assert.False(t, m.MayContain("10s", "20190904", "12", 10, timeutil.SlotRange{Start: 11, End: 20}))
+	assert.False(t, m.MayContain("10s", "20190904", "2", 10, timeutil.SlotRange{Start: 0, End: 5}))
+
+	// replace family state
+	m.UpdateFamily("10s", "20190904", 1000, models.FamilyFilesState{
+		Family:    "12",
+		TimeRange: timeutil.TimeRange{Start: 2000, End: 3000},
+		Files: []models.FileState{
+			{File: 2, MinKey: 10, MaxKey: 40, SlotRange: timeutil.SlotRange{Start: 5, End: 10}},
+		},
+	})
+	assert.True(t, m.MayContain("10s", "20190904", "12", 30, timeutil.SlotRange{Start: 0, End: 5}))
+	m.UpdateFamily("5m", "201909", 100, models.FamilyFilesState{Family: "4"})
+	m.MarkComplete()
+
+	// load from file
+	m = newSegmentManifest(path)
+	assert.True(t, m.IsComplete())
+	states := m.GetSegmentStates()
+	assert.Len(t, states, 2)
+	assert.Equal(t, "10s", states[0].Interval)
+	assert.Equal(t, "20190904", states[0].Name)
+	assert.Equal(t, timeutil.TimeRange{Start: 1000, End: 3000}, states[0].TimeRange)
+	assert.Equal(t, "2", states[0].Families[0].Family)
+	assert.Equal(t, "12", states[0].Families[1].Family)
+	assert.Equal(t, "5m", states[1].Interval)
+	family, ok := m.GetFamily("10s", "20190904", "12")
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), family.Files[0].File)
+	_, ok = m.GetFamily("10s", "20190904", "13")
+	assert.False(t, ok)
+}
+
+func TestSegmentManifest_Load_Persist_Failure(t *testing.T) {
+	defer func() {
+		encodeToml = ltoml.EncodeToml
+	}()
+	path := filepath.Join(t.TempDir(), segmentManifestFile)
+	assert.NoError(t, os.WriteFile(path, []byte("abc"), 0644))
+	m := newSegmentManifest(path)
+	assert.False(t, m.IsComplete())
+
+	encodeToml = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	m.MarkComplete()
+	assert.True(t, m.IsComplete())
+}
+
+func TestSegmentManifest_Retain(t *testing.T) {
+	root := t.TempDir()
+	m := newSegmentManifest(filepath.Join(root, segmentManifestFile))
+	m.UpdateFamily("10s", "20190904", 1000, models.FamilyFilesState{Family: "12"})
+	m.UpdateFamily("10s", "20190905", 2000, models.FamilyFilesState{Family: "12"})
+	m.UpdateFamily("5m", "201909", 100, models.FamilyFilesState{Family: "4"})
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "day", "20190905"), 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "month", "201909"), 0755))
+
+	m.Retain(root, map[string]timeutil.Interval{
+		"10s": timeutil.Interval(10 * timeutil.OneSecond),
+	})
+	states := m.GetSegmentStates()
+	assert.Len(t, states, 1)
+	assert.Equal(t, "20190905", states[0].Name)
+	// nothing removed
+	m.Retain(root, map[string]timeutil.Interval{
+		"10s": timeutil.Interval(10 * timeutil.OneSecond),
+	})
+	assert.Len(t, m.GetSegmentStates(), 1)
+}
+
+func TestSegmentManifest_buildFamilyFilesState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newReaderFunc = metricsdata.NewReader
+		ctrl.Finish()
+	}()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	reader := table.NewMockReader(ctrl)
+	itr := table.NewMockIterator(ctrl)
+	metricReader := metricsdata.NewMockMetricReader(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{
+		version.NewFileMeta(1, 10, 20, 100),
+		version.NewFileMeta(2, 5, 30, 50),
+		version.NewFileMeta(3, 5, 30, 50),
+	}).AnyTimes()
+	reader.EXPECT().Path().Return("path").AnyTimes()
+	newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
+		return metricReader, nil
+	}
+	timeRange := timeutil.TimeRange{Start: 1000, End: 2000}
+	recorded := []models.FileState{{File: 1, MinKey: 10, MaxKey: 20, NumOfSeries: 10, Size: 100}}
+
+	// file 2: read stats successfully, file 3: get reader failure
+	snapshot.EXPECT().GetReader(table.FileNumber(2)).Return(reader, nil)
+	snapshot.EXPECT().GetReader(table.FileNumber(3)).Return(nil, fmt.Errorf("err"))
+	itr.EXPECT().HasNext().Return(true).Times(2)
+	itr.EXPECT().HasNext().Return(false)
+	itr.EXPECT().Value().Return([]byte{1}).Times(2)
+	reader.EXPECT().Iterator().Return(itr)
+	metricReader.EXPECT().GetTimeRange().Return(timeutil.SlotRange{Start: 5, End: 10})
+	metricReader.EXPECT().GetTimeRange().Return(timeutil.SlotRange{Start: 1, End: 8})
+	metricReader.EXPECT().GetSeriesIDs().Return(roaring.BitmapOf(1, 2, 3)).Times(2)
+	state := buildFamilyFilesState(family, "12", timeRange, recorded)
+	assert.Equal(t, "12", state.Family)
+	assert.Equal(t, timeRange, state.TimeRange)
+	assert.Equal(t, 3, state.NumOfFiles)
+	assert.Equal(t, int64(200), state.Size)
+	assert.Equal(t, recorded[0], state.Files[0])
+	assert.Equal(t, timeutil.SlotRange{Start: 1, End: 10}, state.Files[1].SlotRange)
+	assert.Equal(t, uint64(6), state.Files[1].NumOfSeries)
+	assert.Equal(t, timeutil.SlotRange{Start: 0, End: ^uint16(0)}, state.Files[2].SlotRange)
+	assert.Equal(t, uint64(16), state.NumOfSeries())
+
+	// read metric data failure, keep whole slot range
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil).Times(2)
+	itr.EXPECT().HasNext().Return(true).Times(2)
+	itr.EXPECT().Value().Return([]byte{1}).Times(2)
+	reader.EXPECT().Iterator().Return(itr).Times(2)
+	newReaderFunc = func(path string, metricBlock []byte, _ metricsdata.BlockPrefetcher) (metricsdata.MetricReader, error) {
+		return nil, fmt.Errorf("err")
+	}
+	state = buildFamilyFilesState(family, "12", timeRange, recorded)
+	assert.Equal(t, timeutil.SlotRange{Start: 0, End: ^uint16(0)}, state.Files[1].SlotRange)
+	assert.Equal(t, uint64(0), state.Files[1].NumOfSeries)
+}
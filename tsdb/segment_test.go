@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
@@ -49,6 +50,7 @@ func TestSegment_New(t *testing.T) {
 	shard.EXPECT().Database().Return(database).AnyTimes()
 	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
 	shard.EXPECT().CurrentInterval().Return(interval).AnyTimes()
+	shard.EXPECT().segmentManifest().Return(nil).AnyTimes()
 	segmentName := "20190904"
 	cases := []struct {
 		name        string
@@ -333,6 +335,10 @@ func TestSegment_Close(t *testing.T) {
 				gomock.InOrder(
 					family.EXPECT().Close().Return(fmt.Errorf("err")),
 					family.EXPECT().Indicator().Return("family"),
+					family.EXPECT().AfterReadersReleased(gomock.Any()).DoAndReturn(func(fn func()) bool {
+						fn()
+						return false
+					}),
 					store.EXPECT().Name().Return("test"),
 					storeMgr.EXPECT().CloseStore(gomock.Any()).Return(fmt.Errorf("err")),
 				)
@@ -352,9 +358,79 @@ func TestSegment_Close(t *testing.T) {
 	}
 }
 
+func TestSegment_Drop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		kv.InitStoreManager(nil)
+		ctrl.Finish()
+	}()
+
+	storeMgr := kv.NewMockStoreManager(ctrl)
+	kv.InitStoreManager(storeMgr)
+	store := kv.NewMockStore(ctrl)
+	family := NewMockDataFamily(ctrl)
+	seg := &segment{
+		kvStore:  store,
+		families: map[int]DataFamily{1: family},
+		logger:   logger.GetLogger("TSDB", "Test"),
+	}
+	var release func()
+	family.EXPECT().Close().Return(nil)
+	family.EXPECT().AfterReadersReleased(gomock.Any()).DoAndReturn(func(fn func()) bool {
+		release = fn
+		return true
+	})
+	family.EXPECT().Indicator().Return("family")
+	dropped := false
+	seg.Drop(func() {
+		dropped = true
+	})
+	assert.False(t, dropped)
+	assert.Empty(t, seg.families)
+
+	store.EXPECT().Name().Return("test")
+	storeMgr.EXPECT().CloseStore(gomock.Any()).Return(nil)
+	release()
+	assert.True(t, dropped)
+}
+
 func TestSegment_NeedEvict(t *testing.T) {
 	interval := timeutil.Interval(10 * 1000)
 	s := &segment{interval: interval}
 	assert.True(t, s.NeedEvict())
 	s.EvictFamily(timeutil.Now())
 }
+
+func TestSegment_GetState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	s := &segment{
+		kvStore:  store,
+		interval: timeutil.Interval(10 * 1000),
+		baseTime: 1000,
+	}
+	store.EXPECT().ListFamilyNames().Return([]string{"12", "abc", "2", "3"})
+	store.EXPECT().GetFamily("2").Return(family)
+	store.EXPECT().GetFamily("3").Return(nil)
+	store.EXPECT().GetFamily("12").Return(family)
+	family.EXPECT().GetSnapshot().Return(snapshot).Times(2)
+	snapshot.EXPECT().GetCurrent().Return(v).Times(2)
+	snapshot.EXPECT().Close().Times(2)
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{
+		version.NewFileMeta(1, 0, 0, 100),
+		version.NewFileMeta(2, 0, 0, 50),
+	}).Times(2)
+	state := s.GetState()
+	assert.Equal(t, "10s", state.Interval)
+	assert.Len(t, state.Families, 2)
+	assert.Equal(t, "2", state.Families[0].Family)
+	assert.Equal(t, "12", state.Families[1].Family)
+	assert.Equal(t, 2, state.Families[0].NumOfFiles)
+	assert.Equal(t, int64(150), state.Families[0].Size)
+	assert.Equal(t, state.Families[1].TimeRange.End, state.TimeRange.End)
+}
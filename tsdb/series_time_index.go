@@ -0,0 +1,228 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/memdb"
+)
+
+// seriesSlot represents the written slot of series.
+type seriesSlot struct {
+	metricID metric.ID
+	seriesID uint32
+	slot     uint16
+}
+
+// maxTimeBuckets is the max number of time buckets of metric, bounds the memory of series time index.
+const maxTimeBuckets = 32
+
+// seriesTimeEntry represents the slot range and series ids of metric in one sst file.
+type seriesTimeEntry struct {
+	slotRange timeutil.SlotRange
+	seriesIDs *roaring.Bitmap
+}
+
+// timeBuckets represents series ids of metric in each time bucket, each bucket covers 1<<shift time slots,
+// memory of index is bounded by number of buckets and compressed by roaring(series ids are dense).
+type timeBuckets struct {
+	buckets [maxTimeBuckets]*roaring.Bitmap
+}
+
+// add adds series ids into the buckets which slot range covers.
+func (b *timeBuckets) add(shift uint16, slotRange timeutil.SlotRange, seriesIDs *roaring.Bitmap) {
+	for i := bucketOf(shift, slotRange.Start); i <= bucketOf(shift, slotRange.End); i++ {
+		if b.buckets[i] == nil {
+			b.buckets[i] = roaring.New()
+		}
+		b.buckets[i].Or(seriesIDs)
+	}
+}
+
+// write records series's slot.
+func (b *timeBuckets) write(shift uint16, seriesID uint32, slot uint16) {
+	i := bucketOf(shift, slot)
+	if b.buckets[i] == nil {
+		b.buckets[i] = roaring.New()
+	}
+	b.buckets[i].Add(seriesID)
+}
+
+// overlap checks if any series has data in the buckets which query slot range covers.
+func (b *timeBuckets) overlap(shift uint16, seriesIDs *roaring.Bitmap, slotRange timeutil.SlotRange) bool {
+	for i := bucketOf(shift, slotRange.Start); i <= bucketOf(shift, slotRange.End); i++ {
+		bucket := b.buckets[i]
+		if bucket == nil || bucket.IsEmpty() {
+			continue
+		}
+		if seriesIDs == nil || bucket.Intersects(seriesIDs) {
+			return true
+		}
+	}
+	return false
+}
+
+// optimize compresses the series ids of each bucket.
+func (b *timeBuckets) optimize() {
+	for _, bucket := range b.buckets {
+		if bucket != nil {
+			bucket.RunOptimize()
+		}
+	}
+}
+
+// bucketOf returns the time bucket of slot, the slot out of buckets is put into the last bucket.
+func bucketOf(shift, slot uint16) int {
+	i := int(slot >> shift)
+	if i >= maxTimeBuckets {
+		return maxTimeBuckets - 1
+	}
+	return i
+}
+
+// seriesTimeIndex maintains time buckets of series for each metric under data family,
+// query can skip memory database/sst files/data family which has no data in query range.
+type seriesTimeIndex struct {
+	shift uint16 // each time bucket covers 1<<shift time slots
+	// memory part, builds when writing rows, removes after memory database flushed.
+	memory map[memdb.MemoryDatabase]map[metric.ID]*timeBuckets
+	// file part, builds lazily when reading sst files(series ids of all files are merged into buckets),
+	// rebuilds if version of family changed(flush/compact/rollup).
+	files       map[metric.ID]*timeBuckets
+	fileVersion int64
+
+	lock sync.RWMutex
+}
+
+// newSeriesTimeIndex creates a series time index for family with number of time slots.
+func newSeriesTimeIndex(numOfSlots int) *seriesTimeIndex {
+	shift := uint16(0)
+	for (numOfSlots-1)>>shift >= maxTimeBuckets {
+		shift++
+	}
+	return &seriesTimeIndex{
+		shift:  shift,
+		memory: make(map[memdb.MemoryDatabase]map[metric.ID]*timeBuckets),
+		files:  make(map[metric.ID]*timeBuckets),
+	}
+}
+
+// Write records series's slots of rows written into memory database in batch.
+func (idx *seriesTimeIndex) Write(db memdb.MemoryDatabase, slots []seriesSlot) {
+	if len(slots) == 0 {
+		return
+	}
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	metrics, ok := idx.memory[db]
+	if !ok {
+		metrics = make(map[metric.ID]*timeBuckets)
+		idx.memory[db] = metrics
+	}
+	for _, s := range slots {
+		entry, ok := metrics[s.metricID]
+		if !ok {
+			entry = &timeBuckets{}
+			metrics[s.metricID] = entry
+		}
+		entry.write(idx.shift, s.seriesID, s.slot)
+	}
+}
+
+// Release removes the memory part of memory database after it flushed.
+func (idx *seriesTimeIndex) Release(db memdb.MemoryDatabase) {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	delete(idx.memory, db)
+}
+
+// SetFile sets series time range of metric in sst files under family version,
+// series ids of files are merged into time buckets, so that index doesn't reference the data of files.
+// If version changed, cleanups all file part entries.
+func (idx *seriesTimeIndex) SetFile(version int64, metricID metric.ID, entries []*seriesTimeEntry) {
+	buckets := &timeBuckets{}
+	for _, entry := range entries {
+		if !entry.seriesIDs.IsEmpty() {
+			buckets.add(idx.shift, entry.slotRange, entry.seriesIDs)
+		}
+	}
+	buckets.optimize()
+
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	if version != idx.fileVersion {
+		idx.files = make(map[metric.ID]*timeBuckets)
+		idx.fileVersion = version
+	}
+	idx.files[metricID] = buckets
+}
+
+// MemoryMayContain checks if memory database may have data of series in query slot range,
+// returns true if no row written into memory database is recorded.
+func (idx *seriesTimeIndex) MemoryMayContain(db memdb.MemoryDatabase, metricID metric.ID,
+	seriesIDs *roaring.Bitmap, slotRange timeutil.SlotRange,
+) bool {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	metrics, ok := idx.memory[db]
+	if !ok {
+		return true
+	}
+	entry, ok := metrics[metricID]
+	return ok && entry.overlap(idx.shift, seriesIDs, slotRange)
+}
+
+// MetricMayContain checks if any memory database may have data of metric in query slot range.
+func (idx *seriesTimeIndex) MetricMayContain(metricID metric.ID, slotRange timeutil.SlotRange) bool {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	for _, metrics := range idx.memory {
+		if entry, ok := metrics[metricID]; ok && entry.overlap(idx.shift, nil, slotRange) {
+			return true
+		}
+	}
+	return false
+}
+
+// FileMayContain checks if sst files may have data of series in query slot range,
+// returns true if file part of metric is unknown under given family version.
+func (idx *seriesTimeIndex) FileMayContain(version int64, metricID metric.ID,
+	seriesIDs *roaring.Bitmap, slotRange timeutil.SlotRange,
+) bool {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	if version != idx.fileVersion {
+		return true
+	}
+	entry, ok := idx.files[metricID]
+	if !ok {
+		return true
+	}
+	return entry.overlap(idx.shift, seriesIDs, slotRange)
+}
@@ -0,0 +1,145 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/memdb"
+)
+
+func TestSeriesTimeIndex_FileMayContain(t *testing.T) {
+	idx := newSeriesTimeIndex(32)
+	// file part unknown
+	assert.True(t, idx.FileMayContain(1, 10, roaring.BitmapOf(1), timeutil.SlotRange{Start: 0, End: 10}))
+
+	idx.SetFile(1, 10, []*seriesTimeEntry{
+		{seriesIDs: roaring.BitmapOf(1, 2), slotRange: timeutil.SlotRange{Start: 5, End: 8}},
+		{seriesIDs: roaring.BitmapOf(5), slotRange: timeutil.SlotRange{Start: 20, End: 30}},
+	})
+	assert.True(t, idx.FileMayContain(1, 10, roaring.BitmapOf(1), timeutil.SlotRange{Start: 0, End: 5}))
+	assert.True(t, idx.FileMayContain(1, 10, nil, timeutil.SlotRange{Start: 8, End: 20}))
+	assert.True(t, idx.FileMayContain(1, 10, roaring.BitmapOf(5), timeutil.SlotRange{Start: 25, End: 40}))
+	// series not match
+	assert.False(t, idx.FileMayContain(1, 10, roaring.BitmapOf(3), timeutil.SlotRange{Start: 0, End: 10}))
+	// slot range not match
+	assert.False(t, idx.FileMayContain(1, 10, roaring.BitmapOf(1), timeutil.SlotRange{Start: 9, End: 10}))
+	// series in other file with other slot range
+	assert.False(t, idx.FileMayContain(1, 10, roaring.BitmapOf(5), timeutil.SlotRange{Start: 0, End: 10}))
+	// version changed
+	assert.True(t, idx.FileMayContain(2, 10, roaring.BitmapOf(3), timeutil.SlotRange{Start: 0, End: 10}))
+	// metric not found in file
+	idx.SetFile(2, 20, nil)
+	assert.False(t, idx.FileMayContain(2, 20, nil, timeutil.SlotRange{Start: 0, End: 10}))
+	// version changed, old entries cleanup
+	assert.True(t, idx.FileMayContain(2, 10, roaring.BitmapOf(3), timeutil.SlotRange{Start: 0, End: 10}))
+}
+
+func TestSeriesTimeIndex_MemoryMayContain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db1 := memdb.NewMockMemoryDatabase(ctrl)
+	db2 := memdb.NewMockMemoryDatabase(ctrl)
+	idx := newSeriesTimeIndex(32)
+	// no rows written
+	idx.Write(db1, nil)
+	assert.True(t, idx.MemoryMayContain(db1, 20, nil, timeutil.SlotRange{Start: 0, End: 10}))
+	assert.False(t, idx.MetricMayContain(20, timeutil.SlotRange{Start: 0, End: 10}))
+
+	idx.Write(db1, []seriesSlot{
+		{metricID: 20, seriesID: 3, slot: 9},
+		{metricID: 20, seriesID: 3, slot: 3},
+		{metricID: 20, seriesID: 4, slot: 20},
+	})
+	assert.True(t, idx.MemoryMayContain(db1, 20, nil, timeutil.SlotRange{Start: 8, End: 9}))
+	assert.False(t, idx.MemoryMayContain(db1, 20, nil, timeutil.SlotRange{Start: 5, End: 6}))
+	assert.True(t, idx.MemoryMayContain(db1, 20, roaring.BitmapOf(3), timeutil.SlotRange{Start: 0, End: 3}))
+	assert.True(t, idx.MemoryMayContain(db1, 20, roaring.BitmapOf(4, 5, 6), timeutil.SlotRange{Start: 15, End: 20}))
+	assert.False(t, idx.MemoryMayContain(db1, 20, roaring.BitmapOf(4), timeutil.SlotRange{Start: 0, End: 10}))
+	assert.False(t, idx.MemoryMayContain(db1, 20, roaring.BitmapOf(3, 5, 6), timeutil.SlotRange{Start: 15, End: 20}))
+	assert.False(t, idx.MemoryMayContain(db1, 20, nil, timeutil.SlotRange{Start: 21, End: 22}))
+	assert.False(t, idx.MemoryMayContain(db1, 30, nil, timeutil.SlotRange{Start: 0, End: 22}))
+	assert.True(t, idx.MetricMayContain(20, timeutil.SlotRange{Start: 0, End: 10}))
+	assert.False(t, idx.MetricMayContain(30, timeutil.SlotRange{Start: 0, End: 10}))
+
+	idx.Write(db2, []seriesSlot{{metricID: 30, seriesID: 3, slot: 9}})
+	assert.True(t, idx.MetricMayContain(30, timeutil.SlotRange{Start: 0, End: 10}))
+	// release after memory database flushed
+	idx.Release(db1)
+	assert.False(t, idx.MetricMayContain(20, timeutil.SlotRange{Start: 0, End: 10}))
+	assert.True(t, idx.MemoryMayContain(db1, 20, nil, timeutil.SlotRange{Start: 0, End: 10}))
+}
+
+func TestSeriesTimeIndex_TimeBuckets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := memdb.NewMockMemoryDatabase(ctrl)
+	// each bucket covers 16 slots
+	idx := newSeriesTimeIndex(360)
+	assert.Equal(t, uint16(4), idx.shift)
+	idx.Write(db, []seriesSlot{{metricID: 20, seriesID: 3, slot: 100}})
+	assert.True(t, idx.MemoryMayContain(db, 20, roaring.BitmapOf(3), timeutil.SlotRange{Start: 110, End: 111}))
+	assert.False(t, idx.MemoryMayContain(db, 20, roaring.BitmapOf(3), timeutil.SlotRange{Start: 112, End: 120}))
+	assert.False(t, idx.MemoryMayContain(db, 20, roaring.BitmapOf(4), timeutil.SlotRange{Start: 96, End: 111}))
+
+	// slot out of buckets put into last bucket
+	idx = newSeriesTimeIndex(0)
+	assert.Equal(t, uint16(0), idx.shift)
+	idx.SetFile(1, 10, []*seriesTimeEntry{
+		{seriesIDs: roaring.BitmapOf(1), slotRange: timeutil.SlotRange{Start: 100, End: 200}},
+		{seriesIDs: roaring.New(), slotRange: timeutil.SlotRange{Start: 0, End: 10}},
+	})
+	assert.True(t, idx.FileMayContain(1, 10, roaring.BitmapOf(1), timeutil.SlotRange{Start: 40, End: 40}))
+	assert.False(t, idx.FileMayContain(1, 10, nil, timeutil.SlotRange{Start: 0, End: 10}))
+}
+
+func BenchmarkSeriesTimeIndex_Memory(b *testing.B) {
+	const (
+		numOfSeries = 100000
+		numOfSlots  = 360
+	)
+	db := memdb.NewMockMemoryDatabase(gomock.NewController(b))
+	slots := make([]seriesSlot, 0, numOfSeries)
+	var before, after runtime.MemStats
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		idx := newSeriesTimeIndex(numOfSlots)
+		// each series written into each slot
+		for slot := 0; slot < numOfSlots; slot++ {
+			slots = slots[:0]
+			for seriesID := 0; seriesID < numOfSeries; seriesID++ {
+				slots = append(slots, seriesSlot{metricID: 1, seriesID: uint32(seriesID), slot: uint16(slot)})
+			}
+			idx.Write(db, slots)
+		}
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/numOfSeries, "bytes/series")
+		runtime.KeepAlive(idx)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"sync"
@@ -29,9 +30,11 @@ import (
 	commonconstants "github.com/lindb/common/constants"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -66,18 +69,41 @@ type Shard interface {
 	BufferManager() memdb.BufferManager
 	// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
 	LookupRowMetricMeta(rows []metric.StorageRow) error
+	// ResolveRowMetricMeta lookups the metadata of metric data for each row with same family in batch,
+	// rows of new series are staged, which are written into family after series id generated asynchronously,
+	// the sequence of leader is staged in family until staged rows written, then invokes written with the result
+	// (invokes written(true) before returning if no row staged), returns the rows need writing into family by caller.
+	ResolveRowMetricMeta(family DataFamily, leader int32, seq int64, rows []metric.StorageRow,
+		written func(success bool)) []metric.StorageRow
+	// SyncStagedRows waits until all rows staged before are written into family,
+	// then invokes fn, no staged row is written during invoking fn.
+	SyncStagedRows(fn func())
 	// FlushIndex flushes index data to disk.
 	FlushIndex() error
 	// WaitFlushIndexCompleted waits flush index job completed.
 	WaitFlushIndexCompleted()
 	// initIndexDatabase initializes index database
 	initIndexDatabase() error
-	// TTL expires the data of each segment base on time to live.
+	// TTL expires the data of each segment base on time to live, then purges the tombstones of metrics
+	// whose deleted series have no data in any family.
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// GetSegmentStates returns the states of segments of all intervals.
+	GetSegmentStates() ([]models.SegmentState, error)
+	// GetDiskUsage calculates the on-disk bytes of shard, includes data families/index/write ahead log.
+	GetDiskUsage() models.ShardDiskUsage
+	// ExpireOldestSegment drops the oldest segment of each interval before retention.
+	ExpireOldestSegment()
+	// RebuildIndex starts the job which rebuilds index of shard in background,
+	// returns ErrIndexRebuilding if other rebuild job is running.
+	RebuildIndex() error
+	// GetIndexRebuildState returns the state of the latest index rebuild job, return nil if never rebuild.
+	GetIndexRebuildState() *models.IndexRebuildState
 	// notifyLimitsChange notifies the limits changed.
 	notifyLimitsChange()
+	// segmentManifest returns the manifest which keeps the files state of all segments.
+	segmentManifest() *segmentManifest
 	// Closer releases shard's resource, such as flush data, spawned goroutines etc.
 	io.Closer
 }
@@ -90,6 +116,7 @@ type shard struct {
 	option    *option.DatabaseOption
 
 	bufferMgr memdb.BufferManager
+	stager    *rowStager // nil if staging disabled
 	indexDB   indexdb.IndexDatabase
 	metadata  metadb.Metadata
 	// write accept time range
@@ -97,9 +124,14 @@ type shard struct {
 	// segments keeps all rollup target interval segments,
 	// includes one smallest interval segment for writing data, and rollup interval segments
 	rollupTargets  map[timeutil.Interval]IntervalSegment
-	segment        IntervalSegment // smallest interval for writing data
-	isFlushing     atomic.Bool     // restrict flusher concurrency
-	flushCondition *sync.Cond      // flush condition
+	segment        IntervalSegment  // smallest interval for writing data
+	manifest       *segmentManifest // files state of all segments
+	isFlushing     atomic.Bool      // restrict flusher concurrency
+	flushCondition *sync.Cond       // flush condition
+
+	rebuilding   atomic.Bool               // restrict index rebuild concurrency
+	rebuildState *models.IndexRebuildState // state of the latest index rebuild job
+	rebuildMutex sync.Mutex
 
 	limits         *models.Limits // NOTE: limits only update in write goroutine
 	limitsChanged  atomic.Bool
@@ -131,6 +163,7 @@ func newShard(
 		metadata:       db.Metadata(),
 		bufferMgr:      memdb.NewBufferManager(shardTempBufferPath(db.Name(), shardID)),
 		rollupTargets:  make(map[timeutil.Interval]IntervalSegment),
+		manifest:       newSegmentManifest(filepath.Join(shardPath, segmentManifestFile)),
 		isFlushing:     *atomic.NewBool(false),
 		flushCondition: sync.NewCond(&sync.Mutex{}),
 		statistics:     metrics.NewShardStatistics(db.Name(), strconv.Itoa(int(shardID))),
@@ -175,6 +208,10 @@ func newShard(
 	}
 	// init datatbase limits
 	createdShard.limits = db.GetLimits()
+	if maxStagedRows := config.GlobalStorageConfig().TSDB.MaxStagedRows; maxStagedRows > 0 {
+		createdShard.stager = newRowStager(createdShard.indicator, maxStagedRows,
+			createdShard.lookupRowsMeta, createdShard.statistics)
+	}
 	return createdShard, nil
 }
 
@@ -192,6 +229,11 @@ func (s *shard) notifyLimitsChange() {
 	s.limitsChanged.Store(true)
 }
 
+// segmentManifest returns the manifest which keeps the files state of all segments.
+func (s *shard) segmentManifest() *segmentManifest {
+	return s.manifest
+}
+
 // CurrentInterval returns current interval for metric  write.
 func (s *shard) CurrentInterval() timeutil.Interval { return s.interval }
 
@@ -238,29 +280,34 @@ func (s *shard) GetDataFamilies(intervalType timeutil.IntervalType, timeRange ti
 	return nil
 }
 
-func (s *shard) lookupRowMeta(row *metric.StorageRow) (err error) {
-	limits := s.limits
-
+// getLimits returns the limits of database, reloads it if limits changed.
+// NOTE: modify limits in write goroutine
+func (s *shard) getLimits() *models.Limits {
 	if s.limitsChanged.Load() {
 		// get new limits from database
-		// NOTE: modify limits in write goroutine
 		s.limits = s.db.GetLimits()
-		limits = s.limits
 		s.limitsChanged.Store(false)
 	}
+	return s.limits
+}
 
-	namespace := commonconstants.DefaultNamespace
-	metricName := string(row.Name())
-
-	if len(row.NameSpace()) > 0 {
-		// TODO: add auto create ns check
-		namespace = string(row.NameSpace())
-	}
+// lookupRowMeta lookups the series id/field ids of row, metric id of row must be generated before,
+// returns the field keys buffer for reusing.
+func (s *shard) lookupRowMeta(
+	row *metric.StorageRow,
+	metricKey *metadb.MetricKey,
+	fieldKeys []metadb.FieldKey,
+	limits *models.Limits,
+) (_ []metadb.FieldKey, err error) {
+	if err = s.lookupSeriesID(row, metricKey, limits); err != nil {
+		return fieldKeys, err
+	}
+	return s.lookupFieldIDs(row, metricKey, fieldKeys, limits)
+}
 
-	row.MetricID, err = s.metadata.MetadataDatabase().GenMetricID(namespace, metricName, limits)
-	if err != nil {
-		return err
-	}
+// lookupSeriesID lookups the series id of row, generates new series id and builds inverted index if not exist.
+func (s *shard) lookupSeriesID(row *metric.StorageRow, metricKey *metadb.MetricKey, limits *models.Limits) (err error) {
+	namespace, metricName := metricKey.Namespace, metricKey.MetricName
 	var isCreated bool
 	if row.TagsLen() == 0 {
 		// if metric without tags, uses default series id(0)
@@ -281,84 +328,225 @@ func (s *shard) lookupRowMeta(row *metric.StorageRow) (err error) {
 			limits,
 		)
 	}
-	// set field id
+	return nil
+}
+
+// lookupFieldIDs lookups the field ids of row, series id of row must be set before,
+// returns the field keys buffer for reusing.
+func (s *shard) lookupFieldIDs(
+	row *metric.StorageRow,
+	metricKey *metadb.MetricKey,
+	fieldKeys []metadb.FieldKey,
+	limits *models.Limits,
+) (_ []metadb.FieldKey, err error) {
+	namespace, metricName := metricKey.Namespace, metricKey.MetricName
+	// set field ids in batch
+	fieldKeys = s.fieldKeysOfRow(row, fieldKeys)
+	// TODO: only ignore invalid field?
+	row.FieldIDs, err = s.metadata.MetadataDatabase().GenFieldIDs(namespace, metricName, fieldKeys, row.FieldIDs, limits)
+	if err != nil {
+		return fieldKeys, err
+	}
+	row.Writable = true
+	return fieldKeys, nil
+}
+
+// fieldKeysOfRow appends all field keys(simple fields/histogram fields) of row into dst.
+func (s *shard) fieldKeysOfRow(row *metric.StorageRow, dst []metadb.FieldKey) []metadb.FieldKey {
 	simpleFieldItr := row.NewSimpleFieldIterator()
-	var fieldID field.ID
 	for simpleFieldItr.HasNext() {
-		if fieldID, err = s.metadata.MetadataDatabase().GenFieldID(
-			namespace, metricName,
-			simpleFieldItr.NextName(),
-			simpleFieldItr.NextType(), limits); err != nil {
-			// TODO: only ignore invalid field?
-			return err
-		}
-		row.FieldIDs = append(row.FieldIDs, fieldID)
+		dst = append(dst, metadb.FieldKey{Name: simpleFieldItr.NextName(), Type: simpleFieldItr.NextType()})
 	}
 
 	compoundFieldItr, ok := row.NewCompoundFieldIterator()
 	if !ok {
-		goto Done
+		return dst
 	}
 	// min
 	if compoundFieldItr.Min() > 0 {
-		if fieldID, err = s.metadata.MetadataDatabase().GenFieldID(
-			namespace, metricName, compoundFieldItr.HistogramMinFieldName(), field.MinField, limits); err != nil {
-			return err
-		}
-		row.FieldIDs = append(row.FieldIDs, fieldID)
+		dst = append(dst, metadb.FieldKey{Name: compoundFieldItr.HistogramMinFieldName(), Type: field.MinField})
 	}
 	// max
 	if compoundFieldItr.Max() > 0 {
-		if fieldID, err = s.metadata.MetadataDatabase().GenFieldID(
-			namespace, metricName, compoundFieldItr.HistogramMaxFieldName(), field.MaxField, limits); err != nil {
-			return err
-		}
-		row.FieldIDs = append(row.FieldIDs, fieldID)
-	}
-	// sum
-	if fieldID, err = s.metadata.MetadataDatabase().GenFieldID(
-		namespace, metricName, compoundFieldItr.HistogramSumFieldName(), field.SumField, limits); err != nil {
-		return err
-	}
-	row.FieldIDs = append(row.FieldIDs, fieldID)
-	// count
-	if fieldID, err = s.metadata.MetadataDatabase().GenFieldID(
-		namespace, metricName, compoundFieldItr.HistogramCountFieldName(), field.SumField, limits); err != nil {
-		return err
+		dst = append(dst, metadb.FieldKey{Name: compoundFieldItr.HistogramMaxFieldName(), Type: field.MaxField})
 	}
-	row.FieldIDs = append(row.FieldIDs, fieldID)
+	// sum/count
+	dst = append(dst,
+		metadb.FieldKey{Name: compoundFieldItr.HistogramSumFieldName(), Type: field.SumField},
+		metadb.FieldKey{Name: compoundFieldItr.HistogramCountFieldName(), Type: field.SumField},
+	)
 	// explicit bounds
 	for compoundFieldItr.HasNextBucket() {
-		if fieldID, err = s.metadata.MetadataDatabase().GenFieldID(
-			namespace, metricName,
-			compoundFieldItr.BucketName(), field.HistogramField, limits); err != nil {
-			return err
+		dst = append(dst, metadb.FieldKey{Name: compoundFieldItr.BucketName(), Type: field.HistogramField})
+	}
+	// histogram sketch built from buckets, stored as one field,
+	// query falls back to buckets for the time slot without sketch(e.g. data written before enabled).
+	if s.option.HistogramSketch {
+		if row.Sketch == nil {
+			row.Sketch = ddsketch.NewSketch()
+		}
+		row.Sketch.Reset()
+		compoundFieldItr.Sketch(row.Sketch)
+		if !row.Sketch.IsEmpty() {
+			dst = append(dst, metadb.FieldKey{Name: compoundFieldItr.HistogramSketchFieldName(), Type: field.SketchField})
 		}
-		row.FieldIDs = append(row.FieldIDs, fieldID)
 	}
-
-Done:
-	row.Writable = true
-	return nil
+	return dst
 }
 
 // LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
 func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	s.lookupRowsMeta(rows, s.getLimits())
+	return nil
+}
+
+// lookupRowsMeta lookups the metadata of metric data for each row in batch, generates metadata if not exist.
+func (s *shard) lookupRowsMeta(rows []metric.StorageRow, limits *models.Limits) {
+	metricKeys, errs := s.genMetricIDs(rows, limits)
+
+	var fieldKeys []metadb.FieldKey
 	for idx := range rows {
-		if err := s.lookupRowMeta(&rows[idx]); err != nil {
-			s.statistics.LookupMetricMetaFailures.Incr()
-			s.logger.Error("failed to lookup meta of row",
-				logger.String("database", s.db.Name()),
-				logger.Any("shardID", s.id), logger.Error(err))
-			continue
+		err := errs[idx]
+		if err == nil {
+			fieldKeys, err = s.lookupRowMeta(&rows[idx], &metricKeys[idx], fieldKeys[:0], limits)
+		}
+		if err != nil {
+			s.lookupRowMetaFailure(err)
 		}
 	}
-	return nil
+}
+
+// ResolveRowMetricMeta lookups the metadata of metric data for each row with same family in batch,
+// rows of new series are staged, which are written into family after series id generated asynchronously,
+// the sequence of leader is staged in family until staged rows written, then invokes written with the result
+// (invokes written(true) before returning if no row staged), returns the rows need writing into family by caller.
+func (s *shard) ResolveRowMetricMeta(family DataFamily, leader int32, seq int64, rows []metric.StorageRow,
+	written func(success bool),
+) []metric.StorageRow {
+	if len(rows) == 0 {
+		written(true)
+		return rows
+	}
+	limits := s.getLimits()
+	if s.stager == nil {
+		s.lookupRowsMeta(rows, limits)
+		written(true)
+		return rows
+	}
+	sequence := newStagedSequence(family, leader, seq, written)
+	// releases the reference held by resolving after all rows staged
+	defer sequence.release(true)
+
+	metricKeys, errs := s.genMetricIDs(rows, limits)
+
+	var (
+		fieldKeys []metadb.FieldKey
+		staged    []metric.StorageRow
+		series    []stagedSeries
+		resolved  int
+	)
+	for idx := range rows {
+		row := &rows[idx]
+		err := errs[idx]
+		if err == nil {
+			if row.TagsLen() > 0 {
+				if s.stager.isPending(row.MetricID, row.TagsHash()) {
+					if s.stager.tryAcquire(1) {
+						// series has pending staged rows, stages row for keeping the write order of series
+						series = append(series, s.stager.markPending(row))
+						staged = append(staged, row.Clone())
+						continue
+					}
+					// staging buffer is full, waits until pending rows of series written
+					s.statistics.StageFullFallbacks.Incr()
+					s.stager.stage(family, sequence, staged, series, limits)
+					staged, series = nil, nil
+					s.stager.Sync(nil)
+				}
+				seriesID, ok := s.indexDB.GetSeriesID(row.MetricID, row.TagsHash())
+				switch {
+				case ok:
+					row.SeriesID = seriesID
+					fieldKeys, err = s.lookupFieldIDs(row, &metricKeys[idx], fieldKeys[:0], limits)
+				case s.stager.tryAcquire(1):
+					// new series, generates series id asynchronously
+					series = append(series, s.stager.markPending(row))
+					staged = append(staged, row.Clone())
+					continue
+				default:
+					// staging buffer is full, generates series id synchronously
+					s.statistics.StageFullFallbacks.Incr()
+					fieldKeys, err = s.lookupRowMeta(row, &metricKeys[idx], fieldKeys[:0], limits)
+				}
+			} else {
+				fieldKeys, err = s.lookupRowMeta(row, &metricKeys[idx], fieldKeys[:0], limits)
+			}
+		}
+		if err != nil {
+			s.lookupRowMetaFailure(err)
+		}
+		// swap row for keeping the buffer of each row
+		rows[resolved], rows[idx] = rows[idx], rows[resolved]
+		resolved++
+	}
+	s.stager.stage(family, sequence, staged, series, limits)
+	return rows[:resolved]
+}
+
+// SyncStagedRows waits until all rows staged before are written into family,
+// then invokes fn, no staged row is written during invoking fn.
+func (s *shard) SyncStagedRows(fn func()) {
+	if s.stager != nil {
+		s.stager.Sync(fn)
+		return
+	}
+	if fn != nil {
+		fn()
+	}
+}
+
+// genMetricIDs generates the metric ids of rows in batch, returns the metric keys and errors of each row.
+func (s *shard) genMetricIDs(rows []metric.StorageRow, limits *models.Limits) ([]metadb.MetricKey, []error) {
+	metricKeys := make([]metadb.MetricKey, len(rows))
+	metricIDs := make([]metric.ID, len(rows))
+	errs := make([]error, len(rows))
+	for idx := range rows {
+		row := &rows[idx]
+		metricKeys[idx].Namespace = commonconstants.DefaultNamespace
+		if len(row.NameSpace()) > 0 {
+			// TODO: add auto create ns check
+			metricKeys[idx].Namespace = string(row.NameSpace())
+		}
+		metricKeys[idx].MetricName = string(row.Name())
+	}
+	// generate metric ids in batch
+	s.metadata.MetadataDatabase().GenMetricIDs(metricKeys, metricIDs, errs, limits)
+	for idx := range rows {
+		if errs[idx] == nil {
+			rows[idx].MetricID = metricIDs[idx]
+		}
+	}
+	return metricKeys, errs
+}
+
+// lookupRowMetaFailure records the failure of lookup row metadata.
+func (s *shard) lookupRowMetaFailure(err error) {
+	s.statistics.LookupMetricMetaFailures.Incr()
+	s.logger.Error("failed to lookup meta of row",
+		logger.String("database", s.db.Name()),
+		logger.Any("shardID", s.id), logger.Error(err))
 }
 
 func (s *shard) Close() error {
 	// finally, cleanup temp buffer.
 	defer s.bufferMgr.Cleanup()
+	// write all staged rows before closing index/families
+	if s.stager != nil {
+		s.stager.Close()
+	}
 	// wait previous flush job completed
 	s.WaitFlushIndexCompleted()
 
@@ -422,7 +610,8 @@ func (s *shard) WaitFlushIndexCompleted() {
 	s.flushCondition.L.Unlock()
 }
 
-// TTL expires the data of each segment base on time to live.
+// TTL expires the data of each segment base on time to live, then purges the tombstones of metrics
+// whose deleted series have no data in any family.
 func (s *shard) TTL() {
 	for interval, rollupSegment := range s.rollupTargets {
 		if err := rollupSegment.TTL(); err != nil {
@@ -434,6 +623,8 @@ func (s *shard) TTL() {
 			)
 		}
 	}
+	s.retainSegmentManifest()
+	s.purgeTombstones()
 }
 
 // EvictSegment evicts segment which long term no read operation.
@@ -443,6 +634,73 @@ func (s *shard) EvictSegment() {
 	}
 }
 
+// GetSegmentStates returns the states of segments of all intervals,
+// served by segment manifest if all segments recorded, else scans segment dirs then records them into manifest.
+func (s *shard) GetSegmentStates() ([]models.SegmentState, error) {
+	if s.manifest.IsComplete() {
+		return s.manifest.GetSegmentStates(), nil
+	}
+	intervals := make([]timeutil.Interval, 0, len(s.rollupTargets))
+	for interval := range s.rollupTargets {
+		intervals = append(intervals, interval)
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i] < intervals[j]
+	})
+	var rs []models.SegmentState
+	for _, interval := range intervals {
+		states, err := s.rollupTargets[interval].GetSegmentStates()
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, states...)
+	}
+	// all segments are recorded into manifest after scanning
+	s.manifest.MarkComplete()
+	return rs, nil
+}
+
+// retainSegmentManifest removes the segments dropped by ttl/expire from segment manifest.
+func (s *shard) retainSegmentManifest() {
+	intervals := make(map[string]timeutil.Interval, len(s.rollupTargets))
+	for interval := range s.rollupTargets {
+		intervals[interval.String()] = interval
+	}
+	s.manifest.Retain(shardSegmentRootPath(s.db.Name(), s.id), intervals)
+}
+
+// GetDiskUsage calculates the on-disk bytes of shard, includes data families/index/write ahead log.
+func (s *shard) GetDiskUsage() models.ShardDiskUsage {
+	database := s.db.Name()
+	segmentRootPath := shardSegmentRootPath(database, s.id)
+	usage := models.ShardDiskUsage{
+		ShardID:  s.id,
+		Data:     calcDirSize(segmentRootPath),
+		Index:    calcDirSize(shardIndexPath(database, s.id)) + calcDirSize(shardMetaPath(database, s.id)),
+		WAL:      calcDirSize(shardWALPath(database, s.id)),
+		Families: calcFamiliesDiskUsage(segmentRootPath),
+	}
+	s.statistics.DataDiskUsage.Update(float64(usage.Data))
+	s.statistics.IndexDiskUsage.Update(float64(usage.Index))
+	s.statistics.WALDiskUsage.Update(float64(usage.WAL))
+	return usage
+}
+
+// ExpireOldestSegment drops the oldest segment of each interval before retention.
+func (s *shard) ExpireOldestSegment() {
+	for interval, rollupSegment := range s.rollupTargets {
+		if err := rollupSegment.ExpireOldestSegment(); err != nil {
+			s.logger.Warn("expire oldest segment failure",
+				logger.String("database", s.db.Name()),
+				logger.Any("shardID", s.id),
+				logger.String("segment", interval.Type().String()),
+				logger.Error(err),
+			)
+		}
+	}
+	s.retainSegmentManifest()
+}
+
 // initIndexDatabase initializes the index database
 func (s *shard) initIndexDatabase() error {
 	var err error
@@ -0,0 +1,240 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+	"github.com/lindb/lindb/tsdb/tblstore/tagindex"
+)
+
+// for testing
+var (
+	newFileFlusherFunc     = kv.NewFileFlusher
+	newInvertedFlusherFunc = tagindex.NewInvertedFlusher
+	rebuildInvertedFunc    = tagindex.RebuildInvertedIndex
+	collectMaxSeriesIDs    = metricsdata.CollectMaxSeriesIDs
+)
+
+// ErrIndexRebuilding represents the index of shard is rebuilding by other job.
+var ErrIndexRebuilding = errors.New("index is rebuilding")
+
+// RebuildIndex starts the job which rebuilds index of shard in background,
+// returns ErrIndexRebuilding if other rebuild job is running.
+//
+// The job includes:
+// 1. flushes memory index, so that all series of shard are persisted in forward index, no need to replay write ahead log;
+// 2. scans all data files, makes sure the series sequence of each metric is not less than the max series id of data;
+// 3. rebuilds inverted index from forward index, then replaces the files of inverted index online.
+//
+// NOTE: the id mapping(tags hash => series id) cannot be rebuilt from data files, because data files have no tags.
+func (s *shard) RebuildIndex() error {
+	if !s.rebuilding.CAS(false, true) {
+		return ErrIndexRebuilding
+	}
+	s.rebuildMutex.Lock()
+	s.rebuildState = &models.IndexRebuildState{
+		Database:  s.db.Name(),
+		ShardID:   s.id,
+		Status:    models.IndexRebuildRunning,
+		Phase:     models.IndexRebuildFlushMemory,
+		StartTime: timeutil.Now(),
+	}
+	s.rebuildMutex.Unlock()
+
+	go func() {
+		defer s.rebuilding.Store(false)
+		s.rebuildIndex()
+	}()
+	return nil
+}
+
+// GetIndexRebuildState returns the state of the latest index rebuild job, return nil if never rebuild.
+func (s *shard) GetIndexRebuildState() *models.IndexRebuildState {
+	s.rebuildMutex.Lock()
+	defer s.rebuildMutex.Unlock()
+
+	if s.rebuildState == nil {
+		return nil
+	}
+	state := *s.rebuildState
+	return &state
+}
+
+// rebuildIndex rebuilds index of shard, then updates the state of rebuild job.
+func (s *shard) rebuildIndex() {
+	err := s.doRebuildIndex()
+	s.updateRebuildState(func(state *models.IndexRebuildState) {
+		state.EndTime = timeutil.Now()
+		if err != nil {
+			state.Status = models.IndexRebuildFailed
+			state.ErrMsg = err.Error()
+			return
+		}
+		state.Status = models.IndexRebuildCompleted
+		state.Phase = models.IndexRebuildDone
+	})
+	if err != nil {
+		s.logger.Error("rebuild index of shard failure",
+			logger.String("database", s.db.Name()),
+			logger.Any("shardID", s.id), logger.Error(err))
+		return
+	}
+	s.logger.Info("rebuild index of shard successfully",
+		logger.String("database", s.db.Name()),
+		logger.Any("shardID", s.id))
+}
+
+func (s *shard) doRebuildIndex() error {
+	// 1. wait flush job completed, and forbid index flush during rebuilding
+	s.flushCondition.L.Lock()
+	for !s.isFlushing.CAS(false, true) {
+		s.flushCondition.Wait()
+	}
+	s.flushCondition.L.Unlock()
+	defer func() {
+		s.flushCondition.L.Lock()
+		s.isFlushing.Store(false)
+		s.flushCondition.L.Unlock()
+		s.flushCondition.Broadcast()
+	}()
+	if err := s.indexDB.Flush(); err != nil {
+		return err
+	}
+	// 2. fix series sequence of each metric based on data files
+	s.updateRebuildState(func(state *models.IndexRebuildState) {
+		state.Phase = models.IndexRebuildScanDataFiles
+	})
+	if err := s.ensureSeriesSequences(); err != nil {
+		return err
+	}
+	// 3. rebuild inverted index from forward index
+	s.updateRebuildState(func(state *models.IndexRebuildState) {
+		state.Phase = models.IndexRebuildInvertedIndex
+	})
+	return s.invertedFamily.ReplaceFiles(s.rebuildInvertedIndex)
+}
+
+// ensureSeriesSequences scans all data files of shard, makes sure the series sequence of each metric
+// is not less than the max series id of data files.
+func (s *shard) ensureSeriesSequences() error {
+	var families []DataFamily
+	for _, rollupSegment := range s.rollupTargets {
+		rs, err := rollupSegment.GetAllDataFamilies()
+		if err != nil {
+			return err
+		}
+		families = append(families, rs...)
+	}
+	snapshots := make([]version.Snapshot, 0, len(families))
+	defer func() {
+		for _, snapshot := range snapshots {
+			snapshot.Close()
+		}
+	}()
+	totalFiles := 0
+	for _, family := range families {
+		snapshot := family.Family().GetSnapshot()
+		snapshots = append(snapshots, snapshot)
+		totalFiles += len(snapshot.GetCurrent().GetAllFiles())
+	}
+	s.updateRebuildState(func(state *models.IndexRebuildState) {
+		state.TotalFiles = totalFiles
+	})
+
+	maxSeriesIDs := make(map[uint32]uint32)
+	for _, snapshot := range snapshots {
+		for _, file := range snapshot.GetCurrent().GetAllFiles() {
+			reader, err := snapshot.GetReader(file.GetFileNumber())
+			if err != nil {
+				return err
+			}
+			if err = collectMaxSeriesIDs(reader, maxSeriesIDs); err != nil {
+				return err
+			}
+			s.updateRebuildState(func(state *models.IndexRebuildState) {
+				state.ScannedFiles++
+			})
+		}
+	}
+	for metricID, seriesID := range maxSeriesIDs {
+		if err := s.indexDB.EnsureSeriesSequence(metric.ID(metricID), seriesID); err != nil {
+			return err
+		}
+	}
+	s.updateRebuildState(func(state *models.IndexRebuildState) {
+		state.Metrics = len(maxSeriesIDs)
+	})
+	return nil
+}
+
+// rebuildInvertedIndex rebuilds inverted index from all files of forward index into given dir,
+// returns the rebuilt files.
+func (s *shard) rebuildInvertedIndex(dir string) ([]string, error) {
+	snapshot := s.forwardFamily.GetSnapshot()
+	defer snapshot.Close()
+
+	var readers []table.Reader
+	for _, file := range snapshot.GetCurrent().GetAllFiles() {
+		reader, err := snapshot.GetReader(file.GetFileNumber())
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, reader)
+	}
+	fileName := filepath.Join(dir, version.Table(1))
+	flusher, err := newInvertedFlusherFunc(newFileFlusherFunc(fileName))
+	if err != nil {
+		return nil, err
+	}
+	tagKeys, err := rebuildInvertedFunc(readers, flusher)
+	if err != nil {
+		_ = flusher.Close()
+		return nil, err
+	}
+	if err = flusher.Close(); err != nil {
+		return nil, err
+	}
+	s.updateRebuildState(func(state *models.IndexRebuildState) {
+		state.TagKeys = tagKeys
+	})
+	if tagKeys == 0 {
+		// no forward index, clear all files of inverted index
+		return nil, nil
+	}
+	return []string{fileName}, nil
+}
+
+// updateRebuildState updates the state of index rebuild job.
+func (s *shard) updateRebuildState(fn func(state *models.IndexRebuildState)) {
+	s.rebuildMutex.Lock()
+	defer s.rebuildMutex.Unlock()
+
+	if s.rebuildState != nil {
+		fn(s.rebuildState)
+	}
+}
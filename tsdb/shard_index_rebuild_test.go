@@ -0,0 +1,199 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+	"github.com/lindb/lindb/tsdb/tblstore/tagindex"
+)
+
+func TestShard_RebuildIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newFileFlusherFunc = kv.NewFileFlusher
+		newInvertedFlusherFunc = tagindex.NewInvertedFlusher
+		rebuildInvertedFunc = tagindex.RebuildInvertedIndex
+		collectMaxSeriesIDs = metricsdata.CollectMaxSeriesIDs
+		ctrl.Finish()
+	}()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	index := indexdb.NewMockIndexDatabase(ctrl)
+	segment := NewMockIntervalSegment(ctrl)
+	forwardFamily := kv.NewMockFamily(ctrl)
+	invertedFamily := kv.NewMockFamily(ctrl)
+	s := &shard{
+		db:             db,
+		id:             1,
+		indexDB:        index,
+		forwardFamily:  forwardFamily,
+		invertedFamily: invertedFamily,
+		rollupTargets:  map[timeutil.Interval]IntervalSegment{timeutil.Interval(10 * timeutil.OneSecond): segment},
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		logger:         logger.GetLogger("TSDB", "Test"),
+	}
+	assert.Nil(t, s.GetIndexRebuildState())
+
+	// case 1: rebuild is running
+	s.rebuilding.Store(true)
+	assert.Equal(t, ErrIndexRebuilding, s.RebuildIndex())
+	s.rebuilding.Store(false)
+
+	// case 2: rebuild index successfully
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(table.NewMockReader(ctrl), nil).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)}).AnyTimes()
+	dataFamily := NewMockDataFamily(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	dataFamily.EXPECT().Family().Return(family)
+	family.EXPECT().GetSnapshot().Return(snapshot)
+	forwardFamily.EXPECT().GetSnapshot().Return(snapshot)
+	segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{dataFamily}, nil)
+	index.EXPECT().Flush().Return(nil)
+	index.EXPECT().EnsureSeriesSequence(metric.ID(10), uint32(100)).Return(nil)
+	collectMaxSeriesIDs = func(reader table.Reader, maxSeriesIDs map[uint32]uint32) error {
+		maxSeriesIDs[10] = 100
+		return nil
+	}
+	flusher := tagindex.NewMockInvertedFlusher(ctrl)
+	newInvertedFlusherFunc = func(kvFlusher kv.Flusher) (tagindex.InvertedFlusher, error) {
+		return flusher, nil
+	}
+	rebuildInvertedFunc = func(readers []table.Reader, flusher tagindex.InvertedFlusher) (int, error) {
+		return 2, nil
+	}
+	flusher.EXPECT().Close().Return(nil)
+	invertedFamily.EXPECT().ReplaceFiles(gomock.Any()).DoAndReturn(func(fetch func(dir string) ([]string, error)) error {
+		files, err := fetch(t.TempDir())
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		return nil
+	})
+	assert.NoError(t, s.RebuildIndex())
+	state := waitIndexRebuild(t, s)
+	assert.Equal(t, models.IndexRebuildCompleted, state.Status)
+	assert.Equal(t, models.IndexRebuildDone, state.Phase)
+	assert.Equal(t, 1, state.TotalFiles)
+	assert.Equal(t, 1, state.ScannedFiles)
+	assert.Equal(t, 1, state.Metrics)
+	assert.Equal(t, 2, state.TagKeys)
+	assert.False(t, s.isFlushing.Load())
+
+	// case 3: flush memory index failure
+	index.EXPECT().Flush().Return(fmt.Errorf("err"))
+	assert.NoError(t, s.RebuildIndex())
+	state = waitIndexRebuild(t, s)
+	assert.Equal(t, models.IndexRebuildFailed, state.Status)
+	assert.Equal(t, models.IndexRebuildFlushMemory, state.Phase)
+	assert.Equal(t, "err", state.ErrMsg)
+
+	// case 4: get data families failure
+	index.EXPECT().Flush().Return(nil)
+	segment.EXPECT().GetAllDataFamilies().Return(nil, fmt.Errorf("err"))
+	assert.NoError(t, s.RebuildIndex())
+	state = waitIndexRebuild(t, s)
+	assert.Equal(t, models.IndexRebuildFailed, state.Status)
+	assert.Equal(t, models.IndexRebuildScanDataFiles, state.Phase)
+}
+
+func TestShard_rebuildInvertedIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newInvertedFlusherFunc = tagindex.NewInvertedFlusher
+		rebuildInvertedFunc = tagindex.RebuildInvertedIndex
+		ctrl.Finish()
+	}()
+	forwardFamily := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	forwardFamily.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)}).AnyTimes()
+	s := &shard{forwardFamily: forwardFamily}
+	dir := t.TempDir()
+
+	// case 1: get reader failure
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	files, err := s.rebuildInvertedIndex(dir)
+	assert.Error(t, err)
+	assert.Empty(t, files)
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(table.NewMockReader(ctrl), nil).AnyTimes()
+	// case 2: new flusher failure
+	newInvertedFlusherFunc = func(kvFlusher kv.Flusher) (tagindex.InvertedFlusher, error) {
+		return nil, fmt.Errorf("err")
+	}
+	files, err = s.rebuildInvertedIndex(dir)
+	assert.Error(t, err)
+	assert.Empty(t, files)
+	flusher := tagindex.NewMockInvertedFlusher(ctrl)
+	newInvertedFlusherFunc = func(kvFlusher kv.Flusher) (tagindex.InvertedFlusher, error) {
+		return flusher, nil
+	}
+	// case 3: rebuild failure
+	rebuildInvertedFunc = func(readers []table.Reader, flusher tagindex.InvertedFlusher) (int, error) {
+		return 0, fmt.Errorf("err")
+	}
+	flusher.EXPECT().Close().Return(nil)
+	files, err = s.rebuildInvertedIndex(dir)
+	assert.Error(t, err)
+	assert.Empty(t, files)
+	// case 4: close flusher failure
+	rebuildInvertedFunc = func(readers []table.Reader, flusher tagindex.InvertedFlusher) (int, error) {
+		return 0, nil
+	}
+	flusher.EXPECT().Close().Return(fmt.Errorf("err"))
+	files, err = s.rebuildInvertedIndex(dir)
+	assert.Error(t, err)
+	assert.Empty(t, files)
+	// case 5: no forward index
+	flusher.EXPECT().Close().Return(nil)
+	files, err = s.rebuildInvertedIndex(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func waitIndexRebuild(t *testing.T, s *shard) *models.IndexRebuildState {
+	for i := 0; i < 100; i++ {
+		if !s.rebuilding.Load() {
+			return s.GetIndexRebuildState()
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("wait index rebuild timeout")
+	return nil
+}
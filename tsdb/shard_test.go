@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -428,6 +429,7 @@ func TestShard_Write(t *testing.T) {
 		metadata:   metadata,
 		statistics: metrics.NewShardStatistics("data", "1"),
 		logger:     logger.GetLogger("TSDB", "Test"),
+		option:     &option.DatabaseOption{},
 	}
 	cases := []struct {
 		name    string
@@ -437,8 +439,25 @@ func TestShard_Write(t *testing.T) {
 		{
 			name: "gen metric id err",
 			prepare: func() {
-				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-					Return(metric.ID(0), fmt.Errorf("err"))
+				metadataDB.EXPECT().GenMetricIDs([]metadb.MetricKey{{
+					Namespace:  commonconstants.DefaultNamespace,
+					MetricName: "test",
+				}}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ []metadb.MetricKey, _ []metric.ID, errs []error, _ *models.Limits) {
+						errs[0] = fmt.Errorf("err")
+					})
+			},
+		},
+		{
+			name: "lookup row meta successfully",
+			prepare: func() {
+				metadataDB.EXPECT().GenMetricIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ []metadb.MetricKey, metricIDs []metric.ID, _ []error, _ *models.Limits) {
+						metricIDs[0] = metric.ID(10)
+					})
+				metadataDB.EXPECT().GenFieldIDs(commonconstants.DefaultNamespace, "test",
+					[]metadb.FieldKey{{Name: "f1", Type: field.SumField}}, gomock.Any(), gomock.Any()).
+					Return([]field.ID{1}, nil)
 			},
 		},
 	}
@@ -462,6 +481,135 @@ func TestShard_Write(t *testing.T) {
 			}
 		})
 	}
+	// empty rows
+	assert.NoError(t, s.LookupRowMetricMeta(nil))
+}
+
+func TestShard_ResolveRowMetricMeta(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	family := NewMockDataFamily(ctrl)
+	s := &shard{
+		indexDB:    indexDB,
+		metadata:   metadata,
+		statistics: metrics.NewShardStatistics("data", "1"),
+		logger:     logger.GetLogger("TSDB", "Test"),
+		option:     &option.DatabaseOption{},
+	}
+	newRows := func(host string) []metric.StorageRow {
+		return mockBatchRows(&protoMetricsV1.Metric{
+			Name:      "test",
+			Timestamp: timeutil.Now(),
+			Tags:      []*protoMetricsV1.KeyValue{{Key: "host", Value: host}},
+			SimpleFields: []*protoMetricsV1.SimpleField{{
+				Name:  "f1",
+				Value: 1.0,
+				Type:  protoMetricsV1.SimpleFieldType_DELTA_SUM,
+			}},
+		})
+	}
+	metadataDB.EXPECT().GenMetricIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ []metadb.MetricKey, metricIDs []metric.ID, _ []error, _ *models.Limits) {
+			metricIDs[0] = metric.ID(10)
+		}).AnyTimes()
+	metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]field.ID{1}, nil).AnyTimes()
+	written := make(chan int64, 10)
+	writtenFn := func(seq int64) func(success bool) {
+		return func(success bool) {
+			assert.True(t, success)
+			written <- seq
+		}
+	}
+	// case 1: empty rows
+	assert.Empty(t, s.ResolveRowMetricMeta(family, 1, 1, nil, writtenFn(1)))
+	assert.Equal(t, int64(1), <-written)
+	// case 2: staging disabled, lookup synchronously
+	indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(), metric.ID(10), gomock.Any(), gomock.Any()).
+		Return(uint32(5), false, nil)
+	rows := s.ResolveRowMetricMeta(family, 1, 2, newRows("1.1.1.1"), writtenFn(2))
+	assert.Len(t, rows, 1)
+	assert.Equal(t, uint32(5), rows[0].SeriesID)
+	assert.True(t, rows[0].Writable)
+	assert.Equal(t, int64(2), <-written)
+	synced := false
+	s.SyncStagedRows(func() {
+		synced = true
+	})
+	assert.True(t, synced)
+
+	lookup := make(chan []metric.StorageRow, 2)
+	proceed := make(chan struct{})
+	s.stager = newRowStager("db/1", 2, func(rows []metric.StorageRow, _ *models.Limits) {
+		lookup <- rows
+		// keeps staged rows pending until case 7
+		<-proceed
+	}, s.statistics)
+	defer s.stager.Close()
+	// case 3: series id cached
+	indexDB.EXPECT().GetSeriesID(metric.ID(10), gomock.Any()).Return(uint32(6), true)
+	rows = s.ResolveRowMetricMeta(family, 1, 3, newRows("1.1.1.1"), writtenFn(3))
+	assert.Len(t, rows, 1)
+	assert.Equal(t, uint32(6), rows[0].SeriesID)
+	assert.Equal(t, int64(3), <-written)
+	// case 4: new series, staged, sequence is staged until rows written
+	indexDB.EXPECT().GetSeriesID(metric.ID(10), gomock.Any()).Return(uint32(0), false)
+	family.EXPECT().Retain()
+	family.EXPECT().StageSequence(int32(1), int64(4))
+	rows = s.ResolveRowMetricMeta(family, 1, 4, newRows("1.1.1.1"), writtenFn(4))
+	assert.Empty(t, rows)
+	// case 5: series has pending staged rows, staged without lookup
+	family.EXPECT().Retain()
+	family.EXPECT().StageSequence(int32(1), int64(5))
+	rows = s.ResolveRowMetricMeta(family, 1, 5, newRows("1.1.1.1"), writtenFn(5))
+	assert.Empty(t, rows)
+	assert.Empty(t, written)
+	// case 6: staging buffer is full, lookup synchronously
+	indexDB.EXPECT().GetSeriesID(metric.ID(10), gomock.Any()).Return(uint32(0), false)
+	indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(), metric.ID(10), gomock.Any(), gomock.Any()).
+		Return(uint32(7), false, nil)
+	rows = s.ResolveRowMetricMeta(family, 1, 6, newRows("1.1.1.2"), writtenFn(6))
+	assert.Len(t, rows, 1)
+	assert.Equal(t, uint32(7), rows[0].SeriesID)
+	assert.Equal(t, int64(6), <-written)
+	// case 7: series has pending staged rows and staging buffer is full, waits staged rows written
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil).Times(2)
+	family.EXPECT().ReleaseStagedSequence(int32(1), int64(4))
+	family.EXPECT().ReleaseStagedSequence(int32(1), int64(5))
+	family.EXPECT().Release().Times(2)
+	indexDB.EXPECT().GetSeriesID(metric.ID(10), gomock.Any()).Return(uint32(8), true)
+	time.AfterFunc(10*time.Millisecond, func() { close(proceed) })
+	rows = s.ResolveRowMetricMeta(family, 1, 7, newRows("1.1.1.1"), writtenFn(7))
+	assert.Len(t, rows, 1)
+	assert.Equal(t, uint32(8), rows[0].SeriesID)
+	// staged sequences are written in order before the sequence resolved synchronously
+	assert.Equal(t, int64(4), <-written)
+	assert.Equal(t, int64(5), <-written)
+	assert.Equal(t, int64(7), <-written)
+	for i := 0; i < 2; i++ {
+		staged := <-lookup
+		assert.Len(t, staged, 1)
+		assert.Equal(t, "test", string(staged[0].Name()))
+	}
+	assert.False(t, s.stager.isPending(metric.ID(10), rows[0].TagsHash()))
+	// case 8: row without tags
+	rows = s.ResolveRowMetricMeta(family, 1, 8, mockBatchRows(&protoMetricsV1.Metric{
+		Name:      "test",
+		Timestamp: timeutil.Now(),
+		SimpleFields: []*protoMetricsV1.SimpleField{{
+			Name:  "f1",
+			Value: 1.0,
+			Type:  protoMetricsV1.SimpleFieldType_DELTA_SUM,
+		}},
+	}), writtenFn(8))
+	assert.Len(t, rows, 1)
+	assert.True(t, rows[0].Writable)
+	assert.Equal(t, int64(8), <-written)
 }
 
 func TestShard_lookupRowMeta(t *testing.T) {
@@ -480,6 +628,7 @@ func TestShard_lookupRowMeta(t *testing.T) {
 		metadata:   metadata,
 		statistics: metrics.NewShardStatistics("data", "1"),
 		logger:     logger.GetLogger("TSDB", "Test"),
+		option:     &option.DatabaseOption{},
 	}
 	cases := []struct {
 		name      string
@@ -488,20 +637,10 @@ func TestShard_lookupRowMeta(t *testing.T) {
 		prepare   func()
 		wantErr   bool
 	}{
-		{
-			name: "gen metric id err",
-			prepare: func() {
-				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-					Return(metric.ID(0), fmt.Errorf("err"))
-			},
-			wantErr: true,
-		},
 		{
 			name: "gen series id err",
 			tags: tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
 			prepare: func() {
-				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-					Return(metric.ID(10), nil).AnyTimes()
 				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(),
 					metric.ID(10), gomock.Any(), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
 			},
@@ -512,9 +651,8 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			namespace: "ns",
 			tags:      tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
 			prepare: func() {
-				metadataDB.EXPECT().GenMetricID("ns", "test", gomock.Any()).Return(metric.ID(10), nil).AnyTimes()
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any(), gomock.Any()).Return(field.ID(1), nil)
+				metadataDB.EXPECT().GenFieldIDs("ns", "test",
+					gomock.Any(), gomock.Any(), gomock.Any()).Return([]field.ID{1}, nil)
 				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(),
 					metric.ID(10), gomock.Any(), gomock.Any()).Return(uint32(10), false, nil)
 			},
@@ -522,10 +660,8 @@ func TestShard_lookupRowMeta(t *testing.T) {
 		{
 			name: "empty tags",
 			prepare: func() {
-				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-					Return(metric.ID(10), nil).AnyTimes()
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any(), gomock.Any()).Return(field.ID(1), nil)
+				metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any()).Return([]field.ID{1}, nil)
 			},
 		},
 		{
@@ -534,8 +670,6 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			prepare: func() {
 				s.notifyLimitsChange()
 				db.EXPECT().GetLimits().Return(models.NewDefaultLimits())
-				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-					Return(metric.ID(10), nil).AnyTimes()
 				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(),
 					metric.ID(10), gomock.Any(), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
 			},
@@ -545,13 +679,11 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			name: "build inverted index, but gen field failure",
 			tags: tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
 			prepare: func() {
-				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-					Return(metric.ID(10), nil).AnyTimes()
 				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(),
 					metric.ID(10), gomock.Any(), gomock.Any()).Return(uint32(1), true, nil)
 				indexDB.EXPECT().BuildInvertIndex(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(0), fmt.Errorf("err"))
+				metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
@@ -562,7 +694,7 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			err := s.lookupRowMeta(&(mockBatchRows(&protoMetricsV1.Metric{
+			row := &(mockBatchRows(&protoMetricsV1.Metric{
 				Name:      "test",
 				Namespace: tt.namespace,
 				Timestamp: timeutil.Now(),
@@ -572,10 +704,17 @@ func TestShard_lookupRowMeta(t *testing.T) {
 					Value: 1.0,
 					Type:  protoMetricsV1.SimpleFieldType_DELTA_SUM,
 				}},
-			})[0]))
+			})[0])
+			row.MetricID = metric.ID(10)
+			namespace := tt.namespace
+			if namespace == "" {
+				namespace = commonconstants.DefaultNamespace
+			}
+			_, err := s.lookupRowMeta(row, &metadb.MetricKey{Namespace: namespace, MetricName: "test"}, nil, s.getLimits())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("WriteRows() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			assert.Equal(t, !tt.wantErr, row.Writable)
 		})
 	}
 }
@@ -590,73 +729,77 @@ func TestShard_lookup_histogram_fields(t *testing.T) {
 	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
 	db := NewMockDatabase(ctrl)
 	db.EXPECT().Name().Return("tet").AnyTimes()
-	metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test", gomock.Any()).
-		Return(metric.ID(10), nil).AnyTimes()
 	s := &shard{
 		indexDB:    indexDB,
 		db:         db,
 		metadata:   metadata,
 		statistics: metrics.NewShardStatistics("data", "1"),
 		logger:     logger.GetLogger("TSDB", "Test"),
+		option:     &option.DatabaseOption{},
 	}
 	cases := []struct {
 		name    string
+		values  []float64
 		prepare func()
 		wantErr bool
 	}{
 		{
-			name: "gen min field failure",
-			prepare: func() {
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(0), fmt.Errorf("err"))
-			},
-			wantErr: true,
-		},
-		{
-			name: "gen max field failure",
-			prepare: func() {
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(1), nil)
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(0), fmt.Errorf("err"))
-			},
-			wantErr: true,
-		},
-		{
-			name: "gen sum field failure",
+			name: "gen histogram fields failure",
 			prepare: func() {
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(1), nil).MaxTimes(2)
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(0), fmt.Errorf("err"))
+				metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
 		{
-			name: "gen count field failure",
+			name: "gen all fields successfully",
 			prepare: func() {
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(1), nil).MaxTimes(3)
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(0), fmt.Errorf("err"))
+				metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, fields []metadb.FieldKey, dst []field.ID, _ *models.Limits) ([]field.ID, error) {
+						// min/max/sum/count + 6 buckets
+						assert.Len(t, fields, 10)
+						assert.Equal(t, field.MinField, fields[0].Type)
+						assert.Equal(t, field.MaxField, fields[1].Type)
+						assert.Equal(t, field.SumField, fields[2].Type)
+						assert.Equal(t, field.SumField, fields[3].Type)
+						for idx := range fields {
+							dst = append(dst, field.ID(idx+1))
+						}
+						return dst, nil
+					})
 			},
-			wantErr: true,
 		},
 		{
-			name: "gen bucket field failure",
+			name: "gen sketch field successfully",
 			prepare: func() {
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(1), nil).MaxTimes(4)
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(0), fmt.Errorf("err"))
+				s.option.HistogramSketch = true
+				metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, fields []metadb.FieldKey, dst []field.ID, _ *models.Limits) ([]field.ID, error) {
+						assert.Len(t, fields, 11)
+						assert.Equal(t, field.SketchField, fields[10].Type)
+						for idx := range fields {
+							dst = append(dst, field.ID(idx+1))
+						}
+						return dst, nil
+					})
 			},
-			wantErr: true,
 		},
 		{
-			name: "gen all fields successfully",
+			name:   "empty histogram, sketch not stored",
+			values: []float64{0, 0, 0, 0, 0, 0},
 			prepare: func() {
-				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any()).Return(field.ID(1), nil).AnyTimes()
+				s.option.HistogramSketch = true
+				metadataDB.EXPECT().GenFieldIDs(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, fields []metadb.FieldKey, dst []field.ID, _ *models.Limits) ([]field.ID, error) {
+						assert.Len(t, fields, 10)
+						for idx := range fields {
+							dst = append(dst, field.ID(idx+1))
+						}
+						return dst, nil
+					})
 			},
 		},
 	}
@@ -666,7 +809,12 @@ func TestShard_lookup_histogram_fields(t *testing.T) {
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			err := s.lookupRowMeta(&(mockBatchRows(&protoMetricsV1.Metric{
+			values := tt.values
+			if values == nil {
+				// all values are same, narrowed by min/max
+				values = []float64{0, 0, 0, 0, 0, 10}
+			}
+			row := &(mockBatchRows(&protoMetricsV1.Metric{
 				Name:      "test",
 				Timestamp: timeutil.Now(),
 				CompoundField: &protoMetricsV1.CompoundField{
@@ -675,12 +823,17 @@ func TestShard_lookup_histogram_fields(t *testing.T) {
 					Sum:            10,
 					Count:          10,
 					ExplicitBounds: []float64{1, 1, 1, 1, 1, math.Inf(1) + 1},
-					Values:         []float64{1, 1, 1, 1, 1, 1},
+					Values:         values,
 				},
-			})[0]))
+			})[0])
+			fieldKeys, err := s.lookupRowMeta(row,
+				&metadb.MetricKey{Namespace: commonconstants.DefaultNamespace, MetricName: "test"}, nil, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("lookupRowMeta() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if !tt.wantErr {
+				assert.Len(t, row.FieldIDs, len(fieldKeys))
+			}
 		})
 	}
 }
@@ -737,8 +890,9 @@ func TestShard_TTL(t *testing.T) {
 		rollupTargets: map[timeutil.Interval]IntervalSegment{
 			10: segment,
 		},
-		db:     db,
-		logger: logger.GetLogger("TSDB", "Test"),
+		db:       db,
+		manifest: newSegmentManifest(filepath.Join(t.TempDir(), segmentManifestFile)),
+		logger:   logger.GetLogger("TSDB", "Test"),
 	}
 	segment.EXPECT().TTL().Return(fmt.Errorf("err"))
 	s.TTL()
@@ -771,3 +925,77 @@ func mockBatchRows(m *protoMetricsV1.Metric) []metric.StorageRow {
 	br.UnmarshalRows(buf.Bytes())
 	return br.Rows()
 }
+
+func TestShard_GetSegmentStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	segment1 := NewMockIntervalSegment(ctrl)
+	segment2 := NewMockIntervalSegment(ctrl)
+	manifestPath := filepath.Join(t.TempDir(), segmentManifestFile)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			timeutil.Interval(5 * timeutil.OneMinute):  segment2,
+			timeutil.Interval(10 * timeutil.OneSecond): segment1,
+		},
+		manifest: newSegmentManifest(manifestPath),
+	}
+	segment1.EXPECT().GetSegmentStates().Return(nil, fmt.Errorf("err"))
+	states, err := s.GetSegmentStates()
+	assert.Error(t, err)
+	assert.Nil(t, states)
+	assert.False(t, s.manifest.IsComplete())
+
+	segment1.EXPECT().GetSegmentStates().Return([]models.SegmentState{{Interval: "10s"}}, nil)
+	segment2.EXPECT().GetSegmentStates().Return([]models.SegmentState{{Interval: "5m"}}, nil)
+	states, err = s.GetSegmentStates()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SegmentState{{Interval: "10s"}, {Interval: "5m"}}, states)
+	assert.True(t, s.manifest.IsComplete())
+
+	// served by manifest without scanning segments
+	s.manifest.UpdateFamily("10s", "20190904", 1000, models.FamilyFilesState{Family: "12"})
+	states, err = s.GetSegmentStates()
+	assert.NoError(t, err)
+	assert.Len(t, states, 1)
+	assert.True(t, newSegmentManifest(manifestPath).IsComplete())
+}
+
+func TestShard_GetDiskUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		dirSize = fileutil.DirSize
+		ctrl.Finish()
+	}()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	s := &shard{
+		db:         db,
+		id:         1,
+		statistics: metrics.NewShardStatistics("test", "1"),
+	}
+	dirSize = func(path string) (int64, error) {
+		return 10, nil
+	}
+	usage := s.GetDiskUsage()
+	assert.Equal(t, models.ShardDiskUsage{ShardID: 1, Data: 10, Index: 20, WAL: 10}, usage)
+}
+
+func TestShard_ExpireOldestSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	segment := NewMockIntervalSegment(ctrl)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		db:       db,
+		manifest: newSegmentManifest(filepath.Join(t.TempDir(), segmentManifestFile)),
+		logger:   logger.GetLogger("TSDB", "Test"),
+	}
+	segment.EXPECT().ExpireOldestSegment().Return(fmt.Errorf("err"))
+	s.ExpireOldestSegment()
+	segment.EXPECT().ExpireOldestSegment().Return(nil)
+	s.ExpireOldestSegment()
+}
@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// purgeTombstones removes the tombstones of metrics after the data of deleted series are purged
+// from all families by compaction, so that tombstones of shard don't grow forever.
+func (s *shard) purgeTombstones() {
+	if s.indexDB == nil {
+		return
+	}
+	metricIDs := s.indexDB.GetTombstonedMetrics()
+	if metricIDs.IsEmpty() {
+		return
+	}
+	var families []DataFamily
+	for _, rollupSegment := range s.rollupTargets {
+		rs, err := rollupSegment.GetAllDataFamilies()
+		if err != nil {
+			s.logger.Warn("get data families failure when purging tombstones",
+				logger.String("shard", s.indicator), logger.Error(err))
+			return
+		}
+		families = append(families, rs...)
+	}
+	it := metricIDs.Iterator()
+	for it.HasNext() {
+		metricID := metric.ID(it.Next())
+		if err := s.purgeMetricTombstones(metricID, families); err != nil {
+			s.logger.Warn("purge tombstones of metric failure",
+				logger.String("shard", s.indicator), logger.Any("metricID", metricID), logger.Error(err))
+		}
+	}
+}
+
+// purgeMetricTombstones removes the tombstones of metric if no family may have data of deleted series.
+func (s *shard) purgeMetricTombstones(metricID metric.ID, families []DataFamily) error {
+	tombstones, err := s.indexDB.GetDeletedSeries(metricID)
+	if err != nil {
+		return err
+	}
+	if tombstones != nil {
+		for _, family := range families {
+			exist, err := family.MayContainSeries(metricID, tombstones)
+			if err != nil {
+				return err
+			}
+			if exist {
+				// waits the data of deleted series purged by compaction
+				return nil
+			}
+		}
+	}
+	_, err = s.indexDB.PurgeTombstones(metricID, tombstones)
+	return err
+}
@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/indexdb"
+)
+
+func TestShard_purgeTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := indexdb.NewMockIndexDatabase(ctrl)
+	segment := NewMockIntervalSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	tombstones := roaring.BitmapOf(1, 2)
+	cases := []struct {
+		name    string
+		prepare func()
+	}{
+		{
+			name: "no metric has tombstones",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.New())
+			},
+		},
+		{
+			name: "get data families failure",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.BitmapOf(1))
+				segment.EXPECT().GetAllDataFamilies().Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "get deleted series failure",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.BitmapOf(1))
+				segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{family}, nil)
+				index.EXPECT().GetDeletedSeries(metric.ID(1)).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "check series of family failure",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.BitmapOf(1))
+				segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{family}, nil)
+				index.EXPECT().GetDeletedSeries(metric.ID(1)).Return(tombstones, nil)
+				family.EXPECT().MayContainSeries(metric.ID(1), tombstones).Return(false, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "family not compacted, keep tombstones",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.BitmapOf(1))
+				segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{family}, nil)
+				index.EXPECT().GetDeletedSeries(metric.ID(1)).Return(tombstones, nil)
+				family.EXPECT().MayContainSeries(metric.ID(1), tombstones).Return(true, nil)
+			},
+		},
+		{
+			name: "purge tombstones failure",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.BitmapOf(1))
+				segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{family}, nil)
+				index.EXPECT().GetDeletedSeries(metric.ID(1)).Return(tombstones, nil)
+				family.EXPECT().MayContainSeries(metric.ID(1), tombstones).Return(false, nil)
+				index.EXPECT().PurgeTombstones(metric.ID(1), tombstones).Return(false, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "purge tombstones after all families compacted",
+			prepare: func() {
+				index.EXPECT().GetTombstonedMetrics().Return(roaring.BitmapOf(1, 2))
+				segment.EXPECT().GetAllDataFamilies().Return([]DataFamily{family, family}, nil)
+				index.EXPECT().GetDeletedSeries(metric.ID(1)).Return(tombstones, nil)
+				family.EXPECT().MayContainSeries(metric.ID(1), tombstones).Return(false, nil).Times(2)
+				index.EXPECT().PurgeTombstones(metric.ID(1), tombstones).Return(true, nil)
+				// stale metric id without tombstones
+				index.EXPECT().GetDeletedSeries(metric.ID(2)).Return(nil, nil)
+				index.EXPECT().PurgeTombstones(metric.ID(2), nil).Return(true, nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(_ *testing.T) {
+			s := &shard{
+				indicator:     "db/1",
+				indexDB:       index,
+				rollupTargets: map[timeutil.Interval]IntervalSegment{timeutil.Interval(10 * timeutil.OneSecond): segment},
+				logger:        logger.GetLogger("TSDB", "Test"),
+			}
+			tt.prepare()
+			s.purgeTombstones()
+		})
+	}
+	// index database not initialized
+	s := &shard{}
+	s.purgeTombstones()
+}
@@ -31,7 +31,7 @@ import (
 
 func TestField_read(t *testing.T) {
 	block := mockMetricMergeBlock([]uint32{1}, 5, 5)
-	r, err := NewReader("1.sst", block)
+	r, err := NewReader("1.sst", block, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	scanner, _ := newDataScanner(r)
@@ -64,7 +64,7 @@ func TestField_read(t *testing.T) {
 
 func TestFieldReader_close(t *testing.T) {
 	block := mockMetricMergeBlock([]uint32{1}, 5, 5)
-	r, err := NewReader("1.sst", block)
+	r, err := NewReader("1.sst", block, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	scanner, _ := newDataScanner(r)
@@ -77,7 +77,7 @@ func TestFieldReader_close(t *testing.T) {
 
 func TestFieldReader_reset(t *testing.T) {
 	block := mockMetricMergeBlock([]uint32{1}, 5, 5)
-	r, err := NewReader("1.sst", block)
+	r, err := NewReader("1.sst", block, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	scanner, _ := newDataScanner(r)
@@ -112,7 +112,7 @@ func TestFieldReader_reset(t *testing.T) {
 
 func TestFieldReader_Reset_error(t *testing.T) {
 	block := mockMetricMergeBlock([]uint32{1}, 5, 5)
-	r, err := NewReader("1.sst", block)
+	r, err := NewReader("1.sst", block, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	scanner, _ := newDataScanner(r)
@@ -155,7 +155,7 @@ func reverseBuf(data []byte) {
 
 func TestFieldReader_read_one_field(t *testing.T) {
 	block := mockMetricMergeBlockOneField([]uint32{1}, 5, 5)
-	r, err := NewReader("1.sst", block)
+	r, err := NewReader("1.sst", block, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	scanner, _ := newDataScanner(r)
@@ -120,6 +120,11 @@ func (f *fileFilterResultSet) SlotRange() timeutil.SlotRange {
 	return f.reader.GetTimeRange()
 }
 
+// File returns the path of sst file which result set reads from.
+func (f *fileFilterResultSet) File() string {
+	return f.reader.Path()
+}
+
 // Load reads data from sst files, then returns the data file scanner.
 func (f *fileFilterResultSet) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 	return f.reader.Load(ctx)
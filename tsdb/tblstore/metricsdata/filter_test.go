@@ -51,6 +51,8 @@ func TestFileFilterResultSet_Load(t *testing.T) {
 		Start: 10,
 		End:   20,
 	}, rs.SlotRange())
+	reader.EXPECT().Path().Return("family/10/000001.sst")
+	assert.Equal(t, "family/10/000001.sst", rs.(flow.CacheableResultSet).File())
 	snapshot.EXPECT().Close()
 	rs.Close()
 }
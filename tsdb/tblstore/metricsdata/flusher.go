@@ -72,6 +72,7 @@ type flusher struct {
 	// Level1 flusher
 	kvFlusher kv.Flusher
 	kvWriter  table.StreamWriter
+	version   byte // layout version of metric block
 
 	encoders []*encoding.TSDEncoder // each encoder ref field store
 
@@ -112,6 +113,8 @@ type flusher struct {
 	// ├──────────┼──────────┼──────────┼──────────┼──────────┤
 	// │  4 Byte  │ 4 Bytes  │ 4 Bytes  │ 4 Bytes  │  4 Bytes │
 	// └──────────┴──────────┴──────────┴──────────┴──────────┘
+	// Since FormatVersion1, one byte version is written before footer,
+	// and the highest bit of start time slot is set as version flag.
 	//
 	// Level2 is a context of the second level in kv table, used for a writing a full metric
 	// each entry is a series bucket ordered by roaring high key
@@ -195,6 +198,7 @@ func NewFlusher(kvFlusher kv.Flusher) (Flusher, error) {
 	flusher := &flusher{
 		kvFlusher: kvFlusher,
 		kvWriter:  sw,
+		version:   FormatVersion(),
 	}
 	// level2 context
 	flusher.Level2.seriesIDs = roaring.New()
@@ -399,12 +403,21 @@ func (w *flusher) CommitMetric(slotRange timeutil.SlotRange) error {
 		return err
 	}
 
+	startSlot := slotRange.Start
+	if w.version != FormatVersion0 {
+		// write explicit version before footer, marks it in start time slot
+		if _, err := w.kvWriter.Write([]byte{w.version}); err != nil {
+			return err
+		}
+		startSlot |= versionFlag
+	}
+
 	//////////////////////////////////////////////////
 	// build footer (field meta's offset+series ids' offset+high level offsets+crc32 checksum)
 	// (2 bytes + 2 bytes +4 bytes + 4 bytes + 4 bytes + 4 bytes)
 	//////////////////////////////////////////////////
 	// write time range of metric level
-	binary.LittleEndian.PutUint16(w.Level2.footer[:2], slotRange.Start)
+	binary.LittleEndian.PutUint16(w.Level2.footer[:2], startSlot)
 	binary.LittleEndian.PutUint16(w.Level2.footer[2:4], slotRange.End)
 	// write field metas' start position
 	binary.LittleEndian.PutUint32(w.Level2.footer[4:8], fieldMetasAt)
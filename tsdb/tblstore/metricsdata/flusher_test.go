@@ -147,7 +147,7 @@ func flushMoreData(t *testing.T,
 	mockData(t, seriesIDs, flusher)
 	assert.NoError(t, flusher.CommitMetric(timeutil.SlotRange{Start: 5, End: 5}))
 	data := nopKVFlusher.Bytes()
-	r, err := NewReader("1.sst", data)
+	r, err := NewReader("1.sst", data, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	found := 0
@@ -0,0 +1,128 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+const (
+	// FormatVersion0 represents the initial layout of metric block, footer without version.
+	FormatVersion0 byte = 0
+	// FormatVersion1 adds explicit version byte before footer.
+	FormatVersion1 byte = 1
+	// DefaultFormatVersion is the default layout version for writing, which can be read by all nodes.
+	DefaultFormatVersion = FormatVersion0
+	// LatestFormatVersion is the latest layout version which current node supports.
+	LatestFormatVersion = FormatVersion1
+
+	// versionFlag marks the metric block has explicit version byte before footer,
+	// time slot of family is always less than versionFlag, so the highest bit of start time slot is free.
+	versionFlag uint16 = 1 << 15
+)
+
+// formatVersion is the layout version for writing new metric block.
+var formatVersion = atomic.NewUint32(uint32(DefaultFormatVersion))
+
+// dataFooter represents the decoded footer of metric block.
+type dataFooter struct {
+	timeRange         timeutil.SlotRange
+	fieldMetaStartPos int
+	seriesIDsStartPos int
+	highKeyOffsetsPos int
+	crc32CheckSum     uint32
+	footerPos         int // start position of footer(includes version byte)
+}
+
+// dataFooterDecoder decodes the footer of metric block.
+type dataFooterDecoder func(block []byte) (dataFooter, error)
+
+// dataFooterDecoders are keyed by layout version, reader supports all registered versions,
+// metric blocks of different versions maybe merged into one file when compaction.
+var dataFooterDecoders = map[byte]dataFooterDecoder{
+	FormatVersion0: decodeDataFooterV0,
+	FormatVersion1: decodeDataFooterV1,
+}
+
+// SetFormatVersion sets the layout version for writing new metric block, returns err if version not supported.
+// NOTICE: upgrade the layout version only after all nodes support it, because the sst file maybe
+// fetched by other replica.
+func SetFormatVersion(version byte) error {
+	if _, ok := dataFooterDecoders[version]; !ok {
+		return fmt.Errorf("%w: %d, latest version: %d", table.ErrUnsupportedFormatVersion, version, LatestFormatVersion)
+	}
+	formatVersion.Store(uint32(version))
+	return nil
+}
+
+// FormatVersion returns the layout version for writing new metric block.
+func FormatVersion() byte {
+	return byte(formatVersion.Load())
+}
+
+// blockVersion returns the layout version of metric block.
+func blockVersion(block []byte) (byte, error) {
+	if len(block) <= dataFooterSize {
+		return 0, fmt.Errorf("metric block's length too small: %d <= %d", len(block), dataFooterSize)
+	}
+	footerPos := len(block) - dataFooterSize
+	if binary.LittleEndian.Uint16(block[footerPos:footerPos+2])&versionFlag == 0 {
+		return FormatVersion0, nil
+	}
+	return block[footerPos-1], nil
+}
+
+// decodeDataFooter decodes the fixed footer(2+2+4+4+4+4) at the end of metric block.
+func decodeDataFooter(block []byte) dataFooter {
+	footerPos := len(block) - dataFooterSize
+	return dataFooter{
+		timeRange: timeutil.SlotRange{
+			Start: binary.LittleEndian.Uint16(block[footerPos:footerPos+2]) &^ versionFlag,
+			End:   binary.LittleEndian.Uint16(block[footerPos+2 : footerPos+4]),
+		},
+		fieldMetaStartPos: int(binary.LittleEndian.Uint32(block[footerPos+4 : footerPos+8])),
+		seriesIDsStartPos: int(binary.LittleEndian.Uint32(block[footerPos+8 : footerPos+12])),
+		highKeyOffsetsPos: int(binary.LittleEndian.Uint32(block[footerPos+12 : footerPos+16])),
+		crc32CheckSum:     binary.LittleEndian.Uint32(block[footerPos+16 : footerPos+20]),
+		footerPos:         footerPos,
+	}
+}
+
+// decodeDataFooterV0 decodes footer of version 0, layout: footer.
+func decodeDataFooterV0(block []byte) (dataFooter, error) {
+	if len(block) <= dataFooterSize {
+		return dataFooter{}, fmt.Errorf("metric block's length too small: %d <= %d", len(block), dataFooterSize)
+	}
+	return decodeDataFooter(block), nil
+}
+
+// decodeDataFooterV1 decodes footer of version 1, layout: version(1)+footer.
+func decodeDataFooterV1(block []byte) (dataFooter, error) {
+	if len(block) <= dataFooterSize+1 {
+		return dataFooter{}, fmt.Errorf("metric block's length too small: %d <= %d", len(block), dataFooterSize+1)
+	}
+	footer := decodeDataFooter(block)
+	footer.footerPos--
+	return footer, nil
+}
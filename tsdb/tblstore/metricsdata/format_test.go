@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestSetFormatVersion(t *testing.T) {
+	defer func() {
+		_ = SetFormatVersion(DefaultFormatVersion)
+	}()
+	assert.Equal(t, DefaultFormatVersion, FormatVersion())
+	assert.NoError(t, SetFormatVersion(LatestFormatVersion))
+	assert.Equal(t, LatestFormatVersion, FormatVersion())
+	err := SetFormatVersion(LatestFormatVersion + 1)
+	assert.ErrorIs(t, err, table.ErrUnsupportedFormatVersion)
+	assert.Equal(t, LatestFormatVersion, FormatVersion())
+}
+
+func TestReader_FormatVersion(t *testing.T) {
+	defer func() {
+		_ = SetFormatVersion(DefaultFormatVersion)
+	}()
+	blockV0 := mockMetricBlock()
+	assert.NoError(t, SetFormatVersion(FormatVersion1))
+	blockV1 := mockMetricBlock()
+	assert.Len(t, blockV1, len(blockV0)+1)
+
+	for _, block := range [][]byte{blockV0, blockV1} {
+		assert.NoError(t, ChecksumChecker(10, block))
+		r, err := NewReader("1.sst", block, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, timeutil.SlotRange{Start: 5, End: 5}, r.GetTimeRange())
+		assert.Len(t, r.GetFields(), 4)
+		assert.Equal(t, uint64(11), r.GetSeriesIDs().GetCardinality())
+	}
+	version, err := blockVersion(blockV1)
+	assert.NoError(t, err)
+	assert.Equal(t, FormatVersion1, version)
+
+	// case 1: unsupported version
+	block := append([]byte{}, blockV1...)
+	block[len(block)-dataFooterSize-1] = LatestFormatVersion + 1
+	r, err := NewReader("1.sst", block, nil)
+	assert.ErrorIs(t, err, table.ErrUnsupportedFormatVersion)
+	assert.Nil(t, r)
+	// case 2: block too short
+	r, err = NewReader("1.sst", blockV1[len(blockV1)-dataFooterSize-1:], nil)
+	assert.Error(t, err)
+	assert.Nil(t, r)
+}
+
+func TestMerger_FormatVersion(t *testing.T) {
+	defer func() {
+		_ = SetFormatVersion(DefaultFormatVersion)
+	}()
+	blockV0 := mockRealMetricBlock([]uint32{1, 2, 4}, 11, 15)
+	assert.NoError(t, SetFormatVersion(FormatVersion1))
+	blockV1 := mockRealMetricBlock([]uint32{2, 20}, 16, 20)
+
+	// merge metric blocks of different versions, write with current version
+	flusher := kv.NewNopFlusher()
+	merger, err := NewMerger(flusher)
+	assert.NoError(t, err)
+	assert.NoError(t, merger.Merge(1, [][]byte{blockV0, blockV1}))
+	block := flusher.Bytes()
+	version, err := blockVersion(block)
+	assert.NoError(t, err)
+	assert.Equal(t, FormatVersion1, version)
+	r, err := NewReader("1.sst", block, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutil.SlotRange{Start: 11, End: 20}, r.GetTimeRange())
+	assert.Equal(t, []uint32{1, 2, 4, 20}, r.GetSeriesIDs().ToArray())
+}
@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/models"
+)
+
+// Inspect iterates all metric blocks of data file, then returns the detail of data file,
+// includes field metas/series count/time slot range of each metric block and block size histogram.
+func Inspect(reader table.Reader, fileSize int64) (*models.DataFileDetail, error) {
+	detail := models.NewDataFileDetail(reader.FileName(), fileSize)
+	it := reader.Iterator()
+	for it.HasNext() {
+		metricID := it.Key()
+		block := it.Value()
+		r, err := NewReader(reader.Path(), block, nil)
+		if err != nil {
+			return nil, fmt.Errorf("inspect metric block failure, metric id: %d, err: %w", metricID, err)
+		}
+		fieldMetas := r.GetFields()
+		fields := make([]string, 0, len(fieldMetas))
+		for _, fm := range fieldMetas {
+			fields = append(fields, fmt.Sprintf("%d(%s)", fm.ID, fm.Type))
+		}
+		slotRange := r.GetTimeRange()
+		detail.AddMetricBlock(models.MetricBlockDetail{
+			MetricID:    metricID,
+			NumOfSeries: r.GetSeriesIDs().GetCardinality(),
+			Fields:      fields,
+			SlotStart:   slotRange.Start,
+			SlotEnd:     slotRange.End,
+			BlockSize:   len(block),
+		})
+	}
+	return detail, nil
+}
+
+// CollectMaxSeriesIDs collects the max series id of each metric block in data file,
+// keeps the greater one if the metric exists in maxSeriesIDs.
+func CollectMaxSeriesIDs(reader table.Reader, maxSeriesIDs map[uint32]uint32) error {
+	it := reader.Iterator()
+	for it.HasNext() {
+		metricID := it.Key()
+		r, err := NewReader(reader.Path(), it.Value(), nil)
+		if err != nil {
+			return fmt.Errorf("read metric block failure, metric id: %d, err: %w", metricID, err)
+		}
+		seriesIDs := r.GetSeriesIDs()
+		if seriesIDs.IsEmpty() {
+			continue
+		}
+		if maxSeriesID := seriesIDs.Maximum(); maxSeriesID > maxSeriesIDs[metricID] {
+			maxSeriesIDs[metricID] = maxSeriesID
+		}
+	}
+	return nil
+}
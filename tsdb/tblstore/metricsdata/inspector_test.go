@@ -0,0 +1,98 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+)
+
+func TestInspect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := table.NewMockReader(ctrl)
+	it := table.NewMockIterator(ctrl)
+	reader.EXPECT().FileName().Return("000001.sst").AnyTimes()
+	reader.EXPECT().Path().Return("/tmp/000001.sst").AnyTimes()
+	reader.EXPECT().Iterator().Return(it).AnyTimes()
+
+	block := mockMetricBlock()
+	// case 1: inspect metric block successfully
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return(block),
+		it.EXPECT().HasNext().Return(false),
+	)
+	detail, err := Inspect(reader, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "000001.sst", detail.File)
+	assert.Equal(t, int64(1024), detail.Size)
+	assert.Len(t, detail.Metrics, 1)
+	assert.Equal(t, uint32(10), detail.Metrics[0].MetricID)
+	assert.Equal(t, len(block), detail.Metrics[0].BlockSize)
+	assert.Equal(t, uint64(11), detail.Metrics[0].NumOfSeries)
+	assert.Equal(t, uint16(5), detail.SlotStart)
+	assert.Equal(t, uint16(5), detail.SlotEnd)
+	assert.Equal(t, []string{"2(sum)", "10(min)", "30(sum)", "100(max)"}, detail.Metrics[0].Fields)
+	// case 2: invalid metric block
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return([]byte{1, 2, 3}),
+	)
+	detail, err = Inspect(reader, 1024)
+	assert.Error(t, err)
+	assert.Nil(t, detail)
+}
+
+func TestCollectMaxSeriesIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := table.NewMockReader(ctrl)
+	it := table.NewMockIterator(ctrl)
+	reader.EXPECT().Path().Return("/tmp/000001.sst").AnyTimes()
+	reader.EXPECT().Iterator().Return(it).AnyTimes()
+	// case 1: invalid metric block
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return([]byte{1, 2, 3}),
+	)
+	maxSeriesIDs := make(map[uint32]uint32)
+	assert.Error(t, CollectMaxSeriesIDs(reader, maxSeriesIDs))
+	// case 2: collect max series ids
+	maxSeriesIDs = map[uint32]uint32{10: 10, 20: 100}
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return(mockMetricBlock()),
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(20)),
+		it.EXPECT().Value().Return(mockMetricBlock()),
+		it.EXPECT().HasNext().Return(false),
+	)
+	assert.NoError(t, CollectMaxSeriesIDs(reader, maxSeriesIDs))
+	assert.Equal(t, map[uint32]uint32{10: 65536 + 10, 20: 65536 + 10}, maxSeriesIDs)
+}
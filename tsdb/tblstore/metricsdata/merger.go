@@ -30,9 +30,14 @@ import (
 
 var MetricDataMerger kv.MergerType = "MetricDataMerger"
 
-// init registers metric data merger create function
+// maxMergeBlocks represents the max number of metric blocks merged at once when merging in stream,
+// blocks are merged into an intermediate block batch by batch, bounds the memory of merging hot metric.
+const maxMergeBlocks = 16
+
+// init registers metric data merger create function and value checker
 func init() {
 	kv.RegisterMerger(MetricDataMerger, NewMerger)
+	kv.RegisterChecker(MetricDataMerger, ChecksumChecker)
 }
 
 type mergerContext struct {
@@ -50,6 +55,8 @@ type merger struct {
 	dataFlusher  Flusher
 	seriesMerger SeriesMerger
 	rollup       kv.Rollup
+	rollupFilter kv.RollupFilter
+	tombstones   kv.Tombstones
 }
 
 // NewMerger creates a metric data merger
@@ -64,21 +71,42 @@ func NewMerger(flusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job
+// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job,
+// if tombstones context exist purge the data of deleted series,
+// if rollup filter context exist skip the data of metrics which are excluded from rollup.
 func (m *merger) Init(params map[string]interface{}) {
 	if rollupCtx, ok := params[kv.RollupContext]; ok {
 		m.rollup = rollupCtx.(kv.Rollup)
 	}
+	if rollupFilter, ok := params[kv.RollupFilterContext]; ok {
+		m.rollupFilter = rollupFilter.(kv.RollupFilter)
+	}
+	if tombstones, ok := params[kv.TombstonesContext]; ok {
+		m.tombstones = tombstones.(kv.Tombstones)
+	}
 }
 
 // Merge merges the multi metric data into one target metric data for same metric id
 func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
+	if m.rollup != nil && m.rollupFilter != nil && m.rollupFilter.SkipRollup(key) {
+		// metric excluded from rollup, only keeps raw data in source family
+		return nil
+	}
 	blockCount := len(metricBlocks)
 	// 1. prepare readers and metric level data(field/time slot/series ids)
 	mergeCtx, err := m.prepare(metricBlocks)
 	if err != nil {
 		return err
 	}
+	if m.tombstones != nil {
+		if deletedSeriesIDs := m.tombstones.GetTombstones(key); deletedSeriesIDs != nil {
+			// purge the data of deleted series
+			mergeCtx.seriesIDs.AndNot(deletedSeriesIDs)
+			if mergeCtx.seriesIDs.IsEmpty() {
+				return nil
+			}
+		}
+	}
 	// 2. Prepare metric
 	m.dataFlusher.PrepareMetric(key, mergeCtx.targetFields)
 	// 3. merge series data by roaring container
@@ -124,6 +152,54 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 	return nil
 }
 
+// MergeStream merges the metric blocks of same metric id batch by batch, each batch(except the last one)
+// is merged into an intermediate block which is merged with next batch, so that only bounded blocks
+// are decoded at the same time.
+func (m *merger) MergeStream(key uint32, values kv.ValueIterator) error {
+	if m.rollup != nil && m.rollupFilter != nil && m.rollupFilter.SkipRollup(key) {
+		// metric excluded from rollup, only keeps raw data in source family
+		return nil
+	}
+	var metricBlocks [][]byte
+	for values.HasNext() {
+		if len(metricBlocks) == maxMergeBlocks {
+			block, err := m.mergeIntermediate(key, metricBlocks)
+			if err != nil {
+				return err
+			}
+			metricBlocks = metricBlocks[:0]
+			if len(block) > 0 {
+				metricBlocks = append(metricBlocks, block)
+			}
+		}
+		metricBlocks = append(metricBlocks, values.Value())
+	}
+	if len(metricBlocks) == 0 {
+		return nil
+	}
+	return m.Merge(key, metricBlocks)
+}
+
+// mergeIntermediate merges the metric blocks into an intermediate metric block in memory,
+// returns empty block if all series deleted.
+func (m *merger) mergeIntermediate(key uint32, metricBlocks [][]byte) ([]byte, error) {
+	kvFlusher := kv.NewNopFlusher()
+	dataFlusher, err := NewFlusher(kvFlusher)
+	if err != nil {
+		return nil, err
+	}
+	// keeps source time slots, rollup is done when merging last batch
+	intermediate := &merger{
+		dataFlusher:  dataFlusher,
+		seriesMerger: newSeriesMerger(dataFlusher),
+		tombstones:   m.tombstones,
+	}
+	if err := intermediate.Merge(key, metricBlocks); err != nil {
+		return nil, err
+	}
+	return kvFlusher.Bytes(), nil
+}
+
 func (m *merger) prepare(metricBlocks [][]byte) (*mergerContext, error) {
 	ctx := &mergerContext{
 		scanners:     make([]*dataScanner, len(metricBlocks)),
@@ -132,7 +208,7 @@ func (m *merger) prepare(metricBlocks [][]byte) (*mergerContext, error) {
 	}
 
 	for idx, metricBlock := range metricBlocks {
-		reader, err := NewReader("merge_operation", metricBlock)
+		reader, err := NewReader("merge_operation", metricBlock, nil)
 		if err != nil {
 			return nil, err
 		}
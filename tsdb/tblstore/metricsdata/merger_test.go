@@ -65,7 +65,7 @@ func assertMergeReentrant(t *testing.T, flusher kv.Flusher, mergerIntf kv.Merger
 		})
 	assert.Nil(t, err)
 
-	r, err := NewReader("test", flusher.(*kv.NopFlusher).Bytes())
+	r, err := NewReader("test", flusher.(*kv.NopFlusher).Bytes(), nil)
 	assert.Nil(t, err)
 	assert.Len(t, r.GetFields(), 2)
 	assert.EqualValues(t, r.GetFields(), field.Metas{
@@ -107,6 +107,105 @@ func assertMergeReentrant(t *testing.T, flusher kv.Flusher, mergerIntf kv.Merger
 	assert.Equal(t, 2, found)
 }
 
+func TestMerger_Compact_Tombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tombstones := kv.NewMockTombstones(ctrl)
+	// case 1: purge deleted series
+	flusher := kv.NewNopFlusher()
+	mergerIntf, err := NewMerger(flusher)
+	assert.NoError(t, err)
+	mergerIntf.Init(map[string]interface{}{kv.TombstonesContext: tombstones})
+	tombstones.EXPECT().GetTombstones(uint32(1)).Return(roaring.BitmapOf(2, 20))
+	err = mergerIntf.Merge(1, [][]byte{
+		mockRealMetricBlock([]uint32{1, 2, 4}, 11, 15),
+		mockRealMetricBlock([]uint32{2, 20}, 16, 20),
+	})
+	assert.NoError(t, err)
+	r, err := NewReader("test", flusher.Bytes(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 4}, r.GetSeriesIDs().ToArray())
+	// case 2: all series deleted, skip metric
+	flusher = kv.NewNopFlusher()
+	mergerIntf, err = NewMerger(flusher)
+	assert.NoError(t, err)
+	mergerIntf.Init(map[string]interface{}{kv.TombstonesContext: tombstones})
+	tombstones.EXPECT().GetTombstones(uint32(1)).Return(roaring.BitmapOf(2, 20))
+	err = mergerIntf.Merge(1, [][]byte{mockRealMetricBlock([]uint32{2, 20}, 16, 20)})
+	assert.NoError(t, err)
+	assert.Nil(t, flusher.Bytes())
+}
+
+func TestMerger_MergeStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var blocks [][]byte
+	for i := 0; i < 2*maxMergeBlocks+3; i++ {
+		slot := uint16(i % 30)
+		blocks = append(blocks, mockRealMetricBlock([]uint32{1, uint32(i + 10)}, slot, slot+2))
+	}
+	// case 1: merge batch by batch, result same as batch merge
+	batchFlusher := kv.NewNopFlusher()
+	batchMerger, _ := NewMerger(batchFlusher)
+	err := batchMerger.Merge(1, blocks)
+	assert.NoError(t, err)
+	streamFlusher := kv.NewNopFlusher()
+	streamMerger, _ := NewMerger(streamFlusher)
+	err = streamMerger.(kv.StreamMerger).MergeStream(1, newValuesIterator(blocks))
+	assert.NoError(t, err)
+	assert.Equal(t, batchFlusher.Bytes(), streamFlusher.Bytes())
+	r, err := NewReader("test", streamFlusher.Bytes(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2*maxMergeBlocks+4), r.GetSeriesIDs().GetCardinality())
+	// case 2: no values
+	flusher := kv.NewNopFlusher()
+	m, _ := NewMerger(flusher)
+	err = m.(kv.StreamMerger).MergeStream(1, newValuesIterator(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, flusher.Bytes())
+	// case 3: merge intermediate block err
+	err = m.(kv.StreamMerger).MergeStream(1, newValuesIterator(append([][]byte{{1, 2, 3}}, blocks...)))
+	assert.Error(t, err)
+	// case 4: all series deleted in intermediate block
+	tombstones := kv.NewMockTombstones(ctrl)
+	tombstones.EXPECT().GetTombstones(uint32(1)).Return(roaring.BitmapOf(1)).AnyTimes()
+	m.Init(map[string]interface{}{kv.TombstonesContext: tombstones})
+	err = m.(kv.StreamMerger).MergeStream(1, newValuesIterator(
+		append(blocks[:maxMergeBlocks:maxMergeBlocks], mockRealMetricBlock([]uint32{1, 100}, 10, 12))))
+	assert.NoError(t, err)
+	r, err = NewReader("test", flusher.Bytes(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 100},
+		r.GetSeriesIDs().ToArray())
+	// case 5: metric excluded from rollup
+	rollupFilter := kv.NewMockRollupFilter(ctrl)
+	rollupFilter.EXPECT().SkipRollup(uint32(1)).Return(true)
+	m.Init(map[string]interface{}{kv.RollupContext: kv.NewMockRollup(ctrl), kv.RollupFilterContext: rollupFilter})
+	err = m.(kv.StreamMerger).MergeStream(1, newValuesIterator(blocks))
+	assert.NoError(t, err)
+}
+
+// valuesIterator implements kv.ValueIterator for testing.
+type valuesIterator struct {
+	values [][]byte
+	idx    int
+}
+
+func newValuesIterator(values [][]byte) kv.ValueIterator {
+	return &valuesIterator{values: values, idx: -1}
+}
+
+func (it *valuesIterator) HasNext() bool {
+	it.idx++
+	return it.idx < len(it.values)
+}
+
+func (it *valuesIterator) Value() []byte {
+	return it.values[it.idx]
+}
+
 func mockRealMetricBlock(seriesIDs []uint32, start, end uint16) []byte {
 	nopKVFlusher := kv.NewNopFlusher()
 	flusher, _ := NewFlusher(nopKVFlusher)
@@ -233,6 +332,28 @@ func TestMerger_Rollup_Merge(t *testing.T) {
 	assert.False(t, len(nopFlusher.Bytes()) > 0) // data flush is mock
 }
 
+func TestMerger_Rollup_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rollup := kv.NewMockRollup(ctrl)
+	rollupFilter := kv.NewMockRollupFilter(ctrl)
+	flusher := NewMockFlusher(ctrl)
+	nopFlusher := kv.NewNopFlusher()
+	merge, _ := NewMerger(nopFlusher)
+	merge.Init(map[string]interface{}{kv.RollupContext: rollup, kv.RollupFilterContext: rollupFilter})
+
+	m := merge.(*merger)
+	m.dataFlusher = flusher
+	// metric excluded from rollup, skip it
+	rollupFilter.EXPECT().SkipRollup(uint32(1)).Return(true)
+	err := merge.Merge(
+		1,
+		[][]byte{
+			mockMetricMergeBlock([]uint32{1, 2, 4}, 10, 10),
+		})
+	assert.NoError(t, err)
+}
+
 func mockMetricMergeBlock(seriesIDs []uint32, start, end uint16) []byte {
 	nopKVFlusher := kv.NewNopFlusher()
 	flusher, _ := NewFlusher(nopKVFlusher)
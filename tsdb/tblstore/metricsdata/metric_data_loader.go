@@ -27,6 +27,7 @@ import (
 // metricLoader implements flow.DataLoader interface that loads metric data from file storage.
 type metricLoader struct {
 	reader             MetricReader
+	prefetcher         BlockPrefetcher
 	lowContainer       roaring.Container
 	lowKeyOffsets      *encoding.FixedOffsetDecoder
 	seriesEntriesBlock []byte
@@ -35,6 +36,7 @@ type metricLoader struct {
 // newMetricLoader creates a file storage metric loader.
 func newMetricLoader(
 	reader MetricReader,
+	prefetcher BlockPrefetcher,
 	seriesEntriesBlock []byte,
 	lowContainer roaring.Container,
 	lowKeyOffsets *encoding.FixedOffsetDecoder,
@@ -42,6 +44,7 @@ func newMetricLoader(
 	return &metricLoader{
 		seriesEntriesBlock: seriesEntriesBlock,
 		reader:             reader,
+		prefetcher:         prefetcher,
 		lowContainer:       lowContainer,
 		lowKeyOffsets:      lowKeyOffsets,
 	}
@@ -49,6 +52,9 @@ func newMetricLoader(
 
 // Load loads the metric data by given series id from file storage.
 func (s *metricLoader) Load(loadCtx *flow.DataLoadContext) {
+	if s.prefetcher != nil {
+		s.prefetch(loadCtx)
+	}
 	loadCtx.IterateLowSeriesIDs(s.lowContainer, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
 		seriesEntry, err := s.lowKeyOffsets.GetBlock(seriesIdxFromStorage, s.seriesEntriesBlock)
 		if err != nil {
@@ -58,3 +64,15 @@ func (s *metricLoader) Load(loadCtx *flow.DataLoadContext) {
 		s.reader.readSeriesData(loadCtx, seriesIdxFromQuery, seriesEntry)
 	})
 }
+
+// prefetch gathers series entry blocks which need to read, then loads them from file by batch read.
+func (s *metricLoader) prefetch(loadCtx *flow.DataLoadContext) {
+	var seriesEntries [][]byte
+	loadCtx.IterateLowSeriesIDs(s.lowContainer, func(_ uint16, seriesIdxFromStorage int) {
+		seriesEntry, err := s.lowKeyOffsets.GetBlock(seriesIdxFromStorage, s.seriesEntriesBlock)
+		if err == nil {
+			seriesEntries = append(seriesEntries, seriesEntry)
+		}
+	})
+	s.prefetcher.Prefetch(seriesEntries)
+}
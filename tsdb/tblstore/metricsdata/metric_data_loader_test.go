@@ -92,9 +92,36 @@ func TestMetricLoader_Load(t *testing.T) {
 				tt.prepare()
 			}
 
-			s := newMetricLoader(r, nil, roaring.BitmapOf(10).GetContainer(0), seriesOffsets)
+			s := newMetricLoader(r, nil, nil, roaring.BitmapOf(10).GetContainer(0), seriesOffsets)
 			ctx.Grouping()
 			s.Load(ctx)
 		})
 	}
 }
+
+func TestMetricLoader_Prefetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := NewMockMetricReader(ctrl)
+	r.EXPECT().GetTimeRange().Return(timeutil.SlotRange{}).AnyTimes()
+	prefetcher := NewMockBlockPrefetcher(ctrl)
+	ctx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query: &stmt.Query{},
+			},
+		},
+	}
+	ctx.LowSeriesIDsContainer = roaring.BitmapOf(10).GetContainer(0)
+	seriesOffsets := encoding.NewFixedOffsetDecoder()
+	encoder := encoding.NewFixedOffsetEncoder(true)
+	encoder.Add(0)
+	_, _ = seriesOffsets.Unmarshal(encoder.MarshalBinary())
+	ctx.Grouping()
+
+	prefetcher.EXPECT().Prefetch(gomock.Len(1))
+	r.EXPECT().readSeriesData(gomock.Any(), gomock.Any(), gomock.Any())
+	s := newMetricLoader(r, prefetcher, []byte{1, 2, 3}, roaring.BitmapOf(10).GetContainer(0), seriesOffsets)
+	s.Load(ctx)
+}
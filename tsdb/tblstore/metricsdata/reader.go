@@ -25,6 +25,8 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/stream"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -44,6 +46,15 @@ const (
 	fieldNotFound = -1
 )
 
+// ChecksumChecker verifies the crc32 checksum of metric block.
+var ChecksumChecker = table.NewCRC32Checker(dataFooterSize)
+
+// BlockPrefetcher represents the prefetcher which loads blocks from file by batch read.
+type BlockPrefetcher interface {
+	// Prefetch loads the blocks from file by batch read.
+	Prefetch(blocks [][]byte)
+}
+
 // MetricReader represents the metric block metricReader
 type MetricReader interface {
 	// Path returns file path
@@ -70,15 +81,18 @@ type metricReader struct {
 	fields         field.Metas
 	crc32CheckSum  uint32
 	timeRange      timeutil.SlotRange
+	prefetcher     BlockPrefetcher
 
 	readFieldIndexes []int // read field indexes be used when query metric data
 }
 
-// NewReader creates a metric block metricReader
-func NewReader(path string, metricBlock []byte) (MetricReader, error) {
+// NewReader creates a metric block metricReader,
+// prefetcher is optional, if set, loads series entry blocks from file by batch read when loading data.
+func NewReader(path string, metricBlock []byte, prefetcher BlockPrefetcher) (MetricReader, error) {
 	r := &metricReader{
 		path:        path,
 		metricBlock: metricBlock,
+		prefetcher:  prefetcher,
 	}
 	if err := r.initReader(); err != nil {
 		return nil, err
@@ -164,7 +178,7 @@ func (r *metricReader) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 	}
 	seriesEntriesBlock := level3Block[:lowKeyOffsetsAt]
 	// must use lowContainer from store, because get series index based on container
-	return newMetricLoader(r, seriesEntriesBlock, lowContainer, lowKeyOffsetsDecoder)
+	return newMetricLoader(r, r.prefetcher, seriesEntriesBlock, lowContainer, lowKeyOffsetsDecoder)
 }
 
 // readSeriesData reads series data from file by given position.
@@ -172,6 +186,10 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 	decoder := ctx.Decoder
 	fieldCount := r.fields.Len()
 	if fieldCount == 1 {
+		if r.fields[0].Type == field.SketchField {
+			r.readSketch(ctx, seriesIdx, 0, seriesEntryBlock)
+			return
+		}
 		decoder.ResetWithTimeRange(seriesEntryBlock, r.timeRange.Start, r.timeRange.End)
 		// metric has one field, just read the data
 		ctx.DownSampling(r.timeRange, seriesIdx, 0, decoder)
@@ -193,7 +211,12 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 			continue
 		}
 		fieldBlock, err := fieldOffsetsDecoder.GetBlock(readIdx, seriesEntryBlock[:fieldOffsetsAt])
-		if err == nil {
+		if err != nil {
+			continue
+		}
+		if r.fields[readIdx].Type == field.SketchField {
+			r.readSketch(ctx, seriesIdx, queryIdx, fieldBlock)
+		} else {
 			decoder.ResetWithTimeRange(fieldBlock, r.timeRange.Start, r.timeRange.End)
 			// read field data
 			ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, decoder)
@@ -202,20 +225,38 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 	encoding.ReleaseFixedOffsetDecoder(fieldOffsetsDecoder)
 }
 
+// readSketch reads the sketches of histogram sketch field, sketch field has own encoding(not tsd).
+func (r *metricReader) readSketch(ctx *flow.DataLoadContext, seriesIdx uint16, fieldIdx int, fieldBlock []byte) {
+	if ctx.LoadSketch == nil {
+		return
+	}
+	sketches := ddsketch.NewSlotSketches()
+	if err := sketches.UnmarshalBinary(fieldBlock); err != nil || sketches.Len() == 0 {
+		return
+	}
+	ctx.LoadSketch(r.timeRange, seriesIdx, fieldIdx, sketches)
+}
+
 // initReader initializes the metricReader context includes tag value ids/high offsets
 func (r *metricReader) initReader() error {
-	if len(r.metricBlock) <= dataFooterSize {
-		return fmt.Errorf("metric block's length too small: %d <= %d", len(r.metricBlock), dataFooterSize)
-	}
-	// read footer(2+2+4+4+4+4)
-	footerPos := len(r.metricBlock) - dataFooterSize
-	r.timeRange.Start = binary.LittleEndian.Uint16(r.metricBlock[footerPos : footerPos+2])
-	r.timeRange.End = binary.LittleEndian.Uint16(r.metricBlock[footerPos+2 : footerPos+4])
-
-	fieldMetaStartPos := int(binary.LittleEndian.Uint32(r.metricBlock[footerPos+4 : footerPos+8]))
-	seriesIDsStartPos := int(binary.LittleEndian.Uint32(r.metricBlock[footerPos+8 : footerPos+12]))
-	highKeyOffsetsPos := int(binary.LittleEndian.Uint32(r.metricBlock[footerPos+12 : footerPos+16]))
-	r.crc32CheckSum = binary.LittleEndian.Uint32(r.metricBlock[footerPos+16 : footerPos+20])
+	version, err := blockVersion(r.metricBlock)
+	if err != nil {
+		return err
+	}
+	decoder, ok := dataFooterDecoders[version]
+	if !ok {
+		return fmt.Errorf("%w: %d, metric block of file:%s", table.ErrUnsupportedFormatVersion, version, r.path)
+	}
+	footer, err := decoder(r.metricBlock)
+	if err != nil {
+		return err
+	}
+	r.timeRange = footer.timeRange
+	r.crc32CheckSum = footer.crc32CheckSum
+	fieldMetaStartPos := footer.fieldMetaStartPos
+	seriesIDsStartPos := footer.seriesIDsStartPos
+	highKeyOffsetsPos := footer.highKeyOffsetsPos
+	footerPos := footer.footerPos
 	// validate offsets
 	if !sort.IntsAreSorted([]int{
 		0, fieldMetaStartPos, fieldMetaStartPos + 2, seriesIDsStartPos, highKeyOffsetsPos, footerPos,
@@ -242,14 +283,14 @@ func (r *metricReader) initReader() error {
 	}
 	// read series ids
 	seriesIDs := roaring.New()
-	if err := encoding.BitmapUnmarshal(seriesIDs, r.metricBlock[seriesIDsStartPos:]); err != nil {
+	if err = encoding.BitmapUnmarshal(seriesIDs, r.metricBlock[seriesIDsStartPos:]); err != nil {
 		return err
 	}
 	r.seriesBucket = r.metricBlock[:fieldMetaStartPos]
 	r.seriesIDs = seriesIDs
 	// read high offsets
 	r.highKeyOffsets = encoding.NewFixedOffsetDecoder()
-	_, err := r.highKeyOffsets.Unmarshal(r.metricBlock[highKeyOffsetsPos:])
+	_, err = r.highKeyOffsets.Unmarshal(r.metricBlock[highKeyOffsetsPos:])
 	return err
 }
 
@@ -43,15 +43,15 @@ func TestNewReader(t *testing.T) {
 		encoding.BitmapUnmarshal = bitmapUnmarshal
 	}()
 	// case 1: footer err
-	r, err := NewReader("1.sst", []byte{1, 2, 3})
+	r, err := NewReader("1.sst", []byte{1, 2, 3}, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 	// case 2: offset err
-	r, err = NewReader("1.sst", []byte{0, 0, 0, 1, 2, 3, 3, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 1, 2, 3, 4})
+	r, err = NewReader("1.sst", []byte{0, 0, 0, 1, 2, 3, 3, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 1, 2, 3, 4}, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 	// case 3: new metricReader success
-	r, err = NewReader("1.sst", mockMetricBlock())
+	r, err = NewReader("1.sst", mockMetricBlock(), nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	assert.Equal(t, "1.sst", r.Path())
@@ -74,7 +74,7 @@ func TestNewReader(t *testing.T) {
 	encoding.BitmapUnmarshal = func(bitmap *roaring.Bitmap, data []byte) error {
 		return fmt.Errorf("err")
 	}
-	r, err = NewReader("1.sst", mockMetricBlock())
+	r, err = NewReader("1.sst", mockMetricBlock(), nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
@@ -83,7 +83,7 @@ func TestReader_Load(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	r, err := NewReader("1.sst", mockMetricBlock())
+	r, err := NewReader("1.sst", mockMetricBlock(), nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	r1 := r.(*metricReader)
@@ -98,7 +98,7 @@ func TestReader_Load(t *testing.T) {
 		},
 	})
 	// case 3: load data success
-	r, err = NewReader("1.sst", mockMetricBlock())
+	r, err = NewReader("1.sst", mockMetricBlock(), nil)
 	assert.NoError(t, err)
 	scanner := r.Load(&flow.DataLoadContext{
 		SeriesIDHighKey:       0,
@@ -112,7 +112,7 @@ func TestReader_Load(t *testing.T) {
 
 	assert.NotNil(t, scanner)
 	// case 4: series ids not found
-	r, err = NewReader("1.sst", mockMetricBlock())
+	r, err = NewReader("1.sst", mockMetricBlock(), nil)
 	assert.NoError(t, err)
 	scanner = r.Load(&flow.DataLoadContext{
 		SeriesIDHighKey:       0,
@@ -149,13 +149,13 @@ func TestReader_Load(t *testing.T) {
 	ctx.Grouping()
 	scanner = r.Load(ctx)
 	// case 5: load data success, metric has one field
-	r, err = NewReader("1.sst", mockMetricBlockForOneField())
+	r, err = NewReader("1.sst", mockMetricBlockForOneField(), nil)
 	assert.NoError(t, err)
 	ctx.ShardExecuteCtx.StorageExecuteCtx.Fields = field.Metas{{ID: 2}}
 	ctx.Grouping()
 	scanner.Load(ctx)
 	// case 6: high key not exist
-	r, err = NewReader("1.sst", mockMetricBlockForOneField())
+	r, err = NewReader("1.sst", mockMetricBlockForOneField(), nil)
 	assert.NoError(t, err)
 	ctx.SeriesIDHighKey = 10
 	ctx.Grouping()
@@ -164,7 +164,7 @@ func TestReader_Load(t *testing.T) {
 }
 
 func TestReader_scan(t *testing.T) {
-	r, err := NewReader("1.sst", mockMetricBlock())
+	r, err := NewReader("1.sst", mockMetricBlock(), nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 	scanner, err := newDataScanner(r)
@@ -19,7 +19,9 @@ package metricsdata
 
 import (
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/series/field"
 )
 
 //go:generate mockgen -source ./series_merger.go -destination=./series_merger_mock.go -package metricsdata
@@ -53,6 +55,12 @@ func (sm *seriesMerger) merge(
 ) error {
 	for idx, f := range mergeCtx.targetFields {
 		fieldID := f.ID
+		if f.Type == field.SketchField {
+			if err := sm.mergeSketch(mergeCtx, fieldID, fieldReaders); err != nil {
+				return err
+			}
+			continue
+		}
 		encodeStream := sm.flusher.GetEncoder(idx)
 		encodeStream.RestWithStartTime(mergeCtx.targetRange.Start)
 
@@ -102,3 +110,33 @@ func (sm *seriesMerger) merge(
 	}
 	return nil
 }
+
+// mergeSketch merges the sketches of histogram sketch field, source slot => target slot same as tsd field,
+// sketches in same target slot are merged(not aggregated by value).
+func (sm *seriesMerger) mergeSketch(mergeCtx *mergerContext, fieldID field.ID, fieldReaders []FieldReader) error {
+	sketches := ddsketch.NewSlotSketches()
+	ratio := int(mergeCtx.ratio)
+	baseSlot := int(mergeCtx.baseSlot)
+	targetStart, targetEnd := int(mergeCtx.targetRange.Start), int(mergeCtx.targetRange.End)
+	toTargetSlot := func(slot int) (int, bool) {
+		targetSlot := baseSlot + slot/ratio
+		return targetSlot, targetSlot >= targetStart && targetSlot <= targetEnd
+	}
+	for _, reader := range fieldReaders {
+		if reader == nil {
+			continue
+		}
+		fieldData := reader.GetFieldData(fieldID)
+		if len(fieldData) == 0 {
+			continue
+		}
+		if err := sketches.UnmarshalWith(fieldData, toTargetSlot); err != nil {
+			return err
+		}
+	}
+	data, err := sketches.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return sm.flusher.FlushField(data)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/pkg/bit"
+	"github.com/lindb/lindb/pkg/ddsketch"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
@@ -173,6 +174,61 @@ func TestSeriesMerger_rollup_merge(t *testing.T) {
 	assert.Equal(t, 2, c)
 }
 
+func TestSeriesMerger_sketch_merge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flusher := NewMockFlusher(ctrl)
+	merger := newSeriesMerger(flusher)
+	reader1 := NewMockFieldReader(ctrl)
+	reader2 := NewMockFieldReader(ctrl)
+	reader1.EXPECT().Close().AnyTimes()
+	reader2.EXPECT().Close().AnyTimes()
+	readers := []FieldReader{reader1, nil, reader2}
+
+	mockSketches := func(slots ...int) []byte {
+		sketches := ddsketch.NewSlotSketches()
+		sketch := ddsketch.NewSketch()
+		sketch.Add(10, 1)
+		for _, slot := range slots {
+			sketches.Merge(slot, sketch)
+		}
+		data, _ := sketches.MarshalBinary()
+		return data
+	}
+	// case 1: rollup merge, source:[5,182] target:[0,6], interval: 10s => 5min
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return(mockSketches(10, 20))
+	reader2.EXPECT().GetFieldData(gomock.Any()).Return(mockSketches(182, 300))
+	var result []byte
+	flusher.EXPECT().FlushField(gomock.Any()).DoAndReturn(func(data []byte) error {
+		result = data
+		return nil
+	})
+	err := merger.merge(
+		&mergerContext{
+			targetFields: field.Metas{{ID: 1, Type: field.SketchField}},
+			sourceRange:  timeutil.SlotRange{Start: 5, End: 300},
+			targetRange:  timeutil.SlotRange{Start: 0, End: 6},
+			ratio:        30,
+		}, nil, readers)
+	assert.NoError(t, err)
+	sketches := ddsketch.NewSlotSketches()
+	assert.NoError(t, sketches.UnmarshalBinary(result))
+	// slot 300 => 10, out of target range
+	assert.Equal(t, []int{0, 6}, sketches.Slots())
+	sketch, _ := sketches.Lookup(0)
+	assert.Equal(t, 2.0, sketch.Count())
+	// case 2: invalid sketch data
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return([]byte{1})
+	err = merger.merge(
+		&mergerContext{
+			targetFields: field.Metas{{ID: 1, Type: field.SketchField}},
+			targetRange:  timeutil.SlotRange{Start: 0, End: 6},
+			ratio:        1,
+		}, nil, readers)
+	assert.Error(t, err)
+}
+
 func mockField(start uint16) []byte {
 	encoder := encoding.NewTSDEncoder(start)
 	encoder.AppendTime(bit.One)
@@ -21,13 +21,15 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 )
 
 var SeriesForwardMerger kv.MergerType = "SeriesForwardMerger"
 
-// init registers series forward merger create function
+// init registers series forward merger create function and value checker
 func init() {
 	kv.RegisterMerger(SeriesForwardMerger, NewForwardMerger)
+	kv.RegisterChecker(SeriesForwardMerger, table.NewCRC32Checker(indexFooterSize))
 }
 
 // forwardMerger implements kv.Merger for merging forward index data for each tag key
@@ -21,14 +21,16 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/pkg/encoding"
 )
 
 var SeriesInvertedMerger kv.MergerType = "SeriesInvertedMerger"
 
-// init registers series inverted merger create function
+// init registers series inverted merger create function and value checker
 func init() {
 	kv.RegisterMerger(SeriesInvertedMerger, NewInvertedMerger)
+	kv.RegisterChecker(SeriesInvertedMerger, table.NewCRC32Checker(indexFooterSize))
 }
 
 // invertedMerger implements kv.Merger for merging inverted index data for each tag key
@@ -96,3 +98,55 @@ func (m *invertedMerger) Merge(key uint32, values [][]byte) error {
 	}
 	return m.invertedFlusher.CommitTagKey()
 }
+
+// MergeStream merges the inverted index data block by block for same tag key id,
+// only keeps the merged series ids of each tag value instead of all inverted index blocks.
+func (m *invertedMerger) MergeStream(key uint32, values kv.ValueIterator) error {
+	targetTagValueIDs := roaring.New() // target merged tag value ids
+	targetSeriesIDs := make(map[uint32]*roaring.Bitmap)
+	// 1. merge inverted index by tag value id
+	for values.HasNext() {
+		reader, err := newTagInvertedReader(values.Value())
+		if err != nil {
+			return err
+		}
+		scanner, err := newTagInvertedScanner(reader)
+		if err != nil {
+			return err
+		}
+		highKeys := reader.keys.GetHighKeys()
+		for idx, highKey := range highKeys {
+			container := reader.keys.GetContainerAtIndex(idx)
+			it := container.PeekableIterator()
+			hk := uint32(highKey) << 16
+			for it.HasNext() {
+				lowTagValueID := it.Next()
+				tagValueID := encoding.ValueWithHighLowBits(hk, lowTagValueID)
+				seriesIDs, ok := targetSeriesIDs[tagValueID]
+				if !ok {
+					seriesIDs = roaring.New()
+					targetSeriesIDs[tagValueID] = seriesIDs
+				}
+				// scan index data then merge series ids
+				if err := scanner.scan(highKey, lowTagValueID, seriesIDs); err != nil {
+					return err
+				}
+			}
+		}
+		targetTagValueIDs.Or(reader.keys)
+	}
+	if targetTagValueIDs.IsEmpty() {
+		return nil
+	}
+
+	m.invertedFlusher.PrepareTagKey(key)
+	// 2. flush tag value id=>series ids mapping, sort by tag value id
+	it := targetTagValueIDs.Iterator()
+	for it.HasNext() {
+		tagValueID := it.Next()
+		if err := m.invertedFlusher.FlushInvertedIndex(tagValueID, targetSeriesIDs[tagValueID]); err != nil {
+			return err
+		}
+	}
+	return m.invertedFlusher.CommitTagKey()
+}
@@ -173,3 +173,62 @@ func mockInvertedData(tagKeyID uint32, tagValueIDs []uint32, tagValues map[uint3
 	_ = seriesFlusher.CommitTagKey()
 	return nopKVFlusher.Bytes()
 }
+
+func TestInvertedMerger_MergeStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	encoding.BitmapUnmarshal = bitmapUnmarshal
+	nopFlusher := kv.NewNopFlusher()
+	merge, _ := NewInvertedMerger(nopFlusher)
+	m := merge.(kv.StreamMerger)
+	// case 1: merge data success, result same as batch merge
+	err := m.MergeStream(1, newValuesIterator(mockInvertedMergeData()))
+	assert.NoError(t, err)
+	reader, err := newTagInvertedReader(nopFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.EqualValues(t, roaring.BitmapOf(1, 2, 3, 4, 5, 6, 7, 8000000, 9000000).ToArray(), reader.keys.ToArray())
+	seriesIDs, _ := reader.getSeriesIDsByTagValueIDs(roaring.BitmapOf(1))
+	assert.EqualValues(t, roaring.BitmapOf(1, 10).ToArray(), seriesIDs.ToArray())
+	seriesIDs, _ = reader.getSeriesIDsByTagValueIDs(roaring.BitmapOf(3, 9000000))
+	assert.EqualValues(t, roaring.BitmapOf(3, 30, 9000000).ToArray(), seriesIDs.ToArray())
+	// case 2: no values
+	_ = nopFlusher.Commit()
+	err = m.MergeStream(2, newValuesIterator(nil))
+	assert.NoError(t, err)
+	assert.Len(t, nopFlusher.Bytes(), 0)
+	// case 3: new reader err
+	err = m.MergeStream(3, newValuesIterator([][]byte{{1, 2, 3}}))
+	assert.Error(t, err)
+	// case 4: flush tag value data err
+	flusher := NewMockInvertedFlusher(ctrl)
+	merge.(*invertedMerger).invertedFlusher = flusher
+	flusher.EXPECT().PrepareTagKey(gomock.Any()).Return().AnyTimes()
+	flusher.EXPECT().FlushInvertedIndex(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	err = m.MergeStream(4, newValuesIterator(mockInvertedMergeData()))
+	assert.Error(t, err)
+	// case 5: commit tag key err
+	flusher.EXPECT().FlushInvertedIndex(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	flusher.EXPECT().CommitTagKey().Return(fmt.Errorf("err"))
+	err = m.MergeStream(5, newValuesIterator(mockInvertedMergeData()))
+	assert.Error(t, err)
+}
+
+// valuesIterator implements kv.ValueIterator for testing.
+type valuesIterator struct {
+	values [][]byte
+	idx    int
+}
+
+func newValuesIterator(values [][]byte) kv.ValueIterator {
+	return &valuesIterator{values: values, idx: -1}
+}
+
+func (it *valuesIterator) HasNext() bool {
+	it.idx++
+	return it.idx < len(it.values)
+}
+
+func (it *valuesIterator) Value() []byte {
+	return it.values[it.idx]
+}
@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tagindex
+
+import (
+	"sort"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+// RebuildInvertedIndex rebuilds the inverted index(tag value id => series ids) from the files of forward index
+// (series id => tag value id), the forward index of same tag key in different files are merged,
+// returns the number of rebuilt tag keys.
+func RebuildInvertedIndex(readers []table.Reader, flusher InvertedFlusher) (tagKeys int, err error) {
+	// tag key id => tag value id => series ids
+	index := make(map[uint32]map[uint32]*roaring.Bitmap)
+	for _, reader := range readers {
+		it := reader.Iterator()
+		for it.HasNext() {
+			tagKeyID := it.Key()
+			forwardReader, err := NewTagForwardReader(it.Value())
+			if err != nil {
+				return 0, err
+			}
+			tagValues, ok := index[tagKeyID]
+			if !ok {
+				tagValues = make(map[uint32]*roaring.Bitmap)
+				index[tagKeyID] = tagValues
+			}
+			collectInvertedIndex(forwardReader.(*tagForwardReader), tagValues)
+		}
+	}
+	tagKeyIDs := make([]uint32, 0, len(index))
+	for tagKeyID := range index {
+		tagKeyIDs = append(tagKeyIDs, tagKeyID)
+	}
+	// key of kv store must be written in order
+	sort.Slice(tagKeyIDs, func(i, j int) bool { return tagKeyIDs[i] < tagKeyIDs[j] })
+	for _, tagKeyID := range tagKeyIDs {
+		tagValues := index[tagKeyID]
+		tagValueIDs := make([]uint32, 0, len(tagValues))
+		for tagValueID := range tagValues {
+			tagValueIDs = append(tagValueIDs, tagValueID)
+		}
+		sort.Slice(tagValueIDs, func(i, j int) bool { return tagValueIDs[i] < tagValueIDs[j] })
+
+		flusher.PrepareTagKey(tagKeyID)
+		for _, tagValueID := range tagValueIDs {
+			if err := flusher.FlushInvertedIndex(tagValueID, tagValues[tagValueID]); err != nil {
+				return 0, err
+			}
+		}
+		if err := flusher.CommitTagKey(); err != nil {
+			return 0, err
+		}
+	}
+	return len(tagKeyIDs), nil
+}
+
+// collectInvertedIndex collects the series ids of each tag value from the forward index of tag key.
+func collectInvertedIndex(reader *tagForwardReader, tagValues map[uint32]*roaring.Bitmap) {
+	for _, highKey := range reader.GetSeriesIDs().GetHighKeys() {
+		lowSeriesIDs, tagValueIDs := reader.GetSeriesAndTagValue(highKey)
+		hk := uint32(highKey) << 16
+		it := lowSeriesIDs.PeekableIterator()
+		idx := 0
+		for it.HasNext() {
+			seriesID := encoding.ValueWithHighLowBits(hk, it.Next())
+			tagValueID := tagValueIDs[idx]
+			idx++
+			seriesIDs, ok := tagValues[tagValueID]
+			if !ok {
+				seriesIDs = roaring.New()
+				tagValues[tagValueID] = seriesIDs
+			}
+			seriesIDs.Add(seriesID)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tagindex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+)
+
+func TestRebuildInvertedIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := table.NewMockReader(ctrl)
+	it := table.NewMockIterator(ctrl)
+	reader.EXPECT().Iterator().Return(it).AnyTimes()
+	// case 1: forward index block invalid
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return([]byte{1, 2, 3}),
+	)
+	tagKeys, err := RebuildInvertedIndex([]table.Reader{reader}, NewMockInvertedFlusher(ctrl))
+	assert.Error(t, err)
+	assert.Zero(t, tagKeys)
+	// case 2: flush inverted index failure
+	flusher := NewMockInvertedFlusher(ctrl)
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return(buildForwardBlock()),
+		it.EXPECT().HasNext().Return(false),
+	)
+	flusher.EXPECT().PrepareTagKey(uint32(10))
+	flusher.EXPECT().FlushInvertedIndex(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	tagKeys, err = RebuildInvertedIndex([]table.Reader{reader}, flusher)
+	assert.Error(t, err)
+	assert.Zero(t, tagKeys)
+	// case 3: commit tag key failure
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return(buildForwardBlock()),
+		it.EXPECT().HasNext().Return(false),
+	)
+	flusher.EXPECT().PrepareTagKey(uint32(10))
+	flusher.EXPECT().FlushInvertedIndex(gomock.Any(), gomock.Any()).Return(nil).Times(8)
+	flusher.EXPECT().CommitTagKey().Return(fmt.Errorf("err"))
+	tagKeys, err = RebuildInvertedIndex([]table.Reader{reader}, flusher)
+	assert.Error(t, err)
+	assert.Zero(t, tagKeys)
+	// case 4: rebuild inverted index, merges forward index of same tag key
+	reader2 := table.NewMockReader(ctrl)
+	it2 := table.NewMockIterator(ctrl)
+	reader2.EXPECT().Iterator().Return(it2)
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Key().Return(uint32(10)),
+		it.EXPECT().Value().Return(buildForwardBlock()),
+		it.EXPECT().HasNext().Return(false),
+	)
+	nopKVFlusher := kv.NewNopFlusher()
+	forwardFlusher, _ := NewForwardFlusher(nopKVFlusher)
+	forwardFlusher.PrepareTagKey(10)
+	_ = forwardFlusher.FlushForwardIndex([]uint32{1})
+	_ = forwardFlusher.CommitTagKey(roaring.BitmapOf(100))
+	gomock.InOrder(
+		it2.EXPECT().HasNext().Return(true),
+		it2.EXPECT().Key().Return(uint32(10)),
+		it2.EXPECT().Value().Return(nopKVFlusher.Bytes()),
+		it2.EXPECT().HasNext().Return(false),
+	)
+	kvFlusher := kv.NewNopFlusher()
+	invertedFlusher, err := NewInvertedFlusher(kvFlusher)
+	assert.NoError(t, err)
+	tagKeys, err = RebuildInvertedIndex([]table.Reader{reader, reader2}, invertedFlusher)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tagKeys)
+
+	invertedReader, err := newTagInvertedReader(kvFlusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3, 4, 10, 20, 30, 40}, invertedReader.keys.ToArray())
+	seriesIDs, err := invertedReader.getSeriesIDsByTagValueIDs(roaring.BitmapOf(1))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 100}, seriesIDs.ToArray())
+	seriesIDs, err = invertedReader.getSeriesIDsByTagValueIDs(roaring.BitmapOf(20, 40))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{65535 + 20, 65535 + 40}, seriesIDs.ToArray())
+}
@@ -44,6 +44,8 @@ type Flusher interface {
 	FlushTagKeyID(tagKeyID uint32, tagValueSeq uint32) error
 	// used for merging
 	commitTagKeyID() error
+	// flushTagKeyMeta writes the tag key meta block built before, used for merging.
+	flushTagKeyMeta(tagKeyID uint32, block []byte) error
 	// Closer closes the writer, this will be called after writing all tagKeys.
 	io.Closer
 }
@@ -195,8 +197,10 @@ func (tf *flusher) FlushTagKeyID(tagKeyID, tagValueSeq uint32) error {
 	}
 	tf.kvWriter.Prepare(tagKeyID)
 
-	// pre-sort for building trie
-	tf.Level2.tagValueMapping.SortByKeys()
+	// pre-sort for building trie, tag values are sorted already when merging tries.
+	if !sort.IsSorted(&tf.Level2.tagValueMapping) {
+		tf.Level2.tagValueMapping.SortByKeys()
+	}
 	// build trie
 	tree := tf.Level2.trieBuilder.Build(
 		tf.Level2.tagValueMapping.keys,
@@ -244,6 +248,15 @@ func (tf *flusher) FlushTagKeyID(tagKeyID, tagValueSeq uint32) error {
 
 func (tf *flusher) commitTagKeyID() error { return tf.kvWriter.Commit() }
 
+// flushTagKeyMeta writes the tag key meta block built before, used for merging.
+func (tf *flusher) flushTagKeyMeta(tagKeyID uint32, block []byte) error {
+	tf.kvWriter.Prepare(tagKeyID)
+	if _, err := tf.kvWriter.Write(block); err != nil {
+		return err
+	}
+	return tf.commitTagKeyID()
+}
+
 func (tf *flusher) Close() error {
 	return tf.kvFlusher.Commit()
 }
@@ -18,15 +18,20 @@
 package tagkeymeta
 
 import (
+	"bytes"
+
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/trie"
 )
 
 var MergerName kv.MergerType = "TagKeyMetaMerger"
 
-// init registers tag meta merger create function
+// init registers tag meta merger create function and value checker
 func init() {
 	kv.RegisterMerger(MergerName, NewMerger)
+	kv.RegisterChecker(MergerName, table.NewCRC32Checker(tagFooterSize))
 }
 
 // merger implements kv.Merger for merging tag trie meta data for each metric
@@ -58,11 +63,20 @@ func cloneSlice(slice []byte) []byte {
 	return cloned
 }
 
-// Merge merges the multi tag trie meta data into a trie for same metric
+// Merge merges the multi tag trie meta data into a trie for same metric.
+// Each flush only builds the trie of new tag values, if only one trie for tag key, reuses it without rebuilding,
+// else merges the sorted tag values of all tries, then builds the merged trie.
 func (tm *merger) Merge(tagKeyID uint32, dataBlocks [][]byte) error {
+	if len(dataBlocks) == 1 {
+		// validate the block, then reuse it
+		if _, err := newTagKeyMeta(dataBlocks[0]); err != nil {
+			return err
+		}
+		return tm.metaFlusher.flushTagKeyMeta(tagKeyID, dataBlocks[0])
+	}
 	maxSequenceID := uint32(0) // target sequence of tag value id
-	// 1. prepare tagKeyMetas
-	var tagKeyMetas []TagKeyMeta
+	// 1. prepare tag value iterators of each trie
+	var itrs []*trie.PrefixIterator
 	for _, dataBlock := range dataBlocks {
 		tagKeyMeta, err := newTagKeyMeta(dataBlock)
 		if err != nil {
@@ -71,18 +85,35 @@ func (tm *merger) Merge(tagKeyID uint32, dataBlocks [][]byte) error {
 		if maxSequenceID < tagKeyMeta.TagValueIDSeq() {
 			maxSequenceID = tagKeyMeta.TagValueIDSeq()
 		}
-		tagKeyMetas = append(tagKeyMetas, tagKeyMeta)
-	}
-	// 2. iterator trie data, then merge the tag values
-	for _, tagKeyMeta := range tagKeyMetas {
 		itr, err := tagKeyMeta.PrefixIterator(nil)
 		if err != nil {
 			return err
 		}
-		for itr.Valid() {
-			tm.metaFlusher.FlushTagValue(cloneSlice(itr.Key()), encoding.ByteSlice2Uint32(itr.Value()))
-			itr.Next()
+		if itr.Valid() {
+			itrs = append(itrs, itr)
+		}
+	}
+	// 2. merge the sorted tag values of each trie, keeps tag values sorted for building trie
+	for len(itrs) > 0 {
+		minIdx := 0
+		for idx := 1; idx < len(itrs); idx++ {
+			if bytes.Compare(itrs[idx].Key(), itrs[minIdx].Key()) < 0 {
+				minIdx = idx
+			}
+		}
+		tagValue := cloneSlice(itrs[minIdx].Key())
+		tm.metaFlusher.FlushTagValue(tagValue, encoding.ByteSlice2Uint32(itrs[minIdx].Value()))
+		// skip same tag value in other tries
+		valid := itrs[:0]
+		for _, itr := range itrs {
+			for itr.Valid() && bytes.Equal(itr.Key(), tagValue) {
+				itr.Next()
+			}
+			if itr.Valid() {
+				valid = append(valid, itr)
+			}
 		}
+		itrs = valid
 	}
 	if err := tm.metaFlusher.FlushTagKeyID(tagKeyID, maxSequenceID); err != nil {
 		return err
@@ -71,6 +71,32 @@ func TestMerger_Merge_Success(t *testing.T) {
 	}, ips)
 }
 
+func TestMerger_Merge_reuse(t *testing.T) {
+	data := mockMergeData()
+	nopFlusher := kv.NewNopFlusher()
+	merger, err := NewMerger(nopFlusher)
+	assert.NoError(t, err)
+	// only one trie, reuse it
+	err = merger.Merge(20, data[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, data[0], nopFlusher.Bytes())
+
+	// duplicate tag values
+	nopFlusher = kv.NewNopFlusher()
+	merger, err = NewMerger(nopFlusher)
+	assert.NoError(t, err)
+	err = merger.Merge(20, [][]byte{data[0], data[1], data[0]})
+	assert.NoError(t, err)
+	meta, err := newTagKeyMeta(nopFlusher.Bytes())
+	assert.NoError(t, err)
+	result, _ := meta.TagValueIDs()
+	assert.EqualValues(t, []uint32{1, 2, 3, 4, 9}, result.ToArray())
+
+	// bad trie
+	err = merger.Merge(20, [][]byte{{1}})
+	assert.Error(t, err)
+}
+
 func Test_Merger_error(t *testing.T) {
 	assert.Nil(t, cloneSlice(nil))
 
@@ -28,6 +28,7 @@ import { URLStore } from "@src/stores";
 import * as _ from "lodash-es";
 import React, { MutableRefObject, useRef, useState } from "react";
 import { ExecService } from "@src/services";
+import { ApiKit } from "@src/utils";
 
 const Text = Typography.Text;
 
@@ -92,7 +93,7 @@ const TagValueSelect: React.FC<MetadataSelectProps> = (
     } catch (err) {
       Notification.error({
         title: "Fetch tag values error",
-        content: _.get(err, "response.data", "Unknown internal error"),
+        content: ApiKit.getErrorMsg(err),
         position: "top",
         theme: "light",
         duration: 5,
@@ -41,8 +41,11 @@ export interface LogicDatabase {
     key?: string;
     values?: string[];
     brokers?: string[];
+    replicaGroup?: string;
+    weight?: number;
   }[];
   desc?: string;
+  sticky?: boolean;
 }
 
 export interface Database {
@@ -56,6 +59,18 @@ export interface Database {
     autoCreateNS?: boolean;
     behind?: string;
     ahead?: string;
+    diskQuota?: string;
+    diskQuotaPolicy?: string;
+    metricExpireAfter?: string;
+    metricExpiryPolicy?: string;
+    readQuota?: {
+      weight?: number;
+      maxConcurrency?: number;
+    };
+    writeQuota?: {
+      weight?: number;
+      maxConcurrency?: number;
+    };
     data: {
       timeThreshold?: number;
       sizeThreshold?: number;
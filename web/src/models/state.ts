@@ -99,6 +99,8 @@ export type MemoryDatabaseState = {
       memSize: number;
       numOfMetrics: number;
       numOfSeries: number;
+      arenaAllocated: number;
+      arenaUsed: number;
     }[];
   }[];
 };
@@ -35,6 +35,7 @@ import { Route } from "@src/constants";
 import { UIContext } from "@src/context/UIContextProvider";
 import { Database } from "@src/models";
 import { ExecService } from "@src/services";
+import { ApiKit } from "@src/utils";
 import { URLStore } from "@src/stores";
 import { useQuery } from "@tanstack/react-query";
 import * as _ from "lodash-es";
@@ -67,7 +68,7 @@ export default function DatabaseList() {
     } catch (err) {
       Notification.error({
         title: "Drop database error",
-        content: _.get(err, "response.data", "Unknown internal error"),
+        content: ApiKit.getErrorMsg(err),
         position: "top",
         theme: "light",
         duration: 5,
@@ -34,6 +34,7 @@ import { Route } from "@src/constants";
 import { UIContext } from "@src/context/UIContextProvider";
 import { LogicDatabase } from "@src/models";
 import { ExecService } from "@src/services";
+import { ApiKit } from "@src/utils";
 import { URLStore } from "@src/stores";
 import { useQuery } from "@tanstack/react-query";
 import React, { useContext } from "react";
@@ -67,7 +68,7 @@ export const LogicDatabaseList: React.FC = () => {
     } catch (err) {
       Notification.error({
         title: "Drop database error",
-        content: _.get(err, "response.data", Common.unknownInternalError),
+        content: ApiKit.getErrorMsg(err),
         position: "top",
         theme: "light",
         duration: 5,
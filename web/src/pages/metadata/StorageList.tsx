@@ -19,6 +19,7 @@ import { IconRedoStroked } from "@douyinfe/semi-icons";
 import { Button, Popconfirm, Notification } from "@douyinfe/semi-ui";
 import { Route } from "@src/constants";
 import { ExecService } from "@src/services";
+import { ApiKit } from "@src/utils";
 import * as _ from "lodash-es";
 import React, { useContext } from "react";
 import { UIContext } from "@src/context/UIContextProvider";
@@ -45,7 +46,7 @@ export default function StorageList() {
       } catch (err) {
         Notification.error({
           title: MetadataStorageView.recoverErrorTitle,
-          content: _.get(err, "response.data", Common.unknownInternalError),
+          content: ApiKit.getErrorMsg(err),
           position: "top",
           theme: "light",
           duration: 5,
@@ -76,12 +76,20 @@ async function POST<T>(
     });
 }
 
+// unwrap error envelope({code,message,retryable,requestId}) returned by execute api.
+const unwrapErrorData = (data: any) => {
+  if (_.isObject(data) && _.has(data, "message")) {
+    return _.get(data, "message");
+  }
+  return data;
+};
+
 const getErrorMsg = (err: any) => {
   if (_.has(err, "response.data")) {
-    return _.get(err, "response.data");
+    return unwrapErrorData(_.get(err, "response.data"));
   }
   if (_.has(err, "reason.response.data")) {
-    return _.get(err, "reason.response.data");
+    return unwrapErrorData(_.get(err, "reason.response.data"));
   }
   const msg = _.get(err, "reason", "Unknown internal error");
   return `${msg}`;